@@ -19,6 +19,9 @@ import (
 	_ "github.com/Wei-Shaw/sub2api/ent/runtime"
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"github.com/Wei-Shaw/sub2api/internal/repository"
+	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
 )
@@ -70,10 +73,45 @@ func main() {
 		return
 	}
 
+	// 子命令：server reencrypt-secrets
+	if flag.Arg(0) == "reencrypt-secrets" {
+		runReencryptSecrets()
+		return
+	}
+
 	// 直接启动主服务，不需要 setup wizard
 	runMainServer()
 }
 
+// runReencryptSecrets 将存量账号凭证与代理密码迁移到当前主密钥后退出。
+// 用于首次启用静态加密或轮换主密钥后立即完成迁移，无需等待后台任务。
+func runReencryptSecrets() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	cipher, err := repository.NewSecretCipher(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize secret encryption: %v", err)
+	}
+	client, sqlDB, err := repository.InitEnt(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	svc := service.NewSecretRotationService(repository.NewSecretRotationRepository(sqlDB, cipher), cfg)
+	result, err := svc.Run(ctx)
+	if err != nil {
+		log.Printf("Re-encrypt secrets failed after %d accounts and %d proxies: %v", result.Accounts, result.Proxies, err)
+		os.Exit(1)
+	}
+	log.Printf("Re-encrypted %d account credentials and %d proxy passwords with key %q", result.Accounts, result.Proxies, cipher.ActiveKeyID())
+}
+
 func runMainServer() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -83,6 +121,29 @@ func runMainServer() {
 		log.Println("⚠️  WARNING: Running in SIMPLE mode - billing and quota checks are DISABLED")
 	}
 
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err := tracing.Init(context.Background(), tracing.Options{
+			Exporter:       cfg.Tracing.Exporter,
+			Endpoint:       cfg.Tracing.Endpoint,
+			Insecure:       cfg.Tracing.Insecure,
+			Headers:        cfg.Tracing.Headers,
+			ServiceName:    cfg.Tracing.ServiceName,
+			ServiceVersion: Version,
+			SampleRatio:    cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			log.Fatalf("Failed to initialize tracing: %v", err)
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				log.Printf("Tracing shutdown error: %v", err)
+			}
+		}()
+		log.Printf("Tracing enabled: exporter=%s endpoint=%s", cfg.Tracing.Exporter, cfg.Tracing.Endpoint)
+	}
+
 	buildInfo := handler.BuildInfo{
 		Version:   Version,
 		BuildType: BuildType,
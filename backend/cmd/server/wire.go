@@ -70,8 +70,13 @@ func provideCleanup(
 	schedulerSnapshot *service.SchedulerSnapshotService,
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
+	secretRotation *service.SecretRotationService,
+	proxyPool *service.ProxyPoolService,
+	proxyImport *service.ProxyImportService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
 	usageCleanup *service.UsageCleanupService,
+	usageExport *service.UsageExportService,
+	invoice *service.InvoiceService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -131,6 +136,18 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageExportService", func() error {
+				if usageExport != nil {
+					usageExport.Stop()
+				}
+				return nil
+			}},
+			{"InvoiceService", func() error {
+				if invoice != nil {
+					invoice.Stop()
+				}
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
@@ -139,6 +156,18 @@ func provideCleanup(
 				accountExpiry.Stop()
 				return nil
 			}},
+			{"SecretRotationService", func() error {
+				secretRotation.Stop()
+				return nil
+			}},
+			{"ProxyImportService", func() error {
+				proxyImport.Stop()
+				return nil
+			}},
+			{"ProxyPoolService", func() error {
+				proxyPool.Stop()
+				return nil
+			}},
 			{"SubscriptionExpiryService", func() error {
 				subscriptionExpiry.Stop()
 				return nil
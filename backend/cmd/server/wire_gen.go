@@ -54,8 +54,9 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	promoCodeRepository := repository.NewPromoCodeRepository(client)
 	billingCache := repository.NewBillingCache(redisClient)
 	userSubscriptionRepository := repository.NewUserSubscriptionRepository(client)
-	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, configConfig)
 	apiKeyRepository := repository.NewAPIKeyRepository(client)
+	organizationRepository := repository.NewOrganizationRepository(client)
+	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, apiKeyRepository, organizationRepository, configConfig)
 	groupRepository := repository.NewGroupRepository(client, db)
 	apiKeyCache := repository.NewAPIKeyCache(redisClient)
 	apiKeyService := service.NewAPIKeyService(apiKeyRepository, userRepository, groupRepository, userSubscriptionRepository, apiKeyCache, configConfig)
@@ -74,7 +75,22 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
 	usageLogRepository := repository.NewUsageLogRepository(client, db)
 	usageService := service.NewUsageService(usageLogRepository, userRepository, client, apiKeyAuthCacheInvalidator)
-	usageHandler := handler.NewUsageHandler(usageService, apiKeyService)
+	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
+	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
+	if err != nil {
+		return nil, err
+	}
+	modelPriceRepository := repository.NewModelPriceRepository(client)
+	modelPriceService := service.ProvideModelPriceService(modelPriceRepository, groupRepository, settingService)
+	billingService := service.NewBillingService(configConfig, pricingService, modelPriceService)
+	promptCacheStatsService := service.NewPromptCacheStatsService(usageLogRepository, billingService)
+	usageExportRepository := repository.NewUsageExportRepository(client, db)
+	timingWheelService, err := service.ProvideTimingWheelService()
+	if err != nil {
+		return nil, err
+	}
+	usageExportService := service.ProvideUsageExportService(usageExportRepository, timingWheelService, configConfig)
+	usageHandler := handler.NewUsageHandler(usageService, apiKeyService, promptCacheStatsService, usageExportService)
 	redeemCodeRepository := repository.NewRedeemCodeRepository(client)
 	subscriptionService := service.NewSubscriptionService(groupRepository, userSubscriptionRepository, billingCacheService)
 	redeemCache := repository.NewRedeemCache(redisClient)
@@ -84,19 +100,24 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	dashboardAggregationRepository := repository.NewDashboardAggregationRepository(db)
 	dashboardStatsCache := repository.NewDashboardCache(redisClient, configConfig)
 	dashboardService := service.NewDashboardService(usageLogRepository, dashboardAggregationRepository, dashboardStatsCache, configConfig)
-	timingWheelService, err := service.ProvideTimingWheelService()
+	dashboardAggregationService := service.ProvideDashboardAggregationService(dashboardAggregationRepository, timingWheelService, configConfig)
+	dashboardHandler := admin.NewDashboardHandler(dashboardService, dashboardAggregationService, promptCacheStatsService)
+	secretCipher, err := repository.NewSecretCipher(configConfig)
 	if err != nil {
 		return nil, err
 	}
-	dashboardAggregationService := service.ProvideDashboardAggregationService(dashboardAggregationRepository, timingWheelService, configConfig)
-	dashboardHandler := admin.NewDashboardHandler(dashboardService, dashboardAggregationService)
-	schedulerCache := repository.NewSchedulerCache(redisClient)
-	accountRepository := repository.NewAccountRepository(client, db, schedulerCache)
-	proxyRepository := repository.NewProxyRepository(client, db)
+	schedulerCache := repository.NewSchedulerCache(redisClient, secretCipher)
+	accountRepository := repository.NewAccountRepository(client, db, schedulerCache, secretCipher)
+	proxyRepository := repository.NewProxyRepository(client, db, secretCipher)
 	proxyExitInfoProber := repository.NewProxyExitInfoProber(configConfig)
 	proxyLatencyCache := repository.NewProxyLatencyCache(redisClient)
-	adminService := service.NewAdminService(userRepository, groupRepository, accountRepository, proxyRepository, apiKeyRepository, redeemCodeRepository, billingCacheService, proxyExitInfoProber, proxyLatencyCache, apiKeyAuthCacheInvalidator)
-	adminUserHandler := admin.NewUserHandler(adminService)
+	proxyPoolRepository := repository.NewProxyPoolRepository(client, db, secretCipher)
+	proxyHealthCache := repository.NewProxyHealthCache(redisClient)
+	proxyPoolService := service.ProvideProxyPoolService(proxyPoolRepository, proxyRepository, proxyHealthCache, proxyLatencyCache, proxyExitInfoProber, configConfig)
+	adminService := service.NewAdminService(userRepository, groupRepository, accountRepository, proxyRepository, apiKeyRepository, redeemCodeRepository, billingCacheService, proxyExitInfoProber, proxyLatencyCache, apiKeyAuthCacheInvalidator, proxyPoolService)
+	balanceLedgerRepository := repository.NewBalanceLedgerRepository(client, db)
+	balanceLedgerService := service.NewBalanceLedgerService(balanceLedgerRepository)
+	adminUserHandler := admin.NewUserHandler(adminService, balanceLedgerService, configConfig)
 	groupHandler := admin.NewGroupHandler(adminService)
 	claudeOAuthClient := repository.NewClaudeOAuthClient()
 	oAuthService := service.NewOAuthService(proxyRepository, claudeOAuthClient)
@@ -127,7 +148,11 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	concurrencyService := service.ProvideConcurrencyService(concurrencyCache, accountRepository, configConfig)
 	crsSyncService := service.NewCRSSyncService(accountRepository, proxyRepository, oAuthService, openAIOAuthService, geminiOAuthService, configConfig)
 	sessionLimitCache := repository.ProvideSessionLimitCache(redisClient, configConfig)
-	accountHandler := admin.NewAccountHandler(adminService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, rateLimitService, accountUsageService, accountTestService, concurrencyService, crsSyncService, sessionLimitCache, compositeTokenCacheInvalidator)
+	accountHealthCache := repository.NewAccountHealthCache(redisClient)
+	accountHealthService := service.NewAccountHealthService(accountRepository, usageLogRepository, accountHealthCache, sessionLimitCache, configConfig)
+	accountBudgetCache := repository.NewAccountBudgetCache(redisClient)
+	accountBudgetService := service.NewAccountBudgetService(usageLogRepository, accountRepository, accountBudgetCache, tempUnschedCache)
+	accountHandler := admin.NewAccountHandler(adminService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, rateLimitService, accountUsageService, accountTestService, concurrencyService, crsSyncService, sessionLimitCache, compositeTokenCacheInvalidator, accountHealthService, accountBudgetService)
 	oAuthHandler := admin.NewOAuthHandler(oAuthService)
 	openAIOAuthHandler := admin.NewOpenAIOAuthHandler(openAIOAuthService, adminService)
 	geminiOAuthHandler := admin.NewGeminiOAuthHandler(geminiOAuthService)
@@ -138,18 +163,12 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	opsRepository := repository.NewOpsRepository(db)
 	schedulerOutboxRepository := repository.NewSchedulerOutboxRepository(db)
 	schedulerSnapshotService := service.ProvideSchedulerSnapshotService(schedulerCache, schedulerOutboxRepository, accountRepository, groupRepository, configConfig)
-	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
-	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
-	if err != nil {
-		return nil, err
-	}
-	billingService := service.NewBillingService(configConfig, pricingService)
 	identityService := service.NewIdentityService(identityCache)
 	deferredService := service.ProvideDeferredService(accountRepository, timingWheelService)
 	claudeTokenProvider := service.NewClaudeTokenProvider(accountRepository, geminiTokenCache, oAuthService)
-	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache)
+	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, apiKeyRepository, organizationRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, accountHealthCache, proxyPoolService, accountBudgetService)
 	openAITokenProvider := service.NewOpenAITokenProvider(accountRepository, geminiTokenCache, openAIOAuthService)
-	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider)
+	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, apiKeyRepository, organizationRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider, proxyPoolService, accountBudgetService, accountHealthCache)
 	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
 	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService)
 	settingHandler := admin.NewSettingHandler(settingService, emailService, turnstileService, opsService)
@@ -162,33 +181,75 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	adminSubscriptionHandler := admin.NewSubscriptionHandler(subscriptionService)
 	usageCleanupRepository := repository.NewUsageCleanupRepository(client, db)
 	usageCleanupService := service.ProvideUsageCleanupService(usageCleanupRepository, timingWheelService, dashboardAggregationService, configConfig)
-	adminUsageHandler := admin.NewUsageHandler(usageService, apiKeyService, adminService, usageCleanupService)
+	adminUsageHandler := admin.NewUsageHandler(usageService, apiKeyService, adminService, usageCleanupService, usageExportService)
 	userAttributeDefinitionRepository := repository.NewUserAttributeDefinitionRepository(client)
 	userAttributeValueRepository := repository.NewUserAttributeValueRepository(client)
 	userAttributeService := service.NewUserAttributeService(userAttributeDefinitionRepository, userAttributeValueRepository)
 	userAttributeHandler := admin.NewUserAttributeHandler(userAttributeService)
-	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler)
-	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, configConfig)
-	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, configConfig)
+	auditLogRepository := repository.NewAuditLogRepository(client)
+	auditLogService := service.NewAuditLogService(auditLogRepository)
+	auditLogHandler := admin.NewAuditLogHandler(auditLogService)
+	organizationService := service.NewOrganizationService(organizationRepository, userRepository, billingCacheService, client, apiKeyAuthCacheInvalidator)
+	organizationHandler := admin.NewOrganizationHandler(organizationService)
+	balanceLedgerHandler := admin.NewBalanceLedgerHandler(balanceLedgerService)
+	adminAPIKeyRepository := repository.NewAdminAPIKeyRepository(client)
+	adminAPIKeyService := service.NewAdminAPIKeyService(adminAPIKeyRepository, userRepository)
+	adminAPIKeyHandler := admin.NewAdminAPIKeyHandler(adminAPIKeyService)
+	invoiceRepository := repository.NewInvoiceRepository(db)
+	invoiceService := service.ProvideInvoiceService(invoiceRepository, userRepository, organizationRepository, settingService, emailQueueService, timingWheelService, configConfig)
+	invoiceHandler := admin.NewInvoiceHandler(invoiceService)
+	paymentOrderRepository := repository.NewPaymentOrderRepository(db)
+	subscriptionPlanRepository := repository.NewSubscriptionPlanRepository(client)
+	creemService := service.NewCreemService(settingService)
+	stripeService := service.NewStripeService(settingService)
+	ePayService := service.NewEPayService(settingService)
+	paymentService := service.ProvidePaymentService(paymentOrderRepository, userRepository, subscriptionPlanRepository, subscriptionService, billingCacheService, client, apiKeyAuthCacheInvalidator, creemService, stripeService, ePayService)
+	paymentOrderHandler := admin.NewPaymentOrderHandler(paymentService)
+	subscriptionPlanService := service.NewSubscriptionPlanService(subscriptionPlanRepository, groupRepository, userSubscriptionRepository, userRepository, subscriptionService, settingService, billingCacheService, client, apiKeyAuthCacheInvalidator, emailQueueService)
+	subscriptionPlanHandler := admin.NewSubscriptionPlanHandler(subscriptionPlanService)
+	modelPriceHandler := admin.NewModelPriceHandler(modelPriceService, billingService)
+	proxyPoolHandler := admin.NewProxyPoolHandler(proxyPoolService)
+	proxySubscriptionRepository := repository.NewProxySubscriptionRepository(client, secretCipher)
+	proxyImportService := service.ProvideProxyImportService(proxyRepository, proxySubscriptionRepository, proxyPoolService, configConfig)
+	proxyImportHandler := admin.NewProxyImportHandler(proxyImportService)
+	accountBundleService := service.NewAccountBundleService(accountRepository, groupRepository, proxyRepository, settingRepository, configConfig)
+	accountBundleHandler := admin.NewAccountBundleHandler(accountBundleService)
+	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, auditLogHandler, organizationHandler, balanceLedgerHandler, adminAPIKeyHandler, invoiceHandler, paymentOrderHandler, subscriptionPlanHandler, modelPriceHandler, proxyPoolHandler, proxyImportHandler, accountBundleHandler)
+	requestRateLimitCache := repository.NewRequestRateLimitCache(redisClient)
+	requestRateLimitService := service.NewRequestRateLimitService(requestRateLimitCache, configConfig)
+	responseCacheStore := repository.ProvideResponseCacheStore(redisClient, db, configConfig)
+	responseCacheService := service.NewResponseCacheService(configConfig, responseCacheStore, gatewayService, accountRepository)
+	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, requestRateLimitService, responseCacheService, configConfig)
+	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, requestRateLimitService, responseCacheService, configConfig)
+	chatCompletionsHandler := handler.NewChatCompletionsHandler(gatewayHandler, openAIGatewayHandler)
 	handlerSettingHandler := handler.ProvideSettingHandler(settingService, buildInfo)
-	creemService := service.NewCreemService(settingService, userRepository)
-	creemHandler := handler.NewCreemHandler(creemService, userService)
+	paymentHandler := handler.NewPaymentHandler(paymentService, creemService, userService)
 	totpHandler := handler.NewTotpHandler(totpService)
-	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, handlerSettingHandler, creemHandler, totpHandler)
+	gatewayMetricsService := service.NewGatewayMetricsService(concurrencyService, accountRepository, pricingService, configConfig)
+	metricsHandler := handler.NewMetricsHandler(gatewayMetricsService)
+	handlerOrganizationHandler := handler.NewOrganizationHandler(organizationService)
+	balanceTransactionHandler := handler.NewBalanceTransactionHandler(balanceLedgerService)
+	handlerInvoiceHandler := handler.NewInvoiceHandler(invoiceService)
+	handlerSubscriptionPlanHandler := handler.NewSubscriptionPlanHandler(subscriptionPlanService, paymentService, userService)
+	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, chatCompletionsHandler, handlerSettingHandler, paymentHandler, totpHandler, metricsHandler, handlerOrganizationHandler, balanceTransactionHandler, handlerInvoiceHandler, handlerSubscriptionPlanHandler)
 	jwtAuthMiddleware := middleware.NewJWTAuthMiddleware(authService, userService)
-	adminAuthMiddleware := middleware.NewAdminAuthMiddleware(authService, userService, settingService)
+	adminAuthMiddleware := middleware.NewAdminAuthMiddleware(authService, userService, adminAPIKeyService)
 	apiKeyAuthMiddleware := middleware.NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, configConfig)
-	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, apiKeyService, subscriptionService, opsService, settingService, redisClient)
+	metricsAuthMiddleware := middleware.NewMetricsAuthMiddleware(configConfig, adminAPIKeyService)
+	auditLogMiddleware := middleware.NewAuditLogMiddleware(auditLogService)
+	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, metricsAuthMiddleware, auditLogMiddleware, apiKeyService, subscriptionService, opsService, settingService, redisClient)
 	httpServer := server.ProvideHTTPServer(configConfig, engine)
-	opsMetricsCollector := service.ProvideOpsMetricsCollector(opsRepository, settingRepository, accountRepository, concurrencyService, db, redisClient, configConfig)
+	opsMetricsCollector := service.ProvideOpsMetricsCollector(opsRepository, settingRepository, accountRepository, concurrencyService, accountHealthCache, db, redisClient, configConfig)
 	opsAggregationService := service.ProvideOpsAggregationService(opsRepository, settingRepository, db, redisClient, configConfig)
-	opsAlertEvaluatorService := service.ProvideOpsAlertEvaluatorService(opsService, opsRepository, emailService, redisClient, configConfig)
+	opsAlertEvaluatorService := service.ProvideOpsAlertEvaluatorService(opsService, opsRepository, emailService, balanceLedgerService, redisClient, configConfig)
 	opsCleanupService := service.ProvideOpsCleanupService(opsRepository, db, redisClient, configConfig)
 	opsScheduledReportService := service.ProvideOpsScheduledReportService(opsService, userService, emailService, redisClient, configConfig)
 	tokenRefreshService := service.ProvideTokenRefreshService(accountRepository, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, compositeTokenCacheInvalidator, configConfig)
 	accountExpiryService := service.ProvideAccountExpiryService(accountRepository)
-	subscriptionExpiryService := service.ProvideSubscriptionExpiryService(userSubscriptionRepository)
-	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, subscriptionExpiryService, usageCleanupService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
+	secretRotationRepository := repository.NewSecretRotationRepository(db, secretCipher)
+	secretRotationService := service.ProvideSecretRotationService(secretRotationRepository, configConfig)
+	subscriptionExpiryService := service.ProvideSubscriptionExpiryService(userSubscriptionRepository, subscriptionPlanService)
+	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, secretRotationService, proxyPoolService, proxyImportService, subscriptionExpiryService, usageCleanupService, usageExportService, invoiceService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
 	application := &Application{
 		Server:  httpServer,
 		Cleanup: v,
@@ -221,8 +282,13 @@ func provideCleanup(
 	schedulerSnapshot *service.SchedulerSnapshotService,
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
+	secretRotation *service.SecretRotationService,
+	proxyPool *service.ProxyPoolService,
+	proxyImport *service.ProxyImportService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
 	usageCleanup *service.UsageCleanupService,
+	usageExport *service.UsageExportService,
+	invoice *service.InvoiceService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -281,6 +347,18 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageExportService", func() error {
+				if usageExport != nil {
+					usageExport.Stop()
+				}
+				return nil
+			}},
+			{"InvoiceService", func() error {
+				if invoice != nil {
+					invoice.Stop()
+				}
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
@@ -289,6 +367,18 @@ func provideCleanup(
 				accountExpiry.Stop()
 				return nil
 			}},
+			{"SecretRotationService", func() error {
+				secretRotation.Stop()
+				return nil
+			}},
+			{"ProxyImportService", func() error {
+				proxyImport.Stop()
+				return nil
+			}},
+			{"ProxyPoolService", func() error {
+				proxyPool.Stop()
+				return nil
+			}},
 			{"SubscriptionExpiryService", func() error {
 				subscriptionExpiry.Stop()
 				return nil
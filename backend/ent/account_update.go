@@ -151,6 +151,33 @@ func (_u *AccountUpdate) ClearProxyID() *AccountUpdate {
 	return _u
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (_u *AccountUpdate) SetProxyPoolID(v int64) *AccountUpdate {
+	_u.mutation.ResetProxyPoolID()
+	_u.mutation.SetProxyPoolID(v)
+	return _u
+}
+
+// SetNillableProxyPoolID sets the "proxy_pool_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableProxyPoolID(v *int64) *AccountUpdate {
+	if v != nil {
+		_u.SetProxyPoolID(*v)
+	}
+	return _u
+}
+
+// AddProxyPoolID adds value to the "proxy_pool_id" field.
+func (_u *AccountUpdate) AddProxyPoolID(v int64) *AccountUpdate {
+	_u.mutation.AddProxyPoolID(v)
+	return _u
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (_u *AccountUpdate) ClearProxyPoolID() *AccountUpdate {
+	_u.mutation.ClearProxyPoolID()
+	return _u
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (_u *AccountUpdate) SetConcurrency(v int) *AccountUpdate {
 	_u.mutation.ResetConcurrency()
@@ -638,6 +665,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(account.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ProxyPoolID(); ok {
+		_spec.SetField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProxyPoolID(); ok {
+		_spec.AddField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.ProxyPoolIDCleared() {
+		_spec.ClearField(account.FieldProxyPoolID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.Concurrency(); ok {
 		_spec.SetField(account.FieldConcurrency, field.TypeInt, value)
 	}
@@ -990,6 +1026,33 @@ func (_u *AccountUpdateOne) ClearProxyID() *AccountUpdateOne {
 	return _u
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) SetProxyPoolID(v int64) *AccountUpdateOne {
+	_u.mutation.ResetProxyPoolID()
+	_u.mutation.SetProxyPoolID(v)
+	return _u
+}
+
+// SetNillableProxyPoolID sets the "proxy_pool_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableProxyPoolID(v *int64) *AccountUpdateOne {
+	if v != nil {
+		_u.SetProxyPoolID(*v)
+	}
+	return _u
+}
+
+// AddProxyPoolID adds value to the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) AddProxyPoolID(v int64) *AccountUpdateOne {
+	_u.mutation.AddProxyPoolID(v)
+	return _u
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) ClearProxyPoolID() *AccountUpdateOne {
+	_u.mutation.ClearProxyPoolID()
+	return _u
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (_u *AccountUpdateOne) SetConcurrency(v int) *AccountUpdateOne {
 	_u.mutation.ResetConcurrency()
@@ -1507,6 +1570,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(account.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ProxyPoolID(); ok {
+		_spec.SetField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProxyPoolID(); ok {
+		_spec.AddField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.ProxyPoolIDCleared() {
+		_spec.ClearField(account.FieldProxyPoolID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.Concurrency(); ok {
 		_spec.SetField(account.FieldConcurrency, field.TypeInt, value)
 	}
@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/adminapikey"
+)
+
+// AdminAPIKeyCreate is the builder for creating a AdminAPIKey entity.
+type AdminAPIKeyCreate struct {
+	config
+	mutation *AdminAPIKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *AdminAPIKeyCreate) SetName(v string) *AdminAPIKeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (_c *AdminAPIKeyCreate) SetKeyHash(v string) *AdminAPIKeyCreate {
+	_c.mutation.SetKeyHash(v)
+	return _c
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (_c *AdminAPIKeyCreate) SetKeyPrefix(v string) *AdminAPIKeyCreate {
+	_c.mutation.SetKeyPrefix(v)
+	return _c
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (_c *AdminAPIKeyCreate) SetNillableKeyPrefix(v *string) *AdminAPIKeyCreate {
+	if v != nil {
+		_c.SetKeyPrefix(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *AdminAPIKeyCreate) SetScopes(v []string) *AdminAPIKeyCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AdminAPIKeyCreate) SetExpiresAt(v time.Time) *AdminAPIKeyCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *AdminAPIKeyCreate) SetNillableExpiresAt(v *time.Time) *AdminAPIKeyCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *AdminAPIKeyCreate) SetLastUsedAt(v time.Time) *AdminAPIKeyCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *AdminAPIKeyCreate) SetNillableLastUsedAt(v *time.Time) *AdminAPIKeyCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AdminAPIKeyCreate) SetCreatedBy(v int64) *AdminAPIKeyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AdminAPIKeyCreate) SetNillableCreatedBy(v *int64) *AdminAPIKeyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminAPIKeyCreate) SetCreatedAt(v time.Time) *AdminAPIKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminAPIKeyCreate) SetNillableCreatedAt(v *time.Time) *AdminAPIKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AdminAPIKeyMutation object of the builder.
+func (_c *AdminAPIKeyCreate) Mutation() *AdminAPIKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminAPIKey in the database.
+func (_c *AdminAPIKeyCreate) Save(ctx context.Context) (*AdminAPIKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminAPIKeyCreate) SaveX(ctx context.Context) *AdminAPIKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAPIKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAPIKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminAPIKeyCreate) defaults() {
+	if _, ok := _c.mutation.KeyPrefix(); !ok {
+		v := adminapikey.DefaultKeyPrefix
+		_c.mutation.SetKeyPrefix(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminapikey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminAPIKeyCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AdminAPIKey.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := adminapikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AdminAPIKey.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "AdminAPIKey.key_hash"`)}
+	}
+	if v, ok := _c.mutation.KeyHash(); ok {
+		if err := adminapikey.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "AdminAPIKey.key_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KeyPrefix(); !ok {
+		return &ValidationError{Name: "key_prefix", err: errors.New(`ent: missing required field "AdminAPIKey.key_prefix"`)}
+	}
+	if v, ok := _c.mutation.KeyPrefix(); ok {
+		if err := adminapikey.KeyPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "key_prefix", err: fmt.Errorf(`ent: validator failed for field "AdminAPIKey.key_prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AdminAPIKey.scopes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminAPIKey.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AdminAPIKeyCreate) sqlSave(ctx context.Context) (*AdminAPIKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminAPIKeyCreate) createSpec() (*AdminAPIKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminAPIKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminapikey.Table, sqlgraph.NewFieldSpec(adminapikey.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(adminapikey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.KeyHash(); ok {
+		_spec.SetField(adminapikey.FieldKeyHash, field.TypeString, value)
+		_node.KeyHash = value
+	}
+	if value, ok := _c.mutation.KeyPrefix(); ok {
+		_spec.SetField(adminapikey.FieldKeyPrefix, field.TypeString, value)
+		_node.KeyPrefix = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(adminapikey.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminapikey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminapikey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(adminapikey.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminapikey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAPIKey.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminAPIKeyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AdminAPIKeyCreate) OnConflict(opts ...sql.ConflictOption) *AdminAPIKeyUpsertOne {
+	_c.conflict = opts
+	return &AdminAPIKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AdminAPIKeyCreate) OnConflictColumns(columns ...string) *AdminAPIKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AdminAPIKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AdminAPIKeyUpsertOne is the builder for "upsert"-ing
+	//  one AdminAPIKey node.
+	AdminAPIKeyUpsertOne struct {
+		create *AdminAPIKeyCreate
+	}
+
+	// AdminAPIKeyUpsert is the "OnConflict" setter.
+	AdminAPIKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *AdminAPIKeyUpsert) SetName(v string) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateName() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldName)
+	return u
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *AdminAPIKeyUpsert) SetKeyHash(v string) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldKeyHash, v)
+	return u
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateKeyHash() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldKeyHash)
+	return u
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *AdminAPIKeyUpsert) SetKeyPrefix(v string) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldKeyPrefix, v)
+	return u
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateKeyPrefix() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldKeyPrefix)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AdminAPIKeyUpsert) SetScopes(v []string) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateScopes() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldScopes)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AdminAPIKeyUpsert) SetExpiresAt(v time.Time) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateExpiresAt() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AdminAPIKeyUpsert) ClearExpiresAt() *AdminAPIKeyUpsert {
+	u.SetNull(adminapikey.FieldExpiresAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AdminAPIKeyUpsert) SetLastUsedAt(v time.Time) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateLastUsedAt() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AdminAPIKeyUpsert) ClearLastUsedAt() *AdminAPIKeyUpsert {
+	u.SetNull(adminapikey.FieldLastUsedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AdminAPIKeyUpsert) SetCreatedBy(v int64) *AdminAPIKeyUpsert {
+	u.Set(adminapikey.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsert) UpdateCreatedBy() *AdminAPIKeyUpsert {
+	u.SetExcluded(adminapikey.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AdminAPIKeyUpsert) AddCreatedBy(v int64) *AdminAPIKeyUpsert {
+	u.Add(adminapikey.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AdminAPIKeyUpsert) ClearCreatedBy() *AdminAPIKeyUpsert {
+	u.SetNull(adminapikey.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AdminAPIKeyUpsertOne) UpdateNewValues() *AdminAPIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(adminapikey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AdminAPIKeyUpsertOne) Ignore() *AdminAPIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminAPIKeyUpsertOne) DoNothing() *AdminAPIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminAPIKeyCreate.OnConflict
+// documentation for more info.
+func (u *AdminAPIKeyUpsertOne) Update(set func(*AdminAPIKeyUpsert)) *AdminAPIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminAPIKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AdminAPIKeyUpsertOne) SetName(v string) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateName() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *AdminAPIKeyUpsertOne) SetKeyHash(v string) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetKeyHash(v)
+	})
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateKeyHash() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateKeyHash()
+	})
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *AdminAPIKeyUpsertOne) SetKeyPrefix(v string) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetKeyPrefix(v)
+	})
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateKeyPrefix() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateKeyPrefix()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AdminAPIKeyUpsertOne) SetScopes(v []string) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateScopes() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AdminAPIKeyUpsertOne) SetExpiresAt(v time.Time) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateExpiresAt() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AdminAPIKeyUpsertOne) ClearExpiresAt() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AdminAPIKeyUpsertOne) SetLastUsedAt(v time.Time) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateLastUsedAt() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AdminAPIKeyUpsertOne) ClearLastUsedAt() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AdminAPIKeyUpsertOne) SetCreatedBy(v int64) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AdminAPIKeyUpsertOne) AddCreatedBy(v int64) *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertOne) UpdateCreatedBy() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AdminAPIKeyUpsertOne) ClearCreatedBy() *AdminAPIKeyUpsertOne {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *AdminAPIKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminAPIKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminAPIKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AdminAPIKeyUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AdminAPIKeyUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AdminAPIKeyCreateBulk is the builder for creating many AdminAPIKey entities in bulk.
+type AdminAPIKeyCreateBulk struct {
+	config
+	err      error
+	builders []*AdminAPIKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AdminAPIKey entities in the database.
+func (_c *AdminAPIKeyCreateBulk) Save(ctx context.Context) ([]*AdminAPIKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminAPIKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminAPIKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminAPIKeyCreateBulk) SaveX(ctx context.Context) []*AdminAPIKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAPIKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAPIKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAPIKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminAPIKeyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AdminAPIKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *AdminAPIKeyUpsertBulk {
+	_c.conflict = opts
+	return &AdminAPIKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AdminAPIKeyCreateBulk) OnConflictColumns(columns ...string) *AdminAPIKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AdminAPIKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// AdminAPIKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of AdminAPIKey nodes.
+type AdminAPIKeyUpsertBulk struct {
+	create *AdminAPIKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AdminAPIKeyUpsertBulk) UpdateNewValues() *AdminAPIKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(adminapikey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AdminAPIKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AdminAPIKeyUpsertBulk) Ignore() *AdminAPIKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminAPIKeyUpsertBulk) DoNothing() *AdminAPIKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminAPIKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *AdminAPIKeyUpsertBulk) Update(set func(*AdminAPIKeyUpsert)) *AdminAPIKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminAPIKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AdminAPIKeyUpsertBulk) SetName(v string) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateName() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *AdminAPIKeyUpsertBulk) SetKeyHash(v string) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetKeyHash(v)
+	})
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateKeyHash() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateKeyHash()
+	})
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *AdminAPIKeyUpsertBulk) SetKeyPrefix(v string) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetKeyPrefix(v)
+	})
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateKeyPrefix() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateKeyPrefix()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AdminAPIKeyUpsertBulk) SetScopes(v []string) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateScopes() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AdminAPIKeyUpsertBulk) SetExpiresAt(v time.Time) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateExpiresAt() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AdminAPIKeyUpsertBulk) ClearExpiresAt() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AdminAPIKeyUpsertBulk) SetLastUsedAt(v time.Time) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateLastUsedAt() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AdminAPIKeyUpsertBulk) ClearLastUsedAt() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AdminAPIKeyUpsertBulk) SetCreatedBy(v int64) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AdminAPIKeyUpsertBulk) AddCreatedBy(v int64) *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AdminAPIKeyUpsertBulk) UpdateCreatedBy() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AdminAPIKeyUpsertBulk) ClearCreatedBy() *AdminAPIKeyUpsertBulk {
+	return u.Update(func(s *AdminAPIKeyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *AdminAPIKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AdminAPIKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminAPIKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminAPIKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
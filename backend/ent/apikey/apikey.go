@@ -21,6 +21,16 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldRpmLimit holds the string denoting the rpm_limit field in the database.
+	FieldRpmLimit = "rpm_limit"
+	// FieldInputTpmLimit holds the string denoting the input_tpm_limit field in the database.
+	FieldInputTpmLimit = "input_tpm_limit"
+	// FieldOutputTpmLimit holds the string denoting the output_tpm_limit field in the database.
+	FieldOutputTpmLimit = "output_tpm_limit"
+	// FieldResponseCacheEnabled holds the string denoting the response_cache_enabled field in the database.
+	FieldResponseCacheEnabled = "response_cache_enabled"
+	// FieldResponseCacheTTLSeconds holds the string denoting the response_cache_ttl_seconds field in the database.
+	FieldResponseCacheTTLSeconds = "response_cache_ttl_seconds"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldKey holds the string denoting the key field in the database.
@@ -35,6 +45,28 @@ const (
 	FieldIPWhitelist = "ip_whitelist"
 	// FieldIPBlacklist holds the string denoting the ip_blacklist field in the database.
 	FieldIPBlacklist = "ip_blacklist"
+	// FieldAllowedModels holds the string denoting the allowed_models field in the database.
+	FieldAllowedModels = "allowed_models"
+	// FieldModelAliases holds the string denoting the model_aliases field in the database.
+	FieldModelAliases = "model_aliases"
+	// FieldQuotaUsd holds the string denoting the quota_usd field in the database.
+	FieldQuotaUsd = "quota_usd"
+	// FieldDailyLimitUsd holds the string denoting the daily_limit_usd field in the database.
+	FieldDailyLimitUsd = "daily_limit_usd"
+	// FieldMonthlyLimitUsd holds the string denoting the monthly_limit_usd field in the database.
+	FieldMonthlyLimitUsd = "monthly_limit_usd"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldQuotaUsedUsd holds the string denoting the quota_used_usd field in the database.
+	FieldQuotaUsedUsd = "quota_used_usd"
+	// FieldDailyUsageUsd holds the string denoting the daily_usage_usd field in the database.
+	FieldDailyUsageUsd = "daily_usage_usd"
+	// FieldMonthlyUsageUsd holds the string denoting the monthly_usage_usd field in the database.
+	FieldMonthlyUsageUsd = "monthly_usage_usd"
+	// FieldDailyWindowStart holds the string denoting the daily_window_start field in the database.
+	FieldDailyWindowStart = "daily_window_start"
+	// FieldMonthlyWindowStart holds the string denoting the monthly_window_start field in the database.
+	FieldMonthlyWindowStart = "monthly_window_start"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -72,6 +104,11 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldRpmLimit,
+	FieldInputTpmLimit,
+	FieldOutputTpmLimit,
+	FieldResponseCacheEnabled,
+	FieldResponseCacheTTLSeconds,
 	FieldUserID,
 	FieldKey,
 	FieldName,
@@ -79,6 +116,17 @@ var Columns = []string{
 	FieldStatus,
 	FieldIPWhitelist,
 	FieldIPBlacklist,
+	FieldAllowedModels,
+	FieldModelAliases,
+	FieldQuotaUsd,
+	FieldDailyLimitUsd,
+	FieldMonthlyLimitUsd,
+	FieldExpiresAt,
+	FieldQuotaUsedUsd,
+	FieldDailyUsageUsd,
+	FieldMonthlyUsageUsd,
+	FieldDailyWindowStart,
+	FieldMonthlyWindowStart,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -105,6 +153,24 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRpmLimit holds the default value on creation for the "rpm_limit" field.
+	DefaultRpmLimit int
+	// RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	RpmLimitValidator func(int) error
+	// DefaultInputTpmLimit holds the default value on creation for the "input_tpm_limit" field.
+	DefaultInputTpmLimit int
+	// InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	InputTpmLimitValidator func(int) error
+	// DefaultOutputTpmLimit holds the default value on creation for the "output_tpm_limit" field.
+	DefaultOutputTpmLimit int
+	// OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	OutputTpmLimitValidator func(int) error
+	// DefaultResponseCacheEnabled holds the default value on creation for the "response_cache_enabled" field.
+	DefaultResponseCacheEnabled bool
+	// DefaultResponseCacheTTLSeconds holds the default value on creation for the "response_cache_ttl_seconds" field.
+	DefaultResponseCacheTTLSeconds int
+	// ResponseCacheTTLSecondsValidator is a validator for the "response_cache_ttl_seconds" field. It is called by the builders before save.
+	ResponseCacheTTLSecondsValidator func(int) error
 	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
 	KeyValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -113,6 +179,12 @@ var (
 	DefaultStatus string
 	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	StatusValidator func(string) error
+	// DefaultQuotaUsedUsd holds the default value on creation for the "quota_used_usd" field.
+	DefaultQuotaUsedUsd float64
+	// DefaultDailyUsageUsd holds the default value on creation for the "daily_usage_usd" field.
+	DefaultDailyUsageUsd float64
+	// DefaultMonthlyUsageUsd holds the default value on creation for the "monthly_usage_usd" field.
+	DefaultMonthlyUsageUsd float64
 )
 
 // OrderOption defines the ordering options for the APIKey queries.
@@ -138,6 +210,31 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByRpmLimit orders the results by the rpm_limit field.
+func ByRpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRpmLimit, opts...).ToFunc()
+}
+
+// ByInputTpmLimit orders the results by the input_tpm_limit field.
+func ByInputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputTpmLimit, opts...).ToFunc()
+}
+
+// ByOutputTpmLimit orders the results by the output_tpm_limit field.
+func ByOutputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTpmLimit, opts...).ToFunc()
+}
+
+// ByResponseCacheEnabled orders the results by the response_cache_enabled field.
+func ByResponseCacheEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheEnabled, opts...).ToFunc()
+}
+
+// ByResponseCacheTTLSeconds orders the results by the response_cache_ttl_seconds field.
+func ByResponseCacheTTLSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheTTLSeconds, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -163,6 +260,51 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByQuotaUsd orders the results by the quota_usd field.
+func ByQuotaUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaUsd, opts...).ToFunc()
+}
+
+// ByDailyLimitUsd orders the results by the daily_limit_usd field.
+func ByDailyLimitUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyLimitUsd, opts...).ToFunc()
+}
+
+// ByMonthlyLimitUsd orders the results by the monthly_limit_usd field.
+func ByMonthlyLimitUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyLimitUsd, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByQuotaUsedUsd orders the results by the quota_used_usd field.
+func ByQuotaUsedUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaUsedUsd, opts...).ToFunc()
+}
+
+// ByDailyUsageUsd orders the results by the daily_usage_usd field.
+func ByDailyUsageUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyUsageUsd, opts...).ToFunc()
+}
+
+// ByMonthlyUsageUsd orders the results by the monthly_usage_usd field.
+func ByMonthlyUsageUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyUsageUsd, opts...).ToFunc()
+}
+
+// ByDailyWindowStart orders the results by the daily_window_start field.
+func ByDailyWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyWindowStart, opts...).ToFunc()
+}
+
+// ByMonthlyWindowStart orders the results by the monthly_window_start field.
+func ByMonthlyWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyWindowStart, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -67,6 +67,76 @@ func (_c *APIKeyCreate) SetNillableDeletedAt(v *time.Time) *APIKeyCreate {
 	return _c
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_c *APIKeyCreate) SetRpmLimit(v int) *APIKeyCreate {
+	_c.mutation.SetRpmLimit(v)
+	return _c
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableRpmLimit(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetRpmLimit(*v)
+	}
+	return _c
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_c *APIKeyCreate) SetInputTpmLimit(v int) *APIKeyCreate {
+	_c.mutation.SetInputTpmLimit(v)
+	return _c
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableInputTpmLimit(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetInputTpmLimit(*v)
+	}
+	return _c
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_c *APIKeyCreate) SetOutputTpmLimit(v int) *APIKeyCreate {
+	_c.mutation.SetOutputTpmLimit(v)
+	return _c
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableOutputTpmLimit(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetOutputTpmLimit(*v)
+	}
+	return _c
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_c *APIKeyCreate) SetResponseCacheEnabled(v bool) *APIKeyCreate {
+	_c.mutation.SetResponseCacheEnabled(v)
+	return _c
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableResponseCacheEnabled(v *bool) *APIKeyCreate {
+	if v != nil {
+		_c.SetResponseCacheEnabled(*v)
+	}
+	return _c
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_c *APIKeyCreate) SetResponseCacheTTLSeconds(v int) *APIKeyCreate {
+	_c.mutation.SetResponseCacheTTLSeconds(v)
+	return _c
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableResponseCacheTTLSeconds(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetResponseCacheTTLSeconds(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *APIKeyCreate) SetUserID(v int64) *APIKeyCreate {
 	_c.mutation.SetUserID(v)
@@ -125,6 +195,144 @@ func (_c *APIKeyCreate) SetIPBlacklist(v []string) *APIKeyCreate {
 	return _c
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_c *APIKeyCreate) SetAllowedModels(v []string) *APIKeyCreate {
+	_c.mutation.SetAllowedModels(v)
+	return _c
+}
+
+// SetModelAliases sets the "model_aliases" field.
+func (_c *APIKeyCreate) SetModelAliases(v map[string]string) *APIKeyCreate {
+	_c.mutation.SetModelAliases(v)
+	return _c
+}
+
+// SetQuotaUsd sets the "quota_usd" field.
+func (_c *APIKeyCreate) SetQuotaUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetQuotaUsd(v)
+	return _c
+}
+
+// SetNillableQuotaUsd sets the "quota_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableQuotaUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetQuotaUsd(*v)
+	}
+	return _c
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (_c *APIKeyCreate) SetDailyLimitUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetDailyLimitUsd(v)
+	return _c
+}
+
+// SetNillableDailyLimitUsd sets the "daily_limit_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableDailyLimitUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetDailyLimitUsd(*v)
+	}
+	return _c
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (_c *APIKeyCreate) SetMonthlyLimitUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetMonthlyLimitUsd(v)
+	return _c
+}
+
+// SetNillableMonthlyLimitUsd sets the "monthly_limit_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableMonthlyLimitUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetMonthlyLimitUsd(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *APIKeyCreate) SetExpiresAt(v time.Time) *APIKeyCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableExpiresAt(v *time.Time) *APIKeyCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (_c *APIKeyCreate) SetQuotaUsedUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetQuotaUsedUsd(v)
+	return _c
+}
+
+// SetNillableQuotaUsedUsd sets the "quota_used_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableQuotaUsedUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetQuotaUsedUsd(*v)
+	}
+	return _c
+}
+
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (_c *APIKeyCreate) SetDailyUsageUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetDailyUsageUsd(v)
+	return _c
+}
+
+// SetNillableDailyUsageUsd sets the "daily_usage_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableDailyUsageUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetDailyUsageUsd(*v)
+	}
+	return _c
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (_c *APIKeyCreate) SetMonthlyUsageUsd(v float64) *APIKeyCreate {
+	_c.mutation.SetMonthlyUsageUsd(v)
+	return _c
+}
+
+// SetNillableMonthlyUsageUsd sets the "monthly_usage_usd" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableMonthlyUsageUsd(v *float64) *APIKeyCreate {
+	if v != nil {
+		_c.SetMonthlyUsageUsd(*v)
+	}
+	return _c
+}
+
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (_c *APIKeyCreate) SetDailyWindowStart(v time.Time) *APIKeyCreate {
+	_c.mutation.SetDailyWindowStart(v)
+	return _c
+}
+
+// SetNillableDailyWindowStart sets the "daily_window_start" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableDailyWindowStart(v *time.Time) *APIKeyCreate {
+	if v != nil {
+		_c.SetDailyWindowStart(*v)
+	}
+	return _c
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (_c *APIKeyCreate) SetMonthlyWindowStart(v time.Time) *APIKeyCreate {
+	_c.mutation.SetMonthlyWindowStart(v)
+	return _c
+}
+
+// SetNillableMonthlyWindowStart sets the "monthly_window_start" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableMonthlyWindowStart(v *time.Time) *APIKeyCreate {
+	if v != nil {
+		_c.SetMonthlyWindowStart(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *APIKeyCreate) SetUser(v *User) *APIKeyCreate {
 	return _c.SetUserID(v.ID)
@@ -201,10 +409,42 @@ func (_c *APIKeyCreate) defaults() error {
 		v := apikey.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		v := apikey.DefaultRpmLimit
+		_c.mutation.SetRpmLimit(v)
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		v := apikey.DefaultInputTpmLimit
+		_c.mutation.SetInputTpmLimit(v)
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		v := apikey.DefaultOutputTpmLimit
+		_c.mutation.SetOutputTpmLimit(v)
+	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		v := apikey.DefaultResponseCacheEnabled
+		_c.mutation.SetResponseCacheEnabled(v)
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		v := apikey.DefaultResponseCacheTTLSeconds
+		_c.mutation.SetResponseCacheTTLSeconds(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := apikey.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.QuotaUsedUsd(); !ok {
+		v := apikey.DefaultQuotaUsedUsd
+		_c.mutation.SetQuotaUsedUsd(v)
+	}
+	if _, ok := _c.mutation.DailyUsageUsd(); !ok {
+		v := apikey.DefaultDailyUsageUsd
+		_c.mutation.SetDailyUsageUsd(v)
+	}
+	if _, ok := _c.mutation.MonthlyUsageUsd(); !ok {
+		v := apikey.DefaultMonthlyUsageUsd
+		_c.mutation.SetMonthlyUsageUsd(v)
+	}
 	return nil
 }
 
@@ -216,6 +456,41 @@ func (_c *APIKeyCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIKey.updated_at"`)}
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		return &ValidationError{Name: "rpm_limit", err: errors.New(`ent: missing required field "APIKey.rpm_limit"`)}
+	}
+	if v, ok := _c.mutation.RpmLimit(); ok {
+		if err := apikey.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.rpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		return &ValidationError{Name: "input_tpm_limit", err: errors.New(`ent: missing required field "APIKey.input_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.InputTpmLimit(); ok {
+		if err := apikey.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.input_tpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		return &ValidationError{Name: "output_tpm_limit", err: errors.New(`ent: missing required field "APIKey.output_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.OutputTpmLimit(); ok {
+		if err := apikey.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.output_tpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		return &ValidationError{Name: "response_cache_enabled", err: errors.New(`ent: missing required field "APIKey.response_cache_enabled"`)}
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		return &ValidationError{Name: "response_cache_ttl_seconds", err: errors.New(`ent: missing required field "APIKey.response_cache_ttl_seconds"`)}
+	}
+	if v, ok := _c.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := apikey.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "APIKey.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "APIKey.user_id"`)}
 	}
@@ -243,6 +518,15 @@ func (_c *APIKeyCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.QuotaUsedUsd(); !ok {
+		return &ValidationError{Name: "quota_used_usd", err: errors.New(`ent: missing required field "APIKey.quota_used_usd"`)}
+	}
+	if _, ok := _c.mutation.DailyUsageUsd(); !ok {
+		return &ValidationError{Name: "daily_usage_usd", err: errors.New(`ent: missing required field "APIKey.daily_usage_usd"`)}
+	}
+	if _, ok := _c.mutation.MonthlyUsageUsd(); !ok {
+		return &ValidationError{Name: "monthly_usage_usd", err: errors.New(`ent: missing required field "APIKey.monthly_usage_usd"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "APIKey.user"`)}
 	}
@@ -285,6 +569,26 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := _c.mutation.RpmLimit(); ok {
+		_spec.SetField(apikey.FieldRpmLimit, field.TypeInt, value)
+		_node.RpmLimit = value
+	}
+	if value, ok := _c.mutation.InputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldInputTpmLimit, field.TypeInt, value)
+		_node.InputTpmLimit = value
+	}
+	if value, ok := _c.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldOutputTpmLimit, field.TypeInt, value)
+		_node.OutputTpmLimit = value
+	}
+	if value, ok := _c.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(apikey.FieldResponseCacheEnabled, field.TypeBool, value)
+		_node.ResponseCacheEnabled = value
+	}
+	if value, ok := _c.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(apikey.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+		_node.ResponseCacheTTLSeconds = value
+	}
 	if value, ok := _c.mutation.Key(); ok {
 		_spec.SetField(apikey.FieldKey, field.TypeString, value)
 		_node.Key = value
@@ -305,6 +609,50 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldIPBlacklist, field.TypeJSON, value)
 		_node.IPBlacklist = value
 	}
+	if value, ok := _c.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+		_node.AllowedModels = value
+	}
+	if value, ok := _c.mutation.ModelAliases(); ok {
+		_spec.SetField(apikey.FieldModelAliases, field.TypeJSON, value)
+		_node.ModelAliases = value
+	}
+	if value, ok := _c.mutation.QuotaUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsd, field.TypeFloat64, value)
+		_node.QuotaUsd = &value
+	}
+	if value, ok := _c.mutation.DailyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldDailyLimitUsd, field.TypeFloat64, value)
+		_node.DailyLimitUsd = &value
+	}
+	if value, ok := _c.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+		_node.MonthlyLimitUsd = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.QuotaUsedUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsedUsd, field.TypeFloat64, value)
+		_node.QuotaUsedUsd = value
+	}
+	if value, ok := _c.mutation.DailyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldDailyUsageUsd, field.TypeFloat64, value)
+		_node.DailyUsageUsd = value
+	}
+	if value, ok := _c.mutation.MonthlyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyUsageUsd, field.TypeFloat64, value)
+		_node.MonthlyUsageUsd = value
+	}
+	if value, ok := _c.mutation.DailyWindowStart(); ok {
+		_spec.SetField(apikey.FieldDailyWindowStart, field.TypeTime, value)
+		_node.DailyWindowStart = &value
+	}
+	if value, ok := _c.mutation.MonthlyWindowStart(); ok {
+		_spec.SetField(apikey.FieldMonthlyWindowStart, field.TypeTime, value)
+		_node.MonthlyWindowStart = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -437,6 +785,90 @@ func (u *APIKeyUpsert) ClearDeletedAt() *APIKeyUpsert {
 	return u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *APIKeyUpsert) SetRpmLimit(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldRpmLimit, v)
+	return u
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateRpmLimit() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldRpmLimit)
+	return u
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *APIKeyUpsert) AddRpmLimit(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldRpmLimit, v)
+	return u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *APIKeyUpsert) SetInputTpmLimit(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldInputTpmLimit, v)
+	return u
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateInputTpmLimit() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldInputTpmLimit)
+	return u
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *APIKeyUpsert) AddInputTpmLimit(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldInputTpmLimit, v)
+	return u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *APIKeyUpsert) SetOutputTpmLimit(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldOutputTpmLimit, v)
+	return u
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateOutputTpmLimit() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldOutputTpmLimit)
+	return u
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *APIKeyUpsert) AddOutputTpmLimit(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldOutputTpmLimit, v)
+	return u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *APIKeyUpsert) SetResponseCacheEnabled(v bool) *APIKeyUpsert {
+	u.Set(apikey.FieldResponseCacheEnabled, v)
+	return u
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateResponseCacheEnabled() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldResponseCacheEnabled)
+	return u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsert) SetResponseCacheTTLSeconds(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateResponseCacheTTLSeconds() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldResponseCacheTTLSeconds)
+	return u
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsert) AddResponseCacheTTLSeconds(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *APIKeyUpsert) SetUserID(v int64) *APIKeyUpsert {
 	u.Set(apikey.FieldUserID, v)
@@ -539,202 +971,768 @@ func (u *APIKeyUpsert) ClearIPBlacklist() *APIKeyUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create.
-// Using this option is equivalent to using:
-//
-//	client.APIKey.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//		).
-//		Exec(ctx)
-func (u *APIKeyUpsertOne) UpdateNewValues() *APIKeyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.CreatedAt(); exists {
-			s.SetIgnore(apikey.FieldCreatedAt)
-		}
-	}))
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsert) SetAllowedModels(v []string) *APIKeyUpsert {
+	u.Set(apikey.FieldAllowedModels, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.APIKey.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *APIKeyUpsertOne) Ignore() *APIKeyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateAllowedModels() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldAllowedModels)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *APIKeyUpsertOne) DoNothing() *APIKeyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsert) ClearAllowedModels() *APIKeyUpsert {
+	u.SetNull(apikey.FieldAllowedModels)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the APIKeyCreate.OnConflict
-// documentation for more info.
-func (u *APIKeyUpsertOne) Update(set func(*APIKeyUpsert)) *APIKeyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&APIKeyUpsert{UpdateSet: update})
-	}))
+// SetModelAliases sets the "model_aliases" field.
+func (u *APIKeyUpsert) SetModelAliases(v map[string]string) *APIKeyUpsert {
+	u.Set(apikey.FieldModelAliases, v)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *APIKeyUpsertOne) SetUpdatedAt(v time.Time) *APIKeyUpsertOne {
-	return u.Update(func(s *APIKeyUpsert) {
-		s.SetUpdatedAt(v)
-	})
+// UpdateModelAliases sets the "model_aliases" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateModelAliases() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldModelAliases)
+	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateUpdatedAt() *APIKeyUpsertOne {
-	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateUpdatedAt()
-	})
+// ClearModelAliases clears the value of the "model_aliases" field.
+func (u *APIKeyUpsert) ClearModelAliases() *APIKeyUpsert {
+	u.SetNull(apikey.FieldModelAliases)
+	return u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *APIKeyUpsertOne) SetDeletedAt(v time.Time) *APIKeyUpsertOne {
-	return u.Update(func(s *APIKeyUpsert) {
-		s.SetDeletedAt(v)
-	})
+// SetQuotaUsd sets the "quota_usd" field.
+func (u *APIKeyUpsert) SetQuotaUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldQuotaUsd, v)
+	return u
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateDeletedAt() *APIKeyUpsertOne {
-	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateDeletedAt()
-	})
+// UpdateQuotaUsd sets the "quota_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateQuotaUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldQuotaUsd)
+	return u
+}
+
+// AddQuotaUsd adds v to the "quota_usd" field.
+func (u *APIKeyUpsert) AddQuotaUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldQuotaUsd, v)
+	return u
+}
+
+// ClearQuotaUsd clears the value of the "quota_usd" field.
+func (u *APIKeyUpsert) ClearQuotaUsd() *APIKeyUpsert {
+	u.SetNull(apikey.FieldQuotaUsd)
+	return u
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (u *APIKeyUpsert) SetDailyLimitUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldDailyLimitUsd, v)
+	return u
+}
+
+// UpdateDailyLimitUsd sets the "daily_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateDailyLimitUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldDailyLimitUsd)
+	return u
+}
+
+// AddDailyLimitUsd adds v to the "daily_limit_usd" field.
+func (u *APIKeyUpsert) AddDailyLimitUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldDailyLimitUsd, v)
+	return u
+}
+
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (u *APIKeyUpsert) ClearDailyLimitUsd() *APIKeyUpsert {
+	u.SetNull(apikey.FieldDailyLimitUsd)
+	return u
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *APIKeyUpsert) SetMonthlyLimitUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldMonthlyLimitUsd, v)
+	return u
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateMonthlyLimitUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldMonthlyLimitUsd)
+	return u
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *APIKeyUpsert) AddMonthlyLimitUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldMonthlyLimitUsd, v)
+	return u
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (u *APIKeyUpsert) ClearMonthlyLimitUsd() *APIKeyUpsert {
+	u.SetNull(apikey.FieldMonthlyLimitUsd)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsert) SetExpiresAt(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateExpiresAt() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsert) ClearExpiresAt() *APIKeyUpsert {
+	u.SetNull(apikey.FieldExpiresAt)
+	return u
+}
+
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (u *APIKeyUpsert) SetQuotaUsedUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldQuotaUsedUsd, v)
+	return u
+}
+
+// UpdateQuotaUsedUsd sets the "quota_used_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateQuotaUsedUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldQuotaUsedUsd)
+	return u
+}
+
+// AddQuotaUsedUsd adds v to the "quota_used_usd" field.
+func (u *APIKeyUpsert) AddQuotaUsedUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldQuotaUsedUsd, v)
+	return u
+}
+
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (u *APIKeyUpsert) SetDailyUsageUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldDailyUsageUsd, v)
+	return u
+}
+
+// UpdateDailyUsageUsd sets the "daily_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateDailyUsageUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldDailyUsageUsd)
+	return u
+}
+
+// AddDailyUsageUsd adds v to the "daily_usage_usd" field.
+func (u *APIKeyUpsert) AddDailyUsageUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldDailyUsageUsd, v)
+	return u
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (u *APIKeyUpsert) SetMonthlyUsageUsd(v float64) *APIKeyUpsert {
+	u.Set(apikey.FieldMonthlyUsageUsd, v)
+	return u
+}
+
+// UpdateMonthlyUsageUsd sets the "monthly_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateMonthlyUsageUsd() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldMonthlyUsageUsd)
+	return u
+}
+
+// AddMonthlyUsageUsd adds v to the "monthly_usage_usd" field.
+func (u *APIKeyUpsert) AddMonthlyUsageUsd(v float64) *APIKeyUpsert {
+	u.Add(apikey.FieldMonthlyUsageUsd, v)
+	return u
+}
+
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (u *APIKeyUpsert) SetDailyWindowStart(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldDailyWindowStart, v)
+	return u
+}
+
+// UpdateDailyWindowStart sets the "daily_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateDailyWindowStart() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldDailyWindowStart)
+	return u
+}
+
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (u *APIKeyUpsert) ClearDailyWindowStart() *APIKeyUpsert {
+	u.SetNull(apikey.FieldDailyWindowStart)
+	return u
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (u *APIKeyUpsert) SetMonthlyWindowStart(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldMonthlyWindowStart, v)
+	return u
+}
+
+// UpdateMonthlyWindowStart sets the "monthly_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateMonthlyWindowStart() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldMonthlyWindowStart)
+	return u
+}
+
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (u *APIKeyUpsert) ClearMonthlyWindowStart() *APIKeyUpsert {
+	u.SetNull(apikey.FieldMonthlyWindowStart)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.APIKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *APIKeyUpsertOne) UpdateNewValues() *APIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apikey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIKeyUpsertOne) Ignore() *APIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIKeyUpsertOne) DoNothing() *APIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIKeyCreate.OnConflict
+// documentation for more info.
+func (u *APIKeyUpsertOne) Update(set func(*APIKeyUpsert)) *APIKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *APIKeyUpsertOne) SetUpdatedAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateUpdatedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *APIKeyUpsertOne) SetDeletedAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateDeletedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *APIKeyUpsertOne) ClearDeletedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *APIKeyUpsertOne) SetRpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *APIKeyUpsertOne) AddRpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateRpmLimit() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *APIKeyUpsertOne) SetInputTpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *APIKeyUpsertOne) AddInputTpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateInputTpmLimit() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *APIKeyUpsertOne) SetOutputTpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *APIKeyUpsertOne) AddOutputTpmLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateOutputTpmLimit() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *APIKeyUpsertOne) SetResponseCacheEnabled(v bool) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateResponseCacheEnabled() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsertOne) SetResponseCacheTTLSeconds(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsertOne) AddResponseCacheTTLSeconds(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateResponseCacheTTLSeconds() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *APIKeyUpsertOne) SetUserID(v int64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateUserID() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *APIKeyUpsertOne) SetKey(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateKey() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *APIKeyUpsertOne) SetName(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateName() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *APIKeyUpsertOne) SetGroupID(v int64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateGroupID() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *APIKeyUpsertOne) ClearGroupID() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *APIKeyUpsertOne) SetStatus(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateStatus() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetIPWhitelist sets the "ip_whitelist" field.
+func (u *APIKeyUpsertOne) SetIPWhitelist(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetIPWhitelist(v)
+	})
+}
+
+// UpdateIPWhitelist sets the "ip_whitelist" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateIPWhitelist() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateIPWhitelist()
+	})
+}
+
+// ClearIPWhitelist clears the value of the "ip_whitelist" field.
+func (u *APIKeyUpsertOne) ClearIPWhitelist() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearIPWhitelist()
+	})
+}
+
+// SetIPBlacklist sets the "ip_blacklist" field.
+func (u *APIKeyUpsertOne) SetIPBlacklist(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetIPBlacklist(v)
+	})
+}
+
+// UpdateIPBlacklist sets the "ip_blacklist" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateIPBlacklist() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateIPBlacklist()
+	})
+}
+
+// ClearIPBlacklist clears the value of the "ip_blacklist" field.
+func (u *APIKeyUpsertOne) ClearIPBlacklist() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearIPBlacklist()
+	})
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertOne) SetAllowedModels(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertOne) ClearAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetModelAliases sets the "model_aliases" field.
+func (u *APIKeyUpsertOne) SetModelAliases(v map[string]string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetModelAliases(v)
+	})
+}
+
+// UpdateModelAliases sets the "model_aliases" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateModelAliases() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateModelAliases()
+	})
+}
+
+// ClearModelAliases clears the value of the "model_aliases" field.
+func (u *APIKeyUpsertOne) ClearModelAliases() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearModelAliases()
+	})
+}
+
+// SetQuotaUsd sets the "quota_usd" field.
+func (u *APIKeyUpsertOne) SetQuotaUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetQuotaUsd(v)
+	})
+}
+
+// AddQuotaUsd adds v to the "quota_usd" field.
+func (u *APIKeyUpsertOne) AddQuotaUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddQuotaUsd(v)
+	})
+}
+
+// UpdateQuotaUsd sets the "quota_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateQuotaUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateQuotaUsd()
+	})
+}
+
+// ClearQuotaUsd clears the value of the "quota_usd" field.
+func (u *APIKeyUpsertOne) ClearQuotaUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearQuotaUsd()
+	})
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (u *APIKeyUpsertOne) SetDailyLimitUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyLimitUsd(v)
+	})
+}
+
+// AddDailyLimitUsd adds v to the "daily_limit_usd" field.
+func (u *APIKeyUpsertOne) AddDailyLimitUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddDailyLimitUsd(v)
+	})
+}
+
+// UpdateDailyLimitUsd sets the "daily_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateDailyLimitUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyLimitUsd()
+	})
+}
+
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (u *APIKeyUpsertOne) ClearDailyLimitUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearDailyLimitUsd()
+	})
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *APIKeyUpsertOne) SetMonthlyLimitUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetMonthlyLimitUsd(v)
+	})
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *APIKeyUpsertOne) AddMonthlyLimitUsd(v float64) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddMonthlyLimitUsd(v)
+	})
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateMonthlyLimitUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateMonthlyLimitUsd()
+	})
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (u *APIKeyUpsertOne) ClearMonthlyLimitUsd() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearMonthlyLimitUsd()
+	})
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *APIKeyUpsertOne) ClearDeletedAt() *APIKeyUpsertOne {
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsertOne) SetExpiresAt(v time.Time) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.ClearDeletedAt()
+		s.SetExpiresAt(v)
 	})
 }
 
-// SetUserID sets the "user_id" field.
-func (u *APIKeyUpsertOne) SetUserID(v int64) *APIKeyUpsertOne {
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateExpiresAt() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetUserID(v)
+		s.UpdateExpiresAt()
 	})
 }
 
-// UpdateUserID sets the "user_id" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateUserID() *APIKeyUpsertOne {
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsertOne) ClearExpiresAt() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateUserID()
+		s.ClearExpiresAt()
 	})
 }
 
-// SetKey sets the "key" field.
-func (u *APIKeyUpsertOne) SetKey(v string) *APIKeyUpsertOne {
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (u *APIKeyUpsertOne) SetQuotaUsedUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetKey(v)
+		s.SetQuotaUsedUsd(v)
 	})
 }
 
-// UpdateKey sets the "key" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateKey() *APIKeyUpsertOne {
+// AddQuotaUsedUsd adds v to the "quota_used_usd" field.
+func (u *APIKeyUpsertOne) AddQuotaUsedUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateKey()
+		s.AddQuotaUsedUsd(v)
 	})
 }
 
-// SetName sets the "name" field.
-func (u *APIKeyUpsertOne) SetName(v string) *APIKeyUpsertOne {
+// UpdateQuotaUsedUsd sets the "quota_used_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateQuotaUsedUsd() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetName(v)
+		s.UpdateQuotaUsedUsd()
 	})
 }
 
-// UpdateName sets the "name" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateName() *APIKeyUpsertOne {
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (u *APIKeyUpsertOne) SetDailyUsageUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateName()
+		s.SetDailyUsageUsd(v)
 	})
 }
 
-// SetGroupID sets the "group_id" field.
-func (u *APIKeyUpsertOne) SetGroupID(v int64) *APIKeyUpsertOne {
+// AddDailyUsageUsd adds v to the "daily_usage_usd" field.
+func (u *APIKeyUpsertOne) AddDailyUsageUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetGroupID(v)
+		s.AddDailyUsageUsd(v)
 	})
 }
 
-// UpdateGroupID sets the "group_id" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateGroupID() *APIKeyUpsertOne {
+// UpdateDailyUsageUsd sets the "daily_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateDailyUsageUsd() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateGroupID()
+		s.UpdateDailyUsageUsd()
 	})
 }
 
-// ClearGroupID clears the value of the "group_id" field.
-func (u *APIKeyUpsertOne) ClearGroupID() *APIKeyUpsertOne {
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (u *APIKeyUpsertOne) SetMonthlyUsageUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.ClearGroupID()
+		s.SetMonthlyUsageUsd(v)
 	})
 }
 
-// SetStatus sets the "status" field.
-func (u *APIKeyUpsertOne) SetStatus(v string) *APIKeyUpsertOne {
+// AddMonthlyUsageUsd adds v to the "monthly_usage_usd" field.
+func (u *APIKeyUpsertOne) AddMonthlyUsageUsd(v float64) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetStatus(v)
+		s.AddMonthlyUsageUsd(v)
 	})
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateStatus() *APIKeyUpsertOne {
+// UpdateMonthlyUsageUsd sets the "monthly_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateMonthlyUsageUsd() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateStatus()
+		s.UpdateMonthlyUsageUsd()
 	})
 }
 
-// SetIPWhitelist sets the "ip_whitelist" field.
-func (u *APIKeyUpsertOne) SetIPWhitelist(v []string) *APIKeyUpsertOne {
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (u *APIKeyUpsertOne) SetDailyWindowStart(v time.Time) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetIPWhitelist(v)
+		s.SetDailyWindowStart(v)
 	})
 }
 
-// UpdateIPWhitelist sets the "ip_whitelist" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateIPWhitelist() *APIKeyUpsertOne {
+// UpdateDailyWindowStart sets the "daily_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateDailyWindowStart() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateIPWhitelist()
+		s.UpdateDailyWindowStart()
 	})
 }
 
-// ClearIPWhitelist clears the value of the "ip_whitelist" field.
-func (u *APIKeyUpsertOne) ClearIPWhitelist() *APIKeyUpsertOne {
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (u *APIKeyUpsertOne) ClearDailyWindowStart() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.ClearIPWhitelist()
+		s.ClearDailyWindowStart()
 	})
 }
 
-// SetIPBlacklist sets the "ip_blacklist" field.
-func (u *APIKeyUpsertOne) SetIPBlacklist(v []string) *APIKeyUpsertOne {
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (u *APIKeyUpsertOne) SetMonthlyWindowStart(v time.Time) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.SetIPBlacklist(v)
+		s.SetMonthlyWindowStart(v)
 	})
 }
 
-// UpdateIPBlacklist sets the "ip_blacklist" field to the value that was provided on create.
-func (u *APIKeyUpsertOne) UpdateIPBlacklist() *APIKeyUpsertOne {
+// UpdateMonthlyWindowStart sets the "monthly_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateMonthlyWindowStart() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.UpdateIPBlacklist()
+		s.UpdateMonthlyWindowStart()
 	})
 }
 
-// ClearIPBlacklist clears the value of the "ip_blacklist" field.
-func (u *APIKeyUpsertOne) ClearIPBlacklist() *APIKeyUpsertOne {
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (u *APIKeyUpsertOne) ClearMonthlyWindowStart() *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
-		s.ClearIPBlacklist()
+		s.ClearMonthlyWindowStart()
 	})
 }
 
@@ -984,6 +1982,104 @@ func (u *APIKeyUpsertBulk) ClearDeletedAt() *APIKeyUpsertBulk {
 	})
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *APIKeyUpsertBulk) SetRpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *APIKeyUpsertBulk) AddRpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateRpmLimit() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *APIKeyUpsertBulk) SetInputTpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *APIKeyUpsertBulk) AddInputTpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateInputTpmLimit() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *APIKeyUpsertBulk) SetOutputTpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *APIKeyUpsertBulk) AddOutputTpmLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateOutputTpmLimit() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *APIKeyUpsertBulk) SetResponseCacheEnabled(v bool) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateResponseCacheEnabled() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsertBulk) SetResponseCacheTTLSeconds(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *APIKeyUpsertBulk) AddResponseCacheTTLSeconds(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateResponseCacheTTLSeconds() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *APIKeyUpsertBulk) SetUserID(v int64) *APIKeyUpsertBulk {
 	return u.Update(func(s *APIKeyUpsert) {
@@ -1103,6 +2199,258 @@ func (u *APIKeyUpsertBulk) ClearIPBlacklist() *APIKeyUpsertBulk {
 	})
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertBulk) SetAllowedModels(v []string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertBulk) ClearAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetModelAliases sets the "model_aliases" field.
+func (u *APIKeyUpsertBulk) SetModelAliases(v map[string]string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetModelAliases(v)
+	})
+}
+
+// UpdateModelAliases sets the "model_aliases" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateModelAliases() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateModelAliases()
+	})
+}
+
+// ClearModelAliases clears the value of the "model_aliases" field.
+func (u *APIKeyUpsertBulk) ClearModelAliases() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearModelAliases()
+	})
+}
+
+// SetQuotaUsd sets the "quota_usd" field.
+func (u *APIKeyUpsertBulk) SetQuotaUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetQuotaUsd(v)
+	})
+}
+
+// AddQuotaUsd adds v to the "quota_usd" field.
+func (u *APIKeyUpsertBulk) AddQuotaUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddQuotaUsd(v)
+	})
+}
+
+// UpdateQuotaUsd sets the "quota_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateQuotaUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateQuotaUsd()
+	})
+}
+
+// ClearQuotaUsd clears the value of the "quota_usd" field.
+func (u *APIKeyUpsertBulk) ClearQuotaUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearQuotaUsd()
+	})
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (u *APIKeyUpsertBulk) SetDailyLimitUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyLimitUsd(v)
+	})
+}
+
+// AddDailyLimitUsd adds v to the "daily_limit_usd" field.
+func (u *APIKeyUpsertBulk) AddDailyLimitUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddDailyLimitUsd(v)
+	})
+}
+
+// UpdateDailyLimitUsd sets the "daily_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateDailyLimitUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyLimitUsd()
+	})
+}
+
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (u *APIKeyUpsertBulk) ClearDailyLimitUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearDailyLimitUsd()
+	})
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (u *APIKeyUpsertBulk) SetMonthlyLimitUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetMonthlyLimitUsd(v)
+	})
+}
+
+// AddMonthlyLimitUsd adds v to the "monthly_limit_usd" field.
+func (u *APIKeyUpsertBulk) AddMonthlyLimitUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddMonthlyLimitUsd(v)
+	})
+}
+
+// UpdateMonthlyLimitUsd sets the "monthly_limit_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateMonthlyLimitUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateMonthlyLimitUsd()
+	})
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (u *APIKeyUpsertBulk) ClearMonthlyLimitUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearMonthlyLimitUsd()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsertBulk) SetExpiresAt(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsertBulk) ClearExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (u *APIKeyUpsertBulk) SetQuotaUsedUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetQuotaUsedUsd(v)
+	})
+}
+
+// AddQuotaUsedUsd adds v to the "quota_used_usd" field.
+func (u *APIKeyUpsertBulk) AddQuotaUsedUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddQuotaUsedUsd(v)
+	})
+}
+
+// UpdateQuotaUsedUsd sets the "quota_used_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateQuotaUsedUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateQuotaUsedUsd()
+	})
+}
+
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (u *APIKeyUpsertBulk) SetDailyUsageUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyUsageUsd(v)
+	})
+}
+
+// AddDailyUsageUsd adds v to the "daily_usage_usd" field.
+func (u *APIKeyUpsertBulk) AddDailyUsageUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddDailyUsageUsd(v)
+	})
+}
+
+// UpdateDailyUsageUsd sets the "daily_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateDailyUsageUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyUsageUsd()
+	})
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (u *APIKeyUpsertBulk) SetMonthlyUsageUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetMonthlyUsageUsd(v)
+	})
+}
+
+// AddMonthlyUsageUsd adds v to the "monthly_usage_usd" field.
+func (u *APIKeyUpsertBulk) AddMonthlyUsageUsd(v float64) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddMonthlyUsageUsd(v)
+	})
+}
+
+// UpdateMonthlyUsageUsd sets the "monthly_usage_usd" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateMonthlyUsageUsd() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateMonthlyUsageUsd()
+	})
+}
+
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (u *APIKeyUpsertBulk) SetDailyWindowStart(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyWindowStart(v)
+	})
+}
+
+// UpdateDailyWindowStart sets the "daily_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateDailyWindowStart() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyWindowStart()
+	})
+}
+
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (u *APIKeyUpsertBulk) ClearDailyWindowStart() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearDailyWindowStart()
+	})
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (u *APIKeyUpsertBulk) SetMonthlyWindowStart(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetMonthlyWindowStart(v)
+	})
+}
+
+// UpdateMonthlyWindowStart sets the "monthly_window_start" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateMonthlyWindowStart() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateMonthlyWindowStart()
+	})
+}
+
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (u *APIKeyUpsertBulk) ClearMonthlyWindowStart() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearMonthlyWindowStart()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -58,6 +58,104 @@ func (_u *APIKeyUpdate) ClearDeletedAt() *APIKeyUpdate {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *APIKeyUpdate) SetRpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRpmLimit(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *APIKeyUpdate) AddRpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *APIKeyUpdate) SetInputTpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableInputTpmLimit(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *APIKeyUpdate) AddInputTpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *APIKeyUpdate) SetOutputTpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableOutputTpmLimit(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *APIKeyUpdate) AddOutputTpmLimit(v int) *APIKeyUpdate {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *APIKeyUpdate) SetResponseCacheEnabled(v bool) *APIKeyUpdate {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableResponseCacheEnabled(v *bool) *APIKeyUpdate {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *APIKeyUpdate) SetResponseCacheTTLSeconds(v int) *APIKeyUpdate {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableResponseCacheTTLSeconds(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *APIKeyUpdate) AddResponseCacheTTLSeconds(v int) *APIKeyUpdate {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *APIKeyUpdate) SetUserID(v int64) *APIKeyUpdate {
 	_u.mutation.SetUserID(v)
@@ -170,6 +268,240 @@ func (_u *APIKeyUpdate) ClearIPBlacklist() *APIKeyUpdate {
 	return _u
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_u *APIKeyUpdate) SetAllowedModels(v []string) *APIKeyUpdate {
+	_u.mutation.SetAllowedModels(v)
+	return _u
+}
+
+// AppendAllowedModels appends value to the "allowed_models" field.
+func (_u *APIKeyUpdate) AppendAllowedModels(v []string) *APIKeyUpdate {
+	_u.mutation.AppendAllowedModels(v)
+	return _u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (_u *APIKeyUpdate) ClearAllowedModels() *APIKeyUpdate {
+	_u.mutation.ClearAllowedModels()
+	return _u
+}
+
+// SetModelAliases sets the "model_aliases" field.
+func (_u *APIKeyUpdate) SetModelAliases(v map[string]string) *APIKeyUpdate {
+	_u.mutation.SetModelAliases(v)
+	return _u
+}
+
+// ClearModelAliases clears the value of the "model_aliases" field.
+func (_u *APIKeyUpdate) ClearModelAliases() *APIKeyUpdate {
+	_u.mutation.ClearModelAliases()
+	return _u
+}
+
+// SetQuotaUsd sets the "quota_usd" field.
+func (_u *APIKeyUpdate) SetQuotaUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetQuotaUsd()
+	_u.mutation.SetQuotaUsd(v)
+	return _u
+}
+
+// SetNillableQuotaUsd sets the "quota_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableQuotaUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetQuotaUsd(*v)
+	}
+	return _u
+}
+
+// AddQuotaUsd adds value to the "quota_usd" field.
+func (_u *APIKeyUpdate) AddQuotaUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddQuotaUsd(v)
+	return _u
+}
+
+// ClearQuotaUsd clears the value of the "quota_usd" field.
+func (_u *APIKeyUpdate) ClearQuotaUsd() *APIKeyUpdate {
+	_u.mutation.ClearQuotaUsd()
+	return _u
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (_u *APIKeyUpdate) SetDailyLimitUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetDailyLimitUsd()
+	_u.mutation.SetDailyLimitUsd(v)
+	return _u
+}
+
+// SetNillableDailyLimitUsd sets the "daily_limit_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableDailyLimitUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetDailyLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddDailyLimitUsd adds value to the "daily_limit_usd" field.
+func (_u *APIKeyUpdate) AddDailyLimitUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddDailyLimitUsd(v)
+	return _u
+}
+
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (_u *APIKeyUpdate) ClearDailyLimitUsd() *APIKeyUpdate {
+	_u.mutation.ClearDailyLimitUsd()
+	return _u
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (_u *APIKeyUpdate) SetMonthlyLimitUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetMonthlyLimitUsd()
+	_u.mutation.SetMonthlyLimitUsd(v)
+	return _u
+}
+
+// SetNillableMonthlyLimitUsd sets the "monthly_limit_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableMonthlyLimitUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetMonthlyLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddMonthlyLimitUsd adds value to the "monthly_limit_usd" field.
+func (_u *APIKeyUpdate) AddMonthlyLimitUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddMonthlyLimitUsd(v)
+	return _u
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (_u *APIKeyUpdate) ClearMonthlyLimitUsd() *APIKeyUpdate {
+	_u.mutation.ClearMonthlyLimitUsd()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *APIKeyUpdate) SetExpiresAt(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableExpiresAt(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *APIKeyUpdate) ClearExpiresAt() *APIKeyUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (_u *APIKeyUpdate) SetQuotaUsedUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetQuotaUsedUsd()
+	_u.mutation.SetQuotaUsedUsd(v)
+	return _u
+}
+
+// SetNillableQuotaUsedUsd sets the "quota_used_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableQuotaUsedUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetQuotaUsedUsd(*v)
+	}
+	return _u
+}
+
+// AddQuotaUsedUsd adds value to the "quota_used_usd" field.
+func (_u *APIKeyUpdate) AddQuotaUsedUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddQuotaUsedUsd(v)
+	return _u
+}
+
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (_u *APIKeyUpdate) SetDailyUsageUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetDailyUsageUsd()
+	_u.mutation.SetDailyUsageUsd(v)
+	return _u
+}
+
+// SetNillableDailyUsageUsd sets the "daily_usage_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableDailyUsageUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetDailyUsageUsd(*v)
+	}
+	return _u
+}
+
+// AddDailyUsageUsd adds value to the "daily_usage_usd" field.
+func (_u *APIKeyUpdate) AddDailyUsageUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddDailyUsageUsd(v)
+	return _u
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (_u *APIKeyUpdate) SetMonthlyUsageUsd(v float64) *APIKeyUpdate {
+	_u.mutation.ResetMonthlyUsageUsd()
+	_u.mutation.SetMonthlyUsageUsd(v)
+	return _u
+}
+
+// SetNillableMonthlyUsageUsd sets the "monthly_usage_usd" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableMonthlyUsageUsd(v *float64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetMonthlyUsageUsd(*v)
+	}
+	return _u
+}
+
+// AddMonthlyUsageUsd adds value to the "monthly_usage_usd" field.
+func (_u *APIKeyUpdate) AddMonthlyUsageUsd(v float64) *APIKeyUpdate {
+	_u.mutation.AddMonthlyUsageUsd(v)
+	return _u
+}
+
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (_u *APIKeyUpdate) SetDailyWindowStart(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetDailyWindowStart(v)
+	return _u
+}
+
+// SetNillableDailyWindowStart sets the "daily_window_start" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableDailyWindowStart(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetDailyWindowStart(*v)
+	}
+	return _u
+}
+
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (_u *APIKeyUpdate) ClearDailyWindowStart() *APIKeyUpdate {
+	_u.mutation.ClearDailyWindowStart()
+	return _u
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (_u *APIKeyUpdate) SetMonthlyWindowStart(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetMonthlyWindowStart(v)
+	return _u
+}
+
+// SetNillableMonthlyWindowStart sets the "monthly_window_start" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableMonthlyWindowStart(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetMonthlyWindowStart(*v)
+	}
+	return _u
+}
+
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (_u *APIKeyUpdate) ClearMonthlyWindowStart() *APIKeyUpdate {
+	_u.mutation.ClearMonthlyWindowStart()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdate) SetUser(v *User) *APIKeyUpdate {
 	return _u.SetUserID(v.ID)
@@ -277,6 +609,26 @@ func (_u *APIKeyUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *APIKeyUpdate) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := apikey.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := apikey.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := apikey.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.output_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := apikey.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "APIKey.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Key(); ok {
 		if err := apikey.KeyValidator(v); err != nil {
 			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "APIKey.key": %w`, err)}
@@ -319,6 +671,33 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(apikey.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(apikey.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(apikey.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(apikey.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(apikey.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(apikey.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(apikey.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Key(); ok {
 		_spec.SetField(apikey.FieldKey, field.TypeString, value)
 	}
@@ -350,6 +729,86 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.IPBlacklistCleared() {
 		_spec.ClearField(apikey.FieldIPBlacklist, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if _u.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelAliases(); ok {
+		_spec.SetField(apikey.FieldModelAliases, field.TypeJSON, value)
+	}
+	if _u.mutation.ModelAliasesCleared() {
+		_spec.ClearField(apikey.FieldModelAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuotaUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaUsd(); ok {
+		_spec.AddField(apikey.FieldQuotaUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.QuotaUsdCleared() {
+		_spec.ClearField(apikey.FieldQuotaUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DailyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldDailyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDailyLimitUsd(); ok {
+		_spec.AddField(apikey.FieldDailyLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.DailyLimitUsdCleared() {
+		_spec.ClearField(apikey.FieldDailyLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyLimitUsd(); ok {
+		_spec.AddField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthlyLimitUsdCleared() {
+		_spec.ClearField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaUsedUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaUsedUsd(); ok {
+		_spec.AddField(apikey.FieldQuotaUsedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DailyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldDailyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDailyUsageUsd(); ok {
+		_spec.AddField(apikey.FieldDailyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthlyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyUsageUsd(); ok {
+		_spec.AddField(apikey.FieldMonthlyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DailyWindowStart(); ok {
+		_spec.SetField(apikey.FieldDailyWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.DailyWindowStartCleared() {
+		_spec.ClearField(apikey.FieldDailyWindowStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MonthlyWindowStart(); ok {
+		_spec.SetField(apikey.FieldMonthlyWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.MonthlyWindowStartCleared() {
+		_spec.ClearField(apikey.FieldMonthlyWindowStart, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -499,6 +958,104 @@ func (_u *APIKeyUpdateOne) ClearDeletedAt() *APIKeyUpdateOne {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *APIKeyUpdateOne) SetRpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRpmLimit(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *APIKeyUpdateOne) AddRpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *APIKeyUpdateOne) SetInputTpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableInputTpmLimit(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *APIKeyUpdateOne) AddInputTpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *APIKeyUpdateOne) SetOutputTpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableOutputTpmLimit(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *APIKeyUpdateOne) AddOutputTpmLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *APIKeyUpdateOne) SetResponseCacheEnabled(v bool) *APIKeyUpdateOne {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableResponseCacheEnabled(v *bool) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *APIKeyUpdateOne) SetResponseCacheTTLSeconds(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableResponseCacheTTLSeconds(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *APIKeyUpdateOne) AddResponseCacheTTLSeconds(v int) *APIKeyUpdateOne {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *APIKeyUpdateOne) SetUserID(v int64) *APIKeyUpdateOne {
 	_u.mutation.SetUserID(v)
@@ -611,6 +1168,240 @@ func (_u *APIKeyUpdateOne) ClearIPBlacklist() *APIKeyUpdateOne {
 	return _u
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_u *APIKeyUpdateOne) SetAllowedModels(v []string) *APIKeyUpdateOne {
+	_u.mutation.SetAllowedModels(v)
+	return _u
+}
+
+// AppendAllowedModels appends value to the "allowed_models" field.
+func (_u *APIKeyUpdateOne) AppendAllowedModels(v []string) *APIKeyUpdateOne {
+	_u.mutation.AppendAllowedModels(v)
+	return _u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (_u *APIKeyUpdateOne) ClearAllowedModels() *APIKeyUpdateOne {
+	_u.mutation.ClearAllowedModels()
+	return _u
+}
+
+// SetModelAliases sets the "model_aliases" field.
+func (_u *APIKeyUpdateOne) SetModelAliases(v map[string]string) *APIKeyUpdateOne {
+	_u.mutation.SetModelAliases(v)
+	return _u
+}
+
+// ClearModelAliases clears the value of the "model_aliases" field.
+func (_u *APIKeyUpdateOne) ClearModelAliases() *APIKeyUpdateOne {
+	_u.mutation.ClearModelAliases()
+	return _u
+}
+
+// SetQuotaUsd sets the "quota_usd" field.
+func (_u *APIKeyUpdateOne) SetQuotaUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetQuotaUsd()
+	_u.mutation.SetQuotaUsd(v)
+	return _u
+}
+
+// SetNillableQuotaUsd sets the "quota_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableQuotaUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetQuotaUsd(*v)
+	}
+	return _u
+}
+
+// AddQuotaUsd adds value to the "quota_usd" field.
+func (_u *APIKeyUpdateOne) AddQuotaUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddQuotaUsd(v)
+	return _u
+}
+
+// ClearQuotaUsd clears the value of the "quota_usd" field.
+func (_u *APIKeyUpdateOne) ClearQuotaUsd() *APIKeyUpdateOne {
+	_u.mutation.ClearQuotaUsd()
+	return _u
+}
+
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (_u *APIKeyUpdateOne) SetDailyLimitUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetDailyLimitUsd()
+	_u.mutation.SetDailyLimitUsd(v)
+	return _u
+}
+
+// SetNillableDailyLimitUsd sets the "daily_limit_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableDailyLimitUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetDailyLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddDailyLimitUsd adds value to the "daily_limit_usd" field.
+func (_u *APIKeyUpdateOne) AddDailyLimitUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddDailyLimitUsd(v)
+	return _u
+}
+
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (_u *APIKeyUpdateOne) ClearDailyLimitUsd() *APIKeyUpdateOne {
+	_u.mutation.ClearDailyLimitUsd()
+	return _u
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (_u *APIKeyUpdateOne) SetMonthlyLimitUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetMonthlyLimitUsd()
+	_u.mutation.SetMonthlyLimitUsd(v)
+	return _u
+}
+
+// SetNillableMonthlyLimitUsd sets the "monthly_limit_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableMonthlyLimitUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetMonthlyLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddMonthlyLimitUsd adds value to the "monthly_limit_usd" field.
+func (_u *APIKeyUpdateOne) AddMonthlyLimitUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddMonthlyLimitUsd(v)
+	return _u
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (_u *APIKeyUpdateOne) ClearMonthlyLimitUsd() *APIKeyUpdateOne {
+	_u.mutation.ClearMonthlyLimitUsd()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *APIKeyUpdateOne) SetExpiresAt(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableExpiresAt(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *APIKeyUpdateOne) ClearExpiresAt() *APIKeyUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetQuotaUsedUsd sets the "quota_used_usd" field.
+func (_u *APIKeyUpdateOne) SetQuotaUsedUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetQuotaUsedUsd()
+	_u.mutation.SetQuotaUsedUsd(v)
+	return _u
+}
+
+// SetNillableQuotaUsedUsd sets the "quota_used_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableQuotaUsedUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetQuotaUsedUsd(*v)
+	}
+	return _u
+}
+
+// AddQuotaUsedUsd adds value to the "quota_used_usd" field.
+func (_u *APIKeyUpdateOne) AddQuotaUsedUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddQuotaUsedUsd(v)
+	return _u
+}
+
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (_u *APIKeyUpdateOne) SetDailyUsageUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetDailyUsageUsd()
+	_u.mutation.SetDailyUsageUsd(v)
+	return _u
+}
+
+// SetNillableDailyUsageUsd sets the "daily_usage_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableDailyUsageUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetDailyUsageUsd(*v)
+	}
+	return _u
+}
+
+// AddDailyUsageUsd adds value to the "daily_usage_usd" field.
+func (_u *APIKeyUpdateOne) AddDailyUsageUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddDailyUsageUsd(v)
+	return _u
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (_u *APIKeyUpdateOne) SetMonthlyUsageUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.ResetMonthlyUsageUsd()
+	_u.mutation.SetMonthlyUsageUsd(v)
+	return _u
+}
+
+// SetNillableMonthlyUsageUsd sets the "monthly_usage_usd" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableMonthlyUsageUsd(v *float64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetMonthlyUsageUsd(*v)
+	}
+	return _u
+}
+
+// AddMonthlyUsageUsd adds value to the "monthly_usage_usd" field.
+func (_u *APIKeyUpdateOne) AddMonthlyUsageUsd(v float64) *APIKeyUpdateOne {
+	_u.mutation.AddMonthlyUsageUsd(v)
+	return _u
+}
+
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (_u *APIKeyUpdateOne) SetDailyWindowStart(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetDailyWindowStart(v)
+	return _u
+}
+
+// SetNillableDailyWindowStart sets the "daily_window_start" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableDailyWindowStart(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetDailyWindowStart(*v)
+	}
+	return _u
+}
+
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (_u *APIKeyUpdateOne) ClearDailyWindowStart() *APIKeyUpdateOne {
+	_u.mutation.ClearDailyWindowStart()
+	return _u
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (_u *APIKeyUpdateOne) SetMonthlyWindowStart(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetMonthlyWindowStart(v)
+	return _u
+}
+
+// SetNillableMonthlyWindowStart sets the "monthly_window_start" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableMonthlyWindowStart(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetMonthlyWindowStart(*v)
+	}
+	return _u
+}
+
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (_u *APIKeyUpdateOne) ClearMonthlyWindowStart() *APIKeyUpdateOne {
+	_u.mutation.ClearMonthlyWindowStart()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdateOne) SetUser(v *User) *APIKeyUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -731,6 +1522,26 @@ func (_u *APIKeyUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *APIKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := apikey.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := apikey.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := apikey.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.output_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := apikey.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "APIKey.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Key(); ok {
 		if err := apikey.KeyValidator(v); err != nil {
 			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "APIKey.key": %w`, err)}
@@ -790,6 +1601,33 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(apikey.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(apikey.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(apikey.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(apikey.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(apikey.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(apikey.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(apikey.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(apikey.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Key(); ok {
 		_spec.SetField(apikey.FieldKey, field.TypeString, value)
 	}
@@ -821,6 +1659,86 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 	if _u.mutation.IPBlacklistCleared() {
 		_spec.ClearField(apikey.FieldIPBlacklist, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if _u.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelAliases(); ok {
+		_spec.SetField(apikey.FieldModelAliases, field.TypeJSON, value)
+	}
+	if _u.mutation.ModelAliasesCleared() {
+		_spec.ClearField(apikey.FieldModelAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuotaUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaUsd(); ok {
+		_spec.AddField(apikey.FieldQuotaUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.QuotaUsdCleared() {
+		_spec.ClearField(apikey.FieldQuotaUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DailyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldDailyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDailyLimitUsd(); ok {
+		_spec.AddField(apikey.FieldDailyLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.DailyLimitUsdCleared() {
+		_spec.ClearField(apikey.FieldDailyLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MonthlyLimitUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyLimitUsd(); ok {
+		_spec.AddField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthlyLimitUsdCleared() {
+		_spec.ClearField(apikey.FieldMonthlyLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaUsedUsd(); ok {
+		_spec.SetField(apikey.FieldQuotaUsedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaUsedUsd(); ok {
+		_spec.AddField(apikey.FieldQuotaUsedUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DailyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldDailyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDailyUsageUsd(); ok {
+		_spec.AddField(apikey.FieldDailyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MonthlyUsageUsd(); ok {
+		_spec.SetField(apikey.FieldMonthlyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyUsageUsd(); ok {
+		_spec.AddField(apikey.FieldMonthlyUsageUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DailyWindowStart(); ok {
+		_spec.SetField(apikey.FieldDailyWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.DailyWindowStartCleared() {
+		_spec.ClearField(apikey.FieldDailyWindowStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MonthlyWindowStart(); ok {
+		_spec.SetField(apikey.FieldMonthlyWindowStart, field.TypeTime, value)
+	}
+	if _u.mutation.MonthlyWindowStartCleared() {
+		_spec.ClearField(apikey.FieldMonthlyWindowStart, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,622 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/balancetransaction"
+)
+
+// BalanceTransactionCreate is the builder for creating a BalanceTransaction entity.
+type BalanceTransactionCreate struct {
+	config
+	mutation *BalanceTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BalanceTransactionCreate) SetUserID(v int64) *BalanceTransactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BalanceTransactionCreate) SetAmount(v float64) *BalanceTransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_c *BalanceTransactionCreate) SetBalanceAfter(v float64) *BalanceTransactionCreate {
+	_c.mutation.SetBalanceAfter(v)
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *BalanceTransactionCreate) SetSourceType(v string) *BalanceTransactionCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *BalanceTransactionCreate) SetReferenceID(v string) *BalanceTransactionCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *BalanceTransactionCreate) SetNillableReferenceID(v *string) *BalanceTransactionCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (_c *BalanceTransactionCreate) SetActorUserID(v int64) *BalanceTransactionCreate {
+	_c.mutation.SetActorUserID(v)
+	return _c
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (_c *BalanceTransactionCreate) SetNillableActorUserID(v *int64) *BalanceTransactionCreate {
+	if v != nil {
+		_c.SetActorUserID(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *BalanceTransactionCreate) SetNotes(v string) *BalanceTransactionCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *BalanceTransactionCreate) SetNillableNotes(v *string) *BalanceTransactionCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BalanceTransactionCreate) SetCreatedAt(v time.Time) *BalanceTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BalanceTransactionCreate) SetNillableCreatedAt(v *time.Time) *BalanceTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BalanceTransactionMutation object of the builder.
+func (_c *BalanceTransactionCreate) Mutation() *BalanceTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the BalanceTransaction in the database.
+func (_c *BalanceTransactionCreate) Save(ctx context.Context) (*BalanceTransaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BalanceTransactionCreate) SaveX(ctx context.Context) *BalanceTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BalanceTransactionCreate) defaults() {
+	if _, ok := _c.mutation.ReferenceID(); !ok {
+		v := balancetransaction.DefaultReferenceID
+		_c.mutation.SetReferenceID(v)
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		v := balancetransaction.DefaultNotes
+		_c.mutation.SetNotes(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := balancetransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BalanceTransactionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BalanceTransaction.user_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BalanceTransaction.amount"`)}
+	}
+	if _, ok := _c.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "BalanceTransaction.balance_after"`)}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "BalanceTransaction.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := balancetransaction.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "BalanceTransaction.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReferenceID(); !ok {
+		return &ValidationError{Name: "reference_id", err: errors.New(`ent: missing required field "BalanceTransaction.reference_id"`)}
+	}
+	if v, ok := _c.mutation.ReferenceID(); ok {
+		if err := balancetransaction.ReferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`ent: validator failed for field "BalanceTransaction.reference_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		return &ValidationError{Name: "notes", err: errors.New(`ent: missing required field "BalanceTransaction.notes"`)}
+	}
+	if v, ok := _c.mutation.Notes(); ok {
+		if err := balancetransaction.NotesValidator(v); err != nil {
+			return &ValidationError{Name: "notes", err: fmt.Errorf(`ent: validator failed for field "BalanceTransaction.notes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceTransaction.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BalanceTransactionCreate) sqlSave(ctx context.Context) (*BalanceTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BalanceTransactionCreate) createSpec() (*BalanceTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(balancetransaction.Table, sqlgraph.NewFieldSpec(balancetransaction.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(balancetransaction.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(balancetransaction.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.BalanceAfter(); ok {
+		_spec.SetField(balancetransaction.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(balancetransaction.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(balancetransaction.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := _c.mutation.ActorUserID(); ok {
+		_spec.SetField(balancetransaction.FieldActorUserID, field.TypeInt64, value)
+		_node.ActorUserID = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(balancetransaction.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(balancetransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceTransaction.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceTransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceTransactionCreate) OnConflict(opts ...sql.ConflictOption) *BalanceTransactionUpsertOne {
+	_c.conflict = opts
+	return &BalanceTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceTransactionCreate) OnConflictColumns(columns ...string) *BalanceTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BalanceTransactionUpsertOne is the builder for "upsert"-ing
+	//  one BalanceTransaction node.
+	BalanceTransactionUpsertOne struct {
+		create *BalanceTransactionCreate
+	}
+
+	// BalanceTransactionUpsert is the "OnConflict" setter.
+	BalanceTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceTransactionUpsertOne) UpdateNewValues() *BalanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(balancetransaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(balancetransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(balancetransaction.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.SourceType(); exists {
+			s.SetIgnore(balancetransaction.FieldSourceType)
+		}
+		if _, exists := u.create.mutation.ReferenceID(); exists {
+			s.SetIgnore(balancetransaction.FieldReferenceID)
+		}
+		if _, exists := u.create.mutation.ActorUserID(); exists {
+			s.SetIgnore(balancetransaction.FieldActorUserID)
+		}
+		if _, exists := u.create.mutation.Notes(); exists {
+			s.SetIgnore(balancetransaction.FieldNotes)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(balancetransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceTransactionUpsertOne) Ignore() *BalanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceTransactionUpsertOne) DoNothing() *BalanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceTransactionCreate.OnConflict
+// documentation for more info.
+func (u *BalanceTransactionUpsertOne) Update(set func(*BalanceTransactionUpsert)) *BalanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BalanceTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceTransactionUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceTransactionUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BalanceTransactionCreateBulk is the builder for creating many BalanceTransaction entities in bulk.
+type BalanceTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BalanceTransaction entities in the database.
+func (_c *BalanceTransactionCreateBulk) Save(ctx context.Context) ([]*BalanceTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BalanceTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BalanceTransactionCreateBulk) SaveX(ctx context.Context) []*BalanceTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceTransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceTransactionUpsertBulk {
+	_c.conflict = opts
+	return &BalanceTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceTransactionCreateBulk) OnConflictColumns(columns ...string) *BalanceTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// BalanceTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of BalanceTransaction nodes.
+type BalanceTransactionUpsertBulk struct {
+	create *BalanceTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceTransactionUpsertBulk) UpdateNewValues() *BalanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(balancetransaction.FieldUserID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(balancetransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(balancetransaction.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.SourceType(); exists {
+				s.SetIgnore(balancetransaction.FieldSourceType)
+			}
+			if _, exists := b.mutation.ReferenceID(); exists {
+				s.SetIgnore(balancetransaction.FieldReferenceID)
+			}
+			if _, exists := b.mutation.ActorUserID(); exists {
+				s.SetIgnore(balancetransaction.FieldActorUserID)
+			}
+			if _, exists := b.mutation.Notes(); exists {
+				s.SetIgnore(balancetransaction.FieldNotes)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(balancetransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceTransactionUpsertBulk) Ignore() *BalanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceTransactionUpsertBulk) DoNothing() *BalanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceTransactionUpsertBulk) Update(set func(*BalanceTransactionUpsert)) *BalanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *BalanceTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BalanceTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
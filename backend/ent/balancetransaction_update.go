@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/balancetransaction"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// BalanceTransactionUpdate is the builder for updating BalanceTransaction entities.
+type BalanceTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceTransactionMutation
+}
+
+// Where appends a list predicates to the BalanceTransactionUpdate builder.
+func (_u *BalanceTransactionUpdate) Where(ps ...predicate.BalanceTransaction) *BalanceTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the BalanceTransactionMutation object of the builder.
+func (_u *BalanceTransactionUpdate) Mutation() *BalanceTransactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BalanceTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BalanceTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BalanceTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(balancetransaction.Table, balancetransaction.Columns, sqlgraph.NewFieldSpec(balancetransaction.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorUserIDCleared() {
+		_spec.ClearField(balancetransaction.FieldActorUserID, field.TypeInt64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BalanceTransactionUpdateOne is the builder for updating a single BalanceTransaction entity.
+type BalanceTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceTransactionMutation
+}
+
+// Mutation returns the BalanceTransactionMutation object of the builder.
+func (_u *BalanceTransactionUpdateOne) Mutation() *BalanceTransactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BalanceTransactionUpdate builder.
+func (_u *BalanceTransactionUpdateOne) Where(ps ...predicate.BalanceTransaction) *BalanceTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BalanceTransactionUpdateOne) Select(field string, fields ...string) *BalanceTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BalanceTransaction entity.
+func (_u *BalanceTransactionUpdateOne) Save(ctx context.Context) (*BalanceTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceTransactionUpdateOne) SaveX(ctx context.Context) *BalanceTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BalanceTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BalanceTransactionUpdateOne) sqlSave(ctx context.Context) (_node *BalanceTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(balancetransaction.Table, balancetransaction.Columns, sqlgraph.NewFieldSpec(balancetransaction.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancetransaction.FieldID)
+		for _, f := range fields {
+			if !balancetransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancetransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorUserIDCleared() {
+		_spec.ClearField(balancetransaction.FieldActorUserID, field.TypeInt64)
+	}
+	_node = &BalanceTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
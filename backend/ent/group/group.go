@@ -21,6 +21,16 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldRpmLimit holds the string denoting the rpm_limit field in the database.
+	FieldRpmLimit = "rpm_limit"
+	// FieldInputTpmLimit holds the string denoting the input_tpm_limit field in the database.
+	FieldInputTpmLimit = "input_tpm_limit"
+	// FieldOutputTpmLimit holds the string denoting the output_tpm_limit field in the database.
+	FieldOutputTpmLimit = "output_tpm_limit"
+	// FieldResponseCacheEnabled holds the string denoting the response_cache_enabled field in the database.
+	FieldResponseCacheEnabled = "response_cache_enabled"
+	// FieldResponseCacheTTLSeconds holds the string denoting the response_cache_ttl_seconds field in the database.
+	FieldResponseCacheTTLSeconds = "response_cache_ttl_seconds"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -57,6 +67,8 @@ const (
 	FieldModelRouting = "model_routing"
 	// FieldModelRoutingEnabled holds the string denoting the model_routing_enabled field in the database.
 	FieldModelRoutingEnabled = "model_routing_enabled"
+	// FieldResponseCacheCostRatio holds the string denoting the response_cache_cost_ratio field in the database.
+	FieldResponseCacheCostRatio = "response_cache_cost_ratio"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
 	// EdgeRedeemCodes holds the string denoting the redeem_codes edge name in mutations.
@@ -135,6 +147,11 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldRpmLimit,
+	FieldInputTpmLimit,
+	FieldOutputTpmLimit,
+	FieldResponseCacheEnabled,
+	FieldResponseCacheTTLSeconds,
 	FieldName,
 	FieldDescription,
 	FieldRateMultiplier,
@@ -153,6 +170,7 @@ var Columns = []string{
 	FieldFallbackGroupID,
 	FieldModelRouting,
 	FieldModelRoutingEnabled,
+	FieldResponseCacheCostRatio,
 }
 
 var (
@@ -188,6 +206,24 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRpmLimit holds the default value on creation for the "rpm_limit" field.
+	DefaultRpmLimit int
+	// RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	RpmLimitValidator func(int) error
+	// DefaultInputTpmLimit holds the default value on creation for the "input_tpm_limit" field.
+	DefaultInputTpmLimit int
+	// InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	InputTpmLimitValidator func(int) error
+	// DefaultOutputTpmLimit holds the default value on creation for the "output_tpm_limit" field.
+	DefaultOutputTpmLimit int
+	// OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	OutputTpmLimitValidator func(int) error
+	// DefaultResponseCacheEnabled holds the default value on creation for the "response_cache_enabled" field.
+	DefaultResponseCacheEnabled bool
+	// DefaultResponseCacheTTLSeconds holds the default value on creation for the "response_cache_ttl_seconds" field.
+	DefaultResponseCacheTTLSeconds int
+	// ResponseCacheTTLSecondsValidator is a validator for the "response_cache_ttl_seconds" field. It is called by the builders before save.
+	ResponseCacheTTLSecondsValidator func(int) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultRateMultiplier holds the default value on creation for the "rate_multiplier" field.
@@ -212,6 +248,8 @@ var (
 	DefaultClaudeCodeOnly bool
 	// DefaultModelRoutingEnabled holds the default value on creation for the "model_routing_enabled" field.
 	DefaultModelRoutingEnabled bool
+	// DefaultResponseCacheCostRatio holds the default value on creation for the "response_cache_cost_ratio" field.
+	DefaultResponseCacheCostRatio float64
 )
 
 // OrderOption defines the ordering options for the Group queries.
@@ -237,6 +275,31 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByRpmLimit orders the results by the rpm_limit field.
+func ByRpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRpmLimit, opts...).ToFunc()
+}
+
+// ByInputTpmLimit orders the results by the input_tpm_limit field.
+func ByInputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputTpmLimit, opts...).ToFunc()
+}
+
+// ByOutputTpmLimit orders the results by the output_tpm_limit field.
+func ByOutputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTpmLimit, opts...).ToFunc()
+}
+
+// ByResponseCacheEnabled orders the results by the response_cache_enabled field.
+func ByResponseCacheEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheEnabled, opts...).ToFunc()
+}
+
+// ByResponseCacheTTLSeconds orders the results by the response_cache_ttl_seconds field.
+func ByResponseCacheTTLSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheTTLSeconds, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -322,6 +385,11 @@ func ByModelRoutingEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelRoutingEnabled, opts...).ToFunc()
 }
 
+// ByResponseCacheCostRatio orders the results by the response_cache_cost_ratio field.
+func ByResponseCacheCostRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheCostRatio, opts...).ToFunc()
+}
+
 // ByAPIKeysCount orders the results by api_keys count.
 func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -70,6 +70,31 @@ func DeletedAt(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// RpmLimit applies equality check predicate on the "rpm_limit" field. It's identical to RpmLimitEQ.
+func RpmLimit(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRpmLimit, v))
+}
+
+// InputTpmLimit applies equality check predicate on the "input_tpm_limit" field. It's identical to InputTpmLimitEQ.
+func InputTpmLimit(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldInputTpmLimit, v))
+}
+
+// OutputTpmLimit applies equality check predicate on the "output_tpm_limit" field. It's identical to OutputTpmLimitEQ.
+func OutputTpmLimit(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOutputTpmLimit, v))
+}
+
+// ResponseCacheEnabled applies equality check predicate on the "response_cache_enabled" field. It's identical to ResponseCacheEnabledEQ.
+func ResponseCacheEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheTTLSeconds applies equality check predicate on the "response_cache_ttl_seconds" field. It's identical to ResponseCacheTTLSecondsEQ.
+func ResponseCacheTTLSeconds(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheTTLSeconds, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldName, v))
@@ -155,6 +180,11 @@ func ModelRoutingEnabled(v bool) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldModelRoutingEnabled, v))
 }
 
+// ResponseCacheCostRatio applies equality check predicate on the "response_cache_cost_ratio" field. It's identical to ResponseCacheCostRatioEQ.
+func ResponseCacheCostRatio(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheCostRatio, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -285,6 +315,176 @@ func DeletedAtNotNil() predicate.Group {
 	return predicate.Group(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// RpmLimitEQ applies the EQ predicate on the "rpm_limit" field.
+func RpmLimitEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRpmLimit, v))
+}
+
+// RpmLimitNEQ applies the NEQ predicate on the "rpm_limit" field.
+func RpmLimitNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldRpmLimit, v))
+}
+
+// RpmLimitIn applies the In predicate on the "rpm_limit" field.
+func RpmLimitIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldRpmLimit, vs...))
+}
+
+// RpmLimitNotIn applies the NotIn predicate on the "rpm_limit" field.
+func RpmLimitNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldRpmLimit, vs...))
+}
+
+// RpmLimitGT applies the GT predicate on the "rpm_limit" field.
+func RpmLimitGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldRpmLimit, v))
+}
+
+// RpmLimitGTE applies the GTE predicate on the "rpm_limit" field.
+func RpmLimitGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldRpmLimit, v))
+}
+
+// RpmLimitLT applies the LT predicate on the "rpm_limit" field.
+func RpmLimitLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldRpmLimit, v))
+}
+
+// RpmLimitLTE applies the LTE predicate on the "rpm_limit" field.
+func RpmLimitLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldRpmLimit, v))
+}
+
+// InputTpmLimitEQ applies the EQ predicate on the "input_tpm_limit" field.
+func InputTpmLimitEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitNEQ applies the NEQ predicate on the "input_tpm_limit" field.
+func InputTpmLimitNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitIn applies the In predicate on the "input_tpm_limit" field.
+func InputTpmLimitIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldInputTpmLimit, vs...))
+}
+
+// InputTpmLimitNotIn applies the NotIn predicate on the "input_tpm_limit" field.
+func InputTpmLimitNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldInputTpmLimit, vs...))
+}
+
+// InputTpmLimitGT applies the GT predicate on the "input_tpm_limit" field.
+func InputTpmLimitGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitGTE applies the GTE predicate on the "input_tpm_limit" field.
+func InputTpmLimitGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitLT applies the LT predicate on the "input_tpm_limit" field.
+func InputTpmLimitLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitLTE applies the LTE predicate on the "input_tpm_limit" field.
+func InputTpmLimitLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldInputTpmLimit, v))
+}
+
+// OutputTpmLimitEQ applies the EQ predicate on the "output_tpm_limit" field.
+func OutputTpmLimitEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitNEQ applies the NEQ predicate on the "output_tpm_limit" field.
+func OutputTpmLimitNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitIn applies the In predicate on the "output_tpm_limit" field.
+func OutputTpmLimitIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldOutputTpmLimit, vs...))
+}
+
+// OutputTpmLimitNotIn applies the NotIn predicate on the "output_tpm_limit" field.
+func OutputTpmLimitNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldOutputTpmLimit, vs...))
+}
+
+// OutputTpmLimitGT applies the GT predicate on the "output_tpm_limit" field.
+func OutputTpmLimitGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitGTE applies the GTE predicate on the "output_tpm_limit" field.
+func OutputTpmLimitGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitLT applies the LT predicate on the "output_tpm_limit" field.
+func OutputTpmLimitLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitLTE applies the LTE predicate on the "output_tpm_limit" field.
+func OutputTpmLimitLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldOutputTpmLimit, v))
+}
+
+// ResponseCacheEnabledEQ applies the EQ predicate on the "response_cache_enabled" field.
+func ResponseCacheEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheEnabledNEQ applies the NEQ predicate on the "response_cache_enabled" field.
+func ResponseCacheEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheTTLSecondsEQ applies the EQ predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsNEQ applies the NEQ predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsIn applies the In predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldResponseCacheTTLSeconds, vs...))
+}
+
+// ResponseCacheTTLSecondsNotIn applies the NotIn predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldResponseCacheTTLSeconds, vs...))
+}
+
+// ResponseCacheTTLSecondsGT applies the GT predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsGTE applies the GTE predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsLT applies the LT predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsLTE applies the LTE predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldResponseCacheTTLSeconds, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldName, v))
@@ -1090,6 +1290,46 @@ func ModelRoutingEnabledNEQ(v bool) predicate.Group {
 	return predicate.Group(sql.FieldNEQ(FieldModelRoutingEnabled, v))
 }
 
+// ResponseCacheCostRatioEQ applies the EQ predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheCostRatio, v))
+}
+
+// ResponseCacheCostRatioNEQ applies the NEQ predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioNEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldResponseCacheCostRatio, v))
+}
+
+// ResponseCacheCostRatioIn applies the In predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldResponseCacheCostRatio, vs...))
+}
+
+// ResponseCacheCostRatioNotIn applies the NotIn predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioNotIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldResponseCacheCostRatio, vs...))
+}
+
+// ResponseCacheCostRatioGT applies the GT predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioGT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldResponseCacheCostRatio, v))
+}
+
+// ResponseCacheCostRatioGTE applies the GTE predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioGTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldResponseCacheCostRatio, v))
+}
+
+// ResponseCacheCostRatioLT applies the LT predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioLT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldResponseCacheCostRatio, v))
+}
+
+// ResponseCacheCostRatioLTE applies the LTE predicate on the "response_cache_cost_ratio" field.
+func ResponseCacheCostRatioLTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldResponseCacheCostRatio, v))
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
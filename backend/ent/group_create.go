@@ -70,6 +70,76 @@ func (_c *GroupCreate) SetNillableDeletedAt(v *time.Time) *GroupCreate {
 	return _c
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_c *GroupCreate) SetRpmLimit(v int) *GroupCreate {
+	_c.mutation.SetRpmLimit(v)
+	return _c
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableRpmLimit(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetRpmLimit(*v)
+	}
+	return _c
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_c *GroupCreate) SetInputTpmLimit(v int) *GroupCreate {
+	_c.mutation.SetInputTpmLimit(v)
+	return _c
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableInputTpmLimit(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetInputTpmLimit(*v)
+	}
+	return _c
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_c *GroupCreate) SetOutputTpmLimit(v int) *GroupCreate {
+	_c.mutation.SetOutputTpmLimit(v)
+	return _c
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableOutputTpmLimit(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetOutputTpmLimit(*v)
+	}
+	return _c
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_c *GroupCreate) SetResponseCacheEnabled(v bool) *GroupCreate {
+	_c.mutation.SetResponseCacheEnabled(v)
+	return _c
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableResponseCacheEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetResponseCacheEnabled(*v)
+	}
+	return _c
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_c *GroupCreate) SetResponseCacheTTLSeconds(v int) *GroupCreate {
+	_c.mutation.SetResponseCacheTTLSeconds(v)
+	return _c
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableResponseCacheTTLSeconds(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetResponseCacheTTLSeconds(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *GroupCreate) SetName(v string) *GroupCreate {
 	_c.mutation.SetName(v)
@@ -306,6 +376,20 @@ func (_c *GroupCreate) SetNillableModelRoutingEnabled(v *bool) *GroupCreate {
 	return _c
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (_c *GroupCreate) SetResponseCacheCostRatio(v float64) *GroupCreate {
+	_c.mutation.SetResponseCacheCostRatio(v)
+	return _c
+}
+
+// SetNillableResponseCacheCostRatio sets the "response_cache_cost_ratio" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableResponseCacheCostRatio(v *float64) *GroupCreate {
+	if v != nil {
+		_c.SetResponseCacheCostRatio(*v)
+	}
+	return _c
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *GroupCreate) AddAPIKeyIDs(ids ...int64) *GroupCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -447,6 +531,26 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		v := group.DefaultRpmLimit
+		_c.mutation.SetRpmLimit(v)
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		v := group.DefaultInputTpmLimit
+		_c.mutation.SetInputTpmLimit(v)
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		v := group.DefaultOutputTpmLimit
+		_c.mutation.SetOutputTpmLimit(v)
+	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		v := group.DefaultResponseCacheEnabled
+		_c.mutation.SetResponseCacheEnabled(v)
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		v := group.DefaultResponseCacheTTLSeconds
+		_c.mutation.SetResponseCacheTTLSeconds(v)
+	}
 	if _, ok := _c.mutation.RateMultiplier(); !ok {
 		v := group.DefaultRateMultiplier
 		_c.mutation.SetRateMultiplier(v)
@@ -479,6 +583,10 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultModelRoutingEnabled
 		_c.mutation.SetModelRoutingEnabled(v)
 	}
+	if _, ok := _c.mutation.ResponseCacheCostRatio(); !ok {
+		v := group.DefaultResponseCacheCostRatio
+		_c.mutation.SetResponseCacheCostRatio(v)
+	}
 	return nil
 }
 
@@ -490,6 +598,41 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Group.updated_at"`)}
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		return &ValidationError{Name: "rpm_limit", err: errors.New(`ent: missing required field "Group.rpm_limit"`)}
+	}
+	if v, ok := _c.mutation.RpmLimit(); ok {
+		if err := group.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.rpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		return &ValidationError{Name: "input_tpm_limit", err: errors.New(`ent: missing required field "Group.input_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.InputTpmLimit(); ok {
+		if err := group.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.input_tpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		return &ValidationError{Name: "output_tpm_limit", err: errors.New(`ent: missing required field "Group.output_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.OutputTpmLimit(); ok {
+		if err := group.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.output_tpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		return &ValidationError{Name: "response_cache_enabled", err: errors.New(`ent: missing required field "Group.response_cache_enabled"`)}
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		return &ValidationError{Name: "response_cache_ttl_seconds", err: errors.New(`ent: missing required field "Group.response_cache_ttl_seconds"`)}
+	}
+	if v, ok := _c.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := group.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "Group.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
 	}
@@ -537,6 +680,9 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.ModelRoutingEnabled(); !ok {
 		return &ValidationError{Name: "model_routing_enabled", err: errors.New(`ent: missing required field "Group.model_routing_enabled"`)}
 	}
+	if _, ok := _c.mutation.ResponseCacheCostRatio(); !ok {
+		return &ValidationError{Name: "response_cache_cost_ratio", err: errors.New(`ent: missing required field "Group.response_cache_cost_ratio"`)}
+	}
 	return nil
 }
 
@@ -576,6 +722,26 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := _c.mutation.RpmLimit(); ok {
+		_spec.SetField(group.FieldRpmLimit, field.TypeInt, value)
+		_node.RpmLimit = value
+	}
+	if value, ok := _c.mutation.InputTpmLimit(); ok {
+		_spec.SetField(group.FieldInputTpmLimit, field.TypeInt, value)
+		_node.InputTpmLimit = value
+	}
+	if value, ok := _c.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(group.FieldOutputTpmLimit, field.TypeInt, value)
+		_node.OutputTpmLimit = value
+	}
+	if value, ok := _c.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+		_node.ResponseCacheEnabled = value
+	}
+	if value, ok := _c.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+		_node.ResponseCacheTTLSeconds = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(group.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -648,6 +814,10 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 		_node.ModelRoutingEnabled = value
 	}
+	if value, ok := _c.mutation.ResponseCacheCostRatio(); ok {
+		_spec.SetField(group.FieldResponseCacheCostRatio, field.TypeFloat64, value)
+		_node.ResponseCacheCostRatio = value
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -834,6 +1004,90 @@ func (u *GroupUpsert) ClearDeletedAt() *GroupUpsert {
 	return u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *GroupUpsert) SetRpmLimit(v int) *GroupUpsert {
+	u.Set(group.FieldRpmLimit, v)
+	return u
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateRpmLimit() *GroupUpsert {
+	u.SetExcluded(group.FieldRpmLimit)
+	return u
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *GroupUpsert) AddRpmLimit(v int) *GroupUpsert {
+	u.Add(group.FieldRpmLimit, v)
+	return u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *GroupUpsert) SetInputTpmLimit(v int) *GroupUpsert {
+	u.Set(group.FieldInputTpmLimit, v)
+	return u
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateInputTpmLimit() *GroupUpsert {
+	u.SetExcluded(group.FieldInputTpmLimit)
+	return u
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *GroupUpsert) AddInputTpmLimit(v int) *GroupUpsert {
+	u.Add(group.FieldInputTpmLimit, v)
+	return u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *GroupUpsert) SetOutputTpmLimit(v int) *GroupUpsert {
+	u.Set(group.FieldOutputTpmLimit, v)
+	return u
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateOutputTpmLimit() *GroupUpsert {
+	u.SetExcluded(group.FieldOutputTpmLimit)
+	return u
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *GroupUpsert) AddOutputTpmLimit(v int) *GroupUpsert {
+	u.Add(group.FieldOutputTpmLimit, v)
+	return u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsert) SetResponseCacheEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldResponseCacheEnabled, v)
+	return u
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateResponseCacheEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldResponseCacheEnabled)
+	return u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsert) SetResponseCacheTTLSeconds(v int) *GroupUpsert {
+	u.Set(group.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateResponseCacheTTLSeconds() *GroupUpsert {
+	u.SetExcluded(group.FieldResponseCacheTTLSeconds)
+	return u
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsert) AddResponseCacheTTLSeconds(v int) *GroupUpsert {
+	u.Add(group.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *GroupUpsert) SetName(v string) *GroupUpsert {
 	u.Set(group.FieldName, v)
@@ -1158,6 +1412,24 @@ func (u *GroupUpsert) UpdateModelRoutingEnabled() *GroupUpsert {
 	return u
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (u *GroupUpsert) SetResponseCacheCostRatio(v float64) *GroupUpsert {
+	u.Set(group.FieldResponseCacheCostRatio, v)
+	return u
+}
+
+// UpdateResponseCacheCostRatio sets the "response_cache_cost_ratio" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateResponseCacheCostRatio() *GroupUpsert {
+	u.SetExcluded(group.FieldResponseCacheCostRatio)
+	return u
+}
+
+// AddResponseCacheCostRatio adds v to the "response_cache_cost_ratio" field.
+func (u *GroupUpsert) AddResponseCacheCostRatio(v float64) *GroupUpsert {
+	u.Add(group.FieldResponseCacheCostRatio, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1238,6 +1510,104 @@ func (u *GroupUpsertOne) ClearDeletedAt() *GroupUpsertOne {
 	})
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *GroupUpsertOne) SetRpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *GroupUpsertOne) AddRpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateRpmLimit() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *GroupUpsertOne) SetInputTpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *GroupUpsertOne) AddInputTpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateInputTpmLimit() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *GroupUpsertOne) SetOutputTpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *GroupUpsertOne) AddOutputTpmLimit(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateOutputTpmLimit() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsertOne) SetResponseCacheEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateResponseCacheEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertOne) SetResponseCacheTTLSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertOne) AddResponseCacheTTLSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateResponseCacheTTLSeconds() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *GroupUpsertOne) SetName(v string) *GroupUpsertOne {
 	return u.Update(func(s *GroupUpsert) {
@@ -1616,6 +1986,27 @@ func (u *GroupUpsertOne) UpdateModelRoutingEnabled() *GroupUpsertOne {
 	})
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (u *GroupUpsertOne) SetResponseCacheCostRatio(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheCostRatio(v)
+	})
+}
+
+// AddResponseCacheCostRatio adds v to the "response_cache_cost_ratio" field.
+func (u *GroupUpsertOne) AddResponseCacheCostRatio(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheCostRatio(v)
+	})
+}
+
+// UpdateResponseCacheCostRatio sets the "response_cache_cost_ratio" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateResponseCacheCostRatio() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheCostRatio()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1862,6 +2253,104 @@ func (u *GroupUpsertBulk) ClearDeletedAt() *GroupUpsertBulk {
 	})
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *GroupUpsertBulk) SetRpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *GroupUpsertBulk) AddRpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateRpmLimit() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *GroupUpsertBulk) SetInputTpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *GroupUpsertBulk) AddInputTpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateInputTpmLimit() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *GroupUpsertBulk) SetOutputTpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *GroupUpsertBulk) AddOutputTpmLimit(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateOutputTpmLimit() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsertBulk) SetResponseCacheEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateResponseCacheEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertBulk) SetResponseCacheTTLSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertBulk) AddResponseCacheTTLSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateResponseCacheTTLSeconds() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *GroupUpsertBulk) SetName(v string) *GroupUpsertBulk {
 	return u.Update(func(s *GroupUpsert) {
@@ -2240,6 +2729,27 @@ func (u *GroupUpsertBulk) UpdateModelRoutingEnabled() *GroupUpsertBulk {
 	})
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (u *GroupUpsertBulk) SetResponseCacheCostRatio(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheCostRatio(v)
+	})
+}
+
+// AddResponseCacheCostRatio adds v to the "response_cache_cost_ratio" field.
+func (u *GroupUpsertBulk) AddResponseCacheCostRatio(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheCostRatio(v)
+	})
+}
+
+// UpdateResponseCacheCostRatio sets the "response_cache_cost_ratio" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateResponseCacheCostRatio() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheCostRatio()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
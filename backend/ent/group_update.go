@@ -60,6 +60,104 @@ func (_u *GroupUpdate) ClearDeletedAt() *GroupUpdate {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *GroupUpdate) SetRpmLimit(v int) *GroupUpdate {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableRpmLimit(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *GroupUpdate) AddRpmLimit(v int) *GroupUpdate {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *GroupUpdate) SetInputTpmLimit(v int) *GroupUpdate {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableInputTpmLimit(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *GroupUpdate) AddInputTpmLimit(v int) *GroupUpdate {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *GroupUpdate) SetOutputTpmLimit(v int) *GroupUpdate {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableOutputTpmLimit(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *GroupUpdate) AddOutputTpmLimit(v int) *GroupUpdate {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *GroupUpdate) SetResponseCacheEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableResponseCacheEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdate) SetResponseCacheTTLSeconds(v int) *GroupUpdate {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableResponseCacheTTLSeconds(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdate) AddResponseCacheTTLSeconds(v int) *GroupUpdate {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *GroupUpdate) SetName(v string) *GroupUpdate {
 	_u.mutation.SetName(v)
@@ -421,6 +519,27 @@ func (_u *GroupUpdate) SetNillableModelRoutingEnabled(v *bool) *GroupUpdate {
 	return _u
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (_u *GroupUpdate) SetResponseCacheCostRatio(v float64) *GroupUpdate {
+	_u.mutation.ResetResponseCacheCostRatio()
+	_u.mutation.SetResponseCacheCostRatio(v)
+	return _u
+}
+
+// SetNillableResponseCacheCostRatio sets the "response_cache_cost_ratio" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableResponseCacheCostRatio(v *float64) *GroupUpdate {
+	if v != nil {
+		_u.SetResponseCacheCostRatio(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheCostRatio adds value to the "response_cache_cost_ratio" field.
+func (_u *GroupUpdate) AddResponseCacheCostRatio(v float64) *GroupUpdate {
+	_u.mutation.AddResponseCacheCostRatio(v)
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdate) AddAPIKeyIDs(ids ...int64) *GroupUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -686,6 +805,26 @@ func (_u *GroupUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GroupUpdate) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := group.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := group.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := group.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.output_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := group.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "Group.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
@@ -730,6 +869,33 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(group.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(group.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(group.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(group.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(group.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(group.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(group.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(group.FieldName, field.TypeString, value)
 	}
@@ -838,6 +1004,12 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ModelRoutingEnabled(); ok {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheCostRatio(); ok {
+		_spec.SetField(group.FieldResponseCacheCostRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheCostRatio(); ok {
+		_spec.AddField(group.FieldResponseCacheCostRatio, field.TypeFloat64, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1178,6 +1350,104 @@ func (_u *GroupUpdateOne) ClearDeletedAt() *GroupUpdateOne {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *GroupUpdateOne) SetRpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableRpmLimit(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *GroupUpdateOne) AddRpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *GroupUpdateOne) SetInputTpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableInputTpmLimit(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *GroupUpdateOne) AddInputTpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *GroupUpdateOne) SetOutputTpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableOutputTpmLimit(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *GroupUpdateOne) AddOutputTpmLimit(v int) *GroupUpdateOne {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *GroupUpdateOne) SetResponseCacheEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableResponseCacheEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdateOne) SetResponseCacheTTLSeconds(v int) *GroupUpdateOne {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableResponseCacheTTLSeconds(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdateOne) AddResponseCacheTTLSeconds(v int) *GroupUpdateOne {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *GroupUpdateOne) SetName(v string) *GroupUpdateOne {
 	_u.mutation.SetName(v)
@@ -1539,6 +1809,27 @@ func (_u *GroupUpdateOne) SetNillableModelRoutingEnabled(v *bool) *GroupUpdateOn
 	return _u
 }
 
+// SetResponseCacheCostRatio sets the "response_cache_cost_ratio" field.
+func (_u *GroupUpdateOne) SetResponseCacheCostRatio(v float64) *GroupUpdateOne {
+	_u.mutation.ResetResponseCacheCostRatio()
+	_u.mutation.SetResponseCacheCostRatio(v)
+	return _u
+}
+
+// SetNillableResponseCacheCostRatio sets the "response_cache_cost_ratio" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableResponseCacheCostRatio(v *float64) *GroupUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheCostRatio(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheCostRatio adds value to the "response_cache_cost_ratio" field.
+func (_u *GroupUpdateOne) AddResponseCacheCostRatio(v float64) *GroupUpdateOne {
+	_u.mutation.AddResponseCacheCostRatio(v)
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdateOne) AddAPIKeyIDs(ids ...int64) *GroupUpdateOne {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -1817,6 +2108,26 @@ func (_u *GroupUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GroupUpdateOne) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := group.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := group.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := group.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "Group.output_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		if err := group.ResponseCacheTTLSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "response_cache_ttl_seconds", err: fmt.Errorf(`ent: validator failed for field "Group.response_cache_ttl_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
@@ -1878,6 +2189,33 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(group.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(group.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(group.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(group.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(group.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(group.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(group.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(group.FieldName, field.TypeString, value)
 	}
@@ -1986,6 +2324,12 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if value, ok := _u.mutation.ModelRoutingEnabled(); ok {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheCostRatio(); ok {
+		_spec.SetField(group.FieldResponseCacheCostRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheCostRatio(); ok {
+		_spec.AddField(group.FieldResponseCacheCostRatio, field.TypeFloat64, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
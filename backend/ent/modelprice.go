@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+)
+
+// ModelPrice is the model entity for the ModelPrice schema.
+type ModelPrice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 为空表示全局覆盖
+	GroupID *int64 `json:"group_id,omitempty"`
+	// 模型名（小写），以 * 结尾表示前缀匹配
+	Model string `json:"model,omitempty"`
+	// InputPrice holds the value of the "input_price" field.
+	InputPrice float64 `json:"input_price,omitempty"`
+	// OutputPrice holds the value of the "output_price" field.
+	OutputPrice float64 `json:"output_price,omitempty"`
+	// CacheWritePrice holds the value of the "cache_write_price" field.
+	CacheWritePrice float64 `json:"cache_write_price,omitempty"`
+	// CacheReadPrice holds the value of the "cache_read_price" field.
+	CacheReadPrice float64 `json:"cache_read_price,omitempty"`
+	// 0 表示不启用长上下文阶梯
+	LongContextThreshold int `json:"long_context_threshold,omitempty"`
+	// LongContextInputPrice holds the value of the "long_context_input_price" field.
+	LongContextInputPrice *float64 `json:"long_context_input_price,omitempty"`
+	// LongContextOutputPrice holds the value of the "long_context_output_price" field.
+	LongContextOutputPrice *float64 `json:"long_context_output_price,omitempty"`
+	// EffectiveFrom holds the value of the "effective_from" field.
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes        string `json:"notes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModelPrice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case modelprice.FieldInputPrice, modelprice.FieldOutputPrice, modelprice.FieldCacheWritePrice, modelprice.FieldCacheReadPrice, modelprice.FieldLongContextInputPrice, modelprice.FieldLongContextOutputPrice:
+			values[i] = new(sql.NullFloat64)
+		case modelprice.FieldID, modelprice.FieldGroupID, modelprice.FieldLongContextThreshold:
+			values[i] = new(sql.NullInt64)
+		case modelprice.FieldModel, modelprice.FieldNotes:
+			values[i] = new(sql.NullString)
+		case modelprice.FieldCreatedAt, modelprice.FieldUpdatedAt, modelprice.FieldEffectiveFrom:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModelPrice fields.
+func (_m *ModelPrice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case modelprice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case modelprice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case modelprice.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case modelprice.FieldGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = new(int64)
+				*_m.GroupID = value.Int64
+			}
+		case modelprice.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case modelprice.FieldInputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_price", values[i])
+			} else if value.Valid {
+				_m.InputPrice = value.Float64
+			}
+		case modelprice.FieldOutputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_price", values[i])
+			} else if value.Valid {
+				_m.OutputPrice = value.Float64
+			}
+		case modelprice.FieldCacheWritePrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_write_price", values[i])
+			} else if value.Valid {
+				_m.CacheWritePrice = value.Float64
+			}
+		case modelprice.FieldCacheReadPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_read_price", values[i])
+			} else if value.Valid {
+				_m.CacheReadPrice = value.Float64
+			}
+		case modelprice.FieldLongContextThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field long_context_threshold", values[i])
+			} else if value.Valid {
+				_m.LongContextThreshold = int(value.Int64)
+			}
+		case modelprice.FieldLongContextInputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field long_context_input_price", values[i])
+			} else if value.Valid {
+				_m.LongContextInputPrice = new(float64)
+				*_m.LongContextInputPrice = value.Float64
+			}
+		case modelprice.FieldLongContextOutputPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field long_context_output_price", values[i])
+			} else if value.Valid {
+				_m.LongContextOutputPrice = new(float64)
+				*_m.LongContextOutputPrice = value.Float64
+			}
+		case modelprice.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				_m.EffectiveFrom = value.Time
+			}
+		case modelprice.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModelPrice.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModelPrice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModelPrice.
+// Note that you need to call ModelPrice.Unwrap() before calling this method if this ModelPrice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModelPrice) Update() *ModelPriceUpdateOne {
+	return NewModelPriceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModelPrice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModelPrice) Unwrap() *ModelPrice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModelPrice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModelPrice) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModelPrice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.GroupID; v != nil {
+		builder.WriteString("group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("input_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InputPrice))
+	builder.WriteString(", ")
+	builder.WriteString("output_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutputPrice))
+	builder.WriteString(", ")
+	builder.WriteString("cache_write_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CacheWritePrice))
+	builder.WriteString(", ")
+	builder.WriteString("cache_read_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CacheReadPrice))
+	builder.WriteString(", ")
+	builder.WriteString("long_context_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LongContextThreshold))
+	builder.WriteString(", ")
+	if v := _m.LongContextInputPrice; v != nil {
+		builder.WriteString("long_context_input_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LongContextOutputPrice; v != nil {
+		builder.WriteString("long_context_output_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(_m.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModelPrices is a parsable slice of ModelPrice.
+type ModelPrices []*ModelPrice
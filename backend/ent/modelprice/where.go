@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package modelprice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldModel, v))
+}
+
+// InputPrice applies equality check predicate on the "input_price" field. It's identical to InputPriceEQ.
+func InputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// OutputPrice applies equality check predicate on the "output_price" field. It's identical to OutputPriceEQ.
+func OutputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// CacheWritePrice applies equality check predicate on the "cache_write_price" field. It's identical to CacheWritePriceEQ.
+func CacheWritePrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheWritePrice, v))
+}
+
+// CacheReadPrice applies equality check predicate on the "cache_read_price" field. It's identical to CacheReadPriceEQ.
+func CacheReadPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheReadPrice, v))
+}
+
+// LongContextThreshold applies equality check predicate on the "long_context_threshold" field. It's identical to LongContextThresholdEQ.
+func LongContextThreshold(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextThreshold, v))
+}
+
+// LongContextInputPrice applies equality check predicate on the "long_context_input_price" field. It's identical to LongContextInputPriceEQ.
+func LongContextInputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextInputPrice, v))
+}
+
+// LongContextOutputPrice applies equality check predicate on the "long_context_output_price" field. It's identical to LongContextOutputPriceEQ.
+func LongContextOutputPrice(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextOutputPrice, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v int64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldGroupID))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContainsFold(FieldModel, v))
+}
+
+// InputPriceEQ applies the EQ predicate on the "input_price" field.
+func InputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldInputPrice, v))
+}
+
+// InputPriceNEQ applies the NEQ predicate on the "input_price" field.
+func InputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldInputPrice, v))
+}
+
+// InputPriceIn applies the In predicate on the "input_price" field.
+func InputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldInputPrice, vs...))
+}
+
+// InputPriceNotIn applies the NotIn predicate on the "input_price" field.
+func InputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldInputPrice, vs...))
+}
+
+// InputPriceGT applies the GT predicate on the "input_price" field.
+func InputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldInputPrice, v))
+}
+
+// InputPriceGTE applies the GTE predicate on the "input_price" field.
+func InputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldInputPrice, v))
+}
+
+// InputPriceLT applies the LT predicate on the "input_price" field.
+func InputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldInputPrice, v))
+}
+
+// InputPriceLTE applies the LTE predicate on the "input_price" field.
+func InputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldInputPrice, v))
+}
+
+// OutputPriceEQ applies the EQ predicate on the "output_price" field.
+func OutputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceNEQ applies the NEQ predicate on the "output_price" field.
+func OutputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldOutputPrice, v))
+}
+
+// OutputPriceIn applies the In predicate on the "output_price" field.
+func OutputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceNotIn applies the NotIn predicate on the "output_price" field.
+func OutputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldOutputPrice, vs...))
+}
+
+// OutputPriceGT applies the GT predicate on the "output_price" field.
+func OutputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldOutputPrice, v))
+}
+
+// OutputPriceGTE applies the GTE predicate on the "output_price" field.
+func OutputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldOutputPrice, v))
+}
+
+// OutputPriceLT applies the LT predicate on the "output_price" field.
+func OutputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldOutputPrice, v))
+}
+
+// OutputPriceLTE applies the LTE predicate on the "output_price" field.
+func OutputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldOutputPrice, v))
+}
+
+// CacheWritePriceEQ applies the EQ predicate on the "cache_write_price" field.
+func CacheWritePriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceNEQ applies the NEQ predicate on the "cache_write_price" field.
+func CacheWritePriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceIn applies the In predicate on the "cache_write_price" field.
+func CacheWritePriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCacheWritePrice, vs...))
+}
+
+// CacheWritePriceNotIn applies the NotIn predicate on the "cache_write_price" field.
+func CacheWritePriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCacheWritePrice, vs...))
+}
+
+// CacheWritePriceGT applies the GT predicate on the "cache_write_price" field.
+func CacheWritePriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceGTE applies the GTE predicate on the "cache_write_price" field.
+func CacheWritePriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceLT applies the LT predicate on the "cache_write_price" field.
+func CacheWritePriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCacheWritePrice, v))
+}
+
+// CacheWritePriceLTE applies the LTE predicate on the "cache_write_price" field.
+func CacheWritePriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCacheWritePrice, v))
+}
+
+// CacheReadPriceEQ applies the EQ predicate on the "cache_read_price" field.
+func CacheReadPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceNEQ applies the NEQ predicate on the "cache_read_price" field.
+func CacheReadPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceIn applies the In predicate on the "cache_read_price" field.
+func CacheReadPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldCacheReadPrice, vs...))
+}
+
+// CacheReadPriceNotIn applies the NotIn predicate on the "cache_read_price" field.
+func CacheReadPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldCacheReadPrice, vs...))
+}
+
+// CacheReadPriceGT applies the GT predicate on the "cache_read_price" field.
+func CacheReadPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceGTE applies the GTE predicate on the "cache_read_price" field.
+func CacheReadPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceLT applies the LT predicate on the "cache_read_price" field.
+func CacheReadPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldCacheReadPrice, v))
+}
+
+// CacheReadPriceLTE applies the LTE predicate on the "cache_read_price" field.
+func CacheReadPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldCacheReadPrice, v))
+}
+
+// LongContextThresholdEQ applies the EQ predicate on the "long_context_threshold" field.
+func LongContextThresholdEQ(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextThreshold, v))
+}
+
+// LongContextThresholdNEQ applies the NEQ predicate on the "long_context_threshold" field.
+func LongContextThresholdNEQ(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldLongContextThreshold, v))
+}
+
+// LongContextThresholdIn applies the In predicate on the "long_context_threshold" field.
+func LongContextThresholdIn(vs ...int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldLongContextThreshold, vs...))
+}
+
+// LongContextThresholdNotIn applies the NotIn predicate on the "long_context_threshold" field.
+func LongContextThresholdNotIn(vs ...int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldLongContextThreshold, vs...))
+}
+
+// LongContextThresholdGT applies the GT predicate on the "long_context_threshold" field.
+func LongContextThresholdGT(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldLongContextThreshold, v))
+}
+
+// LongContextThresholdGTE applies the GTE predicate on the "long_context_threshold" field.
+func LongContextThresholdGTE(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldLongContextThreshold, v))
+}
+
+// LongContextThresholdLT applies the LT predicate on the "long_context_threshold" field.
+func LongContextThresholdLT(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldLongContextThreshold, v))
+}
+
+// LongContextThresholdLTE applies the LTE predicate on the "long_context_threshold" field.
+func LongContextThresholdLTE(v int) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldLongContextThreshold, v))
+}
+
+// LongContextInputPriceEQ applies the EQ predicate on the "long_context_input_price" field.
+func LongContextInputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceNEQ applies the NEQ predicate on the "long_context_input_price" field.
+func LongContextInputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceIn applies the In predicate on the "long_context_input_price" field.
+func LongContextInputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldLongContextInputPrice, vs...))
+}
+
+// LongContextInputPriceNotIn applies the NotIn predicate on the "long_context_input_price" field.
+func LongContextInputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldLongContextInputPrice, vs...))
+}
+
+// LongContextInputPriceGT applies the GT predicate on the "long_context_input_price" field.
+func LongContextInputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceGTE applies the GTE predicate on the "long_context_input_price" field.
+func LongContextInputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceLT applies the LT predicate on the "long_context_input_price" field.
+func LongContextInputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceLTE applies the LTE predicate on the "long_context_input_price" field.
+func LongContextInputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldLongContextInputPrice, v))
+}
+
+// LongContextInputPriceIsNil applies the IsNil predicate on the "long_context_input_price" field.
+func LongContextInputPriceIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldLongContextInputPrice))
+}
+
+// LongContextInputPriceNotNil applies the NotNil predicate on the "long_context_input_price" field.
+func LongContextInputPriceNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldLongContextInputPrice))
+}
+
+// LongContextOutputPriceEQ applies the EQ predicate on the "long_context_output_price" field.
+func LongContextOutputPriceEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceNEQ applies the NEQ predicate on the "long_context_output_price" field.
+func LongContextOutputPriceNEQ(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceIn applies the In predicate on the "long_context_output_price" field.
+func LongContextOutputPriceIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldLongContextOutputPrice, vs...))
+}
+
+// LongContextOutputPriceNotIn applies the NotIn predicate on the "long_context_output_price" field.
+func LongContextOutputPriceNotIn(vs ...float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldLongContextOutputPrice, vs...))
+}
+
+// LongContextOutputPriceGT applies the GT predicate on the "long_context_output_price" field.
+func LongContextOutputPriceGT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceGTE applies the GTE predicate on the "long_context_output_price" field.
+func LongContextOutputPriceGTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceLT applies the LT predicate on the "long_context_output_price" field.
+func LongContextOutputPriceLT(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceLTE applies the LTE predicate on the "long_context_output_price" field.
+func LongContextOutputPriceLTE(v float64) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldLongContextOutputPrice, v))
+}
+
+// LongContextOutputPriceIsNil applies the IsNil predicate on the "long_context_output_price" field.
+func LongContextOutputPriceIsNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIsNull(FieldLongContextOutputPrice))
+}
+
+// LongContextOutputPriceNotNil applies the NotNil predicate on the "long_context_output_price" field.
+func LongContextOutputPriceNotNil() predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotNull(FieldLongContextOutputPrice))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModelPrice) predicate.ModelPrice {
+	return predicate.ModelPrice(sql.NotPredicates(p))
+}
@@ -0,0 +1,1381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+)
+
+// ModelPriceCreate is the builder for creating a ModelPrice entity.
+type ModelPriceCreate struct {
+	config
+	mutation *ModelPriceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModelPriceCreate) SetCreatedAt(v time.Time) *ModelPriceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableCreatedAt(v *time.Time) *ModelPriceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModelPriceCreate) SetUpdatedAt(v time.Time) *ModelPriceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableUpdatedAt(v *time.Time) *ModelPriceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *ModelPriceCreate) SetGroupID(v int64) *ModelPriceCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableGroupID(v *int64) *ModelPriceCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ModelPriceCreate) SetModel(v string) *ModelPriceCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_c *ModelPriceCreate) SetInputPrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetInputPrice(v)
+	return _c
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_c *ModelPriceCreate) SetOutputPrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetOutputPrice(v)
+	return _c
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (_c *ModelPriceCreate) SetCacheWritePrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetCacheWritePrice(v)
+	return _c
+}
+
+// SetNillableCacheWritePrice sets the "cache_write_price" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableCacheWritePrice(v *float64) *ModelPriceCreate {
+	if v != nil {
+		_c.SetCacheWritePrice(*v)
+	}
+	return _c
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (_c *ModelPriceCreate) SetCacheReadPrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetCacheReadPrice(v)
+	return _c
+}
+
+// SetNillableCacheReadPrice sets the "cache_read_price" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableCacheReadPrice(v *float64) *ModelPriceCreate {
+	if v != nil {
+		_c.SetCacheReadPrice(*v)
+	}
+	return _c
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (_c *ModelPriceCreate) SetLongContextThreshold(v int) *ModelPriceCreate {
+	_c.mutation.SetLongContextThreshold(v)
+	return _c
+}
+
+// SetNillableLongContextThreshold sets the "long_context_threshold" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableLongContextThreshold(v *int) *ModelPriceCreate {
+	if v != nil {
+		_c.SetLongContextThreshold(*v)
+	}
+	return _c
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (_c *ModelPriceCreate) SetLongContextInputPrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetLongContextInputPrice(v)
+	return _c
+}
+
+// SetNillableLongContextInputPrice sets the "long_context_input_price" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableLongContextInputPrice(v *float64) *ModelPriceCreate {
+	if v != nil {
+		_c.SetLongContextInputPrice(*v)
+	}
+	return _c
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (_c *ModelPriceCreate) SetLongContextOutputPrice(v float64) *ModelPriceCreate {
+	_c.mutation.SetLongContextOutputPrice(v)
+	return _c
+}
+
+// SetNillableLongContextOutputPrice sets the "long_context_output_price" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableLongContextOutputPrice(v *float64) *ModelPriceCreate {
+	if v != nil {
+		_c.SetLongContextOutputPrice(*v)
+	}
+	return _c
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_c *ModelPriceCreate) SetEffectiveFrom(v time.Time) *ModelPriceCreate {
+	_c.mutation.SetEffectiveFrom(v)
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *ModelPriceCreate) SetNotes(v string) *ModelPriceCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *ModelPriceCreate) SetNillableNotes(v *string) *ModelPriceCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ModelPriceMutation object of the builder.
+func (_c *ModelPriceCreate) Mutation() *ModelPriceMutation {
+	return _c.mutation
+}
+
+// Save creates the ModelPrice in the database.
+func (_c *ModelPriceCreate) Save(ctx context.Context) (*ModelPrice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModelPriceCreate) SaveX(ctx context.Context) *ModelPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModelPriceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModelPriceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModelPriceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := modelprice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := modelprice.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CacheWritePrice(); !ok {
+		v := modelprice.DefaultCacheWritePrice
+		_c.mutation.SetCacheWritePrice(v)
+	}
+	if _, ok := _c.mutation.CacheReadPrice(); !ok {
+		v := modelprice.DefaultCacheReadPrice
+		_c.mutation.SetCacheReadPrice(v)
+	}
+	if _, ok := _c.mutation.LongContextThreshold(); !ok {
+		v := modelprice.DefaultLongContextThreshold
+		_c.mutation.SetLongContextThreshold(v)
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		v := modelprice.DefaultNotes
+		_c.mutation.SetNotes(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModelPriceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModelPrice.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModelPrice.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "ModelPrice.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := modelprice.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputPrice(); !ok {
+		return &ValidationError{Name: "input_price", err: errors.New(`ent: missing required field "ModelPrice.input_price"`)}
+	}
+	if _, ok := _c.mutation.OutputPrice(); !ok {
+		return &ValidationError{Name: "output_price", err: errors.New(`ent: missing required field "ModelPrice.output_price"`)}
+	}
+	if _, ok := _c.mutation.CacheWritePrice(); !ok {
+		return &ValidationError{Name: "cache_write_price", err: errors.New(`ent: missing required field "ModelPrice.cache_write_price"`)}
+	}
+	if _, ok := _c.mutation.CacheReadPrice(); !ok {
+		return &ValidationError{Name: "cache_read_price", err: errors.New(`ent: missing required field "ModelPrice.cache_read_price"`)}
+	}
+	if _, ok := _c.mutation.LongContextThreshold(); !ok {
+		return &ValidationError{Name: "long_context_threshold", err: errors.New(`ent: missing required field "ModelPrice.long_context_threshold"`)}
+	}
+	if _, ok := _c.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "ModelPrice.effective_from"`)}
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		return &ValidationError{Name: "notes", err: errors.New(`ent: missing required field "ModelPrice.notes"`)}
+	}
+	return nil
+}
+
+func (_c *ModelPriceCreate) sqlSave(ctx context.Context) (*ModelPrice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModelPriceCreate) createSpec() (*ModelPrice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModelPrice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(modelprice.Table, sqlgraph.NewFieldSpec(modelprice.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(modelprice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelprice.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(modelprice.FieldGroupID, field.TypeInt64, value)
+		_node.GroupID = &value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(modelprice.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.InputPrice(); ok {
+		_spec.SetField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+		_node.InputPrice = value
+	}
+	if value, ok := _c.mutation.OutputPrice(); ok {
+		_spec.SetField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+		_node.OutputPrice = value
+	}
+	if value, ok := _c.mutation.CacheWritePrice(); ok {
+		_spec.SetField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+		_node.CacheWritePrice = value
+	}
+	if value, ok := _c.mutation.CacheReadPrice(); ok {
+		_spec.SetField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+		_node.CacheReadPrice = value
+	}
+	if value, ok := _c.mutation.LongContextThreshold(); ok {
+		_spec.SetField(modelprice.FieldLongContextThreshold, field.TypeInt, value)
+		_node.LongContextThreshold = value
+	}
+	if value, ok := _c.mutation.LongContextInputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextInputPrice, field.TypeFloat64, value)
+		_node.LongContextInputPrice = &value
+	}
+	if value, ok := _c.mutation.LongContextOutputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64, value)
+		_node.LongContextOutputPrice = &value
+	}
+	if value, ok := _c.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelprice.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(modelprice.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModelPrice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModelPriceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModelPriceCreate) OnConflict(opts ...sql.ConflictOption) *ModelPriceUpsertOne {
+	_c.conflict = opts
+	return &ModelPriceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModelPriceCreate) OnConflictColumns(columns ...string) *ModelPriceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModelPriceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ModelPriceUpsertOne is the builder for "upsert"-ing
+	//  one ModelPrice node.
+	ModelPriceUpsertOne struct {
+		create *ModelPriceCreate
+	}
+
+	// ModelPriceUpsert is the "OnConflict" setter.
+	ModelPriceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModelPriceUpsert) SetUpdatedAt(v time.Time) *ModelPriceUpsert {
+	u.Set(modelprice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateUpdatedAt() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldUpdatedAt)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ModelPriceUpsert) SetGroupID(v int64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateGroupID() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldGroupID)
+	return u
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *ModelPriceUpsert) AddGroupID(v int64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldGroupID, v)
+	return u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ModelPriceUpsert) ClearGroupID() *ModelPriceUpsert {
+	u.SetNull(modelprice.FieldGroupID)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *ModelPriceUpsert) SetModel(v string) *ModelPriceUpsert {
+	u.Set(modelprice.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateModel() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldModel)
+	return u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (u *ModelPriceUpsert) SetInputPrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldInputPrice, v)
+	return u
+}
+
+// UpdateInputPrice sets the "input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateInputPrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldInputPrice)
+	return u
+}
+
+// AddInputPrice adds v to the "input_price" field.
+func (u *ModelPriceUpsert) AddInputPrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldInputPrice, v)
+	return u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (u *ModelPriceUpsert) SetOutputPrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldOutputPrice, v)
+	return u
+}
+
+// UpdateOutputPrice sets the "output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateOutputPrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldOutputPrice)
+	return u
+}
+
+// AddOutputPrice adds v to the "output_price" field.
+func (u *ModelPriceUpsert) AddOutputPrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldOutputPrice, v)
+	return u
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (u *ModelPriceUpsert) SetCacheWritePrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldCacheWritePrice, v)
+	return u
+}
+
+// UpdateCacheWritePrice sets the "cache_write_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateCacheWritePrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldCacheWritePrice)
+	return u
+}
+
+// AddCacheWritePrice adds v to the "cache_write_price" field.
+func (u *ModelPriceUpsert) AddCacheWritePrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldCacheWritePrice, v)
+	return u
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (u *ModelPriceUpsert) SetCacheReadPrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldCacheReadPrice, v)
+	return u
+}
+
+// UpdateCacheReadPrice sets the "cache_read_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateCacheReadPrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldCacheReadPrice)
+	return u
+}
+
+// AddCacheReadPrice adds v to the "cache_read_price" field.
+func (u *ModelPriceUpsert) AddCacheReadPrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldCacheReadPrice, v)
+	return u
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (u *ModelPriceUpsert) SetLongContextThreshold(v int) *ModelPriceUpsert {
+	u.Set(modelprice.FieldLongContextThreshold, v)
+	return u
+}
+
+// UpdateLongContextThreshold sets the "long_context_threshold" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateLongContextThreshold() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldLongContextThreshold)
+	return u
+}
+
+// AddLongContextThreshold adds v to the "long_context_threshold" field.
+func (u *ModelPriceUpsert) AddLongContextThreshold(v int) *ModelPriceUpsert {
+	u.Add(modelprice.FieldLongContextThreshold, v)
+	return u
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (u *ModelPriceUpsert) SetLongContextInputPrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldLongContextInputPrice, v)
+	return u
+}
+
+// UpdateLongContextInputPrice sets the "long_context_input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateLongContextInputPrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldLongContextInputPrice)
+	return u
+}
+
+// AddLongContextInputPrice adds v to the "long_context_input_price" field.
+func (u *ModelPriceUpsert) AddLongContextInputPrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldLongContextInputPrice, v)
+	return u
+}
+
+// ClearLongContextInputPrice clears the value of the "long_context_input_price" field.
+func (u *ModelPriceUpsert) ClearLongContextInputPrice() *ModelPriceUpsert {
+	u.SetNull(modelprice.FieldLongContextInputPrice)
+	return u
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (u *ModelPriceUpsert) SetLongContextOutputPrice(v float64) *ModelPriceUpsert {
+	u.Set(modelprice.FieldLongContextOutputPrice, v)
+	return u
+}
+
+// UpdateLongContextOutputPrice sets the "long_context_output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateLongContextOutputPrice() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldLongContextOutputPrice)
+	return u
+}
+
+// AddLongContextOutputPrice adds v to the "long_context_output_price" field.
+func (u *ModelPriceUpsert) AddLongContextOutputPrice(v float64) *ModelPriceUpsert {
+	u.Add(modelprice.FieldLongContextOutputPrice, v)
+	return u
+}
+
+// ClearLongContextOutputPrice clears the value of the "long_context_output_price" field.
+func (u *ModelPriceUpsert) ClearLongContextOutputPrice() *ModelPriceUpsert {
+	u.SetNull(modelprice.FieldLongContextOutputPrice)
+	return u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *ModelPriceUpsert) SetEffectiveFrom(v time.Time) *ModelPriceUpsert {
+	u.Set(modelprice.FieldEffectiveFrom, v)
+	return u
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateEffectiveFrom() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldEffectiveFrom)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *ModelPriceUpsert) SetNotes(v string) *ModelPriceUpsert {
+	u.Set(modelprice.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ModelPriceUpsert) UpdateNotes() *ModelPriceUpsert {
+	u.SetExcluded(modelprice.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModelPriceUpsertOne) UpdateNewValues() *ModelPriceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(modelprice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModelPriceUpsertOne) Ignore() *ModelPriceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModelPriceUpsertOne) DoNothing() *ModelPriceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModelPriceCreate.OnConflict
+// documentation for more info.
+func (u *ModelPriceUpsertOne) Update(set func(*ModelPriceUpsert)) *ModelPriceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModelPriceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModelPriceUpsertOne) SetUpdatedAt(v time.Time) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateUpdatedAt() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ModelPriceUpsertOne) SetGroupID(v int64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *ModelPriceUpsertOne) AddGroupID(v int64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateGroupID() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ModelPriceUpsertOne) ClearGroupID() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ModelPriceUpsertOne) SetModel(v string) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateModel() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetInputPrice sets the "input_price" field.
+func (u *ModelPriceUpsertOne) SetInputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetInputPrice(v)
+	})
+}
+
+// AddInputPrice adds v to the "input_price" field.
+func (u *ModelPriceUpsertOne) AddInputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddInputPrice(v)
+	})
+}
+
+// UpdateInputPrice sets the "input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateInputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateInputPrice()
+	})
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (u *ModelPriceUpsertOne) SetOutputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetOutputPrice(v)
+	})
+}
+
+// AddOutputPrice adds v to the "output_price" field.
+func (u *ModelPriceUpsertOne) AddOutputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddOutputPrice(v)
+	})
+}
+
+// UpdateOutputPrice sets the "output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateOutputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateOutputPrice()
+	})
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (u *ModelPriceUpsertOne) SetCacheWritePrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetCacheWritePrice(v)
+	})
+}
+
+// AddCacheWritePrice adds v to the "cache_write_price" field.
+func (u *ModelPriceUpsertOne) AddCacheWritePrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddCacheWritePrice(v)
+	})
+}
+
+// UpdateCacheWritePrice sets the "cache_write_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateCacheWritePrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateCacheWritePrice()
+	})
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (u *ModelPriceUpsertOne) SetCacheReadPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetCacheReadPrice(v)
+	})
+}
+
+// AddCacheReadPrice adds v to the "cache_read_price" field.
+func (u *ModelPriceUpsertOne) AddCacheReadPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddCacheReadPrice(v)
+	})
+}
+
+// UpdateCacheReadPrice sets the "cache_read_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateCacheReadPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateCacheReadPrice()
+	})
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (u *ModelPriceUpsertOne) SetLongContextThreshold(v int) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextThreshold(v)
+	})
+}
+
+// AddLongContextThreshold adds v to the "long_context_threshold" field.
+func (u *ModelPriceUpsertOne) AddLongContextThreshold(v int) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextThreshold(v)
+	})
+}
+
+// UpdateLongContextThreshold sets the "long_context_threshold" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateLongContextThreshold() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextThreshold()
+	})
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (u *ModelPriceUpsertOne) SetLongContextInputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextInputPrice(v)
+	})
+}
+
+// AddLongContextInputPrice adds v to the "long_context_input_price" field.
+func (u *ModelPriceUpsertOne) AddLongContextInputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextInputPrice(v)
+	})
+}
+
+// UpdateLongContextInputPrice sets the "long_context_input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateLongContextInputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextInputPrice()
+	})
+}
+
+// ClearLongContextInputPrice clears the value of the "long_context_input_price" field.
+func (u *ModelPriceUpsertOne) ClearLongContextInputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearLongContextInputPrice()
+	})
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (u *ModelPriceUpsertOne) SetLongContextOutputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextOutputPrice(v)
+	})
+}
+
+// AddLongContextOutputPrice adds v to the "long_context_output_price" field.
+func (u *ModelPriceUpsertOne) AddLongContextOutputPrice(v float64) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextOutputPrice(v)
+	})
+}
+
+// UpdateLongContextOutputPrice sets the "long_context_output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateLongContextOutputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextOutputPrice()
+	})
+}
+
+// ClearLongContextOutputPrice clears the value of the "long_context_output_price" field.
+func (u *ModelPriceUpsertOne) ClearLongContextOutputPrice() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearLongContextOutputPrice()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *ModelPriceUpsertOne) SetEffectiveFrom(v time.Time) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateEffectiveFrom() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ModelPriceUpsertOne) SetNotes(v string) *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ModelPriceUpsertOne) UpdateNotes() *ModelPriceUpsertOne {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ModelPriceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModelPriceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModelPriceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModelPriceUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModelPriceUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModelPriceCreateBulk is the builder for creating many ModelPrice entities in bulk.
+type ModelPriceCreateBulk struct {
+	config
+	err      error
+	builders []*ModelPriceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModelPrice entities in the database.
+func (_c *ModelPriceCreateBulk) Save(ctx context.Context) ([]*ModelPrice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModelPrice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModelPriceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModelPriceCreateBulk) SaveX(ctx context.Context) []*ModelPrice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModelPriceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModelPriceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModelPrice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModelPriceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModelPriceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModelPriceUpsertBulk {
+	_c.conflict = opts
+	return &ModelPriceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModelPriceCreateBulk) OnConflictColumns(columns ...string) *ModelPriceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModelPriceUpsertBulk{
+		create: _c,
+	}
+}
+
+// ModelPriceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModelPrice nodes.
+type ModelPriceUpsertBulk struct {
+	create *ModelPriceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModelPriceUpsertBulk) UpdateNewValues() *ModelPriceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(modelprice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModelPrice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModelPriceUpsertBulk) Ignore() *ModelPriceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModelPriceUpsertBulk) DoNothing() *ModelPriceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModelPriceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModelPriceUpsertBulk) Update(set func(*ModelPriceUpsert)) *ModelPriceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModelPriceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModelPriceUpsertBulk) SetUpdatedAt(v time.Time) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateUpdatedAt() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *ModelPriceUpsertBulk) SetGroupID(v int64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *ModelPriceUpsertBulk) AddGroupID(v int64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateGroupID() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *ModelPriceUpsertBulk) ClearGroupID() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ModelPriceUpsertBulk) SetModel(v string) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateModel() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetInputPrice sets the "input_price" field.
+func (u *ModelPriceUpsertBulk) SetInputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetInputPrice(v)
+	})
+}
+
+// AddInputPrice adds v to the "input_price" field.
+func (u *ModelPriceUpsertBulk) AddInputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddInputPrice(v)
+	})
+}
+
+// UpdateInputPrice sets the "input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateInputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateInputPrice()
+	})
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (u *ModelPriceUpsertBulk) SetOutputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetOutputPrice(v)
+	})
+}
+
+// AddOutputPrice adds v to the "output_price" field.
+func (u *ModelPriceUpsertBulk) AddOutputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddOutputPrice(v)
+	})
+}
+
+// UpdateOutputPrice sets the "output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateOutputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateOutputPrice()
+	})
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (u *ModelPriceUpsertBulk) SetCacheWritePrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetCacheWritePrice(v)
+	})
+}
+
+// AddCacheWritePrice adds v to the "cache_write_price" field.
+func (u *ModelPriceUpsertBulk) AddCacheWritePrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddCacheWritePrice(v)
+	})
+}
+
+// UpdateCacheWritePrice sets the "cache_write_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateCacheWritePrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateCacheWritePrice()
+	})
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (u *ModelPriceUpsertBulk) SetCacheReadPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetCacheReadPrice(v)
+	})
+}
+
+// AddCacheReadPrice adds v to the "cache_read_price" field.
+func (u *ModelPriceUpsertBulk) AddCacheReadPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddCacheReadPrice(v)
+	})
+}
+
+// UpdateCacheReadPrice sets the "cache_read_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateCacheReadPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateCacheReadPrice()
+	})
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (u *ModelPriceUpsertBulk) SetLongContextThreshold(v int) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextThreshold(v)
+	})
+}
+
+// AddLongContextThreshold adds v to the "long_context_threshold" field.
+func (u *ModelPriceUpsertBulk) AddLongContextThreshold(v int) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextThreshold(v)
+	})
+}
+
+// UpdateLongContextThreshold sets the "long_context_threshold" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateLongContextThreshold() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextThreshold()
+	})
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (u *ModelPriceUpsertBulk) SetLongContextInputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextInputPrice(v)
+	})
+}
+
+// AddLongContextInputPrice adds v to the "long_context_input_price" field.
+func (u *ModelPriceUpsertBulk) AddLongContextInputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextInputPrice(v)
+	})
+}
+
+// UpdateLongContextInputPrice sets the "long_context_input_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateLongContextInputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextInputPrice()
+	})
+}
+
+// ClearLongContextInputPrice clears the value of the "long_context_input_price" field.
+func (u *ModelPriceUpsertBulk) ClearLongContextInputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearLongContextInputPrice()
+	})
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (u *ModelPriceUpsertBulk) SetLongContextOutputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetLongContextOutputPrice(v)
+	})
+}
+
+// AddLongContextOutputPrice adds v to the "long_context_output_price" field.
+func (u *ModelPriceUpsertBulk) AddLongContextOutputPrice(v float64) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.AddLongContextOutputPrice(v)
+	})
+}
+
+// UpdateLongContextOutputPrice sets the "long_context_output_price" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateLongContextOutputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateLongContextOutputPrice()
+	})
+}
+
+// ClearLongContextOutputPrice clears the value of the "long_context_output_price" field.
+func (u *ModelPriceUpsertBulk) ClearLongContextOutputPrice() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.ClearLongContextOutputPrice()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *ModelPriceUpsertBulk) SetEffectiveFrom(v time.Time) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateEffectiveFrom() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ModelPriceUpsertBulk) SetNotes(v string) *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ModelPriceUpsertBulk) UpdateNotes() *ModelPriceUpsertBulk {
+	return u.Update(func(s *ModelPriceUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ModelPriceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModelPriceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModelPriceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModelPriceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,826 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ModelPriceUpdate is the builder for updating ModelPrice entities.
+type ModelPriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModelPriceMutation
+}
+
+// Where appends a list predicates to the ModelPriceUpdate builder.
+func (_u *ModelPriceUpdate) Where(ps ...predicate.ModelPrice) *ModelPriceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPriceUpdate) SetUpdatedAt(v time.Time) *ModelPriceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ModelPriceUpdate) SetGroupID(v int64) *ModelPriceUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableGroupID(v *int64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *ModelPriceUpdate) AddGroupID(v int64) *ModelPriceUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ModelPriceUpdate) ClearGroupID() *ModelPriceUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPriceUpdate) SetModel(v string) *ModelPriceUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableModel(v *string) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPriceUpdate) SetInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableInputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPriceUpdate) AddInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPriceUpdate) SetOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableOutputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPriceUpdate) AddOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (_u *ModelPriceUpdate) SetCacheWritePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetCacheWritePrice()
+	_u.mutation.SetCacheWritePrice(v)
+	return _u
+}
+
+// SetNillableCacheWritePrice sets the "cache_write_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableCacheWritePrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetCacheWritePrice(*v)
+	}
+	return _u
+}
+
+// AddCacheWritePrice adds value to the "cache_write_price" field.
+func (_u *ModelPriceUpdate) AddCacheWritePrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddCacheWritePrice(v)
+	return _u
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (_u *ModelPriceUpdate) SetCacheReadPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetCacheReadPrice()
+	_u.mutation.SetCacheReadPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadPrice sets the "cache_read_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableCacheReadPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetCacheReadPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadPrice adds value to the "cache_read_price" field.
+func (_u *ModelPriceUpdate) AddCacheReadPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddCacheReadPrice(v)
+	return _u
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (_u *ModelPriceUpdate) SetLongContextThreshold(v int) *ModelPriceUpdate {
+	_u.mutation.ResetLongContextThreshold()
+	_u.mutation.SetLongContextThreshold(v)
+	return _u
+}
+
+// SetNillableLongContextThreshold sets the "long_context_threshold" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableLongContextThreshold(v *int) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetLongContextThreshold(*v)
+	}
+	return _u
+}
+
+// AddLongContextThreshold adds value to the "long_context_threshold" field.
+func (_u *ModelPriceUpdate) AddLongContextThreshold(v int) *ModelPriceUpdate {
+	_u.mutation.AddLongContextThreshold(v)
+	return _u
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (_u *ModelPriceUpdate) SetLongContextInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetLongContextInputPrice()
+	_u.mutation.SetLongContextInputPrice(v)
+	return _u
+}
+
+// SetNillableLongContextInputPrice sets the "long_context_input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableLongContextInputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetLongContextInputPrice(*v)
+	}
+	return _u
+}
+
+// AddLongContextInputPrice adds value to the "long_context_input_price" field.
+func (_u *ModelPriceUpdate) AddLongContextInputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddLongContextInputPrice(v)
+	return _u
+}
+
+// ClearLongContextInputPrice clears the value of the "long_context_input_price" field.
+func (_u *ModelPriceUpdate) ClearLongContextInputPrice() *ModelPriceUpdate {
+	_u.mutation.ClearLongContextInputPrice()
+	return _u
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (_u *ModelPriceUpdate) SetLongContextOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.ResetLongContextOutputPrice()
+	_u.mutation.SetLongContextOutputPrice(v)
+	return _u
+}
+
+// SetNillableLongContextOutputPrice sets the "long_context_output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableLongContextOutputPrice(v *float64) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetLongContextOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddLongContextOutputPrice adds value to the "long_context_output_price" field.
+func (_u *ModelPriceUpdate) AddLongContextOutputPrice(v float64) *ModelPriceUpdate {
+	_u.mutation.AddLongContextOutputPrice(v)
+	return _u
+}
+
+// ClearLongContextOutputPrice clears the value of the "long_context_output_price" field.
+func (_u *ModelPriceUpdate) ClearLongContextOutputPrice() *ModelPriceUpdate {
+	_u.mutation.ClearLongContextOutputPrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPriceUpdate) SetEffectiveFrom(v time.Time) *ModelPriceUpdate {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableEffectiveFrom(v *time.Time) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ModelPriceUpdate) SetNotes(v string) *ModelPriceUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ModelPriceUpdate) SetNillableNotes(v *string) *ModelPriceUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ModelPriceMutation object of the builder.
+func (_u *ModelPriceUpdate) Mutation() *ModelPriceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModelPriceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModelPriceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPriceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPriceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelprice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPriceUpdate) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelprice.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.model": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPriceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelprice.Table, modelprice.Columns, sqlgraph.NewFieldSpec(modelprice.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelprice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(modelprice.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelprice.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheWritePrice(); ok {
+		_spec.SetField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheWritePrice(); ok {
+		_spec.AddField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheReadPrice(); ok {
+		_spec.SetField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadPrice(); ok {
+		_spec.AddField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LongContextThreshold(); ok {
+		_spec.SetField(modelprice.FieldLongContextThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextThreshold(); ok {
+		_spec.AddField(modelprice.FieldLongContextThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LongContextInputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextInputPrice(); ok {
+		_spec.AddField(modelprice.FieldLongContextInputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongContextInputPriceCleared() {
+		_spec.ClearField(modelprice.FieldLongContextInputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LongContextOutputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongContextOutputPriceCleared() {
+		_spec.ClearField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelprice.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(modelprice.FieldNotes, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModelPriceUpdateOne is the builder for updating a single ModelPrice entity.
+type ModelPriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModelPriceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModelPriceUpdateOne) SetUpdatedAt(v time.Time) *ModelPriceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ModelPriceUpdateOne) SetGroupID(v int64) *ModelPriceUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableGroupID(v *int64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *ModelPriceUpdateOne) AddGroupID(v int64) *ModelPriceUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *ModelPriceUpdateOne) ClearGroupID() *ModelPriceUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ModelPriceUpdateOne) SetModel(v string) *ModelPriceUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableModel(v *string) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetInputPrice sets the "input_price" field.
+func (_u *ModelPriceUpdateOne) SetInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetInputPrice()
+	_u.mutation.SetInputPrice(v)
+	return _u
+}
+
+// SetNillableInputPrice sets the "input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableInputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetInputPrice(*v)
+	}
+	return _u
+}
+
+// AddInputPrice adds value to the "input_price" field.
+func (_u *ModelPriceUpdateOne) AddInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddInputPrice(v)
+	return _u
+}
+
+// SetOutputPrice sets the "output_price" field.
+func (_u *ModelPriceUpdateOne) SetOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetOutputPrice()
+	_u.mutation.SetOutputPrice(v)
+	return _u
+}
+
+// SetNillableOutputPrice sets the "output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableOutputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputPrice adds value to the "output_price" field.
+func (_u *ModelPriceUpdateOne) AddOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddOutputPrice(v)
+	return _u
+}
+
+// SetCacheWritePrice sets the "cache_write_price" field.
+func (_u *ModelPriceUpdateOne) SetCacheWritePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetCacheWritePrice()
+	_u.mutation.SetCacheWritePrice(v)
+	return _u
+}
+
+// SetNillableCacheWritePrice sets the "cache_write_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableCacheWritePrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetCacheWritePrice(*v)
+	}
+	return _u
+}
+
+// AddCacheWritePrice adds value to the "cache_write_price" field.
+func (_u *ModelPriceUpdateOne) AddCacheWritePrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddCacheWritePrice(v)
+	return _u
+}
+
+// SetCacheReadPrice sets the "cache_read_price" field.
+func (_u *ModelPriceUpdateOne) SetCacheReadPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetCacheReadPrice()
+	_u.mutation.SetCacheReadPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadPrice sets the "cache_read_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableCacheReadPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetCacheReadPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadPrice adds value to the "cache_read_price" field.
+func (_u *ModelPriceUpdateOne) AddCacheReadPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddCacheReadPrice(v)
+	return _u
+}
+
+// SetLongContextThreshold sets the "long_context_threshold" field.
+func (_u *ModelPriceUpdateOne) SetLongContextThreshold(v int) *ModelPriceUpdateOne {
+	_u.mutation.ResetLongContextThreshold()
+	_u.mutation.SetLongContextThreshold(v)
+	return _u
+}
+
+// SetNillableLongContextThreshold sets the "long_context_threshold" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableLongContextThreshold(v *int) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetLongContextThreshold(*v)
+	}
+	return _u
+}
+
+// AddLongContextThreshold adds value to the "long_context_threshold" field.
+func (_u *ModelPriceUpdateOne) AddLongContextThreshold(v int) *ModelPriceUpdateOne {
+	_u.mutation.AddLongContextThreshold(v)
+	return _u
+}
+
+// SetLongContextInputPrice sets the "long_context_input_price" field.
+func (_u *ModelPriceUpdateOne) SetLongContextInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetLongContextInputPrice()
+	_u.mutation.SetLongContextInputPrice(v)
+	return _u
+}
+
+// SetNillableLongContextInputPrice sets the "long_context_input_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableLongContextInputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetLongContextInputPrice(*v)
+	}
+	return _u
+}
+
+// AddLongContextInputPrice adds value to the "long_context_input_price" field.
+func (_u *ModelPriceUpdateOne) AddLongContextInputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddLongContextInputPrice(v)
+	return _u
+}
+
+// ClearLongContextInputPrice clears the value of the "long_context_input_price" field.
+func (_u *ModelPriceUpdateOne) ClearLongContextInputPrice() *ModelPriceUpdateOne {
+	_u.mutation.ClearLongContextInputPrice()
+	return _u
+}
+
+// SetLongContextOutputPrice sets the "long_context_output_price" field.
+func (_u *ModelPriceUpdateOne) SetLongContextOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.ResetLongContextOutputPrice()
+	_u.mutation.SetLongContextOutputPrice(v)
+	return _u
+}
+
+// SetNillableLongContextOutputPrice sets the "long_context_output_price" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableLongContextOutputPrice(v *float64) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetLongContextOutputPrice(*v)
+	}
+	return _u
+}
+
+// AddLongContextOutputPrice adds value to the "long_context_output_price" field.
+func (_u *ModelPriceUpdateOne) AddLongContextOutputPrice(v float64) *ModelPriceUpdateOne {
+	_u.mutation.AddLongContextOutputPrice(v)
+	return _u
+}
+
+// ClearLongContextOutputPrice clears the value of the "long_context_output_price" field.
+func (_u *ModelPriceUpdateOne) ClearLongContextOutputPrice() *ModelPriceUpdateOne {
+	_u.mutation.ClearLongContextOutputPrice()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *ModelPriceUpdateOne) SetEffectiveFrom(v time.Time) *ModelPriceUpdateOne {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableEffectiveFrom(v *time.Time) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ModelPriceUpdateOne) SetNotes(v string) *ModelPriceUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ModelPriceUpdateOne) SetNillableNotes(v *string) *ModelPriceUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ModelPriceMutation object of the builder.
+func (_u *ModelPriceUpdateOne) Mutation() *ModelPriceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModelPriceUpdate builder.
+func (_u *ModelPriceUpdateOne) Where(ps ...predicate.ModelPrice) *ModelPriceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModelPriceUpdateOne) Select(field string, fields ...string) *ModelPriceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModelPrice entity.
+func (_u *ModelPriceUpdateOne) Save(ctx context.Context) (*ModelPrice, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModelPriceUpdateOne) SaveX(ctx context.Context) *ModelPrice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModelPriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModelPriceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModelPriceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := modelprice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModelPriceUpdateOne) check() error {
+	if v, ok := _u.mutation.Model(); ok {
+		if err := modelprice.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "ModelPrice.model": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModelPriceUpdateOne) sqlSave(ctx context.Context) (_node *ModelPrice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelprice.Table, modelprice.Columns, sqlgraph.NewFieldSpec(modelprice.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModelPrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelprice.FieldID)
+		for _, f := range fields {
+			if !modelprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != modelprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(modelprice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(modelprice.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(modelprice.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(modelprice.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputPrice(); ok {
+		_spec.SetField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputPrice(); ok {
+		_spec.AddField(modelprice.FieldInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OutputPrice(); ok {
+		_spec.SetField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheWritePrice(); ok {
+		_spec.SetField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheWritePrice(); ok {
+		_spec.AddField(modelprice.FieldCacheWritePrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CacheReadPrice(); ok {
+		_spec.SetField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadPrice(); ok {
+		_spec.AddField(modelprice.FieldCacheReadPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.LongContextThreshold(); ok {
+		_spec.SetField(modelprice.FieldLongContextThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextThreshold(); ok {
+		_spec.AddField(modelprice.FieldLongContextThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LongContextInputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextInputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextInputPrice(); ok {
+		_spec.AddField(modelprice.FieldLongContextInputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongContextInputPriceCleared() {
+		_spec.ClearField(modelprice.FieldLongContextInputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LongContextOutputPrice(); ok {
+		_spec.SetField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongContextOutputPrice(); ok {
+		_spec.AddField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongContextOutputPriceCleared() {
+		_spec.ClearField(modelprice.FieldLongContextOutputPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(modelprice.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(modelprice.FieldNotes, field.TypeString, value)
+	}
+	_node = &ModelPrice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -131,6 +131,34 @@ func (_c *ProxyCreate) SetNillableStatus(v *string) *ProxyCreate {
 	return _c
 }
 
+// SetPoolID sets the "pool_id" field.
+func (_c *ProxyCreate) SetPoolID(v int64) *ProxyCreate {
+	_c.mutation.SetPoolID(v)
+	return _c
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_c *ProxyCreate) SetNillablePoolID(v *int64) *ProxyCreate {
+	if v != nil {
+		_c.SetPoolID(*v)
+	}
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *ProxyCreate) SetSubscriptionID(v int64) *ProxyCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_c *ProxyCreate) SetNillableSubscriptionID(v *int64) *ProxyCreate {
+	if v != nil {
+		_c.SetSubscriptionID(*v)
+	}
+	return _c
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (_c *ProxyCreate) AddAccountIDs(ids ...int64) *ProxyCreate {
 	_c.mutation.AddAccountIDs(ids...)
@@ -244,11 +272,6 @@ func (_c *ProxyCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Proxy.username": %w`, err)}
 		}
 	}
-	if v, ok := _c.mutation.Password(); ok {
-		if err := proxy.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Proxy.password": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Proxy.status"`)}
 	}
@@ -324,6 +347,14 @@ func (_c *ProxyCreate) createSpec() (*Proxy, *sqlgraph.CreateSpec) {
 		_spec.SetField(proxy.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.PoolID(); ok {
+		_spec.SetField(proxy.FieldPoolID, field.TypeInt64, value)
+		_node.PoolID = &value
+	}
+	if value, ok := _c.mutation.SubscriptionID(); ok {
+		_spec.SetField(proxy.FieldSubscriptionID, field.TypeInt64, value)
+		_node.SubscriptionID = &value
+	}
 	if nodes := _c.mutation.AccountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -524,6 +555,54 @@ func (u *ProxyUpsert) UpdateStatus() *ProxyUpsert {
 	return u
 }
 
+// SetPoolID sets the "pool_id" field.
+func (u *ProxyUpsert) SetPoolID(v int64) *ProxyUpsert {
+	u.Set(proxy.FieldPoolID, v)
+	return u
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxyUpsert) UpdatePoolID() *ProxyUpsert {
+	u.SetExcluded(proxy.FieldPoolID)
+	return u
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxyUpsert) AddPoolID(v int64) *ProxyUpsert {
+	u.Add(proxy.FieldPoolID, v)
+	return u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxyUpsert) ClearPoolID() *ProxyUpsert {
+	u.SetNull(proxy.FieldPoolID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *ProxyUpsert) SetSubscriptionID(v int64) *ProxyUpsert {
+	u.Set(proxy.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *ProxyUpsert) UpdateSubscriptionID() *ProxyUpsert {
+	u.SetExcluded(proxy.FieldSubscriptionID)
+	return u
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *ProxyUpsert) AddSubscriptionID(v int64) *ProxyUpsert {
+	u.Add(proxy.FieldSubscriptionID, v)
+	return u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *ProxyUpsert) ClearSubscriptionID() *ProxyUpsert {
+	u.SetNull(proxy.FieldSubscriptionID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -723,6 +802,62 @@ func (u *ProxyUpsertOne) UpdateStatus() *ProxyUpsertOne {
 	})
 }
 
+// SetPoolID sets the "pool_id" field.
+func (u *ProxyUpsertOne) SetPoolID(v int64) *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxyUpsertOne) AddPoolID(v int64) *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.AddPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxyUpsertOne) UpdatePoolID() *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxyUpsertOne) ClearPoolID() *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.ClearPoolID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *ProxyUpsertOne) SetSubscriptionID(v int64) *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *ProxyUpsertOne) AddSubscriptionID(v int64) *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.AddSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *ProxyUpsertOne) UpdateSubscriptionID() *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *ProxyUpsertOne) ClearSubscriptionID() *ProxyUpsertOne {
+	return u.Update(func(s *ProxyUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
 // Exec executes the query.
 func (u *ProxyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1088,6 +1223,62 @@ func (u *ProxyUpsertBulk) UpdateStatus() *ProxyUpsertBulk {
 	})
 }
 
+// SetPoolID sets the "pool_id" field.
+func (u *ProxyUpsertBulk) SetPoolID(v int64) *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxyUpsertBulk) AddPoolID(v int64) *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.AddPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxyUpsertBulk) UpdatePoolID() *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxyUpsertBulk) ClearPoolID() *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.ClearPoolID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *ProxyUpsertBulk) SetSubscriptionID(v int64) *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *ProxyUpsertBulk) AddSubscriptionID(v int64) *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.AddSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *ProxyUpsertBulk) UpdateSubscriptionID() *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *ProxyUpsertBulk) ClearSubscriptionID() *ProxyUpsertBulk {
+	return u.Update(func(s *ProxyUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
 // Exec executes the query.
 func (u *ProxyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -172,6 +172,60 @@ func (_u *ProxyUpdate) SetNillableStatus(v *string) *ProxyUpdate {
 	return _u
 }
 
+// SetPoolID sets the "pool_id" field.
+func (_u *ProxyUpdate) SetPoolID(v int64) *ProxyUpdate {
+	_u.mutation.ResetPoolID()
+	_u.mutation.SetPoolID(v)
+	return _u
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_u *ProxyUpdate) SetNillablePoolID(v *int64) *ProxyUpdate {
+	if v != nil {
+		_u.SetPoolID(*v)
+	}
+	return _u
+}
+
+// AddPoolID adds value to the "pool_id" field.
+func (_u *ProxyUpdate) AddPoolID(v int64) *ProxyUpdate {
+	_u.mutation.AddPoolID(v)
+	return _u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (_u *ProxyUpdate) ClearPoolID() *ProxyUpdate {
+	_u.mutation.ClearPoolID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *ProxyUpdate) SetSubscriptionID(v int64) *ProxyUpdate {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *ProxyUpdate) SetNillableSubscriptionID(v *int64) *ProxyUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *ProxyUpdate) AddSubscriptionID(v int64) *ProxyUpdate {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *ProxyUpdate) ClearSubscriptionID() *ProxyUpdate {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (_u *ProxyUpdate) AddAccountIDs(ids ...int64) *ProxyUpdate {
 	_u.mutation.AddAccountIDs(ids...)
@@ -277,11 +331,6 @@ func (_u *ProxyUpdate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Proxy.username": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Password(); ok {
-		if err := proxy.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Proxy.password": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := proxy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Proxy.status": %w`, err)}
@@ -341,6 +390,24 @@ func (_u *ProxyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(proxy.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PoolID(); ok {
+		_spec.SetField(proxy.FieldPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPoolID(); ok {
+		_spec.AddField(proxy.FieldPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.PoolIDCleared() {
+		_spec.ClearField(proxy.FieldPoolID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(proxy.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(proxy.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(proxy.FieldSubscriptionID, field.TypeInt64)
+	}
 	if _u.mutation.AccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -549,6 +616,60 @@ func (_u *ProxyUpdateOne) SetNillableStatus(v *string) *ProxyUpdateOne {
 	return _u
 }
 
+// SetPoolID sets the "pool_id" field.
+func (_u *ProxyUpdateOne) SetPoolID(v int64) *ProxyUpdateOne {
+	_u.mutation.ResetPoolID()
+	_u.mutation.SetPoolID(v)
+	return _u
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_u *ProxyUpdateOne) SetNillablePoolID(v *int64) *ProxyUpdateOne {
+	if v != nil {
+		_u.SetPoolID(*v)
+	}
+	return _u
+}
+
+// AddPoolID adds value to the "pool_id" field.
+func (_u *ProxyUpdateOne) AddPoolID(v int64) *ProxyUpdateOne {
+	_u.mutation.AddPoolID(v)
+	return _u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (_u *ProxyUpdateOne) ClearPoolID() *ProxyUpdateOne {
+	_u.mutation.ClearPoolID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *ProxyUpdateOne) SetSubscriptionID(v int64) *ProxyUpdateOne {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *ProxyUpdateOne) SetNillableSubscriptionID(v *int64) *ProxyUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *ProxyUpdateOne) AddSubscriptionID(v int64) *ProxyUpdateOne {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *ProxyUpdateOne) ClearSubscriptionID() *ProxyUpdateOne {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (_u *ProxyUpdateOne) AddAccountIDs(ids ...int64) *ProxyUpdateOne {
 	_u.mutation.AddAccountIDs(ids...)
@@ -667,11 +788,6 @@ func (_u *ProxyUpdateOne) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "Proxy.username": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Password(); ok {
-		if err := proxy.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Proxy.password": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := proxy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Proxy.status": %w`, err)}
@@ -748,6 +864,24 @@ func (_u *ProxyUpdateOne) sqlSave(ctx context.Context) (_node *Proxy, err error)
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(proxy.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PoolID(); ok {
+		_spec.SetField(proxy.FieldPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPoolID(); ok {
+		_spec.AddField(proxy.FieldPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.PoolIDCleared() {
+		_spec.ClearField(proxy.FieldPoolID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(proxy.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(proxy.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(proxy.FieldSubscriptionID, field.TypeInt64)
+	}
 	if _u.mutation.AccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
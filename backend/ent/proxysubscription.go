@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/proxysubscription"
+)
+
+// ProxySubscription is the model entity for the ProxySubscription schema.
+type ProxySubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// 自动同步间隔（分钟），0 表示仅手动同步
+	RefreshIntervalMinutes int `json:"refresh_interval_minutes,omitempty"`
+	// PoolID holds the value of the "pool_id" field.
+	PoolID *int64 `json:"pool_id,omitempty"`
+	// 状态: active, inactive
+	Status string `json:"status,omitempty"`
+	// LastSyncedAt holds the value of the "last_synced_at" field.
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// 最近一次同步解析出的可导入代理数
+	LastTotal int `json:"last_total,omitempty"`
+	// LastCreated holds the value of the "last_created" field.
+	LastCreated int `json:"last_created,omitempty"`
+	// LastSkipped holds the value of the "last_skipped" field.
+	LastSkipped int `json:"last_skipped,omitempty"`
+	// LastDisabled holds the value of the "last_disabled" field.
+	LastDisabled int `json:"last_disabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProxySubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case proxysubscription.FieldID, proxysubscription.FieldRefreshIntervalMinutes, proxysubscription.FieldPoolID, proxysubscription.FieldLastTotal, proxysubscription.FieldLastCreated, proxysubscription.FieldLastSkipped, proxysubscription.FieldLastDisabled:
+			values[i] = new(sql.NullInt64)
+		case proxysubscription.FieldName, proxysubscription.FieldURL, proxysubscription.FieldStatus, proxysubscription.FieldLastError:
+			values[i] = new(sql.NullString)
+		case proxysubscription.FieldCreatedAt, proxysubscription.FieldUpdatedAt, proxysubscription.FieldDeletedAt, proxysubscription.FieldLastSyncedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProxySubscription fields.
+func (_m *ProxySubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case proxysubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case proxysubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case proxysubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case proxysubscription.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case proxysubscription.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case proxysubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case proxysubscription.FieldRefreshIntervalMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_interval_minutes", values[i])
+			} else if value.Valid {
+				_m.RefreshIntervalMinutes = int(value.Int64)
+			}
+		case proxysubscription.FieldPoolID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pool_id", values[i])
+			} else if value.Valid {
+				_m.PoolID = new(int64)
+				*_m.PoolID = value.Int64
+			}
+		case proxysubscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case proxysubscription.FieldLastSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_synced_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncedAt = new(time.Time)
+				*_m.LastSyncedAt = value.Time
+			}
+		case proxysubscription.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case proxysubscription.FieldLastTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_total", values[i])
+			} else if value.Valid {
+				_m.LastTotal = int(value.Int64)
+			}
+		case proxysubscription.FieldLastCreated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_created", values[i])
+			} else if value.Valid {
+				_m.LastCreated = int(value.Int64)
+			}
+		case proxysubscription.FieldLastSkipped:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_skipped", values[i])
+			} else if value.Valid {
+				_m.LastSkipped = int(value.Int64)
+			}
+		case proxysubscription.FieldLastDisabled:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_disabled", values[i])
+			} else if value.Valid {
+				_m.LastDisabled = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProxySubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProxySubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProxySubscription.
+// Note that you need to call ProxySubscription.Unwrap() before calling this method if this ProxySubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProxySubscription) Update() *ProxySubscriptionUpdateOne {
+	return NewProxySubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProxySubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProxySubscription) Unwrap() *ProxySubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProxySubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProxySubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProxySubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("refresh_interval_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefreshIntervalMinutes))
+	builder.WriteString(", ")
+	if v := _m.PoolID; v != nil {
+		builder.WriteString("pool_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.LastSyncedAt; v != nil {
+		builder.WriteString("last_synced_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("last_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastTotal))
+	builder.WriteString(", ")
+	builder.WriteString("last_created=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastCreated))
+	builder.WriteString(", ")
+	builder.WriteString("last_skipped=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSkipped))
+	builder.WriteString(", ")
+	builder.WriteString("last_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastDisabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProxySubscriptions is a parsable slice of ProxySubscription.
+type ProxySubscriptions []*ProxySubscription
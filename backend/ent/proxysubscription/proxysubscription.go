@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proxysubscription
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the proxysubscription type in the database.
+	Label = "proxy_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldRefreshIntervalMinutes holds the string denoting the refresh_interval_minutes field in the database.
+	FieldRefreshIntervalMinutes = "refresh_interval_minutes"
+	// FieldPoolID holds the string denoting the pool_id field in the database.
+	FieldPoolID = "pool_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLastSyncedAt holds the string denoting the last_synced_at field in the database.
+	FieldLastSyncedAt = "last_synced_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastTotal holds the string denoting the last_total field in the database.
+	FieldLastTotal = "last_total"
+	// FieldLastCreated holds the string denoting the last_created field in the database.
+	FieldLastCreated = "last_created"
+	// FieldLastSkipped holds the string denoting the last_skipped field in the database.
+	FieldLastSkipped = "last_skipped"
+	// FieldLastDisabled holds the string denoting the last_disabled field in the database.
+	FieldLastDisabled = "last_disabled"
+	// Table holds the table name of the proxysubscription in the database.
+	Table = "proxy_subscriptions"
+)
+
+// Columns holds all SQL columns for proxysubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldURL,
+	FieldRefreshIntervalMinutes,
+	FieldPoolID,
+	FieldStatus,
+	FieldLastSyncedAt,
+	FieldLastError,
+	FieldLastTotal,
+	FieldLastCreated,
+	FieldLastSkipped,
+	FieldLastDisabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/Wei-Shaw/sub2api/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultRefreshIntervalMinutes holds the default value on creation for the "refresh_interval_minutes" field.
+	DefaultRefreshIntervalMinutes int
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultLastError holds the default value on creation for the "last_error" field.
+	DefaultLastError string
+	// DefaultLastTotal holds the default value on creation for the "last_total" field.
+	DefaultLastTotal int
+	// DefaultLastCreated holds the default value on creation for the "last_created" field.
+	DefaultLastCreated int
+	// DefaultLastSkipped holds the default value on creation for the "last_skipped" field.
+	DefaultLastSkipped int
+	// DefaultLastDisabled holds the default value on creation for the "last_disabled" field.
+	DefaultLastDisabled int
+)
+
+// OrderOption defines the ordering options for the ProxySubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByRefreshIntervalMinutes orders the results by the refresh_interval_minutes field.
+func ByRefreshIntervalMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshIntervalMinutes, opts...).ToFunc()
+}
+
+// ByPoolID orders the results by the pool_id field.
+func ByPoolID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoolID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLastSyncedAt orders the results by the last_synced_at field.
+func ByLastSyncedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncedAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastTotal orders the results by the last_total field.
+func ByLastTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTotal, opts...).ToFunc()
+}
+
+// ByLastCreated orders the results by the last_created field.
+func ByLastCreated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastCreated, opts...).ToFunc()
+}
+
+// ByLastSkipped orders the results by the last_skipped field.
+func ByLastSkipped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSkipped, opts...).ToFunc()
+}
+
+// ByLastDisabled orders the results by the last_disabled field.
+func ByLastDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDisabled, opts...).ToFunc()
+}
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proxysubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// RefreshIntervalMinutes applies equality check predicate on the "refresh_interval_minutes" field. It's identical to RefreshIntervalMinutesEQ.
+func RefreshIntervalMinutes(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldRefreshIntervalMinutes, v))
+}
+
+// PoolID applies equality check predicate on the "pool_id" field. It's identical to PoolIDEQ.
+func PoolID(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldPoolID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// LastSyncedAt applies equality check predicate on the "last_synced_at" field. It's identical to LastSyncedAtEQ.
+func LastSyncedAt(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastTotal applies equality check predicate on the "last_total" field. It's identical to LastTotalEQ.
+func LastTotal(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastTotal, v))
+}
+
+// LastCreated applies equality check predicate on the "last_created" field. It's identical to LastCreatedEQ.
+func LastCreated(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastCreated, v))
+}
+
+// LastSkipped applies equality check predicate on the "last_skipped" field. It's identical to LastSkippedEQ.
+func LastSkipped(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastSkipped, v))
+}
+
+// LastDisabled applies equality check predicate on the "last_disabled" field. It's identical to LastDisabledEQ.
+func LastDisabled(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastDisabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContainsFold(FieldURL, v))
+}
+
+// RefreshIntervalMinutesEQ applies the EQ predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldRefreshIntervalMinutes, v))
+}
+
+// RefreshIntervalMinutesNEQ applies the NEQ predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesNEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldRefreshIntervalMinutes, v))
+}
+
+// RefreshIntervalMinutesIn applies the In predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldRefreshIntervalMinutes, vs...))
+}
+
+// RefreshIntervalMinutesNotIn applies the NotIn predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesNotIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldRefreshIntervalMinutes, vs...))
+}
+
+// RefreshIntervalMinutesGT applies the GT predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesGT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldRefreshIntervalMinutes, v))
+}
+
+// RefreshIntervalMinutesGTE applies the GTE predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesGTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldRefreshIntervalMinutes, v))
+}
+
+// RefreshIntervalMinutesLT applies the LT predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesLT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldRefreshIntervalMinutes, v))
+}
+
+// RefreshIntervalMinutesLTE applies the LTE predicate on the "refresh_interval_minutes" field.
+func RefreshIntervalMinutesLTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldRefreshIntervalMinutes, v))
+}
+
+// PoolIDEQ applies the EQ predicate on the "pool_id" field.
+func PoolIDEQ(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldPoolID, v))
+}
+
+// PoolIDNEQ applies the NEQ predicate on the "pool_id" field.
+func PoolIDNEQ(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldPoolID, v))
+}
+
+// PoolIDIn applies the In predicate on the "pool_id" field.
+func PoolIDIn(vs ...int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldPoolID, vs...))
+}
+
+// PoolIDNotIn applies the NotIn predicate on the "pool_id" field.
+func PoolIDNotIn(vs ...int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldPoolID, vs...))
+}
+
+// PoolIDGT applies the GT predicate on the "pool_id" field.
+func PoolIDGT(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldPoolID, v))
+}
+
+// PoolIDGTE applies the GTE predicate on the "pool_id" field.
+func PoolIDGTE(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldPoolID, v))
+}
+
+// PoolIDLT applies the LT predicate on the "pool_id" field.
+func PoolIDLT(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldPoolID, v))
+}
+
+// PoolIDLTE applies the LTE predicate on the "pool_id" field.
+func PoolIDLTE(v int64) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldPoolID, v))
+}
+
+// PoolIDIsNil applies the IsNil predicate on the "pool_id" field.
+func PoolIDIsNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIsNull(FieldPoolID))
+}
+
+// PoolIDNotNil applies the NotNil predicate on the "pool_id" field.
+func PoolIDNotNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotNull(FieldPoolID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LastSyncedAtEQ applies the EQ predicate on the "last_synced_at" field.
+func LastSyncedAtEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtNEQ applies the NEQ predicate on the "last_synced_at" field.
+func LastSyncedAtNEQ(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIn applies the In predicate on the "last_synced_at" field.
+func LastSyncedAtIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtNotIn applies the NotIn predicate on the "last_synced_at" field.
+func LastSyncedAtNotIn(vs ...time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtGT applies the GT predicate on the "last_synced_at" field.
+func LastSyncedAtGT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtGTE applies the GTE predicate on the "last_synced_at" field.
+func LastSyncedAtGTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLT applies the LT predicate on the "last_synced_at" field.
+func LastSyncedAtLT(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLTE applies the LTE predicate on the "last_synced_at" field.
+func LastSyncedAtLTE(v time.Time) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIsNil applies the IsNil predicate on the "last_synced_at" field.
+func LastSyncedAtIsNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIsNull(FieldLastSyncedAt))
+}
+
+// LastSyncedAtNotNil applies the NotNil predicate on the "last_synced_at" field.
+func LastSyncedAtNotNil() predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotNull(FieldLastSyncedAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastTotalEQ applies the EQ predicate on the "last_total" field.
+func LastTotalEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastTotal, v))
+}
+
+// LastTotalNEQ applies the NEQ predicate on the "last_total" field.
+func LastTotalNEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastTotal, v))
+}
+
+// LastTotalIn applies the In predicate on the "last_total" field.
+func LastTotalIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastTotal, vs...))
+}
+
+// LastTotalNotIn applies the NotIn predicate on the "last_total" field.
+func LastTotalNotIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastTotal, vs...))
+}
+
+// LastTotalGT applies the GT predicate on the "last_total" field.
+func LastTotalGT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastTotal, v))
+}
+
+// LastTotalGTE applies the GTE predicate on the "last_total" field.
+func LastTotalGTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastTotal, v))
+}
+
+// LastTotalLT applies the LT predicate on the "last_total" field.
+func LastTotalLT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastTotal, v))
+}
+
+// LastTotalLTE applies the LTE predicate on the "last_total" field.
+func LastTotalLTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastTotal, v))
+}
+
+// LastCreatedEQ applies the EQ predicate on the "last_created" field.
+func LastCreatedEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastCreated, v))
+}
+
+// LastCreatedNEQ applies the NEQ predicate on the "last_created" field.
+func LastCreatedNEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastCreated, v))
+}
+
+// LastCreatedIn applies the In predicate on the "last_created" field.
+func LastCreatedIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastCreated, vs...))
+}
+
+// LastCreatedNotIn applies the NotIn predicate on the "last_created" field.
+func LastCreatedNotIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastCreated, vs...))
+}
+
+// LastCreatedGT applies the GT predicate on the "last_created" field.
+func LastCreatedGT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastCreated, v))
+}
+
+// LastCreatedGTE applies the GTE predicate on the "last_created" field.
+func LastCreatedGTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastCreated, v))
+}
+
+// LastCreatedLT applies the LT predicate on the "last_created" field.
+func LastCreatedLT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastCreated, v))
+}
+
+// LastCreatedLTE applies the LTE predicate on the "last_created" field.
+func LastCreatedLTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastCreated, v))
+}
+
+// LastSkippedEQ applies the EQ predicate on the "last_skipped" field.
+func LastSkippedEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastSkipped, v))
+}
+
+// LastSkippedNEQ applies the NEQ predicate on the "last_skipped" field.
+func LastSkippedNEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastSkipped, v))
+}
+
+// LastSkippedIn applies the In predicate on the "last_skipped" field.
+func LastSkippedIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastSkipped, vs...))
+}
+
+// LastSkippedNotIn applies the NotIn predicate on the "last_skipped" field.
+func LastSkippedNotIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastSkipped, vs...))
+}
+
+// LastSkippedGT applies the GT predicate on the "last_skipped" field.
+func LastSkippedGT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastSkipped, v))
+}
+
+// LastSkippedGTE applies the GTE predicate on the "last_skipped" field.
+func LastSkippedGTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastSkipped, v))
+}
+
+// LastSkippedLT applies the LT predicate on the "last_skipped" field.
+func LastSkippedLT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastSkipped, v))
+}
+
+// LastSkippedLTE applies the LTE predicate on the "last_skipped" field.
+func LastSkippedLTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastSkipped, v))
+}
+
+// LastDisabledEQ applies the EQ predicate on the "last_disabled" field.
+func LastDisabledEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldEQ(FieldLastDisabled, v))
+}
+
+// LastDisabledNEQ applies the NEQ predicate on the "last_disabled" field.
+func LastDisabledNEQ(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNEQ(FieldLastDisabled, v))
+}
+
+// LastDisabledIn applies the In predicate on the "last_disabled" field.
+func LastDisabledIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldIn(FieldLastDisabled, vs...))
+}
+
+// LastDisabledNotIn applies the NotIn predicate on the "last_disabled" field.
+func LastDisabledNotIn(vs ...int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldNotIn(FieldLastDisabled, vs...))
+}
+
+// LastDisabledGT applies the GT predicate on the "last_disabled" field.
+func LastDisabledGT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGT(FieldLastDisabled, v))
+}
+
+// LastDisabledGTE applies the GTE predicate on the "last_disabled" field.
+func LastDisabledGTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldGTE(FieldLastDisabled, v))
+}
+
+// LastDisabledLT applies the LT predicate on the "last_disabled" field.
+func LastDisabledLT(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLT(FieldLastDisabled, v))
+}
+
+// LastDisabledLTE applies the LTE predicate on the "last_disabled" field.
+func LastDisabledLTE(v int) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.FieldLTE(FieldLastDisabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProxySubscription) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProxySubscription) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProxySubscription) predicate.ProxySubscription {
+	return predicate.ProxySubscription(sql.NotPredicates(p))
+}
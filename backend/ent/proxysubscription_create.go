@@ -0,0 +1,1449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/proxysubscription"
+)
+
+// ProxySubscriptionCreate is the builder for creating a ProxySubscription entity.
+type ProxySubscriptionCreate struct {
+	config
+	mutation *ProxySubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProxySubscriptionCreate) SetCreatedAt(v time.Time) *ProxySubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableCreatedAt(v *time.Time) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProxySubscriptionCreate) SetUpdatedAt(v time.Time) *ProxySubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ProxySubscriptionCreate) SetDeletedAt(v time.Time) *ProxySubscriptionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableDeletedAt(v *time.Time) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ProxySubscriptionCreate) SetName(v string) *ProxySubscriptionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *ProxySubscriptionCreate) SetURL(v string) *ProxySubscriptionCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (_c *ProxySubscriptionCreate) SetRefreshIntervalMinutes(v int) *ProxySubscriptionCreate {
+	_c.mutation.SetRefreshIntervalMinutes(v)
+	return _c
+}
+
+// SetNillableRefreshIntervalMinutes sets the "refresh_interval_minutes" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableRefreshIntervalMinutes(v *int) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetRefreshIntervalMinutes(*v)
+	}
+	return _c
+}
+
+// SetPoolID sets the "pool_id" field.
+func (_c *ProxySubscriptionCreate) SetPoolID(v int64) *ProxySubscriptionCreate {
+	_c.mutation.SetPoolID(v)
+	return _c
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillablePoolID(v *int64) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetPoolID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProxySubscriptionCreate) SetStatus(v string) *ProxySubscriptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableStatus(v *string) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_c *ProxySubscriptionCreate) SetLastSyncedAt(v time.Time) *ProxySubscriptionCreate {
+	_c.mutation.SetLastSyncedAt(v)
+	return _c
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastSyncedAt(v *time.Time) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ProxySubscriptionCreate) SetLastError(v string) *ProxySubscriptionCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastError(v *string) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastTotal sets the "last_total" field.
+func (_c *ProxySubscriptionCreate) SetLastTotal(v int) *ProxySubscriptionCreate {
+	_c.mutation.SetLastTotal(v)
+	return _c
+}
+
+// SetNillableLastTotal sets the "last_total" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastTotal(v *int) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastTotal(*v)
+	}
+	return _c
+}
+
+// SetLastCreated sets the "last_created" field.
+func (_c *ProxySubscriptionCreate) SetLastCreated(v int) *ProxySubscriptionCreate {
+	_c.mutation.SetLastCreated(v)
+	return _c
+}
+
+// SetNillableLastCreated sets the "last_created" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastCreated(v *int) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastCreated(*v)
+	}
+	return _c
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (_c *ProxySubscriptionCreate) SetLastSkipped(v int) *ProxySubscriptionCreate {
+	_c.mutation.SetLastSkipped(v)
+	return _c
+}
+
+// SetNillableLastSkipped sets the "last_skipped" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastSkipped(v *int) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastSkipped(*v)
+	}
+	return _c
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (_c *ProxySubscriptionCreate) SetLastDisabled(v int) *ProxySubscriptionCreate {
+	_c.mutation.SetLastDisabled(v)
+	return _c
+}
+
+// SetNillableLastDisabled sets the "last_disabled" field if the given value is not nil.
+func (_c *ProxySubscriptionCreate) SetNillableLastDisabled(v *int) *ProxySubscriptionCreate {
+	if v != nil {
+		_c.SetLastDisabled(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProxySubscriptionMutation object of the builder.
+func (_c *ProxySubscriptionCreate) Mutation() *ProxySubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the ProxySubscription in the database.
+func (_c *ProxySubscriptionCreate) Save(ctx context.Context) (*ProxySubscription, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProxySubscriptionCreate) SaveX(ctx context.Context) *ProxySubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProxySubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProxySubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProxySubscriptionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if proxysubscription.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized proxysubscription.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := proxysubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if proxysubscription.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized proxysubscription.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := proxysubscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RefreshIntervalMinutes(); !ok {
+		v := proxysubscription.DefaultRefreshIntervalMinutes
+		_c.mutation.SetRefreshIntervalMinutes(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := proxysubscription.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		v := proxysubscription.DefaultLastError
+		_c.mutation.SetLastError(v)
+	}
+	if _, ok := _c.mutation.LastTotal(); !ok {
+		v := proxysubscription.DefaultLastTotal
+		_c.mutation.SetLastTotal(v)
+	}
+	if _, ok := _c.mutation.LastCreated(); !ok {
+		v := proxysubscription.DefaultLastCreated
+		_c.mutation.SetLastCreated(v)
+	}
+	if _, ok := _c.mutation.LastSkipped(); !ok {
+		v := proxysubscription.DefaultLastSkipped
+		_c.mutation.SetLastSkipped(v)
+	}
+	if _, ok := _c.mutation.LastDisabled(); !ok {
+		v := proxysubscription.DefaultLastDisabled
+		_c.mutation.SetLastDisabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProxySubscriptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProxySubscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProxySubscription.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProxySubscription.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := proxysubscription.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ProxySubscription.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := proxysubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefreshIntervalMinutes(); !ok {
+		return &ValidationError{Name: "refresh_interval_minutes", err: errors.New(`ent: missing required field "ProxySubscription.refresh_interval_minutes"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProxySubscription.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := proxysubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "ProxySubscription.last_error"`)}
+	}
+	if _, ok := _c.mutation.LastTotal(); !ok {
+		return &ValidationError{Name: "last_total", err: errors.New(`ent: missing required field "ProxySubscription.last_total"`)}
+	}
+	if _, ok := _c.mutation.LastCreated(); !ok {
+		return &ValidationError{Name: "last_created", err: errors.New(`ent: missing required field "ProxySubscription.last_created"`)}
+	}
+	if _, ok := _c.mutation.LastSkipped(); !ok {
+		return &ValidationError{Name: "last_skipped", err: errors.New(`ent: missing required field "ProxySubscription.last_skipped"`)}
+	}
+	if _, ok := _c.mutation.LastDisabled(); !ok {
+		return &ValidationError{Name: "last_disabled", err: errors.New(`ent: missing required field "ProxySubscription.last_disabled"`)}
+	}
+	return nil
+}
+
+func (_c *ProxySubscriptionCreate) sqlSave(ctx context.Context) (*ProxySubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProxySubscriptionCreate) createSpec() (*ProxySubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProxySubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(proxysubscription.Table, sqlgraph.NewFieldSpec(proxysubscription.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(proxysubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(proxysubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(proxysubscription.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(proxysubscription.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(proxysubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.RefreshIntervalMinutes(); ok {
+		_spec.SetField(proxysubscription.FieldRefreshIntervalMinutes, field.TypeInt, value)
+		_node.RefreshIntervalMinutes = value
+	}
+	if value, ok := _c.mutation.PoolID(); ok {
+		_spec.SetField(proxysubscription.FieldPoolID, field.TypeInt64, value)
+		_node.PoolID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(proxysubscription.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LastSyncedAt(); ok {
+		_spec.SetField(proxysubscription.FieldLastSyncedAt, field.TypeTime, value)
+		_node.LastSyncedAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(proxysubscription.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.LastTotal(); ok {
+		_spec.SetField(proxysubscription.FieldLastTotal, field.TypeInt, value)
+		_node.LastTotal = value
+	}
+	if value, ok := _c.mutation.LastCreated(); ok {
+		_spec.SetField(proxysubscription.FieldLastCreated, field.TypeInt, value)
+		_node.LastCreated = value
+	}
+	if value, ok := _c.mutation.LastSkipped(); ok {
+		_spec.SetField(proxysubscription.FieldLastSkipped, field.TypeInt, value)
+		_node.LastSkipped = value
+	}
+	if value, ok := _c.mutation.LastDisabled(); ok {
+		_spec.SetField(proxysubscription.FieldLastDisabled, field.TypeInt, value)
+		_node.LastDisabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProxySubscription.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProxySubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProxySubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *ProxySubscriptionUpsertOne {
+	_c.conflict = opts
+	return &ProxySubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProxySubscriptionCreate) OnConflictColumns(columns ...string) *ProxySubscriptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProxySubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProxySubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one ProxySubscription node.
+	ProxySubscriptionUpsertOne struct {
+		create *ProxySubscriptionCreate
+	}
+
+	// ProxySubscriptionUpsert is the "OnConflict" setter.
+	ProxySubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProxySubscriptionUpsert) SetUpdatedAt(v time.Time) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateUpdatedAt() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ProxySubscriptionUpsert) SetDeletedAt(v time.Time) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateDeletedAt() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ProxySubscriptionUpsert) ClearDeletedAt() *ProxySubscriptionUpsert {
+	u.SetNull(proxysubscription.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProxySubscriptionUpsert) SetName(v string) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateName() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *ProxySubscriptionUpsert) SetURL(v string) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateURL() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldURL)
+	return u
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsert) SetRefreshIntervalMinutes(v int) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldRefreshIntervalMinutes, v)
+	return u
+}
+
+// UpdateRefreshIntervalMinutes sets the "refresh_interval_minutes" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateRefreshIntervalMinutes() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldRefreshIntervalMinutes)
+	return u
+}
+
+// AddRefreshIntervalMinutes adds v to the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsert) AddRefreshIntervalMinutes(v int) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldRefreshIntervalMinutes, v)
+	return u
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ProxySubscriptionUpsert) SetPoolID(v int64) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldPoolID, v)
+	return u
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdatePoolID() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldPoolID)
+	return u
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxySubscriptionUpsert) AddPoolID(v int64) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldPoolID, v)
+	return u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxySubscriptionUpsert) ClearPoolID() *ProxySubscriptionUpsert {
+	u.SetNull(proxysubscription.FieldPoolID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ProxySubscriptionUpsert) SetStatus(v string) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateStatus() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldStatus)
+	return u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *ProxySubscriptionUpsert) SetLastSyncedAt(v time.Time) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastSyncedAt, v)
+	return u
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastSyncedAt() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastSyncedAt)
+	return u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *ProxySubscriptionUpsert) ClearLastSyncedAt() *ProxySubscriptionUpsert {
+	u.SetNull(proxysubscription.FieldLastSyncedAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ProxySubscriptionUpsert) SetLastError(v string) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastError() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastError)
+	return u
+}
+
+// SetLastTotal sets the "last_total" field.
+func (u *ProxySubscriptionUpsert) SetLastTotal(v int) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastTotal, v)
+	return u
+}
+
+// UpdateLastTotal sets the "last_total" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastTotal() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastTotal)
+	return u
+}
+
+// AddLastTotal adds v to the "last_total" field.
+func (u *ProxySubscriptionUpsert) AddLastTotal(v int) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldLastTotal, v)
+	return u
+}
+
+// SetLastCreated sets the "last_created" field.
+func (u *ProxySubscriptionUpsert) SetLastCreated(v int) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastCreated, v)
+	return u
+}
+
+// UpdateLastCreated sets the "last_created" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastCreated() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastCreated)
+	return u
+}
+
+// AddLastCreated adds v to the "last_created" field.
+func (u *ProxySubscriptionUpsert) AddLastCreated(v int) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldLastCreated, v)
+	return u
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (u *ProxySubscriptionUpsert) SetLastSkipped(v int) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastSkipped, v)
+	return u
+}
+
+// UpdateLastSkipped sets the "last_skipped" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastSkipped() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastSkipped)
+	return u
+}
+
+// AddLastSkipped adds v to the "last_skipped" field.
+func (u *ProxySubscriptionUpsert) AddLastSkipped(v int) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldLastSkipped, v)
+	return u
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (u *ProxySubscriptionUpsert) SetLastDisabled(v int) *ProxySubscriptionUpsert {
+	u.Set(proxysubscription.FieldLastDisabled, v)
+	return u
+}
+
+// UpdateLastDisabled sets the "last_disabled" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsert) UpdateLastDisabled() *ProxySubscriptionUpsert {
+	u.SetExcluded(proxysubscription.FieldLastDisabled)
+	return u
+}
+
+// AddLastDisabled adds v to the "last_disabled" field.
+func (u *ProxySubscriptionUpsert) AddLastDisabled(v int) *ProxySubscriptionUpsert {
+	u.Add(proxysubscription.FieldLastDisabled, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProxySubscriptionUpsertOne) UpdateNewValues() *ProxySubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(proxysubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProxySubscriptionUpsertOne) Ignore() *ProxySubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProxySubscriptionUpsertOne) DoNothing() *ProxySubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProxySubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *ProxySubscriptionUpsertOne) Update(set func(*ProxySubscriptionUpsert)) *ProxySubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProxySubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProxySubscriptionUpsertOne) SetUpdatedAt(v time.Time) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateUpdatedAt() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ProxySubscriptionUpsertOne) SetDeletedAt(v time.Time) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateDeletedAt() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ProxySubscriptionUpsertOne) ClearDeletedAt() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ProxySubscriptionUpsertOne) SetName(v string) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateName() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ProxySubscriptionUpsertOne) SetURL(v string) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateURL() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsertOne) SetRefreshIntervalMinutes(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetRefreshIntervalMinutes(v)
+	})
+}
+
+// AddRefreshIntervalMinutes adds v to the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsertOne) AddRefreshIntervalMinutes(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddRefreshIntervalMinutes(v)
+	})
+}
+
+// UpdateRefreshIntervalMinutes sets the "refresh_interval_minutes" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateRefreshIntervalMinutes() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateRefreshIntervalMinutes()
+	})
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ProxySubscriptionUpsertOne) SetPoolID(v int64) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxySubscriptionUpsertOne) AddPoolID(v int64) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdatePoolID() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxySubscriptionUpsertOne) ClearPoolID() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearPoolID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ProxySubscriptionUpsertOne) SetStatus(v string) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateStatus() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *ProxySubscriptionUpsertOne) SetLastSyncedAt(v time.Time) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastSyncedAt(v)
+	})
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastSyncedAt() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastSyncedAt()
+	})
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *ProxySubscriptionUpsertOne) ClearLastSyncedAt() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearLastSyncedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ProxySubscriptionUpsertOne) SetLastError(v string) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastError() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetLastTotal sets the "last_total" field.
+func (u *ProxySubscriptionUpsertOne) SetLastTotal(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastTotal(v)
+	})
+}
+
+// AddLastTotal adds v to the "last_total" field.
+func (u *ProxySubscriptionUpsertOne) AddLastTotal(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastTotal(v)
+	})
+}
+
+// UpdateLastTotal sets the "last_total" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastTotal() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastTotal()
+	})
+}
+
+// SetLastCreated sets the "last_created" field.
+func (u *ProxySubscriptionUpsertOne) SetLastCreated(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastCreated(v)
+	})
+}
+
+// AddLastCreated adds v to the "last_created" field.
+func (u *ProxySubscriptionUpsertOne) AddLastCreated(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastCreated(v)
+	})
+}
+
+// UpdateLastCreated sets the "last_created" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastCreated() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastCreated()
+	})
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (u *ProxySubscriptionUpsertOne) SetLastSkipped(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastSkipped(v)
+	})
+}
+
+// AddLastSkipped adds v to the "last_skipped" field.
+func (u *ProxySubscriptionUpsertOne) AddLastSkipped(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastSkipped(v)
+	})
+}
+
+// UpdateLastSkipped sets the "last_skipped" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastSkipped() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastSkipped()
+	})
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (u *ProxySubscriptionUpsertOne) SetLastDisabled(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastDisabled(v)
+	})
+}
+
+// AddLastDisabled adds v to the "last_disabled" field.
+func (u *ProxySubscriptionUpsertOne) AddLastDisabled(v int) *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastDisabled(v)
+	})
+}
+
+// UpdateLastDisabled sets the "last_disabled" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertOne) UpdateLastDisabled() *ProxySubscriptionUpsertOne {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ProxySubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProxySubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProxySubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProxySubscriptionUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProxySubscriptionUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProxySubscriptionCreateBulk is the builder for creating many ProxySubscription entities in bulk.
+type ProxySubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*ProxySubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProxySubscription entities in the database.
+func (_c *ProxySubscriptionCreateBulk) Save(ctx context.Context) ([]*ProxySubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProxySubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProxySubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProxySubscriptionCreateBulk) SaveX(ctx context.Context) []*ProxySubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProxySubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProxySubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProxySubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProxySubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProxySubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProxySubscriptionUpsertBulk {
+	_c.conflict = opts
+	return &ProxySubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProxySubscriptionCreateBulk) OnConflictColumns(columns ...string) *ProxySubscriptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProxySubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProxySubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProxySubscription nodes.
+type ProxySubscriptionUpsertBulk struct {
+	create *ProxySubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProxySubscriptionUpsertBulk) UpdateNewValues() *ProxySubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(proxysubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProxySubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProxySubscriptionUpsertBulk) Ignore() *ProxySubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProxySubscriptionUpsertBulk) DoNothing() *ProxySubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProxySubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProxySubscriptionUpsertBulk) Update(set func(*ProxySubscriptionUpsert)) *ProxySubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProxySubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProxySubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateUpdatedAt() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ProxySubscriptionUpsertBulk) SetDeletedAt(v time.Time) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateDeletedAt() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ProxySubscriptionUpsertBulk) ClearDeletedAt() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ProxySubscriptionUpsertBulk) SetName(v string) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateName() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *ProxySubscriptionUpsertBulk) SetURL(v string) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateURL() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsertBulk) SetRefreshIntervalMinutes(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetRefreshIntervalMinutes(v)
+	})
+}
+
+// AddRefreshIntervalMinutes adds v to the "refresh_interval_minutes" field.
+func (u *ProxySubscriptionUpsertBulk) AddRefreshIntervalMinutes(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddRefreshIntervalMinutes(v)
+	})
+}
+
+// UpdateRefreshIntervalMinutes sets the "refresh_interval_minutes" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateRefreshIntervalMinutes() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateRefreshIntervalMinutes()
+	})
+}
+
+// SetPoolID sets the "pool_id" field.
+func (u *ProxySubscriptionUpsertBulk) SetPoolID(v int64) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetPoolID(v)
+	})
+}
+
+// AddPoolID adds v to the "pool_id" field.
+func (u *ProxySubscriptionUpsertBulk) AddPoolID(v int64) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddPoolID(v)
+	})
+}
+
+// UpdatePoolID sets the "pool_id" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdatePoolID() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdatePoolID()
+	})
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (u *ProxySubscriptionUpsertBulk) ClearPoolID() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearPoolID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ProxySubscriptionUpsertBulk) SetStatus(v string) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateStatus() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastSyncedAt(v time.Time) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastSyncedAt(v)
+	})
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastSyncedAt() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastSyncedAt()
+	})
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *ProxySubscriptionUpsertBulk) ClearLastSyncedAt() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.ClearLastSyncedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastError(v string) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastError() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetLastTotal sets the "last_total" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastTotal(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastTotal(v)
+	})
+}
+
+// AddLastTotal adds v to the "last_total" field.
+func (u *ProxySubscriptionUpsertBulk) AddLastTotal(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastTotal(v)
+	})
+}
+
+// UpdateLastTotal sets the "last_total" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastTotal() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastTotal()
+	})
+}
+
+// SetLastCreated sets the "last_created" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastCreated(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastCreated(v)
+	})
+}
+
+// AddLastCreated adds v to the "last_created" field.
+func (u *ProxySubscriptionUpsertBulk) AddLastCreated(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastCreated(v)
+	})
+}
+
+// UpdateLastCreated sets the "last_created" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastCreated() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastCreated()
+	})
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastSkipped(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastSkipped(v)
+	})
+}
+
+// AddLastSkipped adds v to the "last_skipped" field.
+func (u *ProxySubscriptionUpsertBulk) AddLastSkipped(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastSkipped(v)
+	})
+}
+
+// UpdateLastSkipped sets the "last_skipped" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastSkipped() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastSkipped()
+	})
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (u *ProxySubscriptionUpsertBulk) SetLastDisabled(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.SetLastDisabled(v)
+	})
+}
+
+// AddLastDisabled adds v to the "last_disabled" field.
+func (u *ProxySubscriptionUpsertBulk) AddLastDisabled(v int) *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.AddLastDisabled(v)
+	})
+}
+
+// UpdateLastDisabled sets the "last_disabled" field to the value that was provided on create.
+func (u *ProxySubscriptionUpsertBulk) UpdateLastDisabled() *ProxySubscriptionUpsertBulk {
+	return u.Update(func(s *ProxySubscriptionUpsert) {
+		s.UpdateLastDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ProxySubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProxySubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProxySubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProxySubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/proxysubscription"
+)
+
+// ProxySubscriptionUpdate is the builder for updating ProxySubscription entities.
+type ProxySubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProxySubscriptionMutation
+}
+
+// Where appends a list predicates to the ProxySubscriptionUpdate builder.
+func (_u *ProxySubscriptionUpdate) Where(ps ...predicate.ProxySubscription) *ProxySubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProxySubscriptionUpdate) SetUpdatedAt(v time.Time) *ProxySubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProxySubscriptionUpdate) SetDeletedAt(v time.Time) *ProxySubscriptionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableDeletedAt(v *time.Time) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProxySubscriptionUpdate) ClearDeletedAt() *ProxySubscriptionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProxySubscriptionUpdate) SetName(v string) *ProxySubscriptionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableName(v *string) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ProxySubscriptionUpdate) SetURL(v string) *ProxySubscriptionUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableURL(v *string) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (_u *ProxySubscriptionUpdate) SetRefreshIntervalMinutes(v int) *ProxySubscriptionUpdate {
+	_u.mutation.ResetRefreshIntervalMinutes()
+	_u.mutation.SetRefreshIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableRefreshIntervalMinutes sets the "refresh_interval_minutes" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableRefreshIntervalMinutes(v *int) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetRefreshIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddRefreshIntervalMinutes adds value to the "refresh_interval_minutes" field.
+func (_u *ProxySubscriptionUpdate) AddRefreshIntervalMinutes(v int) *ProxySubscriptionUpdate {
+	_u.mutation.AddRefreshIntervalMinutes(v)
+	return _u
+}
+
+// SetPoolID sets the "pool_id" field.
+func (_u *ProxySubscriptionUpdate) SetPoolID(v int64) *ProxySubscriptionUpdate {
+	_u.mutation.ResetPoolID()
+	_u.mutation.SetPoolID(v)
+	return _u
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillablePoolID(v *int64) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetPoolID(*v)
+	}
+	return _u
+}
+
+// AddPoolID adds value to the "pool_id" field.
+func (_u *ProxySubscriptionUpdate) AddPoolID(v int64) *ProxySubscriptionUpdate {
+	_u.mutation.AddPoolID(v)
+	return _u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (_u *ProxySubscriptionUpdate) ClearPoolID() *ProxySubscriptionUpdate {
+	_u.mutation.ClearPoolID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProxySubscriptionUpdate) SetStatus(v string) *ProxySubscriptionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableStatus(v *string) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *ProxySubscriptionUpdate) SetLastSyncedAt(v time.Time) *ProxySubscriptionUpdate {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastSyncedAt(v *time.Time) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *ProxySubscriptionUpdate) ClearLastSyncedAt() *ProxySubscriptionUpdate {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ProxySubscriptionUpdate) SetLastError(v string) *ProxySubscriptionUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastError(v *string) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetLastTotal sets the "last_total" field.
+func (_u *ProxySubscriptionUpdate) SetLastTotal(v int) *ProxySubscriptionUpdate {
+	_u.mutation.ResetLastTotal()
+	_u.mutation.SetLastTotal(v)
+	return _u
+}
+
+// SetNillableLastTotal sets the "last_total" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastTotal(v *int) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastTotal(*v)
+	}
+	return _u
+}
+
+// AddLastTotal adds value to the "last_total" field.
+func (_u *ProxySubscriptionUpdate) AddLastTotal(v int) *ProxySubscriptionUpdate {
+	_u.mutation.AddLastTotal(v)
+	return _u
+}
+
+// SetLastCreated sets the "last_created" field.
+func (_u *ProxySubscriptionUpdate) SetLastCreated(v int) *ProxySubscriptionUpdate {
+	_u.mutation.ResetLastCreated()
+	_u.mutation.SetLastCreated(v)
+	return _u
+}
+
+// SetNillableLastCreated sets the "last_created" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastCreated(v *int) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastCreated(*v)
+	}
+	return _u
+}
+
+// AddLastCreated adds value to the "last_created" field.
+func (_u *ProxySubscriptionUpdate) AddLastCreated(v int) *ProxySubscriptionUpdate {
+	_u.mutation.AddLastCreated(v)
+	return _u
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (_u *ProxySubscriptionUpdate) SetLastSkipped(v int) *ProxySubscriptionUpdate {
+	_u.mutation.ResetLastSkipped()
+	_u.mutation.SetLastSkipped(v)
+	return _u
+}
+
+// SetNillableLastSkipped sets the "last_skipped" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastSkipped(v *int) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastSkipped(*v)
+	}
+	return _u
+}
+
+// AddLastSkipped adds value to the "last_skipped" field.
+func (_u *ProxySubscriptionUpdate) AddLastSkipped(v int) *ProxySubscriptionUpdate {
+	_u.mutation.AddLastSkipped(v)
+	return _u
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (_u *ProxySubscriptionUpdate) SetLastDisabled(v int) *ProxySubscriptionUpdate {
+	_u.mutation.ResetLastDisabled()
+	_u.mutation.SetLastDisabled(v)
+	return _u
+}
+
+// SetNillableLastDisabled sets the "last_disabled" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdate) SetNillableLastDisabled(v *int) *ProxySubscriptionUpdate {
+	if v != nil {
+		_u.SetLastDisabled(*v)
+	}
+	return _u
+}
+
+// AddLastDisabled adds value to the "last_disabled" field.
+func (_u *ProxySubscriptionUpdate) AddLastDisabled(v int) *ProxySubscriptionUpdate {
+	_u.mutation.AddLastDisabled(v)
+	return _u
+}
+
+// Mutation returns the ProxySubscriptionMutation object of the builder.
+func (_u *ProxySubscriptionUpdate) Mutation() *ProxySubscriptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProxySubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProxySubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProxySubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProxySubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProxySubscriptionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if proxysubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized proxysubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := proxysubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProxySubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := proxysubscription.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := proxysubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := proxysubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProxySubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proxysubscription.Table, proxysubscription.Columns, sqlgraph.NewFieldSpec(proxysubscription.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(proxysubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(proxysubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(proxysubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(proxysubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(proxysubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshIntervalMinutes(); ok {
+		_spec.SetField(proxysubscription.FieldRefreshIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshIntervalMinutes(); ok {
+		_spec.AddField(proxysubscription.FieldRefreshIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PoolID(); ok {
+		_spec.SetField(proxysubscription.FieldPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPoolID(); ok {
+		_spec.AddField(proxysubscription.FieldPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.PoolIDCleared() {
+		_spec.ClearField(proxysubscription.FieldPoolID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(proxysubscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(proxysubscription.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(proxysubscription.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(proxysubscription.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastTotal(); ok {
+		_spec.SetField(proxysubscription.FieldLastTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTotal(); ok {
+		_spec.AddField(proxysubscription.FieldLastTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCreated(); ok {
+		_spec.SetField(proxysubscription.FieldLastCreated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastCreated(); ok {
+		_spec.AddField(proxysubscription.FieldLastCreated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSkipped(); ok {
+		_spec.SetField(proxysubscription.FieldLastSkipped, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSkipped(); ok {
+		_spec.AddField(proxysubscription.FieldLastSkipped, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastDisabled(); ok {
+		_spec.SetField(proxysubscription.FieldLastDisabled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastDisabled(); ok {
+		_spec.AddField(proxysubscription.FieldLastDisabled, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proxysubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProxySubscriptionUpdateOne is the builder for updating a single ProxySubscription entity.
+type ProxySubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProxySubscriptionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProxySubscriptionUpdateOne) SetUpdatedAt(v time.Time) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProxySubscriptionUpdateOne) SetDeletedAt(v time.Time) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableDeletedAt(v *time.Time) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProxySubscriptionUpdateOne) ClearDeletedAt() *ProxySubscriptionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProxySubscriptionUpdateOne) SetName(v string) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableName(v *string) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ProxySubscriptionUpdateOne) SetURL(v string) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableURL(v *string) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRefreshIntervalMinutes sets the "refresh_interval_minutes" field.
+func (_u *ProxySubscriptionUpdateOne) SetRefreshIntervalMinutes(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetRefreshIntervalMinutes()
+	_u.mutation.SetRefreshIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableRefreshIntervalMinutes sets the "refresh_interval_minutes" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableRefreshIntervalMinutes(v *int) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetRefreshIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddRefreshIntervalMinutes adds value to the "refresh_interval_minutes" field.
+func (_u *ProxySubscriptionUpdateOne) AddRefreshIntervalMinutes(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddRefreshIntervalMinutes(v)
+	return _u
+}
+
+// SetPoolID sets the "pool_id" field.
+func (_u *ProxySubscriptionUpdateOne) SetPoolID(v int64) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetPoolID()
+	_u.mutation.SetPoolID(v)
+	return _u
+}
+
+// SetNillablePoolID sets the "pool_id" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillablePoolID(v *int64) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPoolID(*v)
+	}
+	return _u
+}
+
+// AddPoolID adds value to the "pool_id" field.
+func (_u *ProxySubscriptionUpdateOne) AddPoolID(v int64) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddPoolID(v)
+	return _u
+}
+
+// ClearPoolID clears the value of the "pool_id" field.
+func (_u *ProxySubscriptionUpdateOne) ClearPoolID() *ProxySubscriptionUpdateOne {
+	_u.mutation.ClearPoolID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProxySubscriptionUpdateOne) SetStatus(v string) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableStatus(v *string) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastSyncedAt(v time.Time) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastSyncedAt(v *time.Time) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *ProxySubscriptionUpdateOne) ClearLastSyncedAt() *ProxySubscriptionUpdateOne {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastError(v string) *ProxySubscriptionUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastError(v *string) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetLastTotal sets the "last_total" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastTotal(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetLastTotal()
+	_u.mutation.SetLastTotal(v)
+	return _u
+}
+
+// SetNillableLastTotal sets the "last_total" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastTotal(v *int) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastTotal(*v)
+	}
+	return _u
+}
+
+// AddLastTotal adds value to the "last_total" field.
+func (_u *ProxySubscriptionUpdateOne) AddLastTotal(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddLastTotal(v)
+	return _u
+}
+
+// SetLastCreated sets the "last_created" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastCreated(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetLastCreated()
+	_u.mutation.SetLastCreated(v)
+	return _u
+}
+
+// SetNillableLastCreated sets the "last_created" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastCreated(v *int) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastCreated(*v)
+	}
+	return _u
+}
+
+// AddLastCreated adds value to the "last_created" field.
+func (_u *ProxySubscriptionUpdateOne) AddLastCreated(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddLastCreated(v)
+	return _u
+}
+
+// SetLastSkipped sets the "last_skipped" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastSkipped(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetLastSkipped()
+	_u.mutation.SetLastSkipped(v)
+	return _u
+}
+
+// SetNillableLastSkipped sets the "last_skipped" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastSkipped(v *int) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastSkipped(*v)
+	}
+	return _u
+}
+
+// AddLastSkipped adds value to the "last_skipped" field.
+func (_u *ProxySubscriptionUpdateOne) AddLastSkipped(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddLastSkipped(v)
+	return _u
+}
+
+// SetLastDisabled sets the "last_disabled" field.
+func (_u *ProxySubscriptionUpdateOne) SetLastDisabled(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.ResetLastDisabled()
+	_u.mutation.SetLastDisabled(v)
+	return _u
+}
+
+// SetNillableLastDisabled sets the "last_disabled" field if the given value is not nil.
+func (_u *ProxySubscriptionUpdateOne) SetNillableLastDisabled(v *int) *ProxySubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastDisabled(*v)
+	}
+	return _u
+}
+
+// AddLastDisabled adds value to the "last_disabled" field.
+func (_u *ProxySubscriptionUpdateOne) AddLastDisabled(v int) *ProxySubscriptionUpdateOne {
+	_u.mutation.AddLastDisabled(v)
+	return _u
+}
+
+// Mutation returns the ProxySubscriptionMutation object of the builder.
+func (_u *ProxySubscriptionUpdateOne) Mutation() *ProxySubscriptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProxySubscriptionUpdate builder.
+func (_u *ProxySubscriptionUpdateOne) Where(ps ...predicate.ProxySubscription) *ProxySubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProxySubscriptionUpdateOne) Select(field string, fields ...string) *ProxySubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProxySubscription entity.
+func (_u *ProxySubscriptionUpdateOne) Save(ctx context.Context) (*ProxySubscription, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProxySubscriptionUpdateOne) SaveX(ctx context.Context) *ProxySubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProxySubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProxySubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProxySubscriptionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if proxysubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized proxysubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := proxysubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProxySubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := proxysubscription.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := proxysubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := proxysubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProxySubscription.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProxySubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *ProxySubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proxysubscription.Table, proxysubscription.Columns, sqlgraph.NewFieldSpec(proxysubscription.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProxySubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proxysubscription.FieldID)
+		for _, f := range fields {
+			if !proxysubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != proxysubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(proxysubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(proxysubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(proxysubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(proxysubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(proxysubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshIntervalMinutes(); ok {
+		_spec.SetField(proxysubscription.FieldRefreshIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshIntervalMinutes(); ok {
+		_spec.AddField(proxysubscription.FieldRefreshIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PoolID(); ok {
+		_spec.SetField(proxysubscription.FieldPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPoolID(); ok {
+		_spec.AddField(proxysubscription.FieldPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.PoolIDCleared() {
+		_spec.ClearField(proxysubscription.FieldPoolID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(proxysubscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(proxysubscription.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(proxysubscription.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(proxysubscription.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastTotal(); ok {
+		_spec.SetField(proxysubscription.FieldLastTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTotal(); ok {
+		_spec.AddField(proxysubscription.FieldLastTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastCreated(); ok {
+		_spec.SetField(proxysubscription.FieldLastCreated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastCreated(); ok {
+		_spec.AddField(proxysubscription.FieldLastCreated, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastSkipped(); ok {
+		_spec.SetField(proxysubscription.FieldLastSkipped, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastSkipped(); ok {
+		_spec.AddField(proxysubscription.FieldLastSkipped, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastDisabled(); ok {
+		_spec.SetField(proxysubscription.FieldLastDisabled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastDisabled(); ok {
+		_spec.AddField(proxysubscription.FieldLastDisabled, field.TypeInt, value)
+	}
+	_node = &ProxySubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proxysubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,14 +7,23 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/ent/account"
 	"github.com/Wei-Shaw/sub2api/ent/accountgroup"
+	"github.com/Wei-Shaw/sub2api/ent/adminapikey"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/auditlog"
+	"github.com/Wei-Shaw/sub2api/ent/balancetransaction"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+	"github.com/Wei-Shaw/sub2api/ent/organization"
+	"github.com/Wei-Shaw/sub2api/ent/organizationmember"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
+	"github.com/Wei-Shaw/sub2api/ent/proxysubscription"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
 	"github.com/Wei-Shaw/sub2api/ent/schema"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionplan"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
@@ -35,6 +44,10 @@ func init() {
 	apikey.Interceptors[0] = apikeyMixinInters1[0]
 	apikeyMixinFields0 := apikeyMixin[0].Fields()
 	_ = apikeyMixinFields0
+	apikeyMixinFields2 := apikeyMixin[2].Fields()
+	_ = apikeyMixinFields2
+	apikeyMixinFields3 := apikeyMixin[3].Fields()
+	_ = apikeyMixinFields3
 	apikeyFields := schema.APIKey{}.Fields()
 	_ = apikeyFields
 	// apikeyDescCreatedAt is the schema descriptor for created_at field.
@@ -47,6 +60,34 @@ func init() {
 	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
 	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescRpmLimit is the schema descriptor for rpm_limit field.
+	apikeyDescRpmLimit := apikeyMixinFields2[0].Descriptor()
+	// apikey.DefaultRpmLimit holds the default value on creation for the rpm_limit field.
+	apikey.DefaultRpmLimit = apikeyDescRpmLimit.Default.(int)
+	// apikey.RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	apikey.RpmLimitValidator = apikeyDescRpmLimit.Validators[0].(func(int) error)
+	// apikeyDescInputTpmLimit is the schema descriptor for input_tpm_limit field.
+	apikeyDescInputTpmLimit := apikeyMixinFields2[1].Descriptor()
+	// apikey.DefaultInputTpmLimit holds the default value on creation for the input_tpm_limit field.
+	apikey.DefaultInputTpmLimit = apikeyDescInputTpmLimit.Default.(int)
+	// apikey.InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	apikey.InputTpmLimitValidator = apikeyDescInputTpmLimit.Validators[0].(func(int) error)
+	// apikeyDescOutputTpmLimit is the schema descriptor for output_tpm_limit field.
+	apikeyDescOutputTpmLimit := apikeyMixinFields2[2].Descriptor()
+	// apikey.DefaultOutputTpmLimit holds the default value on creation for the output_tpm_limit field.
+	apikey.DefaultOutputTpmLimit = apikeyDescOutputTpmLimit.Default.(int)
+	// apikey.OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	apikey.OutputTpmLimitValidator = apikeyDescOutputTpmLimit.Validators[0].(func(int) error)
+	// apikeyDescResponseCacheEnabled is the schema descriptor for response_cache_enabled field.
+	apikeyDescResponseCacheEnabled := apikeyMixinFields3[0].Descriptor()
+	// apikey.DefaultResponseCacheEnabled holds the default value on creation for the response_cache_enabled field.
+	apikey.DefaultResponseCacheEnabled = apikeyDescResponseCacheEnabled.Default.(bool)
+	// apikeyDescResponseCacheTTLSeconds is the schema descriptor for response_cache_ttl_seconds field.
+	apikeyDescResponseCacheTTLSeconds := apikeyMixinFields3[1].Descriptor()
+	// apikey.DefaultResponseCacheTTLSeconds holds the default value on creation for the response_cache_ttl_seconds field.
+	apikey.DefaultResponseCacheTTLSeconds = apikeyDescResponseCacheTTLSeconds.Default.(int)
+	// apikey.ResponseCacheTTLSecondsValidator is a validator for the "response_cache_ttl_seconds" field. It is called by the builders before save.
+	apikey.ResponseCacheTTLSecondsValidator = apikeyDescResponseCacheTTLSeconds.Validators[0].(func(int) error)
 	// apikeyDescKey is the schema descriptor for key field.
 	apikeyDescKey := apikeyFields[1].Descriptor()
 	// apikey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
@@ -89,6 +130,18 @@ func init() {
 	apikey.DefaultStatus = apikeyDescStatus.Default.(string)
 	// apikey.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	apikey.StatusValidator = apikeyDescStatus.Validators[0].(func(string) error)
+	// apikeyDescQuotaUsedUsd is the schema descriptor for quota_used_usd field.
+	apikeyDescQuotaUsedUsd := apikeyFields[13].Descriptor()
+	// apikey.DefaultQuotaUsedUsd holds the default value on creation for the quota_used_usd field.
+	apikey.DefaultQuotaUsedUsd = apikeyDescQuotaUsedUsd.Default.(float64)
+	// apikeyDescDailyUsageUsd is the schema descriptor for daily_usage_usd field.
+	apikeyDescDailyUsageUsd := apikeyFields[14].Descriptor()
+	// apikey.DefaultDailyUsageUsd holds the default value on creation for the daily_usage_usd field.
+	apikey.DefaultDailyUsageUsd = apikeyDescDailyUsageUsd.Default.(float64)
+	// apikeyDescMonthlyUsageUsd is the schema descriptor for monthly_usage_usd field.
+	apikeyDescMonthlyUsageUsd := apikeyFields[15].Descriptor()
+	// apikey.DefaultMonthlyUsageUsd holds the default value on creation for the monthly_usage_usd field.
+	apikey.DefaultMonthlyUsageUsd = apikeyDescMonthlyUsageUsd.Default.(float64)
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinHooks1 := accountMixin[1].Hooks()
 	account.Hooks[0] = accountMixinHooks1[0]
@@ -171,33 +224,33 @@ func init() {
 	// account.DefaultExtra holds the default value on creation for the extra field.
 	account.DefaultExtra = accountDescExtra.Default.(func() map[string]interface{})
 	// accountDescConcurrency is the schema descriptor for concurrency field.
-	accountDescConcurrency := accountFields[7].Descriptor()
+	accountDescConcurrency := accountFields[8].Descriptor()
 	// account.DefaultConcurrency holds the default value on creation for the concurrency field.
 	account.DefaultConcurrency = accountDescConcurrency.Default.(int)
 	// accountDescPriority is the schema descriptor for priority field.
-	accountDescPriority := accountFields[8].Descriptor()
+	accountDescPriority := accountFields[9].Descriptor()
 	// account.DefaultPriority holds the default value on creation for the priority field.
 	account.DefaultPriority = accountDescPriority.Default.(int)
 	// accountDescRateMultiplier is the schema descriptor for rate_multiplier field.
-	accountDescRateMultiplier := accountFields[9].Descriptor()
+	accountDescRateMultiplier := accountFields[10].Descriptor()
 	// account.DefaultRateMultiplier holds the default value on creation for the rate_multiplier field.
 	account.DefaultRateMultiplier = accountDescRateMultiplier.Default.(float64)
 	// accountDescStatus is the schema descriptor for status field.
-	accountDescStatus := accountFields[10].Descriptor()
+	accountDescStatus := accountFields[11].Descriptor()
 	// account.DefaultStatus holds the default value on creation for the status field.
 	account.DefaultStatus = accountDescStatus.Default.(string)
 	// account.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	account.StatusValidator = accountDescStatus.Validators[0].(func(string) error)
 	// accountDescAutoPauseOnExpired is the schema descriptor for auto_pause_on_expired field.
-	accountDescAutoPauseOnExpired := accountFields[14].Descriptor()
+	accountDescAutoPauseOnExpired := accountFields[15].Descriptor()
 	// account.DefaultAutoPauseOnExpired holds the default value on creation for the auto_pause_on_expired field.
 	account.DefaultAutoPauseOnExpired = accountDescAutoPauseOnExpired.Default.(bool)
 	// accountDescSchedulable is the schema descriptor for schedulable field.
-	accountDescSchedulable := accountFields[15].Descriptor()
+	accountDescSchedulable := accountFields[16].Descriptor()
 	// account.DefaultSchedulable holds the default value on creation for the schedulable field.
 	account.DefaultSchedulable = accountDescSchedulable.Default.(bool)
 	// accountDescSessionWindowStatus is the schema descriptor for session_window_status field.
-	accountDescSessionWindowStatus := accountFields[21].Descriptor()
+	accountDescSessionWindowStatus := accountFields[22].Descriptor()
 	// account.SessionWindowStatusValidator is a validator for the "session_window_status" field. It is called by the builders before save.
 	account.SessionWindowStatusValidator = accountDescSessionWindowStatus.Validators[0].(func(string) error)
 	accountgroupFields := schema.AccountGroup{}.Fields()
@@ -210,6 +263,154 @@ func init() {
 	accountgroupDescCreatedAt := accountgroupFields[3].Descriptor()
 	// accountgroup.DefaultCreatedAt holds the default value on creation for the created_at field.
 	accountgroup.DefaultCreatedAt = accountgroupDescCreatedAt.Default.(func() time.Time)
+	adminapikeyFields := schema.AdminAPIKey{}.Fields()
+	_ = adminapikeyFields
+	// adminapikeyDescName is the schema descriptor for name field.
+	adminapikeyDescName := adminapikeyFields[0].Descriptor()
+	// adminapikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	adminapikey.NameValidator = func() func(string) error {
+		validators := adminapikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminapikeyDescKeyHash is the schema descriptor for key_hash field.
+	adminapikeyDescKeyHash := adminapikeyFields[1].Descriptor()
+	// adminapikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	adminapikey.KeyHashValidator = func() func(string) error {
+		validators := adminapikeyDescKeyHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_hash string) error {
+			for _, fn := range fns {
+				if err := fn(key_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminapikeyDescKeyPrefix is the schema descriptor for key_prefix field.
+	adminapikeyDescKeyPrefix := adminapikeyFields[2].Descriptor()
+	// adminapikey.DefaultKeyPrefix holds the default value on creation for the key_prefix field.
+	adminapikey.DefaultKeyPrefix = adminapikeyDescKeyPrefix.Default.(string)
+	// adminapikey.KeyPrefixValidator is a validator for the "key_prefix" field. It is called by the builders before save.
+	adminapikey.KeyPrefixValidator = adminapikeyDescKeyPrefix.Validators[0].(func(string) error)
+	// adminapikeyDescCreatedAt is the schema descriptor for created_at field.
+	adminapikeyDescCreatedAt := adminapikeyFields[7].Descriptor()
+	// adminapikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminapikey.DefaultCreatedAt = adminapikeyDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescActorType is the schema descriptor for actor_type field.
+	auditlogDescActorType := auditlogFields[1].Descriptor()
+	// auditlog.DefaultActorType holds the default value on creation for the actor_type field.
+	auditlog.DefaultActorType = auditlogDescActorType.Default.(string)
+	// auditlog.ActorTypeValidator is a validator for the "actor_type" field. It is called by the builders before save.
+	auditlog.ActorTypeValidator = auditlogDescActorType.Validators[0].(func(string) error)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[2].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = func() func(string) error {
+		validators := auditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[3].Descriptor()
+	// auditlog.DefaultTargetType holds the default value on creation for the target_type field.
+	auditlog.DefaultTargetType = auditlogDescTargetType.Default.(string)
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[4].Descriptor()
+	// auditlog.DefaultTargetID holds the default value on creation for the target_id field.
+	auditlog.DefaultTargetID = auditlogDescTargetID.Default.(string)
+	// auditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	auditlog.TargetIDValidator = auditlogDescTargetID.Validators[0].(func(string) error)
+	// auditlogDescStatusCode is the schema descriptor for status_code field.
+	auditlogDescStatusCode := auditlogFields[7].Descriptor()
+	// auditlog.DefaultStatusCode holds the default value on creation for the status_code field.
+	auditlog.DefaultStatusCode = auditlogDescStatusCode.Default.(int)
+	// auditlogDescIPAddress is the schema descriptor for ip_address field.
+	auditlogDescIPAddress := auditlogFields[8].Descriptor()
+	// auditlog.DefaultIPAddress holds the default value on creation for the ip_address field.
+	auditlog.DefaultIPAddress = auditlogDescIPAddress.Default.(string)
+	// auditlog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	auditlog.IPAddressValidator = auditlogDescIPAddress.Validators[0].(func(string) error)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[9].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	auditlog.UserAgentValidator = auditlogDescUserAgent.Validators[0].(func(string) error)
+	// auditlogDescRequestID is the schema descriptor for request_id field.
+	auditlogDescRequestID := auditlogFields[10].Descriptor()
+	// auditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	auditlog.DefaultRequestID = auditlogDescRequestID.Default.(string)
+	// auditlog.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	auditlog.RequestIDValidator = auditlogDescRequestID.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[11].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	balancetransactionFields := schema.BalanceTransaction{}.Fields()
+	_ = balancetransactionFields
+	// balancetransactionDescSourceType is the schema descriptor for source_type field.
+	balancetransactionDescSourceType := balancetransactionFields[3].Descriptor()
+	// balancetransaction.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	balancetransaction.SourceTypeValidator = func() func(string) error {
+		validators := balancetransactionDescSourceType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source_type string) error {
+			for _, fn := range fns {
+				if err := fn(source_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// balancetransactionDescReferenceID is the schema descriptor for reference_id field.
+	balancetransactionDescReferenceID := balancetransactionFields[4].Descriptor()
+	// balancetransaction.DefaultReferenceID holds the default value on creation for the reference_id field.
+	balancetransaction.DefaultReferenceID = balancetransactionDescReferenceID.Default.(string)
+	// balancetransaction.ReferenceIDValidator is a validator for the "reference_id" field. It is called by the builders before save.
+	balancetransaction.ReferenceIDValidator = balancetransactionDescReferenceID.Validators[0].(func(string) error)
+	// balancetransactionDescNotes is the schema descriptor for notes field.
+	balancetransactionDescNotes := balancetransactionFields[6].Descriptor()
+	// balancetransaction.DefaultNotes holds the default value on creation for the notes field.
+	balancetransaction.DefaultNotes = balancetransactionDescNotes.Default.(string)
+	// balancetransaction.NotesValidator is a validator for the "notes" field. It is called by the builders before save.
+	balancetransaction.NotesValidator = balancetransactionDescNotes.Validators[0].(func(string) error)
+	// balancetransactionDescCreatedAt is the schema descriptor for created_at field.
+	balancetransactionDescCreatedAt := balancetransactionFields[7].Descriptor()
+	// balancetransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancetransaction.DefaultCreatedAt = balancetransactionDescCreatedAt.Default.(func() time.Time)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinHooks1 := groupMixin[1].Hooks()
 	group.Hooks[0] = groupMixinHooks1[0]
@@ -217,6 +418,10 @@ func init() {
 	group.Interceptors[0] = groupMixinInters1[0]
 	groupMixinFields0 := groupMixin[0].Fields()
 	_ = groupMixinFields0
+	groupMixinFields2 := groupMixin[2].Fields()
+	_ = groupMixinFields2
+	groupMixinFields3 := groupMixin[3].Fields()
+	_ = groupMixinFields3
 	groupFields := schema.Group{}.Fields()
 	_ = groupFields
 	// groupDescCreatedAt is the schema descriptor for created_at field.
@@ -229,6 +434,34 @@ func init() {
 	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
 	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupDescRpmLimit is the schema descriptor for rpm_limit field.
+	groupDescRpmLimit := groupMixinFields2[0].Descriptor()
+	// group.DefaultRpmLimit holds the default value on creation for the rpm_limit field.
+	group.DefaultRpmLimit = groupDescRpmLimit.Default.(int)
+	// group.RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	group.RpmLimitValidator = groupDescRpmLimit.Validators[0].(func(int) error)
+	// groupDescInputTpmLimit is the schema descriptor for input_tpm_limit field.
+	groupDescInputTpmLimit := groupMixinFields2[1].Descriptor()
+	// group.DefaultInputTpmLimit holds the default value on creation for the input_tpm_limit field.
+	group.DefaultInputTpmLimit = groupDescInputTpmLimit.Default.(int)
+	// group.InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	group.InputTpmLimitValidator = groupDescInputTpmLimit.Validators[0].(func(int) error)
+	// groupDescOutputTpmLimit is the schema descriptor for output_tpm_limit field.
+	groupDescOutputTpmLimit := groupMixinFields2[2].Descriptor()
+	// group.DefaultOutputTpmLimit holds the default value on creation for the output_tpm_limit field.
+	group.DefaultOutputTpmLimit = groupDescOutputTpmLimit.Default.(int)
+	// group.OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	group.OutputTpmLimitValidator = groupDescOutputTpmLimit.Validators[0].(func(int) error)
+	// groupDescResponseCacheEnabled is the schema descriptor for response_cache_enabled field.
+	groupDescResponseCacheEnabled := groupMixinFields3[0].Descriptor()
+	// group.DefaultResponseCacheEnabled holds the default value on creation for the response_cache_enabled field.
+	group.DefaultResponseCacheEnabled = groupDescResponseCacheEnabled.Default.(bool)
+	// groupDescResponseCacheTTLSeconds is the schema descriptor for response_cache_ttl_seconds field.
+	groupDescResponseCacheTTLSeconds := groupMixinFields3[1].Descriptor()
+	// group.DefaultResponseCacheTTLSeconds holds the default value on creation for the response_cache_ttl_seconds field.
+	group.DefaultResponseCacheTTLSeconds = groupDescResponseCacheTTLSeconds.Default.(int)
+	// group.ResponseCacheTTLSecondsValidator is a validator for the "response_cache_ttl_seconds" field. It is called by the builders before save.
+	group.ResponseCacheTTLSecondsValidator = groupDescResponseCacheTTLSeconds.Validators[0].(func(int) error)
 	// groupDescName is the schema descriptor for name field.
 	groupDescName := groupFields[0].Descriptor()
 	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -285,6 +518,143 @@ func init() {
 	groupDescModelRoutingEnabled := groupFields[17].Descriptor()
 	// group.DefaultModelRoutingEnabled holds the default value on creation for the model_routing_enabled field.
 	group.DefaultModelRoutingEnabled = groupDescModelRoutingEnabled.Default.(bool)
+	// groupDescResponseCacheCostRatio is the schema descriptor for response_cache_cost_ratio field.
+	groupDescResponseCacheCostRatio := groupFields[18].Descriptor()
+	// group.DefaultResponseCacheCostRatio holds the default value on creation for the response_cache_cost_ratio field.
+	group.DefaultResponseCacheCostRatio = groupDescResponseCacheCostRatio.Default.(float64)
+	modelpriceMixin := schema.ModelPrice{}.Mixin()
+	modelpriceMixinFields0 := modelpriceMixin[0].Fields()
+	_ = modelpriceMixinFields0
+	modelpriceFields := schema.ModelPrice{}.Fields()
+	_ = modelpriceFields
+	// modelpriceDescCreatedAt is the schema descriptor for created_at field.
+	modelpriceDescCreatedAt := modelpriceMixinFields0[0].Descriptor()
+	// modelprice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	modelprice.DefaultCreatedAt = modelpriceDescCreatedAt.Default.(func() time.Time)
+	// modelpriceDescUpdatedAt is the schema descriptor for updated_at field.
+	modelpriceDescUpdatedAt := modelpriceMixinFields0[1].Descriptor()
+	// modelprice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	modelprice.DefaultUpdatedAt = modelpriceDescUpdatedAt.Default.(func() time.Time)
+	// modelprice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	modelprice.UpdateDefaultUpdatedAt = modelpriceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// modelpriceDescModel is the schema descriptor for model field.
+	modelpriceDescModel := modelpriceFields[1].Descriptor()
+	// modelprice.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	modelprice.ModelValidator = func() func(string) error {
+		validators := modelpriceDescModel.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(model string) error {
+			for _, fn := range fns {
+				if err := fn(model); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// modelpriceDescCacheWritePrice is the schema descriptor for cache_write_price field.
+	modelpriceDescCacheWritePrice := modelpriceFields[4].Descriptor()
+	// modelprice.DefaultCacheWritePrice holds the default value on creation for the cache_write_price field.
+	modelprice.DefaultCacheWritePrice = modelpriceDescCacheWritePrice.Default.(float64)
+	// modelpriceDescCacheReadPrice is the schema descriptor for cache_read_price field.
+	modelpriceDescCacheReadPrice := modelpriceFields[5].Descriptor()
+	// modelprice.DefaultCacheReadPrice holds the default value on creation for the cache_read_price field.
+	modelprice.DefaultCacheReadPrice = modelpriceDescCacheReadPrice.Default.(float64)
+	// modelpriceDescLongContextThreshold is the schema descriptor for long_context_threshold field.
+	modelpriceDescLongContextThreshold := modelpriceFields[6].Descriptor()
+	// modelprice.DefaultLongContextThreshold holds the default value on creation for the long_context_threshold field.
+	modelprice.DefaultLongContextThreshold = modelpriceDescLongContextThreshold.Default.(int)
+	// modelpriceDescNotes is the schema descriptor for notes field.
+	modelpriceDescNotes := modelpriceFields[10].Descriptor()
+	// modelprice.DefaultNotes holds the default value on creation for the notes field.
+	modelprice.DefaultNotes = modelpriceDescNotes.Default.(string)
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinHooks1 := organizationMixin[1].Hooks()
+	organization.Hooks[0] = organizationMixinHooks1[0]
+	organizationMixinInters1 := organizationMixin[1].Interceptors()
+	organization.Interceptors[0] = organizationMixinInters1[0]
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationMixinFields0[1].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = func() func(string) error {
+		validators := organizationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// organizationDescBalance is the schema descriptor for balance field.
+	organizationDescBalance := organizationFields[2].Descriptor()
+	// organization.DefaultBalance holds the default value on creation for the balance field.
+	organization.DefaultBalance = organizationDescBalance.Default.(float64)
+	// organizationDescConcurrency is the schema descriptor for concurrency field.
+	organizationDescConcurrency := organizationFields[3].Descriptor()
+	// organization.DefaultConcurrency holds the default value on creation for the concurrency field.
+	organization.DefaultConcurrency = organizationDescConcurrency.Default.(int)
+	// organizationDescStatus is the schema descriptor for status field.
+	organizationDescStatus := organizationFields[4].Descriptor()
+	// organization.DefaultStatus holds the default value on creation for the status field.
+	organization.DefaultStatus = organizationDescStatus.Default.(string)
+	// organization.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	organization.StatusValidator = organizationDescStatus.Validators[0].(func(string) error)
+	// organizationDescNotes is the schema descriptor for notes field.
+	organizationDescNotes := organizationFields[5].Descriptor()
+	// organization.DefaultNotes holds the default value on creation for the notes field.
+	organization.DefaultNotes = organizationDescNotes.Default.(string)
+	organizationmemberMixin := schema.OrganizationMember{}.Mixin()
+	organizationmemberMixinFields0 := organizationmemberMixin[0].Fields()
+	_ = organizationmemberMixinFields0
+	organizationmemberFields := schema.OrganizationMember{}.Fields()
+	_ = organizationmemberFields
+	// organizationmemberDescCreatedAt is the schema descriptor for created_at field.
+	organizationmemberDescCreatedAt := organizationmemberMixinFields0[0].Descriptor()
+	// organizationmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationmember.DefaultCreatedAt = organizationmemberDescCreatedAt.Default.(func() time.Time)
+	// organizationmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationmemberDescUpdatedAt := organizationmemberMixinFields0[1].Descriptor()
+	// organizationmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizationmember.DefaultUpdatedAt = organizationmemberDescUpdatedAt.Default.(func() time.Time)
+	// organizationmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizationmember.UpdateDefaultUpdatedAt = organizationmemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationmemberDescRole is the schema descriptor for role field.
+	organizationmemberDescRole := organizationmemberFields[2].Descriptor()
+	// organizationmember.DefaultRole holds the default value on creation for the role field.
+	organizationmember.DefaultRole = organizationmemberDescRole.Default.(string)
+	// organizationmember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	organizationmember.RoleValidator = organizationmemberDescRole.Validators[0].(func(string) error)
+	// organizationmemberDescMonthlyUsageUsd is the schema descriptor for monthly_usage_usd field.
+	organizationmemberDescMonthlyUsageUsd := organizationmemberFields[4].Descriptor()
+	// organizationmember.DefaultMonthlyUsageUsd holds the default value on creation for the monthly_usage_usd field.
+	organizationmember.DefaultMonthlyUsageUsd = organizationmemberDescMonthlyUsageUsd.Default.(float64)
+	// organizationmemberDescJoinedAt is the schema descriptor for joined_at field.
+	organizationmemberDescJoinedAt := organizationmemberFields[6].Descriptor()
+	// organizationmember.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	organizationmember.DefaultJoinedAt = organizationmemberDescJoinedAt.Default.(func() time.Time)
 	promocodeFields := schema.PromoCode{}.Fields()
 	_ = promocodeFields
 	// promocodeDescCode is the schema descriptor for code field.
@@ -416,16 +786,136 @@ func init() {
 	proxyDescUsername := proxyFields[4].Descriptor()
 	// proxy.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	proxy.UsernameValidator = proxyDescUsername.Validators[0].(func(string) error)
-	// proxyDescPassword is the schema descriptor for password field.
-	proxyDescPassword := proxyFields[5].Descriptor()
-	// proxy.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
-	proxy.PasswordValidator = proxyDescPassword.Validators[0].(func(string) error)
 	// proxyDescStatus is the schema descriptor for status field.
 	proxyDescStatus := proxyFields[6].Descriptor()
 	// proxy.DefaultStatus holds the default value on creation for the status field.
 	proxy.DefaultStatus = proxyDescStatus.Default.(string)
 	// proxy.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	proxy.StatusValidator = proxyDescStatus.Validators[0].(func(string) error)
+	proxypoolMixin := schema.ProxyPool{}.Mixin()
+	proxypoolMixinHooks1 := proxypoolMixin[1].Hooks()
+	proxypool.Hooks[0] = proxypoolMixinHooks1[0]
+	proxypoolMixinInters1 := proxypoolMixin[1].Interceptors()
+	proxypool.Interceptors[0] = proxypoolMixinInters1[0]
+	proxypoolMixinFields0 := proxypoolMixin[0].Fields()
+	_ = proxypoolMixinFields0
+	proxypoolFields := schema.ProxyPool{}.Fields()
+	_ = proxypoolFields
+	// proxypoolDescCreatedAt is the schema descriptor for created_at field.
+	proxypoolDescCreatedAt := proxypoolMixinFields0[0].Descriptor()
+	// proxypool.DefaultCreatedAt holds the default value on creation for the created_at field.
+	proxypool.DefaultCreatedAt = proxypoolDescCreatedAt.Default.(func() time.Time)
+	// proxypoolDescUpdatedAt is the schema descriptor for updated_at field.
+	proxypoolDescUpdatedAt := proxypoolMixinFields0[1].Descriptor()
+	// proxypool.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	proxypool.DefaultUpdatedAt = proxypoolDescUpdatedAt.Default.(func() time.Time)
+	// proxypool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	proxypool.UpdateDefaultUpdatedAt = proxypoolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// proxypoolDescName is the schema descriptor for name field.
+	proxypoolDescName := proxypoolFields[0].Descriptor()
+	// proxypool.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	proxypool.NameValidator = func() func(string) error {
+		validators := proxypoolDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// proxypoolDescDescription is the schema descriptor for description field.
+	proxypoolDescDescription := proxypoolFields[1].Descriptor()
+	// proxypool.DefaultDescription holds the default value on creation for the description field.
+	proxypool.DefaultDescription = proxypoolDescDescription.Default.(string)
+	// proxypoolDescPolicy is the schema descriptor for policy field.
+	proxypoolDescPolicy := proxypoolFields[2].Descriptor()
+	// proxypool.DefaultPolicy holds the default value on creation for the policy field.
+	proxypool.DefaultPolicy = proxypoolDescPolicy.Default.(string)
+	// proxypool.PolicyValidator is a validator for the "policy" field. It is called by the builders before save.
+	proxypool.PolicyValidator = proxypoolDescPolicy.Validators[0].(func(string) error)
+	// proxypoolDescStatus is the schema descriptor for status field.
+	proxypoolDescStatus := proxypoolFields[3].Descriptor()
+	// proxypool.DefaultStatus holds the default value on creation for the status field.
+	proxypool.DefaultStatus = proxypoolDescStatus.Default.(string)
+	// proxypool.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	proxypool.StatusValidator = proxypoolDescStatus.Validators[0].(func(string) error)
+	proxysubscriptionMixin := schema.ProxySubscription{}.Mixin()
+	proxysubscriptionMixinHooks1 := proxysubscriptionMixin[1].Hooks()
+	proxysubscription.Hooks[0] = proxysubscriptionMixinHooks1[0]
+	proxysubscriptionMixinInters1 := proxysubscriptionMixin[1].Interceptors()
+	proxysubscription.Interceptors[0] = proxysubscriptionMixinInters1[0]
+	proxysubscriptionMixinFields0 := proxysubscriptionMixin[0].Fields()
+	_ = proxysubscriptionMixinFields0
+	proxysubscriptionFields := schema.ProxySubscription{}.Fields()
+	_ = proxysubscriptionFields
+	// proxysubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	proxysubscriptionDescCreatedAt := proxysubscriptionMixinFields0[0].Descriptor()
+	// proxysubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	proxysubscription.DefaultCreatedAt = proxysubscriptionDescCreatedAt.Default.(func() time.Time)
+	// proxysubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	proxysubscriptionDescUpdatedAt := proxysubscriptionMixinFields0[1].Descriptor()
+	// proxysubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	proxysubscription.DefaultUpdatedAt = proxysubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// proxysubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	proxysubscription.UpdateDefaultUpdatedAt = proxysubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// proxysubscriptionDescName is the schema descriptor for name field.
+	proxysubscriptionDescName := proxysubscriptionFields[0].Descriptor()
+	// proxysubscription.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	proxysubscription.NameValidator = func() func(string) error {
+		validators := proxysubscriptionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// proxysubscriptionDescURL is the schema descriptor for url field.
+	proxysubscriptionDescURL := proxysubscriptionFields[1].Descriptor()
+	// proxysubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	proxysubscription.URLValidator = proxysubscriptionDescURL.Validators[0].(func(string) error)
+	// proxysubscriptionDescRefreshIntervalMinutes is the schema descriptor for refresh_interval_minutes field.
+	proxysubscriptionDescRefreshIntervalMinutes := proxysubscriptionFields[2].Descriptor()
+	// proxysubscription.DefaultRefreshIntervalMinutes holds the default value on creation for the refresh_interval_minutes field.
+	proxysubscription.DefaultRefreshIntervalMinutes = proxysubscriptionDescRefreshIntervalMinutes.Default.(int)
+	// proxysubscriptionDescStatus is the schema descriptor for status field.
+	proxysubscriptionDescStatus := proxysubscriptionFields[4].Descriptor()
+	// proxysubscription.DefaultStatus holds the default value on creation for the status field.
+	proxysubscription.DefaultStatus = proxysubscriptionDescStatus.Default.(string)
+	// proxysubscription.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	proxysubscription.StatusValidator = proxysubscriptionDescStatus.Validators[0].(func(string) error)
+	// proxysubscriptionDescLastError is the schema descriptor for last_error field.
+	proxysubscriptionDescLastError := proxysubscriptionFields[6].Descriptor()
+	// proxysubscription.DefaultLastError holds the default value on creation for the last_error field.
+	proxysubscription.DefaultLastError = proxysubscriptionDescLastError.Default.(string)
+	// proxysubscriptionDescLastTotal is the schema descriptor for last_total field.
+	proxysubscriptionDescLastTotal := proxysubscriptionFields[7].Descriptor()
+	// proxysubscription.DefaultLastTotal holds the default value on creation for the last_total field.
+	proxysubscription.DefaultLastTotal = proxysubscriptionDescLastTotal.Default.(int)
+	// proxysubscriptionDescLastCreated is the schema descriptor for last_created field.
+	proxysubscriptionDescLastCreated := proxysubscriptionFields[8].Descriptor()
+	// proxysubscription.DefaultLastCreated holds the default value on creation for the last_created field.
+	proxysubscription.DefaultLastCreated = proxysubscriptionDescLastCreated.Default.(int)
+	// proxysubscriptionDescLastSkipped is the schema descriptor for last_skipped field.
+	proxysubscriptionDescLastSkipped := proxysubscriptionFields[9].Descriptor()
+	// proxysubscription.DefaultLastSkipped holds the default value on creation for the last_skipped field.
+	proxysubscription.DefaultLastSkipped = proxysubscriptionDescLastSkipped.Default.(int)
+	// proxysubscriptionDescLastDisabled is the schema descriptor for last_disabled field.
+	proxysubscriptionDescLastDisabled := proxysubscriptionFields[10].Descriptor()
+	// proxysubscription.DefaultLastDisabled holds the default value on creation for the last_disabled field.
+	proxysubscription.DefaultLastDisabled = proxysubscriptionDescLastDisabled.Default.(int)
 	redeemcodeFields := schema.RedeemCode{}.Fields()
 	_ = redeemcodeFields
 	// redeemcodeDescCode is the schema descriptor for code field.
@@ -496,6 +986,61 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	subscriptionplanMixin := schema.SubscriptionPlan{}.Mixin()
+	subscriptionplanMixinHooks1 := subscriptionplanMixin[1].Hooks()
+	subscriptionplan.Hooks[0] = subscriptionplanMixinHooks1[0]
+	subscriptionplanMixinInters1 := subscriptionplanMixin[1].Interceptors()
+	subscriptionplan.Interceptors[0] = subscriptionplanMixinInters1[0]
+	subscriptionplanMixinFields0 := subscriptionplanMixin[0].Fields()
+	_ = subscriptionplanMixinFields0
+	subscriptionplanFields := schema.SubscriptionPlan{}.Fields()
+	_ = subscriptionplanFields
+	// subscriptionplanDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionplanDescCreatedAt := subscriptionplanMixinFields0[0].Descriptor()
+	// subscriptionplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionplan.DefaultCreatedAt = subscriptionplanDescCreatedAt.Default.(func() time.Time)
+	// subscriptionplanDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionplanDescUpdatedAt := subscriptionplanMixinFields0[1].Descriptor()
+	// subscriptionplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionplan.DefaultUpdatedAt = subscriptionplanDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionplan.UpdateDefaultUpdatedAt = subscriptionplanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionplanDescName is the schema descriptor for name field.
+	subscriptionplanDescName := subscriptionplanFields[0].Descriptor()
+	// subscriptionplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	subscriptionplan.NameValidator = func() func(string) error {
+		validators := subscriptionplanDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// subscriptionplanDescDescription is the schema descriptor for description field.
+	subscriptionplanDescDescription := subscriptionplanFields[1].Descriptor()
+	// subscriptionplan.DefaultDescription holds the default value on creation for the description field.
+	subscriptionplan.DefaultDescription = subscriptionplanDescDescription.Default.(string)
+	// subscriptionplanDescValidityDays is the schema descriptor for validity_days field.
+	subscriptionplanDescValidityDays := subscriptionplanFields[3].Descriptor()
+	// subscriptionplan.DefaultValidityDays holds the default value on creation for the validity_days field.
+	subscriptionplan.DefaultValidityDays = subscriptionplanDescValidityDays.Default.(int)
+	// subscriptionplanDescStatus is the schema descriptor for status field.
+	subscriptionplanDescStatus := subscriptionplanFields[8].Descriptor()
+	// subscriptionplan.DefaultStatus holds the default value on creation for the status field.
+	subscriptionplan.DefaultStatus = subscriptionplanDescStatus.Default.(string)
+	// subscriptionplan.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	subscriptionplan.StatusValidator = subscriptionplanDescStatus.Validators[0].(func(string) error)
+	// subscriptionplanDescSortOrder is the schema descriptor for sort_order field.
+	subscriptionplanDescSortOrder := subscriptionplanFields[9].Descriptor()
+	// subscriptionplan.DefaultSortOrder holds the default value on creation for the sort_order field.
+	subscriptionplan.DefaultSortOrder = subscriptionplanDescSortOrder.Default.(int)
 	usagecleanuptaskMixin := schema.UsageCleanupTask{}.Mixin()
 	usagecleanuptaskMixinFields0 := usagecleanuptaskMixin[0].Fields()
 	_ = usagecleanuptaskMixinFields0
@@ -572,83 +1117,87 @@ func init() {
 		}
 	}()
 	// usagelogDescInputTokens is the schema descriptor for input_tokens field.
-	usagelogDescInputTokens := usagelogFields[7].Descriptor()
+	usagelogDescInputTokens := usagelogFields[8].Descriptor()
 	// usagelog.DefaultInputTokens holds the default value on creation for the input_tokens field.
 	usagelog.DefaultInputTokens = usagelogDescInputTokens.Default.(int)
 	// usagelogDescOutputTokens is the schema descriptor for output_tokens field.
-	usagelogDescOutputTokens := usagelogFields[8].Descriptor()
+	usagelogDescOutputTokens := usagelogFields[9].Descriptor()
 	// usagelog.DefaultOutputTokens holds the default value on creation for the output_tokens field.
 	usagelog.DefaultOutputTokens = usagelogDescOutputTokens.Default.(int)
 	// usagelogDescCacheCreationTokens is the schema descriptor for cache_creation_tokens field.
-	usagelogDescCacheCreationTokens := usagelogFields[9].Descriptor()
+	usagelogDescCacheCreationTokens := usagelogFields[10].Descriptor()
 	// usagelog.DefaultCacheCreationTokens holds the default value on creation for the cache_creation_tokens field.
 	usagelog.DefaultCacheCreationTokens = usagelogDescCacheCreationTokens.Default.(int)
 	// usagelogDescCacheReadTokens is the schema descriptor for cache_read_tokens field.
-	usagelogDescCacheReadTokens := usagelogFields[10].Descriptor()
+	usagelogDescCacheReadTokens := usagelogFields[11].Descriptor()
 	// usagelog.DefaultCacheReadTokens holds the default value on creation for the cache_read_tokens field.
 	usagelog.DefaultCacheReadTokens = usagelogDescCacheReadTokens.Default.(int)
 	// usagelogDescCacheCreation5mTokens is the schema descriptor for cache_creation_5m_tokens field.
-	usagelogDescCacheCreation5mTokens := usagelogFields[11].Descriptor()
+	usagelogDescCacheCreation5mTokens := usagelogFields[12].Descriptor()
 	// usagelog.DefaultCacheCreation5mTokens holds the default value on creation for the cache_creation_5m_tokens field.
 	usagelog.DefaultCacheCreation5mTokens = usagelogDescCacheCreation5mTokens.Default.(int)
 	// usagelogDescCacheCreation1hTokens is the schema descriptor for cache_creation_1h_tokens field.
-	usagelogDescCacheCreation1hTokens := usagelogFields[12].Descriptor()
+	usagelogDescCacheCreation1hTokens := usagelogFields[13].Descriptor()
 	// usagelog.DefaultCacheCreation1hTokens holds the default value on creation for the cache_creation_1h_tokens field.
 	usagelog.DefaultCacheCreation1hTokens = usagelogDescCacheCreation1hTokens.Default.(int)
 	// usagelogDescInputCost is the schema descriptor for input_cost field.
-	usagelogDescInputCost := usagelogFields[13].Descriptor()
+	usagelogDescInputCost := usagelogFields[14].Descriptor()
 	// usagelog.DefaultInputCost holds the default value on creation for the input_cost field.
 	usagelog.DefaultInputCost = usagelogDescInputCost.Default.(float64)
 	// usagelogDescOutputCost is the schema descriptor for output_cost field.
-	usagelogDescOutputCost := usagelogFields[14].Descriptor()
+	usagelogDescOutputCost := usagelogFields[15].Descriptor()
 	// usagelog.DefaultOutputCost holds the default value on creation for the output_cost field.
 	usagelog.DefaultOutputCost = usagelogDescOutputCost.Default.(float64)
 	// usagelogDescCacheCreationCost is the schema descriptor for cache_creation_cost field.
-	usagelogDescCacheCreationCost := usagelogFields[15].Descriptor()
+	usagelogDescCacheCreationCost := usagelogFields[16].Descriptor()
 	// usagelog.DefaultCacheCreationCost holds the default value on creation for the cache_creation_cost field.
 	usagelog.DefaultCacheCreationCost = usagelogDescCacheCreationCost.Default.(float64)
 	// usagelogDescCacheReadCost is the schema descriptor for cache_read_cost field.
-	usagelogDescCacheReadCost := usagelogFields[16].Descriptor()
+	usagelogDescCacheReadCost := usagelogFields[17].Descriptor()
 	// usagelog.DefaultCacheReadCost holds the default value on creation for the cache_read_cost field.
 	usagelog.DefaultCacheReadCost = usagelogDescCacheReadCost.Default.(float64)
 	// usagelogDescTotalCost is the schema descriptor for total_cost field.
-	usagelogDescTotalCost := usagelogFields[17].Descriptor()
+	usagelogDescTotalCost := usagelogFields[18].Descriptor()
 	// usagelog.DefaultTotalCost holds the default value on creation for the total_cost field.
 	usagelog.DefaultTotalCost = usagelogDescTotalCost.Default.(float64)
 	// usagelogDescActualCost is the schema descriptor for actual_cost field.
-	usagelogDescActualCost := usagelogFields[18].Descriptor()
+	usagelogDescActualCost := usagelogFields[19].Descriptor()
 	// usagelog.DefaultActualCost holds the default value on creation for the actual_cost field.
 	usagelog.DefaultActualCost = usagelogDescActualCost.Default.(float64)
 	// usagelogDescRateMultiplier is the schema descriptor for rate_multiplier field.
-	usagelogDescRateMultiplier := usagelogFields[19].Descriptor()
+	usagelogDescRateMultiplier := usagelogFields[20].Descriptor()
 	// usagelog.DefaultRateMultiplier holds the default value on creation for the rate_multiplier field.
 	usagelog.DefaultRateMultiplier = usagelogDescRateMultiplier.Default.(float64)
 	// usagelogDescBillingType is the schema descriptor for billing_type field.
-	usagelogDescBillingType := usagelogFields[21].Descriptor()
+	usagelogDescBillingType := usagelogFields[22].Descriptor()
 	// usagelog.DefaultBillingType holds the default value on creation for the billing_type field.
 	usagelog.DefaultBillingType = usagelogDescBillingType.Default.(int8)
 	// usagelogDescStream is the schema descriptor for stream field.
-	usagelogDescStream := usagelogFields[22].Descriptor()
+	usagelogDescStream := usagelogFields[23].Descriptor()
 	// usagelog.DefaultStream holds the default value on creation for the stream field.
 	usagelog.DefaultStream = usagelogDescStream.Default.(bool)
 	// usagelogDescUserAgent is the schema descriptor for user_agent field.
-	usagelogDescUserAgent := usagelogFields[25].Descriptor()
+	usagelogDescUserAgent := usagelogFields[26].Descriptor()
 	// usagelog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
 	usagelog.UserAgentValidator = usagelogDescUserAgent.Validators[0].(func(string) error)
 	// usagelogDescIPAddress is the schema descriptor for ip_address field.
-	usagelogDescIPAddress := usagelogFields[26].Descriptor()
+	usagelogDescIPAddress := usagelogFields[27].Descriptor()
 	// usagelog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
 	usagelog.IPAddressValidator = usagelogDescIPAddress.Validators[0].(func(string) error)
+	// usagelogDescTraceID is the schema descriptor for trace_id field.
+	usagelogDescTraceID := usagelogFields[28].Descriptor()
+	// usagelog.TraceIDValidator is a validator for the "trace_id" field. It is called by the builders before save.
+	usagelog.TraceIDValidator = usagelogDescTraceID.Validators[0].(func(string) error)
 	// usagelogDescImageCount is the schema descriptor for image_count field.
-	usagelogDescImageCount := usagelogFields[27].Descriptor()
+	usagelogDescImageCount := usagelogFields[29].Descriptor()
 	// usagelog.DefaultImageCount holds the default value on creation for the image_count field.
 	usagelog.DefaultImageCount = usagelogDescImageCount.Default.(int)
 	// usagelogDescImageSize is the schema descriptor for image_size field.
-	usagelogDescImageSize := usagelogFields[28].Descriptor()
+	usagelogDescImageSize := usagelogFields[30].Descriptor()
 	// usagelog.ImageSizeValidator is a validator for the "image_size" field. It is called by the builders before save.
 	usagelog.ImageSizeValidator = usagelogDescImageSize.Validators[0].(func(string) error)
 	// usagelogDescCreatedAt is the schema descriptor for created_at field.
-	usagelogDescCreatedAt := usagelogFields[29].Descriptor()
+	usagelogDescCreatedAt := usagelogFields[31].Descriptor()
 	// usagelog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	usagelog.DefaultCreatedAt = usagelogDescCreatedAt.Default.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
@@ -658,6 +1207,8 @@ func init() {
 	user.Interceptors[0] = userMixinInters1[0]
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
+	userMixinFields2 := userMixin[2].Fields()
+	_ = userMixinFields2
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -670,6 +1221,24 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescRpmLimit is the schema descriptor for rpm_limit field.
+	userDescRpmLimit := userMixinFields2[0].Descriptor()
+	// user.DefaultRpmLimit holds the default value on creation for the rpm_limit field.
+	user.DefaultRpmLimit = userDescRpmLimit.Default.(int)
+	// user.RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	user.RpmLimitValidator = userDescRpmLimit.Validators[0].(func(int) error)
+	// userDescInputTpmLimit is the schema descriptor for input_tpm_limit field.
+	userDescInputTpmLimit := userMixinFields2[1].Descriptor()
+	// user.DefaultInputTpmLimit holds the default value on creation for the input_tpm_limit field.
+	user.DefaultInputTpmLimit = userDescInputTpmLimit.Default.(int)
+	// user.InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	user.InputTpmLimitValidator = userDescInputTpmLimit.Validators[0].(func(int) error)
+	// userDescOutputTpmLimit is the schema descriptor for output_tpm_limit field.
+	userDescOutputTpmLimit := userMixinFields2[2].Descriptor()
+	// user.DefaultOutputTpmLimit holds the default value on creation for the output_tpm_limit field.
+	user.DefaultOutputTpmLimit = userDescOutputTpmLimit.Default.(int)
+	// user.OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	user.OutputTpmLimitValidator = userDescOutputTpmLimit.Validators[0].(func(int) error)
 	// userDescEmail is the schema descriptor for email field.
 	userDescEmail := userFields[0].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
@@ -909,9 +1478,12 @@ func init() {
 	usersubscriptionDescAssignedAt := usersubscriptionFields[12].Descriptor()
 	// usersubscription.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	usersubscription.DefaultAssignedAt = usersubscriptionDescAssignedAt.Default.(func() time.Time)
+	// usersubscriptionDescAutoRenew is the schema descriptor for auto_renew field.
+	usersubscriptionDescAutoRenew := usersubscriptionFields[15].Descriptor()
+	// usersubscription.DefaultAutoRenew holds the default value on creation for the auto_renew field.
+	usersubscription.DefaultAutoRenew = usersubscriptionDescAutoRenew.Default.(bool)
 }
 
 const (
-	Version = "v0.14.5"                                         // Version of ent codegen.
-	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
+	Version = "v0.14.5" // Version of ent codegen.
 )
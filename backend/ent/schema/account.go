@@ -92,6 +92,12 @@ func (Account) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// proxy_pool_id: 引用的代理池 ID（可选）
+		// 设置后 proxy_id 为从池中分配的当前代理，代理不健康时自动切换
+		field.Int64("proxy_pool_id").
+			Optional().
+			Nillable(),
+
 		// concurrency: 账户最大并发请求数
 		// 用于限制同一时间对该账户发起的请求数量
 		field.Int("concurrency").
@@ -207,6 +213,7 @@ func (Account) Indexes() []ent.Index {
 		index.Fields("type"),                // 按认证类型筛选
 		index.Fields("status"),              // 按状态筛选
 		index.Fields("proxy_id"),            // 按代理筛选
+		index.Fields("proxy_pool_id"),       // 按代理池筛选
 		index.Fields("priority"),            // 按优先级排序
 		index.Fields("last_used_at"),        // 按最后使用时间排序
 		index.Fields("schedulable"),         // 筛选可调度账户
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminAPIKey 定义管理端 API Key 的 schema。
+//
+// 用于外部系统集成调用 /api/v1/admin 接口，每个 Key 有独立名称、权限范围与过期时间。
+// 数据库只保存 Key 的 SHA-256 摘要，明文仅在创建时返回一次。
+//
+// 删除策略：硬删除（吊销即删除，操作记录保留在审计日志中）
+type AdminAPIKey struct {
+	ent.Schema
+}
+
+func (AdminAPIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_api_keys"},
+	}
+}
+
+func (AdminAPIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		// key_hash: 明文 Key 的 SHA-256 十六进制摘要
+		field.String("key_hash").
+			MaxLen(64).
+			NotEmpty().
+			Unique(),
+		// key_prefix: 明文 Key 前缀，仅用于列表中辨识
+		field.String("key_prefix").
+			MaxLen(20).
+			Default(""),
+		// scopes: 权限点列表，见 service.AdminPermission；["*"] 表示全部权限
+		field.JSON("scopes", []string{}).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}).
+			Comment("过期时间，null表示永不过期"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		// created_by: 创建该 Key 的管理员，请求以该管理员身份执行
+		field.Int64("created_by").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (AdminAPIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// key_hash 字段已在 Fields() 中声明 Unique()，无需重复索引
+		index.Fields("expires_at"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -27,6 +28,8 @@ func (APIKey) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.TimeMixin{},
 		mixins.SoftDeleteMixin{},
+		mixins.RateLimitMixin{},
+		mixins.ResponseCacheMixin{},
 	}
 }
 
@@ -52,6 +55,49 @@ func (APIKey) Fields() []ent.Field {
 		field.JSON("ip_blacklist", []string{}).
 			Optional().
 			Comment("Blocked IPs/CIDRs"),
+		field.JSON("allowed_models", []string{}).
+			Optional().
+			Comment("Allowed model patterns, supports * wildcard, e.g. [\"claude-*-haiku*\"]"),
+		field.JSON("model_aliases", map[string]string{}).
+			Optional().
+			Comment("Client-facing model aliases, e.g. {\"fast\": \"claude-haiku-4-5\"}"),
+
+		// 花费上限（USD，按实际扣费 actual_cost 计算），为空表示不限制
+		field.Float("quota_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("daily_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+
+		// 用量计数（由 RecordUsage 累加，日/月窗口在累加时自动滚动）
+		field.Float("quota_used_usd").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}).
+			Default(0),
+		field.Float("daily_usage_usd").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}).
+			Default(0),
+		field.Float("monthly_usage_usd").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}).
+			Default(0),
+		field.Time("daily_window_start").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("monthly_window_start").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
 	}
 }
 
@@ -76,6 +122,7 @@ func (APIKey) Indexes() []ent.Index {
 		index.Fields("user_id"),
 		index.Fields("group_id"),
 		index.Fields("status"),
+		index.Fields("expires_at"),
 		index.Fields("deleted_at"),
 	}
 }
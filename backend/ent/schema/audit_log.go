@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog 定义管理员操作审计日志的 schema。
+//
+// 记录每一次管理端变更操作（余额调整、设置修改、密钥轮换、系统更新等）。
+// 这是一个只追加的表，不支持更新和删除。
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_logs"},
+	}
+}
+
+// Fields 定义审计日志实体的所有字段。
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// 操作者：JWT 登录的管理员，或使用 Admin API Key 时映射到的管理员
+		field.Int64("actor_user_id").
+			Optional().
+			Nillable(),
+		// actor_type: jwt / admin_api_key
+		field.String("actor_type").
+			MaxLen(20).
+			Default(""),
+		// action: "<METHOD> <route>"，如 "POST /api/v1/admin/users/:id/balance"
+		field.String("action").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("target_type").
+			MaxLen(64).
+			Default(""),
+		field.String("target_id").
+			MaxLen(64).
+			Default(""),
+
+		// 变更前后快照（已脱敏）
+		field.JSON("before", map[string]any{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		field.Int("status_code").
+			Default(0),
+		field.String("ip_address").
+			MaxLen(45).
+			Default(""),
+		field.String("user_agent").
+			MaxLen(512).
+			Default(""),
+		field.String("request_id").
+			MaxLen(64).
+			Default(""),
+
+		// 时间戳（只有 created_at，日志不可修改）
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_user_id", "created_at"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("action"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BalanceTransaction 定义用户余额流水（账本）的 schema。
+//
+// 每一次 users.balance 的变动都会在同一语句/事务内追加一条记录，
+// 记录金额、来源、关联单据与变动后的余额。这是一个只追加的表，
+// 数据库触发器会拒绝 UPDATE / DELETE。
+type BalanceTransaction struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (BalanceTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "balance_transactions"},
+	}
+}
+
+// Fields 定义余额流水实体的所有字段。
+func (BalanceTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").
+			Immutable(),
+		// amount: 正数为入账，负数为出账
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Immutable(),
+		// balance_after: 本次变动后的用户余额（运行余额）
+		field.Float("balance_after").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Immutable(),
+		// source_type: usage / admin_adjustment / redeem_code / promo_code / creem_checkout / initial / opening_balance
+		field.String("source_type").
+			MaxLen(32).
+			NotEmpty().
+			Immutable(),
+		// reference_id: 关联单据，如 usage 的 request_id、兑换码、Creem checkout ID
+		field.String("reference_id").
+			MaxLen(128).
+			Default("").
+			Immutable(),
+		// 操作者：管理员调整时记录管理员用户 ID
+		field.Int64("actor_user_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("notes").
+			MaxLen(500).
+			Default("").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+// Indexes 定义数据库索引，优化查询性能。
+func (BalanceTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("source_type", "reference_id"),
+		index.Fields("created_at"),
+		index.Fields("actor_user_id", "created_at"),
+	}
+}
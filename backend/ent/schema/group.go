@@ -28,6 +28,8 @@ func (Group) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.TimeMixin{},
 		mixins.SoftDeleteMixin{},
+		mixins.RateLimitMixin{},
+		mixins.ResponseCacheMixin{},
 	}
 }
 
@@ -106,6 +108,12 @@ func (Group) Fields() []ent.Field {
 		field.Bool("model_routing_enabled").
 			Default(false).
 			Comment("是否启用模型路由配置"),
+
+		// 响应缓存命中计费比例 (added by migration 060)
+		field.Float("response_cache_cost_ratio").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}).
+			Default(0).
+			Comment("响应缓存命中时按原费用计费的比例，0 表示免费"),
 	}
 }
 
@@ -0,0 +1,30 @@
+package mixins
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// RateLimitMixin provides per-minute request and token rate limit fields.
+// Shared by users, groups and api_keys; 0 means unlimited.
+type RateLimitMixin struct {
+	mixin.Schema
+}
+
+func (RateLimitMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("rpm_limit").
+			NonNegative().
+			Default(0).
+			Comment("每分钟请求数上限，0 表示不限制"),
+		field.Int("input_tpm_limit").
+			NonNegative().
+			Default(0).
+			Comment("每分钟输入 token 上限，0 表示不限制"),
+		field.Int("output_tpm_limit").
+			NonNegative().
+			Default(0).
+			Comment("每分钟输出 token 上限，0 表示不限制"),
+	}
+}
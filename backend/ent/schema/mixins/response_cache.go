@@ -0,0 +1,25 @@
+package mixins
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ResponseCacheMixin provides opt-in exact response cache fields.
+// Shared by groups and api_keys; api_key settings take precedence when enabled.
+type ResponseCacheMixin struct {
+	mixin.Schema
+}
+
+func (ResponseCacheMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bool("response_cache_enabled").
+			Default(false).
+			Comment("是否启用确定性请求的响应缓存"),
+		field.Int("response_cache_ttl_seconds").
+			NonNegative().
+			Default(0).
+			Comment("响应缓存有效期（秒），0 表示使用全局默认值"),
+	}
+}
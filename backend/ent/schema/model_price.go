@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModelPrice 定义管理员维护的模型价格覆盖 schema。
+//
+// 每行是一个价格版本：group_id 为空表示全局覆盖，否则仅作用于该分组；
+// 同一作用域与模型可以有多个版本，计费时取 effective_from 不晚于请求时间的最新版本。
+// 已生效的版本不可修改，调价需新建版本，保证历史用量始终对应当时的价格。
+//
+// 删除策略：硬删除（已入账的用量记录保存了当时计算出的费用，不依赖价格表）
+type ModelPrice struct {
+	ent.Schema
+}
+
+func (ModelPrice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "model_prices"},
+	}
+}
+
+func (ModelPrice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (ModelPrice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("group_id").
+			Optional().
+			Nillable().
+			Comment("为空表示全局覆盖"),
+		field.String("model").
+			MaxLen(200).
+			NotEmpty().
+			Comment("模型名（小写），以 * 结尾表示前缀匹配"),
+
+		// 价格单位：USD / 百万 token
+		field.Float("input_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("output_price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("cache_write_price").
+			Default(0).
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("cache_read_price").
+			Default(0).
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+
+		// 长上下文阶梯：输入侧 token 总数超过阈值时整次请求按长上下文价格计费
+		field.Int("long_context_threshold").
+			Default(0).
+			Comment("0 表示不启用长上下文阶梯"),
+		field.Float("long_context_input_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("long_context_output_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+
+		field.Time("effective_from").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.String("notes").
+			Default("").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (ModelPrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "model", "effective_from"),
+		index.Fields("model"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Organization holds the schema definition for the Organization entity.
+//
+// 组织：拥有共享余额与并发池，成员用户的 API Key 从组织钱包扣费。
+// 组织订阅挂在所有者（owner）名下，成员在自身无订阅时回退使用。
+//
+// 删除策略：软删除
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "organizations"},
+	}
+}
+
+func (Organization) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.SoftDeleteMixin{},
+	}
+}
+
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.Int64("owner_user_id"),
+		field.Float("balance").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		// 组织级并发池，0 表示不限制（成员仍受各自用户并发限制）
+		field.Int("concurrency").
+			Default(0),
+		field.String("status").
+			MaxLen(20).
+			Default(service.StatusActive),
+		field.String("notes").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Default(""),
+	}
+}
+
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", OrganizationMember.Type),
+	}
+}
+
+func (Organization) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_user_id"),
+		index.Fields("status"),
+		index.Fields("deleted_at"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationMember holds the schema definition for the OrganizationMember entity.
+//
+// 组织成员：一个用户最多属于一个组织（user_id 唯一）。
+// 月度花费计数在记录用量时按自然月窗口累加，用于成员级花费上限。
+//
+// 删除策略：硬删除（移出组织即删除）
+type OrganizationMember struct {
+	ent.Schema
+}
+
+func (OrganizationMember) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "organization_members"},
+	}
+}
+
+func (OrganizationMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (OrganizationMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("organization_id"),
+		field.Int64("user_id").
+			Unique(),
+		// owner / admin / member
+		field.String("role").
+			MaxLen(20).
+			Default(service.OrgRoleMember),
+		// 成员月度花费上限（USD），NULL 表示不限制
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_usage_usd").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}).
+			Default(0),
+		field.Time("monthly_window_start").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (OrganizationMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("members").
+			Field("organization_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (OrganizationMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -45,13 +46,22 @@ func (Proxy) Fields() []ent.Field {
 			MaxLen(100).
 			Optional().
 			Nillable(),
+		// 启用静态加密时存储信封密文，明文长度限制由接口层校验
 		field.String("password").
-			MaxLen(100).
 			Optional().
-			Nillable(),
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("status").
 			MaxLen(20).
 			Default("active"),
+		// pool_id: 所属代理池（可选，一个代理最多属于一个代理池）
+		field.Int64("pool_id").
+			Optional().
+			Nillable(),
+		// subscription_id: 导入来源的代理订阅（可选，手动创建的代理为空）
+		field.Int64("subscription_id").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -67,6 +77,8 @@ func (Proxy) Edges() []ent.Edge {
 func (Proxy) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("status"),
+		index.Fields("pool_id"),
+		index.Fields("subscription_id"),
 		index.Fields("deleted_at"),
 	}
 }
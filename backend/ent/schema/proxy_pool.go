@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProxyPool 定义代理池 schema。
+//
+// 代理通过 proxies.pool_id 加入代理池，账号通过 accounts.proxy_pool_id 引用代理池；
+// 账号的 proxy_id 始终指向当前分配到的池内代理，由选择策略决定，
+// 代理不健康时由健康检查或转发失败自动切换到池内其他健康代理。
+//
+// 删除策略：软删除
+type ProxyPool struct {
+	ent.Schema
+}
+
+func (ProxyPool) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proxy_pools"},
+	}
+}
+
+func (ProxyPool) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.SoftDeleteMixin{},
+	}
+}
+
+func (ProxyPool) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("description").
+			Default("").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("policy").
+			MaxLen(20).
+			Default(service.ProxyPoolPolicySticky).
+			Comment("选择策略: sticky, round_robin, least_latency, same_country"),
+		field.String("status").
+			MaxLen(20).
+			Default(service.StatusActive).
+			Comment("状态: active, inactive"),
+	}
+}
+
+func (ProxyPool) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("deleted_at"),
+	}
+}
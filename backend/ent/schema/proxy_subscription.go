@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProxySubscription 定义代理订阅 schema。
+//
+// 订阅地址返回 Clash YAML、Base64 编码的 V2Ray 订阅或纯文本代理列表；
+// 同步时导入新代理（proxies.subscription_id 记录来源），订阅中已移除的代理会被停用，
+// 重新出现时恢复启用。refresh_interval_minutes 为 0 表示仅手动同步。
+//
+// 删除策略：软删除
+type ProxySubscription struct {
+	ent.Schema
+}
+
+func (ProxySubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proxy_subscriptions"},
+	}
+}
+
+func (ProxySubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.SoftDeleteMixin{},
+	}
+}
+
+func (ProxySubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("url").
+			NotEmpty().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int("refresh_interval_minutes").
+			Default(0).
+			Comment("自动同步间隔（分钟），0 表示仅手动同步"),
+		// pool_id: 导入的代理自动加入该代理池（可选）
+		field.Int64("pool_id").
+			Optional().
+			Nillable(),
+		field.String("status").
+			MaxLen(20).
+			Default(service.StatusActive).
+			Comment("状态: active, inactive"),
+		field.Time("last_synced_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.String("last_error").
+			Default("").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int("last_total").
+			Default(0).
+			Comment("最近一次同步解析出的可导入代理数"),
+		field.Int("last_created").
+			Default(0),
+		field.Int("last_skipped").
+			Default(0),
+		field.Int("last_disabled").
+			Default(0),
+	}
+}
+
+func (ProxySubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("deleted_at"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SubscriptionPlan 定义可自助购买的订阅套餐 schema。
+//
+// 套餐绑定一个订阅类型分组，用户通过支付渠道购买或续费后获得/延长该分组的订阅；
+// 套餐的日/周/月限额在购买时快照到 user_subscriptions，之后修改套餐不影响已售出的周期。
+//
+// 删除策略：软删除（历史订单与订阅仍引用 plan_id）
+type SubscriptionPlan struct {
+	ent.Schema
+}
+
+func (SubscriptionPlan) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "subscription_plans"},
+	}
+}
+
+func (SubscriptionPlan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.SoftDeleteMixin{},
+	}
+}
+
+func (SubscriptionPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("description").
+			Default("").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int64("group_id"),
+		field.Int("validity_days").
+			Default(30),
+		field.Float("price").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Comment("套餐价格（美元），按支付渠道倍率换算为支付金额"),
+
+		// 限额为空表示沿用分组配置
+		field.Float("daily_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("weekly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+
+		field.String("status").
+			MaxLen(20).
+			Default(service.SubscriptionPlanStatusActive).
+			Comment("状态: active, disabled"),
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+func (SubscriptionPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id"),
+		index.Fields("status"),
+		index.Fields("deleted_at"),
+	}
+}
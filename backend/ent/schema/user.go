@@ -28,6 +28,7 @@ func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.TimeMixin{},
 		mixins.SoftDeleteMixin{},
+		mixins.RateLimitMixin{},
 	}
 }
 
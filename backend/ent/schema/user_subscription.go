@@ -79,6 +79,33 @@ func (UserSubscription) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		// plan_id: 最近一次购买/续费的套餐，管理员分配或兑换码获得的订阅为空
+		field.Int64("plan_id").
+			Optional().
+			Nillable(),
+		// auto_renew: 到期前自动从余额扣费续订 plan_id 对应的套餐
+		field.Bool("auto_renew").
+			Default(false),
+		// 套餐限额快照，非空时覆盖分组的对应限额
+		field.Float("daily_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("weekly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// renewal_notice_for: 已完成到期处理（自动续费或提醒）的 expires_at；
+		// 订阅被延长后与 expires_at 不再相等，下个周期会重新处理
+		field.Time("renewal_notice_for").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
 	}
 }
 
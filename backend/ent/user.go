@@ -23,6 +23,12 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 每分钟请求数上限，0 表示不限制
+	RpmLimit int `json:"rpm_limit,omitempty"`
+	// 每分钟输入 token 上限，0 表示不限制
+	InputTpmLimit int `json:"input_tpm_limit,omitempty"`
+	// 每分钟输出 token 上限，0 表示不限制
+	OutputTpmLimit int `json:"output_tpm_limit,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
@@ -166,7 +172,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case user.FieldBalance:
 			values[i] = new(sql.NullFloat64)
-		case user.FieldID, user.FieldConcurrency:
+		case user.FieldID, user.FieldRpmLimit, user.FieldInputTpmLimit, user.FieldOutputTpmLimit, user.FieldConcurrency:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldPasswordHash, user.FieldRole, user.FieldStatus, user.FieldUsername, user.FieldNotes, user.FieldTotpSecretEncrypted:
 			values[i] = new(sql.NullString)
@@ -212,6 +218,24 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.DeletedAt = new(time.Time)
 				*_m.DeletedAt = value.Time
 			}
+		case user.FieldRpmLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rpm_limit", values[i])
+			} else if value.Valid {
+				_m.RpmLimit = int(value.Int64)
+			}
+		case user.FieldInputTpmLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_tpm_limit", values[i])
+			} else if value.Valid {
+				_m.InputTpmLimit = int(value.Int64)
+			}
+		case user.FieldOutputTpmLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_tpm_limit", values[i])
+			} else if value.Valid {
+				_m.OutputTpmLimit = int(value.Int64)
+			}
 		case user.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
@@ -372,6 +396,15 @@ func (_m *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("rpm_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RpmLimit))
+	builder.WriteString(", ")
+	builder.WriteString("input_tpm_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InputTpmLimit))
+	builder.WriteString(", ")
+	builder.WriteString("output_tpm_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutputTpmLimit))
+	builder.WriteString(", ")
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
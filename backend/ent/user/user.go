@@ -21,6 +21,12 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldRpmLimit holds the string denoting the rpm_limit field in the database.
+	FieldRpmLimit = "rpm_limit"
+	// FieldInputTpmLimit holds the string denoting the input_tpm_limit field in the database.
+	FieldInputTpmLimit = "input_tpm_limit"
+	// FieldOutputTpmLimit holds the string denoting the output_tpm_limit field in the database.
+	FieldOutputTpmLimit = "output_tpm_limit"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
 	// FieldPasswordHash holds the string denoting the password_hash field in the database.
@@ -132,6 +138,9 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldRpmLimit,
+	FieldInputTpmLimit,
+	FieldOutputTpmLimit,
 	FieldEmail,
 	FieldPasswordHash,
 	FieldRole,
@@ -175,6 +184,18 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRpmLimit holds the default value on creation for the "rpm_limit" field.
+	DefaultRpmLimit int
+	// RpmLimitValidator is a validator for the "rpm_limit" field. It is called by the builders before save.
+	RpmLimitValidator func(int) error
+	// DefaultInputTpmLimit holds the default value on creation for the "input_tpm_limit" field.
+	DefaultInputTpmLimit int
+	// InputTpmLimitValidator is a validator for the "input_tpm_limit" field. It is called by the builders before save.
+	InputTpmLimitValidator func(int) error
+	// DefaultOutputTpmLimit holds the default value on creation for the "output_tpm_limit" field.
+	DefaultOutputTpmLimit int
+	// OutputTpmLimitValidator is a validator for the "output_tpm_limit" field. It is called by the builders before save.
+	OutputTpmLimitValidator func(int) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
 	// PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
@@ -224,6 +245,21 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByRpmLimit orders the results by the rpm_limit field.
+func ByRpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRpmLimit, opts...).ToFunc()
+}
+
+// ByInputTpmLimit orders the results by the input_tpm_limit field.
+func ByInputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputTpmLimit, opts...).ToFunc()
+}
+
+// ByOutputTpmLimit orders the results by the output_tpm_limit field.
+func ByOutputTpmLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTpmLimit, opts...).ToFunc()
+}
+
 // ByEmail orders the results by the email field.
 func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
@@ -70,6 +70,21 @@ func DeletedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// RpmLimit applies equality check predicate on the "rpm_limit" field. It's identical to RpmLimitEQ.
+func RpmLimit(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRpmLimit, v))
+}
+
+// InputTpmLimit applies equality check predicate on the "input_tpm_limit" field. It's identical to InputTpmLimitEQ.
+func InputTpmLimit(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldInputTpmLimit, v))
+}
+
+// OutputTpmLimit applies equality check predicate on the "output_tpm_limit" field. It's identical to OutputTpmLimitEQ.
+func OutputTpmLimit(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOutputTpmLimit, v))
+}
+
 // Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
 func Email(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -255,6 +270,126 @@ func DeletedAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// RpmLimitEQ applies the EQ predicate on the "rpm_limit" field.
+func RpmLimitEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRpmLimit, v))
+}
+
+// RpmLimitNEQ applies the NEQ predicate on the "rpm_limit" field.
+func RpmLimitNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRpmLimit, v))
+}
+
+// RpmLimitIn applies the In predicate on the "rpm_limit" field.
+func RpmLimitIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRpmLimit, vs...))
+}
+
+// RpmLimitNotIn applies the NotIn predicate on the "rpm_limit" field.
+func RpmLimitNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRpmLimit, vs...))
+}
+
+// RpmLimitGT applies the GT predicate on the "rpm_limit" field.
+func RpmLimitGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRpmLimit, v))
+}
+
+// RpmLimitGTE applies the GTE predicate on the "rpm_limit" field.
+func RpmLimitGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRpmLimit, v))
+}
+
+// RpmLimitLT applies the LT predicate on the "rpm_limit" field.
+func RpmLimitLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRpmLimit, v))
+}
+
+// RpmLimitLTE applies the LTE predicate on the "rpm_limit" field.
+func RpmLimitLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRpmLimit, v))
+}
+
+// InputTpmLimitEQ applies the EQ predicate on the "input_tpm_limit" field.
+func InputTpmLimitEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitNEQ applies the NEQ predicate on the "input_tpm_limit" field.
+func InputTpmLimitNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitIn applies the In predicate on the "input_tpm_limit" field.
+func InputTpmLimitIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldInputTpmLimit, vs...))
+}
+
+// InputTpmLimitNotIn applies the NotIn predicate on the "input_tpm_limit" field.
+func InputTpmLimitNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldInputTpmLimit, vs...))
+}
+
+// InputTpmLimitGT applies the GT predicate on the "input_tpm_limit" field.
+func InputTpmLimitGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitGTE applies the GTE predicate on the "input_tpm_limit" field.
+func InputTpmLimitGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitLT applies the LT predicate on the "input_tpm_limit" field.
+func InputTpmLimitLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldInputTpmLimit, v))
+}
+
+// InputTpmLimitLTE applies the LTE predicate on the "input_tpm_limit" field.
+func InputTpmLimitLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldInputTpmLimit, v))
+}
+
+// OutputTpmLimitEQ applies the EQ predicate on the "output_tpm_limit" field.
+func OutputTpmLimitEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitNEQ applies the NEQ predicate on the "output_tpm_limit" field.
+func OutputTpmLimitNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitIn applies the In predicate on the "output_tpm_limit" field.
+func OutputTpmLimitIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldOutputTpmLimit, vs...))
+}
+
+// OutputTpmLimitNotIn applies the NotIn predicate on the "output_tpm_limit" field.
+func OutputTpmLimitNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldOutputTpmLimit, vs...))
+}
+
+// OutputTpmLimitGT applies the GT predicate on the "output_tpm_limit" field.
+func OutputTpmLimitGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitGTE applies the GTE predicate on the "output_tpm_limit" field.
+func OutputTpmLimitGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitLT applies the LT predicate on the "output_tpm_limit" field.
+func OutputTpmLimitLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldOutputTpmLimit, v))
+}
+
+// OutputTpmLimitLTE applies the LTE predicate on the "output_tpm_limit" field.
+func OutputTpmLimitLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldOutputTpmLimit, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
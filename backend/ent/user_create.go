@@ -71,6 +71,48 @@ func (_c *UserCreate) SetNillableDeletedAt(v *time.Time) *UserCreate {
 	return _c
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_c *UserCreate) SetRpmLimit(v int) *UserCreate {
+	_c.mutation.SetRpmLimit(v)
+	return _c
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRpmLimit(v *int) *UserCreate {
+	if v != nil {
+		_c.SetRpmLimit(*v)
+	}
+	return _c
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_c *UserCreate) SetInputTpmLimit(v int) *UserCreate {
+	_c.mutation.SetInputTpmLimit(v)
+	return _c
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_c *UserCreate) SetNillableInputTpmLimit(v *int) *UserCreate {
+	if v != nil {
+		_c.SetInputTpmLimit(*v)
+	}
+	return _c
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_c *UserCreate) SetOutputTpmLimit(v int) *UserCreate {
+	_c.mutation.SetOutputTpmLimit(v)
+	return _c
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_c *UserCreate) SetNillableOutputTpmLimit(v *int) *UserCreate {
+	if v != nil {
+		_c.SetOutputTpmLimit(*v)
+	}
+	return _c
+}
+
 // SetEmail sets the "email" field.
 func (_c *UserCreate) SetEmail(v string) *UserCreate {
 	_c.mutation.SetEmail(v)
@@ -380,6 +422,18 @@ func (_c *UserCreate) defaults() error {
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		v := user.DefaultRpmLimit
+		_c.mutation.SetRpmLimit(v)
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		v := user.DefaultInputTpmLimit
+		_c.mutation.SetInputTpmLimit(v)
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		v := user.DefaultOutputTpmLimit
+		_c.mutation.SetOutputTpmLimit(v)
+	}
 	if _, ok := _c.mutation.Role(); !ok {
 		v := user.DefaultRole
 		_c.mutation.SetRole(v)
@@ -419,6 +473,30 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := _c.mutation.RpmLimit(); !ok {
+		return &ValidationError{Name: "rpm_limit", err: errors.New(`ent: missing required field "User.rpm_limit"`)}
+	}
+	if v, ok := _c.mutation.RpmLimit(); ok {
+		if err := user.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.rpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InputTpmLimit(); !ok {
+		return &ValidationError{Name: "input_tpm_limit", err: errors.New(`ent: missing required field "User.input_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.InputTpmLimit(); ok {
+		if err := user.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.input_tpm_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OutputTpmLimit(); !ok {
+		return &ValidationError{Name: "output_tpm_limit", err: errors.New(`ent: missing required field "User.output_tpm_limit"`)}
+	}
+	if v, ok := _c.mutation.OutputTpmLimit(); ok {
+		if err := user.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.output_tpm_limit": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "User.email"`)}
 	}
@@ -510,6 +588,18 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := _c.mutation.RpmLimit(); ok {
+		_spec.SetField(user.FieldRpmLimit, field.TypeInt, value)
+		_node.RpmLimit = value
+	}
+	if value, ok := _c.mutation.InputTpmLimit(); ok {
+		_spec.SetField(user.FieldInputTpmLimit, field.TypeInt, value)
+		_node.InputTpmLimit = value
+	}
+	if value, ok := _c.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(user.FieldOutputTpmLimit, field.TypeInt, value)
+		_node.OutputTpmLimit = value
+	}
 	if value, ok := _c.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
@@ -768,6 +858,60 @@ func (u *UserUpsert) ClearDeletedAt() *UserUpsert {
 	return u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *UserUpsert) SetRpmLimit(v int) *UserUpsert {
+	u.Set(user.FieldRpmLimit, v)
+	return u
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRpmLimit() *UserUpsert {
+	u.SetExcluded(user.FieldRpmLimit)
+	return u
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *UserUpsert) AddRpmLimit(v int) *UserUpsert {
+	u.Add(user.FieldRpmLimit, v)
+	return u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *UserUpsert) SetInputTpmLimit(v int) *UserUpsert {
+	u.Set(user.FieldInputTpmLimit, v)
+	return u
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsert) UpdateInputTpmLimit() *UserUpsert {
+	u.SetExcluded(user.FieldInputTpmLimit)
+	return u
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *UserUpsert) AddInputTpmLimit(v int) *UserUpsert {
+	u.Add(user.FieldInputTpmLimit, v)
+	return u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *UserUpsert) SetOutputTpmLimit(v int) *UserUpsert {
+	u.Set(user.FieldOutputTpmLimit, v)
+	return u
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsert) UpdateOutputTpmLimit() *UserUpsert {
+	u.SetExcluded(user.FieldOutputTpmLimit)
+	return u
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *UserUpsert) AddOutputTpmLimit(v int) *UserUpsert {
+	u.Add(user.FieldOutputTpmLimit, v)
+	return u
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsert) SetEmail(v string) *UserUpsert {
 	u.Set(user.FieldEmail, v)
@@ -1004,6 +1148,69 @@ func (u *UserUpsertOne) ClearDeletedAt() *UserUpsertOne {
 	})
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *UserUpsertOne) SetRpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *UserUpsertOne) AddRpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRpmLimit() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *UserUpsertOne) SetInputTpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *UserUpsertOne) AddInputTpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateInputTpmLimit() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *UserUpsertOne) SetOutputTpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *UserUpsertOne) AddOutputTpmLimit(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateOutputTpmLimit() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsertOne) SetEmail(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -1432,6 +1639,69 @@ func (u *UserUpsertBulk) ClearDeletedAt() *UserUpsertBulk {
 	})
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (u *UserUpsertBulk) SetRpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRpmLimit(v)
+	})
+}
+
+// AddRpmLimit adds v to the "rpm_limit" field.
+func (u *UserUpsertBulk) AddRpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddRpmLimit(v)
+	})
+}
+
+// UpdateRpmLimit sets the "rpm_limit" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRpmLimit() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRpmLimit()
+	})
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (u *UserUpsertBulk) SetInputTpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetInputTpmLimit(v)
+	})
+}
+
+// AddInputTpmLimit adds v to the "input_tpm_limit" field.
+func (u *UserUpsertBulk) AddInputTpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddInputTpmLimit(v)
+	})
+}
+
+// UpdateInputTpmLimit sets the "input_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateInputTpmLimit() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateInputTpmLimit()
+	})
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (u *UserUpsertBulk) SetOutputTpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetOutputTpmLimit(v)
+	})
+}
+
+// AddOutputTpmLimit adds v to the "output_tpm_limit" field.
+func (u *UserUpsertBulk) AddOutputTpmLimit(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddOutputTpmLimit(v)
+	})
+}
+
+// UpdateOutputTpmLimit sets the "output_tpm_limit" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateOutputTpmLimit() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateOutputTpmLimit()
+	})
+}
+
 // SetEmail sets the "email" field.
 func (u *UserUpsertBulk) SetEmail(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -61,6 +61,69 @@ func (_u *UserUpdate) ClearDeletedAt() *UserUpdate {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *UserUpdate) SetRpmLimit(v int) *UserUpdate {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRpmLimit(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *UserUpdate) AddRpmLimit(v int) *UserUpdate {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *UserUpdate) SetInputTpmLimit(v int) *UserUpdate {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableInputTpmLimit(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *UserUpdate) AddInputTpmLimit(v int) *UserUpdate {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *UserUpdate) SetOutputTpmLimit(v int) *UserUpdate {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableOutputTpmLimit(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *UserUpdate) AddOutputTpmLimit(v int) *UserUpdate {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
 // SetEmail sets the "email" field.
 func (_u *UserUpdate) SetEmail(v string) *UserUpdate {
 	_u.mutation.SetEmail(v)
@@ -578,6 +641,21 @@ func (_u *UserUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *UserUpdate) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := user.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := user.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := user.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.output_tpm_limit": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Email(); ok {
 		if err := user.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
@@ -627,6 +705,24 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(user.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(user.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(user.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(user.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(user.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(user.FieldOutputTpmLimit, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
@@ -1090,6 +1186,69 @@ func (_u *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
 	return _u
 }
 
+// SetRpmLimit sets the "rpm_limit" field.
+func (_u *UserUpdateOne) SetRpmLimit(v int) *UserUpdateOne {
+	_u.mutation.ResetRpmLimit()
+	_u.mutation.SetRpmLimit(v)
+	return _u
+}
+
+// SetNillableRpmLimit sets the "rpm_limit" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRpmLimit(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetRpmLimit(*v)
+	}
+	return _u
+}
+
+// AddRpmLimit adds value to the "rpm_limit" field.
+func (_u *UserUpdateOne) AddRpmLimit(v int) *UserUpdateOne {
+	_u.mutation.AddRpmLimit(v)
+	return _u
+}
+
+// SetInputTpmLimit sets the "input_tpm_limit" field.
+func (_u *UserUpdateOne) SetInputTpmLimit(v int) *UserUpdateOne {
+	_u.mutation.ResetInputTpmLimit()
+	_u.mutation.SetInputTpmLimit(v)
+	return _u
+}
+
+// SetNillableInputTpmLimit sets the "input_tpm_limit" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableInputTpmLimit(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetInputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddInputTpmLimit adds value to the "input_tpm_limit" field.
+func (_u *UserUpdateOne) AddInputTpmLimit(v int) *UserUpdateOne {
+	_u.mutation.AddInputTpmLimit(v)
+	return _u
+}
+
+// SetOutputTpmLimit sets the "output_tpm_limit" field.
+func (_u *UserUpdateOne) SetOutputTpmLimit(v int) *UserUpdateOne {
+	_u.mutation.ResetOutputTpmLimit()
+	_u.mutation.SetOutputTpmLimit(v)
+	return _u
+}
+
+// SetNillableOutputTpmLimit sets the "output_tpm_limit" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableOutputTpmLimit(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetOutputTpmLimit(*v)
+	}
+	return _u
+}
+
+// AddOutputTpmLimit adds value to the "output_tpm_limit" field.
+func (_u *UserUpdateOne) AddOutputTpmLimit(v int) *UserUpdateOne {
+	_u.mutation.AddOutputTpmLimit(v)
+	return _u
+}
+
 // SetEmail sets the "email" field.
 func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
 	_u.mutation.SetEmail(v)
@@ -1620,6 +1779,21 @@ func (_u *UserUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.RpmLimit(); ok {
+		if err := user.RpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.rpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InputTpmLimit(); ok {
+		if err := user.InputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "input_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.input_tpm_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutputTpmLimit(); ok {
+		if err := user.OutputTpmLimitValidator(v); err != nil {
+			return &ValidationError{Name: "output_tpm_limit", err: fmt.Errorf(`ent: validator failed for field "User.output_tpm_limit": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Email(); ok {
 		if err := user.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
@@ -1686,6 +1860,24 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RpmLimit(); ok {
+		_spec.SetField(user.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRpmLimit(); ok {
+		_spec.AddField(user.FieldRpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InputTpmLimit(); ok {
+		_spec.SetField(user.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTpmLimit(); ok {
+		_spec.AddField(user.FieldInputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTpmLimit(); ok {
+		_spec.SetField(user.FieldOutputTpmLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTpmLimit(); ok {
+		_spec.AddField(user.FieldOutputTpmLimit, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
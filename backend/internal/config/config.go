@@ -6,8 +6,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"net"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -46,8 +48,11 @@ type Config struct {
 	Database     DatabaseConfig             `mapstructure:"database"`
 	Redis        RedisConfig                `mapstructure:"redis"`
 	Ops          OpsConfig                  `mapstructure:"ops"`
+	Metrics      MetricsConfig              `mapstructure:"metrics"`
+	Tracing      TracingConfig              `mapstructure:"tracing"`
 	JWT          JWTConfig                  `mapstructure:"jwt"`
 	Totp         TotpConfig                 `mapstructure:"totp"`
+	SecretEnc    SecretEncryptionConfig     `mapstructure:"secret_encryption"`
 	LinuxDo      LinuxDoConnectConfig       `mapstructure:"linuxdo_connect"`
 	Default      DefaultConfig              `mapstructure:"default"`
 	RateLimit    RateLimitConfig            `mapstructure:"rate_limit"`
@@ -57,6 +62,10 @@ type Config struct {
 	Dashboard    DashboardCacheConfig       `mapstructure:"dashboard_cache"`
 	DashboardAgg DashboardAggregationConfig `mapstructure:"dashboard_aggregation"`
 	UsageCleanup UsageCleanupConfig         `mapstructure:"usage_cleanup"`
+	UsageExport  UsageExportConfig          `mapstructure:"usage_export"`
+	RespCache    ResponseCacheConfig        `mapstructure:"response_cache"`
+	ProxyPool    ProxyPoolConfig            `mapstructure:"proxy_pool"`
+	ProxyImport  ProxyImportConfig          `mapstructure:"proxy_import"`
 	Concurrency  ConcurrencyConfig          `mapstructure:"concurrency"`
 	TokenRefresh TokenRefreshConfig         `mapstructure:"token_refresh"`
 	RunMode      string                     `mapstructure:"run_mode" yaml:"run_mode"`
@@ -64,6 +73,8 @@ type Config struct {
 	Gemini       GeminiConfig               `mapstructure:"gemini"`
 	Update       UpdateConfig               `mapstructure:"update"`
 	Creem        CreemConfig                `mapstructure:"creem"`
+	AdminRoles   AdminRolesConfig           `mapstructure:"admin_roles"`
+	Invoice      InvoiceConfig              `mapstructure:"invoice"`
 }
 
 type GeminiConfig struct {
@@ -328,6 +339,11 @@ type GatewaySchedulingConfig struct {
 	// 兜底层账户选择策略: "last_used"(按最后使用时间排序，默认) 或 "random"(随机)
 	FallbackSelectionMode string `mapstructure:"fallback_selection_mode"`
 
+	// 账户选择模式: "load_aware"(按负载率与最后使用时间，默认) 或 "health_weighted"(按健康分加权随机)
+	// health_weighted 依赖运维监控采集的账号健康指标，同时作用于负载感知层与兜底层，
+	// 对 Claude/Gemini/Antigravity 与 OpenAI 网关均生效（窗口费用因子仅适用于 Anthropic OAuth/SetupToken 账号）
+	SelectionMode string `mapstructure:"selection_mode"`
+
 	// 负载计算
 	LoadBatchEnabled bool `mapstructure:"load_batch_enabled"`
 
@@ -356,6 +372,9 @@ type GatewaySchedulingConfig struct {
 	// 全量重建周期配置
 	// 全量重建周期（秒），0 表示禁用
 	FullRebuildIntervalSeconds int `mapstructure:"full_rebuild_interval_seconds"`
+
+	// 缓存感知路由：无会话绑定时，优先选择最近为相同缓存前缀创建/命中过缓存的账号
+	CacheAwareRouting bool `mapstructure:"cache_aware_routing"`
 }
 
 func (s *ServerConfig) Address() string {
@@ -458,6 +477,34 @@ type OpsConfig struct {
 	Aggregation OpsAggregationConfig `mapstructure:"aggregation"`
 }
 
+// MetricsConfig Prometheus /metrics 端点配置（默认关闭）
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path"`
+	// AllowedIPs 允许免认证抓取的来源 IP/CIDR；其他来源需携带管理员 API Key
+	AllowedIPs []string `mapstructure:"allowed_ips"`
+	// ModelLabels 使用独立 model label 的模型白名单；留空时定价表可识别的模型按首次出现登记
+	ModelLabels []string `mapstructure:"model_labels"`
+	// MaxModelLabels 未配置白名单时 model label 的取值上限，超出及无法识别的模型记为 "other"
+	MaxModelLabels int `mapstructure:"max_model_labels"`
+}
+
+// TracingConfig OpenTelemetry 链路追踪配置（默认关闭）
+type TracingConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Exporter OTLP 导出协议：otlp_grpc / otlp_http
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint 采集端地址（host:port），留空时使用 OTEL_EXPORTER_OTLP_* 环境变量
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure 不使用 TLS 连接采集端
+	Insecure bool `mapstructure:"insecure"`
+	// Headers 导出时附加的请求头（如采集端鉴权）
+	Headers     map[string]string `mapstructure:"headers"`
+	ServiceName string            `mapstructure:"service_name"`
+	// SampleRatio 采样比例 (0,1]；携带已采样 traceparent 的请求始终跟随上游决策
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
 type OpsCleanupConfig struct {
 	Enabled  bool   `mapstructure:"enabled"`
 	Schedule string `mapstructure:"schedule"`
@@ -494,6 +541,33 @@ type TotpConfig struct {
 	EncryptionKeyConfigured bool `mapstructure:"-"`
 }
 
+// SecretEncryptionConfig 账号凭证与代理密码的静态加密配置（信封加密）
+//
+// 每个值使用随机数据密钥加密，数据密钥再由主密钥环中的主密钥加密。
+// 轮换主密钥时新增一个密钥并修改 ActiveKeyID，旧密钥需保留到重加密任务完成。
+type SecretEncryptionConfig struct {
+	// Enabled: 开启后新写入的数据加密存储；关闭时仍可解密已加密的数据（需保留密钥）
+	Enabled bool `mapstructure:"enabled"`
+	// ActiveKeyID: 用于加密新数据的主密钥 ID，必须存在于 Keys 中
+	ActiveKeyID string `mapstructure:"active_key_id"`
+	// Keys: 主密钥环
+	Keys []SecretEncryptionKey `mapstructure:"keys"`
+	// ReencryptIntervalMinutes: 后台重加密任务执行间隔（分钟），0 表示禁用
+	ReencryptIntervalMinutes int `mapstructure:"reencrypt_interval_minutes"`
+	// ReencryptBatchSize: 重加密任务每批处理的行数
+	ReencryptBatchSize int `mapstructure:"reencrypt_batch_size"`
+}
+
+// SecretEncryptionKey 主密钥
+type SecretEncryptionKey struct {
+	// ID: 密钥标识（字母、数字、- 或 _，最长 32 个字符），写入密文用于解密时选择密钥
+	ID string `mapstructure:"id"`
+	// Key: AES-256 密钥（32 字节 hex 编码）
+	Key string `mapstructure:"key"`
+}
+
+var secretEncryptionKeyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
 type TurnstileConfig struct {
 	Required bool `mapstructure:"required"`
 }
@@ -509,6 +583,10 @@ type DefaultConfig struct {
 
 type RateLimitConfig struct {
 	OverloadCooldownMinutes int `mapstructure:"overload_cooldown_minutes"` // 529过载冷却时间(分钟)
+
+	// 请求/Token 速率限制（用户/API Key/分组级别的 RPM、TPM，限制值在数据库中配置）
+	RequestLimitEnabled       bool `mapstructure:"request_limit_enabled"`        // 是否启用 RPM/TPM 限制
+	RequestLimitWindowSeconds int  `mapstructure:"request_limit_window_seconds"` // 滑动窗口长度（秒），默认 60
 }
 
 // APIKeyAuthCacheConfig API Key 认证缓存配置
@@ -574,6 +652,110 @@ type UsageCleanupConfig struct {
 	TaskTimeoutSeconds int `mapstructure:"task_timeout_seconds"`
 }
 
+// ResponseCacheConfig 响应缓存配置（是否缓存由分组 / API Key 配置决定，此处为全局开关与存储参数）
+type ResponseCacheConfig struct {
+	// Enabled: 全局开关，关闭时忽略所有分组 / API Key 的缓存配置
+	Enabled bool `mapstructure:"enabled"`
+	// Backend: 存储后端 redis 或 postgres
+	Backend string `mapstructure:"backend"`
+	// KeyPrefix: Redis key 前缀（仅 redis 后端）
+	KeyPrefix string `mapstructure:"key_prefix"`
+	// DefaultTTLSeconds: 分组 / API Key 未配置有效期时使用的默认值（秒）
+	DefaultTTLSeconds int `mapstructure:"default_ttl_seconds"`
+	// MaxEntryBytes: 单条缓存响应体的最大字节数，超过则不缓存
+	MaxEntryBytes int `mapstructure:"max_entry_bytes"`
+}
+
+const (
+	ResponseCacheBackendRedis    = "redis"
+	ResponseCacheBackendPostgres = "postgres"
+)
+
+// ProxyPoolConfig 代理池健康检查与故障转移配置
+type ProxyPoolConfig struct {
+	// HealthCheckEnabled: 是否启用后台代理健康检查
+	HealthCheckEnabled bool `mapstructure:"health_check_enabled"`
+	// HealthCheckIntervalSeconds: 代理池成员探测间隔（秒）
+	HealthCheckIntervalSeconds int `mapstructure:"health_check_interval_seconds"`
+	// ProbeConcurrency: 单轮探测的最大并发数
+	ProbeConcurrency int `mapstructure:"probe_concurrency"`
+	// UnhealthyThreshold: 连续失败多少次后标记为不健康（探测失败与转发时的代理连接错误均计入）
+	UnhealthyThreshold int `mapstructure:"unhealthy_threshold"`
+	// ErrorWindowMinutes: 错误率统计窗口（分钟）
+	ErrorWindowMinutes int `mapstructure:"error_window_minutes"`
+	// MaxFailoverAttempts: 单次转发因代理连接错误切换代理的最大次数，0 表示不在转发中切换
+	MaxFailoverAttempts int `mapstructure:"max_failover_attempts"`
+}
+
+// ProxyImportConfig 代理批量导入与订阅同步配置
+type ProxyImportConfig struct {
+	// SubscriptionCheckIntervalSeconds: 检查到期订阅的间隔（秒），0 表示关闭自动同步
+	SubscriptionCheckIntervalSeconds int `mapstructure:"subscription_check_interval_seconds"`
+	// FetchTimeoutSeconds: 拉取订阅地址的超时时间（秒）
+	FetchTimeoutSeconds int `mapstructure:"fetch_timeout_seconds"`
+	// MaxSubscriptionBytes: 订阅内容大小上限（字节）
+	MaxSubscriptionBytes int64 `mapstructure:"max_subscription_bytes"`
+	// MaxProxies: 单次导入的代理数量上限
+	MaxProxies int `mapstructure:"max_proxies"`
+}
+
+// UsageExportConfig 使用记录导出配置
+type UsageExportConfig struct {
+	// Enabled: 是否启用后台导出任务执行器
+	Enabled bool `mapstructure:"enabled"`
+	// Dir: 后台导出文件的本地存放目录
+	Dir string `mapstructure:"dir"`
+	// MaxSyncRangeDays: 直接流式下载允许的最大时间跨度（天），更大的范围需创建后台导出任务
+	MaxSyncRangeDays int `mapstructure:"max_sync_range_days"`
+	// MaxRangeDays: 单个后台导出任务允许的最大时间跨度（天）
+	MaxRangeDays int `mapstructure:"max_range_days"`
+	// BatchSize: 单批读取行数
+	BatchSize int `mapstructure:"batch_size"`
+	// WorkerIntervalSeconds: 后台任务轮询间隔（秒）
+	WorkerIntervalSeconds int `mapstructure:"worker_interval_seconds"`
+	// TaskTimeoutSeconds: 单次任务最大执行时长（秒），超时后任务保持 running 并在下次轮询时从游标续跑
+	TaskTimeoutSeconds int `mapstructure:"task_timeout_seconds"`
+	// Nightly: 每日定时导出前一天（UTC）的使用记录到对象存储
+	Nightly UsageExportNightlyConfig `mapstructure:"nightly"`
+	// S3: S3 兼容对象存储（AWS S3 / MinIO / R2 等）
+	S3 UsageExportS3Config `mapstructure:"s3"`
+}
+
+// UsageExportNightlyConfig 每日定时导出配置
+type UsageExportNightlyConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// HourUTC: 每天在该小时（UTC）之后导出前一天的数据
+	HourUTC int `mapstructure:"hour_utc"`
+	// Format: csv 或 jsonl
+	Format string `mapstructure:"format"`
+}
+
+// UsageExportS3Config S3 兼容对象存储配置
+type UsageExportS3Config struct {
+	Enabled         bool   `mapstructure:"enabled"`
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	// Prefix: 对象键前缀，例如 "sub2api/"
+	Prefix string `mapstructure:"prefix"`
+	// UsePathStyle: 使用 endpoint/bucket/key 形式访问（MinIO 需要开启）
+	UsePathStyle bool `mapstructure:"use_path_style"`
+}
+
+// AdminRolesConfig 管理端角色相关限制
+type AdminRolesConfig struct {
+	// SupportBalanceAdjustLimit: 仅持有 balance:adjust 权限（如客服）时每个操作人滚动 24 小时内余额调整的累计最大金额（USD），0 表示禁止调整
+	SupportBalanceAdjustLimit float64 `mapstructure:"support_balance_adjust_limit"`
+}
+
+// InvoiceConfig 发票配置
+type InvoiceConfig struct {
+	// PDFFontPath: PDF 中渲染非 ASCII 文本（如中文）使用的 TrueType 字体（.ttf/.ttc），留空时自动探测系统 CJK 字体
+	PDFFontPath string `mapstructure:"pdf_font_path"`
+}
+
 func NormalizeRunMode(value string) string {
 	normalized := strings.ToLower(strings.TrimSpace(value))
 	switch normalized {
@@ -644,6 +826,11 @@ func Load() (*Config, error) {
 	cfg.Security.ResponseHeaders.AdditionalAllowed = normalizeStringSlice(cfg.Security.ResponseHeaders.AdditionalAllowed)
 	cfg.Security.ResponseHeaders.ForceRemove = normalizeStringSlice(cfg.Security.ResponseHeaders.ForceRemove)
 	cfg.Security.CSP.Policy = strings.TrimSpace(cfg.Security.CSP.Policy)
+	cfg.Metrics.Path = strings.TrimSpace(cfg.Metrics.Path)
+	cfg.Metrics.AllowedIPs = normalizeStringSlice(cfg.Metrics.AllowedIPs)
+	cfg.Tracing.Exporter = strings.ToLower(strings.TrimSpace(cfg.Tracing.Exporter))
+	cfg.Tracing.Endpoint = strings.TrimSpace(cfg.Tracing.Endpoint)
+	cfg.Tracing.ServiceName = strings.TrimSpace(cfg.Tracing.ServiceName)
 
 	if cfg.JWT.Secret == "" {
 		secret, err := generateJWTSecret(64)
@@ -794,6 +981,22 @@ func setDefaults() {
 	// TTL should be slightly larger than collection interval (1m) to maximize cross-replica cache hits.
 	viper.SetDefault("ops.metrics_collector_cache.ttl", 65*time.Second)
 
+	// Metrics
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.path", "/metrics")
+	viper.SetDefault("metrics.allowed_ips", []string{})
+	viper.SetDefault("metrics.model_labels", []string{})
+	viper.SetDefault("metrics.max_model_labels", 200)
+
+	// Tracing
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.exporter", "otlp_grpc")
+	viper.SetDefault("tracing.endpoint", "")
+	viper.SetDefault("tracing.insecure", false)
+	viper.SetDefault("tracing.headers", map[string]string{})
+	viper.SetDefault("tracing.service_name", "sub2api")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
 	// JWT
 	viper.SetDefault("jwt.secret", "")
 	viper.SetDefault("jwt.expire_hour", 24)
@@ -813,6 +1016,8 @@ func setDefaults() {
 
 	// RateLimit
 	viper.SetDefault("rate_limit.overload_cooldown_minutes", 10)
+	viper.SetDefault("rate_limit.request_limit_enabled", true)
+	viper.SetDefault("rate_limit.request_limit_window_seconds", 60)
 
 	// Pricing - 从 price-mirror 分支同步，该分支维护了 sha256 哈希文件用于增量更新检查
 	viper.SetDefault("pricing.remote_url", "https://raw.githubusercontent.com/Wei-Shaw/claude-relay-service/price-mirror/model_prices_and_context_window.json")
@@ -858,6 +1063,53 @@ func setDefaults() {
 	viper.SetDefault("usage_cleanup.worker_interval_seconds", 10)
 	viper.SetDefault("usage_cleanup.task_timeout_seconds", 1800)
 
+	// Response cache
+	viper.SetDefault("response_cache.enabled", true)
+	viper.SetDefault("response_cache.backend", ResponseCacheBackendRedis)
+	viper.SetDefault("response_cache.key_prefix", "response_cache:")
+	viper.SetDefault("response_cache.default_ttl_seconds", 3600)
+	viper.SetDefault("response_cache.max_entry_bytes", 4<<20)
+
+	// Proxy pool
+	viper.SetDefault("proxy_pool.health_check_enabled", true)
+	viper.SetDefault("proxy_pool.health_check_interval_seconds", 60)
+	viper.SetDefault("proxy_pool.probe_concurrency", 8)
+	viper.SetDefault("proxy_pool.unhealthy_threshold", 3)
+	viper.SetDefault("proxy_pool.error_window_minutes", 60)
+	viper.SetDefault("proxy_pool.max_failover_attempts", 2)
+
+	// Proxy import
+	viper.SetDefault("proxy_import.subscription_check_interval_seconds", 60)
+	viper.SetDefault("proxy_import.fetch_timeout_seconds", 30)
+	viper.SetDefault("proxy_import.max_subscription_bytes", 5*1024*1024)
+	viper.SetDefault("proxy_import.max_proxies", 2000)
+
+	// Secret encryption
+	viper.SetDefault("secret_encryption.enabled", false)
+	viper.SetDefault("secret_encryption.active_key_id", "")
+	viper.SetDefault("secret_encryption.reencrypt_interval_minutes", 60)
+	viper.SetDefault("secret_encryption.reencrypt_batch_size", 200)
+
+	// Usage export
+	viper.SetDefault("usage_export.enabled", true)
+	viper.SetDefault("usage_export.dir", "./data/exports")
+	viper.SetDefault("usage_export.max_sync_range_days", 31)
+	viper.SetDefault("usage_export.max_range_days", 366)
+	viper.SetDefault("usage_export.batch_size", 2000)
+	viper.SetDefault("usage_export.worker_interval_seconds", 30)
+	viper.SetDefault("usage_export.task_timeout_seconds", 3600)
+	viper.SetDefault("usage_export.nightly.enabled", false)
+	viper.SetDefault("usage_export.nightly.hour_utc", 1)
+	viper.SetDefault("usage_export.nightly.format", "csv")
+	viper.SetDefault("usage_export.s3.enabled", false)
+	viper.SetDefault("usage_export.s3.region", "us-east-1")
+	viper.SetDefault("usage_export.s3.prefix", "")
+	viper.SetDefault("usage_export.s3.use_path_style", false)
+
+	// Admin roles
+	viper.SetDefault("admin_roles.support_balance_adjust_limit", 50.0)
+	viper.SetDefault("invoice.pdf_font_path", "")
+
 	// Gateway
 	viper.SetDefault("gateway.response_header_timeout", 600) // 600秒(10分钟)等待上游响应头，LLM高负载时可能排队较久
 	viper.SetDefault("gateway.log_upstream_error_body", true)
@@ -885,6 +1137,7 @@ func setDefaults() {
 	viper.SetDefault("gateway.scheduling.fallback_wait_timeout", 30*time.Second)
 	viper.SetDefault("gateway.scheduling.fallback_max_waiting", 100)
 	viper.SetDefault("gateway.scheduling.fallback_selection_mode", "last_used")
+	viper.SetDefault("gateway.scheduling.selection_mode", "load_aware")
 	viper.SetDefault("gateway.scheduling.load_batch_enabled", true)
 	viper.SetDefault("gateway.scheduling.slot_cleanup_interval", 30*time.Second)
 	viper.SetDefault("gateway.scheduling.db_fallback_enabled", true)
@@ -896,6 +1149,7 @@ func setDefaults() {
 	viper.SetDefault("gateway.scheduling.outbox_lag_rebuild_failures", 3)
 	viper.SetDefault("gateway.scheduling.outbox_backlog_rebuild_rows", 10000)
 	viper.SetDefault("gateway.scheduling.full_rebuild_interval_seconds", 300)
+	viper.SetDefault("gateway.scheduling.cache_aware_routing", false)
 	// TLS指纹伪装配置（默认关闭，需要账号级别单独启用）
 	viper.SetDefault("gateway.tls_fingerprint.enabled", true)
 	viper.SetDefault("concurrency.ping_interval", 10)
@@ -1003,6 +1257,11 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("billing.circuit_breaker.half_open_requests must be positive")
 		}
 	}
+	if c.RateLimit.RequestLimitEnabled {
+		if c.RateLimit.RequestLimitWindowSeconds <= 0 || c.RateLimit.RequestLimitWindowSeconds > 3600 {
+			return fmt.Errorf("rate_limit.request_limit_window_seconds must be between 1 and 3600")
+		}
+	}
 	if c.Database.MaxOpenConns <= 0 {
 		return fmt.Errorf("database.max_open_conns must be positive")
 	}
@@ -1108,6 +1367,9 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("dashboard_aggregation.recompute_days must be non-negative")
 		}
 	}
+	if c.AdminRoles.SupportBalanceAdjustLimit < 0 {
+		return fmt.Errorf("admin_roles.support_balance_adjust_limit must be non-negative")
+	}
 	if c.UsageCleanup.Enabled {
 		if c.UsageCleanup.MaxRangeDays <= 0 {
 			return fmt.Errorf("usage_cleanup.max_range_days must be positive")
@@ -1135,6 +1397,114 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("usage_cleanup.task_timeout_seconds must be non-negative")
 		}
 	}
+	if c.UsageExport.Enabled {
+		if strings.TrimSpace(c.UsageExport.Dir) == "" {
+			return fmt.Errorf("usage_export.dir is required")
+		}
+		if c.UsageExport.MaxRangeDays <= 0 {
+			return fmt.Errorf("usage_export.max_range_days must be positive")
+		}
+		if c.UsageExport.BatchSize <= 0 {
+			return fmt.Errorf("usage_export.batch_size must be positive")
+		}
+		if c.UsageExport.WorkerIntervalSeconds <= 0 {
+			return fmt.Errorf("usage_export.worker_interval_seconds must be positive")
+		}
+		if c.UsageExport.TaskTimeoutSeconds <= 0 {
+			return fmt.Errorf("usage_export.task_timeout_seconds must be positive")
+		}
+	}
+	if c.UsageExport.MaxSyncRangeDays < 0 {
+		return fmt.Errorf("usage_export.max_sync_range_days must be non-negative")
+	}
+	if c.UsageExport.Nightly.Enabled {
+		if !c.UsageExport.Enabled {
+			return fmt.Errorf("usage_export.nightly requires usage_export.enabled")
+		}
+		if !c.UsageExport.S3.Enabled {
+			return fmt.Errorf("usage_export.nightly requires usage_export.s3.enabled")
+		}
+		if c.UsageExport.Nightly.HourUTC < 0 || c.UsageExport.Nightly.HourUTC > 23 {
+			return fmt.Errorf("usage_export.nightly.hour_utc must be between 0 and 23")
+		}
+		switch c.UsageExport.Nightly.Format {
+		case "csv", "jsonl":
+		default:
+			return fmt.Errorf("usage_export.nightly.format must be csv or jsonl")
+		}
+	}
+	if c.UsageExport.S3.Enabled {
+		if strings.TrimSpace(c.UsageExport.S3.Endpoint) == "" || strings.TrimSpace(c.UsageExport.S3.Bucket) == "" {
+			return fmt.Errorf("usage_export.s3.endpoint and usage_export.s3.bucket are required when s3 is enabled")
+		}
+		if c.UsageExport.S3.AccessKeyID == "" || c.UsageExport.S3.SecretAccessKey == "" {
+			return fmt.Errorf("usage_export.s3.access_key_id and usage_export.s3.secret_access_key are required when s3 is enabled")
+		}
+	}
+	if c.RespCache.Enabled {
+		switch c.RespCache.Backend {
+		case ResponseCacheBackendRedis, ResponseCacheBackendPostgres:
+		default:
+			return fmt.Errorf("response_cache.backend must be redis or postgres")
+		}
+		if c.RespCache.DefaultTTLSeconds <= 0 {
+			return fmt.Errorf("response_cache.default_ttl_seconds must be positive")
+		}
+		if c.RespCache.MaxEntryBytes <= 0 {
+			return fmt.Errorf("response_cache.max_entry_bytes must be positive")
+		}
+	}
+	if c.ProxyPool.HealthCheckEnabled && c.ProxyPool.HealthCheckIntervalSeconds <= 0 {
+		return fmt.Errorf("proxy_pool.health_check_interval_seconds must be positive")
+	}
+	if c.ProxyPool.ProbeConcurrency <= 0 {
+		return fmt.Errorf("proxy_pool.probe_concurrency must be positive")
+	}
+	if c.ProxyPool.UnhealthyThreshold <= 0 {
+		return fmt.Errorf("proxy_pool.unhealthy_threshold must be positive")
+	}
+	if c.ProxyPool.ErrorWindowMinutes <= 0 {
+		return fmt.Errorf("proxy_pool.error_window_minutes must be positive")
+	}
+	if c.ProxyPool.MaxFailoverAttempts < 0 {
+		return fmt.Errorf("proxy_pool.max_failover_attempts must be non-negative")
+	}
+	if c.ProxyImport.SubscriptionCheckIntervalSeconds < 0 {
+		return fmt.Errorf("proxy_import.subscription_check_interval_seconds must be non-negative")
+	}
+	if c.ProxyImport.FetchTimeoutSeconds <= 0 {
+		return fmt.Errorf("proxy_import.fetch_timeout_seconds must be positive")
+	}
+	if c.ProxyImport.MaxSubscriptionBytes <= 0 {
+		return fmt.Errorf("proxy_import.max_subscription_bytes must be positive")
+	}
+	if c.ProxyImport.MaxProxies <= 0 {
+		return fmt.Errorf("proxy_import.max_proxies must be positive")
+	}
+	seenSecretKeyIDs := make(map[string]struct{}, len(c.SecretEnc.Keys))
+	for _, k := range c.SecretEnc.Keys {
+		if !secretEncryptionKeyIDPattern.MatchString(k.ID) {
+			return fmt.Errorf("secret_encryption.keys id %q is invalid (letters, digits, - or _, up to 32 chars)", k.ID)
+		}
+		if _, dup := seenSecretKeyIDs[k.ID]; dup {
+			return fmt.Errorf("secret_encryption.keys id %q is duplicated", k.ID)
+		}
+		seenSecretKeyIDs[k.ID] = struct{}{}
+		if raw, err := hex.DecodeString(k.Key); err != nil || len(raw) != 32 {
+			return fmt.Errorf("secret_encryption.keys %q must be 32 bytes (64 hex chars)", k.ID)
+		}
+	}
+	if c.SecretEnc.Enabled {
+		if _, ok := seenSecretKeyIDs[c.SecretEnc.ActiveKeyID]; !ok {
+			return fmt.Errorf("secret_encryption.active_key_id must reference a configured key when enabled")
+		}
+	}
+	if c.SecretEnc.ReencryptIntervalMinutes < 0 {
+		return fmt.Errorf("secret_encryption.reencrypt_interval_minutes must be non-negative")
+	}
+	if c.SecretEnc.ReencryptBatchSize <= 0 {
+		return fmt.Errorf("secret_encryption.reencrypt_batch_size must be positive")
+	}
 	if c.Gateway.MaxBodySize <= 0 {
 		return fmt.Errorf("gateway.max_body_size must be positive")
 	}
@@ -1205,6 +1575,11 @@ func (c *Config) Validate() error {
 	if c.Gateway.Scheduling.SlotCleanupInterval < 0 {
 		return fmt.Errorf("gateway.scheduling.slot_cleanup_interval must be non-negative")
 	}
+	switch c.Gateway.Scheduling.SelectionMode {
+	case "", "load_aware", "health_weighted":
+	default:
+		return fmt.Errorf("gateway.scheduling.selection_mode must be one of: load_aware, health_weighted")
+	}
 	if c.Gateway.Scheduling.DbFallbackTimeoutSeconds < 0 {
 		return fmt.Errorf("gateway.scheduling.db_fallback_timeout_seconds must be non-negative")
 	}
@@ -1249,12 +1624,45 @@ func (c *Config) Validate() error {
 	if c.Ops.Cleanup.Enabled && strings.TrimSpace(c.Ops.Cleanup.Schedule) == "" {
 		return fmt.Errorf("ops.cleanup.schedule is required when ops.cleanup.enabled=true")
 	}
+	if c.Metrics.Enabled {
+		if !strings.HasPrefix(c.Metrics.Path, "/") || c.Metrics.Path == "/" {
+			return fmt.Errorf("metrics.path must start with / and not be the root path")
+		}
+		for _, pattern := range c.Metrics.AllowedIPs {
+			if !isValidIPOrCIDR(pattern) {
+				return fmt.Errorf("metrics.allowed_ips contains invalid IP or CIDR: %q", pattern)
+			}
+		}
+		if c.Metrics.MaxModelLabels < 0 {
+			return fmt.Errorf("metrics.max_model_labels must be non-negative")
+		}
+	}
+	if c.Tracing.Enabled {
+		if c.Tracing.Exporter != "otlp_grpc" && c.Tracing.Exporter != "otlp_http" {
+			return fmt.Errorf("tracing.exporter must be one of: otlp_grpc/otlp_http")
+		}
+		if c.Tracing.ServiceName == "" {
+			return fmt.Errorf("tracing.service_name is required when tracing.enabled=true")
+		}
+		if c.Tracing.SampleRatio <= 0 || c.Tracing.SampleRatio > 1 {
+			return fmt.Errorf("tracing.sample_ratio must be within (0,1]")
+		}
+	}
 	if c.Concurrency.PingInterval < 5 || c.Concurrency.PingInterval > 30 {
 		return fmt.Errorf("concurrency.ping_interval must be between 5-30 seconds")
 	}
 	return nil
 }
 
+func isValidIPOrCIDR(pattern string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if strings.Contains(pattern, "/") {
+		_, _, err := net.ParseCIDR(pattern)
+		return err == nil
+	}
+	return net.ParseIP(pattern) != nil
+}
+
 func normalizeStringSlice(values []string) []string {
 	if len(values) == 0 {
 		return values
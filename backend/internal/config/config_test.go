@@ -70,6 +70,29 @@ func TestLoadSchedulingConfigFromEnv(t *testing.T) {
 	}
 }
 
+func TestValidateSchedulingSelectionMode(t *testing.T) {
+	viper.Reset()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	cfg.Gateway.Scheduling.SelectionMode = "health_weighted"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() unexpected error for health_weighted: %v", err)
+	}
+
+	cfg.Gateway.Scheduling.SelectionMode = "health-weighted"
+	err = cfg.Validate()
+	if err == nil {
+		t.Fatalf("Validate() expected error for unknown selection_mode, got nil")
+	}
+	if !strings.Contains(err.Error(), "gateway.scheduling.selection_mode") {
+		t.Fatalf("Validate() expected selection_mode error, got: %v", err)
+	}
+}
+
 func TestLoadDefaultSecurityToggles(t *testing.T) {
 	viper.Reset()
 
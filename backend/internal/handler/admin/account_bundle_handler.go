@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountBundleHandler handles encrypted account export/import and instance-to-instance sync
+type AccountBundleHandler struct {
+	bundleService *service.AccountBundleService
+}
+
+// NewAccountBundleHandler creates a new admin account bundle handler
+func NewAccountBundleHandler(bundleService *service.AccountBundleService) *AccountBundleHandler {
+	return &AccountBundleHandler{
+		bundleService: bundleService,
+	}
+}
+
+// ExportAccountBundleRequest represents an account bundle export request
+type ExportAccountBundleRequest struct {
+	Password   string  `json:"password" binding:"required"`
+	AccountIDs []int64 `json:"account_ids"`
+	GroupIDs   []int64 `json:"group_ids"`
+}
+
+// ImportAccountBundleRequest represents an account bundle import request
+type ImportAccountBundleRequest struct {
+	Bundle         *service.AccountBundleEnvelope `json:"bundle" binding:"required"`
+	Password       string                         `json:"password" binding:"required"`
+	ConflictPolicy string                         `json:"conflict_policy" binding:"omitempty,oneof=skip overwrite rename"`
+	DryRun         bool                           `json:"dry_run"`
+}
+
+// SyncFromInstanceRequest represents a sync request against another sub2api instance
+type SyncFromInstanceRequest struct {
+	BaseURL        string  `json:"base_url" binding:"required"`
+	AdminAPIKey    string  `json:"admin_api_key" binding:"required"`
+	Password       string  `json:"password" binding:"required"`
+	AccountIDs     []int64 `json:"account_ids"`
+	ConflictPolicy string  `json:"conflict_policy" binding:"omitempty,oneof=skip overwrite rename"`
+	DryRun         bool    `json:"dry_run"`
+}
+
+// Export handles exporting accounts, their groups and proxies as a password-encrypted bundle
+// POST /api/v1/admin/accounts/bundle/export
+func (h *AccountBundleHandler) Export(c *gin.Context) {
+	var req ExportAccountBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	bundle, err := h.bundleService.Export(c.Request.Context(), service.AccountBundleExportInput{
+		Password:   req.Password,
+		AccountIDs: req.AccountIDs,
+		GroupIDs:   req.GroupIDs,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, bundle)
+}
+
+// Import handles importing an account bundle (dry_run returns the diff without writing)
+// POST /api/v1/admin/accounts/bundle/import
+func (h *AccountBundleHandler) Import(c *gin.Context) {
+	var req ImportAccountBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := h.bundleService.Import(c.Request.Context(), service.AccountBundleImportInput{
+		Envelope:       req.Bundle,
+		Password:       req.Password,
+		ConflictPolicy: req.ConflictPolicy,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// SyncFromInstance handles pulling accounts from another sub2api instance
+// POST /api/v1/admin/accounts/sync/instance
+func (h *AccountBundleHandler) SyncFromInstance(c *gin.Context) {
+	var req SyncFromInstanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := h.bundleService.SyncFromInstance(c.Request.Context(), service.SyncFromInstanceInput{
+		BaseURL:        req.BaseURL,
+		AdminAPIKey:    req.AdminAPIKey,
+		Password:       req.Password,
+		AccountIDs:     req.AccountIDs,
+		ConflictPolicy: req.ConflictPolicy,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
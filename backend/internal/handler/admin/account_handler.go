@@ -2,7 +2,9 @@
 package admin
 
 import (
+	"context"
 	"errors"
+	"log/slog"
 	"strconv"
 	"strings"
 	"sync"
@@ -46,6 +48,8 @@ type AccountHandler struct {
 	crsSyncService          *service.CRSSyncService
 	sessionLimitCache       service.SessionLimitCache
 	tokenCacheInvalidator   service.TokenCacheInvalidator
+	accountHealthService    *service.AccountHealthService
+	accountBudgetService    *service.AccountBudgetService
 }
 
 // NewAccountHandler creates a new admin account handler
@@ -62,6 +66,8 @@ func NewAccountHandler(
 	crsSyncService *service.CRSSyncService,
 	sessionLimitCache service.SessionLimitCache,
 	tokenCacheInvalidator service.TokenCacheInvalidator,
+	accountHealthService *service.AccountHealthService,
+	accountBudgetService *service.AccountBudgetService,
 ) *AccountHandler {
 	return &AccountHandler{
 		adminService:            adminService,
@@ -76,6 +82,8 @@ func NewAccountHandler(
 		crsSyncService:          crsSyncService,
 		sessionLimitCache:       sessionLimitCache,
 		tokenCacheInvalidator:   tokenCacheInvalidator,
+		accountHealthService:    accountHealthService,
+		accountBudgetService:    accountBudgetService,
 	}
 }
 
@@ -88,6 +96,7 @@ type CreateAccountRequest struct {
 	Credentials             map[string]any `json:"credentials" binding:"required"`
 	Extra                   map[string]any `json:"extra"`
 	ProxyID                 *int64         `json:"proxy_id"`
+	ProxyPoolID             *int64         `json:"proxy_pool_id"`
 	Concurrency             int            `json:"concurrency"`
 	Priority                int            `json:"priority"`
 	RateMultiplier          *float64       `json:"rate_multiplier"`
@@ -106,6 +115,7 @@ type UpdateAccountRequest struct {
 	Credentials             map[string]any `json:"credentials"`
 	Extra                   map[string]any `json:"extra"`
 	ProxyID                 *int64         `json:"proxy_id"`
+	ProxyPoolID             *int64         `json:"proxy_pool_id"` // 0 表示解除代理池绑定
 	Concurrency             *int           `json:"concurrency"`
 	Priority                *int           `json:"priority"`
 	RateMultiplier          *float64       `json:"rate_multiplier"`
@@ -139,6 +149,8 @@ type AccountWithConcurrency struct {
 	// 以下字段仅对 Anthropic OAuth/SetupToken 账号有效，且仅在启用相应功能时返回
 	CurrentWindowCost *float64 `json:"current_window_cost,omitempty"` // 当前窗口费用
 	ActiveSessions    *int     `json:"active_sessions,omitempty"`     // 当前活跃会话数
+	// 账号预算进度（仅配置了预算的账号返回）
+	Budget *service.AccountBudgetStatus `json:"budget,omitempty"`
 }
 
 // List handles listing all accounts with pagination
@@ -230,6 +242,32 @@ func (h *AccountHandler) List(c *gin.Context) {
 		_ = g.Wait()
 	}
 
+	// 获取预算进度（并行查询，仅配置了预算的账号）
+	var budgets map[int64]*service.AccountBudgetStatus
+	if h.accountBudgetService != nil {
+		budgets = make(map[int64]*service.AccountBudgetStatus)
+		var mu sync.Mutex
+		g, gctx := errgroup.WithContext(c.Request.Context())
+		g.SetLimit(10) // 限制并发数
+
+		for i := range accounts {
+			acc := &accounts[i]
+			if !acc.HasBudget() {
+				continue
+			}
+			g.Go(func() error {
+				status, err := h.accountBudgetService.GetStatus(gctx, acc)
+				if err == nil && status != nil {
+					mu.Lock()
+					budgets[acc.ID] = status
+					mu.Unlock()
+				}
+				return nil // 不返回错误，允许部分失败
+			})
+		}
+		_ = g.Wait()
+	}
+
 	// Build response with concurrency info
 	result := make([]AccountWithConcurrency, len(accounts))
 	for i := range accounts {
@@ -253,6 +291,8 @@ func (h *AccountHandler) List(c *gin.Context) {
 			}
 		}
 
+		item.Budget = budgets[acc.ID]
+
 		result[i] = item
 	}
 
@@ -301,6 +341,7 @@ func (h *AccountHandler) Create(c *gin.Context) {
 		Credentials:           req.Credentials,
 		Extra:                 req.Extra,
 		ProxyID:               req.ProxyID,
+		ProxyPoolID:           req.ProxyPoolID,
 		Concurrency:           req.Concurrency,
 		Priority:              req.Priority,
 		RateMultiplier:        req.RateMultiplier,
@@ -332,9 +373,22 @@ func (h *AccountHandler) Create(c *gin.Context) {
 		return
 	}
 
+	h.reconcileBudgetPause(c.Request.Context(), account)
+
 	response.Success(c, dto.AccountFromService(account))
 }
 
+// reconcileBudgetPause 账号 Extra（含预算配置）写入后重新评估预算暂停：
+// 超出预算时暂停，不再超出时解除预算暂停。失败只记录日志，不影响本次写入结果。
+func (h *AccountHandler) reconcileBudgetPause(ctx context.Context, account *service.Account) {
+	if h.accountBudgetService == nil || account == nil {
+		return
+	}
+	if err := h.accountBudgetService.ReconcilePause(ctx, account); err != nil {
+		slog.Warn("account_budget_reconcile_failed", "account_id", account.ID, "error", err)
+	}
+}
+
 // Update handles updating an account
 // PUT /api/v1/admin/accounts/:id
 func (h *AccountHandler) Update(c *gin.Context) {
@@ -364,6 +418,7 @@ func (h *AccountHandler) Update(c *gin.Context) {
 		Credentials:           req.Credentials,
 		Extra:                 req.Extra,
 		ProxyID:               req.ProxyID,
+		ProxyPoolID:           req.ProxyPoolID,
 		Concurrency:           req.Concurrency, // 指针类型，nil 表示未提供
 		Priority:              req.Priority,    // 指针类型，nil 表示未提供
 		RateMultiplier:        req.RateMultiplier,
@@ -396,6 +451,9 @@ func (h *AccountHandler) Update(c *gin.Context) {
 		return
 	}
 
+	// 预算配置可能变更：超出新预算时暂停，不再超出时解除预算暂停
+	h.reconcileBudgetPause(c.Request.Context(), account)
+
 	response.Success(c, dto.AccountFromService(account))
 }
 
@@ -658,6 +716,24 @@ func (h *AccountHandler) GetStats(c *gin.Context) {
 	response.Success(c, stats)
 }
 
+// GetHealth handles getting account health score used by health-weighted scheduling
+// GET /api/v1/admin/accounts/:id/health
+func (h *AccountHandler) GetHealth(c *gin.Context) {
+	accountID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account ID")
+		return
+	}
+
+	report, err := h.accountHealthService.GetAccountHealth(c.Request.Context(), accountID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, report)
+}
+
 // ClearError handles clearing account error
 // POST /api/v1/admin/accounts/:id/clear-error
 func (h *AccountHandler) ClearError(c *gin.Context) {
@@ -843,6 +919,19 @@ func (h *AccountHandler) BulkUpdate(c *gin.Context) {
 		return
 	}
 
+	// Extra 可能包含预算配置，逐个重新评估预算暂停
+	if len(req.Extra) > 0 && h.accountBudgetService != nil {
+		ctx := c.Request.Context()
+		for _, accountID := range result.SuccessIDs {
+			account, err := h.adminService.GetAccount(ctx, accountID)
+			if err != nil {
+				slog.Warn("account_budget_reconcile_failed", "account_id", accountID, "error", err)
+				continue
+			}
+			h.reconcileBudgetPause(ctx, account)
+		}
+	}
+
 	response.Success(c, result)
 }
 
@@ -1313,7 +1402,7 @@ func (h *AccountHandler) RefreshTier(c *gin.Context) {
 		return
 	}
 
-	_, updateErr := h.adminService.UpdateAccount(ctx, accountID, &service.UpdateAccountInput{
+	updated, updateErr := h.adminService.UpdateAccount(ctx, accountID, &service.UpdateAccountInput{
 		Credentials: creds,
 		Extra:       extra,
 	})
@@ -1321,6 +1410,7 @@ func (h *AccountHandler) RefreshTier(c *gin.Context) {
 		response.ErrorFrom(c, updateErr)
 		return
 	}
+	h.reconcileBudgetPause(ctx, updated)
 
 	response.Success(c, gin.H{
 		"tier_id":             tierID,
@@ -1405,10 +1495,13 @@ func (h *AccountHandler) BatchRefreshTier(c *gin.Context) {
 				return nil
 			}
 
-			_, updateErr := h.adminService.UpdateAccount(gctx, acc.ID, &service.UpdateAccountInput{
+			updated, updateErr := h.adminService.UpdateAccount(gctx, acc.ID, &service.UpdateAccountInput{
 				Credentials: creds,
 				Extra:       extra,
 			})
+			if updateErr == nil {
+				h.reconcileBudgetPause(gctx, updated)
+			}
 
 			mu.Lock()
 			if updateErr != nil {
@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAPIKeyHandler handles named, scoped admin API keys
+type AdminAPIKeyHandler struct {
+	adminAPIKeyService *service.AdminAPIKeyService
+}
+
+// NewAdminAPIKeyHandler creates a new admin API key handler
+func NewAdminAPIKeyHandler(adminAPIKeyService *service.AdminAPIKeyService) *AdminAPIKeyHandler {
+	return &AdminAPIKeyHandler{
+		adminAPIKeyService: adminAPIKeyService,
+	}
+}
+
+// CreateAdminAPIKeyRequest represents create admin API key request
+type CreateAdminAPIKeyRequest struct {
+	Name      string   `json:"name" binding:"required,max=100"`
+	Scopes    []string `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *int64   `json:"expires_at"` // 过期时间戳（秒），为空表示永不过期
+}
+
+// CreateAdminAPIKeyResponse 创建结果，明文 Key 只返回这一次
+type CreateAdminAPIKeyResponse struct {
+	*dto.AdminAPIKey
+	Key string `json:"key"`
+}
+
+// AdminRoleInfo 管理端角色及其权限
+type AdminRoleInfo struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+// AdminRolesResponse 角色与权限目录
+type AdminRolesResponse struct {
+	Roles       []AdminRoleInfo `json:"roles"`
+	Permissions []string        `json:"permissions"`
+}
+
+// List handles listing admin API keys
+// GET /api/v1/admin/admin-api-keys
+func (h *AdminAPIKeyHandler) List(c *gin.Context) {
+	keys, err := h.adminAPIKeyService.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AdminAPIKey, 0, len(keys))
+	for i := range keys {
+		out = append(out, *dto.AdminAPIKeyFromService(&keys[i]))
+	}
+	response.Success(c, out)
+}
+
+// Create handles creating a new admin API key
+// POST /api/v1/admin/admin-api-keys
+func (h *AdminAPIKeyHandler) Create(c *gin.Context) {
+	var req CreateAdminAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	granted, _ := middleware.GetAdminPermissionsFromContext(c)
+	input := &service.CreateAdminAPIKeyInput{
+		Name:               req.Name,
+		Scopes:             req.Scopes,
+		GrantorPermissions: granted,
+	}
+	if req.ExpiresAt != nil && *req.ExpiresAt > 0 {
+		t := time.Unix(*req.ExpiresAt, 0)
+		input.ExpiresAt = &t
+	}
+	if subject, ok := middleware.GetAuthSubjectFromContext(c); ok {
+		userID := subject.UserID
+		input.CreatedBy = &userID
+	}
+
+	key, plaintext, err := h.adminAPIKeyService.Create(c.Request.Context(), input)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	// 审计日志只记录 Key 元信息，不记录明文
+	middleware.SetAuditChanges(c, nil, map[string]any{
+		"id":         key.ID,
+		"name":       key.Name,
+		"key_prefix": key.KeyPrefix,
+		"scopes":     key.Scopes,
+		"expires_at": key.ExpiresAt,
+	})
+	response.Success(c, CreateAdminAPIKeyResponse{
+		AdminAPIKey: dto.AdminAPIKeyFromService(key),
+		Key:         plaintext,
+	})
+}
+
+// Delete handles revoking an admin API key
+// DELETE /api/v1/admin/admin-api-keys/:id
+func (h *AdminAPIKeyHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid admin API key ID")
+		return
+	}
+
+	if err := h.adminAPIKeyService.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Admin API key revoked"})
+}
+
+// GetRoles returns the built-in admin roles and the permission catalog
+// GET /api/v1/admin/roles
+func (h *AdminAPIKeyHandler) GetRoles(c *gin.Context) {
+	roles := make([]AdminRoleInfo, 0, len(service.AdminRoles()))
+	for _, role := range service.AdminRoles() {
+		roles = append(roles, AdminRoleInfo{
+			Role:        role,
+			Permissions: service.AdminPermissionsForRole(role).List(),
+		})
+	}
+
+	perms := service.AllAdminPermissions()
+	out := make([]string, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, string(p))
+	}
+	response.Success(c, AdminRolesResponse{Roles: roles, Permissions: out})
+}
@@ -7,16 +7,36 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
 
 func setupAdminRouter() (*gin.Engine, *stubAdminService) {
+	return setupAdminRouterWithRole(service.RoleAdmin)
+}
+
+// setupAdminRouterWithRole 模拟 adminAuth 写入的角色权限集合
+func setupAdminRouterWithRole(role string) (*gin.Engine, *stubAdminService) {
+	return setupAdminRouterWithPermissions(service.AdminPermissionsForRole(role))
+}
+
+// setupAdminRouterWithPermissions 模拟 adminAuth 写入的权限集合（如 Admin API Key 的 scope）
+func setupAdminRouterWithPermissions(perms service.AdminPermissionSet) (*gin.Engine, *stubAdminService) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(string(middleware.ContextKeyUser), middleware.AuthSubject{UserID: 99})
+		c.Set(string(middleware.ContextKeyAdminPermissions), perms)
+		c.Next()
+	})
 	adminSvc := newStubAdminService()
 
-	userHandler := NewUserHandler(adminSvc)
+	cfg := &config.Config{AdminRoles: config.AdminRolesConfig{SupportBalanceAdjustLimit: 50}}
+	ledgerSvc := service.NewBalanceLedgerService(&stubBalanceLedgerRepo{admin: adminSvc})
+	userHandler := NewUserHandler(adminSvc, ledgerSvc, cfg)
 	groupHandler := NewGroupHandler(adminSvc)
 	proxyHandler := NewProxyHandler(adminSvc)
 	redeemHandler := NewRedeemHandler(adminSvc)
@@ -112,6 +132,86 @@ func TestUserHandlerEndpoints(t *testing.T) {
 	require.Equal(t, http.StatusOK, rec.Code)
 }
 
+func TestUserHandlerRoleRestrictions(t *testing.T) {
+	do := func(router *gin.Engine, method, path, body string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// 客服：单次调整不超过 50
+	router, _ := setupAdminRouterWithRole(service.RoleSupport)
+	require.Equal(t, http.StatusOK, do(router, http.MethodPost, "/api/v1/admin/users/1/balance", `{"balance":50,"operation":"add"}`))
+	require.Equal(t, http.StatusForbidden, do(router, http.MethodPost, "/api/v1/admin/users/1/balance", `{"balance":50.01,"operation":"subtract"}`))
+	// set 按与当前余额的差值计算（stub 用户余额为 0）
+	require.Equal(t, http.StatusForbidden, do(router, http.MethodPost, "/api/v1/admin/users/1/balance", `{"balance":80,"operation":"set"}`))
+	// 没有 roles:manage 不能改角色
+	require.Equal(t, http.StatusForbidden, do(router, http.MethodPut, "/api/v1/admin/users/1", `{"role":"admin"}`))
+
+	// 财务：不受额度限制
+	router, _ = setupAdminRouterWithRole(service.RoleFinance)
+	require.Equal(t, http.StatusOK, do(router, http.MethodPost, "/api/v1/admin/users/1/balance", `{"balance":1000,"operation":"add"}`))
+
+	// 超级管理员：可分配角色，但不能修改自己的角色
+	router, _ = setupAdminRouterWithRole(service.RoleAdmin)
+	require.Equal(t, http.StatusOK, do(router, http.MethodPut, "/api/v1/admin/users/1", `{"role":"support"}`))
+	require.Equal(t, http.StatusBadRequest, do(router, http.MethodPut, "/api/v1/admin/users/99", `{"role":"user"}`))
+	require.Equal(t, http.StatusBadRequest, do(router, http.MethodPut, "/api/v1/admin/users/1", `{"role":"root"}`))
+}
+
+func TestUserHandlerSupportBalanceLimitIsCumulative(t *testing.T) {
+	do := func(router *gin.Engine, body string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/1/balance", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// 每次 20 均低于单次上限 50，但 24 小时累计超过 50 后拒绝
+	router, adminSvc := setupAdminRouterWithRole(service.RoleSupport)
+	require.Equal(t, http.StatusOK, do(router, `{"balance":20,"operation":"add"}`))
+	require.Equal(t, http.StatusOK, do(router, `{"balance":20,"operation":"subtract"}`))
+	require.Equal(t, http.StatusForbidden, do(router, `{"balance":20,"operation":"add"}`))
+	require.InDelta(t, 40, adminSvc.balanceAdjusted, 1e-9)
+	require.Equal(t, http.StatusOK, do(router, `{"balance":10,"operation":"add"}`))
+	require.Equal(t, http.StatusForbidden, do(router, `{"balance":1,"operation":"add"}`))
+
+	// 财务不受累计额度限制
+	router, _ = setupAdminRouterWithRole(service.RoleFinance)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, do(router, `{"balance":40,"operation":"add"}`))
+	}
+}
+
+func TestUserHandlerRoleGrantRequiresHeldPermissions(t *testing.T) {
+	do := func(router *gin.Engine, path, body string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// 仅持有 roles:manage 的 Key 不能授予超级管理员或自己没有的权限
+	router, adminSvc := setupAdminRouterWithPermissions(service.NewAdminPermissionSet(service.AdminPermissionRolesManage))
+	require.Equal(t, http.StatusForbidden, do(router, "/api/v1/admin/users/1", `{"role":"admin"}`))
+	require.Equal(t, http.StatusBadRequest, do(router, "/api/v1/admin/users/99", `{"role":"admin"}`))
+	require.Equal(t, http.StatusForbidden, do(router, "/api/v1/admin/users/1", `{"role":"support"}`))
+	require.Equal(t, http.StatusOK, do(router, "/api/v1/admin/users/1", `{"role":"user"}`))
+
+	// 持有客服全部权限时可授予客服，但不能改动权限更高的用户的角色
+	perms := service.AdminPermissionsForRole(service.RoleSupport)
+	perms[service.AdminPermissionRolesManage] = struct{}{}
+	router, adminSvc = setupAdminRouterWithPermissions(perms)
+	adminSvc.users = append(adminSvc.users, service.User{ID: 2, Role: service.RoleAdmin, Status: service.StatusActive})
+	require.Equal(t, http.StatusOK, do(router, "/api/v1/admin/users/1", `{"role":"support"}`))
+	require.Equal(t, http.StatusForbidden, do(router, "/api/v1/admin/users/1", `{"role":"finance"}`))
+	require.Equal(t, http.StatusForbidden, do(router, "/api/v1/admin/users/2", `{"role":"user"}`))
+}
+
 func TestGroupHandlerEndpoints(t *testing.T) {
 	router, _ := setupAdminRouter()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 )
 
@@ -15,6 +16,9 @@ type stubAdminService struct {
 	proxies     []service.Proxy
 	proxyCounts []service.ProxyWithAccountCount
 	redeems     []service.RedeemCode
+
+	// balanceAdjusted 累计调整金额（绝对值），供余额流水 stub 统计操作人额度
+	balanceAdjusted float64
 }
 
 func newStubAdminService() *stubAdminService {
@@ -110,7 +114,8 @@ func (s *stubAdminService) DeleteUser(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *stubAdminService) UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string) (*service.User, error) {
+func (s *stubAdminService) UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string, actorUserID int64) (*service.User, error) {
+	s.balanceAdjusted += balance
 	user := service.User{ID: userID, Balance: balance, Status: service.StatusActive}
 	return &user, nil
 }
@@ -290,5 +295,30 @@ func (s *stubAdminService) ExpireRedeemCode(ctx context.Context, id int64) (*ser
 	return &code, nil
 }
 
+// stubBalanceLedgerRepo 以 stubAdminService 的累计调整金额作为操作人额度统计
+type stubBalanceLedgerRepo struct {
+	admin *stubAdminService
+}
+
+func (r *stubBalanceLedgerRepo) List(context.Context, pagination.PaginationParams, service.BalanceTransactionFilter) ([]service.BalanceTransaction, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (r *stubBalanceLedgerRepo) ListBeforeID(context.Context, service.BalanceTransactionFilter, int64, int) ([]service.BalanceTransaction, error) {
+	return nil, nil
+}
+
+func (r *stubBalanceLedgerRepo) FindDrifts(context.Context, float64, int) ([]service.BalanceLedgerDrift, int, error) {
+	return nil, 0, nil
+}
+
+func (r *stubBalanceLedgerRepo) SumAdjustmentsByActor(context.Context, int64, time.Time) (float64, error) {
+	return r.admin.balanceAdjusted, nil
+}
+
+func (r *stubBalanceLedgerRepo) WithAdjustmentQuotaLock(ctx context.Context, _ int64, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // Ensure stub implements interface.
 var _ service.AdminService = (*stubAdminService)(nil)
@@ -0,0 +1,177 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogHandler handles admin audit log queries
+type AuditLogHandler struct {
+	auditLogService *service.AuditLogService
+}
+
+// NewAuditLogHandler creates a new admin audit log handler
+func NewAuditLogHandler(auditLogService *service.AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{
+		auditLogService: auditLogService,
+	}
+}
+
+// List handles listing audit logs with filters
+// GET /api/v1/admin/audit-logs
+func (h *AuditLogHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+
+	filter, ok := parseAuditLogFilter(c)
+	if !ok {
+		return
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	logs, result, err := h.auditLogService.List(c.Request.Context(), params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AuditLog, 0, len(logs))
+	for i := range logs {
+		out = append(out, *dto.AuditLogFromService(&logs[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// Export streams audit logs matching the filters as CSV
+// GET /api/v1/admin/audit-logs/export
+func (h *AuditLogHandler) Export(c *gin.Context) {
+	filter, ok := parseAuditLogFilter(c)
+	if !ok {
+		return
+	}
+
+	filename := fmt.Sprintf("audit_logs_%s.csv", time.Now().UTC().Format("20060102_150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Status(200)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.Write([]string{
+		"id", "created_at", "actor_user_id", "actor_type", "action", "target_type", "target_id",
+		"status_code", "ip_address", "user_agent", "request_id", "before", "after",
+	}); err != nil {
+		return
+	}
+
+	rows := 0
+	err := h.auditLogService.Export(c.Request.Context(), filter, func(l *service.AuditLog) error {
+		actor := ""
+		if l.ActorUserID != nil {
+			actor = strconv.FormatInt(*l.ActorUserID, 10)
+		}
+		if err := writer.Write([]string{
+			strconv.FormatInt(l.ID, 10),
+			l.CreatedAt.UTC().Format(time.RFC3339),
+			actor,
+			l.ActorType,
+			l.Action,
+			l.TargetType,
+			l.TargetID,
+			strconv.Itoa(l.StatusCode),
+			l.IPAddress,
+			csvSafeCell(l.UserAgent),
+			csvSafeCell(l.RequestID),
+			auditSnapshotCSV(l.Before),
+			auditSnapshotCSV(l.After),
+		}); err != nil {
+			return err
+		}
+		rows++
+		if rows%500 == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	})
+	writer.Flush()
+	if err == nil {
+		err = writer.Error()
+	}
+	if err != nil {
+		// 响应头已发送，只能中断输出并记录日志
+		log.Printf("[AuditLog] export aborted after %d rows: %v", rows, err)
+	}
+}
+
+// csvSafeCell 防止表格软件把客户端可控的字段当作公式执行
+func csvSafeCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func auditSnapshotCSV(m map[string]any) string {
+	if len(m) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// parseAuditLogFilter parses shared query filters; writes a 400 response and returns false on invalid input.
+func parseAuditLogFilter(c *gin.Context) (service.AuditLogFilter, bool) {
+	filter := service.AuditLogFilter{
+		ActorType:  strings.TrimSpace(c.Query("actor_type")),
+		Action:     strings.TrimSpace(c.Query("action")),
+		TargetType: strings.TrimSpace(c.Query("target_type")),
+		TargetID:   strings.TrimSpace(c.Query("target_id")),
+	}
+	if len(filter.Action) > 100 {
+		filter.Action = filter.Action[:100]
+	}
+
+	if actorStr := c.Query("actor_user_id"); actorStr != "" {
+		id, err := strconv.ParseInt(actorStr, 10, 64)
+		if err != nil || id <= 0 {
+			response.BadRequest(c, "Invalid actor_user_id")
+			return filter, false
+		}
+		filter.ActorUserID = &id
+	}
+
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return filter, false
+		}
+		filter.StartTime = &t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return filter, false
+		}
+		t = t.Add(24*time.Hour - time.Nanosecond)
+		filter.EndTime = &t
+	}
+	return filter, true
+}
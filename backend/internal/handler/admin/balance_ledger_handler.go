@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BalanceLedgerHandler handles admin balance ledger queries, statements and reconciliation
+type BalanceLedgerHandler struct {
+	balanceLedgerService *service.BalanceLedgerService
+}
+
+// NewBalanceLedgerHandler creates a new admin balance ledger handler
+func NewBalanceLedgerHandler(balanceLedgerService *service.BalanceLedgerService) *BalanceLedgerHandler {
+	return &BalanceLedgerHandler{
+		balanceLedgerService: balanceLedgerService,
+	}
+}
+
+// ListUserTransactions handles listing a user's balance transactions
+// GET /api/v1/admin/users/:id/transactions
+func (h *BalanceLedgerHandler) ListUserTransactions(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	filter, ok := parseBalanceTransactionFilter(c)
+	if !ok {
+		return
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	txs, result, err := h.balanceLedgerService.ListByUser(c.Request.Context(), userID, params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.BalanceTransaction, 0, len(txs))
+	for i := range txs {
+		out = append(out, *dto.BalanceTransactionFromServiceAdmin(&txs[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// ExportUserStatement streams a user's balance statement as CSV
+// GET /api/v1/admin/users/:id/transactions/export
+func (h *BalanceLedgerHandler) ExportUserStatement(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	filter, ok := parseBalanceTransactionFilter(c)
+	if !ok {
+		return
+	}
+
+	filename := fmt.Sprintf("balance_statement_user_%d_%s.csv", userID, time.Now().UTC().Format("20060102_150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Status(200)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.Write([]string{
+		"id", "created_at", "user_id", "amount", "balance_after", "source_type", "reference_id", "actor_user_id", "notes",
+	}); err != nil {
+		return
+	}
+
+	rows := 0
+	err = h.balanceLedgerService.ExportByUser(c.Request.Context(), userID, filter, func(t *service.BalanceTransaction) error {
+		actor := ""
+		if t.ActorUserID != nil {
+			actor = strconv.FormatInt(*t.ActorUserID, 10)
+		}
+		if err := writer.Write([]string{
+			strconv.FormatInt(t.ID, 10),
+			t.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(t.UserID, 10),
+			strconv.FormatFloat(t.Amount, 'f', 8, 64),
+			strconv.FormatFloat(t.BalanceAfter, 'f', 8, 64),
+			t.SourceType,
+			csvSafeCell(t.ReferenceID),
+			actor,
+			csvSafeCell(t.Notes),
+		}); err != nil {
+			return err
+		}
+		rows++
+		if rows%500 == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	})
+	writer.Flush()
+	if err == nil {
+		err = writer.Error()
+	}
+	if err != nil {
+		// 响应头已发送，只能中断输出并记录日志
+		log.Printf("[BalanceLedger] statement export aborted: user_id=%d rows=%d err=%v", userID, rows, err)
+	}
+}
+
+// Reconcile runs a ledger reconciliation immediately and returns users whose balance drifted
+// POST /api/v1/admin/balance-ledger/reconcile
+func (h *BalanceLedgerHandler) Reconcile(c *gin.Context) {
+	report, err := h.balanceLedgerService.Reconcile(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, report)
+}
+
+// parseBalanceTransactionFilter parses shared query filters; writes a 400 response and returns false on invalid input.
+func parseBalanceTransactionFilter(c *gin.Context) (service.BalanceTransactionFilter, bool) {
+	filter := service.BalanceTransactionFilter{
+		SourceType: strings.TrimSpace(c.Query("source_type")),
+	}
+
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return filter, false
+		}
+		filter.StartTime = &t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return filter, false
+		}
+		t = t.Add(24*time.Hour - time.Nanosecond)
+		filter.EndTime = &t
+	}
+	return filter, true
+}
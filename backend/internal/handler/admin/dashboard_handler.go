@@ -16,14 +16,16 @@ import (
 type DashboardHandler struct {
 	dashboardService   *service.DashboardService
 	aggregationService *service.DashboardAggregationService
+	promptCacheService *service.PromptCacheStatsService
 	startTime          time.Time // Server start time for uptime calculation
 }
 
 // NewDashboardHandler creates a new admin dashboard handler
-func NewDashboardHandler(dashboardService *service.DashboardService, aggregationService *service.DashboardAggregationService) *DashboardHandler {
+func NewDashboardHandler(dashboardService *service.DashboardService, aggregationService *service.DashboardAggregationService, promptCacheService *service.PromptCacheStatsService) *DashboardHandler {
 	return &DashboardHandler{
 		dashboardService:   dashboardService,
 		aggregationService: aggregationService,
+		promptCacheService: promptCacheService,
 		startTime:          time.Now(),
 	}
 }
@@ -308,6 +310,40 @@ func (h *DashboardHandler) GetModelStats(c *gin.Context) {
 	})
 }
 
+// GetPromptCacheStats handles getting prompt cache hit rates per account/group and savings per user
+// GET /api/v1/admin/dashboard/prompt-cache
+// Query params: start_date, end_date (YYYY-MM-DD), group_id (optional, filters accounts), limit (top users, default 10)
+func (h *DashboardHandler) GetPromptCacheStats(c *gin.Context) {
+	startTime, endTime := parseTimeRange(c)
+
+	var groupID int64
+	if groupIDStr := c.Query("group_id"); groupIDStr != "" {
+		if id, err := strconv.ParseInt(groupIDStr, 10, 64); err == nil {
+			groupID = id
+		}
+	}
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	stats, err := h.promptCacheService.GetStats(c.Request.Context(), startTime, endTime, groupID, limit)
+	if err != nil {
+		response.Error(c, 500, "Failed to get prompt cache statistics")
+		return
+	}
+
+	response.Success(c, gin.H{
+		"accounts":   stats.Accounts,
+		"groups":     stats.Groups,
+		"users":      stats.Users,
+		"start_date": startTime.Format("2006-01-02"),
+		"end_date":   endTime.Add(-24 * time.Hour).Format("2006-01-02"),
+	})
+}
+
 // GetAPIKeyUsageTrend handles getting API key usage trend data
 // GET /api/v1/admin/dashboard/api-keys-trend
 // Query params: start_date, end_date (YYYY-MM-DD), granularity (day/hour), limit (default 5)
@@ -43,6 +43,14 @@ type CreateGroupRequest struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64 `json:"model_routing"`
 	ModelRoutingEnabled bool               `json:"model_routing_enabled"`
+	// RPM/TPM 限制（分组内共享，0 表示不限制）
+	RPMLimit       int `json:"rpm_limit" binding:"omitempty,min=0"`
+	InputTPMLimit  int `json:"input_tpm_limit" binding:"omitempty,min=0"`
+	OutputTPMLimit int `json:"output_tpm_limit" binding:"omitempty,min=0"`
+	// 响应缓存（仅缓存 temperature=0 的确定性请求）
+	ResponseCacheEnabled    bool    `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int     `json:"response_cache_ttl_seconds" binding:"omitempty,min=0"`
+	ResponseCacheCostRatio  float64 `json:"response_cache_cost_ratio" binding:"omitempty,min=0,max=1"`
 }
 
 // UpdateGroupRequest represents update group request
@@ -66,6 +74,14 @@ type UpdateGroupRequest struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64 `json:"model_routing"`
 	ModelRoutingEnabled *bool              `json:"model_routing_enabled"`
+	// RPM/TPM 限制：nil 表示不修改，0 表示取消限制
+	RPMLimit       *int `json:"rpm_limit" binding:"omitempty,min=0"`
+	InputTPMLimit  *int `json:"input_tpm_limit" binding:"omitempty,min=0"`
+	OutputTPMLimit *int `json:"output_tpm_limit" binding:"omitempty,min=0"`
+	// 响应缓存：nil 表示不修改
+	ResponseCacheEnabled    *bool    `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds *int     `json:"response_cache_ttl_seconds" binding:"omitempty,min=0"`
+	ResponseCacheCostRatio  *float64 `json:"response_cache_cost_ratio" binding:"omitempty,min=0,max=1"`
 }
 
 // List handles listing all groups with pagination
@@ -171,6 +187,16 @@ func (h *GroupHandler) Create(c *gin.Context) {
 		FallbackGroupID:     req.FallbackGroupID,
 		ModelRouting:        req.ModelRouting,
 		ModelRoutingEnabled: req.ModelRoutingEnabled,
+		RateLimits: service.RequestRateLimits{
+			RPM:       req.RPMLimit,
+			InputTPM:  req.InputTPMLimit,
+			OutputTPM: req.OutputTPMLimit,
+		},
+		ResponseCache: service.ResponseCacheSettings{
+			Enabled:    req.ResponseCacheEnabled,
+			TTLSeconds: req.ResponseCacheTTLSeconds,
+		},
+		ResponseCacheCostRatio: req.ResponseCacheCostRatio,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -213,6 +239,16 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		FallbackGroupID:     req.FallbackGroupID,
 		ModelRouting:        req.ModelRouting,
 		ModelRoutingEnabled: req.ModelRoutingEnabled,
+		RateLimits: service.RequestRateLimitsUpdate{
+			RPM:       req.RPMLimit,
+			InputTPM:  req.InputTPMLimit,
+			OutputTPM: req.OutputTPMLimit,
+		},
+		ResponseCache: service.ResponseCacheSettingsUpdate{
+			Enabled:    req.ResponseCacheEnabled,
+			TTLSeconds: req.ResponseCacheTTLSeconds,
+		},
+		ResponseCacheCostRatio: req.ResponseCacheCostRatio,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -0,0 +1,230 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InvoiceHandler handles admin invoice management
+type InvoiceHandler struct {
+	invoiceService *service.InvoiceService
+}
+
+// NewInvoiceHandler creates a new admin invoice handler
+func NewInvoiceHandler(invoiceService *service.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{invoiceService: invoiceService}
+}
+
+// GenerateInvoicesRequest represents invoice generation request.
+// subject_type/subject_id 为空时在后台为账期内所有主体开票
+type GenerateInvoicesRequest struct {
+	Period      string `json:"period" binding:"required"`
+	SubjectType string `json:"subject_type"`
+	SubjectID   int64  `json:"subject_id"`
+	SendEmail   bool   `json:"send_email"`
+}
+
+// List handles listing invoices
+// GET /api/v1/admin/invoices
+func (h *InvoiceHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	filter := service.InvoiceFilter{
+		SubjectType: strings.TrimSpace(c.Query("subject_type")),
+		Status:      strings.TrimSpace(c.Query("status")),
+	}
+	if raw := c.Query("subject_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid subject_id")
+			return
+		}
+		filter.SubjectID = id
+	}
+	if period := c.Query("period"); period != "" {
+		start, err := service.ParseInvoicePeriod(period)
+		if err != nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		filter.PeriodStart = &start
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	invoices, result, err := h.invoiceService.List(c.Request.Context(), params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.Invoice, 0, len(invoices))
+	for i := range invoices {
+		out = append(out, *dto.InvoiceFromService(&invoices[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetByID handles getting an invoice
+// GET /api/v1/admin/invoices/:id
+func (h *InvoiceHandler) GetByID(c *gin.Context) {
+	id, ok := parseInvoiceID(c)
+	if !ok {
+		return
+	}
+	inv, err := h.invoiceService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.InvoiceFromService(inv))
+}
+
+// Generate handles issuing invoices for a past month
+// POST /api/v1/admin/invoices/generate
+func (h *InvoiceHandler) Generate(c *gin.Context) {
+	var req GenerateInvoicesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	periodStart, err := service.ParseInvoicePeriod(req.Period)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	var createdBy *int64
+	if subject, ok := middleware.GetAuthSubjectFromContext(c); ok && subject.UserID > 0 {
+		createdBy = &subject.UserID
+	}
+
+	req.SubjectType = strings.TrimSpace(req.SubjectType)
+	if req.SubjectType == "" {
+		if err := h.invoiceService.StartGenerateForPeriod(periodStart, createdBy, req.SendEmail); err != nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		middleware.SetAuditChanges(c, nil, map[string]any{"period": req.Period, "send_email": req.SendEmail})
+		response.Success(c, gin.H{"period": req.Period, "started": true})
+		return
+	}
+	if req.SubjectID <= 0 {
+		response.BadRequest(c, "subject_id is required when subject_type is set")
+		return
+	}
+
+	inv, err := h.invoiceService.Generate(c.Request.Context(), service.InvoiceSubject{Type: req.SubjectType, ID: req.SubjectID}, periodStart, createdBy, req.SendEmail)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c, nil, map[string]any{
+		"invoice_id":   inv.ID,
+		"number":       inv.Number,
+		"subject_type": inv.SubjectType,
+		"subject_id":   inv.SubjectID,
+		"period":       inv.PeriodLabel(),
+		"total":        inv.Total,
+	})
+	response.Success(c, dto.InvoiceFromService(inv))
+}
+
+// Regenerate handles rebuilding an invoice from current data (number unchanged)
+// POST /api/v1/admin/invoices/:id/regenerate
+func (h *InvoiceHandler) Regenerate(c *gin.Context) {
+	id, ok := parseInvoiceID(c)
+	if !ok {
+		return
+	}
+	before, err := h.invoiceService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	inv, err := h.invoiceService.Regenerate(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c,
+		map[string]any{"subtotal": before.Subtotal, "tax_amount": before.TaxAmount, "total": before.Total},
+		map[string]any{"subtotal": inv.Subtotal, "tax_amount": inv.TaxAmount, "total": inv.Total},
+	)
+	response.Success(c, dto.InvoiceFromService(inv))
+}
+
+// Void handles voiding an invoice
+// POST /api/v1/admin/invoices/:id/void
+func (h *InvoiceHandler) Void(c *gin.Context) {
+	id, ok := parseInvoiceID(c)
+	if !ok {
+		return
+	}
+	inv, err := h.invoiceService.Void(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c, map[string]any{"status": service.InvoiceStatusIssued}, map[string]any{"status": inv.Status})
+	response.Success(c, dto.InvoiceFromService(inv))
+}
+
+// SendEmail handles queueing the invoice email to its recipient
+// POST /api/v1/admin/invoices/:id/send
+func (h *InvoiceHandler) SendEmail(c *gin.Context) {
+	id, ok := parseInvoiceID(c)
+	if !ok {
+		return
+	}
+	if err := h.invoiceService.SendEmail(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Invoice email queued"})
+}
+
+// DownloadPDF handles downloading an invoice as PDF
+// GET /api/v1/admin/invoices/:id/pdf
+func (h *InvoiceHandler) DownloadPDF(c *gin.Context) {
+	h.download(c, service.InvoiceFormatPDF)
+}
+
+// DownloadHTML handles downloading an invoice as HTML
+// GET /api/v1/admin/invoices/:id/html
+func (h *InvoiceHandler) DownloadHTML(c *gin.Context) {
+	h.download(c, service.InvoiceFormatHTML)
+}
+
+func (h *InvoiceHandler) download(c *gin.Context, format string) {
+	id, ok := parseInvoiceID(c)
+	if !ok {
+		return
+	}
+	inv, err := h.invoiceService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	data, contentType, err := service.RenderInvoice(inv, format)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename="+inv.FileName(format))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+func parseInvoiceID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid invoice id")
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,258 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ModelPriceHandler handles admin model price overrides
+type ModelPriceHandler struct {
+	modelPriceService *service.ModelPriceService
+	billingService    *service.BillingService
+}
+
+// NewModelPriceHandler creates a new admin model price handler
+func NewModelPriceHandler(modelPriceService *service.ModelPriceService, billingService *service.BillingService) *ModelPriceHandler {
+	return &ModelPriceHandler{
+		modelPriceService: modelPriceService,
+		billingService:    billingService,
+	}
+}
+
+// ModelPriceRequest represents create/update model price request (prices in USD per million tokens)
+type ModelPriceRequest struct {
+	GroupID                *int64     `json:"group_id"` // 为空表示全局覆盖
+	Model                  string     `json:"model" binding:"required,max=200"`
+	InputPrice             float64    `json:"input_price" binding:"min=0"`
+	OutputPrice            float64    `json:"output_price" binding:"min=0"`
+	CacheWritePrice        float64    `json:"cache_write_price" binding:"min=0"`
+	CacheReadPrice         float64    `json:"cache_read_price" binding:"min=0"`
+	LongContextThreshold   int        `json:"long_context_threshold" binding:"min=0"`
+	LongContextInputPrice  *float64   `json:"long_context_input_price"`
+	LongContextOutputPrice *float64   `json:"long_context_output_price"`
+	EffectiveFrom          *time.Time `json:"effective_from"` // 为空表示立即生效
+	Notes                  string     `json:"notes" binding:"max=500"`
+}
+
+// UpdateUnknownModelPricingRequest represents update unknown model pricing policy request
+type UpdateUnknownModelPricingRequest struct {
+	Policy          string  `json:"policy" binding:"required,oneof=fallback default_price reject"`
+	InputPrice      float64 `json:"input_price"`
+	OutputPrice     float64 `json:"output_price"`
+	CacheWritePrice float64 `json:"cache_write_price"`
+	CacheReadPrice  float64 `json:"cache_read_price"`
+}
+
+func (r *ModelPriceRequest) toInput() *service.ModelPriceInput {
+	return &service.ModelPriceInput{
+		GroupID:                r.GroupID,
+		Model:                  r.Model,
+		InputPrice:             r.InputPrice,
+		OutputPrice:            r.OutputPrice,
+		CacheWritePrice:        r.CacheWritePrice,
+		CacheReadPrice:         r.CacheReadPrice,
+		LongContextThreshold:   r.LongContextThreshold,
+		LongContextInputPrice:  r.LongContextInputPrice,
+		LongContextOutputPrice: r.LongContextOutputPrice,
+		EffectiveFrom:          r.EffectiveFrom,
+		Notes:                  r.Notes,
+	}
+}
+
+// List handles listing model price versions with pagination
+// GET /api/v1/admin/model-prices
+func (h *ModelPriceHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	filters := service.ModelPriceListFilters{
+		Scope: strings.TrimSpace(c.Query("scope")),
+		Model: strings.TrimSpace(c.Query("model")),
+	}
+	if raw := strings.TrimSpace(c.Query("group_id")); raw != "" {
+		groupID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid group_id")
+			return
+		}
+		filters.GroupID = &groupID
+	}
+
+	prices, paginationResult, err := h.modelPriceService.List(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	now := time.Now()
+	out := make([]dto.ModelPrice, 0, len(prices))
+	for i := range prices {
+		out = append(out, *dto.ModelPriceFromService(&prices[i], now))
+	}
+	response.Paginated(c, out, paginationResult.Total, page, pageSize)
+}
+
+// GetByID handles getting a model price version by ID
+// GET /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) GetByID(c *gin.Context) {
+	priceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid model price ID")
+		return
+	}
+
+	price, err := h.modelPriceService.GetByID(c.Request.Context(), priceID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ModelPriceFromService(price, time.Now()))
+}
+
+// Create handles creating a new model price version
+// POST /api/v1/admin/model-prices
+func (h *ModelPriceHandler) Create(c *gin.Context) {
+	var req ModelPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	price, err := h.modelPriceService.Create(c.Request.Context(), req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ModelPriceFromService(price, time.Now()))
+}
+
+// Update handles updating a scheduled model price version
+// PUT /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) Update(c *gin.Context) {
+	priceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid model price ID")
+		return
+	}
+
+	var req ModelPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	price, err := h.modelPriceService.Update(c.Request.Context(), priceID, req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ModelPriceFromService(price, time.Now()))
+}
+
+// Delete handles deleting a model price version
+// DELETE /api/v1/admin/model-prices/:id
+func (h *ModelPriceHandler) Delete(c *gin.Context) {
+	priceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid model price ID")
+		return
+	}
+
+	if err := h.modelPriceService.Delete(c.Request.Context(), priceID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Model price deleted successfully"})
+}
+
+// Preview handles resolving the price currently used to bill a model
+// GET /api/v1/admin/model-prices/preview?model=xxx&group_id=1
+func (h *ModelPriceHandler) Preview(c *gin.Context) {
+	model := strings.TrimSpace(c.Query("model"))
+	if model == "" {
+		response.BadRequest(c, "model is required")
+		return
+	}
+	var groupID *int64
+	if raw := strings.TrimSpace(c.Query("group_id")); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid group_id")
+			return
+		}
+		groupID = &id
+	}
+
+	if err := h.billingService.CheckModelPricing(groupID, model); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	pricing, err := h.billingService.GetModelPricingForGroup(groupID, model)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ModelPricingPreviewFromService(strings.ToLower(model), pricing))
+}
+
+// GetUnknownModelPricing 获取未知模型计费策略
+// GET /api/v1/admin/model-prices/unknown-policy
+func (h *ModelPriceHandler) GetUnknownModelPricing(c *gin.Context) {
+	settings, err := h.modelPriceService.GetUnknownModelPricing(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, unknownModelPricingToDTO(settings))
+}
+
+// UpdateUnknownModelPricing 更新未知模型计费策略
+// PUT /api/v1/admin/model-prices/unknown-policy
+func (h *ModelPriceHandler) UpdateUnknownModelPricing(c *gin.Context) {
+	var req UpdateUnknownModelPricingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	settings, err := h.modelPriceService.UpdateUnknownModelPricing(c.Request.Context(), &service.UnknownModelPricingSettings{
+		Policy:          req.Policy,
+		InputPrice:      req.InputPrice,
+		OutputPrice:     req.OutputPrice,
+		CacheWritePrice: req.CacheWritePrice,
+		CacheReadPrice:  req.CacheReadPrice,
+	})
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	response.Success(c, unknownModelPricingToDTO(settings))
+}
+
+func unknownModelPricingToDTO(settings *service.UnknownModelPricingSettings) dto.UnknownModelPricingSettings {
+	return dto.UnknownModelPricingSettings{
+		Policy:          settings.Policy,
+		InputPrice:      settings.InputPrice,
+		OutputPrice:     settings.OutputPrice,
+		CacheWritePrice: settings.CacheWritePrice,
+		CacheReadPrice:  settings.CacheReadPrice,
+	}
+}
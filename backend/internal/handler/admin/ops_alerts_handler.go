@@ -23,6 +23,7 @@ var validOpsAlertMetricTypes = []string{
 	"cpu_usage_percent",
 	"memory_usage_percent",
 	"concurrency_queue_depth",
+	"balance_ledger_drift_users",
 }
 
 var validOpsAlertMetricTypeSet = func() map[string]struct{} {
@@ -65,8 +66,9 @@ type opsAlertRuleValidatedInput struct {
 	SustainedMinutes int
 	CooldownMinutes  int
 
-	Enabled     bool
-	NotifyEmail bool
+	Enabled          bool
+	NotifyEmail      bool
+	NotifyChannelIDs []int64
 
 	WindowProvided    bool
 	SustainedProvided bool
@@ -183,6 +185,25 @@ func validateOpsAlertRulePayload(raw map[string]json.RawMessage) (*opsAlertRuleV
 		validated.NotifyEmail = true
 	}
 
+	validated.NotifyChannelIDs = []int64{}
+	if v, ok := raw["notify_channel_ids"]; ok && string(v) != "null" {
+		var ids []int64
+		if err := json.Unmarshal(v, &ids); err != nil {
+			return nil, fmt.Errorf("notify_channel_ids must be an array of integers")
+		}
+		seen := make(map[int64]struct{}, len(ids))
+		for _, id := range ids {
+			if id <= 0 {
+				return nil, fmt.Errorf("notify_channel_ids must contain positive ids")
+			}
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+			validated.NotifyChannelIDs = append(validated.NotifyChannelIDs, id)
+		}
+	}
+
 	if v, ok := raw["window_minutes"]; ok {
 		validated.WindowProvided = true
 		if err := json.Unmarshal(v, &validated.WindowMinutes); err != nil {
@@ -283,6 +304,7 @@ func (h *OpsHandler) CreateAlertRule(c *gin.Context) {
 	rule.Severity = validated.Severity
 	rule.Enabled = validated.Enabled
 	rule.NotifyEmail = validated.NotifyEmail
+	rule.NotifyChannelIDs = validated.NotifyChannelIDs
 
 	created, err := h.opsService.CreateAlertRule(c.Request.Context(), &rule)
 	if err != nil {
@@ -338,6 +360,7 @@ func (h *OpsHandler) UpdateAlertRule(c *gin.Context) {
 	rule.Severity = validated.Severity
 	rule.Enabled = validated.Enabled
 	rule.NotifyEmail = validated.NotifyEmail
+	rule.NotifyChannelIDs = validated.NotifyChannelIDs
 
 	updated, err := h.opsService.UpdateAlertRule(c.Request.Context(), &rule)
 	if err != nil {
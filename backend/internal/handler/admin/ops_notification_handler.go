@@ -0,0 +1,148 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type opsNotificationChannelRequest struct {
+	Name         string                               `json:"name"`
+	Type         string                               `json:"type"`
+	Enabled      *bool                                `json:"enabled"`
+	Config       service.OpsNotificationChannelConfig `json:"config"`
+	SendResolved *bool                                `json:"send_resolved"`
+	SendReports  bool                                 `json:"send_reports"`
+}
+
+func (r *opsNotificationChannelRequest) toChannel(id int64) *service.OpsNotificationChannel {
+	ch := &service.OpsNotificationChannel{
+		ID:           id,
+		Name:         r.Name,
+		Type:         r.Type,
+		Enabled:      true,
+		Config:       r.Config,
+		SendResolved: true,
+		SendReports:  r.SendReports,
+	}
+	if r.Enabled != nil {
+		ch.Enabled = *r.Enabled
+	}
+	if r.SendResolved != nil {
+		ch.SendResolved = *r.SendResolved
+	}
+	return ch
+}
+
+// ListNotificationChannels returns all ops notification channels (secrets masked).
+// GET /api/v1/admin/ops/notification-channels
+func (h *OpsHandler) ListNotificationChannels(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	channels, err := h.opsService.ListNotificationChannels(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, channels)
+}
+
+// CreateNotificationChannel creates an ops notification channel.
+// POST /api/v1/admin/ops/notification-channels
+func (h *OpsHandler) CreateNotificationChannel(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	var req opsNotificationChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	created, err := h.opsService.CreateNotificationChannel(c.Request.Context(), req.toChannel(0))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, created)
+}
+
+// UpdateNotificationChannel updates an ops notification channel.
+// Blank secret/bot_token keep the stored values.
+// PUT /api/v1/admin/ops/notification-channels/:id
+func (h *OpsHandler) UpdateNotificationChannel(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid channel ID")
+		return
+	}
+
+	var req opsNotificationChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	updated, err := h.opsService.UpdateNotificationChannel(c.Request.Context(), req.toChannel(id))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, updated)
+}
+
+// DeleteNotificationChannel deletes an ops notification channel and detaches it from alert rules.
+// DELETE /api/v1/admin/ops/notification-channels/:id
+func (h *OpsHandler) DeleteNotificationChannel(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid channel ID")
+		return
+	}
+
+	if err := h.opsService.DeleteNotificationChannel(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"deleted": true})
+}
+
+// TestNotificationChannel sends a test message through the channel and returns the delivery result.
+// POST /api/v1/admin/ops/notification-channels/:id/test
+func (h *OpsHandler) TestNotificationChannel(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid channel ID")
+		return
+	}
+
+	delivery, err := h.opsService.TestNotificationChannel(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, delivery)
+}
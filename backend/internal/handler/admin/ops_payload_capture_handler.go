@@ -0,0 +1,269 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type opsPayloadCaptureRuleRequest struct {
+	Name       string     `json:"name"`
+	ScopeType  string     `json:"scope_type"`
+	ScopeID    int64      `json:"scope_id"`
+	SampleRate float64    `json:"sample_rate"`
+	TTLHours   int        `json:"ttl_hours"`
+	RedactKeys []string   `json:"redact_keys"`
+	Enabled    *bool      `json:"enabled"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+func (r *opsPayloadCaptureRuleRequest) toRule(id int64) *service.OpsPayloadCaptureRule {
+	rule := &service.OpsPayloadCaptureRule{
+		ID:         id,
+		Name:       r.Name,
+		ScopeType:  r.ScopeType,
+		ScopeID:    r.ScopeID,
+		SampleRate: r.SampleRate,
+		TTLHours:   r.TTLHours,
+		RedactKeys: r.RedactKeys,
+		Enabled:    true,
+		ExpiresAt:  r.ExpiresAt,
+	}
+	if r.Enabled != nil {
+		rule.Enabled = *r.Enabled
+	}
+	return rule
+}
+
+type opsPayloadCaptureReplayRequest struct {
+	AccountID *int64 `json:"account_id"`
+}
+
+// ListPayloadCaptureRules returns all payload capture rules.
+// GET /api/v1/admin/ops/payload-capture-rules
+func (h *OpsHandler) ListPayloadCaptureRules(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	rules, err := h.opsService.ListPayloadCaptureRules(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// CreatePayloadCaptureRule creates a payload capture rule.
+// POST /api/v1/admin/ops/payload-capture-rules
+func (h *OpsHandler) CreatePayloadCaptureRule(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	var req opsPayloadCaptureRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	rule := req.toRule(0)
+	if subject, ok := middleware.GetAuthSubjectFromContext(c); ok {
+		uid := subject.UserID
+		rule.CreatedBy = &uid
+	}
+
+	created, err := h.opsService.CreatePayloadCaptureRule(c.Request.Context(), rule)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, created)
+}
+
+// UpdatePayloadCaptureRule updates a payload capture rule.
+// PUT /api/v1/admin/ops/payload-capture-rules/:id
+func (h *OpsHandler) UpdatePayloadCaptureRule(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req opsPayloadCaptureRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	updated, err := h.opsService.UpdatePayloadCaptureRule(c.Request.Context(), req.toRule(id))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, updated)
+}
+
+// DeletePayloadCaptureRule deletes a payload capture rule. Existing captures are kept until they expire.
+// DELETE /api/v1/admin/ops/payload-capture-rules/:id
+func (h *OpsHandler) DeletePayloadCaptureRule(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.opsService.DeletePayloadCaptureRule(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"deleted": true})
+}
+
+// ListPayloadCaptures lists captured payloads (metadata only).
+// GET /api/v1/admin/ops/payload-captures
+func (h *OpsHandler) ListPayloadCaptures(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	startTime, endTime, err := parseOpsTimeRange(c, "24h")
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	filter := &service.OpsPayloadCaptureFilter{Page: page, PageSize: pageSize}
+	if !startTime.IsZero() {
+		filter.StartTime = &startTime
+	}
+	if !endTime.IsZero() {
+		filter.EndTime = &endTime
+	}
+	filter.Model = strings.TrimSpace(c.Query("model"))
+
+	for _, p := range []struct {
+		name string
+		dst  **int64
+	}{
+		{"rule_id", &filter.RuleID},
+		{"user_id", &filter.UserID},
+		{"api_key_id", &filter.APIKeyID},
+		{"group_id", &filter.GroupID},
+		{"account_id", &filter.AccountID},
+	} {
+		v := strings.TrimSpace(c.Query(p.name))
+		if v == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			response.BadRequest(c, "Invalid "+p.name)
+			return
+		}
+		*p.dst = &id
+	}
+
+	result, err := h.opsService.ListPayloadCaptures(c.Request.Context(), filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, result.Items, result.Total, result.Page, result.PageSize)
+}
+
+// GetPayloadCapture returns a captured exchange with decompressed (redacted) bodies.
+// GET /api/v1/admin/ops/payload-captures/:id
+func (h *OpsHandler) GetPayloadCapture(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid capture ID")
+		return
+	}
+
+	detail, err := h.opsService.GetPayloadCapture(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, detail)
+}
+
+// DeletePayloadCapture deletes a captured exchange.
+// DELETE /api/v1/admin/ops/payload-captures/:id
+func (h *OpsHandler) DeletePayloadCapture(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid capture ID")
+		return
+	}
+
+	if err := h.opsService.DeletePayloadCapture(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"deleted": true})
+}
+
+// ReplayPayloadCapture replays a captured request, optionally pinned to an account.
+// POST /api/v1/admin/ops/payload-captures/:id/replay
+func (h *OpsHandler) ReplayPayloadCapture(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid capture ID")
+		return
+	}
+
+	var req opsPayloadCaptureReplayRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "Invalid request body")
+			return
+		}
+	}
+	if req.AccountID != nil && *req.AccountID <= 0 {
+		req.AccountID = nil
+	}
+
+	result, err := h.opsService.ReplayPayloadCapture(c.Request.Context(), id, req.AccountID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, result)
+}
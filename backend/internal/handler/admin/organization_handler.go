@@ -0,0 +1,406 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrganizationHandler handles admin organization management
+type OrganizationHandler struct {
+	organizationService *service.OrganizationService
+}
+
+// NewOrganizationHandler creates a new admin organization handler
+func NewOrganizationHandler(organizationService *service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+	}
+}
+
+// CreateOrganizationRequest represents create organization request
+type CreateOrganizationRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	OwnerUserID int64   `json:"owner_user_id" binding:"required,gt=0"`
+	Balance     float64 `json:"balance" binding:"min=0"`     // 初始钱包余额
+	Concurrency int     `json:"concurrency" binding:"min=0"` // 共享并发池，0=不限制
+	Notes       string  `json:"notes"`
+}
+
+// UpdateOrganizationRequest represents update organization request
+type UpdateOrganizationRequest struct {
+	Name        *string `json:"name" binding:"omitempty,max=100"`
+	Concurrency *int    `json:"concurrency" binding:"omitempty,min=0"`
+	Status      *string `json:"status" binding:"omitempty,oneof=active disabled"`
+	Notes       *string `json:"notes"`
+}
+
+// AdjustOrganizationBalanceRequest represents organization wallet adjustment request
+type AdjustOrganizationBalanceRequest struct {
+	Amount    float64 `json:"amount" binding:"min=0"`
+	Operation string  `json:"operation" binding:"required,oneof=set add subtract"`
+	Notes     string  `json:"notes"`
+}
+
+// AddOrganizationMemberRequest represents add member request (user_id 或 email 二选一)
+type AddOrganizationMemberRequest struct {
+	UserID          int64    `json:"user_id"`
+	Email           string   `json:"email"`
+	Role            string   `json:"role" binding:"omitempty,oneof=admin member"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd" binding:"omitempty,min=0"`
+}
+
+// UpdateOrganizationMemberRequest represents update member request
+type UpdateOrganizationMemberRequest struct {
+	Role *string `json:"role" binding:"omitempty,oneof=admin member"`
+	// MonthlyLimitUSD 为 0 或负数表示清除月度上限
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+}
+
+// List handles listing organizations with pagination
+// GET /api/v1/admin/organizations
+func (h *OrganizationHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	status := c.Query("status")
+	search := strings.TrimSpace(c.Query("search"))
+	if len(search) > 100 {
+		search = search[:100]
+	}
+
+	params := pagination.PaginationParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	orgs, paginationResult, err := h.organizationService.List(c.Request.Context(), params, status, search)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.Organization, 0, len(orgs))
+	for i := range orgs {
+		out = append(out, *dto.OrganizationFromService(&orgs[i]))
+	}
+	response.Paginated(c, out, paginationResult.Total, page, pageSize)
+}
+
+// GetByID handles getting an organization by ID
+// GET /api/v1/admin/organizations/:id
+func (h *OrganizationHandler) GetByID(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	org, err := h.organizationService.GetByID(c.Request.Context(), orgID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// Create handles creating a new organization
+// POST /api/v1/admin/organizations
+func (h *OrganizationHandler) Create(c *gin.Context) {
+	var req CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	org, err := h.organizationService.Create(c.Request.Context(), &service.CreateOrganizationInput{
+		Name:        strings.TrimSpace(req.Name),
+		OwnerUserID: req.OwnerUserID,
+		Balance:     req.Balance,
+		Concurrency: req.Concurrency,
+		Notes:       req.Notes,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// Update handles updating an organization
+// PUT /api/v1/admin/organizations/:id
+func (h *OrganizationHandler) Update(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	org, err := h.organizationService.Update(c.Request.Context(), orgID, &service.UpdateOrganizationInput{
+		Name:        req.Name,
+		Concurrency: req.Concurrency,
+		Status:      req.Status,
+		Notes:       req.Notes,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// Delete handles deleting an organization
+// DELETE /api/v1/admin/organizations/:id
+func (h *OrganizationHandler) Delete(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.organizationService.Delete(c.Request.Context(), orgID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Organization deleted successfully"})
+}
+
+// AdjustBalance handles adjusting the organization wallet
+// POST /api/v1/admin/organizations/:id/balance
+func (h *OrganizationHandler) AdjustBalance(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req AdjustOrganizationBalanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	var actorUserID int64
+	if subject, ok := middleware.GetAuthSubjectFromContext(c); ok {
+		actorUserID = subject.UserID
+	}
+
+	org, err := h.organizationService.AdjustBalance(c.Request.Context(), orgID, req.Amount, req.Operation, req.Notes, actorUserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// ListTransactions handles listing the organization wallet ledger
+// GET /api/v1/admin/organizations/:id/transactions
+func (h *OrganizationHandler) ListTransactions(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	txs, result, err := h.organizationService.ListBalanceTransactions(c.Request.Context(), orgID, params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.OrganizationBalanceTransaction, 0, len(txs))
+	for i := range txs {
+		out = append(out, *dto.OrganizationBalanceTransactionFromService(&txs[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// ListMembers handles listing organization members
+// GET /api/v1/admin/organizations/:id/members
+func (h *OrganizationHandler) ListMembers(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	members, err := h.organizationService.ListMembers(c.Request.Context(), orgID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.OrganizationMember, 0, len(members))
+	for i := range members {
+		out = append(out, *dto.OrganizationMemberFromService(&members[i]))
+	}
+	response.Success(c, out)
+}
+
+// AddMember handles adding a user to an organization
+// POST /api/v1/admin/organizations/:id/members
+func (h *OrganizationHandler) AddMember(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	var req AddOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	if req.UserID <= 0 && strings.TrimSpace(req.Email) == "" {
+		response.BadRequest(c, "user_id or email is required")
+		return
+	}
+
+	member, err := h.organizationService.AddMember(c.Request.Context(), orgID, &service.AddOrganizationMemberInput{
+		UserID:          req.UserID,
+		Email:           strings.TrimSpace(req.Email),
+		Role:            req.Role,
+		MonthlyLimitUSD: req.MonthlyLimitUSD,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationMemberFromService(member))
+}
+
+// UpdateMember handles updating a member's role or monthly limit
+// PUT /api/v1/admin/organizations/:id/members/:user_id
+func (h *OrganizationHandler) UpdateMember(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	var req UpdateOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	member, err := h.organizationService.UpdateMember(c.Request.Context(), orgID, userID, updateOrganizationMemberInput(&req))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.OrganizationMemberFromService(member))
+}
+
+// RemoveMember handles removing a user from an organization
+// DELETE /api/v1/admin/organizations/:id/members/:user_id
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.organizationService.RemoveMember(c.Request.Context(), orgID, userID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Member removed successfully"})
+}
+
+// GetUsage handles per-member usage breakdown of an organization
+// GET /api/v1/admin/organizations/:id/usage
+func (h *OrganizationHandler) GetUsage(c *gin.Context) {
+	orgID, ok := parseOrganizationID(c)
+	if !ok {
+		return
+	}
+
+	startTime, endTime, ok := parseOrganizationUsageRange(c)
+	if !ok {
+		return
+	}
+
+	breakdown, err := h.organizationService.GetUsageBreakdown(c.Request.Context(), orgID, startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, breakdown)
+}
+
+func parseOrganizationID(c *gin.Context) (int64, bool) {
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return 0, false
+	}
+	return orgID, true
+}
+
+func updateOrganizationMemberInput(req *UpdateOrganizationMemberRequest) *service.UpdateOrganizationMemberInput {
+	input := &service.UpdateOrganizationMemberInput{Role: req.Role}
+	if req.MonthlyLimitUSD != nil {
+		if *req.MonthlyLimitUSD <= 0 {
+			input.ClearMonthlyLimit = true
+		} else {
+			input.MonthlyLimitUSD = req.MonthlyLimitUSD
+		}
+	}
+	return input
+}
+
+// parseOrganizationUsageRange 解析 start_date/end_date，默认本月至今
+func parseOrganizationUsageRange(c *gin.Context) (time.Time, time.Time, bool) {
+	userTZ := c.Query("timezone")
+	now := timezone.NowInUserLocation(userTZ)
+	startTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	endTime := timezone.StartOfDayInUserLocation(now.AddDate(0, 0, 1), userTZ)
+
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return time.Time{}, time.Time{}, false
+		}
+		startTime = t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return time.Time{}, time.Time{}, false
+		}
+		endTime = t.Add(24 * time.Hour)
+	}
+	if !endTime.After(startTime) {
+		response.BadRequest(c, "end_date must not be earlier than start_date")
+		return time.Time{}, time.Time{}, false
+	}
+	return startTime, endTime, true
+}
@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentOrderHandler handles admin top-up order reconciliation
+type PaymentOrderHandler struct {
+	paymentService *service.PaymentService
+}
+
+// NewPaymentOrderHandler creates a new admin payment order handler
+func NewPaymentOrderHandler(paymentService *service.PaymentService) *PaymentOrderHandler {
+	return &PaymentOrderHandler{paymentService: paymentService}
+}
+
+// MarkPaymentOrderPaidRequest represents manual crediting of an order whose webhook was lost
+type MarkPaymentOrderPaidRequest struct {
+	ProviderTxnID string `json:"provider_txn_id"`
+	Notes         string `json:"notes"`
+}
+
+// RefundPaymentOrderRequest represents recording a refund issued in the provider dashboard
+type RefundPaymentOrderRequest struct {
+	Notes string `json:"notes"`
+}
+
+// List handles listing payment orders
+// GET /api/v1/admin/payment-orders
+func (h *PaymentOrderHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	filter := service.PaymentOrderFilter{
+		Provider: strings.TrimSpace(c.Query("provider")),
+		Status:   strings.TrimSpace(c.Query("status")),
+		Search:   strings.TrimSpace(c.Query("search")),
+	}
+	if raw := c.Query("user_id"); raw != "" {
+		userID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid user_id")
+			return
+		}
+		filter.UserID = userID
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	orders, result, err := h.paymentService.List(c.Request.Context(), params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.AdminPaymentOrder, 0, len(orders))
+	for i := range orders {
+		out = append(out, *dto.PaymentOrderFromServiceAdmin(&orders[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetByID handles getting a payment order
+// GET /api/v1/admin/payment-orders/:id
+func (h *PaymentOrderHandler) GetByID(c *gin.Context) {
+	id, ok := parsePaymentOrderID(c)
+	if !ok {
+		return
+	}
+	order, err := h.paymentService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.PaymentOrderFromServiceAdmin(order))
+}
+
+// MarkPaid handles crediting a pending/failed order after confirming payment in the provider dashboard
+// POST /api/v1/admin/payment-orders/:id/mark-paid
+func (h *PaymentOrderHandler) MarkPaid(c *gin.Context) {
+	id, ok := parsePaymentOrderID(c)
+	if !ok {
+		return
+	}
+	var req MarkPaymentOrderPaidRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	subject, _ := middleware.GetAuthSubjectFromContext(c)
+
+	order, err := h.paymentService.MarkPaid(c.Request.Context(), id, req.ProviderTxnID, strings.TrimSpace(req.Notes), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c, nil, map[string]any{
+		"order_no":        order.OrderNo,
+		"status":          order.Status,
+		"provider_txn_id": order.ProviderTxnID,
+		"credit_amount":   order.CreditAmount,
+	})
+	response.Success(c, dto.PaymentOrderFromServiceAdmin(order))
+}
+
+// Refund handles recording a refund and debiting the credited balance
+// POST /api/v1/admin/payment-orders/:id/refund
+func (h *PaymentOrderHandler) Refund(c *gin.Context) {
+	id, ok := parsePaymentOrderID(c)
+	if !ok {
+		return
+	}
+	var req RefundPaymentOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	subject, _ := middleware.GetAuthSubjectFromContext(c)
+
+	order, err := h.paymentService.MarkRefunded(c.Request.Context(), id, strings.TrimSpace(req.Notes), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c,
+		map[string]any{"order_no": order.OrderNo, "status": service.PaymentStatusPaid},
+		map[string]any{"order_no": order.OrderNo, "status": order.Status, "debit_amount": order.CreditAmount},
+	)
+	response.Success(c, dto.PaymentOrderFromServiceAdmin(order))
+}
+
+func parsePaymentOrderID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid payment order id")
+		return 0, false
+	}
+	return id, true
+}
@@ -30,7 +30,7 @@ type CreateProxyRequest struct {
 	Host     string `json:"host" binding:"required"`
 	Port     int    `json:"port" binding:"required,min=1,max=65535"`
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" binding:"max=100"`
 }
 
 // UpdateProxyRequest represents update proxy request
@@ -40,7 +40,7 @@ type UpdateProxyRequest struct {
 	Host     string `json:"host"`
 	Port     int    `json:"port" binding:"omitempty,min=1,max=65535"`
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" binding:"max=100"`
 	Status   string `json:"status" binding:"omitempty,oneof=active inactive"`
 }
 
@@ -284,7 +284,7 @@ type BatchCreateProxyItem struct {
 	Host     string `json:"host" binding:"required"`
 	Port     int    `json:"port" binding:"required,min=1,max=65535"`
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" binding:"max=100"`
 }
 
 // BatchCreateRequest represents batch create proxies request
@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProxyImportHandler handles bulk proxy import and proxy subscriptions
+type ProxyImportHandler struct {
+	importService *service.ProxyImportService
+}
+
+// NewProxyImportHandler creates a new admin proxy import handler
+func NewProxyImportHandler(importService *service.ProxyImportService) *ProxyImportHandler {
+	return &ProxyImportHandler{
+		importService: importService,
+	}
+}
+
+// ImportProxiesRequest represents a bulk proxy import request (content or url)
+type ImportProxiesRequest struct {
+	Content    string `json:"content"`
+	URL        string `json:"url" binding:"omitempty,max=2048"`
+	NamePrefix string `json:"name_prefix" binding:"omitempty,max=50"`
+	PoolID     *int64 `json:"pool_id"`
+}
+
+// CreateProxySubscriptionRequest represents create proxy subscription request
+type CreateProxySubscriptionRequest struct {
+	Name                   string `json:"name" binding:"required,max=100"`
+	URL                    string `json:"url" binding:"required,max=2048"`
+	RefreshIntervalMinutes int    `json:"refresh_interval_minutes" binding:"omitempty,min=0"`
+	PoolID                 *int64 `json:"pool_id"`
+}
+
+// UpdateProxySubscriptionRequest represents update proxy subscription request
+type UpdateProxySubscriptionRequest struct {
+	Name                   *string `json:"name" binding:"omitempty,max=100"`
+	URL                    *string `json:"url" binding:"omitempty,max=2048"`
+	RefreshIntervalMinutes *int    `json:"refresh_interval_minutes" binding:"omitempty,min=0"`
+	PoolID                 *int64  `json:"pool_id"`
+	Status                 *string `json:"status" binding:"omitempty,oneof=active inactive"`
+}
+
+// Import handles importing proxies from a pasted list or a subscription URL
+// POST /api/v1/admin/proxies/import
+func (h *ProxyImportHandler) Import(c *gin.Context) {
+	var req ImportProxiesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := h.importService.Import(c.Request.Context(), service.ProxyImportInput{
+		Content:    req.Content,
+		URL:        strings.TrimSpace(req.URL),
+		NamePrefix: strings.TrimSpace(req.NamePrefix),
+		PoolID:     req.PoolID,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxyImportResultFromService(result))
+}
+
+// ListSubscriptions handles listing proxy subscriptions
+// GET /api/v1/admin/proxy-subscriptions
+func (h *ProxyImportHandler) ListSubscriptions(c *gin.Context) {
+	subs, err := h.importService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.ProxySubscription, 0, len(subs))
+	for i := range subs {
+		out = append(out, *dto.ProxySubscriptionFromService(&subs[i]))
+	}
+	response.Success(c, out)
+}
+
+// CreateSubscription handles creating a proxy subscription and runs its first sync
+// POST /api/v1/admin/proxy-subscriptions
+func (h *ProxyImportHandler) CreateSubscription(c *gin.Context) {
+	var req CreateProxySubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	sub, result, err := h.importService.CreateSubscription(c.Request.Context(), service.CreateProxySubscriptionInput{
+		Name:                   req.Name,
+		URL:                    req.URL,
+		RefreshIntervalMinutes: req.RefreshIntervalMinutes,
+		PoolID:                 req.PoolID,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"subscription": dto.ProxySubscriptionFromService(sub),
+		"result":       dto.ProxyImportResultFromService(result),
+	})
+}
+
+// UpdateSubscription handles updating a proxy subscription
+// PUT /api/v1/admin/proxy-subscriptions/:id
+func (h *ProxyImportHandler) UpdateSubscription(c *gin.Context) {
+	subID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy subscription ID")
+		return
+	}
+
+	var req UpdateProxySubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	sub, err := h.importService.UpdateSubscription(c.Request.Context(), subID, service.UpdateProxySubscriptionInput{
+		Name:                   req.Name,
+		URL:                    req.URL,
+		RefreshIntervalMinutes: req.RefreshIntervalMinutes,
+		PoolID:                 req.PoolID,
+		Status:                 req.Status,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxySubscriptionFromService(sub))
+}
+
+// DeleteSubscription handles deleting a proxy subscription (imported proxies are kept)
+// DELETE /api/v1/admin/proxy-subscriptions/:id
+func (h *ProxyImportHandler) DeleteSubscription(c *gin.Context) {
+	subID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy subscription ID")
+		return
+	}
+
+	if err := h.importService.DeleteSubscription(c.Request.Context(), subID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Proxy subscription deleted successfully"})
+}
+
+// SyncSubscription handles syncing a proxy subscription immediately
+// POST /api/v1/admin/proxy-subscriptions/:id/sync
+func (h *ProxyImportHandler) SyncSubscription(c *gin.Context) {
+	subID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy subscription ID")
+		return
+	}
+
+	result, err := h.importService.SyncSubscription(c.Request.Context(), subID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxyImportResultFromService(result))
+}
@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProxyPoolHandler handles admin proxy pool management
+type ProxyPoolHandler struct {
+	poolService *service.ProxyPoolService
+}
+
+// NewProxyPoolHandler creates a new admin proxy pool handler
+func NewProxyPoolHandler(poolService *service.ProxyPoolService) *ProxyPoolHandler {
+	return &ProxyPoolHandler{
+		poolService: poolService,
+	}
+}
+
+// CreateProxyPoolRequest represents create proxy pool request
+type CreateProxyPoolRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	Description string  `json:"description"`
+	Policy      string  `json:"policy" binding:"omitempty,oneof=sticky round_robin least_latency same_country"`
+	ProxyIDs    []int64 `json:"proxy_ids"`
+}
+
+// UpdateProxyPoolRequest represents update proxy pool request
+type UpdateProxyPoolRequest struct {
+	Name        *string  `json:"name" binding:"omitempty,max=100"`
+	Description *string  `json:"description"`
+	Policy      *string  `json:"policy" binding:"omitempty,oneof=sticky round_robin least_latency same_country"`
+	Status      *string  `json:"status" binding:"omitempty,oneof=active inactive"`
+	ProxyIDs    *[]int64 `json:"proxy_ids"`
+}
+
+// List handles listing proxy pools with pagination
+// GET /api/v1/admin/proxy-pools
+func (h *ProxyPoolHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+	search := strings.TrimSpace(c.Query("search"))
+	if len(search) > 100 {
+		search = search[:100]
+	}
+
+	pools, paginationResult, err := h.poolService.List(c.Request.Context(), params, strings.TrimSpace(c.Query("status")), search)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.ProxyPool, 0, len(pools))
+	for i := range pools {
+		out = append(out, *dto.ProxyPoolFromService(&pools[i]))
+	}
+	response.Paginated(c, out, paginationResult.Total, page, pageSize)
+}
+
+// GetAll handles getting all active proxy pools without pagination
+// GET /api/v1/admin/proxy-pools/all
+func (h *ProxyPoolHandler) GetAll(c *gin.Context) {
+	pools, err := h.poolService.ListActive(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.ProxyPool, 0, len(pools))
+	for i := range pools {
+		out = append(out, *dto.ProxyPoolFromService(&pools[i]))
+	}
+	response.Success(c, out)
+}
+
+// GetByID handles getting a proxy pool by ID
+// GET /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) GetByID(c *gin.Context) {
+	poolID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	pool, err := h.poolService.GetByID(c.Request.Context(), poolID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxyPoolFromService(pool))
+}
+
+// Create handles creating a new proxy pool
+// POST /api/v1/admin/proxy-pools
+func (h *ProxyPoolHandler) Create(c *gin.Context) {
+	var req CreateProxyPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	pool, err := h.poolService.Create(c.Request.Context(), &service.CreateProxyPoolInput{
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Policy:      req.Policy,
+		ProxyIDs:    req.ProxyIDs,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxyPoolFromService(pool))
+}
+
+// Update handles updating a proxy pool
+// PUT /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) Update(c *gin.Context) {
+	poolID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	var req UpdateProxyPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	pool, err := h.poolService.Update(c.Request.Context(), poolID, &service.UpdateProxyPoolInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Policy:      req.Policy,
+		Status:      req.Status,
+		ProxyIDs:    req.ProxyIDs,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.ProxyPoolFromService(pool))
+}
+
+// Delete handles deleting a proxy pool
+// DELETE /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) Delete(c *gin.Context) {
+	poolID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	if err := h.poolService.Delete(c.Request.Context(), poolID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Proxy pool deleted successfully"})
+}
@@ -92,6 +92,18 @@ func (h *SettingHandler) GetSettings(c *gin.Context) {
 		CreemProductID:                       settings.CreemProductID,
 		CreemRateMultiplier:                  settings.CreemRateMultiplier,
 		CreemSuccessURL:                      settings.CreemSuccessURL,
+		StripeEnabled:                        settings.StripeEnabled,
+		StripeSecretKeyConfigured:            settings.StripeSecretKeyConfigured,
+		StripeWebhookSecretConfigured:        settings.StripeWebhookSecretConfigured,
+		StripeCurrency:                       settings.StripeCurrency,
+		StripeRateMultiplier:                 settings.StripeRateMultiplier,
+		EPayEnabled:                          settings.EPayEnabled,
+		EPayGatewayURL:                       settings.EPayGatewayURL,
+		EPayMerchantID:                       settings.EPayMerchantID,
+		EPayMerchantKeyConfigured:            settings.EPayMerchantKeyConfigured,
+		EPayPayTypes:                         settings.EPayPayTypes,
+		EPayCurrency:                         settings.EPayCurrency,
+		EPayRateMultiplier:                   settings.EPayRateMultiplier,
 	})
 }
 
@@ -162,6 +174,22 @@ type UpdateSettingsRequest struct {
 	CreemProductID      string  `json:"creem_product_id"`
 	CreemRateMultiplier float64 `json:"creem_rate_multiplier"`
 	CreemSuccessURL     string  `json:"creem_success_url"`
+
+	// Stripe Checkout
+	StripeEnabled        bool    `json:"stripe_enabled"`
+	StripeSecretKey      string  `json:"stripe_secret_key"`
+	StripeWebhookSecret  string  `json:"stripe_webhook_secret"`
+	StripeCurrency       string  `json:"stripe_currency"`
+	StripeRateMultiplier float64 `json:"stripe_rate_multiplier"`
+
+	// EPay 易支付
+	EPayEnabled        bool    `json:"epay_enabled"`
+	EPayGatewayURL     string  `json:"epay_gateway_url"`
+	EPayMerchantID     string  `json:"epay_merchant_id"`
+	EPayMerchantKey    string  `json:"epay_merchant_key"`
+	EPayPayTypes       string  `json:"epay_pay_types"`
+	EPayCurrency       string  `json:"epay_currency"`
+	EPayRateMultiplier float64 `json:"epay_rate_multiplier"`
 }
 
 // UpdateSettings 更新系统设置
@@ -256,6 +284,49 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		}
 	}
 
+	// Stripe 参数验证
+	req.StripeCurrency = strings.ToLower(strings.TrimSpace(req.StripeCurrency))
+	if req.StripeCurrency != "" && len(req.StripeCurrency) != 3 {
+		response.BadRequest(c, "Stripe currency must be a 3-letter ISO code")
+		return
+	}
+	if req.StripeEnabled && strings.TrimSpace(req.StripeSecretKey) == "" && !previousSettings.StripeSecretKeyConfigured {
+		response.BadRequest(c, "Stripe Secret Key is required when enabled")
+		return
+	}
+	if req.StripeEnabled && strings.TrimSpace(req.StripeWebhookSecret) == "" && !previousSettings.StripeWebhookSecretConfigured {
+		response.BadRequest(c, "Stripe Webhook Secret is required when enabled")
+		return
+	}
+
+	// 易支付参数验证
+	req.EPayGatewayURL = strings.TrimSpace(req.EPayGatewayURL)
+	payTypes := make([]string, 0, 4)
+	for _, t := range strings.Split(req.EPayPayTypes, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			payTypes = append(payTypes, t)
+		}
+	}
+	req.EPayPayTypes = strings.Join(payTypes, ",")
+	if req.EPayEnabled {
+		if req.EPayGatewayURL == "" || strings.TrimSpace(req.EPayMerchantID) == "" {
+			response.BadRequest(c, "EPay gateway URL and merchant ID are required when enabled")
+			return
+		}
+		if err := config.ValidateAbsoluteHTTPURL(req.EPayGatewayURL); err != nil {
+			response.BadRequest(c, "EPay gateway URL must be an absolute http(s) URL")
+			return
+		}
+		if strings.TrimSpace(req.EPayMerchantKey) == "" && !previousSettings.EPayMerchantKeyConfigured {
+			response.BadRequest(c, "EPay merchant key is required when enabled")
+			return
+		}
+	}
+	if req.StripeRateMultiplier < 0 || req.EPayRateMultiplier < 0 {
+		response.BadRequest(c, "Rate multiplier cannot be negative")
+		return
+	}
+
 	// Ops metrics collector interval validation (seconds).
 	if req.OpsMetricsIntervalSeconds != nil {
 		v := *req.OpsMetricsIntervalSeconds
@@ -336,6 +407,20 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		CreemProductID:      req.CreemProductID,
 		CreemRateMultiplier: req.CreemRateMultiplier,
 		CreemSuccessURL:     req.CreemSuccessURL,
+		// Stripe Checkout
+		StripeEnabled:        req.StripeEnabled,
+		StripeSecretKey:      strings.TrimSpace(req.StripeSecretKey),
+		StripeWebhookSecret:  strings.TrimSpace(req.StripeWebhookSecret),
+		StripeCurrency:       req.StripeCurrency,
+		StripeRateMultiplier: req.StripeRateMultiplier,
+		// EPay 易支付
+		EPayEnabled:        req.EPayEnabled,
+		EPayGatewayURL:     req.EPayGatewayURL,
+		EPayMerchantID:     req.EPayMerchantID,
+		EPayMerchantKey:    strings.TrimSpace(req.EPayMerchantKey),
+		EPayPayTypes:       req.EPayPayTypes,
+		EPayCurrency:       req.EPayCurrency,
+		EPayRateMultiplier: req.EPayRateMultiplier,
 	}
 
 	if err := h.settingService.UpdateSettings(c.Request.Context(), settings); err != nil {
@@ -343,7 +428,7 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		return
 	}
 
-	h.auditSettingsUpdate(c, previousSettings, settings, req)
+	h.auditSettingsUpdate(c, previousSettings, settings)
 
 	// 重新获取设置返回
 	updatedSettings, err := h.settingService.GetAllSettings(c.Request.Context())
@@ -400,19 +485,34 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		CreemProductID:                       updatedSettings.CreemProductID,
 		CreemRateMultiplier:                  updatedSettings.CreemRateMultiplier,
 		CreemSuccessURL:                      updatedSettings.CreemSuccessURL,
+		StripeEnabled:                        updatedSettings.StripeEnabled,
+		StripeSecretKeyConfigured:            updatedSettings.StripeSecretKeyConfigured,
+		StripeWebhookSecretConfigured:        updatedSettings.StripeWebhookSecretConfigured,
+		StripeCurrency:                       updatedSettings.StripeCurrency,
+		StripeRateMultiplier:                 updatedSettings.StripeRateMultiplier,
+		EPayEnabled:                          updatedSettings.EPayEnabled,
+		EPayGatewayURL:                       updatedSettings.EPayGatewayURL,
+		EPayMerchantID:                       updatedSettings.EPayMerchantID,
+		EPayMerchantKeyConfigured:            updatedSettings.EPayMerchantKeyConfigured,
+		EPayPayTypes:                         updatedSettings.EPayPayTypes,
+		EPayCurrency:                         updatedSettings.EPayCurrency,
+		EPayRateMultiplier:                   updatedSettings.EPayRateMultiplier,
 	})
 }
 
-func (h *SettingHandler) auditSettingsUpdate(c *gin.Context, before *service.SystemSettings, after *service.SystemSettings, req UpdateSettingsRequest) {
+func (h *SettingHandler) auditSettingsUpdate(c *gin.Context, before *service.SystemSettings, after *service.SystemSettings) {
 	if before == nil || after == nil {
 		return
 	}
 
-	changed := diffSettings(before, after, req)
+	changed := diffSettings(before, after)
 	if len(changed) == 0 {
 		return
 	}
 
+	auditBefore, auditAfter := settingsAuditSnapshots(before, after, changed)
+	middleware.SetAuditChanges(c, auditBefore, auditAfter)
+
 	subject, _ := middleware.GetAuthSubjectFromContext(c)
 	role, _ := middleware.GetUserRoleFromContext(c)
 	log.Printf("AUDIT: settings updated at=%s user_id=%d role=%s changed=%v",
@@ -423,126 +523,114 @@ func (h *SettingHandler) auditSettingsUpdate(c *gin.Context, before *service.Sys
 	)
 }
 
-func diffSettings(before *service.SystemSettings, after *service.SystemSettings, req UpdateSettingsRequest) []string {
+// settingsAuditField 描述一个参与审计比对的设置项
+type settingsAuditField struct {
+	key string
+	get func(*service.SystemSettings) any
+}
+
+// settingsAuditFields 参与审计比对的非敏感设置项；密钥类字段只记录是否被修改
+var settingsAuditFields = []settingsAuditField{
+	{"registration_enabled", func(s *service.SystemSettings) any { return s.RegistrationEnabled }},
+	{"email_verify_enabled", func(s *service.SystemSettings) any { return s.EmailVerifyEnabled }},
+	{"promo_code_enabled", func(s *service.SystemSettings) any { return s.PromoCodeEnabled }},
+	{"password_reset_enabled", func(s *service.SystemSettings) any { return s.PasswordResetEnabled }},
+	{"totp_enabled", func(s *service.SystemSettings) any { return s.TotpEnabled }},
+	{"smtp_host", func(s *service.SystemSettings) any { return s.SMTPHost }},
+	{"smtp_port", func(s *service.SystemSettings) any { return s.SMTPPort }},
+	{"smtp_username", func(s *service.SystemSettings) any { return s.SMTPUsername }},
+	{"smtp_from_email", func(s *service.SystemSettings) any { return s.SMTPFrom }},
+	{"smtp_from_name", func(s *service.SystemSettings) any { return s.SMTPFromName }},
+	{"smtp_use_tls", func(s *service.SystemSettings) any { return s.SMTPUseTLS }},
+	{"turnstile_enabled", func(s *service.SystemSettings) any { return s.TurnstileEnabled }},
+	{"turnstile_site_key", func(s *service.SystemSettings) any { return s.TurnstileSiteKey }},
+	{"linuxdo_connect_enabled", func(s *service.SystemSettings) any { return s.LinuxDoConnectEnabled }},
+	{"linuxdo_connect_client_id", func(s *service.SystemSettings) any { return s.LinuxDoConnectClientID }},
+	{"linuxdo_connect_redirect_url", func(s *service.SystemSettings) any { return s.LinuxDoConnectRedirectURL }},
+	{"site_name", func(s *service.SystemSettings) any { return s.SiteName }},
+	{"site_logo", func(s *service.SystemSettings) any { return s.SiteLogo }},
+	{"site_subtitle", func(s *service.SystemSettings) any { return s.SiteSubtitle }},
+	{"api_base_url", func(s *service.SystemSettings) any { return s.APIBaseURL }},
+	{"contact_info", func(s *service.SystemSettings) any { return s.ContactInfo }},
+	{"doc_url", func(s *service.SystemSettings) any { return s.DocURL }},
+	{"home_content", func(s *service.SystemSettings) any { return s.HomeContent }},
+	{"hide_ccs_import_button", func(s *service.SystemSettings) any { return s.HideCcsImportButton }},
+	{"default_concurrency", func(s *service.SystemSettings) any { return s.DefaultConcurrency }},
+	{"default_balance", func(s *service.SystemSettings) any { return s.DefaultBalance }},
+	{"enable_model_fallback", func(s *service.SystemSettings) any { return s.EnableModelFallback }},
+	{"fallback_model_anthropic", func(s *service.SystemSettings) any { return s.FallbackModelAnthropic }},
+	{"fallback_model_openai", func(s *service.SystemSettings) any { return s.FallbackModelOpenAI }},
+	{"fallback_model_gemini", func(s *service.SystemSettings) any { return s.FallbackModelGemini }},
+	{"fallback_model_antigravity", func(s *service.SystemSettings) any { return s.FallbackModelAntigravity }},
+	{"enable_identity_patch", func(s *service.SystemSettings) any { return s.EnableIdentityPatch }},
+	{"identity_patch_prompt", func(s *service.SystemSettings) any { return s.IdentityPatchPrompt }},
+	{"ops_monitoring_enabled", func(s *service.SystemSettings) any { return s.OpsMonitoringEnabled }},
+	{"ops_realtime_monitoring_enabled", func(s *service.SystemSettings) any { return s.OpsRealtimeMonitoringEnabled }},
+	{"ops_query_mode_default", func(s *service.SystemSettings) any { return s.OpsQueryModeDefault }},
+	{"ops_metrics_interval_seconds", func(s *service.SystemSettings) any { return s.OpsMetricsIntervalSeconds }},
+	{"creem_enabled", func(s *service.SystemSettings) any { return s.CreemEnabled }},
+	{"creem_product_id", func(s *service.SystemSettings) any { return s.CreemProductID }},
+	{"creem_rate_multiplier", func(s *service.SystemSettings) any { return s.CreemRateMultiplier }},
+	{"creem_success_url", func(s *service.SystemSettings) any { return s.CreemSuccessURL }},
+	{"stripe_enabled", func(s *service.SystemSettings) any { return s.StripeEnabled }},
+	{"stripe_currency", func(s *service.SystemSettings) any { return s.StripeCurrency }},
+	{"stripe_rate_multiplier", func(s *service.SystemSettings) any { return s.StripeRateMultiplier }},
+	{"epay_enabled", func(s *service.SystemSettings) any { return s.EPayEnabled }},
+	{"epay_gateway_url", func(s *service.SystemSettings) any { return s.EPayGatewayURL }},
+	{"epay_merchant_id", func(s *service.SystemSettings) any { return s.EPayMerchantID }},
+	{"epay_pay_types", func(s *service.SystemSettings) any { return s.EPayPayTypes }},
+	{"epay_currency", func(s *service.SystemSettings) any { return s.EPayCurrency }},
+	{"epay_rate_multiplier", func(s *service.SystemSettings) any { return s.EPayRateMultiplier }},
+}
+
+// settingsAuditSecretFields 密钥类设置项：留空表示保持不变，非空且与原值不同才视为修改
+var settingsAuditSecretFields = []settingsAuditField{
+	{"smtp_password", func(s *service.SystemSettings) any { return s.SMTPPassword }},
+	{"turnstile_secret_key", func(s *service.SystemSettings) any { return s.TurnstileSecretKey }},
+	{"linuxdo_connect_client_secret", func(s *service.SystemSettings) any { return s.LinuxDoConnectClientSecret }},
+	{"creem_api_key", func(s *service.SystemSettings) any { return s.CreemAPIKey }},
+	{"creem_webhook_secret", func(s *service.SystemSettings) any { return s.CreemWebhookSecret }},
+	{"stripe_secret_key", func(s *service.SystemSettings) any { return s.StripeSecretKey }},
+	{"stripe_webhook_secret", func(s *service.SystemSettings) any { return s.StripeWebhookSecret }},
+	{"epay_merchant_key", func(s *service.SystemSettings) any { return s.EPayMerchantKey }},
+}
+
+func diffSettings(before *service.SystemSettings, after *service.SystemSettings) []string {
 	changed := make([]string, 0, 20)
-	if before.RegistrationEnabled != after.RegistrationEnabled {
-		changed = append(changed, "registration_enabled")
-	}
-	if before.EmailVerifyEnabled != after.EmailVerifyEnabled {
-		changed = append(changed, "email_verify_enabled")
-	}
-	if before.PasswordResetEnabled != after.PasswordResetEnabled {
-		changed = append(changed, "password_reset_enabled")
-	}
-	if before.TotpEnabled != after.TotpEnabled {
-		changed = append(changed, "totp_enabled")
-	}
-	if before.SMTPHost != after.SMTPHost {
-		changed = append(changed, "smtp_host")
-	}
-	if before.SMTPPort != after.SMTPPort {
-		changed = append(changed, "smtp_port")
-	}
-	if before.SMTPUsername != after.SMTPUsername {
-		changed = append(changed, "smtp_username")
-	}
-	if req.SMTPPassword != "" {
-		changed = append(changed, "smtp_password")
-	}
-	if before.SMTPFrom != after.SMTPFrom {
-		changed = append(changed, "smtp_from_email")
-	}
-	if before.SMTPFromName != after.SMTPFromName {
-		changed = append(changed, "smtp_from_name")
-	}
-	if before.SMTPUseTLS != after.SMTPUseTLS {
-		changed = append(changed, "smtp_use_tls")
-	}
-	if before.TurnstileEnabled != after.TurnstileEnabled {
-		changed = append(changed, "turnstile_enabled")
-	}
-	if before.TurnstileSiteKey != after.TurnstileSiteKey {
-		changed = append(changed, "turnstile_site_key")
-	}
-	if req.TurnstileSecretKey != "" {
-		changed = append(changed, "turnstile_secret_key")
-	}
-	if before.LinuxDoConnectEnabled != after.LinuxDoConnectEnabled {
-		changed = append(changed, "linuxdo_connect_enabled")
-	}
-	if before.LinuxDoConnectClientID != after.LinuxDoConnectClientID {
-		changed = append(changed, "linuxdo_connect_client_id")
-	}
-	if req.LinuxDoConnectClientSecret != "" {
-		changed = append(changed, "linuxdo_connect_client_secret")
-	}
-	if before.LinuxDoConnectRedirectURL != after.LinuxDoConnectRedirectURL {
-		changed = append(changed, "linuxdo_connect_redirect_url")
-	}
-	if before.SiteName != after.SiteName {
-		changed = append(changed, "site_name")
-	}
-	if before.SiteLogo != after.SiteLogo {
-		changed = append(changed, "site_logo")
-	}
-	if before.SiteSubtitle != after.SiteSubtitle {
-		changed = append(changed, "site_subtitle")
-	}
-	if before.APIBaseURL != after.APIBaseURL {
-		changed = append(changed, "api_base_url")
-	}
-	if before.ContactInfo != after.ContactInfo {
-		changed = append(changed, "contact_info")
-	}
-	if before.DocURL != after.DocURL {
-		changed = append(changed, "doc_url")
-	}
-	if before.HomeContent != after.HomeContent {
-		changed = append(changed, "home_content")
-	}
-	if before.HideCcsImportButton != after.HideCcsImportButton {
-		changed = append(changed, "hide_ccs_import_button")
-	}
-	if before.DefaultConcurrency != after.DefaultConcurrency {
-		changed = append(changed, "default_concurrency")
-	}
-	if before.DefaultBalance != after.DefaultBalance {
-		changed = append(changed, "default_balance")
-	}
-	if before.EnableModelFallback != after.EnableModelFallback {
-		changed = append(changed, "enable_model_fallback")
-	}
-	if before.FallbackModelAnthropic != after.FallbackModelAnthropic {
-		changed = append(changed, "fallback_model_anthropic")
-	}
-	if before.FallbackModelOpenAI != after.FallbackModelOpenAI {
-		changed = append(changed, "fallback_model_openai")
-	}
-	if before.FallbackModelGemini != after.FallbackModelGemini {
-		changed = append(changed, "fallback_model_gemini")
-	}
-	if before.FallbackModelAntigravity != after.FallbackModelAntigravity {
-		changed = append(changed, "fallback_model_antigravity")
-	}
-	if before.EnableIdentityPatch != after.EnableIdentityPatch {
-		changed = append(changed, "enable_identity_patch")
-	}
-	if before.IdentityPatchPrompt != after.IdentityPatchPrompt {
-		changed = append(changed, "identity_patch_prompt")
-	}
-	if before.OpsMonitoringEnabled != after.OpsMonitoringEnabled {
-		changed = append(changed, "ops_monitoring_enabled")
+	for _, f := range settingsAuditFields {
+		if f.get(before) != f.get(after) {
+			changed = append(changed, f.key)
+		}
 	}
-	if before.OpsRealtimeMonitoringEnabled != after.OpsRealtimeMonitoringEnabled {
-		changed = append(changed, "ops_realtime_monitoring_enabled")
+	for _, f := range settingsAuditSecretFields {
+		if v := f.get(after); v != "" && v != f.get(before) {
+			changed = append(changed, f.key)
+		}
 	}
-	if before.OpsQueryModeDefault != after.OpsQueryModeDefault {
-		changed = append(changed, "ops_query_mode_default")
+	return changed
+}
+
+// settingsAuditSnapshots 生成仅包含变更字段的前后快照，密钥类字段以占位符代替
+func settingsAuditSnapshots(before *service.SystemSettings, after *service.SystemSettings, changed []string) (map[string]any, map[string]any) {
+	changedSet := make(map[string]struct{}, len(changed))
+	for _, key := range changed {
+		changedSet[key] = struct{}{}
+	}
+	b := make(map[string]any, len(changed))
+	a := make(map[string]any, len(changed))
+	for _, f := range settingsAuditFields {
+		if _, ok := changedSet[f.key]; ok {
+			b[f.key] = f.get(before)
+			a[f.key] = f.get(after)
+		}
 	}
-	if before.OpsMetricsIntervalSeconds != after.OpsMetricsIntervalSeconds {
-		changed = append(changed, "ops_metrics_interval_seconds")
+	for _, f := range settingsAuditSecretFields {
+		if _, ok := changedSet[f.key]; ok {
+			b[f.key] = "***"
+			a[f.key] = "*** (updated)"
+		}
 	}
-	return changed
+	return b, a
 }
 
 // TestSMTPRequest 测试SMTP连接请求
@@ -679,46 +767,6 @@ func (h *SettingHandler) SendTestEmail(c *gin.Context) {
 	response.Success(c, gin.H{"message": "Test email sent successfully"})
 }
 
-// GetAdminAPIKey 获取管理员 API Key 状态
-// GET /api/v1/admin/settings/admin-api-key
-func (h *SettingHandler) GetAdminAPIKey(c *gin.Context) {
-	maskedKey, exists, err := h.settingService.GetAdminAPIKeyStatus(c.Request.Context())
-	if err != nil {
-		response.ErrorFrom(c, err)
-		return
-	}
-
-	response.Success(c, gin.H{
-		"exists":     exists,
-		"masked_key": maskedKey,
-	})
-}
-
-// RegenerateAdminAPIKey 生成/重新生成管理员 API Key
-// POST /api/v1/admin/settings/admin-api-key/regenerate
-func (h *SettingHandler) RegenerateAdminAPIKey(c *gin.Context) {
-	key, err := h.settingService.GenerateAdminAPIKey(c.Request.Context())
-	if err != nil {
-		response.ErrorFrom(c, err)
-		return
-	}
-
-	response.Success(c, gin.H{
-		"key": key, // 完整 key 只在生成时返回一次
-	})
-}
-
-// DeleteAdminAPIKey 删除管理员 API Key
-// DELETE /api/v1/admin/settings/admin-api-key
-func (h *SettingHandler) DeleteAdminAPIKey(c *gin.Context) {
-	if err := h.settingService.DeleteAdminAPIKey(c.Request.Context()); err != nil {
-		response.ErrorFrom(c, err)
-		return
-	}
-
-	response.Success(c, gin.H{"message": "Admin API key deleted"})
-}
-
 // GetStreamTimeoutSettings 获取流超时处理配置
 // GET /api/v1/admin/settings/stream-timeout
 func (h *SettingHandler) GetStreamTimeoutSettings(c *gin.Context) {
@@ -783,3 +831,90 @@ func (h *SettingHandler) UpdateStreamTimeoutSettings(c *gin.Context) {
 		ThresholdWindowMinutes: updatedSettings.ThresholdWindowMinutes,
 	})
 }
+
+// GetInvoiceSettings 获取发票配置
+// GET /api/v1/admin/settings/invoice
+func (h *SettingHandler) GetInvoiceSettings(c *gin.Context) {
+	settings, err := h.settingService.GetInvoiceSettings(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, invoiceSettingsToDTO(settings))
+}
+
+// UpdateInvoiceSettings 更新发票配置
+// PUT /api/v1/admin/settings/invoice
+func (h *SettingHandler) UpdateInvoiceSettings(c *gin.Context) {
+	var req dto.InvoiceSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	before, err := h.settingService.GetInvoiceSettings(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	settings := &service.InvoiceSettings{
+		AutoGenerate:   req.AutoGenerate,
+		AutoEmail:      req.AutoEmail,
+		NumberPrefix:   req.NumberPrefix,
+		NextNumber:     req.NextNumber,
+		NumberPadding:  req.NumberPadding,
+		Currency:       req.Currency,
+		TaxRate:        req.TaxRate,
+		TaxLabel:       req.TaxLabel,
+		CompanyName:    req.CompanyName,
+		CompanyAddress: req.CompanyAddress,
+		CompanyTaxID:   req.CompanyTaxID,
+		CompanyEmail:   req.CompanyEmail,
+		FooterNote:     req.FooterNote,
+	}
+	if err := h.settingService.SetInvoiceSettings(c.Request.Context(), settings); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	updated, err := h.settingService.GetInvoiceSettings(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c, invoiceSettingsAuditMap(before), invoiceSettingsAuditMap(updated))
+	response.Success(c, invoiceSettingsToDTO(updated))
+}
+
+func invoiceSettingsToDTO(settings *service.InvoiceSettings) dto.InvoiceSettings {
+	return dto.InvoiceSettings{
+		AutoGenerate:   settings.AutoGenerate,
+		AutoEmail:      settings.AutoEmail,
+		NumberPrefix:   settings.NumberPrefix,
+		NextNumber:     settings.NextNumber,
+		NumberPadding:  settings.NumberPadding,
+		Currency:       settings.Currency,
+		TaxRate:        settings.TaxRate,
+		TaxLabel:       settings.TaxLabel,
+		CompanyName:    settings.CompanyName,
+		CompanyAddress: settings.CompanyAddress,
+		CompanyTaxID:   settings.CompanyTaxID,
+		CompanyEmail:   settings.CompanyEmail,
+		FooterNote:     settings.FooterNote,
+	}
+}
+
+// invoiceSettingsAuditMap 审计快照只记录影响开票金额与编号的字段
+func invoiceSettingsAuditMap(settings *service.InvoiceSettings) map[string]any {
+	return map[string]any{
+		"auto_generate":  settings.AutoGenerate,
+		"auto_email":     settings.AutoEmail,
+		"number_prefix":  settings.NumberPrefix,
+		"next_number":    settings.NextNumber,
+		"number_padding": settings.NumberPadding,
+		"currency":       settings.Currency,
+		"tax_rate":       settings.TaxRate,
+		"company_tax_id": settings.CompanyTaxID,
+	}
+}
@@ -0,0 +1,174 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionPlanHandler handles admin subscription plan management
+type SubscriptionPlanHandler struct {
+	planService *service.SubscriptionPlanService
+}
+
+// NewSubscriptionPlanHandler creates a new admin subscription plan handler
+func NewSubscriptionPlanHandler(planService *service.SubscriptionPlanService) *SubscriptionPlanHandler {
+	return &SubscriptionPlanHandler{
+		planService: planService,
+	}
+}
+
+// CreateSubscriptionPlanRequest represents create subscription plan request
+type CreateSubscriptionPlanRequest struct {
+	Name            string   `json:"name" binding:"required,max=100"`
+	Description     string   `json:"description"`
+	GroupID         int64    `json:"group_id" binding:"required"`
+	ValidityDays    int      `json:"validity_days" binding:"required,min=1,max=36500"`
+	Price           float64  `json:"price" binding:"required,gt=0"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`   // 为空或 0 表示沿用分组限额
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd"`  // 为空或 0 表示沿用分组限额
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"` // 为空或 0 表示沿用分组限额
+	Status          string   `json:"status" binding:"omitempty,oneof=active disabled"`
+	SortOrder       int      `json:"sort_order"`
+}
+
+// UpdateSubscriptionPlanRequest represents update subscription plan request
+type UpdateSubscriptionPlanRequest struct {
+	Name            *string  `json:"name" binding:"omitempty,max=100"`
+	Description     *string  `json:"description"`
+	GroupID         *int64   `json:"group_id"`
+	ValidityDays    *int     `json:"validity_days" binding:"omitempty,min=1,max=36500"`
+	Price           *float64 `json:"price" binding:"omitempty,gt=0"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+	Status          *string  `json:"status" binding:"omitempty,oneof=active disabled"`
+	SortOrder       *int     `json:"sort_order"`
+}
+
+// List handles listing subscription plans with pagination
+// GET /api/v1/admin/subscription-plans
+func (h *SubscriptionPlanHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	plans, paginationResult, err := h.planService.List(c.Request.Context(), params, strings.TrimSpace(c.Query("status")))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.SubscriptionPlan, 0, len(plans))
+	for i := range plans {
+		out = append(out, *dto.SubscriptionPlanFromService(&plans[i]))
+	}
+	response.Paginated(c, out, paginationResult.Total, page, pageSize)
+}
+
+// GetByID handles getting a subscription plan by ID
+// GET /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) GetByID(c *gin.Context) {
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+
+	plan, err := h.planService.GetByID(c.Request.Context(), planID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Create handles creating a new subscription plan
+// POST /api/v1/admin/subscription-plans
+func (h *SubscriptionPlanHandler) Create(c *gin.Context) {
+	var req CreateSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	plan, err := h.planService.Create(c.Request.Context(), &service.CreateSubscriptionPlanInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		GroupID:         req.GroupID,
+		ValidityDays:    req.ValidityDays,
+		Price:           req.Price,
+		DailyLimitUSD:   req.DailyLimitUSD,
+		WeeklyLimitUSD:  req.WeeklyLimitUSD,
+		MonthlyLimitUSD: req.MonthlyLimitUSD,
+		Status:          req.Status,
+		SortOrder:       req.SortOrder,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Update handles updating a subscription plan
+// PUT /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) Update(c *gin.Context) {
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+
+	var req UpdateSubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	plan, err := h.planService.Update(c.Request.Context(), planID, &service.UpdateSubscriptionPlanInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		GroupID:         req.GroupID,
+		ValidityDays:    req.ValidityDays,
+		Price:           req.Price,
+		DailyLimitUSD:   req.DailyLimitUSD,
+		WeeklyLimitUSD:  req.WeeklyLimitUSD,
+		MonthlyLimitUSD: req.MonthlyLimitUSD,
+		Status:          req.Status,
+		SortOrder:       req.SortOrder,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Delete handles deleting a subscription plan
+// DELETE /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) Delete(c *gin.Context) {
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+
+	if err := h.planService.Delete(c.Request.Context(), planID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Subscription plan deleted successfully"})
+}
@@ -0,0 +1,272 @@
+package admin
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateUsageExportTaskRequest represents background export task creation request
+type CreateUsageExportTaskRequest struct {
+	CreateUsageCleanupTaskRequest
+	Format      string `json:"format"`
+	Destination string `json:"destination"`
+}
+
+// Export streams usage records as CSV/JSONL, with the same filters as List
+// GET /api/v1/admin/usage/export
+func (h *UsageHandler) Export(c *gin.Context) {
+	if h.exportService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage export service unavailable")
+		return
+	}
+	format, ok := service.NormalizeUsageExportFormat(c.Query("format"))
+	if !ok {
+		response.BadRequest(c, "Invalid format, use csv or jsonl")
+		return
+	}
+	filters, ok := parseUsageExportQuery(c)
+	if !ok {
+		return
+	}
+	if err := h.exportService.ValidateStreamFilters(filters); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	writeUsageExportStream(c, h.exportService, filters, format)
+}
+
+// ListExportTasks handles listing background usage export tasks
+// GET /api/v1/admin/usage/export-tasks
+func (h *UsageHandler) ListExportTasks(c *gin.Context) {
+	if h.exportService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage export service unavailable")
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	tasks, result, err := h.exportService.ListTasks(c.Request.Context(), params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.UsageExportTask, 0, len(tasks))
+	for i := range tasks {
+		out = append(out, *dto.UsageExportTaskFromService(&tasks[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// CreateExportTask handles creating a background usage export task
+// POST /api/v1/admin/usage/export-tasks
+func (h *UsageHandler) CreateExportTask(c *gin.Context) {
+	if h.exportService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage export service unavailable")
+		return
+	}
+	subject, ok := middleware.GetAuthSubjectFromContext(c)
+	if !ok || subject.UserID <= 0 {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	var req CreateUsageExportTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	req.StartDate = strings.TrimSpace(req.StartDate)
+	req.EndDate = strings.TrimSpace(req.EndDate)
+	if req.StartDate == "" || req.EndDate == "" {
+		response.BadRequest(c, "start_date and end_date are required")
+		return
+	}
+	startTime, err := timezone.ParseInUserLocation("2006-01-02", req.StartDate, req.Timezone)
+	if err != nil {
+		response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+		return
+	}
+	endTime, err := timezone.ParseInUserLocation("2006-01-02", req.EndDate, req.Timezone)
+	if err != nil {
+		response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+		return
+	}
+
+	filters := service.UsageExportFilters{
+		StartTime:   startTime,
+		EndTime:     endTime.Add(24 * time.Hour),
+		UserID:      req.UserID,
+		APIKeyID:    req.APIKeyID,
+		AccountID:   req.AccountID,
+		GroupID:     req.GroupID,
+		Model:       req.Model,
+		Stream:      req.Stream,
+		BillingType: req.BillingType,
+	}
+	task, err := h.exportService.CreateTask(c.Request.Context(), filters, req.Format, req.Destination, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	middleware.SetAuditChanges(c, nil, map[string]any{
+		"task_id":     task.ID,
+		"format":      task.Format,
+		"destination": task.Destination,
+		"start_time":  task.Filters.StartTime,
+		"end_time":    task.Filters.EndTime,
+	})
+	response.Success(c, dto.UsageExportTaskFromService(task))
+}
+
+// CancelExportTask handles canceling a pending or running export task
+// POST /api/v1/admin/usage/export-tasks/:id/cancel
+func (h *UsageHandler) CancelExportTask(c *gin.Context) {
+	taskID, ok := h.parseExportTaskID(c)
+	if !ok {
+		return
+	}
+	if err := h.exportService.CancelTask(c.Request.Context(), taskID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"id": taskID, "status": service.UsageExportStatusCanceled})
+}
+
+// DeleteExportTask handles deleting a finished export task and its file
+// DELETE /api/v1/admin/usage/export-tasks/:id
+func (h *UsageHandler) DeleteExportTask(c *gin.Context) {
+	taskID, ok := h.parseExportTaskID(c)
+	if !ok {
+		return
+	}
+	if err := h.exportService.DeleteTask(c.Request.Context(), taskID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Export task deleted successfully"})
+}
+
+// DownloadExportTask handles downloading the file of a succeeded export task
+// GET /api/v1/admin/usage/export-tasks/:id/download
+func (h *UsageHandler) DownloadExportTask(c *gin.Context) {
+	taskID, ok := h.parseExportTaskID(c)
+	if !ok {
+		return
+	}
+	task, f, err := h.exportService.OpenTaskFile(c.Request.Context(), taskID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	c.Header("Content-Type", service.UsageExportContentType(task.Format))
+	c.Header("Content-Disposition", "attachment; filename="+task.DownloadName())
+	c.Header("Content-Length", strconv.FormatInt(task.FileSize, 10))
+	c.Status(http.StatusOK)
+	if _, err := io.CopyN(c.Writer, f, task.FileSize); err != nil {
+		log.Printf("[UsageExport] download aborted: task=%d err=%v", taskID, err)
+	}
+}
+
+func (h *UsageHandler) parseExportTaskID(c *gin.Context) (int64, bool) {
+	if h.exportService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage export service unavailable")
+		return 0, false
+	}
+	taskID, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil || taskID <= 0 {
+		response.BadRequest(c, "Invalid task id")
+		return 0, false
+	}
+	return taskID, true
+}
+
+// parseUsageExportQuery 解析与 List 相同的查询参数；end_date 当天包含在内
+func parseUsageExportQuery(c *gin.Context) (service.UsageExportFilters, bool) {
+	var filters service.UsageExportFilters
+	for _, p := range []struct {
+		name string
+		dest **int64
+	}{
+		{"user_id", &filters.UserID},
+		{"api_key_id", &filters.APIKeyID},
+		{"account_id", &filters.AccountID},
+		{"group_id", &filters.GroupID},
+	} {
+		raw := c.Query(p.name)
+		if raw == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid "+p.name)
+			return filters, false
+		}
+		*p.dest = &id
+	}
+	if model := c.Query("model"); model != "" {
+		filters.Model = &model
+	}
+	if streamStr := c.Query("stream"); streamStr != "" {
+		val, err := strconv.ParseBool(streamStr)
+		if err != nil {
+			response.BadRequest(c, "Invalid stream value, use true or false")
+			return filters, false
+		}
+		filters.Stream = &val
+	}
+	if billingTypeStr := c.Query("billing_type"); billingTypeStr != "" {
+		val, err := strconv.ParseInt(billingTypeStr, 10, 8)
+		if err != nil {
+			response.BadRequest(c, "Invalid billing_type")
+			return filters, false
+		}
+		bt := int8(val)
+		filters.BillingType = &bt
+	}
+
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return filters, false
+		}
+		filters.StartTime = t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return filters, false
+		}
+		filters.EndTime = t.Add(24 * time.Hour)
+	}
+	return filters, true
+}
+
+// writeUsageExportStream 设置下载响应头并流式写出导出内容
+func writeUsageExportStream(c *gin.Context, svc *service.UsageExportService, filters service.UsageExportFilters, format string) {
+	c.Header("Content-Type", service.UsageExportContentType(format))
+	c.Header("Content-Disposition", "attachment; filename="+service.UsageExportFileName(filters, format))
+	c.Status(http.StatusOK)
+
+	rows, err := svc.WriteStream(c.Request.Context(), c.Writer, filters, format, true)
+	if err != nil {
+		// 响应头已发送，只能中断输出并记录日志
+		log.Printf("[UsageExport] stream aborted after %d rows: %v", rows, err)
+	}
+}
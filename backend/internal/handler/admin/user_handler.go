@@ -1,11 +1,15 @@
 package admin
 
 import (
+	"context"
+	"math"
 	"strconv"
 	"strings"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -13,13 +17,17 @@ import (
 
 // UserHandler handles admin user management
 type UserHandler struct {
-	adminService service.AdminService
+	adminService         service.AdminService
+	balanceLedgerService *service.BalanceLedgerService
+	cfg                  *config.Config
 }
 
 // NewUserHandler creates a new admin user handler
-func NewUserHandler(adminService service.AdminService) *UserHandler {
+func NewUserHandler(adminService service.AdminService, balanceLedgerService *service.BalanceLedgerService, cfg *config.Config) *UserHandler {
 	return &UserHandler{
-		adminService: adminService,
+		adminService:         adminService,
+		balanceLedgerService: balanceLedgerService,
+		cfg:                  cfg,
 	}
 }
 
@@ -32,6 +40,10 @@ type CreateUserRequest struct {
 	Balance       float64 `json:"balance"`
 	Concurrency   int     `json:"concurrency"`
 	AllowedGroups []int64 `json:"allowed_groups"`
+	// RPM/TPM 限制，0 表示不限制
+	RPMLimit       int `json:"rpm_limit" binding:"omitempty,min=0"`
+	InputTPMLimit  int `json:"input_tpm_limit" binding:"omitempty,min=0"`
+	OutputTPMLimit int `json:"output_tpm_limit" binding:"omitempty,min=0"`
 }
 
 // UpdateUserRequest represents admin update user request
@@ -45,6 +57,12 @@ type UpdateUserRequest struct {
 	Concurrency   *int     `json:"concurrency"`
 	Status        string   `json:"status" binding:"omitempty,oneof=active disabled"`
 	AllowedGroups *[]int64 `json:"allowed_groups"`
+	// Role 管理端角色分配，需要 roles:manage 权限
+	Role string `json:"role" binding:"omitempty,oneof=user admin support finance ops"`
+	// RPM/TPM 限制：nil 表示不修改，0 表示取消限制
+	RPMLimit       *int `json:"rpm_limit" binding:"omitempty,min=0"`
+	InputTPMLimit  *int `json:"input_tpm_limit" binding:"omitempty,min=0"`
+	OutputTPMLimit *int `json:"output_tpm_limit" binding:"omitempty,min=0"`
 }
 
 // UpdateBalanceRequest represents balance update request
@@ -149,6 +167,11 @@ func (h *UserHandler) Create(c *gin.Context) {
 		Balance:       req.Balance,
 		Concurrency:   req.Concurrency,
 		AllowedGroups: req.AllowedGroups,
+		RateLimits: service.RequestRateLimits{
+			RPM:       req.RPMLimit,
+			InputTPM:  req.InputTPMLimit,
+			OutputTPM: req.OutputTPMLimit,
+		},
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -173,6 +196,32 @@ func (h *UserHandler) Update(c *gin.Context) {
 		return
 	}
 
+	if req.Role != "" {
+		if !middleware.HasAdminPermission(c, service.AdminPermissionRolesManage) {
+			response.Forbidden(c, "Changing roles requires the roles:manage permission")
+			return
+		}
+		if subject, ok := middleware.GetAuthSubjectFromContext(c); ok && subject.UserID == userID {
+			response.BadRequest(c, "Cannot change your own role")
+			return
+		}
+		// 只能授予/撤销自己持有的权限：超级管理员角色仅超级管理员可授予
+		granted, _ := middleware.GetAdminPermissionsFromContext(c)
+		if !granted.Covers(service.AdminPermissionsForRole(req.Role)) {
+			response.Forbidden(c, "Cannot grant a role with permissions you do not hold")
+			return
+		}
+		current, err := h.adminService.GetUser(c.Request.Context(), userID)
+		if err != nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		if !granted.Covers(service.AdminPermissionsForRole(current.Role)) {
+			response.Forbidden(c, "Cannot change the role of a user with permissions you do not hold")
+			return
+		}
+	}
+
 	// 使用指针类型直接传递，nil 表示未提供该字段
 	user, err := h.adminService.UpdateUser(c.Request.Context(), userID, &service.UpdateUserInput{
 		Email:         req.Email,
@@ -183,6 +232,12 @@ func (h *UserHandler) Update(c *gin.Context) {
 		Concurrency:   req.Concurrency,
 		Status:        req.Status,
 		AllowedGroups: req.AllowedGroups,
+		Role:          req.Role,
+		RateLimits: service.RequestRateLimitsUpdate{
+			RPM:       req.RPMLimit,
+			InputTPM:  req.InputTPMLimit,
+			OutputTPM: req.OutputTPMLimit,
+		},
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -225,12 +280,48 @@ func (h *UserHandler) UpdateBalance(c *gin.Context) {
 		return
 	}
 
-	user, err := h.adminService.UpdateUserBalance(c.Request.Context(), userID, req.Balance, req.Operation, req.Notes)
+	// 记录调整前余额供审计日志使用
+	var beforeBalance any
+	current, err := h.adminService.GetUser(c.Request.Context(), userID)
+	if err == nil && current != nil {
+		beforeBalance = current.Balance
+	}
+
+	var actorUserID int64
+	if subject, ok := middleware.GetAuthSubjectFromContext(c); ok {
+		actorUserID = subject.UserID
+	}
+
+	var user *service.User
+	adjust := func(ctx context.Context) error {
+		var adjustErr error
+		user, adjustErr = h.adminService.UpdateUserBalance(ctx, userID, req.Balance, req.Operation, req.Notes, actorUserID)
+		return adjustErr
+	}
+
+	// 仅持有 balance:adjust 的角色（如客服）受 24 小时累计调整额度限制
+	if !middleware.HasAdminPermission(c, service.AdminPermissionBalanceAdjustUnlimited) {
+		if current == nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		delta := req.Balance
+		if req.Operation == "set" {
+			delta = math.Abs(req.Balance - current.Balance)
+		}
+		err = h.balanceLedgerService.AdjustWithinQuota(c.Request.Context(), actorUserID, delta, h.supportBalanceAdjustLimit(), adjust)
+	} else {
+		err = adjust(c.Request.Context())
+	}
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
 
+	middleware.SetAuditChanges(c,
+		map[string]any{"balance": beforeBalance},
+		map[string]any{"balance": user.Balance, "operation": req.Operation, "amount": req.Balance, "notes": req.Notes},
+	)
 	response.Success(c, dto.UserFromServiceAdmin(user))
 }
 
@@ -277,3 +368,10 @@ func (h *UserHandler) GetUserUsage(c *gin.Context) {
 
 	response.Success(c, stats)
 }
+
+func (h *UserHandler) supportBalanceAdjustLimit() float64 {
+	if h.cfg == nil {
+		return 0
+	}
+	return h.cfg.AdminRoles.SupportBalanceAdjustLimit
+}
@@ -3,6 +3,7 @@ package handler
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
@@ -32,6 +33,21 @@ type CreateAPIKeyRequest struct {
 	CustomKey   *string  `json:"custom_key"`   // 可选的自定义key
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	QuotaUSD        *float64   `json:"quota_usd"`         // 总花费上限（USD）
+	DailyLimitUSD   *float64   `json:"daily_limit_usd"`   // 每日花费上限（USD）
+	MonthlyLimitUSD *float64   `json:"monthly_limit_usd"` // 每月花费上限（USD）
+	ExpiresAt       *time.Time `json:"expires_at"`        // 过期时间
+
+	AllowedModels []string          `json:"allowed_models"` // 模型白名单（支持 * 通配符）
+	ModelAliases  map[string]string `json:"model_aliases"`  // 模型别名，如 {"fast": "claude-haiku-4-5"}
+
+	RPMLimit       int `json:"rpm_limit" binding:"omitempty,min=0"`        // 每分钟请求数上限（0 不限制）
+	InputTPMLimit  int `json:"input_tpm_limit" binding:"omitempty,min=0"`  // 每分钟输入 token 上限
+	OutputTPMLimit int `json:"output_tpm_limit" binding:"omitempty,min=0"` // 每分钟输出 token 上限
+
+	ResponseCacheEnabled    bool `json:"response_cache_enabled"`                               // 缓存 temperature=0 的确定性请求
+	ResponseCacheTTLSeconds int  `json:"response_cache_ttl_seconds" binding:"omitempty,min=0"` // 缓存有效期（秒，0 使用默认值）
 }
 
 // UpdateAPIKeyRequest represents the update API key request payload
@@ -41,6 +57,21 @@ type UpdateAPIKeyRequest struct {
 	Status      string   `json:"status" binding:"omitempty,oneof=active inactive"`
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	QuotaUSD        *float64 `json:"quota_usd"`         // 总花费上限（0 清除，不传则不修改）
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`   // 每日花费上限（0 清除，不传则不修改）
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"` // 每月花费上限（0 清除，不传则不修改）
+	ExpiresAt       *string  `json:"expires_at"`        // 过期时间 RFC3339（空字符串清除，不传则不修改）
+
+	AllowedModels []string          `json:"allowed_models"` // 模型白名单（空数组清除，不传则不修改）
+	ModelAliases  map[string]string `json:"model_aliases"`  // 模型别名（空对象清除，不传则不修改）
+
+	RPMLimit       *int `json:"rpm_limit" binding:"omitempty,min=0"`        // 每分钟请求数上限（0 清除，不传则不修改）
+	InputTPMLimit  *int `json:"input_tpm_limit" binding:"omitempty,min=0"`  // 每分钟输入 token 上限
+	OutputTPMLimit *int `json:"output_tpm_limit" binding:"omitempty,min=0"` // 每分钟输出 token 上限
+
+	ResponseCacheEnabled    *bool `json:"response_cache_enabled"`                               // 响应缓存开关（不传则不修改）
+	ResponseCacheTTLSeconds *int  `json:"response_cache_ttl_seconds" binding:"omitempty,min=0"` // 缓存有效期（秒，不传则不修改）
 }
 
 // List handles listing user's API keys with pagination
@@ -119,6 +150,21 @@ func (h *APIKeyHandler) Create(c *gin.Context) {
 		CustomKey:   req.CustomKey,
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		QuotaUSD:        req.QuotaUSD,
+		DailyLimitUSD:   req.DailyLimitUSD,
+		MonthlyLimitUSD: req.MonthlyLimitUSD,
+		ExpiresAt:       req.ExpiresAt,
+
+		AllowedModels: req.AllowedModels,
+		ModelAliases:  req.ModelAliases,
+
+		RPMLimit:       req.RPMLimit,
+		InputTPMLimit:  req.InputTPMLimit,
+		OutputTPMLimit: req.OutputTPMLimit,
+
+		ResponseCacheEnabled:    req.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds: req.ResponseCacheTTLSeconds,
 	}
 	key, err := h.apiKeyService.Create(c.Request.Context(), subject.UserID, svcReq)
 	if err != nil {
@@ -153,6 +199,32 @@ func (h *APIKeyHandler) Update(c *gin.Context) {
 	svcReq := service.UpdateAPIKeyRequest{
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		QuotaUSD:        req.QuotaUSD,
+		DailyLimitUSD:   req.DailyLimitUSD,
+		MonthlyLimitUSD: req.MonthlyLimitUSD,
+
+		AllowedModels: req.AllowedModels,
+		ModelAliases:  req.ModelAliases,
+
+		RPMLimit:       req.RPMLimit,
+		InputTPMLimit:  req.InputTPMLimit,
+		OutputTPMLimit: req.OutputTPMLimit,
+
+		ResponseCacheEnabled:    req.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds: req.ResponseCacheTTLSeconds,
+	}
+	if req.ExpiresAt != nil {
+		if *req.ExpiresAt == "" {
+			svcReq.ClearExpiresAt = true
+		} else {
+			expiresAt, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+			if err != nil {
+				response.BadRequest(c, "Invalid expires_at: "+err.Error())
+				return
+			}
+			svcReq.ExpiresAt = &expiresAt
+		}
 	}
 	if req.Name != "" {
 		svcReq.Name = &req.Name
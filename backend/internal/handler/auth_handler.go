@@ -267,6 +267,8 @@ func (h *AuthHandler) GetCurrentUser(c *gin.Context) {
 	type UserResponse struct {
 		*dto.User
 		RunMode string `json:"run_mode"`
+		// Permissions 管理端角色的权限点，前端据此显示管理菜单
+		Permissions []string `json:"permissions,omitempty"`
 	}
 
 	runMode := config.RunModeStandard
@@ -274,7 +276,11 @@ func (h *AuthHandler) GetCurrentUser(c *gin.Context) {
 		runMode = h.cfg.RunMode
 	}
 
-	response.Success(c, UserResponse{User: dto.UserFromService(user), RunMode: runMode})
+	out := UserResponse{User: dto.UserFromService(user), RunMode: runMode}
+	if user.IsStaff() {
+		out.Permissions = service.AdminPermissionsForRole(user.Role).List()
+	}
+	response.Success(c, out)
 }
 
 // ValidatePromoCodeRequest 验证优惠码请求
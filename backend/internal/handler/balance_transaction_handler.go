@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BalanceTransactionHandler handles the current user's balance history
+type BalanceTransactionHandler struct {
+	balanceLedgerService *service.BalanceLedgerService
+}
+
+// NewBalanceTransactionHandler creates a new user balance transaction handler
+func NewBalanceTransactionHandler(balanceLedgerService *service.BalanceLedgerService) *BalanceTransactionHandler {
+	return &BalanceTransactionHandler{
+		balanceLedgerService: balanceLedgerService,
+	}
+}
+
+// List handles listing the current user's balance transactions
+// GET /api/v1/user/transactions
+func (h *BalanceTransactionHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	filter := service.BalanceTransactionFilter{
+		SourceType: strings.TrimSpace(c.Query("source_type")),
+	}
+
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return
+		}
+		filter.StartTime = &t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return
+		}
+		t = t.Add(24*time.Hour - time.Nanosecond)
+		filter.EndTime = &t
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	txs, result, err := h.balanceLedgerService.ListByUser(c.Request.Context(), subject.UserID, params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.BalanceTransaction, 0, len(txs))
+	for i := range txs {
+		out = append(out, *dto.BalanceTransactionFromService(&txs[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
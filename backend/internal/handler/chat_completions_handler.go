@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// ChatCompletionsHandler 提供 OpenAI Chat Completions 兼容入口。
+// 请求被转换为 Claude Messages（Anthropic/Gemini/Antigravity 分组）或 OpenAI Responses（OpenAI 分组），
+// 然后交给现有的 GatewayHandler / OpenAIGatewayHandler 处理，复用其账号调度、故障转移与计费逻辑；
+// 响应通过 chatCompletionsWriter 转换回 chat.completion / chat.completion.chunk。
+type ChatCompletionsHandler struct {
+	gatewayHandler       *GatewayHandler
+	openaiGatewayHandler *OpenAIGatewayHandler
+}
+
+// NewChatCompletionsHandler creates a new ChatCompletionsHandler
+func NewChatCompletionsHandler(gatewayHandler *GatewayHandler, openaiGatewayHandler *OpenAIGatewayHandler) *ChatCompletionsHandler {
+	return &ChatCompletionsHandler{
+		gatewayHandler:       gatewayHandler,
+		openaiGatewayHandler: openaiGatewayHandler,
+	}
+}
+
+// ChatCompletions handles OpenAI Chat Completions compatible endpoint
+// POST /v1/chat/completions
+func (h *ChatCompletionsHandler) ChatCompletions(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			h.errorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to read request body")
+		return
+	}
+	if len(body) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Request body is empty")
+		return
+	}
+
+	req, err := openai.ParseChatCompletionRequest(body)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	platform := ""
+	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
+		platform = forcePlatform
+	} else if apiKey.Group != nil {
+		platform = apiKey.Group.Platform
+	}
+
+	var (
+		converted []byte
+		converter openai.ChatResponseConverter
+		next      gin.HandlerFunc
+	)
+	if platform == service.PlatformOpenAI {
+		converted, err = openai.ChatToResponsesRequest(req)
+		converter = openai.NewResponsesToChatConverter(req.Model, req.IncludeUsage())
+		next = h.openaiGatewayHandler.Responses
+	} else {
+		converted, err = openai.ChatToClaudeRequest(req)
+		converter = openai.NewClaudeToChatConverter(req.Model, req.IncludeUsage())
+		next = h.gatewayHandler.Messages
+	}
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(converted))
+	c.Request.ContentLength = int64(len(converted))
+
+	w := newChatCompletionsWriter(c.Writer, converter, req.Stream)
+	c.Writer = w
+	defer func() {
+		w.finish()
+		c.Writer = w.ResponseWriter
+	}()
+
+	next(c)
+}
+
+// errorResponse returns OpenAI API format error response
+func (h *ChatCompletionsHandler) errorResponse(c *gin.Context, status int, errType, message string) {
+	c.Data(status, "application/json", openai.BuildChatError(errType, message))
+}
+
+// chatCompletionsWriter 拦截下游 handler 写出的原生响应并转换为 Chat Completions 格式。
+//   - 流式成功响应：按 SSE 事件增量转换后立即写出
+//   - 非流式或错误响应：先缓冲，finish 时整体转换
+type chatCompletionsWriter struct {
+	gin.ResponseWriter
+	converter openai.ChatResponseConverter
+	stream    bool
+
+	decided   bool
+	streaming bool
+	pending   bytes.Buffer
+	buffered  bytes.Buffer
+}
+
+func newChatCompletionsWriter(w gin.ResponseWriter, converter openai.ChatResponseConverter, stream bool) *chatCompletionsWriter {
+	return &chatCompletionsWriter{ResponseWriter: w, converter: converter, stream: stream}
+}
+
+func (w *chatCompletionsWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	w.streaming = w.stream && w.Status() < http.StatusBadRequest
+	if w.streaming {
+		w.Header().Del("Content-Length")
+		w.Header().Set("Content-Type", "text/event-stream")
+	}
+}
+
+func (w *chatCompletionsWriter) Write(b []byte) (int, error) {
+	w.decide()
+	if !w.streaming {
+		return w.buffered.Write(b)
+	}
+	w.pending.Write(b)
+	if err := w.drainEvents(); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (w *chatCompletionsWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush 仅在流式模式下透传，缓冲模式下避免提前发送响应头
+func (w *chatCompletionsWriter) Flush() {
+	if w.decided && w.streaming {
+		w.ResponseWriter.Flush()
+	}
+}
+
+// drainEvents 从 pending 中取出完整的 SSE 事件（以空行分隔）并转换写出
+func (w *chatCompletionsWriter) drainEvents() error {
+	for {
+		data := w.pending.Bytes()
+		idx := bytes.Index(data, []byte("\n\n"))
+		if idx < 0 {
+			return nil
+		}
+		raw := string(data[:idx])
+		w.pending.Next(idx + 2)
+		if out := w.convertEvent(raw); len(out) > 0 {
+			if _, err := w.ResponseWriter.Write(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (w *chatCompletionsWriter) convertEvent(raw string) []byte {
+	var event string
+	var dataLines []string
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if len(dataLines) == 0 {
+		// SSE 注释（keep-alive ping）原样转发
+		if strings.HasPrefix(strings.TrimSpace(raw), ":") {
+			return []byte(":\n\n")
+		}
+		return nil
+	}
+	data := strings.Join(dataLines, "\n")
+	if data == "[DONE]" {
+		return w.converter.FinishStream()
+	}
+	if gjson.Get(data, "type").String() == "ping" {
+		return []byte(":\n\n")
+	}
+	return w.converter.ConvertStreamEvent(event, data)
+}
+
+// finish 在下游 handler 返回后调用，输出缓冲内容或补齐流式结尾
+func (w *chatCompletionsWriter) finish() {
+	if !w.decided {
+		return
+	}
+	if w.streaming {
+		if w.pending.Len() > 0 {
+			w.pending.WriteString("\n\n")
+			_ = w.drainEvents()
+		}
+		// 仅在成功写出过事件时补齐结尾；已由 FinishStream 结束的流不会重复输出
+		if out := w.converter.FinishStream(); len(out) > 0 {
+			_, _ = w.ResponseWriter.Write(out)
+		}
+		w.ResponseWriter.Flush()
+		return
+	}
+
+	body := w.buffered.Bytes()
+	status := w.Status()
+	w.Header().Del("Content-Length")
+	if status >= http.StatusBadRequest {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.ResponseWriter.Write(convertChatErrorBody(body))
+		return
+	}
+	converted, err := w.converter.ConvertResponse(body)
+	if err != nil {
+		_, _ = w.ResponseWriter.Write(body)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.ResponseWriter.Write(converted)
+}
+
+// convertChatErrorBody 将 Claude/Google 格式的错误体统一为 OpenAI 格式
+func convertChatErrorBody(body []byte) []byte {
+	parsed := gjson.ParseBytes(body)
+	errObj := parsed.Get("error")
+	if !errObj.Exists() {
+		return openai.BuildChatError("upstream_error", strings.TrimSpace(string(body)))
+	}
+	if !errObj.IsObject() {
+		return openai.BuildChatError("upstream_error", errObj.String())
+	}
+	errType := errObj.Get("type").String()
+	if errType == "" {
+		errType = errObj.Get("status").String()
+	}
+	if errType == "" {
+		errType = "upstream_error"
+	}
+	return openai.BuildChatError(errType, errObj.Get("message").String())
+}
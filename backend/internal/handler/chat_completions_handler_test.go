@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatCompletionsWriter_StreamSplitAcrossWrites(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	w := newChatCompletionsWriter(c.Writer, openai.NewClaudeToChatConverter("claude-sonnet-4-5", false), true)
+	c.Writer = w
+
+	c.Header("Content-Type", "text/event-stream")
+	_, _ = c.Writer.WriteString("data: {\"type\": \"ping\"}\n\n")
+	_, _ = c.Writer.WriteString("event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"id\":\"msg_1\"}}\n\nevent: content_block_delta\ndata: {\"type\":\"content_block_")
+	_, _ = c.Writer.WriteString("delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\"Hi\"}}\n\n")
+	_, _ = c.Writer.WriteString("event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n")
+	w.finish()
+
+	body := rec.Body.String()
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, body, `"content":"Hi"`)
+	require.Contains(t, body, `"finish_reason":"stop"`)
+	require.Equal(t, 1, strings.Count(body, openai.ChatStreamDone))
+	require.NotContains(t, body, "message_start")
+}
+
+func TestChatCompletionsWriter_ConvertsClaudeError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	w := newChatCompletionsWriter(c.Writer, openai.NewClaudeToChatConverter("claude-sonnet-4-5", false), true)
+	c.Writer = w
+
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"type":  "error",
+		"error": gin.H{"type": "rate_limit_error", "message": "slow down"},
+	})
+	w.finish()
+
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `{"error":{"type":"rate_limit_error","message":"slow down","code":null}}`, rec.Body.String())
+}
+
+func TestChatCompletionsWriter_NonStreamResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+
+	w := newChatCompletionsWriter(c.Writer, openai.NewResponsesToChatConverter("gpt-5.1", false), false)
+	c.Writer = w
+
+	c.Data(http.StatusOK, "application/json", []byte(`{"id":"resp_1","status":"completed","output":[{"type":"message","content":[{"type":"output_text","text":"ok"}]}]}`))
+	w.finish()
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"object":"chat.completion"`)
+	require.Contains(t, rec.Body.String(), `"content":"ok"`)
+}
@@ -12,16 +12,19 @@ func UserFromServiceShallow(u *service.User) *User {
 		return nil
 	}
 	return &User{
-		ID:            u.ID,
-		Email:         u.Email,
-		Username:      u.Username,
-		Role:          u.Role,
-		Balance:       u.Balance,
-		Concurrency:   u.Concurrency,
-		Status:        u.Status,
-		AllowedGroups: u.AllowedGroups,
-		CreatedAt:     u.CreatedAt,
-		UpdatedAt:     u.UpdatedAt,
+		ID:             u.ID,
+		Email:          u.Email,
+		Username:       u.Username,
+		Role:           u.Role,
+		Balance:        u.Balance,
+		Concurrency:    u.Concurrency,
+		Status:         u.Status,
+		AllowedGroups:  u.AllowedGroups,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+		RPMLimit:       u.RateLimits.RPM,
+		InputTPMLimit:  u.RateLimits.InputTPM,
+		OutputTPMLimit: u.RateLimits.OutputTPM,
 	}
 }
 
@@ -67,19 +70,35 @@ func APIKeyFromService(k *service.APIKey) *APIKey {
 	if k == nil {
 		return nil
 	}
+	now := time.Now()
 	return &APIKey{
-		ID:          k.ID,
-		UserID:      k.UserID,
-		Key:         k.Key,
-		Name:        k.Name,
-		GroupID:     k.GroupID,
-		Status:      k.Status,
-		IPWhitelist: k.IPWhitelist,
-		IPBlacklist: k.IPBlacklist,
-		CreatedAt:   k.CreatedAt,
-		UpdatedAt:   k.UpdatedAt,
-		User:        UserFromServiceShallow(k.User),
-		Group:       GroupFromServiceShallow(k.Group),
+		ID:              k.ID,
+		UserID:          k.UserID,
+		Key:             k.Key,
+		Name:            k.Name,
+		GroupID:         k.GroupID,
+		Status:          k.Status,
+		IPWhitelist:     k.IPWhitelist,
+		IPBlacklist:     k.IPBlacklist,
+		CreatedAt:       k.CreatedAt,
+		UpdatedAt:       k.UpdatedAt,
+		AllowedModels:   k.AllowedModels,
+		ModelAliases:    k.ModelAliases,
+		QuotaUSD:        k.QuotaUSD,
+		DailyLimitUSD:   k.DailyLimitUSD,
+		MonthlyLimitUSD: k.MonthlyLimitUSD,
+		ExpiresAt:       k.ExpiresAt,
+		QuotaUsedUSD:    k.QuotaUsedUSD,
+		DailyUsageUSD:   k.CurrentDailyUsage(now),
+		MonthlyUsageUSD: k.CurrentMonthlyUsage(now),
+		RPMLimit:        k.RateLimits.RPM,
+		InputTPMLimit:   k.RateLimits.InputTPM,
+		OutputTPMLimit:  k.RateLimits.OutputTPM,
+		User:            UserFromServiceShallow(k.User),
+		Group:           GroupFromServiceShallow(k.Group),
+
+		ResponseCacheEnabled:    k.ResponseCache.Enabled,
+		ResponseCacheTTLSeconds: k.ResponseCache.TTLSeconds,
 	}
 }
 
@@ -138,8 +157,16 @@ func groupFromServiceBase(g *service.Group) Group {
 		ImagePrice4K:     g.ImagePrice4K,
 		ClaudeCodeOnly:   g.ClaudeCodeOnly,
 		FallbackGroupID:  g.FallbackGroupID,
-		CreatedAt:        g.CreatedAt,
-		UpdatedAt:        g.UpdatedAt,
+		RPMLimit:         g.RateLimits.RPM,
+		InputTPMLimit:    g.RateLimits.InputTPM,
+		OutputTPMLimit:   g.RateLimits.OutputTPM,
+
+		ResponseCacheEnabled:    g.ResponseCache.Enabled,
+		ResponseCacheTTLSeconds: g.ResponseCache.TTLSeconds,
+		ResponseCacheCostRatio:  g.ResponseCacheCostRatio,
+
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
 	}
 }
 
@@ -156,6 +183,7 @@ func AccountFromServiceShallow(a *service.Account) *Account {
 		Credentials:             a.Credentials,
 		Extra:                   a.Extra,
 		ProxyID:                 a.ProxyID,
+		ProxyPoolID:             a.ProxyPoolID,
 		Concurrency:             a.Concurrency,
 		Priority:                a.Priority,
 		RateMultiplier:          a.BillingRateMultiplier(),
@@ -264,8 +292,11 @@ func ProxyFromService(p *service.Proxy) *Proxy {
 		Username:  p.Username,
 		Password:  p.Password,
 		Status:    p.Status,
+		PoolID:    p.PoolID,
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
+
+		SubscriptionID: p.SubscriptionID,
 	}
 }
 
@@ -284,6 +315,81 @@ func ProxyWithAccountCountFromService(p *service.ProxyWithAccountCount) *ProxyWi
 		CountryCode:    p.CountryCode,
 		Region:         p.Region,
 		City:           p.City,
+
+		HealthStatus:        p.HealthStatus,
+		HealthMessage:       p.HealthMessage,
+		ErrorRate:           p.ErrorRate,
+		ConsecutiveFailures: p.ConsecutiveFailures,
+		ForwardErrors:       p.ForwardErrors,
+		HealthCheckedAt:     p.HealthCheckedAt,
+	}
+}
+
+func ProxyPoolFromService(p *service.ProxyPool) *ProxyPool {
+	if p == nil {
+		return nil
+	}
+	proxyIDs := p.ProxyIDs
+	if proxyIDs == nil {
+		proxyIDs = []int64{}
+	}
+	return &ProxyPool{
+		ID:           p.ID,
+		Name:         p.Name,
+		Description:  p.Description,
+		Policy:       p.Policy,
+		Status:       p.Status,
+		ProxyIDs:     proxyIDs,
+		AccountCount: p.AccountCount,
+		HealthyCount: p.HealthyCount,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+func ProxySubscriptionFromService(s *service.ProxySubscription) *ProxySubscription {
+	if s == nil {
+		return nil
+	}
+	return &ProxySubscription{
+		ID:                     s.ID,
+		Name:                   s.Name,
+		URL:                    s.URL,
+		RefreshIntervalMinutes: s.RefreshIntervalMinutes,
+		PoolID:                 s.PoolID,
+		Status:                 s.Status,
+		ProxyCount:             s.ProxyCount,
+		LastSyncedAt:           s.LastSyncedAt,
+		LastError:              s.LastError,
+		LastTotal:              s.LastTotal,
+		LastCreated:            s.LastCreated,
+		LastSkipped:            s.LastSkipped,
+		LastDisabled:           s.LastDisabled,
+		CreatedAt:              s.CreatedAt,
+		UpdatedAt:              s.UpdatedAt,
+	}
+}
+
+func ProxyImportResultFromService(r *service.ProxyImportResult) *ProxyImportResult {
+	if r == nil {
+		return nil
+	}
+	skipped := make([]ProxyImportSkipped, 0, len(r.Skipped))
+	for _, s := range r.Skipped {
+		skipped = append(skipped, ProxyImportSkipped{Entry: s.Entry, Reason: s.Reason})
+	}
+	proxyIDs := r.ProxyIDs
+	if proxyIDs == nil {
+		proxyIDs = []int64{}
+	}
+	return &ProxyImportResult{
+		Total:       r.Total,
+		Created:     r.Created,
+		Duplicated:  r.Duplicated,
+		Reactivated: r.Reactivated,
+		Disabled:    r.Disabled,
+		Skipped:     skipped,
+		ProxyIDs:    proxyIDs,
 	}
 }
 
@@ -360,6 +466,7 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		Model:                 l.Model,
 		GroupID:               l.GroupID,
 		SubscriptionID:        l.SubscriptionID,
+		OrganizationID:        l.OrganizationID,
 		InputTokens:           l.InputTokens,
 		OutputTokens:          l.OutputTokens,
 		CacheCreationTokens:   l.CacheCreationTokens,
@@ -380,6 +487,7 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		ImageCount:            l.ImageCount,
 		ImageSize:             l.ImageSize,
 		UserAgent:             l.UserAgent,
+		TraceID:               l.TraceID,
 		CreatedAt:             l.CreatedAt,
 		User:                  UserFromServiceShallow(l.User),
 		APIKey:                APIKeyFromService(l.APIKey),
@@ -442,6 +550,44 @@ func UsageCleanupTaskFromService(task *service.UsageCleanupTask) *UsageCleanupTa
 	}
 }
 
+func UsageExportTaskFromService(task *service.UsageExportTask) *UsageExportTask {
+	if task == nil {
+		return nil
+	}
+	out := &UsageExportTask{
+		ID:          task.ID,
+		Status:      task.Status,
+		Format:      task.Format,
+		Destination: task.Destination,
+		Filters: UsageExportFilters{
+			StartTime:   task.Filters.StartTime,
+			EndTime:     task.Filters.EndTime,
+			UserID:      task.Filters.UserID,
+			APIKeyID:    task.Filters.APIKeyID,
+			AccountID:   task.Filters.AccountID,
+			GroupID:     task.Filters.GroupID,
+			Model:       task.Filters.Model,
+			Stream:      task.Filters.Stream,
+			BillingType: task.Filters.BillingType,
+		},
+		CreatedBy:    task.CreatedBy,
+		RowsExported: task.RowsExported,
+		FileSize:     task.FileSize,
+		FileName:     task.DownloadName(),
+		ObjectKey:    task.ObjectKey,
+		ErrorMessage: task.ErrorMsg,
+		StartedAt:    task.StartedAt,
+		FinishedAt:   task.FinishedAt,
+		CreatedAt:    task.CreatedAt,
+		UpdatedAt:    task.UpdatedAt,
+	}
+	if task.ScheduledFor != nil {
+		day := task.ScheduledFor.UTC().Format("2006-01-02")
+		out.ScheduledFor = &day
+	}
+	return out
+}
+
 func SettingFromService(s *service.Setting) *Setting {
 	if s == nil {
 		return nil
@@ -491,6 +637,11 @@ func userSubscriptionFromServiceBase(sub *service.UserSubscription) UserSubscrip
 		DailyUsageUSD:      sub.DailyUsageUSD,
 		WeeklyUsageUSD:     sub.WeeklyUsageUSD,
 		MonthlyUsageUSD:    sub.MonthlyUsageUSD,
+		PlanID:             sub.PlanID,
+		AutoRenew:          sub.AutoRenew,
+		DailyLimitUSD:      sub.DailyLimitUSD,
+		WeeklyLimitUSD:     sub.WeeklyLimitUSD,
+		MonthlyLimitUSD:    sub.MonthlyLimitUSD,
 		CreatedAt:          sub.CreatedAt,
 		UpdatedAt:          sub.UpdatedAt,
 		User:               UserFromServiceShallow(sub.User),
@@ -545,3 +696,306 @@ func PromoCodeUsageFromService(u *service.PromoCodeUsage) *PromoCodeUsage {
 		User:        UserFromServiceShallow(u.User),
 	}
 }
+
+func OrganizationFromService(o *service.Organization) *Organization {
+	if o == nil {
+		return nil
+	}
+	return &Organization{
+		ID:          o.ID,
+		Name:        o.Name,
+		OwnerUserID: o.OwnerUserID,
+		Balance:     o.Balance,
+		Concurrency: o.Concurrency,
+		Status:      o.Status,
+		Notes:       o.Notes,
+		MemberCount: o.MemberCount,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+		Owner:       UserFromServiceShallow(o.Owner),
+	}
+}
+
+func OrganizationMemberFromService(m *service.OrganizationMember) *OrganizationMember {
+	if m == nil {
+		return nil
+	}
+	return &OrganizationMember{
+		ID:              m.ID,
+		OrganizationID:  m.OrganizationID,
+		UserID:          m.UserID,
+		Role:            m.Role,
+		MonthlyLimitUSD: m.MonthlyLimitUSD,
+		MonthlyUsageUSD: m.CurrentMonthlyUsage(time.Now()),
+		JoinedAt:        m.JoinedAt,
+		User:            UserFromServiceShallow(m.User),
+	}
+}
+
+func BalanceTransactionFromService(t *service.BalanceTransaction) *BalanceTransaction {
+	if t == nil {
+		return nil
+	}
+	return &BalanceTransaction{
+		ID:           t.ID,
+		UserID:       t.UserID,
+		Amount:       t.Amount,
+		BalanceAfter: t.BalanceAfter,
+		SourceType:   t.SourceType,
+		ReferenceID:  t.ReferenceID,
+		CreatedAt:    t.CreatedAt,
+	}
+}
+
+// BalanceTransactionFromServiceAdmin 管理员视图，包含操作者与备注
+func BalanceTransactionFromServiceAdmin(t *service.BalanceTransaction) *BalanceTransaction {
+	out := BalanceTransactionFromService(t)
+	if out == nil {
+		return nil
+	}
+	out.ActorUserID = t.ActorUserID
+	out.Notes = t.Notes
+	return out
+}
+
+func OrganizationBalanceTransactionFromService(t *service.OrganizationBalanceTransaction) *OrganizationBalanceTransaction {
+	if t == nil {
+		return nil
+	}
+	return &OrganizationBalanceTransaction{
+		ID:             t.ID,
+		OrganizationID: t.OrganizationID,
+		Amount:         t.Amount,
+		BalanceAfter:   t.BalanceAfter,
+		SourceType:     t.SourceType,
+		ReferenceID:    t.ReferenceID,
+		ActorUserID:    t.ActorUserID,
+		Notes:          t.Notes,
+		CreatedAt:      t.CreatedAt,
+	}
+}
+
+func AuditLogFromService(l *service.AuditLog) *AuditLog {
+	if l == nil {
+		return nil
+	}
+	return &AuditLog{
+		ID:          l.ID,
+		ActorUserID: l.ActorUserID,
+		ActorType:   l.ActorType,
+		Action:      l.Action,
+		TargetType:  l.TargetType,
+		TargetID:    l.TargetID,
+		Before:      l.Before,
+		After:       l.After,
+		StatusCode:  l.StatusCode,
+		IPAddress:   l.IPAddress,
+		UserAgent:   l.UserAgent,
+		RequestID:   l.RequestID,
+		CreatedAt:   l.CreatedAt,
+	}
+}
+
+func AdminAPIKeyFromService(k *service.AdminAPIKey) *AdminAPIKey {
+	if k == nil {
+		return nil
+	}
+	scopes := k.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &AdminAPIKey{
+		ID:         k.ID,
+		Name:       k.Name,
+		KeyPrefix:  k.KeyPrefix,
+		Scopes:     scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		CreatedBy:  k.CreatedBy,
+		Expired:    k.IsExpired(time.Now()),
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func PaymentProviderFromService(p service.PaymentProviderInfo) PaymentProvider {
+	methods := p.Methods
+	if methods == nil {
+		methods = []string{}
+	}
+	return PaymentProvider{
+		Name:           p.Name,
+		Currency:       p.Currency,
+		RateMultiplier: p.RateMultiplier,
+		Methods:        methods,
+	}
+}
+
+func PaymentOrderFromService(o *service.PaymentOrder) *PaymentOrder {
+	if o == nil {
+		return nil
+	}
+	out := paymentOrderFromServiceBase(o)
+	return &out
+}
+
+// PaymentOrderFromServiceAdmin includes notes and session id - user-facing endpoints must not use this.
+func PaymentOrderFromServiceAdmin(o *service.PaymentOrder) *AdminPaymentOrder {
+	if o == nil {
+		return nil
+	}
+	return &AdminPaymentOrder{
+		PaymentOrder:      paymentOrderFromServiceBase(o),
+		ProviderSessionID: o.ProviderSessionID,
+		Notes:             o.Notes,
+		User:              UserFromServiceShallow(o.User),
+	}
+}
+
+func paymentOrderFromServiceBase(o *service.PaymentOrder) PaymentOrder {
+	return PaymentOrder{
+		ID:             o.ID,
+		OrderNo:        o.OrderNo,
+		UserID:         o.UserID,
+		Provider:       o.Provider,
+		Method:         o.Method,
+		Status:         o.Status,
+		Amount:         o.Amount,
+		Currency:       o.Currency,
+		RateMultiplier: o.RateMultiplier,
+		CreditAmount:   o.CreditAmount,
+		PlanID:         o.PlanID,
+		PlanName:       o.PlanName,
+		ProviderTxnID:  o.ProviderTxnID,
+		CheckoutURL:    o.CheckoutURL,
+		FailureReason:  o.FailureReason,
+		PaidAt:         o.PaidAt,
+		RefundedAt:     o.RefundedAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+}
+
+func SubscriptionPlanFromService(p *service.SubscriptionPlan) *SubscriptionPlan {
+	if p == nil {
+		return nil
+	}
+	return &SubscriptionPlan{
+		ID:              p.ID,
+		Name:            p.Name,
+		Description:     p.Description,
+		GroupID:         p.GroupID,
+		ValidityDays:    p.ValidityDays,
+		Price:           p.Price,
+		DailyLimitUSD:   p.DailyLimitUSD,
+		WeeklyLimitUSD:  p.WeeklyLimitUSD,
+		MonthlyLimitUSD: p.MonthlyLimitUSD,
+		Status:          p.Status,
+		SortOrder:       p.SortOrder,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		Group:           GroupFromServiceShallow(p.Group),
+	}
+}
+
+func ModelPriceFromService(p *service.ModelPrice, now time.Time) *ModelPrice {
+	if p == nil {
+		return nil
+	}
+	return &ModelPrice{
+		ID:                     p.ID,
+		GroupID:                p.GroupID,
+		Model:                  p.Model,
+		InputPrice:             p.InputPrice,
+		OutputPrice:            p.OutputPrice,
+		CacheWritePrice:        p.CacheWritePrice,
+		CacheReadPrice:         p.CacheReadPrice,
+		LongContextThreshold:   p.LongContextThreshold,
+		LongContextInputPrice:  p.LongContextInputPrice,
+		LongContextOutputPrice: p.LongContextOutputPrice,
+		EffectiveFrom:          p.EffectiveFrom,
+		Scheduled:              !p.InEffect(now),
+		Notes:                  p.Notes,
+		CreatedAt:              p.CreatedAt,
+		UpdatedAt:              p.UpdatedAt,
+		Group:                  GroupFromServiceShallow(p.Group),
+	}
+}
+
+// ModelPricingPreviewFromService 将 per-token 价格换算为每百万 token 价格
+func ModelPricingPreviewFromService(model string, p *service.ModelPricing) *ModelPricingPreview {
+	if p == nil {
+		return nil
+	}
+	return &ModelPricingPreview{
+		Model:                  model,
+		Source:                 p.Source,
+		PriceID:                p.PriceID,
+		InputPrice:             p.InputPricePerToken * 1_000_000,
+		OutputPrice:            p.OutputPricePerToken * 1_000_000,
+		CacheWritePrice:        p.CacheCreationPricePerToken * 1_000_000,
+		CacheReadPrice:         p.CacheReadPricePerToken * 1_000_000,
+		LongContextThreshold:   p.LongContextThreshold,
+		LongContextInputPrice:  p.LongContextInputPricePerToken * 1_000_000,
+		LongContextOutputPrice: p.LongContextOutputPricePerToken * 1_000_000,
+	}
+}
+
+func InvoiceFromService(inv *service.Invoice) *Invoice {
+	if inv == nil {
+		return nil
+	}
+	out := &Invoice{
+		ID:              inv.ID,
+		Number:          inv.Number,
+		SubjectType:     inv.SubjectType,
+		SubjectID:       inv.SubjectID,
+		RecipientUserID: inv.RecipientUserID,
+		Period:          inv.PeriodLabel(),
+		PeriodStart:     inv.PeriodStart,
+		PeriodEnd:       inv.PeriodEnd,
+		Currency:        inv.Currency,
+		Items:           make([]InvoiceLineItem, 0, len(inv.Items)),
+		TopUps:          make([]InvoiceTopUp, 0, len(inv.TopUps)),
+		Subtotal:        inv.Subtotal,
+		TaxLabel:        inv.Issuer.TaxLabel,
+		TaxRate:         inv.TaxRate,
+		TaxAmount:       inv.TaxAmount,
+		Total:           inv.Total,
+		TopUpTotal:      inv.TopUpTotal,
+		BillToName:      inv.BillTo.Name,
+		BillToEmail:     inv.BillTo.Email,
+		Status:          inv.Status,
+		EmailedAt:       inv.EmailedAt,
+		EmailError:      inv.EmailError,
+		CreatedBy:       inv.CreatedBy,
+		CreatedAt:       inv.CreatedAt,
+		UpdatedAt:       inv.UpdatedAt,
+	}
+	for _, item := range inv.Items {
+		out.Items = append(out.Items, InvoiceLineItem{
+			Kind:                item.Kind,
+			Description:         item.Description,
+			Model:               item.Model,
+			GroupID:             item.GroupID,
+			GroupName:           item.GroupName,
+			SubscriptionCovered: item.SubscriptionCovered,
+			Requests:            item.Requests,
+			InputTokens:         item.InputTokens,
+			OutputTokens:        item.OutputTokens,
+			CacheCreationTokens: item.CacheCreationTokens,
+			CacheReadTokens:     item.CacheReadTokens,
+			ValidityDays:        item.ValidityDays,
+			Cost:                item.Cost,
+			Amount:              item.Amount,
+		})
+	}
+	for _, t := range inv.TopUps {
+		out.TopUps = append(out.TopUps, InvoiceTopUp{
+			Source:    t.Source,
+			Reference: t.Reference,
+			Amount:    t.Amount,
+			PaidAt:    t.PaidAt,
+		})
+	}
+	return out
+}
@@ -62,6 +62,22 @@ type SystemSettings struct {
 	CreemProductID               string  `json:"creem_product_id"`
 	CreemRateMultiplier          float64 `json:"creem_rate_multiplier"`
 	CreemSuccessURL              string  `json:"creem_success_url"`
+
+	// Stripe Checkout
+	StripeEnabled                 bool    `json:"stripe_enabled"`
+	StripeSecretKeyConfigured     bool    `json:"stripe_secret_key_configured"`
+	StripeWebhookSecretConfigured bool    `json:"stripe_webhook_secret_configured"`
+	StripeCurrency                string  `json:"stripe_currency"`
+	StripeRateMultiplier          float64 `json:"stripe_rate_multiplier"`
+
+	// EPay 易支付
+	EPayEnabled               bool    `json:"epay_enabled"`
+	EPayGatewayURL            string  `json:"epay_gateway_url"`
+	EPayMerchantID            string  `json:"epay_merchant_id"`
+	EPayMerchantKeyConfigured bool    `json:"epay_merchant_key_configured"`
+	EPayPayTypes              string  `json:"epay_pay_types"`
+	EPayCurrency              string  `json:"epay_currency"`
+	EPayRateMultiplier        float64 `json:"epay_rate_multiplier"`
 }
 
 type PublicSettings struct {
@@ -92,3 +108,29 @@ type StreamTimeoutSettings struct {
 	ThresholdCount         int    `json:"threshold_count"`
 	ThresholdWindowMinutes int    `json:"threshold_window_minutes"`
 }
+
+// UnknownModelPricingSettings 未知模型计费策略 DTO
+type UnknownModelPricingSettings struct {
+	Policy          string  `json:"policy"`
+	InputPrice      float64 `json:"input_price"`
+	OutputPrice     float64 `json:"output_price"`
+	CacheWritePrice float64 `json:"cache_write_price"`
+	CacheReadPrice  float64 `json:"cache_read_price"`
+}
+
+// InvoiceSettings 发票配置 DTO
+type InvoiceSettings struct {
+	AutoGenerate   bool    `json:"auto_generate"`
+	AutoEmail      bool    `json:"auto_email"`
+	NumberPrefix   string  `json:"number_prefix"`
+	NextNumber     int64   `json:"next_number"`
+	NumberPadding  int     `json:"number_padding"`
+	Currency       string  `json:"currency"`
+	TaxRate        float64 `json:"tax_rate"`
+	TaxLabel       string  `json:"tax_label"`
+	CompanyName    string  `json:"company_name"`
+	CompanyAddress string  `json:"company_address"`
+	CompanyTaxID   string  `json:"company_tax_id"`
+	CompanyEmail   string  `json:"company_email"`
+	FooterNote     string  `json:"footer_note"`
+}
@@ -14,6 +14,11 @@ type User struct {
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 
+	// RPM/TPM 限制（0 表示不限制）
+	RPMLimit       int `json:"rpm_limit"`
+	InputTPMLimit  int `json:"input_tpm_limit"`
+	OutputTPMLimit int `json:"output_tpm_limit"`
+
 	APIKeys       []APIKey           `json:"api_keys,omitempty"`
 	Subscriptions []UserSubscription `json:"subscriptions,omitempty"`
 }
@@ -38,6 +43,28 @@ type APIKey struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 
+	// 模型白名单与别名（null 表示不限制）
+	AllowedModels []string          `json:"allowed_models"`
+	ModelAliases  map[string]string `json:"model_aliases"`
+
+	// 花费上限（USD，null 表示不限制）与当前用量
+	QuotaUSD        *float64   `json:"quota_usd"`
+	DailyLimitUSD   *float64   `json:"daily_limit_usd"`
+	MonthlyLimitUSD *float64   `json:"monthly_limit_usd"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	QuotaUsedUSD    float64    `json:"quota_used_usd"`
+	DailyUsageUSD   float64    `json:"daily_usage_usd"`
+	MonthlyUsageUSD float64    `json:"monthly_usage_usd"`
+
+	// RPM/TPM 限制（0 表示不限制）
+	RPMLimit       int `json:"rpm_limit"`
+	InputTPMLimit  int `json:"input_tpm_limit"`
+	OutputTPMLimit int `json:"output_tpm_limit"`
+
+	// 响应缓存（仅缓存 temperature=0 的确定性请求）
+	ResponseCacheEnabled    bool `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int  `json:"response_cache_ttl_seconds"`
+
 	User  *User  `json:"user,omitempty"`
 	Group *Group `json:"group,omitempty"`
 }
@@ -65,6 +92,16 @@ type Group struct {
 	ClaudeCodeOnly  bool   `json:"claude_code_only"`
 	FallbackGroupID *int64 `json:"fallback_group_id"`
 
+	// RPM/TPM 限制（分组内共享，0 表示不限制）
+	RPMLimit       int `json:"rpm_limit"`
+	InputTPMLimit  int `json:"input_tpm_limit"`
+	OutputTPMLimit int `json:"output_tpm_limit"`
+
+	// 响应缓存（命中按 response_cache_cost_ratio 比例计费，0 表示免费）
+	ResponseCacheEnabled    bool    `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int     `json:"response_cache_ttl_seconds"`
+	ResponseCacheCostRatio  float64 `json:"response_cache_cost_ratio"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -91,6 +128,7 @@ type Account struct {
 	Credentials        map[string]any `json:"credentials"`
 	Extra              map[string]any `json:"extra"`
 	ProxyID            *int64         `json:"proxy_id"`
+	ProxyPoolID        *int64         `json:"proxy_pool_id"`
 	Concurrency        int            `json:"concurrency"`
 	Priority           int            `json:"priority"`
 	RateMultiplier     float64        `json:"rate_multiplier"`
@@ -160,8 +198,11 @@ type Proxy struct {
 	Username  string    `json:"username"`
 	Password  string    `json:"-"`
 	Status    string    `json:"status"`
+	PoolID    *int64    `json:"pool_id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	SubscriptionID *int64 `json:"subscription_id"`
 }
 
 type ProxyWithAccountCount struct {
@@ -175,6 +216,59 @@ type ProxyWithAccountCount struct {
 	CountryCode    string `json:"country_code,omitempty"`
 	Region         string `json:"region,omitempty"`
 	City           string `json:"city,omitempty"`
+
+	HealthStatus        string     `json:"health_status,omitempty"`
+	HealthMessage       string     `json:"health_message,omitempty"`
+	ErrorRate           *float64   `json:"error_rate,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	ForwardErrors       int        `json:"forward_errors"`
+	HealthCheckedAt     *time.Time `json:"health_checked_at,omitempty"`
+}
+
+type ProxyPool struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Policy       string    `json:"policy"`
+	Status       string    `json:"status"`
+	ProxyIDs     []int64   `json:"proxy_ids"`
+	AccountCount int64     `json:"account_count"`
+	HealthyCount int       `json:"healthy_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type ProxySubscription struct {
+	ID                     int64      `json:"id"`
+	Name                   string     `json:"name"`
+	URL                    string     `json:"url"`
+	RefreshIntervalMinutes int        `json:"refresh_interval_minutes"`
+	PoolID                 *int64     `json:"pool_id"`
+	Status                 string     `json:"status"`
+	ProxyCount             int64      `json:"proxy_count"`
+	LastSyncedAt           *time.Time `json:"last_synced_at"`
+	LastError              string     `json:"last_error"`
+	LastTotal              int        `json:"last_total"`
+	LastCreated            int        `json:"last_created"`
+	LastSkipped            int        `json:"last_skipped"`
+	LastDisabled           int        `json:"last_disabled"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+}
+
+type ProxyImportSkipped struct {
+	Entry  string `json:"entry"`
+	Reason string `json:"reason"`
+}
+
+type ProxyImportResult struct {
+	Total       int                  `json:"total"`
+	Created     int                  `json:"created"`
+	Duplicated  int                  `json:"duplicated"`
+	Reactivated int                  `json:"reactivated"`
+	Disabled    int                  `json:"disabled"`
+	Skipped     []ProxyImportSkipped `json:"skipped"`
+	ProxyIDs    []int64              `json:"proxy_ids"`
 }
 
 type ProxyAccountSummary struct {
@@ -221,6 +315,7 @@ type UsageLog struct {
 
 	GroupID        *int64 `json:"group_id"`
 	SubscriptionID *int64 `json:"subscription_id"`
+	OrganizationID *int64 `json:"organization_id,omitempty"`
 
 	InputTokens         int `json:"input_tokens"`
 	OutputTokens        int `json:"output_tokens"`
@@ -250,6 +345,9 @@ type UsageLog struct {
 	// User-Agent
 	UserAgent *string `json:"user_agent"`
 
+	// TraceID 链路追踪 ID（与响应头 X-Trace-Id 一致）
+	TraceID *string `json:"trace_id"`
+
 	CreatedAt time.Time `json:"created_at"`
 
 	User         *User             `json:"user,omitempty"`
@@ -299,6 +397,28 @@ type UsageCleanupTask struct {
 	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
+// UsageExportFilters 导出条件与清理任务一致，end_time 为开区间
+type UsageExportFilters = UsageCleanupFilters
+
+type UsageExportTask struct {
+	ID           int64              `json:"id"`
+	Status       string             `json:"status"`
+	Format       string             `json:"format"`
+	Destination  string             `json:"destination"`
+	Filters      UsageExportFilters `json:"filters"`
+	CreatedBy    *int64             `json:"created_by,omitempty"`
+	ScheduledFor *string            `json:"scheduled_for,omitempty"`
+	RowsExported int64              `json:"rows_exported"`
+	FileSize     int64              `json:"file_size"`
+	FileName     string             `json:"file_name"`
+	ObjectKey    *string            `json:"object_key,omitempty"`
+	ErrorMessage *string            `json:"error_message,omitempty"`
+	StartedAt    *time.Time         `json:"started_at,omitempty"`
+	FinishedAt   *time.Time         `json:"finished_at,omitempty"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
 // AccountSummary is a minimal account info for usage log display.
 // It intentionally excludes sensitive fields like Credentials, Proxy, etc.
 type AccountSummary struct {
@@ -330,6 +450,13 @@ type UserSubscription struct {
 	WeeklyUsageUSD  float64 `json:"weekly_usage_usd"`
 	MonthlyUsageUSD float64 `json:"monthly_usage_usd"`
 
+	// 套餐订阅：来源套餐、自动续费开关与限额快照（为空表示沿用分组限额）
+	PlanID          *int64   `json:"plan_id"`
+	AutoRenew       bool     `json:"auto_renew"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
@@ -380,3 +507,238 @@ type PromoCodeUsage struct {
 
 	User *User `json:"user,omitempty"`
 }
+
+// Organization 组织（共享钱包与并发池）
+type Organization struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	OwnerUserID int64     `json:"owner_user_id"`
+	Balance     float64   `json:"balance"`
+	Concurrency int       `json:"concurrency"`
+	Status      string    `json:"status"`
+	Notes       string    `json:"notes"`
+	MemberCount int       `json:"member_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	Owner *User `json:"owner,omitempty"`
+}
+
+// OrganizationMember 组织成员
+type OrganizationMember struct {
+	ID              int64     `json:"id"`
+	OrganizationID  int64     `json:"organization_id"`
+	UserID          int64     `json:"user_id"`
+	Role            string    `json:"role"`
+	MonthlyLimitUSD *float64  `json:"monthly_limit_usd"`
+	MonthlyUsageUSD float64   `json:"monthly_usage_usd"`
+	JoinedAt        time.Time `json:"joined_at"`
+
+	User *User `json:"user,omitempty"`
+}
+
+// BalanceTransaction 余额流水（用户视图不包含操作者与备注）
+type BalanceTransaction struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	Amount       float64   `json:"amount"`
+	BalanceAfter float64   `json:"balance_after"`
+	SourceType   string    `json:"source_type"`
+	ReferenceID  string    `json:"reference_id"`
+	ActorUserID  *int64    `json:"actor_user_id,omitempty"`
+	Notes        string    `json:"notes,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// OrganizationBalanceTransaction 组织钱包流水
+type OrganizationBalanceTransaction struct {
+	ID             int64     `json:"id"`
+	OrganizationID int64     `json:"organization_id"`
+	Amount         float64   `json:"amount"`
+	BalanceAfter   float64   `json:"balance_after"`
+	SourceType     string    `json:"source_type"`
+	ReferenceID    string    `json:"reference_id"`
+	ActorUserID    *int64    `json:"actor_user_id,omitempty"`
+	Notes          string    `json:"notes,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// AuditLog 管理员操作审计记录
+type AuditLog struct {
+	ID          int64          `json:"id"`
+	ActorUserID *int64         `json:"actor_user_id"`
+	ActorType   string         `json:"actor_type"`
+	Action      string         `json:"action"`
+	TargetType  string         `json:"target_type"`
+	TargetID    string         `json:"target_id"`
+	Before      map[string]any `json:"before,omitempty"`
+	After       map[string]any `json:"after,omitempty"`
+	StatusCode  int            `json:"status_code"`
+	IPAddress   string         `json:"ip_address"`
+	UserAgent   string         `json:"user_agent"`
+	RequestID   string         `json:"request_id,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// AdminAPIKey 管理端 API Key（不含明文）
+type AdminAPIKey struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	KeyPrefix  string     `json:"key_prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedBy  *int64     `json:"created_by"`
+	Expired    bool       `json:"expired"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Invoice 月度发票（明细为开具时的快照；Logo 等大字段不在接口中返回）
+type Invoice struct {
+	ID              int64             `json:"id"`
+	Number          string            `json:"number"`
+	SubjectType     string            `json:"subject_type"`
+	SubjectID       int64             `json:"subject_id"`
+	RecipientUserID int64             `json:"recipient_user_id"`
+	Period          string            `json:"period"`
+	PeriodStart     time.Time         `json:"period_start"`
+	PeriodEnd       time.Time         `json:"period_end"`
+	Currency        string            `json:"currency"`
+	Items           []InvoiceLineItem `json:"items"`
+	TopUps          []InvoiceTopUp    `json:"top_ups"`
+	Subtotal        float64           `json:"subtotal"`
+	TaxLabel        string            `json:"tax_label"`
+	TaxRate         float64           `json:"tax_rate"`
+	TaxAmount       float64           `json:"tax_amount"`
+	Total           float64           `json:"total"`
+	TopUpTotal      float64           `json:"top_up_total"`
+	BillToName      string            `json:"bill_to_name"`
+	BillToEmail     string            `json:"bill_to_email"`
+	Status          string            `json:"status"`
+	EmailedAt       *time.Time        `json:"emailed_at,omitempty"`
+	EmailError      *string           `json:"email_error,omitempty"`
+	CreatedBy       *int64            `json:"created_by,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
+// InvoiceLineItem 发票明细行
+type InvoiceLineItem struct {
+	Kind                string  `json:"kind"`
+	Description         string  `json:"description"`
+	Model               string  `json:"model,omitempty"`
+	GroupID             *int64  `json:"group_id,omitempty"`
+	GroupName           string  `json:"group_name,omitempty"`
+	SubscriptionCovered bool    `json:"subscription_covered"`
+	Requests            int64   `json:"requests"`
+	InputTokens         int64   `json:"input_tokens"`
+	OutputTokens        int64   `json:"output_tokens"`
+	CacheCreationTokens int64   `json:"cache_creation_tokens"`
+	CacheReadTokens     int64   `json:"cache_read_tokens"`
+	ValidityDays        int     `json:"validity_days,omitempty"`
+	Cost                float64 `json:"cost"`
+	Amount              float64 `json:"amount"`
+}
+
+// InvoiceTopUp 账期内的充值记录
+type InvoiceTopUp struct {
+	Source    string    `json:"source"`
+	Reference string    `json:"reference"`
+	Amount    float64   `json:"amount"`
+	PaidAt    time.Time `json:"paid_at"`
+}
+
+// PaymentProvider 前端可选的支付渠道
+type PaymentProvider struct {
+	Name           string   `json:"name"`
+	Currency       string   `json:"currency"`
+	RateMultiplier float64  `json:"rate_multiplier"`
+	Methods        []string `json:"methods"`
+}
+
+// PaymentOrder 是普通用户接口使用的充值订单 DTO（不包含管理员备注）
+type PaymentOrder struct {
+	ID             int64      `json:"id"`
+	OrderNo        string     `json:"order_no"`
+	UserID         int64      `json:"user_id"`
+	Provider       string     `json:"provider"`
+	Method         string     `json:"method"`
+	Status         string     `json:"status"`
+	Amount         float64    `json:"amount"`
+	Currency       string     `json:"currency"`
+	RateMultiplier float64    `json:"rate_multiplier"`
+	CreditAmount   float64    `json:"credit_amount"`
+	PlanID         *int64     `json:"plan_id"`
+	PlanName       string     `json:"plan_name,omitempty"`
+	ProviderTxnID  string     `json:"provider_txn_id"`
+	CheckoutURL    string     `json:"checkout_url"`
+	FailureReason  string     `json:"failure_reason"`
+	PaidAt         *time.Time `json:"paid_at"`
+	RefundedAt     *time.Time `json:"refunded_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// AdminPaymentOrder 是管理员对账使用的充值订单 DTO
+type AdminPaymentOrder struct {
+	PaymentOrder
+
+	ProviderSessionID string `json:"provider_session_id"`
+	Notes             string `json:"notes"`
+	User              *User  `json:"user,omitempty"`
+}
+
+// SubscriptionPlan 订阅套餐；限额为 null 表示沿用分组配置
+type SubscriptionPlan struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	GroupID         int64     `json:"group_id"`
+	ValidityDays    int       `json:"validity_days"`
+	Price           float64   `json:"price"`
+	DailyLimitUSD   *float64  `json:"daily_limit_usd"`
+	WeeklyLimitUSD  *float64  `json:"weekly_limit_usd"`
+	MonthlyLimitUSD *float64  `json:"monthly_limit_usd"`
+	Status          string    `json:"status"`
+	SortOrder       int       `json:"sort_order"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	Group *Group `json:"group,omitempty"`
+}
+
+// ModelPrice 模型价格版本，价格单位 USD / 百万 token；group_id 为 null 表示全局覆盖
+type ModelPrice struct {
+	ID                     int64     `json:"id"`
+	GroupID                *int64    `json:"group_id"`
+	Model                  string    `json:"model"`
+	InputPrice             float64   `json:"input_price"`
+	OutputPrice            float64   `json:"output_price"`
+	CacheWritePrice        float64   `json:"cache_write_price"`
+	CacheReadPrice         float64   `json:"cache_read_price"`
+	LongContextThreshold   int       `json:"long_context_threshold"`
+	LongContextInputPrice  *float64  `json:"long_context_input_price"`
+	LongContextOutputPrice *float64  `json:"long_context_output_price"`
+	EffectiveFrom          time.Time `json:"effective_from"`
+	// Scheduled 尚未生效（仅此状态可修改）
+	Scheduled bool      `json:"scheduled"`
+	Notes     string    `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Group *Group `json:"group,omitempty"`
+}
+
+// ModelPricingPreview 模型在某分组下当前生效的计费价格，单位 USD / 百万 token
+type ModelPricingPreview struct {
+	Model                  string  `json:"model"`
+	Source                 string  `json:"source"`
+	PriceID                *int64  `json:"price_id,omitempty"`
+	InputPrice             float64 `json:"input_price"`
+	OutputPrice            float64 `json:"output_price"`
+	CacheWritePrice        float64 `json:"cache_write_price"`
+	CacheReadPrice         float64 `json:"cache_read_price"`
+	LongContextThreshold   int     `json:"long_context_threshold"`
+	LongContextInputPrice  float64 `json:"long_context_input_price"`
+	LongContextOutputPrice float64 `json:"long_context_output_price"`
+}
@@ -17,6 +17,7 @@ import (
 	pkgerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -30,6 +31,8 @@ type GatewayHandler struct {
 	antigravityGatewayService *service.AntigravityGatewayService
 	userService               *service.UserService
 	billingCacheService       *service.BillingCacheService
+	requestRateLimitService   *service.RequestRateLimitService
+	responseCacheService      *service.ResponseCacheService
 	concurrencyHelper         *ConcurrencyHelper
 	maxAccountSwitches        int
 	maxAccountSwitchesGemini  int
@@ -43,6 +46,8 @@ func NewGatewayHandler(
 	userService *service.UserService,
 	concurrencyService *service.ConcurrencyService,
 	billingCacheService *service.BillingCacheService,
+	requestRateLimitService *service.RequestRateLimitService,
+	responseCacheService *service.ResponseCacheService,
 	cfg *config.Config,
 ) *GatewayHandler {
 	pingInterval := time.Duration(0)
@@ -63,6 +68,8 @@ func NewGatewayHandler(
 		antigravityGatewayService: antigravityGatewayService,
 		userService:               userService,
 		billingCacheService:       billingCacheService,
+		requestRateLimitService:   requestRateLimitService,
+		responseCacheService:      responseCacheService,
 		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, SSEPingFormatClaude, pingInterval),
 		maxAccountSwitches:        maxAccountSwitches,
 		maxAccountSwitchesGemini:  maxAccountSwitchesGemini,
@@ -122,6 +129,37 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		return
 	}
 
+	// API Key 级别的模型别名与白名单（在账号调度前解析）
+	if resolved, ok := h.resolveAPIKeyModel(c, apiKey, parsedReq); !ok {
+		return
+	} else if resolved != reqModel {
+		body = parsedReq.Body
+		reqModel = resolved
+		setOpsRequestContext(c, reqModel, reqStream, body)
+	}
+
+	// 未知模型策略为 reject 时，没有任何价格的模型在转发前拒绝
+	if err := h.gatewayService.CheckModelPricing(apiKey.GroupID, reqModel); err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", modelPriceUnknownMessage(reqModel))
+		return
+	}
+
+	// 用户/API Key/分组级别的 RPM/TPM 限制
+	rateLimit, ok := acquireRequestRateLimit(c, h.requestRateLimitService, apiKey, body, func(message string) {
+		h.errorResponse(c, http.StatusTooManyRequests, "rate_limit_error", message)
+	})
+	if !ok {
+		return
+	}
+	defer settleRequestRateLimit(c, h.requestRateLimitService, rateLimit)
+
+	// 确定性请求的响应缓存：命中直接回放，未命中在请求结束后写入缓存
+	cacheServed, cacheFinish := serveResponseCache(c, h.responseCacheService, h.billingCacheService, apiKey, reqModel, body)
+	if cacheServed {
+		return
+	}
+	defer cacheFinish()
+
 	// Track if we've started streaming (for error handling)
 	streamStarted := false
 
@@ -167,6 +205,20 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		defer userReleaseFunc()
 	}
 
+	// 1.1 组织成员额外占用组织共享并发池
+	if org := apiKey.User.Organization; org != nil && org.Concurrency > 0 {
+		orgReleaseFunc, err := h.concurrencyHelper.AcquireOrganizationSlotWithWait(c, org.OrganizationID, org.Concurrency, reqStream, &streamStarted)
+		if err != nil {
+			log.Printf("Organization concurrency acquire failed: %v", err)
+			h.handleConcurrencyError(c, err, "organization", streamStarted)
+			return
+		}
+		orgReleaseFunc = wrapReleaseOnDone(c.Request.Context(), orgReleaseFunc)
+		if orgReleaseFunc != nil {
+			defer orgReleaseFunc()
+		}
+	}
+
 	// 2. 【新增】Wait后二次检查余额/订阅
 	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
 		log.Printf("Billing eligibility check failed after wait: %v", err)
@@ -189,6 +241,9 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	if platform == service.PlatformGemini && sessionHash != "" {
 		sessionKey = "gemini:" + sessionHash
 	}
+	// 缓存感知路由：新会话优先落到最近为相同缓存前缀建立过缓存的账号
+	cachePrefixHash := h.gatewayService.GenerateCachePrefixHash(parsedReq)
+	h.gatewayService.SeedStickySessionFromCachePrefix(c.Request.Context(), apiKey.GroupID, sessionKey, cachePrefixHash)
 
 	if platform == service.PlatformGemini {
 		maxAccountSwitches := h.maxAccountSwitchesGemini
@@ -278,11 +333,13 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 
 			// 转发请求 - 根据账号平台分流
 			var result *service.ForwardResult
+			forwardCtx, forwardSpan := startForwardSpan(c, account, switchCount+1)
 			if account.Platform == service.PlatformAntigravity {
-				result, err = h.antigravityGatewayService.ForwardGemini(c.Request.Context(), c, account, reqModel, "generateContent", reqStream, body)
+				result, err = h.antigravityGatewayService.ForwardGemini(forwardCtx, c, account, reqModel, "generateContent", reqStream, body)
 			} else {
-				result, err = h.geminiCompatService.Forward(c.Request.Context(), c, account, body)
+				result, err = h.geminiCompatService.Forward(forwardCtx, c, account, body)
 			}
+			tracing.End(forwardSpan, err)
 			if accountReleaseFunc != nil {
 				accountReleaseFunc()
 			}
@@ -290,6 +347,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 				var failoverErr *service.UpstreamFailoverError
 				if errors.As(err, &failoverErr) {
 					failedAccountIDs[account.ID] = struct{}{}
+					recordUpstreamFailover(account.Platform, failoverErr)
 					lastFailoverStatus = failoverErr.StatusCode
 					if switchCount >= maxAccountSwitches {
 						h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
@@ -304,22 +362,26 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 				return
 			}
 
+			rateLimit.RecordUsage(result.Usage.InputTokens+result.Usage.CacheCreationInputTokens, result.Usage.OutputTokens)
+
 			// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 			userAgent := c.GetHeader("User-Agent")
 			clientIP := ip.GetClientIP(c)
 
 			// 异步记录使用量（subscription已在函数开头获取）
+			usageCtx := tracing.Detach(c.Request.Context())
 			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string) {
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
-					Result:       result,
-					APIKey:       apiKey,
-					User:         apiKey.User,
-					Account:      usedAccount,
-					Subscription: subscription,
-					UserAgent:    ua,
-					IPAddress:    clientIP,
+					Result:          result,
+					APIKey:          apiKey,
+					User:            apiKey.User,
+					Account:         usedAccount,
+					Subscription:    subscription,
+					UserAgent:       ua,
+					IPAddress:       clientIP,
+					CachePrefixHash: cachePrefixHash,
 				}); err != nil {
 					log.Printf("Record usage failed: %v", err)
 				}
@@ -414,11 +476,13 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 
 		// 转发请求 - 根据账号平台分流
 		var result *service.ForwardResult
+		forwardCtx, forwardSpan := startForwardSpan(c, account, switchCount+1)
 		if account.Platform == service.PlatformAntigravity {
-			result, err = h.antigravityGatewayService.Forward(c.Request.Context(), c, account, body)
+			result, err = h.antigravityGatewayService.Forward(forwardCtx, c, account, body)
 		} else {
-			result, err = h.gatewayService.Forward(c.Request.Context(), c, account, parsedReq)
+			result, err = h.gatewayService.Forward(forwardCtx, c, account, parsedReq)
 		}
+		tracing.End(forwardSpan, err)
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
@@ -426,6 +490,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			var failoverErr *service.UpstreamFailoverError
 			if errors.As(err, &failoverErr) {
 				failedAccountIDs[account.ID] = struct{}{}
+				recordUpstreamFailover(account.Platform, failoverErr)
 				lastFailoverStatus = failoverErr.StatusCode
 				if switchCount >= maxAccountSwitches {
 					h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
@@ -440,22 +505,26 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			return
 		}
 
+		rateLimit.RecordUsage(result.Usage.InputTokens+result.Usage.CacheCreationInputTokens, result.Usage.OutputTokens)
+
 		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
 
 		// 异步记录使用量（subscription已在函数开头获取）
+		usageCtx := tracing.Detach(c.Request.Context())
 		go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 			defer cancel()
 			if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
-				Result:       result,
-				APIKey:       apiKey,
-				User:         apiKey.User,
-				Account:      usedAccount,
-				Subscription: subscription,
-				UserAgent:    ua,
-				IPAddress:    clientIP,
+				Result:          result,
+				APIKey:          apiKey,
+				User:            apiKey.User,
+				Account:         usedAccount,
+				Subscription:    subscription,
+				UserAgent:       ua,
+				IPAddress:       clientIP,
+				CachePrefixHash: cachePrefixHash,
 			}); err != nil {
 				log.Printf("Record usage failed: %v", err)
 			}
@@ -483,6 +552,10 @@ func (h *GatewayHandler) Models(c *gin.Context) {
 	availableModels := h.gatewayService.GetAvailableModels(c.Request.Context(), groupID, "")
 
 	if len(availableModels) > 0 {
+		// Restrict to models permitted by the API key and append its aliases
+		if apiKey != nil && apiKey.HasModelPolicy() {
+			availableModels = apiKey.FilterModels(availableModels)
+		}
 		// Build model list from whitelist
 		models := make([]claude.Model, 0, len(availableModels))
 		for _, modelID := range availableModels {
@@ -504,26 +577,96 @@ func (h *GatewayHandler) Models(c *gin.Context) {
 	if platform == "openai" {
 		c.JSON(http.StatusOK, gin.H{
 			"object": "list",
-			"data":   openai.DefaultModels,
+			"data":   filterOpenAIModelsForAPIKey(apiKey, openai.DefaultModels),
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"object": "list",
-		"data":   claude.DefaultModels,
+		"data":   filterClaudeModelsForAPIKey(apiKey, claude.DefaultModels),
 	})
 }
 
-// AntigravityModels 返回 Antigravity 支持的全部模型
+// filterClaudeModelsForAPIKey 按 API Key 的模型白名单过滤默认模型列表，别名以独立条目追加
+func filterClaudeModelsForAPIKey(apiKey *service.APIKey, models []claude.Model) []claude.Model {
+	if apiKey == nil || !apiKey.HasModelPolicy() {
+		return models
+	}
+	byID := make(map[string]claude.Model, len(models))
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		byID[m.ID] = m
+		ids = append(ids, m.ID)
+	}
+	filtered := apiKey.FilterModels(ids)
+	out := make([]claude.Model, 0, len(filtered))
+	for _, id := range filtered {
+		if m, ok := byID[id]; ok {
+			out = append(out, m)
+			continue
+		}
+		out = append(out, claude.Model{ID: id, Type: "model", DisplayName: id, CreatedAt: "2024-01-01T00:00:00Z"})
+	}
+	return out
+}
+
+// filterOpenAIModelsForAPIKey 同 filterClaudeModelsForAPIKey，用于 OpenAI 默认模型列表
+func filterOpenAIModelsForAPIKey(apiKey *service.APIKey, models []openai.Model) []openai.Model {
+	if apiKey == nil || !apiKey.HasModelPolicy() {
+		return models
+	}
+	byID := make(map[string]openai.Model, len(models))
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		byID[m.ID] = m
+		ids = append(ids, m.ID)
+	}
+	filtered := apiKey.FilterModels(ids)
+	out := make([]openai.Model, 0, len(filtered))
+	for _, id := range filtered {
+		if m, ok := byID[id]; ok {
+			out = append(out, m)
+			continue
+		}
+		out = append(out, openai.Model{ID: id, Object: "model", OwnedBy: "openai", Type: "model", DisplayName: id})
+	}
+	return out
+}
+
+// AntigravityModels 返回 Antigravity 支持的模型（按 API Key 的模型白名单过滤）
 // GET /antigravity/models
 func (h *GatewayHandler) AntigravityModels(c *gin.Context) {
+	apiKey, _ := middleware2.GetAPIKeyFromContext(c)
 	c.JSON(http.StatusOK, gin.H{
 		"object": "list",
-		"data":   antigravity.DefaultModels(),
+		"data":   filterAntigravityModelsForAPIKey(apiKey, antigravity.DefaultModels()),
 	})
 }
 
+// filterAntigravityModelsForAPIKey 同 filterClaudeModelsForAPIKey，用于 Antigravity 模型列表
+func filterAntigravityModelsForAPIKey(apiKey *service.APIKey, models []antigravity.ClaudeModel) []antigravity.ClaudeModel {
+	if apiKey == nil || !apiKey.HasModelPolicy() {
+		return models
+	}
+	byID := make(map[string]antigravity.ClaudeModel, len(models))
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		byID[m.ID] = m
+		ids = append(ids, m.ID)
+	}
+	filtered := apiKey.FilterModels(ids)
+	out := make([]antigravity.ClaudeModel, 0, len(filtered))
+	for _, id := range filtered {
+		if m, ok := byID[id]; ok {
+			out = append(out, m)
+			continue
+		}
+		out = append(out, antigravity.ClaudeModel{ID: id, Type: "model", DisplayName: id, CreatedAt: "2024-01-01T00:00:00Z"})
+	}
+	return out
+}
+
 // Usage handles getting account balance for CC Switch integration
 // GET /v1/usage
 func (h *GatewayHandler) Usage(c *gin.Context) {
@@ -548,12 +691,14 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 		}
 
 		remaining := h.calculateSubscriptionRemaining(apiKey.Group, subscription)
-		c.JSON(http.StatusOK, gin.H{
+		resp := gin.H{
 			"isValid":   true,
 			"planName":  apiKey.Group.Name,
 			"remaining": remaining,
 			"unit":      "USD",
-		})
+		}
+		h.applyAPIKeyBudget(c, apiKey, resp, remaining)
+		c.JSON(http.StatusOK, resp)
 		return
 	}
 
@@ -564,12 +709,63 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
+	resp := gin.H{
 		"isValid":   true,
 		"planName":  "钱包余额",
 		"remaining": latestUser.Balance,
 		"unit":      "USD",
-	})
+	}
+	h.applyAPIKeyBudget(c, apiKey, resp, latestUser.Balance)
+	c.JSON(http.StatusOK, resp)
+}
+
+// applyAPIKeyBudget 在 /v1/usage 响应中附加 API Key 级别的花费上限信息，
+// 并将 remaining 收紧为计费额度与 Key 剩余预算中的较小值（-1 表示无限制）。
+func (h *GatewayHandler) applyAPIKeyBudget(c *gin.Context, apiKey *service.APIKey, resp gin.H, remaining float64) {
+	if !apiKey.HasSpendingLimit() && apiKey.ExpiresAt == nil {
+		return
+	}
+	budget := gin.H{"expiresAt": apiKey.ExpiresAt}
+	if apiKey.HasSpendingLimit() {
+		usage, err := h.billingCacheService.GetAPIKeyUsage(c.Request.Context(), apiKey.ID)
+		if err != nil {
+			log.Printf("Get api key usage failed for key %d: %v", apiKey.ID, err)
+			return
+		}
+		keyRemaining := calculateAPIKeyRemaining(apiKey, usage)
+		budget["quotaUsd"] = apiKey.QuotaUSD
+		budget["quotaUsed"] = usage.TotalUsage
+		budget["dailyLimitUsd"] = apiKey.DailyLimitUSD
+		budget["dailyUsage"] = usage.DailyUsage
+		budget["monthlyLimitUsd"] = apiKey.MonthlyLimitUSD
+		budget["monthlyUsage"] = usage.MonthlyUsage
+		budget["remaining"] = keyRemaining
+		if remaining < 0 || keyRemaining < remaining {
+			resp["remaining"] = keyRemaining
+		}
+	}
+	resp["apiKey"] = budget
+}
+
+// calculateAPIKeyRemaining 计算 API Key 剩余预算：取总/日/月上限剩余额度的最小值
+func calculateAPIKeyRemaining(apiKey *service.APIKey, usage *service.APIKeyUsageCacheData) float64 {
+	remaining := -1.0
+	consider := func(limit *float64, used float64) {
+		if limit == nil || *limit <= 0 {
+			return
+		}
+		left := *limit - used
+		if left < 0 {
+			left = 0
+		}
+		if remaining < 0 || left < remaining {
+			remaining = left
+		}
+	}
+	consider(apiKey.QuotaUSD, usage.TotalUsage)
+	consider(apiKey.DailyLimitUSD, usage.DailyUsage)
+	consider(apiKey.MonthlyLimitUSD, usage.MonthlyUsage)
+	return remaining
 }
 
 // calculateSubscriptionRemaining 计算订阅剩余可用额度
@@ -578,6 +774,7 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 // 2. 否则返回所有已配置周期中剩余额度的最小值
 func (h *GatewayHandler) calculateSubscriptionRemaining(group *service.Group, sub *service.UserSubscription) float64 {
 	var remainingValues []float64
+	group = sub.EffectiveGroup(group)
 
 	// 检查日限额
 	if group.HasDailyLimit() {
@@ -681,6 +878,26 @@ func (h *GatewayHandler) handleStreamingAwareError(c *gin.Context, status int, e
 	h.errorResponse(c, status, errType, message)
 }
 
+// resolveAPIKeyModel 按 API Key 的模型别名与白名单解析请求模型。
+// 命中别名时同步改写 parsedReq.Model 与 parsedReq.Body；不允许时写出错误响应并返回 false。
+func (h *GatewayHandler) resolveAPIKeyModel(c *gin.Context, apiKey *service.APIKey, parsedReq *service.ParsedRequest) (string, bool) {
+	resolved, err := apiKey.ResolveModel(parsedReq.Model)
+	if err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", modelNotAllowedMessage(parsedReq.Model))
+		return "", false
+	}
+	if resolved != parsedReq.Model {
+		body, err := rewriteRequestModel(parsedReq.Body, resolved)
+		if err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to process request body")
+			return "", false
+		}
+		parsedReq.Body = body
+		parsedReq.Model = resolved
+	}
+	return resolved, true
+}
+
 // errorResponse 返回Claude API格式的错误响应
 func (h *GatewayHandler) errorResponse(c *gin.Context, status int, errType, message string) {
 	c.JSON(status, gin.H{
@@ -739,7 +956,11 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 		return
 	}
 
-	setOpsRequestContext(c, parsedReq.Model, parsedReq.Stream, body)
+	if _, ok := h.resolveAPIKeyModel(c, apiKey, parsedReq); !ok {
+		return
+	}
+
+	setOpsRequestContext(c, parsedReq.Model, parsedReq.Stream, parsedReq.Body)
 
 	// 获取订阅信息（可能为nil）
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
@@ -3,17 +3,95 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/tidwall/sjson"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// startForwardSpan 为一次账号转发尝试创建 span（attempt 从 1 开始，故障切换后递增）
+func startForwardSpan(c *gin.Context, account *service.Account, attempt int) (context.Context, trace.Span) {
+	return tracing.Start(c.Request.Context(), "gateway.forward",
+		attribute.Int64("account.id", account.ID),
+		attribute.String("account.platform", account.Platform),
+		attribute.String("account.type", account.Type),
+		attribute.Int("gateway.attempt", attempt),
+	)
+}
+
+// rewriteRequestModel 将请求体中的 model 字段替换为别名解析后的真实模型
+func rewriteRequestModel(body []byte, model string) ([]byte, error) {
+	return sjson.SetBytes(body, "model", model)
+}
+
+// modelNotAllowedMessage 构造 API Key 模型白名单拒绝时的错误信息
+func modelNotAllowedMessage(model string) string {
+	return fmt.Sprintf("Model %q is not allowed for this API key", model)
+}
+
+// modelPriceUnknownMessage 构造未知模型策略为 reject 时的拒绝信息
+func modelPriceUnknownMessage(model string) string {
+	return fmt.Sprintf("Model %q has no configured price and cannot be billed", model)
+}
+
+// acquireRequestRateLimit 检查用户/API Key/分组的 RPM/TPM 限制（按请求体预占输入 token）并写入 x-ratelimit-* 响应头。
+// 被限流时设置 Retry-After 并通过 reject 以各协议的原生格式返回 429，返回 ok=false。
+// 通过时调用方需 defer settleRequestRateLimit，转发成功后通过 reservation.RecordUsage 记录实际用量。
+func acquireRequestRateLimit(c *gin.Context, svc *service.RequestRateLimitService, apiKey *service.APIKey, body []byte, reject func(message string)) (*service.RateLimitReservation, bool) {
+	reservation, err := svc.Acquire(c.Request.Context(), apiKey, body)
+	writeRateLimitHeaders(c.Writer.Header(), reservation)
+	if errors.Is(err, service.ErrRequestRateLimited) {
+		c.Header("Retry-After", strconv.Itoa(reservation.RetryAfterSeconds()))
+		reject(reservation.RejectMessage())
+		return nil, false
+	}
+	return reservation, true
+}
+
+// settleRequestRateLimit 请求结束时统一结算限流预占（每个请求只结算一次）：
+// 已记录用量时修正输入 token 预占并补记输出 token，否则释放预占。结算在后台完成，不阻塞响应。
+func settleRequestRateLimit(c *gin.Context, svc *service.RequestRateLimitService, reservation *service.RateLimitReservation) {
+	if reservation == nil {
+		return
+	}
+	ctx := tracing.Detach(c.Request.Context())
+	go svc.Settle(ctx, reservation)
+}
+
+// writeRateLimitHeaders 按最严格的主体写入 OpenAI 风格的 x-ratelimit-* 响应头
+func writeRateLimitHeaders(header http.Header, reservation *service.RateLimitReservation) {
+	requests, requestsOK, tokens, tokensOK := reservation.MostRestrictive()
+	if requestsOK {
+		header.Set("X-Ratelimit-Limit-Requests", strconv.Itoa(requests.Limit))
+		header.Set("X-Ratelimit-Remaining-Requests", strconv.Itoa(requests.Remaining))
+		header.Set("X-Ratelimit-Reset-Requests", formatRateLimitReset(requests.Reset))
+	}
+	if tokensOK {
+		header.Set("X-Ratelimit-Limit-Tokens", strconv.Itoa(tokens.Limit))
+		header.Set("X-Ratelimit-Remaining-Tokens", strconv.Itoa(tokens.Remaining))
+		header.Set("X-Ratelimit-Reset-Tokens", formatRateLimitReset(tokens.Reset))
+	}
+}
+
+// formatRateLimitReset 格式化为 "1s"、"1m0s" 形式（与 OpenAI 一致）
+func formatRateLimitReset(d time.Duration) string {
+	if d < time.Second {
+		d = time.Second
+	}
+	return d.Truncate(time.Second).String()
+}
+
 // claudeCodeValidator is a singleton validator for Claude Code client detection
 var claudeCodeValidator = service.NewClaudeCodeValidator()
 
@@ -173,6 +251,25 @@ func (h *ConcurrencyHelper) AcquireUserSlotWithWait(c *gin.Context, userID int64
 	return h.waitForSlotWithPing(c, "user", userID, maxConcurrency, isStream, streamStarted)
 }
 
+// AcquireOrganizationSlotWithWait acquires a slot from the organization's shared pool, waiting if necessary.
+// Called after the member's own user slot is held, so the org pool caps the whole team.
+func (h *ConcurrencyHelper) AcquireOrganizationSlotWithWait(c *gin.Context, organizationID int64, maxConcurrency int, isStream bool, streamStarted *bool) (func(), error) {
+	ctx := c.Request.Context()
+
+	// Try to acquire immediately
+	result, err := h.concurrencyService.AcquireOrganizationSlot(ctx, organizationID, maxConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Acquired {
+		return result.ReleaseFunc, nil
+	}
+
+	// Need to wait - handle streaming ping if needed
+	return h.waitForSlotWithPing(c, "organization", organizationID, maxConcurrency, isStream, streamStarted)
+}
+
 // AcquireAccountSlotWithWait acquires an account concurrency slot, waiting if necessary.
 // For streaming requests, sends ping events during the wait.
 // streamStarted is updated if streaming response has begun.
@@ -201,17 +298,23 @@ func (h *ConcurrencyHelper) waitForSlotWithPing(c *gin.Context, slotType string,
 
 // waitForSlotWithPingTimeout waits for a concurrency slot with a custom timeout.
 func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType string, id int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	ctx, span := tracing.Start(c.Request.Context(), "concurrency.wait_slot",
+		attribute.String("slot.type", slotType),
+		attribute.Int64("slot.id", id),
+		attribute.Int("slot.max_concurrency", maxConcurrency),
+		attribute.Int64("slot.timeout_ms", timeout.Milliseconds()),
+	)
+	releaseFunc, err := h.waitForSlot(ctx, c, slotType, id, maxConcurrency, timeout, isStream, streamStarted)
+	tracing.End(span, err)
+	return releaseFunc, err
+}
+
+func (h *ConcurrencyHelper) waitForSlot(parent context.Context, c *gin.Context, slotType string, id int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
 
 	// Try immediate acquire first (avoid unnecessary wait)
-	var result *service.AcquireResult
-	var err error
-	if slotType == "user" {
-		result, err = h.concurrencyService.AcquireUserSlot(ctx, id, maxConcurrency)
-	} else {
-		result, err = h.concurrencyService.AcquireAccountSlot(ctx, id, maxConcurrency)
-	}
+	result, err := h.tryAcquireSlot(ctx, slotType, id, maxConcurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -268,15 +371,7 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 
 		case <-timer.C:
 			// Try to acquire slot
-			var result *service.AcquireResult
-			var err error
-
-			if slotType == "user" {
-				result, err = h.concurrencyService.AcquireUserSlot(ctx, id, maxConcurrency)
-			} else {
-				result, err = h.concurrencyService.AcquireAccountSlot(ctx, id, maxConcurrency)
-			}
-
+			result, err := h.tryAcquireSlot(ctx, slotType, id, maxConcurrency)
 			if err != nil {
 				return nil, err
 			}
@@ -290,6 +385,18 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 	}
 }
 
+// tryAcquireSlot performs a single non-blocking acquire for the given slot type.
+func (h *ConcurrencyHelper) tryAcquireSlot(ctx context.Context, slotType string, id int64, maxConcurrency int) (*service.AcquireResult, error) {
+	switch slotType {
+	case "user":
+		return h.concurrencyService.AcquireUserSlot(ctx, id, maxConcurrency)
+	case "organization":
+		return h.concurrencyService.AcquireOrganizationSlot(ctx, id, maxConcurrency)
+	default:
+		return h.concurrencyService.AcquireAccountSlot(ctx, id, maxConcurrency)
+	}
+}
+
 // AcquireAccountSlotWithWaitTimeout acquires an account slot with a custom timeout (keeps SSE ping).
 func (h *ConcurrencyHelper) AcquireAccountSlotWithWaitTimeout(c *gin.Context, accountID int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
 	return h.waitForSlotWithPingTimeout(c, "account", accountID, maxConcurrency, timeout, isStream, streamStarted)
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GatewayMetricsMiddleware 记录网关请求数与耗时（按 platform/model/group/status）。
+// 未开启 metrics 时直接放行，不产生额外开销。
+func GatewayMetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !metrics.Enabled() {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+
+		apiKey, _ := middleware2.GetAPIKeyFromContext(c)
+		platform := resolveOpsPlatform(apiKey, guessPlatformFromPath(c.Request.URL.Path))
+		var model, group string
+		// 未通过认证的请求不记录 model，避免任意字符串放大 label 基数
+		if apiKey != nil {
+			if v, ok := c.Get(opsModelKey); ok {
+				model, _ = v.(string)
+			}
+			if apiKey.Group != nil {
+				group = apiKey.Group.Name
+			}
+		}
+		metrics.ObserveGatewayRequest(platform, model, group, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// recordUpstreamFailover 记录一次触发账号切换的上游故障
+func recordUpstreamFailover(platform string, failoverErr *service.UpstreamFailoverError) {
+	if failoverErr == nil {
+		return
+	}
+	metrics.IncUpstreamFailover(platform, failoverErr.StatusCode)
+}
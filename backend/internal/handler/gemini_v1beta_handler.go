@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"io"
 	"log"
@@ -17,10 +18,13 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/pkg/gemini"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/googleapi"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 )
 
 // geminiCLITmpDirRegex 用于从 Gemini CLI 请求体中提取 tmp 目录的哈希值
@@ -51,7 +55,9 @@ func (h *GatewayHandler) GeminiV1BetaListModels(c *gin.Context) {
 
 	// 强制 antigravity 模式：返回 antigravity 支持的模型列表
 	if forcePlatform == service.PlatformAntigravity {
-		c.JSON(http.StatusOK, antigravity.FallbackGeminiModelsList())
+		c.JSON(http.StatusOK, gemini.ModelsListResponse{
+			Models: filterGeminiModelsForAPIKey(apiKey, geminiModelsFromAntigravity(antigravity.DefaultGeminiModels())),
+		})
 		return
 	}
 
@@ -61,7 +67,7 @@ func (h *GatewayHandler) GeminiV1BetaListModels(c *gin.Context) {
 		hasAntigravity, _ := h.geminiCompatService.HasAntigravityAccounts(c.Request.Context(), apiKey.GroupID)
 		if hasAntigravity {
 			// antigravity 账户使用静态模型列表
-			c.JSON(http.StatusOK, gemini.FallbackModelsList())
+			c.JSON(http.StatusOK, gemini.ModelsListResponse{Models: filterGeminiModelsForAPIKey(apiKey, gemini.DefaultModels())})
 			return
 		}
 		googleError(c, http.StatusServiceUnavailable, "No available Gemini accounts: "+err.Error())
@@ -74,9 +80,20 @@ func (h *GatewayHandler) GeminiV1BetaListModels(c *gin.Context) {
 		return
 	}
 	if shouldFallbackGeminiModels(res) {
-		c.JSON(http.StatusOK, gemini.FallbackModelsList())
+		c.JSON(http.StatusOK, gemini.ModelsListResponse{Models: filterGeminiModelsForAPIKey(apiKey, gemini.DefaultModels())})
 		return
 	}
+	// 按 API Key 的模型白名单过滤上游列表并追加别名；响应无法解析时改用过滤后的静态列表，避免泄露未授权的模型
+	if apiKey.HasModelPolicy() && res.StatusCode >= 200 && res.StatusCode < 300 {
+		body, ok := filterGeminiUpstreamModelsForAPIKey(apiKey, res.Body)
+		if !ok {
+			c.JSON(http.StatusOK, gemini.ModelsListResponse{Models: filterGeminiModelsForAPIKey(apiKey, gemini.DefaultModels())})
+			return
+		}
+		filtered := *res
+		filtered.Body = body
+		res = &filtered
+	}
 	writeUpstreamResponse(c, res)
 }
 
@@ -100,6 +117,12 @@ func (h *GatewayHandler) GeminiV1BetaGetModel(c *gin.Context) {
 		googleError(c, http.StatusBadRequest, "Missing model in URL")
 		return
 	}
+	resolvedModel, err := apiKey.ResolveModel(modelName)
+	if err != nil {
+		googleError(c, http.StatusForbidden, modelNotAllowedMessage(modelName))
+		return
+	}
+	modelName = resolvedModel
 
 	// 强制 antigravity 模式：返回 antigravity 模型信息
 	if forcePlatform == service.PlatformAntigravity {
@@ -161,6 +184,20 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		return
 	}
 
+	// API Key 级别的模型别名与白名单（在账号调度前解析）
+	resolvedModel, err := apiKey.ResolveModel(modelName)
+	if err != nil {
+		googleError(c, http.StatusForbidden, modelNotAllowedMessage(modelName))
+		return
+	}
+	modelName = resolvedModel
+
+	// 未知模型策略为 reject 时，没有任何价格的模型在转发前拒绝
+	if err := h.gatewayService.CheckModelPricing(apiKey.GroupID, modelName); err != nil {
+		googleError(c, http.StatusForbidden, modelPriceUnknownMessage(modelName))
+		return
+	}
+
 	stream := action == "streamGenerateContent"
 
 	body, err := io.ReadAll(c.Request.Body)
@@ -179,6 +216,22 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 
 	setOpsRequestContext(c, modelName, stream, body)
 
+	// user/API key/group RPM & TPM limits
+	rateLimit, ok := acquireRequestRateLimit(c, h.requestRateLimitService, apiKey, body, func(message string) {
+		googleError(c, http.StatusTooManyRequests, message)
+	})
+	if !ok {
+		return
+	}
+	defer settleRequestRateLimit(c, h.requestRateLimitService, rateLimit)
+
+	// 确定性请求的响应缓存：命中直接回放，未命中在请求结束后写入缓存
+	cacheServed, cacheFinish := serveResponseCache(c, h.responseCacheService, h.billingCacheService, apiKey, modelName, body)
+	if cacheServed {
+		return
+	}
+	defer cacheFinish()
+
 	// Get subscription (may be nil)
 	subscription, _ := middleware.GetSubscriptionFromContext(c)
 
@@ -221,6 +274,19 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		defer userReleaseFunc()
 	}
 
+	// 1.1) organization shared concurrency pool
+	if org := apiKey.User.Organization; org != nil && org.Concurrency > 0 {
+		orgReleaseFunc, err := geminiConcurrency.AcquireOrganizationSlotWithWait(c, org.OrganizationID, org.Concurrency, stream, &streamStarted)
+		if err != nil {
+			googleError(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		orgReleaseFunc = wrapReleaseOnDone(c.Request.Context(), orgReleaseFunc)
+		if orgReleaseFunc != nil {
+			defer orgReleaseFunc()
+		}
+	}
+
 	// 2) billing eligibility check (after wait)
 	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
 		status, _, message := billingErrorDetails(err)
@@ -335,11 +401,13 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 
 		// 5) forward (根据平台分流)
 		var result *service.ForwardResult
+		forwardCtx, forwardSpan := startForwardSpan(c, account, switchCount+1)
 		if account.Platform == service.PlatformAntigravity {
-			result, err = h.antigravityGatewayService.ForwardGemini(c.Request.Context(), c, account, modelName, action, stream, body)
+			result, err = h.antigravityGatewayService.ForwardGemini(forwardCtx, c, account, modelName, action, stream, body)
 		} else {
-			result, err = h.geminiCompatService.ForwardNative(c.Request.Context(), c, account, modelName, action, stream, body)
+			result, err = h.geminiCompatService.ForwardNative(forwardCtx, c, account, modelName, action, stream, body)
 		}
+		tracing.End(forwardSpan, err)
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
@@ -347,6 +415,7 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 			var failoverErr *service.UpstreamFailoverError
 			if errors.As(err, &failoverErr) {
 				failedAccountIDs[account.ID] = struct{}{}
+				recordUpstreamFailover(account.Platform, failoverErr)
 				if switchCount >= maxAccountSwitches {
 					lastFailoverStatus = failoverErr.StatusCode
 					handleGeminiFailoverExhausted(c, lastFailoverStatus)
@@ -362,13 +431,16 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 			return
 		}
 
+		rateLimit.RecordUsage(result.Usage.InputTokens+result.Usage.CacheCreationInputTokens, result.Usage.OutputTokens)
+
 		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
 
 		// 6) record usage async
+		usageCtx := tracing.Detach(c.Request.Context())
 		go func(result *service.ForwardResult, usedAccount *service.Account, ua, ip string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 			defer cancel()
 			if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 				Result:       result,
@@ -462,6 +534,112 @@ func writeUpstreamResponse(c *gin.Context, res *service.UpstreamHTTPResult) {
 	c.Data(res.StatusCode, contentType, res.Body)
 }
 
+// filterGeminiModelsForAPIKey 按 API Key 的模型白名单过滤 Gemini 模型列表，别名以独立条目追加（沿用目标模型的元数据）
+func filterGeminiModelsForAPIKey(apiKey *service.APIKey, models []gemini.Model) []gemini.Model {
+	if apiKey == nil || !apiKey.HasModelPolicy() {
+		return models
+	}
+	byID := make(map[string]gemini.Model, len(models))
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		id := strings.TrimPrefix(m.Name, "models/")
+		byID[id] = m
+		ids = append(ids, id)
+	}
+	filtered := apiKey.FilterModels(ids)
+	out := make([]gemini.Model, 0, len(filtered))
+	for _, id := range filtered {
+		if m, ok := byID[id]; ok {
+			out = append(out, m)
+			continue
+		}
+		if m, ok := byID[apiKey.ModelAliases[id]]; ok {
+			m.Name = "models/" + id
+			out = append(out, m)
+			continue
+		}
+		out = append(out, gemini.FallbackModel(id))
+	}
+	return out
+}
+
+// filterGeminiUpstreamModelsForAPIKey 过滤上游 /v1beta/models 响应体，保留其余字段（如 nextPageToken）。
+// 分页时别名只追加到最后一页，避免重复；响应无法解析时返回 false。
+func filterGeminiUpstreamModelsForAPIKey(apiKey *service.APIKey, body []byte) ([]byte, bool) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, false
+	}
+	var entries []json.RawMessage
+	if raw, ok := payload["models"]; ok {
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return nil, false
+		}
+	}
+
+	byID := make(map[string]json.RawMessage, len(entries))
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		id := strings.TrimPrefix(gjson.GetBytes(entry, "name").String(), "models/")
+		if id == "" {
+			continue
+		}
+		if _, ok := byID[id]; !ok {
+			ids = append(ids, id)
+		}
+		byID[id] = entry
+	}
+	lastPage := gjson.GetBytes(body, "nextPageToken").String() == ""
+
+	filtered := apiKey.FilterModels(ids)
+	out := make([]json.RawMessage, 0, len(filtered))
+	for _, id := range filtered {
+		if entry, ok := byID[id]; ok {
+			out = append(out, entry)
+			continue
+		}
+		if !lastPage {
+			continue
+		}
+		if target, ok := byID[apiKey.ModelAliases[id]]; ok {
+			entry, err := sjson.SetBytes(append([]byte(nil), target...), "name", "models/"+id)
+			if err == nil {
+				out = append(out, entry)
+				continue
+			}
+		}
+		entry, err := json.Marshal(gemini.FallbackModel(id))
+		if err != nil {
+			return nil, false
+		}
+		out = append(out, entry)
+	}
+
+	models, err := json.Marshal(out)
+	if err != nil {
+		return nil, false
+	}
+	payload["models"] = models
+	result, err := json.Marshal(payload)
+	if err != nil {
+		return nil, false
+	}
+	return result, true
+}
+
+// geminiModelsFromAntigravity 将 antigravity 的 v1beta 模型列表转换为 gemini.Model（JSON 结构一致）
+func geminiModelsFromAntigravity(models []antigravity.GeminiModel) []gemini.Model {
+	out := make([]gemini.Model, 0, len(models))
+	for _, m := range models {
+		out = append(out, gemini.Model{
+			Name:                       m.Name,
+			DisplayName:                m.DisplayName,
+			SupportedGenerationMethods: m.SupportedGenerationMethods,
+		})
+	}
+	return out
+}
+
 func shouldFallbackGeminiModels(res *service.UpstreamHTTPResult) bool {
 	if res == nil {
 		return true
@@ -5,8 +5,10 @@ package handler
 import (
 	"testing"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/gemini"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 // TestGeminiV1BetaHandler_PlatformRoutingInvariant 文档化并验证 Handler 层的平台路由逻辑不变量
@@ -141,3 +143,48 @@ func TestGeminiV1BetaHandler_GetModelAntigravityFallback(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterGeminiModelsForAPIKey(t *testing.T) {
+	models := gemini.DefaultModels()
+	require.Equal(t, models, filterGeminiModelsForAPIKey(&service.APIKey{}, models))
+
+	apiKey := &service.APIKey{
+		AllowedModels: []string{"gemini-2.5-*"},
+		ModelAliases:  map[string]string{"fast": "gemini-2.5-flash", "blocked": "gemini-3-pro-preview"},
+	}
+	filtered := filterGeminiModelsForAPIKey(apiKey, models)
+	names := make([]string, 0, len(filtered))
+	for _, m := range filtered {
+		names = append(names, m.Name)
+	}
+	require.Equal(t, []string{"models/gemini-2.5-flash", "models/gemini-2.5-pro", "models/fast"}, names)
+	require.Equal(t, []string{"generateContent", "streamGenerateContent"}, filtered[2].SupportedGenerationMethods)
+}
+
+func TestFilterGeminiUpstreamModelsForAPIKey(t *testing.T) {
+	apiKey := &service.APIKey{
+		AllowedModels: []string{"gemini-2.5-pro"},
+		ModelAliases:  map[string]string{"pro": "gemini-2.5-pro"},
+	}
+	body := []byte(`{"models":[` +
+		`{"name":"models/gemini-2.5-pro","inputTokenLimit":1048576},` +
+		`{"name":"models/gemini-2.5-flash","inputTokenLimit":1048576}]}`)
+
+	out, ok := filterGeminiUpstreamModelsForAPIKey(apiKey, body)
+	require.True(t, ok)
+	models := gjson.GetBytes(out, "models").Array()
+	require.Len(t, models, 2)
+	require.Equal(t, "models/gemini-2.5-pro", models[0].Get("name").String())
+	require.Equal(t, "models/pro", models[1].Get("name").String())
+	require.Equal(t, int64(1048576), models[1].Get("inputTokenLimit").Int(), "alias keeps the target metadata")
+
+	// 非最后一页不追加别名，其余字段原样保留
+	paged := []byte(`{"models":[{"name":"models/gemini-2.5-pro"}],"nextPageToken":"abc"}`)
+	out, ok = filterGeminiUpstreamModelsForAPIKey(apiKey, paged)
+	require.True(t, ok)
+	require.Len(t, gjson.GetBytes(out, "models").Array(), 1)
+	require.Equal(t, "abc", gjson.GetBytes(out, "nextPageToken").String())
+
+	_, ok = filterGeminiUpstreamModelsForAPIKey(apiKey, []byte("not json"))
+	require.False(t, ok)
+}
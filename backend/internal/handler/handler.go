@@ -23,22 +23,39 @@ type AdminHandlers struct {
 	Subscription     *admin.SubscriptionHandler
 	Usage            *admin.UsageHandler
 	UserAttribute    *admin.UserAttributeHandler
+	AuditLog         *admin.AuditLogHandler
+	Organization     *admin.OrganizationHandler
+	BalanceLedger    *admin.BalanceLedgerHandler
+	AdminAPIKey      *admin.AdminAPIKeyHandler
+	Invoice          *admin.InvoiceHandler
+	PaymentOrder     *admin.PaymentOrderHandler
+	SubscriptionPlan *admin.SubscriptionPlanHandler
+	ModelPrice       *admin.ModelPriceHandler
+	ProxyPool        *admin.ProxyPoolHandler
+	ProxyImport      *admin.ProxyImportHandler
+	AccountBundle    *admin.AccountBundleHandler
 }
 
 // Handlers contains all HTTP handlers
 type Handlers struct {
-	Auth          *AuthHandler
-	User          *UserHandler
-	APIKey        *APIKeyHandler
-	Usage         *UsageHandler
-	Redeem        *RedeemHandler
-	Subscription  *SubscriptionHandler
-	Admin         *AdminHandlers
-	Gateway       *GatewayHandler
-	OpenAIGateway *OpenAIGatewayHandler
-	Setting       *SettingHandler
-	Creem         *CreemHandler
-	Totp          *TotpHandler
+	Auth             *AuthHandler
+	User             *UserHandler
+	APIKey           *APIKeyHandler
+	Usage            *UsageHandler
+	Redeem           *RedeemHandler
+	Subscription     *SubscriptionHandler
+	Admin            *AdminHandlers
+	Gateway          *GatewayHandler
+	OpenAIGateway    *OpenAIGatewayHandler
+	ChatCompletions  *ChatCompletionsHandler
+	Setting          *SettingHandler
+	Payment          *PaymentHandler
+	Totp             *TotpHandler
+	Metrics          *MetricsHandler
+	Organization     *OrganizationHandler
+	Transaction      *BalanceTransactionHandler
+	Invoice          *InvoiceHandler
+	SubscriptionPlan *SubscriptionPlanHandler
 }
 
 // BuildInfo contains build-time information
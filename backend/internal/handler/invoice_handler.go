@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InvoiceHandler handles the current user's invoices
+type InvoiceHandler struct {
+	invoiceService *service.InvoiceService
+}
+
+// NewInvoiceHandler creates a new user invoice handler
+func NewInvoiceHandler(invoiceService *service.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{invoiceService: invoiceService}
+}
+
+// List handles listing invoices addressed to the current user
+// (personal invoices, plus organization invoices for organization owners)
+// GET /api/v1/invoices
+func (h *InvoiceHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	filter := service.InvoiceFilter{
+		RecipientUserID: subject.UserID,
+		Status:          service.InvoiceStatusIssued,
+	}
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	invoices, result, err := h.invoiceService.List(c.Request.Context(), params, filter)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.Invoice, 0, len(invoices))
+	for i := range invoices {
+		out = append(out, *dto.InvoiceFromService(&invoices[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetByID handles getting one of the current user's invoices
+// GET /api/v1/invoices/:id
+func (h *InvoiceHandler) GetByID(c *gin.Context) {
+	inv, ok := h.loadInvoice(c)
+	if !ok {
+		return
+	}
+	response.Success(c, dto.InvoiceFromService(inv))
+}
+
+// DownloadPDF handles downloading one of the current user's invoices as PDF
+// GET /api/v1/invoices/:id/pdf
+func (h *InvoiceHandler) DownloadPDF(c *gin.Context) {
+	h.download(c, service.InvoiceFormatPDF)
+}
+
+// DownloadHTML handles downloading one of the current user's invoices as HTML
+// GET /api/v1/invoices/:id/html
+func (h *InvoiceHandler) DownloadHTML(c *gin.Context) {
+	h.download(c, service.InvoiceFormatHTML)
+}
+
+func (h *InvoiceHandler) download(c *gin.Context, format string) {
+	inv, ok := h.loadInvoice(c)
+	if !ok {
+		return
+	}
+	data, contentType, err := service.RenderInvoice(inv, format)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename="+inv.FileName(format))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// loadInvoice 读取当前用户作为收票人的发票；已作废的发票对用户不可见
+func (h *InvoiceHandler) loadInvoice(c *gin.Context) (*service.Invoice, bool) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return nil, false
+	}
+	id, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid invoice id")
+		return nil, false
+	}
+	inv, err := h.invoiceService.GetForUser(c.Request.Context(), subject.UserID, id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return nil, false
+	}
+	if inv.Status == service.InvoiceStatusVoid {
+		response.ErrorFrom(c, service.ErrInvoiceNotFound)
+		return nil, false
+	}
+	return inv, true
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsHandler 输出 Prometheus 指标
+type MetricsHandler struct {
+	metricsService *service.GatewayMetricsService
+	handler        http.Handler
+}
+
+// NewMetricsHandler 创建指标 handler
+func NewMetricsHandler(metricsService *service.GatewayMetricsService) *MetricsHandler {
+	return &MetricsHandler{metricsService: metricsService, handler: metrics.Handler()}
+}
+
+// Metrics 以 Prometheus 格式输出当前指标
+// GET /metrics
+func (h *MetricsHandler) Metrics(c *gin.Context) {
+	h.metricsService.RefreshScrapeMetrics(c.Request.Context())
+	h.handler.ServeHTTP(c.Writer, c.Request)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -22,10 +23,12 @@ import (
 
 // OpenAIGatewayHandler handles OpenAI API gateway requests
 type OpenAIGatewayHandler struct {
-	gatewayService      *service.OpenAIGatewayService
-	billingCacheService *service.BillingCacheService
-	concurrencyHelper   *ConcurrencyHelper
-	maxAccountSwitches  int
+	gatewayService          *service.OpenAIGatewayService
+	billingCacheService     *service.BillingCacheService
+	requestRateLimitService *service.RequestRateLimitService
+	responseCacheService    *service.ResponseCacheService
+	concurrencyHelper       *ConcurrencyHelper
+	maxAccountSwitches      int
 }
 
 // NewOpenAIGatewayHandler creates a new OpenAIGatewayHandler
@@ -33,6 +36,8 @@ func NewOpenAIGatewayHandler(
 	gatewayService *service.OpenAIGatewayService,
 	concurrencyService *service.ConcurrencyService,
 	billingCacheService *service.BillingCacheService,
+	requestRateLimitService *service.RequestRateLimitService,
+	responseCacheService *service.ResponseCacheService,
 	cfg *config.Config,
 ) *OpenAIGatewayHandler {
 	pingInterval := time.Duration(0)
@@ -44,10 +49,12 @@ func NewOpenAIGatewayHandler(
 		}
 	}
 	return &OpenAIGatewayHandler{
-		gatewayService:      gatewayService,
-		billingCacheService: billingCacheService,
-		concurrencyHelper:   NewConcurrencyHelper(concurrencyService, SSEPingFormatComment, pingInterval),
-		maxAccountSwitches:  maxAccountSwitches,
+		gatewayService:          gatewayService,
+		billingCacheService:     billingCacheService,
+		requestRateLimitService: requestRateLimitService,
+		responseCacheService:    responseCacheService,
+		concurrencyHelper:       NewConcurrencyHelper(concurrencyService, SSEPingFormatComment, pingInterval),
+		maxAccountSwitches:      maxAccountSwitches,
 	}
 }
 
@@ -102,6 +109,28 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		return
 	}
 
+	// API Key 级别的模型别名与白名单（在账号调度前解析）
+	resolvedModel, err := apiKey.ResolveModel(reqModel)
+	if err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", modelNotAllowedMessage(reqModel))
+		return
+	}
+	if resolvedModel != reqModel {
+		body, err = rewriteRequestModel(body, resolvedModel)
+		if err != nil {
+			h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to process request")
+			return
+		}
+		reqBody["model"] = resolvedModel
+		reqModel = resolvedModel
+	}
+
+	// 未知模型策略为 reject 时，没有任何价格的模型在转发前拒绝
+	if err := h.gatewayService.CheckModelPricing(apiKey.GroupID, reqModel); err != nil {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", modelPriceUnknownMessage(reqModel))
+		return
+	}
+
 	userAgent := c.GetHeader("User-Agent")
 	if !openai.IsCodexCLIRequest(userAgent) {
 		existingInstructions, _ := reqBody["instructions"].(string)
@@ -140,6 +169,22 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		}
 	}
 
+	// User/API key/group RPM and TPM limits
+	rateLimit, ok := acquireRequestRateLimit(c, h.requestRateLimitService, apiKey, body, func(message string) {
+		h.errorResponse(c, http.StatusTooManyRequests, "rate_limit_error", message)
+	})
+	if !ok {
+		return
+	}
+	defer settleRequestRateLimit(c, h.requestRateLimitService, rateLimit)
+
+	// Deterministic requests may be replayed from the response cache
+	cacheServed, cacheFinish := serveResponseCache(c, h.responseCacheService, h.billingCacheService, apiKey, reqModel, body)
+	if cacheServed {
+		return
+	}
+	defer cacheFinish()
+
 	// Track if we've started streaming (for error handling)
 	streamStarted := false
 
@@ -184,6 +229,20 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		defer userReleaseFunc()
 	}
 
+	// 1.1 Organization members also take a slot from the shared org pool
+	if org := apiKey.User.Organization; org != nil && org.Concurrency > 0 {
+		orgReleaseFunc, err := h.concurrencyHelper.AcquireOrganizationSlotWithWait(c, org.OrganizationID, org.Concurrency, reqStream, &streamStarted)
+		if err != nil {
+			log.Printf("Organization concurrency acquire failed: %v", err)
+			h.handleConcurrencyError(c, err, "organization", streamStarted)
+			return
+		}
+		orgReleaseFunc = wrapReleaseOnDone(c.Request.Context(), orgReleaseFunc)
+		if orgReleaseFunc != nil {
+			defer orgReleaseFunc()
+		}
+	}
+
 	// 2. Re-check billing eligibility after wait
 	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
 		log.Printf("Billing eligibility check failed after wait: %v", err)
@@ -267,7 +326,9 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		accountReleaseFunc = wrapReleaseOnDone(c.Request.Context(), accountReleaseFunc)
 
 		// Forward request
-		result, err := h.gatewayService.Forward(c.Request.Context(), c, account, body)
+		forwardCtx, forwardSpan := startForwardSpan(c, account, switchCount+1)
+		result, err := h.gatewayService.Forward(forwardCtx, c, account, body)
+		tracing.End(forwardSpan, err)
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
@@ -275,6 +336,7 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 			var failoverErr *service.UpstreamFailoverError
 			if errors.As(err, &failoverErr) {
 				failedAccountIDs[account.ID] = struct{}{}
+				recordUpstreamFailover(account.Platform, failoverErr)
 				if switchCount >= maxAccountSwitches {
 					lastFailoverStatus = failoverErr.StatusCode
 					h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
@@ -290,13 +352,16 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 			return
 		}
 
+		rateLimit.RecordUsage(result.Usage.InputTokens+result.Usage.CacheCreationInputTokens, result.Usage.OutputTokens)
+
 		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
 
 		// Async record usage
+		usageCtx := tracing.Detach(c.Request.Context())
 		go func(result *service.OpenAIForwardResult, usedAccount *service.Account, ua, ip string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			ctx, cancel := context.WithTimeout(usageCtx, 10*time.Second)
 			defer cancel()
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:       result,
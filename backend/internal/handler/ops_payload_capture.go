@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	opsPayloadCaptureTimeout     = 10 * time.Second
+	opsPayloadCaptureConcurrency = 32
+)
+
+var (
+	opsPayloadCaptureSlots   = make(chan struct{}, opsPayloadCaptureConcurrency)
+	opsPayloadCaptureDropped atomic.Int64
+)
+
+// opsPayloadCaptureWriter buffers the response body only after a capture rule matched.
+//
+// The match is decided lazily on the first write because the API key (and therefore the rule
+// scope) is only known once auth has run further down the chain.
+type opsPayloadCaptureWriter struct {
+	gin.ResponseWriter
+	c   *gin.Context
+	ops *service.OpsService
+
+	decided   bool
+	rule      *service.OpsPayloadCaptureRule
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (w *opsPayloadCaptureWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	apiKey, ok := middleware2.GetAPIKeyFromContext(w.c)
+	if !ok || apiKey == nil {
+		return
+	}
+	w.rule = w.ops.MatchPayloadCaptureRule(w.c.Request.Context(), apiKey.UserID, apiKey.ID, apiKey.GroupID)
+}
+
+func (w *opsPayloadCaptureWriter) capture(b []byte) {
+	w.decide()
+	if w.rule == nil || w.truncated {
+		return
+	}
+	remaining := service.OpsPayloadCaptureMaxBodyBytes - w.buf.Len()
+	if len(b) > remaining {
+		_, _ = w.buf.Write(b[:remaining])
+		w.truncated = true
+		return
+	}
+	_, _ = w.buf.Write(b)
+}
+
+func (w *opsPayloadCaptureWriter) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *opsPayloadCaptureWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// OpsPayloadCaptureMiddleware records full request/response payloads for traffic matched by
+// an active capture rule. When no rule is active it adds no buffering at all.
+func OpsPayloadCaptureMiddleware(ops *service.OpsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if ops == nil || !ops.HasPayloadCaptureRules(c.Request.Context()) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		w := &opsPayloadCaptureWriter{ResponseWriter: c.Writer, c: c, ops: ops}
+		c.Writer = w
+		c.Next()
+
+		w.decide()
+		if w.rule == nil {
+			return
+		}
+
+		apiKey, _ := middleware2.GetAPIKeyFromContext(c)
+		if apiKey == nil {
+			return
+		}
+		clientRequestID, _ := c.Request.Context().Value(ctxkey.ClientRequestID).(string)
+
+		model, _ := c.Get(opsModelKey)
+		streamV, _ := c.Get(opsStreamKey)
+		accountIDV, _ := c.Get(opsAccountIDKey)
+
+		var modelName string
+		if s, ok := model.(string); ok {
+			modelName = s
+		}
+		stream := false
+		if b, ok := streamV.(bool); ok {
+			stream = b
+		}
+		var accountID *int64
+		if v, ok := accountIDV.(int64); ok && v > 0 {
+			accountID = &v
+		}
+		var requestBody []byte
+		if v, ok := c.Get(opsRequestBodyKey); ok {
+			if b, ok := v.([]byte); ok {
+				requestBody = b
+			}
+		}
+
+		requestID := c.Writer.Header().Get("X-Request-Id")
+		if requestID == "" {
+			requestID = c.Writer.Header().Get("x-request-id")
+		}
+
+		userID := apiKey.UserID
+		apiKeyID := apiKey.ID
+		input := &service.OpsPayloadCaptureInput{
+			Rule:                w.rule,
+			RequestID:           requestID,
+			ClientRequestID:     clientRequestID,
+			UserID:              &userID,
+			APIKeyID:            &apiKeyID,
+			GroupID:             apiKey.GroupID,
+			AccountID:           accountID,
+			Platform:            resolveOpsPlatform(apiKey, guessPlatformFromPath(c.Request.URL.Path)),
+			Model:               modelName,
+			RequestPath:         c.Request.URL.Path,
+			Stream:              stream,
+			StatusCode:          c.Writer.Status(),
+			DurationMs:          time.Since(start).Milliseconds(),
+			UserAgent:           c.GetHeader("User-Agent"),
+			RequestHeaders:      c.Request.Header.Clone(),
+			RequestBody:         requestBody,
+			ResponseBody:        append([]byte(nil), w.buf.Bytes()...),
+			ResponseContentType: c.Writer.Header().Get("Content-Type"),
+			ResponseTruncated:   w.truncated,
+			CreatedAt:           start.UTC(),
+		}
+
+		enqueueOpsPayloadCapture(ops, input)
+	}
+}
+
+// enqueueOpsPayloadCapture persists the capture asynchronously; captures are dropped (not queued)
+// when too many writes are already in flight so the gateway path never blocks on storage.
+func enqueueOpsPayloadCapture(ops *service.OpsService, input *service.OpsPayloadCaptureInput) {
+	select {
+	case opsPayloadCaptureSlots <- struct{}{}:
+	default:
+		if n := opsPayloadCaptureDropped.Add(1); n == 1 || n%100 == 0 {
+			log.Printf("[OpsPayloadCapture] too many in-flight captures, dropped=%d", n)
+		}
+		return
+	}
+
+	go func() {
+		defer func() { <-opsPayloadCaptureSlots }()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("[OpsPayloadCapture] panic recovered: %v\n%s", r, debug.Stack())
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), opsPayloadCaptureTimeout)
+		defer cancel()
+		if err := ops.RecordPayloadCapture(ctx, input); err != nil {
+			log.Printf("[OpsPayloadCapture] record failed: request_id=%s err=%v", input.RequestID, err)
+		}
+	}()
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MyOrganizationResponse 当前用户所属组织及其成员身份
+type MyOrganizationResponse struct {
+	Organization *dto.Organization       `json:"organization"`
+	Membership   *dto.OrganizationMember `json:"membership"`
+}
+
+// OrganizationMemberRequest 组织 owner/admin 添加或修改成员
+type OrganizationMemberRequest struct {
+	Email string  `json:"email"`
+	Role  *string `json:"role" binding:"omitempty,oneof=admin member"`
+	// MonthlyLimitUSD 为 0 或负数表示清除月度上限
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+}
+
+// OrganizationHandler handles organization self-service for owners and admins
+type OrganizationHandler struct {
+	organizationService *service.OrganizationService
+}
+
+// NewOrganizationHandler creates a new user organization handler
+func NewOrganizationHandler(organizationService *service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+	}
+}
+
+// GetMine handles getting the current user's organization
+// GET /api/v1/organization
+func (h *OrganizationHandler) GetMine(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	org, member, err := h.organizationService.GetMyOrganization(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := dto.OrganizationFromService(org)
+	// 普通成员看不到组织钱包与备注
+	if !member.CanManageMembers() {
+		out.Balance = 0
+		out.Notes = ""
+	}
+	out.Owner = nil
+	response.Success(c, MyOrganizationResponse{
+		Organization: out,
+		Membership:   organizationMemberPublic(member),
+	})
+}
+
+// ListMembers handles listing members of the current user's organization
+// GET /api/v1/organization/members
+func (h *OrganizationHandler) ListMembers(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	members, err := h.organizationService.ListMyMembers(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.OrganizationMember, 0, len(members))
+	for i := range members {
+		out = append(out, *organizationMemberPublic(&members[i]))
+	}
+	response.Success(c, out)
+}
+
+// AddMember handles adding a member by email
+// POST /api/v1/organization/members
+func (h *OrganizationHandler) AddMember(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req OrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		response.BadRequest(c, "email is required")
+		return
+	}
+
+	input := &service.AddOrganizationMemberInput{Email: email}
+	if req.Role != nil {
+		input.Role = *req.Role
+	}
+	if req.MonthlyLimitUSD != nil && *req.MonthlyLimitUSD > 0 {
+		input.MonthlyLimitUSD = req.MonthlyLimitUSD
+	}
+
+	member, err := h.organizationService.AddMyMember(c.Request.Context(), subject.UserID, input)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, organizationMemberPublic(member))
+}
+
+// UpdateMember handles updating a member's role or monthly limit
+// PUT /api/v1/organization/members/:user_id
+func (h *OrganizationHandler) UpdateMember(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	var req OrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	input := &service.UpdateOrganizationMemberInput{Role: req.Role}
+	if req.MonthlyLimitUSD != nil {
+		if *req.MonthlyLimitUSD <= 0 {
+			input.ClearMonthlyLimit = true
+		} else {
+			input.MonthlyLimitUSD = req.MonthlyLimitUSD
+		}
+	}
+
+	member, err := h.organizationService.UpdateMyMember(c.Request.Context(), subject.UserID, userID, input)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, organizationMemberPublic(member))
+}
+
+// RemoveMember handles removing a member from the organization
+// DELETE /api/v1/organization/members/:user_id
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.organizationService.RemoveMyMember(c.Request.Context(), subject.UserID, userID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Member removed successfully"})
+}
+
+// GetUsage handles per-member usage breakdown for owners and admins
+// GET /api/v1/organization/usage
+func (h *OrganizationHandler) GetUsage(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	startTime, endTime := parseUserTimeRange(c)
+	if c.Query("start_date") == "" {
+		// 未指定时默认统计本月
+		now := time.Now().In(endTime.Location())
+		startTime = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	}
+
+	breakdown, err := h.organizationService.GetMyUsageBreakdown(c.Request.Context(), subject.UserID, startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, breakdown)
+}
+
+// organizationMemberPublic 成员视图只暴露身份信息，不包含其他成员的个人余额等字段
+func organizationMemberPublic(m *service.OrganizationMember) *dto.OrganizationMember {
+	out := dto.OrganizationMemberFromService(m)
+	if out == nil {
+		return nil
+	}
+	if m.User != nil {
+		out.User = &dto.User{
+			ID:       m.User.ID,
+			Email:    m.User.Email,
+			Username: m.User.Username,
+		}
+	}
+	return out
+}
@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentHandler handles online top-up checkout, order history and provider webhooks
+type PaymentHandler struct {
+	paymentService *service.PaymentService
+	creemService   *service.CreemService
+	userService    *service.UserService
+}
+
+// NewPaymentHandler creates a new PaymentHandler
+func NewPaymentHandler(paymentService *service.PaymentService, creemService *service.CreemService, userService *service.UserService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+		creemService:   creemService,
+		userService:    userService,
+	}
+}
+
+// PaymentCheckoutRequest 创建充值订单请求
+type PaymentCheckoutRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	Amount   int    `json:"amount" binding:"required,min=1,max=10000"` // 金额（支付币种，整数）
+	Method   string `json:"method"`
+}
+
+// PaymentCheckoutResponse 创建充值订单响应
+type PaymentCheckoutResponse struct {
+	OrderNo     string `json:"order_no"`
+	CheckoutURL string `json:"checkout_url"`
+}
+
+// CheckoutRequest Creem 旧版创建支付请求
+type CheckoutRequest struct {
+	Amount int `json:"amount" binding:"required,min=1,max=10000"` // 金额（美元，整数）
+}
+
+// CheckoutResponse 支付响应
+type CheckoutResponse struct {
+	CheckoutURL string `json:"checkout_url"`
+}
+
+// GetStatusResponse 支付状态响应
+type GetStatusResponse struct {
+	Enabled        bool    `json:"enabled"`
+	RateMultiplier float64 `json:"rate_multiplier"`
+}
+
+// GetProviders 获取已启用的支付渠道
+// GET /api/v1/payment/providers
+func (h *PaymentHandler) GetProviders(c *gin.Context) {
+	providers := h.paymentService.EnabledProviders(c.Request.Context())
+	out := make([]dto.PaymentProvider, 0, len(providers))
+	for _, p := range providers {
+		out = append(out, dto.PaymentProviderFromService(p))
+	}
+	response.Success(c, out)
+}
+
+// CreateCheckout 创建充值订单并返回渠道支付地址
+// POST /api/v1/payment/checkout
+func (h *PaymentHandler) CreateCheckout(c *gin.Context) {
+	var req PaymentCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	order, ok := h.createCheckout(c, req)
+	if !ok {
+		return
+	}
+	response.Success(c, PaymentCheckoutResponse{
+		OrderNo:     order.OrderNo,
+		CheckoutURL: order.CheckoutURL,
+	})
+}
+
+// GetStatus 获取 Creem 支付状态（兼容旧版前端）
+// GET /api/v1/creem/status
+func (h *PaymentHandler) GetStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	response.Success(c, GetStatusResponse{
+		Enabled:        h.creemService.IsEnabled(ctx),
+		RateMultiplier: h.creemService.GetRateMultiplier(ctx),
+	})
+}
+
+// CreateCreemCheckout 创建 Creem 支付会话（兼容旧版前端）
+// POST /api/v1/creem/checkout
+func (h *PaymentHandler) CreateCreemCheckout(c *gin.Context) {
+	var req CheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	order, ok := h.createCheckout(c, PaymentCheckoutRequest{Provider: service.PaymentProviderCreem, Amount: req.Amount})
+	if !ok {
+		return
+	}
+	response.Success(c, CheckoutResponse{
+		CheckoutURL: order.CheckoutURL,
+	})
+}
+
+func (h *PaymentHandler) createCheckout(c *gin.Context, req PaymentCheckoutRequest) (*service.PaymentOrder, bool) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return nil, false
+	}
+
+	user, err := h.userService.GetProfile(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return nil, false
+	}
+
+	order, err := h.paymentService.CreateCheckout(c.Request.Context(), service.PaymentCheckoutRequest{
+		UserID:   subject.UserID,
+		Email:    user.Email,
+		Provider: req.Provider,
+		Amount:   req.Amount,
+		Method:   req.Method,
+		BaseURL:  requestBaseURL(c),
+	})
+	if err != nil {
+		if isPaymentClientError(err) {
+			response.ErrorFrom(c, err)
+			return nil, false
+		}
+		log.Printf("[Payment] CreateCheckout error: provider=%s err=%v", req.Provider, err)
+		response.InternalError(c, "Failed to create checkout session")
+		return nil, false
+	}
+	return order, true
+}
+
+// ListOrders 当前用户的充值记录
+// GET /api/v1/payment/orders
+func (h *PaymentHandler) ListOrders(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	orders, result, err := h.paymentService.ListUserOrders(c.Request.Context(), subject.UserID, params, strings.TrimSpace(c.Query("status")))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.PaymentOrder, 0, len(orders))
+	for i := range orders {
+		out = append(out, *dto.PaymentOrderFromService(&orders[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetOrder 按订单号查询当前用户的订单（支付返回页轮询结果）
+// GET /api/v1/payment/orders/:order_no
+func (h *PaymentHandler) GetOrder(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	order, err := h.paymentService.GetUserOrder(c.Request.Context(), subject.UserID, c.Param("order_no"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.PaymentOrderFromService(order))
+}
+
+// HandleWebhook 处理支付渠道回调（签名由各渠道校验）
+// GET/POST /api/v1/webhook/:provider
+func (h *PaymentHandler) HandleWebhook(c *gin.Context) {
+	provider := c.Param("provider")
+	payload, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		log.Printf("[Payment] Failed to read %s webhook body: %v", provider, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read body"})
+		return
+	}
+
+	// 易支付以 query 或表单参数回调，合并后交给渠道验签
+	form := c.Request.URL.Query()
+	if strings.HasPrefix(c.ContentType(), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(payload)); err == nil {
+			for k, v := range values {
+				form[k] = v
+			}
+		}
+	}
+
+	err = h.paymentService.HandleWebhook(c.Request.Context(), provider, &service.PaymentWebhookRequest{
+		Body:   payload,
+		Header: c.Request.Header,
+		Form:   form,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, service.ErrPaymentProviderNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown payment provider"})
+		return
+	case errors.Is(err, service.ErrPaymentWebhookInvalid):
+		log.Printf("[Payment] Invalid %s webhook signature", provider)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+		return
+	default:
+		// 返回 5xx 让渠道稍后重试；入账按订单状态幂等，重试不会重复到账
+		log.Printf("[Payment] %s webhook processing error: %v", provider, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "webhook processing failed"})
+		return
+	}
+
+	if ack := h.paymentService.WebhookAck(provider); ack != "" {
+		c.String(http.StatusOK, ack)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// isPaymentClientError 用户可修正的下单错误直接返回给前端，其余按内部错误处理
+func isPaymentClientError(err error) bool {
+	for _, target := range []error{
+		service.ErrPaymentProviderNotFound,
+		service.ErrPaymentProviderDisabled,
+		service.ErrPaymentInvalidAmount,
+		service.ErrPaymentInvalidMethod,
+		service.ErrSubscriptionPlanNotFound,
+		service.ErrSubscriptionPlanUnavailable,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestBaseURL 根据请求推导站点对外地址（支持反向代理头）
+func requestBaseURL(c *gin.Context) string {
+	scheme := "https"
+	if c.Request.TLS == nil {
+		if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+			scheme = proto
+		} else {
+			scheme = "http"
+		}
+	}
+	host := c.Request.Host
+	if xfHost := strings.TrimSpace(c.GetHeader("X-Forwarded-Host")); xfHost != "" {
+		host = xfHost
+	}
+	return scheme + "://" + host
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	responseCacheHeader        = "X-Response-Cache"
+	responseCacheRecordTimeout = 10 * time.Second
+)
+
+// responseCacheRecorder 缓存未命中时在透传响应的同时缓冲响应体，超过上限后放弃缓存
+type responseCacheRecorder struct {
+	gin.ResponseWriter
+	buf      bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (w *responseCacheRecorder) capture(b []byte) {
+	if w.overflow {
+		return
+	}
+	if w.buf.Len()+len(b) > w.limit {
+		w.overflow = true
+		w.buf = bytes.Buffer{}
+		return
+	}
+	_, _ = w.buf.Write(b)
+}
+
+func (w *responseCacheRecorder) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseCacheRecorder) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// serveResponseCache 查询确定性请求的响应缓存（需在模型解析与 RPM 检查之后调用）。
+//
+// 命中时按平台原生格式原样回放缓存响应、异步记录用量并返回 served=true；
+// 未命中时包装 c.Writer 缓冲响应，调用方须在请求处理结束后调用 finish 写入缓存。
+// 不可缓存的请求返回 served=false、finish 为空操作。
+func serveResponseCache(
+	c *gin.Context,
+	svc *service.ResponseCacheService,
+	billingCacheService *service.BillingCacheService,
+	apiKey *service.APIKey,
+	model string,
+	body []byte,
+) (served bool, finish func()) {
+	finish = func() {}
+	if svc == nil || apiKey == nil {
+		return false, finish
+	}
+
+	platform := ""
+	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
+		platform = forcePlatform
+	} else if apiKey.Group != nil {
+		platform = apiKey.Group.Platform
+	}
+	lookup, ok := svc.Prepare(apiKey, platform, c.Request.URL.Path, model, body)
+	if !ok {
+		return false, finish
+	}
+
+	start := time.Now()
+	subscription, _ := middleware2.GetSubscriptionFromContext(c)
+	if entry := svc.Get(c.Request.Context(), lookup); entry != nil {
+		// 命中需计费时检查余额/订阅，不满足则按未命中处理，由后续正常链路返回计费错误
+		eligible := lookup.Policy.CostRatio <= 0 || billingCacheService == nil ||
+			billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription) == nil
+		if eligible {
+			c.Header(responseCacheHeader, "HIT")
+			c.Data(entry.StatusCode, entry.ContentType, entry.Body)
+			c.Writer.Flush()
+
+			hit := &service.ResponseCacheHitInput{
+				Lookup:       lookup,
+				Entry:        entry,
+				APIKey:       apiKey,
+				Subscription: subscription,
+				UserAgent:    c.GetHeader("User-Agent"),
+				IPAddress:    ip.GetClientIP(c),
+				Duration:     time.Since(start),
+			}
+			usageCtx := tracing.Detach(c.Request.Context())
+			go func() {
+				ctx, cancel := context.WithTimeout(usageCtx, responseCacheRecordTimeout)
+				defer cancel()
+				if err := svc.RecordHit(ctx, hit); err != nil {
+					log.Printf("[ResponseCache] record hit failed: api_key=%d err=%v", apiKey.ID, err)
+				}
+			}()
+			return true, finish
+		}
+	}
+
+	c.Header(responseCacheHeader, "MISS")
+	recorder := &responseCacheRecorder{ResponseWriter: c.Writer, limit: svc.MaxEntryBytes()}
+	c.Writer = recorder
+	finish = func() {
+		if c.Writer == recorder {
+			c.Writer = recorder.ResponseWriter
+		}
+		if recorder.overflow || recorder.buf.Len() == 0 {
+			return
+		}
+		accountID, _ := c.Get(opsAccountIDKey)
+		id, _ := accountID.(int64)
+		status := recorder.Status()
+		contentType := recorder.Header().Get("Content-Type")
+		body := recorder.buf.Bytes()
+
+		saveCtx := tracing.Detach(c.Request.Context())
+		go func() {
+			ctx, cancel := context.WithTimeout(saveCtx, responseCacheRecordTimeout)
+			defer cancel()
+			if err := svc.Save(ctx, lookup, id, status, contentType, body); err != nil {
+				log.Printf("[ResponseCache] save failed: key=%s err=%v", lookup.Key, err)
+			}
+		}()
+	}
+	return false, finish
+}
@@ -150,15 +150,16 @@ func (h *SubscriptionHandler) GetSummary(c *gin.Context) {
 
 		// Add group info if preloaded
 		if sub.Group != nil {
-			item.GroupName = sub.Group.Name
-			if sub.Group.DailyLimitUSD != nil {
-				item.DailyLimitUSD = *sub.Group.DailyLimitUSD
+			group := sub.EffectiveGroup(sub.Group)
+			item.GroupName = group.Name
+			if group.DailyLimitUSD != nil {
+				item.DailyLimitUSD = *group.DailyLimitUSD
 			}
-			if sub.Group.WeeklyLimitUSD != nil {
-				item.WeeklyLimitUSD = *sub.Group.WeeklyLimitUSD
+			if group.WeeklyLimitUSD != nil {
+				item.WeeklyLimitUSD = *group.WeeklyLimitUSD
 			}
-			if sub.Group.MonthlyLimitUSD != nil {
-				item.MonthlyLimitUSD = *sub.Group.MonthlyLimitUSD
+			if group.MonthlyLimitUSD != nil {
+				item.MonthlyLimitUSD = *group.MonthlyLimitUSD
 			}
 		}
 
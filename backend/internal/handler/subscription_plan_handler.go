@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionPlanHandler handles plan listing, plan checkout and auto-renew settings for users
+type SubscriptionPlanHandler struct {
+	planService    *service.SubscriptionPlanService
+	paymentService *service.PaymentService
+	userService    *service.UserService
+}
+
+// NewSubscriptionPlanHandler creates a new SubscriptionPlanHandler
+func NewSubscriptionPlanHandler(planService *service.SubscriptionPlanService, paymentService *service.PaymentService, userService *service.UserService) *SubscriptionPlanHandler {
+	return &SubscriptionPlanHandler{
+		planService:    planService,
+		paymentService: paymentService,
+		userService:    userService,
+	}
+}
+
+// PlanCheckoutRequest 购买套餐请求
+type PlanCheckoutRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	Method   string `json:"method"`
+}
+
+// SetAutoRenewRequest 设置自动续费请求
+type SetAutoRenewRequest struct {
+	AutoRenew *bool `json:"auto_renew" binding:"required"`
+}
+
+// List 可购买的套餐
+// GET /api/v1/subscription-plans
+func (h *SubscriptionPlanHandler) List(c *gin.Context) {
+	plans, err := h.planService.ListActive(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.SubscriptionPlan, 0, len(plans))
+	for i := range plans {
+		out = append(out, *dto.SubscriptionPlanFromService(&plans[i]))
+	}
+	response.Success(c, out)
+}
+
+// Checkout 创建套餐购买订单并返回渠道支付地址，支付成功后开通/续期订阅
+// POST /api/v1/subscription-plans/:id/checkout
+func (h *SubscriptionPlanHandler) Checkout(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+
+	var req PlanCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	user, err := h.userService.GetProfile(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	order, err := h.paymentService.CreatePlanCheckout(c.Request.Context(), service.PaymentPlanCheckoutRequest{
+		UserID:   subject.UserID,
+		Email:    user.Email,
+		PlanID:   planID,
+		Provider: req.Provider,
+		Method:   req.Method,
+		BaseURL:  requestBaseURL(c),
+	})
+	if err != nil {
+		if isPaymentClientError(err) {
+			response.ErrorFrom(c, err)
+			return
+		}
+		log.Printf("[Payment] CreatePlanCheckout error: plan_id=%d provider=%s err=%v", planID, req.Provider, err)
+		response.InternalError(c, "Failed to create checkout session")
+		return
+	}
+	response.Success(c, PaymentCheckoutResponse{
+		OrderNo:     order.OrderNo,
+		CheckoutURL: order.CheckoutURL,
+	})
+}
+
+// SetAutoRenew 开启/关闭订阅自动续费（到期前从余额扣除套餐价格）
+// PUT /api/v1/subscriptions/:id/auto-renew
+func (h *SubscriptionPlanHandler) SetAutoRenew(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	var req SetAutoRenewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	sub, err := h.planService.SetAutoRenew(c.Request.Context(), subject.UserID, subscriptionID, *req.AutoRenew)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UserSubscriptionFromService(sub))
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"log"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -17,15 +19,109 @@ import (
 
 // UsageHandler handles usage-related requests
 type UsageHandler struct {
-	usageService  *service.UsageService
-	apiKeyService *service.APIKeyService
+	usageService       *service.UsageService
+	apiKeyService      *service.APIKeyService
+	promptCacheService *service.PromptCacheStatsService
+	exportService      *service.UsageExportService
 }
 
 // NewUsageHandler creates a new UsageHandler
-func NewUsageHandler(usageService *service.UsageService, apiKeyService *service.APIKeyService) *UsageHandler {
+func NewUsageHandler(usageService *service.UsageService, apiKeyService *service.APIKeyService, promptCacheService *service.PromptCacheStatsService, exportService *service.UsageExportService) *UsageHandler {
 	return &UsageHandler{
-		usageService:  usageService,
-		apiKeyService: apiKeyService,
+		usageService:       usageService,
+		apiKeyService:      apiKeyService,
+		promptCacheService: promptCacheService,
+		exportService:      exportService,
+	}
+}
+
+// Export streams the current user's usage records as CSV/JSONL
+// GET /api/v1/usage/export
+func (h *UsageHandler) Export(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+	if h.exportService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage export service unavailable")
+		return
+	}
+	format, ok := service.NormalizeUsageExportFormat(c.Query("format"))
+	if !ok {
+		response.BadRequest(c, "Invalid format, use csv or jsonl")
+		return
+	}
+
+	userID := subject.UserID // Always filter by current user for security
+	filters := service.UsageExportFilters{UserID: &userID}
+	if apiKeyIDStr := c.Query("api_key_id"); apiKeyIDStr != "" {
+		id, err := strconv.ParseInt(apiKeyIDStr, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid api_key_id")
+			return
+		}
+		apiKey, err := h.apiKeyService.GetByID(c.Request.Context(), id)
+		if err != nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		if apiKey.UserID != subject.UserID {
+			response.Forbidden(c, "Not authorized to access this API key's usage records")
+			return
+		}
+		filters.APIKeyID = &id
+	}
+	if model := c.Query("model"); model != "" {
+		filters.Model = &model
+	}
+	if streamStr := c.Query("stream"); streamStr != "" {
+		val, err := strconv.ParseBool(streamStr)
+		if err != nil {
+			response.BadRequest(c, "Invalid stream value, use true or false")
+			return
+		}
+		filters.Stream = &val
+	}
+	if billingTypeStr := c.Query("billing_type"); billingTypeStr != "" {
+		val, err := strconv.ParseInt(billingTypeStr, 10, 8)
+		if err != nil {
+			response.BadRequest(c, "Invalid billing_type")
+			return
+		}
+		bt := int8(val)
+		filters.BillingType = &bt
+	}
+
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return
+		}
+		filters.StartTime = t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return
+		}
+		filters.EndTime = t.Add(24 * time.Hour)
+	}
+	if err := h.exportService.ValidateStreamFilters(filters); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	c.Header("Content-Type", service.UsageExportContentType(format))
+	c.Header("Content-Disposition", "attachment; filename="+service.UsageExportFileName(filters, format))
+	c.Status(http.StatusOK)
+	rows, err := h.exportService.WriteStream(c.Request.Context(), c.Writer, filters, format, false)
+	if err != nil {
+		// 响应头已发送，只能中断输出并记录日志
+		log.Printf("[UsageExport] user stream aborted: user=%d rows=%d err=%v", subject.UserID, rows, err)
 	}
 }
 
@@ -350,6 +446,33 @@ func (h *UsageHandler) DashboardModels(c *gin.Context) {
 	})
 }
 
+// DashboardCacheSavings handles getting prompt cache savings for current user
+// GET /api/v1/usage/dashboard/cache-savings
+func (h *UsageHandler) DashboardCacheSavings(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	startTime, endTime := parseUserTimeRange(c)
+
+	savings, err := h.promptCacheService.GetUserSavings(c.Request.Context(), subject.UserID, startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"cache_read_tokens":  savings.CacheReadTokens,
+		"cache_hit_requests": savings.CacheHitRequests,
+		"savings":            savings.Savings,
+		"actual_savings":     savings.ActualSavings,
+		"start_date":         startTime.Format("2006-01-02"),
+		"end_date":           endTime.Add(-24 * time.Hour).Format("2006-01-02"),
+	})
+}
+
 // BatchAPIKeysUsageRequest represents the request for batch API keys usage
 type BatchAPIKeysUsageRequest struct {
 	APIKeyIDs []int64 `json:"api_key_ids" binding:"required"`
@@ -26,6 +26,17 @@ func ProvideAdminHandlers(
 	subscriptionHandler *admin.SubscriptionHandler,
 	usageHandler *admin.UsageHandler,
 	userAttributeHandler *admin.UserAttributeHandler,
+	auditLogHandler *admin.AuditLogHandler,
+	organizationHandler *admin.OrganizationHandler,
+	balanceLedgerHandler *admin.BalanceLedgerHandler,
+	adminAPIKeyHandler *admin.AdminAPIKeyHandler,
+	invoiceHandler *admin.InvoiceHandler,
+	paymentOrderHandler *admin.PaymentOrderHandler,
+	subscriptionPlanHandler *admin.SubscriptionPlanHandler,
+	modelPriceHandler *admin.ModelPriceHandler,
+	proxyPoolHandler *admin.ProxyPoolHandler,
+	proxyImportHandler *admin.ProxyImportHandler,
+	accountBundleHandler *admin.AccountBundleHandler,
 ) *AdminHandlers {
 	return &AdminHandlers{
 		Dashboard:        dashboardHandler,
@@ -45,6 +56,17 @@ func ProvideAdminHandlers(
 		Subscription:     subscriptionHandler,
 		Usage:            usageHandler,
 		UserAttribute:    userAttributeHandler,
+		AuditLog:         auditLogHandler,
+		Organization:     organizationHandler,
+		BalanceLedger:    balanceLedgerHandler,
+		AdminAPIKey:      adminAPIKeyHandler,
+		Invoice:          invoiceHandler,
+		PaymentOrder:     paymentOrderHandler,
+		SubscriptionPlan: subscriptionPlanHandler,
+		ModelPrice:       modelPriceHandler,
+		ProxyPool:        proxyPoolHandler,
+		ProxyImport:      proxyImportHandler,
+		AccountBundle:    accountBundleHandler,
 	}
 }
 
@@ -69,23 +91,35 @@ func ProvideHandlers(
 	adminHandlers *AdminHandlers,
 	gatewayHandler *GatewayHandler,
 	openaiGatewayHandler *OpenAIGatewayHandler,
+	chatCompletionsHandler *ChatCompletionsHandler,
 	settingHandler *SettingHandler,
-	creemHandler *CreemHandler,
+	paymentHandler *PaymentHandler,
 	totpHandler *TotpHandler,
+	metricsHandler *MetricsHandler,
+	organizationHandler *OrganizationHandler,
+	transactionHandler *BalanceTransactionHandler,
+	invoiceHandler *InvoiceHandler,
+	subscriptionPlanHandler *SubscriptionPlanHandler,
 ) *Handlers {
 	return &Handlers{
-		Auth:          authHandler,
-		User:          userHandler,
-		APIKey:        apiKeyHandler,
-		Usage:         usageHandler,
-		Redeem:        redeemHandler,
-		Subscription:  subscriptionHandler,
-		Admin:         adminHandlers,
-		Gateway:       gatewayHandler,
-		OpenAIGateway: openaiGatewayHandler,
-		Setting:       settingHandler,
-		Creem:         creemHandler,
-		Totp:          totpHandler,
+		Auth:             authHandler,
+		User:             userHandler,
+		APIKey:           apiKeyHandler,
+		Usage:            usageHandler,
+		Redeem:           redeemHandler,
+		Subscription:     subscriptionHandler,
+		Admin:            adminHandlers,
+		Gateway:          gatewayHandler,
+		OpenAIGateway:    openaiGatewayHandler,
+		ChatCompletions:  chatCompletionsHandler,
+		Setting:          settingHandler,
+		Payment:          paymentHandler,
+		Totp:             totpHandler,
+		Metrics:          metricsHandler,
+		Organization:     organizationHandler,
+		Transaction:      transactionHandler,
+		Invoice:          invoiceHandler,
+		SubscriptionPlan: subscriptionPlanHandler,
 	}
 }
 
@@ -100,9 +134,15 @@ var ProviderSet = wire.NewSet(
 	NewSubscriptionHandler,
 	NewGatewayHandler,
 	NewOpenAIGatewayHandler,
+	NewChatCompletionsHandler,
 	NewTotpHandler,
 	ProvideSettingHandler,
-	NewCreemHandler,
+	NewPaymentHandler,
+	NewMetricsHandler,
+	NewOrganizationHandler,
+	NewBalanceTransactionHandler,
+	NewInvoiceHandler,
+	NewSubscriptionPlanHandler,
 
 	// Admin handlers
 	admin.NewDashboardHandler,
@@ -122,6 +162,17 @@ var ProviderSet = wire.NewSet(
 	admin.NewSubscriptionHandler,
 	admin.NewUsageHandler,
 	admin.NewUserAttributeHandler,
+	admin.NewAuditLogHandler,
+	admin.NewOrganizationHandler,
+	admin.NewBalanceLedgerHandler,
+	admin.NewAdminAPIKeyHandler,
+	admin.NewInvoiceHandler,
+	admin.NewPaymentOrderHandler,
+	admin.NewSubscriptionPlanHandler,
+	admin.NewModelPriceHandler,
+	admin.NewProxyPoolHandler,
+	admin.NewProxyImportHandler,
+	admin.NewAccountBundleHandler,
 
 	// AdminHandlers and Handlers constructors
 	ProvideAdminHandlers,
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// enabled 控制埋点是否生效；未开启 /metrics 时埋点为 no-op，避免无意义的 label 基数增长
+var enabled atomic.Bool
+
+// SetEnabled 开启/关闭埋点（由服务启动时根据配置调用）
+func SetEnabled(v bool) { enabled.Store(v) }
+
+// Enabled 返回埋点是否开启
+func Enabled() bool { return enabled.Load() }
+
+var (
+	factory = promauto.With(Default)
+
+	gatewayRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "sub2api_gateway_requests_total",
+		Help: "Total gateway requests by platform, model, group and HTTP status.",
+	}, []string{"platform", "model", "group", "status"})
+	gatewayRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sub2api_gateway_request_duration_seconds",
+		Help:    "Gateway request latency in seconds, including streaming time.",
+		Buckets: DefaultLatencyBuckets,
+	}, []string{"platform", "model", "group"})
+	gatewayFirstToken = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sub2api_gateway_first_token_seconds",
+		Help:    "Time to first upstream token in seconds for streaming requests.",
+		Buckets: []float64{0.25, 0.5, 1, 2, 3, 5, 10, 20, 30, 60},
+	}, []string{"platform", "model"})
+	gatewayFailovers = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "sub2api_gateway_upstream_failovers_total",
+		Help: "Upstream failures that triggered an account switch, by platform and upstream status code.",
+	}, []string{"platform", "status_code"})
+	billingCacheWriteDrops = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "sub2api_billing_cache_write_drops_total",
+		Help: "Billing cache write tasks dropped because the queue was full or closed.",
+	}, []string{"reason"})
+	schedulerOutboxLag = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "sub2api_scheduler_outbox_lag_seconds",
+		Help: "Age in seconds of the oldest unprocessed scheduler outbox event.",
+	})
+	accountSlotsInUse = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sub2api_account_slots_in_use",
+		Help: "Concurrency slots currently held on schedulable accounts, by platform.",
+	}, []string{"platform"})
+	accountSlotsCapacity = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sub2api_account_slots_capacity",
+		Help: "Total concurrency capacity of schedulable accounts, by platform.",
+	}, []string{"platform"})
+	accountWaitQueueDepth = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sub2api_account_wait_queue_depth",
+		Help: "Requests waiting for an account slot, by platform.",
+	}, []string{"platform"})
+)
+
+// AccountSlotStats 某平台账号槽位占用汇总
+type AccountSlotStats struct {
+	InUse    int
+	Capacity int
+	Waiting  int
+}
+
+// ObserveGatewayRequest 记录一次网关请求的状态与耗时
+func ObserveGatewayRequest(platform, model, group string, status int, duration time.Duration) {
+	if !Enabled() {
+		return
+	}
+	model = ModelLabel(model)
+	gatewayRequests.WithLabelValues(platform, model, group, strconv.Itoa(status)).Inc()
+	gatewayRequestDuration.WithLabelValues(platform, model, group).Observe(duration.Seconds())
+}
+
+// ObserveFirstToken 记录首 token 延迟（毫秒）
+func ObserveFirstToken(platform, model string, firstTokenMs int) {
+	if !Enabled() || firstTokenMs < 0 {
+		return
+	}
+	gatewayFirstToken.WithLabelValues(platform, ModelLabel(model)).Observe(float64(firstTokenMs) / 1000)
+}
+
+// IncUpstreamFailover 记录一次上游故障切换
+func IncUpstreamFailover(platform string, statusCode int) {
+	if !Enabled() {
+		return
+	}
+	gatewayFailovers.WithLabelValues(platform, strconv.Itoa(statusCode)).Inc()
+}
+
+// IncBillingCacheWriteDrop 记录一次计费缓存写入任务丢弃
+func IncBillingCacheWriteDrop(reason string) {
+	if !Enabled() {
+		return
+	}
+	billingCacheWriteDrops.WithLabelValues(reason).Inc()
+}
+
+// SetSchedulerOutboxLag 设置调度 outbox 积压时长
+func SetSchedulerOutboxLag(lag time.Duration) {
+	if !Enabled() {
+		return
+	}
+	if lag < 0 {
+		lag = 0
+	}
+	schedulerOutboxLag.Set(lag.Seconds())
+}
+
+// SetAccountSlots 以抓取时的快照整体替换账号槽位指标
+func SetAccountSlots(stats map[string]AccountSlotStats) {
+	accountSlotsInUse.Reset()
+	accountSlotsCapacity.Reset()
+	accountWaitQueueDepth.Reset()
+	for platform, st := range stats {
+		accountSlotsInUse.WithLabelValues(platform).Set(float64(st.InUse))
+		accountSlotsCapacity.WithLabelValues(platform).Set(float64(st.Capacity))
+		accountWaitQueueDepth.WithLabelValues(platform).Set(float64(st.Waiting))
+	}
+}
@@ -0,0 +1,104 @@
+// Package metrics 基于 prometheus/client_golang 注册网关指标，并通过独立的注册表输出。
+//
+// 埋点函数在未开启 /metrics 时为 no-op；model label 经 ModelLabel 收敛，避免客户端传入的任意字符串
+// 造成 label 基数无界增长。
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultLatencyBuckets 请求耗时直方图的默认分桶（秒）
+var DefaultLatencyBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300}
+
+// DefaultMaxModelLabels 未配置 model 白名单时，model label 的默认取值上限
+const DefaultMaxModelLabels = 200
+
+// OtherModel 未登记的 model 统一归入的 label 值
+const OtherModel = "other"
+
+// Default 进程级注册表，/metrics 端点输出该注册表（含 Go 运行时与进程指标）
+var Default = prometheus.NewRegistry()
+
+func init() {
+	Default.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler 返回输出 Default 注册表的 HTTP handler（支持内容协商与 gzip）
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Default, promhttp.HandlerOpts{})
+}
+
+// modelLabels 决定哪些 model 取值可以作为独立的 label
+var modelLabels = struct {
+	mu      sync.RWMutex
+	allowed map[string]struct{} // 显式配置的模型，非空时只有这些模型使用独立 label
+	known   func(model string) bool
+	max     int
+	seen    map[string]struct{}
+}{max: DefaultMaxModelLabels, seen: make(map[string]struct{})}
+
+// ConfigureModelLabels 配置 model label 的收敛规则：
+// allowed 非空时只有列出的模型使用独立 label；否则 known 认可的模型按首次出现登记，最多 maxLabels 个。
+// 其余取值一律记为 OtherModel。
+func ConfigureModelLabels(allowed []string, maxLabels int, known func(model string) bool) {
+	modelLabels.mu.Lock()
+	defer modelLabels.mu.Unlock()
+	modelLabels.allowed = nil
+	if len(allowed) > 0 {
+		modelLabels.allowed = make(map[string]struct{}, len(allowed))
+		for _, m := range allowed {
+			modelLabels.allowed[m] = struct{}{}
+		}
+	}
+	if maxLabels <= 0 {
+		maxLabels = DefaultMaxModelLabels
+	}
+	modelLabels.max = maxLabels
+	modelLabels.known = known
+	modelLabels.seen = make(map[string]struct{})
+}
+
+// ModelLabel 返回 model 在指标中使用的 label 值
+func ModelLabel(model string) string {
+	if model == "" {
+		return ""
+	}
+	modelLabels.mu.RLock()
+	if modelLabels.allowed != nil {
+		_, ok := modelLabels.allowed[model]
+		modelLabels.mu.RUnlock()
+		if ok {
+			return model
+		}
+		return OtherModel
+	}
+	_, seen := modelLabels.seen[model]
+	known := modelLabels.known
+	modelLabels.mu.RUnlock()
+	if seen {
+		return model
+	}
+	if known == nil || !known(model) {
+		return OtherModel
+	}
+
+	modelLabels.mu.Lock()
+	defer modelLabels.mu.Unlock()
+	if _, ok := modelLabels.seen[model]; ok {
+		return model
+	}
+	if len(modelLabels.seen) >= modelLabels.max {
+		return OtherModel
+	}
+	modelLabels.seen[model] = struct{}{}
+	return model
+}
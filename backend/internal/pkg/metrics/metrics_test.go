@@ -0,0 +1,83 @@
+//go:build unit
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec.Body.String()
+}
+
+func TestModelLabelAllowlist(t *testing.T) {
+	ConfigureModelLabels([]string{"claude-sonnet-4-5"}, 0, func(string) bool { return true })
+	defer ConfigureModelLabels(nil, 0, nil)
+
+	require.Equal(t, "claude-sonnet-4-5", ModelLabel("claude-sonnet-4-5"))
+	require.Equal(t, OtherModel, ModelLabel("gpt-5"))
+	require.Equal(t, "", ModelLabel(""))
+}
+
+func TestModelLabelKnownModelsAreCapped(t *testing.T) {
+	known := map[string]bool{"gpt-5": true, "gpt-5-mini": true, "gemini-2.5-pro": true}
+	ConfigureModelLabels(nil, 2, func(m string) bool { return known[m] })
+	defer ConfigureModelLabels(nil, 0, nil)
+
+	require.Equal(t, OtherModel, ModelLabel("random-\u0000-garbage"))
+	require.Equal(t, "gpt-5", ModelLabel("gpt-5"))
+	require.Equal(t, "gpt-5-mini", ModelLabel("gpt-5-mini"))
+	require.Equal(t, OtherModel, ModelLabel("gemini-2.5-pro")) // 超出上限
+	require.Equal(t, "gpt-5", ModelLabel("gpt-5"))             // 已登记的模型不受上限影响
+}
+
+func TestModelLabelWithoutKnownFuncFallsBackToOther(t *testing.T) {
+	ConfigureModelLabels(nil, 0, nil)
+	require.Equal(t, OtherModel, ModelLabel("claude-opus-4-1"))
+}
+
+func TestInstrumentsDisabledAreNoop(t *testing.T) {
+	SetEnabled(false)
+	IncBillingCacheWriteDrop("full")
+	require.NotContains(t, scrape(t), `sub2api_billing_cache_write_drops_total{reason="full"}`)
+
+	SetEnabled(true)
+	defer SetEnabled(false)
+	IncBillingCacheWriteDrop("full")
+	require.Contains(t, scrape(t), `sub2api_billing_cache_write_drops_total{reason="full"} 1`)
+}
+
+func TestObserveGatewayRequestBoundsModelLabel(t *testing.T) {
+	ConfigureModelLabels([]string{"gpt-5"}, 0, nil)
+	defer ConfigureModelLabels(nil, 0, nil)
+	SetEnabled(true)
+	defer SetEnabled(false)
+
+	ObserveGatewayRequest("openai", "gpt-5", "default", 200, time.Second)
+	ObserveGatewayRequest("openai", "client-chosen-model-1", "default", 200, time.Second)
+	ObserveGatewayRequest("openai", "client-chosen-model-2", "default", 200, time.Second)
+
+	out := scrape(t)
+	require.Contains(t, out, `sub2api_gateway_requests_total{group="default",model="gpt-5",platform="openai",status="200"} 1`)
+	require.Contains(t, out, `sub2api_gateway_requests_total{group="default",model="other",platform="openai",status="200"} 2`)
+	require.NotContains(t, out, "client-chosen-model")
+}
+
+func TestSetAccountSlotsReplacesSnapshot(t *testing.T) {
+	SetAccountSlots(map[string]AccountSlotStats{"openai": {InUse: 3, Capacity: 10}})
+	SetAccountSlots(map[string]AccountSlotStats{"gemini": {InUse: 1, Capacity: 4, Waiting: 2}})
+
+	out := scrape(t)
+	require.NotContains(t, out, `sub2api_account_slots_in_use{platform="openai"}`)
+	require.Contains(t, out, `sub2api_account_slots_in_use{platform="gemini"} 1`)
+	require.Contains(t, out, `sub2api_account_wait_queue_depth{platform="gemini"} 2`)
+}
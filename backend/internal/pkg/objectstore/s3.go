@@ -0,0 +1,223 @@
+// Package objectstore 提供最小化的 S3 兼容对象存储客户端
+//
+// 仅实现 PutObject（AWS Signature V4 签名），可对接 AWS S3、MinIO、Cloudflare R2
+// 等兼容服务。避免为单一上传场景引入完整 SDK。
+package objectstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRegion  = "us-east-1"
+	defaultTimeout = 10 * time.Minute
+	signAlgorithm  = "AWS4-HMAC-SHA256"
+	serviceName    = "s3"
+)
+
+// Config S3 兼容存储连接参数
+type Config struct {
+	Endpoint        string // 例如 https://s3.amazonaws.com、http://127.0.0.1:9000
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle: true 时使用 endpoint/bucket/key，否则使用 bucket.endpoint/key（MinIO 通常需要 true）
+	UsePathStyle bool
+}
+
+// Client S3 兼容存储客户端
+type Client struct {
+	cfg        Config
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// New 校验配置并创建客户端
+func New(cfg Config) (*Client, error) {
+	cfg.Endpoint = strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	cfg.Bucket = strings.TrimSpace(cfg.Bucket)
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("objectstore: endpoint and bucket are required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("objectstore: access key id and secret access key are required")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("objectstore: invalid endpoint %q", cfg.Endpoint)
+	}
+	if strings.TrimSpace(cfg.Region) == "" {
+		cfg.Region = defaultRegion
+	}
+	return &Client{
+		cfg:        cfg,
+		endpoint:   u,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		now:        time.Now,
+	}, nil
+}
+
+// Bucket 返回目标桶名
+func (c *Client) Bucket() string {
+	return c.cfg.Bucket
+}
+
+// PutObject 上传对象；body 会被读取两次（计算摘要 + 发送），因此需要可 Seek
+func (c *Client) PutObject(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return errors.New("objectstore: empty object key")
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, body); err != nil {
+		return fmt.Errorf("objectstore: hash body: %w", err)
+	}
+	payloadHash := hex.EncodeToString(hasher.Sum(nil))
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("objectstore: rewind body: %w", err)
+	}
+
+	u := c.objectURL(key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), io.NopCloser(body))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	c.sign(req, payloadHash, c.now().UTC())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("objectstore: put %s: %w", key, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("objectstore: put %s: status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (c *Client) objectURL(key string) *url.URL {
+	u := *c.endpoint
+	escapedKey := escapePath(key)
+	basePath := strings.TrimRight(u.Path, "/")
+	if c.cfg.UsePathStyle {
+		u.Path = basePath + "/" + c.cfg.Bucket + "/" + key
+		u.RawPath = basePath + "/" + escapePath(c.cfg.Bucket) + "/" + escapedKey
+	} else {
+		u.Host = c.cfg.Bucket + "." + u.Host
+		u.Path = basePath + "/" + key
+		u.RawPath = basePath + "/" + escapedKey
+	}
+	return &u
+}
+
+// sign 按 AWS Signature V4 为请求添加 Authorization 头
+func (c *Client) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headerNames := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		headerNames = append(headerNames, strings.ToLower(name))
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name)
+		canonicalHeaders.WriteByte(':')
+		canonicalHeaders.WriteString(strings.TrimSpace(value))
+		canonicalHeaders.WriteByte('\n')
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := dateStamp + "/" + c.cfg.Region + "/" + serviceName + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		signAlgorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey(c.cfg.SecretAccessKey, dateStamp, c.cfg.Region, serviceName), []byte(stringToSign)))
+	req.Header.Set("Authorization", signAlgorithm+" Credential="+c.cfg.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+	// Host 由 net/http 根据 URL 填充，不能作为普通头发送
+	req.Header.Del("Host")
+}
+
+func signingKey(secret, dateStamp, region, service string) []byte {
+	kDate := hmacSHA256([]byte("AWS4"+secret), []byte(dateStamp))
+	kRegion := hmacSHA256(kDate, []byte(region))
+	kService := hmacSHA256(kRegion, []byte(service))
+	return hmacSHA256(kService, []byte("aws4_request"))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(data)
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// escapePath 按 S3 规则对路径逐段做 URI 编码（保留 /）
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, seg := range segments {
+		segments[i] = uriEncode(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", ch)
+	}
+	return b.String()
+}
@@ -0,0 +1,478 @@
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultClaudeMaxTokens Claude Messages 要求 max_tokens 必填，客户端未指定时使用该值
+const defaultClaudeMaxTokens = 8192
+
+// ChatToClaudeRequest 将 Chat Completions 请求转换为 Claude Messages 请求体
+func ChatToClaudeRequest(req *ChatCompletionRequest) ([]byte, error) {
+	out := map[string]any{
+		"model":  req.Model,
+		"stream": req.Stream,
+	}
+
+	maxTokens := req.OutputTokenLimit()
+	if maxTokens <= 0 {
+		maxTokens = defaultClaudeMaxTokens
+	}
+	out["max_tokens"] = maxTokens
+	if req.Temperature != nil {
+		out["temperature"] = *req.Temperature
+	}
+	if req.TopP != nil {
+		out["top_p"] = *req.TopP
+	}
+	if stops := req.StopSequences(); len(stops) > 0 {
+		out["stop_sequences"] = stops
+	}
+
+	var systemParts []string
+	messages := make([]map[string]any, 0, len(req.Messages))
+	appendBlocks := func(role string, blocks []map[string]any) {
+		if len(blocks) == 0 {
+			return
+		}
+		// Claude 要求 user/assistant 交替，连续同角色消息合并为一条
+		if n := len(messages); n > 0 && messages[n-1]["role"] == role {
+			prev := messages[n-1]["content"].([]map[string]any)
+			messages[n-1]["content"] = append(prev, blocks...)
+			return
+		}
+		messages = append(messages, map[string]any{"role": role, "content": blocks})
+	}
+
+	for i := range req.Messages {
+		msg := &req.Messages[i]
+		switch msg.Role {
+		case "system", "developer":
+			if text := msg.ContentText(); text != "" {
+				systemParts = append(systemParts, text)
+			}
+		case "user":
+			blocks, err := chatContentToClaudeBlocks(msg)
+			if err != nil {
+				return nil, err
+			}
+			appendBlocks("user", blocks)
+		case "assistant":
+			blocks, err := chatContentToClaudeBlocks(msg)
+			if err != nil {
+				return nil, err
+			}
+			for _, tc := range msg.ToolCalls {
+				input := map[string]any{}
+				if args := strings.TrimSpace(tc.Function.Arguments); args != "" {
+					if err := json.Unmarshal([]byte(args), &input); err != nil {
+						return nil, fmt.Errorf("invalid tool_calls arguments for %s: %w", tc.Function.Name, err)
+					}
+				}
+				blocks = append(blocks, map[string]any{
+					"type":  "tool_use",
+					"id":    tc.ID,
+					"name":  tc.Function.Name,
+					"input": input,
+				})
+			}
+			appendBlocks("assistant", blocks)
+		case "tool", "function":
+			appendBlocks("user", []map[string]any{{
+				"type":        "tool_result",
+				"tool_use_id": msg.ToolCallID,
+				"content":     msg.ContentText(),
+			}})
+		default:
+			return nil, fmt.Errorf("unsupported message role: %s", msg.Role)
+		}
+	}
+
+	if instruction := responseFormatInstruction(req.ResponseFormat); instruction != "" {
+		systemParts = append(systemParts, instruction)
+	}
+	if len(systemParts) > 0 {
+		out["system"] = strings.Join(systemParts, "\n\n")
+	}
+	out["messages"] = messages
+
+	if len(req.Tools) > 0 {
+		tools := make([]map[string]any, 0, len(req.Tools))
+		for _, t := range req.Tools {
+			if t.Type != "" && t.Type != "function" {
+				continue
+			}
+			schema := json.RawMessage(`{"type":"object","properties":{}}`)
+			if len(t.Function.Parameters) > 0 {
+				schema = t.Function.Parameters
+			}
+			tool := map[string]any{
+				"name":         t.Function.Name,
+				"input_schema": schema,
+			}
+			if t.Function.Description != "" {
+				tool["description"] = t.Function.Description
+			}
+			tools = append(tools, tool)
+		}
+		if len(tools) > 0 {
+			out["tools"] = tools
+		}
+	}
+
+	if toolChoice := chatToolChoiceToClaude(req.ToolChoice, req.ParallelToolCalls); toolChoice != nil {
+		if _, hasTools := out["tools"]; hasTools {
+			out["tool_choice"] = toolChoice
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+func chatContentToClaudeBlocks(msg *ChatMessage) ([]map[string]any, error) {
+	parts, text, isString := msg.ContentParts()
+	if isString {
+		if text == "" {
+			return nil, nil
+		}
+		return []map[string]any{{"type": "text", "text": text}}, nil
+	}
+	blocks := make([]map[string]any, 0, len(parts))
+	for _, p := range parts {
+		switch p.Type {
+		case "text", "input_text", "output_text":
+			if p.Text != "" {
+				blocks = append(blocks, map[string]any{"type": "text", "text": p.Text})
+			}
+		case "image_url":
+			if p.ImageURL == nil || p.ImageURL.URL == "" {
+				continue
+			}
+			if mediaType, data, ok := ParseDataURI(p.ImageURL.URL); ok {
+				blocks = append(blocks, map[string]any{
+					"type": "image",
+					"source": map[string]any{
+						"type":       "base64",
+						"media_type": mediaType,
+						"data":       data,
+					},
+				})
+				continue
+			}
+			blocks = append(blocks, map[string]any{
+				"type":   "image",
+				"source": map[string]any{"type": "url", "url": p.ImageURL.URL},
+			})
+		default:
+			return nil, fmt.Errorf("unsupported content part type: %s", p.Type)
+		}
+	}
+	return blocks, nil
+}
+
+func chatToolChoiceToClaude(raw json.RawMessage, parallel *bool) map[string]any {
+	var choice map[string]any
+	if len(raw) > 0 {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			switch s {
+			case "none":
+				choice = map[string]any{"type": "none"}
+			case "required":
+				choice = map[string]any{"type": "any"}
+			default:
+				choice = map[string]any{"type": "auto"}
+			}
+		} else {
+			var obj ChatTool
+			if err := json.Unmarshal(raw, &obj); err == nil && obj.Function.Name != "" {
+				choice = map[string]any{"type": "tool", "name": obj.Function.Name}
+			}
+		}
+	}
+	if parallel != nil && !*parallel {
+		if choice == nil {
+			choice = map[string]any{"type": "auto"}
+		}
+		if choice["type"] != "none" {
+			choice["disable_parallel_tool_use"] = true
+		}
+	}
+	return choice
+}
+
+// claudeStopReasonToFinish 映射 Claude stop_reason 到 Chat Completions finish_reason
+func claudeStopReasonToFinish(reason string) string {
+	switch reason {
+	case "max_tokens", "model_context_window_exceeded":
+		return ChatFinishLength
+	case "tool_use":
+		return ChatFinishToolCalls
+	case "refusal":
+		return ChatFinishFilter
+	default:
+		return ChatFinishStop
+	}
+}
+
+type claudeUsage struct {
+	InputTokens              int `json:"input_tokens"`
+	OutputTokens             int `json:"output_tokens"`
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+}
+
+func (u *claudeUsage) merge(other *claudeUsage) {
+	if other == nil {
+		return
+	}
+	if other.InputTokens > 0 {
+		u.InputTokens = other.InputTokens
+	}
+	if other.OutputTokens > 0 {
+		u.OutputTokens = other.OutputTokens
+	}
+	if other.CacheCreationInputTokens > 0 {
+		u.CacheCreationInputTokens = other.CacheCreationInputTokens
+	}
+	if other.CacheReadInputTokens > 0 {
+		u.CacheReadInputTokens = other.CacheReadInputTokens
+	}
+}
+
+func (u *claudeUsage) toChat() *ChatUsage {
+	prompt := u.InputTokens + u.CacheCreationInputTokens + u.CacheReadInputTokens
+	return &ChatUsage{
+		PromptTokens:        prompt,
+		CompletionTokens:    u.OutputTokens,
+		TotalTokens:         prompt + u.OutputTokens,
+		PromptTokensDetails: &ChatPromptTokenDetails{CachedTokens: u.CacheReadInputTokens},
+	}
+}
+
+type claudeContentBlock struct {
+	Type     string          `json:"type"`
+	Text     string          `json:"text"`
+	Thinking string          `json:"thinking"`
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Input    json.RawMessage `json:"input"`
+}
+
+// ClaudeToChatConverter 将 Claude Messages 响应转换为 Chat Completions 响应
+type ClaudeToChatConverter struct {
+	model        string
+	includeUsage bool
+	created      int64
+
+	id            string
+	usage         claudeUsage
+	finishReason  string
+	toolIndex     map[int]int
+	nextToolIndex int
+	roleSent      bool
+	finished      bool
+}
+
+// NewClaudeToChatConverter 创建转换器，model 为客户端请求的模型名
+func NewClaudeToChatConverter(model string, includeUsage bool) *ClaudeToChatConverter {
+	return &ClaudeToChatConverter{
+		model:        model,
+		includeUsage: includeUsage,
+		created:      time.Now().Unix(),
+		id:           fmt.Sprintf("chatcmpl-%d", time.Now().UnixNano()),
+		toolIndex:    make(map[int]int),
+	}
+}
+
+// ConvertResponse 转换非流式 Claude 响应
+func (c *ClaudeToChatConverter) ConvertResponse(body []byte) ([]byte, error) {
+	var resp struct {
+		ID         string               `json:"id"`
+		Content    []claudeContentBlock `json:"content"`
+		StopReason string               `json:"stop_reason"`
+		Usage      claudeUsage          `json:"usage"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse claude response: %w", err)
+	}
+
+	var text, reasoning strings.Builder
+	var toolCalls []ChatToolCall
+	for _, block := range resp.Content {
+		switch block.Type {
+		case "text":
+			text.WriteString(block.Text)
+		case "thinking":
+			reasoning.WriteString(block.Thinking)
+		case "tool_use":
+			args := string(block.Input)
+			if args == "" || args == "null" {
+				args = "{}"
+			}
+			toolCalls = append(toolCalls, ChatToolCall{
+				ID:       block.ID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: block.Name, Arguments: args},
+			})
+		}
+	}
+
+	msg := &ChatResponseMessage{
+		Role:             "assistant",
+		ReasoningContent: reasoning.String(),
+		ToolCalls:        toolCalls,
+	}
+	if text.Len() > 0 || len(toolCalls) == 0 {
+		msg.Content = strPtr(text.String())
+	}
+
+	id := c.id
+	if resp.ID != "" {
+		id = "chatcmpl-" + resp.ID
+	}
+	finish := claudeStopReasonToFinish(resp.StopReason)
+	return json.Marshal(&ChatCompletion{
+		ID:      id,
+		Object:  "chat.completion",
+		Created: c.created,
+		Model:   c.model,
+		Choices: []ChatChoice{{Index: 0, Message: msg, FinishReason: &finish}},
+		Usage:   resp.Usage.toChat(),
+	})
+}
+
+// ConvertStreamEvent 转换单个 Claude SSE 事件
+func (c *ClaudeToChatConverter) ConvertStreamEvent(event, data string) []byte {
+	if c.finished {
+		return nil
+	}
+	var payload struct {
+		Type    string          `json:"type"`
+		Index   int             `json:"index"`
+		Message json.RawMessage `json:"message"`
+		Delta   struct {
+			Type        string `json:"type"`
+			Text        string `json:"text"`
+			Thinking    string `json:"thinking"`
+			PartialJSON string `json:"partial_json"`
+			StopReason  string `json:"stop_reason"`
+		} `json:"delta"`
+		ContentBlock claudeContentBlock `json:"content_block"`
+		Usage        *claudeUsage       `json:"usage"`
+		Error        *struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(data), &payload); err != nil {
+		return nil
+	}
+	eventType := payload.Type
+	if eventType == "" {
+		eventType = event
+	}
+
+	switch eventType {
+	case "message_start":
+		var msg struct {
+			ID    string       `json:"id"`
+			Usage *claudeUsage `json:"usage"`
+		}
+		if err := json.Unmarshal(payload.Message, &msg); err == nil {
+			if msg.ID != "" {
+				c.id = "chatcmpl-" + msg.ID
+			}
+			c.usage.merge(msg.Usage)
+		}
+		return c.emitRole()
+	case "content_block_start":
+		if payload.ContentBlock.Type != "tool_use" {
+			return nil
+		}
+		idx := c.nextToolIndex
+		c.nextToolIndex++
+		c.toolIndex[payload.Index] = idx
+		out := c.emitRole()
+		return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{
+			ToolCalls: []ChatToolCall{{
+				Index:    &idx,
+				ID:       payload.ContentBlock.ID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: payload.ContentBlock.Name, Arguments: ""},
+			}},
+		}, nil))...)
+	case "content_block_delta":
+		out := c.emitRole()
+		switch payload.Delta.Type {
+		case "text_delta":
+			return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{Content: strPtr(payload.Delta.Text)}, nil))...)
+		case "thinking_delta":
+			return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{ReasoningContent: payload.Delta.Thinking}, nil))...)
+		case "input_json_delta":
+			idx, ok := c.toolIndex[payload.Index]
+			if !ok {
+				return out
+			}
+			return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{
+				ToolCalls: []ChatToolCall{{Index: &idx, Function: ChatFunctionCall{Arguments: payload.Delta.PartialJSON}}},
+			}, nil))...)
+		}
+		return out
+	case "message_delta":
+		c.usage.merge(payload.Usage)
+		if payload.Delta.StopReason != "" {
+			c.finishReason = claudeStopReasonToFinish(payload.Delta.StopReason)
+		}
+		return nil
+	case "message_stop":
+		return c.FinishStream()
+	case "error":
+		c.finished = true
+		errType, message := "upstream_error", "Upstream stream error"
+		if payload.Error != nil {
+			errType, message = payload.Error.Type, payload.Error.Message
+		}
+		out := []byte("data: ")
+		out = append(out, BuildChatError(errType, message)...)
+		out = append(out, "\n\n"...)
+		return append(out, ChatStreamDone...)
+	}
+	return nil
+}
+
+// FinishStream 输出结束 chunk、可选的 usage chunk 和 [DONE]
+func (c *ClaudeToChatConverter) FinishStream() []byte {
+	if c.finished {
+		return nil
+	}
+	c.finished = true
+	out := c.emitRole()
+	finish := c.finishReason
+	if finish == "" {
+		finish = ChatFinishStop
+	}
+	out = append(out, encodeChunk(newChunk(c.id, c.model, c.created, nil, &finish))...)
+	if c.includeUsage {
+		out = append(out, encodeChunk(&ChatCompletion{
+			ID:      c.id,
+			Object:  "chat.completion.chunk",
+			Created: c.created,
+			Model:   c.model,
+			Choices: []ChatChoice{},
+			Usage:   c.usage.toChat(),
+		})...)
+	}
+	return append(out, ChatStreamDone...)
+}
+
+func (c *ClaudeToChatConverter) emitRole() []byte {
+	if c.roleSent {
+		return nil
+	}
+	c.roleSent = true
+	return encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{Role: "assistant", Content: strPtr("")}, nil))
+}
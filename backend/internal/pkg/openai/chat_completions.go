@@ -0,0 +1,327 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Chat Completions 兼容层的公共类型。
+// 网关内部只转发 Claude Messages / OpenAI Responses 两种协议，
+// Chat Completions 请求在入口处被转换成其中一种，响应再转换回 chat.completion(.chunk)。
+
+// ChatCompletionRequest 表示 /v1/chat/completions 请求体
+type ChatCompletionRequest struct {
+	Model               string              `json:"model"`
+	Messages            []ChatMessage       `json:"messages"`
+	Stream              bool                `json:"stream,omitempty"`
+	StreamOptions       *ChatStreamOptions  `json:"stream_options,omitempty"`
+	MaxTokens           *int                `json:"max_tokens,omitempty"`
+	MaxCompletionTokens *int                `json:"max_completion_tokens,omitempty"`
+	Temperature         *float64            `json:"temperature,omitempty"`
+	TopP                *float64            `json:"top_p,omitempty"`
+	Stop                json.RawMessage     `json:"stop,omitempty"`
+	Tools               []ChatTool          `json:"tools,omitempty"`
+	ToolChoice          json.RawMessage     `json:"tool_choice,omitempty"`
+	ParallelToolCalls   *bool               `json:"parallel_tool_calls,omitempty"`
+	ResponseFormat      *ChatResponseFormat `json:"response_format,omitempty"`
+	ReasoningEffort     string              `json:"reasoning_effort,omitempty"`
+	User                string              `json:"user,omitempty"`
+}
+
+// ChatStreamOptions 流式选项
+type ChatStreamOptions struct {
+	IncludeUsage bool `json:"include_usage,omitempty"`
+}
+
+// ChatMessage 单条消息；content 可以是字符串或 content part 数组
+type ChatMessage struct {
+	Role       string          `json:"role"`
+	Content    json.RawMessage `json:"content,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	ToolCalls  []ChatToolCall  `json:"tool_calls,omitempty"`
+	ToolCallID string          `json:"tool_call_id,omitempty"`
+}
+
+// ChatContentPart 多模态消息片段
+type ChatContentPart struct {
+	Type     string        `json:"type"`
+	Text     string        `json:"text,omitempty"`
+	ImageURL *ChatImageURL `json:"image_url,omitempty"`
+}
+
+// ChatImageURL 图片片段，URL 可以是 http(s) 地址或 data URI
+type ChatImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// ChatTool 工具定义（仅支持 function 类型）
+type ChatTool struct {
+	Type     string       `json:"type"`
+	Function ChatFunction `json:"function"`
+}
+
+// ChatFunction 函数工具定义
+type ChatFunction struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+	Strict      *bool           `json:"strict,omitempty"`
+}
+
+// ChatToolCall assistant 消息中的工具调用
+type ChatToolCall struct {
+	Index    *int             `json:"index,omitempty"`
+	ID       string           `json:"id,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Function ChatFunctionCall `json:"function"`
+}
+
+// ChatFunctionCall 工具调用的函数名与参数（参数为 JSON 字符串）
+type ChatFunctionCall struct {
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments"`
+}
+
+// ChatResponseFormat response_format 字段
+type ChatResponseFormat struct {
+	Type       string              `json:"type"`
+	JSONSchema *ChatJSONSchemaSpec `json:"json_schema,omitempty"`
+}
+
+// ChatJSONSchemaSpec json_schema 结构化输出定义
+type ChatJSONSchemaSpec struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Schema      json.RawMessage `json:"schema,omitempty"`
+	Strict      *bool           `json:"strict,omitempty"`
+}
+
+// ChatCompletion 非流式响应
+type ChatCompletion struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"`
+	Created int64        `json:"created"`
+	Model   string       `json:"model"`
+	Choices []ChatChoice `json:"choices"`
+	Usage   *ChatUsage   `json:"usage,omitempty"`
+}
+
+// ChatChoice 响应中的候选项；非流式使用 Message，流式使用 Delta
+type ChatChoice struct {
+	Index        int                  `json:"index"`
+	Message      *ChatResponseMessage `json:"message,omitempty"`
+	Delta        *ChatResponseMessage `json:"delta,omitempty"`
+	FinishReason *string              `json:"finish_reason"`
+}
+
+// ChatResponseMessage assistant 输出消息/增量
+type ChatResponseMessage struct {
+	Role             string         `json:"role,omitempty"`
+	Content          *string        `json:"content,omitempty"`
+	ReasoningContent string         `json:"reasoning_content,omitempty"`
+	ToolCalls        []ChatToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatUsage token 用量
+type ChatUsage struct {
+	PromptTokens        int                     `json:"prompt_tokens"`
+	CompletionTokens    int                     `json:"completion_tokens"`
+	TotalTokens         int                     `json:"total_tokens"`
+	PromptTokensDetails *ChatPromptTokenDetails `json:"prompt_tokens_details,omitempty"`
+}
+
+// ChatPromptTokenDetails 输入 token 明细
+type ChatPromptTokenDetails struct {
+	CachedTokens int `json:"cached_tokens"`
+}
+
+// Chat Completions finish_reason 取值
+const (
+	ChatFinishStop      = "stop"
+	ChatFinishLength    = "length"
+	ChatFinishToolCalls = "tool_calls"
+	ChatFinishFilter    = "content_filter"
+)
+
+// ParseChatCompletionRequest 解析并校验 Chat Completions 请求
+func ParseChatCompletionRequest(body []byte) (*ChatCompletionRequest, error) {
+	var req ChatCompletionRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("parse chat completion request: %w", err)
+	}
+	if strings.TrimSpace(req.Model) == "" {
+		return nil, fmt.Errorf("model is required")
+	}
+	if len(req.Messages) == 0 {
+		return nil, fmt.Errorf("messages is required")
+	}
+	return &req, nil
+}
+
+// IncludeUsage 流式响应是否需要在末尾追加 usage chunk
+func (r *ChatCompletionRequest) IncludeUsage() bool {
+	return r != nil && r.StreamOptions != nil && r.StreamOptions.IncludeUsage
+}
+
+// OutputTokenLimit 返回 max_completion_tokens / max_tokens 中生效的那个
+func (r *ChatCompletionRequest) OutputTokenLimit() int {
+	if r.MaxCompletionTokens != nil && *r.MaxCompletionTokens > 0 {
+		return *r.MaxCompletionTokens
+	}
+	if r.MaxTokens != nil && *r.MaxTokens > 0 {
+		return *r.MaxTokens
+	}
+	return 0
+}
+
+// StopSequences 将 stop（字符串或数组）统一为字符串切片
+func (r *ChatCompletionRequest) StopSequences() []string {
+	if len(r.Stop) == 0 {
+		return nil
+	}
+	var single string
+	if err := json.Unmarshal(r.Stop, &single); err == nil {
+		if single == "" {
+			return nil
+		}
+		return []string{single}
+	}
+	var list []string
+	if err := json.Unmarshal(r.Stop, &list); err == nil {
+		return list
+	}
+	return nil
+}
+
+// ContentText 返回消息中的纯文本（字符串 content 或所有 text part 拼接）
+func (m *ChatMessage) ContentText() string {
+	parts, text, isString := m.ContentParts()
+	if isString {
+		return text
+	}
+	var sb strings.Builder
+	for _, p := range parts {
+		if p.Type == "text" || p.Type == "input_text" {
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(p.Text)
+		}
+	}
+	return sb.String()
+}
+
+// ContentParts 解析 content：字符串时返回 (nil, text, true)，数组时返回 (parts, "", false)
+func (m *ChatMessage) ContentParts() ([]ChatContentPart, string, bool) {
+	raw := bytes.TrimSpace(m.Content)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, "", true
+	}
+	if raw[0] == '"' {
+		var text string
+		_ = json.Unmarshal(raw, &text)
+		return nil, text, true
+	}
+	var parts []ChatContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return nil, string(raw), true
+	}
+	return parts, "", false
+}
+
+// ParseDataURI 解析 data:<media>;base64,<data> 格式的图片地址
+func ParseDataURI(uri string) (mediaType, data string, ok bool) {
+	if !strings.HasPrefix(uri, "data:") {
+		return "", "", false
+	}
+	rest := strings.TrimPrefix(uri, "data:")
+	meta, payload, found := strings.Cut(rest, ",")
+	if !found {
+		return "", "", false
+	}
+	mediaType, encoding, _ := strings.Cut(meta, ";")
+	if encoding != "base64" {
+		return "", "", false
+	}
+	if mediaType == "" {
+		mediaType = "image/png"
+	}
+	return mediaType, payload, true
+}
+
+// responseFormatInstruction 为不支持原生结构化输出的上游生成提示词
+func responseFormatInstruction(rf *ChatResponseFormat) string {
+	if rf == nil {
+		return ""
+	}
+	switch rf.Type {
+	case "json_object":
+		return "Respond only with a single valid JSON object. Do not include any text outside of the JSON."
+	case "json_schema":
+		if rf.JSONSchema == nil || len(rf.JSONSchema.Schema) == 0 {
+			return "Respond only with a single valid JSON object. Do not include any text outside of the JSON."
+		}
+		return "Respond only with a single valid JSON value that conforms to the following JSON Schema. Do not include any text outside of the JSON.\n" + string(rf.JSONSchema.Schema)
+	default:
+		return ""
+	}
+}
+
+// newChunk 构造一个只有单个 choice 的 chat.completion.chunk
+func newChunk(id, model string, created int64, delta *ChatResponseMessage, finishReason *string) *ChatCompletion {
+	if delta == nil {
+		delta = &ChatResponseMessage{}
+	}
+	return &ChatCompletion{
+		ID:      id,
+		Object:  "chat.completion.chunk",
+		Created: created,
+		Model:   model,
+		Choices: []ChatChoice{{Index: 0, Delta: delta, FinishReason: finishReason}},
+	}
+}
+
+// encodeChunk 将 chunk 编码为 SSE data 行
+func encodeChunk(chunk any) []byte {
+	b, err := json.Marshal(chunk)
+	if err != nil {
+		return nil
+	}
+	out := make([]byte, 0, len(b)+8)
+	out = append(out, "data: "...)
+	out = append(out, b...)
+	out = append(out, "\n\n"...)
+	return out
+}
+
+// ChatStreamDone 流式响应结束标记
+const ChatStreamDone = "data: [DONE]\n\n"
+
+// BuildChatError 构造 OpenAI 格式的错误体
+func BuildChatError(errType, message string) []byte {
+	b, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"type":    errType,
+			"message": message,
+			"code":    nil,
+		},
+	})
+	return b
+}
+
+// ChatResponseConverter 把上游（Claude Messages 或 OpenAI Responses）的响应转换为 Chat Completions 格式
+type ChatResponseConverter interface {
+	// ConvertStreamEvent 处理一个完整的 SSE 事件（event 名可能为空），返回需要写给客户端的数据
+	ConvertStreamEvent(event, data string) []byte
+	// FinishStream 在上游流结束时调用，补齐 finish chunk / usage / [DONE]
+	FinishStream() []byte
+	// ConvertResponse 转换非流式响应体
+	ConvertResponse(body []byte) ([]byte, error)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
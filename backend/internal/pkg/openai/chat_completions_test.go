@@ -0,0 +1,218 @@
+package openai
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatToClaudeRequest(t *testing.T) {
+	body := `{
+		"model": "claude-sonnet-4-5",
+		"stream": true,
+		"max_tokens": 256,
+		"stop": "END",
+		"messages": [
+			{"role": "system", "content": "You are helpful."},
+			{"role": "user", "content": [{"type": "text", "text": "What is the weather?"}, {"type": "image_url", "image_url": {"url": "data:image/jpeg;base64,AAAA"}}]},
+			{"role": "assistant", "content": null, "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}]},
+			{"role": "tool", "tool_call_id": "call_1", "content": "sunny"}
+		],
+		"tools": [{"type": "function", "function": {"name": "get_weather", "parameters": {"type": "object", "properties": {"city": {"type": "string"}}}}}],
+		"tool_choice": "required",
+		"response_format": {"type": "json_object"}
+	}`
+	req, err := ParseChatCompletionRequest([]byte(body))
+	require.NoError(t, err)
+
+	out, err := ChatToClaudeRequest(req)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Equal(t, float64(256), got["max_tokens"])
+	require.Equal(t, []any{"END"}, got["stop_sequences"])
+	require.Contains(t, got["system"], "You are helpful.")
+	require.Contains(t, got["system"], "valid JSON object")
+	require.Equal(t, map[string]any{"type": "any"}, got["tool_choice"])
+
+	messages := got["messages"].([]any)
+	require.Len(t, messages, 3)
+
+	user := messages[0].(map[string]any)
+	userContent := user["content"].([]any)
+	require.Len(t, userContent, 2)
+	image := userContent[1].(map[string]any)
+	require.Equal(t, "image", image["type"])
+	require.Equal(t, "image/jpeg", image["source"].(map[string]any)["media_type"])
+
+	assistant := messages[1].(map[string]any)
+	toolUse := assistant["content"].([]any)[0].(map[string]any)
+	require.Equal(t, "tool_use", toolUse["type"])
+	require.Equal(t, "call_1", toolUse["id"])
+	require.Equal(t, map[string]any{"city": "Paris"}, toolUse["input"])
+
+	toolResult := messages[2].(map[string]any)["content"].([]any)[0].(map[string]any)
+	require.Equal(t, "tool_result", toolResult["type"])
+	require.Equal(t, "call_1", toolResult["tool_use_id"])
+}
+
+func TestChatToResponsesRequest(t *testing.T) {
+	body := `{
+		"model": "gpt-5.1",
+		"max_completion_tokens": 100,
+		"messages": [
+			{"role": "developer", "content": "Be terse."},
+			{"role": "user", "content": "hi"},
+			{"role": "assistant", "content": "", "tool_calls": [{"id": "call_9", "type": "function", "function": {"name": "lookup", "arguments": "{}"}}]},
+			{"role": "tool", "tool_call_id": "call_9", "content": "42"}
+		],
+		"tool_choice": {"type": "function", "function": {"name": "lookup"}},
+		"response_format": {"type": "json_schema", "json_schema": {"name": "answer", "schema": {"type": "object"}, "strict": true}}
+	}`
+	req, err := ParseChatCompletionRequest([]byte(body))
+	require.NoError(t, err)
+
+	out, err := ChatToResponsesRequest(req)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Equal(t, "Be terse.", got["instructions"])
+	require.Equal(t, float64(100), got["max_output_tokens"])
+	require.Equal(t, map[string]any{"type": "function", "name": "lookup"}, got["tool_choice"])
+
+	format := got["text"].(map[string]any)["format"].(map[string]any)
+	require.Equal(t, "json_schema", format["type"])
+	require.Equal(t, "answer", format["name"])
+	require.Equal(t, true, format["strict"])
+
+	input := got["input"].([]any)
+	require.Len(t, input, 3)
+	require.Equal(t, "message", input[0].(map[string]any)["type"])
+	require.Equal(t, "function_call", input[1].(map[string]any)["type"])
+	require.Equal(t, "call_9", input[1].(map[string]any)["call_id"])
+	require.Equal(t, "function_call_output", input[2].(map[string]any)["type"])
+}
+
+func TestParseChatCompletionRequest_Validation(t *testing.T) {
+	_, err := ParseChatCompletionRequest([]byte(`{"messages":[{"role":"user","content":"hi"}]}`))
+	require.Error(t, err)
+	_, err = ParseChatCompletionRequest([]byte(`{"model":"x","messages":[]}`))
+	require.Error(t, err)
+}
+
+func TestClaudeToChatConverter_Response(t *testing.T) {
+	conv := NewClaudeToChatConverter("claude-sonnet-4-5", false)
+	out, err := conv.ConvertResponse([]byte(`{
+		"id": "msg_1",
+		"content": [
+			{"type": "text", "text": "Let me check."},
+			{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": {"city": "Paris"}}
+		],
+		"stop_reason": "tool_use",
+		"usage": {"input_tokens": 10, "output_tokens": 5, "cache_read_input_tokens": 20}
+	}`))
+	require.NoError(t, err)
+
+	var got ChatCompletion
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Equal(t, "chat.completion", got.Object)
+	require.Equal(t, "claude-sonnet-4-5", got.Model)
+	require.Len(t, got.Choices, 1)
+	require.Equal(t, ChatFinishToolCalls, *got.Choices[0].FinishReason)
+	require.Equal(t, "Let me check.", *got.Choices[0].Message.Content)
+	require.Len(t, got.Choices[0].Message.ToolCalls, 1)
+	require.JSONEq(t, `{"city":"Paris"}`, got.Choices[0].Message.ToolCalls[0].Function.Arguments)
+	require.Equal(t, 30, got.Usage.PromptTokens)
+	require.Equal(t, 20, got.Usage.PromptTokensDetails.CachedTokens)
+	require.Equal(t, 35, got.Usage.TotalTokens)
+}
+
+func TestClaudeToChatConverter_Stream(t *testing.T) {
+	conv := NewClaudeToChatConverter("claude-sonnet-4-5", true)
+	events := []struct{ event, data string }{
+		{"message_start", `{"type":"message_start","message":{"id":"msg_1","usage":{"input_tokens":12,"output_tokens":0}}}`},
+		{"content_block_start", `{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`},
+		{"content_block_delta", `{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hello"}}`},
+		{"content_block_start", `{"type":"content_block_start","index":1,"content_block":{"type":"tool_use","id":"toolu_1","name":"f"}}`},
+		{"content_block_delta", `{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"a\":1}"}}`},
+		{"message_delta", `{"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":7}}`},
+		{"message_stop", `{"type":"message_stop"}`},
+	}
+	var sb strings.Builder
+	for _, e := range events {
+		sb.Write(conv.ConvertStreamEvent(e.event, e.data))
+	}
+	require.Nil(t, conv.FinishStream())
+
+	chunks := parseChunks(t, sb.String())
+	require.Equal(t, "assistant", chunks[0].Choices[0].Delta.Role)
+	require.Equal(t, "Hello", *chunks[1].Choices[0].Delta.Content)
+	require.Equal(t, "toolu_1", chunks[2].Choices[0].Delta.ToolCalls[0].ID)
+	require.Equal(t, 0, *chunks[3].Choices[0].Delta.ToolCalls[0].Index)
+	require.Equal(t, `{"a":1}`, chunks[3].Choices[0].Delta.ToolCalls[0].Function.Arguments)
+	require.Equal(t, ChatFinishToolCalls, *chunks[4].Choices[0].FinishReason)
+	require.Empty(t, chunks[5].Choices)
+	require.Equal(t, 12, chunks[5].Usage.PromptTokens)
+	require.Equal(t, 7, chunks[5].Usage.CompletionTokens)
+	require.True(t, strings.HasSuffix(sb.String(), ChatStreamDone))
+}
+
+func TestResponsesToChatConverter_Stream(t *testing.T) {
+	conv := NewResponsesToChatConverter("gpt-5.1", false)
+	events := []string{
+		`{"type":"response.created","response":{"id":"resp_1"}}`,
+		`{"type":"response.output_text.delta","delta":"Hi"}`,
+		`{"type":"response.output_item.added","item":{"id":"fc_1","type":"function_call","call_id":"call_1","name":"lookup"}}`,
+		`{"type":"response.function_call_arguments.delta","item_id":"fc_1","delta":"{}"}`,
+		`{"type":"response.completed","response":{"id":"resp_1","status":"completed","usage":{"input_tokens":3,"output_tokens":4}}}`,
+	}
+	var sb strings.Builder
+	for _, data := range events {
+		sb.Write(conv.ConvertStreamEvent("", data))
+	}
+
+	chunks := parseChunks(t, sb.String())
+	require.Len(t, chunks, 5)
+	require.Equal(t, "chatcmpl-resp_1", chunks[0].ID)
+	require.Equal(t, "Hi", *chunks[1].Choices[0].Delta.Content)
+	require.Equal(t, "call_1", chunks[2].Choices[0].Delta.ToolCalls[0].ID)
+	require.Equal(t, "{}", chunks[3].Choices[0].Delta.ToolCalls[0].Function.Arguments)
+	require.Equal(t, ChatFinishToolCalls, *chunks[4].Choices[0].FinishReason)
+}
+
+func TestResponsesToChatConverter_Response(t *testing.T) {
+	conv := NewResponsesToChatConverter("gpt-5.1", false)
+	out, err := conv.ConvertResponse([]byte(`{
+		"id": "resp_1",
+		"status": "incomplete",
+		"incomplete_details": {"reason": "max_output_tokens"},
+		"output": [{"type": "message", "content": [{"type": "output_text", "text": "partial"}]}],
+		"usage": {"input_tokens": 5, "output_tokens": 9, "input_tokens_details": {"cached_tokens": 2}}
+	}`))
+	require.NoError(t, err)
+
+	var got ChatCompletion
+	require.NoError(t, json.Unmarshal(out, &got))
+	require.Equal(t, "partial", *got.Choices[0].Message.Content)
+	require.Equal(t, ChatFinishLength, *got.Choices[0].FinishReason)
+	require.Equal(t, 14, got.Usage.TotalTokens)
+	require.Equal(t, 2, got.Usage.PromptTokensDetails.CachedTokens)
+}
+
+func parseChunks(t *testing.T, stream string) []ChatCompletion {
+	t.Helper()
+	var chunks []ChatCompletion
+	for _, line := range strings.Split(stream, "\n") {
+		if !strings.HasPrefix(line, "data: ") || line == "data: [DONE]" {
+			continue
+		}
+		var chunk ChatCompletion
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &chunk))
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
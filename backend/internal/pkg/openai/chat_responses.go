@@ -0,0 +1,454 @@
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChatToResponsesRequest 将 Chat Completions 请求转换为 OpenAI Responses 请求体
+func ChatToResponsesRequest(req *ChatCompletionRequest) ([]byte, error) {
+	out := map[string]any{
+		"model":  req.Model,
+		"stream": req.Stream,
+	}
+	if maxTokens := req.OutputTokenLimit(); maxTokens > 0 {
+		out["max_output_tokens"] = maxTokens
+	}
+	if req.Temperature != nil {
+		out["temperature"] = *req.Temperature
+	}
+	if req.TopP != nil {
+		out["top_p"] = *req.TopP
+	}
+	if req.ParallelToolCalls != nil {
+		out["parallel_tool_calls"] = *req.ParallelToolCalls
+	}
+	if req.ReasoningEffort != "" {
+		out["reasoning"] = map[string]any{"effort": req.ReasoningEffort}
+	}
+	if req.User != "" {
+		out["user"] = req.User
+	}
+
+	var instructions []string
+	input := make([]map[string]any, 0, len(req.Messages))
+	for i := range req.Messages {
+		msg := &req.Messages[i]
+		switch msg.Role {
+		case "system", "developer":
+			if text := msg.ContentText(); text != "" {
+				instructions = append(instructions, text)
+			}
+		case "user":
+			content, err := chatContentToResponsesParts(msg, "input_text")
+			if err != nil {
+				return nil, err
+			}
+			if len(content) > 0 {
+				input = append(input, map[string]any{"type": "message", "role": "user", "content": content})
+			}
+		case "assistant":
+			content, err := chatContentToResponsesParts(msg, "output_text")
+			if err != nil {
+				return nil, err
+			}
+			if len(content) > 0 {
+				input = append(input, map[string]any{"type": "message", "role": "assistant", "content": content})
+			}
+			for _, tc := range msg.ToolCalls {
+				args := tc.Function.Arguments
+				if strings.TrimSpace(args) == "" {
+					args = "{}"
+				}
+				input = append(input, map[string]any{
+					"type":      "function_call",
+					"call_id":   tc.ID,
+					"name":      tc.Function.Name,
+					"arguments": args,
+				})
+			}
+		case "tool", "function":
+			input = append(input, map[string]any{
+				"type":    "function_call_output",
+				"call_id": msg.ToolCallID,
+				"output":  msg.ContentText(),
+			})
+		default:
+			return nil, fmt.Errorf("unsupported message role: %s", msg.Role)
+		}
+	}
+	if len(instructions) > 0 {
+		out["instructions"] = strings.Join(instructions, "\n\n")
+	}
+	out["input"] = input
+
+	if len(req.Tools) > 0 {
+		tools := make([]map[string]any, 0, len(req.Tools))
+		for _, t := range req.Tools {
+			if t.Type != "" && t.Type != "function" {
+				continue
+			}
+			tool := map[string]any{
+				"type": "function",
+				"name": t.Function.Name,
+			}
+			if t.Function.Description != "" {
+				tool["description"] = t.Function.Description
+			}
+			if len(t.Function.Parameters) > 0 {
+				tool["parameters"] = t.Function.Parameters
+			}
+			if t.Function.Strict != nil {
+				tool["strict"] = *t.Function.Strict
+			}
+			tools = append(tools, tool)
+		}
+		if len(tools) > 0 {
+			out["tools"] = tools
+		}
+	}
+
+	if len(req.ToolChoice) > 0 {
+		var s string
+		if err := json.Unmarshal(req.ToolChoice, &s); err == nil {
+			out["tool_choice"] = s
+		} else {
+			var obj ChatTool
+			if err := json.Unmarshal(req.ToolChoice, &obj); err == nil && obj.Function.Name != "" {
+				out["tool_choice"] = map[string]any{"type": "function", "name": obj.Function.Name}
+			}
+		}
+	}
+
+	if rf := req.ResponseFormat; rf != nil {
+		switch rf.Type {
+		case "json_object":
+			out["text"] = map[string]any{"format": map[string]any{"type": "json_object"}}
+		case "json_schema":
+			if rf.JSONSchema != nil {
+				format := map[string]any{
+					"type":   "json_schema",
+					"name":   rf.JSONSchema.Name,
+					"schema": rf.JSONSchema.Schema,
+				}
+				if rf.JSONSchema.Description != "" {
+					format["description"] = rf.JSONSchema.Description
+				}
+				if rf.JSONSchema.Strict != nil {
+					format["strict"] = *rf.JSONSchema.Strict
+				}
+				out["text"] = map[string]any{"format": format}
+			}
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+func chatContentToResponsesParts(msg *ChatMessage, textType string) ([]map[string]any, error) {
+	parts, text, isString := msg.ContentParts()
+	if isString {
+		if text == "" {
+			return nil, nil
+		}
+		return []map[string]any{{"type": textType, "text": text}}, nil
+	}
+	out := make([]map[string]any, 0, len(parts))
+	for _, p := range parts {
+		switch p.Type {
+		case "text", "input_text", "output_text":
+			out = append(out, map[string]any{"type": textType, "text": p.Text})
+		case "image_url":
+			if p.ImageURL == nil || p.ImageURL.URL == "" {
+				continue
+			}
+			img := map[string]any{"type": "input_image", "image_url": p.ImageURL.URL}
+			if p.ImageURL.Detail != "" {
+				img["detail"] = p.ImageURL.Detail
+			}
+			out = append(out, img)
+		default:
+			return nil, fmt.Errorf("unsupported content part type: %s", p.Type)
+		}
+	}
+	return out, nil
+}
+
+type responsesUsage struct {
+	InputTokens        int `json:"input_tokens"`
+	OutputTokens       int `json:"output_tokens"`
+	InputTokensDetails struct {
+		CachedTokens int `json:"cached_tokens"`
+	} `json:"input_tokens_details"`
+}
+
+func (u *responsesUsage) toChat() *ChatUsage {
+	return &ChatUsage{
+		PromptTokens:        u.InputTokens,
+		CompletionTokens:    u.OutputTokens,
+		TotalTokens:         u.InputTokens + u.OutputTokens,
+		PromptTokensDetails: &ChatPromptTokenDetails{CachedTokens: u.InputTokensDetails.CachedTokens},
+	}
+}
+
+type responsesObject struct {
+	ID                string `json:"id"`
+	Status            string `json:"status"`
+	IncompleteDetails *struct {
+		Reason string `json:"reason"`
+	} `json:"incomplete_details"`
+	Output []struct {
+		Type      string `json:"type"`
+		CallID    string `json:"call_id"`
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+		Content   []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+		Summary []struct {
+			Text string `json:"text"`
+		} `json:"summary"`
+	} `json:"output"`
+	Usage *responsesUsage `json:"usage"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// finishReason 根据 Responses 的 status / incomplete_details 推导 finish_reason
+func (r *responsesObject) finishReason(hasToolCalls bool) string {
+	if r.Status == "incomplete" && r.IncompleteDetails != nil {
+		if r.IncompleteDetails.Reason == "content_filter" {
+			return ChatFinishFilter
+		}
+		return ChatFinishLength
+	}
+	if hasToolCalls {
+		return ChatFinishToolCalls
+	}
+	return ChatFinishStop
+}
+
+// ResponsesToChatConverter 将 OpenAI Responses 响应转换为 Chat Completions 响应
+type ResponsesToChatConverter struct {
+	model        string
+	includeUsage bool
+	created      int64
+
+	id        string
+	usage     *responsesUsage
+	finish    string
+	toolIndex map[string]int
+	roleSent  bool
+	finished  bool
+}
+
+// NewResponsesToChatConverter 创建转换器，model 为客户端请求的模型名
+func NewResponsesToChatConverter(model string, includeUsage bool) *ResponsesToChatConverter {
+	return &ResponsesToChatConverter{
+		model:        model,
+		includeUsage: includeUsage,
+		created:      time.Now().Unix(),
+		id:           fmt.Sprintf("chatcmpl-%d", time.Now().UnixNano()),
+		toolIndex:    make(map[string]int),
+	}
+}
+
+// ConvertResponse 转换非流式 Responses 响应
+func (c *ResponsesToChatConverter) ConvertResponse(body []byte) ([]byte, error) {
+	var resp responsesObject
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse responses response: %w", err)
+	}
+
+	var text, reasoning strings.Builder
+	var toolCalls []ChatToolCall
+	for _, item := range resp.Output {
+		switch item.Type {
+		case "message":
+			for _, part := range item.Content {
+				if part.Type == "output_text" {
+					text.WriteString(part.Text)
+				}
+			}
+		case "reasoning":
+			for _, s := range item.Summary {
+				reasoning.WriteString(s.Text)
+			}
+		case "function_call":
+			toolCalls = append(toolCalls, ChatToolCall{
+				ID:       item.CallID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: item.Name, Arguments: item.Arguments},
+			})
+		}
+	}
+
+	msg := &ChatResponseMessage{
+		Role:             "assistant",
+		ReasoningContent: reasoning.String(),
+		ToolCalls:        toolCalls,
+	}
+	if text.Len() > 0 || len(toolCalls) == 0 {
+		msg.Content = strPtr(text.String())
+	}
+
+	id := c.id
+	if resp.ID != "" {
+		id = "chatcmpl-" + resp.ID
+	}
+	finish := resp.finishReason(len(toolCalls) > 0)
+	completion := &ChatCompletion{
+		ID:      id,
+		Object:  "chat.completion",
+		Created: c.created,
+		Model:   c.model,
+		Choices: []ChatChoice{{Index: 0, Message: msg, FinishReason: &finish}},
+	}
+	if resp.Usage != nil {
+		completion.Usage = resp.Usage.toChat()
+	}
+	return json.Marshal(completion)
+}
+
+// ConvertStreamEvent 转换单个 Responses SSE 事件
+func (c *ResponsesToChatConverter) ConvertStreamEvent(event, data string) []byte {
+	if c.finished {
+		return nil
+	}
+	var payload struct {
+		Type     string          `json:"type"`
+		Delta    string          `json:"delta"`
+		ItemID   string          `json:"item_id"`
+		Response json.RawMessage `json:"response"`
+		Item     struct {
+			ID     string `json:"id"`
+			Type   string `json:"type"`
+			CallID string `json:"call_id"`
+			Name   string `json:"name"`
+		} `json:"item"`
+		Error *struct {
+			Type    string `json:"type"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(data), &payload); err != nil {
+		return nil
+	}
+	eventType := payload.Type
+	if eventType == "" {
+		eventType = event
+	}
+
+	switch eventType {
+	case "response.created":
+		var resp responsesObject
+		if err := json.Unmarshal(payload.Response, &resp); err == nil && resp.ID != "" {
+			c.id = "chatcmpl-" + resp.ID
+		}
+		return c.emitRole()
+	case "response.output_text.delta":
+		out := c.emitRole()
+		return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{Content: strPtr(payload.Delta)}, nil))...)
+	case "response.reasoning_summary_text.delta", "response.reasoning_text.delta":
+		out := c.emitRole()
+		return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{ReasoningContent: payload.Delta}, nil))...)
+	case "response.output_item.added":
+		if payload.Item.Type != "function_call" {
+			return nil
+		}
+		idx := len(c.toolIndex)
+		c.toolIndex[payload.Item.ID] = idx
+		out := c.emitRole()
+		return append(out, encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{
+			ToolCalls: []ChatToolCall{{
+				Index:    &idx,
+				ID:       payload.Item.CallID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: payload.Item.Name, Arguments: ""},
+			}},
+		}, nil))...)
+	case "response.function_call_arguments.delta":
+		idx, ok := c.toolIndex[payload.ItemID]
+		if !ok {
+			return nil
+		}
+		return encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{
+			ToolCalls: []ChatToolCall{{Index: &idx, Function: ChatFunctionCall{Arguments: payload.Delta}}},
+		}, nil))
+	case "response.completed", "response.incomplete":
+		var resp responsesObject
+		if err := json.Unmarshal(payload.Response, &resp); err == nil {
+			c.usage = resp.Usage
+			c.finish = resp.finishReason(len(c.toolIndex) > 0)
+		}
+		return c.FinishStream()
+	case "response.failed", "error":
+		c.finished = true
+		errType, message := "upstream_error", "Upstream stream error"
+		if payload.Error != nil {
+			if payload.Error.Type != "" {
+				errType = payload.Error.Type
+			} else if payload.Error.Code != "" {
+				errType = payload.Error.Code
+			}
+			if payload.Error.Message != "" {
+				message = payload.Error.Message
+			}
+		} else if payload.Message != "" {
+			message = payload.Message
+		} else {
+			var resp responsesObject
+			if err := json.Unmarshal(payload.Response, &resp); err == nil && resp.Error != nil {
+				errType, message = resp.Error.Code, resp.Error.Message
+			}
+		}
+		out := []byte("data: ")
+		out = append(out, BuildChatError(errType, message)...)
+		out = append(out, "\n\n"...)
+		return append(out, ChatStreamDone...)
+	}
+	return nil
+}
+
+// FinishStream 输出结束 chunk、可选的 usage chunk 和 [DONE]
+func (c *ResponsesToChatConverter) FinishStream() []byte {
+	if c.finished {
+		return nil
+	}
+	c.finished = true
+	out := c.emitRole()
+	finish := c.finish
+	if finish == "" {
+		finish = ChatFinishStop
+		if len(c.toolIndex) > 0 {
+			finish = ChatFinishToolCalls
+		}
+	}
+	out = append(out, encodeChunk(newChunk(c.id, c.model, c.created, nil, &finish))...)
+	if c.includeUsage && c.usage != nil {
+		out = append(out, encodeChunk(&ChatCompletion{
+			ID:      c.id,
+			Object:  "chat.completion.chunk",
+			Created: c.created,
+			Model:   c.model,
+			Choices: []ChatChoice{},
+			Usage:   c.usage.toChat(),
+		})...)
+	}
+	return append(out, ChatStreamDone...)
+}
+
+func (c *ResponsesToChatConverter) emitRole() []byte {
+	if c.roleSent {
+		return nil
+	}
+	c.roleSent = true
+	return encodeChunk(newChunk(c.id, c.model, c.created, &ChatResponseMessage{Role: "assistant", Content: strPtr("")}, nil))
+}
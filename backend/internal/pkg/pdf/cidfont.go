@@ -0,0 +1,111 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// unicodeFontResource Unicode 字体在页面资源中的名称
+const unicodeFontResource = "F3"
+
+// unicodeFontObjects Unicode 字体相关的对象编号
+type unicodeFontObjects struct {
+	font       int // Type0 字体
+	descendant int // CIDFontType2 子字体
+	descriptor int
+	file       int // FontFile2（字形子集）
+	toUnicode  int
+}
+
+// writeUnicodeFont 写出 Type0/CIDFontType2 字体：Identity-H 编码下 CID 即 GID，
+// 字宽表与 ToUnicode 只包含文档用到的字形，字体文件为对应的字形子集
+func (d *Document) writeUnicodeFont(out *bytes.Buffer, ids unicodeFontObjects, beginObject func(int)) error {
+	f := d.unicode
+	fontFile, err := f.subset(d.usedGlyphs)
+	if err != nil {
+		return fmt.Errorf("subset unicode font: %w", err)
+	}
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(fontFile); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	gids := make([]int, 0, len(d.usedGlyphs))
+	for gid := range d.usedGlyphs {
+		gids = append(gids, int(gid))
+	}
+	sort.Ints(gids)
+
+	// 子集字体名需带 6 位大写字母前缀
+	baseFont := "SUBSET+" + f.name
+
+	beginObject(ids.font)
+	fmt.Fprintf(out, "<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>\nendobj\n",
+		baseFont, ids.descendant, ids.toUnicode)
+
+	beginObject(ids.descendant)
+	var widths strings.Builder
+	for _, gid := range gids {
+		fmt.Fprintf(&widths, " %d [%d]", gid, f.advance(uint16(gid)))
+	}
+	fmt.Fprintf(out, "<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW 1000 /W [%s ] >>\nendobj\n",
+		baseFont, ids.descriptor, widths.String())
+
+	beginObject(ids.descriptor)
+	fmt.Fprintf(out, "<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>\nendobj\n",
+		baseFont, f.scale(f.bbox[0]), f.scale(f.bbox[1]), f.scale(f.bbox[2]), f.scale(f.bbox[3]),
+		f.scale(f.ascent), f.scale(f.descent), f.scale(f.capHeight), ids.file)
+
+	beginObject(ids.file)
+	fmt.Fprintf(out, "<< /Length %d /Length1 %d /Filter /FlateDecode >>\nstream\n", compressed.Len(), len(fontFile))
+	out.Write(compressed.Bytes())
+	out.WriteString("\nendstream\nendobj\n")
+
+	cmap := toUnicodeCMap(gids, d.usedGlyphs)
+	beginObject(ids.toUnicode)
+	fmt.Fprintf(out, "<< /Length %d >>\nstream\n", len(cmap))
+	out.WriteString(cmap)
+	out.WriteString("endstream\nendobj\n")
+	return nil
+}
+
+// toUnicodeCMap 生成字形到 Unicode 的映射，保证复制/搜索文本正确
+func toUnicodeCMap(gids []int, used map[uint16]rune) string {
+	var entries []string
+	for _, gid := range gids {
+		r := used[uint16(gid)]
+		if r == 0 {
+			continue
+		}
+		var u strings.Builder
+		for _, unit := range utf16.Encode([]rune{r}) {
+			fmt.Fprintf(&u, "%04X", unit)
+		}
+		entries = append(entries, fmt.Sprintf("<%04X> <%s>", gid, u.String()))
+	}
+
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// 每个 bfchar 段最多 100 项
+	for len(entries) > 0 {
+		n := len(entries)
+		if n > 100 {
+			n = 100
+		}
+		fmt.Fprintf(&b, "%d beginbfchar\n%s\nendbfchar\n", n, strings.Join(entries[:n], "\n"))
+		entries = entries[n:]
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.String()
+}
@@ -0,0 +1,493 @@
+// Package pdf 提供最小化的 PDF 文档生成器
+//
+// 仅支持发票类版式需要的能力：A4 页面、标准 Helvetica 字体（WinAnsi 编码）、
+// 直线、填充矩形与 RGB 图片。标准字体不含 CJK 字形：通过 SetUnicodeFont 设置 TrueType 字体后，
+// 含 ASCII 之外字符的文本以该字体（CIDFontType2 + Identity-H，按用到的字形子集嵌入）输出；
+// 未设置时这些字符输出为 '?'。
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 页面尺寸（单位：pt）
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font 标准字体
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+// Document PDF 文档
+type Document struct {
+	pages  []*Page
+	images []*Image
+	info   map[string]string
+
+	unicode    *UnicodeFont
+	usedGlyphs map[uint16]rune // 已输出的字形及其对应字符，用于字宽表、ToUnicode 与子集嵌入
+}
+
+// Page 单个页面；坐标原点为左上角，y 向下增长
+type Page struct {
+	doc     *Document
+	content bytes.Buffer
+	images  map[string]*Image
+	gray    float64
+}
+
+// Image 已嵌入文档的图片
+type Image struct {
+	name   string
+	width  int
+	height int
+	data   []byte // zlib 压缩后的 RGB 数据
+}
+
+// Width 图片像素宽度
+func (img *Image) Width() int { return img.width }
+
+// Height 图片像素高度
+func (img *Image) Height() int { return img.height }
+
+// New 创建空文档
+func New() *Document {
+	return &Document{info: make(map[string]string)}
+}
+
+// SetTitle 设置文档标题元数据
+func (d *Document) SetTitle(title string) {
+	d.info["Title"] = title
+}
+
+// SetAuthor 设置文档作者元数据
+func (d *Document) SetAuthor(author string) {
+	d.info["Author"] = author
+}
+
+// SetUnicodeFont 设置输出 ASCII 之外字符时使用的 TrueType 字体；nil 表示不使用
+func (d *Document) SetUnicodeFont(font *UnicodeFont) {
+	d.unicode = font
+	d.usedGlyphs = make(map[uint16]rune)
+}
+
+// AddPage 追加一个 A4 页面
+func (d *Document) AddPage() *Page {
+	p := &Page{doc: d, images: make(map[string]*Image)}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// PageCount 当前页数
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// AddImage 嵌入图片；透明像素按白色背景合成
+func (d *Document) AddImage(src image.Image) (*Image, error) {
+	b := src.Bounds()
+	if b.Dx() <= 0 || b.Dy() <= 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	raw := make([]byte, 0, b.Dx()*b.Dy()*3)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := src.At(x, y).RGBA()
+			// 预乘 alpha 的颜色与白色背景合成
+			white := 0xffff - a
+			raw = append(raw, byte((r+white)>>8), byte((g+white)>>8), byte((bl+white)>>8))
+		}
+	}
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	img := &Image{
+		name:   "Im" + strconv.Itoa(len(d.images)+1),
+		width:  b.Dx(),
+		height: b.Dy(),
+		data:   buf.Bytes(),
+	}
+	d.images = append(d.images, img)
+	return img, nil
+}
+
+// Text 在 (x, y) 处输出文本，y 为基线位置
+//
+// 设置了 Unicode 字体时，文本按 ASCII / 非 ASCII 分段，ASCII 段仍使用标准字体。
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	if !p.doc.useUnicode(s) {
+		p.standardText(x, y, font, size, s)
+		return
+	}
+	for _, run := range splitTextRuns(s) {
+		if run.unicode {
+			p.unicodeText(x, y, font, size, run.text)
+			x += p.doc.unicodeWidth(size, run.text)
+		} else {
+			p.standardText(x, y, font, size, run.text)
+			x += TextWidth(font, size, run.text)
+		}
+	}
+}
+
+func (p *Page) standardText(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		fontResourceName(font), num(size), num(x), num(PageHeight-y), escapeText(s))
+}
+
+// unicodeText 以 Unicode 字体按字形编号输出文本；字体没有粗体字形，粗体以同色描边加粗
+func (p *Page) unicodeText(x, y float64, font Font, size float64, s string) {
+	var glyphs strings.Builder
+	for _, r := range s {
+		gid, ok := p.doc.unicode.GlyphID(r)
+		if !ok {
+			r = 0
+		}
+		p.doc.usedGlyphs[gid] = r
+		fmt.Fprintf(&glyphs, "%04X", gid)
+	}
+	if font == HelveticaBold {
+		fmt.Fprintf(&p.content, "q %s G %s w BT /%s %s Tf 2 Tr %s %s Td <%s> Tj ET Q\n",
+			num(p.gray), num(size*0.03), unicodeFontResource, num(size), num(x), num(PageHeight-y), glyphs.String())
+		return
+	}
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td <%s> Tj ET\n",
+		unicodeFontResource, num(size), num(x), num(PageHeight-y), glyphs.String())
+}
+
+// TextRight 右对齐输出文本，right 为文本右边缘
+func (p *Page) TextRight(right, y float64, font Font, size float64, s string) {
+	p.Text(right-p.doc.TextWidth(font, size, s), y, font, size, s)
+}
+
+// SetFillGray 设置后续文本与填充的灰度（0 黑，1 白）
+func (p *Page) SetFillGray(gray float64) {
+	p.gray = gray
+	fmt.Fprintf(&p.content, "%s g\n", num(gray))
+}
+
+// Line 绘制直线
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// FillRect 以指定灰度填充矩形，(x, y) 为左上角；绘制后恢复黑色填充
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	p.gray = 0
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f 0 g\n",
+		num(gray), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// DrawImage 在 (x, y)（左上角）绘制图片，缩放到 w×h
+func (p *Page) DrawImage(img *Image, x, y, w, h float64) {
+	p.images[img.name] = img
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /%s Do Q\n",
+		num(w), num(h), num(x), num(PageHeight-y-h), img.name)
+}
+
+// TextWidth 计算文本在指定字体字号下的宽度
+func TextWidth(font Font, size float64, s string) float64 {
+	widths := &helveticaWidths
+	if font == HelveticaBold {
+		widths = &helveticaBoldWidths
+	}
+	total := 0
+	for _, r := range s {
+		total += widths[glyphIndex(r)]
+	}
+	return float64(total) * size / 1000
+}
+
+// Truncate 截断文本使其宽度不超过 maxWidth，被截断时以 "..." 结尾
+func Truncate(font Font, size float64, s string, maxWidth float64) string {
+	return truncate(func(s string) float64 { return TextWidth(font, size, s) }, s, maxWidth)
+}
+
+// TextWidth 计算文本在本文档中的实际宽度（含 Unicode 字体输出的文本）
+func (d *Document) TextWidth(font Font, size float64, s string) float64 {
+	if !d.useUnicode(s) {
+		return TextWidth(font, size, s)
+	}
+	total := 0.0
+	for _, run := range splitTextRuns(s) {
+		if run.unicode {
+			total += d.unicodeWidth(size, run.text)
+		} else {
+			total += TextWidth(font, size, run.text)
+		}
+	}
+	return total
+}
+
+func (d *Document) unicodeWidth(size float64, s string) float64 {
+	total := 0
+	for _, r := range s {
+		gid, _ := d.unicode.GlyphID(r)
+		total += d.unicode.advance(gid)
+	}
+	return float64(total) * size / 1000
+}
+
+// Truncate 按本文档的实际字宽截断文本，被截断时以 "..." 结尾
+func (d *Document) Truncate(font Font, size float64, s string, maxWidth float64) string {
+	return truncate(func(s string) float64 { return d.TextWidth(font, size, s) }, s, maxWidth)
+}
+
+func truncate(width func(string) float64, s string, maxWidth float64) string {
+	if width(s) <= maxWidth {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "..."
+		if width(candidate) <= maxWidth {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// useUnicode 文本含标准字体无法输出的字符且已设置 Unicode 字体时，使用 Unicode 字体输出
+func (d *Document) useUnicode(s string) bool {
+	return d.unicode != nil && !isPlainText(s)
+}
+
+// WriteTo 输出完整 PDF 文件
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	newObject := func() int {
+		offsets = append(offsets, 0)
+		return len(offsets)
+	}
+	beginObject := func(id int) {
+		offsets[id-1] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n", id)
+	}
+
+	catalogID := newObject()
+	pagesID := newObject()
+	fontID := newObject()
+	fontBoldID := newObject()
+	var unicodeIDs unicodeFontObjects
+	if len(d.usedGlyphs) > 0 {
+		unicodeIDs = unicodeFontObjects{
+			font:       newObject(),
+			descendant: newObject(),
+			descriptor: newObject(),
+			file:       newObject(),
+			toUnicode:  newObject(),
+		}
+	}
+	imageIDs := make(map[string]int, len(d.images))
+	for _, img := range d.images {
+		imageIDs[img.name] = newObject()
+	}
+	pageIDs := make([]int, len(d.pages))
+	contentIDs := make([]int, len(d.pages))
+	for i := range d.pages {
+		pageIDs[i] = newObject()
+		contentIDs[i] = newObject()
+	}
+	infoID := 0
+	if len(d.info) > 0 {
+		infoID = newObject()
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	beginObject(catalogID)
+	fmt.Fprintf(&out, "<< /Type /Catalog /Pages %d 0 R >>\nendobj\n", pagesID)
+
+	beginObject(pagesID)
+	kids := make([]string, len(pageIDs))
+	for i, id := range pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	fmt.Fprintf(&out, "<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(pageIDs))
+
+	for _, f := range []struct {
+		id   int
+		base string
+	}{{fontID, "Helvetica"}, {fontBoldID, "Helvetica-Bold"}} {
+		beginObject(f.id)
+		fmt.Fprintf(&out, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>\nendobj\n", f.base)
+	}
+	if unicodeIDs.font > 0 {
+		if err := d.writeUnicodeFont(&out, unicodeIDs, beginObject); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, img := range d.images {
+		beginObject(imageIDs[img.name])
+		fmt.Fprintf(&out, "<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n",
+			img.width, img.height, len(img.data))
+		out.Write(img.data)
+		out.WriteString("\nendstream\nendobj\n")
+	}
+
+	for i, page := range d.pages {
+		beginObject(pageIDs[i])
+		var xobjects strings.Builder
+		for _, img := range d.images {
+			if _, ok := page.images[img.name]; ok {
+				fmt.Fprintf(&xobjects, " /%s %d 0 R", img.name, imageIDs[img.name])
+			}
+		}
+		resources := fmt.Sprintf("/Font << /F1 %d 0 R /F2 %d 0 R", fontID, fontBoldID)
+		if unicodeIDs.font > 0 {
+			resources += fmt.Sprintf(" /%s %d 0 R", unicodeFontResource, unicodeIDs.font)
+		}
+		resources += " >>"
+		if xobjects.Len() > 0 {
+			resources += " /XObject <<" + xobjects.String() + " >>"
+		}
+		fmt.Fprintf(&out, "<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << %s >> /Contents %d 0 R >>\nendobj\n",
+			pagesID, num(PageWidth), num(PageHeight), resources, contentIDs[i])
+
+		beginObject(contentIDs[i])
+		fmt.Fprintf(&out, "<< /Length %d >>\nstream\n", page.content.Len())
+		out.Write(page.content.Bytes())
+		out.WriteString("endstream\nendobj\n")
+	}
+
+	if infoID > 0 {
+		beginObject(infoID)
+		out.WriteString("<<")
+		for _, key := range []string{"Title", "Author"} {
+			if v, ok := d.info[key]; ok {
+				fmt.Fprintf(&out, " /%s %s", key, infoString(v))
+			}
+		}
+		out.WriteString(" /Producer (sub2api) >>\nendobj\n")
+	}
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root %d 0 R", len(offsets)+1, catalogID)
+	if infoID > 0 {
+		fmt.Fprintf(&out, " /Info %d 0 R", infoID)
+	}
+	fmt.Fprintf(&out, " >>\nstartxref\n%d\n%%%%EOF\n", xrefOffset)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+func fontResourceName(font Font) string {
+	if font == HelveticaBold {
+		return "F2"
+	}
+	return "F1"
+}
+
+// isPlainText 文本是否只含标准字体可输出的 ASCII 可打印字符
+func isPlainText(s string) bool {
+	for _, r := range s {
+		if !isPlainRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isPlainRune(r rune) bool {
+	return r >= 32 && r <= 126
+}
+
+// textRun 连续的 ASCII 或非 ASCII 文本片段
+type textRun struct {
+	text    string
+	unicode bool
+}
+
+func splitTextRuns(s string) []textRun {
+	var runs []textRun
+	start := 0
+	for i, r := range s {
+		unicode := !isPlainRune(r)
+		if len(runs) > 0 {
+			if runs[len(runs)-1].unicode == unicode {
+				continue
+			}
+			runs[len(runs)-1].text = s[start:i]
+		}
+		runs = append(runs, textRun{unicode: unicode})
+		start = i
+	}
+	if len(runs) > 0 {
+		runs[len(runs)-1].text = s[start:]
+	}
+	return runs
+}
+
+// infoString 编码文档信息字符串；含非 ASCII 字符时使用 UTF-16BE 文本串
+func infoString(s string) string {
+	if isPlainText(s) {
+		return "(" + escapeText(s) + ")"
+	}
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// escapeText 转义 PDF 字符串；非 ASCII 可打印字符替换为 '?'
+func escapeText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r <= 126:
+			b.WriteRune(r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+func glyphIndex(r rune) int {
+	if r < 32 || r > 126 {
+		return '?' - 32
+	}
+	return int(r - 32)
+}
+
+func num(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
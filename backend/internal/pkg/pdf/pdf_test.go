@@ -0,0 +1,249 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteToProducesValidXref(t *testing.T) {
+	doc := New()
+	doc.SetTitle("Invoice (INV-000001)")
+	p := doc.AddPage()
+	p.Text(40, 60, HelveticaBold, 18, "INVOICE")
+	p.TextRight(555, 60, Helvetica, 10, "Total: $12.00")
+	p.Line(40, 70, 555, 70, 0.5)
+	p.FillRect(40, 80, 515, 20, 0.9)
+
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img, err := doc.AddImage(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.DrawImage(img, 40, 100, 20, 20)
+	doc.AddPage().Text(40, 60, Helvetica, 10, "page 2")
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("missing pdf header/trailer")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Fatalf("expected 2 pages")
+	}
+	if !bytes.Contains(out, []byte(`/Title (Invoice \(INV-000001\))`)) {
+		t.Fatalf("title not escaped")
+	}
+	assertValidXref(t, out)
+}
+
+// assertValidXref 校验 startxref 指向 xref 表，且每个对象偏移都指向 "N 0 obj"
+func assertValidXref(t *testing.T, out []byte) {
+	t.Helper()
+	// startxref 指向 xref 表，且每个对象偏移都指向 "N 0 obj"
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if m == nil {
+		t.Fatal("startxref missing")
+	}
+	xrefOffset, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(out[xrefOffset:], []byte("xref\n")) {
+		t.Fatalf("startxref offset does not point to xref table")
+	}
+	lines := strings.Split(string(out[xrefOffset:]), "\n")
+	count, _ := strconv.Atoi(strings.Fields(lines[1])[1])
+	for i := 1; i < count; i++ {
+		off, _ := strconv.Atoi(lines[2+i][:10])
+		prefix := strconv.Itoa(i) + " 0 obj"
+		if !bytes.HasPrefix(out[off:], []byte(prefix)) {
+			t.Fatalf("object %d offset %d does not point to %q", i, off, prefix)
+		}
+	}
+}
+
+func TestTextWidthAndTruncate(t *testing.T) {
+	// "A" 在 Helvetica 中宽 667/1000 em
+	if got := TextWidth(Helvetica, 10, "A"); got != 6.67 {
+		t.Fatalf("width = %v", got)
+	}
+	if TextWidth(HelveticaBold, 10, "b") <= TextWidth(Helvetica, 10, "b") {
+		t.Fatalf("bold should be wider")
+	}
+	s := "claude-sonnet-4-5-20250929 / default group"
+	got := Truncate(Helvetica, 9, s, 80)
+	if !strings.HasSuffix(got, "...") || TextWidth(Helvetica, 9, got) > 80 {
+		t.Fatalf("truncate = %q", got)
+	}
+	if Truncate(Helvetica, 9, "short", 80) != "short" {
+		t.Fatalf("short text should not be truncated")
+	}
+}
+
+func TestEscapeTextReplacesNonASCII(t *testing.T) {
+	if got := escapeText(`a(b)\c 中`); got != `a\(b\)\\c ?` {
+		t.Fatalf("escape = %q", got)
+	}
+}
+
+func TestUnicodeFontRendersCJK(t *testing.T) {
+	font, err := ParseUnicodeFont(buildTestFont())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gid, ok := font.GlyphID('票'); !ok || gid != 2 {
+		t.Fatalf("glyph id = %d, %v", gid, ok)
+	}
+
+	doc := New()
+	doc.SetUnicodeFont(font)
+	doc.SetTitle("发票")
+	p := doc.AddPage()
+	p.Text(40, 60, HelveticaBold, 12, "发票")
+	p.Text(40, 80, Helvetica, 10, "No.1 发票")
+	if got := doc.TextWidth(Helvetica, 10, "发票"); got != 20 {
+		t.Fatalf("cjk width = %v", got)
+	}
+	if got := doc.Truncate(Helvetica, 10, "发票发票发票", 25); got != "发..." {
+		t.Fatalf("cjk truncate = %q", got)
+	}
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.Bytes()
+	for _, want := range []string{
+		"/Subtype /Type0 /BaseFont /SUBSET+TestCJK /Encoding /Identity-H",
+		"/Subtype /CIDFontType2",
+		"/CIDToGIDMap /Identity",
+		"/W [ 1 [1000] 2 [1000] ]",
+		"/F3 ",
+		"<00010002> Tj",
+		"(No.1 ) Tj ET\nBT /F3 10 Tf",
+		"<0001> <53D1>",
+		"<0002> <7968>",
+		"/Title <FEFF53D17968>",
+	} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Fatalf("pdf missing %q", want)
+		}
+	}
+	assertValidXref(t, out)
+
+	// 嵌入的子集保留用到的字形及复合字形的组件，未用到的字形为空
+	m := regexp.MustCompile(`/Length (\d+) /Length1 \d+ /Filter /FlateDecode >>\nstream\n`).FindSubmatchIndex(out)
+	if m == nil {
+		t.Fatal("font file stream missing")
+	}
+	n, _ := strconv.Atoi(string(out[m[2]:m[3]]))
+	zr, err := zlib.NewReader(bytes.NewReader(out[m[1] : m[1]+n]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 子集不含 cmap（文本按 GID 输出），补回后重新解析
+	sub, err := ParseUnicodeFont(withTable(data, "cmap", font.tables["cmap"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for gid, wantEmpty := range map[uint16]bool{1: false, 2: false, 3: false, 4: true} {
+		r, err := sub.glyphRange(gid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (r[1] == r[0]) != wantEmpty {
+			t.Fatalf("glyph %d range %v, want empty=%v", gid, r, wantEmpty)
+		}
+	}
+}
+
+// buildTestFont 构造最小的 TrueType 字体：
+// GID 1 = 发（简单字形），GID 2 = 票（引用 GID 3 的复合字形），GID 4 = 中（未使用）
+func buildTestFont() []byte {
+	be16 := func(vs ...int) []byte {
+		b := make([]byte, 2*len(vs))
+		for i, v := range vs {
+			binary.BigEndian.PutUint16(b[2*i:], uint16(v))
+		}
+		return b
+	}
+	cat := func(parts ...[]byte) []byte { return bytes.Join(parts, nil) }
+
+	const numGlyphs = 5
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)
+	binary.BigEndian.PutUint16(head[18:], 1000) // unitsPerEm
+	copy(head[36:], be16(0, -120, 1000, 880))   // bbox
+	hhea := make([]byte, 36)
+	binary.BigEndian.PutUint32(hhea, 0x00010000)
+	copy(hhea[4:], be16(880, -120))
+	binary.BigEndian.PutUint16(hhea[34:], numGlyphs) // numberOfHMetrics
+	maxp := cat([]byte{0, 0, 0x50, 0}, be16(numGlyphs))
+	var hmtx []byte
+	for i := 0; i < numGlyphs; i++ {
+		hmtx = append(hmtx, be16(1000, 0)...)
+	}
+
+	// 单点单轮廓的简单字形（20 字节）与引用 GID 3 的复合字形（20 字节）
+	simple := cat(be16(1, 0, 0, 100, 100, 0, 0), []byte{0x01}, be16(50, 50), []byte{0})
+	composite := cat(be16(0xffff, 0, 0, 100, 100), be16(glyfArgsAreWords, 3, 0, 0), be16(0))
+	glyphs := [][]byte{nil, simple, composite, simple, simple}
+	var glyf []byte
+	loca := be16(0)
+	for _, g := range glyphs {
+		glyf = append(glyf, g...)
+		loca = append(loca, be16(len(glyf)/2)...)
+	}
+
+	// cmap format 4：每个字符一个段，最后是 0xFFFF 结束段
+	codes := []int{0x4E2D, 0x53D1, 0x7968, 0xFFFF}
+	gids := []int{4, 1, 2, 1}
+	var ends, starts, deltas, offsets []byte
+	for i, c := range codes {
+		ends = append(ends, be16(c)...)
+		starts = append(starts, be16(c)...)
+		deltas = append(deltas, be16(gids[i]-c)...)
+		offsets = append(offsets, be16(0)...)
+	}
+	segX2 := len(codes) * 2
+	format4 := cat(be16(4, 16+4*segX2, 0, segX2, 8, 2, 0), ends, be16(0), starts, deltas, offsets)
+	cmap := cat(be16(0, 1, 3, 1), []byte{0, 0, 0, 12}, format4)
+
+	psName := "TestCJK"
+	var nameUTF16 []byte
+	for _, c := range psName {
+		nameUTF16 = append(nameUTF16, be16(int(c))...)
+	}
+	name := cat(be16(0, 1, 18, 3, 1, 0x409, 6, len(nameUTF16), 0), nameUTF16)
+
+	return buildFontFile(map[string][]byte{
+		"cmap": cmap, "glyf": glyf, "head": head, "hhea": hhea,
+		"hmtx": hmtx, "loca": loca, "maxp": maxp, "name": name,
+	})
+}
+
+// withTable 向字体文件追加一张表
+func withTable(data []byte, tag string, table []byte) []byte {
+	tables := map[string][]byte{tag: table}
+	n := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < n; i++ {
+		rec := data[12+16*i:]
+		off := binary.BigEndian.Uint32(rec[8:])
+		length := binary.BigEndian.Uint32(rec[12:])
+		tables[string(rec[:4])] = data[off : off+length]
+	}
+	return buildFontFile(tables)
+}
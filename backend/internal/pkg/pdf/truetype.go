@@ -0,0 +1,462 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnicodeFont 用于输出 ASCII 之外字符（如中日韩文字）的 TrueType 字体
+//
+// 以 CIDFontType2 + Identity-H 编码嵌入：文本按字形编号（GID）输出，
+// 写入文档时只保留用到的字形轮廓（GID 保持不变），避免整套 CJK 字体进入每份 PDF。
+// 仅支持 glyf 轮廓的 TrueType 字体（.ttf，或 .ttc 中的第一个字体），不支持 CFF 轮廓的 OpenType。
+type UnicodeFont struct {
+	name             string
+	unitsPerEm       int
+	ascent           int
+	descent          int
+	capHeight        int
+	bbox             [4]int
+	numGlyphs        int
+	indexToLocFormat int
+	advances         []uint16
+	cmap             map[rune]uint16
+	tables           map[string][]byte
+}
+
+var (
+	errFontTruncated   = errors.New("font data truncated")
+	errFontUnsupported = errors.New("only TrueType (glyf) fonts are supported")
+)
+
+// subsetFontTables 嵌入子集时保留的表（hinting 相关表一并保留以免字形渲染异常）
+var subsetFontTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// ParseUnicodeFont 解析 TrueType 字体（.ttf / .ttc）
+func ParseUnicodeFont(data []byte) (*UnicodeFont, error) {
+	if len(data) < 12 {
+		return nil, errFontTruncated
+	}
+	offset := 0
+	switch string(data[:4]) {
+	case "ttcf":
+		// 字体集合：使用第一个字体
+		if len(data) < 16 || binary.BigEndian.Uint32(data[8:12]) == 0 {
+			return nil, errFontTruncated
+		}
+		offset = int(binary.BigEndian.Uint32(data[12:16]))
+	case "OTTO":
+		return nil, errFontUnsupported
+	}
+	if offset+12 > len(data) {
+		return nil, errFontTruncated
+	}
+	if v := binary.BigEndian.Uint32(data[offset:]); v != 0x00010000 && string(data[offset:offset+4]) != "true" {
+		return nil, errFontUnsupported
+	}
+
+	numTables := int(binary.BigEndian.Uint16(data[offset+4:]))
+	if offset+12+numTables*16 > len(data) {
+		return nil, errFontTruncated
+	}
+	f := &UnicodeFont{tables: make(map[string][]byte, numTables)}
+	for i := 0; i < numTables; i++ {
+		rec := data[offset+12+i*16:]
+		tag := string(rec[:4])
+		start := int(binary.BigEndian.Uint32(rec[8:12]))
+		length := int(binary.BigEndian.Uint32(rec[12:16]))
+		if start < 0 || length < 0 || start+length > len(data) {
+			return nil, fmt.Errorf("font table %q out of range", tag)
+		}
+		f.tables[tag] = data[start : start+length]
+	}
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "loca", "glyf", "cmap"} {
+		if _, ok := f.tables[tag]; !ok {
+			if tag == "glyf" || tag == "loca" {
+				return nil, errFontUnsupported
+			}
+			return nil, fmt.Errorf("font table %q missing", tag)
+		}
+	}
+
+	head := f.tables["head"]
+	hhea := f.tables["hhea"]
+	maxp := f.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return nil, errFontTruncated
+	}
+	f.unitsPerEm = int(binary.BigEndian.Uint16(head[18:]))
+	if f.unitsPerEm == 0 {
+		return nil, errors.New("font unitsPerEm is zero")
+	}
+	for i := range f.bbox {
+		f.bbox[i] = int(int16(binary.BigEndian.Uint16(head[36+2*i:])))
+	}
+	f.indexToLocFormat = int(int16(binary.BigEndian.Uint16(head[50:])))
+	f.ascent = int(int16(binary.BigEndian.Uint16(hhea[4:])))
+	f.descent = int(int16(binary.BigEndian.Uint16(hhea[6:])))
+	f.capHeight = f.ascent
+	if os2 := f.tables["OS/2"]; len(os2) >= 90 && binary.BigEndian.Uint16(os2) >= 2 {
+		f.capHeight = int(int16(binary.BigEndian.Uint16(os2[88:])))
+	}
+	f.numGlyphs = int(binary.BigEndian.Uint16(maxp[4:]))
+
+	numHMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+	hmtx := f.tables["hmtx"]
+	if numHMetrics == 0 || len(hmtx) < numHMetrics*4 {
+		return nil, errFontTruncated
+	}
+	f.advances = make([]uint16, numHMetrics)
+	for i := range f.advances {
+		f.advances[i] = binary.BigEndian.Uint16(hmtx[i*4:])
+	}
+
+	if _, err := f.glyphRange(uint16(f.numGlyphs - 1)); err != nil {
+		return nil, err
+	}
+	cmap, err := parseCmap(f.tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+	f.cmap = cmap
+	f.name = parseFontName(f.tables["name"])
+	return f, nil
+}
+
+// GlyphID 返回字符对应的字形编号，字体中没有该字符时 ok 为 false
+func (f *UnicodeFont) GlyphID(r rune) (gid uint16, ok bool) {
+	gid, ok = f.cmap[r]
+	return gid, ok && gid != 0
+}
+
+// advance 返回字形宽度（单位：1/1000 em）
+func (f *UnicodeFont) advance(gid uint16) int {
+	idx := int(gid)
+	if idx >= len(f.advances) {
+		idx = len(f.advances) - 1
+	}
+	return int(f.advances[idx]) * 1000 / f.unitsPerEm
+}
+
+// scale 将字体单位换算为 1/1000 em
+func (f *UnicodeFont) scale(v int) int {
+	return v * 1000 / f.unitsPerEm
+}
+
+// glyphRange 返回字形在 glyf 表中的区间
+func (f *UnicodeFont) glyphRange(gid uint16) ([2]int, error) {
+	loca := f.tables["loca"]
+	idx := int(gid)
+	var start, end int
+	if f.indexToLocFormat == 0 {
+		if len(loca) < (idx+2)*2 {
+			return [2]int{}, errFontTruncated
+		}
+		start = int(binary.BigEndian.Uint16(loca[idx*2:])) * 2
+		end = int(binary.BigEndian.Uint16(loca[idx*2+2:])) * 2
+	} else {
+		if len(loca) < (idx+2)*4 {
+			return [2]int{}, errFontTruncated
+		}
+		start = int(binary.BigEndian.Uint32(loca[idx*4:]))
+		end = int(binary.BigEndian.Uint32(loca[idx*4+4:]))
+	}
+	if start > end || end > len(f.tables["glyf"]) {
+		return [2]int{}, fmt.Errorf("glyph %d out of range", gid)
+	}
+	return [2]int{start, end}, nil
+}
+
+// 复合字形组件标志
+const (
+	glyfArgsAreWords    = 0x0001
+	glyfHaveScale       = 0x0008
+	glyfMoreComponents  = 0x0020
+	glyfHaveXYScale     = 0x0040
+	glyfHaveTwoByTwo    = 0x0080
+	glyfCompositeHeader = 10
+)
+
+// componentGlyphs 返回复合字形引用的组件字形
+func (f *UnicodeFont) componentGlyphs(gid uint16) []uint16 {
+	r, err := f.glyphRange(gid)
+	if err != nil || r[1]-r[0] < glyfCompositeHeader {
+		return nil
+	}
+	glyph := f.tables["glyf"][r[0]:r[1]]
+	if int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return nil
+	}
+	var out []uint16
+	pos := glyfCompositeHeader
+	for pos+4 <= len(glyph) {
+		flags := binary.BigEndian.Uint16(glyph[pos:])
+		out = append(out, binary.BigEndian.Uint16(glyph[pos+2:]))
+		pos += 4
+		if flags&glyfArgsAreWords != 0 {
+			pos += 4
+		} else {
+			pos += 2
+		}
+		switch {
+		case flags&glyfHaveScale != 0:
+			pos += 2
+		case flags&glyfHaveXYScale != 0:
+			pos += 4
+		case flags&glyfHaveTwoByTwo != 0:
+			pos += 8
+		}
+		if flags&glyfMoreComponents == 0 {
+			break
+		}
+	}
+	return out
+}
+
+// subset 生成只包含指定字形轮廓的字体文件；字形编号不变，未使用的字形为空轮廓
+func (f *UnicodeFont) subset(used map[uint16]rune) ([]byte, error) {
+	// 始终保留 .notdef（GID 0），复合字形递归保留其组件
+	keep := make(map[uint16]bool, len(used)+1)
+	queue := make([]uint16, 0, len(used)+1)
+	queue = append(queue, 0)
+	for gid := range used {
+		queue = append(queue, gid)
+	}
+	for len(queue) > 0 {
+		gid := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if keep[gid] || int(gid) >= f.numGlyphs {
+			continue
+		}
+		keep[gid] = true
+		queue = append(queue, f.componentGlyphs(gid)...)
+	}
+
+	src := f.tables["glyf"]
+	glyf := make([]byte, 0, 1024)
+	loca := make([]byte, (f.numGlyphs+1)*4)
+	for gid := 0; gid < f.numGlyphs; gid++ {
+		binary.BigEndian.PutUint32(loca[gid*4:], uint32(len(glyf)))
+		if !keep[uint16(gid)] {
+			continue
+		}
+		r, err := f.glyphRange(uint16(gid))
+		if err != nil {
+			return nil, err
+		}
+		glyf = append(glyf, src[r[0]:r[1]]...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[f.numGlyphs*4:], uint32(len(glyf)))
+
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment
+	binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat: long
+
+	tables := map[string][]byte{"glyf": glyf, "loca": loca, "head": head}
+	for _, tag := range subsetFontTables {
+		if _, ok := tables[tag]; ok {
+			continue
+		}
+		if data, ok := f.tables[tag]; ok {
+			tables[tag] = data
+		}
+	}
+	return buildFontFile(tables), nil
+}
+
+// buildFontFile 按表名排序写出 sfnt 文件
+func buildFontFile(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	n := len(tags)
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= n {
+		searchRange *= 2
+		entrySelector++
+	}
+	searchRange *= 16
+
+	header := make([]byte, 12+16*n)
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(n))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(n*16-searchRange))
+
+	out := header
+	for i, tag := range tags {
+		data := tables[tag]
+		// out 追加后可能重新分配，表目录需写入 out 而非 header
+		rec := out[12+16*i:]
+		copy(rec, tag)
+		binary.BigEndian.PutUint32(rec[4:], fontChecksum(data))
+		binary.BigEndian.PutUint32(rec[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(rec[12:], uint32(len(data)))
+		out = append(out, data...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+func fontChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// parseCmap 解析 Unicode 字符映射（优先 format 12 完整 Unicode，其次 format 4 BMP）
+func parseCmap(data []byte) (map[rune]uint16, error) {
+	if len(data) < 4 {
+		return nil, errFontTruncated
+	}
+	numTables := int(binary.BigEndian.Uint16(data[2:]))
+	best, bestScore := -1, 0
+	for i := 0; i < numTables; i++ {
+		rec := 4 + i*8
+		if rec+8 > len(data) {
+			return nil, errFontTruncated
+		}
+		platform := binary.BigEndian.Uint16(data[rec:])
+		encoding := binary.BigEndian.Uint16(data[rec+2:])
+		off := int(binary.BigEndian.Uint32(data[rec+4:]))
+		if off+2 > len(data) {
+			continue
+		}
+		format := binary.BigEndian.Uint16(data[off:])
+		score := 0
+		switch {
+		case format == 12 && (platform == 3 && encoding == 10 || platform == 0):
+			score = 3
+		case format == 4 && (platform == 3 && encoding == 1 || platform == 0):
+			score = 2
+		}
+		if score > bestScore {
+			best, bestScore = off, score
+		}
+	}
+	if best < 0 {
+		return nil, errors.New("font has no unicode cmap")
+	}
+
+	out := make(map[rune]uint16)
+	sub := data[best:]
+	if binary.BigEndian.Uint16(sub) == 12 {
+		if len(sub) < 16 {
+			return nil, errFontTruncated
+		}
+		groups := int(binary.BigEndian.Uint32(sub[12:]))
+		if len(sub) < 16+groups*12 {
+			return nil, errFontTruncated
+		}
+		for g := 0; g < groups; g++ {
+			rec := sub[16+g*12:]
+			start := binary.BigEndian.Uint32(rec)
+			end := binary.BigEndian.Uint32(rec[4:])
+			gid := binary.BigEndian.Uint32(rec[8:])
+			if end < start || end-start > 0x10ffff {
+				continue
+			}
+			for c := start; c <= end; c++ {
+				out[rune(c)] = uint16(gid + c - start)
+			}
+		}
+		return out, nil
+	}
+
+	if len(sub) < 14 {
+		return nil, errFontTruncated
+	}
+	segCount := int(binary.BigEndian.Uint16(sub[6:])) / 2
+	endCodes := 14
+	startCodes := endCodes + segCount*2 + 2
+	idDeltas := startCodes + segCount*2
+	idRangeOffsets := idDeltas + segCount*2
+	if len(sub) < idRangeOffsets+segCount*2 {
+		return nil, errFontTruncated
+	}
+	for s := 0; s < segCount; s++ {
+		end := int(binary.BigEndian.Uint16(sub[endCodes+s*2:]))
+		start := int(binary.BigEndian.Uint16(sub[startCodes+s*2:]))
+		delta := binary.BigEndian.Uint16(sub[idDeltas+s*2:])
+		rangeOffset := int(binary.BigEndian.Uint16(sub[idRangeOffsets+s*2:]))
+		if start > end || start == 0xffff {
+			continue
+		}
+		for c := start; c <= end; c++ {
+			var gid uint16
+			if rangeOffset == 0 {
+				gid = uint16(c) + delta
+			} else {
+				pos := idRangeOffsets + s*2 + rangeOffset + (c-start)*2
+				if pos+2 > len(sub) {
+					break
+				}
+				if gid = binary.BigEndian.Uint16(sub[pos:]); gid != 0 {
+					gid += delta
+				}
+			}
+			if gid != 0 {
+				out[rune(c)] = gid
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseFontName 读取 PostScript 名称（name ID 6），只保留 PDF 名称中安全的字符
+func parseFontName(data []byte) string {
+	const fallback = "UnicodeFont"
+	if len(data) < 6 {
+		return fallback
+	}
+	count := int(binary.BigEndian.Uint16(data[2:]))
+	storage := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < count; i++ {
+		rec := 6 + i*12
+		if rec+12 > len(data) {
+			break
+		}
+		platform := binary.BigEndian.Uint16(data[rec:])
+		nameID := binary.BigEndian.Uint16(data[rec+6:])
+		length := int(binary.BigEndian.Uint16(data[rec+8:]))
+		off := storage + int(binary.BigEndian.Uint16(data[rec+10:]))
+		if nameID != 6 || off+length > len(data) {
+			continue
+		}
+		raw := data[off : off+length]
+		var b strings.Builder
+		step := 1
+		if platform == 0 || platform == 3 {
+			step = 2 // UTF-16BE
+		}
+		for j := step - 1; j < len(raw); j += step {
+			c := raw[j]
+			if step == 2 && raw[j-1] != 0 {
+				continue
+			}
+			if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' {
+				b.WriteByte(c)
+			}
+		}
+		if b.Len() > 0 {
+			return b.String()
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,51 @@
+//go:build unit
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartEndAndTraceID(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	require.Empty(t, TraceID(context.Background()))
+
+	ctx, parent := Start(context.Background(), "parent")
+	traceID := TraceID(ctx)
+	require.Len(t, traceID, 32)
+
+	// Detach 后父 context 取消不影响异步任务，且仍在同一 trace 下
+	cancelCtx, cancel := context.WithCancel(ctx)
+	detached := Detach(cancelCtx)
+	cancel()
+	require.NoError(t, detached.Err())
+	_, child := Start(detached, "child")
+	End(child, errors.New("boom"))
+	End(parent, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name())
+	require.Equal(t, traceID, spans[0].SpanContext().TraceID().String())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestNewExporterRejectsUnknown(t *testing.T) {
+	_, err := newExporter(context.Background(), Options{Exporter: "zipkin"})
+	require.Error(t, err)
+}
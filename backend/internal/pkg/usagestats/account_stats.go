@@ -12,3 +12,13 @@ type AccountStats struct {
 	StandardCost float64 `json:"standard_cost"`
 	UserCost     float64 `json:"user_cost"`
 }
+
+// AccountPeriodUsage 账号在自然日/周/月内的用量（费用为账号口径，用于账号预算）
+type AccountPeriodUsage struct {
+	DailyCost     float64 `json:"daily_cost"`
+	DailyTokens   int64   `json:"daily_tokens"`
+	WeeklyCost    float64 `json:"weekly_cost"`
+	WeeklyTokens  int64   `json:"weekly_tokens"`
+	MonthlyCost   float64 `json:"monthly_cost"`
+	MonthlyTokens int64   `json:"monthly_tokens"`
+}
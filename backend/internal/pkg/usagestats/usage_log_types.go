@@ -226,3 +226,36 @@ type AccountUsageStatsResponse struct {
 	Summary AccountUsageSummary   `json:"summary"`
 	Models  []ModelStat           `json:"models"`
 }
+
+// PromptCacheStat represents prompt cache usage for a single account or group
+type PromptCacheStat struct {
+	ID                  int64   `json:"id"`
+	Name                string  `json:"name"`
+	Requests            int64   `json:"requests"`
+	CacheHitRequests    int64   `json:"cache_hit_requests"` // cache_read_tokens > 0 的请求数
+	InputTokens         int64   `json:"input_tokens"`
+	CacheCreationTokens int64   `json:"cache_creation_tokens"`
+	CacheReadTokens     int64   `json:"cache_read_tokens"`
+	HitRate             float64 `json:"hit_rate"` // cache_read / (input + cache_creation + cache_read)
+}
+
+// UserModelCacheRead represents cache read tokens grouped by user, model and rate multiplier.
+// Used to price cache savings with the same billing rules as the original requests.
+type UserModelCacheRead struct {
+	UserID          int64
+	Email           string
+	Model           string
+	RateMultiplier  float64
+	Requests        int64
+	CacheReadTokens int64
+}
+
+// UserCacheSavings represents the cost saved by prompt cache reads for a single user
+type UserCacheSavings struct {
+	UserID           int64   `json:"user_id"`
+	Email            string  `json:"email"`
+	CacheReadTokens  int64   `json:"cache_read_tokens"`
+	CacheHitRequests int64   `json:"cache_hit_requests"`
+	Savings          float64 `json:"savings"`        // 标准计费节省
+	ActualSavings    float64 `json:"actual_savings"` // 按倍率计算的实际节省
+}
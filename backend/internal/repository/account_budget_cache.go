@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	accountBudgetKeyPrefix = "account:budget:"
+	accountBudgetCacheTTL  = 5 * time.Minute
+)
+
+const (
+	budgetFieldDailyCost          = "daily_cost"
+	budgetFieldDailyTokens        = "daily_tokens"
+	budgetFieldDailyWindowStart   = "daily_window_start"
+	budgetFieldWeeklyCost         = "weekly_cost"
+	budgetFieldWeeklyTokens       = "weekly_tokens"
+	budgetFieldWeeklyWindowStart  = "weekly_window_start"
+	budgetFieldMonthlyCost        = "monthly_cost"
+	budgetFieldMonthlyTokens      = "monthly_tokens"
+	budgetFieldMonthlyWindowStart = "monthly_window_start"
+)
+
+// addAccountBudgetUsageScript 累加账号预算用量；日/周/月窗口起点落后于当前窗口时先归零
+var addAccountBudgetUsageScript = redis.NewScript(`
+	local exists = redis.call('EXISTS', KEYS[1])
+	if exists == 0 then
+		return 0
+	end
+	local cost = tonumber(ARGV[1])
+	local tokens = tonumber(ARGV[2])
+	local periods = {'daily', 'weekly', 'monthly'}
+	for i, period in ipairs(periods) do
+		local windowStart = tonumber(ARGV[2 + i])
+		local stored = tonumber(redis.call('HGET', KEYS[1], period .. '_window_start') or '0')
+		if stored == nil or stored < windowStart then
+			redis.call('HSET', KEYS[1], period .. '_cost', 0, period .. '_tokens', 0, period .. '_window_start', windowStart)
+		end
+		redis.call('HINCRBYFLOAT', KEYS[1], period .. '_cost', cost)
+		redis.call('HINCRBY', KEYS[1], period .. '_tokens', tokens)
+	end
+	redis.call('EXPIRE', KEYS[1], ARGV[6])
+	return 1
+`)
+
+func accountBudgetKey(accountID int64) string {
+	return fmt.Sprintf("%s%d", accountBudgetKeyPrefix, accountID)
+}
+
+type accountBudgetCache struct {
+	rdb *redis.Client
+}
+
+func NewAccountBudgetCache(rdb *redis.Client) service.AccountBudgetCache {
+	return &accountBudgetCache{rdb: rdb}
+}
+
+func (c *accountBudgetCache) GetAccountBudgetUsage(ctx context.Context, accountID int64) (*service.AccountBudgetUsage, error) {
+	result, err := c.rdb.HGetAll(ctx, accountBudgetKey(accountID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, redis.Nil
+	}
+	return parseAccountBudgetUsage(result)
+}
+
+func parseAccountBudgetUsage(data map[string]string) (*service.AccountBudgetUsage, error) {
+	if _, ok := data[budgetFieldDailyWindowStart]; !ok {
+		return nil, errors.New("invalid cache: missing daily window start")
+	}
+	result := &service.AccountBudgetUsage{}
+	result.DailyCost, _ = strconv.ParseFloat(data[budgetFieldDailyCost], 64)
+	result.DailyTokens, _ = strconv.ParseInt(data[budgetFieldDailyTokens], 10, 64)
+	result.WeeklyCost, _ = strconv.ParseFloat(data[budgetFieldWeeklyCost], 64)
+	result.WeeklyTokens, _ = strconv.ParseInt(data[budgetFieldWeeklyTokens], 10, 64)
+	result.MonthlyCost, _ = strconv.ParseFloat(data[budgetFieldMonthlyCost], 64)
+	result.MonthlyTokens, _ = strconv.ParseInt(data[budgetFieldMonthlyTokens], 10, 64)
+	if v, err := strconv.ParseInt(data[budgetFieldDailyWindowStart], 10, 64); err == nil && v > 0 {
+		result.DailyWindowStart = time.Unix(v, 0)
+	}
+	if v, err := strconv.ParseInt(data[budgetFieldWeeklyWindowStart], 10, 64); err == nil && v > 0 {
+		result.WeeklyWindowStart = time.Unix(v, 0)
+	}
+	if v, err := strconv.ParseInt(data[budgetFieldMonthlyWindowStart], 10, 64); err == nil && v > 0 {
+		result.MonthlyWindowStart = time.Unix(v, 0)
+	}
+	return result, nil
+}
+
+func (c *accountBudgetCache) SetAccountBudgetUsage(ctx context.Context, accountID int64, usage *service.AccountBudgetUsage) error {
+	if usage == nil {
+		return nil
+	}
+
+	key := accountBudgetKey(accountID)
+	fields := map[string]any{
+		budgetFieldDailyCost:          usage.DailyCost,
+		budgetFieldDailyTokens:        usage.DailyTokens,
+		budgetFieldDailyWindowStart:   unixOrZero(usage.DailyWindowStart),
+		budgetFieldWeeklyCost:         usage.WeeklyCost,
+		budgetFieldWeeklyTokens:       usage.WeeklyTokens,
+		budgetFieldWeeklyWindowStart:  unixOrZero(usage.WeeklyWindowStart),
+		budgetFieldMonthlyCost:        usage.MonthlyCost,
+		budgetFieldMonthlyTokens:      usage.MonthlyTokens,
+		budgetFieldMonthlyWindowStart: unixOrZero(usage.MonthlyWindowStart),
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, accountBudgetCacheTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *accountBudgetCache) AddAccountBudgetUsage(ctx context.Context, accountID int64, cost float64, tokens int64, dayStart, weekStart, monthStart time.Time) error {
+	key := accountBudgetKey(accountID)
+	_, err := addAccountBudgetUsageScript.Run(ctx, c.rdb, []string{key}, cost, tokens, dayStart.Unix(), weekStart.Unix(), monthStart.Unix(), int(accountBudgetCacheTTL.Seconds())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	return nil
+}
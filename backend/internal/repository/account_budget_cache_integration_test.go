@@ -0,0 +1,76 @@
+//go:build integration
+
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountBudgetCacheSuite struct {
+	IntegrationRedisSuite
+	cache service.AccountBudgetCache
+}
+
+func (s *AccountBudgetCacheSuite) SetupTest() {
+	s.IntegrationRedisSuite.SetupTest()
+	s.cache = NewAccountBudgetCache(s.rdb)
+}
+
+func (s *AccountBudgetCacheSuite) TestAdd_MissingKeyIsNoop() {
+	now := time.Now()
+	require.NoError(s.T(), s.cache.AddAccountBudgetUsage(s.ctx, 1, 1.0, 100, now, now, now), "AddAccountBudgetUsage")
+
+	_, err := s.cache.GetAccountBudgetUsage(s.ctx, 1)
+	require.True(s.T(), errors.Is(err, redis.Nil), "expected redis.Nil for missing key")
+}
+
+func (s *AccountBudgetCacheSuite) TestAdd_RollsExpiredWindows() {
+	dayStart := time.Unix(1700000000, 0)
+	weekStart := dayStart.Add(-72 * time.Hour)
+	monthStart := dayStart.Add(-240 * time.Hour)
+
+	require.NoError(s.T(), s.cache.SetAccountBudgetUsage(s.ctx, 2, &service.AccountBudgetUsage{
+		DailyCost:          1,
+		DailyTokens:        10,
+		DailyWindowStart:   dayStart,
+		WeeklyCost:         2,
+		WeeklyTokens:       20,
+		WeeklyWindowStart:  weekStart,
+		MonthlyCost:        3,
+		MonthlyTokens:      30,
+		MonthlyWindowStart: monthStart,
+	}), "SetAccountBudgetUsage")
+
+	require.NoError(s.T(), s.cache.AddAccountBudgetUsage(s.ctx, 2, 0.5, 5, dayStart, weekStart, monthStart), "AddAccountBudgetUsage")
+	got, err := s.cache.GetAccountBudgetUsage(s.ctx, 2)
+	require.NoError(s.T(), err, "GetAccountBudgetUsage")
+	require.InDelta(s.T(), 1.5, got.DailyCost, 1e-9)
+	require.Equal(s.T(), int64(15), got.DailyTokens)
+	require.InDelta(s.T(), 3.5, got.MonthlyCost, 1e-9)
+
+	// 跨天：仅日窗口归零
+	nextDay := dayStart.Add(24 * time.Hour)
+	require.NoError(s.T(), s.cache.AddAccountBudgetUsage(s.ctx, 2, 0.25, 1, nextDay, weekStart, monthStart), "AddAccountBudgetUsage next day")
+	got, err = s.cache.GetAccountBudgetUsage(s.ctx, 2)
+	require.NoError(s.T(), err, "GetAccountBudgetUsage next day")
+	require.InDelta(s.T(), 0.25, got.DailyCost, 1e-9)
+	require.Equal(s.T(), int64(1), got.DailyTokens)
+	require.Equal(s.T(), nextDay.Unix(), got.DailyWindowStart.Unix())
+	require.InDelta(s.T(), 2.75, got.WeeklyCost, 1e-9)
+	require.Equal(s.T(), int64(36), got.MonthlyTokens)
+
+	ttl, err := s.rdb.TTL(s.ctx, accountBudgetKey(2)).Result()
+	require.NoError(s.T(), err, "TTL")
+	s.AssertTTLWithin(ttl, time.Second, accountBudgetCacheTTL)
+}
+
+func TestAccountBudgetCacheSuite(t *testing.T) {
+	suite.Run(t, new(AccountBudgetCacheSuite))
+}
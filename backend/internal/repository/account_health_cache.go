@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const accountHealthKeyPrefix = "account:health:"
+
+func accountHealthKey(accountID int64) string {
+	return fmt.Sprintf("%s%d", accountHealthKeyPrefix, accountID)
+}
+
+type accountHealthCache struct {
+	rdb *redis.Client
+}
+
+func NewAccountHealthCache(rdb *redis.Client) service.AccountHealthCache {
+	return &accountHealthCache{rdb: rdb}
+}
+
+func (c *accountHealthCache) GetAccountHealthStats(ctx context.Context, accountIDs []int64) (map[int64]*service.AccountHealthStats, error) {
+	results := make(map[int64]*service.AccountHealthStats)
+	if len(accountIDs) == 0 {
+		return results, nil
+	}
+
+	keys := make([]string, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		keys = append(keys, accountHealthKey(id))
+	}
+
+	values, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return results, err
+	}
+
+	for i, raw := range values {
+		if raw == nil {
+			continue
+		}
+		var payload []byte
+		switch v := raw.(type) {
+		case string:
+			payload = []byte(v)
+		case []byte:
+			payload = v
+		default:
+			continue
+		}
+		var stats service.AccountHealthStats
+		if err := json.Unmarshal(payload, &stats); err != nil {
+			continue
+		}
+		results[accountIDs[i]] = &stats
+	}
+
+	return results, nil
+}
+
+func (c *accountHealthCache) SetAccountHealthStats(ctx context.Context, stats []*service.AccountHealthStats, ttl time.Duration) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, st := range stats {
+		if st == nil {
+			continue
+		}
+		payload, err := json.Marshal(st)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, accountHealthKey(st.AccountID), payload, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
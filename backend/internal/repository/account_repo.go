@@ -15,6 +15,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -40,6 +41,7 @@ import (
 //   - client: Ent 客户端，用于类型安全的 ORM 操作
 //   - sql: 原生 SQL 执行器，用于复杂查询和批量操作
 //   - schedulerCache: 调度器缓存，用于在账号状态变更时同步快照
+//   - cipher: 凭证静态加密，写入时加密、读取时透明解密
 type accountRepository struct {
 	client *dbent.Client // Ent ORM 客户端
 	sql    sqlExecutor   // 原生 SQL 执行接口
@@ -48,6 +50,7 @@ type accountRepository struct {
 	// Used to proactively sync account snapshot to cache when status changes,
 	// ensuring sticky sessions can promptly detect unavailable accounts.
 	schedulerCache service.SchedulerCache
+	cipher         *SecretCipher
 }
 
 type tempUnschedSnapshot struct {
@@ -57,27 +60,31 @@ type tempUnschedSnapshot struct {
 
 // NewAccountRepository 创建账户仓储实例。
 // 这是对外暴露的构造函数，返回接口类型以便于依赖注入。
-func NewAccountRepository(client *dbent.Client, sqlDB *sql.DB, schedulerCache service.SchedulerCache) service.AccountRepository {
-	return newAccountRepositoryWithSQL(client, sqlDB, schedulerCache)
+func NewAccountRepository(client *dbent.Client, sqlDB *sql.DB, schedulerCache service.SchedulerCache, cipher *SecretCipher) service.AccountRepository {
+	return newAccountRepositoryWithSQL(client, sqlDB, schedulerCache, cipher)
 }
 
 // newAccountRepositoryWithSQL 是内部构造函数，支持依赖注入 SQL 执行器。
 // 这种设计便于单元测试时注入 mock 对象。
-func newAccountRepositoryWithSQL(client *dbent.Client, sqlq sqlExecutor, schedulerCache service.SchedulerCache) *accountRepository {
-	return &accountRepository{client: client, sql: sqlq, schedulerCache: schedulerCache}
+func newAccountRepositoryWithSQL(client *dbent.Client, sqlq sqlExecutor, schedulerCache service.SchedulerCache, cipher *SecretCipher) *accountRepository {
+	return &accountRepository{client: client, sql: sqlq, schedulerCache: schedulerCache, cipher: cipher}
 }
 
 func (r *accountRepository) Create(ctx context.Context, account *service.Account) error {
 	if account == nil {
 		return service.ErrAccountNilInput
 	}
+	credentials, err := r.cipher.sealCredentials(normalizeJSONMap(account.Credentials))
+	if err != nil {
+		return err
+	}
 
 	builder := r.client.Account.Create().
 		SetName(account.Name).
 		SetNillableNotes(account.Notes).
 		SetPlatform(account.Platform).
 		SetType(account.Type).
-		SetCredentials(normalizeJSONMap(account.Credentials)).
+		SetCredentials(credentials).
 		SetExtra(normalizeJSONMap(account.Extra)).
 		SetConcurrency(account.Concurrency).
 		SetPriority(account.Priority).
@@ -93,6 +100,9 @@ func (r *accountRepository) Create(ctx context.Context, account *service.Account
 	if account.ProxyID != nil {
 		builder.SetProxyID(*account.ProxyID)
 	}
+	if account.ProxyPoolID != nil {
+		builder.SetProxyPoolID(*account.ProxyPoolID)
+	}
 	if account.LastUsedAt != nil {
 		builder.SetLastUsedAt(*account.LastUsedAt)
 	}
@@ -205,10 +215,16 @@ func (r *accountRepository) GetByIDs(ctx context.Context, ids []int64) ([]*servi
 		if out == nil {
 			continue
 		}
+		if err := r.openCredentials(out); err != nil {
+			return nil, err
+		}
 
 		// Prefer the preloaded proxy edge when available.
 		if entAcc.Edges.Proxy != nil {
 			out.Proxy = proxyEntityToService(entAcc.Edges.Proxy)
+			if err := openProxyPassword(r.cipher, out.Proxy); err != nil {
+				return nil, err
+			}
 		}
 
 		if groups, ok := groupsByAccount[entAcc.ID]; ok {
@@ -286,13 +302,17 @@ func (r *accountRepository) Update(ctx context.Context, account *service.Account
 	if account == nil {
 		return nil
 	}
+	credentials, err := r.cipher.sealCredentials(normalizeJSONMap(account.Credentials))
+	if err != nil {
+		return err
+	}
 
 	builder := r.client.Account.UpdateOneID(account.ID).
 		SetName(account.Name).
 		SetNillableNotes(account.Notes).
 		SetPlatform(account.Platform).
 		SetType(account.Type).
-		SetCredentials(normalizeJSONMap(account.Credentials)).
+		SetCredentials(credentials).
 		SetExtra(normalizeJSONMap(account.Extra)).
 		SetConcurrency(account.Concurrency).
 		SetPriority(account.Priority).
@@ -310,6 +330,11 @@ func (r *accountRepository) Update(ctx context.Context, account *service.Account
 	} else {
 		builder.ClearProxyID()
 	}
+	if account.ProxyPoolID != nil {
+		builder.SetProxyPoolID(*account.ProxyPoolID)
+	} else {
+		builder.ClearProxyPoolID()
+	}
 	if account.LastUsedAt != nil {
 		builder.SetLastUsedAt(*account.LastUsedAt)
 	} else {
@@ -1107,6 +1132,7 @@ func (r *accountRepository) BulkUpdate(ctx context.Context, ids []int64, updates
 	}
 	if updates.ProxyID != nil {
 		// 0 表示清除代理（前端发送 0 而不是 null 来表达清除意图）
+		// 直接指定代理即解除代理池绑定
 		if *updates.ProxyID == 0 {
 			setClauses = append(setClauses, "proxy_id = NULL")
 		} else {
@@ -1114,6 +1140,7 @@ func (r *accountRepository) BulkUpdate(ctx context.Context, ids []int64, updates
 			args = append(args, *updates.ProxyID)
 			idx++
 		}
+		setClauses = append(setClauses, "proxy_pool_id = NULL")
 	}
 	if updates.Concurrency != nil {
 		setClauses = append(setClauses, "concurrency = $"+itoa(idx))
@@ -1141,7 +1168,14 @@ func (r *accountRepository) BulkUpdate(ctx context.Context, ids []int64, updates
 		idx++
 	}
 	// JSONB 需要合并而非覆盖，使用 raw SQL 保持旧行为。
-	if len(updates.Credentials) > 0 {
+	// 配置了静态加密时凭证可能是密文，无法在 SQL 中合并，改为逐行解密合并后重新加密。
+	credentialsMerged := false
+	if len(updates.Credentials) > 0 && r.cipher.configured() {
+		if err := r.mergeCredentials(ctx, ids, updates.Credentials); err != nil {
+			return 0, err
+		}
+		credentialsMerged = true
+	} else if len(updates.Credentials) > 0 {
 		payload, err := json.Marshal(updates.Credentials)
 		if err != nil {
 			return 0, err
@@ -1160,7 +1194,7 @@ func (r *accountRepository) BulkUpdate(ctx context.Context, ids []int64, updates
 		idx++
 	}
 
-	if len(setClauses) == 0 {
+	if len(setClauses) == 0 && !credentialsMerged {
 		return 0, nil
 	}
 
@@ -1299,6 +1333,9 @@ func (r *accountRepository) accountsToService(ctx context.Context, accounts []*d
 		if out == nil {
 			continue
 		}
+		if err := r.openCredentials(out); err != nil {
+			return nil, err
+		}
 		if acc.ProxyID != nil {
 			if proxy, ok := proxyMap[*acc.ProxyID]; ok {
 				out.Proxy = proxy
@@ -1395,11 +1432,75 @@ func (r *accountRepository) loadProxies(ctx context.Context, proxyIDs []int64) (
 	}
 
 	for _, p := range proxies {
-		proxyMap[p.ID] = proxyEntityToService(p)
+		out := proxyEntityToService(p)
+		if err := openProxyPassword(r.cipher, out); err != nil {
+			return nil, err
+		}
+		proxyMap[p.ID] = out
 	}
 	return proxyMap, nil
 }
 
+// mergeCredentials 在事务内锁定账号行，解密凭证后合并 patch 并重新加密写回
+func (r *accountRepository) mergeCredentials(ctx context.Context, ids []int64, patch map[string]any) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return err
+	}
+
+	var txClient *dbent.Client
+	if err == nil {
+		defer func() { _ = tx.Rollback() }()
+		txClient = tx.Client()
+	} else {
+		// 已处于外部事务中（ErrTxStarted），复用当前 client
+		txClient = r.client
+	}
+
+	accounts, err := txClient.Account.Query().
+		Where(dbaccount.IDIn(ids...)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, acc := range accounts {
+		current, err := r.cipher.openCredentials(acc.Credentials)
+		if err != nil {
+			return fmt.Errorf("open credentials of account %d: %w", acc.ID, err)
+		}
+		merged := make(map[string]any, len(current)+len(patch))
+		for k, v := range current {
+			merged[k] = v
+		}
+		for k, v := range patch {
+			merged[k] = v
+		}
+		sealed, err := r.cipher.sealCredentials(merged)
+		if err != nil {
+			return err
+		}
+		if err := txClient.Account.UpdateOneID(acc.ID).SetCredentials(sealed).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if tx != nil {
+		return tx.Commit()
+	}
+	return nil
+}
+
+// openCredentials 解密账号凭证（未加密的历史数据原样返回）
+func (r *accountRepository) openCredentials(account *service.Account) error {
+	credentials, err := r.cipher.openCredentials(account.Credentials)
+	if err != nil {
+		return fmt.Errorf("open credentials of account %d: %w", account.ID, err)
+	}
+	account.Credentials = credentials
+	return nil
+}
+
 func (r *accountRepository) loadAccountGroups(ctx context.Context, accountIDs []int64) (map[int64][]*service.Group, map[int64][]int64, map[int64][]service.AccountGroup, error) {
 	groupsByAccount := make(map[int64][]*service.Group)
 	groupIDsByAccount := make(map[int64][]int64)
@@ -1501,6 +1602,7 @@ func accountEntityToService(m *dbent.Account) *service.Account {
 		Credentials:         copyJSONMap(m.Credentials),
 		Extra:               copyJSONMap(m.Extra),
 		ProxyID:             m.ProxyID,
+		ProxyPoolID:         m.ProxyPoolID,
 		Concurrency:         m.Concurrency,
 		Priority:            m.Priority,
 		RateMultiplier:      &rateMultiplier,
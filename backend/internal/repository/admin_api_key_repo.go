@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/adminapikey"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type adminAPIKeyRepository struct {
+	client *dbent.Client
+}
+
+func NewAdminAPIKeyRepository(client *dbent.Client) service.AdminAPIKeyRepository {
+	return &adminAPIKeyRepository{client: client}
+}
+
+func (r *adminAPIKeyRepository) Create(ctx context.Context, key *service.AdminAPIKey, keyHash string) error {
+	created, err := r.client.AdminAPIKey.Create().
+		SetName(key.Name).
+		SetKeyHash(keyHash).
+		SetKeyPrefix(key.KeyPrefix).
+		SetScopes(key.Scopes).
+		SetNillableExpiresAt(key.ExpiresAt).
+		SetNillableCreatedBy(key.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	key.ID = created.ID
+	key.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *adminAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*service.AdminAPIKey, error) {
+	m, err := r.client.AdminAPIKey.Query().
+		Where(adminapikey.KeyHashEQ(keyHash)).
+		Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, service.ErrAdminAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return adminAPIKeyEntityToService(m), nil
+}
+
+func (r *adminAPIKeyRepository) List(ctx context.Context) ([]service.AdminAPIKey, error) {
+	models, err := r.client.AdminAPIKey.Query().
+		Order(dbent.Desc(adminapikey.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.AdminAPIKey, 0, len(models))
+	for _, m := range models {
+		out = append(out, *adminAPIKeyEntityToService(m))
+	}
+	return out, nil
+}
+
+func (r *adminAPIKeyRepository) Delete(ctx context.Context, id int64) error {
+	n, err := r.client.AdminAPIKey.Delete().
+		Where(adminapikey.IDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrAdminAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *adminAPIKeyRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time) error {
+	return r.client.AdminAPIKey.UpdateOneID(id).
+		SetLastUsedAt(at).
+		Exec(ctx)
+}
+
+func adminAPIKeyEntityToService(m *dbent.AdminAPIKey) *service.AdminAPIKey {
+	if m == nil {
+		return nil
+	}
+	return &service.AdminAPIKey{
+		ID:         m.ID,
+		Name:       m.Name,
+		KeyPrefix:  m.KeyPrefix,
+		Scopes:     m.Scopes,
+		ExpiresAt:  m.ExpiresAt,
+		LastUsedAt: m.LastUsedAt,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+	}
+}
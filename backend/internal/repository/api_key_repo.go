@@ -33,7 +33,16 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 		SetKey(key.Key).
 		SetName(key.Name).
 		SetStatus(key.Status).
-		SetNillableGroupID(key.GroupID)
+		SetNillableGroupID(key.GroupID).
+		SetNillableQuotaUsd(key.QuotaUSD).
+		SetNillableDailyLimitUsd(key.DailyLimitUSD).
+		SetNillableMonthlyLimitUsd(key.MonthlyLimitUSD).
+		SetNillableExpiresAt(key.ExpiresAt).
+		SetRpmLimit(key.RateLimits.RPM).
+		SetInputTpmLimit(key.RateLimits.InputTPM).
+		SetOutputTpmLimit(key.RateLimits.OutputTPM).
+		SetResponseCacheEnabled(key.ResponseCache.Enabled).
+		SetResponseCacheTTLSeconds(key.ResponseCache.TTLSeconds)
 
 	if len(key.IPWhitelist) > 0 {
 		builder.SetIPWhitelist(key.IPWhitelist)
@@ -41,6 +50,12 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 	if len(key.IPBlacklist) > 0 {
 		builder.SetIPBlacklist(key.IPBlacklist)
 	}
+	if len(key.AllowedModels) > 0 {
+		builder.SetAllowedModels(key.AllowedModels)
+	}
+	if len(key.ModelAliases) > 0 {
+		builder.SetModelAliases(key.ModelAliases)
+	}
 
 	created, err := builder.Save(ctx)
 	if err == nil {
@@ -110,6 +125,17 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 			apikey.FieldStatus,
 			apikey.FieldIPWhitelist,
 			apikey.FieldIPBlacklist,
+			apikey.FieldQuotaUsd,
+			apikey.FieldDailyLimitUsd,
+			apikey.FieldMonthlyLimitUsd,
+			apikey.FieldExpiresAt,
+			apikey.FieldAllowedModels,
+			apikey.FieldModelAliases,
+			apikey.FieldRpmLimit,
+			apikey.FieldInputTpmLimit,
+			apikey.FieldOutputTpmLimit,
+			apikey.FieldResponseCacheEnabled,
+			apikey.FieldResponseCacheTTLSeconds,
 		).
 		WithUser(func(q *dbent.UserQuery) {
 			q.Select(
@@ -118,6 +144,9 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				user.FieldRole,
 				user.FieldBalance,
 				user.FieldConcurrency,
+				user.FieldRpmLimit,
+				user.FieldInputTpmLimit,
+				user.FieldOutputTpmLimit,
 			)
 		}).
 		WithGroup(func(q *dbent.GroupQuery) {
@@ -138,6 +167,12 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				group.FieldFallbackGroupID,
 				group.FieldModelRoutingEnabled,
 				group.FieldModelRouting,
+				group.FieldRpmLimit,
+				group.FieldInputTpmLimit,
+				group.FieldOutputTpmLimit,
+				group.FieldResponseCacheEnabled,
+				group.FieldResponseCacheTTLSeconds,
+				group.FieldResponseCacheCostRatio,
 			)
 		}).
 		Only(ctx)
@@ -147,7 +182,16 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 		}
 		return nil, err
 	}
-	return apiKeyEntityToService(m), nil
+	out := apiKeyEntityToService(m)
+	if out.User != nil {
+		// 组织成员身份决定计费主体与组织并发池，随认证快照一起缓存
+		membership, err := loadOrganizationMembership(ctx, r.client, out.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		out.User.Organization = membership
+	}
+	return out, nil
 }
 
 func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) error {
@@ -161,6 +205,11 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		Where(apikey.IDEQ(key.ID), apikey.DeletedAtIsNil()).
 		SetName(key.Name).
 		SetStatus(key.Status).
+		SetRpmLimit(key.RateLimits.RPM).
+		SetInputTpmLimit(key.RateLimits.InputTPM).
+		SetOutputTpmLimit(key.RateLimits.OutputTPM).
+		SetResponseCacheEnabled(key.ResponseCache.Enabled).
+		SetResponseCacheTTLSeconds(key.ResponseCache.TTLSeconds).
 		SetUpdatedAt(now)
 	if key.GroupID != nil {
 		builder.SetGroupID(*key.GroupID)
@@ -180,6 +229,40 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		builder.ClearIPBlacklist()
 	}
 
+	// 模型白名单与别名
+	if len(key.AllowedModels) > 0 {
+		builder.SetAllowedModels(key.AllowedModels)
+	} else {
+		builder.ClearAllowedModels()
+	}
+	if len(key.ModelAliases) > 0 {
+		builder.SetModelAliases(key.ModelAliases)
+	} else {
+		builder.ClearModelAliases()
+	}
+
+	// 花费上限与过期时间
+	if key.QuotaUSD != nil {
+		builder.SetQuotaUsd(*key.QuotaUSD)
+	} else {
+		builder.ClearQuotaUsd()
+	}
+	if key.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*key.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if key.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*key.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+	if key.ExpiresAt != nil {
+		builder.SetExpiresAt(*key.ExpiresAt)
+	} else {
+		builder.ClearExpiresAt()
+	}
+
 	affected, err := builder.Save(ctx)
 	if err != nil {
 		return err
@@ -194,6 +277,47 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 	return nil
 }
 
+// IncrementUsage 原子累加 API Key 用量。
+// 日/月窗口起点早于传入的当前窗口起点时，先归零再累加，避免依赖定时任务重置。
+func (r *apiKeyRepository) IncrementUsage(ctx context.Context, id int64, costUSD float64, dayStart, monthStart time.Time) error {
+	const updateSQL = `
+		UPDATE api_keys
+		SET
+			quota_used_usd = quota_used_usd + $1,
+			daily_usage_usd = CASE
+				WHEN daily_window_start IS NULL OR daily_window_start < $3 THEN $1
+				ELSE daily_usage_usd + $1
+			END,
+			daily_window_start = CASE
+				WHEN daily_window_start IS NULL OR daily_window_start < $3 THEN $3
+				ELSE daily_window_start
+			END,
+			monthly_usage_usd = CASE
+				WHEN monthly_window_start IS NULL OR monthly_window_start < $4 THEN $1
+				ELSE monthly_usage_usd + $1
+			END,
+			monthly_window_start = CASE
+				WHEN monthly_window_start IS NULL OR monthly_window_start < $4 THEN $4
+				ELSE monthly_window_start
+			END
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	client := clientFromContext(ctx, r.client)
+	result, err := client.ExecContext(ctx, updateSQL, costUSD, id, dayStart, monthStart)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
 func (r *apiKeyRepository) Delete(ctx context.Context, id int64) error {
 	// 显式软删除：避免依赖 Hook 行为，确保 deleted_at 一定被设置。
 	affected, err := r.client.APIKey.Update().
@@ -372,6 +496,21 @@ func apiKeyEntityToService(m *dbent.APIKey) *service.APIKey {
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 		GroupID:     m.GroupID,
+
+		AllowedModels: m.AllowedModels,
+		ModelAliases:  m.ModelAliases,
+		RateLimits:    service.RequestRateLimits{RPM: m.RpmLimit, InputTPM: m.InputTpmLimit, OutputTPM: m.OutputTpmLimit},
+		ResponseCache: service.ResponseCacheSettings{Enabled: m.ResponseCacheEnabled, TTLSeconds: m.ResponseCacheTTLSeconds},
+
+		QuotaUSD:           m.QuotaUsd,
+		DailyLimitUSD:      m.DailyLimitUsd,
+		MonthlyLimitUSD:    m.MonthlyLimitUsd,
+		ExpiresAt:          m.ExpiresAt,
+		QuotaUsedUSD:       m.QuotaUsedUsd,
+		DailyUsageUSD:      m.DailyUsageUsd,
+		MonthlyUsageUSD:    m.MonthlyUsageUsd,
+		DailyWindowStart:   m.DailyWindowStart,
+		MonthlyWindowStart: m.MonthlyWindowStart,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
@@ -399,6 +538,7 @@ func userEntityToService(u *dbent.User) *service.User {
 		TotpSecretEncrypted: u.TotpSecretEncrypted,
 		TotpEnabled:         u.TotpEnabled,
 		TotpEnabledAt:       u.TotpEnabledAt,
+		RateLimits:          service.RequestRateLimits{RPM: u.RpmLimit, InputTPM: u.InputTpmLimit, OutputTPM: u.OutputTpmLimit},
 		CreatedAt:           u.CreatedAt,
 		UpdatedAt:           u.UpdatedAt,
 	}
@@ -429,8 +569,14 @@ func groupEntityToService(g *dbent.Group) *service.Group {
 		FallbackGroupID:     g.FallbackGroupID,
 		ModelRouting:        g.ModelRouting,
 		ModelRoutingEnabled: g.ModelRoutingEnabled,
-		CreatedAt:           g.CreatedAt,
-		UpdatedAt:           g.UpdatedAt,
+		RateLimits:          service.RequestRateLimits{RPM: g.RpmLimit, InputTPM: g.InputTpmLimit, OutputTPM: g.OutputTpmLimit},
+		ResponseCache: service.ResponseCacheSettings{
+			Enabled:    g.ResponseCacheEnabled,
+			TTLSeconds: g.ResponseCacheTTLSeconds,
+		},
+		ResponseCacheCostRatio: g.ResponseCacheCostRatio,
+		CreatedAt:              g.CreatedAt,
+		UpdatedAt:              g.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/auditlog"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type auditLogRepository struct {
+	client *dbent.Client
+}
+
+func NewAuditLogRepository(client *dbent.Client) service.AuditLogRepository {
+	return &auditLogRepository{client: client}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *service.AuditLog) error {
+	builder := r.client.AuditLog.Create().
+		SetNillableActorUserID(entry.ActorUserID).
+		SetActorType(entry.ActorType).
+		SetAction(entry.Action).
+		SetTargetType(entry.TargetType).
+		SetTargetID(entry.TargetID).
+		SetStatusCode(entry.StatusCode).
+		SetIPAddress(entry.IPAddress).
+		SetUserAgent(entry.UserAgent).
+		SetRequestID(entry.RequestID)
+	if entry.Before != nil {
+		builder.SetBefore(entry.Before)
+	}
+	if entry.After != nil {
+		builder.SetAfter(entry.After)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	entry.ID = created.ID
+	entry.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *auditLogRepository) List(ctx context.Context, params pagination.PaginationParams, filter service.AuditLogFilter) ([]service.AuditLog, *pagination.PaginationResult, error) {
+	q := applyAuditLogFilter(r.client.AuditLog.Query(), filter)
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(auditlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return auditLogEntitiesToService(logs), paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *auditLogRepository) ListBeforeID(ctx context.Context, filter service.AuditLogFilter, beforeID int64, limit int) ([]service.AuditLog, error) {
+	q := applyAuditLogFilter(r.client.AuditLog.Query(), filter)
+	if beforeID > 0 {
+		q = q.Where(auditlog.IDLT(beforeID))
+	}
+
+	logs, err := q.
+		Limit(limit).
+		Order(dbent.Desc(auditlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return auditLogEntitiesToService(logs), nil
+}
+
+func applyAuditLogFilter(q *dbent.AuditLogQuery, filter service.AuditLogFilter) *dbent.AuditLogQuery {
+	if filter.ActorUserID != nil {
+		q = q.Where(auditlog.ActorUserIDEQ(*filter.ActorUserID))
+	}
+	if filter.ActorType != "" {
+		q = q.Where(auditlog.ActorTypeEQ(filter.ActorType))
+	}
+	if filter.Action != "" {
+		q = q.Where(auditlog.ActionContainsFold(filter.Action))
+	}
+	if filter.TargetType != "" {
+		q = q.Where(auditlog.TargetTypeEQ(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		q = q.Where(auditlog.TargetIDEQ(filter.TargetID))
+	}
+	if filter.StartTime != nil {
+		q = q.Where(auditlog.CreatedAtGTE(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		q = q.Where(auditlog.CreatedAtLTE(*filter.EndTime))
+	}
+	return q
+}
+
+func auditLogEntityToService(m *dbent.AuditLog) *service.AuditLog {
+	if m == nil {
+		return nil
+	}
+	return &service.AuditLog{
+		ID:          m.ID,
+		ActorUserID: m.ActorUserID,
+		ActorType:   m.ActorType,
+		Action:      m.Action,
+		TargetType:  m.TargetType,
+		TargetID:    m.TargetID,
+		Before:      m.Before,
+		After:       m.After,
+		StatusCode:  m.StatusCode,
+		IPAddress:   m.IPAddress,
+		UserAgent:   m.UserAgent,
+		RequestID:   m.RequestID,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func auditLogEntitiesToService(models []*dbent.AuditLog) []service.AuditLog {
+	out := make([]service.AuditLog, 0, len(models))
+	for i := range models {
+		if s := auditLogEntityToService(models[i]); s != nil {
+			out = append(out, *s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/balancetransaction"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type balanceLedgerRepository struct {
+	client *dbent.Client
+	sql    sqlExecutor
+}
+
+func NewBalanceLedgerRepository(client *dbent.Client, sqlDB *sql.DB) service.BalanceLedgerRepository {
+	return &balanceLedgerRepository{client: client, sql: sqlDB}
+}
+
+func (r *balanceLedgerRepository) List(ctx context.Context, params pagination.PaginationParams, filter service.BalanceTransactionFilter) ([]service.BalanceTransaction, *pagination.PaginationResult, error) {
+	q := applyBalanceTransactionFilter(r.client.BalanceTransaction.Query(), filter)
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(balancetransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balanceTransactionEntitiesToService(txs), paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *balanceLedgerRepository) ListBeforeID(ctx context.Context, filter service.BalanceTransactionFilter, beforeID int64, limit int) ([]service.BalanceTransaction, error) {
+	q := applyBalanceTransactionFilter(r.client.BalanceTransaction.Query(), filter)
+	if beforeID > 0 {
+		q = q.Where(balancetransaction.IDLT(beforeID))
+	}
+
+	txs, err := q.
+		Limit(limit).
+		Order(dbent.Desc(balancetransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return balanceTransactionEntitiesToService(txs), nil
+}
+
+func (r *balanceLedgerRepository) FindDrifts(ctx context.Context, tolerance float64, limit int) ([]service.BalanceLedgerDrift, int, error) {
+	query := `
+		WITH ledger AS (
+			SELECT user_id, SUM(amount) AS total
+			FROM balance_transactions
+			GROUP BY user_id
+		), drifts AS (
+			SELECT u.id, u.email, u.balance, COALESCE(l.total, 0) AS ledger_sum
+			FROM users u
+			LEFT JOIN ledger l ON l.user_id = u.id
+			WHERE u.deleted_at IS NULL
+			  AND ABS(u.balance - COALESCE(l.total, 0)) > $1
+		)
+		SELECT id, email, balance, ledger_sum, COUNT(*) OVER () AS total
+		FROM drifts
+		ORDER BY ABS(balance - ledger_sum) DESC, id
+		LIMIT $2`
+
+	rows, err := r.sql.QueryContext(ctx, query, tolerance, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var (
+		out   []service.BalanceLedgerDrift
+		total int
+	)
+	for rows.Next() {
+		var d service.BalanceLedgerDrift
+		if err := rows.Scan(&d.UserID, &d.Email, &d.Balance, &d.LedgerSum, &total); err != nil {
+			return nil, 0, err
+		}
+		d.Drift = d.Balance - d.LedgerSum
+		out = append(out, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+func (r *balanceLedgerRepository) SumAdjustmentsByActor(ctx context.Context, actorUserID int64, since time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(SUM(ABS(amount)), 0)
+		FROM balance_transactions
+		WHERE actor_user_id = $1
+		  AND source_type = $2
+		  AND created_at >= $3`
+
+	var total float64
+	if err := scanSingleRow(ctx, clientFromContext(ctx, r.client), query, []any{actorUserID, service.BalanceSourceAdminAdjustment, since}, &total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// WithAdjustmentQuotaLock 开启事务并以 SELECT ... FOR UPDATE 锁定操作人的用户行，再在同一事务内执行 fn。
+// 同一操作人的额度统计与调整写入因此跨实例串行，并发请求无法同时通过额度校验。
+func (r *balanceLedgerRepository) WithAdjustmentQuotaLock(ctx context.Context, actorUserID int64, fn func(ctx context.Context) error) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	var id int64
+	if err := scanSingleRow(txCtx, tx.Client(), `SELECT id FROM users WHERE id = $1 FOR UPDATE`, []any{actorUserID}, &id); err != nil {
+		return translatePersistenceError(err, service.ErrUserNotFound, nil)
+	}
+	if err := fn(txCtx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func applyBalanceTransactionFilter(q *dbent.BalanceTransactionQuery, filter service.BalanceTransactionFilter) *dbent.BalanceTransactionQuery {
+	if filter.UserID > 0 {
+		q = q.Where(balancetransaction.UserIDEQ(filter.UserID))
+	}
+	if filter.SourceType != "" {
+		q = q.Where(balancetransaction.SourceTypeEQ(filter.SourceType))
+	}
+	if filter.StartTime != nil {
+		q = q.Where(balancetransaction.CreatedAtGTE(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		q = q.Where(balancetransaction.CreatedAtLTE(*filter.EndTime))
+	}
+	return q
+}
+
+func balanceTransactionEntityToService(m *dbent.BalanceTransaction) *service.BalanceTransaction {
+	if m == nil {
+		return nil
+	}
+	return &service.BalanceTransaction{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		Amount:       m.Amount,
+		BalanceAfter: m.BalanceAfter,
+		SourceType:   m.SourceType,
+		ReferenceID:  m.ReferenceID,
+		ActorUserID:  m.ActorUserID,
+		Notes:        m.Notes,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func balanceTransactionEntitiesToService(models []*dbent.BalanceTransaction) []service.BalanceTransaction {
+	out := make([]service.BalanceTransaction, 0, len(models))
+	for i := range models {
+		if s := balanceTransactionEntityToService(models[i]); s != nil {
+			out = append(out, *s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceLedgerRepository_AdjustmentQuotaLockSerializesActor(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	userRepo := newUserRepositoryWithSQL(client, integrationDB)
+	repo := NewBalanceLedgerRepository(client, integrationDB)
+
+	newUser := func(prefix, role string) *service.User {
+		u := &service.User{
+			Email:        prefix + "-" + time.Now().Format(time.RFC3339Nano) + "@example.com",
+			PasswordHash: "test-password-hash",
+			Role:         role,
+			Status:       service.StatusActive,
+			Concurrency:  5,
+		}
+		require.NoError(t, userRepo.Create(ctx, u))
+		return u
+	}
+	actor := newUser("quota-actor", service.RoleSupport)
+	target := newUser("quota-target", service.RoleUser)
+	since := time.Now().Add(-time.Hour)
+
+	adjust := func(ctx context.Context, amount float64) error {
+		return userRepo.UpdateBalance(ctx, target.ID, amount, service.BalanceChangeSource{
+			Type:        service.BalanceSourceAdminAdjustment,
+			ActorUserID: &actor.ID,
+		})
+	}
+
+	// fn 出错时整笔回滚
+	errAbort := errors.New("abort")
+	err := repo.WithAdjustmentQuotaLock(ctx, actor.ID, func(txCtx context.Context) error {
+		require.NoError(t, adjust(txCtx, 10))
+		used, err := repo.SumAdjustmentsByActor(txCtx, actor.ID, since)
+		require.NoError(t, err)
+		require.InDelta(t, 10, used, 1e-6, "the sum must see writes of the same transaction")
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+	used, err := repo.SumAdjustmentsByActor(ctx, actor.ID, since)
+	require.NoError(t, err)
+	require.Zero(t, used)
+
+	// 并发调整同一操作人：额度 30、每次 20，只有一笔能通过校验
+	const limit, amount = 30.0, 20.0
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		applied int
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := repo.WithAdjustmentQuotaLock(ctx, actor.ID, func(txCtx context.Context) error {
+				used, err := repo.SumAdjustmentsByActor(txCtx, actor.ID, since)
+				if err != nil {
+					return err
+				}
+				if used+amount > limit {
+					return nil
+				}
+				if err := adjust(txCtx, amount); err != nil {
+					return err
+				}
+				mu.Lock()
+				applied++
+				mu.Unlock()
+				return nil
+			})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, applied)
+
+	used, err = repo.SumAdjustmentsByActor(ctx, actor.ID, since)
+	require.NoError(t, err)
+	require.InDelta(t, amount, used, 1e-6)
+
+	require.ErrorIs(t, repo.WithAdjustmentQuotaLock(ctx, actor.ID+1000000, func(context.Context) error { return nil }), service.ErrUserNotFound)
+}
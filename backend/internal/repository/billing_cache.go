@@ -15,6 +15,9 @@ import (
 const (
 	billingBalanceKeyPrefix = "billing:balance:"
 	billingSubKeyPrefix     = "billing:sub:"
+	billingAPIKeyKeyPrefix  = "billing:apikey:"
+	billingOrgKeyPrefix     = "billing:org_balance:"
+	billingOrgMemberPrefix  = "billing:org_member:"
 	billingCacheTTL         = 5 * time.Minute
 )
 
@@ -28,6 +31,21 @@ func billingSubKey(userID, groupID int64) string {
 	return fmt.Sprintf("%s%d:%d", billingSubKeyPrefix, userID, groupID)
 }
 
+// billingAPIKeyKey generates the Redis key for API key spending cache.
+func billingAPIKeyKey(apiKeyID int64) string {
+	return fmt.Sprintf("%s%d", billingAPIKeyKeyPrefix, apiKeyID)
+}
+
+// billingOrgKey generates the Redis key for organization wallet balance cache.
+func billingOrgKey(orgID int64) string {
+	return fmt.Sprintf("%s%d", billingOrgKeyPrefix, orgID)
+}
+
+// billingOrgMemberKey generates the Redis key for organization member spending cache.
+func billingOrgMemberKey(userID int64) string {
+	return fmt.Sprintf("%s%d", billingOrgMemberPrefix, userID)
+}
+
 const (
 	subFieldStatus       = "status"
 	subFieldExpiresAt    = "expires_at"
@@ -37,6 +55,14 @@ const (
 	subFieldVersion      = "version"
 )
 
+const (
+	keyFieldTotalUsage         = "total_usage"
+	keyFieldDailyUsage         = "daily_usage"
+	keyFieldDailyWindowStart   = "daily_window_start"
+	keyFieldMonthlyUsage       = "monthly_usage"
+	keyFieldMonthlyWindowStart = "monthly_window_start"
+)
+
 var (
 	deductBalanceScript = redis.NewScript(`
 		local current = redis.call('GET', KEYS[1])
@@ -61,6 +87,47 @@ var (
 		redis.call('EXPIRE', KEYS[1], ARGV[2])
 		return 1
 	`)
+
+	// updateAPIKeyUsageScript 累加 API Key 用量；日/月窗口起点落后于当前窗口时先归零
+	updateAPIKeyUsageScript = redis.NewScript(`
+		local exists = redis.call('EXISTS', KEYS[1])
+		if exists == 0 then
+			return 0
+		end
+		local cost = tonumber(ARGV[1])
+		local dayStart = tonumber(ARGV[2])
+		local monthStart = tonumber(ARGV[3])
+		local storedDay = tonumber(redis.call('HGET', KEYS[1], 'daily_window_start') or '0')
+		if storedDay == nil or storedDay < dayStart then
+			redis.call('HSET', KEYS[1], 'daily_usage', 0, 'daily_window_start', dayStart)
+		end
+		local storedMonth = tonumber(redis.call('HGET', KEYS[1], 'monthly_window_start') or '0')
+		if storedMonth == nil or storedMonth < monthStart then
+			redis.call('HSET', KEYS[1], 'monthly_usage', 0, 'monthly_window_start', monthStart)
+		end
+		redis.call('HINCRBYFLOAT', KEYS[1], 'total_usage', cost)
+		redis.call('HINCRBYFLOAT', KEYS[1], 'daily_usage', cost)
+		redis.call('HINCRBYFLOAT', KEYS[1], 'monthly_usage', cost)
+		redis.call('EXPIRE', KEYS[1], ARGV[4])
+		return 1
+	`)
+
+	// updateOrgMemberUsageScript 累加组织成员月度花费；月窗口起点落后于当前窗口时先归零
+	updateOrgMemberUsageScript = redis.NewScript(`
+		local exists = redis.call('EXISTS', KEYS[1])
+		if exists == 0 then
+			return 0
+		end
+		local cost = tonumber(ARGV[1])
+		local monthStart = tonumber(ARGV[2])
+		local storedMonth = tonumber(redis.call('HGET', KEYS[1], 'monthly_window_start') or '0')
+		if storedMonth == nil or storedMonth < monthStart then
+			redis.call('HSET', KEYS[1], 'monthly_usage', 0, 'monthly_window_start', monthStart)
+		end
+		redis.call('HINCRBYFLOAT', KEYS[1], 'monthly_usage', cost)
+		redis.call('EXPIRE', KEYS[1], ARGV[3])
+		return 1
+	`)
 )
 
 type billingCache struct {
@@ -181,3 +248,158 @@ func (c *billingCache) InvalidateSubscriptionCache(ctx context.Context, userID,
 	key := billingSubKey(userID, groupID)
 	return c.rdb.Del(ctx, key).Err()
 }
+
+func (c *billingCache) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*service.APIKeyUsageCacheData, error) {
+	key := billingAPIKeyKey(apiKeyID)
+	result, err := c.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, redis.Nil
+	}
+	return parseAPIKeyUsageCache(result)
+}
+
+func parseAPIKeyUsageCache(data map[string]string) (*service.APIKeyUsageCacheData, error) {
+	totalStr, ok := data[keyFieldTotalUsage]
+	if !ok {
+		return nil, errors.New("invalid cache: missing total usage")
+	}
+	result := &service.APIKeyUsageCacheData{}
+	result.TotalUsage, _ = strconv.ParseFloat(totalStr, 64)
+	result.DailyUsage, _ = strconv.ParseFloat(data[keyFieldDailyUsage], 64)
+	result.MonthlyUsage, _ = strconv.ParseFloat(data[keyFieldMonthlyUsage], 64)
+	if v, err := strconv.ParseInt(data[keyFieldDailyWindowStart], 10, 64); err == nil && v > 0 {
+		result.DailyWindowStart = time.Unix(v, 0)
+	}
+	if v, err := strconv.ParseInt(data[keyFieldMonthlyWindowStart], 10, 64); err == nil && v > 0 {
+		result.MonthlyWindowStart = time.Unix(v, 0)
+	}
+	return result, nil
+}
+
+func (c *billingCache) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *service.APIKeyUsageCacheData) error {
+	if data == nil {
+		return nil
+	}
+
+	key := billingAPIKeyKey(apiKeyID)
+
+	fields := map[string]any{
+		keyFieldTotalUsage:         data.TotalUsage,
+		keyFieldDailyUsage:         data.DailyUsage,
+		keyFieldDailyWindowStart:   unixOrZero(data.DailyWindowStart),
+		keyFieldMonthlyUsage:       data.MonthlyUsage,
+		keyFieldMonthlyWindowStart: unixOrZero(data.MonthlyWindowStart),
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, billingCacheTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *billingCache) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64, dayStart, monthStart time.Time) error {
+	key := billingAPIKeyKey(apiKeyID)
+	_, err := updateAPIKeyUsageScript.Run(ctx, c.rdb, []string{key}, cost, dayStart.Unix(), monthStart.Unix(), int(billingCacheTTL.Seconds())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("Warning: update api key usage cache failed for key %d: %v", apiKeyID, err)
+	}
+	return nil
+}
+
+func (c *billingCache) InvalidateAPIKeyUsageCache(ctx context.Context, apiKeyID int64) error {
+	key := billingAPIKeyKey(apiKeyID)
+	return c.rdb.Del(ctx, key).Err()
+}
+
+func (c *billingCache) GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error) {
+	key := billingOrgKey(orgID)
+	val, err := c.rdb.Get(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(val, 64)
+}
+
+func (c *billingCache) SetOrganizationBalance(ctx context.Context, orgID int64, balance float64) error {
+	key := billingOrgKey(orgID)
+	return c.rdb.Set(ctx, key, balance, billingCacheTTL).Err()
+}
+
+func (c *billingCache) DeductOrganizationBalance(ctx context.Context, orgID int64, amount float64) error {
+	key := billingOrgKey(orgID)
+	_, err := deductBalanceScript.Run(ctx, c.rdb, []string{key}, amount, int(billingCacheTTL.Seconds())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("Warning: deduct organization balance cache failed for org %d: %v", orgID, err)
+	}
+	return nil
+}
+
+func (c *billingCache) InvalidateOrganizationBalance(ctx context.Context, orgID int64) error {
+	key := billingOrgKey(orgID)
+	return c.rdb.Del(ctx, key).Err()
+}
+
+func (c *billingCache) GetOrgMemberUsageCache(ctx context.Context, userID int64) (*service.OrgMemberUsageCacheData, error) {
+	key := billingOrgMemberKey(userID)
+	result, err := c.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, redis.Nil
+	}
+	usageStr, ok := result[keyFieldMonthlyUsage]
+	if !ok {
+		return nil, errors.New("invalid cache: missing monthly usage")
+	}
+	data := &service.OrgMemberUsageCacheData{}
+	data.MonthlyUsage, _ = strconv.ParseFloat(usageStr, 64)
+	if v, err := strconv.ParseInt(result[keyFieldMonthlyWindowStart], 10, 64); err == nil && v > 0 {
+		data.MonthlyWindowStart = time.Unix(v, 0)
+	}
+	return data, nil
+}
+
+func (c *billingCache) SetOrgMemberUsageCache(ctx context.Context, userID int64, data *service.OrgMemberUsageCacheData) error {
+	if data == nil {
+		return nil
+	}
+
+	key := billingOrgMemberKey(userID)
+
+	fields := map[string]any{
+		keyFieldMonthlyUsage:       data.MonthlyUsage,
+		keyFieldMonthlyWindowStart: unixOrZero(data.MonthlyWindowStart),
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, billingCacheTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *billingCache) UpdateOrgMemberUsage(ctx context.Context, userID int64, cost float64, monthStart time.Time) error {
+	key := billingOrgMemberKey(userID)
+	_, err := updateOrgMemberUsageScript.Run(ctx, c.rdb, []string{key}, cost, monthStart.Unix(), int(billingCacheTTL.Seconds())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("Warning: update organization member usage cache failed for user %d: %v", userID, err)
+	}
+	return nil
+}
+
+func (c *billingCache) InvalidateOrgMemberUsageCache(ctx context.Context, userID int64) error {
+	key := billingOrgMemberKey(userID)
+	return c.rdb.Del(ctx, key).Err()
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
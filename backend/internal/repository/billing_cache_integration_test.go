@@ -278,6 +278,81 @@ func (s *BillingCacheSuite) TestSubscriptionCache() {
 	}
 }
 
+func (s *BillingCacheSuite) TestAPIKeyUsageCache() {
+	tests := []struct {
+		name string
+		fn   func(ctx context.Context, rdb *redis.Client, cache service.BillingCache)
+	}{
+		{
+			name: "missing_key_returns_redis_nil",
+			fn: func(ctx context.Context, rdb *redis.Client, cache service.BillingCache) {
+				_, err := cache.GetAPIKeyUsageCache(ctx, 30)
+				require.ErrorIs(s.T(), err, redis.Nil, "expected redis.Nil for missing api key usage")
+			},
+		},
+		{
+			name: "update_usage_on_nonexistent_is_noop",
+			fn: func(ctx context.Context, rdb *redis.Client, cache service.BillingCache) {
+				now := time.Now()
+				require.NoError(s.T(), cache.UpdateAPIKeyUsage(ctx, 31, 1.0, now, now), "UpdateAPIKeyUsage")
+
+				exists, err := rdb.Exists(ctx, billingAPIKeyKey(31)).Result()
+				require.NoError(s.T(), err, "Exists")
+				require.Equal(s.T(), int64(0), exists, "expected missing api key usage after update on non-existent")
+			},
+		},
+		{
+			name: "update_usage_increments_and_rolls_windows",
+			fn: func(ctx context.Context, rdb *redis.Client, cache service.BillingCache) {
+				apiKeyID := int64(32)
+				monthStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+				dayStart := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+				data := &service.APIKeyUsageCacheData{
+					TotalUsage:         5.0,
+					DailyUsage:         1.0,
+					DailyWindowStart:   dayStart,
+					MonthlyUsage:       3.0,
+					MonthlyWindowStart: monthStart,
+				}
+				require.NoError(s.T(), cache.SetAPIKeyUsageCache(ctx, apiKeyID, data), "SetAPIKeyUsageCache")
+
+				// 同一窗口内累加
+				require.NoError(s.T(), cache.UpdateAPIKeyUsage(ctx, apiKeyID, 0.5, dayStart, monthStart), "UpdateAPIKeyUsage")
+				got, err := cache.GetAPIKeyUsageCache(ctx, apiKeyID)
+				require.NoError(s.T(), err, "GetAPIKeyUsageCache")
+				require.Equal(s.T(), 5.5, got.TotalUsage)
+				require.Equal(s.T(), 1.5, got.DailyUsage)
+				require.Equal(s.T(), 3.5, got.MonthlyUsage)
+
+				// 跨天：日用量归零后累加，月用量继续累加
+				nextDay := dayStart.Add(24 * time.Hour)
+				require.NoError(s.T(), cache.UpdateAPIKeyUsage(ctx, apiKeyID, 0.25, nextDay, monthStart), "UpdateAPIKeyUsage next day")
+				got, err = cache.GetAPIKeyUsageCache(ctx, apiKeyID)
+				require.NoError(s.T(), err, "GetAPIKeyUsageCache")
+				require.Equal(s.T(), 5.75, got.TotalUsage)
+				require.Equal(s.T(), 0.25, got.DailyUsage)
+				require.Equal(s.T(), 3.75, got.MonthlyUsage)
+				require.Equal(s.T(), nextDay.Unix(), got.DailyWindowStart.Unix())
+
+				ttl, err := rdb.TTL(ctx, billingAPIKeyKey(apiKeyID)).Result()
+				require.NoError(s.T(), err, "TTL")
+				s.AssertTTLWithin(ttl, 1*time.Second, billingCacheTTL)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rdb := testRedis(s.T())
+			cache := NewBillingCache(rdb)
+			ctx := context.Background()
+
+			tt.fn(ctx, rdb, cache)
+		})
+	}
+}
+
 func TestBillingCacheSuite(t *testing.T) {
 	suite.Run(t, new(BillingCacheSuite))
 }
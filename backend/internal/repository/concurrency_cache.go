@@ -27,6 +27,8 @@ const (
 	accountSlotKeyPrefix = "concurrency:account:"
 	// 格式: concurrency:user:{userID}
 	userSlotKeyPrefix = "concurrency:user:"
+	// 格式: concurrency:org:{organizationID}
+	orgSlotKeyPrefix = "concurrency:org:"
 	// 等待队列计数器格式: concurrency:wait:{userID}
 	waitQueueKeyPrefix = "concurrency:wait:"
 	// 账号级等待队列计数器格式: wait:account:{accountID}
@@ -239,6 +241,10 @@ func userSlotKey(userID int64) string {
 	return fmt.Sprintf("%s%d", userSlotKeyPrefix, userID)
 }
 
+func orgSlotKey(organizationID int64) string {
+	return fmt.Sprintf("%s%d", orgSlotKeyPrefix, organizationID)
+}
+
 func waitQueueKey(userID int64) string {
 	return fmt.Sprintf("%s%d", waitQueueKeyPrefix, userID)
 }
@@ -301,6 +307,20 @@ func (c *concurrencyCache) GetUserConcurrency(ctx context.Context, userID int64)
 	return result, nil
 }
 
+func (c *concurrencyCache) AcquireOrganizationSlot(ctx context.Context, organizationID int64, maxConcurrency int, requestID string) (bool, error) {
+	key := orgSlotKey(organizationID)
+	result, err := acquireScript.Run(ctx, c.rdb, []string{key}, maxConcurrency, c.slotTTLSeconds, requestID).Int()
+	if err != nil {
+		return false, err
+	}
+	return result == 1, nil
+}
+
+func (c *concurrencyCache) ReleaseOrganizationSlot(ctx context.Context, organizationID int64, requestID string) error {
+	key := orgSlotKey(organizationID)
+	return c.rdb.ZRem(ctx, key, requestID).Err()
+}
+
 // Wait queue operations
 
 func (c *concurrencyCache) IncrementWaitCount(ctx context.Context, userID int64, maxWait int) (bool, error) {
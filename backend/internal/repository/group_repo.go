@@ -50,7 +50,13 @@ func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) er
 		SetDefaultValidityDays(groupIn.DefaultValidityDays).
 		SetClaudeCodeOnly(groupIn.ClaudeCodeOnly).
 		SetNillableFallbackGroupID(groupIn.FallbackGroupID).
-		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled)
+		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
+		SetRpmLimit(groupIn.RateLimits.RPM).
+		SetInputTpmLimit(groupIn.RateLimits.InputTPM).
+		SetOutputTpmLimit(groupIn.RateLimits.OutputTPM).
+		SetResponseCacheEnabled(groupIn.ResponseCache.Enabled).
+		SetResponseCacheTTLSeconds(groupIn.ResponseCache.TTLSeconds).
+		SetResponseCacheCostRatio(groupIn.ResponseCacheCostRatio)
 
 	// 设置模型路由配置
 	if groupIn.ModelRouting != nil {
@@ -108,7 +114,13 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		SetNillableImagePrice4k(groupIn.ImagePrice4K).
 		SetDefaultValidityDays(groupIn.DefaultValidityDays).
 		SetClaudeCodeOnly(groupIn.ClaudeCodeOnly).
-		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled)
+		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
+		SetRpmLimit(groupIn.RateLimits.RPM).
+		SetInputTpmLimit(groupIn.RateLimits.InputTPM).
+		SetOutputTpmLimit(groupIn.RateLimits.OutputTPM).
+		SetResponseCacheEnabled(groupIn.ResponseCache.Enabled).
+		SetResponseCacheTTLSeconds(groupIn.ResponseCache.TTLSeconds).
+		SetResponseCacheCostRatio(groupIn.ResponseCacheCostRatio)
 
 	// 处理 FallbackGroupID：nil 时清除，否则设置
 	if groupIn.FallbackGroupID != nil {
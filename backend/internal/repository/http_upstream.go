@@ -16,8 +16,11 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/proxyutil"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/tlsfingerprint"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // 默认配置常量
@@ -123,6 +126,12 @@ func NewHTTPUpstream(cfg *config.Config) service.HTTPUpstream {
 //   - 调用方必须关闭 resp.Body，否则会导致 inFlight 计数泄漏
 //   - inFlight > 0 的客户端不会被淘汰，确保活跃请求不被中断
 func (s *httpUpstreamService) Do(req *http.Request, proxyURL string, accountID int64, accountConcurrency int) (*http.Response, error) {
+	return traceUpstreamRequest(req, accountID, false, func() (*http.Response, error) {
+		return s.do(req, proxyURL, accountID, accountConcurrency)
+	})
+}
+
+func (s *httpUpstreamService) do(req *http.Request, proxyURL string, accountID int64, accountConcurrency int) (*http.Response, error) {
 	if err := s.validateRequestHost(req); err != nil {
 		return nil, err
 	}
@@ -171,7 +180,12 @@ func (s *httpUpstreamService) DoWithTLS(req *http.Request, proxyURL string, acco
 	if !enableTLSFingerprint {
 		return s.Do(req, proxyURL, accountID, accountConcurrency)
 	}
+	return traceUpstreamRequest(req, accountID, true, func() (*http.Response, error) {
+		return s.doWithTLS(req, proxyURL, accountID, accountConcurrency)
+	})
+}
 
+func (s *httpUpstreamService) doWithTLS(req *http.Request, proxyURL string, accountID int64, accountConcurrency int) (*http.Response, error) {
 	// TLS 指纹已启用，记录调试日志
 	targetHost := ""
 	if req != nil && req.URL != nil {
@@ -193,7 +207,7 @@ func (s *httpUpstreamService) DoWithTLS(req *http.Request, proxyURL string, acco
 	if profile == nil {
 		// 如果获取不到 profile，回退到普通请求
 		slog.Debug("tls_fingerprint_no_profile", "account_id", accountID, "fallback", "standard_request")
-		return s.Do(req, proxyURL, accountID, accountConcurrency)
+		return s.do(req, proxyURL, accountID, accountConcurrency)
 	}
 
 	slog.Debug("tls_fingerprint_using_profile", "account_id", accountID, "profile", profile.Name, "grease", profile.EnableGREASE)
@@ -862,6 +876,37 @@ func (b *trackedBody) Close() error {
 	return err
 }
 
+// traceUpstreamRequest 为单次上游请求创建 span，响应体关闭时结束（覆盖流式读取耗时）。
+// 仅记录 host 与 path（不含 query，避免泄露 key 等参数），且不向上游注入 trace 头。
+func traceUpstreamRequest(req *http.Request, accountID int64, tlsFingerprint bool, fn func() (*http.Response, error)) (*http.Response, error) {
+	if req == nil || req.URL == nil {
+		return fn()
+	}
+	_, span := tracing.Start(req.Context(), "upstream.request",
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Host),
+		attribute.String("url.path", req.URL.Path),
+		attribute.Int64("account.id", accountID),
+		attribute.Bool("upstream.tls_fingerprint", tlsFingerprint),
+	)
+
+	resp, err := fn()
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	if resp.Body == nil {
+		span.End()
+		return resp, nil
+	}
+	resp.Body = wrapTrackedBody(resp.Body, func() { span.End() })
+	return resp, nil
+}
+
 // wrapTrackedBody 包装响应体以跟踪关闭事件
 // 用于在响应体关闭时更新 inFlight 计数
 //
@@ -0,0 +1,486 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+const invoiceColumns = `id, invoice_number, sequence, subject_type, subject_id, recipient_user_id,
+	period_start, period_end, currency, line_items, top_ups, subtotal, tax_rate, tax_amount, total, top_up_total,
+	issuer, bill_to, status, emailed_at, email_error, created_by, created_at, updated_at`
+
+// invoiceCreateMaxAttempts 并发开票时序号冲突的最大重试次数
+const invoiceCreateMaxAttempts = 5
+
+// invoiceTopUpSources 计入发票充值记录的余额流水来源
+var invoiceTopUpSources = []string{
+	service.BalanceSourcePaymentOrder,
+	service.BalanceSourceCreemCheckout,
+	service.BalanceSourceRedeemCode,
+}
+
+type invoiceRepository struct {
+	sql sqlExecutor
+}
+
+func NewInvoiceRepository(sqlDB *sql.DB) service.InvoiceRepository {
+	return &invoiceRepository{sql: sqlDB}
+}
+
+func (r *invoiceRepository) Create(ctx context.Context, inv *service.Invoice, minSequence int64, formatNumber func(seq int64) string) (bool, error) {
+	if inv == nil {
+		return false, nil
+	}
+	payload, err := marshalInvoicePayload(inv)
+	if err != nil {
+		return false, err
+	}
+	query := `
+		INSERT INTO invoices (
+			invoice_number, sequence, subject_type, subject_id, recipient_user_id,
+			period_start, period_end, currency, line_items, top_ups,
+			subtotal, tax_rate, tax_amount, total, top_up_total,
+			issuer, bill_to, status, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, NOW(), NOW())
+		ON CONFLICT (subject_type, subject_id, period_start) WHERE status <> 'void' DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+	for attempt := 1; ; attempt++ {
+		var seq int64
+		if err := scanSingleRow(ctx, r.sql, "SELECT COALESCE(MAX(sequence), 0) + 1 FROM invoices", nil, &seq); err != nil {
+			return false, err
+		}
+		if seq < minSequence {
+			seq = minSequence
+		}
+		number := formatNumber(seq)
+		err := scanSingleRow(ctx, r.sql, query, []any{
+			number, seq, inv.SubjectType, inv.SubjectID, inv.RecipientUserID,
+			inv.PeriodStart, inv.PeriodEnd, inv.Currency, payload.items, payload.topUps,
+			inv.Subtotal, inv.TaxRate, inv.TaxAmount, inv.Total, inv.TopUpTotal,
+			payload.issuer, payload.billTo, inv.Status, inv.CreatedBy,
+		}, &inv.ID, &inv.CreatedAt, &inv.UpdatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		// 序号或编号被并发开票占用：重新读取最大序号后重试
+		if err != nil && isUniqueConstraintViolation(err) && attempt < invoiceCreateMaxAttempts {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		inv.Sequence = seq
+		inv.Number = number
+		return true, nil
+	}
+}
+
+func (r *invoiceRepository) UpdateContent(ctx context.Context, inv *service.Invoice) error {
+	payload, err := marshalInvoicePayload(inv)
+	if err != nil {
+		return err
+	}
+	query := `
+		UPDATE invoices
+		SET recipient_user_id = $2, currency = $3, line_items = $4, top_ups = $5,
+			subtotal = $6, tax_rate = $7, tax_amount = $8, total = $9, top_up_total = $10,
+			issuer = $11, bill_to = $12, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err = scanSingleRow(ctx, r.sql, query, []any{
+		inv.ID, inv.RecipientUserID, inv.Currency, payload.items, payload.topUps,
+		inv.Subtotal, inv.TaxRate, inv.TaxAmount, inv.Total, inv.TopUpTotal,
+		payload.issuer, payload.billTo,
+	}, &inv.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return service.ErrInvoiceNotFound
+	}
+	return err
+}
+
+func (r *invoiceRepository) GetByID(ctx context.Context, id int64) (*service.Invoice, error) {
+	rows, err := r.sql.QueryContext(ctx, `SELECT `+invoiceColumns+` FROM invoices WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrInvoiceNotFound
+	}
+	inv, err := scanInvoice(rows)
+	if err != nil {
+		return nil, err
+	}
+	return inv, rows.Err()
+}
+
+func (r *invoiceRepository) List(ctx context.Context, params pagination.PaginationParams, filter service.InvoiceFilter) ([]service.Invoice, *pagination.PaginationResult, error) {
+	conditions := make([]string, 0, 5)
+	args := make([]any, 0, 7)
+	addCondition := func(expr string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(expr, len(args)))
+	}
+	if filter.SubjectType != "" {
+		addCondition("subject_type = $%d", filter.SubjectType)
+	}
+	if filter.SubjectID > 0 {
+		addCondition("subject_id = $%d", filter.SubjectID)
+	}
+	if filter.RecipientUserID > 0 {
+		addCondition("recipient_user_id = $%d", filter.RecipientUserID)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+	if filter.PeriodStart != nil {
+		addCondition("period_start = $%d", *filter.PeriodStart)
+	}
+	where := buildWhere(conditions)
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM invoices "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.Invoice{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := `SELECT ` + invoiceColumns + ` FROM invoices ` + where +
+		` ORDER BY period_start DESC, id DESC LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	invoices := make([]service.Invoice, 0)
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		invoices = append(invoices, *inv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return invoices, paginationResultFromTotal(total, params), nil
+}
+
+func (r *invoiceRepository) UpdateStatus(ctx context.Context, id int64, status string) error {
+	res, err := r.sql.ExecContext(ctx, `UPDATE invoices SET status = $2, updated_at = NOW() WHERE id = $1`, id, status)
+	if err != nil {
+		return err
+	}
+	return invoiceAffected(res)
+}
+
+func (r *invoiceRepository) UpdateEmailResult(ctx context.Context, id int64, emailedAt *time.Time, errMsg *string) error {
+	// 发送失败时保留上一次成功发送的时间
+	query := `
+		UPDATE invoices
+		SET emailed_at = COALESCE($2, emailed_at), email_error = $3, updated_at = NOW()
+		WHERE id = $1
+	`
+	res, err := r.sql.ExecContext(ctx, query, id, emailedAt, errMsg)
+	if err != nil {
+		return err
+	}
+	return invoiceAffected(res)
+}
+
+func (r *invoiceRepository) AggregateUsage(ctx context.Context, subject service.InvoiceSubject, start, end time.Time) ([]service.InvoiceLineItem, error) {
+	// 个人发票只统计个人钱包/订阅扣费的用量，组织钱包扣费的用量归入组织发票
+	subjectCond := "ul.user_id = $1 AND ul.organization_id IS NULL"
+	if subject.Type == service.InvoiceSubjectOrganization {
+		subjectCond = "ul.organization_id = $1"
+	}
+	// 响应缓存命中按其实际扣费路径归类：关联订阅的命中由订阅额度抵扣
+	billingTypeExpr := fmt.Sprintf(
+		"CASE WHEN ul.billing_type = %d AND ul.subscription_id IS NOT NULL THEN %d ELSE ul.billing_type END",
+		service.BillingTypeResponseCache, service.BillingTypeSubscription,
+	)
+	query := `
+		SELECT
+			ul.model,
+			ul.group_id,
+			COALESCE(g.name, ''),
+			` + billingTypeExpr + ` AS effective_billing_type,
+			COUNT(*),
+			COALESCE(SUM(ul.input_tokens), 0),
+			COALESCE(SUM(ul.output_tokens), 0),
+			COALESCE(SUM(ul.cache_creation_tokens), 0),
+			COALESCE(SUM(ul.cache_read_tokens), 0),
+			COALESCE(SUM(ul.actual_cost), 0)
+		FROM usage_logs ul
+		LEFT JOIN groups g ON g.id = ul.group_id
+		WHERE ` + subjectCond + ` AND ul.created_at >= $2 AND ul.created_at < $3
+		GROUP BY ul.model, ul.group_id, g.name, effective_billing_type
+		ORDER BY effective_billing_type, COALESCE(SUM(ul.actual_cost), 0) DESC, ul.model
+	`
+	rows, err := r.sql.QueryContext(ctx, query, subject.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := make([]service.InvoiceLineItem, 0)
+	for rows.Next() {
+		var (
+			item        service.InvoiceLineItem
+			groupID     sql.NullInt64
+			billingType int8
+		)
+		if err := rows.Scan(
+			&item.Model,
+			&groupID,
+			&item.GroupName,
+			&billingType,
+			&item.Requests,
+			&item.InputTokens,
+			&item.OutputTokens,
+			&item.CacheCreationTokens,
+			&item.CacheReadTokens,
+			&item.Cost,
+		); err != nil {
+			return nil, err
+		}
+		if groupID.Valid {
+			v := groupID.Int64
+			item.GroupID = &v
+		}
+		item.SubscriptionCovered = billingType == service.BillingTypeSubscription
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *invoiceRepository) ListSubscriptionFees(ctx context.Context, userID int64, start, end time.Time) ([]service.InvoiceLineItem, error) {
+	query := `
+		SELECT rc.group_id, COALESCE(g.name, ''), rc.validity_days, rc.value
+		FROM redeem_codes rc
+		LEFT JOIN groups g ON g.id = rc.group_id
+		WHERE rc.type = $1 AND rc.used_by = $2 AND rc.used_at >= $3 AND rc.used_at < $4
+		ORDER BY rc.used_at, rc.id
+	`
+	rows, err := r.sql.QueryContext(ctx, query, service.RedeemTypeSubscription, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := make([]service.InvoiceLineItem, 0)
+	for rows.Next() {
+		var (
+			item    service.InvoiceLineItem
+			groupID sql.NullInt64
+		)
+		if err := rows.Scan(&groupID, &item.GroupName, &item.ValidityDays, &item.Cost); err != nil {
+			return nil, err
+		}
+		if groupID.Valid {
+			v := groupID.Int64
+			item.GroupID = &v
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *invoiceRepository) ListTopUps(ctx context.Context, userID int64, start, end time.Time) ([]service.InvoiceTopUp, error) {
+	query := `
+		SELECT source_type, reference_id, amount, created_at
+		FROM balance_transactions
+		WHERE user_id = $1 AND source_type = ANY($2) AND amount > 0
+			AND created_at >= $3 AND created_at < $4
+		ORDER BY created_at, id
+	`
+	rows, err := r.sql.QueryContext(ctx, query, userID, pq.Array(invoiceTopUpSources), start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	topUps := make([]service.InvoiceTopUp, 0)
+	for rows.Next() {
+		var t service.InvoiceTopUp
+		if err := rows.Scan(&t.Source, &t.Reference, &t.Amount, &t.PaidAt); err != nil {
+			return nil, err
+		}
+		topUps = append(topUps, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return topUps, nil
+}
+
+func (r *invoiceRepository) ListBillableSubjects(ctx context.Context, start, end time.Time) ([]service.InvoiceSubject, error) {
+	query := `
+		SELECT 'user', user_id FROM usage_logs
+			WHERE created_at >= $1 AND created_at < $2 AND organization_id IS NULL
+		UNION
+		SELECT 'user', user_id FROM balance_transactions
+			WHERE created_at >= $1 AND created_at < $2 AND source_type = ANY($3) AND amount > 0
+		UNION
+		SELECT 'user', used_by FROM redeem_codes
+			WHERE used_at >= $1 AND used_at < $2 AND type = $4 AND used_by IS NOT NULL
+		UNION
+		SELECT 'organization', organization_id FROM usage_logs
+			WHERE created_at >= $1 AND created_at < $2 AND organization_id IS NOT NULL
+		ORDER BY 1, 2
+	`
+	rows, err := r.sql.QueryContext(ctx, query, start, end, pq.Array(invoiceTopUpSources), service.RedeemTypeSubscription)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	subjects := make([]service.InvoiceSubject, 0)
+	for rows.Next() {
+		var s service.InvoiceSubject
+		if err := rows.Scan(&s.Type, &s.ID); err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return subjects, nil
+}
+
+type invoicePayload struct {
+	items  []byte
+	topUps []byte
+	issuer []byte
+	billTo []byte
+}
+
+func marshalInvoicePayload(inv *service.Invoice) (*invoicePayload, error) {
+	items := inv.Items
+	if items == nil {
+		items = []service.InvoiceLineItem{}
+	}
+	topUps := inv.TopUps
+	if topUps == nil {
+		topUps = []service.InvoiceTopUp{}
+	}
+	var (
+		p   invoicePayload
+		err error
+	)
+	if p.items, err = json.Marshal(items); err != nil {
+		return nil, fmt.Errorf("marshal invoice items: %w", err)
+	}
+	if p.topUps, err = json.Marshal(topUps); err != nil {
+		return nil, fmt.Errorf("marshal invoice top-ups: %w", err)
+	}
+	if p.issuer, err = json.Marshal(inv.Issuer); err != nil {
+		return nil, fmt.Errorf("marshal invoice issuer: %w", err)
+	}
+	if p.billTo, err = json.Marshal(inv.BillTo); err != nil {
+		return nil, fmt.Errorf("marshal invoice bill-to: %w", err)
+	}
+	return &p, nil
+}
+
+func scanInvoice(scanner interface{ Scan(...any) error }) (*service.Invoice, error) {
+	var (
+		inv        service.Invoice
+		itemsJSON  []byte
+		topUpsJSON []byte
+		issuerJSON []byte
+		billToJSON []byte
+		emailedAt  sql.NullTime
+		emailError sql.NullString
+		createdBy  sql.NullInt64
+	)
+	if err := scanner.Scan(
+		&inv.ID,
+		&inv.Number,
+		&inv.Sequence,
+		&inv.SubjectType,
+		&inv.SubjectID,
+		&inv.RecipientUserID,
+		&inv.PeriodStart,
+		&inv.PeriodEnd,
+		&inv.Currency,
+		&itemsJSON,
+		&topUpsJSON,
+		&inv.Subtotal,
+		&inv.TaxRate,
+		&inv.TaxAmount,
+		&inv.Total,
+		&inv.TopUpTotal,
+		&issuerJSON,
+		&billToJSON,
+		&inv.Status,
+		&emailedAt,
+		&emailError,
+		&createdBy,
+		&inv.CreatedAt,
+		&inv.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	for _, part := range []struct {
+		name string
+		data []byte
+		dest any
+	}{
+		{"items", itemsJSON, &inv.Items},
+		{"top-ups", topUpsJSON, &inv.TopUps},
+		{"issuer", issuerJSON, &inv.Issuer},
+		{"bill-to", billToJSON, &inv.BillTo},
+	} {
+		if err := json.Unmarshal(part.data, part.dest); err != nil {
+			return nil, fmt.Errorf("parse invoice %s: %w", part.name, err)
+		}
+	}
+	if emailedAt.Valid {
+		t := emailedAt.Time
+		inv.EmailedAt = &t
+	}
+	if emailError.Valid {
+		v := emailError.String
+		inv.EmailError = &v
+	}
+	if createdBy.Valid {
+		v := createdBy.Int64
+		inv.CreatedBy = &v
+	}
+	return &inv, nil
+}
+
+func invoiceAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrInvoiceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func newInvoiceForRepoTest() *service.Invoice {
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	return &service.Invoice{
+		SubjectType:     service.InvoiceSubjectUser,
+		SubjectID:       7,
+		RecipientUserID: 7,
+		PeriodStart:     start,
+		PeriodEnd:       start.AddDate(0, 1, 0),
+		Currency:        "USD",
+		Status:          service.InvoiceStatusIssued,
+	}
+}
+
+func formatInvoiceNumberForTest(seq int64) string {
+	return fmt.Sprintf("INV-%04d", seq)
+}
+
+func TestInvoiceRepositoryCreateSkipsExistingPeriod(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &invoiceRepository{sql: db}
+
+	mock.ExpectQuery("SELECT COALESCE\\(MAX\\(sequence\\), 0\\) \\+ 1 FROM invoices").
+		WillReturnRows(sqlmock.NewRows([]string{"seq"}).AddRow(int64(3)))
+	mock.ExpectQuery("INSERT INTO invoices .* ON CONFLICT \\(subject_type, subject_id, period_start\\) WHERE status <> 'void' DO NOTHING").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}))
+
+	created, err := repo.Create(context.Background(), newInvoiceForRepoTest(), 1, formatInvoiceNumberForTest)
+	require.NoError(t, err)
+	require.False(t, created)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepositoryCreateRetriesSequenceConflict(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &invoiceRepository{sql: db}
+	now := time.Now()
+
+	// 配置的起始编号大于现有最大序号时使用起始编号
+	mock.ExpectQuery("SELECT COALESCE\\(MAX\\(sequence\\), 0\\) \\+ 1 FROM invoices").
+		WillReturnRows(sqlmock.NewRows([]string{"seq"}).AddRow(int64(3)))
+	mock.ExpectQuery("INSERT INTO invoices").
+		WithArgs("INV-0100", int64(100), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnError(&pq.Error{Code: "23505"})
+	mock.ExpectQuery("SELECT COALESCE\\(MAX\\(sequence\\), 0\\) \\+ 1 FROM invoices").
+		WillReturnRows(sqlmock.NewRows([]string{"seq"}).AddRow(int64(101)))
+	mock.ExpectQuery("INSERT INTO invoices").
+		WithArgs("INV-0101", int64(101), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(int64(12), now, now))
+
+	inv := newInvoiceForRepoTest()
+	created, err := repo.Create(context.Background(), inv, 100, formatInvoiceNumberForTest)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, int64(12), inv.ID)
+	require.Equal(t, int64(101), inv.Sequence)
+	require.Equal(t, "INV-0101", inv.Number)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepositoryGetByIDNotFound(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &invoiceRepository{sql: db}
+
+	mock.ExpectQuery("FROM invoices WHERE id = \\$1").
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetByID(context.Background(), 9)
+	require.ErrorIs(t, err, service.ErrInvoiceNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepositoryListFiltersByRecipient(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &invoiceRepository{sql: db}
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM invoices WHERE recipient_user_id = \\$1 AND status = \\$2").
+		WithArgs(int64(7), service.InvoiceStatusIssued).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(1)))
+	mock.ExpectQuery("FROM invoices WHERE recipient_user_id = \\$1 AND status = \\$2 ORDER BY").
+		WithArgs(int64(7), service.InvoiceStatusIssued, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	items, result, err := repo.List(context.Background(), pagination.PaginationParams{Page: 1, PageSize: 20}, service.InvoiceFilter{
+		RecipientUserID: 7,
+		Status:          service.InvoiceStatusIssued,
+	})
+	require.NoError(t, err)
+	require.Empty(t, items)
+	require.Equal(t, int64(1), result.Total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	dbgroup "github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/modelprice"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type modelPriceRepository struct {
+	client *dbent.Client
+}
+
+func NewModelPriceRepository(client *dbent.Client) service.ModelPriceRepository {
+	return &modelPriceRepository{client: client}
+}
+
+func (r *modelPriceRepository) Create(ctx context.Context, price *service.ModelPrice) error {
+	client := clientFromContext(ctx, r.client)
+	created, err := client.ModelPrice.Create().
+		SetNillableGroupID(price.GroupID).
+		SetModel(price.Model).
+		SetInputPrice(price.InputPrice).
+		SetOutputPrice(price.OutputPrice).
+		SetCacheWritePrice(price.CacheWritePrice).
+		SetCacheReadPrice(price.CacheReadPrice).
+		SetLongContextThreshold(price.LongContextThreshold).
+		SetNillableLongContextInputPrice(price.LongContextInputPrice).
+		SetNillableLongContextOutputPrice(price.LongContextOutputPrice).
+		SetEffectiveFrom(price.EffectiveFrom).
+		SetNotes(price.Notes).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	price.ID = created.ID
+	price.CreatedAt = created.CreatedAt
+	price.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *modelPriceRepository) GetByID(ctx context.Context, id int64) (*service.ModelPrice, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.ModelPrice.Query().
+		Where(modelprice.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrModelPriceNotFound, nil)
+	}
+	prices := []service.ModelPrice{*modelPriceEntityToService(m)}
+	if err := r.attachGroups(ctx, prices); err != nil {
+		return nil, err
+	}
+	return &prices[0], nil
+}
+
+func (r *modelPriceRepository) Update(ctx context.Context, price *service.ModelPrice) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.ModelPrice.UpdateOneID(price.ID).
+		SetModel(price.Model).
+		SetInputPrice(price.InputPrice).
+		SetOutputPrice(price.OutputPrice).
+		SetCacheWritePrice(price.CacheWritePrice).
+		SetCacheReadPrice(price.CacheReadPrice).
+		SetLongContextThreshold(price.LongContextThreshold).
+		SetEffectiveFrom(price.EffectiveFrom).
+		SetNotes(price.Notes)
+	if price.GroupID != nil {
+		builder.SetGroupID(*price.GroupID)
+	} else {
+		builder.ClearGroupID()
+	}
+	if price.LongContextInputPrice != nil {
+		builder.SetLongContextInputPrice(*price.LongContextInputPrice)
+	} else {
+		builder.ClearLongContextInputPrice()
+	}
+	if price.LongContextOutputPrice != nil {
+		builder.SetLongContextOutputPrice(*price.LongContextOutputPrice)
+	} else {
+		builder.ClearLongContextOutputPrice()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrModelPriceNotFound, nil)
+	}
+	price.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *modelPriceRepository) Delete(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.ModelPrice.Delete().Where(modelprice.IDEQ(id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrModelPriceNotFound
+	}
+	return nil
+}
+
+func (r *modelPriceRepository) List(ctx context.Context, params pagination.PaginationParams, filters service.ModelPriceListFilters) ([]service.ModelPrice, *pagination.PaginationResult, error) {
+	q := r.client.ModelPrice.Query()
+	switch filters.Scope {
+	case service.ModelPriceScopeGlobal:
+		q = q.Where(modelprice.GroupIDIsNil())
+	case service.ModelPriceScopeGroup:
+		q = q.Where(modelprice.GroupIDNotNil())
+	}
+	if filters.GroupID != nil {
+		q = q.Where(modelprice.GroupIDEQ(*filters.GroupID))
+	}
+	if filters.Model != "" {
+		q = q.Where(modelprice.ModelContainsFold(filters.Model))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	models, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Asc(modelprice.FieldModel), dbent.Desc(modelprice.FieldEffectiveFrom), dbent.Desc(modelprice.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	prices := modelPriceEntitiesToService(models)
+	if err := r.attachGroups(ctx, prices); err != nil {
+		return nil, nil, err
+	}
+	return prices, paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *modelPriceRepository) ListAll(ctx context.Context) ([]service.ModelPrice, error) {
+	models, err := r.client.ModelPrice.Query().
+		Order(dbent.Desc(modelprice.FieldEffectiveFrom), dbent.Desc(modelprice.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return modelPriceEntitiesToService(models), nil
+}
+
+func (r *modelPriceRepository) ExistsVersion(ctx context.Context, groupID *int64, model string, effectiveFrom time.Time, excludeID int64) (bool, error) {
+	q := r.client.ModelPrice.Query().
+		Where(
+			modelprice.ModelEQ(model),
+			modelprice.EffectiveFromEQ(effectiveFrom),
+		)
+	if groupID != nil {
+		q = q.Where(modelprice.GroupIDEQ(*groupID))
+	} else {
+		q = q.Where(modelprice.GroupIDIsNil())
+	}
+	if excludeID > 0 {
+		q = q.Where(modelprice.IDNEQ(excludeID))
+	}
+	return q.Exist(ctx)
+}
+
+// attachGroups 批量加载分组覆盖所属的分组（价格与分组之间没有建立 ent 边）
+func (r *modelPriceRepository) attachGroups(ctx context.Context, prices []service.ModelPrice) error {
+	ids := make([]int64, 0, len(prices))
+	for i := range prices {
+		if prices[i].GroupID != nil {
+			ids = append(ids, *prices[i].GroupID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	client := clientFromContext(ctx, r.client)
+	groups, err := client.Group.Query().Where(dbgroup.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]*service.Group, len(groups))
+	for _, g := range groups {
+		byID[g.ID] = groupEntityToService(g)
+	}
+	for i := range prices {
+		if prices[i].GroupID != nil {
+			prices[i].Group = byID[*prices[i].GroupID]
+		}
+	}
+	return nil
+}
+
+func modelPriceEntityToService(m *dbent.ModelPrice) *service.ModelPrice {
+	if m == nil {
+		return nil
+	}
+	return &service.ModelPrice{
+		ID:                     m.ID,
+		GroupID:                m.GroupID,
+		Model:                  m.Model,
+		InputPrice:             m.InputPrice,
+		OutputPrice:            m.OutputPrice,
+		CacheWritePrice:        m.CacheWritePrice,
+		CacheReadPrice:         m.CacheReadPrice,
+		LongContextThreshold:   m.LongContextThreshold,
+		LongContextInputPrice:  m.LongContextInputPrice,
+		LongContextOutputPrice: m.LongContextOutputPrice,
+		EffectiveFrom:          m.EffectiveFrom,
+		Notes:                  m.Notes,
+		CreatedAt:              m.CreatedAt,
+		UpdatedAt:              m.UpdatedAt,
+	}
+}
+
+func modelPriceEntitiesToService(models []*dbent.ModelPrice) []service.ModelPrice {
+	out := make([]service.ModelPrice, 0, len(models))
+	for i := range models {
+		if p := modelPriceEntityToService(models[i]); p != nil {
+			out = append(out, *p)
+		}
+	}
+	return out
+}
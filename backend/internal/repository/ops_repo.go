@@ -62,9 +62,10 @@ INSERT INTO ops_error_logs (
   request_headers,
   is_retryable,
   retry_count,
+  trace_id,
   created_at
 ) VALUES (
-  $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34
+  $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32,$33,$34,$35
 ) RETURNING id`
 
 	var id int64
@@ -104,6 +105,7 @@ INSERT INTO ops_error_logs (
 		opsNullString(input.RequestHeadersJSON),
 		input.IsRetryable,
 		input.RetryCount,
+		opsNullString(input.TraceID),
 		input.CreatedAt,
 	).Scan(&id)
 	if err != nil {
@@ -163,6 +165,7 @@ SELECT
   e.resolved_retry_id,
   COALESCE(e.client_request_id, ''),
   COALESCE(e.request_id, ''),
+  COALESCE(e.trace_id, ''),
   COALESCE(e.error_message, ''),
   e.user_id,
   COALESCE(u.email, ''),
@@ -225,6 +228,7 @@ LIMIT $` + itoa(len(args)+1) + ` OFFSET $` + itoa(len(args)+2)
 			&resolvedRetryID,
 			&item.ClientRequestID,
 			&item.RequestID,
+			&item.TraceID,
 			&item.Message,
 			&userID,
 			&userEmail,
@@ -318,6 +322,7 @@ SELECT
   e.resolved_retry_id,
   COALESCE(e.client_request_id, ''),
   COALESCE(e.request_id, ''),
+  COALESCE(e.trace_id, ''),
   COALESCE(e.error_message, ''),
   COALESCE(e.error_body, ''),
   e.upstream_status_code,
@@ -389,6 +394,7 @@ LIMIT 1`
 		&resolvedRetryID,
 		&out.ClientRequestID,
 		&out.RequestID,
+		&out.TraceID,
 		&out.Message,
 		&out.ErrorBody,
 		&upstreamStatusCode,
@@ -1032,7 +1038,7 @@ func buildOpsErrorLogsWhere(filter *service.OpsErrorLogFilter) (string, []any) {
 		like := "%" + q + "%"
 		args = append(args, like)
 		n := itoa(len(args))
-		clauses = append(clauses, "(request_id ILIKE $"+n+" OR client_request_id ILIKE $"+n+" OR error_message ILIKE $"+n+")")
+		clauses = append(clauses, "(request_id ILIKE $"+n+" OR client_request_id ILIKE $"+n+" OR trace_id ILIKE $"+n+" OR error_message ILIKE $"+n+")")
 	}
 
 	if userQuery := strings.TrimSpace(filter.UserQuery); userQuery != "" {
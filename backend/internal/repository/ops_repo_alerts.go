@@ -30,6 +30,7 @@ SELECT
   sustained_minutes,
   cooldown_minutes,
   COALESCE(notify_email, true),
+  notify_channel_ids,
   filters,
   last_triggered_at,
   created_at,
@@ -47,6 +48,7 @@ ORDER BY id DESC`
 	for rows.Next() {
 		var rule service.OpsAlertRule
 		var filtersRaw []byte
+		var channelIDsRaw []byte
 		var lastTriggeredAt sql.NullTime
 		if err := rows.Scan(
 			&rule.ID,
@@ -61,6 +63,7 @@ ORDER BY id DESC`
 			&rule.SustainedMinutes,
 			&rule.CooldownMinutes,
 			&rule.NotifyEmail,
+			&channelIDsRaw,
 			&filtersRaw,
 			&lastTriggeredAt,
 			&rule.CreatedAt,
@@ -78,6 +81,7 @@ ORDER BY id DESC`
 				rule.Filters = decoded
 			}
 		}
+		rule.NotifyChannelIDs = decodeOpsInt64Array(channelIDsRaw)
 		out = append(out, &rule)
 	}
 	if err := rows.Err(); err != nil {
@@ -98,6 +102,10 @@ func (r *opsRepository) CreateAlertRule(ctx context.Context, input *service.OpsA
 	if err != nil {
 		return nil, err
 	}
+	channelIDsArg, err := opsJSONInt64Array(input.NotifyChannelIDs)
+	if err != nil {
+		return nil, err
+	}
 
 	q := `
 INSERT INTO ops_alert_rules (
@@ -112,11 +120,12 @@ INSERT INTO ops_alert_rules (
   sustained_minutes,
   cooldown_minutes,
   notify_email,
+  notify_channel_ids,
   filters,
   created_at,
   updated_at
 ) VALUES (
-  $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,NOW(),NOW()
+  $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,NOW(),NOW()
 )
 RETURNING
   id,
@@ -131,6 +140,7 @@ RETURNING
   sustained_minutes,
   cooldown_minutes,
   COALESCE(notify_email, true),
+  notify_channel_ids,
   filters,
   last_triggered_at,
   created_at,
@@ -138,6 +148,7 @@ RETURNING
 
 	var out service.OpsAlertRule
 	var filtersRaw []byte
+	var channelIDsRaw []byte
 	var lastTriggeredAt sql.NullTime
 
 	if err := r.db.QueryRowContext(
@@ -154,6 +165,7 @@ RETURNING
 		input.SustainedMinutes,
 		input.CooldownMinutes,
 		input.NotifyEmail,
+		channelIDsArg,
 		filtersArg,
 	).Scan(
 		&out.ID,
@@ -168,6 +180,7 @@ RETURNING
 		&out.SustainedMinutes,
 		&out.CooldownMinutes,
 		&out.NotifyEmail,
+		&channelIDsRaw,
 		&filtersRaw,
 		&lastTriggeredAt,
 		&out.CreatedAt,
@@ -185,6 +198,7 @@ RETURNING
 			out.Filters = decoded
 		}
 	}
+	out.NotifyChannelIDs = decodeOpsInt64Array(channelIDsRaw)
 
 	return &out, nil
 }
@@ -204,6 +218,10 @@ func (r *opsRepository) UpdateAlertRule(ctx context.Context, input *service.OpsA
 	if err != nil {
 		return nil, err
 	}
+	channelIDsArg, err := opsJSONInt64Array(input.NotifyChannelIDs)
+	if err != nil {
+		return nil, err
+	}
 
 	q := `
 UPDATE ops_alert_rules
@@ -219,7 +237,8 @@ SET
   sustained_minutes = $10,
   cooldown_minutes = $11,
   notify_email = $12,
-  filters = $13,
+  notify_channel_ids = $13,
+  filters = $14,
   updated_at = NOW()
 WHERE id = $1
 RETURNING
@@ -235,6 +254,7 @@ RETURNING
   sustained_minutes,
   cooldown_minutes,
   COALESCE(notify_email, true),
+  notify_channel_ids,
   filters,
   last_triggered_at,
   created_at,
@@ -242,6 +262,7 @@ RETURNING
 
 	var out service.OpsAlertRule
 	var filtersRaw []byte
+	var channelIDsRaw []byte
 	var lastTriggeredAt sql.NullTime
 
 	if err := r.db.QueryRowContext(
@@ -259,6 +280,7 @@ RETURNING
 		input.SustainedMinutes,
 		input.CooldownMinutes,
 		input.NotifyEmail,
+		channelIDsArg,
 		filtersArg,
 	).Scan(
 		&out.ID,
@@ -273,6 +295,7 @@ RETURNING
 		&out.SustainedMinutes,
 		&out.CooldownMinutes,
 		&out.NotifyEmail,
+		&channelIDsRaw,
 		&filtersRaw,
 		&lastTriggeredAt,
 		&out.CreatedAt,
@@ -291,6 +314,7 @@ RETURNING
 			out.Filters = decoded
 		}
 	}
+	out.NotifyChannelIDs = decodeOpsInt64Array(channelIDsRaw)
 
 	return &out, nil
 }
@@ -351,6 +375,7 @@ SELECT
   fired_at,
   resolved_at,
   email_sent,
+  notification_status,
   created_at
 FROM ops_alert_events
 ` + where + `
@@ -370,6 +395,7 @@ LIMIT ` + limitArg
 		var thresholdValue sql.NullFloat64
 		var dimensionsRaw []byte
 		var resolvedAt sql.NullTime
+		var notificationsRaw []byte
 		if err := rows.Scan(
 			&ev.ID,
 			&ev.RuleID,
@@ -383,6 +409,7 @@ LIMIT ` + limitArg
 			&ev.FiredAt,
 			&resolvedAt,
 			&ev.EmailSent,
+			&notificationsRaw,
 			&ev.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -405,6 +432,7 @@ LIMIT ` + limitArg
 				ev.Dimensions = decoded
 			}
 		}
+		ev.Notifications = decodeOpsNotificationDeliveries(notificationsRaw)
 		out = append(out, &ev)
 	}
 	if err := rows.Err(); err != nil {
@@ -435,6 +463,7 @@ SELECT
   fired_at,
   resolved_at,
   email_sent,
+  notification_status,
   created_at
 FROM ops_alert_events
 WHERE id = $1`
@@ -472,6 +501,7 @@ SELECT
   fired_at,
   resolved_at,
   email_sent,
+  notification_status,
   created_at
 FROM ops_alert_events
 WHERE rule_id = $1 AND status = $2
@@ -511,6 +541,7 @@ SELECT
   fired_at,
   resolved_at,
   email_sent,
+  notification_status,
   created_at
 FROM ops_alert_events
 WHERE rule_id = $1
@@ -571,6 +602,7 @@ RETURNING
   fired_at,
   resolved_at,
   email_sent,
+  notification_status,
   created_at`
 
 	row := r.db.QueryRowContext(
@@ -624,6 +656,22 @@ func (r *opsRepository) UpdateAlertEventEmailSent(ctx context.Context, eventID i
 	return err
 }
 
+func (r *opsRepository) UpdateAlertEventNotifications(ctx context.Context, eventID int64, deliveries []service.OpsNotificationDelivery) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("nil ops repository")
+	}
+	if eventID <= 0 {
+		return fmt.Errorf("invalid event id")
+	}
+
+	raw, err := json.Marshal(deliveries)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, "UPDATE ops_alert_events SET notification_status = $2 WHERE id = $1", eventID, string(raw))
+	return err
+}
+
 type opsAlertEventRow interface {
 	Scan(dest ...any) error
 }
@@ -749,6 +797,7 @@ func scanOpsAlertEvent(row opsAlertEventRow) (*service.OpsAlertEvent, error) {
 	var thresholdValue sql.NullFloat64
 	var dimensionsRaw []byte
 	var resolvedAt sql.NullTime
+	var notificationsRaw []byte
 
 	if err := row.Scan(
 		&ev.ID,
@@ -763,6 +812,7 @@ func scanOpsAlertEvent(row opsAlertEventRow) (*service.OpsAlertEvent, error) {
 		&ev.FiredAt,
 		&resolvedAt,
 		&ev.EmailSent,
+		&notificationsRaw,
 		&ev.CreatedAt,
 	); err != nil {
 		return nil, err
@@ -785,6 +835,7 @@ func scanOpsAlertEvent(row opsAlertEventRow) (*service.OpsAlertEvent, error) {
 			ev.Dimensions = decoded
 		}
 	}
+	ev.Notifications = decodeOpsNotificationDeliveries(notificationsRaw)
 	return &ev, nil
 }
 
@@ -851,3 +902,34 @@ func opsNullJSONMap(v map[string]any) (any, error) {
 	}
 	return sql.NullString{String: string(b), Valid: true}, nil
 }
+
+func opsJSONInt64Array(v []int64) (string, error) {
+	if v == nil {
+		v = []int64{}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func decodeOpsInt64Array(raw []byte) []int64 {
+	out := []int64{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return out
+	}
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
+
+func decodeOpsNotificationDeliveries(raw []byte) []service.OpsNotificationDelivery {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var out []service.OpsNotificationDelivery
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const opsNotificationChannelColumns = `
+  id,
+  name,
+  channel_type,
+  enabled,
+  config,
+  send_resolved,
+  send_reports,
+  created_at,
+  updated_at`
+
+func (r *opsRepository) ListNotificationChannels(ctx context.Context) ([]*service.OpsNotificationChannel, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT"+opsNotificationChannelColumns+"\nFROM ops_notification_channels\nORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*service.OpsNotificationChannel{}
+	for rows.Next() {
+		ch, err := scanOpsNotificationChannel(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *opsRepository) GetNotificationChannelByID(ctx context.Context, id int64) (*service.OpsNotificationChannel, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	row := r.db.QueryRowContext(ctx, "SELECT"+opsNotificationChannelColumns+"\nFROM ops_notification_channels\nWHERE id = $1", id)
+	ch, err := scanOpsNotificationChannel(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (r *opsRepository) CreateNotificationChannel(ctx context.Context, input *service.OpsNotificationChannel) (*service.OpsNotificationChannel, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("nil input")
+	}
+
+	configArg, err := opsNotificationChannelConfigJSON(input.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+INSERT INTO ops_notification_channels (
+  name,
+  channel_type,
+  enabled,
+  config,
+  send_resolved,
+  send_reports,
+  created_at,
+  updated_at
+) VALUES (
+  $1,$2,$3,$4,$5,$6,NOW(),NOW()
+)
+RETURNING` + opsNotificationChannelColumns
+
+	row := r.db.QueryRowContext(
+		ctx,
+		q,
+		strings.TrimSpace(input.Name),
+		strings.TrimSpace(input.Type),
+		input.Enabled,
+		configArg,
+		input.SendResolved,
+		input.SendReports,
+	)
+	return scanOpsNotificationChannel(row)
+}
+
+func (r *opsRepository) UpdateNotificationChannel(ctx context.Context, input *service.OpsNotificationChannel) (*service.OpsNotificationChannel, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("nil input")
+	}
+	if input.ID <= 0 {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	configArg, err := opsNotificationChannelConfigJSON(input.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+UPDATE ops_notification_channels
+SET
+  name = $2,
+  channel_type = $3,
+  enabled = $4,
+  config = $5,
+  send_resolved = $6,
+  send_reports = $7,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING` + opsNotificationChannelColumns
+
+	row := r.db.QueryRowContext(
+		ctx,
+		q,
+		input.ID,
+		strings.TrimSpace(input.Name),
+		strings.TrimSpace(input.Type),
+		input.Enabled,
+		configArg,
+		input.SendResolved,
+		input.SendReports,
+	)
+	return scanOpsNotificationChannel(row)
+}
+
+// DeleteNotificationChannel removes the channel and drops its id from every alert rule's notify_channel_ids.
+func (r *opsRepository) DeleteNotificationChannel(ctx context.Context, id int64) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("nil ops repository")
+	}
+	if id <= 0 {
+		return fmt.Errorf("invalid id")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM ops_notification_channels WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	q := `
+UPDATE ops_alert_rules
+SET notify_channel_ids = COALESCE((
+  SELECT jsonb_agg(elem)
+  FROM jsonb_array_elements(notify_channel_ids) AS elem
+  WHERE elem <> to_jsonb($1::bigint)
+), '[]'::jsonb)
+WHERE notify_channel_ids @> jsonb_build_array($1::bigint)`
+	if _, err := tx.ExecContext(ctx, q, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func scanOpsNotificationChannel(row opsAlertEventRow) (*service.OpsNotificationChannel, error) {
+	var ch service.OpsNotificationChannel
+	var configRaw []byte
+	if err := row.Scan(
+		&ch.ID,
+		&ch.Name,
+		&ch.Type,
+		&ch.Enabled,
+		&configRaw,
+		&ch.SendResolved,
+		&ch.SendReports,
+		&ch.CreatedAt,
+		&ch.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if len(configRaw) > 0 && string(configRaw) != "null" {
+		if err := json.Unmarshal(configRaw, &ch.Config); err != nil {
+			return nil, fmt.Errorf("decode notification channel config: %w", err)
+		}
+	}
+	return &ch, nil
+}
+
+func opsNotificationChannelConfigJSON(cfg service.OpsNotificationChannelConfig) (string, error) {
+	// Response-only flags are derived from the stored secrets on read; never store stale values.
+	cfg.SecretConfigured = false
+	cfg.BotTokenConfigured = false
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
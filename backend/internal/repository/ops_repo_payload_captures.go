@@ -0,0 +1,525 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const opsPayloadCaptureRuleColumns = `
+  id,
+  name,
+  scope_type,
+  scope_id,
+  sample_rate,
+  ttl_hours,
+  redact_keys,
+  enabled,
+  expires_at,
+  capture_count,
+  last_captured_at,
+  created_by,
+  created_at,
+  updated_at`
+
+const opsPayloadCaptureColumns = `
+  c.id,
+  c.rule_id,
+  c.request_id,
+  c.client_request_id,
+  c.user_id,
+  COALESCE(u.email, ''),
+  c.api_key_id,
+  c.group_id,
+  COALESCE(g.name, ''),
+  c.account_id,
+  COALESCE(a.name, ''),
+  c.platform,
+  c.model,
+  c.request_path,
+  c.stream,
+  c.status_code,
+  c.duration_ms,
+  c.request_bytes,
+  c.response_bytes,
+  c.request_truncated,
+  c.response_truncated,
+  c.response_reassembled,
+  c.expires_at,
+  c.created_at`
+
+const opsPayloadCaptureJoins = `
+FROM ops_payload_captures c
+LEFT JOIN users u ON c.user_id = u.id
+LEFT JOIN groups g ON c.group_id = g.id
+LEFT JOIN accounts a ON c.account_id = a.id`
+
+func (r *opsRepository) ListPayloadCaptureRules(ctx context.Context) ([]*service.OpsPayloadCaptureRule, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT"+opsPayloadCaptureRuleColumns+"\nFROM ops_payload_capture_rules\nORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*service.OpsPayloadCaptureRule{}
+	for rows.Next() {
+		rule, err := scanOpsPayloadCaptureRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *opsRepository) CreatePayloadCaptureRule(ctx context.Context, input *service.OpsPayloadCaptureRule) (*service.OpsPayloadCaptureRule, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("nil input")
+	}
+
+	redactKeys, err := opsRedactKeysJSON(input.RedactKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+INSERT INTO ops_payload_capture_rules (
+  name,
+  scope_type,
+  scope_id,
+  sample_rate,
+  ttl_hours,
+  redact_keys,
+  enabled,
+  expires_at,
+  created_by,
+  created_at,
+  updated_at
+) VALUES (
+  $1,$2,$3,$4,$5,$6,$7,$8,$9,NOW(),NOW()
+)
+RETURNING` + opsPayloadCaptureRuleColumns
+
+	row := r.db.QueryRowContext(
+		ctx,
+		q,
+		strings.TrimSpace(input.Name),
+		input.ScopeType,
+		input.ScopeID,
+		input.SampleRate,
+		input.TTLHours,
+		redactKeys,
+		input.Enabled,
+		opsNullTime(input.ExpiresAt),
+		opsNullInt64(input.CreatedBy),
+	)
+	return scanOpsPayloadCaptureRule(row)
+}
+
+func (r *opsRepository) UpdatePayloadCaptureRule(ctx context.Context, input *service.OpsPayloadCaptureRule) (*service.OpsPayloadCaptureRule, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("nil input")
+	}
+	if input.ID <= 0 {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	redactKeys, err := opsRedactKeysJSON(input.RedactKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+UPDATE ops_payload_capture_rules
+SET
+  name = $2,
+  scope_type = $3,
+  scope_id = $4,
+  sample_rate = $5,
+  ttl_hours = $6,
+  redact_keys = $7,
+  enabled = $8,
+  expires_at = $9,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING` + opsPayloadCaptureRuleColumns
+
+	row := r.db.QueryRowContext(
+		ctx,
+		q,
+		input.ID,
+		strings.TrimSpace(input.Name),
+		input.ScopeType,
+		input.ScopeID,
+		input.SampleRate,
+		input.TTLHours,
+		redactKeys,
+		input.Enabled,
+		opsNullTime(input.ExpiresAt),
+	)
+	return scanOpsPayloadCaptureRule(row)
+}
+
+// DeletePayloadCaptureRule removes the rule; existing captures are kept (rule_id is set to NULL).
+func (r *opsRepository) DeletePayloadCaptureRule(ctx context.Context, id int64) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("nil ops repository")
+	}
+	if id <= 0 {
+		return fmt.Errorf("invalid id")
+	}
+
+	res, err := r.db.ExecContext(ctx, "DELETE FROM ops_payload_capture_rules WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// InsertPayloadCapture stores a capture and bumps the rule's capture counter in one statement.
+func (r *opsRepository) InsertPayloadCapture(ctx context.Context, input *service.OpsInsertPayloadCaptureInput) (int64, error) {
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("nil ops repository")
+	}
+	if input == nil {
+		return 0, fmt.Errorf("nil input")
+	}
+
+	headers := input.RequestHeadersJSON
+	if strings.TrimSpace(headers) == "" {
+		headers = "{}"
+	}
+
+	q := `
+WITH inserted AS (
+  INSERT INTO ops_payload_captures (
+    rule_id,
+    request_id,
+    client_request_id,
+    user_id,
+    api_key_id,
+    group_id,
+    account_id,
+    platform,
+    model,
+    request_path,
+    stream,
+    status_code,
+    duration_ms,
+    user_agent,
+    request_headers,
+    request_body,
+    response_body,
+    request_bytes,
+    response_bytes,
+    request_truncated,
+    response_truncated,
+    response_reassembled,
+    expires_at,
+    created_at
+  ) VALUES (
+    $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24
+  )
+  RETURNING id, rule_id, created_at
+), bumped AS (
+  UPDATE ops_payload_capture_rules r
+  SET capture_count = r.capture_count + 1,
+      last_captured_at = inserted.created_at
+  FROM inserted
+  WHERE r.id = inserted.rule_id
+)
+SELECT id FROM inserted`
+
+	var id int64
+	err := r.db.QueryRowContext(
+		ctx,
+		q,
+		opsNullInt64(input.RuleID),
+		input.RequestID,
+		input.ClientRequestID,
+		opsNullInt64(input.UserID),
+		opsNullInt64(input.APIKeyID),
+		opsNullInt64(input.GroupID),
+		opsNullInt64(input.AccountID),
+		input.Platform,
+		input.Model,
+		input.RequestPath,
+		input.Stream,
+		input.StatusCode,
+		input.DurationMs,
+		input.UserAgent,
+		headers,
+		input.RequestBodyGzip,
+		input.ResponseBodyGzip,
+		input.RequestBytes,
+		input.ResponseBytes,
+		input.RequestTruncated,
+		input.ResponseTruncated,
+		input.ResponseReassembled,
+		input.ExpiresAt,
+		input.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *opsRepository) ListPayloadCaptures(ctx context.Context, filter *service.OpsPayloadCaptureFilter) (*service.OpsPayloadCaptureList, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if filter == nil {
+		filter = &service.OpsPayloadCaptureFilter{}
+	}
+
+	page := filter.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	where, args := buildOpsPayloadCapturesWhere(filter)
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM ops_payload_captures c "+where, args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * pageSize
+	argsWithLimit := append(args, pageSize, offset)
+	q := "SELECT" + opsPayloadCaptureColumns + opsPayloadCaptureJoins + "\n" + where + `
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT $` + itoa(len(args)+1) + ` OFFSET $` + itoa(len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, q, argsWithLimit...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := make([]*service.OpsPayloadCapture, 0, pageSize)
+	for rows.Next() {
+		var item service.OpsPayloadCapture
+		if err := rows.Scan(opsPayloadCaptureScanDest(&item)...); err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &service.OpsPayloadCaptureList{
+		Items:    items,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+func (r *opsRepository) GetPayloadCaptureByID(ctx context.Context, id int64) (*service.OpsPayloadCaptureDetail, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if id <= 0 {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	q := "SELECT" + opsPayloadCaptureColumns + `,
+  c.user_agent,
+  c.request_headers,
+  c.request_body,
+  c.response_body` + opsPayloadCaptureJoins + `
+WHERE c.id = $1`
+
+	var detail service.OpsPayloadCaptureDetail
+	var headers []byte
+	dest := append(opsPayloadCaptureScanDest(&detail.OpsPayloadCapture),
+		&detail.UserAgent,
+		&headers,
+		&detail.RequestBodyGzip,
+		&detail.ResponseBodyGzip,
+	)
+	if err := r.db.QueryRowContext(ctx, q, id).Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	detail.RequestHeadersJSON = string(headers)
+	return &detail, nil
+}
+
+func (r *opsRepository) DeletePayloadCapture(ctx context.Context, id int64) error {
+	if r == nil || r.db == nil {
+		return fmt.Errorf("nil ops repository")
+	}
+	if id <= 0 {
+		return fmt.Errorf("invalid id")
+	}
+
+	res, err := r.db.ExecContext(ctx, "DELETE FROM ops_payload_captures WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func buildOpsPayloadCapturesWhere(filter *service.OpsPayloadCaptureFilter) (string, []any) {
+	clauses := []string{"c.expires_at > NOW()"}
+	args := []any{}
+
+	add := func(clause string, arg any) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
+
+	if filter.StartTime != nil && !filter.StartTime.IsZero() {
+		add("c.created_at >= $%d", filter.StartTime.UTC())
+	}
+	if filter.EndTime != nil && !filter.EndTime.IsZero() {
+		add("c.created_at < $%d", filter.EndTime.UTC())
+	}
+	if filter.RuleID != nil && *filter.RuleID > 0 {
+		add("c.rule_id = $%d", *filter.RuleID)
+	}
+	if filter.UserID != nil && *filter.UserID > 0 {
+		add("c.user_id = $%d", *filter.UserID)
+	}
+	if filter.APIKeyID != nil && *filter.APIKeyID > 0 {
+		add("c.api_key_id = $%d", *filter.APIKeyID)
+	}
+	if filter.GroupID != nil && *filter.GroupID > 0 {
+		add("c.group_id = $%d", *filter.GroupID)
+	}
+	if filter.AccountID != nil && *filter.AccountID > 0 {
+		add("c.account_id = $%d", *filter.AccountID)
+	}
+	if model := strings.TrimSpace(filter.Model); model != "" {
+		add("c.model = $%d", model)
+	}
+
+	return "WHERE " + strings.Join(clauses, " AND "), args
+}
+
+func opsPayloadCaptureScanDest(item *service.OpsPayloadCapture) []any {
+	return []any{
+		&item.ID,
+		&item.RuleID,
+		&item.RequestID,
+		&item.ClientRequestID,
+		&item.UserID,
+		&item.UserEmail,
+		&item.APIKeyID,
+		&item.GroupID,
+		&item.GroupName,
+		&item.AccountID,
+		&item.AccountName,
+		&item.Platform,
+		&item.Model,
+		&item.RequestPath,
+		&item.Stream,
+		&item.StatusCode,
+		&item.DurationMs,
+		&item.RequestBytes,
+		&item.ResponseBytes,
+		&item.RequestTruncated,
+		&item.ResponseTruncated,
+		&item.ResponseReassembled,
+		&item.ExpiresAt,
+		&item.CreatedAt,
+	}
+}
+
+func scanOpsPayloadCaptureRule(row opsAlertEventRow) (*service.OpsPayloadCaptureRule, error) {
+	var rule service.OpsPayloadCaptureRule
+	var redactKeys []byte
+	var expiresAt, lastCapturedAt sql.NullTime
+	var createdBy sql.NullInt64
+	if err := row.Scan(
+		&rule.ID,
+		&rule.Name,
+		&rule.ScopeType,
+		&rule.ScopeID,
+		&rule.SampleRate,
+		&rule.TTLHours,
+		&redactKeys,
+		&rule.Enabled,
+		&expiresAt,
+		&rule.CaptureCount,
+		&lastCapturedAt,
+		&createdBy,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	rule.RedactKeys = []string{}
+	if len(redactKeys) > 0 && string(redactKeys) != "null" {
+		if err := json.Unmarshal(redactKeys, &rule.RedactKeys); err != nil {
+			return nil, fmt.Errorf("decode payload capture redact_keys: %w", err)
+		}
+	}
+	if expiresAt.Valid {
+		t := expiresAt.Time
+		rule.ExpiresAt = &t
+	}
+	if lastCapturedAt.Valid {
+		t := lastCapturedAt.Time
+		rule.LastCapturedAt = &t
+	}
+	if createdBy.Valid {
+		v := createdBy.Int64
+		rule.CreatedBy = &v
+	}
+	return &rule, nil
+}
+
+func opsRedactKeysJSON(keys []string) (string, error) {
+	if keys == nil {
+		keys = []string{}
+	}
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,531 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/organization"
+	"github.com/Wei-Shaw/sub2api/ent/organizationmember"
+	dbuser "github.com/Wei-Shaw/sub2api/ent/user"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type organizationRepository struct {
+	client *dbent.Client
+}
+
+func NewOrganizationRepository(client *dbent.Client) service.OrganizationRepository {
+	return &organizationRepository{client: client}
+}
+
+func (r *organizationRepository) Create(ctx context.Context, org *service.Organization) error {
+	client := clientFromContext(ctx, r.client)
+	created, err := client.Organization.Create().
+		SetName(org.Name).
+		SetOwnerUserID(org.OwnerUserID).
+		SetBalance(org.Balance).
+		SetConcurrency(org.Concurrency).
+		SetStatus(org.Status).
+		SetNotes(org.Notes).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 初始余额同样记入账本，保证账本合计与余额一致
+	if created.Balance != 0 {
+		if _, err := client.ExecContext(ctx, `
+			INSERT INTO organization_balance_transactions (organization_id, amount, balance_after, source_type, created_at)
+			VALUES ($1, $2, $2, $3, NOW())`,
+			created.ID, created.Balance, service.BalanceSourceInitial); err != nil {
+			return err
+		}
+	}
+
+	org.ID = created.ID
+	org.CreatedAt = created.CreatedAt
+	org.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *organizationRepository) GetByID(ctx context.Context, id int64) (*service.Organization, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.Organization.Query().
+		Where(organization.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrOrganizationNotFound, nil)
+	}
+	out := organizationEntityToService(m)
+	count, err := client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(id)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out.MemberCount = count
+	return out, nil
+}
+
+func (r *organizationRepository) Update(ctx context.Context, org *service.Organization) error {
+	client := clientFromContext(ctx, r.client)
+	updated, err := client.Organization.UpdateOneID(org.ID).
+		SetName(org.Name).
+		SetConcurrency(org.Concurrency).
+		SetStatus(org.Status).
+		SetNotes(org.Notes).
+		Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrOrganizationNotFound, nil)
+	}
+	org.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *organizationRepository) Delete(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.Organization.Delete().Where(organization.IDEQ(id)).Exec(ctx)
+	return translatePersistenceError(err, service.ErrOrganizationNotFound, nil)
+}
+
+func (r *organizationRepository) ListWithFilters(ctx context.Context, params pagination.PaginationParams, status, search string) ([]service.Organization, *pagination.PaginationResult, error) {
+	q := r.client.Organization.Query()
+
+	if status != "" {
+		q = q.Where(organization.StatusEQ(status))
+	}
+	if search != "" {
+		q = q.Where(organization.NameContainsFold(search))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orgs, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(organization.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]service.Organization, 0, len(orgs))
+	orgIDs := make([]int64, 0, len(orgs))
+	ownerIDs := make([]int64, 0, len(orgs))
+	for _, m := range orgs {
+		out = append(out, *organizationEntityToService(m))
+		orgIDs = append(orgIDs, m.ID)
+		ownerIDs = append(ownerIDs, m.OwnerUserID)
+	}
+
+	if len(orgIDs) > 0 {
+		counts, err := r.memberCounts(ctx, orgIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		owners, err := r.client.User.Query().Where(dbuser.IDIn(ownerIDs...)).All(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		ownerMap := make(map[int64]*service.User, len(owners))
+		for _, u := range owners {
+			ownerMap[u.ID] = userEntityToService(u)
+		}
+		for i := range out {
+			out[i].MemberCount = counts[out[i].ID]
+			out[i].Owner = ownerMap[out[i].OwnerUserID]
+		}
+	}
+
+	return out, paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *organizationRepository) memberCounts(ctx context.Context, orgIDs []int64) (map[int64]int, error) {
+	var rows []struct {
+		OrganizationID int64 `json:"organization_id"`
+		Count          int   `json:"count"`
+	}
+	err := r.client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDIn(orgIDs...)).
+		GroupBy(organizationmember.FieldOrganizationID).
+		Aggregate(dbent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.OrganizationID] = row.Count
+	}
+	return counts, nil
+}
+
+// UpdateBalance 在同一条语句内更新组织余额并追加组织钱包流水，保证账本合计始终等于 organizations.balance
+func (r *organizationRepository) UpdateBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
+	client := clientFromContext(ctx, r.client)
+	query := `
+		WITH updated AS (
+			UPDATE organizations
+			SET balance = balance + $2::decimal(20,8), updated_at = NOW()
+			WHERE id = $1
+			RETURNING id, balance
+		)
+		INSERT INTO organization_balance_transactions (organization_id, amount, balance_after, source_type, reference_id, actor_user_id, notes, created_at)
+		SELECT id, $2::decimal(20,8), balance, $3, $4, $5, $6, NOW() FROM updated
+		RETURNING id`
+	var txID int64
+	err := scanSingleRow(ctx, client, query, []any{
+		id,
+		amount,
+		source.Type,
+		source.ReferenceID,
+		source.ActorUserID,
+		source.Notes,
+	}, &txID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return service.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return translatePersistenceError(err, service.ErrOrganizationNotFound, nil)
+	}
+	return nil
+}
+
+// DeductBalance 扣除组织余额
+// 透支策略：允许余额变为负数，确保当前请求能够完成；计费资格检查会阻止余额 <= 0 的组织发起后续请求
+func (r *organizationRepository) DeductBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
+	return r.UpdateBalance(ctx, id, -amount, source)
+}
+
+func (r *organizationRepository) ListBalanceTransactions(ctx context.Context, organizationID int64, params pagination.PaginationParams) ([]service.OrganizationBalanceTransaction, *pagination.PaginationResult, error) {
+	client := clientFromContext(ctx, r.client)
+	var total int64
+	if err := scanSingleRow(ctx, client,
+		`SELECT COUNT(*) FROM organization_balance_transactions WHERE organization_id = $1`,
+		[]any{organizationID}, &total); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := client.QueryContext(ctx, `
+		SELECT id, organization_id, amount, balance_after, source_type, reference_id, actor_user_id, notes, created_at
+		FROM organization_balance_transactions
+		WHERE organization_id = $1
+		ORDER BY id DESC
+		OFFSET $2 LIMIT $3`, organizationID, params.Offset(), params.Limit())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.OrganizationBalanceTransaction, 0)
+	for rows.Next() {
+		var (
+			t     service.OrganizationBalanceTransaction
+			actor sql.NullInt64
+		)
+		if err := rows.Scan(&t.ID, &t.OrganizationID, &t.Amount, &t.BalanceAfter, &t.SourceType, &t.ReferenceID, &actor, &t.Notes, &t.CreatedAt); err != nil {
+			return nil, nil, err
+		}
+		if actor.Valid {
+			t.ActorUserID = &actor.Int64
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func (r *organizationRepository) AddMember(ctx context.Context, member *service.OrganizationMember) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.OrganizationMember.Create().
+		SetOrganizationID(member.OrganizationID).
+		SetUserID(member.UserID).
+		SetRole(member.Role).
+		SetNillableMonthlyLimitUsd(member.MonthlyLimitUSD)
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, nil, service.ErrUserAlreadyInOrganization)
+	}
+
+	member.ID = created.ID
+	member.JoinedAt = created.JoinedAt
+	member.CreatedAt = created.CreatedAt
+	member.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *organizationRepository) GetMemberByUserID(ctx context.Context, userID int64) (*service.OrganizationMember, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.OrganizationMember.Query().
+		Where(organizationmember.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrOrganizationMemberNotFound, nil)
+	}
+	return organizationMemberEntityToService(m), nil
+}
+
+func (r *organizationRepository) UpdateMember(ctx context.Context, member *service.OrganizationMember) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.OrganizationMember.UpdateOneID(member.ID).
+		SetRole(member.Role)
+	if member.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*member.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrOrganizationMemberNotFound, nil)
+	}
+	member.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *organizationRepository) RemoveMember(ctx context.Context, organizationID, userID int64) error {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.OrganizationMember.Delete().
+		Where(
+			organizationmember.OrganizationIDEQ(organizationID),
+			organizationmember.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+func (r *organizationRepository) ListMembers(ctx context.Context, organizationID int64) ([]service.OrganizationMember, error) {
+	members, err := r.client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(organizationID)).
+		Order(dbent.Asc(organizationmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+	}
+	userMap := make(map[int64]*service.User, len(userIDs))
+	if len(userIDs) > 0 {
+		users, err := r.client.User.Query().Where(dbuser.IDIn(userIDs...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			userMap[u.ID] = userEntityToService(u)
+		}
+	}
+
+	out := make([]service.OrganizationMember, 0, len(members))
+	for _, m := range members {
+		member := organizationMemberEntityToService(m)
+		member.User = userMap[m.UserID]
+		out = append(out, *member)
+	}
+	return out, nil
+}
+
+func (r *organizationRepository) ListMemberUserIDs(ctx context.Context, organizationID int64) ([]int64, error) {
+	client := clientFromContext(ctx, r.client)
+	members, err := client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(organizationID)).
+		Select(organizationmember.FieldUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]int64, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+	}
+	return userIDs, nil
+}
+
+func (r *organizationRepository) IncrementMemberUsage(ctx context.Context, userID int64, amount float64, monthStart time.Time) error {
+	const updateSQL = `
+		UPDATE organization_members
+		SET
+			monthly_usage_usd = CASE
+				WHEN monthly_window_start IS NULL OR monthly_window_start < $3 THEN $1
+				ELSE monthly_usage_usd + $1
+			END,
+			monthly_window_start = CASE
+				WHEN monthly_window_start IS NULL OR monthly_window_start < $3 THEN $3
+				ELSE monthly_window_start
+			END,
+			updated_at = NOW()
+		WHERE user_id = $2
+	`
+
+	client := clientFromContext(ctx, r.client)
+	result, err := client.ExecContext(ctx, updateSQL, amount, userID, monthStart)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+func (r *organizationRepository) GetMemberUsage(ctx context.Context, organizationID int64, startTime, endTime time.Time) (results []service.OrganizationMemberUsage, err error) {
+	// 以当前成员为主表左连接用量，已移出组织的历史成员也按 usage_logs 保留在结果中
+	const query = `
+		WITH usage AS (
+			SELECT
+				user_id,
+				COUNT(*) AS requests,
+				COALESCE(SUM(input_tokens), 0) AS input_tokens,
+				COALESCE(SUM(output_tokens), 0) AS output_tokens,
+				COALESCE(SUM(cache_creation_tokens + cache_read_tokens), 0) AS cache_tokens,
+				COALESCE(SUM(total_cost), 0) AS total_cost,
+				COALESCE(SUM(actual_cost), 0) AS actual_cost
+			FROM usage_logs
+			WHERE organization_id = $1 AND created_at >= $2 AND created_at < $3
+			GROUP BY user_id
+		), members AS (
+			SELECT user_id, role FROM organization_members WHERE organization_id = $1
+		)
+		SELECT
+			COALESCE(m.user_id, u.user_id) AS user_id,
+			COALESCE(usr.email, '') AS email,
+			COALESCE(usr.username, '') AS username,
+			COALESCE(m.role, '') AS role,
+			COALESCE(u.requests, 0),
+			COALESCE(u.input_tokens, 0),
+			COALESCE(u.output_tokens, 0),
+			COALESCE(u.cache_tokens, 0),
+			COALESCE(u.total_cost, 0),
+			COALESCE(u.actual_cost, 0)
+		FROM members m
+		FULL OUTER JOIN usage u ON u.user_id = m.user_id
+		LEFT JOIN users usr ON usr.id = COALESCE(m.user_id, u.user_id)
+		ORDER BY COALESCE(u.actual_cost, 0) DESC, COALESCE(m.user_id, u.user_id) ASC
+	`
+
+	rows, err := r.client.QueryContext(ctx, query, organizationID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			results = nil
+		}
+	}()
+
+	results = make([]service.OrganizationMemberUsage, 0)
+	for rows.Next() {
+		var row service.OrganizationMemberUsage
+		if err := rows.Scan(
+			&row.UserID,
+			&row.Email,
+			&row.Username,
+			&row.Role,
+			&row.Requests,
+			&row.InputTokens,
+			&row.OutputTokens,
+			&row.CacheTokens,
+			&row.TotalCost,
+			&row.ActualCost,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// loadOrganizationMembership 查询用户所属组织的计费视图（供 API Key 认证使用）；不属于任何组织时返回 nil
+func loadOrganizationMembership(ctx context.Context, client *dbent.Client, userID int64) (*service.OrganizationMembership, error) {
+	m, err := client.OrganizationMember.Query().
+		Where(organizationmember.UserIDEQ(userID)).
+		WithOrganization(func(q *dbent.OrganizationQuery) {
+			q.Select(
+				organization.FieldID,
+				organization.FieldOwnerUserID,
+				organization.FieldStatus,
+				organization.FieldConcurrency,
+			)
+		}).
+		Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	org := m.Edges.Organization
+	if org == nil {
+		// 组织已软删除
+		return nil, nil
+	}
+	return &service.OrganizationMembership{
+		OrganizationID:  org.ID,
+		OwnerUserID:     org.OwnerUserID,
+		Status:          org.Status,
+		Concurrency:     org.Concurrency,
+		Role:            m.Role,
+		MonthlyLimitUSD: m.MonthlyLimitUsd,
+	}, nil
+}
+
+func organizationEntityToService(m *dbent.Organization) *service.Organization {
+	if m == nil {
+		return nil
+	}
+	return &service.Organization{
+		ID:          m.ID,
+		Name:        m.Name,
+		OwnerUserID: m.OwnerUserID,
+		Balance:     m.Balance,
+		Concurrency: m.Concurrency,
+		Status:      m.Status,
+		Notes:       m.Notes,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func organizationMemberEntityToService(m *dbent.OrganizationMember) *service.OrganizationMember {
+	if m == nil {
+		return nil
+	}
+	return &service.OrganizationMember{
+		ID:                 m.ID,
+		OrganizationID:     m.OrganizationID,
+		UserID:             m.UserID,
+		Role:               m.Role,
+		MonthlyLimitUSD:    m.MonthlyLimitUsd,
+		MonthlyUsageUSD:    m.MonthlyUsageUsd,
+		MonthlyWindowStart: m.MonthlyWindowStart,
+		JoinedAt:           m.JoinedAt,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationRepository_BalanceChangesAppendLedger(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	repo := NewOrganizationRepository(client)
+
+	owner := &service.User{
+		Email:        "org-ledger-" + time.Now().Format(time.RFC3339Nano) + "@example.com",
+		PasswordHash: "test-password-hash",
+		Role:         service.RoleUser,
+		Status:       service.StatusActive,
+		Concurrency:  5,
+	}
+	require.NoError(t, newUserRepositoryWithSQL(client, integrationDB).Create(ctx, owner))
+
+	org := &service.Organization{Name: "ledger-org", OwnerUserID: owner.ID, Balance: 10, Status: service.StatusActive}
+	require.NoError(t, repo.Create(ctx, org))
+
+	actorID := int64(1)
+	require.NoError(t, repo.UpdateBalance(ctx, org.ID, 2.5, service.BalanceChangeSource{
+		Type:        service.BalanceSourceAdminAdjustment,
+		ActorUserID: &actorID,
+		Notes:       "manual top-up",
+	}))
+	require.NoError(t, repo.DeductBalance(ctx, org.ID, 5, service.BalanceChangeSource{
+		Type:        service.BalanceSourceUsage,
+		ReferenceID: "req-1",
+		ActorUserID: &owner.ID,
+	}))
+
+	got, err := repo.GetByID(ctx, org.ID)
+	require.NoError(t, err)
+	require.InDelta(t, 7.5, got.Balance, 1e-6)
+
+	txs, result, err := repo.ListBalanceTransactions(ctx, org.ID, pagination.PaginationParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, result.Total)
+	require.Len(t, txs, 3)
+
+	// 按时间倒序
+	require.Equal(t, service.BalanceSourceUsage, txs[0].SourceType)
+	require.Equal(t, "req-1", txs[0].ReferenceID)
+	require.Equal(t, owner.ID, *txs[0].ActorUserID)
+	require.InDelta(t, -5.0, txs[0].Amount, 1e-6)
+	require.InDelta(t, 7.5, txs[0].BalanceAfter, 1e-6)
+
+	require.Equal(t, service.BalanceSourceAdminAdjustment, txs[1].SourceType)
+	require.Equal(t, "manual top-up", txs[1].Notes)
+	require.InDelta(t, 12.5, txs[1].BalanceAfter, 1e-6)
+
+	require.Equal(t, service.BalanceSourceInitial, txs[2].SourceType)
+	require.InDelta(t, 10.0, txs[2].BalanceAfter, 1e-6)
+
+	require.ErrorIs(t, repo.UpdateBalance(ctx, org.ID+1000000, 1, service.BalanceChangeSource{Type: service.BalanceSourceAdminAdjustment}), service.ErrOrganizationNotFound)
+}
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+const paymentOrderColumns = `po.id, po.order_no, po.user_id, po.provider, po.method, po.status, po.amount, po.currency,
+	po.rate_multiplier, po.credit_amount, po.provider_session_id, po.provider_txn_id, po.checkout_url,
+	po.failure_reason, po.notes, po.paid_at, po.refunded_at, po.created_at, po.updated_at, po.plan_id,
+	COALESCE(u.email, ''), COALESCE(u.username, ''), COALESCE(sp.name, '')`
+
+const paymentOrderJoins = `LEFT JOIN users u ON u.id = po.user_id LEFT JOIN subscription_plans sp ON sp.id = po.plan_id `
+
+const paymentOrderFrom = ` FROM payment_orders po ` + paymentOrderJoins
+
+type paymentOrderRepository struct {
+	sql sqlExecutor
+}
+
+func NewPaymentOrderRepository(sqlDB *sql.DB) service.PaymentOrderRepository {
+	return &paymentOrderRepository{sql: sqlDB}
+}
+
+// executor 在事务上下文中使用 tx 绑定的执行器，保证状态迁移与余额入账同事务
+func (r *paymentOrderRepository) executor(ctx context.Context) sqlExecutor {
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return r.sql
+}
+
+func (r *paymentOrderRepository) Create(ctx context.Context, order *service.PaymentOrder) error {
+	query := `
+		INSERT INTO payment_orders (
+			order_no, user_id, provider, method, status, amount, currency,
+			rate_multiplier, credit_amount, provider_txn_id, notes, plan_id, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+	err := scanSingleRow(ctx, r.executor(ctx), query, []any{
+		order.OrderNo, order.UserID, order.Provider, order.Method, order.Status, order.Amount, order.Currency,
+		order.RateMultiplier, order.CreditAmount, order.ProviderTxnID, order.Notes, order.PlanID,
+	}, &order.ID, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil && isUniqueConstraintViolation(err) {
+		return service.ErrPaymentTxnConflict
+	}
+	return err
+}
+
+func (r *paymentOrderRepository) GetByID(ctx context.Context, id int64) (*service.PaymentOrder, error) {
+	return r.getOne(ctx, "po.id = $1", id)
+}
+
+func (r *paymentOrderRepository) GetByOrderNo(ctx context.Context, orderNo string) (*service.PaymentOrder, error) {
+	return r.getOne(ctx, "po.order_no = $1", orderNo)
+}
+
+func (r *paymentOrderRepository) GetByProviderTxnID(ctx context.Context, provider, txnID string) (*service.PaymentOrder, error) {
+	return r.getOne(ctx, "po.provider = $1 AND po.provider_txn_id = $2", provider, txnID)
+}
+
+func (r *paymentOrderRepository) getOne(ctx context.Context, cond string, args ...any) (*service.PaymentOrder, error) {
+	rows, err := r.executor(ctx).QueryContext(ctx, `SELECT `+paymentOrderColumns+paymentOrderFrom+`WHERE `+cond, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrPaymentOrderNotFound
+	}
+	order, err := scanPaymentOrder(rows)
+	if err != nil {
+		return nil, err
+	}
+	return order, rows.Err()
+}
+
+func (r *paymentOrderRepository) SetCheckout(ctx context.Context, id int64, sessionID, checkoutURL string) error {
+	res, err := r.executor(ctx).ExecContext(ctx,
+		`UPDATE payment_orders SET provider_session_id = $2, checkout_url = $3, updated_at = NOW() WHERE id = $1`,
+		id, sessionID, checkoutURL)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrPaymentOrderNotFound
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) Transition(ctx context.Context, id int64, from []string, t service.PaymentOrderTransition) (*service.PaymentOrder, error) {
+	query := `
+		WITH po AS (
+			UPDATE payment_orders
+			SET status = $2,
+				provider_txn_id = CASE WHEN $3 <> '' THEN $3 ELSE provider_txn_id END,
+				amount = COALESCE($4, amount),
+				credit_amount = COALESCE($5, credit_amount),
+				failure_reason = CASE WHEN $6 <> '' THEN $6 ELSE failure_reason END,
+				notes = CASE WHEN $7 <> '' THEN $7 ELSE notes END,
+				paid_at = CASE WHEN $2 = 'paid' THEN NOW() ELSE paid_at END,
+				refunded_at = CASE WHEN $2 = 'refunded' THEN NOW() ELSE refunded_at END,
+				updated_at = NOW()
+			WHERE id = $1 AND status = ANY($8)
+			RETURNING *
+		)
+		SELECT ` + paymentOrderColumns + ` FROM po ` + paymentOrderJoins
+	rows, err := r.executor(ctx).QueryContext(ctx, query,
+		id, t.Status, t.ProviderTxnID, t.Amount, t.CreditAmount, t.FailureReason, t.Notes, pq.Array(from))
+	if err != nil {
+		if isUniqueConstraintViolation(err) {
+			return nil, service.ErrPaymentTxnConflict
+		}
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			if isUniqueConstraintViolation(err) {
+				return nil, service.ErrPaymentTxnConflict
+			}
+			return nil, err
+		}
+		return nil, nil
+	}
+	order, err := scanPaymentOrder(rows)
+	if err != nil {
+		return nil, err
+	}
+	return order, rows.Err()
+}
+
+func (r *paymentOrderRepository) List(ctx context.Context, params pagination.PaginationParams, filter service.PaymentOrderFilter) ([]service.PaymentOrder, *pagination.PaginationResult, error) {
+	conditions := make([]string, 0, 4)
+	args := make([]any, 0, 6)
+	addCondition := func(expr string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(expr, len(args)))
+	}
+	if filter.UserID > 0 {
+		addCondition("po.user_id = $%d", filter.UserID)
+	}
+	if filter.Provider != "" {
+		addCondition("po.provider = $%d", filter.Provider)
+	}
+	if filter.Status != "" {
+		addCondition("po.status = $%d", filter.Status)
+	}
+	if filter.Search != "" {
+		addCondition("(po.order_no ILIKE $%[1]d OR po.provider_txn_id ILIKE $%[1]d OR u.email ILIKE $%[1]d)", "%"+filter.Search+"%")
+	}
+	where := buildWhere(conditions)
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*)"+paymentOrderFrom+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.PaymentOrder{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := `SELECT ` + paymentOrderColumns + paymentOrderFrom + where +
+		` ORDER BY po.id DESC LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	orders := make([]service.PaymentOrder, 0)
+	for rows.Next() {
+		order, err := scanPaymentOrder(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		orders = append(orders, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return orders, paginationResultFromTotal(total, params), nil
+}
+
+func scanPaymentOrder(rows *sql.Rows) (*service.PaymentOrder, error) {
+	var (
+		order      service.PaymentOrder
+		paidAt     sql.NullTime
+		refundedAt sql.NullTime
+		planID     sql.NullInt64
+		email      string
+		username   string
+	)
+	if err := rows.Scan(
+		&order.ID,
+		&order.OrderNo,
+		&order.UserID,
+		&order.Provider,
+		&order.Method,
+		&order.Status,
+		&order.Amount,
+		&order.Currency,
+		&order.RateMultiplier,
+		&order.CreditAmount,
+		&order.ProviderSessionID,
+		&order.ProviderTxnID,
+		&order.CheckoutURL,
+		&order.FailureReason,
+		&order.Notes,
+		&paidAt,
+		&refundedAt,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&planID,
+		&email,
+		&username,
+		&order.PlanName,
+	); err != nil {
+		return nil, err
+	}
+	if paidAt.Valid {
+		t := paidAt.Time
+		order.PaidAt = &t
+	}
+	if refundedAt.Valid {
+		t := refundedAt.Time
+		order.RefundedAt = &t
+	}
+	if planID.Valid {
+		id := planID.Int64
+		order.PlanID = &id
+	}
+	if email != "" || username != "" {
+		order.User = &service.User{ID: order.UserID, Email: email, Username: username}
+	}
+	return &order, nil
+}
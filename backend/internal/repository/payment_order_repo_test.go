@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var paymentOrderTestColumns = []string{
+	"id", "order_no", "user_id", "provider", "method", "status", "amount", "currency",
+	"rate_multiplier", "credit_amount", "provider_session_id", "provider_txn_id", "checkout_url",
+	"failure_reason", "notes", "paid_at", "refunded_at", "created_at", "updated_at", "plan_id", "email", "username", "plan_name",
+}
+
+func TestPaymentOrderRepositoryCreateMapsUniqueViolation(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &paymentOrderRepository{sql: db}
+
+	mock.ExpectQuery("INSERT INTO payment_orders").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err := repo.Create(context.Background(), &service.PaymentOrder{OrderNo: "P1", UserID: 1, Provider: "creem", ProviderTxnID: "ch_1"})
+	require.ErrorIs(t, err, service.ErrPaymentTxnConflict)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPaymentOrderRepositoryTransition(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &paymentOrderRepository{sql: db}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	from := []string{service.PaymentStatusPending, service.PaymentStatusFailed}
+
+	mock.ExpectQuery(`UPDATE payment_orders[\s\S]*WHERE id = \$1 AND status = ANY\(\$8\)`).
+		WithArgs(int64(5), service.PaymentStatusPaid, "pi_1", nil, nil, "", "", pq.Array(from)).
+		WillReturnRows(sqlmock.NewRows(paymentOrderTestColumns).AddRow(
+			int64(5), "P5", int64(9), "stripe", "", "paid", 10.0, "USD",
+			1.0, 10.0, "cs_1", "pi_1", "https://checkout.stripe.com/c/1",
+			"", "", now, nil, now, now, nil, "u@example.com", "alice", "",
+		))
+
+	order, err := repo.Transition(context.Background(), 5, from, service.PaymentOrderTransition{
+		Status: service.PaymentStatusPaid, ProviderTxnID: "pi_1",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	require.Equal(t, service.PaymentStatusPaid, order.Status)
+	require.NotNil(t, order.PaidAt)
+	require.NotNil(t, order.User)
+	require.Equal(t, "u@example.com", order.User.Email)
+
+	// 状态不符：不更新任何行，返回 nil
+	mock.ExpectQuery(`UPDATE payment_orders`).
+		WillReturnRows(sqlmock.NewRows(paymentOrderTestColumns))
+	order, err = repo.Transition(context.Background(), 5, from, service.PaymentOrderTransition{Status: service.PaymentStatusPaid})
+	require.NoError(t, err)
+	require.Nil(t, order)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPaymentOrderRepositoryListFilters(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := &paymentOrderRepository{sql: db}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM payment_orders po LEFT JOIN users u ON u.id = po.user_id LEFT JOIN subscription_plans sp ON sp.id = po.plan_id WHERE po.provider = \$1 AND po.status = \$2 AND \(po.order_no ILIKE \$3`).
+		WithArgs("epay", "paid", "%P7%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(1)))
+	mock.ExpectQuery(`ORDER BY po.id DESC LIMIT \$4 OFFSET \$5`).
+		WithArgs("epay", "paid", "%P7%", 20, 0).
+		WillReturnRows(sqlmock.NewRows(paymentOrderTestColumns).AddRow(
+			int64(7), "P7", int64(9), "epay", "alipay", "paid", 70.0, "CNY",
+			0.14, 9.8, "", "T7", "https://pay.example.com/submit.php",
+			"", "", now, nil, now, now, int64(3), "", "", "Pro Monthly",
+		))
+
+	orders, result, err := repo.List(context.Background(), pagination.PaginationParams{Page: 1, PageSize: 20}, service.PaymentOrderFilter{
+		Provider: "epay", Status: "paid", Search: "P7",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Total)
+	require.Len(t, orders, 1)
+	require.Equal(t, "alipay", orders[0].Method)
+	require.InDelta(t, 9.8, orders[0].CreditAmount, 1e-9)
+	require.NotNil(t, orders[0].PlanID)
+	require.Equal(t, "Pro Monthly", orders[0].PlanName)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
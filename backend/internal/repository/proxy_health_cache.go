@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const proxyHealthKeyPrefix = "proxy:health:"
+
+func proxyHealthKey(proxyID int64) string {
+	return fmt.Sprintf("%s%d", proxyHealthKeyPrefix, proxyID)
+}
+
+type proxyHealthCache struct {
+	rdb *redis.Client
+}
+
+func NewProxyHealthCache(rdb *redis.Client) service.ProxyHealthCache {
+	return &proxyHealthCache{rdb: rdb}
+}
+
+func (c *proxyHealthCache) GetProxyHealth(ctx context.Context, proxyIDs []int64) (map[int64]*service.ProxyHealth, error) {
+	results := make(map[int64]*service.ProxyHealth)
+	if len(proxyIDs) == 0 {
+		return results, nil
+	}
+
+	keys := make([]string, 0, len(proxyIDs))
+	for _, id := range proxyIDs {
+		keys = append(keys, proxyHealthKey(id))
+	}
+
+	values, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return results, err
+	}
+
+	for i, raw := range values {
+		if raw == nil {
+			continue
+		}
+		var payload []byte
+		switch v := raw.(type) {
+		case string:
+			payload = []byte(v)
+		case []byte:
+			payload = v
+		default:
+			continue
+		}
+		var health service.ProxyHealth
+		if err := json.Unmarshal(payload, &health); err != nil {
+			continue
+		}
+		results[proxyIDs[i]] = &health
+	}
+
+	return results, nil
+}
+
+func (c *proxyHealthCache) SetProxyHealth(ctx context.Context, proxyID int64, health *service.ProxyHealth) error {
+	if health == nil {
+		return nil
+	}
+	payload, err := json.Marshal(health)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, proxyHealthKey(proxyID), payload, 0).Err()
+}
@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+type proxyPoolRepository struct {
+	client *dbent.Client
+	sql    sqlExecutor
+	cipher *SecretCipher
+}
+
+func NewProxyPoolRepository(client *dbent.Client, sqlDB *sql.DB, cipher *SecretCipher) service.ProxyPoolRepository {
+	return &proxyPoolRepository{client: client, sql: sqlDB, cipher: cipher}
+}
+
+func (r *proxyPoolRepository) Create(ctx context.Context, pool *service.ProxyPool) error {
+	created, err := r.client.ProxyPool.Create().
+		SetName(pool.Name).
+		SetDescription(pool.Description).
+		SetPolicy(pool.Policy).
+		SetStatus(pool.Status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	pool.ID = created.ID
+	pool.CreatedAt = created.CreatedAt
+	pool.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *proxyPoolRepository) GetByID(ctx context.Context, id int64) (*service.ProxyPool, error) {
+	m, err := r.client.ProxyPool.Query().
+		Where(proxypool.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrProxyPoolNotFound, nil)
+	}
+	pools := []service.ProxyPool{*proxyPoolEntityToService(m)}
+	if err := r.attachStats(ctx, pools); err != nil {
+		return nil, err
+	}
+	return &pools[0], nil
+}
+
+func (r *proxyPoolRepository) Update(ctx context.Context, pool *service.ProxyPool) error {
+	updated, err := r.client.ProxyPool.UpdateOneID(pool.ID).
+		SetName(pool.Name).
+		SetDescription(pool.Description).
+		SetPolicy(pool.Policy).
+		SetStatus(pool.Status).
+		Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrProxyPoolNotFound, nil)
+	}
+	pool.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *proxyPoolRepository) Delete(ctx context.Context, id int64) error {
+	n, err := r.client.ProxyPool.Delete().Where(proxypool.IDEQ(id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrProxyPoolNotFound
+	}
+	return nil
+}
+
+func (r *proxyPoolRepository) List(ctx context.Context, params pagination.PaginationParams, status, search string) ([]service.ProxyPool, *pagination.PaginationResult, error) {
+	q := r.client.ProxyPool.Query()
+	if status != "" {
+		q = q.Where(proxypool.StatusEQ(status))
+	}
+	if search != "" {
+		q = q.Where(proxypool.NameContainsFold(search))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	models, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(proxypool.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	pools := proxyPoolEntitiesToService(models)
+	if err := r.attachStats(ctx, pools); err != nil {
+		return nil, nil, err
+	}
+	return pools, paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *proxyPoolRepository) ListActive(ctx context.Context) ([]service.ProxyPool, error) {
+	models, err := r.client.ProxyPool.Query().
+		Where(proxypool.StatusEQ(service.StatusActive)).
+		Order(dbent.Asc(proxypool.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pools := proxyPoolEntitiesToService(models)
+	if err := r.attachStats(ctx, pools); err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+func (r *proxyPoolRepository) SetMembers(ctx context.Context, poolID int64, proxyIDs []int64) error {
+	// 使用事务保证移出旧成员与加入新成员的原子性
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return err
+	}
+
+	var txClient *dbent.Client
+	if err == nil {
+		defer func() { _ = tx.Rollback() }()
+		txClient = tx.Client()
+	} else {
+		// 已处于外部事务中（ErrTxStarted），复用当前 client
+		txClient = r.client
+	}
+
+	if _, err := txClient.Proxy.Update().
+		Where(proxy.PoolIDEQ(poolID), proxy.IDNotIn(proxyIDs...)).
+		ClearPoolID().
+		Save(ctx); err != nil {
+		return err
+	}
+	if len(proxyIDs) > 0 {
+		if _, err := txClient.Proxy.Update().
+			Where(proxy.IDIn(proxyIDs...)).
+			SetPoolID(poolID).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	if tx != nil {
+		return tx.Commit()
+	}
+	return nil
+}
+
+func (r *proxyPoolRepository) ListMembers(ctx context.Context, poolID int64) ([]service.Proxy, error) {
+	models, err := r.client.Proxy.Query().
+		Where(proxy.PoolIDEQ(poolID), proxy.StatusEQ(service.StatusActive)).
+		Order(dbent.Asc(proxy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.Proxy, 0, len(models))
+	for i := range models {
+		p := proxyEntityToService(models[i])
+		if err := openProxyPassword(r.cipher, p); err != nil {
+			return nil, err
+		}
+		out = append(out, *p)
+	}
+	return out, nil
+}
+
+func (r *proxyPoolRepository) CountAccounts(ctx context.Context, poolID int64) (int64, error) {
+	var count int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM accounts WHERE proxy_pool_id = $1 AND deleted_at IS NULL", []any{poolID}, &count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *proxyPoolRepository) ListAssignments(ctx context.Context, poolID int64) ([]service.ProxyPoolAssignment, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id, proxy_id
+		FROM accounts
+		WHERE proxy_pool_id = $1 AND deleted_at IS NULL
+		ORDER BY id
+	`, poolID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.ProxyPoolAssignment, 0)
+	for rows.Next() {
+		var (
+			accountID int64
+			proxyID   sql.NullInt64
+		)
+		if err := rows.Scan(&accountID, &proxyID); err != nil {
+			return nil, err
+		}
+		a := service.ProxyPoolAssignment{AccountID: accountID}
+		if proxyID.Valid {
+			a.ProxyID = &proxyID.Int64
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+func (r *proxyPoolRepository) ReassignAccountProxy(ctx context.Context, accountID, poolID int64, fromProxyID *int64, toProxyID int64) (bool, error) {
+	var from any
+	if fromProxyID != nil {
+		from = *fromProxyID
+	}
+	// 以当前代理作为条件，避免覆盖并发的切换或管理员的修改
+	result, err := r.sql.ExecContext(ctx, `
+		UPDATE accounts
+		SET proxy_id = $1, updated_at = NOW()
+		WHERE id = $2
+			AND proxy_pool_id = $3
+			AND proxy_id IS NOT DISTINCT FROM $4
+			AND deleted_at IS NULL
+	`, toProxyID, accountID, poolID, from)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	if err := enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventAccountChanged, &accountID, nil, nil); err != nil {
+		log.Printf("[SchedulerOutbox] enqueue proxy reassign failed: account=%d err=%v", accountID, err)
+	}
+	return true, nil
+}
+
+// attachStats 批量加载代理池成员与引用账号数
+func (r *proxyPoolRepository) attachStats(ctx context.Context, pools []service.ProxyPool) error {
+	if len(pools) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(pools))
+	for i := range pools {
+		ids = append(ids, pools[i].ID)
+	}
+
+	members, err := r.client.Proxy.Query().
+		Where(proxy.PoolIDIn(ids...)).
+		Order(dbent.Asc(proxy.FieldID)).
+		Select(proxy.FieldID, proxy.FieldPoolID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	memberIDs := make(map[int64][]int64, len(pools))
+	for _, m := range members {
+		if m.PoolID != nil {
+			memberIDs[*m.PoolID] = append(memberIDs[*m.PoolID], m.ID)
+		}
+	}
+
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT proxy_pool_id, COUNT(*)
+		FROM accounts
+		WHERE proxy_pool_id = ANY($1) AND deleted_at IS NULL
+		GROUP BY proxy_pool_id
+	`, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+	accountCounts := make(map[int64]int64, len(pools))
+	for rows.Next() {
+		var poolID, count int64
+		if err := rows.Scan(&poolID, &count); err != nil {
+			return err
+		}
+		accountCounts[poolID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range pools {
+		pools[i].ProxyIDs = memberIDs[pools[i].ID]
+		pools[i].AccountCount = accountCounts[pools[i].ID]
+	}
+	return nil
+}
+
+func proxyPoolEntityToService(m *dbent.ProxyPool) *service.ProxyPool {
+	if m == nil {
+		return nil
+	}
+	return &service.ProxyPool{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Policy:      m.Policy,
+		Status:      m.Status,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func proxyPoolEntitiesToService(models []*dbent.ProxyPool) []service.ProxyPool {
+	out := make([]service.ProxyPool, 0, len(models))
+	for i := range models {
+		if p := proxyPoolEntityToService(models[i]); p != nil {
+			out = append(out, *p)
+		}
+	}
+	return out
+}
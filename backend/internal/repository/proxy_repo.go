@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
@@ -18,28 +19,34 @@ type sqlQuerier interface {
 type proxyRepository struct {
 	client *dbent.Client
 	sql    sqlQuerier
+	cipher *SecretCipher
 }
 
-func NewProxyRepository(client *dbent.Client, sqlDB *sql.DB) service.ProxyRepository {
-	return newProxyRepositoryWithSQL(client, sqlDB)
+func NewProxyRepository(client *dbent.Client, sqlDB *sql.DB, cipher *SecretCipher) service.ProxyRepository {
+	return newProxyRepositoryWithSQL(client, sqlDB, cipher)
 }
 
-func newProxyRepositoryWithSQL(client *dbent.Client, sqlq sqlQuerier) *proxyRepository {
-	return &proxyRepository{client: client, sql: sqlq}
+func newProxyRepositoryWithSQL(client *dbent.Client, sqlq sqlQuerier, cipher *SecretCipher) *proxyRepository {
+	return &proxyRepository{client: client, sql: sqlq, cipher: cipher}
 }
 
 func (r *proxyRepository) Create(ctx context.Context, proxyIn *service.Proxy) error {
+	password, err := r.cipher.sealString(proxyIn.Password)
+	if err != nil {
+		return err
+	}
 	builder := r.client.Proxy.Create().
 		SetName(proxyIn.Name).
 		SetProtocol(proxyIn.Protocol).
 		SetHost(proxyIn.Host).
 		SetPort(proxyIn.Port).
-		SetStatus(proxyIn.Status)
+		SetStatus(proxyIn.Status).
+		SetNillableSubscriptionID(proxyIn.SubscriptionID)
 	if proxyIn.Username != "" {
 		builder.SetUsername(proxyIn.Username)
 	}
-	if proxyIn.Password != "" {
-		builder.SetPassword(proxyIn.Password)
+	if password != "" {
+		builder.SetPassword(password)
 	}
 
 	created, err := builder.Save(ctx)
@@ -57,10 +64,14 @@ func (r *proxyRepository) GetByID(ctx context.Context, id int64) (*service.Proxy
 		}
 		return nil, err
 	}
-	return proxyEntityToService(m), nil
+	return r.toService(m)
 }
 
 func (r *proxyRepository) Update(ctx context.Context, proxyIn *service.Proxy) error {
+	password, err := r.cipher.sealString(proxyIn.Password)
+	if err != nil {
+		return err
+	}
 	builder := r.client.Proxy.UpdateOneID(proxyIn.ID).
 		SetName(proxyIn.Name).
 		SetProtocol(proxyIn.Protocol).
@@ -72,8 +83,8 @@ func (r *proxyRepository) Update(ctx context.Context, proxyIn *service.Proxy) er
 	} else {
 		builder.ClearUsername()
 	}
-	if proxyIn.Password != "" {
-		builder.SetPassword(proxyIn.Password)
+	if password != "" {
+		builder.SetPassword(password)
 	} else {
 		builder.ClearPassword()
 	}
@@ -127,7 +138,11 @@ func (r *proxyRepository) ListWithFilters(ctx context.Context, params pagination
 
 	outProxies := make([]service.Proxy, 0, len(proxies))
 	for i := range proxies {
-		outProxies = append(outProxies, *proxyEntityToService(proxies[i]))
+		out, err := r.toService(proxies[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		outProxies = append(outProxies, *out)
 	}
 
 	return outProxies, paginationResultFromTotal(int64(total), params), nil
@@ -169,7 +184,10 @@ func (r *proxyRepository) ListWithFiltersAndAccountCount(ctx context.Context, pa
 	// Build result with account counts
 	result := make([]service.ProxyWithAccountCount, 0, len(proxies))
 	for i := range proxies {
-		proxyOut := proxyEntityToService(proxies[i])
+		proxyOut, err := r.toService(proxies[i])
+		if err != nil {
+			return nil, nil, err
+		}
 		if proxyOut == nil {
 			continue
 		}
@@ -191,7 +209,11 @@ func (r *proxyRepository) ListActive(ctx context.Context) ([]service.Proxy, erro
 	}
 	outProxies := make([]service.Proxy, 0, len(proxies))
 	for i := range proxies {
-		outProxies = append(outProxies, *proxyEntityToService(proxies[i]))
+		out, err := r.toService(proxies[i])
+		if err != nil {
+			return nil, err
+		}
+		outProxies = append(outProxies, *out)
 	}
 	return outProxies, nil
 }
@@ -208,8 +230,24 @@ func (r *proxyRepository) ExistsByHostPortAuth(ctx context.Context, host string,
 	}
 	if password == "" {
 		q = q.Where(proxy.Or(proxy.PasswordIsNil(), proxy.PasswordEQ("")))
-	} else {
+	} else if !r.cipher.configured() {
 		q = q.Where(proxy.PasswordEQ(password))
+	} else {
+		// 密码可能加密存储（随机 nonce），只能解密后逐个比较
+		candidates, err := q.Where(proxy.PasswordNotNil()).All(ctx)
+		if err != nil {
+			return false, err
+		}
+		for _, candidate := range candidates {
+			stored, err := r.cipher.openString(*candidate.Password)
+			if err != nil {
+				return false, err
+			}
+			if stored == password {
+				return true, nil
+			}
+		}
+		return false, nil
 	}
 
 	count, err := q.Count(ctx)
@@ -313,7 +351,10 @@ func (r *proxyRepository) ListActiveWithAccountCount(ctx context.Context) ([]ser
 	// Build result with account counts
 	result := make([]service.ProxyWithAccountCount, 0, len(proxies))
 	for i := range proxies {
-		proxyOut := proxyEntityToService(proxies[i])
+		proxyOut, err := r.toService(proxies[i])
+		if err != nil {
+			return nil, err
+		}
 		if proxyOut == nil {
 			continue
 		}
@@ -326,6 +367,28 @@ func (r *proxyRepository) ListActiveWithAccountCount(ctx context.Context) ([]ser
 	return result, nil
 }
 
+// toService 转换实体并解密代理密码
+func (r *proxyRepository) toService(m *dbent.Proxy) (*service.Proxy, error) {
+	out := proxyEntityToService(m)
+	if err := openProxyPassword(r.cipher, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// openProxyPassword 解密代理密码（未加密的历史数据原样返回）
+func openProxyPassword(cipher *SecretCipher, p *service.Proxy) error {
+	if p == nil {
+		return nil
+	}
+	password, err := cipher.openString(p.Password)
+	if err != nil {
+		return fmt.Errorf("open password of proxy %d: %w", p.ID, err)
+	}
+	p.Password = password
+	return nil
+}
+
 func proxyEntityToService(m *dbent.Proxy) *service.Proxy {
 	if m == nil {
 		return nil
@@ -337,8 +400,11 @@ func proxyEntityToService(m *dbent.Proxy) *service.Proxy {
 		Host:      m.Host,
 		Port:      m.Port,
 		Status:    m.Status,
+		PoolID:    m.PoolID,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+
+		SubscriptionID: m.SubscriptionID,
 	}
 	if m.Username != nil {
 		out.Username = *m.Username
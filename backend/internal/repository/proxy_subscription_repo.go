@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxysubscription"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type proxySubscriptionRepository struct {
+	client *dbent.Client
+	cipher *SecretCipher
+}
+
+func NewProxySubscriptionRepository(client *dbent.Client, cipher *SecretCipher) service.ProxySubscriptionRepository {
+	return &proxySubscriptionRepository{client: client, cipher: cipher}
+}
+
+func (r *proxySubscriptionRepository) Create(ctx context.Context, sub *service.ProxySubscription) error {
+	created, err := r.client.ProxySubscription.Create().
+		SetName(sub.Name).
+		SetURL(sub.URL).
+		SetRefreshIntervalMinutes(sub.RefreshIntervalMinutes).
+		SetNillablePoolID(sub.PoolID).
+		SetStatus(sub.Status).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	sub.ID = created.ID
+	sub.CreatedAt = created.CreatedAt
+	sub.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *proxySubscriptionRepository) GetByID(ctx context.Context, id int64) (*service.ProxySubscription, error) {
+	m, err := r.client.ProxySubscription.Query().
+		Where(proxysubscription.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrProxySubscriptionNotFound, nil)
+	}
+	subs := []service.ProxySubscription{*proxySubscriptionEntityToService(m)}
+	if err := r.attachProxyCounts(ctx, subs); err != nil {
+		return nil, err
+	}
+	return &subs[0], nil
+}
+
+func (r *proxySubscriptionRepository) Update(ctx context.Context, sub *service.ProxySubscription) error {
+	builder := r.client.ProxySubscription.UpdateOneID(sub.ID).
+		SetName(sub.Name).
+		SetURL(sub.URL).
+		SetRefreshIntervalMinutes(sub.RefreshIntervalMinutes).
+		SetStatus(sub.Status)
+	if sub.PoolID != nil {
+		builder.SetPoolID(*sub.PoolID)
+	} else {
+		builder.ClearPoolID()
+	}
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrProxySubscriptionNotFound, nil)
+	}
+	sub.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+// Delete 删除订阅；已导入的代理保留为普通代理
+func (r *proxySubscriptionRepository) Delete(ctx context.Context, id int64) error {
+	if _, err := r.client.Proxy.Update().
+		Where(proxy.SubscriptionIDEQ(id)).
+		ClearSubscriptionID().
+		Save(ctx); err != nil {
+		return err
+	}
+	n, err := r.client.ProxySubscription.Delete().Where(proxysubscription.IDEQ(id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrProxySubscriptionNotFound
+	}
+	return nil
+}
+
+func (r *proxySubscriptionRepository) List(ctx context.Context) ([]service.ProxySubscription, error) {
+	models, err := r.client.ProxySubscription.Query().
+		Order(dbent.Desc(proxysubscription.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.ProxySubscription, 0, len(models))
+	for i := range models {
+		out = append(out, *proxySubscriptionEntityToService(models[i]))
+	}
+	if err := r.attachProxyCounts(ctx, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *proxySubscriptionRepository) UpdateSyncResult(ctx context.Context, id int64, syncedAt time.Time, result *service.ProxyImportResult, syncErr string) error {
+	builder := r.client.ProxySubscription.UpdateOneID(id).
+		SetLastSyncedAt(syncedAt).
+		SetLastError(syncErr)
+	if result != nil {
+		builder.
+			SetLastTotal(result.Total).
+			SetLastCreated(result.Created).
+			SetLastSkipped(len(result.Skipped)).
+			SetLastDisabled(result.Disabled)
+	}
+	_, err := builder.Save(ctx)
+	return translatePersistenceError(err, service.ErrProxySubscriptionNotFound, nil)
+}
+
+func (r *proxySubscriptionRepository) ListProxies(ctx context.Context, id int64) ([]service.Proxy, error) {
+	models, err := r.client.Proxy.Query().
+		Where(proxy.SubscriptionIDEQ(id)).
+		Order(dbent.Asc(proxy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.Proxy, 0, len(models))
+	for i := range models {
+		p := proxyEntityToService(models[i])
+		if err := openProxyPassword(r.cipher, p); err != nil {
+			return nil, err
+		}
+		out = append(out, *p)
+	}
+	return out, nil
+}
+
+func (r *proxySubscriptionRepository) attachProxyCounts(ctx context.Context, subs []service.ProxySubscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(subs))
+	for i := range subs {
+		ids = append(ids, subs[i].ID)
+	}
+	proxies, err := r.client.Proxy.Query().
+		Where(proxy.SubscriptionIDIn(ids...)).
+		Select(proxy.FieldID, proxy.FieldSubscriptionID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	counts := make(map[int64]int64, len(subs))
+	for _, p := range proxies {
+		if p.SubscriptionID != nil {
+			counts[*p.SubscriptionID]++
+		}
+	}
+	for i := range subs {
+		subs[i].ProxyCount = counts[subs[i].ID]
+	}
+	return nil
+}
+
+func proxySubscriptionEntityToService(m *dbent.ProxySubscription) *service.ProxySubscription {
+	if m == nil {
+		return nil
+	}
+	return &service.ProxySubscription{
+		ID:                     m.ID,
+		Name:                   m.Name,
+		URL:                    m.URL,
+		RefreshIntervalMinutes: m.RefreshIntervalMinutes,
+		PoolID:                 m.PoolID,
+		Status:                 m.Status,
+		CreatedAt:              m.CreatedAt,
+		UpdatedAt:              m.UpdatedAt,
+		LastSyncedAt:           m.LastSyncedAt,
+		LastError:              m.LastError,
+		LastTotal:              m.LastTotal,
+		LastCreated:            m.LastCreated,
+		LastSkipped:            m.LastSkipped,
+		LastDisabled:           m.LastDisabled,
+	}
+}
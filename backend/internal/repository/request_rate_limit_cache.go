@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// 请求速率限制缓存常量定义
+//
+// 设计说明：
+// 每个主体的每个维度使用一个 Redis Hash 记录滑动窗口内的计数：
+// - Key: ratelimit:{rpm|itpm|otpm}:{scope}
+// - Field: Unix 秒级时间戳
+// - Value: 该秒内的请求数/token 数
+//
+// 访问时清理窗口外的字段，窗口内求和即为当前用量；Key 过期时间为窗口 + 10 秒。
+const (
+	rateLimitRPMKeyPrefix  = "ratelimit:rpm:"
+	rateLimitITPMKeyPrefix = "ratelimit:itpm:"
+	rateLimitOTPMKeyPrefix = "ratelimit:otpm:"
+)
+
+var (
+	// rateLimitAcquireScript 原子地检查所有主体的 RPM/TPM 限制，全部通过时 RPM 计数 +1 并预占输入 token
+	// 使用 Redis TIME 命令获取服务器时间，避免多实例时钟不同步
+	// KEYS = 每个主体依次为 rpm/itpm/otpm 三个 key
+	// ARGV[1] = 窗口（秒）
+	// ARGV[2] = 预占的输入 token 数
+	// ARGV[3..] = 与 KEYS 一一对应的限制值（0 表示该维度不限制，跳过）
+	// 返回: {allowed, now, used_1, oldest_1, used_2, oldest_2, ...}，oldest 为窗口内最早的时间戳（无记录时为 0）
+	rateLimitAcquireScript = redis.NewScript(`
+		local window = tonumber(ARGV[1])
+		local reserve = tonumber(ARGV[2])
+		local now = tonumber(redis.call('TIME')[1])
+		local windowStart = now - window
+
+		local result = {1, now}
+		for i, key in ipairs(KEYS) do
+			local limit = tonumber(ARGV[i + 2])
+			local used = 0
+			local oldest = 0
+			if limit > 0 then
+				local entries = redis.call('HGETALL', key)
+				for j = 1, #entries, 2 do
+					local ts = tonumber(entries[j])
+					if ts == nil or ts <= windowStart then
+						redis.call('HDEL', key, entries[j])
+					else
+						used = used + tonumber(entries[j + 1])
+						if oldest == 0 or ts < oldest then
+							oldest = ts
+						end
+					end
+				end
+				if used >= limit then
+					result[1] = 0
+				elseif i % 3 == 2 and used > 0 and used + reserve > limit then
+					-- 输入 TPM：已用 + 预占超出限制时拒绝
+					result[1] = 0
+				end
+			end
+			result[2 * i + 1] = used
+			result[2 * i + 2] = oldest
+		end
+
+		if result[1] == 1 then
+			-- 全部通过，为配置了 RPM 的主体计数 +1（每个主体的第一个 key 为 rpm），
+			-- 为配置了输入 TPM 的主体预占输入 token（第二个 key 为 itpm）
+			for i = 1, #KEYS, 3 do
+				if tonumber(ARGV[i + 2]) > 0 then
+					redis.call('HINCRBY', KEYS[i], now, 1)
+					redis.call('EXPIRE', KEYS[i], window + 10)
+				end
+				if reserve > 0 and tonumber(ARGV[i + 3]) > 0 then
+					redis.call('HINCRBY', KEYS[i + 1], now, reserve)
+					redis.call('EXPIRE', KEYS[i + 1], window + 10)
+				end
+			end
+		end
+		return result
+	`)
+
+	// rateLimitSettleTokensScript 结算 token 用量
+	// KEYS = 需要结算的 itpm/otpm key
+	// ARGV[1] = 窗口（秒）
+	// ARGV[2] = 预占所在的时间戳
+	// ARGV[2+2i-1], ARGV[2+2i] = 第 i 个 key 的模式与数值：
+	//   r = 修正预占（数值为 实际 - 预占），预占仍在窗口内时在原时间戳上修正，否则仅补记超出部分
+	//   a = 在当前时间累加
+	rateLimitSettleTokensScript = redis.NewScript(`
+		local window = tonumber(ARGV[1])
+		local reservedAt = ARGV[2]
+		local now = redis.call('TIME')[1]
+		for i, key in ipairs(KEYS) do
+			local mode = ARGV[2 * i + 1]
+			local value = tonumber(ARGV[2 * i + 2])
+			if mode == 'r' and redis.call('HEXISTS', key, reservedAt) == 1 then
+				if redis.call('HINCRBY', key, reservedAt, value) <= 0 then
+					redis.call('HDEL', key, reservedAt)
+				end
+			elseif value > 0 then
+				redis.call('HINCRBY', key, now, value)
+			end
+			redis.call('EXPIRE', key, window + 10)
+		end
+		return 1
+	`)
+)
+
+type requestRateLimitCache struct {
+	rdb *redis.Client
+}
+
+// NewRequestRateLimitCache 创建请求速率限制缓存
+func NewRequestRateLimitCache(rdb *redis.Client) service.RequestRateLimitCache {
+	return &requestRateLimitCache{rdb: rdb}
+}
+
+func (c *requestRateLimitCache) Acquire(ctx context.Context, scopes []service.RateLimitScope, inputTokens int, window time.Duration) (*service.RateLimitAcquireResult, error) {
+	windowSeconds := rateLimitWindowSeconds(window)
+	if inputTokens < 0 {
+		inputTokens = 0
+	}
+	keys := make([]string, 0, len(scopes)*3)
+	args := make([]any, 0, len(scopes)*3+2)
+	args = append(args, windowSeconds, inputTokens)
+	for _, scope := range scopes {
+		keys = append(keys,
+			rateLimitRPMKeyPrefix+scope.Name,
+			rateLimitITPMKeyPrefix+scope.Name,
+			rateLimitOTPMKeyPrefix+scope.Name,
+		)
+		args = append(args, scope.Limits.RPM, scope.Limits.InputTPM, scope.Limits.OutputTPM)
+	}
+
+	values, err := rateLimitAcquireScript.Run(ctx, c.rdb, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("request rate limit acquire: %w", err)
+	}
+	if len(values) != 2+len(keys)*2 {
+		return nil, fmt.Errorf("request rate limit acquire: unexpected result length %d", len(values))
+	}
+
+	allowed := values[0] == 1
+	now := values[1]
+	result := &service.RateLimitAcquireResult{
+		Allowed: allowed,
+		States:  make([]service.RateLimitScopeState, len(scopes)),
+	}
+	if allowed {
+		result.ReservedAt = now
+	}
+	for i, scope := range scopes {
+		limits := []int{scope.Limits.RPM, scope.Limits.InputTPM, scope.Limits.OutputTPM}
+		dimensions := []string{
+			service.RateLimitDimensionRequests,
+			service.RateLimitDimensionInputTokens,
+			service.RateLimitDimensionOutputTokens,
+		}
+		windows := make([]service.RateLimitWindow, 3)
+		for d := 0; d < 3; d++ {
+			idx := 2 + (i*3+d)*2
+			used, oldest := values[idx], values[idx+1]
+			limit := limits[d]
+			if limit <= 0 {
+				continue
+			}
+			// 本次请求已计入 RPM 与输入 token 预占
+			if allowed && (d == 0 || (d == 1 && inputTokens > 0)) {
+				if d == 0 {
+					used++
+				} else {
+					used += int64(inputTokens)
+				}
+				if oldest == 0 {
+					oldest = now
+				}
+			}
+			over := int(used) >= limit
+			if !allowed && d == 1 && used > 0 && int(used)+inputTokens > limit {
+				over = true
+			}
+			reset := window
+			if oldest > 0 {
+				reset = time.Duration(oldest+windowSeconds-now) * time.Second
+			}
+			remaining := limit - int(used)
+			if remaining < 0 {
+				remaining = 0
+			}
+			windows[d] = service.RateLimitWindow{Limit: limit, Remaining: remaining, Reset: reset}
+			if !allowed && over && (result.RejectedScope == "" || reset > result.RetryAfter) {
+				result.RejectedScope = scope.Name
+				result.RejectedDimension = dimensions[d]
+				result.RetryAfter = reset
+			}
+		}
+		result.States[i] = service.RateLimitScopeState{
+			Requests:     windows[0],
+			InputTokens:  windows[1],
+			OutputTokens: windows[2],
+		}
+	}
+	return result, nil
+}
+
+func (c *requestRateLimitCache) SettleTokens(ctx context.Context, scopes []service.RateLimitScope, reservedAt int64, reservedInput, inputTokens, outputTokens int, window time.Duration) error {
+	keys := make([]string, 0, len(scopes)*2)
+	args := make([]any, 0, len(scopes)*4+2)
+	args = append(args, rateLimitWindowSeconds(window), reservedAt)
+	for _, scope := range scopes {
+		if scope.Limits.InputTPM > 0 {
+			if reservedInput > 0 && inputTokens != reservedInput {
+				keys = append(keys, rateLimitITPMKeyPrefix+scope.Name)
+				args = append(args, "r", inputTokens-reservedInput)
+			} else if reservedInput <= 0 && inputTokens > 0 {
+				keys = append(keys, rateLimitITPMKeyPrefix+scope.Name)
+				args = append(args, "a", inputTokens)
+			}
+		}
+		if scope.Limits.OutputTPM > 0 && outputTokens > 0 {
+			keys = append(keys, rateLimitOTPMKeyPrefix+scope.Name)
+			args = append(args, "a", outputTokens)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := rateLimitSettleTokensScript.Run(ctx, c.rdb, keys, args...).Err(); err != nil {
+		return fmt.Errorf("request rate limit settle tokens: %w", err)
+	}
+	return nil
+}
+
+func rateLimitWindowSeconds(window time.Duration) int64 {
+	seconds := int64(window / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
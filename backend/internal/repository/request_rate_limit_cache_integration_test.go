@@ -0,0 +1,121 @@
+//go:build integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestRateLimitCacheSuite struct {
+	IntegrationRedisSuite
+	cache service.RequestRateLimitCache
+}
+
+func (s *RequestRateLimitCacheSuite) SetupTest() {
+	s.IntegrationRedisSuite.SetupTest()
+	s.cache = NewRequestRateLimitCache(s.rdb)
+}
+
+func (s *RequestRateLimitCacheSuite) TestAcquire_RPMLimit() {
+	scopes := []service.RateLimitScope{{Name: "key:1", Limits: service.RequestRateLimits{RPM: 2}}}
+
+	for i := 0; i < 2; i++ {
+		result, err := s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+		require.NoError(s.T(), err, "Acquire %d", i)
+		require.True(s.T(), result.Allowed, "request %d should be allowed", i)
+		require.Equal(s.T(), 2, result.States[0].Requests.Limit)
+		require.Equal(s.T(), 1-i, result.States[0].Requests.Remaining)
+	}
+
+	result, err := s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err, "Acquire over limit")
+	require.False(s.T(), result.Allowed)
+	require.Equal(s.T(), "key:1", result.RejectedScope)
+	require.Equal(s.T(), service.RateLimitDimensionRequests, result.RejectedDimension)
+	require.Greater(s.T(), result.RetryAfter, time.Duration(0))
+	require.LessOrEqual(s.T(), result.RetryAfter, time.Minute)
+
+	ttl, err := s.rdb.TTL(s.ctx, rateLimitRPMKeyPrefix+"key:1").Result()
+	require.NoError(s.T(), err, "TTL rpm key")
+	s.AssertTTLWithin(ttl, time.Minute, time.Minute+10*time.Second)
+}
+
+func (s *RequestRateLimitCacheSuite) TestAcquire_RejectionDoesNotCountOtherScopes() {
+	scopes := []service.RateLimitScope{
+		{Name: "user:1", Limits: service.RequestRateLimits{RPM: 10}},
+		{Name: "key:2", Limits: service.RequestRateLimits{RPM: 1}},
+	}
+
+	result, err := s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err)
+	require.True(s.T(), result.Allowed)
+
+	result, err = s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err)
+	require.False(s.T(), result.Allowed)
+	require.Equal(s.T(), "key:2", result.RejectedScope)
+	// 被拒绝的请求不占用用户级额度
+	require.Equal(s.T(), 9, result.States[0].Requests.Remaining)
+}
+
+func (s *RequestRateLimitCacheSuite) TestAddTokens_OutputTPMLimit() {
+	scopes := []service.RateLimitScope{{Name: "group:3", Limits: service.RequestRateLimits{InputTPM: 1000, OutputTPM: 100}}}
+
+	result, err := s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err)
+	require.True(s.T(), result.Allowed)
+	require.Equal(s.T(), 100, result.States[0].OutputTokens.Remaining)
+
+	require.NoError(s.T(), s.cache.SettleTokens(s.ctx, scopes, 0, 0, 400, 150, time.Minute), "SettleTokens")
+
+	result, err = s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err)
+	require.False(s.T(), result.Allowed)
+	require.Equal(s.T(), service.RateLimitDimensionOutputTokens, result.RejectedDimension)
+	require.Equal(s.T(), 600, result.States[0].InputTokens.Remaining)
+	require.Equal(s.T(), 0, result.States[0].OutputTokens.Remaining)
+
+	// 未配置 RPM 时不写入请求计数
+	exists, err := s.rdb.Exists(s.ctx, rateLimitRPMKeyPrefix+"group:3").Result()
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), exists)
+}
+
+func (s *RequestRateLimitCacheSuite) TestAcquire_ReservesInputTokens() {
+	scopes := []service.RateLimitScope{{Name: "key:4", Limits: service.RequestRateLimits{InputTPM: 1000}}}
+
+	// 预占计入输入 TPM
+	result, err := s.cache.Acquire(s.ctx, scopes, 600, time.Minute)
+	require.NoError(s.T(), err)
+	require.True(s.T(), result.Allowed)
+	require.Positive(s.T(), result.ReservedAt)
+	require.Equal(s.T(), 400, result.States[0].InputTokens.Remaining)
+
+	// 已用 + 预占超出限制时拒绝，且不占用额度
+	rejected, err := s.cache.Acquire(s.ctx, scopes, 500, time.Minute)
+	require.NoError(s.T(), err)
+	require.False(s.T(), rejected.Allowed)
+	require.Equal(s.T(), service.RateLimitDimensionInputTokens, rejected.RejectedDimension)
+
+	// 结算将预占修正为实际值，释放的额度可被后续请求使用
+	require.NoError(s.T(), s.cache.SettleTokens(s.ctx, scopes, result.ReservedAt, 600, 100, 0, time.Minute), "SettleTokens")
+	result, err = s.cache.Acquire(s.ctx, scopes, 500, time.Minute)
+	require.NoError(s.T(), err)
+	require.True(s.T(), result.Allowed)
+	require.Equal(s.T(), 400, result.States[0].InputTokens.Remaining)
+
+	// 未记录用量时释放全部预占
+	require.NoError(s.T(), s.cache.SettleTokens(s.ctx, scopes, result.ReservedAt, 500, 0, 0, time.Minute), "SettleTokens release")
+	result, err = s.cache.Acquire(s.ctx, scopes, 0, time.Minute)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 900, result.States[0].InputTokens.Remaining)
+}
+
+func TestRequestRateLimitCacheSuite(t *testing.T) {
+	suite.Run(t, new(RequestRateLimitCacheSuite))
+}
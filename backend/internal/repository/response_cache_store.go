@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultResponseCacheKeyPrefix = "response_cache:"
+	// 写入时按该概率顺带清理过期行，避免 PostgreSQL 后端需要独立的清理任务
+	responseCachePurgeProbability = 0.01
+	responseCachePurgeBatchSize   = 500
+)
+
+// ProvideResponseCacheStore 按配置选择响应缓存存储后端
+func ProvideResponseCacheStore(rdb *redis.Client, db *sql.DB, cfg *config.Config) service.ResponseCacheStore {
+	if cfg != nil && cfg.RespCache.Backend == config.ResponseCacheBackendPostgres {
+		return NewResponseCachePostgresStore(db)
+	}
+	prefix := defaultResponseCacheKeyPrefix
+	if cfg != nil && cfg.RespCache.KeyPrefix != "" {
+		prefix = cfg.RespCache.KeyPrefix
+	}
+	return NewResponseCacheRedisStore(rdb, prefix)
+}
+
+type responseCacheRedisStore struct {
+	rdb    *redis.Client
+	prefix string
+}
+
+func NewResponseCacheRedisStore(rdb *redis.Client, prefix string) service.ResponseCacheStore {
+	return &responseCacheRedisStore{rdb: rdb, prefix: prefix}
+}
+
+func (s *responseCacheRedisStore) Get(ctx context.Context, key string) (*service.ResponseCacheEntry, error) {
+	raw, err := s.rdb.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry service.ResponseCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *responseCacheRedisStore) Set(ctx context.Context, key string, entry *service.ResponseCacheEntry, ttl time.Duration) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return s.rdb.Set(ctx, s.prefix+key, raw, ttl).Err()
+}
+
+type responseCachePostgresStore struct {
+	db *sql.DB
+}
+
+func NewResponseCachePostgresStore(db *sql.DB) service.ResponseCacheStore {
+	return &responseCachePostgresStore{db: db}
+}
+
+func (s *responseCachePostgresStore) Get(ctx context.Context, key string) (*service.ResponseCacheEntry, error) {
+	var raw []byte
+	err := s.db.QueryRowContext(ctx,
+		`SELECT payload FROM response_cache_entries WHERE cache_key = $1 AND expires_at > NOW()`,
+		key,
+	).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry service.ResponseCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *responseCachePostgresStore) Set(ctx context.Context, key string, entry *service.ResponseCacheEntry, ttl time.Duration) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(ttl)
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO response_cache_entries (cache_key, payload, expires_at, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (cache_key) DO UPDATE
+		SET payload = EXCLUDED.payload, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+	`, key, raw, expiresAt); err != nil {
+		return err
+	}
+
+	if mathrand.Float64() < responseCachePurgeProbability {
+		if _, err := s.db.ExecContext(ctx, `
+			DELETE FROM response_cache_entries
+			WHERE cache_key IN (
+				SELECT cache_key FROM response_cache_entries
+				WHERE expires_at <= NOW()
+				LIMIT $1
+			)
+		`, responseCachePurgeBatchSize); err != nil {
+			log.Printf("[ResponseCache] purge expired entries failed: %v", err)
+		}
+	}
+	return nil
+}
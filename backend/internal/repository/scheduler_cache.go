@@ -24,10 +24,12 @@ const (
 
 type schedulerCache struct {
 	rdb *redis.Client
+	// cipher 启用静态加密时，账号凭证与代理密码以密文写入快照，读取时再解密
+	cipher *SecretCipher
 }
 
-func NewSchedulerCache(rdb *redis.Client) service.SchedulerCache {
-	return &schedulerCache{rdb: rdb}
+func NewSchedulerCache(rdb *redis.Client, cipher *SecretCipher) service.SchedulerCache {
+	return &schedulerCache{rdb: rdb, cipher: cipher}
 }
 
 func (c *schedulerCache) GetSnapshot(ctx context.Context, bucket service.SchedulerBucket) ([]*service.Account, bool, error) {
@@ -75,7 +77,7 @@ func (c *schedulerCache) GetSnapshot(ctx context.Context, bucket service.Schedul
 		if val == nil {
 			return nil, false, nil
 		}
-		account, err := decodeCachedAccount(val)
+		account, err := c.decodeAccount(val)
 		if err != nil {
 			return nil, false, err
 		}
@@ -99,8 +101,9 @@ func (c *schedulerCache) SetSnapshot(ctx context.Context, bucket service.Schedul
 	snapshotKey := schedulerSnapshotKey(bucket, versionStr)
 
 	pipe := c.rdb.Pipeline()
-	for _, account := range accounts {
-		payload, err := json.Marshal(account)
+	for i := range accounts {
+		account := &accounts[i]
+		payload, err := c.encodeAccount(account)
 		if err != nil {
 			return err
 		}
@@ -142,14 +145,14 @@ func (c *schedulerCache) GetAccount(ctx context.Context, accountID int64) (*serv
 	if err != nil {
 		return nil, err
 	}
-	return decodeCachedAccount(val)
+	return c.decodeAccount(val)
 }
 
 func (c *schedulerCache) SetAccount(ctx context.Context, account *service.Account) error {
 	if account == nil || account.ID <= 0 {
 		return nil
 	}
-	payload, err := json.Marshal(account)
+	payload, err := c.encodeAccount(account)
 	if err != nil {
 		return err
 	}
@@ -187,6 +190,7 @@ func (c *schedulerCache) UpdateLastUsed(ctx context.Context, updates map[int64]t
 		if val == nil {
 			continue
 		}
+		// 仅更新时间戳，凭证保持快照中的原始（可能为密文）形式
 		account, err := decodeCachedAccount(val)
 		if err != nil {
 			return err
@@ -258,6 +262,42 @@ func ptrTime(t time.Time) *time.Time {
 	return &t
 }
 
+// encodeAccount 序列化账号快照，启用静态加密时凭证与代理密码不以明文写入 Redis
+func (c *schedulerCache) encodeAccount(account *service.Account) ([]byte, error) {
+	if !c.cipher.Enabled() {
+		return json.Marshal(account)
+	}
+	sealed := *account
+	credentials, err := c.cipher.sealCredentials(account.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	sealed.Credentials = credentials
+	if account.Proxy != nil {
+		proxy := *account.Proxy
+		if proxy.Password, err = c.cipher.sealString(proxy.Password); err != nil {
+			return nil, err
+		}
+		sealed.Proxy = &proxy
+	}
+	return json.Marshal(&sealed)
+}
+
+// decodeAccount 反序列化账号快照并解密凭证与代理密码
+func (c *schedulerCache) decodeAccount(val any) (*service.Account, error) {
+	account, err := decodeCachedAccount(val)
+	if err != nil {
+		return nil, err
+	}
+	if account.Credentials, err = c.cipher.openCredentials(account.Credentials); err != nil {
+		return nil, err
+	}
+	if err := openProxyPassword(c.cipher, account.Proxy); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
 func decodeCachedAccount(val any) (*service.Account, error) {
 	var payload []byte
 	switch raw := val.(type) {
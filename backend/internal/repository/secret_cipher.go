@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+const (
+	// secretEnvelopePrefix 加密值前缀
+	// 格式：enc:v1:<主密钥 ID>:base64(nonce + 包裹后的数据密钥):base64(nonce + 密文)
+	secretEnvelopePrefix = "enc:v1:"
+	// credentialsEnvelopeField accounts.credentials 加密后存放信封的字段，列值仍为 JSON 对象：{"$enc": "enc:v1:..."}
+	credentialsEnvelopeField = "$enc"
+
+	secretDataKeySize = 32
+)
+
+var (
+	ErrSecretKeyNotFound    = errors.New("secret encryption key not found")
+	ErrSecretCipherDisabled = errors.New("secret encryption is not configured")
+	errMalformedEnvelope    = errors.New("malformed secret envelope")
+)
+
+// SecretCipher 账号凭证与代理密码的信封加密
+//
+// 每个值使用随机生成的数据密钥（AES-256-GCM）加密，数据密钥再由带版本的主密钥环中的主密钥包裹；
+// 轮换主密钥只需重新包裹数据密钥。启用加密前写入的值按明文读取。
+// nil *SecretCipher 一律按明文存储，且无法解开信封。
+type SecretCipher struct {
+	enabled  bool
+	activeID string
+	keys     map[string]cipher.AEAD
+}
+
+// NewSecretCipher 根据 cfg.SecretEnc 构建主密钥环
+func NewSecretCipher(cfg *config.Config) (*SecretCipher, error) {
+	c := &SecretCipher{
+		enabled:  cfg.SecretEnc.Enabled,
+		activeID: cfg.SecretEnc.ActiveKeyID,
+		keys:     make(map[string]cipher.AEAD, len(cfg.SecretEnc.Keys)),
+	}
+	for _, k := range cfg.SecretEnc.Keys {
+		raw, err := hex.DecodeString(k.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret encryption key %q: %w", k.ID, err)
+		}
+		aead, err := newGCM(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret encryption key %q: %w", k.ID, err)
+		}
+		c.keys[k.ID] = aead
+	}
+	if c.enabled {
+		if _, ok := c.keys[c.activeID]; !ok {
+			return nil, fmt.Errorf("%w: active key %q", ErrSecretKeyNotFound, c.activeID)
+		}
+	}
+	return c, nil
+}
+
+// Enabled 新写入的值是否加密
+func (c *SecretCipher) Enabled() bool {
+	return c != nil && c.enabled
+}
+
+// configured 是否配置了主密钥环，即已存储的值可能是密文
+func (c *SecretCipher) configured() bool {
+	return c != nil && len(c.keys) > 0
+}
+
+// ActiveKeyID 返回新写入值使用的主密钥 ID
+func (c *SecretCipher) ActiveKeyID() string {
+	if c == nil {
+		return ""
+	}
+	return c.activeID
+}
+
+// Seal 使用新数据密钥加密明文，数据密钥由当前主密钥包裹
+func (c *SecretCipher) Seal(plaintext []byte) (string, error) {
+	if !c.Enabled() {
+		return "", ErrSecretCipherDisabled
+	}
+	kek := c.keys[c.activeID]
+
+	dataKey := make([]byte, secretDataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", fmt.Errorf("generate data key: %w", err)
+	}
+	dek, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := sealWithNonce(kek, dataKey, []byte(c.activeID))
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := sealWithNonce(dek, plaintext, nil)
+	if err != nil {
+		return "", err
+	}
+	return secretEnvelopePrefix + c.activeID + ":" +
+		base64.RawStdEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Open 使用密钥环中对应的主密钥解开 Seal 生成的信封
+func (c *SecretCipher) Open(envelope string) ([]byte, error) {
+	keyID, wrapped, ciphertext, err := parseSecretEnvelope(envelope)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := c.unwrapDataKey(keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	dek, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := openWithNonce(dek, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt secret: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Rotate 使用当前主密钥重新包裹信封中的数据密钥，密文本身保持不变
+func (c *SecretCipher) Rotate(envelope string) (string, error) {
+	if !c.Enabled() {
+		return "", ErrSecretCipherDisabled
+	}
+	keyID, wrapped, ciphertext, err := parseSecretEnvelope(envelope)
+	if err != nil {
+		return "", err
+	}
+	if keyID == c.activeID {
+		return envelope, nil
+	}
+	dataKey, err := c.unwrapDataKey(keyID, wrapped)
+	if err != nil {
+		return "", err
+	}
+	rewrapped, err := sealWithNonce(c.keys[c.activeID], dataKey, []byte(c.activeID))
+	if err != nil {
+		return "", err
+	}
+	return secretEnvelopePrefix + c.activeID + ":" +
+		base64.RawStdEncoding.EncodeToString(rewrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// NeedsRotation 启用加密时，已存储的值为明文或由旧主密钥包裹则需要轮换
+func (c *SecretCipher) NeedsRotation(value string) bool {
+	if !c.Enabled() {
+		return false
+	}
+	return !strings.HasPrefix(value, secretEnvelopePrefix+c.activeID+":")
+}
+
+func (c *SecretCipher) unwrapDataKey(keyID string, wrapped []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrSecretCipherDisabled
+	}
+	kek, ok := c.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSecretKeyNotFound, keyID)
+	}
+	dataKey, err := openWithNonce(kek, wrapped, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// sealString 加密字符串列值；空值或未启用加密时原样返回
+func (c *SecretCipher) sealString(value string) (string, error) {
+	if value == "" || !c.Enabled() || isSecretEnvelope(value) {
+		return value, nil
+	}
+	return c.Seal([]byte(value))
+}
+
+// openString 解密字符串列值；明文原样返回
+func (c *SecretCipher) openString(value string) (string, error) {
+	if !isSecretEnvelope(value) {
+		return value, nil
+	}
+	plaintext, err := c.Open(value)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// sealCredentials 将凭证 map 加密为 {"$enc": 信封}
+func (c *SecretCipher) sealCredentials(credentials map[string]any) (map[string]any, error) {
+	if !c.Enabled() || len(credentials) == 0 {
+		return credentials, nil
+	}
+	if _, sealed := credentialsEnvelope(credentials); sealed {
+		return credentials, nil
+	}
+	payload, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := c.Seal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{credentialsEnvelopeField: envelope}, nil
+}
+
+// openCredentials 解开 {"$enc": 信封}；明文 map 原样返回
+func (c *SecretCipher) openCredentials(credentials map[string]any) (map[string]any, error) {
+	envelope, sealed := credentialsEnvelope(credentials)
+	if !sealed {
+		return credentials, nil
+	}
+	payload, err := c.Open(envelope)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("decode credentials: %w", err)
+	}
+	return out, nil
+}
+
+func credentialsEnvelope(credentials map[string]any) (string, bool) {
+	if len(credentials) != 1 {
+		return "", false
+	}
+	envelope, ok := credentials[credentialsEnvelopeField].(string)
+	if !ok || !isSecretEnvelope(envelope) {
+		return "", false
+	}
+	return envelope, true
+}
+
+func isSecretEnvelope(value string) bool {
+	return strings.HasPrefix(value, secretEnvelopePrefix)
+}
+
+func parseSecretEnvelope(envelope string) (keyID string, wrapped, ciphertext []byte, err error) {
+	if !isSecretEnvelope(envelope) {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	parts := strings.Split(strings.TrimPrefix(envelope, secretEnvelopePrefix), ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	if wrapped, err = base64.RawStdEncoding.DecodeString(parts[1]); err != nil {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	if ciphertext, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, errMalformedEnvelope
+	}
+	return parts[0], wrapped, ciphertext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+	return gcm, nil
+}
+
+// sealWithNonce 返回 nonce + 密文 + 认证标签
+func sealWithNonce(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func openWithNonce(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errMalformedEnvelope
+	}
+	return aead.Open(nil, data[:nonceSize], data[nonceSize:], additionalData)
+}
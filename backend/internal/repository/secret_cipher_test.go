@@ -0,0 +1,147 @@
+//go:build unit
+
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretKeyA = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	testSecretKeyB = "1f1e1d1c1b1a191817161514131211100f0e0d0c0b0a09080706050403020100"
+)
+
+func newTestSecretCipher(t *testing.T, enabled bool, activeID string, keys ...config.SecretEncryptionKey) *SecretCipher {
+	t.Helper()
+	cfg := &config.Config{SecretEnc: config.SecretEncryptionConfig{Enabled: enabled, ActiveKeyID: activeID, Keys: keys}}
+	c, err := NewSecretCipher(cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func TestSecretCipher_SealOpen(t *testing.T) {
+	c := newTestSecretCipher(t, true, "a", config.SecretEncryptionKey{ID: "a", Key: testSecretKeyA})
+
+	envelope, err := c.Seal([]byte("sk-ant-secret"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(envelope, "enc:v1:a:"))
+	require.NotContains(t, envelope, "sk-ant-secret")
+
+	again, err := c.Seal([]byte("sk-ant-secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, envelope, again, "every value uses a fresh data key and nonce")
+
+	plaintext, err := c.Open(envelope)
+	require.NoError(t, err)
+	require.Equal(t, "sk-ant-secret", string(plaintext))
+
+	_, err = c.Open(envelope[:len(envelope)-4] + "AAAA")
+	require.Error(t, err)
+}
+
+func TestSecretCipher_Rotate(t *testing.T) {
+	keyA := config.SecretEncryptionKey{ID: "a", Key: testSecretKeyA}
+	keyB := config.SecretEncryptionKey{ID: "b", Key: testSecretKeyB}
+	old := newTestSecretCipher(t, true, "a", keyA)
+	envelope, err := old.Seal([]byte("refresh-token"))
+	require.NoError(t, err)
+
+	rotating := newTestSecretCipher(t, true, "b", keyA, keyB)
+	require.True(t, rotating.NeedsRotation(envelope))
+	require.True(t, rotating.NeedsRotation("plaintext"))
+
+	rotated, err := rotating.Rotate(envelope)
+	require.NoError(t, err)
+	require.False(t, rotating.NeedsRotation(rotated))
+	// Only the data key is rewrapped; the ciphertext part is unchanged.
+	require.Equal(t, envelope[strings.LastIndex(envelope, ":"):], rotated[strings.LastIndex(rotated, ":"):])
+
+	retired := newTestSecretCipher(t, true, "b", keyB)
+	plaintext, err := retired.Open(rotated)
+	require.NoError(t, err)
+	require.Equal(t, "refresh-token", string(plaintext))
+
+	_, err = retired.Open(envelope)
+	require.ErrorIs(t, err, ErrSecretKeyNotFound)
+}
+
+func TestSecretCipher_Credentials(t *testing.T) {
+	c := newTestSecretCipher(t, true, "a", config.SecretEncryptionKey{ID: "a", Key: testSecretKeyA})
+	credentials := map[string]any{"access_token": "at", "expires_at": float64(1700000000)}
+
+	sealed, err := c.sealCredentials(credentials)
+	require.NoError(t, err)
+	require.Len(t, sealed, 1)
+	require.Contains(t, sealed, credentialsEnvelopeField)
+
+	resealed, err := c.sealCredentials(sealed)
+	require.NoError(t, err)
+	require.Equal(t, sealed, resealed, "already sealed credentials are not wrapped twice")
+
+	opened, err := c.openCredentials(sealed)
+	require.NoError(t, err)
+	require.Equal(t, credentials, opened)
+
+	// Plaintext rows written before encryption was enabled are read as-is.
+	opened, err = c.openCredentials(credentials)
+	require.NoError(t, err)
+	require.Equal(t, credentials, opened)
+}
+
+func TestSecretCipher_DisabledPassthrough(t *testing.T) {
+	var nilCipher *SecretCipher
+	credentials := map[string]any{"api_key": "sk"}
+	out, err := nilCipher.sealCredentials(credentials)
+	require.NoError(t, err)
+	require.Equal(t, credentials, out)
+	password, err := nilCipher.sealString("pw")
+	require.NoError(t, err)
+	require.Equal(t, "pw", password)
+
+	// Disabled but keyed: new values stay plaintext, existing envelopes still open.
+	keyA := config.SecretEncryptionKey{ID: "a", Key: testSecretKeyA}
+	envelope, err := newTestSecretCipher(t, true, "a", keyA).Seal([]byte("pw"))
+	require.NoError(t, err)
+	disabled := newTestSecretCipher(t, false, "", keyA)
+	password, err = disabled.sealString("pw")
+	require.NoError(t, err)
+	require.Equal(t, "pw", password)
+	password, err = disabled.openString(envelope)
+	require.NoError(t, err)
+	require.Equal(t, "pw", password)
+	require.False(t, disabled.NeedsRotation("pw"))
+
+	_, err = nilCipher.openString(envelope)
+	require.ErrorIs(t, err, ErrSecretCipherDisabled)
+}
+
+func TestNewSecretCipher_RequiresActiveKey(t *testing.T) {
+	_, err := NewSecretCipher(&config.Config{SecretEnc: config.SecretEncryptionConfig{Enabled: true, ActiveKeyID: "missing"}})
+	require.ErrorIs(t, err, ErrSecretKeyNotFound)
+}
+
+func TestSchedulerCache_EncodeAccountSealsSecrets(t *testing.T) {
+	c := newTestSecretCipher(t, true, "a", config.SecretEncryptionKey{ID: "a", Key: testSecretKeyA})
+	cache := &schedulerCache{cipher: c}
+	account := &service.Account{
+		ID:          1,
+		Credentials: map[string]any{"access_token": "at-secret"},
+		Proxy:       &service.Proxy{ID: 2, Password: "proxy-secret"},
+	}
+
+	payload, err := cache.encodeAccount(account)
+	require.NoError(t, err)
+	require.NotContains(t, string(payload), "at-secret")
+	require.NotContains(t, string(payload), "proxy-secret")
+	require.Equal(t, "at-secret", account.Credentials["access_token"], "the caller's account is not modified")
+
+	decoded, err := cache.decodeAccount(string(payload))
+	require.NoError(t, err)
+	require.Equal(t, "at-secret", decoded.Credentials["access_token"])
+	require.Equal(t, "proxy-secret", decoded.Proxy.Password)
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// secretRotationRepository 将存量账号凭证与代理密码迁移到当前主密钥。
+//
+// 已加密的数据仅用新主密钥重新包装数据密钥（密文不变），明文数据直接加密。
+// 写回时以读取到的原值作为条件（compare-and-swap），与并发写入冲突的行跳过，由下一轮处理。
+// 软删除的行同样处理，避免明文凭证残留在数据库中。
+type secretRotationRepository struct {
+	sql    sqlExecutor
+	cipher *SecretCipher
+}
+
+func NewSecretRotationRepository(sqlDB *sql.DB, cipher *SecretCipher) service.SecretRotationRepository {
+	return &secretRotationRepository{sql: sqlDB, cipher: cipher}
+}
+
+type secretRotationRow struct {
+	id    int64
+	value string
+}
+
+func (r *secretRotationRepository) ReencryptAccounts(ctx context.Context, afterID int64, limit int) (service.SecretRotationBatch, error) {
+	var batch service.SecretRotationBatch
+	if !r.cipher.Enabled() {
+		return batch, ErrSecretCipherDisabled
+	}
+	rows, err := r.scan(ctx, "SELECT id, credentials::text FROM accounts WHERE id > $1 ORDER BY id LIMIT $2", afterID, limit)
+	if err != nil {
+		return batch, err
+	}
+
+	for _, row := range rows {
+		batch.Scanned++
+		batch.LastID = row.id
+
+		rotated, changed, err := r.rotateCredentials(row.value)
+		if err != nil {
+			log.Printf("[SecretRotation] Skip account %d: %v", row.id, err)
+			continue
+		}
+		if !changed {
+			continue
+		}
+		updated, err := r.compareAndSwap(ctx,
+			"UPDATE accounts SET credentials = $1::jsonb WHERE id = $2 AND credentials = $3::jsonb",
+			rotated, row.id, row.value)
+		if err != nil {
+			return batch, err
+		}
+		if updated {
+			batch.Updated++
+		}
+	}
+	return batch, nil
+}
+
+func (r *secretRotationRepository) ReencryptProxies(ctx context.Context, afterID int64, limit int) (service.SecretRotationBatch, error) {
+	var batch service.SecretRotationBatch
+	if !r.cipher.Enabled() {
+		return batch, ErrSecretCipherDisabled
+	}
+	rows, err := r.scan(ctx, "SELECT id, COALESCE(password, '') FROM proxies WHERE id > $1 ORDER BY id LIMIT $2", afterID, limit)
+	if err != nil {
+		return batch, err
+	}
+
+	for _, row := range rows {
+		batch.Scanned++
+		batch.LastID = row.id
+
+		if row.value == "" || !r.cipher.NeedsRotation(row.value) {
+			continue
+		}
+		rotated, err := r.rotateString(row.value)
+		if err != nil {
+			log.Printf("[SecretRotation] Skip proxy %d: %v", row.id, err)
+			continue
+		}
+		updated, err := r.compareAndSwap(ctx,
+			"UPDATE proxies SET password = $1 WHERE id = $2 AND password = $3",
+			rotated, row.id, row.value)
+		if err != nil {
+			return batch, err
+		}
+		if updated {
+			batch.Updated++
+		}
+	}
+	return batch, nil
+}
+
+func (r *secretRotationRepository) RequestSchedulerRebuild(ctx context.Context) error {
+	return enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventFullRebuild, nil, nil, nil)
+}
+
+func (r *secretRotationRepository) scan(ctx context.Context, query string, afterID int64, limit int) ([]secretRotationRow, error) {
+	rows, err := r.sql.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]secretRotationRow, 0, limit)
+	for rows.Next() {
+		var row secretRotationRow
+		var value sql.NullString
+		if err := rows.Scan(&row.id, &value); err != nil {
+			return nil, err
+		}
+		row.value = value.String
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *secretRotationRepository) compareAndSwap(ctx context.Context, query string, newValue string, id int64, oldValue string) (bool, error) {
+	result, err := r.sql.ExecContext(ctx, query, newValue, id, oldValue)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// rotateCredentials 返回使用当前主密钥加密的凭证 JSON；已是当前主密钥或为空时 changed=false
+func (r *secretRotationRepository) rotateCredentials(raw string) (string, bool, error) {
+	if raw == "" {
+		return "", false, nil
+	}
+	// UseNumber 保留数字原文，避免大整数在重新序列化时丢失精度
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+	var credentials map[string]any
+	if err := decoder.Decode(&credentials); err != nil {
+		return "", false, err
+	}
+	if len(credentials) == 0 {
+		return "", false, nil
+	}
+
+	var rotated map[string]any
+	if envelope, sealed := credentialsEnvelope(credentials); sealed {
+		if !r.cipher.NeedsRotation(envelope) {
+			return "", false, nil
+		}
+		next, err := r.cipher.Rotate(envelope)
+		if err != nil {
+			return "", false, err
+		}
+		rotated = map[string]any{credentialsEnvelopeField: next}
+	} else {
+		sealed, err := r.cipher.sealCredentials(credentials)
+		if err != nil {
+			return "", false, err
+		}
+		rotated = sealed
+	}
+	payload, err := json.Marshal(rotated)
+	if err != nil {
+		return "", false, err
+	}
+	return string(payload), true, nil
+}
+
+func (r *secretRotationRepository) rotateString(value string) (string, error) {
+	if isSecretEnvelope(value) {
+		return r.cipher.Rotate(value)
+	}
+	return r.cipher.Seal([]byte(value))
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	dbgroup "github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionplan"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type subscriptionPlanRepository struct {
+	client *dbent.Client
+}
+
+func NewSubscriptionPlanRepository(client *dbent.Client) service.SubscriptionPlanRepository {
+	return &subscriptionPlanRepository{client: client}
+}
+
+func (r *subscriptionPlanRepository) Create(ctx context.Context, plan *service.SubscriptionPlan) error {
+	client := clientFromContext(ctx, r.client)
+	created, err := client.SubscriptionPlan.Create().
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetGroupID(plan.GroupID).
+		SetValidityDays(plan.ValidityDays).
+		SetPrice(plan.Price).
+		SetNillableDailyLimitUsd(plan.DailyLimitUSD).
+		SetNillableWeeklyLimitUsd(plan.WeeklyLimitUSD).
+		SetNillableMonthlyLimitUsd(plan.MonthlyLimitUSD).
+		SetStatus(plan.Status).
+		SetSortOrder(plan.SortOrder).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	plan.ID = created.ID
+	plan.CreatedAt = created.CreatedAt
+	plan.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *subscriptionPlanRepository) GetByID(ctx context.Context, id int64) (*service.SubscriptionPlan, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.SubscriptionPlan.Query().
+		Where(subscriptionplan.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrSubscriptionPlanNotFound, nil)
+	}
+	plans := []service.SubscriptionPlan{*subscriptionPlanEntityToService(m)}
+	if err := r.attachGroups(ctx, plans); err != nil {
+		return nil, err
+	}
+	return &plans[0], nil
+}
+
+func (r *subscriptionPlanRepository) Update(ctx context.Context, plan *service.SubscriptionPlan) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.SubscriptionPlan.UpdateOneID(plan.ID).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetGroupID(plan.GroupID).
+		SetValidityDays(plan.ValidityDays).
+		SetPrice(plan.Price).
+		SetStatus(plan.Status).
+		SetSortOrder(plan.SortOrder)
+	if plan.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*plan.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if plan.WeeklyLimitUSD != nil {
+		builder.SetWeeklyLimitUsd(*plan.WeeklyLimitUSD)
+	} else {
+		builder.ClearWeeklyLimitUsd()
+	}
+	if plan.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*plan.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrSubscriptionPlanNotFound, nil)
+	}
+	plan.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *subscriptionPlanRepository) Delete(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.SubscriptionPlan.Delete().Where(subscriptionplan.IDEQ(id)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrSubscriptionPlanNotFound
+	}
+	return nil
+}
+
+func (r *subscriptionPlanRepository) List(ctx context.Context, params pagination.PaginationParams, status string) ([]service.SubscriptionPlan, *pagination.PaginationResult, error) {
+	q := r.client.SubscriptionPlan.Query()
+	if status != "" {
+		q = q.Where(subscriptionplan.StatusEQ(status))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	models, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Asc(subscriptionplan.FieldSortOrder), dbent.Asc(subscriptionplan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	plans := subscriptionPlanEntitiesToService(models)
+	if err := r.attachGroups(ctx, plans); err != nil {
+		return nil, nil, err
+	}
+	return plans, paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *subscriptionPlanRepository) ListActive(ctx context.Context) ([]service.SubscriptionPlan, error) {
+	models, err := r.client.SubscriptionPlan.Query().
+		Where(subscriptionplan.StatusEQ(service.SubscriptionPlanStatusActive)).
+		Order(dbent.Asc(subscriptionplan.FieldSortOrder), dbent.Asc(subscriptionplan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plans := subscriptionPlanEntitiesToService(models)
+	if err := r.attachGroups(ctx, plans); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// attachGroups 批量加载套餐绑定的分组（套餐与分组之间没有建立 ent 边）
+func (r *subscriptionPlanRepository) attachGroups(ctx context.Context, plans []service.SubscriptionPlan) error {
+	if len(plans) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(plans))
+	for i := range plans {
+		ids = append(ids, plans[i].GroupID)
+	}
+	client := clientFromContext(ctx, r.client)
+	groups, err := client.Group.Query().Where(dbgroup.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]*service.Group, len(groups))
+	for _, g := range groups {
+		byID[g.ID] = groupEntityToService(g)
+	}
+	for i := range plans {
+		plans[i].Group = byID[plans[i].GroupID]
+	}
+	return nil
+}
+
+func subscriptionPlanEntityToService(m *dbent.SubscriptionPlan) *service.SubscriptionPlan {
+	if m == nil {
+		return nil
+	}
+	return &service.SubscriptionPlan{
+		ID:              m.ID,
+		Name:            m.Name,
+		Description:     m.Description,
+		GroupID:         m.GroupID,
+		ValidityDays:    m.ValidityDays,
+		Price:           m.Price,
+		DailyLimitUSD:   m.DailyLimitUsd,
+		WeeklyLimitUSD:  m.WeeklyLimitUsd,
+		MonthlyLimitUSD: m.MonthlyLimitUsd,
+		Status:          m.Status,
+		SortOrder:       m.SortOrder,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func subscriptionPlanEntitiesToService(models []*dbent.SubscriptionPlan) []service.SubscriptionPlan {
+	out := make([]service.SubscriptionPlan, 0, len(models))
+	for i := range models {
+		if p := subscriptionPlanEntityToService(models[i]); p != nil {
+			out = append(out, *p)
+		}
+	}
+	return out
+}
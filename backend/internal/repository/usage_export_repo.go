@@ -0,0 +1,395 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const usageExportTaskColumns = `id, status, format, destination, filters, created_by, scheduled_for,
+	rows_exported, cursor_created_at, cursor_id, file_size, object_key, error_message,
+	started_at, finished_at, created_at, updated_at`
+
+type usageExportRepository struct {
+	sql  sqlExecutor
+	logs *usageLogRepository
+}
+
+func NewUsageExportRepository(client *dbent.Client, sqlDB *sql.DB) service.UsageExportRepository {
+	return newUsageExportRepositoryWithSQL(client, sqlDB)
+}
+
+func newUsageExportRepositoryWithSQL(client *dbent.Client, sqlq sqlExecutor) *usageExportRepository {
+	return &usageExportRepository{sql: sqlq, logs: newUsageLogRepositoryWithSQL(client, sqlq)}
+}
+
+func (r *usageExportRepository) CreateTask(ctx context.Context, task *service.UsageExportTask) error {
+	if task == nil {
+		return nil
+	}
+	filtersJSON, err := json.Marshal(task.Filters)
+	if err != nil {
+		return fmt.Errorf("marshal export filters: %w", err)
+	}
+	query := `
+		INSERT INTO usage_export_tasks (status, format, destination, filters, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+	return scanSingleRow(ctx, r.sql, query, []any{task.Status, task.Format, task.Destination, filtersJSON, task.CreatedBy},
+		&task.ID, &task.CreatedAt, &task.UpdatedAt)
+}
+
+func (r *usageExportRepository) CreateScheduledTask(ctx context.Context, task *service.UsageExportTask) (bool, error) {
+	if task == nil || task.ScheduledFor == nil {
+		return false, fmt.Errorf("scheduled export task missing date")
+	}
+	filtersJSON, err := json.Marshal(task.Filters)
+	if err != nil {
+		return false, fmt.Errorf("marshal export filters: %w", err)
+	}
+	query := `
+		INSERT INTO usage_export_tasks (status, format, destination, filters, scheduled_for, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (scheduled_for) WHERE scheduled_for IS NOT NULL DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+	err = scanSingleRow(ctx, r.sql, query, []any{task.Status, task.Format, task.Destination, filtersJSON, task.ScheduledFor.UTC().Format("2006-01-02")},
+		&task.ID, &task.CreatedAt, &task.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *usageExportRepository) ListTasks(ctx context.Context, params pagination.PaginationParams) ([]service.UsageExportTask, *pagination.PaginationResult, error) {
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM usage_export_tasks", nil, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.UsageExportTask{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := `SELECT ` + usageExportTaskColumns + `
+		FROM usage_export_tasks
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2`
+	rows, err := r.sql.QueryContext(ctx, query, params.Limit(), params.Offset())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	tasks := make([]service.UsageExportTask, 0)
+	for rows.Next() {
+		task, err := scanUsageExportTask(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, *task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return tasks, paginationResultFromTotal(total, params), nil
+}
+
+func (r *usageExportRepository) GetTask(ctx context.Context, taskID int64) (*service.UsageExportTask, error) {
+	rows, err := r.sql.QueryContext(ctx, `SELECT `+usageExportTaskColumns+` FROM usage_export_tasks WHERE id = $1`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrUsageExportTaskNotFound
+	}
+	task, err := scanUsageExportTask(rows)
+	if err != nil {
+		return nil, err
+	}
+	return task, rows.Err()
+}
+
+func (r *usageExportRepository) ClaimNextPendingTask(ctx context.Context, staleRunningAfterSeconds int64) (*service.UsageExportTask, error) {
+	if staleRunningAfterSeconds <= 0 {
+		staleRunningAfterSeconds = 3600
+	}
+	// 与清理任务不同，重新抢占时保留游标与已写入字节数，用于断点续跑
+	query := `
+		WITH next AS (
+			SELECT id
+			FROM usage_export_tasks
+			WHERE status = $1
+				OR (
+					status = $2
+					AND updated_at < NOW() - ($3 * interval '1 second')
+				)
+			ORDER BY created_at ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE usage_export_tasks AS tasks
+		SET status = $2,
+			started_at = COALESCE(tasks.started_at, NOW()),
+			finished_at = NULL,
+			error_message = NULL,
+			updated_at = NOW()
+		FROM next
+		WHERE tasks.id = next.id
+		RETURNING tasks.id, tasks.status, tasks.format, tasks.destination, tasks.filters, tasks.created_by, tasks.scheduled_for,
+			tasks.rows_exported, tasks.cursor_created_at, tasks.cursor_id, tasks.file_size, tasks.object_key, tasks.error_message,
+			tasks.started_at, tasks.finished_at, tasks.created_at, tasks.updated_at
+	`
+	rows, err := r.sql.QueryContext(ctx, query, service.UsageExportStatusPending, service.UsageExportStatusRunning, staleRunningAfterSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	task, err := scanUsageExportTask(rows)
+	if err != nil {
+		return nil, err
+	}
+	return task, rows.Err()
+}
+
+func (r *usageExportRepository) UpdateTaskProgress(ctx context.Context, taskID int64, rowsExported int64, cursor service.UsageExportCursor, fileSize int64) error {
+	query := `
+		UPDATE usage_export_tasks
+		SET rows_exported = $1,
+			cursor_created_at = $2,
+			cursor_id = $3,
+			file_size = $4,
+			updated_at = NOW()
+		WHERE id = $5
+	`
+	_, err := r.sql.ExecContext(ctx, query, rowsExported, cursor.CreatedAt, cursor.ID, fileSize, taskID)
+	return err
+}
+
+func (r *usageExportRepository) MarkTaskSucceeded(ctx context.Context, taskID int64, rowsExported int64, fileSize int64, objectKey *string) error {
+	query := `
+		UPDATE usage_export_tasks
+		SET status = $1,
+			rows_exported = $2,
+			file_size = $3,
+			object_key = $4,
+			finished_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $5
+	`
+	_, err := r.sql.ExecContext(ctx, query, service.UsageExportStatusSucceeded, rowsExported, fileSize, objectKey, taskID)
+	return err
+}
+
+func (r *usageExportRepository) MarkTaskFailed(ctx context.Context, taskID int64, errorMsg string) error {
+	query := `
+		UPDATE usage_export_tasks
+		SET status = $1,
+			error_message = $2,
+			finished_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $3
+	`
+	_, err := r.sql.ExecContext(ctx, query, service.UsageExportStatusFailed, errorMsg, taskID)
+	return err
+}
+
+func (r *usageExportRepository) CancelTask(ctx context.Context, taskID int64) (bool, error) {
+	query := `
+		UPDATE usage_export_tasks
+		SET status = $1,
+			finished_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $2
+			AND status IN ($3, $4)
+		RETURNING id
+	`
+	var id int64
+	err := scanSingleRow(ctx, r.sql, query, []any{
+		service.UsageExportStatusCanceled,
+		taskID,
+		service.UsageExportStatusPending,
+		service.UsageExportStatusRunning,
+	}, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *usageExportRepository) DeleteTask(ctx context.Context, taskID int64) (bool, error) {
+	query := `
+		DELETE FROM usage_export_tasks
+		WHERE id = $1
+			AND status IN ($2, $3, $4)
+	`
+	res, err := r.sql.ExecContext(ctx, query, taskID,
+		service.UsageExportStatusSucceeded, service.UsageExportStatusFailed, service.UsageExportStatusCanceled)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *usageExportRepository) ListUsageLogsForExport(ctx context.Context, filters service.UsageExportFilters, windowStart, windowEnd time.Time, after *service.UsageExportCursor, limit int) ([]service.UsageLog, error) {
+	if windowStart.IsZero() || windowEnd.IsZero() {
+		return nil, fmt.Errorf("export window missing time range")
+	}
+	conditions, args := buildUsageExportConditions(filters)
+	conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)+1))
+	args = append(args, windowStart)
+	conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)+1))
+	args = append(args, windowEnd)
+	if after != nil {
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) > ($%d, $%d)", len(args)+1, len(args)+2))
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf("SELECT %s FROM usage_logs %s ORDER BY created_at ASC, id ASC LIMIT $%d",
+		usageLogSelectColumns, buildWhere(conditions), len(args))
+
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := func() ([]service.UsageLog, error) {
+		defer func() { _ = rows.Close() }()
+		logs := make([]service.UsageLog, 0, limit)
+		for rows.Next() {
+			log, err := scanUsageLog(rows)
+			if err != nil {
+				return nil, err
+			}
+			logs = append(logs, *log)
+		}
+		return logs, rows.Err()
+	}()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.logs.hydrateUsageLogAssociations(ctx, logs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func buildUsageExportConditions(filters service.UsageExportFilters) ([]string, []any) {
+	conditions := make([]string, 0, 10)
+	args := make([]any, 0, 10)
+	if filters.UserID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)+1))
+		args = append(args, *filters.UserID)
+	}
+	if filters.APIKeyID != nil {
+		conditions = append(conditions, fmt.Sprintf("api_key_id = $%d", len(args)+1))
+		args = append(args, *filters.APIKeyID)
+	}
+	if filters.AccountID != nil {
+		conditions = append(conditions, fmt.Sprintf("account_id = $%d", len(args)+1))
+		args = append(args, *filters.AccountID)
+	}
+	if filters.GroupID != nil {
+		conditions = append(conditions, fmt.Sprintf("group_id = $%d", len(args)+1))
+		args = append(args, *filters.GroupID)
+	}
+	if filters.Model != nil {
+		conditions = append(conditions, fmt.Sprintf("model = $%d", len(args)+1))
+		args = append(args, *filters.Model)
+	}
+	if filters.Stream != nil {
+		conditions = append(conditions, fmt.Sprintf("stream = $%d", len(args)+1))
+		args = append(args, *filters.Stream)
+	}
+	if filters.BillingType != nil {
+		conditions = append(conditions, fmt.Sprintf("billing_type = $%d", len(args)+1))
+		args = append(args, int16(*filters.BillingType))
+	}
+	return conditions, args
+}
+
+func scanUsageExportTask(scanner interface{ Scan(...any) error }) (*service.UsageExportTask, error) {
+	var (
+		task         service.UsageExportTask
+		filtersJSON  []byte
+		createdBy    sql.NullInt64
+		scheduledFor sql.NullTime
+		cursorAt     sql.NullTime
+		cursorID     sql.NullInt64
+		objectKey    sql.NullString
+		errMsg       sql.NullString
+		startedAt    sql.NullTime
+		finishedAt   sql.NullTime
+	)
+	if err := scanner.Scan(
+		&task.ID,
+		&task.Status,
+		&task.Format,
+		&task.Destination,
+		&filtersJSON,
+		&createdBy,
+		&scheduledFor,
+		&task.RowsExported,
+		&cursorAt,
+		&cursorID,
+		&task.FileSize,
+		&objectKey,
+		&errMsg,
+		&startedAt,
+		&finishedAt,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filtersJSON, &task.Filters); err != nil {
+		return nil, fmt.Errorf("parse export filters: %w", err)
+	}
+	if createdBy.Valid {
+		v := createdBy.Int64
+		task.CreatedBy = &v
+	}
+	if scheduledFor.Valid {
+		v := scheduledFor.Time.UTC()
+		task.ScheduledFor = &v
+	}
+	if cursorAt.Valid && cursorID.Valid {
+		task.Cursor = &service.UsageExportCursor{CreatedAt: cursorAt.Time, ID: cursorID.Int64}
+	}
+	if objectKey.Valid {
+		task.ObjectKey = &objectKey.String
+	}
+	if errMsg.Valid {
+		task.ErrorMsg = &errMsg.String
+	}
+	if startedAt.Valid {
+		task.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		task.FinishedAt = &finishedAt.Time
+	}
+	return &task, nil
+}
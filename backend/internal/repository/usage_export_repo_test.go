@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageExportRepositoryCreateScheduledTaskIsIdempotent(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := newUsageExportRepositoryWithSQL(nil, db)
+
+	day := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	task := &service.UsageExportTask{
+		Status:       service.UsageExportStatusPending,
+		Format:       service.UsageExportFormatCSV,
+		Destination:  service.UsageExportDestinationS3,
+		Filters:      service.UsageExportFilters{StartTime: day, EndTime: day.AddDate(0, 0, 1)},
+		ScheduledFor: &day,
+	}
+
+	mock.ExpectQuery("INSERT INTO usage_export_tasks .* ON CONFLICT \\(scheduled_for\\) WHERE scheduled_for IS NOT NULL DO NOTHING").
+		WithArgs(task.Status, task.Format, task.Destination, sqlmock.AnyArg(), "2026-03-14").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}))
+
+	created, err := repo.CreateScheduledTask(context.Background(), task)
+	require.NoError(t, err)
+	require.False(t, created)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUsageExportRepositoryGetTaskNotFound(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := newUsageExportRepositoryWithSQL(nil, db)
+
+	mock.ExpectQuery("SELECT id, status, format").
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetTask(context.Background(), 9)
+	require.ErrorIs(t, err, service.ErrUsageExportTaskNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUsageExportRepositoryListUsageLogsForExportUsesKeysetCursor(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := newUsageExportRepositoryWithSQL(nil, db)
+
+	userID := int64(3)
+	model := "claude-sonnet-4"
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	cursor := &service.UsageExportCursor{CreatedAt: start.Add(time.Hour), ID: 42}
+
+	mock.ExpectQuery(`FROM usage_logs WHERE user_id = \$1 AND model = \$2 AND created_at >= \$3 AND created_at < \$4 AND \(created_at, id\) > \(\$5, \$6\) ORDER BY created_at ASC, id ASC LIMIT \$7`).
+		WithArgs(userID, model, start, end, cursor.CreatedAt, cursor.ID, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	logs, err := repo.ListUsageLogsForExport(context.Background(), service.UsageExportFilters{
+		StartTime: start,
+		EndTime:   end,
+		UserID:    &userID,
+		Model:     &model,
+	}, start, end, cursor, 100)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
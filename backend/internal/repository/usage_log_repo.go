@@ -22,7 +22,7 @@ import (
 	"github.com/lib/pq"
 )
 
-const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, created_at"
+const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, trace_id, organization_id, created_at"
 
 type usageLogRepository struct {
 	client *dbent.Client
@@ -114,6 +114,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 			ip_address,
 			image_count,
 			image_size,
+			trace_id,
+			organization_id,
 			created_at
 		) VALUES (
 			$1, $2, $3, $4, $5,
@@ -121,7 +123,7 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 			$8, $9, $10, $11,
 			$12, $13,
 			$14, $15, $16, $17, $18, $19,
-			$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30
+			$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32
 		)
 		ON CONFLICT (request_id, api_key_id) DO NOTHING
 		RETURNING id, created_at
@@ -134,6 +136,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 	userAgent := nullString(log.UserAgent)
 	ipAddress := nullString(log.IPAddress)
 	imageSize := nullString(log.ImageSize)
+	traceID := nullString(log.TraceID)
+	organizationID := nullInt64(log.OrganizationID)
 
 	var requestIDArg any
 	if requestID != "" {
@@ -170,6 +174,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 		ipAddress,
 		log.ImageCount,
 		imageSize,
+		traceID,
+		organizationID,
 		createdAt,
 	}
 	if err := scanSingleRow(ctx, sqlq, query, args, &log.ID, &log.CreatedAt); err != nil {
@@ -891,6 +897,47 @@ func (r *usageLogRepository) GetAccountWindowStats(ctx context.Context, accountI
 	return stats, nil
 }
 
+// GetAccountPeriodUsage 获取账号在当日/本周/本月的用量（账号口径费用与 token，不含响应缓存命中）
+func (r *usageLogRepository) GetAccountPeriodUsage(ctx context.Context, accountID int64, dayStart, weekStart, monthStart time.Time) (*usagestats.AccountPeriodUsage, error) {
+	// 周起点可能早于月起点，扫描范围取三者最早值
+	since := dayStart
+	if weekStart.Before(since) {
+		since = weekStart
+	}
+	if monthStart.Before(since) {
+		since = monthStart
+	}
+
+	query := `
+		SELECT
+			COALESCE(SUM(total_cost * COALESCE(account_rate_multiplier, 1)) FILTER (WHERE created_at >= $2), 0),
+			COALESCE(SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) FILTER (WHERE created_at >= $2), 0),
+			COALESCE(SUM(total_cost * COALESCE(account_rate_multiplier, 1)) FILTER (WHERE created_at >= $3), 0),
+			COALESCE(SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) FILTER (WHERE created_at >= $3), 0),
+			COALESCE(SUM(total_cost * COALESCE(account_rate_multiplier, 1)) FILTER (WHERE created_at >= $4), 0),
+			COALESCE(SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) FILTER (WHERE created_at >= $4), 0)
+		FROM usage_logs
+		WHERE account_id = $1 AND created_at >= $5 AND billing_type <> $6
+	`
+
+	usage := &usagestats.AccountPeriodUsage{}
+	if err := scanSingleRow(
+		ctx,
+		r.sql,
+		query,
+		[]any{accountID, dayStart, weekStart, monthStart, since, service.BillingTypeResponseCache},
+		&usage.DailyCost,
+		&usage.DailyTokens,
+		&usage.WeeklyCost,
+		&usage.WeeklyTokens,
+		&usage.MonthlyCost,
+		&usage.MonthlyTokens,
+	); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
 // TrendDataPoint represents a single point in trend data
 type TrendDataPoint = usagestats.TrendDataPoint
 
@@ -1550,6 +1597,136 @@ func (r *usageLogRepository) GetModelStatsWithFilters(ctx context.Context, start
 	return results, nil
 }
 
+// GetPromptCacheStatsByAccount aggregates prompt cache usage per account within a time range.
+// groupID > 0 restricts to a single group.
+func (r *usageLogRepository) GetPromptCacheStatsByAccount(ctx context.Context, startTime, endTime time.Time, groupID int64) (results []usagestats.PromptCacheStat, err error) {
+	query := `
+		SELECT
+			ul.account_id,
+			COALESCE(MAX(a.name), '') as name,
+			COUNT(*) as requests,
+			COUNT(*) FILTER (WHERE ul.cache_read_tokens > 0) as cache_hit_requests,
+			COALESCE(SUM(ul.input_tokens), 0) as input_tokens,
+			COALESCE(SUM(ul.cache_creation_tokens), 0) as cache_creation_tokens,
+			COALESCE(SUM(ul.cache_read_tokens), 0) as cache_read_tokens
+		FROM usage_logs ul
+		LEFT JOIN accounts a ON a.id = ul.account_id
+		WHERE ul.created_at >= $1 AND ul.created_at < $2
+	`
+	args := []any{startTime, endTime}
+	if groupID > 0 {
+		query += " AND ul.group_id = $3"
+		args = append(args, groupID)
+	}
+	query += " GROUP BY ul.account_id ORDER BY cache_read_tokens DESC, requests DESC"
+	return r.queryPromptCacheStats(ctx, query, args...)
+}
+
+// GetPromptCacheStatsByGroup aggregates prompt cache usage per group within a time range.
+// Requests without a group are reported under ID 0.
+func (r *usageLogRepository) GetPromptCacheStatsByGroup(ctx context.Context, startTime, endTime time.Time) (results []usagestats.PromptCacheStat, err error) {
+	query := `
+		SELECT
+			COALESCE(ul.group_id, 0) as group_id,
+			COALESCE(MAX(g.name), '') as name,
+			COUNT(*) as requests,
+			COUNT(*) FILTER (WHERE ul.cache_read_tokens > 0) as cache_hit_requests,
+			COALESCE(SUM(ul.input_tokens), 0) as input_tokens,
+			COALESCE(SUM(ul.cache_creation_tokens), 0) as cache_creation_tokens,
+			COALESCE(SUM(ul.cache_read_tokens), 0) as cache_read_tokens
+		FROM usage_logs ul
+		LEFT JOIN groups g ON g.id = ul.group_id
+		WHERE ul.created_at >= $1 AND ul.created_at < $2
+		GROUP BY COALESCE(ul.group_id, 0)
+		ORDER BY cache_read_tokens DESC, requests DESC
+	`
+	return r.queryPromptCacheStats(ctx, query, startTime, endTime)
+}
+
+func (r *usageLogRepository) queryPromptCacheStats(ctx context.Context, query string, args ...any) (results []usagestats.PromptCacheStat, err error) {
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			results = nil
+		}
+	}()
+
+	results = make([]usagestats.PromptCacheStat, 0)
+	for rows.Next() {
+		var row usagestats.PromptCacheStat
+		if err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Requests,
+			&row.CacheHitRequests,
+			&row.InputTokens,
+			&row.CacheCreationTokens,
+			&row.CacheReadTokens,
+		); err != nil {
+			return nil, err
+		}
+		if total := row.InputTokens + row.CacheCreationTokens + row.CacheReadTokens; total > 0 {
+			row.HitRate = float64(row.CacheReadTokens) / float64(total)
+		}
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// GetUserModelCacheReads returns cache read tokens grouped by user, model and rate multiplier.
+// userID > 0 restricts to a single user.
+func (r *usageLogRepository) GetUserModelCacheReads(ctx context.Context, startTime, endTime time.Time, userID int64) (results []usagestats.UserModelCacheRead, err error) {
+	query := `
+		SELECT
+			ul.user_id,
+			COALESCE(MAX(u.email), '') as email,
+			ul.model,
+			ul.rate_multiplier,
+			COUNT(*) as requests,
+			COALESCE(SUM(ul.cache_read_tokens), 0) as cache_read_tokens
+		FROM usage_logs ul
+		LEFT JOIN users u ON u.id = ul.user_id
+		WHERE ul.created_at >= $1 AND ul.created_at < $2 AND ul.cache_read_tokens > 0
+	`
+	args := []any{startTime, endTime}
+	if userID > 0 {
+		query += " AND ul.user_id = $3"
+		args = append(args, userID)
+	}
+	query += " GROUP BY ul.user_id, ul.model, ul.rate_multiplier"
+
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			results = nil
+		}
+	}()
+
+	results = make([]usagestats.UserModelCacheRead, 0)
+	for rows.Next() {
+		var row usagestats.UserModelCacheRead
+		if err := rows.Scan(&row.UserID, &row.Email, &row.Model, &row.RateMultiplier, &row.Requests, &row.CacheReadTokens); err != nil {
+			return nil, err
+		}
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // GetGlobalStats gets usage statistics for all users within a time range
 func (r *usageLogRepository) GetGlobalStats(ctx context.Context, startTime, endTime time.Time) (*UsageStats, error) {
 	query := `
@@ -2023,7 +2200,10 @@ func (r *usageLogRepository) loadAccounts(ctx context.Context, ids []int64) (map
 		return nil, err
 	}
 	for _, m := range models {
-		out[m.ID] = accountEntityToService(m)
+		acc := accountEntityToService(m)
+		// 用量日志只展示账号摘要，不解密也不携带凭证
+		acc.Credentials = nil
+		out[m.ID] = acc
 	}
 	return out, nil
 }
@@ -2090,6 +2270,8 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		ipAddress             sql.NullString
 		imageCount            int
 		imageSize             sql.NullString
+		traceID               sql.NullString
+		organizationID        sql.NullInt64
 		createdAt             time.Time
 	)
 
@@ -2124,6 +2306,8 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		&ipAddress,
 		&imageCount,
 		&imageSize,
+		&traceID,
+		&organizationID,
 		&createdAt,
 	); err != nil {
 		return nil, err
@@ -2183,6 +2367,12 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 	if imageSize.Valid {
 		log.ImageSize = &imageSize.String
 	}
+	if traceID.Valid {
+		log.TraceID = &traceID.String
+	}
+	if organizationID.Valid {
+		log.OrganizationID = &organizationID.Int64
+	}
 
 	return log, nil
 }
@@ -844,6 +844,31 @@ func (s *UsageLogRepoSuite) TestGetAccountWindowStats() {
 	s.Require().Equal(int64(70), stats.Tokens) // (10+20) + (15+25)
 }
 
+// --- GetAccountPeriodUsage ---
+
+func (s *UsageLogRepoSuite) TestGetAccountPeriodUsage() {
+	user := mustCreateUser(s.T(), s.client, &service.User{Email: "periodusage@test.com"})
+	apiKey := mustCreateApiKey(s.T(), s.client, &service.APIKey{UserID: user.ID, Key: "sk-periodusage", Name: "k"})
+	account := mustCreateAccount(s.T(), s.client, &service.Account{Name: "acc-periodusage"})
+
+	now := time.Now()
+	dayStart := now.Add(-1 * time.Hour)
+	weekStart := now.Add(-48 * time.Hour)
+	monthStart := now.Add(-24 * time.Hour) // 月起点晚于周起点（跨月的一周）
+
+	s.createUsageLog(user, apiKey, account, 10, 20, 0.5, now.Add(-10*time.Minute))
+	s.createUsageLog(user, apiKey, account, 15, 25, 0.6, now.Add(-30*time.Hour))
+	s.createUsageLog(user, apiKey, account, 20, 30, 0.7, now.Add(-72*time.Hour)) // outside all periods
+
+	usage, err := s.repo.GetAccountPeriodUsage(s.ctx, account.ID, dayStart, weekStart, monthStart)
+	s.Require().NoError(err, "GetAccountPeriodUsage")
+	s.Require().Equal(int64(30), usage.DailyTokens)
+	s.Require().Equal(int64(70), usage.WeeklyTokens)
+	s.Require().Equal(int64(30), usage.MonthlyTokens)
+	s.Require().InDelta(0.5, usage.DailyCost, 1e-9)
+	s.Require().InDelta(1.1, usage.WeeklyCost, 1e-9)
+}
+
 // --- GetUserUsageTrendByUserID ---
 
 func (s *UsageLogRepoSuite) TestGetUserUsageTrendByUserID() {
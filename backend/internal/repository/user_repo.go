@@ -60,6 +60,9 @@ func (r *userRepository) Create(ctx context.Context, userIn *service.User) error
 		SetBalance(userIn.Balance).
 		SetConcurrency(userIn.Concurrency).
 		SetStatus(userIn.Status).
+		SetRpmLimit(userIn.RateLimits.RPM).
+		SetInputTpmLimit(userIn.RateLimits.InputTPM).
+		SetOutputTpmLimit(userIn.RateLimits.OutputTPM).
 		Save(ctx)
 	if err != nil {
 		return translatePersistenceError(err, nil, service.ErrEmailExists)
@@ -69,6 +72,18 @@ func (r *userRepository) Create(ctx context.Context, userIn *service.User) error
 		return err
 	}
 
+	// 初始余额同样记入账本，保证账本合计与余额一致
+	if created.Balance != 0 {
+		if _, err := txClient.BalanceTransaction.Create().
+			SetUserID(created.ID).
+			SetAmount(created.Balance).
+			SetBalanceAfter(created.Balance).
+			SetSourceType(service.BalanceSourceInitial).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
 	if tx != nil {
 		if err := tx.Commit(); err != nil {
 			return err
@@ -139,9 +154,11 @@ func (r *userRepository) Update(ctx context.Context, userIn *service.User) error
 		SetNotes(userIn.Notes).
 		SetPasswordHash(userIn.PasswordHash).
 		SetRole(userIn.Role).
-		SetBalance(userIn.Balance).
 		SetConcurrency(userIn.Concurrency).
 		SetStatus(userIn.Status).
+		SetRpmLimit(userIn.RateLimits.RPM).
+		SetInputTpmLimit(userIn.RateLimits.InputTPM).
+		SetOutputTpmLimit(userIn.RateLimits.OutputTPM).
 		Save(ctx)
 	if err != nil {
 		return translatePersistenceError(err, service.ErrUserNotFound, service.ErrEmailExists)
@@ -157,6 +174,8 @@ func (r *userRepository) Update(ctx context.Context, userIn *service.User) error
 		}
 	}
 
+	// 余额不随资料更新写入（见 UpdateBalance），回填数据库中的最新值
+	userIn.Balance = updated.Balance
 	userIn.UpdatedAt = updated.UpdatedAt
 	return nil
 }
@@ -318,33 +337,46 @@ func (r *userRepository) filterUsersByAttributes(ctx context.Context, attrs map[
 	return result, nil
 }
 
-func (r *userRepository) UpdateBalance(ctx context.Context, id int64, amount float64) error {
-	client := clientFromContext(ctx, r.client)
-	n, err := client.User.Update().Where(dbuser.IDEQ(id)).AddBalance(amount).Save(ctx)
-	if err != nil {
-		return translatePersistenceError(err, service.ErrUserNotFound, nil)
-	}
-	if n == 0 {
-		return service.ErrUserNotFound
-	}
-	return nil
+func (r *userRepository) UpdateBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
+	return r.applyBalanceChange(ctx, id, amount, source)
 }
 
 // DeductBalance 扣除用户余额
 // 透支策略：允许余额变为负数，确保当前请求能够完成
 // 中间件会阻止余额 <= 0 的用户发起后续请求
-func (r *userRepository) DeductBalance(ctx context.Context, id int64, amount float64) error {
+func (r *userRepository) DeductBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
+	return r.applyBalanceChange(ctx, id, -amount, source)
+}
+
+// applyBalanceChange 在同一条语句内更新余额并追加余额流水，保证账本合计始终等于 users.balance。
+// 金额先按列精度取整，余额与流水使用同一取整后的值。
+func (r *userRepository) applyBalanceChange(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
 	client := clientFromContext(ctx, r.client)
-	n, err := client.User.Update().
-		Where(dbuser.IDEQ(id)).
-		AddBalance(-amount).
-		Save(ctx)
-	if err != nil {
-		return err
-	}
-	if n == 0 {
+	query := `
+		WITH updated AS (
+			UPDATE users
+			SET balance = balance + $2::decimal(20,8), updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id, balance
+		)
+		INSERT INTO balance_transactions (user_id, amount, balance_after, source_type, reference_id, actor_user_id, notes, created_at)
+		SELECT id, $2::decimal(20,8), balance, $3, $4, $5, $6, NOW() FROM updated
+		RETURNING id`
+	var txID int64
+	err := scanSingleRow(ctx, client, query, []any{
+		id,
+		amount,
+		source.Type,
+		source.ReferenceID,
+		source.ActorUserID,
+		source.Notes,
+	}, &txID)
+	if errors.Is(err, sql.ErrNoRows) {
 		return service.ErrUserNotFound
 	}
+	if err != nil {
+		return translatePersistenceError(err, service.ErrUserNotFound, nil)
+	}
 	return nil
 }
 
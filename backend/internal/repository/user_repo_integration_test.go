@@ -8,6 +8,7 @@ import (
 	"time"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/balancetransaction"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/stretchr/testify/suite"
@@ -35,6 +36,8 @@ func TestUserRepoSuite(t *testing.T) {
 	suite.Run(t, new(UserRepoSuite))
 }
 
+var testBalanceSource = service.BalanceChangeSource{Type: service.BalanceSourceAdminAdjustment}
+
 func (s *UserRepoSuite) mustCreateUser(u *service.User) *service.User {
 	s.T().Helper()
 
@@ -257,7 +260,7 @@ func (s *UserRepoSuite) TestListWithFilters_CombinedFilters() {
 func (s *UserRepoSuite) TestUpdateBalance() {
 	user := s.mustCreateUser(&service.User{Email: "bal@test.com", Balance: 10})
 
-	err := s.repo.UpdateBalance(s.ctx, user.ID, 2.5)
+	err := s.repo.UpdateBalance(s.ctx, user.ID, 2.5, testBalanceSource)
 	s.Require().NoError(err, "UpdateBalance")
 
 	got, err := s.repo.GetByID(s.ctx, user.ID)
@@ -268,7 +271,7 @@ func (s *UserRepoSuite) TestUpdateBalance() {
 func (s *UserRepoSuite) TestUpdateBalance_Negative() {
 	user := s.mustCreateUser(&service.User{Email: "balneg@test.com", Balance: 10})
 
-	err := s.repo.UpdateBalance(s.ctx, user.ID, -3)
+	err := s.repo.UpdateBalance(s.ctx, user.ID, -3, testBalanceSource)
 	s.Require().NoError(err, "UpdateBalance with negative")
 
 	got, err := s.repo.GetByID(s.ctx, user.ID)
@@ -279,7 +282,7 @@ func (s *UserRepoSuite) TestUpdateBalance_Negative() {
 func (s *UserRepoSuite) TestDeductBalance() {
 	user := s.mustCreateUser(&service.User{Email: "deduct@test.com", Balance: 10})
 
-	err := s.repo.DeductBalance(s.ctx, user.ID, 5)
+	err := s.repo.DeductBalance(s.ctx, user.ID, 5, testBalanceSource)
 	s.Require().NoError(err, "DeductBalance")
 
 	got, err := s.repo.GetByID(s.ctx, user.ID)
@@ -291,7 +294,7 @@ func (s *UserRepoSuite) TestDeductBalance_InsufficientFunds() {
 	user := s.mustCreateUser(&service.User{Email: "insuf@test.com", Balance: 5})
 
 	// 透支策略：允许扣除超过余额的金额
-	err := s.repo.DeductBalance(s.ctx, user.ID, 999)
+	err := s.repo.DeductBalance(s.ctx, user.ID, 999, testBalanceSource)
 	s.Require().NoError(err, "DeductBalance should allow overdraft")
 
 	// 验证余额变为负数
@@ -303,7 +306,7 @@ func (s *UserRepoSuite) TestDeductBalance_InsufficientFunds() {
 func (s *UserRepoSuite) TestDeductBalance_ExactAmount() {
 	user := s.mustCreateUser(&service.User{Email: "exact@test.com", Balance: 10})
 
-	err := s.repo.DeductBalance(s.ctx, user.ID, 10)
+	err := s.repo.DeductBalance(s.ctx, user.ID, 10, testBalanceSource)
 	s.Require().NoError(err, "DeductBalance exact amount")
 
 	got, err := s.repo.GetByID(s.ctx, user.ID)
@@ -315,7 +318,7 @@ func (s *UserRepoSuite) TestDeductBalance_AllowsOverdraft() {
 	user := s.mustCreateUser(&service.User{Email: "overdraft@test.com", Balance: 5.0})
 
 	// 扣除超过余额的金额 - 应该成功
-	err := s.repo.DeductBalance(s.ctx, user.ID, 10.0)
+	err := s.repo.DeductBalance(s.ctx, user.ID, 10.0, testBalanceSource)
 	s.Require().NoError(err, "DeductBalance should allow overdraft")
 
 	// 验证余额为负
@@ -324,6 +327,56 @@ func (s *UserRepoSuite) TestDeductBalance_AllowsOverdraft() {
 	s.Require().InDelta(-5.0, got.Balance, 1e-6, "Balance should be -5.0 after overdraft")
 }
 
+func (s *UserRepoSuite) TestBalanceChanges_AppendLedger() {
+	user := s.mustCreateUser(&service.User{Email: "ledger@test.com", Balance: 10})
+
+	actorID := int64(1)
+	s.Require().NoError(s.repo.UpdateBalance(s.ctx, user.ID, 2.5, service.BalanceChangeSource{
+		Type:        service.BalanceSourceAdminAdjustment,
+		ReferenceID: "ADJ-1",
+		ActorUserID: &actorID,
+		Notes:       "manual top-up",
+	}))
+	s.Require().NoError(s.repo.DeductBalance(s.ctx, user.ID, 5, service.BalanceChangeSource{
+		Type:        service.BalanceSourceUsage,
+		ReferenceID: "req-1",
+	}))
+
+	txs, err := s.client.BalanceTransaction.Query().
+		Where(balancetransaction.UserIDEQ(user.ID)).
+		Order(dbent.Asc(balancetransaction.FieldID)).
+		All(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 3)
+
+	s.Require().Equal(service.BalanceSourceInitial, txs[0].SourceType)
+	s.Require().InDelta(10.0, txs[0].BalanceAfter, 1e-6)
+
+	s.Require().Equal("ADJ-1", txs[1].ReferenceID)
+	s.Require().Equal(&actorID, txs[1].ActorUserID)
+	s.Require().InDelta(12.5, txs[1].BalanceAfter, 1e-6)
+
+	s.Require().Equal(service.BalanceSourceUsage, txs[2].SourceType)
+	s.Require().InDelta(-5.0, txs[2].Amount, 1e-6)
+	s.Require().InDelta(7.5, txs[2].BalanceAfter, 1e-6)
+}
+
+func (s *UserRepoSuite) TestUpdate_DoesNotOverwriteBalance() {
+	user := s.mustCreateUser(&service.User{Email: "stale@test.com", Balance: 10})
+	stale, err := s.repo.GetByID(s.ctx, user.ID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.DeductBalance(s.ctx, user.ID, 4, testBalanceSource))
+
+	stale.Username = "renamed"
+	s.Require().NoError(s.repo.Update(s.ctx, stale))
+	s.Require().InDelta(6.0, stale.Balance, 1e-6, "Update should refresh balance from database")
+
+	got, err := s.repo.GetByID(s.ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().InDelta(6.0, got.Balance, 1e-6)
+}
+
 // --- Concurrency ---
 
 func (s *UserRepoSuite) TestUpdateConcurrency() {
@@ -485,18 +538,18 @@ func (s *UserRepoSuite) TestCRUD_And_Filters_And_AtomicUpdates() {
 	s.Require().NoError(err, "GetByID after update")
 	s.Require().Equal("Alice2", got2.Username, "Update did not persist")
 
-	s.Require().NoError(s.repo.UpdateBalance(s.ctx, user1.ID, 2.5), "UpdateBalance")
+	s.Require().NoError(s.repo.UpdateBalance(s.ctx, user1.ID, 2.5, testBalanceSource), "UpdateBalance")
 	got3, err := s.repo.GetByID(s.ctx, user1.ID)
 	s.Require().NoError(err, "GetByID after UpdateBalance")
 	s.Require().InDelta(12.5, got3.Balance, 1e-6)
 
-	s.Require().NoError(s.repo.DeductBalance(s.ctx, user1.ID, 5), "DeductBalance")
+	s.Require().NoError(s.repo.DeductBalance(s.ctx, user1.ID, 5, testBalanceSource), "DeductBalance")
 	got4, err := s.repo.GetByID(s.ctx, user1.ID)
 	s.Require().NoError(err, "GetByID after DeductBalance")
 	s.Require().InDelta(7.5, got4.Balance, 1e-6)
 
 	// 透支策略：允许扣除超过余额的金额
-	err = s.repo.DeductBalance(s.ctx, user1.ID, 999)
+	err = s.repo.DeductBalance(s.ctx, user1.ID, 999, testBalanceSource)
 	s.Require().NoError(err, "DeductBalance should allow overdraft")
 	gotOverdraft, err := s.repo.GetByID(s.ctx, user1.ID)
 	s.Require().NoError(err, "GetByID after overdraft")
@@ -518,7 +571,7 @@ func (s *UserRepoSuite) TestCRUD_And_Filters_And_AtomicUpdates() {
 // --- UpdateBalance/UpdateConcurrency 影响行数校验测试 ---
 
 func (s *UserRepoSuite) TestUpdateBalance_NotFound() {
-	err := s.repo.UpdateBalance(s.ctx, 999999, 10.0)
+	err := s.repo.UpdateBalance(s.ctx, 999999, 10.0, testBalanceSource)
 	s.Require().Error(err, "expected error for non-existent user")
 	s.Require().ErrorIs(err, service.ErrUserNotFound)
 }
@@ -530,7 +583,7 @@ func (s *UserRepoSuite) TestUpdateConcurrency_NotFound() {
 }
 
 func (s *UserRepoSuite) TestDeductBalance_NotFound() {
-	err := s.repo.DeductBalance(s.ctx, 999999, 5)
+	err := s.repo.DeductBalance(s.ctx, 999999, 5, testBalanceSource)
 	s.Require().Error(err, "expected error for non-existent user")
 	// DeductBalance 在用户不存在时返回 ErrUserNotFound
 	s.Require().ErrorIs(err, service.ErrUserNotFound)
@@ -8,6 +8,8 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	entsql "entgo.io/ent/dialect/sql"
 )
 
 type userSubscriptionRepository struct {
@@ -381,6 +383,83 @@ func (r *userSubscriptionRepository) BatchUpdateExpiredStatus(ctx context.Contex
 	return int64(n), err
 }
 
+func (r *userSubscriptionRepository) ApplyPlan(ctx context.Context, id int64, plan *service.SubscriptionPlan) error {
+	if plan == nil {
+		return service.ErrSubscriptionNilInput
+	}
+	client := clientFromContext(ctx, r.client)
+	builder := client.UserSubscription.UpdateOneID(id).SetPlanID(plan.ID)
+	if plan.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*plan.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if plan.WeeklyLimitUSD != nil {
+		builder.SetWeeklyLimitUsd(*plan.WeeklyLimitUSD)
+	} else {
+		builder.ClearWeeklyLimitUsd()
+	}
+	if plan.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*plan.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+	_, err := builder.Save(ctx)
+	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+}
+
+func (r *userSubscriptionRepository) SetAutoRenew(ctx context.Context, id int64, autoRenew bool) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.UserSubscription.UpdateOneID(id).
+		SetAutoRenew(autoRenew).
+		Save(ctx)
+	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+}
+
+func (r *userSubscriptionRepository) ListRenewalDue(ctx context.Context, before time.Time, limit int) ([]service.UserSubscription, error) {
+	client := clientFromContext(ctx, r.client)
+	subs, err := client.UserSubscription.Query().
+		Where(
+			usersubscription.StatusEQ(service.SubscriptionStatusActive),
+			usersubscription.PlanIDNotNil(),
+			usersubscription.ExpiresAtLTE(before),
+			func(s *entsql.Selector) {
+				s.Where(entsql.Or(
+					entsql.IsNull(s.C(usersubscription.FieldRenewalNoticeFor)),
+					entsql.ColumnsNEQ(s.C(usersubscription.FieldRenewalNoticeFor), s.C(usersubscription.FieldExpiresAt)),
+				))
+			},
+		).
+		WithUser().
+		WithGroup().
+		Order(dbent.Asc(usersubscription.FieldExpiresAt), dbent.Asc(usersubscription.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return userSubscriptionEntitiesToService(subs), nil
+}
+
+func (r *userSubscriptionRepository) ClaimRenewalNotice(ctx context.Context, id int64, expiresAt time.Time) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.UserSubscription.Update().
+		Where(
+			usersubscription.IDEQ(id),
+			usersubscription.ExpiresAtEQ(expiresAt),
+			usersubscription.Or(
+				usersubscription.RenewalNoticeForIsNil(),
+				usersubscription.RenewalNoticeForNEQ(expiresAt),
+			),
+		).
+		SetRenewalNoticeFor(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 // Extra repository helpers (currently used only by integration tests).
 
 func (r *userSubscriptionRepository) ListExpired(ctx context.Context) ([]service.UserSubscription, error) {
@@ -441,6 +520,12 @@ func userSubscriptionEntityToService(m *dbent.UserSubscription) *service.UserSub
 		AssignedBy:         m.AssignedBy,
 		AssignedAt:         m.AssignedAt,
 		Notes:              derefString(m.Notes),
+		PlanID:             m.PlanID,
+		AutoRenew:          m.AutoRenew,
+		DailyLimitUSD:      m.DailyLimitUsd,
+		WeeklyLimitUSD:     m.WeeklyLimitUsd,
+		MonthlyLimitUSD:    m.MonthlyLimitUsd,
+		RenewalNoticeFor:   m.RenewalNoticeFor,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 	}
@@ -54,10 +54,22 @@ var ProviderSet = wire.NewSet(
 	NewGroupRepository,
 	NewAccountRepository,
 	NewProxyRepository,
+	NewProxyPoolRepository,
+	NewProxySubscriptionRepository,
 	NewRedeemCodeRepository,
 	NewPromoCodeRepository,
 	NewUsageLogRepository,
 	NewUsageCleanupRepository,
+	NewUsageExportRepository,
+	NewInvoiceRepository,
+	NewPaymentOrderRepository,
+	NewSubscriptionPlanRepository,
+	NewModelPriceRepository,
+	NewAuditLogRepository,
+	NewBalanceLedgerRepository,
+	NewAdminAPIKeyRepository,
+	NewOrganizationRepository,
+	NewSecretRotationRepository,
 	NewDashboardAggregationRepository,
 	NewSettingRepository,
 	NewOpsRepository,
@@ -73,6 +85,7 @@ var ProviderSet = wire.NewSet(
 	NewTimeoutCounterCache,
 	ProvideConcurrencyCache,
 	ProvideSessionLimitCache,
+	NewRequestRateLimitCache,
 	NewDashboardCache,
 	NewEmailCache,
 	NewIdentityCache,
@@ -82,10 +95,15 @@ var ProviderSet = wire.NewSet(
 	NewSchedulerCache,
 	NewSchedulerOutboxRepository,
 	NewProxyLatencyCache,
+	NewProxyHealthCache,
+	NewAccountHealthCache,
+	NewAccountBudgetCache,
 	NewTotpCache,
+	ProvideResponseCacheStore,
 
 	// Encryptors
 	NewAESEncryptor,
+	NewSecretCipher,
 
 	// HTTP service ports (DI Strategy A: return interface directly)
 	NewTurnstileVerifier,
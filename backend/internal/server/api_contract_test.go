@@ -56,6 +56,9 @@ func TestAPIContracts(t *testing.T) {
 					"concurrency": 5,
 					"status": "active",
 					"allowed_groups": null,
+					"rpm_limit": 0,
+					"input_tpm_limit": 0,
+					"output_tpm_limit": 0,
 					"created_at": "2025-01-02T03:04:05Z",
 					"updated_at": "2025-01-02T03:04:05Z",
 					"run_mode": "standard"
@@ -83,6 +86,20 @@ func TestAPIContracts(t *testing.T) {
 					"status": "active",
 					"ip_whitelist": null,
 					"ip_blacklist": null,
+					"allowed_models": null,
+					"model_aliases": null,
+					"quota_usd": null,
+					"daily_limit_usd": null,
+					"monthly_limit_usd": null,
+					"expires_at": null,
+					"quota_used_usd": 0,
+					"daily_usage_usd": 0,
+					"monthly_usage_usd": 0,
+					"rpm_limit": 0,
+					"input_tpm_limit": 0,
+					"output_tpm_limit": 0,
+					"response_cache_enabled": false,
+					"response_cache_ttl_seconds": 0,
 					"created_at": "2025-01-02T03:04:05Z",
 					"updated_at": "2025-01-02T03:04:05Z"
 				}
@@ -119,6 +136,20 @@ func TestAPIContracts(t *testing.T) {
 							"status": "active",
 							"ip_whitelist": null,
 							"ip_blacklist": null,
+							"allowed_models": null,
+							"model_aliases": null,
+							"quota_usd": null,
+							"daily_limit_usd": null,
+							"monthly_limit_usd": null,
+							"expires_at": null,
+							"quota_used_usd": 0,
+							"daily_usage_usd": 0,
+							"monthly_usage_usd": 0,
+							"rpm_limit": 0,
+							"input_tpm_limit": 0,
+							"output_tpm_limit": 0,
+							"response_cache_enabled": false,
+							"response_cache_ttl_seconds": 0,
 							"created_at": "2025-01-02T03:04:05Z",
 							"updated_at": "2025-01-02T03:04:05Z"
 						}
@@ -180,6 +211,12 @@ func TestAPIContracts(t *testing.T) {
 						"image_price_4k": null,
 						"claude_code_only": false,
 						"fallback_group_id": null,
+						"rpm_limit": 0,
+						"input_tpm_limit": 0,
+						"output_tpm_limit": 0,
+						"response_cache_enabled": false,
+						"response_cache_ttl_seconds": 0,
+						"response_cache_cost_ratio": 0,
 						"created_at": "2025-01-02T03:04:05Z",
 						"updated_at": "2025-01-02T03:04:05Z"
 					}
@@ -230,6 +267,11 @@ func TestAPIContracts(t *testing.T) {
 						"daily_usage_usd": 1.23,
 						"weekly_usage_usd": 2.34,
 						"monthly_usage_usd": 3.45,
+						"plan_id": null,
+						"auto_renew": false,
+						"daily_limit_usd": null,
+						"weekly_limit_usd": null,
+						"monthly_limit_usd": null,
 						"created_at": "2025-01-02T03:04:05Z",
 						"updated_at": "2025-01-02T03:04:05Z"
 					}
@@ -395,7 +437,8 @@ func TestAPIContracts(t *testing.T) {
 							"image_count": 0,
 							"image_size": null,
 							"created_at": "2025-01-02T03:04:05Z",
-							"user_agent": null
+							"user_agent": null,
+							"trace_id": null
 						}
 					],
 					"total": 1,
@@ -489,7 +532,25 @@ func TestAPIContracts(t *testing.T) {
 					"enable_identity_patch": true,
 					"identity_patch_prompt": "",
 					"home_content": "",
-					"hide_ccs_import_button": false
+					"hide_ccs_import_button": false,
+					"creem_enabled": false,
+					"creem_api_key_configured": false,
+					"creem_webhook_secret_configured": false,
+					"creem_product_id": "",
+					"creem_rate_multiplier": 10,
+					"creem_success_url": "",
+					"stripe_enabled": false,
+					"stripe_secret_key_configured": false,
+					"stripe_webhook_secret_configured": false,
+					"stripe_currency": "usd",
+					"stripe_rate_multiplier": 1,
+					"epay_enabled": false,
+					"epay_gateway_url": "",
+					"epay_merchant_id": "",
+					"epay_merchant_key_configured": false,
+					"epay_pay_types": "alipay,wxpay",
+					"epay_currency": "CNY",
+					"epay_rate_multiplier": 1
 				}
 			}`,
 		},
@@ -597,12 +658,12 @@ func newContractDeps(t *testing.T) *contractDeps {
 	settingRepo := newStubSettingRepo()
 	settingService := service.NewSettingService(settingRepo, cfg)
 
-	adminService := service.NewAdminService(userRepo, groupRepo, &accountRepo, proxyRepo, apiKeyRepo, redeemRepo, nil, nil, nil, nil)
+	adminService := service.NewAdminService(userRepo, groupRepo, &accountRepo, proxyRepo, apiKeyRepo, redeemRepo, nil, nil, nil, nil, nil)
 	authHandler := handler.NewAuthHandler(cfg, nil, userService, settingService, nil, nil)
 	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
-	usageHandler := handler.NewUsageHandler(usageService, apiKeyService)
+	usageHandler := handler.NewUsageHandler(usageService, apiKeyService, nil, nil)
 	adminSettingHandler := adminhandler.NewSettingHandler(settingService, nil, nil, nil)
-	adminAccountHandler := adminhandler.NewAccountHandler(adminService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	adminAccountHandler := adminhandler.NewAccountHandler(adminService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	jwtAuth := func(c *gin.Context) {
 		c.Set(string(middleware.ContextKeyUser), middleware.AuthSubject{
@@ -737,11 +798,11 @@ func (r *stubUserRepo) ListWithFilters(ctx context.Context, params pagination.Pa
 	return nil, nil, errors.New("not implemented")
 }
 
-func (r *stubUserRepo) UpdateBalance(ctx context.Context, id int64, amount float64) error {
+func (r *stubUserRepo) UpdateBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
 	return errors.New("not implemented")
 }
 
-func (r *stubUserRepo) DeductBalance(ctx context.Context, id int64, amount float64) error {
+func (r *stubUserRepo) DeductBalance(ctx context.Context, id int64, amount float64, source service.BalanceChangeSource) error {
 	return errors.New("not implemented")
 }
 
@@ -1224,6 +1285,18 @@ func (stubUserSubscriptionRepo) IncrementUsage(ctx context.Context, id int64, co
 func (stubUserSubscriptionRepo) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
 	return 0, errors.New("not implemented")
 }
+func (stubUserSubscriptionRepo) ApplyPlan(ctx context.Context, id int64, plan *service.SubscriptionPlan) error {
+	return errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) SetAutoRenew(ctx context.Context, id int64, autoRenew bool) error {
+	return errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) ListRenewalDue(ctx context.Context, before time.Time, limit int) ([]service.UserSubscription, error) {
+	return nil, errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) ClaimRenewalNotice(ctx context.Context, id int64, expiresAt time.Time) (bool, error) {
+	return false, errors.New("not implemented")
+}
 
 type stubApiKeyRepo struct {
 	now time.Time
@@ -1423,6 +1496,10 @@ func (r *stubApiKeyRepo) ListKeysByGroupID(ctx context.Context, groupID int64) (
 	return nil, errors.New("not implemented")
 }
 
+func (r *stubApiKeyRepo) IncrementUsage(ctx context.Context, id int64, costUSD float64, dayStart, monthStart time.Time) error {
+	return errors.New("not implemented")
+}
+
 type stubUsageLogRepo struct {
 	userLogs map[int64][]service.UsageLog
 }
@@ -1487,6 +1564,10 @@ func (r *stubUsageLogRepo) GetAccountTodayStats(ctx context.Context, accountID i
 	return nil, errors.New("not implemented")
 }
 
+func (r *stubUsageLogRepo) GetAccountPeriodUsage(ctx context.Context, accountID int64, dayStart, weekStart, monthStart time.Time) (*usagestats.AccountPeriodUsage, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (r *stubUsageLogRepo) GetDashboardStats(ctx context.Context) (*usagestats.DashboardStats, error) {
 	return nil, errors.New("not implemented")
 }
@@ -1576,6 +1657,18 @@ func (r *stubUsageLogRepo) GetBatchAPIKeyUsageStats(ctx context.Context, apiKeyI
 	return nil, errors.New("not implemented")
 }
 
+func (r *stubUsageLogRepo) GetPromptCacheStatsByAccount(ctx context.Context, startTime, endTime time.Time, groupID int64) ([]usagestats.PromptCacheStat, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *stubUsageLogRepo) GetPromptCacheStatsByGroup(ctx context.Context, startTime, endTime time.Time) ([]usagestats.PromptCacheStat, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *stubUsageLogRepo) GetUserModelCacheReads(ctx context.Context, startTime, endTime time.Time, userID int64) ([]usagestats.UserModelCacheRead, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (r *stubUsageLogRepo) GetUserDashboardStats(ctx context.Context, userID int64) (*usagestats.UserDashboardStats, error) {
 	return nil, errors.New("not implemented")
 }
@@ -2,7 +2,6 @@
 package middleware
 
 import (
-	"crypto/subtle"
 	"errors"
 	"strings"
 
@@ -15,19 +14,21 @@ import (
 func NewAdminAuthMiddleware(
 	authService *service.AuthService,
 	userService *service.UserService,
-	settingService *service.SettingService,
+	adminAPIKeyService *service.AdminAPIKeyService,
 ) AdminAuthMiddleware {
-	return AdminAuthMiddleware(adminAuth(authService, userService, settingService))
+	return AdminAuthMiddleware(adminAuth(authService, userService, adminAPIKeyService))
 }
 
 // adminAuth 管理员认证中间件实现
 // 支持两种认证方式（通过不同的 header 区分）：
 // 1. Admin API Key: x-api-key: <admin-api-key>
-// 2. JWT Token: Authorization: Bearer <jwt-token> (需要管理员角色)
+// 2. JWT Token: Authorization: Bearer <jwt-token> (需要管理端角色)
+//
+// 认证通过后写入有效权限集合，具体路由组由 RequireAdminPermission 校验。
 func adminAuth(
 	authService *service.AuthService,
 	userService *service.UserService,
-	settingService *service.SettingService,
+	adminAPIKeyService *service.AdminAPIKeyService,
 ) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// WebSocket upgrade requests cannot set Authorization headers in browsers.
@@ -47,7 +48,7 @@ func adminAuth(
 		// 检查 x-api-key header（Admin API Key 认证）
 		apiKey := c.GetHeader("x-api-key")
 		if apiKey != "" {
-			if !validateAdminAPIKey(c, apiKey, settingService, userService) {
+			if !validateAdminAPIKey(c, apiKey, adminAPIKeyService) {
 				return
 			}
 			c.Next()
@@ -110,37 +111,31 @@ func extractJWTFromWebSocketSubprotocol(c *gin.Context) string {
 	return ""
 }
 
-// validateAdminAPIKey 验证管理员 API Key
+// validateAdminAPIKey 验证管理端 API Key
+// 请求以创建该 Key 的管理员身份执行，权限为 Key scope 与创建者当前角色权限的交集。
 func validateAdminAPIKey(
 	c *gin.Context,
 	key string,
-	settingService *service.SettingService,
-	userService *service.UserService,
+	adminAPIKeyService *service.AdminAPIKeyService,
 ) bool {
-	storedKey, err := settingService.GetAdminAPIKey(c.Request.Context())
+	principal, err := adminAPIKeyService.Authenticate(c.Request.Context(), key)
 	if err != nil {
+		// 不存在、已过期或创建者不可用，统一返回相同错误（避免信息泄露）
+		if errors.Is(err, service.ErrAdminAPIKeyInvalid) {
+			AbortWithError(c, 401, "INVALID_ADMIN_KEY", "Invalid admin API key")
+			return false
+		}
 		AbortWithError(c, 500, "INTERNAL_ERROR", "Internal server error")
 		return false
 	}
 
-	// 未配置或不匹配，统一返回相同错误（避免信息泄露）
-	if storedKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(storedKey)) != 1 {
-		AbortWithError(c, 401, "INVALID_ADMIN_KEY", "Invalid admin API key")
-		return false
-	}
-
-	// 获取真实的管理员用户
-	admin, err := userService.GetFirstAdmin(c.Request.Context())
-	if err != nil {
-		AbortWithError(c, 500, "INTERNAL_ERROR", "No admin user found")
-		return false
-	}
-
+	actor := principal.Actor
 	c.Set(string(ContextKeyUser), AuthSubject{
-		UserID:      admin.ID,
-		Concurrency: admin.Concurrency,
+		UserID:      actor.ID,
+		Concurrency: actor.Concurrency,
 	})
-	c.Set(string(ContextKeyUserRole), admin.Role)
+	c.Set(string(ContextKeyUserRole), actor.Role)
+	c.Set(string(ContextKeyAdminPermissions), principal.Permissions)
 	c.Set("auth_method", "admin_api_key")
 	return true
 }
@@ -176,8 +171,8 @@ func validateJWTForAdmin(
 		return false
 	}
 
-	// 检查管理员权限
-	if !user.IsAdmin() {
+	// 检查管理端角色
+	if !user.IsStaff() {
 		AbortWithError(c, 403, "FORBIDDEN", "Admin access required")
 		return false
 	}
@@ -187,6 +182,7 @@ func validateJWTForAdmin(
 		Concurrency: user.Concurrency,
 	})
 	c.Set(string(ContextKeyUserRole), user.Role)
+	c.Set(string(ContextKeyAdminPermissions), service.AdminPermissionsForRole(user.Role))
 	c.Set("auth_method", "jwt")
 
 	return true
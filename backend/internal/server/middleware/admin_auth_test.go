@@ -0,0 +1,64 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/Wei-Shaw/sub2api/internal/service/servicetest"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminAuth_AdminAPIKeyActsAsCreator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	adminID, financeID := int64(1), int64(2)
+	users := servicetest.NewUserRepo(
+		&service.User{ID: adminID, Role: service.RoleAdmin, Status: service.StatusActive, Concurrency: 3},
+		&service.User{ID: financeID, Role: service.RoleFinance, Status: service.StatusActive, Concurrency: 5},
+	)
+	repo := servicetest.NewAdminAPIKeyRepo()
+	repo.Add("legacy-key", &service.AdminAPIKey{ID: 1, Scopes: []string{"*"}})
+	repo.Add("finance-key", &service.AdminAPIKey{
+		ID:        2,
+		Scopes:    []string{string(service.AdminPermissionBilling), string(service.AdminPermissionUsersView)},
+		CreatedBy: &financeID,
+	})
+
+	r := gin.New()
+	r.Use(adminAuth(nil, nil, service.NewAdminAPIKeyService(repo, users)))
+	r.GET("/t", func(c *gin.Context) {
+		subject, _ := GetAuthSubjectFromContext(c)
+		perms, _ := c.Get(string(ContextKeyAdminPermissions))
+		c.JSON(http.StatusOK, gin.H{"user_id": subject.UserID, "perms": perms.(service.AdminPermissionSet).List()})
+	})
+	do := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/t", nil)
+		req.Header.Set("x-api-key", key)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do("legacy-key")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"user_id":1,"perms":["*"]}`, w.Body.String())
+
+	w = do("finance-key")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"user_id":2,"perms":["billing:manage","users:view"]}`, w.Body.String())
+
+	// 创建者降级为 support：billing 权限随之失效
+	users.Users[financeID].Role = service.RoleSupport
+	w = do("finance-key")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"user_id":2,"perms":["users:view"]}`, w.Body.String())
+
+	// 创建者被禁用：Key 失效而不是回退到超级管理员
+	users.Users[financeID].Status = service.StatusDisabled
+	require.Equal(t, http.StatusUnauthorized, do("finance-key").Code)
+}
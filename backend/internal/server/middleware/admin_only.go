@@ -6,7 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// AdminOnly 管理员权限中间件
+// AdminOnly 管理端角色中间件（超级管理员、客服、财务、运维），细粒度权限见 RequireAdminPermission
 // 必须在JWTAuth中间件之后使用
 func AdminOnly() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -16,8 +16,8 @@ func AdminOnly() gin.HandlerFunc {
 			return
 		}
 
-		// 检查是否为管理员
-		if role != service.RoleAdmin {
+		// 检查是否为管理端角色
+		if !service.IsAdminRole(role) {
 			AbortWithError(c, 403, "FORBIDDEN", "Admin access required")
 			return
 		}
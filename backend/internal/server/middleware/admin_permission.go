@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdminPermission 管理端权限中间件，持有任一指定权限即放行
+// 必须在 AdminAuth 中间件之后使用
+func RequireAdminPermission(perms ...service.AdminPermission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		granted, ok := GetAdminPermissionsFromContext(c)
+		if !ok {
+			AbortWithError(c, 401, "UNAUTHORIZED", "User not found in context")
+			return
+		}
+
+		if !granted.HasAny(perms...) {
+			AbortWithError(c, 403, "FORBIDDEN", "Insufficient admin permissions")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetAdminPermissionsFromContext 获取当前管理端请求的有效权限集合
+func GetAdminPermissionsFromContext(c *gin.Context) (service.AdminPermissionSet, bool) {
+	value, exists := c.Get(string(ContextKeyAdminPermissions))
+	if !exists {
+		return nil, false
+	}
+	perms, ok := value.(service.AdminPermissionSet)
+	return perms, ok
+}
+
+// HasAdminPermission 判断当前管理端请求是否持有指定权限
+func HasAdminPermission(c *gin.Context, perm service.AdminPermission) bool {
+	perms, ok := GetAdminPermissionsFromContext(c)
+	return ok && perms.Has(perm)
+}
@@ -0,0 +1,57 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireAdminPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	do := func(perms service.AdminPermissionSet, required ...service.AdminPermission) int {
+		r := gin.New()
+		r.Use(func(c *gin.Context) {
+			if perms != nil {
+				c.Set(string(ContextKeyAdminPermissions), perms)
+			}
+			c.Next()
+		})
+		r.GET("/t", RequireAdminPermission(required...), func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/t", nil))
+		return w.Code
+	}
+
+	// 未经过 adminAuth
+	require.Equal(t, http.StatusUnauthorized, do(nil, service.AdminPermissionUsersView))
+
+	superAdmin := service.AdminPermissionsForRole(service.RoleAdmin)
+	require.Equal(t, http.StatusOK, do(superAdmin, service.AdminPermissionSystemManage))
+
+	support := service.AdminPermissionsForRole(service.RoleSupport)
+	require.Equal(t, http.StatusOK, do(support, service.AdminPermissionUsersView))
+	require.Equal(t, http.StatusForbidden, do(support, service.AdminPermissionBilling))
+	require.Equal(t, http.StatusForbidden, do(support, service.AdminPermissionAccountsManage))
+
+	finance := service.AdminPermissionsForRole(service.RoleFinance)
+	require.Equal(t, http.StatusOK, do(finance, service.AdminPermissionBilling))
+	require.Equal(t, http.StatusForbidden, do(finance, service.AdminPermissionOps))
+
+	ops := service.AdminPermissionsForRole(service.RoleOps)
+	require.Equal(t, http.StatusOK, do(ops, service.AdminPermissionProxiesManage))
+	require.Equal(t, http.StatusForbidden, do(ops, service.AdminPermissionUsersView))
+	// 任一权限即放行
+	require.Equal(t, http.StatusOK, do(ops, service.AdminPermissionUsersView, service.AdminPermissionOps))
+
+	// 普通用户角色没有任何管理权限
+	require.Equal(t, http.StatusForbidden, do(service.AdminPermissionsForRole(service.RoleUser), service.AdminPermissionDashboardView))
+}
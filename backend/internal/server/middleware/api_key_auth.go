@@ -9,9 +9,11 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // NewAPIKeyAuthMiddleware 创建 API Key 认证中间件
@@ -58,7 +60,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 		}
 
 		// 从数据库验证API key
-		apiKey, err := apiKeyService.GetByKey(c.Request.Context(), apiKeyString)
+		apiKey, err := lookupAPIKey(c.Request.Context(), apiKeyService, apiKeyString)
 		if err != nil {
 			if errors.Is(err, service.ErrAPIKeyNotFound) {
 				AbortWithError(c, 401, "INVALID_API_KEY", "Invalid API key")
@@ -74,6 +76,12 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			return
 		}
 
+		// 检查API key是否过期
+		if apiKey.IsExpired() {
+			AbortWithError(c, 401, "API_KEY_EXPIRED", "API key has expired")
+			return
+		}
+
 		// 检查 IP 限制（白名单/黑名单）
 		// 注意：错误信息故意模糊，避免暴露具体的 IP 限制机制
 		if len(apiKey.IPWhitelist) > 0 || len(apiKey.IPBlacklist) > 0 {
@@ -115,9 +123,10 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 
 		if isSubscriptionType && subscriptionService != nil {
 			// 订阅模式：验证订阅
-			subscription, err := subscriptionService.GetActiveSubscription(
+			// 组织成员无个人订阅时使用组织所有者的订阅
+			subscription, err := subscriptionService.ResolveActiveSubscription(
 				c.Request.Context(),
-				apiKey.User.ID,
+				apiKey.User,
 				apiKey.Group.ID,
 			)
 			if err != nil {
@@ -150,8 +159,8 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			// 将订阅信息存入上下文
 			c.Set(string(ContextKeySubscription), subscription)
 		} else {
-			// 余额模式：检查用户余额
-			if apiKey.User.Balance <= 0 {
+			// 余额模式：检查用户余额（组织成员使用组织钱包，由计费资格检查负责）
+			if apiKey.User.Organization == nil && apiKey.User.Balance <= 0 {
 				AbortWithError(c, 403, "INSUFFICIENT_BALANCE", "Insufficient account balance")
 				return
 			}
@@ -190,6 +199,23 @@ func GetSubscriptionFromContext(c *gin.Context) (*service.UserSubscription, bool
 	return subscription, ok
 }
 
+// lookupAPIKey 查询并校验 API Key（记录 auth.api_key span）
+func lookupAPIKey(ctx context.Context, apiKeyService *service.APIKeyService, key string) (*service.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "auth.api_key")
+	apiKey, err := apiKeyService.GetByKey(ctx, key)
+	if apiKey != nil {
+		span.SetAttributes(
+			attribute.Int64("api_key.id", apiKey.ID),
+			attribute.Int64("user.id", apiKey.UserID),
+		)
+		if apiKey.GroupID != nil {
+			span.SetAttributes(attribute.Int64("group.id", *apiKey.GroupID))
+		}
+	}
+	tracing.End(span, err)
+	return apiKey, err
+}
+
 func setGroupContext(c *gin.Context, group *service.Group) {
 	if !service.IsGroupContextValid(group) {
 		return
@@ -319,6 +319,10 @@ func (r *stubApiKeyRepo) ListKeysByGroupID(ctx context.Context, groupID int64) (
 	return nil, errors.New("not implemented")
 }
 
+func (r *stubApiKeyRepo) IncrementUsage(ctx context.Context, id int64, costUSD float64, dayStart, monthStart time.Time) error {
+	return errors.New("not implemented")
+}
+
 type stubUserSubscriptionRepo struct {
 	getActive      func(ctx context.Context, userID, groupID int64) (*service.UserSubscription, error)
 	updateStatus   func(ctx context.Context, subscriptionID int64, status string) error
@@ -425,3 +429,19 @@ func (r *stubUserSubscriptionRepo) IncrementUsage(ctx context.Context, id int64,
 func (r *stubUserSubscriptionRepo) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
 	return 0, errors.New("not implemented")
 }
+
+func (r *stubUserSubscriptionRepo) ApplyPlan(ctx context.Context, id int64, plan *service.SubscriptionPlan) error {
+	return errors.New("not implemented")
+}
+
+func (r *stubUserSubscriptionRepo) SetAutoRenew(ctx context.Context, id int64, autoRenew bool) error {
+	return errors.New("not implemented")
+}
+
+func (r *stubUserSubscriptionRepo) ListRenewalDue(ctx context.Context, before time.Time, limit int) ([]service.UserSubscription, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *stubUserSubscriptionRepo) ClaimRenewalNotice(ctx context.Context, id int64, expiresAt time.Time) (bool, error) {
+	return false, errors.New("not implemented")
+}
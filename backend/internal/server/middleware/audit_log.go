@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// auditBodyMaxBytes 审计时最多解析的请求体大小，超出部分不记录
+const auditBodyMaxBytes = 64 << 10
+
+const contextKeyAuditChanges = "audit_changes"
+
+type auditChanges struct {
+	before map[string]any
+	after  map[string]any
+}
+
+// NewAuditLogMiddleware 创建管理端操作审计中间件
+func NewAuditLogMiddleware(auditLogService *service.AuditLogService) AuditLogMiddleware {
+	return AuditLogMiddleware(auditLog(auditLogService))
+}
+
+// SetAuditChanges 由 handler 提供变更前后快照，覆盖默认记录的请求体
+func SetAuditChanges(c *gin.Context, before, after map[string]any) {
+	c.Set(contextKeyAuditChanges, auditChanges{before: before, after: after})
+}
+
+// auditLog 记录所有变更类（非 GET/HEAD/OPTIONS）管理端请求，需挂在 adminAuth 之后
+func auditLog(auditLogService *service.AuditLogService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		body := captureAuditBody(c)
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+
+		entry := &service.AuditLog{
+			Action:     c.Request.Method + " " + route,
+			TargetType: auditTargetType(route),
+			TargetID:   c.Param("id"),
+			StatusCode: c.Writer.Status(),
+			IPAddress:  c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+		}
+		if subject, ok := GetAuthSubjectFromContext(c); ok {
+			userID := subject.UserID
+			entry.ActorUserID = &userID
+		}
+		if method, ok := c.Get("auth_method"); ok {
+			entry.ActorType, _ = method.(string)
+		}
+		if requestID, ok := c.Request.Context().Value(ctxkey.ClientRequestID).(string); ok {
+			entry.RequestID = requestID
+		}
+
+		if v, ok := c.Get(contextKeyAuditChanges); ok {
+			changes, _ := v.(auditChanges)
+			entry.Before = changes.before
+			entry.After = changes.after
+		} else {
+			entry.After = body
+		}
+
+		auditLogService.Record(c.Request.Context(), entry)
+	}
+}
+
+// captureAuditBody 读取并还原 JSON 请求体，返回解析后的对象；非 JSON 或超限时只记录标记
+func captureAuditBody(c *gin.Context) map[string]any {
+	if c.Request.Body == nil || c.Request.ContentLength == 0 {
+		return nil
+	}
+	if !strings.Contains(strings.ToLower(c.ContentType()), "json") {
+		return map[string]any{"_body": "<non-json payload omitted>"}
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(c.Request.Body, auditBodyMaxBytes+1))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(raw), c.Request.Body))
+	if err != nil {
+		return nil
+	}
+	if len(raw) > auditBodyMaxBytes {
+		return map[string]any{"_body": "<payload too large, omitted>"}
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return map[string]any{"_body": "<non-object payload omitted>"}
+	}
+	return parsed
+}
+
+// auditTargetType 取 /admin/ 之后的第一段路径作为目标类型，如 users、accounts、settings
+func auditTargetType(route string) string {
+	idx := strings.Index(route, "/admin/")
+	if idx < 0 {
+		return ""
+	}
+	rest := route[idx+len("/admin/"):]
+	if slash := strings.IndexByte(rest, '/'); slash >= 0 {
+		rest = rest[:slash]
+	}
+	return rest
+}
@@ -0,0 +1,116 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type auditLogRepoStub struct {
+	logs []service.AuditLog
+}
+
+func (s *auditLogRepoStub) Create(_ context.Context, log *service.AuditLog) error {
+	s.logs = append(s.logs, *log)
+	return nil
+}
+
+func (s *auditLogRepoStub) List(context.Context, pagination.PaginationParams, service.AuditLogFilter) ([]service.AuditLog, *pagination.PaginationResult, error) {
+	return s.logs, &pagination.PaginationResult{Total: int64(len(s.logs))}, nil
+}
+
+func (s *auditLogRepoStub) ListBeforeID(context.Context, service.AuditLogFilter, int64, int) ([]service.AuditLog, error) {
+	return nil, nil
+}
+
+func newAuditTestRouter(repo *auditLogRepoStub, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	admin := r.Group("/api/v1/admin")
+	admin.Use(func(c *gin.Context) {
+		c.Set(string(ContextKeyUser), AuthSubject{UserID: 7})
+		c.Set("auth_method", service.AuditActorTypeAdminAPIKey)
+		c.Next()
+	}, auditLog(service.NewAuditLogService(repo)))
+	admin.GET("/users/:id", handler)
+	admin.PUT("/accounts/:id", handler)
+	admin.POST("/users/:id/balance", handler)
+	return r
+}
+
+func TestAuditLog_RecordsMutatingRequestWithRedactedBody(t *testing.T) {
+	repo := &auditLogRepoStub{}
+	var seenBody string
+	r := newAuditTestRouter(repo, func(c *gin.Context) {
+		b, _ := io.ReadAll(c.Request.Body)
+		seenBody = string(b)
+		c.Status(http.StatusOK)
+	})
+
+	body := `{"name":"acc","credentials":{"api_key":"sk-secret"},"password":"p"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/accounts/42", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, body, seenBody, "handler must still see the original body")
+	require.Len(t, repo.logs, 1)
+
+	entry := repo.logs[0]
+	require.Equal(t, "PUT /api/v1/admin/accounts/:id", entry.Action)
+	require.Equal(t, "accounts", entry.TargetType)
+	require.Equal(t, "42", entry.TargetID)
+	require.Equal(t, service.AuditActorTypeAdminAPIKey, entry.ActorType)
+	require.NotNil(t, entry.ActorUserID)
+	require.Equal(t, int64(7), *entry.ActorUserID)
+	require.Equal(t, http.StatusOK, entry.StatusCode)
+	require.Nil(t, entry.Before)
+	require.Equal(t, "acc", entry.After["name"])
+	require.Equal(t, "***", entry.After["credentials"])
+	require.Equal(t, "***", entry.After["password"])
+}
+
+func TestAuditLog_SkipsReadOnlyRequests(t *testing.T) {
+	repo := &auditLogRepoStub{}
+	r := newAuditTestRouter(repo, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/1", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, repo.logs)
+}
+
+func TestAuditLog_HandlerChangesOverrideBody(t *testing.T) {
+	repo := &auditLogRepoStub{}
+	r := newAuditTestRouter(repo, func(c *gin.Context) {
+		SetAuditChanges(c,
+			map[string]any{"balance": 10.0, "status": "active"},
+			map[string]any{"balance": 15.0, "status": "active"},
+		)
+		c.Status(http.StatusBadRequest)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/3/balance", strings.NewReader(`{"balance":5}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Len(t, repo.logs, 1)
+	entry := repo.logs[0]
+	require.Equal(t, "users", entry.TargetType)
+	require.Equal(t, http.StatusBadRequest, entry.StatusCode)
+	require.Equal(t, map[string]any{"balance": 10.0}, entry.Before, "unchanged fields are dropped")
+	require.Equal(t, map[string]any{"balance": 15.0}, entry.After)
+}
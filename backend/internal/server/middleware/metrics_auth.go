@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewMetricsAuthMiddleware 创建 /metrics 访问控制中间件
+func NewMetricsAuthMiddleware(cfg *config.Config, adminAPIKeyService *service.AdminAPIKeyService) MetricsAuthMiddleware {
+	return MetricsAuthMiddleware(metricsAuth(cfg.Metrics.AllowedIPs, adminAPIKeyService))
+}
+
+// metricsAuth /metrics 访问控制实现，满足任一条件即放行：
+// 1. 来源 IP 命中 metrics.allowed_ips（使用 gin ClientIP，仅信任 server.trusted_proxies 转发的头）
+// 2. 携带具备 ops:manage 权限的管理端 API Key：x-api-key: <key> 或 Authorization: Bearer <key>（兼容 Prometheus bearer_token）
+func metricsAuth(allowedIPs []string, adminAPIKeyService *service.AdminAPIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(allowedIPs) > 0 && ip.MatchesAnyPattern(c.ClientIP(), allowedIPs) {
+			c.Next()
+			return
+		}
+
+		key := strings.TrimSpace(c.GetHeader("x-api-key"))
+		if key == "" {
+			if parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2); len(parts) == 2 && parts[0] == "Bearer" {
+				key = strings.TrimSpace(parts[1])
+			}
+		}
+		if key == "" {
+			AbortWithError(c, 401, "UNAUTHORIZED", "Authorization required")
+			return
+		}
+
+		principal, err := adminAPIKeyService.Authenticate(c.Request.Context(), key)
+		if err != nil {
+			if errors.Is(err, service.ErrAdminAPIKeyInvalid) {
+				AbortWithError(c, 401, "INVALID_ADMIN_KEY", "Invalid admin API key")
+				return
+			}
+			AbortWithError(c, 500, "INTERNAL_ERROR", "Internal server error")
+			return
+		}
+		if !principal.Permissions.Has(service.AdminPermissionOps) {
+			AbortWithError(c, 403, "FORBIDDEN", "Insufficient admin permissions")
+			return
+		}
+		c.Next()
+	}
+}
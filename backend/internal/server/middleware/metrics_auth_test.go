@@ -0,0 +1,74 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/Wei-Shaw/sub2api/internal/service/servicetest"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(allowedIPs []string, adminKey string) *gin.Engine {
+		adminID, opsID, disabledOpsID := int64(1), int64(2), int64(3)
+		users := servicetest.NewUserRepo(
+			&service.User{ID: adminID, Role: service.RoleAdmin, Status: service.StatusActive},
+			&service.User{ID: opsID, Role: service.RoleOps, Status: service.StatusActive},
+			&service.User{ID: disabledOpsID, Role: service.RoleOps, Status: service.StatusDisabled},
+		)
+		repo := servicetest.NewAdminAPIKeyRepo()
+		if adminKey != "" {
+			repo.Add(adminKey, &service.AdminAPIKey{ID: 1, Scopes: []string{"*"}})
+		}
+		repo.Add("ops-key", &service.AdminAPIKey{ID: 2, Scopes: []string{string(service.AdminPermissionOps)}, CreatedBy: &opsID})
+		repo.Add("billing-key", &service.AdminAPIKey{ID: 3, Scopes: []string{string(service.AdminPermissionBilling)}, CreatedBy: &adminID})
+		expired := time.Now().Add(-time.Hour)
+		repo.Add("expired-key", &service.AdminAPIKey{ID: 4, Scopes: []string{"*"}, ExpiresAt: &expired})
+		repo.Add("disabled-creator-key", &service.AdminAPIKey{ID: 5, Scopes: []string{string(service.AdminPermissionOps)}, CreatedBy: &disabledOpsID})
+
+		r := gin.New()
+		// 与 ProvideRouter 一致：未配置 trusted_proxies 时不信任转发头
+		require.NoError(t, r.SetTrustedProxies(nil))
+		r.GET("/metrics", metricsAuth(allowedIPs, service.NewAdminAPIKeyService(repo, users)), func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+		return r
+	}
+	do := func(r *gin.Engine, remoteAddr string, header http.Header) int {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.RemoteAddr = remoteAddr
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	r := newRouter([]string{"10.0.0.0/8"}, "admin-secret")
+	require.Equal(t, http.StatusOK, do(r, "10.1.2.3:5000", nil))
+	require.Equal(t, http.StatusUnauthorized, do(r, "192.168.1.1:5000", nil))
+	// 未信任代理时伪造的转发头不生效
+	require.Equal(t, http.StatusUnauthorized, do(r, "192.168.1.1:5000", http.Header{"X-Forwarded-For": {"10.1.2.3"}}))
+	require.Equal(t, http.StatusOK, do(r, "192.168.1.1:5000", http.Header{"X-Api-Key": {"admin-secret"}}))
+	require.Equal(t, http.StatusOK, do(r, "192.168.1.1:5000", http.Header{"Authorization": {"Bearer admin-secret"}}))
+	require.Equal(t, http.StatusUnauthorized, do(r, "192.168.1.1:5000", http.Header{"Authorization": {"Bearer wrong"}}))
+	// 需要 ops:manage 权限，过期 Key 与创建者已禁用的 Key 无效
+	require.Equal(t, http.StatusOK, do(r, "192.168.1.1:5000", http.Header{"X-Api-Key": {"ops-key"}}))
+	require.Equal(t, http.StatusForbidden, do(r, "192.168.1.1:5000", http.Header{"X-Api-Key": {"billing-key"}}))
+	require.Equal(t, http.StatusUnauthorized, do(r, "192.168.1.1:5000", http.Header{"X-Api-Key": {"expired-key"}}))
+	require.Equal(t, http.StatusUnauthorized, do(r, "192.168.1.1:5000", http.Header{"X-Api-Key": {"disabled-creator-key"}}))
+
+	// 未配置的 key 都无效
+	r = newRouter(nil, "")
+	require.Equal(t, http.StatusUnauthorized, do(r, "10.1.2.3:5000", http.Header{"X-Api-Key": {""}}))
+	require.Equal(t, http.StatusUnauthorized, do(r, "10.1.2.3:5000", http.Header{"X-Api-Key": {"anything"}}))
+}
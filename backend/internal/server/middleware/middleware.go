@@ -15,6 +15,8 @@ const (
 	ContextKeyUser ContextKey = "user"
 	// ContextKeyUserRole 当前用户角色（string）
 	ContextKeyUserRole ContextKey = "user_role"
+	// ContextKeyAdminPermissions 管理端请求的有效权限集合（service.AdminPermissionSet）
+	ContextKeyAdminPermissions ContextKey = "admin_permissions"
 	// ContextKeyAPIKey API密钥上下文键
 	ContextKeyAPIKey ContextKey = "api_key"
 	// ContextKeySubscription 订阅上下文键
@@ -14,9 +14,17 @@ type AdminAuthMiddleware gin.HandlerFunc
 // APIKeyAuthMiddleware API Key 认证中间件类型
 type APIKeyAuthMiddleware gin.HandlerFunc
 
+// MetricsAuthMiddleware /metrics 访问控制中间件类型
+type MetricsAuthMiddleware gin.HandlerFunc
+
+// AuditLogMiddleware 管理端操作审计中间件类型
+type AuditLogMiddleware gin.HandlerFunc
+
 // ProviderSet 中间件层的依赖注入
 var ProviderSet = wire.NewSet(
 	NewJWTAuthMiddleware,
 	NewAdminAuthMiddleware,
 	NewAPIKeyAuthMiddleware,
+	NewMetricsAuthMiddleware,
+	NewAuditLogMiddleware,
 )
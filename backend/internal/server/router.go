@@ -21,6 +21,8 @@ func SetupRouter(
 	jwtAuth middleware2.JWTAuthMiddleware,
 	adminAuth middleware2.AdminAuthMiddleware,
 	apiKeyAuth middleware2.APIKeyAuthMiddleware,
+	metricsAuth middleware2.MetricsAuthMiddleware,
+	auditLog middleware2.AuditLogMiddleware,
 	apiKeyService *service.APIKeyService,
 	subscriptionService *service.SubscriptionService,
 	opsService *service.OpsService,
@@ -35,10 +37,14 @@ func SetupRouter(
 
 	// Serve embedded frontend with settings injection if available
 	if web.HasEmbeddedFrontend() {
-		frontendServer, err := web.NewFrontendServer(settingService)
+		var extraAPIPaths []string
+		if cfg.Metrics.Enabled {
+			extraAPIPaths = append(extraAPIPaths, cfg.Metrics.Path)
+		}
+		frontendServer, err := web.NewFrontendServer(settingService, extraAPIPaths...)
 		if err != nil {
 			log.Printf("Warning: Failed to create frontend server with settings injection: %v, using legacy mode", err)
-			r.Use(web.ServeEmbeddedFrontend())
+			r.Use(web.ServeEmbeddedFrontend(extraAPIPaths...))
 		} else {
 			// Register cache invalidation callback
 			settingService.SetOnUpdateCallback(frontendServer.InvalidateCache)
@@ -47,7 +53,7 @@ func SetupRouter(
 	}
 
 	// 注册路由
-	registerRoutes(r, handlers, jwtAuth, adminAuth, apiKeyAuth, apiKeyService, subscriptionService, opsService, cfg, redisClient)
+	registerRoutes(r, handlers, jwtAuth, adminAuth, apiKeyAuth, metricsAuth, auditLog, apiKeyService, subscriptionService, opsService, cfg, redisClient)
 
 	return r
 }
@@ -59,6 +65,8 @@ func registerRoutes(
 	jwtAuth middleware2.JWTAuthMiddleware,
 	adminAuth middleware2.AdminAuthMiddleware,
 	apiKeyAuth middleware2.APIKeyAuthMiddleware,
+	metricsAuth middleware2.MetricsAuthMiddleware,
+	auditLog middleware2.AuditLogMiddleware,
 	apiKeyService *service.APIKeyService,
 	subscriptionService *service.SubscriptionService,
 	opsService *service.OpsService,
@@ -68,13 +76,16 @@ func registerRoutes(
 	// 通用路由（健康检查、状态等）
 	routes.RegisterCommonRoutes(r)
 
+	// Prometheus 指标（默认关闭）
+	routes.RegisterMetricsRoutes(r, h, metricsAuth, cfg)
+
 	// API v1
 	v1 := r.Group("/api/v1")
 
 	// 注册各模块路由
 	routes.RegisterAuthRoutes(v1, h, jwtAuth, redisClient)
 	routes.RegisterUserRoutes(v1, h, jwtAuth)
-	routes.RegisterAdminRoutes(v1, h, adminAuth)
+	routes.RegisterAdminRoutes(v1, h, adminAuth, auditLog)
 	routes.RegisterGatewayRoutes(r, h, apiKeyAuth, apiKeyService, subscriptionService, opsService, cfg)
-	routes.RegisterCreemRoutes(r, v1, h, jwtAuth)
+	routes.RegisterPaymentRoutes(r, v1, h, jwtAuth)
 }
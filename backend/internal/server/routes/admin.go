@@ -4,18 +4,21 @@ package routes
 import (
 	"github.com/Wei-Shaw/sub2api/internal/handler"
 	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterAdminRoutes 注册管理员路由
+// 所有管理端角色均可通过 adminAuth，各路由组再按权限集合（service.AdminPermission）校验
 func RegisterAdminRoutes(
 	v1 *gin.RouterGroup,
 	h *handler.Handlers,
 	adminAuth middleware.AdminAuthMiddleware,
+	auditLog middleware.AuditLogMiddleware,
 ) {
 	admin := v1.Group("/admin")
-	admin.Use(gin.HandlerFunc(adminAuth))
+	admin.Use(gin.HandlerFunc(adminAuth), gin.HandlerFunc(auditLog))
 	{
 		// 仪表盘
 		registerDashboardRoutes(admin, h)
@@ -64,11 +67,37 @@ func RegisterAdminRoutes(
 
 		// 用户属性管理
 		registerUserAttributeRoutes(admin, h)
+
+		// 操作审计日志
+		registerAuditLogRoutes(admin, h)
+
+		// 组织管理
+		registerOrganizationRoutes(admin, h)
+
+		// 余额账本对账
+		registerBalanceLedgerRoutes(admin, h)
+
+		// 管理端 API Key 与角色
+		registerAdminAPIKeyRoutes(admin, h)
+
+		// 月度发票
+		registerInvoiceRoutes(admin, h)
+
+		// 充值订单对账
+		registerPaymentOrderRoutes(admin, h)
+
+		// 模型价格覆盖
+		registerModelPriceRoutes(admin, h)
 	}
 }
 
+// requirePermission 路由组权限校验，持有任一权限即可
+func requirePermission(perms ...service.AdminPermission) gin.HandlerFunc {
+	return middleware.RequireAdminPermission(perms...)
+}
+
 func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	ops := admin.Group("/ops")
+	ops := admin.Group("/ops", requirePermission(service.AdminPermissionOps))
 	{
 		// Realtime ops signals
 		ops.GET("/concurrency", h.Admin.Ops.GetConcurrencyStats)
@@ -85,6 +114,23 @@ func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		ops.PUT("/alert-events/:id/status", h.Admin.Ops.UpdateAlertEventStatus)
 		ops.POST("/alert-silences", h.Admin.Ops.CreateAlertSilence)
 
+		// Notification channels (webhook / Slack / Discord / Telegram / DingTalk / Feishu)
+		ops.GET("/notification-channels", h.Admin.Ops.ListNotificationChannels)
+		ops.POST("/notification-channels", h.Admin.Ops.CreateNotificationChannel)
+		ops.PUT("/notification-channels/:id", h.Admin.Ops.UpdateNotificationChannel)
+		ops.DELETE("/notification-channels/:id", h.Admin.Ops.DeleteNotificationChannel)
+		ops.POST("/notification-channels/:id/test", h.Admin.Ops.TestNotificationChannel)
+
+		// Payload capture rules & captured exchanges
+		ops.GET("/payload-capture-rules", h.Admin.Ops.ListPayloadCaptureRules)
+		ops.POST("/payload-capture-rules", h.Admin.Ops.CreatePayloadCaptureRule)
+		ops.PUT("/payload-capture-rules/:id", h.Admin.Ops.UpdatePayloadCaptureRule)
+		ops.DELETE("/payload-capture-rules/:id", h.Admin.Ops.DeletePayloadCaptureRule)
+		ops.GET("/payload-captures", h.Admin.Ops.ListPayloadCaptures)
+		ops.GET("/payload-captures/:id", h.Admin.Ops.GetPayloadCapture)
+		ops.DELETE("/payload-captures/:id", h.Admin.Ops.DeletePayloadCapture)
+		ops.POST("/payload-captures/:id/replay", h.Admin.Ops.ReplayPayloadCapture)
+
 		// Email notification config (DB-backed)
 		ops.GET("/email-notification/config", h.Admin.Ops.GetEmailNotificationConfig)
 		ops.PUT("/email-notification/config", h.Admin.Ops.UpdateEmailNotificationConfig)
@@ -147,65 +193,78 @@ func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerDashboardRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	dashboard := admin.Group("/dashboard")
+	dashboard := admin.Group("/dashboard", requirePermission(service.AdminPermissionDashboardView))
 	{
 		dashboard.GET("/stats", h.Admin.Dashboard.GetStats)
 		dashboard.GET("/realtime", h.Admin.Dashboard.GetRealtimeMetrics)
 		dashboard.GET("/trend", h.Admin.Dashboard.GetUsageTrend)
 		dashboard.GET("/models", h.Admin.Dashboard.GetModelStats)
+		dashboard.GET("/prompt-cache", h.Admin.Dashboard.GetPromptCacheStats)
 		dashboard.GET("/api-keys-trend", h.Admin.Dashboard.GetAPIKeyUsageTrend)
 		dashboard.GET("/users-trend", h.Admin.Dashboard.GetUserUsageTrend)
 		dashboard.POST("/users-usage", h.Admin.Dashboard.GetBatchUsersUsage)
 		dashboard.POST("/api-keys-usage", h.Admin.Dashboard.GetBatchAPIKeysUsage)
-		dashboard.POST("/aggregation/backfill", h.Admin.Dashboard.BackfillAggregation)
+		dashboard.POST("/aggregation/backfill", requirePermission(service.AdminPermissionOps), h.Admin.Dashboard.BackfillAggregation)
 	}
 }
 
 func registerUserManagementRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	users := admin.Group("/users")
+	users := admin.Group("/users", requirePermission(service.AdminPermissionUsersView))
 	{
+		manage := requirePermission(service.AdminPermissionUsersManage)
+		adjust := requirePermission(service.AdminPermissionBalanceAdjust, service.AdminPermissionBalanceAdjustUnlimited)
+		ledger := requirePermission(service.AdminPermissionBilling, service.AdminPermissionBalanceAdjust, service.AdminPermissionBalanceAdjustUnlimited)
+
 		users.GET("", h.Admin.User.List)
 		users.GET("/:id", h.Admin.User.GetByID)
-		users.POST("", h.Admin.User.Create)
-		users.PUT("/:id", h.Admin.User.Update)
-		users.DELETE("/:id", h.Admin.User.Delete)
-		users.POST("/:id/balance", h.Admin.User.UpdateBalance)
+		users.POST("", manage, h.Admin.User.Create)
+		users.PUT("/:id", manage, h.Admin.User.Update)
+		users.DELETE("/:id", manage, h.Admin.User.Delete)
+		users.POST("/:id/balance", adjust, h.Admin.User.UpdateBalance)
 		users.GET("/:id/api-keys", h.Admin.User.GetUserAPIKeys)
 		users.GET("/:id/usage", h.Admin.User.GetUserUsage)
+		users.GET("/:id/transactions", ledger, h.Admin.BalanceLedger.ListUserTransactions)
+		users.GET("/:id/transactions/export", ledger, h.Admin.BalanceLedger.ExportUserStatement)
 
 		// User attribute values
 		users.GET("/:id/attributes", h.Admin.UserAttribute.GetUserAttributes)
-		users.PUT("/:id/attributes", h.Admin.UserAttribute.UpdateUserAttributes)
+		users.PUT("/:id/attributes", manage, h.Admin.UserAttribute.UpdateUserAttributes)
 	}
 }
 
 func registerGroupRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	groups := admin.Group("/groups")
+	groups := admin.Group("/groups", requirePermission(service.AdminPermissionGroupsView))
 	{
+		manage := requirePermission(service.AdminPermissionGroupsManage)
+
 		groups.GET("", h.Admin.Group.List)
 		groups.GET("/all", h.Admin.Group.GetAll)
 		groups.GET("/:id", h.Admin.Group.GetByID)
-		groups.POST("", h.Admin.Group.Create)
-		groups.PUT("/:id", h.Admin.Group.Update)
-		groups.DELETE("/:id", h.Admin.Group.Delete)
+		groups.POST("", manage, h.Admin.Group.Create)
+		groups.PUT("/:id", manage, h.Admin.Group.Update)
+		groups.DELETE("/:id", manage, h.Admin.Group.Delete)
 		groups.GET("/:id/stats", h.Admin.Group.GetStats)
 		groups.GET("/:id/api-keys", h.Admin.Group.GetGroupAPIKeys)
 	}
 }
 
 func registerAccountRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	accounts := admin.Group("/accounts")
+	accounts := admin.Group("/accounts", requirePermission(service.AdminPermissionAccountsManage))
 	{
 		accounts.GET("", h.Admin.Account.List)
 		accounts.GET("/:id", h.Admin.Account.GetByID)
 		accounts.POST("", h.Admin.Account.Create)
 		accounts.POST("/sync/crs", h.Admin.Account.SyncFromCRS)
+		accounts.POST("/sync/instance", h.Admin.AccountBundle.SyncFromInstance)
+		accounts.POST("/bundle/export", h.Admin.AccountBundle.Export)
+		accounts.POST("/bundle/import", h.Admin.AccountBundle.Import)
 		accounts.PUT("/:id", h.Admin.Account.Update)
 		accounts.DELETE("/:id", h.Admin.Account.Delete)
 		accounts.POST("/:id/test", h.Admin.Account.Test)
 		accounts.POST("/:id/refresh", h.Admin.Account.Refresh)
 		accounts.POST("/:id/refresh-tier", h.Admin.Account.RefreshTier)
 		accounts.GET("/:id/stats", h.Admin.Account.GetStats)
+		accounts.GET("/:id/health", h.Admin.Account.GetHealth)
 		accounts.POST("/:id/clear-error", h.Admin.Account.ClearError)
 		accounts.GET("/:id/usage", h.Admin.Account.GetUsage)
 		accounts.GET("/:id/today-stats", h.Admin.Account.GetTodayStats)
@@ -230,7 +289,7 @@ func registerAccountRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerOpenAIOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	openai := admin.Group("/openai")
+	openai := admin.Group("/openai", requirePermission(service.AdminPermissionAccountsManage))
 	{
 		openai.POST("/generate-auth-url", h.Admin.OpenAIOAuth.GenerateAuthURL)
 		openai.POST("/exchange-code", h.Admin.OpenAIOAuth.ExchangeCode)
@@ -241,7 +300,7 @@ func registerOpenAIOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerGeminiOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	gemini := admin.Group("/gemini")
+	gemini := admin.Group("/gemini", requirePermission(service.AdminPermissionAccountsManage))
 	{
 		gemini.POST("/oauth/auth-url", h.Admin.GeminiOAuth.GenerateAuthURL)
 		gemini.POST("/oauth/exchange-code", h.Admin.GeminiOAuth.ExchangeCode)
@@ -250,7 +309,7 @@ func registerGeminiOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerAntigravityOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	antigravity := admin.Group("/antigravity")
+	antigravity := admin.Group("/antigravity", requirePermission(service.AdminPermissionAccountsManage))
 	{
 		antigravity.POST("/oauth/auth-url", h.Admin.AntigravityOAuth.GenerateAuthURL)
 		antigravity.POST("/oauth/exchange-code", h.Admin.AntigravityOAuth.ExchangeCode)
@@ -258,7 +317,7 @@ func registerAntigravityOAuthRoutes(admin *gin.RouterGroup, h *handler.Handlers)
 }
 
 func registerProxyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	proxies := admin.Group("/proxies")
+	proxies := admin.Group("/proxies", requirePermission(service.AdminPermissionProxiesManage))
 	{
 		proxies.GET("", h.Admin.Proxy.List)
 		proxies.GET("/all", h.Admin.Proxy.GetAll)
@@ -271,11 +330,31 @@ func registerProxyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		proxies.GET("/:id/accounts", h.Admin.Proxy.GetProxyAccounts)
 		proxies.POST("/batch-delete", h.Admin.Proxy.BatchDelete)
 		proxies.POST("/batch", h.Admin.Proxy.BatchCreate)
+		proxies.POST("/import", h.Admin.ProxyImport.Import)
+	}
+
+	pools := admin.Group("/proxy-pools", requirePermission(service.AdminPermissionProxiesManage))
+	{
+		pools.GET("", h.Admin.ProxyPool.List)
+		pools.GET("/all", h.Admin.ProxyPool.GetAll)
+		pools.GET("/:id", h.Admin.ProxyPool.GetByID)
+		pools.POST("", h.Admin.ProxyPool.Create)
+		pools.PUT("/:id", h.Admin.ProxyPool.Update)
+		pools.DELETE("/:id", h.Admin.ProxyPool.Delete)
+	}
+
+	subscriptions := admin.Group("/proxy-subscriptions", requirePermission(service.AdminPermissionProxiesManage))
+	{
+		subscriptions.GET("", h.Admin.ProxyImport.ListSubscriptions)
+		subscriptions.POST("", h.Admin.ProxyImport.CreateSubscription)
+		subscriptions.PUT("/:id", h.Admin.ProxyImport.UpdateSubscription)
+		subscriptions.DELETE("/:id", h.Admin.ProxyImport.DeleteSubscription)
+		subscriptions.POST("/:id/sync", h.Admin.ProxyImport.SyncSubscription)
 	}
 }
 
 func registerRedeemCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	codes := admin.Group("/redeem-codes")
+	codes := admin.Group("/redeem-codes", requirePermission(service.AdminPermissionBilling))
 	{
 		codes.GET("", h.Admin.Redeem.List)
 		codes.GET("/stats", h.Admin.Redeem.GetStats)
@@ -289,7 +368,7 @@ func registerRedeemCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerPromoCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	promoCodes := admin.Group("/promo-codes")
+	promoCodes := admin.Group("/promo-codes", requirePermission(service.AdminPermissionBilling))
 	{
 		promoCodes.GET("", h.Admin.Promo.List)
 		promoCodes.GET("/:id", h.Admin.Promo.GetByID)
@@ -301,24 +380,23 @@ func registerPromoCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerSettingsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	adminSettings := admin.Group("/settings")
+	adminSettings := admin.Group("/settings", requirePermission(service.AdminPermissionSettingsManage))
 	{
 		adminSettings.GET("", h.Admin.Setting.GetSettings)
 		adminSettings.PUT("", h.Admin.Setting.UpdateSettings)
 		adminSettings.POST("/test-smtp", h.Admin.Setting.TestSMTPConnection)
 		adminSettings.POST("/send-test-email", h.Admin.Setting.SendTestEmail)
-		// Admin API Key 管理
-		adminSettings.GET("/admin-api-key", h.Admin.Setting.GetAdminAPIKey)
-		adminSettings.POST("/admin-api-key/regenerate", h.Admin.Setting.RegenerateAdminAPIKey)
-		adminSettings.DELETE("/admin-api-key", h.Admin.Setting.DeleteAdminAPIKey)
 		// 流超时处理配置
 		adminSettings.GET("/stream-timeout", h.Admin.Setting.GetStreamTimeoutSettings)
 		adminSettings.PUT("/stream-timeout", h.Admin.Setting.UpdateStreamTimeoutSettings)
+		// 发票编号、税率与公司信息
+		adminSettings.GET("/invoice", h.Admin.Setting.GetInvoiceSettings)
+		adminSettings.PUT("/invoice", h.Admin.Setting.UpdateInvoiceSettings)
 	}
 }
 
 func registerSystemRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	system := admin.Group("/system")
+	system := admin.Group("/system", requirePermission(service.AdminPermissionSystemManage))
 	{
 		system.GET("/version", h.Admin.System.GetVersion)
 		system.GET("/check-updates", h.Admin.System.CheckUpdates)
@@ -329,7 +407,8 @@ func registerSystemRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 }
 
 func registerSubscriptionRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	subscriptions := admin.Group("/subscriptions")
+	billing := requirePermission(service.AdminPermissionBilling)
+	subscriptions := admin.Group("/subscriptions", billing)
 	{
 		subscriptions.GET("", h.Admin.Subscription.List)
 		subscriptions.GET("/:id", h.Admin.Subscription.GetByID)
@@ -341,33 +420,136 @@ func registerSubscriptionRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	}
 
 	// 分组下的订阅列表
-	admin.GET("/groups/:id/subscriptions", h.Admin.Subscription.ListByGroup)
+	admin.GET("/groups/:id/subscriptions", billing, h.Admin.Subscription.ListByGroup)
 
 	// 用户下的订阅列表
-	admin.GET("/users/:id/subscriptions", h.Admin.Subscription.ListByUser)
+	admin.GET("/users/:id/subscriptions", requirePermission(service.AdminPermissionBilling, service.AdminPermissionUsersView), h.Admin.Subscription.ListByUser)
+
+	// 订阅套餐
+	plans := admin.Group("/subscription-plans", billing)
+	{
+		plans.GET("", h.Admin.SubscriptionPlan.List)
+		plans.GET("/:id", h.Admin.SubscriptionPlan.GetByID)
+		plans.POST("", h.Admin.SubscriptionPlan.Create)
+		plans.PUT("/:id", h.Admin.SubscriptionPlan.Update)
+		plans.DELETE("/:id", h.Admin.SubscriptionPlan.Delete)
+	}
 }
 
 func registerUsageRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	usage := admin.Group("/usage")
+	usage := admin.Group("/usage", requirePermission(service.AdminPermissionUsageView))
 	{
+		manage := requirePermission(service.AdminPermissionUsageManage)
+
 		usage.GET("", h.Admin.Usage.List)
 		usage.GET("/stats", h.Admin.Usage.Stats)
 		usage.GET("/search-users", h.Admin.Usage.SearchUsers)
 		usage.GET("/search-api-keys", h.Admin.Usage.SearchAPIKeys)
-		usage.GET("/cleanup-tasks", h.Admin.Usage.ListCleanupTasks)
-		usage.POST("/cleanup-tasks", h.Admin.Usage.CreateCleanupTask)
-		usage.POST("/cleanup-tasks/:id/cancel", h.Admin.Usage.CancelCleanupTask)
+		usage.GET("/cleanup-tasks", manage, h.Admin.Usage.ListCleanupTasks)
+		usage.POST("/cleanup-tasks", manage, h.Admin.Usage.CreateCleanupTask)
+		usage.POST("/cleanup-tasks/:id/cancel", manage, h.Admin.Usage.CancelCleanupTask)
+		usage.GET("/export", manage, h.Admin.Usage.Export)
+		usage.GET("/export-tasks", manage, h.Admin.Usage.ListExportTasks)
+		usage.POST("/export-tasks", manage, h.Admin.Usage.CreateExportTask)
+		usage.POST("/export-tasks/:id/cancel", manage, h.Admin.Usage.CancelExportTask)
+		usage.DELETE("/export-tasks/:id", manage, h.Admin.Usage.DeleteExportTask)
+		usage.GET("/export-tasks/:id/download", manage, h.Admin.Usage.DownloadExportTask)
+	}
+}
+
+func registerAuditLogRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	auditLogs := admin.Group("/audit-logs", requirePermission(service.AdminPermissionAuditView))
+	{
+		auditLogs.GET("", h.Admin.AuditLog.List)
+		auditLogs.GET("/export", h.Admin.AuditLog.Export)
+	}
+}
+
+func registerOrganizationRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	orgs := admin.Group("/organizations", requirePermission(service.AdminPermissionBilling))
+	{
+		orgs.GET("", h.Admin.Organization.List)
+		orgs.GET("/:id", h.Admin.Organization.GetByID)
+		orgs.POST("", h.Admin.Organization.Create)
+		orgs.PUT("/:id", h.Admin.Organization.Update)
+		orgs.DELETE("/:id", h.Admin.Organization.Delete)
+		orgs.POST("/:id/balance", h.Admin.Organization.AdjustBalance)
+		orgs.GET("/:id/transactions", h.Admin.Organization.ListTransactions)
+		orgs.GET("/:id/members", h.Admin.Organization.ListMembers)
+		orgs.POST("/:id/members", h.Admin.Organization.AddMember)
+		orgs.PUT("/:id/members/:user_id", h.Admin.Organization.UpdateMember)
+		orgs.DELETE("/:id/members/:user_id", h.Admin.Organization.RemoveMember)
+		orgs.GET("/:id/usage", h.Admin.Organization.GetUsage)
+	}
+}
+
+func registerBalanceLedgerRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	ledger := admin.Group("/balance-ledger", requirePermission(service.AdminPermissionBilling))
+	{
+		ledger.POST("/reconcile", h.Admin.BalanceLedger.Reconcile)
+	}
+}
+
+func registerInvoiceRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	invoices := admin.Group("/invoices", requirePermission(service.AdminPermissionBilling))
+	{
+		invoices.GET("", h.Admin.Invoice.List)
+		invoices.POST("/generate", h.Admin.Invoice.Generate)
+		invoices.GET("/:id", h.Admin.Invoice.GetByID)
+		invoices.GET("/:id/pdf", h.Admin.Invoice.DownloadPDF)
+		invoices.GET("/:id/html", h.Admin.Invoice.DownloadHTML)
+		invoices.POST("/:id/regenerate", h.Admin.Invoice.Regenerate)
+		invoices.POST("/:id/void", h.Admin.Invoice.Void)
+		invoices.POST("/:id/send", h.Admin.Invoice.SendEmail)
+	}
+}
+
+func registerPaymentOrderRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	orders := admin.Group("/payment-orders", requirePermission(service.AdminPermissionBilling))
+	{
+		orders.GET("", h.Admin.PaymentOrder.List)
+		orders.GET("/:id", h.Admin.PaymentOrder.GetByID)
+		orders.POST("/:id/mark-paid", h.Admin.PaymentOrder.MarkPaid)
+		orders.POST("/:id/refund", h.Admin.PaymentOrder.Refund)
+	}
+}
+
+func registerModelPriceRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	prices := admin.Group("/model-prices", requirePermission(service.AdminPermissionBilling))
+	{
+		prices.GET("", h.Admin.ModelPrice.List)
+		prices.GET("/preview", h.Admin.ModelPrice.Preview)
+		prices.GET("/unknown-policy", h.Admin.ModelPrice.GetUnknownModelPricing)
+		prices.PUT("/unknown-policy", h.Admin.ModelPrice.UpdateUnknownModelPricing)
+		prices.GET("/:id", h.Admin.ModelPrice.GetByID)
+		prices.POST("", h.Admin.ModelPrice.Create)
+		prices.PUT("/:id", h.Admin.ModelPrice.Update)
+		prices.DELETE("/:id", h.Admin.ModelPrice.Delete)
 	}
 }
 
 func registerUserAttributeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
-	attrs := admin.Group("/user-attributes")
+	attrs := admin.Group("/user-attributes", requirePermission(service.AdminPermissionUsersView))
 	{
+		manage := requirePermission(service.AdminPermissionUsersManage)
+
 		attrs.GET("", h.Admin.UserAttribute.ListDefinitions)
-		attrs.POST("", h.Admin.UserAttribute.CreateDefinition)
+		attrs.POST("", manage, h.Admin.UserAttribute.CreateDefinition)
 		attrs.POST("/batch", h.Admin.UserAttribute.GetBatchUserAttributes)
-		attrs.PUT("/reorder", h.Admin.UserAttribute.ReorderDefinitions)
-		attrs.PUT("/:id", h.Admin.UserAttribute.UpdateDefinition)
-		attrs.DELETE("/:id", h.Admin.UserAttribute.DeleteDefinition)
+		attrs.PUT("/reorder", manage, h.Admin.UserAttribute.ReorderDefinitions)
+		attrs.PUT("/:id", manage, h.Admin.UserAttribute.UpdateDefinition)
+		attrs.DELETE("/:id", manage, h.Admin.UserAttribute.DeleteDefinition)
+	}
+}
+
+func registerAdminAPIKeyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	// 角色与权限目录，任一管理端角色可读
+	admin.GET("/roles", h.Admin.AdminAPIKey.GetRoles)
+
+	keys := admin.Group("/admin-api-keys", requirePermission(service.AdminPermissionAdminKeysManage))
+	{
+		keys.GET("", h.Admin.AdminAPIKey.List)
+		keys.POST("", h.Admin.AdminAPIKey.Create)
+		keys.DELETE("/:id", h.Admin.AdminAPIKey.Delete)
 	}
 }
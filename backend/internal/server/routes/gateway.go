@@ -22,12 +22,18 @@ func RegisterGatewayRoutes(
 	bodyLimit := middleware.RequestBodyLimit(cfg.Gateway.MaxBodySize)
 	clientRequestID := middleware.ClientRequestID()
 	opsErrorLogger := handler.OpsErrorLoggerMiddleware(opsService)
+	payloadCapture := handler.OpsPayloadCaptureMiddleware(opsService)
+	gatewayMetrics := handler.GatewayMetricsMiddleware()
+	tracing := middleware.Tracing()
 
 	// API网关（Claude API兼容）
 	gateway := r.Group("/v1")
+	gateway.Use(tracing)
 	gateway.Use(bodyLimit)
 	gateway.Use(clientRequestID)
+	gateway.Use(gatewayMetrics)
 	gateway.Use(opsErrorLogger)
+	gateway.Use(payloadCapture)
 	gateway.Use(gin.HandlerFunc(apiKeyAuth))
 	{
 		gateway.POST("/messages", h.Gateway.Messages)
@@ -36,13 +42,18 @@ func RegisterGatewayRoutes(
 		gateway.GET("/usage", h.Gateway.Usage)
 		// OpenAI Responses API
 		gateway.POST("/responses", h.OpenAIGateway.Responses)
+		// OpenAI Chat Completions API（按分组平台转换后复用 Messages/Responses 链路）
+		gateway.POST("/chat/completions", h.ChatCompletions.ChatCompletions)
 	}
 
 	// Gemini 原生 API 兼容层（Gemini SDK/CLI 直连）
 	gemini := r.Group("/v1beta")
+	gemini.Use(tracing)
 	gemini.Use(bodyLimit)
 	gemini.Use(clientRequestID)
+	gemini.Use(gatewayMetrics)
 	gemini.Use(opsErrorLogger)
+	gemini.Use(payloadCapture)
 	gemini.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, cfg))
 	{
 		gemini.GET("/models", h.Gateway.GeminiV1BetaListModels)
@@ -52,29 +63,39 @@ func RegisterGatewayRoutes(
 	}
 
 	// OpenAI Responses API（不带v1前缀的别名）
-	r.POST("/responses", bodyLimit, clientRequestID, opsErrorLogger, gin.HandlerFunc(apiKeyAuth), h.OpenAIGateway.Responses)
+	r.POST("/responses", tracing, bodyLimit, clientRequestID, gatewayMetrics, opsErrorLogger, payloadCapture, gin.HandlerFunc(apiKeyAuth), h.OpenAIGateway.Responses)
+
+	// OpenAI Chat Completions API（不带v1前缀的别名）
+	r.POST("/chat/completions", tracing, bodyLimit, clientRequestID, gatewayMetrics, opsErrorLogger, payloadCapture, gin.HandlerFunc(apiKeyAuth), h.ChatCompletions.ChatCompletions)
 
 	// Antigravity 模型列表
 	r.GET("/antigravity/models", gin.HandlerFunc(apiKeyAuth), h.Gateway.AntigravityModels)
 
 	// Antigravity 专用路由（仅使用 antigravity 账户，不混合调度）
 	antigravityV1 := r.Group("/antigravity/v1")
+	antigravityV1.Use(tracing)
 	antigravityV1.Use(bodyLimit)
 	antigravityV1.Use(clientRequestID)
+	antigravityV1.Use(gatewayMetrics)
 	antigravityV1.Use(opsErrorLogger)
+	antigravityV1.Use(payloadCapture)
 	antigravityV1.Use(middleware.ForcePlatform(service.PlatformAntigravity))
 	antigravityV1.Use(gin.HandlerFunc(apiKeyAuth))
 	{
 		antigravityV1.POST("/messages", h.Gateway.Messages)
 		antigravityV1.POST("/messages/count_tokens", h.Gateway.CountTokens)
+		antigravityV1.POST("/chat/completions", h.ChatCompletions.ChatCompletions)
 		antigravityV1.GET("/models", h.Gateway.AntigravityModels)
 		antigravityV1.GET("/usage", h.Gateway.Usage)
 	}
 
 	antigravityV1Beta := r.Group("/antigravity/v1beta")
+	antigravityV1Beta.Use(tracing)
 	antigravityV1Beta.Use(bodyLimit)
 	antigravityV1Beta.Use(clientRequestID)
+	antigravityV1Beta.Use(gatewayMetrics)
 	antigravityV1Beta.Use(opsErrorLogger)
+	antigravityV1Beta.Use(payloadCapture)
 	antigravityV1Beta.Use(middleware.ForcePlatform(service.PlatformAntigravity))
 	antigravityV1Beta.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, cfg))
 	{
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterMetricsRoutes 注册 Prometheus 指标路由（metrics.enabled=true 时生效）
+func RegisterMetricsRoutes(
+	r *gin.Engine,
+	h *handler.Handlers,
+	metricsAuth middleware.MetricsAuthMiddleware,
+	cfg *config.Config,
+) {
+	if !cfg.Metrics.Enabled {
+		return
+	}
+	r.GET(cfg.Metrics.Path, gin.HandlerFunc(metricsAuth), h.Metrics.Metrics)
+}
@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPaymentRoutes 注册在线充值相关路由
+func RegisterPaymentRoutes(
+	r *gin.Engine,
+	v1 *gin.RouterGroup,
+	h *handler.Handlers,
+	jwtAuth middleware.JWTAuthMiddleware,
+) {
+	// 公开接口：获取可用支付渠道 / Creem 支付状态（兼容旧版前端）
+	v1.GET("/payment/providers", h.Payment.GetProviders)
+	v1.GET("/creem/status", h.Payment.GetStatus)
+
+	// 需要认证的接口
+	payment := v1.Group("/payment")
+	payment.Use(gin.HandlerFunc(jwtAuth))
+	{
+		payment.POST("/checkout", h.Payment.CreateCheckout)
+		payment.GET("/orders", h.Payment.ListOrders)
+		payment.GET("/orders/:order_no", h.Payment.GetOrder)
+	}
+
+	creem := v1.Group("/creem")
+	creem.Use(gin.HandlerFunc(jwtAuth))
+	{
+		creem.POST("/checkout", h.Payment.CreateCreemCheckout)
+	}
+
+	// Webhook 接口（无需认证，由各渠道签名验证）；易支付以 GET 回调
+	webhook := v1.Group("/webhook")
+	{
+		webhook.GET("/:provider", h.Payment.HandleWebhook)
+		webhook.POST("/:provider", h.Payment.HandleWebhook)
+	}
+}
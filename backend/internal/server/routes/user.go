@@ -22,6 +22,7 @@ func RegisterUserRoutes(
 			user.GET("/profile", h.User.GetProfile)
 			user.PUT("/password", h.User.ChangePassword)
 			user.PUT("", h.User.UpdateProfile)
+			user.GET("/transactions", h.Transaction.List)
 
 			// TOTP 双因素认证
 			totp := user.Group("/totp")
@@ -55,12 +56,14 @@ func RegisterUserRoutes(
 		usage := authenticated.Group("/usage")
 		{
 			usage.GET("", h.Usage.List)
+			usage.GET("/export", h.Usage.Export)
 			usage.GET("/:id", h.Usage.GetByID)
 			usage.GET("/stats", h.Usage.Stats)
 			// User dashboard endpoints
 			usage.GET("/dashboard/stats", h.Usage.DashboardStats)
 			usage.GET("/dashboard/trend", h.Usage.DashboardTrend)
 			usage.GET("/dashboard/models", h.Usage.DashboardModels)
+			usage.GET("/dashboard/cache-savings", h.Usage.DashboardCacheSavings)
 			usage.POST("/dashboard/api-keys-usage", h.Usage.DashboardAPIKeysUsage)
 		}
 
@@ -78,6 +81,34 @@ func RegisterUserRoutes(
 			subscriptions.GET("/active", h.Subscription.GetActive)
 			subscriptions.GET("/progress", h.Subscription.GetProgress)
 			subscriptions.GET("/summary", h.Subscription.GetSummary)
+			subscriptions.PUT("/:id/auto-renew", h.SubscriptionPlan.SetAutoRenew)
+		}
+
+		// 订阅套餐购买
+		plans := authenticated.Group("/subscription-plans")
+		{
+			plans.GET("", h.SubscriptionPlan.List)
+			plans.POST("/:id/checkout", h.SubscriptionPlan.Checkout)
+		}
+
+		// 组织（成员管理需 owner/admin 角色，由服务层校验）
+		organization := authenticated.Group("/organization")
+		{
+			organization.GET("", h.Organization.GetMine)
+			organization.GET("/members", h.Organization.ListMembers)
+			organization.POST("/members", h.Organization.AddMember)
+			organization.PUT("/members/:user_id", h.Organization.UpdateMember)
+			organization.DELETE("/members/:user_id", h.Organization.RemoveMember)
+			organization.GET("/usage", h.Organization.GetUsage)
+		}
+
+		// 发票（个人发票，以及组织 owner 收到的组织发票）
+		invoices := authenticated.Group("/invoices")
+		{
+			invoices.GET("", h.Invoice.List)
+			invoices.GET("/:id", h.Invoice.GetByID)
+			invoices.GET("/:id/pdf", h.Invoice.DownloadPDF)
+			invoices.GET("/:id/html", h.Invoice.DownloadHTML)
 		}
 	}
 }
@@ -17,6 +17,8 @@ type Account struct {
 	Credentials map[string]any
 	Extra       map[string]any
 	ProxyID     *int64
+	// ProxyPoolID 引用的代理池；非空时 ProxyID 为池内当前分配的代理
+	ProxyPoolID *int64
 	Concurrency int
 	Priority    int
 	// RateMultiplier 账号计费倍率（>=0，允许 0 表示该账号计费为 0）。
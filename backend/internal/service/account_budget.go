@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// 账号预算配置键（存储在 Account.Extra 中，0 或缺省表示不限制）
+const (
+	AccountExtraBudgetDailyCost     = "budget_daily_cost_usd"
+	AccountExtraBudgetWeeklyCost    = "budget_weekly_cost_usd"
+	AccountExtraBudgetMonthlyCost   = "budget_monthly_cost_usd"
+	AccountExtraBudgetDailyTokens   = "budget_daily_tokens"
+	AccountExtraBudgetWeeklyTokens  = "budget_weekly_tokens"
+	AccountExtraBudgetMonthlyTokens = "budget_monthly_tokens"
+)
+
+// 预算周期
+const (
+	AccountBudgetPeriodDaily   = "daily"
+	AccountBudgetPeriodWeekly  = "weekly"
+	AccountBudgetPeriodMonthly = "monthly"
+)
+
+// accountBudgetPauseKeyword 预算耗尽触发的临时不可调度标记（TempUnschedState.MatchedKeyword）
+const accountBudgetPauseKeyword = "budget_exhausted"
+
+// AccountBudgetLimits 账号预算上限，费用为账号口径（total_cost * account_rate_multiplier）
+type AccountBudgetLimits struct {
+	DailyCost     float64
+	WeeklyCost    float64
+	MonthlyCost   float64
+	DailyTokens   int64
+	WeeklyTokens  int64
+	MonthlyTokens int64
+}
+
+// HasAny 是否配置了任一预算
+func (l AccountBudgetLimits) HasAny() bool {
+	return l.DailyCost > 0 || l.WeeklyCost > 0 || l.MonthlyCost > 0 ||
+		l.DailyTokens > 0 || l.WeeklyTokens > 0 || l.MonthlyTokens > 0
+}
+
+// GetBudgetLimits 从 Extra 读取账号预算配置
+func (a *Account) GetBudgetLimits() AccountBudgetLimits {
+	if a == nil || a.Extra == nil {
+		return AccountBudgetLimits{}
+	}
+	return AccountBudgetLimits{
+		DailyCost:     parseExtraFloat64(a.Extra[AccountExtraBudgetDailyCost]),
+		WeeklyCost:    parseExtraFloat64(a.Extra[AccountExtraBudgetWeeklyCost]),
+		MonthlyCost:   parseExtraFloat64(a.Extra[AccountExtraBudgetMonthlyCost]),
+		DailyTokens:   int64(parseExtraFloat64(a.Extra[AccountExtraBudgetDailyTokens])),
+		WeeklyTokens:  int64(parseExtraFloat64(a.Extra[AccountExtraBudgetWeeklyTokens])),
+		MonthlyTokens: int64(parseExtraFloat64(a.Extra[AccountExtraBudgetMonthlyTokens])),
+	}
+}
+
+// HasBudget 账号是否配置了任一预算
+func (a *Account) HasBudget() bool {
+	return a.GetBudgetLimits().HasAny()
+}
+
+// AccountBudgetUsage 账号在当前日/周/月窗口内的用量及对应窗口起点
+type AccountBudgetUsage struct {
+	DailyCost          float64
+	DailyTokens        int64
+	DailyWindowStart   time.Time
+	WeeklyCost         float64
+	WeeklyTokens       int64
+	WeeklyWindowStart  time.Time
+	MonthlyCost        float64
+	MonthlyTokens      int64
+	MonthlyWindowStart time.Time
+}
+
+// accountBudgetWindows 返回 now 所在的日/周/月窗口起点
+func accountBudgetWindows(now time.Time) (dayStart, weekStart, monthStart time.Time) {
+	return timezone.StartOfDay(now), timezone.StartOfWeek(now), timezone.StartOfMonth(now)
+}
+
+// normalizeAccountBudgetUsage 处理缓存中跨天/跨周/跨月但尚未被累加脚本滚动的窗口
+func normalizeAccountBudgetUsage(data *AccountBudgetUsage, now time.Time) *AccountBudgetUsage {
+	out := *data
+	dayStart, weekStart, monthStart := accountBudgetWindows(now)
+	if out.DailyWindowStart.Before(dayStart) {
+		out.DailyCost, out.DailyTokens, out.DailyWindowStart = 0, 0, dayStart
+	}
+	if out.WeeklyWindowStart.Before(weekStart) {
+		out.WeeklyCost, out.WeeklyTokens, out.WeeklyWindowStart = 0, 0, weekStart
+	}
+	if out.MonthlyWindowStart.Before(monthStart) {
+		out.MonthlyCost, out.MonthlyTokens, out.MonthlyWindowStart = 0, 0, monthStart
+	}
+	return &out
+}
+
+// budgetExceeded 费用或 token 任一达到上限即视为耗尽（上限为 0 表示不限制）
+func budgetExceeded(cost, costLimit float64, tokens, tokenLimit int64) bool {
+	return (costLimit > 0 && cost >= costLimit) || (tokenLimit > 0 && tokens >= tokenLimit)
+}
+
+// exhaustedPeriod 返回已耗尽的预算周期中恢复时间最晚的一个；未耗尽时 period 为空
+func (u *AccountBudgetUsage) exhaustedPeriod(limits AccountBudgetLimits) (period string, resetAt time.Time) {
+	check := func(name string, cost, costLimit float64, tokens, tokenLimit int64, reset time.Time) {
+		if budgetExceeded(cost, costLimit, tokens, tokenLimit) && reset.After(resetAt) {
+			period, resetAt = name, reset
+		}
+	}
+	check(AccountBudgetPeriodDaily, u.DailyCost, limits.DailyCost, u.DailyTokens, limits.DailyTokens, u.DailyWindowStart.AddDate(0, 0, 1))
+	check(AccountBudgetPeriodWeekly, u.WeeklyCost, limits.WeeklyCost, u.WeeklyTokens, limits.WeeklyTokens, u.WeeklyWindowStart.AddDate(0, 0, 7))
+	check(AccountBudgetPeriodMonthly, u.MonthlyCost, limits.MonthlyCost, u.MonthlyTokens, limits.MonthlyTokens, u.MonthlyWindowStart.AddDate(0, 1, 0))
+	return period, resetAt
+}
+
+// AccountBudgetPeriodStatus 单个预算周期的进度
+type AccountBudgetPeriodStatus struct {
+	Period      string    `json:"period"`
+	CostUsed    float64   `json:"cost_used"`
+	CostLimit   float64   `json:"cost_limit,omitempty"`
+	TokensUsed  int64     `json:"tokens_used"`
+	TokensLimit int64     `json:"tokens_limit,omitempty"`
+	ResetAt     time.Time `json:"reset_at"`
+	Exhausted   bool      `json:"exhausted"`
+}
+
+// AccountBudgetStatus 账号预算进度（仅包含已配置的周期）
+type AccountBudgetStatus struct {
+	Periods   []AccountBudgetPeriodStatus `json:"periods"`
+	Exhausted bool                        `json:"exhausted"`
+	ResumeAt  *time.Time                  `json:"resume_at,omitempty"`
+}
+
+func buildAccountBudgetStatus(usage *AccountBudgetUsage, limits AccountBudgetLimits) *AccountBudgetStatus {
+	status := &AccountBudgetStatus{Periods: make([]AccountBudgetPeriodStatus, 0, 3)}
+	add := func(name string, cost, costLimit float64, tokens, tokenLimit int64, reset time.Time) {
+		if costLimit <= 0 && tokenLimit <= 0 {
+			return
+		}
+		status.Periods = append(status.Periods, AccountBudgetPeriodStatus{
+			Period:      name,
+			CostUsed:    cost,
+			CostLimit:   costLimit,
+			TokensUsed:  tokens,
+			TokensLimit: tokenLimit,
+			ResetAt:     reset,
+			Exhausted:   budgetExceeded(cost, costLimit, tokens, tokenLimit),
+		})
+	}
+	add(AccountBudgetPeriodDaily, usage.DailyCost, limits.DailyCost, usage.DailyTokens, limits.DailyTokens, usage.DailyWindowStart.AddDate(0, 0, 1))
+	add(AccountBudgetPeriodWeekly, usage.WeeklyCost, limits.WeeklyCost, usage.WeeklyTokens, limits.WeeklyTokens, usage.WeeklyWindowStart.AddDate(0, 0, 7))
+	add(AccountBudgetPeriodMonthly, usage.MonthlyCost, limits.MonthlyCost, usage.MonthlyTokens, limits.MonthlyTokens, usage.MonthlyWindowStart.AddDate(0, 1, 0))
+
+	if period, resetAt := usage.exhaustedPeriod(limits); period != "" {
+		status.Exhausted = true
+		status.ResumeAt = &resetAt
+	}
+	return status
+}
+
+// AccountBudgetCache 账号预算用量缓存
+type AccountBudgetCache interface {
+	GetAccountBudgetUsage(ctx context.Context, accountID int64) (*AccountBudgetUsage, error)
+	SetAccountBudgetUsage(ctx context.Context, accountID int64, usage *AccountBudgetUsage) error
+	// AddAccountBudgetUsage 累加用量并按窗口起点滚动；缓存不存在时不写入
+	AddAccountBudgetUsage(ctx context.Context, accountID int64, cost float64, tokens int64, dayStart, weekStart, monthStart time.Time) error
+}
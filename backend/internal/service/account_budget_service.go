@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// AccountBudgetService 账号日/周/月费用与 token 预算
+//
+// 用量以 usage_logs 为准，Redis 缓存按窗口累加；预算耗尽时将账号临时设为不可调度，
+// 恢复时间为对应窗口的重置时间，到期后调度器自动恢复使用。
+type AccountBudgetService struct {
+	usageLogRepo     UsageLogRepository
+	accountRepo      AccountRepository
+	cache            AccountBudgetCache
+	tempUnschedCache TempUnschedCache
+}
+
+// NewAccountBudgetService creates a new AccountBudgetService
+func NewAccountBudgetService(usageLogRepo UsageLogRepository, accountRepo AccountRepository, cache AccountBudgetCache, tempUnschedCache TempUnschedCache) *AccountBudgetService {
+	return &AccountBudgetService{
+		usageLogRepo:     usageLogRepo,
+		accountRepo:      accountRepo,
+		cache:            cache,
+		tempUnschedCache: tempUnschedCache,
+	}
+}
+
+// GetUsage 获取账号当前窗口用量（优先从缓存读取）
+func (s *AccountBudgetService) GetUsage(ctx context.Context, accountID int64) (*AccountBudgetUsage, error) {
+	now := time.Now()
+	if s.cache != nil {
+		data, err := s.cache.GetAccountBudgetUsage(ctx, accountID)
+		if err == nil && data != nil {
+			return normalizeAccountBudgetUsage(data, now), nil
+		}
+	}
+
+	// 缓存未命中，从 usage_logs 聚合
+	dayStart, weekStart, monthStart := accountBudgetWindows(now)
+	stats, err := s.usageLogRepo.GetAccountPeriodUsage(ctx, accountID, dayStart, weekStart, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("get account period usage: %w", err)
+	}
+	usage := &AccountBudgetUsage{
+		DailyCost:          stats.DailyCost,
+		DailyTokens:        stats.DailyTokens,
+		DailyWindowStart:   dayStart,
+		WeeklyCost:         stats.WeeklyCost,
+		WeeklyTokens:       stats.WeeklyTokens,
+		WeeklyWindowStart:  weekStart,
+		MonthlyCost:        stats.MonthlyCost,
+		MonthlyTokens:      stats.MonthlyTokens,
+		MonthlyWindowStart: monthStart,
+	}
+	if s.cache != nil {
+		if err := s.cache.SetAccountBudgetUsage(ctx, accountID, usage); err != nil {
+			slog.Warn("account_budget_set_cache_failed", "account_id", accountID, "error", err)
+		}
+	}
+	return usage, nil
+}
+
+// GetStatus 获取账号预算进度；未配置预算时返回 nil
+func (s *AccountBudgetService) GetStatus(ctx context.Context, account *Account) (*AccountBudgetStatus, error) {
+	limits := account.GetBudgetLimits()
+	if !limits.HasAny() {
+		return nil, nil
+	}
+	usage, err := s.GetUsage(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	return buildAccountBudgetStatus(usage, limits), nil
+}
+
+// RecordUsage 累加一次请求的账号用量，预算耗尽时暂停账号调度直至窗口重置。
+// 调用方需保证对应的 usage_log 已写入，cost 为账号口径费用。
+func (s *AccountBudgetService) RecordUsage(ctx context.Context, account *Account, cost float64, tokens int64) {
+	if account == nil || !account.HasBudget() {
+		return
+	}
+
+	// 缓存不存在时不累加，随后的 GetUsage 会从数据库重建（已包含本次用量）
+	now := time.Now()
+	if s.cache != nil {
+		dayStart, weekStart, monthStart := accountBudgetWindows(now)
+		if err := s.cache.AddAccountBudgetUsage(ctx, account.ID, cost, tokens, dayStart, weekStart, monthStart); err != nil {
+			slog.Warn("account_budget_add_usage_failed", "account_id", account.ID, "error", err)
+		}
+	}
+
+	usage, err := s.GetUsage(ctx, account.ID)
+	if err != nil {
+		slog.Warn("account_budget_get_usage_failed", "account_id", account.ID, "error", err)
+		return
+	}
+	s.applyPause(ctx, account, usage, now)
+}
+
+// ReconcilePause 在预算配置变更后重新评估账号：超出新预算时暂停，
+// 不再超出时解除由预算触发的暂停（其他原因的临时不可调度保持不变）。
+func (s *AccountBudgetService) ReconcilePause(ctx context.Context, account *Account) error {
+	if account == nil {
+		return nil
+	}
+	now := time.Now()
+	if account.HasBudget() {
+		usage, err := s.GetUsage(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if s.applyPause(ctx, account, usage, now) {
+			return nil
+		}
+	}
+
+	if !isAccountBudgetPause(account, now) {
+		return nil
+	}
+	if err := s.accountRepo.ClearTempUnschedulable(ctx, account.ID); err != nil {
+		return err
+	}
+	if s.tempUnschedCache != nil {
+		if err := s.tempUnschedCache.DeleteTempUnsched(ctx, account.ID); err != nil {
+			slog.Warn("account_budget_clear_temp_unsched_cache_failed", "account_id", account.ID, "error", err)
+		}
+	}
+	account.TempUnschedulableUntil = nil
+	account.TempUnschedulableReason = ""
+	slog.Info("account_budget_resumed", "account_id", account.ID)
+	return nil
+}
+
+// applyPause 预算耗尽时将账号设为临时不可调度，返回账号是否处于预算耗尽状态
+func (s *AccountBudgetService) applyPause(ctx context.Context, account *Account, usage *AccountBudgetUsage, now time.Time) bool {
+	period, resetAt := usage.exhaustedPeriod(account.GetBudgetLimits())
+	if period == "" {
+		return false
+	}
+	if account.TempUnschedulableUntil != nil && !account.TempUnschedulableUntil.Before(resetAt) {
+		return true
+	}
+
+	state := &TempUnschedState{
+		UntilUnix:       resetAt.Unix(),
+		TriggeredAtUnix: now.Unix(),
+		StatusCode:      0,
+		MatchedKeyword:  accountBudgetPauseKeyword,
+		RuleIndex:       -1, // 表示系统级规则
+		ErrorMessage:    fmt.Sprintf("Account %s budget exhausted, resumes at %s", period, resetAt.Format(time.RFC3339)),
+	}
+
+	reason := ""
+	if raw, err := json.Marshal(state); err == nil {
+		reason = string(raw)
+	}
+	if reason == "" {
+		reason = state.ErrorMessage
+	}
+
+	if err := s.accountRepo.SetTempUnschedulable(ctx, account.ID, resetAt, reason); err != nil {
+		slog.Warn("account_budget_set_temp_unsched_failed", "account_id", account.ID, "error", err)
+		return true
+	}
+	if s.tempUnschedCache != nil {
+		if err := s.tempUnschedCache.SetTempUnsched(ctx, account.ID, state); err != nil {
+			slog.Warn("account_budget_set_temp_unsched_cache_failed", "account_id", account.ID, "error", err)
+		}
+	}
+	account.TempUnschedulableUntil = &resetAt
+	account.TempUnschedulableReason = reason
+
+	slog.Info("account_budget_exhausted", "account_id", account.ID, "period", period, "until", resetAt)
+	return true
+}
+
+// isAccountBudgetPause 账号当前的临时不可调度是否由预算耗尽触发
+func isAccountBudgetPause(account *Account, now time.Time) bool {
+	if account.TempUnschedulableUntil == nil || !now.Before(*account.TempUnschedulableUntil) {
+		return false
+	}
+	var state TempUnschedState
+	if err := json.Unmarshal([]byte(account.TempUnschedulableReason), &state); err != nil {
+		return false
+	}
+	return state.MatchedKeyword == accountBudgetPauseKeyword
+}
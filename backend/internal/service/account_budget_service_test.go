@@ -0,0 +1,144 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagestats"
+	"github.com/stretchr/testify/require"
+)
+
+type budgetUsageLogRepoStub struct {
+	UsageLogRepository
+	usage *usagestats.AccountPeriodUsage
+	calls int
+}
+
+func (r *budgetUsageLogRepoStub) GetAccountPeriodUsage(ctx context.Context, accountID int64, dayStart, weekStart, monthStart time.Time) (*usagestats.AccountPeriodUsage, error) {
+	r.calls++
+	cp := *r.usage
+	return &cp, nil
+}
+
+type budgetAccountRepoStub struct {
+	AccountRepository
+	until   *time.Time
+	reason  string
+	cleared bool
+}
+
+func (r *budgetAccountRepoStub) SetTempUnschedulable(ctx context.Context, id int64, until time.Time, reason string) error {
+	r.until = &until
+	r.reason = reason
+	return nil
+}
+
+func (r *budgetAccountRepoStub) ClearTempUnschedulable(ctx context.Context, id int64) error {
+	r.until = nil
+	r.reason = ""
+	r.cleared = true
+	return nil
+}
+
+func TestAccount_GetBudgetLimits(t *testing.T) {
+	account := &Account{Extra: map[string]any{
+		AccountExtraBudgetDailyCost:     "12.5",
+		AccountExtraBudgetMonthlyTokens: float64(1000000),
+	}}
+	limits := account.GetBudgetLimits()
+	require.True(t, limits.HasAny())
+	require.Equal(t, 12.5, limits.DailyCost)
+	require.Equal(t, int64(1000000), limits.MonthlyTokens)
+	require.Zero(t, limits.WeeklyCost)
+
+	require.False(t, (&Account{}).HasBudget())
+	require.False(t, (&Account{Extra: map[string]any{AccountExtraBudgetDailyCost: 0}}).HasBudget())
+}
+
+func TestAccountBudgetUsage_ExhaustedPeriod(t *testing.T) {
+	dayStart := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC) // 周三
+	weekStart := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	usage := &AccountBudgetUsage{
+		DailyCost:          5,
+		DailyWindowStart:   dayStart,
+		WeeklyTokens:       2000,
+		WeeklyWindowStart:  weekStart,
+		MonthlyWindowStart: monthStart,
+	}
+
+	period, _ := usage.exhaustedPeriod(AccountBudgetLimits{DailyCost: 10, WeeklyTokens: 5000})
+	require.Empty(t, period)
+
+	period, resetAt := usage.exhaustedPeriod(AccountBudgetLimits{DailyCost: 5})
+	require.Equal(t, AccountBudgetPeriodDaily, period)
+	require.Equal(t, dayStart.AddDate(0, 0, 1), resetAt)
+
+	// 多个周期同时耗尽时取最晚的恢复时间
+	period, resetAt = usage.exhaustedPeriod(AccountBudgetLimits{DailyCost: 5, WeeklyTokens: 1000})
+	require.Equal(t, AccountBudgetPeriodWeekly, period)
+	require.Equal(t, weekStart.AddDate(0, 0, 7), resetAt)
+
+	status := buildAccountBudgetStatus(usage, AccountBudgetLimits{DailyCost: 5, MonthlyCost: 100})
+	require.Len(t, status.Periods, 2)
+	require.True(t, status.Periods[0].Exhausted)
+	require.False(t, status.Periods[1].Exhausted)
+	require.True(t, status.Exhausted)
+	require.NotNil(t, status.ResumeAt)
+}
+
+func TestNormalizeAccountBudgetUsage_ResetsStaleWindows(t *testing.T) {
+	now := time.Now()
+	dayStart, weekStart, monthStart := accountBudgetWindows(now)
+	usage := normalizeAccountBudgetUsage(&AccountBudgetUsage{
+		DailyCost:          3,
+		DailyWindowStart:   dayStart.AddDate(0, 0, -1),
+		MonthlyCost:        9,
+		MonthlyWindowStart: monthStart,
+		WeeklyWindowStart:  weekStart,
+	}, now)
+	require.Zero(t, usage.DailyCost)
+	require.Equal(t, dayStart, usage.DailyWindowStart)
+	require.Equal(t, 9.0, usage.MonthlyCost)
+}
+
+func TestAccountBudgetService_RecordUsagePausesAndReconcileResumes(t *testing.T) {
+	ctx := context.Background()
+	usageRepo := &budgetUsageLogRepoStub{usage: &usagestats.AccountPeriodUsage{DailyCost: 4, DailyTokens: 100}}
+	accountRepo := &budgetAccountRepoStub{}
+	svc := NewAccountBudgetService(usageRepo, accountRepo, nil, nil)
+
+	// 未配置预算的账号不查询用量
+	svc.RecordUsage(ctx, &Account{ID: 1}, 1, 10)
+	require.Zero(t, usageRepo.calls)
+
+	account := &Account{ID: 2, Status: StatusActive, Schedulable: true, Extra: map[string]any{AccountExtraBudgetDailyCost: 5.0}}
+	svc.RecordUsage(ctx, account, 1, 10)
+	require.Nil(t, accountRepo.until, "budget not yet exhausted")
+	require.True(t, account.IsSchedulable())
+
+	usageRepo.usage.DailyCost = 5
+	svc.RecordUsage(ctx, account, 1, 10)
+	require.NotNil(t, accountRepo.until)
+	dayStart, _, _ := accountBudgetWindows(time.Now())
+	require.Equal(t, dayStart.AddDate(0, 0, 1), *accountRepo.until)
+	require.Contains(t, accountRepo.reason, accountBudgetPauseKeyword)
+	require.False(t, account.IsSchedulable())
+
+	// 提高预算后解除由预算触发的暂停
+	account.Extra[AccountExtraBudgetDailyCost] = 50.0
+	require.NoError(t, svc.ReconcilePause(ctx, account))
+	require.True(t, accountRepo.cleared)
+	require.Nil(t, account.TempUnschedulableUntil)
+
+	// 其他原因的临时不可调度不受影响
+	accountRepo.cleared = false
+	until := time.Now().Add(time.Hour)
+	account.TempUnschedulableUntil = &until
+	account.TempUnschedulableReason = `{"matched_keyword":"overloaded"}`
+	require.NoError(t, svc.ReconcilePause(ctx, account))
+	require.False(t, accountRepo.cleared)
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// AccountBundleFormat 账号迁移包格式标识
+	AccountBundleFormat  = "sub2api-account-bundle"
+	AccountBundleVersion = 1
+
+	accountBundleKDF            = "scrypt"
+	accountBundleMinPasswordLen = 8
+
+	// 导出时使用的 scrypt 参数；导入时接受的参数上限用于防止恶意包消耗过多内存
+	accountBundleScryptN    = 1 << 15
+	accountBundleScryptR    = 8
+	accountBundleScryptP    = 1
+	accountBundleMaxScryptN = 1 << 20
+	accountBundleMaxScryptR = 16
+	accountBundleMaxScryptP = 4
+)
+
+// 冲突处理策略：按名称（或迁移来源）匹配到已有账号 / 分组时的处理方式
+const (
+	AccountBundleConflictSkip      = "skip"
+	AccountBundleConflictOverwrite = "overwrite"
+	AccountBundleConflictRename    = "rename"
+)
+
+// 导入明细动作
+const (
+	AccountBundleActionCreate = "create"
+	AccountBundleActionUpdate = "update"
+	AccountBundleActionSkip   = "skip"
+	AccountBundleActionRename = "rename"
+	AccountBundleActionReuse  = "reuse"
+	AccountBundleActionFailed = "failed"
+)
+
+// accountBundleOriginKey 记录导入账号的来源（<实例 ID>:<来源账号 ID>），用于重复导入 / 实例间同步时匹配
+const accountBundleOriginKey = "bundle_origin"
+
+var (
+	ErrAccountBundlePasswordTooShort = infraerrors.BadRequest("ACCOUNT_BUNDLE_PASSWORD_TOO_SHORT", "bundle password must be at least 8 characters")
+	ErrAccountBundleInvalid          = infraerrors.BadRequest("ACCOUNT_BUNDLE_INVALID", "invalid account bundle")
+	ErrAccountBundleDecrypt          = infraerrors.BadRequest("ACCOUNT_BUNDLE_DECRYPT_FAILED", "failed to decrypt bundle: wrong password or corrupted file")
+	ErrAccountBundleUnsupported      = infraerrors.BadRequest("ACCOUNT_BUNDLE_UNSUPPORTED", "unsupported bundle format or version")
+	ErrAccountBundleInvalidPolicy    = infraerrors.BadRequest("ACCOUNT_BUNDLE_INVALID_POLICY", "conflict_policy must be one of: skip, overwrite, rename")
+	ErrAccountBundleEmpty            = infraerrors.BadRequest("ACCOUNT_BUNDLE_EMPTY", "no accounts or groups to export")
+)
+
+// AccountBundleEnvelope 加密后的迁移包（可直接保存为 JSON 文件）
+type AccountBundleEnvelope struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	Source     string    `json:"source"`
+	ExportedAt time.Time `json:"exported_at"`
+	KDF        string    `json:"kdf"`
+	ScryptN    int       `json:"scrypt_n"`
+	ScryptR    int       `json:"scrypt_r"`
+	ScryptP    int       `json:"scrypt_p"`
+	Salt       string    `json:"salt"`
+	Nonce      string    `json:"nonce"`
+	Ciphertext string    `json:"ciphertext"`
+}
+
+// AccountBundle 迁移包明文内容；ID 均为来源实例中的 ID，导入时重新映射
+type AccountBundle struct {
+	Source     string                 `json:"source"`
+	ExportedAt time.Time              `json:"exported_at"`
+	Groups     []AccountBundleGroup   `json:"groups"`
+	Proxies    []AccountBundleProxy   `json:"proxies"`
+	Accounts   []AccountBundleAccount `json:"accounts"`
+}
+
+type AccountBundleProxy struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Status   string `json:"status"`
+}
+
+type AccountBundleGroup struct {
+	ID                     int64                 `json:"id"`
+	Name                   string                `json:"name"`
+	Description            string                `json:"description"`
+	Platform               string                `json:"platform"`
+	RateMultiplier         float64               `json:"rate_multiplier"`
+	IsExclusive            bool                  `json:"is_exclusive"`
+	Status                 string                `json:"status"`
+	SubscriptionType       string                `json:"subscription_type"`
+	DailyLimitUSD          *float64              `json:"daily_limit_usd,omitempty"`
+	WeeklyLimitUSD         *float64              `json:"weekly_limit_usd,omitempty"`
+	MonthlyLimitUSD        *float64              `json:"monthly_limit_usd,omitempty"`
+	DefaultValidityDays    int                   `json:"default_validity_days"`
+	ImagePrice1K           *float64              `json:"image_price_1k,omitempty"`
+	ImagePrice2K           *float64              `json:"image_price_2k,omitempty"`
+	ImagePrice4K           *float64              `json:"image_price_4k,omitempty"`
+	ClaudeCodeOnly         bool                  `json:"claude_code_only"`
+	FallbackGroupID        *int64                `json:"fallback_group_id,omitempty"`
+	ModelRouting           map[string][]int64    `json:"model_routing,omitempty"`
+	ModelRoutingEnabled    bool                  `json:"model_routing_enabled"`
+	RateLimits             RequestRateLimits     `json:"rate_limits"`
+	ResponseCache          ResponseCacheSettings `json:"response_cache"`
+	ResponseCacheCostRatio float64               `json:"response_cache_cost_ratio"`
+}
+
+// AccountBundleAccount 账号数据；模型映射、临时不可调度规则等保存在 Credentials 中一并导出
+type AccountBundleAccount struct {
+	ID                 int64          `json:"id"`
+	Name               string         `json:"name"`
+	Notes              *string        `json:"notes,omitempty"`
+	Platform           string         `json:"platform"`
+	Type               string         `json:"type"`
+	Credentials        map[string]any `json:"credentials"`
+	Extra              map[string]any `json:"extra,omitempty"`
+	ProxyID            *int64         `json:"proxy_id,omitempty"`
+	Concurrency        int            `json:"concurrency"`
+	Priority           int            `json:"priority"`
+	RateMultiplier     *float64       `json:"rate_multiplier,omitempty"`
+	Status             string         `json:"status"`
+	Schedulable        bool           `json:"schedulable"`
+	ExpiresAt          *time.Time     `json:"expires_at,omitempty"`
+	AutoPauseOnExpired bool           `json:"auto_pause_on_expired"`
+	// GroupIDs 按分组内优先级排序
+	GroupIDs []int64 `json:"group_ids"`
+}
+
+// AccountBundleExportInput 导出参数；AccountIDs 为空表示导出全部账号
+type AccountBundleExportInput struct {
+	Password   string
+	AccountIDs []int64
+	// GroupIDs 额外导出的分组（账号所属分组总会导出）
+	GroupIDs []int64
+}
+
+// AccountBundleImportInput 导入参数
+type AccountBundleImportInput struct {
+	Envelope       *AccountBundleEnvelope
+	Password       string
+	ConflictPolicy string
+	// DryRun 仅计算差异，不写入
+	DryRun bool
+}
+
+// SyncFromInstanceInput 从另一个 sub2api 实例拉取迁移包并导入
+type SyncFromInstanceInput struct {
+	BaseURL string
+	// AdminAPIKey 远端实例的管理端 API Key（x-api-key）
+	AdminAPIKey    string
+	Password       string
+	AccountIDs     []int64
+	ConflictPolicy string
+	DryRun         bool
+}
+
+type AccountBundleImportCounts struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+	Renamed int `json:"renamed"`
+	Reused  int `json:"reused"`
+	Failed  int `json:"failed"`
+}
+
+// AccountBundleImportItem 单个条目的导入结果；Changes 为覆盖时变化的字段（不含敏感值）
+type AccountBundleImportItem struct {
+	Kind     string   `json:"kind"` // account / group / proxy
+	SourceID int64    `json:"source_id"`
+	Name     string   `json:"name"`
+	Action   string   `json:"action"`
+	TargetID *int64   `json:"target_id,omitempty"`
+	Changes  []string `json:"changes,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+type AccountBundleImportResult struct {
+	DryRun     bool                      `json:"dry_run"`
+	Source     string                    `json:"source"`
+	ExportedAt time.Time                 `json:"exported_at"`
+	Accounts   AccountBundleImportCounts `json:"accounts"`
+	Groups     AccountBundleImportCounts `json:"groups"`
+	Proxies    AccountBundleImportCounts `json:"proxies"`
+	Items      []AccountBundleImportItem `json:"items"`
+}
+
+func (c *AccountBundleImportCounts) add(action string) {
+	switch action {
+	case AccountBundleActionCreate:
+		c.Created++
+	case AccountBundleActionUpdate:
+		c.Updated++
+	case AccountBundleActionSkip:
+		c.Skipped++
+	case AccountBundleActionRename:
+		c.Renamed++
+	case AccountBundleActionReuse:
+		c.Reused++
+	case AccountBundleActionFailed:
+		c.Failed++
+	}
+}
+
+func normalizeAccountBundleConflictPolicy(policy string) (string, error) {
+	switch policy {
+	case "":
+		return AccountBundleConflictSkip, nil
+	case AccountBundleConflictSkip, AccountBundleConflictOverwrite, AccountBundleConflictRename:
+		return policy, nil
+	default:
+		return "", ErrAccountBundleInvalidPolicy
+	}
+}
+
+// sealAccountBundle 使用口令派生密钥（scrypt）并以 AES-256-GCM 加密迁移包
+func sealAccountBundle(bundle *AccountBundle, password string) (*AccountBundleEnvelope, error) {
+	if len(password) < accountBundleMinPasswordLen {
+		return nil, ErrAccountBundlePasswordTooShort
+	}
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bundle: %w", err)
+	}
+
+	env := &AccountBundleEnvelope{
+		Format:     AccountBundleFormat,
+		Version:    AccountBundleVersion,
+		Source:     bundle.Source,
+		ExportedAt: bundle.ExportedAt,
+		KDF:        accountBundleKDF,
+		ScryptN:    accountBundleScryptN,
+		ScryptR:    accountBundleScryptR,
+		ScryptP:    accountBundleScryptP,
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+	aead, err := accountBundleAEAD(password, salt, env.ScryptN, env.ScryptR, env.ScryptP)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	env.Salt = base64.StdEncoding.EncodeToString(salt)
+	env.Nonce = base64.StdEncoding.EncodeToString(nonce)
+	env.Ciphertext = base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, plaintext, accountBundleAAD(env)))
+	return env, nil
+}
+
+// openAccountBundle 校验格式并解密迁移包
+func openAccountBundle(env *AccountBundleEnvelope, password string) (*AccountBundle, error) {
+	if env == nil {
+		return nil, ErrAccountBundleInvalid
+	}
+	if env.Format != AccountBundleFormat || env.Version != AccountBundleVersion || env.KDF != accountBundleKDF {
+		return nil, ErrAccountBundleUnsupported
+	}
+	if env.ScryptN <= 1 || env.ScryptN > accountBundleMaxScryptN || env.ScryptN&(env.ScryptN-1) != 0 ||
+		env.ScryptR <= 0 || env.ScryptR > accountBundleMaxScryptR ||
+		env.ScryptP <= 0 || env.ScryptP > accountBundleMaxScryptP {
+		return nil, ErrAccountBundleUnsupported
+	}
+	salt, err := base64.StdEncoding.DecodeString(env.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, ErrAccountBundleInvalid
+	}
+	nonce, err := base64.StdEncoding.DecodeString(env.Nonce)
+	if err != nil {
+		return nil, ErrAccountBundleInvalid
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(env.Ciphertext)
+	if err != nil {
+		return nil, ErrAccountBundleInvalid
+	}
+
+	aead, err := accountBundleAEAD(password, salt, env.ScryptN, env.ScryptR, env.ScryptP)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrAccountBundleInvalid
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, accountBundleAAD(env))
+	if err != nil {
+		return nil, ErrAccountBundleDecrypt
+	}
+
+	var bundle AccountBundle
+	if err := json.Unmarshal(plaintext, &bundle); err != nil {
+		return nil, ErrAccountBundleInvalid
+	}
+	return &bundle, nil
+}
+
+func accountBundleAEAD(password string, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, n, r, p, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive bundle key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// accountBundleAAD 将明文头部绑定到密文，防止篡改来源 / 导出时间
+func accountBundleAAD(env *AccountBundleEnvelope) []byte {
+	return []byte(fmt.Sprintf("%s|%d|%s|%d", env.Format, env.Version, env.Source, env.ExportedAt.UnixNano()))
+}
@@ -0,0 +1,919 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"github.com/google/uuid"
+)
+
+const (
+	accountBundlePageSize = 100
+	// accountBundleExportPath 远端实例导出接口路径（实例间同步使用）
+	accountBundleExportPath = "/api/v1/admin/accounts/bundle/export"
+	// 远端迁移包响应体上限
+	accountBundleMaxResponseBytes = 32 << 20
+)
+
+// AccountBundleService exports accounts (with their groups and proxies) into a
+// password-encrypted bundle and imports such bundles into this instance.
+type AccountBundleService struct {
+	accountRepo AccountRepository
+	groupRepo   GroupRepository
+	proxyRepo   ProxyRepository
+	settingRepo SettingRepository
+	cfg         *config.Config
+}
+
+// NewAccountBundleService creates an account bundle service
+func NewAccountBundleService(
+	accountRepo AccountRepository,
+	groupRepo GroupRepository,
+	proxyRepo ProxyRepository,
+	settingRepo SettingRepository,
+	cfg *config.Config,
+) *AccountBundleService {
+	return &AccountBundleService{
+		accountRepo: accountRepo,
+		groupRepo:   groupRepo,
+		proxyRepo:   proxyRepo,
+		settingRepo: settingRepo,
+		cfg:         cfg,
+	}
+}
+
+// Export builds an encrypted bundle of the selected accounts (all when AccountIDs is empty),
+// the groups they belong to and the proxies they use. Proxy pools are not exported; an account
+// that uses a pool is exported with the proxy currently assigned to it.
+func (s *AccountBundleService) Export(ctx context.Context, input AccountBundleExportInput) (*AccountBundleEnvelope, error) {
+	if len(input.Password) < accountBundleMinPasswordLen {
+		return nil, ErrAccountBundlePasswordTooShort
+	}
+	instanceID, err := s.instanceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []Account
+	if len(input.AccountIDs) == 0 {
+		accounts, err = s.listAllAccounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := s.accountRepo.GetByIDs(ctx, input.AccountIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, acc := range found {
+			if acc != nil {
+				accounts = append(accounts, *acc)
+			}
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+
+	bundle := &AccountBundle{
+		Source:     instanceID,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	groupIDs := append([]int64(nil), input.GroupIDs...)
+	proxyIDs := make([]int64, 0)
+	seenProxy := make(map[int64]struct{})
+	for i := range accounts {
+		acc := &accounts[i]
+		item := accountToBundle(acc)
+		groupIDs = append(groupIDs, item.GroupIDs...)
+		if acc.ProxyID != nil {
+			if _, ok := seenProxy[*acc.ProxyID]; !ok {
+				seenProxy[*acc.ProxyID] = struct{}{}
+				proxyIDs = append(proxyIDs, *acc.ProxyID)
+			}
+		}
+		bundle.Accounts = append(bundle.Accounts, item)
+	}
+
+	// 分组：账号所属分组 + 额外指定的分组 + 降级分组（闭包）
+	seenGroup := make(map[int64]struct{})
+	for len(groupIDs) > 0 {
+		id := groupIDs[0]
+		groupIDs = groupIDs[1:]
+		if _, ok := seenGroup[id]; ok {
+			continue
+		}
+		seenGroup[id] = struct{}{}
+		group, err := s.groupRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrGroupNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		bundle.Groups = append(bundle.Groups, groupToBundle(group))
+		if group.FallbackGroupID != nil {
+			groupIDs = append(groupIDs, *group.FallbackGroupID)
+		}
+	}
+
+	for _, id := range proxyIDs {
+		proxy, err := s.proxyRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrProxyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		bundle.Proxies = append(bundle.Proxies, AccountBundleProxy{
+			ID:       proxy.ID,
+			Name:     proxy.Name,
+			Protocol: proxy.Protocol,
+			Host:     proxy.Host,
+			Port:     proxy.Port,
+			Username: proxy.Username,
+			Password: proxy.Password,
+			Status:   proxy.Status,
+		})
+	}
+
+	if len(bundle.Accounts) == 0 && len(bundle.Groups) == 0 {
+		return nil, ErrAccountBundleEmpty
+	}
+	return sealAccountBundle(bundle, input.Password)
+}
+
+// Import decrypts a bundle and applies it to this instance. With DryRun set nothing is
+// written and the result describes what would change.
+func (s *AccountBundleService) Import(ctx context.Context, input AccountBundleImportInput) (*AccountBundleImportResult, error) {
+	policy, err := normalizeAccountBundleConflictPolicy(input.ConflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := openAccountBundle(input.Envelope, input.Password)
+	if err != nil {
+		return nil, err
+	}
+	instanceID, err := s.instanceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &accountBundleImporter{
+		svc:        s,
+		bundle:     bundle,
+		policy:     policy,
+		dryRun:     input.DryRun,
+		selfSource: bundle.Source == instanceID,
+		proxyMap:   make(map[int64]int64),
+		groupMap:   make(map[int64]int64),
+		accountMap: make(map[int64]int64),
+		result: &AccountBundleImportResult{
+			DryRun:     input.DryRun,
+			Source:     bundle.Source,
+			ExportedAt: bundle.ExportedAt,
+			Items:      make([]AccountBundleImportItem, 0, len(bundle.Proxies)+len(bundle.Groups)+len(bundle.Accounts)),
+		},
+	}
+	if err := imp.run(ctx); err != nil {
+		return nil, err
+	}
+	return imp.result, nil
+}
+
+// SyncFromInstance pulls an encrypted bundle from another sub2api instance through its admin
+// API and imports it. The remote instance is authenticated with its admin API key.
+func (s *AccountBundleService) SyncFromInstance(ctx context.Context, input SyncFromInstanceInput) (*AccountBundleImportResult, error) {
+	if s.cfg == nil {
+		return nil, errors.New("config is not available")
+	}
+	baseURL := strings.TrimSpace(input.BaseURL)
+	if s.cfg.Security.URLAllowlist.Enabled {
+		normalized, err := normalizeBaseURL(baseURL, s.cfg.Security.URLAllowlist.CRSHosts, s.cfg.Security.URLAllowlist.AllowPrivateHosts)
+		if err != nil {
+			return nil, infraerrors.BadRequest("ACCOUNT_BUNDLE_INVALID_BASE_URL", err.Error())
+		}
+		baseURL = normalized
+	} else {
+		normalized, err := urlvalidator.ValidateURLFormat(baseURL, s.cfg.Security.URLAllowlist.AllowInsecureHTTP)
+		if err != nil {
+			return nil, infraerrors.BadRequest("ACCOUNT_BUNDLE_INVALID_BASE_URL", "invalid base_url: "+err.Error())
+		}
+		baseURL = normalized
+	}
+	if strings.TrimSpace(input.AdminAPIKey) == "" {
+		return nil, infraerrors.BadRequest("ACCOUNT_BUNDLE_API_KEY_REQUIRED", "admin_api_key is required")
+	}
+	if len(input.Password) < accountBundleMinPasswordLen {
+		return nil, ErrAccountBundlePasswordTooShort
+	}
+	policy := input.ConflictPolicy
+	if policy == "" {
+		policy = AccountBundleConflictOverwrite
+	}
+	if _, err := normalizeAccountBundleConflictPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	client, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            60 * time.Second,
+		ValidateResolvedIP: s.cfg.Security.URLAllowlist.Enabled,
+		AllowPrivateHosts:  s.cfg.Security.URLAllowlist.AllowPrivateHosts,
+	})
+	if err != nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+
+	env, err := fetchRemoteAccountBundle(ctx, client, baseURL, strings.TrimSpace(input.AdminAPIKey), input.Password, input.AccountIDs)
+	if err != nil {
+		return nil, err
+	}
+	return s.Import(ctx, AccountBundleImportInput{
+		Envelope:       env,
+		Password:       input.Password,
+		ConflictPolicy: policy,
+		DryRun:         input.DryRun,
+	})
+}
+
+// instanceID 返回本实例的唯一标识，首次调用时生成并持久化
+func (s *AccountBundleService) instanceID(ctx context.Context) (string, error) {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyInstanceID)
+	if err == nil && strings.TrimSpace(value) != "" {
+		return strings.TrimSpace(value), nil
+	}
+	if err != nil && !errors.Is(err, ErrSettingNotFound) {
+		return "", fmt.Errorf("get instance id: %w", err)
+	}
+	value = uuid.NewString()
+	if err := s.settingRepo.Set(ctx, SettingKeyInstanceID, value); err != nil {
+		return "", fmt.Errorf("save instance id: %w", err)
+	}
+	return value, nil
+}
+
+func (s *AccountBundleService) listAllAccounts(ctx context.Context) ([]Account, error) {
+	var out []Account
+	for page := 1; ; page++ {
+		items, pg, err := s.accountRepo.ListWithFilters(ctx, pagination.PaginationParams{Page: page, PageSize: accountBundlePageSize}, "", "", "", "")
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+		if pg == nil || page >= pg.Pages || len(items) == 0 {
+			return out, nil
+		}
+	}
+}
+
+func (s *AccountBundleService) listAllGroups(ctx context.Context) ([]Group, error) {
+	var out []Group
+	for page := 1; ; page++ {
+		items, pg, err := s.groupRepo.ListWithFilters(ctx, pagination.PaginationParams{Page: page, PageSize: accountBundlePageSize}, "", "", "", nil)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+		if pg == nil || page >= pg.Pages || len(items) == 0 {
+			return out, nil
+		}
+	}
+}
+
+func (s *AccountBundleService) listAllProxies(ctx context.Context) ([]Proxy, error) {
+	var out []Proxy
+	for page := 1; ; page++ {
+		items, pg, err := s.proxyRepo.ListWithFilters(ctx, pagination.PaginationParams{Page: page, PageSize: accountBundlePageSize}, "", "", "")
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+		if pg == nil || page >= pg.Pages || len(items) == 0 {
+			return out, nil
+		}
+	}
+}
+
+func fetchRemoteAccountBundle(ctx context.Context, client *http.Client, baseURL, apiKey, password string, accountIDs []int64) (*AccountBundleEnvelope, error) {
+	body, _ := json.Marshal(map[string]any{
+		"password":    password,
+		"account_ids": accountIDs,
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(baseURL, "/")+accountBundleExportPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote export request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, accountBundleMaxResponseBytes))
+	var parsed struct {
+		Code    int                    `json:"code"`
+		Message string                 `json:"message"`
+		Data    *AccountBundleEnvelope `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("remote export failed: status=%d", resp.StatusCode)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || parsed.Code != 0 {
+		msg := parsed.Message
+		if msg == "" {
+			msg = "unknown error"
+		}
+		return nil, fmt.Errorf("remote export failed: status=%d message=%s", resp.StatusCode, msg)
+	}
+	if parsed.Data == nil {
+		return nil, ErrAccountBundleInvalid
+	}
+	return parsed.Data, nil
+}
+
+// accountBundleImporter 保存一次导入过程中的 ID 映射和结果
+type accountBundleImporter struct {
+	svc        *AccountBundleService
+	bundle     *AccountBundle
+	policy     string
+	dryRun     bool
+	selfSource bool // 迁移包由本实例导出，账号按原 ID 匹配
+
+	proxyMap   map[int64]int64 // 来源 ID -> 本实例 ID
+	groupMap   map[int64]int64
+	accountMap map[int64]int64
+	// routedGroups 需要在账号导入后回填模型路由 / 降级分组的分组（来源 ID -> 结果条目下标）
+	routedGroups map[int64]int
+
+	result *AccountBundleImportResult
+}
+
+func (imp *accountBundleImporter) run(ctx context.Context) error {
+	if err := imp.importProxies(ctx); err != nil {
+		return err
+	}
+	if err := imp.importGroups(ctx); err != nil {
+		return err
+	}
+	if err := imp.importAccounts(ctx); err != nil {
+		return err
+	}
+	return imp.linkGroups(ctx)
+}
+
+func (imp *accountBundleImporter) addItem(counts *AccountBundleImportCounts, item AccountBundleImportItem) int {
+	counts.add(item.Action)
+	imp.result.Items = append(imp.result.Items, item)
+	return len(imp.result.Items) - 1
+}
+
+func (imp *accountBundleImporter) importProxies(ctx context.Context) error {
+	if len(imp.bundle.Proxies) == 0 {
+		return nil
+	}
+	existing, err := imp.svc.listAllProxies(ctx)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]int64, len(existing))
+	for i := range existing {
+		byKey[proxyImportKey(&existing[i])] = existing[i].ID
+	}
+
+	counts := &imp.result.Proxies
+	for _, bp := range imp.bundle.Proxies {
+		proxy := &Proxy{
+			Name:     bp.Name,
+			Protocol: bp.Protocol,
+			Host:     bp.Host,
+			Port:     bp.Port,
+			Username: bp.Username,
+			Password: bp.Password,
+			Status:   bp.Status,
+		}
+		if proxy.Status == "" {
+			proxy.Status = StatusActive
+		}
+		item := AccountBundleImportItem{Kind: "proxy", SourceID: bp.ID, Name: bp.Name}
+
+		key := proxyImportKey(proxy)
+		if id, ok := byKey[key]; ok {
+			imp.proxyMap[bp.ID] = id
+			item.Action = AccountBundleActionReuse
+			item.TargetID = int64Ptr(id)
+			imp.addItem(counts, item)
+			continue
+		}
+
+		item.Action = AccountBundleActionCreate
+		if !imp.dryRun {
+			if err := imp.svc.proxyRepo.Create(ctx, proxy); err != nil {
+				item.Action = AccountBundleActionFailed
+				item.Error = err.Error()
+				imp.addItem(counts, item)
+				continue
+			}
+			imp.proxyMap[bp.ID] = proxy.ID
+			byKey[key] = proxy.ID
+			item.TargetID = int64Ptr(proxy.ID)
+		}
+		imp.addItem(counts, item)
+	}
+	return nil
+}
+
+func (imp *accountBundleImporter) importGroups(ctx context.Context) error {
+	imp.routedGroups = make(map[int64]int)
+	if len(imp.bundle.Groups) == 0 {
+		return nil
+	}
+	existing, err := imp.svc.listAllGroups(ctx)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*Group, len(existing))
+	for i := range existing {
+		byName[existing[i].Name] = &existing[i]
+	}
+
+	counts := &imp.result.Groups
+	for _, bg := range imp.bundle.Groups {
+		item := AccountBundleImportItem{Kind: "group", SourceID: bg.ID, Name: bg.Name}
+		current := byName[bg.Name]
+
+		if current != nil && imp.policy != AccountBundleConflictRename {
+			imp.groupMap[bg.ID] = current.ID
+			item.TargetID = int64Ptr(current.ID)
+			if imp.policy == AccountBundleConflictSkip {
+				item.Action = AccountBundleActionSkip
+				imp.addItem(counts, item)
+				continue
+			}
+			// overwrite：模型路由 / 降级分组在账号导入后回填
+			updated := *current
+			applyBundleGroup(&updated, &bg)
+			item.Action = AccountBundleActionUpdate
+			item.Changes = diffBundleGroup(current, &updated)
+			if !imp.dryRun && len(item.Changes) > 0 {
+				if err := imp.svc.groupRepo.Update(ctx, &updated); err != nil {
+					item.Action = AccountBundleActionFailed
+					item.Error = err.Error()
+					imp.addItem(counts, item)
+					continue
+				}
+				*current = updated
+			}
+			imp.routedGroups[bg.ID] = imp.addItem(counts, item)
+			continue
+		}
+
+		group := &Group{}
+		applyBundleGroup(group, &bg)
+		group.ModelRouting = nil
+		group.FallbackGroupID = nil
+		item.Action = AccountBundleActionCreate
+		if current != nil {
+			group.Name = uniqueBundleName(bg.Name, func(name string) bool { return byName[name] != nil })
+			item.Action = AccountBundleActionRename
+			item.Changes = []string{"name: " + group.Name}
+		}
+		if !imp.dryRun {
+			if err := imp.svc.groupRepo.Create(ctx, group); err != nil {
+				item.Action = AccountBundleActionFailed
+				item.Error = err.Error()
+				imp.addItem(counts, item)
+				continue
+			}
+			imp.groupMap[bg.ID] = group.ID
+			item.TargetID = int64Ptr(group.ID)
+		}
+		byName[group.Name] = group
+		imp.routedGroups[bg.ID] = imp.addItem(counts, item)
+	}
+	return nil
+}
+
+func (imp *accountBundleImporter) importAccounts(ctx context.Context) error {
+	if len(imp.bundle.Accounts) == 0 {
+		return nil
+	}
+	existing, err := imp.svc.listAllAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]*Account, len(existing))
+	byOrigin := make(map[string]*Account)
+	byName := make(map[string]*Account, len(existing))
+	for i := range existing {
+		acc := &existing[i]
+		byID[acc.ID] = acc
+		if origin, ok := acc.Extra[accountBundleOriginKey].(string); ok && origin != "" {
+			byOrigin[origin] = acc
+		}
+		byName[acc.Platform+"|"+acc.Name] = acc
+	}
+
+	counts := &imp.result.Accounts
+	for i := range imp.bundle.Accounts {
+		ba := &imp.bundle.Accounts[i]
+		origin := imp.bundle.Source + ":" + strconv.FormatInt(ba.ID, 10)
+		item := AccountBundleImportItem{Kind: "account", SourceID: ba.ID, Name: ba.Name}
+
+		var current *Account
+		if imp.selfSource {
+			current = byID[ba.ID]
+		}
+		if current == nil {
+			current = byOrigin[origin]
+		}
+		if current == nil {
+			current = byName[ba.Platform+"|"+ba.Name]
+		}
+
+		groupIDs := imp.mapIDs(ba.GroupIDs, imp.groupMap)
+
+		if current != nil && imp.policy != AccountBundleConflictRename {
+			imp.accountMap[ba.ID] = current.ID
+			item.TargetID = int64Ptr(current.ID)
+			if imp.policy == AccountBundleConflictSkip {
+				item.Action = AccountBundleActionSkip
+				imp.addItem(counts, item)
+				continue
+			}
+			updated := *current
+			imp.applyAccount(&updated, ba, origin, false)
+			item.Action = AccountBundleActionUpdate
+			item.Changes = diffBundleAccount(current, &updated)
+			groupsChanged := !int64SliceEqual(accountGroupIDsByPriority(current), groupIDs)
+			if groupsChanged {
+				item.Changes = append(item.Changes, "group_ids")
+			}
+			if !imp.dryRun {
+				if err := imp.svc.accountRepo.Update(ctx, &updated); err != nil {
+					item.Action = AccountBundleActionFailed
+					item.Error = err.Error()
+					imp.addItem(counts, item)
+					continue
+				}
+				if groupsChanged {
+					if err := imp.svc.accountRepo.BindGroups(ctx, updated.ID, groupIDs); err != nil {
+						item.Action = AccountBundleActionFailed
+						item.Error = err.Error()
+					}
+				}
+			}
+			imp.addItem(counts, item)
+			continue
+		}
+
+		account := &Account{}
+		imp.applyAccount(account, ba, origin, true)
+		item.Action = AccountBundleActionCreate
+		if current != nil {
+			account.Name = uniqueBundleName(ba.Name, func(name string) bool { return byName[ba.Platform+"|"+name] != nil })
+			item.Action = AccountBundleActionRename
+			item.Changes = []string{"name: " + account.Name}
+		}
+		if !imp.dryRun {
+			if err := imp.svc.accountRepo.Create(ctx, account); err != nil {
+				item.Action = AccountBundleActionFailed
+				item.Error = err.Error()
+				imp.addItem(counts, item)
+				continue
+			}
+			imp.accountMap[ba.ID] = account.ID
+			item.TargetID = int64Ptr(account.ID)
+			if len(groupIDs) > 0 {
+				if err := imp.svc.accountRepo.BindGroups(ctx, account.ID, groupIDs); err != nil {
+					item.Action = AccountBundleActionFailed
+					item.Error = err.Error()
+				}
+			}
+		}
+		byName[account.Platform+"|"+account.Name] = account
+		imp.addItem(counts, item)
+	}
+	return nil
+}
+
+// linkGroups 将分组的模型路由账号 ID 与降级分组 ID 映射为本实例 ID
+func (imp *accountBundleImporter) linkGroups(ctx context.Context) error {
+	for _, bg := range imp.bundle.Groups {
+		idx, ok := imp.routedGroups[bg.ID]
+		if !ok {
+			continue
+		}
+		item := &imp.result.Items[idx]
+		if item.Action == AccountBundleActionFailed || item.TargetID == nil {
+			continue
+		}
+
+		routing := make(map[string][]int64, len(bg.ModelRouting))
+		for pattern, ids := range bg.ModelRouting {
+			if mapped := imp.mapIDs(ids, imp.accountMap); len(mapped) > 0 {
+				routing[pattern] = mapped
+			}
+		}
+		var fallback *int64
+		if bg.FallbackGroupID != nil {
+			if id, ok := imp.groupMap[*bg.FallbackGroupID]; ok {
+				fallback = int64Ptr(id)
+			}
+		}
+
+		group, err := imp.svc.groupRepo.GetByID(ctx, *item.TargetID)
+		if err != nil {
+			return err
+		}
+		var changes []string
+		if !bundleJSONEqual(group.ModelRouting, routing) {
+			changes = append(changes, "model_routing")
+		}
+		if !int64PtrEqual(group.FallbackGroupID, fallback) {
+			changes = append(changes, "fallback_group_id")
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		if item.Action == AccountBundleActionUpdate {
+			item.Changes = append(item.Changes, changes...)
+		}
+		if imp.dryRun {
+			continue
+		}
+		group.ModelRouting = routing
+		group.FallbackGroupID = fallback
+		if err := imp.svc.groupRepo.Update(ctx, group); err != nil {
+			item.Action = AccountBundleActionFailed
+			item.Error = err.Error()
+		}
+	}
+	return nil
+}
+
+// applyAccount 将迁移包中的账号写入 acc。
+// 覆盖已有账号时保留其运行状态（status / schedulable），使用代理池的账号保留池分配的代理。
+func (imp *accountBundleImporter) applyAccount(acc *Account, ba *AccountBundleAccount, origin string, create bool) {
+	acc.Name = ba.Name
+	acc.Notes = ba.Notes
+	acc.Platform = ba.Platform
+	acc.Type = ba.Type
+	acc.Credentials = ba.Credentials
+	acc.Concurrency = ba.Concurrency
+	acc.Priority = ba.Priority
+	acc.RateMultiplier = ba.RateMultiplier
+	acc.ExpiresAt = ba.ExpiresAt
+	acc.AutoPauseOnExpired = ba.AutoPauseOnExpired
+
+	extra := make(map[string]any, len(acc.Extra)+len(ba.Extra)+1)
+	for k, v := range acc.Extra {
+		extra[k] = v
+	}
+	for k, v := range ba.Extra {
+		extra[k] = v
+	}
+	extra[accountBundleOriginKey] = origin
+	acc.Extra = extra
+
+	if acc.ProxyPoolID == nil {
+		acc.ProxyID = nil
+		if ba.ProxyID != nil {
+			if id, ok := imp.proxyMap[*ba.ProxyID]; ok {
+				acc.ProxyID = int64Ptr(id)
+			}
+		}
+	}
+
+	if create {
+		acc.Status = ba.Status
+		if acc.Status == "" {
+			acc.Status = StatusActive
+		}
+		acc.Schedulable = ba.Schedulable
+	}
+}
+
+func (imp *accountBundleImporter) mapIDs(ids []int64, mapping map[int64]int64) []int64 {
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if mapped, ok := mapping[id]; ok {
+			out = append(out, mapped)
+		}
+	}
+	return out
+}
+
+func accountToBundle(acc *Account) AccountBundleAccount {
+	var extra map[string]any
+	if len(acc.Extra) > 0 {
+		extra = make(map[string]any, len(acc.Extra))
+		for k, v := range acc.Extra {
+			// 来源标记在导出时重置为本实例
+			if k == accountBundleOriginKey {
+				continue
+			}
+			extra[k] = v
+		}
+	}
+	return AccountBundleAccount{
+		ID:                 acc.ID,
+		Name:               acc.Name,
+		Notes:              acc.Notes,
+		Platform:           acc.Platform,
+		Type:               acc.Type,
+		Credentials:        acc.Credentials,
+		Extra:              extra,
+		ProxyID:            acc.ProxyID,
+		Concurrency:        acc.Concurrency,
+		Priority:           acc.Priority,
+		RateMultiplier:     acc.RateMultiplier,
+		Status:             acc.Status,
+		Schedulable:        acc.Schedulable,
+		ExpiresAt:          acc.ExpiresAt,
+		AutoPauseOnExpired: acc.AutoPauseOnExpired,
+		GroupIDs:           accountGroupIDsByPriority(acc),
+	}
+}
+
+// accountGroupIDsByPriority 返回账号所属分组 ID（按分组内优先级排序）
+func accountGroupIDsByPriority(acc *Account) []int64 {
+	if len(acc.AccountGroups) == 0 {
+		return append([]int64{}, acc.GroupIDs...)
+	}
+	groups := append([]AccountGroup(nil), acc.AccountGroups...)
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Priority < groups[j].Priority })
+	ids := make([]int64, 0, len(groups))
+	for _, ag := range groups {
+		ids = append(ids, ag.GroupID)
+	}
+	return ids
+}
+
+func groupToBundle(g *Group) AccountBundleGroup {
+	return AccountBundleGroup{
+		ID:                     g.ID,
+		Name:                   g.Name,
+		Description:            g.Description,
+		Platform:               g.Platform,
+		RateMultiplier:         g.RateMultiplier,
+		IsExclusive:            g.IsExclusive,
+		Status:                 g.Status,
+		SubscriptionType:       g.SubscriptionType,
+		DailyLimitUSD:          g.DailyLimitUSD,
+		WeeklyLimitUSD:         g.WeeklyLimitUSD,
+		MonthlyLimitUSD:        g.MonthlyLimitUSD,
+		DefaultValidityDays:    g.DefaultValidityDays,
+		ImagePrice1K:           g.ImagePrice1K,
+		ImagePrice2K:           g.ImagePrice2K,
+		ImagePrice4K:           g.ImagePrice4K,
+		ClaudeCodeOnly:         g.ClaudeCodeOnly,
+		FallbackGroupID:        g.FallbackGroupID,
+		ModelRouting:           g.ModelRouting,
+		ModelRoutingEnabled:    g.ModelRoutingEnabled,
+		RateLimits:             g.RateLimits,
+		ResponseCache:          g.ResponseCache,
+		ResponseCacheCostRatio: g.ResponseCacheCostRatio,
+	}
+}
+
+// applyBundleGroup 写入分组配置（模型路由与降级分组由 linkGroups 处理）
+func applyBundleGroup(g *Group, bg *AccountBundleGroup) {
+	g.Name = bg.Name
+	g.Description = bg.Description
+	g.Platform = bg.Platform
+	g.RateMultiplier = bg.RateMultiplier
+	g.IsExclusive = bg.IsExclusive
+	g.Status = bg.Status
+	if g.Status == "" {
+		g.Status = StatusActive
+	}
+	g.SubscriptionType = bg.SubscriptionType
+	if g.SubscriptionType == "" {
+		g.SubscriptionType = SubscriptionTypeStandard
+	}
+	g.DailyLimitUSD = bg.DailyLimitUSD
+	g.WeeklyLimitUSD = bg.WeeklyLimitUSD
+	g.MonthlyLimitUSD = bg.MonthlyLimitUSD
+	g.DefaultValidityDays = bg.DefaultValidityDays
+	g.ImagePrice1K = bg.ImagePrice1K
+	g.ImagePrice2K = bg.ImagePrice2K
+	g.ImagePrice4K = bg.ImagePrice4K
+	g.ClaudeCodeOnly = bg.ClaudeCodeOnly
+	g.ModelRoutingEnabled = bg.ModelRoutingEnabled
+	g.RateLimits = bg.RateLimits
+	g.ResponseCache = bg.ResponseCache
+	g.ResponseCacheCostRatio = bg.ResponseCacheCostRatio
+}
+
+func diffBundleGroup(before, after *Group) []string {
+	var changes []string
+	check := func(name string, a, b any) {
+		if !bundleJSONEqual(a, b) {
+			changes = append(changes, name)
+		}
+	}
+	check("description", before.Description, after.Description)
+	check("platform", before.Platform, after.Platform)
+	check("rate_multiplier", before.RateMultiplier, after.RateMultiplier)
+	check("is_exclusive", before.IsExclusive, after.IsExclusive)
+	check("status", before.Status, after.Status)
+	check("subscription_type", before.SubscriptionType, after.SubscriptionType)
+	check("daily_limit_usd", before.DailyLimitUSD, after.DailyLimitUSD)
+	check("weekly_limit_usd", before.WeeklyLimitUSD, after.WeeklyLimitUSD)
+	check("monthly_limit_usd", before.MonthlyLimitUSD, after.MonthlyLimitUSD)
+	check("default_validity_days", before.DefaultValidityDays, after.DefaultValidityDays)
+	check("image_price_1k", before.ImagePrice1K, after.ImagePrice1K)
+	check("image_price_2k", before.ImagePrice2K, after.ImagePrice2K)
+	check("image_price_4k", before.ImagePrice4K, after.ImagePrice4K)
+	check("claude_code_only", before.ClaudeCodeOnly, after.ClaudeCodeOnly)
+	check("model_routing_enabled", before.ModelRoutingEnabled, after.ModelRoutingEnabled)
+	check("rate_limits", before.RateLimits, after.RateLimits)
+	check("response_cache", before.ResponseCache, after.ResponseCache)
+	check("response_cache_cost_ratio", before.ResponseCacheCostRatio, after.ResponseCacheCostRatio)
+	return changes
+}
+
+// diffBundleAccount 返回发生变化的字段名（不包含任何凭证内容）
+func diffBundleAccount(before, after *Account) []string {
+	var changes []string
+	check := func(name string, a, b any) {
+		if !bundleJSONEqual(a, b) {
+			changes = append(changes, name)
+		}
+	}
+	check("name", before.Name, after.Name)
+	check("notes", before.Notes, after.Notes)
+	check("type", before.Type, after.Type)
+	check("credentials", before.Credentials, after.Credentials)
+	check("extra", before.Extra, after.Extra)
+	check("proxy_id", before.ProxyID, after.ProxyID)
+	check("concurrency", before.Concurrency, after.Concurrency)
+	check("priority", before.Priority, after.Priority)
+	check("rate_multiplier", before.RateMultiplier, after.RateMultiplier)
+	check("expires_at", before.ExpiresAt, after.ExpiresAt)
+	check("auto_pause_on_expired", before.AutoPauseOnExpired, after.AutoPauseOnExpired)
+	return changes
+}
+
+// bundleJSONEqual 按 JSON 语义比较（数据库与迁移包中的 map 均来自 JSON 解码，数字类型一致）
+func bundleJSONEqual(a, b any) bool {
+	ra, errA := json.Marshal(a)
+	rb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	if string(ra) == "{}" || string(ra) == "[]" {
+		ra = []byte("null")
+	}
+	if string(rb) == "{}" || string(rb) == "[]" {
+		rb = []byte("null")
+	}
+	return bytes.Equal(ra, rb)
+}
+
+func uniqueBundleName(base string, taken func(string) bool) string {
+	name := base + " (imported)"
+	for i := 2; taken(name); i++ {
+		name = fmt.Sprintf("%s (imported %d)", base, i)
+	}
+	return name
+}
+
+func int64SliceEqual(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,285 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type memBundleAccountRepo struct {
+	AccountRepository
+	nextID   int64
+	accounts map[int64]*Account
+}
+
+func newMemBundleAccountRepo() *memBundleAccountRepo {
+	return &memBundleAccountRepo{accounts: map[int64]*Account{}}
+}
+
+func (r *memBundleAccountRepo) Create(ctx context.Context, account *Account) error {
+	r.nextID++
+	account.ID = r.nextID
+	cp := *account
+	r.accounts[account.ID] = &cp
+	return nil
+}
+
+func (r *memBundleAccountRepo) Update(ctx context.Context, account *Account) error {
+	cp := *account
+	cp.AccountGroups = r.accounts[account.ID].AccountGroups
+	r.accounts[account.ID] = &cp
+	return nil
+}
+
+func (r *memBundleAccountRepo) BindGroups(ctx context.Context, accountID int64, groupIDs []int64) error {
+	acc := r.accounts[accountID]
+	acc.AccountGroups = nil
+	for i, id := range groupIDs {
+		acc.AccountGroups = append(acc.AccountGroups, AccountGroup{AccountID: accountID, GroupID: id, Priority: i + 1})
+	}
+	acc.GroupIDs = append([]int64(nil), groupIDs...)
+	return nil
+}
+
+func (r *memBundleAccountRepo) GetByIDs(ctx context.Context, ids []int64) ([]*Account, error) {
+	var out []*Account
+	for _, id := range ids {
+		if acc, ok := r.accounts[id]; ok {
+			cp := *acc
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (r *memBundleAccountRepo) ListWithFilters(ctx context.Context, params pagination.PaginationParams, platform, accountType, status, search string) ([]Account, *pagination.PaginationResult, error) {
+	out := make([]Account, 0, len(r.accounts))
+	for _, acc := range r.accounts {
+		out = append(out, *acc)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+type memBundleGroupRepo struct {
+	GroupRepository
+	nextID int64
+	groups map[int64]*Group
+}
+
+func newMemBundleGroupRepo() *memBundleGroupRepo {
+	return &memBundleGroupRepo{groups: map[int64]*Group{}}
+}
+
+func (r *memBundleGroupRepo) Create(ctx context.Context, group *Group) error {
+	r.nextID++
+	group.ID = r.nextID
+	cp := *group
+	r.groups[group.ID] = &cp
+	return nil
+}
+
+func (r *memBundleGroupRepo) Update(ctx context.Context, group *Group) error {
+	cp := *group
+	r.groups[group.ID] = &cp
+	return nil
+}
+
+func (r *memBundleGroupRepo) GetByID(ctx context.Context, id int64) (*Group, error) {
+	g, ok := r.groups[id]
+	if !ok {
+		return nil, ErrGroupNotFound
+	}
+	cp := *g
+	return &cp, nil
+}
+
+func (r *memBundleGroupRepo) ListWithFilters(ctx context.Context, params pagination.PaginationParams, platform, status, search string, isExclusive *bool) ([]Group, *pagination.PaginationResult, error) {
+	out := make([]Group, 0, len(r.groups))
+	for _, g := range r.groups {
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+type memBundleProxyRepo struct {
+	*memProxyRepo
+}
+
+func (r memBundleProxyRepo) GetByID(ctx context.Context, id int64) (*Proxy, error) {
+	p, ok := r.proxies[id]
+	if !ok {
+		return nil, ErrProxyNotFound
+	}
+	cp := *p
+	return &cp, nil
+}
+
+func (r memBundleProxyRepo) ListWithFilters(ctx context.Context, params pagination.PaginationParams, protocol, status, search string) ([]Proxy, *pagination.PaginationResult, error) {
+	out := make([]Proxy, 0, len(r.proxies))
+	for _, p := range r.proxies {
+		out = append(out, *p)
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, Pages: 1}, nil
+}
+
+type memBundleSettingRepo struct {
+	SettingRepository
+	values map[string]string
+}
+
+func (r *memBundleSettingRepo) GetValue(ctx context.Context, key string) (string, error) {
+	v, ok := r.values[key]
+	if !ok {
+		return "", ErrSettingNotFound
+	}
+	return v, nil
+}
+
+func (r *memBundleSettingRepo) Set(ctx context.Context, key, value string) error {
+	r.values[key] = value
+	return nil
+}
+
+type bundleInstance struct {
+	svc      *AccountBundleService
+	accounts *memBundleAccountRepo
+	groups   *memBundleGroupRepo
+	proxies  memBundleProxyRepo
+}
+
+func newBundleInstance() *bundleInstance {
+	inst := &bundleInstance{
+		accounts: newMemBundleAccountRepo(),
+		groups:   newMemBundleGroupRepo(),
+		proxies:  memBundleProxyRepo{newMemProxyRepo()},
+	}
+	inst.svc = NewAccountBundleService(inst.accounts, inst.groups, inst.proxies, &memBundleSettingRepo{values: map[string]string{}}, nil)
+	return inst
+}
+
+func TestAccountBundle_SealOpen(t *testing.T) {
+	bundle := &AccountBundle{
+		Source:   "instance-a",
+		Accounts: []AccountBundleAccount{{ID: 1, Name: "acc", Credentials: map[string]any{"api_key": "sk-secret"}}},
+	}
+
+	_, err := sealAccountBundle(bundle, "short")
+	require.ErrorIs(t, err, ErrAccountBundlePasswordTooShort)
+
+	env, err := sealAccountBundle(bundle, "correct horse")
+	require.NoError(t, err)
+	require.NotContains(t, env.Ciphertext, "sk-secret")
+
+	opened, err := openAccountBundle(env, "correct horse")
+	require.NoError(t, err)
+	require.Equal(t, "sk-secret", opened.Accounts[0].Credentials["api_key"])
+
+	_, err = openAccountBundle(env, "wrong password")
+	require.ErrorIs(t, err, ErrAccountBundleDecrypt)
+
+	tampered := *env
+	tampered.Source = "instance-b"
+	_, err = openAccountBundle(&tampered, "correct horse")
+	require.ErrorIs(t, err, ErrAccountBundleDecrypt)
+
+	tampered = *env
+	tampered.ScryptN = 1 << 30
+	_, err = openAccountBundle(&tampered, "correct horse")
+	require.ErrorIs(t, err, ErrAccountBundleUnsupported)
+}
+
+func TestAccountBundleService_ExportImportRemapsIDs(t *testing.T) {
+	ctx := context.Background()
+	src := newBundleInstance()
+
+	// 占用 ID，确保两个实例的 ID 不一致
+	require.NoError(t, src.groups.Create(ctx, &Group{Name: "unused"}))
+	proxy := &Proxy{Name: "p", Protocol: "http", Host: "1.2.3.4", Port: 8080, Status: StatusActive}
+	require.NoError(t, src.proxies.Create(ctx, proxy))
+	group := &Group{Name: "claude-pool", Platform: PlatformAnthropic, Status: StatusActive, RateMultiplier: 1.5}
+	require.NoError(t, src.groups.Create(ctx, group))
+	acc := &Account{Name: "main", Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true,
+		Credentials: map[string]any{"api_key": "sk-1", "model_mapping": map[string]any{"a": "b"}}, ProxyID: &proxy.ID}
+	require.NoError(t, src.accounts.Create(ctx, acc))
+	require.NoError(t, src.accounts.BindGroups(ctx, acc.ID, []int64{group.ID}))
+	group.ModelRouting = map[string][]int64{"claude-*": {acc.ID}}
+	require.NoError(t, src.groups.Update(ctx, group))
+
+	env, err := src.svc.Export(ctx, AccountBundleExportInput{Password: "password123"})
+	require.NoError(t, err)
+
+	dst := newBundleInstance()
+	result, err := dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123", DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Accounts.Created)
+	require.Empty(t, dst.accounts.accounts, "dry run must not write")
+
+	result, err = dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123"})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Accounts.Created)
+	require.Equal(t, 1, result.Groups.Created)
+	require.Equal(t, 1, result.Proxies.Created)
+
+	imported := dst.accounts.accounts[1]
+	require.Equal(t, "sk-1", imported.Credentials["api_key"])
+	require.Equal(t, []int64{1}, imported.GroupIDs)
+	require.Equal(t, int64(1), *imported.ProxyID)
+	require.Equal(t, []int64{1}, dst.groups.groups[1].ModelRouting["claude-*"])
+
+	// 目标实例改名后再次同步，仍按来源标记匹配到同一账号
+	imported.Name = "renamed"
+	acc.Concurrency = 5
+	require.NoError(t, src.accounts.Update(ctx, acc))
+	env, err = src.svc.Export(ctx, AccountBundleExportInput{Password: "password123"})
+	require.NoError(t, err)
+
+	result, err = dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123", ConflictPolicy: AccountBundleConflictOverwrite})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Accounts.Updated)
+	require.Equal(t, 1, result.Proxies.Reused)
+	require.Len(t, dst.accounts.accounts, 1)
+	require.Equal(t, 5, dst.accounts.accounts[1].Concurrency)
+	for _, item := range result.Items {
+		if item.Kind == "account" {
+			require.ElementsMatch(t, []string{"name", "concurrency"}, item.Changes)
+		}
+	}
+}
+
+func TestAccountBundleService_ImportConflictPolicies(t *testing.T) {
+	ctx := context.Background()
+	src := newBundleInstance()
+	require.NoError(t, src.groups.Create(ctx, &Group{Name: "shared", Platform: PlatformOpenAI, Status: StatusActive}))
+	require.NoError(t, src.accounts.Create(ctx, &Account{Name: "acc", Platform: PlatformOpenAI, Type: AccountTypeAPIKey, Status: StatusActive}))
+	require.NoError(t, src.accounts.BindGroups(ctx, 1, []int64{1}))
+	env, err := src.svc.Export(ctx, AccountBundleExportInput{Password: "password123"})
+	require.NoError(t, err)
+
+	dst := newBundleInstance()
+	require.NoError(t, dst.groups.Create(ctx, &Group{Name: "shared", Platform: PlatformOpenAI, Status: StatusActive}))
+	require.NoError(t, dst.accounts.Create(ctx, &Account{Name: "acc", Platform: PlatformOpenAI, Type: AccountTypeAPIKey, Status: StatusActive}))
+
+	_, err = dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123", ConflictPolicy: "merge"})
+	require.ErrorIs(t, err, ErrAccountBundleInvalidPolicy)
+
+	result, err := dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123", ConflictPolicy: AccountBundleConflictSkip})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Accounts.Skipped)
+	require.Equal(t, 1, result.Groups.Skipped)
+	require.Len(t, dst.accounts.accounts, 1)
+
+	result, err = dst.svc.Import(ctx, AccountBundleImportInput{Envelope: env, Password: "password123", ConflictPolicy: AccountBundleConflictRename})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Accounts.Renamed)
+	require.Equal(t, 1, result.Groups.Renamed)
+	require.Equal(t, "acc (imported)", dst.accounts.accounts[2].Name)
+	require.Equal(t, "shared (imported)", dst.groups.groups[2].Name)
+	require.Equal(t, []int64{2}, dst.accounts.accounts[2].GroupIDs)
+}
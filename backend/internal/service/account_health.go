@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	mathrand "math/rand"
+	"sort"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+// 账号选择模式（gateway.scheduling.selection_mode）
+const (
+	// SchedulingModeLoadAware 默认：同优先级内按负载率、最后使用时间排序
+	SchedulingModeLoadAware = "load_aware"
+	// SchedulingModeHealthWeighted 健康度加权：同优先级内按健康分加权随机选择，亚健康账号分得更少流量
+	SchedulingModeHealthWeighted = "health_weighted"
+)
+
+const (
+	// accountHealthWindow 健康度统计窗口（由 OpsMetricsCollector 周期性计算并写入 Redis）
+	accountHealthWindow = 15 * time.Minute
+	// accountHealthMinSamples 样本量不足时不据此惩罚账号
+	accountHealthMinSamples = 5
+	// accountHealthMinWeight 最低权重：亚健康账号仍保留少量流量，以便恢复后能被重新观测到
+	accountHealthMinWeight = 0.05
+
+	// 首字延迟参考线（毫秒）：低于 good 不扣分，高于 bad 扣到该项下限
+	accountHealthTTFTP50GoodMs = 2000
+	accountHealthTTFTP50BadMs  = 10000
+	accountHealthTTFTP95GoodMs = 6000
+	accountHealthTTFTP95BadMs  = 30000
+)
+
+// 健康分因子标识，前端据此渲染说明文案
+const (
+	AccountHealthFactorSuccessRate = "success_rate"
+	AccountHealthFactorLatency     = "latency"
+	AccountHealthFactorThrottle    = "throttle"
+	AccountHealthFactorWindowCost  = "window_cost"
+)
+
+// AccountHealthStats 账号在最近统计窗口内的运行指标（来自 usage_logs 与 ops_error_logs）
+type AccountHealthStats struct {
+	AccountID        int64     `json:"account_id"`
+	WindowMinutes    int       `json:"window_minutes"`
+	SuccessCount     int64     `json:"success_count"`
+	ErrorCount       int64     `json:"error_count"`
+	Upstream429Count int64     `json:"upstream_429_count"`
+	Upstream529Count int64     `json:"upstream_529_count"`
+	TTFTP50Ms        *int      `json:"ttft_p50_ms,omitempty"`
+	TTFTP95Ms        *int      `json:"ttft_p95_ms,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// AccountHealthCache 账号健康指标缓存
+// Key 格式: account:health:{accountID}
+type AccountHealthCache interface {
+	GetAccountHealthStats(ctx context.Context, accountIDs []int64) (map[int64]*AccountHealthStats, error)
+	SetAccountHealthStats(ctx context.Context, stats []*AccountHealthStats, ttl time.Duration) error
+}
+
+// AccountHealthFactor 单项健康因子，Score 取值 0-100
+type AccountHealthFactor struct {
+	Key   string  `json:"key"`
+	Score float64 `json:"score"`
+	// Applied 为 false 表示数据不足或不适用，该项不参与扣分
+	Applied bool `json:"applied"`
+}
+
+// AccountHealthReport 账号健康分及其构成，供调度与管理后台展示
+type AccountHealthReport struct {
+	AccountID int64 `json:"account_id"`
+	// Score 综合健康分（0-100），为各因子的乘积
+	Score float64 `json:"score"`
+	// Weight 调度权重（0-1），不低于 accountHealthMinWeight
+	Weight  float64               `json:"weight"`
+	Factors []AccountHealthFactor `json:"factors"`
+	Stats   *AccountHealthStats   `json:"stats,omitempty"`
+
+	WindowCost      *float64 `json:"window_cost,omitempty"`
+	WindowCostLimit *float64 `json:"window_cost_limit,omitempty"`
+
+	// SchedulingMode 当前生效的调度模式，便于管理员判断健康分是否影响选号
+	SchedulingMode string `json:"scheduling_mode"`
+}
+
+// computeAccountHealth 根据运行指标与窗口费用计算健康分。
+// windowCostLimit <= 0 表示未启用窗口费用限制。
+func computeAccountHealth(stats *AccountHealthStats, windowCost, windowCostLimit float64) *AccountHealthReport {
+	report := &AccountHealthReport{Stats: stats}
+	if stats != nil {
+		report.AccountID = stats.AccountID
+	}
+
+	successFactor := AccountHealthFactor{Key: AccountHealthFactorSuccessRate, Score: 100}
+	latencyFactor := AccountHealthFactor{Key: AccountHealthFactorLatency, Score: 100}
+	throttleFactor := AccountHealthFactor{Key: AccountHealthFactorThrottle, Score: 100}
+	windowFactor := AccountHealthFactor{Key: AccountHealthFactorWindowCost, Score: 100}
+
+	if stats != nil {
+		total := stats.SuccessCount + stats.ErrorCount
+		if total >= accountHealthMinSamples {
+			// 成功率平方：95% → 90，80% → 64，对持续报错的账号惩罚更明显
+			rate := float64(stats.SuccessCount) / float64(total)
+			successFactor.Score = rate * rate * 100
+			successFactor.Applied = true
+
+			// 429/529 占比：10% → 80，50% 及以上 → 0（最终由最低权重兜底）
+			throttled := float64(stats.Upstream429Count+stats.Upstream529Count) / float64(total)
+			throttleFactor.Score = clampFloat64(1-throttled*2, 0, 1) * 100
+			throttleFactor.Applied = true
+		}
+
+		if stats.SuccessCount >= accountHealthMinSamples && (stats.TTFTP50Ms != nil || stats.TTFTP95Ms != nil) {
+			// 延迟只做温和降权（下限 30），避免长上下文请求较多的账号被过度惩罚
+			score := 1.0
+			if stats.TTFTP50Ms != nil {
+				score = math.Min(score, latencyScore(*stats.TTFTP50Ms, accountHealthTTFTP50GoodMs, accountHealthTTFTP50BadMs))
+			}
+			if stats.TTFTP95Ms != nil {
+				score = math.Min(score, latencyScore(*stats.TTFTP95Ms, accountHealthTTFTP95GoodMs, accountHealthTTFTP95BadMs))
+			}
+			latencyFactor.Score = score * 100
+			latencyFactor.Applied = true
+		}
+	}
+
+	if windowCostLimit > 0 {
+		cost, limit := windowCost, windowCostLimit
+		report.WindowCost = &cost
+		report.WindowCostLimit = &limit
+		// 剩余额度越少权重越低：剩余 100% → 100，剩余 0 → 20
+		remaining := clampFloat64(1-windowCost/windowCostLimit, 0, 1)
+		windowFactor.Score = (0.2 + 0.8*remaining) * 100
+		windowFactor.Applied = true
+	}
+
+	report.Factors = []AccountHealthFactor{successFactor, latencyFactor, throttleFactor, windowFactor}
+	score := 1.0
+	for _, f := range report.Factors {
+		score *= f.Score / 100
+	}
+	report.Score = math.Round(score*1000) / 10
+	report.Weight = math.Max(score, accountHealthMinWeight)
+	return report
+}
+
+// latencyScore 将延迟线性映射到 [0.3, 1]
+func latencyScore(ms, goodMs, badMs int) float64 {
+	if ms <= goodMs {
+		return 1
+	}
+	if ms >= badMs {
+		return 0.3
+	}
+	return 1 - 0.7*float64(ms-goodMs)/float64(badMs-goodMs)
+}
+
+// healthWeightedSchedulingEnabled 判断是否按健康分加权选号（Claude/Gemini 与 OpenAI 网关共用）
+func healthWeightedSchedulingEnabled(cfg *config.Config, healthCache AccountHealthCache) bool {
+	return cfg != nil && cfg.Gateway.Scheduling.SelectionMode == SchedulingModeHealthWeighted && healthCache != nil
+}
+
+func (s *GatewayService) healthWeightedSchedulingEnabled() bool {
+	return healthWeightedSchedulingEnabled(s.cfg, s.accountHealthCache)
+}
+
+func (s *GatewayService) accountHealthWeights(ctx context.Context, accounts []*Account) map[int64]float64 {
+	return loadAccountHealthWeights(ctx, s.accountHealthCache, s.sessionLimitCache, accounts)
+}
+
+// loadAccountHealthWeights 批量获取候选账号的调度权重；缓存缺失的账号按满分处理（失败开放）。
+// sessionLimitCache 可为 nil，此时不计窗口费用因子。
+func loadAccountHealthWeights(ctx context.Context, healthCache AccountHealthCache, sessionLimitCache SessionLimitCache, accounts []*Account) map[int64]float64 {
+	weights := make(map[int64]float64, len(accounts))
+	ids := make([]int64, 0, len(accounts))
+	for _, acc := range accounts {
+		ids = append(ids, acc.ID)
+	}
+	statsMap, err := healthCache.GetAccountHealthStats(ctx, ids)
+	if err != nil {
+		statsMap = nil
+	}
+	for _, acc := range accounts {
+		var windowCost, limit float64
+		if acc.IsAnthropicOAuthOrSetupToken() {
+			limit = acc.GetWindowCostLimit()
+		}
+		// 调度热路径只读缓存；窗口费用检查阶段通常已写入
+		if limit > 0 && sessionLimitCache != nil {
+			if cost, hit, err := sessionLimitCache.GetWindowCost(ctx, acc.ID); err == nil && hit {
+				windowCost = cost
+			}
+		}
+		weights[acc.ID] = computeAccountHealth(statsMap[acc.ID], windowCost, limit).Weight
+	}
+	return weights
+}
+
+// scaleWeightsByIdleRate 权重乘以空闲率，负载高的账号被选中的概率更低
+func scaleWeightsByIdleRate(weights map[int64]float64, loadMap map[int64]*AccountLoadInfo) {
+	for id, w := range weights {
+		loadRate := 0
+		if info := loadMap[id]; info != nil {
+			loadRate = info.LoadRate
+		}
+		idle := float64(100-loadRate) / 100
+		weights[id] = w * math.Max(idle, 0.01)
+	}
+}
+
+// sortAccountsByPriorityAndHealth 先按优先级排序，同优先级内按权重加权随机排序
+// （Efraimidis-Spirakis：key = u^(1/w)，按 key 降序）
+func sortAccountsByPriorityAndHealth(accounts []*Account, weights map[int64]float64) {
+	if len(accounts) <= 1 {
+		return
+	}
+	r := mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
+	keys := make(map[int64]float64, len(accounts))
+	for _, acc := range accounts {
+		w := weights[acc.ID]
+		if w <= 0 {
+			w = accountHealthMinWeight
+		}
+		keys[acc.ID] = math.Pow(r.Float64(), 1/w)
+	}
+	sort.SliceStable(accounts, func(i, j int) bool {
+		a, b := accounts[i], accounts[j]
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return keys[a.ID] > keys[b.ID]
+	})
+}
+
+// AccountHealthService 为管理后台提供账号健康分及说明
+type AccountHealthService struct {
+	accountRepo       AccountRepository
+	usageLogRepo      UsageLogRepository
+	healthCache       AccountHealthCache
+	sessionLimitCache SessionLimitCache
+	cfg               *config.Config
+}
+
+func NewAccountHealthService(
+	accountRepo AccountRepository,
+	usageLogRepo UsageLogRepository,
+	healthCache AccountHealthCache,
+	sessionLimitCache SessionLimitCache,
+	cfg *config.Config,
+) *AccountHealthService {
+	return &AccountHealthService{
+		accountRepo:       accountRepo,
+		usageLogRepo:      usageLogRepo,
+		healthCache:       healthCache,
+		sessionLimitCache: sessionLimitCache,
+		cfg:               cfg,
+	}
+}
+
+// GetAccountHealth 返回账号当前健康分；窗口费用缓存未命中时回源数据库
+func (s *AccountHealthService) GetAccountHealth(ctx context.Context, accountID int64) (*AccountHealthReport, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats *AccountHealthStats
+	if s.healthCache != nil {
+		statsMap, err := s.healthCache.GetAccountHealthStats(ctx, []int64{accountID})
+		if err != nil {
+			log.Printf("[AccountHealth] get stats failed: account=%d err=%v", accountID, err)
+		} else {
+			stats = statsMap[accountID]
+		}
+	}
+
+	var windowCost, limit float64
+	if account.IsAnthropicOAuthOrSetupToken() {
+		limit = account.GetWindowCostLimit()
+	}
+	if limit > 0 {
+		hit := false
+		if s.sessionLimitCache != nil {
+			if cost, ok, err := s.sessionLimitCache.GetWindowCost(ctx, accountID); err == nil && ok {
+				windowCost, hit = cost, true
+			}
+		}
+		if !hit && s.usageLogRepo != nil {
+			if ws, err := s.usageLogRepo.GetAccountWindowStats(ctx, accountID, account.GetCurrentWindowStartTime()); err == nil {
+				windowCost = ws.StandardCost
+			}
+		}
+	}
+
+	report := computeAccountHealth(stats, windowCost, limit)
+	report.AccountID = accountID
+	report.SchedulingMode = SchedulingModeLoadAware
+	if s.cfg != nil && s.cfg.Gateway.Scheduling.SelectionMode != "" {
+		report.SchedulingMode = s.cfg.Gateway.Scheduling.SelectionMode
+	}
+	return report, nil
+}
@@ -0,0 +1,178 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAccountHealthCache struct {
+	stats map[int64]*AccountHealthStats
+}
+
+func (c stubAccountHealthCache) GetAccountHealthStats(ctx context.Context, accountIDs []int64) (map[int64]*AccountHealthStats, error) {
+	return c.stats, nil
+}
+
+func (c stubAccountHealthCache) SetAccountHealthStats(ctx context.Context, stats []*AccountHealthStats, ttl time.Duration) error {
+	return nil
+}
+
+// degradedHealthCache 账号 1 全部报错（权重降到下限），账号 2 健康
+func degradedHealthCache() stubAccountHealthCache {
+	return stubAccountHealthCache{stats: map[int64]*AccountHealthStats{
+		1: {AccountID: 1, ErrorCount: 20},
+		2: {AccountID: 2, SuccessCount: 20},
+	}}
+}
+
+func TestComputeAccountHealth_NoDataIsNeutral(t *testing.T) {
+	t.Parallel()
+
+	report := computeAccountHealth(nil, 0, 0)
+	require.Equal(t, 100.0, report.Score)
+	require.Equal(t, 1.0, report.Weight)
+	for _, f := range report.Factors {
+		require.False(t, f.Applied, f.Key)
+	}
+}
+
+func TestComputeAccountHealth_TooFewSamplesNotPenalized(t *testing.T) {
+	t.Parallel()
+
+	report := computeAccountHealth(&AccountHealthStats{AccountID: 1, SuccessCount: 1, ErrorCount: 3, Upstream429Count: 3}, 0, 0)
+	require.Equal(t, 100.0, report.Score)
+}
+
+func TestComputeAccountHealth_DegradesOnErrorsThrottleAndLatency(t *testing.T) {
+	t.Parallel()
+
+	healthy := computeAccountHealth(&AccountHealthStats{AccountID: 1, SuccessCount: 100, TTFTP50Ms: intPtr(800), TTFTP95Ms: intPtr(2000)}, 0, 0)
+	require.Equal(t, 100.0, healthy.Score)
+
+	sick := computeAccountHealth(&AccountHealthStats{
+		AccountID:        2,
+		SuccessCount:     80,
+		ErrorCount:       20,
+		Upstream429Count: 10,
+		TTFTP50Ms:        intPtr(6000),
+		TTFTP95Ms:        intPtr(18000),
+	}, 0, 0)
+	require.Less(t, sick.Score, 50.0)
+	require.Greater(t, sick.Weight, accountHealthMinWeight)
+
+	dead := computeAccountHealth(&AccountHealthStats{AccountID: 3, SuccessCount: 0, ErrorCount: 50, Upstream529Count: 50}, 0, 0)
+	require.Equal(t, 0.0, dead.Score)
+	require.Equal(t, accountHealthMinWeight, dead.Weight)
+}
+
+func TestComputeAccountHealth_WindowCost(t *testing.T) {
+	t.Parallel()
+
+	report := computeAccountHealth(nil, 75, 100)
+	require.InDelta(t, 40.0, report.Score, 0.01)
+	require.NotNil(t, report.WindowCostLimit)
+	require.Equal(t, 100.0, *report.WindowCostLimit)
+
+	exhausted := computeAccountHealth(nil, 150, 100)
+	require.InDelta(t, 20.0, exhausted.Score, 0.01)
+}
+
+func TestSortAccountsByPriorityAndHealth(t *testing.T) {
+	t.Parallel()
+
+	weights := map[int64]float64{1: 1, 2: accountHealthMinWeight, 3: 1}
+	picks := map[int64]int{}
+	for i := 0; i < 2000; i++ {
+		accounts := []*Account{
+			{ID: 3, Priority: 2},
+			{ID: 2, Priority: 1},
+			{ID: 1, Priority: 1},
+		}
+		sortAccountsByPriorityAndHealth(accounts, weights)
+		require.Equal(t, int64(3), accounts[2].ID, "priority must still dominate")
+		picks[accounts[0].ID]++
+	}
+	require.Greater(t, picks[1], picks[2]*5)
+	require.Greater(t, picks[2], 0, "sick accounts keep a trickle of traffic")
+}
+
+func TestGatewayServiceHealthWeightedSelectionAcquiresSlot(t *testing.T) {
+	repo := &mockAccountRepoForPlatform{
+		accounts: []Account{
+			{ID: 1, Platform: PlatformAnthropic, Priority: 1, Status: StatusActive, Schedulable: true, Concurrency: 5},
+			{ID: 2, Platform: PlatformAnthropic, Priority: 1, Status: StatusActive, Schedulable: true, Concurrency: 5},
+		},
+		accountsByID: map[int64]*Account{},
+	}
+	for i := range repo.accounts {
+		repo.accountsByID[repo.accounts[i].ID] = &repo.accounts[i]
+	}
+	cfg := testConfig()
+	cfg.Gateway.Scheduling.LoadBatchEnabled = true
+	cfg.Gateway.Scheduling.SelectionMode = SchedulingModeHealthWeighted
+
+	svc := &GatewayService{
+		accountRepo:        repo,
+		cache:              &mockGatewayCacheForPlatform{},
+		cfg:                cfg,
+		concurrencyService: NewConcurrencyService(&mockConcurrencyCache{}),
+		accountHealthCache: degradedHealthCache(),
+	}
+
+	picks := map[int64]int{}
+	for i := 0; i < 200; i++ {
+		result, err := svc.SelectAccountWithLoadAwareness(context.Background(), nil, "", "claude-3-5-sonnet-20241022", nil, "")
+		require.NoError(t, err)
+		require.True(t, result.Acquired, "health_weighted must acquire a slot in the load-aware layer")
+		require.Nil(t, result.WaitPlan)
+		picks[result.Account.ID]++
+	}
+	require.Greater(t, picks[2], picks[1]*5)
+}
+
+func TestOpenAIHealthWeightedSelection(t *testing.T) {
+	groupID := int64(1)
+	repo := stubOpenAIAccountRepo{
+		accounts: []Account{
+			{ID: 1, Platform: PlatformOpenAI, Status: StatusActive, Schedulable: true, Concurrency: 1, Priority: 1},
+			{ID: 2, Platform: PlatformOpenAI, Status: StatusActive, Schedulable: true, Concurrency: 1, Priority: 1},
+		},
+	}
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.LoadBatchEnabled = true
+	cfg.Gateway.Scheduling.SelectionMode = SchedulingModeHealthWeighted
+
+	svc := &OpenAIGatewayService{
+		accountRepo:        repo,
+		cache:              &stubGatewayCache{},
+		cfg:                cfg,
+		concurrencyService: NewConcurrencyService(stubConcurrencyCache{}),
+		accountHealthCache: degradedHealthCache(),
+	}
+
+	picks := map[int64]int{}
+	for i := 0; i < 200; i++ {
+		selection, err := svc.SelectAccountWithLoadAwareness(context.Background(), &groupID, "", "gpt-4", nil)
+		require.NoError(t, err)
+		require.True(t, selection.Acquired)
+		picks[selection.Account.ID]++
+	}
+	require.Greater(t, picks[2], picks[1]*5)
+
+	// 兜底层同样按健康分排序
+	svc.concurrencyService = NewConcurrencyService(stubConcurrencyCache{acquireResults: map[int64]bool{1: false, 2: false}})
+	waits := map[int64]int{}
+	for i := 0; i < 200; i++ {
+		selection, err := svc.SelectAccountWithLoadAwareness(context.Background(), &groupID, "", "gpt-4", nil)
+		require.NoError(t, err)
+		require.NotNil(t, selection.WaitPlan)
+		waits[selection.Account.ID]++
+	}
+	require.Greater(t, waits[2], waits[1]*5)
+}
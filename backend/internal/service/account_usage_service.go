@@ -29,6 +29,8 @@ type UsageLogRepository interface {
 
 	GetAccountWindowStats(ctx context.Context, accountID int64, startTime time.Time) (*usagestats.AccountStats, error)
 	GetAccountTodayStats(ctx context.Context, accountID int64) (*usagestats.AccountStats, error)
+	// GetAccountPeriodUsage 一次查询账号在当日/本周/本月的费用与 token 用量
+	GetAccountPeriodUsage(ctx context.Context, accountID int64, dayStart, weekStart, monthStart time.Time) (*usagestats.AccountPeriodUsage, error)
 
 	// Admin dashboard stats
 	GetDashboardStats(ctx context.Context) (*usagestats.DashboardStats, error)
@@ -39,6 +41,11 @@ type UsageLogRepository interface {
 	GetBatchUserUsageStats(ctx context.Context, userIDs []int64) (map[int64]*usagestats.BatchUserUsageStats, error)
 	GetBatchAPIKeyUsageStats(ctx context.Context, apiKeyIDs []int64) (map[int64]*usagestats.BatchAPIKeyUsageStats, error)
 
+	// Prompt cache stats
+	GetPromptCacheStatsByAccount(ctx context.Context, startTime, endTime time.Time, groupID int64) ([]usagestats.PromptCacheStat, error)
+	GetPromptCacheStatsByGroup(ctx context.Context, startTime, endTime time.Time) ([]usagestats.PromptCacheStat, error)
+	GetUserModelCacheReads(ctx context.Context, startTime, endTime time.Time, userID int64) ([]usagestats.UserModelCacheRead, error)
+
 	// User dashboard stats
 	GetUserDashboardStats(ctx context.Context, userID int64) (*usagestats.UserDashboardStats, error)
 	GetUserUsageTrendByUserID(ctx context.Context, userID int64, startTime, endTime time.Time, granularity string) ([]usagestats.TrendDataPoint, error)
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrAdminAPIKeyNotFound      = infraerrors.NotFound("ADMIN_API_KEY_NOT_FOUND", "admin api key not found")
+	ErrAdminAPIKeyInvalid       = infraerrors.Unauthorized("INVALID_ADMIN_KEY", "Invalid admin API key")
+	ErrAdminAPIKeyInvalidScope  = infraerrors.BadRequest("ADMIN_API_KEY_INVALID_SCOPE", "invalid admin api key scope")
+	ErrAdminAPIKeyScopeExceeded = infraerrors.Forbidden("ADMIN_API_KEY_SCOPE_EXCEEDED", "cannot grant permissions you do not hold")
+	ErrAdminAPIKeyNameRequired  = infraerrors.BadRequest("ADMIN_API_KEY_NAME_REQUIRED", "admin api key name is required")
+	ErrAdminAPIKeyExpiryInPast  = infraerrors.BadRequest("ADMIN_API_KEY_EXPIRY_IN_PAST", "expires_at must be in the future")
+)
+
+// AdminAPIKey 管理端 API Key（明文只在创建时返回）
+type AdminAPIKey struct {
+	ID         int64
+	Name       string
+	KeyPrefix  string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedBy  *int64
+	CreatedAt  time.Time
+}
+
+// IsExpired 是否已过期；ExpiresAt 为空表示永不过期
+func (k *AdminAPIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Permissions 返回 Key 的权限集合
+func (k *AdminAPIKey) Permissions() AdminPermissionSet {
+	set := make(AdminPermissionSet, len(k.Scopes))
+	for _, s := range k.Scopes {
+		set[AdminPermission(s)] = struct{}{}
+	}
+	return set
+}
+
+// AdminAPIKeyRepository 管理端 API Key 持久层接口
+type AdminAPIKeyRepository interface {
+	Create(ctx context.Context, key *AdminAPIKey, keyHash string) error
+	GetByHash(ctx context.Context, keyHash string) (*AdminAPIKey, error)
+	List(ctx context.Context) ([]AdminAPIKey, error)
+	Delete(ctx context.Context, id int64) error
+	TouchLastUsed(ctx context.Context, id int64, at time.Time) error
+}
+
+// CreateAdminAPIKeyInput 创建管理端 API Key 的参数
+type CreateAdminAPIKeyInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy *int64
+	// GrantorPermissions 创建者自身的权限，Key 的 scope 不能超出该范围
+	GrantorPermissions AdminPermissionSet
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	adminAPIKeyPrefixDisplayLen = 14 // "admin-" + 8 位十六进制
+	// adminAPIKeyTouchInterval last_used_at 最小刷新间隔，避免每个请求都写库
+	adminAPIKeyTouchInterval = time.Minute
+)
+
+// AdminAPIKeyService 管理端 API Key 服务：多个具名 Key，各自带权限范围与过期时间
+type AdminAPIKeyService struct {
+	repo     AdminAPIKeyRepository
+	userRepo UserRepository
+}
+
+// AdminAPIKeyPrincipal Admin API Key 认证结果：请求的执行身份与有效权限
+type AdminAPIKeyPrincipal struct {
+	Key   *AdminAPIKey
+	Actor *User
+	// Permissions Key scope 与创建者当前角色权限的交集
+	Permissions AdminPermissionSet
+}
+
+// NewAdminAPIKeyService 创建管理端 API Key 服务
+func NewAdminAPIKeyService(repo AdminAPIKeyRepository, userRepo UserRepository) *AdminAPIKeyService {
+	return &AdminAPIKeyService{repo: repo, userRepo: userRepo}
+}
+
+// HashAdminAPIKey 计算明文 Key 的存储摘要
+func HashAdminAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Create 生成新 Key，返回记录与明文（明文不落库，仅此一次返回）
+func (s *AdminAPIKeyService) Create(ctx context.Context, input *CreateAdminAPIKeyInput) (*AdminAPIKey, string, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, "", ErrAdminAPIKeyNameRequired
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrAdminAPIKeyExpiryInPast
+	}
+
+	scopes, err := NormalizeAdminPermissions(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, p := range scopes {
+		if p == AdminPermissionAll {
+			if !input.GrantorPermissions.Has(AdminPermissionAll) {
+				return nil, "", ErrAdminAPIKeyScopeExceeded
+			}
+			continue
+		}
+		if !input.GrantorPermissions.Has(p) {
+			return nil, "", ErrAdminAPIKeyScopeExceeded
+		}
+	}
+
+	// 生成 32 字节随机数 = 64 位十六进制字符
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	plaintext := AdminAPIKeyPrefix + hex.EncodeToString(bytes)
+
+	key := &AdminAPIKey{
+		Name:      name,
+		KeyPrefix: plaintext[:adminAPIKeyPrefixDisplayLen],
+		Scopes:    make([]string, 0, len(scopes)),
+		ExpiresAt: input.ExpiresAt,
+		CreatedBy: input.CreatedBy,
+	}
+	for _, p := range scopes {
+		key.Scopes = append(key.Scopes, string(p))
+	}
+	if err := s.repo.Create(ctx, key, HashAdminAPIKey(plaintext)); err != nil {
+		return nil, "", fmt.Errorf("create admin api key: %w", err)
+	}
+	return key, plaintext, nil
+}
+
+// List 返回全部 Key（不含明文）
+func (s *AdminAPIKeyService) List(ctx context.Context) ([]AdminAPIKey, error) {
+	return s.repo.List(ctx)
+}
+
+// Delete 吊销 Key
+func (s *AdminAPIKeyService) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Authenticate 校验明文 Key，返回执行身份与有效权限。
+// 请求以 Key 的创建者身份执行，权限不超过创建者当前角色；创建者已被删除、禁用或不再是管理端角色时 Key 随之失效。
+// 仅迁移 053 转入的旧版全局 Key（CreatedBy 为空）回退到首个超级管理员。
+// Key 不存在、已过期或创建者不可用统一返回 ErrAdminAPIKeyInvalid。
+func (s *AdminAPIKeyService) Authenticate(ctx context.Context, plaintext string) (*AdminAPIKeyPrincipal, error) {
+	plaintext = strings.TrimSpace(plaintext)
+	if plaintext == "" {
+		return nil, ErrAdminAPIKeyInvalid
+	}
+
+	key, err := s.repo.GetByHash(ctx, HashAdminAPIKey(plaintext))
+	if err != nil {
+		if errors.Is(err, ErrAdminAPIKeyNotFound) {
+			return nil, ErrAdminAPIKeyInvalid
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.IsExpired(now) {
+		return nil, ErrAdminAPIKeyInvalid
+	}
+
+	principal, err := s.resolvePrincipal(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= adminAPIKeyTouchInterval {
+		if err := s.repo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			log.Printf("[AdminAPIKey] failed to update last_used_at id=%d: %v", key.ID, err)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+	return principal, nil
+}
+
+func (s *AdminAPIKeyService) resolvePrincipal(ctx context.Context, key *AdminAPIKey) (*AdminAPIKeyPrincipal, error) {
+	if key.CreatedBy == nil {
+		admin, err := s.userRepo.GetFirstAdmin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get first admin: %w", err)
+		}
+		return &AdminAPIKeyPrincipal{Key: key, Actor: admin, Permissions: key.Permissions()}, nil
+	}
+
+	creator, err := s.userRepo.GetByID(ctx, *key.CreatedBy)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrAdminAPIKeyInvalid
+		}
+		return nil, fmt.Errorf("get admin api key creator: %w", err)
+	}
+	if !creator.IsActive() || !creator.IsStaff() {
+		return nil, ErrAdminAPIKeyInvalid
+	}
+	return &AdminAPIKeyPrincipal{
+		Key:         key,
+		Actor:       creator,
+		Permissions: key.Permissions().Intersect(AdminPermissionsForRole(creator.Role)),
+	}, nil
+}
@@ -0,0 +1,153 @@
+//go:build unit
+
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/Wei-Shaw/sub2api/internal/service/servicetest"
+	"github.com/stretchr/testify/require"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func newTestAdminAPIKeyService(repo *servicetest.AdminAPIKeyRepo, users ...*service.User) *service.AdminAPIKeyService {
+	return service.NewAdminAPIKeyService(repo, servicetest.NewUserRepo(users...))
+}
+
+func TestAdminAPIKeyService_CreateAndAuthenticate(t *testing.T) {
+	repo := servicetest.NewAdminAPIKeyRepo()
+	creator := &service.User{ID: 7, Role: service.RoleAdmin, Status: service.StatusActive}
+	svc := newTestAdminAPIKeyService(repo, creator)
+	ctx := context.Background()
+
+	key, plaintext, err := svc.Create(ctx, &service.CreateAdminAPIKeyInput{
+		Name:               " billing-sync ",
+		Scopes:             []string{"billing:manage", "users:view", "billing:manage"},
+		CreatedBy:          int64Ptr(creator.ID),
+		GrantorPermissions: service.AdminPermissionsForRole(service.RoleAdmin),
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(plaintext, service.AdminAPIKeyPrefix))
+	require.Equal(t, "billing-sync", key.Name)
+	require.Equal(t, plaintext[:len(key.KeyPrefix)], key.KeyPrefix)
+	require.Equal(t, []string{"billing:manage", "users:view"}, key.Scopes)
+
+	// 数据库只存摘要
+	_, ok := repo.ByHash[plaintext]
+	require.False(t, ok)
+
+	got, err := svc.Authenticate(ctx, plaintext)
+	require.NoError(t, err)
+	require.Equal(t, creator.ID, got.Actor.ID)
+	require.True(t, got.Permissions.Has(service.AdminPermissionBilling))
+	require.False(t, got.Permissions.Has(service.AdminPermissionSettingsManage))
+	require.NotNil(t, got.Key.LastUsedAt)
+
+	// 一分钟内重复使用不再写 last_used_at
+	_, err = svc.Authenticate(ctx, plaintext)
+	require.NoError(t, err)
+	require.Equal(t, 1, repo.Touched)
+
+	_, err = svc.Authenticate(ctx, plaintext+"x")
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalid)
+}
+
+func TestAdminAPIKeyService_CreateValidation(t *testing.T) {
+	svc := newTestAdminAPIKeyService(servicetest.NewAdminAPIKeyRepo())
+	ctx := context.Background()
+	superAdmin := service.AdminPermissionsForRole(service.RoleAdmin)
+
+	_, _, err := svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: " ", Scopes: []string{"*"}, GrantorPermissions: superAdmin})
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyNameRequired)
+
+	_, _, err = svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "k", Scopes: []string{"users:fly"}, GrantorPermissions: superAdmin})
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalidScope)
+
+	past := time.Now().Add(-time.Minute)
+	_, _, err = svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "k", Scopes: []string{"*"}, ExpiresAt: &past, GrantorPermissions: superAdmin})
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyExpiryInPast)
+
+	// 不能授予自己没有的权限
+	finance := service.AdminPermissionsForRole(service.RoleFinance)
+	_, _, err = svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "k", Scopes: []string{"*"}, GrantorPermissions: finance})
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyScopeExceeded)
+	_, _, err = svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "k", Scopes: []string{"ops:manage"}, GrantorPermissions: finance})
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyScopeExceeded)
+	_, _, err = svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "k", Scopes: []string{"billing:manage"}, GrantorPermissions: finance})
+	require.NoError(t, err)
+
+	// 通配 scope 归一化为 ["*"]
+	key, _, err := svc.Create(ctx, &service.CreateAdminAPIKeyInput{Name: "all", Scopes: []string{"users:view", "*"}, GrantorPermissions: superAdmin})
+	require.NoError(t, err)
+	require.Equal(t, []string{"*"}, key.Scopes)
+}
+
+func TestAdminAPIKeyService_ExpiredKeyRejected(t *testing.T) {
+	repo := servicetest.NewAdminAPIKeyRepo()
+	svc := newTestAdminAPIKeyService(repo, &service.User{ID: 1, Role: service.RoleAdmin, Status: service.StatusActive})
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour)
+	_, plaintext, err := svc.Create(ctx, &service.CreateAdminAPIKeyInput{
+		Name:               "short-lived",
+		Scopes:             []string{"ops:manage"},
+		ExpiresAt:          &expiresAt,
+		CreatedBy:          int64Ptr(1),
+		GrantorPermissions: service.AdminPermissionsForRole(service.RoleAdmin),
+	})
+	require.NoError(t, err)
+
+	past := time.Now().Add(-time.Second)
+	repo.ByHash[service.HashAdminAPIKey(plaintext)].ExpiresAt = &past
+	_, err = svc.Authenticate(ctx, plaintext)
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalid)
+}
+
+func TestAdminAPIKeyService_AuthenticateFollowsCreator(t *testing.T) {
+	repo := servicetest.NewAdminAPIKeyRepo()
+	users := servicetest.NewUserRepo(
+		&service.User{ID: 1, Role: service.RoleAdmin, Status: service.StatusActive},
+		&service.User{ID: 2, Role: service.RoleFinance, Status: service.StatusActive},
+	)
+	svc := service.NewAdminAPIKeyService(repo, users)
+	ctx := context.Background()
+
+	repo.Add("legacy", &service.AdminAPIKey{ID: 1, Scopes: []string{"*"}})
+	repo.Add("finance", &service.AdminAPIKey{ID: 2, Scopes: []string{"billing:manage", "users:view"}, CreatedBy: int64Ptr(2)})
+	repo.Add("orphan", &service.AdminAPIKey{ID: 3, Scopes: []string{"users:view"}, CreatedBy: int64Ptr(99)})
+
+	// 旧版全局 Key 没有创建者，以首个超级管理员执行
+	got, err := svc.Authenticate(ctx, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.Actor.ID)
+	require.True(t, got.Permissions.Has(service.AdminPermissionSettingsManage))
+
+	got, err = svc.Authenticate(ctx, "finance")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), got.Actor.ID)
+	require.Equal(t, []string{"billing:manage", "users:view"}, got.Permissions.List())
+
+	// 创建者降级后权限收缩为 scope 与新角色的交集
+	users.Users[2].Role = service.RoleSupport
+	got, err = svc.Authenticate(ctx, "finance")
+	require.NoError(t, err)
+	require.Equal(t, []string{"users:view"}, got.Permissions.List())
+
+	// 创建者不再是管理端角色、被禁用或已删除时 Key 失效，不回退到超级管理员
+	users.Users[2].Role = service.RoleUser
+	_, err = svc.Authenticate(ctx, "finance")
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalid)
+
+	users.Users[2].Role = service.RoleFinance
+	users.Users[2].Status = service.StatusDisabled
+	_, err = svc.Authenticate(ctx, "finance")
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalid)
+
+	_, err = svc.Authenticate(ctx, "orphan")
+	require.ErrorIs(t, err, service.ErrAdminAPIKeyInvalid)
+}
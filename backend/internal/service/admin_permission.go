@@ -0,0 +1,213 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var ErrInvalidUserRole = infraerrors.BadRequest("INVALID_USER_ROLE", "role must be one of user, admin, support, finance, ops")
+
+// AdminPermission 管理端权限点，按路由组粒度划分
+type AdminPermission string
+
+const (
+	// AdminPermissionAll 通配权限，仅超级管理员与全权限 Admin API Key 持有
+	AdminPermissionAll AdminPermission = "*"
+
+	AdminPermissionDashboardView AdminPermission = "dashboard:view"
+	AdminPermissionUsersView     AdminPermission = "users:view"
+	AdminPermissionUsersManage   AdminPermission = "users:manage"
+	AdminPermissionRolesManage   AdminPermission = "roles:manage"
+	// AdminPermissionBalanceAdjust 受单次调整额度限制的余额调整（admin_roles.support_balance_adjust_limit）
+	AdminPermissionBalanceAdjust          AdminPermission = "balance:adjust"
+	AdminPermissionBalanceAdjustUnlimited AdminPermission = "balance:adjust_unlimited"
+	AdminPermissionUsageView              AdminPermission = "usage:view"
+	AdminPermissionUsageManage            AdminPermission = "usage:manage"
+	// AdminPermissionBilling 卡密、优惠码、订阅、组织钱包与余额账本
+	AdminPermissionBilling        AdminPermission = "billing:manage"
+	AdminPermissionGroupsView     AdminPermission = "groups:view"
+	AdminPermissionGroupsManage   AdminPermission = "groups:manage"
+	AdminPermissionAccountsManage AdminPermission = "accounts:manage"
+	AdminPermissionProxiesManage  AdminPermission = "proxies:manage"
+	// AdminPermissionOps 运维监控、告警、错误重试以及 /metrics 抓取
+	AdminPermissionOps             AdminPermission = "ops:manage"
+	AdminPermissionSettingsManage  AdminPermission = "settings:manage"
+	AdminPermissionSystemManage    AdminPermission = "system:manage"
+	AdminPermissionAuditView       AdminPermission = "audit:view"
+	AdminPermissionAdminKeysManage AdminPermission = "admin_keys:manage"
+)
+
+// allAdminPermissions 全部具体权限点（不含通配），用于校验 API Key scope 与前端展示
+var allAdminPermissions = []AdminPermission{
+	AdminPermissionDashboardView,
+	AdminPermissionUsersView,
+	AdminPermissionUsersManage,
+	AdminPermissionRolesManage,
+	AdminPermissionBalanceAdjust,
+	AdminPermissionBalanceAdjustUnlimited,
+	AdminPermissionUsageView,
+	AdminPermissionUsageManage,
+	AdminPermissionBilling,
+	AdminPermissionGroupsView,
+	AdminPermissionGroupsManage,
+	AdminPermissionAccountsManage,
+	AdminPermissionProxiesManage,
+	AdminPermissionOps,
+	AdminPermissionSettingsManage,
+	AdminPermissionSystemManage,
+	AdminPermissionAuditView,
+	AdminPermissionAdminKeysManage,
+}
+
+// adminRolePermissions 内置管理端角色的权限集合
+var adminRolePermissions = map[string][]AdminPermission{
+	RoleAdmin: {AdminPermissionAll},
+	RoleSupport: {
+		AdminPermissionDashboardView,
+		AdminPermissionUsersView,
+		AdminPermissionUsageView,
+		AdminPermissionGroupsView,
+		AdminPermissionBalanceAdjust,
+	},
+	RoleFinance: {
+		AdminPermissionDashboardView,
+		AdminPermissionUsersView,
+		AdminPermissionUsageView,
+		AdminPermissionGroupsView,
+		AdminPermissionBilling,
+		AdminPermissionBalanceAdjust,
+		AdminPermissionBalanceAdjustUnlimited,
+	},
+	RoleOps: {
+		AdminPermissionDashboardView,
+		AdminPermissionUsageView,
+		AdminPermissionGroupsView,
+		AdminPermissionAccountsManage,
+		AdminPermissionProxiesManage,
+		AdminPermissionOps,
+	},
+}
+
+// AdminPermissionSet 一次管理端请求的有效权限集合
+type AdminPermissionSet map[AdminPermission]struct{}
+
+// NewAdminPermissionSet 由权限列表构造权限集合
+func NewAdminPermissionSet(perms ...AdminPermission) AdminPermissionSet {
+	set := make(AdminPermissionSet, len(perms))
+	for _, p := range perms {
+		set[p] = struct{}{}
+	}
+	return set
+}
+
+// Has 判断是否持有指定权限；通配权限视为持有全部权限
+func (s AdminPermissionSet) Has(perm AdminPermission) bool {
+	if _, ok := s[AdminPermissionAll]; ok {
+		return true
+	}
+	_, ok := s[perm]
+	return ok
+}
+
+// HasAny 判断是否持有任一指定权限
+func (s AdminPermissionSet) HasAny(perms ...AdminPermission) bool {
+	for _, p := range perms {
+		if s.Has(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers 判断当前集合是否包含 other 的全部权限；通配权限只能由持有通配权限者覆盖
+func (s AdminPermissionSet) Covers(other AdminPermissionSet) bool {
+	if _, ok := s[AdminPermissionAll]; ok {
+		return true
+	}
+	for p := range other {
+		if _, ok := s[p]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect 返回两个集合共同持有的权限；一方为通配时结果即为另一方
+func (s AdminPermissionSet) Intersect(other AdminPermissionSet) AdminPermissionSet {
+	if _, ok := s[AdminPermissionAll]; ok {
+		s, other = other, s
+	}
+	out := make(AdminPermissionSet, len(s))
+	for p := range s {
+		if other.Has(p) {
+			out[p] = struct{}{}
+		}
+	}
+	return out
+}
+
+// List 返回排序后的权限列表，供接口输出
+func (s AdminPermissionSet) List() []string {
+	out := make([]string, 0, len(s))
+	for p := range s {
+		out = append(out, string(p))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// IsAdminRole 是否为管理端角色
+func IsAdminRole(role string) bool {
+	_, ok := adminRolePermissions[role]
+	return ok
+}
+
+// AdminRoles 返回全部管理端角色
+func AdminRoles() []string {
+	return []string{RoleAdmin, RoleSupport, RoleFinance, RoleOps}
+}
+
+// IsValidUserRole 是否为可分配给用户的角色
+func IsValidUserRole(role string) bool {
+	return role == RoleUser || IsAdminRole(role)
+}
+
+// AdminPermissionsForRole 返回角色的权限集合；普通用户返回空集合
+func AdminPermissionsForRole(role string) AdminPermissionSet {
+	return NewAdminPermissionSet(adminRolePermissions[role]...)
+}
+
+// AllAdminPermissions 返回全部具体权限点
+func AllAdminPermissions() []AdminPermission {
+	out := make([]AdminPermission, len(allAdminPermissions))
+	copy(out, allAdminPermissions)
+	return out
+}
+
+// NormalizeAdminPermissions 校验并去重 API Key scope；包含通配权限时只保留通配
+func NormalizeAdminPermissions(scopes []string) ([]AdminPermission, error) {
+	known := NewAdminPermissionSet(allAdminPermissions...)
+	seen := make(map[AdminPermission]struct{}, len(scopes))
+	out := make([]AdminPermission, 0, len(scopes))
+	for _, raw := range scopes {
+		p := AdminPermission(strings.TrimSpace(raw))
+		if p == AdminPermissionAll {
+			return []AdminPermission{AdminPermissionAll}, nil
+		}
+		if _, ok := known[p]; !ok {
+			return nil, ErrAdminAPIKeyInvalidScope.WithMetadata(map[string]string{"scope": raw})
+		}
+		if _, dup := seen[p]; dup {
+			continue
+		}
+		seen[p] = struct{}{}
+		out = append(out, p)
+	}
+	if len(out) == 0 {
+		return nil, ErrAdminAPIKeyInvalidScope
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
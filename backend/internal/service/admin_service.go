@@ -19,7 +19,7 @@ type AdminService interface {
 	CreateUser(ctx context.Context, input *CreateUserInput) (*User, error)
 	UpdateUser(ctx context.Context, id int64, input *UpdateUserInput) (*User, error)
 	DeleteUser(ctx context.Context, id int64) error
-	UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string) (*User, error)
+	UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string, actorUserID int64) (*User, error)
 	GetUserAPIKeys(ctx context.Context, userID int64, page, pageSize int) ([]APIKey, int64, error)
 	GetUserUsageStats(ctx context.Context, userID int64, period string) (any, error)
 
@@ -78,6 +78,7 @@ type CreateUserInput struct {
 	Balance       float64
 	Concurrency   int
 	AllowedGroups []int64
+	RateLimits    RequestRateLimits
 }
 
 type UpdateUserInput struct {
@@ -89,6 +90,8 @@ type UpdateUserInput struct {
 	Concurrency   *int     // 使用指针区分"未提供"和"设置为0"
 	Status        string
 	AllowedGroups *[]int64 // 使用指针区分"未提供"和"设置为空数组"
+	Role          string   // 为空表示不修改；权限校验由调用方完成
+	RateLimits    RequestRateLimitsUpdate
 }
 
 type CreateGroupInput struct {
@@ -110,6 +113,11 @@ type CreateGroupInput struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled bool // 是否启用模型路由
+	// RPM/TPM 限制（分组内所有 Key 共享，0 表示不限制）
+	RateLimits RequestRateLimits
+	// 响应缓存配置与命中计费比例（0 表示免费）
+	ResponseCache          ResponseCacheSettings
+	ResponseCacheCostRatio float64
 }
 
 type UpdateGroupInput struct {
@@ -132,6 +140,11 @@ type UpdateGroupInput struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled *bool // 是否启用模型路由
+	// RPM/TPM 限制：各字段 nil 表示不修改
+	RateLimits RequestRateLimitsUpdate
+	// 响应缓存：各字段 nil 表示不修改
+	ResponseCache          ResponseCacheSettingsUpdate
+	ResponseCacheCostRatio *float64
 }
 
 type CreateAccountInput struct {
@@ -142,6 +155,7 @@ type CreateAccountInput struct {
 	Credentials        map[string]any
 	Extra              map[string]any
 	ProxyID            *int64
+	ProxyPoolID        *int64 // 引用代理池（>0 时由代理池分配代理，忽略 ProxyID）
 	Concurrency        int
 	Priority           int
 	RateMultiplier     *float64 // 账号计费倍率（>=0，允许 0）
@@ -160,6 +174,7 @@ type UpdateAccountInput struct {
 	Credentials           map[string]any
 	Extra                 map[string]any
 	ProxyID               *int64
+	ProxyPoolID           *int64   // >0 绑定代理池（忽略 ProxyID），0 解除绑定
 	Concurrency           *int     // 使用指针区分"未提供"和"设置为0"
 	Priority              *int     // 使用指针区分"未提供"和"设置为0"
 	RateMultiplier        *float64 // 账号计费倍率（>=0，允许 0）
@@ -279,6 +294,7 @@ type adminServiceImpl struct {
 	proxyProber          ProxyExitInfoProber
 	proxyLatencyCache    ProxyLatencyCache
 	authCacheInvalidator APIKeyAuthCacheInvalidator
+	proxyPoolService     *ProxyPoolService
 }
 
 // NewAdminService creates a new AdminService
@@ -293,6 +309,7 @@ func NewAdminService(
 	proxyProber ProxyExitInfoProber,
 	proxyLatencyCache ProxyLatencyCache,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	proxyPoolService *ProxyPoolService,
 ) AdminService {
 	return &adminServiceImpl{
 		userRepo:             userRepo,
@@ -305,6 +322,7 @@ func NewAdminService(
 		proxyProber:          proxyProber,
 		proxyLatencyCache:    proxyLatencyCache,
 		authCacheInvalidator: authCacheInvalidator,
+		proxyPoolService:     proxyPoolService,
 	}
 }
 
@@ -323,6 +341,9 @@ func (s *adminServiceImpl) GetUser(ctx context.Context, id int64) (*User, error)
 }
 
 func (s *adminServiceImpl) CreateUser(ctx context.Context, input *CreateUserInput) (*User, error) {
+	if err := input.RateLimits.Validate(); err != nil {
+		return nil, err
+	}
 	user := &User{
 		Email:         input.Email,
 		Username:      input.Username,
@@ -332,6 +353,7 @@ func (s *adminServiceImpl) CreateUser(ctx context.Context, input *CreateUserInpu
 		Concurrency:   input.Concurrency,
 		Status:        StatusActive,
 		AllowedGroups: input.AllowedGroups,
+		RateLimits:    input.RateLimits,
 	}
 	if err := user.SetPassword(input.Password); err != nil {
 		return nil, err
@@ -356,6 +378,7 @@ func (s *adminServiceImpl) UpdateUser(ctx context.Context, id int64, input *Upda
 	oldConcurrency := user.Concurrency
 	oldStatus := user.Status
 	oldRole := user.Role
+	oldRateLimits := user.RateLimits
 
 	if input.Email != "" {
 		user.Email = input.Email
@@ -377,6 +400,13 @@ func (s *adminServiceImpl) UpdateUser(ctx context.Context, id int64, input *Upda
 		user.Status = input.Status
 	}
 
+	if input.Role != "" {
+		if !IsValidUserRole(input.Role) {
+			return nil, ErrInvalidUserRole
+		}
+		user.Role = input.Role
+	}
+
 	if input.Concurrency != nil {
 		user.Concurrency = *input.Concurrency
 	}
@@ -385,11 +415,19 @@ func (s *adminServiceImpl) UpdateUser(ctx context.Context, id int64, input *Upda
 		user.AllowedGroups = *input.AllowedGroups
 	}
 
+	if !input.RateLimits.IsEmpty() {
+		limits, err := input.RateLimits.Apply(user.RateLimits)
+		if err != nil {
+			return nil, err
+		}
+		user.RateLimits = limits
+	}
+
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return nil, err
 	}
 	if s.authCacheInvalidator != nil {
-		if user.Concurrency != oldConcurrency || user.Status != oldStatus || user.Role != oldRole {
+		if user.Concurrency != oldConcurrency || user.Status != oldStatus || user.Role != oldRole || user.RateLimits != oldRateLimits {
 			s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, user.ID)
 		}
 	}
@@ -437,31 +475,51 @@ func (s *adminServiceImpl) DeleteUser(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *adminServiceImpl) UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string) (*User, error) {
+func (s *adminServiceImpl) UpdateUserBalance(ctx context.Context, userID int64, balance float64, operation string, notes string, actorUserID int64) (*User, error) {
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	oldBalance := user.Balance
+	newBalance := oldBalance
 
 	switch operation {
 	case "set":
-		user.Balance = balance
+		newBalance = balance
 	case "add":
-		user.Balance += balance
+		newBalance += balance
 	case "subtract":
-		user.Balance -= balance
+		newBalance -= balance
 	}
 
-	if user.Balance < 0 {
-		return nil, fmt.Errorf("balance cannot be negative, current balance: %.2f, requested operation would result in: %.2f", oldBalance, user.Balance)
+	if newBalance < 0 {
+		return nil, fmt.Errorf("balance cannot be negative, current balance: %.2f, requested operation would result in: %.2f", oldBalance, newBalance)
 	}
 
-	if err := s.userRepo.Update(ctx, user); err != nil {
-		return nil, err
+	balanceDiff := newBalance - oldBalance
+	var adjustmentCode string
+	if balanceDiff != 0 {
+		// 调整记录码同时作为余额流水的关联单据
+		adjustmentCode, err = GenerateRedeemCode()
+		if err != nil {
+			log.Printf("failed to generate adjustment redeem code: %v", err)
+			adjustmentCode = ""
+		}
+		source := BalanceChangeSource{
+			Type:        BalanceSourceAdminAdjustment,
+			ReferenceID: adjustmentCode,
+			Notes:       truncateString(notes, 500),
+		}
+		if actorUserID > 0 {
+			source.ActorUserID = &actorUserID
+		}
+		// 按差额原子更新，避免覆盖并发扣费
+		if err := s.userRepo.UpdateBalance(ctx, userID, balanceDiff, source); err != nil {
+			return nil, err
+		}
+		user.Balance = newBalance
 	}
-	balanceDiff := user.Balance - oldBalance
 	if s.authCacheInvalidator != nil && balanceDiff != 0 {
 		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
 	}
@@ -476,15 +534,9 @@ func (s *adminServiceImpl) UpdateUserBalance(ctx context.Context, userID int64,
 		}()
 	}
 
-	if balanceDiff != 0 {
-		code, err := GenerateRedeemCode()
-		if err != nil {
-			log.Printf("failed to generate adjustment redeem code: %v", err)
-			return user, nil
-		}
-
+	if adjustmentCode != "" {
 		adjustmentRecord := &RedeemCode{
-			Code:   code,
+			Code:   adjustmentCode,
 			Type:   AdjustmentTypeAdminBalance,
 			Value:  balanceDiff,
 			Status: StatusUsed,
@@ -572,6 +624,16 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		}
 	}
 
+	if err := input.RateLimits.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.ResponseCache.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateResponseCacheCostRatio(input.ResponseCacheCostRatio); err != nil {
+		return nil, err
+	}
+
 	group := &Group{
 		Name:             input.Name,
 		Description:      input.Description,
@@ -589,6 +651,10 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		ClaudeCodeOnly:   input.ClaudeCodeOnly,
 		FallbackGroupID:  input.FallbackGroupID,
 		ModelRouting:     input.ModelRouting,
+		RateLimits:       input.RateLimits,
+
+		ResponseCache:          input.ResponseCache,
+		ResponseCacheCostRatio: input.ResponseCacheCostRatio,
 	}
 	if err := s.groupRepo.Create(ctx, group); err != nil {
 		return nil, err
@@ -725,6 +791,28 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 		group.ModelRoutingEnabled = *input.ModelRoutingEnabled
 	}
 
+	// RPM/TPM 限制
+	if !input.RateLimits.IsEmpty() {
+		limits, err := input.RateLimits.Apply(group.RateLimits)
+		if err != nil {
+			return nil, err
+		}
+		group.RateLimits = limits
+	}
+
+	// 响应缓存
+	responseCache, err := input.ResponseCache.Apply(group.ResponseCache)
+	if err != nil {
+		return nil, err
+	}
+	group.ResponseCache = responseCache
+	if input.ResponseCacheCostRatio != nil {
+		if err := validateResponseCacheCostRatio(*input.ResponseCacheCostRatio); err != nil {
+			return nil, err
+		}
+		group.ResponseCacheCostRatio = *input.ResponseCacheCostRatio
+	}
+
 	if err := s.groupRepo.Update(ctx, group); err != nil {
 		return nil, err
 	}
@@ -843,6 +931,14 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 		Status:      StatusActive,
 		Schedulable: true,
 	}
+	// 绑定代理池时代理由代理池分配（创建后按选择策略分配）
+	if input.ProxyPoolID != nil && *input.ProxyPoolID > 0 {
+		if err := s.validateProxyPool(ctx, *input.ProxyPoolID); err != nil {
+			return nil, err
+		}
+		account.ProxyPoolID = input.ProxyPoolID
+		account.ProxyID = nil
+	}
 	if input.ExpiresAt != nil && *input.ExpiresAt > 0 {
 		expiresAt := time.Unix(*input.ExpiresAt, 0)
 		account.ExpiresAt = &expiresAt
@@ -869,6 +965,10 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 		}
 	}
 
+	if err := s.proxyPoolService.AssignAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
 	return account, nil
 }
 
@@ -893,14 +993,24 @@ func (s *adminServiceImpl) UpdateAccount(ctx context.Context, id int64, input *U
 	if len(input.Extra) > 0 {
 		account.Extra = input.Extra
 	}
-	if input.ProxyID != nil {
+	if input.ProxyPoolID != nil && *input.ProxyPoolID > 0 {
+		// 绑定代理池：保留当前代理（若仍是池内健康代理），否则保存后由代理池重新分配
+		if err := s.validateProxyPool(ctx, *input.ProxyPoolID); err != nil {
+			return nil, err
+		}
+		account.ProxyPoolID = input.ProxyPoolID
+		account.Proxy = nil
+	} else if input.ProxyID != nil {
 		// 0 表示清除代理（前端发送 0 而不是 null 来表达清除意图）
 		if *input.ProxyID == 0 {
 			account.ProxyID = nil
 		} else {
 			account.ProxyID = input.ProxyID
 		}
-		account.Proxy = nil // 清除关联对象，防止 GORM Save 时根据 Proxy.ID 覆盖 ProxyID
+		account.ProxyPoolID = nil // 直接指定代理即解除代理池绑定
+		account.Proxy = nil       // 清除关联对象，防止 GORM Save 时根据 Proxy.ID 覆盖 ProxyID
+	} else if input.ProxyPoolID != nil {
+		account.ProxyPoolID = nil
 	}
 	// 只在指针非 nil 时更新 Concurrency（支持设置为 0）
 	if input.Concurrency != nil {
@@ -958,6 +1068,10 @@ func (s *adminServiceImpl) UpdateAccount(ctx context.Context, id int64, input *U
 		}
 	}
 
+	if err := s.proxyPoolService.AssignAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
 	// 重新查询以确保返回完整数据（包括正确的 Proxy 关联对象）
 	return s.accountRepo.GetByID(ctx, id)
 }
@@ -1130,6 +1244,7 @@ func (s *adminServiceImpl) ListProxiesWithAccountCount(ctx context.Context, page
 		return nil, 0, err
 	}
 	s.attachProxyLatency(ctx, proxies)
+	s.proxyPoolService.AttachProxyHealth(ctx, proxies)
 	return proxies, result.Total, nil
 }
 
@@ -1143,6 +1258,7 @@ func (s *adminServiceImpl) GetAllProxiesWithAccountCount(ctx context.Context) ([
 		return nil, err
 	}
 	s.attachProxyLatency(ctx, proxies)
+	s.proxyPoolService.AttachProxyHealth(ctx, proxies)
 	return proxies, nil
 }
 
@@ -1248,6 +1364,14 @@ func (s *adminServiceImpl) BatchDeleteProxies(ctx context.Context, ids []int64)
 	return result, nil
 }
 
+// validateProxyPool 校验代理池可被账号引用
+func (s *adminServiceImpl) validateProxyPool(ctx context.Context, poolID int64) error {
+	if s.proxyPoolService == nil {
+		return ErrProxyPoolNotFound
+	}
+	return s.proxyPoolService.ValidatePool(ctx, poolID)
+}
+
 func (s *adminServiceImpl) GetProxyAccounts(ctx context.Context, proxyID int64) ([]ProxyAccountSummary, error) {
 	return s.proxyRepo.ListAccountSummariesByProxyID(ctx, proxyID)
 }
@@ -70,11 +70,11 @@ func (s *userRepoStub) ListWithFilters(ctx context.Context, params pagination.Pa
 	panic("unexpected ListWithFilters call")
 }
 
-func (s *userRepoStub) UpdateBalance(ctx context.Context, id int64, amount float64) error {
+func (s *userRepoStub) UpdateBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error {
 	panic("unexpected UpdateBalance call")
 }
 
-func (s *userRepoStub) DeductBalance(ctx context.Context, id int64, amount float64) error {
+func (s *userRepoStub) DeductBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error {
 	panic("unexpected DeductBalance call")
 }
 
@@ -320,6 +320,54 @@ func (s *billingCacheStub) InvalidateSubscriptionCache(ctx context.Context, user
 	return nil
 }
 
+func (s *billingCacheStub) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyUsageCacheData, error) {
+	panic("unexpected GetAPIKeyUsageCache call")
+}
+
+func (s *billingCacheStub) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyUsageCacheData) error {
+	panic("unexpected SetAPIKeyUsageCache call")
+}
+
+func (s *billingCacheStub) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64, dayStart, monthStart time.Time) error {
+	panic("unexpected UpdateAPIKeyUsage call")
+}
+
+func (s *billingCacheStub) InvalidateAPIKeyUsageCache(ctx context.Context, apiKeyID int64) error {
+	panic("unexpected InvalidateAPIKeyUsageCache call")
+}
+
+func (s *billingCacheStub) GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error) {
+	panic("unexpected GetOrganizationBalance call")
+}
+
+func (s *billingCacheStub) SetOrganizationBalance(ctx context.Context, orgID int64, balance float64) error {
+	panic("unexpected SetOrganizationBalance call")
+}
+
+func (s *billingCacheStub) DeductOrganizationBalance(ctx context.Context, orgID int64, amount float64) error {
+	panic("unexpected DeductOrganizationBalance call")
+}
+
+func (s *billingCacheStub) InvalidateOrganizationBalance(ctx context.Context, orgID int64) error {
+	panic("unexpected InvalidateOrganizationBalance call")
+}
+
+func (s *billingCacheStub) GetOrgMemberUsageCache(ctx context.Context, userID int64) (*OrgMemberUsageCacheData, error) {
+	panic("unexpected GetOrgMemberUsageCache call")
+}
+
+func (s *billingCacheStub) SetOrgMemberUsageCache(ctx context.Context, userID int64, data *OrgMemberUsageCacheData) error {
+	panic("unexpected SetOrgMemberUsageCache call")
+}
+
+func (s *billingCacheStub) UpdateOrgMemberUsage(ctx context.Context, userID int64, cost float64, monthStart time.Time) error {
+	panic("unexpected UpdateOrgMemberUsage call")
+}
+
+func (s *billingCacheStub) InvalidateOrgMemberUsageCache(ctx context.Context, userID int64) error {
+	panic("unexpected InvalidateOrgMemberUsageCache call")
+}
+
 func waitForInvalidations(t *testing.T, ch <-chan subscriptionInvalidateCall, expected int) []subscriptionInvalidateCall {
 	t.Helper()
 	calls := make([]subscriptionInvalidateCall, 0, expected)
@@ -13,6 +13,18 @@ type balanceUserRepoStub struct {
 	*userRepoStub
 	updateErr error
 	updated   []*User
+	sources   []BalanceChangeSource
+}
+
+func (s *balanceUserRepoStub) UpdateBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error {
+	if s.updateErr != nil {
+		return s.updateErr
+	}
+	s.sources = append(s.sources, source)
+	if s.userRepoStub != nil && s.userRepoStub.user != nil {
+		s.userRepoStub.user.Balance += amount
+	}
+	return nil
 }
 
 func (s *balanceUserRepoStub) Update(ctx context.Context, user *User) error {
@@ -73,10 +85,14 @@ func TestAdminService_UpdateUserBalance_InvalidatesAuthCache(t *testing.T) {
 		authCacheInvalidator: invalidator,
 	}
 
-	_, err := svc.UpdateUserBalance(context.Background(), 7, 5, "add", "")
+	_, err := svc.UpdateUserBalance(context.Background(), 7, 5, "add", "", 1)
 	require.NoError(t, err)
 	require.Equal(t, []int64{7}, invalidator.userIDs)
 	require.Len(t, redeemRepo.created, 1)
+	require.Len(t, repo.sources, 1)
+	require.Equal(t, BalanceSourceAdminAdjustment, repo.sources[0].Type)
+	require.Equal(t, redeemRepo.created[0].Code, repo.sources[0].ReferenceID)
+	require.Equal(t, int64(1), *repo.sources[0].ActorUserID)
 }
 
 func TestAdminService_UpdateUserBalance_NoChangeNoInvalidate(t *testing.T) {
@@ -90,8 +106,9 @@ func TestAdminService_UpdateUserBalance_NoChangeNoInvalidate(t *testing.T) {
 		authCacheInvalidator: invalidator,
 	}
 
-	_, err := svc.UpdateUserBalance(context.Background(), 7, 10, "set", "")
+	_, err := svc.UpdateUserBalance(context.Background(), 7, 10, "set", "", 1)
 	require.NoError(t, err)
 	require.Empty(t, invalidator.userIDs)
 	require.Empty(t, redeemRepo.created)
+	require.Empty(t, repo.sources)
 }
@@ -1,6 +1,10 @@
 package service
 
-import "time"
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
 
 type APIKey struct {
 	ID          int64
@@ -11,12 +15,74 @@ type APIKey struct {
 	Status      string
 	IPWhitelist []string
 	IPBlacklist []string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	User        *User
-	Group       *Group
+
+	// 模型白名单（支持 * 通配符）与模型别名，为空表示不限制
+	AllowedModels []string
+	ModelAliases  map[string]string
+
+	// RPM/TPM 限制（0 表示不限制）
+	RateLimits RequestRateLimits
+
+	// 响应缓存配置（启用时优先于分组配置）
+	ResponseCache ResponseCacheSettings
+
+	// 花费上限（USD），nil 表示不限制
+	QuotaUSD        *float64
+	DailyLimitUSD   *float64
+	MonthlyLimitUSD *float64
+	ExpiresAt       *time.Time
+
+	// 用量计数（仅从数据库加载时有值，认证缓存中不包含）
+	QuotaUsedUSD       float64
+	DailyUsageUSD      float64
+	MonthlyUsageUSD    float64
+	DailyWindowStart   *time.Time
+	MonthlyWindowStart *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      *User
+	Group     *Group
 }
 
 func (k *APIKey) IsActive() bool {
 	return k.Status == StatusActive
 }
+
+// IsExpired 检查 API Key 是否已过期
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && !time.Now().Before(*k.ExpiresAt)
+}
+
+func (k *APIKey) HasQuota() bool {
+	return k.QuotaUSD != nil && *k.QuotaUSD > 0
+}
+
+func (k *APIKey) HasDailyLimit() bool {
+	return k.DailyLimitUSD != nil && *k.DailyLimitUSD > 0
+}
+
+func (k *APIKey) HasMonthlyLimit() bool {
+	return k.MonthlyLimitUSD != nil && *k.MonthlyLimitUSD > 0
+}
+
+// HasSpendingLimit 是否配置了任一花费上限
+func (k *APIKey) HasSpendingLimit() bool {
+	return k.HasQuota() || k.HasDailyLimit() || k.HasMonthlyLimit()
+}
+
+// CurrentDailyUsage 返回当前自然日的用量，窗口已过期时视为 0
+func (k *APIKey) CurrentDailyUsage(now time.Time) float64 {
+	if k.DailyWindowStart == nil || k.DailyWindowStart.Before(timezone.StartOfDay(now)) {
+		return 0
+	}
+	return k.DailyUsageUSD
+}
+
+// CurrentMonthlyUsage 返回当前自然月的用量，窗口已过期时视为 0
+func (k *APIKey) CurrentMonthlyUsage(now time.Time) float64 {
+	if k.MonthlyWindowStart == nil || k.MonthlyWindowStart.Before(timezone.StartOfMonth(now)) {
+		return 0
+	}
+	return k.MonthlyUsageUSD
+}
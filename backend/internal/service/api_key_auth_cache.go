@@ -1,5 +1,7 @@
 package service
 
+import "time"
+
 // APIKeyAuthSnapshot API Key 认证缓存快照（仅包含认证所需字段）
 type APIKeyAuthSnapshot struct {
 	APIKeyID    int64                    `json:"api_key_id"`
@@ -10,6 +12,22 @@ type APIKeyAuthSnapshot struct {
 	IPBlacklist []string                 `json:"ip_blacklist,omitempty"`
 	User        APIKeyAuthUserSnapshot   `json:"user"`
 	Group       *APIKeyAuthGroupSnapshot `json:"group,omitempty"`
+
+	// 花费上限与过期时间在认证与计费资格检查时使用；用量计数走独立的计费缓存
+	QuotaUSD        *float64   `json:"quota_usd,omitempty"`
+	DailyLimitUSD   *float64   `json:"daily_limit_usd,omitempty"`
+	MonthlyLimitUSD *float64   `json:"monthly_limit_usd,omitempty"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+
+	// 模型白名单与别名在账号调度前解析，需要包含在快照中
+	AllowedModels []string          `json:"allowed_models,omitempty"`
+	ModelAliases  map[string]string `json:"model_aliases,omitempty"`
+
+	// RPM/TPM 限制在账号调度前检查
+	RateLimits RequestRateLimits `json:"rate_limits"`
+
+	// 响应缓存在进入网关处理前查询
+	ResponseCache ResponseCacheSettings `json:"response_cache"`
 }
 
 // APIKeyAuthUserSnapshot 用户快照
@@ -19,6 +37,21 @@ type APIKeyAuthUserSnapshot struct {
 	Role        string  `json:"role"`
 	Balance     float64 `json:"balance"`
 	Concurrency int     `json:"concurrency"`
+
+	RateLimits RequestRateLimits `json:"rate_limits"`
+
+	// 组织成员身份决定计费主体与组织并发池
+	Organization *APIKeyAuthOrgSnapshot `json:"organization,omitempty"`
+}
+
+// APIKeyAuthOrgSnapshot 组织成员快照
+type APIKeyAuthOrgSnapshot struct {
+	OrganizationID  int64    `json:"organization_id"`
+	OwnerUserID     int64    `json:"owner_user_id"`
+	Status          string   `json:"status"`
+	Concurrency     int      `json:"concurrency"`
+	Role            string   `json:"role"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd,omitempty"`
 }
 
 // APIKeyAuthGroupSnapshot 分组快照
@@ -42,6 +75,11 @@ type APIKeyAuthGroupSnapshot struct {
 	// Only anthropic groups use these fields; others may leave them empty.
 	ModelRouting        map[string][]int64 `json:"model_routing,omitempty"`
 	ModelRoutingEnabled bool               `json:"model_routing_enabled"`
+
+	RateLimits RequestRateLimits `json:"rate_limits"`
+
+	ResponseCache          ResponseCacheSettings `json:"response_cache"`
+	ResponseCacheCostRatio float64               `json:"response_cache_cost_ratio"`
 }
 
 // APIKeyAuthCacheEntry 缓存条目，支持负缓存
@@ -207,20 +207,39 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 		return nil
 	}
 	snapshot := &APIKeyAuthSnapshot{
-		APIKeyID:    apiKey.ID,
-		UserID:      apiKey.UserID,
-		GroupID:     apiKey.GroupID,
-		Status:      apiKey.Status,
-		IPWhitelist: apiKey.IPWhitelist,
-		IPBlacklist: apiKey.IPBlacklist,
+		APIKeyID:        apiKey.ID,
+		UserID:          apiKey.UserID,
+		GroupID:         apiKey.GroupID,
+		Status:          apiKey.Status,
+		IPWhitelist:     apiKey.IPWhitelist,
+		IPBlacklist:     apiKey.IPBlacklist,
+		QuotaUSD:        apiKey.QuotaUSD,
+		DailyLimitUSD:   apiKey.DailyLimitUSD,
+		MonthlyLimitUSD: apiKey.MonthlyLimitUSD,
+		ExpiresAt:       apiKey.ExpiresAt,
+		AllowedModels:   apiKey.AllowedModels,
+		ModelAliases:    apiKey.ModelAliases,
+		RateLimits:      apiKey.RateLimits,
+		ResponseCache:   apiKey.ResponseCache,
 		User: APIKeyAuthUserSnapshot{
 			ID:          apiKey.User.ID,
 			Status:      apiKey.User.Status,
 			Role:        apiKey.User.Role,
 			Balance:     apiKey.User.Balance,
 			Concurrency: apiKey.User.Concurrency,
+			RateLimits:  apiKey.User.RateLimits,
 		},
 	}
+	if org := apiKey.User.Organization; org != nil {
+		snapshot.User.Organization = &APIKeyAuthOrgSnapshot{
+			OrganizationID:  org.OrganizationID,
+			OwnerUserID:     org.OwnerUserID,
+			Status:          org.Status,
+			Concurrency:     org.Concurrency,
+			Role:            org.Role,
+			MonthlyLimitUSD: org.MonthlyLimitUSD,
+		}
+	}
 	if apiKey.Group != nil {
 		snapshot.Group = &APIKeyAuthGroupSnapshot{
 			ID:                  apiKey.Group.ID,
@@ -239,6 +258,10 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 			FallbackGroupID:     apiKey.Group.FallbackGroupID,
 			ModelRouting:        apiKey.Group.ModelRouting,
 			ModelRoutingEnabled: apiKey.Group.ModelRoutingEnabled,
+			RateLimits:          apiKey.Group.RateLimits,
+
+			ResponseCache:          apiKey.Group.ResponseCache,
+			ResponseCacheCostRatio: apiKey.Group.ResponseCacheCostRatio,
 		}
 	}
 	return snapshot
@@ -249,21 +272,40 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 		return nil
 	}
 	apiKey := &APIKey{
-		ID:          snapshot.APIKeyID,
-		UserID:      snapshot.UserID,
-		GroupID:     snapshot.GroupID,
-		Key:         key,
-		Status:      snapshot.Status,
-		IPWhitelist: snapshot.IPWhitelist,
-		IPBlacklist: snapshot.IPBlacklist,
+		ID:              snapshot.APIKeyID,
+		UserID:          snapshot.UserID,
+		GroupID:         snapshot.GroupID,
+		Key:             key,
+		Status:          snapshot.Status,
+		IPWhitelist:     snapshot.IPWhitelist,
+		IPBlacklist:     snapshot.IPBlacklist,
+		QuotaUSD:        snapshot.QuotaUSD,
+		DailyLimitUSD:   snapshot.DailyLimitUSD,
+		MonthlyLimitUSD: snapshot.MonthlyLimitUSD,
+		ExpiresAt:       snapshot.ExpiresAt,
+		AllowedModels:   snapshot.AllowedModels,
+		ModelAliases:    snapshot.ModelAliases,
+		RateLimits:      snapshot.RateLimits,
+		ResponseCache:   snapshot.ResponseCache,
 		User: &User{
 			ID:          snapshot.User.ID,
 			Status:      snapshot.User.Status,
 			Role:        snapshot.User.Role,
 			Balance:     snapshot.User.Balance,
 			Concurrency: snapshot.User.Concurrency,
+			RateLimits:  snapshot.User.RateLimits,
 		},
 	}
+	if org := snapshot.User.Organization; org != nil {
+		apiKey.User.Organization = &OrganizationMembership{
+			OrganizationID:  org.OrganizationID,
+			OwnerUserID:     org.OwnerUserID,
+			Status:          org.Status,
+			Concurrency:     org.Concurrency,
+			Role:            org.Role,
+			MonthlyLimitUSD: org.MonthlyLimitUSD,
+		}
+	}
 	if snapshot.Group != nil {
 		apiKey.Group = &Group{
 			ID:                  snapshot.Group.ID,
@@ -283,6 +325,10 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 			FallbackGroupID:     snapshot.Group.FallbackGroupID,
 			ModelRouting:        snapshot.Group.ModelRouting,
 			ModelRoutingEnabled: snapshot.Group.ModelRoutingEnabled,
+			RateLimits:          snapshot.Group.RateLimits,
+
+			ResponseCache:          snapshot.Group.ResponseCache,
+			ResponseCacheCostRatio: snapshot.Group.ResponseCacheCostRatio,
 		}
 	}
 	return apiKey
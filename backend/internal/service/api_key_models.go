@@ -0,0 +1,156 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+const (
+	// maxAPIKeyModelRules 单个 API Key 允许配置的模型白名单/别名条目上限
+	maxAPIKeyModelRules = 100
+	maxAPIKeyModelLen   = 128
+)
+
+var (
+	ErrModelNotAllowed      = infraerrors.Forbidden("MODEL_NOT_ALLOWED", "model is not allowed for this api key")
+	ErrInvalidAPIKeyModels  = infraerrors.BadRequest("INVALID_API_KEY_MODELS", "invalid api key model allowlist")
+	ErrInvalidAPIKeyAliases = infraerrors.BadRequest("INVALID_API_KEY_MODEL_ALIASES", "invalid api key model aliases")
+)
+
+// HasModelRestriction 是否配置了模型白名单
+func (k *APIKey) HasModelRestriction() bool {
+	return len(k.AllowedModels) > 0
+}
+
+// HasModelPolicy 是否配置了模型白名单或别名（影响模型列表输出）
+func (k *APIKey) HasModelPolicy() bool {
+	return k.HasModelRestriction() || len(k.ModelAliases) > 0
+}
+
+// IsModelAllowed 检查模型是否在白名单内，未配置白名单时允许所有模型
+func (k *APIKey) IsModelAllowed(model string) bool {
+	if !k.HasModelRestriction() {
+		return true
+	}
+	for _, pattern := range k.AllowedModels {
+		if matchModelGlob(pattern, model) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveModel 解析客户端请求的模型名：先按别名映射，再校验白名单。
+// 返回实际转发的模型名；不允许时返回 ErrModelNotAllowed。
+func (k *APIKey) ResolveModel(requested string) (string, error) {
+	model := requested
+	if target, ok := k.ModelAliases[requested]; ok && target != "" {
+		model = target
+	}
+	if !k.IsModelAllowed(model) {
+		return "", ErrModelNotAllowed
+	}
+	return model, nil
+}
+
+// FilterModels 过滤出白名单允许的模型，并追加指向允许模型的别名（按名称排序）
+func (k *APIKey) FilterModels(models []string) []string {
+	out := make([]string, 0, len(models)+len(k.ModelAliases))
+	seen := make(map[string]struct{}, len(models)+len(k.ModelAliases))
+	for _, model := range models {
+		if _, ok := seen[model]; ok || !k.IsModelAllowed(model) {
+			continue
+		}
+		seen[model] = struct{}{}
+		out = append(out, model)
+	}
+	aliases := make([]string, 0, len(k.ModelAliases))
+	for alias, target := range k.ModelAliases {
+		if _, ok := seen[alias]; ok || !k.IsModelAllowed(target) {
+			continue
+		}
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return append(out, aliases...)
+}
+
+// matchModelGlob 模型通配符匹配，* 可出现在任意位置并匹配任意长度字符，
+// 如 "claude-*-haiku*" 匹配 "claude-3-5-haiku-20241022"
+func matchModelGlob(pattern, model string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == model
+	}
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(model, parts[0]) {
+		return false
+	}
+	rest := model[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(rest, part)
+		if idx < 0 {
+			return false
+		}
+		rest = rest[idx+len(part):]
+	}
+	return len(rest) >= len(last) && strings.HasSuffix(rest, last)
+}
+
+// normalizeAllowedModels 去除空白与重复项并校验格式
+func normalizeAllowedModels(patterns []string) ([]string, error) {
+	if len(patterns) > maxAPIKeyModelRules {
+		return nil, fmt.Errorf("%w: at most %d entries", ErrInvalidAPIKeyModels, maxAPIKeyModelRules)
+	}
+	out := make([]string, 0, len(patterns))
+	seen := make(map[string]struct{}, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if !isValidModelName(pattern) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAPIKeyModels, pattern)
+		}
+		if _, ok := seen[pattern]; ok {
+			continue
+		}
+		seen[pattern] = struct{}{}
+		out = append(out, pattern)
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// normalizeModelAliases 去除空白并校验别名：别名不能包含通配符，目标模型不能为空
+func normalizeModelAliases(aliases map[string]string) (map[string]string, error) {
+	if len(aliases) > maxAPIKeyModelRules {
+		return nil, fmt.Errorf("%w: at most %d entries", ErrInvalidAPIKeyAliases, maxAPIKeyModelRules)
+	}
+	out := make(map[string]string, len(aliases))
+	for alias, target := range aliases {
+		alias = strings.TrimSpace(alias)
+		target = strings.TrimSpace(target)
+		if alias == "" && target == "" {
+			continue
+		}
+		if !isValidModelName(alias) || strings.Contains(alias, "*") ||
+			!isValidModelName(target) || strings.Contains(target, "*") {
+			return nil, fmt.Errorf("%w: %q -> %q", ErrInvalidAPIKeyAliases, alias, target)
+		}
+		out[alias] = target
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+func isValidModelName(name string) bool {
+	return name != "" && len(name) <= maxAPIKeyModelLen && !strings.ContainsAny(name, " \t\r\n")
+}
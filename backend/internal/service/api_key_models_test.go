@@ -0,0 +1,89 @@
+//go:build unit
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchModelGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		model   string
+		want    bool
+	}{
+		{"claude-sonnet-4-5", "claude-sonnet-4-5", true},
+		{"claude-sonnet-4-5", "claude-sonnet-4-5-20250929", false},
+		{"claude-*", "claude-opus-4-5-20251101", true},
+		{"*-haiku*", "claude-3-5-haiku-20241022", true},
+		{"claude-*-haiku*", "claude-haiku-4-5", false},
+		{"claude-*-haiku*", "claude-3-5-haiku-20241022", true},
+		{"gpt-*-codex", "gpt-5.1-codex", true},
+		{"gpt-*-codex", "gpt-5.1-codex-max", false},
+		{"a*a", "a", false},
+		{"*", "anything", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.model, func(t *testing.T) {
+			require.Equal(t, tt.want, matchModelGlob(tt.pattern, tt.model))
+		})
+	}
+}
+
+func TestAPIKeyResolveModel(t *testing.T) {
+	key := &APIKey{
+		AllowedModels: []string{"claude-*-haiku*", "claude-haiku-*"},
+		ModelAliases:  map[string]string{"fast": "claude-haiku-4-5", "smart": "claude-opus-4-5"},
+	}
+
+	model, err := key.ResolveModel("fast")
+	require.NoError(t, err)
+	require.Equal(t, "claude-haiku-4-5", model)
+
+	model, err = key.ResolveModel("claude-3-5-haiku-20241022")
+	require.NoError(t, err)
+	require.Equal(t, "claude-3-5-haiku-20241022", model)
+
+	// 别名目标同样受白名单约束
+	_, err = key.ResolveModel("smart")
+	require.True(t, errors.Is(err, ErrModelNotAllowed))
+
+	_, err = key.ResolveModel("claude-opus-4-5")
+	require.True(t, errors.Is(err, ErrModelNotAllowed))
+
+	unrestricted := &APIKey{ModelAliases: map[string]string{"fast": "claude-haiku-4-5"}}
+	model, err = unrestricted.ResolveModel("anything")
+	require.NoError(t, err)
+	require.Equal(t, "anything", model)
+}
+
+func TestAPIKeyFilterModels(t *testing.T) {
+	key := &APIKey{
+		AllowedModels: []string{"claude-sonnet-*", "claude-haiku-*"},
+		ModelAliases:  map[string]string{"fast": "claude-haiku-4-5", "big": "claude-opus-4-5", "default": "claude-sonnet-4-5"},
+	}
+	got := key.FilterModels([]string{"claude-opus-4-5", "claude-sonnet-4-5", "claude-haiku-4-5", "claude-sonnet-4-5"})
+	require.Equal(t, []string{"claude-sonnet-4-5", "claude-haiku-4-5", "default", "fast"}, got)
+}
+
+func TestNormalizeAPIKeyModelRules(t *testing.T) {
+	models, err := normalizeAllowedModels([]string{" claude-* ", "", "claude-*", "gpt-5"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"claude-*", "gpt-5"}, models)
+
+	_, err = normalizeAllowedModels([]string{"claude sonnet"})
+	require.True(t, errors.Is(err, ErrInvalidAPIKeyModels))
+
+	aliases, err := normalizeModelAliases(map[string]string{" fast ": " claude-haiku-4-5 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"fast": "claude-haiku-4-5"}, aliases)
+
+	_, err = normalizeModelAliases(map[string]string{"fast*": "claude-haiku-4-5"})
+	require.True(t, errors.Is(err, ErrInvalidAPIKeyAliases))
+
+	_, err = normalizeModelAliases(map[string]string{"fast": ""})
+	require.True(t, errors.Is(err, ErrInvalidAPIKeyAliases))
+}
@@ -24,6 +24,8 @@ var (
 	ErrAPIKeyInvalidChars = infraerrors.BadRequest("API_KEY_INVALID_CHARS", "api key can only contain letters, numbers, underscores, and hyphens")
 	ErrAPIKeyRateLimited  = infraerrors.TooManyRequests("API_KEY_RATE_LIMITED", "too many failed attempts, please try again later")
 	ErrInvalidIPPattern   = infraerrors.BadRequest("INVALID_IP_PATTERN", "invalid IP or CIDR pattern")
+	ErrInvalidKeyLimit    = infraerrors.BadRequest("INVALID_API_KEY_LIMIT", "api key spending limit must not be negative")
+	ErrInvalidKeyExpiry   = infraerrors.BadRequest("INVALID_API_KEY_EXPIRY", "api key expiration must be in the future")
 )
 
 const (
@@ -51,6 +53,8 @@ type APIKeyRepository interface {
 	CountByGroupID(ctx context.Context, groupID int64) (int64, error)
 	ListKeysByUserID(ctx context.Context, userID int64) ([]string, error)
 	ListKeysByGroupID(ctx context.Context, groupID int64) ([]string, error)
+	// IncrementUsage 累加 API Key 的总/日/月用量（USD），窗口过期时自动滚动
+	IncrementUsage(ctx context.Context, id int64, costUSD float64, dayStart, monthStart time.Time) error
 }
 
 // APIKeyCache defines cache operations for API key service
@@ -85,6 +89,25 @@ type CreateAPIKeyRequest struct {
 	CustomKey   *string  `json:"custom_key"`   // 可选的自定义key
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	// 花费上限（USD）与过期时间，均为可选
+	QuotaUSD        *float64   `json:"quota_usd"`
+	DailyLimitUSD   *float64   `json:"daily_limit_usd"`
+	MonthlyLimitUSD *float64   `json:"monthly_limit_usd"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+
+	// 模型白名单（支持 * 通配符）与模型别名，均为可选
+	AllowedModels []string          `json:"allowed_models"`
+	ModelAliases  map[string]string `json:"model_aliases"`
+
+	// RPM/TPM 限制，0 表示不限制
+	RPMLimit       int `json:"rpm_limit"`
+	InputTPMLimit  int `json:"input_tpm_limit"`
+	OutputTPMLimit int `json:"output_tpm_limit"`
+
+	// 响应缓存，TTL 为 0 表示使用分组或全局默认值
+	ResponseCacheEnabled    bool `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int  `json:"response_cache_ttl_seconds"`
 }
 
 // UpdateAPIKeyRequest 更新API Key请求
@@ -94,6 +117,27 @@ type UpdateAPIKeyRequest struct {
 	Status      *string  `json:"status"`
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单（空数组清空）
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单（空数组清空）
+
+	// 花费上限：nil 表示不修改，0 表示清除
+	QuotaUSD        *float64 `json:"quota_usd"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+	// 过期时间：nil 表示不修改，ClearExpiresAt 为 true 时清除
+	ExpiresAt      *time.Time `json:"expires_at"`
+	ClearExpiresAt bool       `json:"-"`
+
+	// 模型白名单与别名：nil 表示不修改，空数组/空对象表示清除
+	AllowedModels []string          `json:"allowed_models"`
+	ModelAliases  map[string]string `json:"model_aliases"`
+
+	// RPM/TPM 限制：nil 表示不修改，0 表示取消限制
+	RPMLimit       *int `json:"rpm_limit"`
+	InputTPMLimit  *int `json:"input_tpm_limit"`
+	OutputTPMLimit *int `json:"output_tpm_limit"`
+
+	// 响应缓存：nil 表示不修改
+	ResponseCacheEnabled    *bool `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds *int  `json:"response_cache_ttl_seconds"`
 }
 
 // APIKeyService API Key服务
@@ -232,6 +276,33 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		}
 	}
 
+	// 验证花费上限与过期时间
+	if err := validateKeyLimits(req.QuotaUSD, req.DailyLimitUSD, req.MonthlyLimitUSD); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidKeyExpiry
+	}
+
+	// 验证模型白名单与别名
+	allowedModels, err := normalizeAllowedModels(req.AllowedModels)
+	if err != nil {
+		return nil, err
+	}
+	modelAliases, err := normalizeModelAliases(req.ModelAliases)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimits := RequestRateLimits{RPM: req.RPMLimit, InputTPM: req.InputTPMLimit, OutputTPM: req.OutputTPMLimit}
+	if err := rateLimits.Validate(); err != nil {
+		return nil, err
+	}
+	responseCache := ResponseCacheSettings{Enabled: req.ResponseCacheEnabled, TTLSeconds: req.ResponseCacheTTLSeconds}
+	if err := responseCache.Validate(); err != nil {
+		return nil, err
+	}
+
 	// 验证分组权限（如果指定了分组）
 	if req.GroupID != nil {
 		group, err := s.groupRepo.GetByID(ctx, *req.GroupID)
@@ -289,6 +360,17 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		Status:      StatusActive,
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		QuotaUSD:        normalizeLimit(req.QuotaUSD),
+		DailyLimitUSD:   normalizeLimit(req.DailyLimitUSD),
+		MonthlyLimitUSD: normalizeLimit(req.MonthlyLimitUSD),
+		ExpiresAt:       req.ExpiresAt,
+
+		AllowedModels: allowedModels,
+		ModelAliases:  modelAliases,
+
+		RateLimits:    rateLimits,
+		ResponseCache: responseCache,
 	}
 
 	if err := s.apiKeyRepo.Create(ctx, apiKey); err != nil {
@@ -404,6 +486,48 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 		}
 	}
 
+	// 验证花费上限与过期时间
+	if err := validateKeyLimits(req.QuotaUSD, req.DailyLimitUSD, req.MonthlyLimitUSD); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidKeyExpiry
+	}
+
+	// 更新模型白名单与别名（nil 表示不修改）
+	if req.AllowedModels != nil {
+		allowedModels, err := normalizeAllowedModels(req.AllowedModels)
+		if err != nil {
+			return nil, err
+		}
+		apiKey.AllowedModels = allowedModels
+	}
+	if req.ModelAliases != nil {
+		modelAliases, err := normalizeModelAliases(req.ModelAliases)
+		if err != nil {
+			return nil, err
+		}
+		apiKey.ModelAliases = modelAliases
+	}
+
+	// 更新 RPM/TPM 限制
+	rateLimitsUpdate := RequestRateLimitsUpdate{RPM: req.RPMLimit, InputTPM: req.InputTPMLimit, OutputTPM: req.OutputTPMLimit}
+	if !rateLimitsUpdate.IsEmpty() {
+		rateLimits, err := rateLimitsUpdate.Apply(apiKey.RateLimits)
+		if err != nil {
+			return nil, err
+		}
+		apiKey.RateLimits = rateLimits
+	}
+
+	// 更新响应缓存配置
+	responseCacheUpdate := ResponseCacheSettingsUpdate{Enabled: req.ResponseCacheEnabled, TTLSeconds: req.ResponseCacheTTLSeconds}
+	responseCache, err := responseCacheUpdate.Apply(apiKey.ResponseCache)
+	if err != nil {
+		return nil, err
+	}
+	apiKey.ResponseCache = responseCache
+
 	// 更新字段
 	if req.Name != nil {
 		apiKey.Name = *req.Name
@@ -440,6 +564,24 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	apiKey.IPWhitelist = req.IPWhitelist
 	apiKey.IPBlacklist = req.IPBlacklist
 
+	// 更新花费上限（0 表示清除）
+	if req.QuotaUSD != nil {
+		apiKey.QuotaUSD = normalizeLimit(req.QuotaUSD)
+	}
+	if req.DailyLimitUSD != nil {
+		apiKey.DailyLimitUSD = normalizeLimit(req.DailyLimitUSD)
+	}
+	if req.MonthlyLimitUSD != nil {
+		apiKey.MonthlyLimitUSD = normalizeLimit(req.MonthlyLimitUSD)
+	}
+
+	// 更新过期时间
+	if req.ClearExpiresAt {
+		apiKey.ExpiresAt = nil
+	} else if req.ExpiresAt != nil {
+		apiKey.ExpiresAt = req.ExpiresAt
+	}
+
 	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
 		return nil, fmt.Errorf("update api key: %w", err)
 	}
@@ -449,6 +591,16 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	return apiKey, nil
 }
 
+// validateKeyLimits 校验花费上限不为负数
+func validateKeyLimits(limits ...*float64) error {
+	for _, limit := range limits {
+		if limit != nil && *limit < 0 {
+			return ErrInvalidKeyLimit
+		}
+	}
+	return nil
+}
+
 // Delete 删除API Key
 func (s *APIKeyService) Delete(ctx context.Context, id int64, userID int64) error {
 	key, ownerID, err := s.apiKeyRepo.GetKeyAndOwnerID(ctx, id)
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	AuditActorTypeJWT         = "jwt"
+	AuditActorTypeAdminAPIKey = "admin_api_key"
+)
+
+// AuditLog 管理员操作审计记录（只追加）
+type AuditLog struct {
+	ID          int64
+	ActorUserID *int64
+	ActorType   string
+	Action      string
+	TargetType  string
+	TargetID    string
+	Before      map[string]any
+	After       map[string]any
+	StatusCode  int
+	IPAddress   string
+	UserAgent   string
+	RequestID   string
+	CreatedAt   time.Time
+}
+
+// AuditLogFilter 审计日志查询条件，零值表示不过滤
+type AuditLogFilter struct {
+	ActorUserID *int64
+	ActorType   string
+	Action      string // 模糊匹配
+	TargetType  string
+	TargetID    string
+	StartTime   *time.Time
+	EndTime     *time.Time
+}
+
+// AuditLogRepository 审计日志持久层接口，只提供追加与查询
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *AuditLog) error
+	List(ctx context.Context, params pagination.PaginationParams, filter AuditLogFilter) ([]AuditLog, *pagination.PaginationResult, error)
+	// ListBeforeID 按 id 倒序返回 id < beforeID 的记录（beforeID<=0 表示从最新开始），用于导出时游标分页
+	ListBeforeID(ctx context.Context, filter AuditLogFilter, beforeID int64, limit int) ([]AuditLog, error)
+}
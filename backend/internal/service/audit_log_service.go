@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/util/logredact"
+)
+
+const (
+	auditLogWriteTimeout   = 5 * time.Second
+	auditLogExportBatch    = 500
+	auditLogExportMaxRows  = 100000
+	auditLogMaxStringField = 255
+)
+
+// auditSensitiveKeys 在 logredact 默认列表之外额外脱敏的字段
+var auditSensitiveKeys = []string{
+	"api_key",
+	"key",
+	"secret",
+	"token",
+	"credentials",
+	"session_key",
+	"cookie",
+	"new_password",
+	"old_password",
+	"smtp_password",
+	"turnstile_secret_key",
+	"linuxdo_connect_client_secret",
+	"creem_api_key",
+	"creem_webhook_secret",
+	"stripe_secret_key",
+	"stripe_webhook_secret",
+	"epay_merchant_key",
+	"webhook_secret",
+	"bot_token",
+	"totp_secret",
+}
+
+// AuditLogService 管理员操作审计日志服务
+type AuditLogService struct {
+	repo AuditLogRepository
+}
+
+// NewAuditLogService 创建审计日志服务
+func NewAuditLogService(repo AuditLogRepository) *AuditLogService {
+	return &AuditLogService{repo: repo}
+}
+
+// Record 写入一条审计记录。
+// before/after 会先去掉未变化的字段再脱敏；写入失败只记录日志，不影响业务请求。
+func (s *AuditLogService) Record(ctx context.Context, entry *AuditLog) {
+	if s == nil || s.repo == nil || entry == nil || entry.Action == "" {
+		return
+	}
+
+	entry.Before, entry.After = diffAuditSnapshots(entry.Before, entry.After)
+	if entry.Before != nil {
+		entry.Before = logredact.RedactMap(entry.Before, auditSensitiveKeys...)
+	}
+	if entry.After != nil {
+		entry.After = logredact.RedactMap(entry.After, auditSensitiveKeys...)
+	}
+	entry.Action = truncateString(entry.Action, auditLogMaxStringField)
+	entry.TargetID = truncateString(entry.TargetID, 64)
+	entry.UserAgent = truncateString(entry.UserAgent, 512)
+	entry.RequestID = truncateString(entry.RequestID, 64)
+
+	// 请求可能已结束或被取消，审计写入不应随之失败
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditLogWriteTimeout)
+	defer cancel()
+	if err := s.repo.Create(writeCtx, entry); err != nil {
+		log.Printf("[AuditLog] failed to record action=%s actor_user_id=%v: %v", entry.Action, derefInt64(entry.ActorUserID), err)
+	}
+}
+
+// List 分页查询审计日志
+func (s *AuditLogService) List(ctx context.Context, params pagination.PaginationParams, filter AuditLogFilter) ([]AuditLog, *pagination.PaginationResult, error) {
+	return s.repo.List(ctx, params, filter)
+}
+
+// Export 按 id 倒序遍历符合条件的审计日志，最多 auditLogExportMaxRows 条
+func (s *AuditLogService) Export(ctx context.Context, filter AuditLogFilter, fn func(*AuditLog) error) error {
+	var cursor int64
+	exported := 0
+	for exported < auditLogExportMaxRows {
+		limit := auditLogExportBatch
+		if remaining := auditLogExportMaxRows - exported; remaining < limit {
+			limit = remaining
+		}
+		batch, err := s.repo.ListBeforeID(ctx, filter, cursor, limit)
+		if err != nil {
+			return err
+		}
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		exported += len(batch)
+		if len(batch) < limit {
+			return nil
+		}
+		cursor = batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// diffAuditSnapshots 两侧都有快照时只保留发生变化的字段
+func diffAuditSnapshots(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+	b := make(map[string]any, len(before))
+	a := make(map[string]any, len(after))
+	for k, v := range before {
+		if av, ok := after[k]; ok && reflect.DeepEqual(v, av) {
+			continue
+		}
+		b[k] = v
+	}
+	for k, v := range after {
+		if bv, ok := before[k]; ok && reflect.DeepEqual(v, bv) {
+			continue
+		}
+		a[k] = v
+	}
+	return b, a
+}
+
+func derefInt64(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
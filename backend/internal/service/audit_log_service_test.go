@@ -0,0 +1,68 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type auditLogRepoPager struct {
+	ids     []int64
+	cursors []int64
+}
+
+func (r *auditLogRepoPager) Create(context.Context, *AuditLog) error { return nil }
+
+func (r *auditLogRepoPager) List(context.Context, pagination.PaginationParams, AuditLogFilter) ([]AuditLog, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (r *auditLogRepoPager) ListBeforeID(_ context.Context, _ AuditLogFilter, beforeID int64, limit int) ([]AuditLog, error) {
+	r.cursors = append(r.cursors, beforeID)
+	out := make([]AuditLog, 0, limit)
+	for _, id := range r.ids {
+		if beforeID > 0 && id >= beforeID {
+			continue
+		}
+		out = append(out, AuditLog{ID: id})
+		if len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func TestAuditLogService_ExportPagesByCursor(t *testing.T) {
+	ids := make([]int64, 0, auditLogExportBatch+3)
+	for id := int64(auditLogExportBatch + 3); id >= 1; id-- {
+		ids = append(ids, id)
+	}
+	repo := &auditLogRepoPager{ids: ids}
+	svc := NewAuditLogService(repo)
+
+	var got []int64
+	err := svc.Export(context.Background(), AuditLogFilter{}, func(l *AuditLog) error {
+		got = append(got, l.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ids, got)
+	require.Equal(t, []int64{0, 4}, repo.cursors)
+}
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	before, after := diffAuditSnapshots(
+		map[string]any{"a": 1, "b": "x", "gone": true},
+		map[string]any{"a": 2, "b": "x", "new": "y"},
+	)
+	require.Equal(t, map[string]any{"a": 1, "gone": true}, before)
+	require.Equal(t, map[string]any{"a": 2, "new": "y"}, after)
+
+	before, after = diffAuditSnapshots(nil, map[string]any{"k": 1})
+	require.Nil(t, before)
+	require.Equal(t, map[string]any{"k": 1}, after)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 余额流水来源类型
+const (
+	BalanceSourceUsage               = "usage"                // 网关请求扣费，reference 为 request_id
+	BalanceSourceAdminAdjustment     = "admin_adjustment"     // 管理员调整，reference 为调整记录码
+	BalanceSourceRedeemCode          = "redeem_code"          // 兑换码，reference 为兑换码
+	BalanceSourcePromoCode           = "promo_code"           // 优惠码，reference 为优惠码
+	BalanceSourceCreemCheckout       = "creem_checkout"       // Creem 支付回调（充值订单上线前），reference 为 checkout ID
+	BalanceSourcePaymentOrder        = "payment_order"        // 充值订单支付成功，reference 为订单号
+	BalanceSourcePaymentRefund       = "payment_refund"       // 充值订单退款扣回，reference 为订单号
+	BalanceSourceSubscriptionRenewal = "subscription_renewal" // 订阅套餐自动续费扣款，reference 为订阅 ID
+	BalanceSourceInitial             = "initial"              // 注册/创建用户时的初始余额
+	BalanceSourceOpeningBalance      = "opening_balance"      // 启用账本前已有的余额（迁移写入）
+)
+
+// BalanceChangeSource 描述一次余额变动的来源，随余额更新一起写入账本
+type BalanceChangeSource struct {
+	Type        string
+	ReferenceID string
+	ActorUserID *int64
+	Notes       string
+}
+
+// BalanceTransaction 用户余额流水（只追加）
+type BalanceTransaction struct {
+	ID           int64
+	UserID       int64
+	Amount       float64
+	BalanceAfter float64
+	SourceType   string
+	ReferenceID  string
+	ActorUserID  *int64
+	Notes        string
+	CreatedAt    time.Time
+}
+
+// BalanceTransactionFilter 余额流水查询条件，零值表示不过滤
+type BalanceTransactionFilter struct {
+	UserID     int64
+	SourceType string
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+// BalanceLedgerDrift 账本合计与 users.balance 不一致的用户
+type BalanceLedgerDrift struct {
+	UserID    int64   `json:"user_id"`
+	Email     string  `json:"email"`
+	Balance   float64 `json:"balance"`
+	LedgerSum float64 `json:"ledger_sum"`
+	Drift     float64 `json:"drift"`
+}
+
+// BalanceLedgerReconcileReport 一次对账的结果
+type BalanceLedgerReconcileReport struct {
+	CheckedAt  time.Time `json:"checked_at"`
+	DriftUsers int       `json:"drift_users"`
+	// TotalDrift 报告中所列用户（最多 balanceLedgerReconcileMaxDrifts 个）的偏差合计
+	TotalDrift float64              `json:"total_drift"`
+	Drifts     []BalanceLedgerDrift `json:"drifts"`
+}
+
+// BalanceLedgerRepository 余额流水查询与对账。
+// 流水由 UserRepository 在更新余额时同步写入，这里不提供写接口。
+type BalanceLedgerRepository interface {
+	List(ctx context.Context, params pagination.PaginationParams, filter BalanceTransactionFilter) ([]BalanceTransaction, *pagination.PaginationResult, error)
+	// ListBeforeID 按 id 倒序返回 id < beforeID 的记录（beforeID<=0 表示从最新开始），用于导出时游标分页
+	ListBeforeID(ctx context.Context, filter BalanceTransactionFilter, beforeID int64, limit int) ([]BalanceTransaction, error)
+	// FindDrifts 返回账本合计与余额相差超过 tolerance 的未删除用户（按偏差绝对值倒序，最多 limit 条）及总数
+	FindDrifts(ctx context.Context, tolerance float64, limit int) ([]BalanceLedgerDrift, int, error)
+	// SumAdjustmentsByActor 返回操作人自 since 起管理员余额调整金额的绝对值合计
+	SumAdjustmentsByActor(ctx context.Context, actorUserID int64, since time.Time) (float64, error)
+	// WithAdjustmentQuotaLock 在事务内锁定操作人后执行 fn；fn 收到的 ctx 携带该事务，额度统计与调整写入须使用它
+	WithAdjustmentQuotaLock(ctx context.Context, actorUserID int64, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	balanceLedgerExportBatch   = 500
+	balanceLedgerExportMaxRows = 100000
+
+	// balanceLedgerDriftTolerance 余额与流水均为 decimal(20,8)，正常情况下应严格相等
+	balanceLedgerDriftTolerance = 0.000001
+	// balanceLedgerReconcileMaxDrifts 对账报告中最多列出的用户数
+	balanceLedgerReconcileMaxDrifts = 100
+	// balanceLedgerReconcileInterval 告警评估按分钟调用，对账结果在进程内缓存，避免频繁全表聚合
+	balanceLedgerReconcileInterval = 10 * time.Minute
+	// balanceAdjustQuotaWindow 受限角色（如客服）余额调整累计额度的滚动统计窗口
+	balanceAdjustQuotaWindow = 24 * time.Hour
+)
+
+// ErrBalanceAdjustQuotaExceeded 操作人在统计窗口内的余额调整累计金额超出所属角色额度
+var ErrBalanceAdjustQuotaExceeded = infraerrors.Forbidden("BALANCE_ADJUST_QUOTA_EXCEEDED", "balance adjustment exceeds the 24h limit for your role")
+
+// BalanceLedgerService 余额流水查询、导出与对账
+type BalanceLedgerService struct {
+	repo BalanceLedgerRepository
+
+	mu         sync.Mutex
+	lastReport *BalanceLedgerReconcileReport
+}
+
+// NewBalanceLedgerService 创建余额流水服务
+func NewBalanceLedgerService(repo BalanceLedgerRepository) *BalanceLedgerService {
+	return &BalanceLedgerService{repo: repo}
+}
+
+// ListByUser 分页查询指定用户的余额流水
+func (s *BalanceLedgerService) ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, filter BalanceTransactionFilter) ([]BalanceTransaction, *pagination.PaginationResult, error) {
+	filter.UserID = userID
+	return s.repo.List(ctx, params, filter)
+}
+
+// ExportByUser 按 id 倒序遍历指定用户的余额流水，最多 balanceLedgerExportMaxRows 条
+func (s *BalanceLedgerService) ExportByUser(ctx context.Context, userID int64, filter BalanceTransactionFilter, fn func(*BalanceTransaction) error) error {
+	filter.UserID = userID
+	var cursor int64
+	exported := 0
+	for exported < balanceLedgerExportMaxRows {
+		limit := balanceLedgerExportBatch
+		if remaining := balanceLedgerExportMaxRows - exported; remaining < limit {
+			limit = remaining
+		}
+		batch, err := s.repo.ListBeforeID(ctx, filter, cursor, limit)
+		if err != nil {
+			return err
+		}
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		exported += len(batch)
+		if len(batch) < limit {
+			return nil
+		}
+		cursor = batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// AdjustWithinQuota 校验操作人近 24 小时的管理员余额调整累计金额（按绝对值）加上本次 amount
+// 不超过 limit，通过后执行 adjust。调整流水随余额更新写入账本，因此额度按账本滚动统计。
+//
+// 额度按实际操作人统计（JWT 登录的管理员，或 Admin API Key 的创建者）；校验与写入在同一数据库事务内
+// 且锁定操作人，多实例部署下同样不会被并发请求绕过。adjust 必须使用传入的 ctx 以加入该事务。
+func (s *BalanceLedgerService) AdjustWithinQuota(ctx context.Context, actorUserID int64, amount, limit float64, adjust func(ctx context.Context) error) error {
+	if amount > limit {
+		return ErrBalanceAdjustQuotaExceeded
+	}
+
+	return s.repo.WithAdjustmentQuotaLock(ctx, actorUserID, func(txCtx context.Context) error {
+		used, err := s.repo.SumAdjustmentsByActor(txCtx, actorUserID, time.Now().Add(-balanceAdjustQuotaWindow))
+		if err != nil {
+			return err
+		}
+		if used+amount > limit+balanceLedgerDriftTolerance {
+			return ErrBalanceAdjustQuotaExceeded.WithMetadata(map[string]string{
+				"used":  strconv.FormatFloat(used, 'f', 2, 64),
+				"limit": strconv.FormatFloat(limit, 'f', 2, 64),
+			})
+		}
+		return adjust(txCtx)
+	})
+}
+
+// Reconcile 比对每个用户的流水合计与 users.balance，返回不一致的用户
+func (s *BalanceLedgerService) Reconcile(ctx context.Context) (*BalanceLedgerReconcileReport, error) {
+	drifts, total, err := s.repo.FindDrifts(ctx, balanceLedgerDriftTolerance, balanceLedgerReconcileMaxDrifts)
+	if err != nil {
+		return nil, err
+	}
+	report := &BalanceLedgerReconcileReport{
+		CheckedAt:  time.Now().UTC(),
+		DriftUsers: total,
+		Drifts:     drifts,
+	}
+	if report.Drifts == nil {
+		report.Drifts = []BalanceLedgerDrift{}
+	}
+	for _, d := range drifts {
+		report.TotalDrift += d.Drift
+	}
+	if total > 0 {
+		log.Printf("[BalanceLedger] reconcile found %d user(s) with drift, total_drift(top %d)=%.8f", total, len(drifts), report.TotalDrift)
+	}
+
+	s.mu.Lock()
+	s.lastReport = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+// DriftUserCount 返回最近一次对账发现的不一致用户数；结果超过 balanceLedgerReconcileInterval 时重新对账。
+// 供 ops 告警指标 balance_ledger_drift_users 使用。
+func (s *BalanceLedgerService) DriftUserCount(ctx context.Context) (int, bool) {
+	if s == nil || s.repo == nil {
+		return 0, false
+	}
+	s.mu.Lock()
+	last := s.lastReport
+	s.mu.Unlock()
+	if last != nil && time.Since(last.CheckedAt) < balanceLedgerReconcileInterval {
+		return last.DriftUsers, true
+	}
+
+	report, err := s.Reconcile(ctx)
+	if err != nil {
+		log.Printf("[BalanceLedger] reconcile failed: %v", err)
+		return 0, false
+	}
+	return report.DriftUsers, true
+}
@@ -0,0 +1,139 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceLedgerRepoStub struct {
+	ids        []int64
+	cursors    []int64
+	drifts     []BalanceLedgerDrift
+	driftCalls int
+	// adjusted 按操作人累计的调整金额（绝对值）
+	adjusted map[int64]float64
+	sumSince time.Time
+	// locked 记录 WithAdjustmentQuotaLock 锁定的操作人；inLock 标记 fn 是否在锁内执行
+	locked []int64
+	inLock bool
+}
+
+func (r *balanceLedgerRepoStub) List(context.Context, pagination.PaginationParams, BalanceTransactionFilter) ([]BalanceTransaction, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (r *balanceLedgerRepoStub) ListBeforeID(_ context.Context, filter BalanceTransactionFilter, beforeID int64, limit int) ([]BalanceTransaction, error) {
+	r.cursors = append(r.cursors, beforeID)
+	out := make([]BalanceTransaction, 0, limit)
+	for _, id := range r.ids {
+		if beforeID > 0 && id >= beforeID {
+			continue
+		}
+		out = append(out, BalanceTransaction{ID: id, UserID: filter.UserID})
+		if len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (r *balanceLedgerRepoStub) FindDrifts(context.Context, float64, int) ([]BalanceLedgerDrift, int, error) {
+	r.driftCalls++
+	return r.drifts, len(r.drifts), nil
+}
+
+func (r *balanceLedgerRepoStub) SumAdjustmentsByActor(_ context.Context, actorUserID int64, since time.Time) (float64, error) {
+	r.sumSince = since
+	return r.adjusted[actorUserID], nil
+}
+
+type quotaTxKey struct{}
+
+func (r *balanceLedgerRepoStub) WithAdjustmentQuotaLock(ctx context.Context, actorUserID int64, fn func(ctx context.Context) error) error {
+	r.locked = append(r.locked, actorUserID)
+	r.inLock = true
+	defer func() { r.inLock = false }()
+	return fn(context.WithValue(ctx, quotaTxKey{}, actorUserID))
+}
+
+func TestBalanceLedgerService_ExportByUserPagesByCursor(t *testing.T) {
+	ids := make([]int64, 0, balanceLedgerExportBatch+2)
+	for id := int64(balanceLedgerExportBatch + 2); id >= 1; id-- {
+		ids = append(ids, id)
+	}
+	repo := &balanceLedgerRepoStub{ids: ids}
+	svc := NewBalanceLedgerService(repo)
+
+	var got []int64
+	err := svc.ExportByUser(context.Background(), 42, BalanceTransactionFilter{UserID: 1}, func(tx *BalanceTransaction) error {
+		require.Equal(t, int64(42), tx.UserID)
+		got = append(got, tx.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ids, got)
+	require.Equal(t, []int64{0, 3}, repo.cursors)
+}
+
+func TestBalanceLedgerService_DriftUserCountCachesReport(t *testing.T) {
+	repo := &balanceLedgerRepoStub{drifts: []BalanceLedgerDrift{
+		{UserID: 1, Balance: 10, LedgerSum: 8, Drift: 2},
+		{UserID: 2, Balance: 5, LedgerSum: 5.5, Drift: -0.5},
+	}}
+	svc := NewBalanceLedgerService(repo)
+
+	count, ok := svc.DriftUserCount(context.Background())
+	require.True(t, ok)
+	require.Equal(t, 2, count)
+	require.InDelta(t, 1.5, svc.lastReport.TotalDrift, 1e-9)
+
+	// 缓存期内不重复对账
+	count, ok = svc.DriftUserCount(context.Background())
+	require.True(t, ok)
+	require.Equal(t, 2, count)
+	require.Equal(t, 1, repo.driftCalls)
+
+	// 缓存过期后重新对账
+	repo.drifts = nil
+	svc.lastReport.CheckedAt = time.Now().Add(-balanceLedgerReconcileInterval - time.Second)
+	count, ok = svc.DriftUserCount(context.Background())
+	require.True(t, ok)
+	require.Equal(t, 0, count)
+	require.Equal(t, 2, repo.driftCalls)
+	require.NotNil(t, svc.lastReport.Drifts)
+}
+
+func TestBalanceLedgerService_AdjustWithinQuotaIsCumulative(t *testing.T) {
+	repo := &balanceLedgerRepoStub{adjusted: map[int64]float64{}}
+	svc := NewBalanceLedgerService(repo)
+	adjust := func(actor int64, amount float64) error {
+		return svc.AdjustWithinQuota(context.Background(), actor, amount, 50, func(ctx context.Context) error {
+			// 调整写入必须在锁定操作人的同一事务内执行
+			require.True(t, repo.inLock)
+			require.Equal(t, actor, ctx.Value(quotaTxKey{}))
+			repo.adjusted[actor] += amount
+			return nil
+		})
+	}
+
+	// 单次均未超过 50，但同一操作人累计超过后拒绝
+	require.NoError(t, adjust(7, 20))
+	require.NoError(t, adjust(7, 20))
+	require.ErrorIs(t, adjust(7, 20), ErrBalanceAdjustQuotaExceeded)
+	require.InDelta(t, 40, repo.adjusted[7], 1e-9)
+	require.NoError(t, adjust(7, 10))
+	require.ErrorIs(t, adjust(7, 0.01), ErrBalanceAdjustQuotaExceeded)
+	require.WithinDuration(t, time.Now().Add(-balanceAdjustQuotaWindow), repo.sumSince, time.Minute)
+
+	// 额度按操作人独立统计；单次超额直接拒绝
+	require.NoError(t, adjust(8, 50))
+	require.ErrorIs(t, adjust(9, 50.01), ErrBalanceAdjustQuotaExceeded)
+	require.Zero(t, repo.adjusted[9])
+	require.NotContains(t, repo.locked, int64(9), "single adjustments over the limit are rejected before locking")
+}
@@ -13,3 +13,18 @@ type SubscriptionCacheData struct {
 	MonthlyUsage float64
 	Version      int64
 }
+
+// APIKeyUsageCacheData represents cached API key spending counters
+type APIKeyUsageCacheData struct {
+	TotalUsage         float64
+	DailyUsage         float64
+	DailyWindowStart   time.Time
+	MonthlyUsage       float64
+	MonthlyWindowStart time.Time
+}
+
+// OrgMemberUsageCacheData represents cached organization member monthly spending
+type OrgMemberUsageCacheData struct {
+	MonthlyUsage       float64
+	MonthlyWindowStart time.Time
+}
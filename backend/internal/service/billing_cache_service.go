@@ -10,6 +10,10 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // 错误定义
@@ -18,6 +22,10 @@ import (
 var (
 	ErrSubscriptionInvalid       = infraerrors.Forbidden("SUBSCRIPTION_INVALID", "subscription is invalid or expired")
 	ErrBillingServiceUnavailable = infraerrors.ServiceUnavailable("BILLING_SERVICE_ERROR", "Billing service temporarily unavailable. Please retry later.")
+
+	ErrAPIKeyQuotaExhausted       = infraerrors.Forbidden("API_KEY_QUOTA_EXHAUSTED", "api key spending quota exhausted")
+	ErrAPIKeyDailyLimitExceeded   = infraerrors.TooManyRequests("API_KEY_DAILY_LIMIT_EXCEEDED", "api key daily spending limit exceeded")
+	ErrAPIKeyMonthlyLimitExceeded = infraerrors.TooManyRequests("API_KEY_MONTHLY_LIMIT_EXCEEDED", "api key monthly spending limit exceeded")
 )
 
 // subscriptionCacheData 订阅缓存数据结构（内部使用）
@@ -38,6 +46,12 @@ const (
 	cacheWriteSetSubscription
 	cacheWriteUpdateSubscriptionUsage
 	cacheWriteDeductBalance
+	cacheWriteSetAPIKeyUsage
+	cacheWriteUpdateAPIKeyUsage
+	cacheWriteSetOrgBalance
+	cacheWriteDeductOrgBalance
+	cacheWriteSetOrgMemberUsage
+	cacheWriteUpdateOrgMemberUsage
 )
 
 // 异步缓存写入工作池配置
@@ -68,6 +82,12 @@ type cacheWriteTask struct {
 	balance          float64
 	amount           float64
 	subscriptionData *subscriptionCacheData
+	apiKeyID         int64
+	apiKeyUsage      *APIKeyUsageCacheData
+	dayStart         time.Time
+	monthStart       time.Time
+	organizationID   int64
+	orgMemberUsage   *OrgMemberUsageCacheData
 }
 
 // BillingCacheService 计费缓存服务
@@ -76,6 +96,8 @@ type BillingCacheService struct {
 	cache          BillingCache
 	userRepo       UserRepository
 	subRepo        UserSubscriptionRepository
+	apiKeyRepo     APIKeyRepository
+	orgRepo        OrganizationRepository
 	cfg            *config.Config
 	circuitBreaker *billingCircuitBreaker
 
@@ -90,12 +112,14 @@ type BillingCacheService struct {
 }
 
 // NewBillingCacheService 创建计费缓存服务
-func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, cfg *config.Config) *BillingCacheService {
+func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, apiKeyRepo APIKeyRepository, orgRepo OrganizationRepository, cfg *config.Config) *BillingCacheService {
 	svc := &BillingCacheService{
-		cache:    cache,
-		userRepo: userRepo,
-		subRepo:  subRepo,
-		cfg:      cfg,
+		cache:      cache,
+		userRepo:   userRepo,
+		subRepo:    subRepo,
+		apiKeyRepo: apiKeyRepo,
+		orgRepo:    orgRepo,
+		cfg:        cfg,
 	}
 	svc.circuitBreaker = newBillingCircuitBreaker(cfg.Billing.CircuitBreaker)
 	svc.startCacheWriteWorkers()
@@ -165,6 +189,30 @@ func (s *BillingCacheService) cacheWriteWorker() {
 					log.Printf("Warning: deduct balance cache failed for user %d: %v", task.userID, err)
 				}
 			}
+		case cacheWriteSetAPIKeyUsage:
+			s.setAPIKeyUsageCache(ctx, task.apiKeyID, task.apiKeyUsage)
+		case cacheWriteUpdateAPIKeyUsage:
+			if s.cache != nil {
+				if err := s.cache.UpdateAPIKeyUsage(ctx, task.apiKeyID, task.amount, task.dayStart, task.monthStart); err != nil {
+					log.Printf("Warning: update api key usage cache failed for key %d: %v", task.apiKeyID, err)
+				}
+			}
+		case cacheWriteSetOrgBalance:
+			s.setOrganizationBalanceCache(ctx, task.organizationID, task.balance)
+		case cacheWriteDeductOrgBalance:
+			if s.cache != nil {
+				if err := s.cache.DeductOrganizationBalance(ctx, task.organizationID, task.amount); err != nil {
+					log.Printf("Warning: deduct organization balance cache failed for org %d: %v", task.organizationID, err)
+				}
+			}
+		case cacheWriteSetOrgMemberUsage:
+			s.setOrgMemberUsageCache(ctx, task.userID, task.orgMemberUsage)
+		case cacheWriteUpdateOrgMemberUsage:
+			if s.cache != nil {
+				if err := s.cache.UpdateOrgMemberUsage(ctx, task.userID, task.amount, task.monthStart); err != nil {
+					log.Printf("Warning: update organization member usage cache failed for user %d: %v", task.userID, err)
+				}
+			}
 		}
 		cancel()
 	}
@@ -181,6 +229,18 @@ func cacheWriteKindName(kind cacheWriteKind) string {
 		return "update_subscription_usage"
 	case cacheWriteDeductBalance:
 		return "deduct_balance"
+	case cacheWriteSetAPIKeyUsage:
+		return "set_api_key_usage"
+	case cacheWriteUpdateAPIKeyUsage:
+		return "update_api_key_usage"
+	case cacheWriteSetOrgBalance:
+		return "set_org_balance"
+	case cacheWriteDeductOrgBalance:
+		return "deduct_org_balance"
+	case cacheWriteSetOrgMemberUsage:
+		return "set_org_member_usage"
+	case cacheWriteUpdateOrgMemberUsage:
+		return "update_org_member_usage"
 	default:
 		return "unknown"
 	}
@@ -203,6 +263,7 @@ func (s *BillingCacheService) logCacheWriteDrop(task cacheWriteTask, reason stri
 		return
 	}
 
+	metrics.IncBillingCacheWriteDrop(reason)
 	atomic.AddUint64(countPtr, 1)
 	now := time.Now().UnixNano()
 	last := atomic.LoadInt64(lastPtr)
@@ -441,32 +502,428 @@ func (s *BillingCacheService) InvalidateSubscription(ctx context.Context, userID
 	return nil
 }
 
+// ============================================
+// API Key 花费上限缓存方法
+// ============================================
+
+// GetAPIKeyUsage 获取 API Key 当前用量（优先从缓存读取），日/月窗口已过期的用量视为 0
+func (s *BillingCacheService) GetAPIKeyUsage(ctx context.Context, apiKeyID int64) (*APIKeyUsageCacheData, error) {
+	now := time.Now()
+	if s.cache != nil {
+		data, err := s.cache.GetAPIKeyUsageCache(ctx, apiKeyID)
+		if err == nil && data != nil {
+			return normalizeAPIKeyUsage(data, now), nil
+		}
+	}
+
+	// 缓存未命中，从数据库读取
+	key, err := s.apiKeyRepo.GetByID(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("get api key usage: %w", err)
+	}
+	data := apiKeyUsageFromKey(key, now)
+
+	if s.cache != nil {
+		// 异步建立缓存
+		_ = s.enqueueCacheWrite(cacheWriteTask{
+			kind:        cacheWriteSetAPIKeyUsage,
+			apiKeyID:    apiKeyID,
+			apiKeyUsage: data,
+		})
+	}
+
+	return data, nil
+}
+
+// apiKeyUsageFromKey 将数据库中的用量字段转换为缓存结构，过期窗口归零
+func apiKeyUsageFromKey(key *APIKey, now time.Time) *APIKeyUsageCacheData {
+	return &APIKeyUsageCacheData{
+		TotalUsage:         key.QuotaUsedUSD,
+		DailyUsage:         key.CurrentDailyUsage(now),
+		DailyWindowStart:   timezone.StartOfDay(now),
+		MonthlyUsage:       key.CurrentMonthlyUsage(now),
+		MonthlyWindowStart: timezone.StartOfMonth(now),
+	}
+}
+
+// normalizeAPIKeyUsage 处理缓存中跨天/跨月但尚未被累加脚本滚动的窗口
+func normalizeAPIKeyUsage(data *APIKeyUsageCacheData, now time.Time) *APIKeyUsageCacheData {
+	out := *data
+	if out.DailyWindowStart.Before(timezone.StartOfDay(now)) {
+		out.DailyUsage = 0
+	}
+	if out.MonthlyWindowStart.Before(timezone.StartOfMonth(now)) {
+		out.MonthlyUsage = 0
+	}
+	return &out
+}
+
+// setAPIKeyUsageCache 设置 API Key 用量缓存
+func (s *BillingCacheService) setAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyUsageCacheData) {
+	if s.cache == nil || data == nil {
+		return
+	}
+	if err := s.cache.SetAPIKeyUsageCache(ctx, apiKeyID, data); err != nil {
+		log.Printf("Warning: set api key usage cache failed for key %d: %v", apiKeyID, err)
+	}
+}
+
+// QueueUpdateAPIKeyUsage 异步累加 API Key 用量缓存
+func (s *BillingCacheService) QueueUpdateAPIKeyUsage(apiKeyID int64, costUSD float64) {
+	if s.cache == nil {
+		return
+	}
+	now := time.Now()
+	task := cacheWriteTask{
+		kind:       cacheWriteUpdateAPIKeyUsage,
+		apiKeyID:   apiKeyID,
+		amount:     costUSD,
+		dayStart:   timezone.StartOfDay(now),
+		monthStart: timezone.StartOfMonth(now),
+	}
+	// 队列满时同步回退，避免上限被绕过。
+	if s.enqueueCacheWrite(task) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+	defer cancel()
+	if err := s.cache.UpdateAPIKeyUsage(ctx, apiKeyID, costUSD, task.dayStart, task.monthStart); err != nil {
+		log.Printf("Warning: update api key usage cache fallback failed for key %d: %v", apiKeyID, err)
+	}
+}
+
+// recordAPIKeyUsage 在 RecordUsage 中累加 API Key 用量：先写数据库，再异步更新缓存
+func recordAPIKeyUsage(ctx context.Context, repo APIKeyRepository, billingCache *BillingCacheService, apiKeyID int64, costUSD float64) {
+	if repo != nil {
+		now := time.Now()
+		if err := repo.IncrementUsage(ctx, apiKeyID, costUSD, timezone.StartOfDay(now), timezone.StartOfMonth(now)); err != nil {
+			log.Printf("Increment api key usage failed for key %d: %v", apiKeyID, err)
+		}
+	}
+	if billingCache != nil {
+		billingCache.QueueUpdateAPIKeyUsage(apiKeyID, costUSD)
+	}
+}
+
+// InvalidateAPIKeyUsage 失效 API Key 用量缓存
+func (s *BillingCacheService) InvalidateAPIKeyUsage(ctx context.Context, apiKeyID int64) error {
+	if s.cache == nil {
+		return nil
+	}
+	if err := s.cache.InvalidateAPIKeyUsageCache(ctx, apiKeyID); err != nil {
+		log.Printf("Warning: invalidate api key usage cache failed for key %d: %v", apiKeyID, err)
+		return err
+	}
+	return nil
+}
+
+// ============================================
+// 组织钱包缓存方法
+// ============================================
+
+// GetOrganizationBalance 获取组织余额（优先从缓存读取）
+func (s *BillingCacheService) GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error) {
+	if s.cache != nil {
+		if balance, err := s.cache.GetOrganizationBalance(ctx, orgID); err == nil {
+			return balance, nil
+		}
+	}
+
+	// 缓存未命中，从数据库读取
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return 0, fmt.Errorf("get organization balance: %w", err)
+	}
+
+	if s.cache != nil {
+		// 异步建立缓存
+		_ = s.enqueueCacheWrite(cacheWriteTask{
+			kind:           cacheWriteSetOrgBalance,
+			organizationID: orgID,
+			balance:        org.Balance,
+		})
+	}
+	return org.Balance, nil
+}
+
+// setOrganizationBalanceCache 设置组织余额缓存
+func (s *BillingCacheService) setOrganizationBalanceCache(ctx context.Context, orgID int64, balance float64) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.SetOrganizationBalance(ctx, orgID, balance); err != nil {
+		log.Printf("Warning: set organization balance cache failed for org %d: %v", orgID, err)
+	}
+}
+
+// QueueDeductOrganizationBalance 异步扣减组织余额缓存
+func (s *BillingCacheService) QueueDeductOrganizationBalance(orgID int64, amount float64) {
+	if s.cache == nil {
+		return
+	}
+	// 队列满时同步回退，避免关键扣减被静默丢弃。
+	if s.enqueueCacheWrite(cacheWriteTask{
+		kind:           cacheWriteDeductOrgBalance,
+		organizationID: orgID,
+		amount:         amount,
+	}) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+	defer cancel()
+	if err := s.cache.DeductOrganizationBalance(ctx, orgID, amount); err != nil {
+		log.Printf("Warning: deduct organization balance cache fallback failed for org %d: %v", orgID, err)
+	}
+}
+
+// InvalidateOrganizationBalance 失效组织余额缓存
+func (s *BillingCacheService) InvalidateOrganizationBalance(ctx context.Context, orgID int64) error {
+	if s.cache == nil {
+		return nil
+	}
+	if err := s.cache.InvalidateOrganizationBalance(ctx, orgID); err != nil {
+		log.Printf("Warning: invalidate organization balance cache failed for org %d: %v", orgID, err)
+		return err
+	}
+	return nil
+}
+
+// GetOrgMemberUsage 获取组织成员当月花费（优先从缓存读取），跨月的用量视为 0
+func (s *BillingCacheService) GetOrgMemberUsage(ctx context.Context, userID int64) (*OrgMemberUsageCacheData, error) {
+	now := time.Now()
+	monthStart := timezone.StartOfMonth(now)
+	if s.cache != nil {
+		data, err := s.cache.GetOrgMemberUsageCache(ctx, userID)
+		if err == nil && data != nil {
+			out := *data
+			if out.MonthlyWindowStart.Before(monthStart) {
+				out.MonthlyUsage = 0
+			}
+			return &out, nil
+		}
+	}
+
+	// 缓存未命中，从数据库读取
+	member, err := s.orgRepo.GetMemberByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get organization member usage: %w", err)
+	}
+	data := &OrgMemberUsageCacheData{
+		MonthlyUsage:       member.CurrentMonthlyUsage(now),
+		MonthlyWindowStart: monthStart,
+	}
+
+	if s.cache != nil {
+		// 异步建立缓存
+		_ = s.enqueueCacheWrite(cacheWriteTask{
+			kind:           cacheWriteSetOrgMemberUsage,
+			userID:         userID,
+			orgMemberUsage: data,
+		})
+	}
+	return data, nil
+}
+
+// setOrgMemberUsageCache 设置组织成员花费缓存
+func (s *BillingCacheService) setOrgMemberUsageCache(ctx context.Context, userID int64, data *OrgMemberUsageCacheData) {
+	if s.cache == nil || data == nil {
+		return
+	}
+	if err := s.cache.SetOrgMemberUsageCache(ctx, userID, data); err != nil {
+		log.Printf("Warning: set organization member usage cache failed for user %d: %v", userID, err)
+	}
+}
+
+// QueueUpdateOrgMemberUsage 异步累加组织成员花费缓存
+func (s *BillingCacheService) QueueUpdateOrgMemberUsage(userID int64, costUSD float64) {
+	if s.cache == nil {
+		return
+	}
+	task := cacheWriteTask{
+		kind:       cacheWriteUpdateOrgMemberUsage,
+		userID:     userID,
+		amount:     costUSD,
+		monthStart: timezone.StartOfMonth(time.Now()),
+	}
+	// 队列满时同步回退，避免成员上限被绕过。
+	if s.enqueueCacheWrite(task) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+	defer cancel()
+	if err := s.cache.UpdateOrgMemberUsage(ctx, userID, costUSD, task.monthStart); err != nil {
+		log.Printf("Warning: update organization member usage cache fallback failed for user %d: %v", userID, err)
+	}
+}
+
+// InvalidateOrgMemberUsage 失效组织成员花费缓存
+func (s *BillingCacheService) InvalidateOrgMemberUsage(ctx context.Context, userID int64) error {
+	if s.cache == nil {
+		return nil
+	}
+	if err := s.cache.InvalidateOrgMemberUsageCache(ctx, userID); err != nil {
+		log.Printf("Warning: invalidate organization member usage cache failed for user %d: %v", userID, err)
+		return err
+	}
+	return nil
+}
+
+// recordOrganizationUsage 在 RecordUsage 中从组织钱包扣费并累加成员月度花费：先写数据库，再异步更新缓存
+func recordOrganizationUsage(ctx context.Context, repo OrganizationRepository, billingCache *BillingCacheService, orgID, userID int64, costUSD float64, requestID string) {
+	if repo != nil {
+		source := BalanceChangeSource{Type: BalanceSourceUsage, ReferenceID: requestID, ActorUserID: &userID}
+		if err := repo.DeductBalance(ctx, orgID, costUSD, source); err != nil {
+			log.Printf("Deduct organization balance failed for org %d: %v", orgID, err)
+		}
+	}
+	if billingCache != nil {
+		billingCache.QueueDeductOrganizationBalance(orgID, costUSD)
+	}
+	recordOrgMemberUsage(ctx, repo, billingCache, userID, costUSD)
+}
+
+// recordOrgMemberUsage 累加组织成员月度花费（组织钱包扣费与共享 owner 订阅的用量都计入）
+func recordOrgMemberUsage(ctx context.Context, repo OrganizationRepository, billingCache *BillingCacheService, userID int64, costUSD float64) {
+	if repo != nil {
+		if err := repo.IncrementMemberUsage(ctx, userID, costUSD, timezone.StartOfMonth(time.Now())); err != nil {
+			log.Printf("Increment organization member usage failed for user %d: %v", userID, err)
+		}
+	}
+	if billingCache != nil {
+		billingCache.QueueUpdateOrgMemberUsage(userID, costUSD)
+	}
+}
+
+// usesSharedSubscription 组织成员是否正在使用 owner 名下的共享订阅
+func usesSharedSubscription(user *User, subscription *UserSubscription) bool {
+	return user.Organization != nil && subscription != nil && subscription.UserID > 0 && subscription.UserID != user.ID
+}
+
 // ============================================
 // 统一检查方法
 // ============================================
 
 // CheckBillingEligibility 检查用户是否有资格发起请求
-// 余额模式：检查缓存余额 > 0
+// 余额模式：检查缓存余额 > 0；组织成员检查组织钱包余额与成员月度上限
 // 订阅模式：检查缓存用量未超过限额（Group限额从参数传入）
-func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription) error {
+func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription) (err error) {
 	// 简易模式：跳过所有计费检查
 	if s.cfg.RunMode == config.RunModeSimple {
 		return nil
 	}
+	ctx, span := tracing.Start(ctx, "billing.check_eligibility",
+		attribute.Bool("billing.subscription_mode", group != nil && group.IsSubscriptionType() && subscription != nil),
+	)
+	defer func() { tracing.End(span, err) }()
 	if s.circuitBreaker != nil && !s.circuitBreaker.Allow() {
 		return ErrBillingServiceUnavailable
 	}
 
+	// API Key 级别的花费上限（与计费模式无关）
+	if apiKey != nil && apiKey.HasSpendingLimit() {
+		if err := s.checkAPIKeyLimits(ctx, apiKey); err != nil {
+			return err
+		}
+	}
+
 	// 判断计费模式
 	isSubscriptionMode := group != nil && group.IsSubscriptionType() && subscription != nil
 
 	if isSubscriptionMode {
-		return s.checkSubscriptionEligibility(ctx, user.ID, group, subscription)
+		// 组织成员可能使用 owner 名下的订阅，用量缓存按订阅持有者记录；共享订阅同样受成员月度上限约束
+		if usesSharedSubscription(user, subscription) {
+			if err := s.checkOrgMemberLimit(ctx, user.ID, user.Organization); err != nil {
+				return err
+			}
+		}
+		return s.checkSubscriptionEligibility(ctx, subscriptionHolderID(user, subscription), group, subscription)
+	}
+
+	if user.Organization != nil {
+		return s.checkOrganizationEligibility(ctx, user.ID, user.Organization)
 	}
 
 	return s.checkBalanceEligibility(ctx, user.ID)
 }
 
+// subscriptionHolderID 返回订阅持有者用户 ID
+func subscriptionHolderID(user *User, subscription *UserSubscription) int64 {
+	if subscription != nil && subscription.UserID > 0 {
+		return subscription.UserID
+	}
+	return user.ID
+}
+
+// checkOrganizationEligibility 检查组织钱包余额与成员月度花费上限
+func (s *BillingCacheService) checkOrganizationEligibility(ctx context.Context, userID int64, membership *OrganizationMembership) error {
+	if !membership.IsActive() {
+		return ErrOrganizationDisabled
+	}
+
+	balance, err := s.GetOrganizationBalance(ctx, membership.OrganizationID)
+	if err != nil {
+		if s.circuitBreaker != nil {
+			s.circuitBreaker.OnFailure(err)
+		}
+		log.Printf("ALERT: billing organization balance check failed for org %d: %v", membership.OrganizationID, err)
+		return ErrBillingServiceUnavailable.WithCause(err)
+	}
+	if balance <= 0 {
+		return ErrInsufficientBalance
+	}
+
+	if err := s.checkOrgMemberLimit(ctx, userID, membership); err != nil {
+		return err
+	}
+
+	if s.circuitBreaker != nil {
+		s.circuitBreaker.OnSuccess()
+	}
+	return nil
+}
+
+// checkOrgMemberLimit 检查组织成员月度花费上限
+func (s *BillingCacheService) checkOrgMemberLimit(ctx context.Context, userID int64, membership *OrganizationMembership) error {
+	if membership.MonthlyLimitUSD == nil || *membership.MonthlyLimitUSD <= 0 {
+		return nil
+	}
+	usage, err := s.GetOrgMemberUsage(ctx, userID)
+	if err != nil {
+		if s.circuitBreaker != nil {
+			s.circuitBreaker.OnFailure(err)
+		}
+		log.Printf("ALERT: billing organization member limit check failed for user %d: %v", userID, err)
+		return ErrBillingServiceUnavailable.WithCause(err)
+	}
+	if usage.MonthlyUsage >= *membership.MonthlyLimitUSD {
+		return ErrOrgMemberMonthlyLimitReached
+	}
+	return nil
+}
+
+// checkAPIKeyLimits 检查 API Key 的总/日/月花费上限
+func (s *BillingCacheService) checkAPIKeyLimits(ctx context.Context, apiKey *APIKey) error {
+	usage, err := s.GetAPIKeyUsage(ctx, apiKey.ID)
+	if err != nil {
+		if s.circuitBreaker != nil {
+			s.circuitBreaker.OnFailure(err)
+		}
+		log.Printf("ALERT: billing api key limit check failed for key %d: %v", apiKey.ID, err)
+		return ErrBillingServiceUnavailable.WithCause(err)
+	}
+
+	if apiKey.HasQuota() && usage.TotalUsage >= *apiKey.QuotaUSD {
+		return ErrAPIKeyQuotaExhausted
+	}
+	if apiKey.HasDailyLimit() && usage.DailyUsage >= *apiKey.DailyLimitUSD {
+		return ErrAPIKeyDailyLimitExceeded
+	}
+	if apiKey.HasMonthlyLimit() && usage.MonthlyUsage >= *apiKey.MonthlyLimitUSD {
+		return ErrAPIKeyMonthlyLimitExceeded
+	}
+	return nil
+}
+
 // checkBalanceEligibility 检查余额模式资格
 func (s *BillingCacheService) checkBalanceEligibility(ctx context.Context, userID int64) error {
 	balance, err := s.GetUserBalance(ctx, userID)
@@ -513,7 +970,8 @@ func (s *BillingCacheService) checkSubscriptionEligibility(ctx context.Context,
 		return ErrSubscriptionInvalid
 	}
 
-	// 检查限额（使用传入的Group限额配置）
+	// 检查限额（使用传入的Group限额配置，套餐订阅以订阅上的限额快照为准）
+	group = subscription.EffectiveGroup(group)
 	if group.HasDailyLimit() && subData.DailyUsage >= *group.DailyLimitUSD {
 		return ErrDailyLimitExceeded
 	}
@@ -52,9 +52,189 @@ func (b *billingCacheWorkerStub) InvalidateSubscriptionCache(ctx context.Context
 	return nil
 }
 
+func (b *billingCacheWorkerStub) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyUsageCacheData, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *billingCacheWorkerStub) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyUsageCacheData) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64, dayStart, monthStart time.Time) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) InvalidateAPIKeyUsageCache(ctx context.Context, apiKeyID int64) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (b *billingCacheWorkerStub) SetOrganizationBalance(ctx context.Context, orgID int64, balance float64) error {
+	atomic.AddInt64(&b.balanceUpdates, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) DeductOrganizationBalance(ctx context.Context, orgID int64, amount float64) error {
+	atomic.AddInt64(&b.balanceUpdates, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) InvalidateOrganizationBalance(ctx context.Context, orgID int64) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) GetOrgMemberUsageCache(ctx context.Context, userID int64) (*OrgMemberUsageCacheData, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *billingCacheWorkerStub) SetOrgMemberUsageCache(ctx context.Context, userID int64, data *OrgMemberUsageCacheData) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) UpdateOrgMemberUsage(ctx context.Context, userID int64, cost float64, monthStart time.Time) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) InvalidateOrgMemberUsageCache(ctx context.Context, userID int64) error {
+	return nil
+}
+
+type apiKeyUsageCacheStub struct {
+	billingCacheWorkerStub
+	usage *APIKeyUsageCacheData
+}
+
+func (s *apiKeyUsageCacheStub) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyUsageCacheData, error) {
+	return s.usage, nil
+}
+
+func TestBillingCacheServiceCheckAPIKeyLimits(t *testing.T) {
+	now := time.Now()
+	cache := &apiKeyUsageCacheStub{usage: &APIKeyUsageCacheData{
+		TotalUsage:         9,
+		DailyUsage:         3,
+		DailyWindowStart:   now,
+		MonthlyUsage:       5,
+		MonthlyWindowStart: now,
+	}}
+	svc := NewBillingCacheService(cache, nil, nil, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	user := &User{ID: 1}
+	limit := func(v float64) *float64 { return &v }
+
+	err := svc.checkAPIKeyLimits(context.Background(), &APIKey{ID: 1, User: user, QuotaUSD: limit(9)})
+	require.ErrorIs(t, err, ErrAPIKeyQuotaExhausted)
+
+	err = svc.checkAPIKeyLimits(context.Background(), &APIKey{ID: 1, User: user, QuotaUSD: limit(20), DailyLimitUSD: limit(3)})
+	require.ErrorIs(t, err, ErrAPIKeyDailyLimitExceeded)
+
+	err = svc.checkAPIKeyLimits(context.Background(), &APIKey{ID: 1, User: user, MonthlyLimitUSD: limit(5)})
+	require.ErrorIs(t, err, ErrAPIKeyMonthlyLimitExceeded)
+
+	err = svc.checkAPIKeyLimits(context.Background(), &APIKey{ID: 1, User: user, QuotaUSD: limit(20), DailyLimitUSD: limit(4), MonthlyLimitUSD: limit(6)})
+	require.NoError(t, err)
+}
+
+type orgBillingCacheStub struct {
+	billingCacheWorkerStub
+	orgBalance  float64
+	memberUsage *OrgMemberUsageCacheData
+}
+
+func (s *orgBillingCacheStub) GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error) {
+	return s.orgBalance, nil
+}
+
+func (s *orgBillingCacheStub) GetOrgMemberUsageCache(ctx context.Context, userID int64) (*OrgMemberUsageCacheData, error) {
+	return s.memberUsage, nil
+}
+
+func (s *orgBillingCacheStub) GetSubscriptionCache(ctx context.Context, userID, groupID int64) (*SubscriptionCacheData, error) {
+	return &SubscriptionCacheData{Status: SubscriptionStatusActive, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func TestBillingCacheServiceCheckOrganizationEligibility(t *testing.T) {
+	cache := &orgBillingCacheStub{
+		orgBalance:  10,
+		memberUsage: &OrgMemberUsageCacheData{MonthlyUsage: 5, MonthlyWindowStart: time.Now()},
+	}
+	svc := NewBillingCacheService(cache, nil, nil, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	limit := func(v float64) *float64 { return &v }
+	membership := func(status string, monthlyLimit *float64) *OrganizationMembership {
+		return &OrganizationMembership{OrganizationID: 1, OwnerUserID: 1, Status: status, Role: OrgRoleMember, MonthlyLimitUSD: monthlyLimit}
+	}
+
+	err := svc.checkOrganizationEligibility(context.Background(), 2, membership(StatusDisabled, nil))
+	require.ErrorIs(t, err, ErrOrganizationDisabled)
+
+	err = svc.checkOrganizationEligibility(context.Background(), 2, membership(StatusActive, limit(5)))
+	require.ErrorIs(t, err, ErrOrgMemberMonthlyLimitReached)
+
+	err = svc.checkOrganizationEligibility(context.Background(), 2, membership(StatusActive, limit(6)))
+	require.NoError(t, err)
+
+	// 上个月的用量不计入本月上限
+	cache.memberUsage = &OrgMemberUsageCacheData{MonthlyUsage: 50, MonthlyWindowStart: time.Now().AddDate(0, -2, 0)}
+	err = svc.checkOrganizationEligibility(context.Background(), 2, membership(StatusActive, limit(6)))
+	require.NoError(t, err)
+
+	cache.orgBalance = 0
+	err = svc.checkOrganizationEligibility(context.Background(), 2, membership(StatusActive, nil))
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+}
+
+func TestBillingCacheServiceSharedSubscriptionRespectsMemberLimit(t *testing.T) {
+	cache := &orgBillingCacheStub{
+		memberUsage: &OrgMemberUsageCacheData{MonthlyUsage: 5, MonthlyWindowStart: time.Now()},
+	}
+	svc := NewBillingCacheService(cache, nil, nil, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	limit := 5.0
+	group := &Group{ID: 3, SubscriptionType: SubscriptionTypeSubscription}
+	member := &User{ID: 2, Organization: &OrganizationMembership{
+		OrganizationID: 1, OwnerUserID: 1, Status: StatusActive, Role: OrgRoleMember, MonthlyLimitUSD: &limit,
+	}}
+
+	// 使用 owner 名下的共享订阅：成员月度上限生效
+	err := svc.CheckBillingEligibility(context.Background(), member, nil, group, &UserSubscription{ID: 9, UserID: 1, GroupID: 3})
+	require.ErrorIs(t, err, ErrOrgMemberMonthlyLimitReached)
+
+	// 成员自己的订阅不受组织上限约束
+	err = svc.CheckBillingEligibility(context.Background(), member, nil, group, &UserSubscription{ID: 10, UserID: 2, GroupID: 3})
+	require.NoError(t, err)
+
+	limit = 6
+	err = svc.CheckBillingEligibility(context.Background(), member, nil, group, &UserSubscription{ID: 9, UserID: 1, GroupID: 3})
+	require.NoError(t, err)
+}
+
+func TestNormalizeAPIKeyUsageRollsExpiredWindows(t *testing.T) {
+	now := time.Now()
+	data := &APIKeyUsageCacheData{
+		TotalUsage:         10,
+		DailyUsage:         2,
+		DailyWindowStart:   now.Add(-48 * time.Hour),
+		MonthlyUsage:       7,
+		MonthlyWindowStart: now.AddDate(0, -2, 0),
+	}
+
+	got := normalizeAPIKeyUsage(data, now)
+	require.Equal(t, 10.0, got.TotalUsage)
+	require.Zero(t, got.DailyUsage)
+	require.Zero(t, got.MonthlyUsage)
+	require.Equal(t, 2.0, data.DailyUsage, "input must not be mutated")
+}
+
 func TestBillingCacheServiceQueueHighLoad(t *testing.T) {
 	cache := &billingCacheWorkerStub{}
-	svc := NewBillingCacheService(cache, nil, nil, &config.Config{})
+	svc := NewBillingCacheService(cache, nil, nil, nil, nil, &config.Config{})
 	t.Cleanup(svc.Stop)
 
 	start := time.Now()
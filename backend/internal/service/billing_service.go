@@ -6,6 +6,7 @@ import (
 
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 )
@@ -23,6 +24,24 @@ type BillingCache interface {
 	SetSubscriptionCache(ctx context.Context, userID, groupID int64, data *SubscriptionCacheData) error
 	UpdateSubscriptionUsage(ctx context.Context, userID, groupID int64, cost float64) error
 	InvalidateSubscriptionCache(ctx context.Context, userID, groupID int64) error
+
+	// API key spending operations
+	GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyUsageCacheData, error)
+	SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyUsageCacheData) error
+	UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64, dayStart, monthStart time.Time) error
+	InvalidateAPIKeyUsageCache(ctx context.Context, apiKeyID int64) error
+
+	// Organization wallet operations
+	GetOrganizationBalance(ctx context.Context, orgID int64) (float64, error)
+	SetOrganizationBalance(ctx context.Context, orgID int64, balance float64) error
+	DeductOrganizationBalance(ctx context.Context, orgID int64, amount float64) error
+	InvalidateOrganizationBalance(ctx context.Context, orgID int64) error
+
+	// Organization member spending operations
+	GetOrgMemberUsageCache(ctx context.Context, userID int64) (*OrgMemberUsageCacheData, error)
+	SetOrgMemberUsageCache(ctx context.Context, userID int64, data *OrgMemberUsageCacheData) error
+	UpdateOrgMemberUsage(ctx context.Context, userID int64, cost float64, monthStart time.Time) error
+	InvalidateOrgMemberUsageCache(ctx context.Context, userID int64) error
 }
 
 // ModelPricing 模型价格配置（per-token价格，与LiteLLM格式一致）
@@ -34,8 +53,24 @@ type ModelPricing struct {
 	CacheCreation5mPrice       float64 // 5分钟缓存创建价格（每百万token）- 仅用于硬编码回退
 	CacheCreation1hPrice       float64 // 1小时缓存创建价格（每百万token）- 仅用于硬编码回退
 	SupportsCacheBreakdown     bool    // 是否支持详细的缓存分类
+
+	// 长上下文阶梯（仅管理员价格覆盖）：输入侧 token 总数超过阈值时输入/输出按长上下文单价计费
+	LongContextThreshold           int
+	LongContextInputPricePerToken  float64
+	LongContextOutputPricePerToken float64
+
+	Source  string // 价格来源，见 ModelPricingSource* 常量
+	PriceID *int64 // 来源为管理员覆盖时的价格版本 ID
 }
 
+// 价格来源
+const (
+	ModelPricingSourceOverride     = "override"      // 管理员价格覆盖
+	ModelPricingSourceLiteLLM      = "litellm"       // LiteLLM 动态价格
+	ModelPricingSourceFallback     = "fallback"      // 硬编码回退价格
+	ModelPricingSourceDefaultPrice = "default_price" // 未知模型默认价格
+)
+
 // UsageTokens 使用的token数量
 type UsageTokens struct {
 	InputTokens           int
@@ -56,19 +91,36 @@ type CostBreakdown struct {
 	ActualCost        float64 // 应用倍率后的实际费用
 }
 
+// Scale 按比例折算各项费用（用于响应缓存命中等场景），ratio <= 0 时返回零费用
+func (c *CostBreakdown) Scale(ratio float64) *CostBreakdown {
+	if c == nil || ratio <= 0 {
+		return &CostBreakdown{}
+	}
+	return &CostBreakdown{
+		InputCost:         c.InputCost * ratio,
+		OutputCost:        c.OutputCost * ratio,
+		CacheCreationCost: c.CacheCreationCost * ratio,
+		CacheReadCost:     c.CacheReadCost * ratio,
+		TotalCost:         c.TotalCost * ratio,
+		ActualCost:        c.ActualCost * ratio,
+	}
+}
+
 // BillingService 计费服务
 type BillingService struct {
-	cfg            *config.Config
-	pricingService *PricingService
-	fallbackPrices map[string]*ModelPricing // 硬编码回退价格
+	cfg               *config.Config
+	pricingService    *PricingService
+	modelPriceService *ModelPriceService       // 管理员价格覆盖（可为 nil）
+	fallbackPrices    map[string]*ModelPricing // 硬编码回退价格
 }
 
 // NewBillingService 创建计费服务实例
-func NewBillingService(cfg *config.Config, pricingService *PricingService) *BillingService {
+func NewBillingService(cfg *config.Config, pricingService *PricingService, modelPriceService *ModelPriceService) *BillingService {
 	s := &BillingService{
-		cfg:            cfg,
-		pricingService: pricingService,
-		fallbackPrices: make(map[string]*ModelPricing),
+		cfg:               cfg,
+		pricingService:    pricingService,
+		modelPriceService: modelPriceService,
+		fallbackPrices:    make(map[string]*ModelPricing),
 	}
 
 	// 初始化硬编码回退价格（当动态价格不可用时使用）
@@ -87,6 +139,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 6.25e-6, // $6.25 per MTok
 		CacheReadPricePerToken:     0.5e-6,  // $0.50 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 
 	// Claude 4 Sonnet
@@ -96,6 +149,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 3.75e-6, // $3.75 per MTok
 		CacheReadPricePerToken:     0.3e-6,  // $0.30 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 
 	// Claude 3.5 Sonnet
@@ -105,6 +159,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 3.75e-6, // $3.75 per MTok
 		CacheReadPricePerToken:     0.3e-6,  // $0.30 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 
 	// Claude 3.5 Haiku
@@ -114,6 +169,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 1.25e-6, // $1.25 per MTok
 		CacheReadPricePerToken:     0.1e-6,  // $0.10 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 
 	// Claude 3 Opus
@@ -123,6 +179,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 18.75e-6, // $18.75 per MTok
 		CacheReadPricePerToken:     1.5e-6,   // $1.50 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 
 	// Claude 3 Haiku
@@ -132,6 +189,7 @@ func (s *BillingService) initFallbackPricing() {
 		CacheCreationPricePerToken: 0.3e-6,  // $0.30 per MTok
 		CacheReadPricePerToken:     0.03e-6, // $0.03 per MTok
 		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceFallback,
 	}
 }
 
@@ -163,49 +221,120 @@ func (s *BillingService) getFallbackPricing(model string) *ModelPricing {
 	return s.fallbackPrices["claude-sonnet-4"]
 }
 
-// GetModelPricing 获取模型价格配置
+// GetModelPricing 获取模型价格配置（全局，不考虑分组覆盖）
 func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
+	return s.GetModelPricingForGroup(nil, model)
+}
+
+// GetModelPricingForGroup 获取分组在当前时间的模型价格配置
+// 优先级：分组覆盖 > 全局覆盖 > LiteLLM 动态价格 > 未知模型策略
+func (s *BillingService) GetModelPricingForGroup(groupID *int64, model string) (*ModelPricing, error) {
 	// 标准化模型名称（转小写）
 	model = strings.ToLower(model)
 
-	// 1. 优先从动态价格服务获取
-	if s.pricingService != nil {
-		litellmPricing := s.pricingService.GetModelPricing(model)
-		if litellmPricing != nil {
-			return &ModelPricing{
-				InputPricePerToken:         litellmPricing.InputCostPerToken,
-				OutputPricePerToken:        litellmPricing.OutputCostPerToken,
-				CacheCreationPricePerToken: litellmPricing.CacheCreationInputTokenCost,
-				CacheReadPricePerToken:     litellmPricing.CacheReadInputTokenCost,
-				SupportsCacheBreakdown:     false,
-			}, nil
+	// 1. 管理员价格覆盖（按生效时间取当前版本）
+	if s.modelPriceService != nil {
+		if override := s.modelPriceService.Resolve(groupID, model, time.Now()); override != nil {
+			return override.ToModelPricing(), nil
 		}
 	}
 
-	// 2. 使用硬编码回退价格
+	// 2. 动态价格服务
+	if pricing := s.getLiteLLMPricing(model); pricing != nil {
+		return pricing, nil
+	}
+
+	// 3. 未知模型：按配置的策略计费
+	policy := DefaultUnknownModelPricingSettings()
+	if s.modelPriceService != nil {
+		policy = s.modelPriceService.UnknownModelPolicy()
+	}
+	if policy.Policy == UnknownModelPricingDefaultPrice {
+		log.Printf("[Billing] Using default price for unknown model: %s", model)
+		return &ModelPricing{
+			InputPricePerToken:         policy.InputPrice / 1_000_000,
+			OutputPricePerToken:        policy.OutputPrice / 1_000_000,
+			CacheCreationPricePerToken: policy.CacheWritePrice / 1_000_000,
+			CacheReadPricePerToken:     policy.CacheReadPrice / 1_000_000,
+			Source:                     ModelPricingSourceDefaultPrice,
+		}, nil
+	}
+
+	// fallback 策略，或 reject 策略下请求已被放行（如转发期间价格被删除）：按模型系列回退，避免漏计费
 	fallback := s.getFallbackPricing(model)
 	if fallback != nil {
-		log.Printf("[Billing] Using fallback pricing for model: %s", model)
+		log.Printf("[Billing] Using fallback pricing for model: %s (policy=%s)", model, policy.Policy)
 		return fallback, nil
 	}
 
 	return nil, fmt.Errorf("pricing not found for model: %s", model)
 }
 
-// CalculateCost 计算使用费用
+func (s *BillingService) getLiteLLMPricing(model string) *ModelPricing {
+	if s.pricingService == nil {
+		return nil
+	}
+	litellmPricing := s.pricingService.GetModelPricing(model)
+	if litellmPricing == nil {
+		return nil
+	}
+	return &ModelPricing{
+		InputPricePerToken:         litellmPricing.InputCostPerToken,
+		OutputPricePerToken:        litellmPricing.OutputCostPerToken,
+		CacheCreationPricePerToken: litellmPricing.CacheCreationInputTokenCost,
+		CacheReadPricePerToken:     litellmPricing.CacheReadInputTokenCost,
+		SupportsCacheBreakdown:     false,
+		Source:                     ModelPricingSourceLiteLLM,
+	}
+}
+
+// CheckModelPricing 转发前检查模型是否可计费；仅在未知模型策略为 reject 时拒绝没有任何价格的模型
+func (s *BillingService) CheckModelPricing(groupID *int64, model string) error {
+	if s == nil || s.modelPriceService == nil || s.modelPriceService.UnknownModelPolicy().Policy != UnknownModelPricingReject {
+		return nil
+	}
+	model = strings.ToLower(model)
+	if s.modelPriceService.Resolve(groupID, model, time.Now()) != nil {
+		return nil
+	}
+	if s.getLiteLLMPricing(model) != nil {
+		return nil
+	}
+	return ErrModelPriceUnknown
+}
+
+// CalculateCost 按全局价格计算使用费用
 func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
-	pricing, err := s.GetModelPricing(model)
+	return s.CalculateCostForGroup(nil, model, tokens, rateMultiplier)
+}
+
+// CalculateCostForGroup 按分组当前生效的价格计算使用费用
+func (s *BillingService) CalculateCostForGroup(groupID *int64, model string, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
+	pricing, err := s.GetModelPricingForGroup(groupID, model)
 	if err != nil {
 		return nil, err
 	}
 
 	breakdown := &CostBreakdown{}
 
+	// 长上下文阶梯：输入侧 token（含缓存）超过阈值时整次请求按长上下文单价计费
+	inputPrice := pricing.InputPricePerToken
+	outputPrice := pricing.OutputPricePerToken
+	if pricing.LongContextThreshold > 0 &&
+		tokens.InputTokens+tokens.CacheCreationTokens+tokens.CacheReadTokens > pricing.LongContextThreshold {
+		if pricing.LongContextInputPricePerToken > 0 {
+			inputPrice = pricing.LongContextInputPricePerToken
+		}
+		if pricing.LongContextOutputPricePerToken > 0 {
+			outputPrice = pricing.LongContextOutputPricePerToken
+		}
+	}
+
 	// 计算输入token费用（使用per-token价格）
-	breakdown.InputCost = float64(tokens.InputTokens) * pricing.InputPricePerToken
+	breakdown.InputCost = float64(tokens.InputTokens) * inputPrice
 
 	// 计算输出token费用
-	breakdown.OutputCost = float64(tokens.OutputTokens) * pricing.OutputPricePerToken
+	breakdown.OutputCost = float64(tokens.OutputTokens) * outputPrice
 
 	// 计算缓存费用
 	if pricing.SupportsCacheBreakdown && (pricing.CacheCreation5mPrice > 0 || pricing.CacheCreation1hPrice > 0) {
@@ -29,6 +29,11 @@ type ConcurrencyCache interface {
 	ReleaseUserSlot(ctx context.Context, userID int64, requestID string) error
 	GetUserConcurrency(ctx context.Context, userID int64) (int, error)
 
+	// 组织槽位管理（组织成员共享的并发池）
+	// 键格式: concurrency:org:{organizationID}（有序集合，成员为 requestID）
+	AcquireOrganizationSlot(ctx context.Context, organizationID int64, maxConcurrency int, requestID string) (bool, error)
+	ReleaseOrganizationSlot(ctx context.Context, organizationID int64, requestID string) error
+
 	// 等待队列计数（只在首次创建时设置 TTL）
 	IncrementWaitCount(ctx context.Context, userID int64, maxWait int) (bool, error)
 	DecrementWaitCount(ctx context.Context, userID int64) error
@@ -162,6 +167,45 @@ func (s *ConcurrencyService) AcquireUserSlot(ctx context.Context, userID int64,
 	}, nil
 }
 
+// AcquireOrganizationSlot attempts to acquire a slot from an organization's shared concurrency pool.
+// Members hold both their own user slot and an organization slot for the duration of a request.
+// Returns a release function that MUST be called when the request completes.
+func (s *ConcurrencyService) AcquireOrganizationSlot(ctx context.Context, organizationID int64, maxConcurrency int) (*AcquireResult, error) {
+	// If maxConcurrency is 0 or negative, no limit
+	if maxConcurrency <= 0 {
+		return &AcquireResult{
+			Acquired:    true,
+			ReleaseFunc: func() {}, // no-op
+		}, nil
+	}
+
+	// Generate unique request ID for this slot
+	requestID := generateRequestID()
+
+	acquired, err := s.cache.AcquireOrganizationSlot(ctx, organizationID, maxConcurrency, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if acquired {
+		return &AcquireResult{
+			Acquired: true,
+			ReleaseFunc: func() {
+				bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := s.cache.ReleaseOrganizationSlot(bgCtx, organizationID, requestID); err != nil {
+					log.Printf("Warning: failed to release organization slot for %d (req=%s): %v", organizationID, requestID, err)
+				}
+			},
+		}, nil
+	}
+
+	return &AcquireResult{
+		Acquired:    false,
+		ReleaseFunc: nil,
+	}, nil
+}
+
 // ============================================
 // Wait Queue Count Methods
 // ============================================
@@ -10,24 +10,30 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"strings"
 )
 
-// CreemService Creem 支付服务
+// CreemService Creem 支付渠道
 type CreemService struct {
 	settingService *SettingService
-	userRepo       UserRepository
 }
 
+var _ PaymentProvider = (*CreemService)(nil)
+
 // NewCreemService 创建 Creem 支付服务实例
-func NewCreemService(settingService *SettingService, userRepo UserRepository) *CreemService {
+func NewCreemService(settingService *SettingService) *CreemService {
 	return &CreemService{
 		settingService: settingService,
-		userRepo:       userRepo,
 	}
 }
 
+// Name 渠道名称
+func (s *CreemService) Name() string {
+	return PaymentProviderCreem
+}
+
 // IsEnabled 检查 Creem 是否启用
 func (s *CreemService) IsEnabled(ctx context.Context) bool {
 	cfg, err := s.settingService.GetCreemConfig(ctx)
@@ -46,6 +52,21 @@ func (s *CreemService) GetRateMultiplier(ctx context.Context) float64 {
 	return cfg.RateMultiplier
 }
 
+// Info 渠道展示配置（Creem 以美元收款）
+func (s *CreemService) Info(ctx context.Context) PaymentProviderInfo {
+	return PaymentProviderInfo{
+		Name:           PaymentProviderCreem,
+		Enabled:        s.IsEnabled(ctx),
+		Currency:       "USD",
+		RateMultiplier: s.GetRateMultiplier(ctx),
+	}
+}
+
+// CreditAmount 支付金额（美元）乘以充值倍率
+func (s *CreemService) CreditAmount(ctx context.Context, amount float64) float64 {
+	return amount * s.GetRateMultiplier(ctx)
+}
+
 // CreemCheckoutRequest Creem checkout 请求
 type CreemCheckoutRequest struct {
 	ProductID  string                 `json:"product_id"`
@@ -57,9 +78,10 @@ type CreemCheckoutRequest struct {
 
 // CreemCheckoutMetadata Creem checkout 元数据
 type CreemCheckoutMetadata struct {
-	UserID int64  `json:"user_id"`
-	Email  string `json:"email"`
-	Amount int    `json:"amount"` // 金额（分）
+	OrderNo string `json:"order_no"`
+	UserID  int64  `json:"user_id"`
+	Email   string `json:"email"`
+	Amount  int    `json:"amount"` // 金额（分）
 }
 
 // CreemCheckoutCustomer Creem checkout 客户信息
@@ -104,38 +126,35 @@ type CreemCustomer struct {
 	Email string `json:"email"`
 }
 
-// CreateCheckoutResult checkout 创建结果
-type CreateCheckoutResult struct {
-	CheckoutURL string `json:"checkout_url"`
-}
-
-// CreateCheckout 创建 Creem checkout session
-func (s *CreemService) CreateCheckout(ctx context.Context, userID int64, email string, amountCents int) (*CreateCheckoutResult, error) {
+// CreateCheckout 为充值订单创建 Creem checkout session
+func (s *CreemService) CreateCheckout(ctx context.Context, order *PaymentOrder, in PaymentCheckoutInput) (*PaymentCheckout, error) {
 	cfg, err := s.settingService.GetCreemConfig(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get creem config: %w", err)
 	}
 
 	if !cfg.Enabled || cfg.APIKey == "" || cfg.ProductID == "" {
-		return nil, fmt.Errorf("creem payment is not enabled or not configured")
+		return nil, ErrPaymentProviderDisabled
 	}
 
 	successURL := cfg.SuccessURL
 	if successURL == "" {
-		successURL = "https://hbf.ink/redeem?payment=success"
+		successURL = in.ReturnURL
 	}
 
+	amountCents := int(math.Round(order.Amount * 100))
 	reqBody := CreemCheckoutRequest{
 		ProductID:  cfg.ProductID,
 		SuccessURL: successURL,
-		RequestID:  fmt.Sprintf("user_%d_%d", userID, amountCents),
+		RequestID:  order.OrderNo,
 		Metadata: &CreemCheckoutMetadata{
-			UserID: userID,
-			Email:  email,
-			Amount: amountCents,
+			OrderNo: order.OrderNo,
+			UserID:  order.UserID,
+			Email:   in.Email,
+			Amount:  amountCents,
 		},
 		Customer: &CreemCheckoutCustomer{
-			Email: email,
+			Email: in.Email,
 		},
 	}
 
@@ -178,40 +197,25 @@ func (s *CreemService) CreateCheckout(ctx context.Context, userID int64, email s
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
-	return &CreateCheckoutResult{
-		CheckoutURL: checkoutResp.CheckoutURL,
+	return &PaymentCheckout{
+		SessionID: checkoutResp.ID,
+		URL:       checkoutResp.CheckoutURL,
 	}, nil
 }
 
-// VerifyWebhookSignature 验证 webhook 签名
-func (s *CreemService) VerifyWebhookSignature(ctx context.Context, payload []byte, signature string) bool {
+// VerifyWebhook 校验 creem-signature 并解析 checkout 完成事件
+func (s *CreemService) VerifyWebhook(ctx context.Context, req *PaymentWebhookRequest) (*PaymentWebhookEvent, error) {
 	cfg, err := s.settingService.GetCreemConfig(ctx)
-	if err != nil || cfg.WebhookSecret == "" {
-		log.Println("[Creem] Warning: webhook_secret not configured, skipping signature verification")
-		return true
+	if err != nil {
+		return nil, fmt.Errorf("get creem config: %w", err)
+	}
+	if !verifyCreemSignature(cfg.WebhookSecret, req.Body, req.Header.Get("creem-signature")) {
+		return nil, ErrPaymentWebhookInvalid
 	}
 
-	// 获取 secret，去掉可能的 whsec_ 前缀
-	secret := cfg.WebhookSecret
-	secret = strings.TrimPrefix(secret, "whsec_")
-
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write(payload)
-	expectedSig := hex.EncodeToString(mac.Sum(nil))
-
-	// 移除 "sha256=" 前缀（如果存在）
-	signature = strings.TrimPrefix(signature, "sha256=")
-
-	log.Printf("[Creem] Signature verification: received=%s expected=%s", signature, expectedSig)
-
-	return hmac.Equal([]byte(expectedSig), []byte(signature))
-}
-
-// HandleWebhook 处理 Creem webhook
-func (s *CreemService) HandleWebhook(ctx context.Context, payload []byte) error {
 	var webhookData CreemWebhookPayload
-	if err := json.Unmarshal(payload, &webhookData); err != nil {
-		return fmt.Errorf("unmarshal webhook: %w", err)
+	if err := json.Unmarshal(req.Body, &webhookData); err != nil {
+		return nil, fmt.Errorf("unmarshal webhook: %w", err)
 	}
 
 	log.Printf("[Creem] Webhook received: id=%s object=%s status=%s",
@@ -219,48 +223,44 @@ func (s *CreemService) HandleWebhook(ctx context.Context, payload []byte) error
 
 	// 只处理 checkout.completed 事件
 	if webhookData.Object != "event" || webhookData.Data.Object.Status != "completed" {
-		log.Printf("[Creem] Ignoring webhook: object=%s status=%s", webhookData.Object, webhookData.Data.Object.Status)
-		return nil
+		return &PaymentWebhookEvent{}, nil
 	}
 
 	checkout := webhookData.Data.Object
-
-	// 从 metadata 获取 user_id
-	var userID int64
+	event := &PaymentWebhookEvent{
+		Status:        PaymentStatusPaid,
+		ProviderTxnID: checkout.ID,
+		Amount:        float64(checkout.AmountTotal) / 100.0, // amount_total 单位为分
+		Currency:      strings.ToUpper(checkout.Currency),
+		Email:         checkout.Customer.Email,
+	}
 	if metadata := checkout.Metadata; metadata != nil {
-		if uid, ok := metadata["user_id"].(float64); ok {
-			userID = int64(uid)
+		if orderNo, ok := metadata["order_no"].(string); ok {
+			event.OrderNo = orderNo
 		}
-	}
-
-	// 如果 metadata 没有 user_id，尝试从 email 查找用户
-	if userID == 0 && checkout.Customer.Email != "" {
-		user, err := s.userRepo.GetByEmail(ctx, checkout.Customer.Email)
-		if err != nil {
-			log.Printf("[Creem] User not found by email %s: %v", checkout.Customer.Email, err)
-			return fmt.Errorf("user not found: %w", err)
+		if uid, ok := metadata["user_id"].(float64); ok {
+			event.UserID = int64(uid)
 		}
-		userID = user.ID
 	}
+	return event, nil
+}
 
-	if userID == 0 {
-		return fmt.Errorf("cannot determine user_id from webhook")
+// verifyCreemSignature HMAC-SHA256(hex) 校验；未配置 webhook_secret 时跳过校验（保持旧行为）
+func verifyCreemSignature(secret string, payload []byte, signature string) bool {
+	if secret == "" {
+		log.Println("[Creem] Warning: webhook_secret not configured, skipping signature verification")
+		return true
 	}
 
-	// 计算余额增加量
-	// amount_total 是分，转换为美元后乘以倍率
-	amountUSD := float64(checkout.AmountTotal) / 100.0
-	rateMultiplier := s.GetRateMultiplier(ctx)
-	balanceIncrease := amountUSD * rateMultiplier
+	// 去掉可能的 whsec_ 前缀
+	secret = strings.TrimPrefix(secret, "whsec_")
 
-	log.Printf("[Creem] Updating balance: user_id=%d amount_usd=%.2f multiplier=%.1f balance_increase=%.2f",
-		userID, amountUSD, rateMultiplier, balanceIncrease)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	expectedSig := hex.EncodeToString(mac.Sum(nil))
 
-	// 更新用户余额
-	if err := s.userRepo.UpdateBalance(ctx, userID, balanceIncrease); err != nil {
-		return fmt.Errorf("update balance: %w", err)
-	}
+	// 移除 "sha256=" 前缀（如果存在）
+	signature = strings.TrimPrefix(signature, "sha256=")
 
-	log.Printf("[Creem] Balance updated successfully: user_id=%d +%.2f", userID, balanceIncrease)
-	return nil
+	return hmac.Equal([]byte(expectedSig), []byte(signature))
 }
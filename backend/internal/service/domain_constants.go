@@ -12,8 +12,11 @@ const (
 
 // Role constants
 const (
-	RoleAdmin = "admin"
-	RoleUser  = "user"
+	RoleAdmin   = "admin"   // 超级管理员，拥有全部管理权限
+	RoleSupport = "support" // 客服：查看用户/用量，限额调整余额
+	RoleFinance = "finance" // 财务：卡密、优惠码、订阅、余额账本
+	RoleOps     = "ops"     // 运维：账号、代理、运维监控与重试
+	RoleUser    = "user"
 )
 
 // Platform constants
@@ -44,6 +47,34 @@ const (
 	PromoCodeStatusDisabled = "disabled"
 )
 
+// Subscription plan status constants
+const (
+	SubscriptionPlanStatusActive   = "active"
+	SubscriptionPlanStatusDisabled = "disabled"
+)
+
+// Proxy pool selection policy constants
+const (
+	ProxyPoolPolicySticky       = "sticky"        // 账号固定使用分配到的代理，不健康时才切换
+	ProxyPoolPolicyRoundRobin   = "round_robin"   // 分配时在健康代理间轮转
+	ProxyPoolPolicyLeastLatency = "least_latency" // 分配延迟最低的健康代理
+	ProxyPoolPolicySameCountry  = "same_country"  // 优先分配与原代理出口国家相同的健康代理
+)
+
+// Proxy health status constants
+const (
+	ProxyHealthUnknown   = "unknown"
+	ProxyHealthHealthy   = "healthy"
+	ProxyHealthUnhealthy = "unhealthy"
+)
+
+// Organization member role constants
+const (
+	OrgRoleOwner  = "owner"  // 组织所有者，每个组织唯一
+	OrgRoleAdmin  = "admin"  // 组织管理员，可管理成员
+	OrgRoleMember = "member" // 普通成员，API Key 从组织钱包扣费
+)
+
 // Admin adjustment type constants
 const (
 	AdjustmentTypeAdminBalance     = "admin_balance"     // 管理员调整余额
@@ -111,9 +142,6 @@ const (
 	SettingKeyDefaultConcurrency = "default_concurrency" // 新用户默认并发量
 	SettingKeyDefaultBalance     = "default_balance"     // 新用户默认余额
 
-	// 管理员 API Key
-	SettingKeyAdminAPIKey = "admin_api_key" // 全局管理员 API Key（用于外部系统集成）
-
 	// Gemini 配额策略（JSON）
 	SettingKeyGeminiQuotaPolicy = "gemini_quota_policy"
 
@@ -160,6 +188,20 @@ const (
 	// SettingKeyStreamTimeoutSettings stores JSON config for stream timeout handling.
 	SettingKeyStreamTimeoutSettings = "stream_timeout_settings"
 
+	// =========================
+	// Invoices
+	// =========================
+
+	// SettingKeyInvoiceSettings stores JSON config for invoice numbering, tax and company details.
+	SettingKeyInvoiceSettings = "invoice_settings"
+
+	// =========================
+	// Model Pricing
+	// =========================
+
+	// SettingKeyUnknownModelPricing stores JSON config for billing models without any known price.
+	SettingKeyUnknownModelPricing = "unknown_model_pricing"
+
 	// =========================
 	// Creem Payment Integration
 	// =========================
@@ -181,6 +223,53 @@ const (
 
 	// SettingKeyCreemSuccessURL 支付成功后跳转 URL
 	SettingKeyCreemSuccessURL = "creem_success_url"
+
+	// =========================
+	// Stripe Checkout
+	// =========================
+
+	// SettingKeyStripeEnabled 是否启用 Stripe 支付
+	SettingKeyStripeEnabled = "stripe_enabled"
+
+	// SettingKeyStripeSecretKey Stripe Secret Key（sk_live_/sk_test_）
+	SettingKeyStripeSecretKey = "stripe_secret_key"
+
+	// SettingKeyStripeWebhookSecret Stripe Webhook 签名密钥（whsec_）
+	SettingKeyStripeWebhookSecret = "stripe_webhook_secret"
+
+	// SettingKeyStripeCurrency 收款币种（ISO 4217 小写，默认 usd）
+	SettingKeyStripeCurrency = "stripe_currency"
+
+	// SettingKeyStripeRateMultiplier 充值倍率（支付 1 单位币种获得多少余额）
+	SettingKeyStripeRateMultiplier = "stripe_rate_multiplier"
+
+	// =========================
+	// EPay（易支付聚合）
+	// =========================
+
+	// SettingKeyEPayEnabled 是否启用易支付
+	SettingKeyEPayEnabled = "epay_enabled"
+
+	// SettingKeyEPayGatewayURL 易支付网关地址（submit.php 所在目录）
+	SettingKeyEPayGatewayURL = "epay_gateway_url"
+
+	// SettingKeyEPayMerchantID 商户 ID（pid）
+	SettingKeyEPayMerchantID = "epay_merchant_id"
+
+	// SettingKeyEPayMerchantKey 商户密钥，用于 MD5 签名
+	SettingKeyEPayMerchantKey = "epay_merchant_key"
+
+	// SettingKeyEPayPayTypes 可选支付方式，逗号分隔（如 alipay,wxpay）
+	SettingKeyEPayPayTypes = "epay_pay_types"
+
+	// SettingKeyEPayCurrency 收款币种（仅用于展示与记录，默认 CNY）
+	SettingKeyEPayCurrency = "epay_currency"
+
+	// SettingKeyEPayRateMultiplier 充值倍率（支付 1 单位币种获得多少余额）
+	SettingKeyEPayRateMultiplier = "epay_rate_multiplier"
+
+	// SettingKeyInstanceID 实例唯一标识（首次使用时生成），用于账号迁移包的来源标记
+	SettingKeyInstanceID = "instance_id"
 )
 
 // AdminAPIKeyPrefix is the prefix for admin API keys (distinct from user "sk-" keys).
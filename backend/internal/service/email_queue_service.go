@@ -12,14 +12,23 @@ import (
 const (
 	TaskTypeVerifyCode    = "verify_code"
 	TaskTypePasswordReset = "password_reset"
+	TaskTypeInvoice       = "invoice"
+	TaskTypeNotice        = "notice"
 )
 
 // EmailTask 邮件发送任务
 type EmailTask struct {
 	Email    string
 	SiteName string
-	TaskType string // "verify_code" or "password_reset"
+	TaskType string // "verify_code", "password_reset", "invoice" or "notice"
 	ResetURL string // Only used for password_reset task type
+
+	// 以下字段用于 invoice / notice 类型（notice 不带附件）
+	Subject     string
+	Body        string
+	Attachments []EmailAttachment
+	// OnComplete 发送结束后回调（err 为 nil 表示成功），用于回写发送状态
+	OnComplete func(err error)
 }
 
 // EmailQueueService 异步邮件队列服务
@@ -92,6 +101,22 @@ func (s *EmailQueueService) processTask(workerID int, task EmailTask) {
 		} else {
 			log.Printf("[EmailQueue] Worker %d sent password reset to %s", workerID, task.Email)
 		}
+	case TaskTypeInvoice:
+		err := s.emailService.SendEmailWithAttachments(ctx, task.Email, task.Subject, task.Body, task.Attachments)
+		if err != nil {
+			log.Printf("[EmailQueue] Worker %d failed to send invoice to %s: %v", workerID, task.Email, err)
+		} else {
+			log.Printf("[EmailQueue] Worker %d sent invoice to %s", workerID, task.Email)
+		}
+		if task.OnComplete != nil {
+			task.OnComplete(err)
+		}
+	case TaskTypeNotice:
+		if err := s.emailService.SendEmail(ctx, task.Email, task.Subject, task.Body); err != nil {
+			log.Printf("[EmailQueue] Worker %d failed to send notice to %s: %v", workerID, task.Email, err)
+		} else {
+			log.Printf("[EmailQueue] Worker %d sent notice to %s", workerID, task.Email)
+		}
 	default:
 		log.Printf("[EmailQueue] Worker %d unknown task type: %s", workerID, task.TaskType)
 	}
@@ -132,6 +157,44 @@ func (s *EmailQueueService) EnqueuePasswordReset(email, siteName, resetURL strin
 	}
 }
 
+// EnqueueInvoice 将发票邮件任务加入队列，onComplete 在发送结束后回调
+func (s *EmailQueueService) EnqueueInvoice(email, subject, body string, attachments []EmailAttachment, onComplete func(err error)) error {
+	task := EmailTask{
+		Email:       email,
+		TaskType:    TaskTypeInvoice,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
+		OnComplete:  onComplete,
+	}
+
+	select {
+	case s.taskChan <- task:
+		log.Printf("[EmailQueue] Enqueued invoice task for %s", email)
+		return nil
+	default:
+		return fmt.Errorf("email queue is full")
+	}
+}
+
+// EnqueueNotice 将通知类邮件（如订阅到期提醒）加入队列
+func (s *EmailQueueService) EnqueueNotice(email, subject, body string) error {
+	task := EmailTask{
+		Email:    email,
+		TaskType: TaskTypeNotice,
+		Subject:  subject,
+		Body:     body,
+	}
+
+	select {
+	case s.taskChan <- task:
+		log.Printf("[EmailQueue] Enqueued notice task for %s", email)
+		return nil
+	default:
+		return fmt.Errorf("email queue is full")
+	}
+}
+
 // Stop 停止队列服务
 func (s *EmailQueueService) Stop() {
 	close(s.stopChan)
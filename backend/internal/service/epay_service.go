@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EPayService 易支付（彩虹易支付等兼容协议）聚合支付渠道
+//
+// 下单：跳转 {gateway}/submit.php，参数按 key 排序后拼接并追加商户密钥做 MD5 签名；
+// 通知：网关以 GET/POST 参数回调 notify_url，验签通过且 trade_status=TRADE_SUCCESS 视为支付成功，需应答纯文本 success。
+type EPayService struct {
+	settingService *SettingService
+}
+
+var (
+	_ PaymentProvider     = (*EPayService)(nil)
+	_ PaymentWebhookAcker = (*EPayService)(nil)
+)
+
+// NewEPayService 创建易支付服务实例
+func NewEPayService(settingService *SettingService) *EPayService {
+	return &EPayService{settingService: settingService}
+}
+
+// Name 渠道名称
+func (s *EPayService) Name() string {
+	return PaymentProviderEPay
+}
+
+// Info 渠道展示配置
+func (s *EPayService) Info(ctx context.Context) PaymentProviderInfo {
+	cfg, err := s.settingService.GetEPayConfig(ctx)
+	if err != nil {
+		return PaymentProviderInfo{Name: PaymentProviderEPay}
+	}
+	return PaymentProviderInfo{
+		Name:           PaymentProviderEPay,
+		Enabled:        epayConfigured(cfg),
+		Currency:       cfg.Currency,
+		RateMultiplier: cfg.RateMultiplier,
+		Methods:        cfg.PayTypes,
+	}
+}
+
+// CreditAmount 支付金额乘以充值倍率
+func (s *EPayService) CreditAmount(ctx context.Context, amount float64) float64 {
+	cfg, err := s.settingService.GetEPayConfig(ctx)
+	if err != nil {
+		return 0
+	}
+	return amount * cfg.RateMultiplier
+}
+
+// WebhookAck 易支付要求通知应答纯文本 success，否则会重复通知
+func (s *EPayService) WebhookAck() string {
+	return "success"
+}
+
+// CreateCheckout 生成带签名的收银台跳转地址（无需服务端调用网关）
+func (s *EPayService) CreateCheckout(ctx context.Context, order *PaymentOrder, in PaymentCheckoutInput) (*PaymentCheckout, error) {
+	cfg, err := s.settingService.GetEPayConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get epay config: %w", err)
+	}
+	if !epayConfigured(cfg) {
+		return nil, ErrPaymentProviderDisabled
+	}
+	method := in.Method
+	if method == "" {
+		method = cfg.PayTypes[0]
+	}
+	if !slices.Contains(cfg.PayTypes, method) {
+		return nil, ErrPaymentInvalidMethod
+	}
+
+	params := url.Values{}
+	params.Set("pid", cfg.MerchantID)
+	params.Set("type", method)
+	params.Set("out_trade_no", order.OrderNo)
+	params.Set("notify_url", in.NotifyURL)
+	params.Set("return_url", in.ReturnURL)
+	params.Set("name", "Balance top-up "+order.OrderNo)
+	params.Set("money", strconv.FormatFloat(order.Amount, 'f', 2, 64))
+	params.Set("sign", epaySign(params, cfg.MerchantKey))
+	params.Set("sign_type", "MD5")
+
+	return &PaymentCheckout{URL: cfg.GatewayURL + "/submit.php?" + params.Encode()}, nil
+}
+
+// VerifyWebhook 校验通知签名与商户号
+func (s *EPayService) VerifyWebhook(ctx context.Context, req *PaymentWebhookRequest) (*PaymentWebhookEvent, error) {
+	cfg, err := s.settingService.GetEPayConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get epay config: %w", err)
+	}
+	if cfg.MerchantKey == "" {
+		return nil, ErrPaymentWebhookInvalid
+	}
+	params := req.Form
+	expected := epaySign(params, cfg.MerchantKey)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(params.Get("sign")))) != 1 {
+		log.Printf("[EPay] Invalid notify signature: out_trade_no=%s", params.Get("out_trade_no"))
+		return nil, ErrPaymentWebhookInvalid
+	}
+	if params.Get("pid") != cfg.MerchantID {
+		log.Printf("[EPay] Notify merchant mismatch: pid=%s", params.Get("pid"))
+		return nil, ErrPaymentWebhookInvalid
+	}
+
+	log.Printf("[EPay] Notify received: out_trade_no=%s trade_no=%s status=%s",
+		params.Get("out_trade_no"), params.Get("trade_no"), params.Get("trade_status"))
+	if params.Get("trade_status") != "TRADE_SUCCESS" {
+		return &PaymentWebhookEvent{}, nil
+	}
+	amount, err := strconv.ParseFloat(params.Get("money"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid money %q: %w", params.Get("money"), err)
+	}
+	return &PaymentWebhookEvent{
+		Status:        PaymentStatusPaid,
+		OrderNo:       params.Get("out_trade_no"),
+		ProviderTxnID: params.Get("trade_no"),
+		Amount:        amount,
+		Currency:      cfg.Currency,
+	}, nil
+}
+
+func epayConfigured(cfg *EPayConfig) bool {
+	return cfg.Enabled && cfg.GatewayURL != "" && cfg.MerchantID != "" && cfg.MerchantKey != ""
+}
+
+// epaySign 非空参数（不含 sign、sign_type）按 key 升序拼成 a=1&b=2，末尾直接追加密钥后取 MD5 小写
+func epaySign(params url.Values, key string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "sign" || k == "sign_type" || params.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(params.Get(k))
+	}
+	b.WriteString(key)
+	sum := md5.Sum([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// cachePrefixAffinityTTL 与上游 ephemeral 缓存的默认存活时间保持一致；每次命中都会续期
+const cachePrefixAffinityTTL = 5 * time.Minute
+
+// cachePrefixSessionKey 缓存前缀亲和绑定复用粘性会话存储，使用独立前缀避免与会话 hash 冲突
+func cachePrefixSessionKey(prefixHash string) string {
+	return "cache_prefix:" + prefixHash
+}
+
+func (s *GatewayService) cacheAwareRoutingEnabled() bool {
+	return s.cfg != nil && s.cfg.Gateway.Scheduling.CacheAwareRouting && s.cache != nil
+}
+
+// GenerateCachePrefixHash 仅对带 cache_control 的内容计算 hash，没有可缓存内容时返回空串
+func (s *GatewayService) GenerateCachePrefixHash(parsed *ParsedRequest) string {
+	cacheableContent := s.extractCacheableContent(parsed)
+	if cacheableContent == "" {
+		return ""
+	}
+	return s.hashContent(cacheableContent)
+}
+
+// SeedStickySessionFromCachePrefix 在会话尚无绑定时，将其预绑定到最近为同一缓存前缀创建/命中缓存的账号。
+// 预绑定后的账号仍走常规粘性会话校验（可调度、模型支持、并发等），不可用时自动回退到负载均衡选择。
+func (s *GatewayService) SeedStickySessionFromCachePrefix(ctx context.Context, groupID *int64, sessionHash, prefixHash string) {
+	if sessionHash == "" || prefixHash == "" || !s.cacheAwareRoutingEnabled() {
+		return
+	}
+	gid := derefGroupID(groupID)
+	if current, err := s.cache.GetSessionAccountID(ctx, gid, sessionHash); err == nil && current > 0 {
+		return
+	}
+	accountID, err := s.cache.GetSessionAccountID(ctx, gid, cachePrefixSessionKey(prefixHash))
+	if err != nil || accountID <= 0 {
+		return
+	}
+	if err := s.cache.SetSessionAccountID(ctx, gid, sessionHash, accountID, stickySessionTTL); err != nil {
+		log.Printf("[CacheAffinity] seed session failed: group=%d account=%d err=%v", gid, accountID, err)
+	}
+}
+
+// recordCachePrefixAffinity 上游实际创建或读取了提示缓存时，记录缓存前缀 -> 账号的亲和关系
+func (s *GatewayService) recordCachePrefixAffinity(ctx context.Context, groupID *int64, prefixHash string, accountID int64, usage ClaudeUsage) {
+	if prefixHash == "" || accountID <= 0 || !s.cacheAwareRoutingEnabled() {
+		return
+	}
+	if usage.CacheCreationInputTokens <= 0 && usage.CacheReadInputTokens <= 0 {
+		return
+	}
+	gid := derefGroupID(groupID)
+	if err := s.cache.SetSessionAccountID(ctx, gid, cachePrefixSessionKey(prefixHash), accountID, cachePrefixAffinityTTL); err != nil {
+		log.Printf("[CacheAffinity] record prefix failed: group=%d account=%d err=%v", gid, accountID, err)
+	}
+}
@@ -0,0 +1,99 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheAffinityTestService(enabled bool) (*GatewayService, *mockGatewayCacheForPlatform) {
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.CacheAwareRouting = enabled
+	cache := &mockGatewayCacheForPlatform{}
+	return &GatewayService{cfg: cfg, cache: cache}, cache
+}
+
+func cacheablePrefixRequest(text string) *ParsedRequest {
+	return &ParsedRequest{
+		System: []any{
+			map[string]any{"type": "text", "text": text, "cache_control": map[string]any{"type": "ephemeral"}},
+		},
+	}
+}
+
+func TestGenerateCachePrefixHash(t *testing.T) {
+	svc, _ := newCacheAffinityTestService(true)
+
+	require.Empty(t, svc.GenerateCachePrefixHash(nil))
+	require.Empty(t, svc.GenerateCachePrefixHash(&ParsedRequest{System: "plain system prompt"}))
+
+	h1 := svc.GenerateCachePrefixHash(cacheablePrefixRequest("shared prefix"))
+	h2 := svc.GenerateCachePrefixHash(cacheablePrefixRequest("shared prefix"))
+	h3 := svc.GenerateCachePrefixHash(cacheablePrefixRequest("other prefix"))
+	require.NotEmpty(t, h1)
+	require.Equal(t, h1, h2)
+	require.NotEqual(t, h1, h3)
+}
+
+func TestRecordCachePrefixAffinity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled does nothing", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(false)
+		svc.recordCachePrefixAffinity(ctx, nil, "p1", 7, ClaudeUsage{CacheCreationInputTokens: 100})
+		require.Empty(t, cache.sessionBindings)
+	})
+
+	t.Run("no cache activity does nothing", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(true)
+		svc.recordCachePrefixAffinity(ctx, nil, "p1", 7, ClaudeUsage{InputTokens: 100})
+		require.Empty(t, cache.sessionBindings)
+	})
+
+	t.Run("cache creation records latest account", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(true)
+		svc.recordCachePrefixAffinity(ctx, nil, "p1", 7, ClaudeUsage{CacheCreationInputTokens: 100})
+		svc.recordCachePrefixAffinity(ctx, nil, "p1", 9, ClaudeUsage{CacheReadInputTokens: 50})
+		require.Equal(t, int64(9), cache.sessionBindings[cachePrefixSessionKey("p1")])
+	})
+}
+
+func TestSeedStickySessionFromCachePrefix(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("seeds unbound session", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(true)
+		cache.sessionBindings = map[string]int64{cachePrefixSessionKey("p1"): 7}
+
+		svc.SeedStickySessionFromCachePrefix(ctx, nil, "sess", "p1")
+		require.Equal(t, int64(7), cache.sessionBindings["sess"])
+	})
+
+	t.Run("keeps existing binding", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(true)
+		cache.sessionBindings = map[string]int64{cachePrefixSessionKey("p1"): 7, "sess": 3}
+
+		svc.SeedStickySessionFromCachePrefix(ctx, nil, "sess", "p1")
+		require.Equal(t, int64(3), cache.sessionBindings["sess"])
+	})
+
+	t.Run("disabled does nothing", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(false)
+		cache.sessionBindings = map[string]int64{cachePrefixSessionKey("p1"): 7}
+
+		svc.SeedStickySessionFromCachePrefix(ctx, nil, "sess", "p1")
+		_, ok := cache.sessionBindings["sess"]
+		require.False(t, ok)
+	})
+
+	t.Run("unknown prefix does nothing", func(t *testing.T) {
+		svc, cache := newCacheAffinityTestService(true)
+
+		svc.SeedStickySessionFromCachePrefix(ctx, nil, "sess", "p1")
+		require.Empty(t, cache.sessionBindings)
+	})
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+)
+
+// GatewayMetricsService 负责 Prometheus 指标的抓取时采集。
+//
+// 请求数/耗时/故障切换等由各埋点实时累加；账号槽位占用与等待队列深度
+// 需要读取 Redis，仅在抓取时按可调度账号汇总计算。
+type GatewayMetricsService struct {
+	concurrencyService *ConcurrencyService
+	accountRepo        AccountRepository
+	cfg                *config.Config
+}
+
+// NewGatewayMetricsService 创建指标服务，根据配置开启/关闭埋点并设置 model label 的收敛规则
+// （未配置 metrics.model_labels 时，只有定价表能识别的模型使用独立 label）
+func NewGatewayMetricsService(concurrencyService *ConcurrencyService, accountRepo AccountRepository, pricingService *PricingService, cfg *config.Config) *GatewayMetricsService {
+	metrics.SetEnabled(cfg != nil && cfg.Metrics.Enabled)
+	if cfg != nil {
+		var known func(string) bool
+		if pricingService != nil {
+			known = func(model string) bool { return pricingService.GetModelPricing(model) != nil }
+		}
+		metrics.ConfigureModelLabels(cfg.Metrics.ModelLabels, cfg.Metrics.MaxModelLabels, known)
+	}
+	return &GatewayMetricsService{
+		concurrencyService: concurrencyService,
+		accountRepo:        accountRepo,
+		cfg:                cfg,
+	}
+}
+
+// Enabled 返回 /metrics 是否开启
+func (s *GatewayMetricsService) Enabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.Metrics.Enabled
+}
+
+// RefreshScrapeMetrics 刷新仅在抓取时计算的指标
+func (s *GatewayMetricsService) RefreshScrapeMetrics(ctx context.Context) {
+	s.refreshAccountSlots(ctx)
+}
+
+// refreshAccountSlots 按平台汇总可调度账号的槽位占用与等待数（best-effort，失败时保留上次结果）
+func (s *GatewayMetricsService) refreshAccountSlots(ctx context.Context) {
+	if s.concurrencyService == nil || s.accountRepo == nil {
+		return
+	}
+	accounts, err := s.accountRepo.ListSchedulable(ctx)
+	if err != nil {
+		log.Printf("[Metrics] list schedulable accounts failed: %v", err)
+		return
+	}
+
+	stats := make(map[string]metrics.AccountSlotStats)
+	platformByID := make(map[int64]string, len(accounts))
+	batch := make([]AccountWithConcurrency, 0, len(accounts))
+	for _, acc := range accounts {
+		if _, seen := platformByID[acc.ID]; seen {
+			continue
+		}
+		platformByID[acc.ID] = acc.Platform
+		batch = append(batch, AccountWithConcurrency{ID: acc.ID, MaxConcurrency: acc.Concurrency})
+
+		st := stats[acc.Platform]
+		st.Capacity += acc.Concurrency
+		stats[acc.Platform] = st
+	}
+
+	for i := 0; i < len(batch); i += opsConcurrencyBatchChunkSize {
+		end := min(i+opsConcurrencyBatchChunkSize, len(batch))
+		loads, err := s.concurrencyService.GetAccountsLoadBatch(ctx, batch[i:end])
+		if err != nil {
+			log.Printf("[Metrics] GetAccountsLoadBatch failed: %v", err)
+			return
+		}
+		for id, load := range loads {
+			if load == nil {
+				continue
+			}
+			platform := platformByID[id]
+			st := stats[platform]
+			st.InUse += load.CurrentConcurrency
+			st.Waiting += load.WaitingCount
+			stats[platform] = st
+		}
+	}
+
+	metrics.SetAccountSlots(stats)
+}
@@ -1819,6 +1819,14 @@ func (m *mockConcurrencyCache) GetUserConcurrency(ctx context.Context, userID in
 	return 0, nil
 }
 
+func (m *mockConcurrencyCache) AcquireOrganizationSlot(ctx context.Context, organizationID int64, maxConcurrency int, requestID string) (bool, error) {
+	return true, nil
+}
+
+func (m *mockConcurrencyCache) ReleaseOrganizationSlot(ctx context.Context, organizationID int64, requestID string) error {
+	return nil
+}
+
 func (m *mockConcurrencyCache) IncrementWaitCount(ctx context.Context, userID int64, maxWait int) (bool, error) {
 	return true, nil
 }
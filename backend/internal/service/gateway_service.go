@@ -24,6 +24,8 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
 	"github.com/tidwall/gjson"
@@ -202,6 +204,8 @@ type GatewayService struct {
 	usageLogRepo        UsageLogRepository
 	userRepo            UserRepository
 	userSubRepo         UserSubscriptionRepository
+	apiKeyRepo          APIKeyRepository
+	orgRepo             OrganizationRepository
 	cache               GatewayCache
 	cfg                 *config.Config
 	schedulerSnapshot   *SchedulerSnapshotService
@@ -213,7 +217,11 @@ type GatewayService struct {
 	deferredService     *DeferredService
 	concurrencyService  *ConcurrencyService
 	claudeTokenProvider *ClaudeTokenProvider
-	sessionLimitCache   SessionLimitCache // 会话数量限制缓存（仅 Anthropic OAuth/SetupToken）
+	sessionLimitCache   SessionLimitCache  // 会话数量限制缓存（仅 Anthropic OAuth/SetupToken）
+	accountHealthCache  AccountHealthCache // 账号健康指标缓存（health_weighted 调度模式）
+	proxyPoolService    *ProxyPoolService  // 代理池故障切换（代理连接失败时切换到池内其他代理）
+
+	accountBudgetService *AccountBudgetService // 账号日/周/月预算（耗尽后暂停调度）
 }
 
 // NewGatewayService creates a new GatewayService
@@ -223,6 +231,8 @@ func NewGatewayService(
 	usageLogRepo UsageLogRepository,
 	userRepo UserRepository,
 	userSubRepo UserSubscriptionRepository,
+	apiKeyRepo APIKeyRepository,
+	orgRepo OrganizationRepository,
 	cache GatewayCache,
 	cfg *config.Config,
 	schedulerSnapshot *SchedulerSnapshotService,
@@ -235,6 +245,9 @@ func NewGatewayService(
 	deferredService *DeferredService,
 	claudeTokenProvider *ClaudeTokenProvider,
 	sessionLimitCache SessionLimitCache,
+	accountHealthCache AccountHealthCache,
+	proxyPoolService *ProxyPoolService,
+	accountBudgetService *AccountBudgetService,
 ) *GatewayService {
 	return &GatewayService{
 		accountRepo:         accountRepo,
@@ -242,6 +255,8 @@ func NewGatewayService(
 		usageLogRepo:        usageLogRepo,
 		userRepo:            userRepo,
 		userSubRepo:         userSubRepo,
+		apiKeyRepo:          apiKeyRepo,
+		orgRepo:             orgRepo,
 		cache:               cache,
 		cfg:                 cfg,
 		schedulerSnapshot:   schedulerSnapshot,
@@ -254,6 +269,10 @@ func NewGatewayService(
 		deferredService:     deferredService,
 		claudeTokenProvider: claudeTokenProvider,
 		sessionLimitCache:   sessionLimitCache,
+		accountHealthCache:  accountHealthCache,
+		proxyPoolService:    proxyPoolService,
+
+		accountBudgetService: accountBudgetService,
 	}
 }
 
@@ -462,6 +481,13 @@ func (s *GatewayService) SelectAccountForModelWithExclusions(ctx context.Context
 // SelectAccountWithLoadAwareness selects account with load-awareness and wait plan.
 // metadataUserID: 已废弃参数，会话限制现在统一使用 sessionHash
 func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}, metadataUserID string) (*AccountSelectionResult, error) {
+	ctx, span := startSelectAccountSpan(ctx, groupID, requestedModel, len(excludedIDs))
+	result, err := s.selectAccountWithLoadAwareness(ctx, groupID, sessionHash, requestedModel, excludedIDs)
+	endSelectAccountSpan(span, result, err)
+	return result, err
+}
+
+func (s *GatewayService) selectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (*AccountSelectionResult, error) {
 	// 调试日志：记录调度入口参数
 	excludedIDsList := make([]int64, 0, len(excludedIDs))
 	for id := range excludedIDs {
@@ -655,6 +681,15 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 				derefGroupID(groupID), requestedModel, len(routingAccountIDs), len(routingCandidates),
 				filteredExcluded, filteredMissing, filteredUnsched, filteredPlatform, filteredModelScope, filteredModelMapping, filteredWindowCost)
 		}
+		recordCandidateFilter(ctx, "model_routing", len(routingAccountIDs), len(routingCandidates), candidateSkipStats{
+			Excluded:      filteredExcluded,
+			Missing:       filteredMissing,
+			Unschedulable: filteredUnsched,
+			Platform:      filteredPlatform,
+			ModelScope:    filteredModelScope,
+			ModelMapping:  filteredModelMapping,
+			WindowCost:    filteredWindowCost,
+		})
 
 		if len(routingCandidates) > 0 {
 			// 1.5. 在路由账号范围内检查粘性会话
@@ -868,32 +903,40 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 
 	// ============ Layer 2: 负载感知选择 ============
 	candidates := make([]*Account, 0, len(accounts))
+	var skipped candidateSkipStats
 	for i := range accounts {
 		acc := &accounts[i]
 		if isExcluded(acc.ID) {
+			skipped.Excluded++
 			continue
 		}
 		// Scheduler snapshots can be temporarily stale (bucket rebuild is throttled);
 		// re-check schedulability here so recently rate-limited/overloaded accounts
 		// are not selected again before the bucket is rebuilt.
 		if !acc.IsSchedulable() {
+			skipped.Unschedulable++
 			continue
 		}
 		if !s.isAccountAllowedForPlatform(acc, platform, useMixed) {
+			skipped.Platform++
 			continue
 		}
 		if !acc.IsSchedulableForModel(requestedModel) {
+			skipped.ModelScope++
 			continue
 		}
 		if requestedModel != "" && !s.isModelSupportedByAccount(acc, requestedModel) {
+			skipped.ModelMapping++
 			continue
 		}
 		// 窗口费用检查（非粘性会话路径）
 		if !s.isAccountSchedulableForWindowCost(ctx, acc, false) {
+			skipped.WindowCost++
 			continue
 		}
 		candidates = append(candidates, acc)
 	}
+	recordCandidateFilter(ctx, "load_aware", len(accounts), len(candidates), skipped)
 
 	if len(candidates) == 0 {
 		return nil, errors.New("no available accounts")
@@ -932,28 +975,44 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 		}
 
 		if len(available) > 0 {
-			sort.SliceStable(available, func(i, j int) bool {
-				a, b := available[i], available[j]
-				if a.account.Priority != b.account.Priority {
-					return a.account.Priority < b.account.Priority
+			if s.healthWeightedSchedulingEnabled() {
+				// 健康度加权：权重 = 健康权重 × 空闲率，负载高或亚健康的账号被选中的概率更低
+				ordered := make([]*Account, 0, len(available))
+				loadByID := make(map[int64]accountWithLoad, len(available))
+				for _, item := range available {
+					ordered = append(ordered, item.account)
+					loadByID[item.account.ID] = item
 				}
-				if a.loadInfo.LoadRate != b.loadInfo.LoadRate {
-					return a.loadInfo.LoadRate < b.loadInfo.LoadRate
+				weights := s.accountHealthWeights(ctx, ordered)
+				scaleWeightsByIdleRate(weights, loadMap)
+				sortAccountsByPriorityAndHealth(ordered, weights)
+				for i, acc := range ordered {
+					available[i] = loadByID[acc.ID]
 				}
-				switch {
-				case a.account.LastUsedAt == nil && b.account.LastUsedAt != nil:
-					return true
-				case a.account.LastUsedAt != nil && b.account.LastUsedAt == nil:
-					return false
-				case a.account.LastUsedAt == nil && b.account.LastUsedAt == nil:
-					if preferOAuth && a.account.Type != b.account.Type {
-						return a.account.Type == AccountTypeOAuth
+			} else {
+				sort.SliceStable(available, func(i, j int) bool {
+					a, b := available[i], available[j]
+					if a.account.Priority != b.account.Priority {
+						return a.account.Priority < b.account.Priority
 					}
-					return false
-				default:
-					return a.account.LastUsedAt.Before(*b.account.LastUsedAt)
-				}
-			})
+					if a.loadInfo.LoadRate != b.loadInfo.LoadRate {
+						return a.loadInfo.LoadRate < b.loadInfo.LoadRate
+					}
+					switch {
+					case a.account.LastUsedAt == nil && b.account.LastUsedAt != nil:
+						return true
+					case a.account.LastUsedAt != nil && b.account.LastUsedAt == nil:
+						return false
+					case a.account.LastUsedAt == nil && b.account.LastUsedAt == nil:
+						if preferOAuth && a.account.Type != b.account.Type {
+							return a.account.Type == AccountTypeOAuth
+						}
+						return false
+					default:
+						return a.account.LastUsedAt.Before(*b.account.LastUsedAt)
+					}
+				})
+			}
 
 			for _, item := range available {
 				result, err := s.tryAcquireAccountSlot(ctx, item.account.ID, item.account.Concurrency)
@@ -977,7 +1036,11 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 	}
 
 	// ============ Layer 3: 兜底排队 ============
-	s.sortCandidatesForFallback(candidates, preferOAuth, cfg.FallbackSelectionMode)
+	if s.healthWeightedSchedulingEnabled() {
+		sortAccountsByPriorityAndHealth(candidates, s.accountHealthWeights(ctx, candidates))
+	} else {
+		s.sortCandidatesForFallback(candidates, preferOAuth, cfg.FallbackSelectionMode)
+	}
 	for _, acc := range candidates {
 		// 会话数量限制检查（等待计划也需要占用会话配额）
 		if !s.checkAndRegisterSession(ctx, acc, sessionHash) {
@@ -2375,8 +2438,14 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 			return nil, err
 		}
 
-		// 发送请求
-		resp, err = s.httpUpstream.DoWithTLS(upstreamReq, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
+		// 发送请求（绑定代理池的账号在代理连接失败时切换到池内其他代理）
+		resp, proxyURL, err = s.proxyPoolService.DoWithFailover(ctx, account, upstreamReq, proxyURL,
+			func() (*http.Request, error) {
+				return s.buildUpstreamRequest(ctx, c, account, body, token, tokenType, reqModel)
+			},
+			func(req *http.Request, proxyURL string) (*http.Response, error) {
+				return s.httpUpstream.DoWithTLS(req, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
+			})
 		if err != nil {
 			if resp != nil && resp.Body != nil {
 				_ = resp.Body.Close()
@@ -3504,6 +3573,11 @@ func (s *GatewayService) replaceModelInResponseBody(body []byte, fromModel, toMo
 	return newBody
 }
 
+// CheckModelPricing 转发前检查模型是否可计费（未知模型策略为 reject 时拒绝无价格的模型）
+func (s *GatewayService) CheckModelPricing(groupID *int64, model string) error {
+	return s.billingService.CheckModelPricing(groupID, model)
+}
+
 // RecordUsageInput 记录使用量的输入参数
 type RecordUsageInput struct {
 	Result       *ForwardResult
@@ -3513,6 +3587,11 @@ type RecordUsageInput struct {
 	Subscription *UserSubscription // 可选：订阅信息
 	UserAgent    string            // 请求的 User-Agent
 	IPAddress    string            // 请求的客户端 IP 地址
+	// CachePrefixHash 请求可缓存前缀的 hash，缓存感知路由据此记录前缀 -> 账号亲和
+	CachePrefixHash string
+	// ResponseCacheHit 响应缓存命中：未请求上游，费用按 ResponseCacheCostRatio 折算
+	ResponseCacheHit       bool
+	ResponseCacheCostRatio float64
 }
 
 // RecordUsage 记录使用量并扣费（或更新订阅用量）
@@ -3523,6 +3602,13 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	account := input.Account
 	subscription := input.Subscription
 
+	ctx, span := startRecordUsageSpan(ctx, account, result.Model, result.RequestID)
+	defer span.End()
+
+	if result.FirstTokenMs != nil && !input.ResponseCacheHit {
+		metrics.ObserveFirstToken(account.Platform, result.Model, *result.FirstTokenMs)
+	}
+
 	// 获取费率倍数
 	multiplier := s.cfg.Default.RateMultiplier
 	if apiKey.GroupID != nil && apiKey.Group != nil {
@@ -3552,7 +3638,7 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 			CacheReadTokens:     result.Usage.CacheReadInputTokens,
 		}
 		var err error
-		cost, err = s.billingService.CalculateCost(result.Model, tokens, multiplier)
+		cost, err = s.billingService.CalculateCostForGroup(apiKey.GroupID, result.Model, tokens, multiplier)
 		if err != nil {
 			log.Printf("Calculate cost failed: %v", err)
 			cost = &CostBreakdown{ActualCost: 0}
@@ -3565,6 +3651,11 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if isSubscriptionBilling {
 		billingType = BillingTypeSubscription
 	}
+	// 响应缓存命中：单独的计费类型，费用按比例折算（默认免费），扣费仍走订阅/余额路径
+	if input.ResponseCacheHit {
+		cost = cost.Scale(input.ResponseCacheCostRatio)
+		billingType = BillingTypeResponseCache
+	}
 
 	// 创建使用日志
 	durationMs := int(result.Duration.Milliseconds())
@@ -3610,6 +3701,10 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 		usageLog.IPAddress = &input.IPAddress
 	}
 
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		usageLog.TraceID = &traceID
+	}
+
 	// 添加分组和订阅关联
 	if apiKey.GroupID != nil {
 		usageLog.GroupID = apiKey.GroupID
@@ -3617,20 +3712,34 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if subscription != nil {
 		usageLog.SubscriptionID = &subscription.ID
 	}
+	if user.Organization != nil {
+		usageLog.OrganizationID = &user.Organization.OrganizationID
+	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	recordUsageSpanResult(span, usageLog, inserted, err)
 	if err != nil {
 		log.Printf("Create usage log failed: %v", err)
 	}
+	// 用量日志写入失败时仍计费（宁可多记不可漏记）；仅重复请求（未插入且无错误）跳过
+	shouldBill := inserted || err != nil
+
+	if !input.ResponseCacheHit {
+		s.recordCachePrefixAffinity(ctx, apiKey.GroupID, input.CachePrefixHash, account.ID, result.Usage)
+		// 账号预算按账号口径费用累计（缓存命中未使用账号，不计入）
+		if shouldBill && s.accountBudgetService != nil {
+			s.accountBudgetService.RecordUsage(ctx, account, cost.TotalCost*accountRateMultiplier, int64(usageLog.TotalTokens()))
+		}
+	}
 
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
-		s.deferredService.ScheduleLastUsedUpdate(account.ID)
+		if !input.ResponseCacheHit {
+			s.deferredService.ScheduleLastUsedUpdate(account.ID)
+		}
 		return nil
 	}
 
-	shouldBill := inserted || err != nil
-
 	// 根据计费类型执行扣费
 	if isSubscriptionBilling {
 		// 订阅模式：更新订阅用量（使用 TotalCost 原始费用，不考虑倍率）
@@ -3638,13 +3747,22 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 			if err := s.userSubRepo.IncrementUsage(ctx, subscription.ID, cost.TotalCost); err != nil {
 				log.Printf("Increment subscription usage failed: %v", err)
 			}
-			// 异步更新订阅缓存
-			s.billingCacheService.QueueUpdateSubscriptionUsage(user.ID, *apiKey.GroupID, cost.TotalCost)
+			// 异步更新订阅缓存（组织成员可能使用 owner 的订阅）
+			s.billingCacheService.QueueUpdateSubscriptionUsage(subscriptionHolderID(user, subscription), *apiKey.GroupID, cost.TotalCost)
+			// 共享 owner 订阅的用量计入成员月度花费
+			if usesSharedSubscription(user, subscription) {
+				recordOrgMemberUsage(ctx, s.orgRepo, s.billingCacheService, user.ID, cost.TotalCost)
+			}
+		}
+	} else if org := user.Organization; org != nil {
+		// 组织成员：从组织钱包扣费，并累加成员月度花费
+		if shouldBill && cost.ActualCost > 0 {
+			recordOrganizationUsage(ctx, s.orgRepo, s.billingCacheService, org.OrganizationID, user.ID, cost.ActualCost, usageLog.RequestID)
 		}
 	} else {
 		// 余额模式：扣除用户余额（使用 ActualCost 考虑倍率后的费用）
 		if shouldBill && cost.ActualCost > 0 {
-			if err := s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost); err != nil {
+			if err := s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost, BalanceChangeSource{Type: BalanceSourceUsage, ReferenceID: usageLog.RequestID}); err != nil {
 				log.Printf("Deduct balance failed: %v", err)
 			}
 			// 异步更新余额缓存
@@ -3652,8 +3770,15 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 		}
 	}
 
-	// Schedule batch update for account last_used_at
-	s.deferredService.ScheduleLastUsedUpdate(account.ID)
+	// API Key 花费计数（用于 Key 级别的总/日/月上限）
+	if shouldBill && cost.ActualCost > 0 {
+		recordAPIKeyUsage(ctx, s.apiKeyRepo, s.billingCacheService, apiKey.ID, cost.ActualCost)
+	}
+
+	// Schedule batch update for account last_used_at（缓存命中未使用账号）
+	if !input.ResponseCacheHit {
+		s.deferredService.ScheduleLastUsedUpdate(account.ID)
+	}
 
 	return nil
 }
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 网关链路的 span 辅助函数（调度、令牌刷新、使用量记录）。追踪未开启时 span 为 no-op。
+
+// candidateSkipStats 调度候选账号过滤时各跳过原因的计数
+type candidateSkipStats struct {
+	Excluded      int // 本次请求已失败切换排除
+	Missing       int // 路由配置的账号不在可调度快照中
+	Unschedulable int // 限流/过载/停用等状态不可调度
+	Platform      int // 平台不匹配
+	ModelScope    int // 模型级限流
+	ModelMapping  int // 账号不支持请求模型
+	WindowCost    int // 窗口费用超限
+}
+
+// recordCandidateFilter 在当前 span 上记录一次候选过滤结果（layer 区分模型路由层与负载感知层）
+func recordCandidateFilter(ctx context.Context, layer string, total, candidates int, skipped candidateSkipStats) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.AddEvent("scheduler.candidates", trace.WithAttributes(
+		attribute.String("scheduler.layer", layer),
+		attribute.Int("scheduler.total", total),
+		attribute.Int("scheduler.candidates", candidates),
+		attribute.Int("scheduler.skipped.excluded", skipped.Excluded),
+		attribute.Int("scheduler.skipped.missing", skipped.Missing),
+		attribute.Int("scheduler.skipped.unschedulable", skipped.Unschedulable),
+		attribute.Int("scheduler.skipped.platform", skipped.Platform),
+		attribute.Int("scheduler.skipped.model_scope", skipped.ModelScope),
+		attribute.Int("scheduler.skipped.model_mapping", skipped.ModelMapping),
+		attribute.Int("scheduler.skipped.window_cost", skipped.WindowCost),
+	))
+}
+
+// startSelectAccountSpan 创建账号调度 span
+func startSelectAccountSpan(ctx context.Context, groupID *int64, requestedModel string, excludedCount int) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "scheduler.select_account",
+		attribute.Int64("group.id", derefGroupID(groupID)),
+		attribute.String("model", requestedModel),
+		attribute.Int("scheduler.excluded", excludedCount),
+	)
+}
+
+// endSelectAccountSpan 记录调度结果（选中账号、是否直接获得槽位或进入等待计划）并结束 span
+func endSelectAccountSpan(span trace.Span, result *AccountSelectionResult, err error) {
+	if result != nil && result.Account != nil {
+		span.SetAttributes(
+			attribute.Int64("account.id", result.Account.ID),
+			attribute.String("account.platform", result.Account.Platform),
+			attribute.Bool("scheduler.acquired", result.Acquired),
+			attribute.Bool("scheduler.wait_plan", result.WaitPlan != nil),
+		)
+	}
+	tracing.End(span, err)
+}
+
+// startTokenRefreshSpan 创建 OAuth 令牌刷新 span，返回以刷新结果结束 span 的函数
+func startTokenRefreshSpan(ctx context.Context, account *Account) (context.Context, func(error)) {
+	ctx, span := tracing.Start(ctx, "oauth.refresh_token",
+		attribute.Int64("account.id", account.ID),
+		attribute.String("account.platform", account.Platform),
+	)
+	return ctx, func(err error) { tracing.End(span, err) }
+}
+
+// startRecordUsageSpan 创建使用量记录 span
+func startRecordUsageSpan(ctx context.Context, account *Account, model, requestID string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "usage.record",
+		attribute.Int64("account.id", account.ID),
+		attribute.String("model", model),
+		attribute.String("request.id", requestID),
+	)
+}
+
+// recordUsageSpanResult 记录使用日志写入结果（inserted=false 且无错误表示重复请求已记录）
+func recordUsageSpanResult(span trace.Span, usageLog *UsageLog, inserted bool, err error) {
+	span.SetAttributes(
+		attribute.Int("usage.total_tokens", usageLog.TotalTokens()),
+		attribute.Float64("usage.actual_cost", usageLog.ActualCost),
+		attribute.Bool("usage.inserted", inserted),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
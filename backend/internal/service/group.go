@@ -36,6 +36,13 @@ type Group struct {
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled bool
 
+	// 分组内所有 Key 共享的 RPM/TPM 限制
+	RateLimits RequestRateLimits
+
+	// 响应缓存配置；命中时按 ResponseCacheCostRatio 比例计费（0 表示免费）
+	ResponseCache          ResponseCacheSettings
+	ResponseCacheCostRatio float64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 发票主体类型：个人用户（不含组织钱包扣费的用量）或组织（组织钱包扣费的用量）
+const (
+	InvoiceSubjectUser         = "user"
+	InvoiceSubjectOrganization = "organization"
+)
+
+const (
+	InvoiceStatusIssued = "issued"
+	// InvoiceStatusVoid 已作废：保留编号与内容，同一账期可重新开具
+	InvoiceStatusVoid = "void"
+)
+
+// 发票明细类型
+const (
+	InvoiceLineUsage        = "usage"        // 按模型 + 分组汇总的用量
+	InvoiceLineSubscription = "subscription" // 订阅兑换码费用
+)
+
+var (
+	ErrInvoiceNotFound = infraerrors.NotFound("INVOICE_NOT_FOUND", "invoice not found")
+	ErrInvoiceExists   = infraerrors.Conflict("INVOICE_EXISTS", "an invoice for this subject and period already exists")
+	ErrInvoiceVoided   = infraerrors.Conflict("INVOICE_VOIDED", "invoice has been voided")
+	ErrInvoiceNoEmail  = infraerrors.BadRequest("INVOICE_NO_EMAIL", "invoice recipient has no email address")
+)
+
+// InvoiceLineItem 发票明细行
+type InvoiceLineItem struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+	Model       string `json:"model,omitempty"`
+	GroupID     *int64 `json:"group_id,omitempty"`
+	GroupName   string `json:"group_name,omitempty"`
+	// SubscriptionCovered 用量由订阅额度抵扣，金额计为 0，仅列示用量
+	SubscriptionCovered bool  `json:"subscription_covered,omitempty"`
+	Requests            int64 `json:"requests"`
+	InputTokens         int64 `json:"input_tokens"`
+	OutputTokens        int64 `json:"output_tokens"`
+	CacheCreationTokens int64 `json:"cache_creation_tokens"`
+	CacheReadTokens     int64 `json:"cache_read_tokens"`
+	// ValidityDays 订阅明细的有效天数
+	ValidityDays int `json:"validity_days,omitempty"`
+	// Cost 账期内 actual_cost 合计（订阅抵扣的用量同样记录，便于核对）
+	Cost float64 `json:"cost"`
+	// Amount 计入发票小计的金额（保留两位小数）
+	Amount float64 `json:"amount"`
+}
+
+// TotalTokens 明细行的总 token 数
+func (l *InvoiceLineItem) TotalTokens() int64 {
+	return l.InputTokens + l.OutputTokens + l.CacheCreationTokens + l.CacheReadTokens
+}
+
+// InvoiceTopUp 账期内的充值记录（来自余额账本，不计入发票小计）
+type InvoiceTopUp struct {
+	Source    string    `json:"source"`
+	Reference string    `json:"reference"`
+	Amount    float64   `json:"amount"`
+	PaidAt    time.Time `json:"paid_at"`
+}
+
+// InvoiceIssuer 开票方信息快照（开票时的站点品牌与公司信息）
+type InvoiceIssuer struct {
+	SiteName       string `json:"site_name"`
+	SiteLogo       string `json:"site_logo,omitempty"`
+	CompanyName    string `json:"company_name,omitempty"`
+	CompanyAddress string `json:"company_address,omitempty"`
+	CompanyTaxID   string `json:"company_tax_id,omitempty"`
+	CompanyEmail   string `json:"company_email,omitempty"`
+	TaxLabel       string `json:"tax_label,omitempty"`
+	FooterNote     string `json:"footer_note,omitempty"`
+}
+
+// InvoiceBillTo 收票方信息快照
+type InvoiceBillTo struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Invoice 月度发票；明细、开票方与收票方均为开票时的快照，PDF/HTML 由快照按需渲染
+type Invoice struct {
+	ID          int64
+	Number      string
+	Sequence    int64
+	SubjectType string
+	SubjectID   int64
+	// RecipientUserID 接收发票的用户：个人发票为用户本人，组织发票为组织所有者
+	RecipientUserID int64
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	Currency        string
+
+	Items      []InvoiceLineItem
+	TopUps     []InvoiceTopUp
+	Subtotal   float64
+	TaxRate    float64 // 百分比，例如 8.5 表示 8.5%
+	TaxAmount  float64
+	Total      float64
+	TopUpTotal float64
+
+	Issuer InvoiceIssuer
+	BillTo InvoiceBillTo
+
+	Status     string
+	EmailedAt  *time.Time
+	EmailError *string
+	CreatedBy  *int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PeriodLabel 账期标识，例如 2026-09
+func (inv *Invoice) PeriodLabel() string {
+	return inv.PeriodStart.Format("2006-01")
+}
+
+// InvoiceFilter 发票列表查询条件，零值表示不过滤
+type InvoiceFilter struct {
+	SubjectType     string
+	SubjectID       int64
+	RecipientUserID int64
+	Status          string
+	PeriodStart     *time.Time
+}
+
+// InvoiceSubject 需要开具发票的主体
+type InvoiceSubject struct {
+	Type string
+	ID   int64
+}
+
+type InvoiceRepository interface {
+	// Create 分配序号并写入发票；同一主体同一账期已有未作废发票时返回 false
+	Create(ctx context.Context, inv *Invoice, minSequence int64, formatNumber func(seq int64) string) (bool, error)
+	// UpdateContent 重新生成后覆盖明细与金额，编号保持不变
+	UpdateContent(ctx context.Context, inv *Invoice) error
+	GetByID(ctx context.Context, id int64) (*Invoice, error)
+	List(ctx context.Context, params pagination.PaginationParams, filter InvoiceFilter) ([]Invoice, *pagination.PaginationResult, error)
+	UpdateStatus(ctx context.Context, id int64, status string) error
+	// UpdateEmailResult 记录邮件发送结果；errMsg 为 nil 表示发送成功
+	UpdateEmailResult(ctx context.Context, id int64, emailedAt *time.Time, errMsg *string) error
+
+	// AggregateUsage 按模型、分组与计费类型汇总主体在 [start, end) 内的用量
+	AggregateUsage(ctx context.Context, subject InvoiceSubject, start, end time.Time) ([]InvoiceLineItem, error)
+	// ListSubscriptionFees 用户在 [start, end) 内兑换的订阅码（兑换码面值作为订阅费用）
+	ListSubscriptionFees(ctx context.Context, userID int64, start, end time.Time) ([]InvoiceLineItem, error)
+	// ListTopUps 用户在 [start, end) 内的充值（Creem 支付与余额兑换码）
+	ListTopUps(ctx context.Context, userID int64, start, end time.Time) ([]InvoiceTopUp, error)
+	// ListBillableSubjects 在 [start, end) 内有用量、订阅或充值的主体
+	ListBillableSubjects(ctx context.Context, start, end time.Time) ([]InvoiceSubject, error)
+}
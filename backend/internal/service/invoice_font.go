@@ -0,0 +1,61 @@
+package service
+
+import (
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pdf"
+)
+
+// invoicePDFFontCandidates 未配置 invoice.pdf_font_path 时依次探测的系统 CJK 字体
+// （官方 Docker 镜像内置文泉驿正黑）
+var invoicePDFFontCandidates = []string{
+	"/usr/share/fonts/wenquanyi/wqy-zenhei/wqy-zenhei.ttc",
+	"/usr/share/fonts/truetype/wqy/wqy-zenhei.ttc",
+	"/usr/share/fonts/wqy-zenhei/wqy-zenhei.ttc",
+	"/usr/share/fonts/truetype/droid/DroidSansFallbackFull.ttf",
+	"/usr/share/fonts/google-droid/DroidSansFallbackFull.ttf",
+	"/usr/share/fonts/droid/DroidSansFallbackFull.ttf",
+}
+
+var (
+	invoicePDFFontPath string
+	invoicePDFFontOnce sync.Once
+	invoicePDFFont     *pdf.UnicodeFont
+)
+
+// ConfigureInvoicePDFFont 设置发票 PDF 使用的 Unicode 字体文件（TrueType/TTC），需在首次渲染前调用
+func ConfigureInvoicePDFFont(path string) {
+	invoicePDFFontPath = strings.TrimSpace(path)
+}
+
+// loadInvoicePDFFont 首次调用时加载字体；找不到可用字体时返回 nil，PDF 中的非 ASCII 字符退化为 '?'
+func loadInvoicePDFFont() *pdf.UnicodeFont {
+	invoicePDFFontOnce.Do(func() {
+		paths := invoicePDFFontCandidates
+		if invoicePDFFontPath != "" {
+			paths = []string{invoicePDFFontPath}
+		}
+		for _, path := range paths {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				if invoicePDFFontPath != "" {
+					log.Printf("[Invoice] read pdf font failed: path=%s err=%v", path, err)
+				}
+				continue
+			}
+			font, err := pdf.ParseUnicodeFont(data)
+			if err != nil {
+				log.Printf("[Invoice] parse pdf font failed: path=%s err=%v", path, err)
+				continue
+			}
+			invoicePDFFont = font
+			log.Printf("[Invoice] pdf unicode font loaded: path=%s", path)
+			return
+		}
+		log.Printf("[Invoice] no unicode pdf font available, non-ASCII text will render as '?' (set invoice.pdf_font_path)")
+	})
+	return invoicePDFFont
+}
@@ -0,0 +1,504 @@
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"image"
+	_ "image/jpeg" // 注册 JPEG 解码器，用于 PDF 中的站点 Logo
+	_ "image/png"  // 注册 PNG 解码器，用于 PDF 中的站点 Logo
+	"strconv"
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pdf"
+)
+
+var invoiceHTMLTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"money":  formatInvoiceMoney,
+	"tokens": formatInvoiceTokens,
+	"lines":  func(s string) []string { return strings.Split(strings.TrimSpace(s), "\n") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Inv.Issuer.SiteName}} - Invoice {{.Inv.Number}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, "PingFang SC", "Microsoft YaHei", sans-serif; color: #111827; margin: 0; padding: 24px; background: #f3f4f6; }
+.invoice { max-width: 820px; margin: 0 auto; background: #fff; padding: 40px; border-radius: 8px; }
+.header { display: flex; justify-content: space-between; align-items: flex-start; border-bottom: 2px solid #111827; padding-bottom: 16px; }
+.brand { display: flex; align-items: center; gap: 12px; font-size: 20px; font-weight: 600; }
+.brand img { max-height: 48px; max-width: 160px; }
+.title { text-align: right; }
+.title h1 { margin: 0; font-size: 28px; letter-spacing: 2px; }
+.meta { color: #4b5563; font-size: 13px; line-height: 1.6; }
+.parties { display: flex; justify-content: space-between; margin: 24px 0; font-size: 13px; line-height: 1.6; }
+.parties h3 { margin: 0 0 4px; font-size: 12px; text-transform: uppercase; color: #6b7280; }
+table { width: 100%; border-collapse: collapse; font-size: 13px; }
+th { background: #f3f4f6; text-align: left; padding: 8px; font-weight: 600; }
+td { padding: 8px; border-bottom: 1px solid #e5e7eb; }
+.num { text-align: right; white-space: nowrap; }
+.muted { color: #6b7280; font-size: 12px; }
+.totals { margin-top: 16px; margin-left: auto; width: 320px; font-size: 14px; }
+.totals td { border: none; padding: 4px 8px; }
+.totals .grand td { border-top: 2px solid #111827; font-weight: 700; font-size: 16px; }
+h2 { font-size: 15px; margin: 32px 0 8px; }
+.footer { margin-top: 32px; color: #6b7280; font-size: 12px; white-space: pre-line; }
+.void { color: #b91c1c; font-weight: 700; }
+</style>
+</head>
+<body>
+<div class="invoice">
+  <div class="header">
+    <div class="brand">{{if .Logo}}<img src="{{.Logo}}" alt="">{{end}}<span>{{.Inv.Issuer.SiteName}}</span></div>
+    <div class="title">
+      <h1>INVOICE</h1>
+      <div class="meta">
+        No. {{.Inv.Number}}<br>
+        Period: {{.Inv.PeriodLabel}}<br>
+        Issued: {{.Inv.CreatedAt.Format "2006-01-02"}}
+        {{if eq .Inv.Status "void"}}<br><span class="void">VOID</span>{{end}}
+      </div>
+    </div>
+  </div>
+  <div class="parties">
+    <div>
+      <h3>From</h3>
+      {{if .Inv.Issuer.CompanyName}}<strong>{{.Inv.Issuer.CompanyName}}</strong><br>{{else}}<strong>{{.Inv.Issuer.SiteName}}</strong><br>{{end}}
+      {{if .Inv.Issuer.CompanyAddress}}{{range lines .Inv.Issuer.CompanyAddress}}{{.}}<br>{{end}}{{end}}
+      {{if .Inv.Issuer.CompanyTaxID}}Tax ID: {{.Inv.Issuer.CompanyTaxID}}<br>{{end}}
+      {{if .Inv.Issuer.CompanyEmail}}{{.Inv.Issuer.CompanyEmail}}{{end}}
+    </div>
+    <div style="text-align:right">
+      <h3>Bill To</h3>
+      <strong>{{.Inv.BillTo.Name}}</strong><br>
+      {{.Inv.BillTo.Email}}
+    </div>
+  </div>
+  <table>
+    <thead>
+      <tr><th>Description</th><th class="num">Requests</th><th class="num">Input</th><th class="num">Output</th><th class="num">Cache</th><th class="num">Amount</th></tr>
+    </thead>
+    <tbody>
+    {{range .Inv.Items}}
+      <tr>
+        <td>{{.Description}}{{if .SubscriptionCovered}}<div class="muted">Covered by subscription (cost {{money $.Inv.Currency .Cost}})</div>{{end}}</td>
+        <td class="num">{{if eq .Kind "usage"}}{{tokens .Requests}}{{end}}</td>
+        <td class="num">{{if eq .Kind "usage"}}{{tokens .InputTokens}}{{end}}</td>
+        <td class="num">{{if eq .Kind "usage"}}{{tokens .OutputTokens}}{{end}}</td>
+        <td class="num">{{if eq .Kind "usage"}}{{tokens .CacheReadTokens}} / {{tokens .CacheCreationTokens}}{{end}}</td>
+        <td class="num">{{money $.Inv.Currency .Amount}}</td>
+      </tr>
+    {{else}}
+      <tr><td colspan="6" class="muted">No billable activity in this period.</td></tr>
+    {{end}}
+    </tbody>
+  </table>
+  <table class="totals">
+    <tr><td>Subtotal</td><td class="num">{{money .Inv.Currency .Inv.Subtotal}}</td></tr>
+    <tr><td>{{.Inv.Issuer.TaxLabel}} ({{.TaxRate}}%)</td><td class="num">{{money .Inv.Currency .Inv.TaxAmount}}</td></tr>
+    <tr class="grand"><td>Total</td><td class="num">{{money .Inv.Currency .Inv.Total}}</td></tr>
+  </table>
+  {{if .Inv.TopUps}}
+  <h2>Top-ups received</h2>
+  <table>
+    <thead><tr><th>Date</th><th>Source</th><th>Reference</th><th class="num">Credited</th></tr></thead>
+    <tbody>
+    {{range .Inv.TopUps}}
+      <tr><td>{{.PaidAt.Format "2006-01-02"}}</td><td>{{.Source}}</td><td>{{.Reference}}</td><td class="num">{{money $.Inv.Currency .Amount}}</td></tr>
+    {{end}}
+      <tr><td colspan="3"><strong>Total top-ups</strong></td><td class="num"><strong>{{money .Inv.Currency .Inv.TopUpTotal}}</strong></td></tr>
+    </tbody>
+  </table>
+  <p class="muted">Top-ups are credited to the account balance and are listed for reference; they are not part of the invoice total.</p>
+  {{end}}
+  {{if .Inv.Issuer.FooterNote}}<div class="footer">{{.Inv.Issuer.FooterNote}}</div>{{end}}
+</div>
+</body>
+</html>
+`))
+
+// 发票下载格式
+const (
+	InvoiceFormatPDF  = "pdf"
+	InvoiceFormatHTML = "html"
+)
+
+// RenderInvoice 按格式渲染发票，返回内容与 Content-Type
+func RenderInvoice(inv *Invoice, format string) ([]byte, string, error) {
+	switch format {
+	case InvoiceFormatPDF:
+		data, err := RenderInvoicePDF(inv)
+		return data, "application/pdf", err
+	case InvoiceFormatHTML:
+		data, err := RenderInvoiceHTML(inv)
+		return data, "text/html; charset=utf-8", err
+	default:
+		return nil, "", infraerrors.BadRequest("INVOICE_INVALID_FORMAT", "format must be pdf or html")
+	}
+}
+
+// RenderInvoiceHTML 渲染发票 HTML（支持完整 Unicode，用于在线查看与邮件正文）
+func RenderInvoiceHTML(inv *Invoice) ([]byte, error) {
+	data := struct {
+		Inv     *Invoice
+		Logo    template.URL
+		TaxRate string
+	}{
+		Inv:     inv,
+		TaxRate: strconv.FormatFloat(inv.TaxRate, 'f', -1, 64),
+	}
+	// 只接受 data:image/ 形式的 Logo，避免注入任意 URL
+	if strings.HasPrefix(inv.Issuer.SiteLogo, "data:image/") {
+		data.Logo = template.URL(inv.Issuer.SiteLogo)
+	}
+	var buf bytes.Buffer
+	if err := invoiceHTMLTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("render invoice html: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF 版式参数（单位：pt）
+const (
+	invoicePDFMargin   = 40.0
+	invoicePDFBottom   = pdf.PageHeight - 60
+	invoicePDFRowH     = 16.0
+	invoicePDFFontSize = 9.0
+)
+
+// invoicePDFColumn 明细表列定义，right 为 true 时右对齐
+type invoicePDFColumn struct {
+	title string
+	x     float64
+	width float64
+	right bool
+}
+
+var invoicePDFItemColumns = []invoicePDFColumn{
+	{title: "Description", x: invoicePDFMargin, width: 195},
+	{title: "Requests", x: 235, width: 55, right: true},
+	{title: "Input", x: 290, width: 65, right: true},
+	{title: "Output", x: 355, width: 65, right: true},
+	{title: "Cache R/W", x: 420, width: 75, right: true},
+	{title: "Amount", x: 495, width: pdf.PageWidth - invoicePDFMargin - 495, right: true},
+}
+
+var invoicePDFTopUpColumns = []invoicePDFColumn{
+	{title: "Date", x: invoicePDFMargin, width: 80},
+	{title: "Source", x: 125, width: 120},
+	{title: "Reference", x: 250, width: 220},
+	{title: "Credited", x: 475, width: pdf.PageWidth - invoicePDFMargin - 475, right: true},
+}
+
+// invoicePDFWriter 负责分页的 PDF 输出
+type invoicePDFWriter struct {
+	doc  *pdf.Document
+	page *pdf.Page
+	y    float64
+	inv  *Invoice
+}
+
+// RenderInvoicePDF 渲染发票 PDF
+//
+// ASCII 文本使用标准 Helvetica 字体；含非 ASCII 字符（例如中文用户名）的文本使用嵌入的 Unicode 字体子集，
+// 未找到可用字体时这些字符显示为 '?'，完整内容以 HTML 版本为准。
+func RenderInvoicePDF(inv *Invoice) ([]byte, error) {
+	w := &invoicePDFWriter{doc: pdf.New(), inv: inv}
+	if font := loadInvoicePDFFont(); font != nil {
+		w.doc.SetUnicodeFont(font)
+	}
+	w.doc.SetTitle("Invoice " + inv.Number)
+	w.doc.SetAuthor(inv.Issuer.SiteName)
+	w.newPage()
+	w.header()
+	w.parties()
+
+	w.tableHeader(invoicePDFItemColumns)
+	if len(inv.Items) == 0 {
+		w.ensureSpace(invoicePDFRowH, invoicePDFItemColumns)
+		w.page.Text(invoicePDFMargin+4, w.y+11, pdf.Helvetica, invoicePDFFontSize, "No billable activity in this period.")
+		w.y += invoicePDFRowH
+	}
+	for i := range inv.Items {
+		item := &inv.Items[i]
+		cells := []string{item.Description, "", "", "", "", formatInvoiceMoney(inv.Currency, item.Amount)}
+		if item.Kind == InvoiceLineUsage {
+			cells[1] = formatInvoiceTokens(item.Requests)
+			cells[2] = formatInvoiceTokens(item.InputTokens)
+			cells[3] = formatInvoiceTokens(item.OutputTokens)
+			cells[4] = formatInvoiceCompact(item.CacheReadTokens) + "/" + formatInvoiceCompact(item.CacheCreationTokens)
+		}
+		if item.SubscriptionCovered {
+			cells[0] += " (subscription)"
+		}
+		w.row(invoicePDFItemColumns, cells)
+	}
+
+	w.totals()
+
+	if len(inv.TopUps) > 0 {
+		w.ensureSpace(60, nil)
+		w.y += 24
+		w.page.Text(invoicePDFMargin, w.y, pdf.HelveticaBold, 11, "Top-ups received")
+		w.y += 8
+		w.tableHeader(invoicePDFTopUpColumns)
+		for i := range inv.TopUps {
+			t := &inv.TopUps[i]
+			w.row(invoicePDFTopUpColumns, []string{t.PaidAt.Format("2006-01-02"), t.Source, t.Reference, formatInvoiceMoney(inv.Currency, t.Amount)})
+		}
+		w.row(invoicePDFTopUpColumns, []string{"Total top-ups", "", "", formatInvoiceMoney(inv.Currency, inv.TopUpTotal)})
+		w.y += 6
+		w.paragraph("Top-ups are credited to the account balance and are listed for reference; they are not part of the invoice total.", 8)
+	}
+
+	if note := strings.TrimSpace(inv.Issuer.FooterNote); note != "" {
+		w.y += 16
+		for _, line := range strings.Split(note, "\n") {
+			w.paragraph(strings.TrimSpace(line), 8)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := w.doc.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("render invoice pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (w *invoicePDFWriter) newPage() {
+	w.page = w.doc.AddPage()
+	w.y = invoicePDFMargin
+	if w.doc.PageCount() > 1 {
+		w.page.Text(invoicePDFMargin, w.y+10, pdf.HelveticaBold, 10, w.inv.Issuer.SiteName)
+		w.page.TextRight(pdf.PageWidth-invoicePDFMargin, w.y+10, pdf.Helvetica, 9, "Invoice "+w.inv.Number+" (continued)")
+		w.y += 24
+	}
+	w.page.TextRight(pdf.PageWidth-invoicePDFMargin, pdf.PageHeight-30, pdf.Helvetica, 8, "Page "+strconv.Itoa(w.doc.PageCount()))
+}
+
+// ensureSpace 剩余空间不足时换页；cols 非空时在新页重复表头
+func (w *invoicePDFWriter) ensureSpace(h float64, cols []invoicePDFColumn) {
+	if w.y+h <= invoicePDFBottom {
+		return
+	}
+	w.newPage()
+	if cols != nil {
+		w.tableHeader(cols)
+	}
+}
+
+func (w *invoicePDFWriter) header() {
+	inv := w.inv
+	x := invoicePDFMargin
+	if img := decodeInvoiceLogo(inv.Issuer.SiteLogo); img != nil {
+		if embedded, err := w.doc.AddImage(img); err == nil {
+			h := 40.0
+			width := h * float64(embedded.Width()) / float64(embedded.Height())
+			if width > 140 {
+				width = 140
+				h = width * float64(embedded.Height()) / float64(embedded.Width())
+			}
+			w.page.DrawImage(embedded, x, w.y, width, h)
+			x += width + 10
+		}
+	}
+	w.page.Text(x, w.y+26, pdf.HelveticaBold, 16, w.doc.Truncate(pdf.HelveticaBold, 16, inv.Issuer.SiteName, 300-x))
+
+	right := pdf.PageWidth - invoicePDFMargin
+	w.page.TextRight(right, w.y+20, pdf.HelveticaBold, 22, "INVOICE")
+	w.page.TextRight(right, w.y+36, pdf.Helvetica, 9, "No. "+inv.Number)
+	w.page.TextRight(right, w.y+48, pdf.Helvetica, 9, "Period: "+inv.PeriodLabel())
+	w.page.TextRight(right, w.y+60, pdf.Helvetica, 9, "Issued: "+inv.CreatedAt.Format("2006-01-02"))
+	if inv.Status == InvoiceStatusVoid {
+		w.page.TextRight(right, w.y+74, pdf.HelveticaBold, 11, "VOID")
+	}
+	w.y += 84
+	w.page.Line(invoicePDFMargin, w.y, right, w.y, 1)
+	w.y += 20
+}
+
+func (w *invoicePDFWriter) parties() {
+	inv := w.inv
+	left := []string{}
+	if inv.Issuer.CompanyAddress != "" {
+		for _, line := range strings.Split(strings.TrimSpace(inv.Issuer.CompanyAddress), "\n") {
+			left = append(left, strings.TrimSpace(line))
+		}
+	}
+	if inv.Issuer.CompanyTaxID != "" {
+		left = append(left, "Tax ID: "+inv.Issuer.CompanyTaxID)
+	}
+	if inv.Issuer.CompanyEmail != "" {
+		left = append(left, inv.Issuer.CompanyEmail)
+	}
+	fromName := inv.Issuer.CompanyName
+	if fromName == "" {
+		fromName = inv.Issuer.SiteName
+	}
+
+	right := pdf.PageWidth - invoicePDFMargin
+	w.page.Text(invoicePDFMargin, w.y, pdf.HelveticaBold, 8, "FROM")
+	w.page.TextRight(right, w.y, pdf.HelveticaBold, 8, "BILL TO")
+	w.y += 14
+	w.page.Text(invoicePDFMargin, w.y, pdf.HelveticaBold, 10, w.doc.Truncate(pdf.HelveticaBold, 10, fromName, 250))
+	w.page.TextRight(right, w.y, pdf.HelveticaBold, 10, w.doc.Truncate(pdf.HelveticaBold, 10, inv.BillTo.Name, 250))
+	lineY := w.y
+	for i, line := range left {
+		lineY = w.y + float64(i+1)*12
+		w.page.Text(invoicePDFMargin, lineY, pdf.Helvetica, 9, w.doc.Truncate(pdf.Helvetica, 9, line, 250))
+	}
+	w.page.TextRight(right, w.y+12, pdf.Helvetica, 9, w.doc.Truncate(pdf.Helvetica, 9, inv.BillTo.Email, 250))
+	if lineY < w.y+12 {
+		lineY = w.y + 12
+	}
+	w.y = lineY + 24
+}
+
+func (w *invoicePDFWriter) tableHeader(cols []invoicePDFColumn) {
+	w.ensureSpace(invoicePDFRowH*2, nil)
+	w.page.FillRect(invoicePDFMargin, w.y, pdf.PageWidth-2*invoicePDFMargin, invoicePDFRowH, 0.93)
+	w.drawCells(cols, nil, pdf.HelveticaBold)
+	w.y += invoicePDFRowH
+}
+
+func (w *invoicePDFWriter) row(cols []invoicePDFColumn, cells []string) {
+	w.ensureSpace(invoicePDFRowH, cols)
+	w.drawCells(cols, cells, pdf.Helvetica)
+	w.y += invoicePDFRowH
+	w.page.Line(invoicePDFMargin, w.y, pdf.PageWidth-invoicePDFMargin, w.y, 0.3)
+}
+
+// drawCells 输出一行单元格；cells 为 nil 时输出列标题
+func (w *invoicePDFWriter) drawCells(cols []invoicePDFColumn, cells []string, font pdf.Font) {
+	baseline := w.y + 11
+	for i, col := range cols {
+		text := col.title
+		if cells != nil {
+			text = cells[i]
+		}
+		text = w.doc.Truncate(font, invoicePDFFontSize, text, col.width-4)
+		if col.right {
+			w.page.TextRight(col.x+col.width-4, baseline, font, invoicePDFFontSize, text)
+		} else {
+			w.page.Text(col.x+4, baseline, font, invoicePDFFontSize, text)
+		}
+	}
+}
+
+func (w *invoicePDFWriter) totals() {
+	inv := w.inv
+	w.ensureSpace(70, nil)
+	w.y += 10
+	labelX := 340.0
+	right := pdf.PageWidth - invoicePDFMargin - 4
+	rows := [][2]string{
+		{"Subtotal", formatInvoiceMoney(inv.Currency, inv.Subtotal)},
+		{fmt.Sprintf("%s (%s%%)", inv.Issuer.TaxLabel, strconv.FormatFloat(inv.TaxRate, 'f', -1, 64)), formatInvoiceMoney(inv.Currency, inv.TaxAmount)},
+	}
+	for _, r := range rows {
+		w.y += 14
+		w.page.Text(labelX, w.y, pdf.Helvetica, 10, r[0])
+		w.page.TextRight(right, w.y, pdf.Helvetica, 10, r[1])
+	}
+	w.y += 8
+	w.page.Line(labelX, w.y, pdf.PageWidth-invoicePDFMargin, w.y, 1)
+	w.y += 16
+	w.page.Text(labelX, w.y, pdf.HelveticaBold, 12, "Total")
+	w.page.TextRight(right, w.y, pdf.HelveticaBold, 12, formatInvoiceMoney(inv.Currency, inv.Total))
+	w.y += 6
+}
+
+// paragraph 输出按宽度自动换行的小字段落
+func (w *invoicePDFWriter) paragraph(text string, size float64) {
+	maxWidth := pdf.PageWidth - 2*invoicePDFMargin
+	line := ""
+	flush := func() {
+		w.ensureSpace(size+4, nil)
+		w.y += size + 4
+		w.page.Text(invoicePDFMargin, w.y, pdf.Helvetica, size, line)
+		line = ""
+	}
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line != "" && w.doc.TextWidth(pdf.Helvetica, size, candidate) > maxWidth {
+			flush()
+			candidate = word
+		}
+		line = candidate
+	}
+	if line != "" {
+		flush()
+	}
+}
+
+// decodeInvoiceLogo 解析 data URL 形式的站点 Logo（仅支持 PNG/JPEG），失败时返回 nil
+func decodeInvoiceLogo(dataURL string) image.Image {
+	if !strings.HasPrefix(dataURL, "data:image/") {
+		return nil
+	}
+	idx := strings.Index(dataURL, ";base64,")
+	if idx < 0 {
+		return nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(dataURL[idx+len(";base64,"):])
+	if err != nil {
+		return nil
+	}
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil
+	}
+	return img
+}
+
+// formatInvoiceMoney 金额格式化：USD 使用 $ 符号，其他币种使用代码前缀
+func formatInvoiceMoney(currency string, v float64) string {
+	s := formatInvoiceThousands(strconv.FormatFloat(roundInvoiceAmount(v), 'f', 2, 64))
+	if currency == "" || currency == "USD" {
+		return "$" + s
+	}
+	return currency + " " + s
+}
+
+func formatInvoiceTokens(v int64) string {
+	return formatInvoiceThousands(strconv.FormatInt(v, 10))
+}
+
+// formatInvoiceCompact 紧凑格式（1.2K / 3.4M），用于 PDF 窄列
+func formatInvoiceCompact(v int64) string {
+	switch {
+	case v >= 1_000_000_000:
+		return strconv.FormatFloat(float64(v)/1e9, 'f', 1, 64) + "B"
+	case v >= 1_000_000:
+		return strconv.FormatFloat(float64(v)/1e6, 'f', 1, 64) + "M"
+	case v >= 1_000:
+		return strconv.FormatFloat(float64(v)/1e3, 'f', 1, 64) + "K"
+	default:
+		return strconv.FormatInt(v, 10)
+	}
+}
+
+// formatInvoiceThousands 为数字字符串的整数部分添加千分位
+func formatInvoiceThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, frac = s[:i], s[i:]
+	}
+	var b strings.Builder
+	for i, r := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return sign + b.String() + frac
+}
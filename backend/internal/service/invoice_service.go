@@ -0,0 +1,478 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+const (
+	invoiceWorkerName     = "invoice_monthly_worker"
+	invoiceWorkerInterval = time.Hour
+	invoiceRunTimeout     = 30 * time.Minute
+)
+
+var ErrInvoiceGenerationRunning = infraerrors.New(http.StatusConflict, "INVOICE_GENERATION_RUNNING", "invoice generation is already running")
+
+// invoiceMailer 发票邮件投递接口（便于测试替换）
+type invoiceMailer interface {
+	EnqueueInvoice(email, subject, body string, attachments []EmailAttachment, onComplete func(err error)) error
+}
+
+// InvoiceService 负责月度发票的生成、存储、渲染与邮件发送
+//
+// 发票内容在开具时固化为快照（明细、税率、开票方与收票方），PDF/HTML 由快照按需渲染，
+// 之后修改站点品牌或公司信息不会影响已开具的发票；需要更新时可重新生成（编号不变）。
+type InvoiceService struct {
+	repo           InvoiceRepository
+	userRepo       UserRepository
+	orgRepo        OrganizationRepository
+	settingService *SettingService
+	mailer         invoiceMailer
+	timingWheel    *TimingWheelService
+	now            func() time.Time
+
+	running   int32
+	lastAuto  atomic.Value // time.Time，最近一次已完成自动开票的账期
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	workerCtx    context.Context
+	workerCancel context.CancelFunc
+}
+
+func NewInvoiceService(
+	repo InvoiceRepository,
+	userRepo UserRepository,
+	orgRepo OrganizationRepository,
+	settingService *SettingService,
+	emailQueueService *EmailQueueService,
+	timingWheel *TimingWheelService,
+) *InvoiceService {
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	svc := &InvoiceService{
+		repo:           repo,
+		userRepo:       userRepo,
+		orgRepo:        orgRepo,
+		settingService: settingService,
+		timingWheel:    timingWheel,
+		now:            time.Now,
+		workerCtx:      workerCtx,
+		workerCancel:   workerCancel,
+	}
+	if emailQueueService != nil {
+		svc.mailer = emailQueueService
+	}
+	return svc
+}
+
+func (s *InvoiceService) Start() {
+	if s == nil || s.repo == nil || s.timingWheel == nil {
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(invoiceWorkerName, invoiceWorkerInterval, s.runOnce)
+		log.Printf("[Invoice] started (interval=%s)", invoiceWorkerInterval)
+	})
+}
+
+func (s *InvoiceService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.workerCancel != nil {
+			s.workerCancel()
+		}
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(invoiceWorkerName)
+		}
+		log.Printf("[Invoice] stopped")
+	})
+}
+
+// ParseInvoicePeriod 解析账期（YYYY-MM），返回该月在系统时区下的起始时间
+func ParseInvoicePeriod(period string) (time.Time, error) {
+	t, err := timezone.ParseInLocation("2006-01", strings.TrimSpace(period))
+	if err != nil {
+		return time.Time{}, infraerrors.BadRequest("INVOICE_INVALID_PERIOD", "invalid period, use YYYY-MM")
+	}
+	return t, nil
+}
+
+func (s *InvoiceService) List(ctx context.Context, params pagination.PaginationParams, filter InvoiceFilter) ([]Invoice, *pagination.PaginationResult, error) {
+	return s.repo.List(ctx, params, filter)
+}
+
+func (s *InvoiceService) GetByID(ctx context.Context, id int64) (*Invoice, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// GetForUser 获取用户作为收票人的发票；不属于该用户时视为不存在
+func (s *InvoiceService) GetForUser(ctx context.Context, userID, id int64) (*Invoice, error) {
+	inv, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.RecipientUserID != userID {
+		return nil, ErrInvoiceNotFound
+	}
+	return inv, nil
+}
+
+// Generate 为单个主体开具指定账期的发票；已存在未作废的发票时返回 ErrInvoiceExists
+func (s *InvoiceService) Generate(ctx context.Context, subject InvoiceSubject, periodStart time.Time, createdBy *int64, sendEmail bool) (*Invoice, error) {
+	if periodStart.AddDate(0, 1, 0).After(s.now()) {
+		return nil, infraerrors.BadRequest("INVOICE_PERIOD_NOT_CLOSED", "invoices can only be issued for past months")
+	}
+	settings, err := s.settingService.GetInvoiceSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := s.build(ctx, subject, periodStart, settings)
+	if err != nil {
+		return nil, err
+	}
+	inv.CreatedBy = createdBy
+
+	created, err := s.repo.Create(ctx, inv, settings.NextNumber, invoiceNumberFormatter(settings))
+	if err != nil {
+		return nil, fmt.Errorf("create invoice: %w", err)
+	}
+	if !created {
+		return nil, ErrInvoiceExists
+	}
+	log.Printf("[Invoice] issued: number=%s subject=%s:%d period=%s total=%.2f", inv.Number, inv.SubjectType, inv.SubjectID, inv.PeriodLabel(), inv.Total)
+	if sendEmail {
+		if err := s.enqueueEmail(inv); err != nil {
+			log.Printf("[Invoice] enqueue email failed: number=%s err=%v", inv.Number, err)
+		}
+	}
+	return inv, nil
+}
+
+// GenerateForPeriod 为账期内所有有业务发生的主体开具发票，已开具的主体跳过
+func (s *InvoiceService) GenerateForPeriod(ctx context.Context, periodStart time.Time, createdBy *int64, sendEmail bool) (int, error) {
+	subjects, err := s.repo.ListBillableSubjects(ctx, periodStart, periodStart.AddDate(0, 1, 0))
+	if err != nil {
+		return 0, fmt.Errorf("list billable subjects: %w", err)
+	}
+	issued := 0
+	for _, subject := range subjects {
+		if err := ctx.Err(); err != nil {
+			return issued, err
+		}
+		if _, err := s.Generate(ctx, subject, periodStart, createdBy, sendEmail); err != nil {
+			if infraerrors.Reason(err) == infraerrors.Reason(ErrInvoiceExists) {
+				continue
+			}
+			// 单个主体失败（例如用户已删除）不影响其他主体
+			log.Printf("[Invoice] generate failed: subject=%s:%d period=%s err=%v", subject.Type, subject.ID, periodStart.Format("2006-01"), err)
+			continue
+		}
+		issued++
+	}
+	return issued, nil
+}
+
+// StartGenerateForPeriod 在后台为账期内所有主体开具发票，避免阻塞管理端请求
+func (s *InvoiceService) StartGenerateForPeriod(periodStart time.Time, createdBy *int64, sendEmail bool) error {
+	if periodStart.AddDate(0, 1, 0).After(s.now()) {
+		return infraerrors.BadRequest("INVOICE_PERIOD_NOT_CLOSED", "invoices can only be issued for past months")
+	}
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return ErrInvoiceGenerationRunning
+	}
+	go func() {
+		defer atomic.StoreInt32(&s.running, 0)
+		ctx, cancel := context.WithTimeout(s.workerCtx, invoiceRunTimeout)
+		defer cancel()
+		issued, err := s.GenerateForPeriod(ctx, periodStart, createdBy, sendEmail)
+		if err != nil {
+			log.Printf("[Invoice] batch generation aborted: period=%s issued=%d err=%v", periodStart.Format("2006-01"), issued, err)
+			return
+		}
+		log.Printf("[Invoice] batch generation finished: period=%s issued=%d", periodStart.Format("2006-01"), issued)
+	}()
+	return nil
+}
+
+// Regenerate 按当前数据与配置重新生成发票内容，编号与账期保持不变
+func (s *InvoiceService) Regenerate(ctx context.Context, id int64) (*Invoice, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status == InvoiceStatusVoid {
+		return nil, ErrInvoiceVoided
+	}
+	settings, err := s.settingService.GetInvoiceSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := s.build(ctx, InvoiceSubject{Type: existing.SubjectType, ID: existing.SubjectID}, existing.PeriodStart, settings)
+	if err != nil {
+		return nil, err
+	}
+	inv.ID = existing.ID
+	inv.Number = existing.Number
+	inv.Sequence = existing.Sequence
+	inv.CreatedBy = existing.CreatedBy
+	inv.CreatedAt = existing.CreatedAt
+	inv.EmailedAt = existing.EmailedAt
+	inv.EmailError = existing.EmailError
+	if err := s.repo.UpdateContent(ctx, inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// Void 作废发票；作废后同一主体同一账期可重新开具（使用新编号）
+func (s *InvoiceService) Void(ctx context.Context, id int64) (*Invoice, error) {
+	inv, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inv.Status == InvoiceStatusVoid {
+		return inv, nil
+	}
+	if err := s.repo.UpdateStatus(ctx, id, InvoiceStatusVoid); err != nil {
+		return nil, err
+	}
+	inv.Status = InvoiceStatusVoid
+	return inv, nil
+}
+
+// SendEmail 将发票加入邮件队列（HTML 正文 + PDF 附件），发送结果异步回写
+func (s *InvoiceService) SendEmail(ctx context.Context, id int64) error {
+	inv, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inv.Status == InvoiceStatusVoid {
+		return ErrInvoiceVoided
+	}
+	return s.enqueueEmail(inv)
+}
+
+func (s *InvoiceService) enqueueEmail(inv *Invoice) error {
+	if s.mailer == nil {
+		return ErrEmailNotConfigured
+	}
+	if strings.TrimSpace(inv.BillTo.Email) == "" {
+		return ErrInvoiceNoEmail
+	}
+	body, err := RenderInvoiceHTML(inv)
+	if err != nil {
+		return err
+	}
+	pdfData, err := RenderInvoicePDF(inv)
+	if err != nil {
+		return err
+	}
+	subject := fmt.Sprintf("[%s] Invoice %s for %s", inv.Issuer.SiteName, inv.Number, inv.PeriodLabel())
+	attachment := EmailAttachment{
+		Filename:    inv.FileName("pdf"),
+		ContentType: "application/pdf",
+		Data:        pdfData,
+	}
+	invoiceID := inv.ID
+	return s.mailer.EnqueueInvoice(inv.BillTo.Email, subject, string(body), []EmailAttachment{attachment}, func(sendErr error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		var (
+			emailedAt *time.Time
+			errMsg    *string
+		)
+		if sendErr != nil {
+			msg := sendErr.Error()
+			errMsg = &msg
+		} else {
+			now := s.now()
+			emailedAt = &now
+		}
+		if err := s.repo.UpdateEmailResult(ctx, invoiceID, emailedAt, errMsg); err != nil {
+			log.Printf("[Invoice] update email result failed: invoice=%d err=%v", invoiceID, err)
+		}
+	})
+}
+
+// FileName 下载/附件文件名，例如 INV-000001.pdf
+func (inv *Invoice) FileName(ext string) string {
+	return sanitizeInvoiceFileName(inv.Number) + "." + ext
+}
+
+func sanitizeInvoiceFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
+
+// runOnce 每月初为上一个自然月（系统时区）自动开具发票
+func (s *InvoiceService) runOnce() {
+	ctx, cancel := context.WithTimeout(s.workerCtx, invoiceRunTimeout)
+	defer cancel()
+
+	settings, err := s.settingService.GetInvoiceSettings(ctx)
+	if err != nil {
+		log.Printf("[Invoice] load settings failed: %v", err)
+		return
+	}
+	if !settings.AutoGenerate {
+		return
+	}
+	period := timezone.StartOfMonth(s.now()).AddDate(0, -1, 0)
+	if last, ok := s.lastAuto.Load().(time.Time); ok && last.Equal(period) {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	issued, err := s.GenerateForPeriod(ctx, period, nil, settings.AutoEmail)
+	if err != nil {
+		log.Printf("[Invoice] monthly generation aborted: period=%s issued=%d err=%v", period.Format("2006-01"), issued, err)
+		return
+	}
+	s.lastAuto.Store(period)
+	if issued > 0 {
+		log.Printf("[Invoice] monthly generation finished: period=%s issued=%d", period.Format("2006-01"), issued)
+	}
+}
+
+// build 汇总主体在账期内的用量、订阅费用与充值，生成发票快照（未分配编号）
+func (s *InvoiceService) build(ctx context.Context, subject InvoiceSubject, periodStart time.Time, settings *InvoiceSettings) (*Invoice, error) {
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	inv := &Invoice{
+		SubjectType: subject.Type,
+		SubjectID:   subject.ID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Currency:    settings.Currency,
+		TaxRate:     settings.TaxRate,
+		Status:      InvoiceStatusIssued,
+		Issuer: InvoiceIssuer{
+			SiteName:       s.settingService.GetSiteName(ctx),
+			SiteLogo:       s.settingService.GetSiteLogo(ctx),
+			CompanyName:    settings.CompanyName,
+			CompanyAddress: settings.CompanyAddress,
+			CompanyTaxID:   settings.CompanyTaxID,
+			CompanyEmail:   settings.CompanyEmail,
+			TaxLabel:       settings.TaxLabel,
+			FooterNote:     settings.FooterNote,
+		},
+	}
+
+	switch subject.Type {
+	case InvoiceSubjectUser:
+		user, err := s.userRepo.GetByID(ctx, subject.ID)
+		if err != nil {
+			return nil, err
+		}
+		inv.RecipientUserID = user.ID
+		inv.BillTo = InvoiceBillTo{Name: user.Username, Email: user.Email}
+	case InvoiceSubjectOrganization:
+		org, err := s.orgRepo.GetByID(ctx, subject.ID)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := s.userRepo.GetByID(ctx, org.OwnerUserID)
+		if err != nil {
+			return nil, err
+		}
+		inv.RecipientUserID = owner.ID
+		inv.BillTo = InvoiceBillTo{Name: org.Name, Email: owner.Email}
+	default:
+		return nil, infraerrors.BadRequest("INVOICE_INVALID_SUBJECT", "subject_type must be user or organization")
+	}
+	if inv.BillTo.Name == "" {
+		inv.BillTo.Name = inv.BillTo.Email
+	}
+
+	usage, err := s.repo.AggregateUsage(ctx, subject, periodStart, periodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate usage: %w", err)
+	}
+	for i := range usage {
+		item := usage[i]
+		item.Kind = InvoiceLineUsage
+		item.Description = item.Model
+		if item.GroupName != "" {
+			item.Description += " / " + item.GroupName
+		}
+		if item.SubscriptionCovered {
+			item.Amount = 0
+		} else {
+			item.Amount = roundInvoiceAmount(item.Cost)
+		}
+		inv.Items = append(inv.Items, item)
+	}
+
+	// 组织钱包的充值未写入账本，组织发票只包含用量
+	if subject.Type == InvoiceSubjectUser {
+		fees, err := s.repo.ListSubscriptionFees(ctx, subject.ID, periodStart, periodEnd)
+		if err != nil {
+			return nil, fmt.Errorf("list subscription fees: %w", err)
+		}
+		for i := range fees {
+			item := fees[i]
+			item.Kind = InvoiceLineSubscription
+			item.Description = "Subscription"
+			if item.GroupName != "" {
+				item.Description += ": " + item.GroupName
+			}
+			if item.ValidityDays > 0 {
+				item.Description += fmt.Sprintf(" (%d days)", item.ValidityDays)
+			}
+			item.Amount = roundInvoiceAmount(item.Cost)
+			inv.Items = append(inv.Items, item)
+		}
+
+		topUps, err := s.repo.ListTopUps(ctx, subject.ID, periodStart, periodEnd)
+		if err != nil {
+			return nil, fmt.Errorf("list top-ups: %w", err)
+		}
+		inv.TopUps = topUps
+	}
+
+	for i := range inv.Items {
+		inv.Subtotal += inv.Items[i].Amount
+	}
+	inv.Subtotal = roundInvoiceAmount(inv.Subtotal)
+	inv.TaxAmount = roundInvoiceAmount(inv.Subtotal * inv.TaxRate / 100)
+	inv.Total = roundInvoiceAmount(inv.Subtotal + inv.TaxAmount)
+	for i := range inv.TopUps {
+		inv.TopUpTotal += inv.TopUps[i].Amount
+	}
+	inv.TopUpTotal = roundInvoiceAmount(inv.TopUpTotal)
+	return inv, nil
+}
+
+// invoiceNumberFormatter 编号 = 前缀 + 补零序号
+func invoiceNumberFormatter(settings *InvoiceSettings) func(seq int64) string {
+	prefix := settings.NumberPrefix
+	padding := settings.NumberPadding
+	return func(seq int64) string {
+		return fmt.Sprintf("%s%0*d", prefix, padding, seq)
+	}
+}
+
+func roundInvoiceAmount(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,329 @@
+//go:build unit
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type invoiceRepoStub struct {
+	invoices    map[int64]*Invoice
+	usage       []InvoiceLineItem
+	fees        []InvoiceLineItem
+	topUps      []InvoiceTopUp
+	conflict    bool
+	nextSeq     int64
+	minSequence int64
+	emailedAt   *time.Time
+	emailErr    *string
+}
+
+func (r *invoiceRepoStub) Create(_ context.Context, inv *Invoice, minSequence int64, formatNumber func(seq int64) string) (bool, error) {
+	if r.conflict {
+		return false, nil
+	}
+	r.minSequence = minSequence
+	seq := r.nextSeq
+	if seq < minSequence {
+		seq = minSequence
+	}
+	r.nextSeq = seq + 1
+	inv.ID = seq
+	inv.Sequence = seq
+	inv.Number = formatNumber(seq)
+	if r.invoices == nil {
+		r.invoices = map[int64]*Invoice{}
+	}
+	r.invoices[inv.ID] = inv
+	return true, nil
+}
+
+func (r *invoiceRepoStub) UpdateContent(_ context.Context, inv *Invoice) error {
+	r.invoices[inv.ID] = inv
+	return nil
+}
+
+func (r *invoiceRepoStub) GetByID(_ context.Context, id int64) (*Invoice, error) {
+	inv, ok := r.invoices[id]
+	if !ok {
+		return nil, ErrInvoiceNotFound
+	}
+	cp := *inv
+	return &cp, nil
+}
+
+func (r *invoiceRepoStub) List(context.Context, pagination.PaginationParams, InvoiceFilter) ([]Invoice, *pagination.PaginationResult, error) {
+	return nil, &pagination.PaginationResult{}, nil
+}
+
+func (r *invoiceRepoStub) UpdateStatus(_ context.Context, id int64, status string) error {
+	r.invoices[id].Status = status
+	return nil
+}
+
+func (r *invoiceRepoStub) UpdateEmailResult(_ context.Context, _ int64, emailedAt *time.Time, errMsg *string) error {
+	r.emailedAt = emailedAt
+	r.emailErr = errMsg
+	return nil
+}
+
+func (r *invoiceRepoStub) AggregateUsage(context.Context, InvoiceSubject, time.Time, time.Time) ([]InvoiceLineItem, error) {
+	return r.usage, nil
+}
+
+func (r *invoiceRepoStub) ListSubscriptionFees(context.Context, int64, time.Time, time.Time) ([]InvoiceLineItem, error) {
+	return r.fees, nil
+}
+
+func (r *invoiceRepoStub) ListTopUps(context.Context, int64, time.Time, time.Time) ([]InvoiceTopUp, error) {
+	return r.topUps, nil
+}
+
+func (r *invoiceRepoStub) ListBillableSubjects(context.Context, time.Time, time.Time) ([]InvoiceSubject, error) {
+	return nil, nil
+}
+
+type invoiceOrgRepoStub struct {
+	OrganizationRepository
+	org *Organization
+}
+
+func (r *invoiceOrgRepoStub) GetByID(context.Context, int64) (*Organization, error) {
+	if r.org == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	return r.org, nil
+}
+
+type invoiceMailerStub struct {
+	to          string
+	subject     string
+	body        string
+	attachments []EmailAttachment
+	onComplete  func(err error)
+}
+
+func (m *invoiceMailerStub) EnqueueInvoice(email, subject, body string, attachments []EmailAttachment, onComplete func(err error)) error {
+	m.to = email
+	m.subject = subject
+	m.body = body
+	m.attachments = attachments
+	m.onComplete = onComplete
+	return nil
+}
+
+func newInvoiceServiceForTest(repo *invoiceRepoStub, settings map[string]string, orgRepo OrganizationRepository) (*InvoiceService, *invoiceMailerStub) {
+	user := &User{ID: 7, Username: "alice", Email: "alice@example.com"}
+	svc := NewInvoiceService(repo, &userRepoStub{user: user}, orgRepo, NewSettingService(&settingRepoStub{values: settings}, nil), nil, nil)
+	svc.now = func() time.Time { return time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC) }
+	mailer := &invoiceMailerStub{}
+	svc.mailer = mailer
+	return svc, mailer
+}
+
+func TestInvoiceService_GenerateComputesTotals(t *testing.T) {
+	groupID := int64(3)
+	repo := &invoiceRepoStub{
+		usage: []InvoiceLineItem{
+			{Model: "claude-sonnet", GroupID: &groupID, GroupName: "pro", Requests: 10, InputTokens: 1000, Cost: 1.234},
+			{Model: "claude-opus", GroupName: "sub", SubscriptionCovered: true, Requests: 2, Cost: 5},
+		},
+		fees:   []InvoiceLineItem{{GroupName: "monthly", ValidityDays: 30, Cost: 20}},
+		topUps: []InvoiceTopUp{{Source: "redeem_code", Amount: 50}},
+	}
+	settings := map[string]string{
+		SettingKeySiteName:        "Acme",
+		SettingKeyInvoiceSettings: `{"number_prefix":"ACME-","next_number":100,"number_padding":4,"currency":"EUR","tax_rate":10,"tax_label":"VAT","company_name":"Acme Ltd"}`,
+	}
+	svc, _ := newInvoiceServiceForTest(repo, settings, nil)
+
+	period := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	inv, err := svc.Generate(context.Background(), InvoiceSubject{Type: InvoiceSubjectUser, ID: 7}, period, nil, false)
+	require.NoError(t, err)
+
+	require.Equal(t, "ACME-0100", inv.Number)
+	require.Equal(t, int64(100), repo.minSequence)
+	require.Equal(t, int64(7), inv.RecipientUserID)
+	require.Equal(t, InvoiceBillTo{Name: "alice", Email: "alice@example.com"}, inv.BillTo)
+	require.Equal(t, "EUR", inv.Currency)
+	require.Equal(t, "Acme", inv.Issuer.SiteName)
+	require.Equal(t, "VAT", inv.Issuer.TaxLabel)
+	require.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), inv.PeriodEnd)
+
+	require.Len(t, inv.Items, 3)
+	require.Equal(t, "claude-sonnet / pro", inv.Items[0].Description)
+	require.Equal(t, 1.23, inv.Items[0].Amount)
+	require.Equal(t, 0.0, inv.Items[1].Amount, "subscription-covered usage is not charged")
+	require.Equal(t, InvoiceLineSubscription, inv.Items[2].Kind)
+	require.Equal(t, "Subscription: monthly (30 days)", inv.Items[2].Description)
+
+	require.Equal(t, 21.23, inv.Subtotal)
+	require.Equal(t, 2.12, inv.TaxAmount)
+	require.Equal(t, 23.35, inv.Total)
+	require.Equal(t, 50.0, inv.TopUpTotal)
+}
+
+func TestInvoiceService_GenerateRejectsOpenPeriodAndDuplicates(t *testing.T) {
+	repo := &invoiceRepoStub{}
+	svc, _ := newInvoiceServiceForTest(repo, nil, nil)
+	subject := InvoiceSubject{Type: InvoiceSubjectUser, ID: 7}
+
+	_, err := svc.Generate(context.Background(), subject, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), nil, false)
+	require.Error(t, err)
+
+	repo.conflict = true
+	_, err = svc.Generate(context.Background(), subject, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), nil, false)
+	require.ErrorIs(t, err, ErrInvoiceExists)
+}
+
+func TestInvoiceService_OrganizationInvoiceBillsOwner(t *testing.T) {
+	repo := &invoiceRepoStub{
+		usage: []InvoiceLineItem{{Model: "gpt-5", Cost: 3}},
+		fees:  []InvoiceLineItem{{Cost: 99}},
+	}
+	orgRepo := &invoiceOrgRepoStub{org: &Organization{ID: 11, Name: "Team", OwnerUserID: 7}}
+	svc, _ := newInvoiceServiceForTest(repo, nil, orgRepo)
+
+	inv, err := svc.Generate(context.Background(), InvoiceSubject{Type: InvoiceSubjectOrganization, ID: 11}, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), nil, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), inv.RecipientUserID)
+	require.Equal(t, InvoiceBillTo{Name: "Team", Email: "alice@example.com"}, inv.BillTo)
+	require.Len(t, inv.Items, 1, "organization invoices only contain usage")
+	require.Equal(t, 3.0, inv.Total)
+	require.Equal(t, "INV-000001", inv.Number)
+}
+
+func TestInvoiceService_GetForUserHidesOtherRecipients(t *testing.T) {
+	repo := &invoiceRepoStub{invoices: map[int64]*Invoice{1: {ID: 1, RecipientUserID: 7}}}
+	svc, _ := newInvoiceServiceForTest(repo, nil, nil)
+
+	_, err := svc.GetForUser(context.Background(), 7, 1)
+	require.NoError(t, err)
+	_, err = svc.GetForUser(context.Background(), 8, 1)
+	require.ErrorIs(t, err, ErrInvoiceNotFound)
+}
+
+func TestInvoiceService_RegenerateKeepsNumber(t *testing.T) {
+	repo := &invoiceRepoStub{
+		invoices: map[int64]*Invoice{5: {
+			ID: 5, Number: "INV-000005", Sequence: 5, SubjectType: InvoiceSubjectUser, SubjectID: 7,
+			PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), Status: InvoiceStatusIssued, Total: 1,
+		}},
+		usage: []InvoiceLineItem{{Model: "m", Cost: 4}},
+	}
+	svc, _ := newInvoiceServiceForTest(repo, nil, nil)
+
+	inv, err := svc.Regenerate(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, "INV-000005", inv.Number)
+	require.Equal(t, 4.0, inv.Total)
+
+	_, err = svc.Void(context.Background(), 5)
+	require.NoError(t, err)
+	_, err = svc.Regenerate(context.Background(), 5)
+	require.ErrorIs(t, err, ErrInvoiceVoided)
+}
+
+func TestInvoiceService_SendEmailRecordsResult(t *testing.T) {
+	repo := &invoiceRepoStub{invoices: map[int64]*Invoice{1: {
+		ID: 1, Number: "INV-000001", Status: InvoiceStatusIssued,
+		PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Issuer:      InvoiceIssuer{SiteName: "Acme"},
+		BillTo:      InvoiceBillTo{Name: "alice", Email: "alice@example.com"},
+	}}}
+	svc, mailer := newInvoiceServiceForTest(repo, nil, nil)
+
+	require.NoError(t, svc.SendEmail(context.Background(), 1))
+	require.Equal(t, "alice@example.com", mailer.to)
+	require.Equal(t, "[Acme] Invoice INV-000001 for 2026-09", mailer.subject)
+	require.Len(t, mailer.attachments, 1)
+	require.Equal(t, "INV-000001.pdf", mailer.attachments[0].Filename)
+	require.True(t, bytes.HasPrefix(mailer.attachments[0].Data, []byte("%PDF-")))
+
+	mailer.onComplete(nil)
+	require.NotNil(t, repo.emailedAt)
+	require.Nil(t, repo.emailErr)
+
+	mailer.onComplete(errors.New("smtp down"))
+	require.Nil(t, repo.emailedAt)
+	require.Equal(t, "smtp down", *repo.emailErr)
+
+	repo.invoices[1].BillTo.Email = ""
+	require.ErrorIs(t, svc.SendEmail(context.Background(), 1), ErrInvoiceNoEmail)
+}
+
+func TestRenderInvoiceHTML_EscapesAndRestrictsLogo(t *testing.T) {
+	inv := &Invoice{
+		Number:      "INV-1",
+		PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Issuer:      InvoiceIssuer{SiteName: "<b>Acme</b>", SiteLogo: "https://evil.example/logo.png"},
+		BillTo:      InvoiceBillTo{Name: "李雷", Email: "li@example.com"},
+		Items:       []InvoiceLineItem{{Description: "model <x>", Amount: 1}},
+	}
+	out, err := RenderInvoiceHTML(inv)
+	require.NoError(t, err)
+	html := string(out)
+	require.NotContains(t, html, "<b>Acme</b>")
+	require.Contains(t, html, "&lt;b&gt;Acme&lt;/b&gt;")
+	require.NotContains(t, html, "evil.example")
+	require.Contains(t, html, "李雷")
+	require.Contains(t, html, "model &lt;x&gt;")
+}
+
+func TestRenderInvoicePDF_Paginates(t *testing.T) {
+	inv := &Invoice{
+		Number:      "INV-1",
+		PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Issuer:      InvoiceIssuer{SiteName: "Acme"},
+	}
+	for i := 0; i < 120; i++ {
+		inv.Items = append(inv.Items, InvoiceLineItem{Description: "model", Requests: 1, Amount: 1})
+	}
+	out, err := RenderInvoicePDF(inv)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	require.Greater(t, bytes.Count(out, []byte("/Type /Page /Parent")), 1)
+}
+
+func TestBuildMultipartEmail_IncludesAttachment(t *testing.T) {
+	cfg := &SMTPConfig{From: "billing@example.com", FromName: "Acme"}
+	raw, err := buildMultipartEmail(cfg, "alice@example.com", "发票 INV-1", "<p>hi</p>", []EmailAttachment{
+		{Filename: "INV-1.pdf", ContentType: "application/pdf", Data: []byte("%PDF-1.4")},
+	})
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "发票 INV-1", subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		parts = append(parts, part.Header.Get("Content-Type"))
+		if strings.HasPrefix(part.Header.Get("Content-Disposition"), "attachment") {
+			require.Equal(t, "INV-1.pdf", part.FileName())
+		}
+	}
+	require.Len(t, parts, 2)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrModelPriceNotFound  = infraerrors.NotFound("MODEL_PRICE_NOT_FOUND", "model price not found")
+	ErrModelPriceInvalid   = infraerrors.BadRequest("MODEL_PRICE_INVALID", "invalid model price")
+	ErrModelPriceImmutable = infraerrors.Conflict("MODEL_PRICE_IMMUTABLE", "price version is already in effect; create a new version instead")
+	ErrModelPriceDuplicate = infraerrors.Conflict("MODEL_PRICE_DUPLICATE", "a price version with the same scope, model and effective time already exists")
+	ErrModelPriceUnknown   = infraerrors.Forbidden("MODEL_PRICE_UNKNOWN", "no price is configured for this model")
+)
+
+// ModelPrice 管理员维护的模型价格版本，价格单位 USD / 百万 token
+type ModelPrice struct {
+	ID int64
+	// GroupID 为 nil 表示全局覆盖
+	GroupID *int64
+	// Model 小写模型名，以 * 结尾表示前缀匹配
+	Model           string
+	InputPrice      float64
+	OutputPrice     float64
+	CacheWritePrice float64
+	CacheReadPrice  float64
+	// LongContextThreshold 输入侧 token 总数超过该值时按长上下文价格计费，0 表示不启用
+	LongContextThreshold   int
+	LongContextInputPrice  *float64
+	LongContextOutputPrice *float64
+	EffectiveFrom          time.Time
+	Notes                  string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+
+	Group *Group
+}
+
+// IsGlobal 是否为全局覆盖
+func (p *ModelPrice) IsGlobal() bool {
+	return p.GroupID == nil
+}
+
+// IsWildcard 是否为前缀匹配
+func (p *ModelPrice) IsWildcard() bool {
+	return strings.HasSuffix(p.Model, "*")
+}
+
+// Matches 判断价格是否适用于给定的小写模型名
+func (p *ModelPrice) Matches(model string) bool {
+	if p.IsWildcard() {
+		return strings.HasPrefix(model, strings.TrimSuffix(p.Model, "*"))
+	}
+	return p.Model == model
+}
+
+// InEffect 价格版本在给定时间是否已生效
+func (p *ModelPrice) InEffect(at time.Time) bool {
+	return !p.EffectiveFrom.After(at)
+}
+
+// ToModelPricing 转换为计费使用的 per-token 价格
+func (p *ModelPrice) ToModelPricing() *ModelPricing {
+	pricing := &ModelPricing{
+		InputPricePerToken:         p.InputPrice / 1_000_000,
+		OutputPricePerToken:        p.OutputPrice / 1_000_000,
+		CacheCreationPricePerToken: p.CacheWritePrice / 1_000_000,
+		CacheReadPricePerToken:     p.CacheReadPrice / 1_000_000,
+		LongContextThreshold:       p.LongContextThreshold,
+		Source:                     ModelPricingSourceOverride,
+		PriceID:                    &p.ID,
+	}
+	if p.LongContextInputPrice != nil {
+		pricing.LongContextInputPricePerToken = *p.LongContextInputPrice / 1_000_000
+	}
+	if p.LongContextOutputPrice != nil {
+		pricing.LongContextOutputPricePerToken = *p.LongContextOutputPrice / 1_000_000
+	}
+	return pricing
+}
+
+// ModelPriceInput 创建/修改价格版本的输入
+type ModelPriceInput struct {
+	GroupID                *int64
+	Model                  string
+	InputPrice             float64
+	OutputPrice            float64
+	CacheWritePrice        float64
+	CacheReadPrice         float64
+	LongContextThreshold   int
+	LongContextInputPrice  *float64
+	LongContextOutputPrice *float64
+	// EffectiveFrom 为 nil 表示立即生效
+	EffectiveFrom *time.Time
+	Notes         string
+}
+
+// ModelPriceListFilters 价格版本列表过滤条件
+type ModelPriceListFilters struct {
+	// Scope: "" 全部 | "global" 仅全局 | "group" 仅分组（GroupID 非空时只看该分组）
+	Scope   string
+	GroupID *int64
+	// Model 模型名模糊搜索
+	Model string
+}
+
+// 价格作用域过滤常量
+const (
+	ModelPriceScopeGlobal = "global"
+	ModelPriceScopeGroup  = "group"
+)
+
+// ModelPriceRepository 模型价格仓储接口
+type ModelPriceRepository interface {
+	Create(ctx context.Context, price *ModelPrice) error
+	GetByID(ctx context.Context, id int64) (*ModelPrice, error)
+	Update(ctx context.Context, price *ModelPrice) error
+	Delete(ctx context.Context, id int64) error
+	// List 管理端分页列表，按模型名、作用域、生效时间倒序
+	List(ctx context.Context, params pagination.PaginationParams, filters ModelPriceListFilters) ([]ModelPrice, *pagination.PaginationResult, error)
+	// ListAll 加载全部价格版本，用于构建计费快照
+	ListAll(ctx context.Context) ([]ModelPrice, error)
+	// ExistsVersion 同一作用域、模型与生效时间是否已存在版本（excludeID 用于修改时排除自身）
+	ExistsVersion(ctx context.Context, groupID *int64, model string, effectiveFrom time.Time, excludeID int64) (bool, error)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	// modelPriceSnapshotTTL 价格快照刷新间隔；本实例修改价格后立即刷新，其他实例最多延迟一个周期
+	modelPriceSnapshotTTL = 30 * time.Second
+	// modelPriceEffectiveSkew 生效时间允许的时钟误差，早于 now-skew 的生效时间视为回溯
+	modelPriceEffectiveSkew = time.Minute
+	modelPriceLoadTimeout   = 5 * time.Second
+)
+
+// modelPriceSnapshot 计费使用的价格快照
+type modelPriceSnapshot struct {
+	// byScope 按作用域分组的价格版本，0 表示全局；每组按 effective_from 倒序
+	byScope  map[int64][]*ModelPrice
+	unknown  *UnknownModelPricingSettings
+	loadedAt time.Time
+}
+
+// ModelPriceService 模型价格覆盖管理与计费时的价格解析
+type ModelPriceService struct {
+	repo           ModelPriceRepository
+	groupRepo      GroupRepository
+	settingService *SettingService
+
+	mu         sync.RWMutex
+	snapshot   *modelPriceSnapshot
+	refreshing atomic.Bool
+
+	now func() time.Time
+}
+
+// NewModelPriceService 创建模型价格服务
+func NewModelPriceService(repo ModelPriceRepository, groupRepo GroupRepository, settingService *SettingService) *ModelPriceService {
+	return &ModelPriceService{
+		repo:           repo,
+		groupRepo:      groupRepo,
+		settingService: settingService,
+		now:            time.Now,
+	}
+}
+
+// List 管理端分页列表
+func (s *ModelPriceService) List(ctx context.Context, params pagination.PaginationParams, filters ModelPriceListFilters) ([]ModelPrice, *pagination.PaginationResult, error) {
+	return s.repo.List(ctx, params, filters)
+}
+
+// GetByID 获取价格版本
+func (s *ModelPriceService) GetByID(ctx context.Context, id int64) (*ModelPrice, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 新建价格版本；调价通过新建版本完成，生效时间为空表示立即生效
+func (s *ModelPriceService) Create(ctx context.Context, input *ModelPriceInput) (*ModelPrice, error) {
+	price := &ModelPrice{}
+	if err := s.applyInput(ctx, price, input, 0); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, price); err != nil {
+		return nil, fmt.Errorf("create model price: %w", err)
+	}
+	s.refresh(ctx)
+	return price, nil
+}
+
+// Update 修改尚未生效的价格版本；已生效的版本不可修改，保证历史用量对应的价格可追溯
+func (s *ModelPriceService) Update(ctx context.Context, id int64, input *ModelPriceInput) (*ModelPrice, error) {
+	price, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if price.InEffect(s.now()) {
+		return nil, ErrModelPriceImmutable
+	}
+	if err := s.applyInput(ctx, price, input, id); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, price); err != nil {
+		return nil, fmt.Errorf("update model price: %w", err)
+	}
+	s.refresh(ctx)
+	return price, nil
+}
+
+// Delete 删除价格版本；删除当前生效的版本后，该模型回退到上一个版本或上游价格，已入账的用量不受影响
+func (s *ModelPriceService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.refresh(ctx)
+	return nil
+}
+
+// GetUnknownModelPricing 获取未知模型计费策略
+func (s *ModelPriceService) GetUnknownModelPricing(ctx context.Context) (*UnknownModelPricingSettings, error) {
+	return s.settingService.GetUnknownModelPricingSettings(ctx)
+}
+
+// UpdateUnknownModelPricing 设置未知模型计费策略
+func (s *ModelPriceService) UpdateUnknownModelPricing(ctx context.Context, settings *UnknownModelPricingSettings) (*UnknownModelPricingSettings, error) {
+	if err := s.settingService.SetUnknownModelPricingSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	s.refresh(ctx)
+	return s.settingService.GetUnknownModelPricingSettings(ctx)
+}
+
+func (s *ModelPriceService) applyInput(ctx context.Context, price *ModelPrice, input *ModelPriceInput, excludeID int64) error {
+	now := s.now()
+	model := normalizeModelPricePattern(input.Model)
+	if model == "" || model == "*" || strings.Count(model, "*") > 1 || (strings.Contains(model, "*") && !strings.HasSuffix(model, "*")) {
+		return ErrModelPriceInvalid.WithMetadata(map[string]string{"field": "model"})
+	}
+	if input.InputPrice < 0 || input.OutputPrice < 0 || input.CacheWritePrice < 0 || input.CacheReadPrice < 0 {
+		return ErrModelPriceInvalid.WithMetadata(map[string]string{"field": "price"})
+	}
+	if input.LongContextThreshold < 0 {
+		return ErrModelPriceInvalid.WithMetadata(map[string]string{"field": "long_context_threshold"})
+	}
+	longInput := normalizeLimit(input.LongContextInputPrice)
+	longOutput := normalizeLimit(input.LongContextOutputPrice)
+	if input.LongContextThreshold > 0 && longInput == nil && longOutput == nil {
+		return ErrModelPriceInvalid.WithMetadata(map[string]string{"field": "long_context_threshold"})
+	}
+	if input.LongContextThreshold == 0 {
+		longInput, longOutput = nil, nil
+	}
+
+	effectiveFrom := now
+	if input.EffectiveFrom != nil {
+		if input.EffectiveFrom.Before(now.Add(-modelPriceEffectiveSkew)) {
+			return ErrModelPriceInvalid.WithMetadata(map[string]string{"field": "effective_from"})
+		}
+		if input.EffectiveFrom.After(now) {
+			effectiveFrom = *input.EffectiveFrom
+		}
+	}
+	effectiveFrom = effectiveFrom.UTC().Truncate(time.Second)
+
+	if input.GroupID != nil {
+		if _, err := s.groupRepo.GetByID(ctx, *input.GroupID); err != nil {
+			return err
+		}
+	}
+	exists, err := s.repo.ExistsVersion(ctx, input.GroupID, model, effectiveFrom, excludeID)
+	if err != nil {
+		return fmt.Errorf("check model price version: %w", err)
+	}
+	if exists {
+		return ErrModelPriceDuplicate
+	}
+
+	price.GroupID = input.GroupID
+	price.Model = model
+	price.InputPrice = input.InputPrice
+	price.OutputPrice = input.OutputPrice
+	price.CacheWritePrice = input.CacheWritePrice
+	price.CacheReadPrice = input.CacheReadPrice
+	price.LongContextThreshold = input.LongContextThreshold
+	price.LongContextInputPrice = longInput
+	price.LongContextOutputPrice = longOutput
+	price.EffectiveFrom = effectiveFrom
+	price.Notes = strings.TrimSpace(input.Notes)
+	return nil
+}
+
+// Resolve 解析模型在给定时间的价格覆盖：分组覆盖优先于全局覆盖，同一作用域内精确匹配优先于最长前缀匹配。
+// 未配置覆盖时返回 nil。
+func (s *ModelPriceService) Resolve(groupID *int64, model string, at time.Time) *ModelPrice {
+	snap := s.currentSnapshot()
+	if snap == nil {
+		return nil
+	}
+	model = normalizeModelPricePattern(model)
+	if model == "" {
+		return nil
+	}
+	if groupID != nil && *groupID > 0 {
+		if price := matchModelPrice(snap.byScope[*groupID], model, at); price != nil {
+			return price
+		}
+	}
+	return matchModelPrice(snap.byScope[0], model, at)
+}
+
+// UnknownModelPolicy 返回当前生效的未知模型计费策略
+func (s *ModelPriceService) UnknownModelPolicy() *UnknownModelPricingSettings {
+	snap := s.currentSnapshot()
+	if snap == nil || snap.unknown == nil {
+		return DefaultUnknownModelPricingSettings()
+	}
+	return snap.unknown
+}
+
+// Reload 重新加载价格快照
+func (s *ModelPriceService) Reload(ctx context.Context) error {
+	prices, err := s.repo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("load model prices: %w", err)
+	}
+	unknown, err := s.settingService.GetUnknownModelPricingSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	byScope := make(map[int64][]*ModelPrice)
+	for i := range prices {
+		price := &prices[i]
+		scope := int64(0)
+		if price.GroupID != nil {
+			scope = *price.GroupID
+		}
+		byScope[scope] = append(byScope[scope], price)
+	}
+	for scope := range byScope {
+		list := byScope[scope]
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].EffectiveFrom.After(list[j].EffectiveFrom)
+		})
+	}
+
+	s.mu.Lock()
+	s.snapshot = &modelPriceSnapshot{byScope: byScope, unknown: unknown, loadedAt: s.now()}
+	s.mu.Unlock()
+	return nil
+}
+
+// refresh 修改后立即刷新快照，失败时保留旧快照并等待下个周期
+func (s *ModelPriceService) refresh(ctx context.Context) {
+	if err := s.Reload(ctx); err != nil {
+		log.Printf("[ModelPrice] refresh snapshot failed: %v", err)
+	}
+}
+
+// currentSnapshot 返回当前快照；过期时在后台刷新，计费路径不等待数据库
+func (s *ModelPriceService) currentSnapshot() *modelPriceSnapshot {
+	s.mu.RLock()
+	snap := s.snapshot
+	s.mu.RUnlock()
+	if snap != nil && s.now().Sub(snap.loadedAt) < modelPriceSnapshotTTL {
+		return snap
+	}
+	if !s.refreshing.CompareAndSwap(false, true) {
+		return snap
+	}
+	go func() {
+		defer s.refreshing.Store(false)
+		ctx, cancel := context.WithTimeout(context.Background(), modelPriceLoadTimeout)
+		defer cancel()
+		s.refresh(ctx)
+	}()
+	return snap
+}
+
+// matchModelPrice 在一个作用域内查找生效的版本：精确匹配优先，其次最长前缀；同一模式取最新生效的版本
+func matchModelPrice(prices []*ModelPrice, model string, at time.Time) *ModelPrice {
+	var best *ModelPrice
+	for _, price := range prices {
+		if !price.InEffect(at) || !price.Matches(model) {
+			continue
+		}
+		if !price.IsWildcard() {
+			return price
+		}
+		if best == nil || len(price.Model) > len(best.Model) {
+			best = price
+		}
+	}
+	return best
+}
+
+func normalizeModelPricePattern(model string) string {
+	return strings.ToLower(strings.TrimSpace(model))
+}
@@ -0,0 +1,219 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type modelPriceRepoStub struct {
+	prices map[int64]*ModelPrice
+	nextID int64
+}
+
+func newModelPriceRepoStub(prices ...*ModelPrice) *modelPriceRepoStub {
+	s := &modelPriceRepoStub{prices: map[int64]*ModelPrice{}, nextID: 100}
+	for _, p := range prices {
+		s.prices[p.ID] = p
+	}
+	return s
+}
+
+func (s *modelPriceRepoStub) Create(ctx context.Context, price *ModelPrice) error {
+	s.nextID++
+	price.ID = s.nextID
+	cp := *price
+	s.prices[price.ID] = &cp
+	return nil
+}
+
+func (s *modelPriceRepoStub) GetByID(ctx context.Context, id int64) (*ModelPrice, error) {
+	if p, ok := s.prices[id]; ok {
+		cp := *p
+		return &cp, nil
+	}
+	return nil, ErrModelPriceNotFound
+}
+
+func (s *modelPriceRepoStub) Update(ctx context.Context, price *ModelPrice) error {
+	cp := *price
+	s.prices[price.ID] = &cp
+	return nil
+}
+
+func (s *modelPriceRepoStub) Delete(ctx context.Context, id int64) error {
+	if _, ok := s.prices[id]; !ok {
+		return ErrModelPriceNotFound
+	}
+	delete(s.prices, id)
+	return nil
+}
+
+func (s *modelPriceRepoStub) List(ctx context.Context, params pagination.PaginationParams, filters ModelPriceListFilters) ([]ModelPrice, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+func (s *modelPriceRepoStub) ListAll(ctx context.Context) ([]ModelPrice, error) {
+	out := make([]ModelPrice, 0, len(s.prices))
+	for _, p := range s.prices {
+		out = append(out, *p)
+	}
+	return out, nil
+}
+
+func (s *modelPriceRepoStub) ExistsVersion(ctx context.Context, groupID *int64, model string, effectiveFrom time.Time, excludeID int64) (bool, error) {
+	for _, p := range s.prices {
+		if p.ID == excludeID || p.Model != model || !p.EffectiveFrom.Equal(effectiveFrom) {
+			continue
+		}
+		if (p.GroupID == nil) == (groupID == nil) && (groupID == nil || *p.GroupID == *groupID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newModelPriceServiceForTest(t *testing.T, settings map[string]string, prices ...*ModelPrice) (*ModelPriceService, *modelPriceRepoStub) {
+	t.Helper()
+	repo := newModelPriceRepoStub(prices...)
+	groups := &planGroupRepoStub{groups: map[int64]*Group{7: {ID: 7, Name: "vip"}}}
+	svc := NewModelPriceService(repo, groups, NewSettingService(&settingRepoStub{values: settings}, nil))
+	require.NoError(t, svc.Reload(context.Background()))
+	return svc, repo
+}
+
+func TestModelPriceService_ResolvePrecedence(t *testing.T) {
+	now := time.Now()
+	groupID := int64(7)
+	svc, _ := newModelPriceServiceForTest(t, nil,
+		&ModelPrice{ID: 1, Model: "claude-sonnet-4*", InputPrice: 1, EffectiveFrom: now.Add(-48 * time.Hour)},
+		&ModelPrice{ID: 2, Model: "claude-sonnet-4-5", InputPrice: 2, EffectiveFrom: now.Add(-48 * time.Hour)},
+		&ModelPrice{ID: 3, Model: "claude-sonnet-4-5", InputPrice: 3, EffectiveFrom: now.Add(-time.Hour)},
+		&ModelPrice{ID: 4, Model: "claude-sonnet-4-5", InputPrice: 4, EffectiveFrom: now.Add(time.Hour)},
+		&ModelPrice{ID: 5, GroupID: &groupID, Model: "claude-*", InputPrice: 5, EffectiveFrom: now.Add(-time.Hour)},
+		&ModelPrice{ID: 6, Model: "claude-*", InputPrice: 6, EffectiveFrom: now.Add(-time.Hour)},
+	)
+
+	// 全局：精确匹配取最新已生效版本，未来版本不生效
+	require.Equal(t, int64(3), svc.Resolve(nil, "Claude-Sonnet-4-5", now).ID)
+	// 按历史时间解析得到当时的版本
+	require.Equal(t, int64(2), svc.Resolve(nil, "claude-sonnet-4-5", now.Add(-2*time.Hour)).ID)
+	require.Equal(t, int64(4), svc.Resolve(nil, "claude-sonnet-4-5", now.Add(2*time.Hour)).ID)
+	// 前缀匹配取最长前缀
+	require.Equal(t, int64(1), svc.Resolve(nil, "claude-sonnet-4-20250514", now).ID)
+	require.Equal(t, int64(6), svc.Resolve(nil, "claude-opus-4", now).ID)
+	// 分组覆盖优先于全局
+	require.Equal(t, int64(5), svc.Resolve(&groupID, "claude-sonnet-4-5", now).ID)
+	require.Nil(t, svc.Resolve(nil, "gpt-5", now))
+}
+
+func TestModelPriceService_CreateValidation(t *testing.T) {
+	svc, _ := newModelPriceServiceForTest(t, nil)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+	missingGroup := int64(99)
+
+	cases := []*ModelPriceInput{
+		{Model: "claude-*-opus", InputPrice: 1},
+		{Model: "*", InputPrice: 1},
+		{Model: "claude", InputPrice: -1},
+		{Model: "claude", InputPrice: 1, LongContextThreshold: 200000},
+		{Model: "claude", InputPrice: 1, EffectiveFrom: &past},
+	}
+	for _, input := range cases {
+		_, err := svc.Create(ctx, input)
+		require.ErrorIs(t, err, ErrModelPriceInvalid, "model=%s", input.Model)
+	}
+
+	_, err := svc.Create(ctx, &ModelPriceInput{GroupID: &missingGroup, Model: "claude", InputPrice: 1})
+	require.ErrorIs(t, err, ErrGroupNotFound)
+
+	long := 6.0
+	price, err := svc.Create(ctx, &ModelPriceInput{Model: " Claude-Opus-4* ", InputPrice: 5, OutputPrice: 25, LongContextThreshold: 200000, LongContextInputPrice: &long})
+	require.NoError(t, err)
+	require.Equal(t, "claude-opus-4*", price.Model)
+	require.Nil(t, price.LongContextOutputPrice)
+	// 新建后快照立即刷新
+	require.Equal(t, price.ID, svc.Resolve(nil, "claude-opus-4-1", time.Now()).ID)
+}
+
+func TestModelPriceService_UpdateOnlyScheduled(t *testing.T) {
+	now := time.Now()
+	svc, repo := newModelPriceServiceForTest(t, nil,
+		&ModelPrice{ID: 1, Model: "claude-sonnet-4", InputPrice: 3, EffectiveFrom: now.Add(-time.Hour)},
+		&ModelPrice{ID: 2, Model: "claude-sonnet-4", InputPrice: 3, EffectiveFrom: now.Add(24 * time.Hour)},
+	)
+	ctx := context.Background()
+
+	_, err := svc.Update(ctx, 1, &ModelPriceInput{Model: "claude-sonnet-4", InputPrice: 4})
+	require.ErrorIs(t, err, ErrModelPriceImmutable)
+	require.Equal(t, 3.0, repo.prices[1].InputPrice)
+
+	next := now.Add(48 * time.Hour)
+	updated, err := svc.Update(ctx, 2, &ModelPriceInput{Model: "claude-sonnet-4", InputPrice: 4, EffectiveFrom: &next})
+	require.NoError(t, err)
+	require.Equal(t, 4.0, updated.InputPrice)
+	require.True(t, updated.EffectiveFrom.After(now.Add(47*time.Hour)))
+}
+
+func TestBillingService_CalculateCostForGroupWithOverride(t *testing.T) {
+	groupID := int64(7)
+	longInput, longOutput := 6.0, 22.5
+	svc, _ := newModelPriceServiceForTest(t, nil,
+		&ModelPrice{ID: 1, GroupID: &groupID, Model: "claude-sonnet-4-5", InputPrice: 3, OutputPrice: 15, CacheWritePrice: 3.75, CacheReadPrice: 0.3,
+			LongContextThreshold: 200000, LongContextInputPrice: &longInput, LongContextOutputPrice: &longOutput, EffectiveFrom: time.Now().Add(-time.Hour)},
+	)
+	billing := NewBillingService(&config.Config{}, nil, svc)
+
+	cost, err := billing.CalculateCostForGroup(&groupID, "claude-sonnet-4-5", UsageTokens{InputTokens: 100_000, OutputTokens: 10_000, CacheReadTokens: 50_000}, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 0.3, cost.InputCost, 1e-9)
+	require.InDelta(t, 0.15, cost.OutputCost, 1e-9)
+	require.InDelta(t, 0.015, cost.CacheReadCost, 1e-9)
+	require.InDelta(t, 0.93, cost.ActualCost, 1e-9)
+
+	// 输入侧 token 超过阈值时按长上下文价格
+	cost, err = billing.CalculateCostForGroup(&groupID, "claude-sonnet-4-5", UsageTokens{InputTokens: 150_000, OutputTokens: 10_000, CacheReadTokens: 100_000}, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 0.9, cost.InputCost, 1e-9)
+	require.InDelta(t, 0.225, cost.OutputCost, 1e-9)
+	require.InDelta(t, 0.03, cost.CacheReadCost, 1e-9)
+
+	// 其他分组不受分组覆盖影响
+	pricing, err := billing.GetModelPricingForGroup(nil, "claude-sonnet-4-5")
+	require.NoError(t, err)
+	require.Equal(t, ModelPricingSourceFallback, pricing.Source)
+}
+
+func TestBillingService_UnknownModelPolicy(t *testing.T) {
+	svc, _ := newModelPriceServiceForTest(t, map[string]string{
+		SettingKeyUnknownModelPricing: `{"policy":"default_price","input_price":2,"output_price":8}`,
+	})
+	billing := NewBillingService(&config.Config{}, nil, svc)
+
+	pricing, err := billing.GetModelPricingForGroup(nil, "mystery-model")
+	require.NoError(t, err)
+	require.Equal(t, ModelPricingSourceDefaultPrice, pricing.Source)
+	require.InDelta(t, 2e-6, pricing.InputPricePerToken, 1e-15)
+	require.NoError(t, billing.CheckModelPricing(nil, "mystery-model"))
+
+	svc, _ = newModelPriceServiceForTest(t, map[string]string{
+		SettingKeyUnknownModelPricing: `{"policy":"reject"}`,
+	}, &ModelPrice{ID: 1, Model: "known-model", InputPrice: 1, EffectiveFrom: time.Now().Add(-time.Hour)})
+	billing = NewBillingService(&config.Config{}, nil, svc)
+
+	require.ErrorIs(t, billing.CheckModelPricing(nil, "mystery-model"), ErrModelPriceUnknown)
+	require.NoError(t, billing.CheckModelPricing(nil, "known-model"))
+
+	// 无效配置回退为默认的 fallback 策略
+	svc, _ = newModelPriceServiceForTest(t, map[string]string{
+		SettingKeyUnknownModelPricing: `{"policy":"default_price"}`,
+	})
+	require.Equal(t, UnknownModelPricingFallback, svc.UnknownModelPolicy().Policy)
+}
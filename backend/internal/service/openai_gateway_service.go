@@ -20,7 +20,9 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
 	"github.com/gin-gonic/gin"
@@ -170,6 +172,8 @@ type OpenAIGatewayService struct {
 	usageLogRepo        UsageLogRepository
 	userRepo            UserRepository
 	userSubRepo         UserSubscriptionRepository
+	apiKeyRepo          APIKeyRepository
+	orgRepo             OrganizationRepository
 	cache               GatewayCache
 	cfg                 *config.Config
 	schedulerSnapshot   *SchedulerSnapshotService
@@ -181,6 +185,10 @@ type OpenAIGatewayService struct {
 	deferredService     *DeferredService
 	openAITokenProvider *OpenAITokenProvider
 	toolCorrector       *CodexToolCorrector
+	proxyPoolService    *ProxyPoolService
+
+	accountBudgetService *AccountBudgetService
+	accountHealthCache   AccountHealthCache // account health stats for the health_weighted selection mode
 }
 
 // NewOpenAIGatewayService creates a new OpenAIGatewayService
@@ -189,6 +197,8 @@ func NewOpenAIGatewayService(
 	usageLogRepo UsageLogRepository,
 	userRepo UserRepository,
 	userSubRepo UserSubscriptionRepository,
+	apiKeyRepo APIKeyRepository,
+	orgRepo OrganizationRepository,
 	cache GatewayCache,
 	cfg *config.Config,
 	schedulerSnapshot *SchedulerSnapshotService,
@@ -199,12 +209,17 @@ func NewOpenAIGatewayService(
 	httpUpstream HTTPUpstream,
 	deferredService *DeferredService,
 	openAITokenProvider *OpenAITokenProvider,
+	proxyPoolService *ProxyPoolService,
+	accountBudgetService *AccountBudgetService,
+	accountHealthCache AccountHealthCache,
 ) *OpenAIGatewayService {
 	return &OpenAIGatewayService{
 		accountRepo:         accountRepo,
 		usageLogRepo:        usageLogRepo,
 		userRepo:            userRepo,
 		userSubRepo:         userSubRepo,
+		apiKeyRepo:          apiKeyRepo,
+		orgRepo:             orgRepo,
 		cache:               cache,
 		cfg:                 cfg,
 		schedulerSnapshot:   schedulerSnapshot,
@@ -216,6 +231,10 @@ func NewOpenAIGatewayService(
 		deferredService:     deferredService,
 		openAITokenProvider: openAITokenProvider,
 		toolCorrector:       NewCodexToolCorrector(),
+		proxyPoolService:    proxyPoolService,
+
+		accountBudgetService: accountBudgetService,
+		accountHealthCache:   accountHealthCache,
 	}
 }
 
@@ -428,6 +447,13 @@ func (s *OpenAIGatewayService) isBetterAccount(candidate, current *Account) bool
 
 // SelectAccountWithLoadAwareness selects an account with load-awareness and wait plan.
 func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (*AccountSelectionResult, error) {
+	ctx, span := startSelectAccountSpan(ctx, groupID, requestedModel, len(excludedIDs))
+	result, err := s.selectAccountWithLoadAwareness(ctx, groupID, sessionHash, requestedModel, excludedIDs)
+	endSelectAccountSpan(span, result, err)
+	return result, err
+}
+
+func (s *OpenAIGatewayService) selectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (*AccountSelectionResult, error) {
 	cfg := s.schedulingConfig()
 	var stickyAccountID int64
 	if sessionHash != "" && s.cache != nil {
@@ -530,22 +556,27 @@ func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Contex
 
 	// ============ Layer 2: Load-aware selection ============
 	candidates := make([]*Account, 0, len(accounts))
+	var skipped candidateSkipStats
 	for i := range accounts {
 		acc := &accounts[i]
 		if isExcluded(acc.ID) {
+			skipped.Excluded++
 			continue
 		}
 		// Scheduler snapshots can be temporarily stale (bucket rebuild is throttled);
 		// re-check schedulability here so recently rate-limited/overloaded accounts
 		// are not selected again before the bucket is rebuilt.
 		if !acc.IsSchedulable() {
+			skipped.Unschedulable++
 			continue
 		}
 		if requestedModel != "" && !acc.IsModelSupported(requestedModel) {
+			skipped.ModelMapping++
 			continue
 		}
 		candidates = append(candidates, acc)
 	}
+	recordCandidateFilter(ctx, "load_aware", len(accounts), len(candidates), skipped)
 
 	if len(candidates) == 0 {
 		return nil, errors.New("no available accounts")
@@ -596,25 +627,41 @@ func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Contex
 		}
 
 		if len(available) > 0 {
-			sort.SliceStable(available, func(i, j int) bool {
-				a, b := available[i], available[j]
-				if a.account.Priority != b.account.Priority {
-					return a.account.Priority < b.account.Priority
-				}
-				if a.loadInfo.LoadRate != b.loadInfo.LoadRate {
-					return a.loadInfo.LoadRate < b.loadInfo.LoadRate
+			if healthWeightedSchedulingEnabled(s.cfg, s.accountHealthCache) {
+				// health_weighted: weight = health weight x idle rate, so busy or degraded accounts get less traffic
+				ordered := make([]*Account, 0, len(available))
+				loadByID := make(map[int64]accountWithLoad, len(available))
+				for _, item := range available {
+					ordered = append(ordered, item.account)
+					loadByID[item.account.ID] = item
 				}
-				switch {
-				case a.account.LastUsedAt == nil && b.account.LastUsedAt != nil:
-					return true
-				case a.account.LastUsedAt != nil && b.account.LastUsedAt == nil:
-					return false
-				case a.account.LastUsedAt == nil && b.account.LastUsedAt == nil:
-					return false
-				default:
-					return a.account.LastUsedAt.Before(*b.account.LastUsedAt)
+				weights := loadAccountHealthWeights(ctx, s.accountHealthCache, nil, ordered)
+				scaleWeightsByIdleRate(weights, loadMap)
+				sortAccountsByPriorityAndHealth(ordered, weights)
+				for i, acc := range ordered {
+					available[i] = loadByID[acc.ID]
 				}
-			})
+			} else {
+				sort.SliceStable(available, func(i, j int) bool {
+					a, b := available[i], available[j]
+					if a.account.Priority != b.account.Priority {
+						return a.account.Priority < b.account.Priority
+					}
+					if a.loadInfo.LoadRate != b.loadInfo.LoadRate {
+						return a.loadInfo.LoadRate < b.loadInfo.LoadRate
+					}
+					switch {
+					case a.account.LastUsedAt == nil && b.account.LastUsedAt != nil:
+						return true
+					case a.account.LastUsedAt != nil && b.account.LastUsedAt == nil:
+						return false
+					case a.account.LastUsedAt == nil && b.account.LastUsedAt == nil:
+						return false
+					default:
+						return a.account.LastUsedAt.Before(*b.account.LastUsedAt)
+					}
+				})
+			}
 
 			for _, item := range available {
 				result, err := s.tryAcquireAccountSlot(ctx, item.account.ID, item.account.Concurrency)
@@ -633,7 +680,11 @@ func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Contex
 	}
 
 	// ============ Layer 3: Fallback wait ============
-	sortAccountsByPriorityAndLastUsed(candidates, false)
+	if healthWeightedSchedulingEnabled(s.cfg, s.accountHealthCache) {
+		sortAccountsByPriorityAndHealth(candidates, loadAccountHealthWeights(ctx, s.accountHealthCache, nil, candidates))
+	} else {
+		sortAccountsByPriorityAndLastUsed(candidates, false)
+	}
 	for _, acc := range candidates {
 		return &AccountSelectionResult{
 			Account: acc,
@@ -876,8 +927,14 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 		c.Set(OpsUpstreamRequestBodyKey, string(body))
 	}
 
-	// Send request
-	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
+	// Send request, failing over to another pool member on proxy connect errors
+	resp, _, err := s.proxyPoolService.DoWithFailover(ctx, account, upstreamReq, proxyURL,
+		func() (*http.Request, error) {
+			return s.buildUpstreamRequest(ctx, c, account, body, token, reqStream, promptCacheKey, isCodexCLI)
+		},
+		func(req *http.Request, proxyURL string) (*http.Response, error) {
+			return s.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
+		})
 	if err != nil {
 		// Ensure the client receives an error response (handlers assume Forward writes on non-failover errors).
 		safeErr := sanitizeUpstreamErrorMessage(err.Error())
@@ -1626,6 +1683,11 @@ func (s *OpenAIGatewayService) replaceModelInResponseBody(body []byte, fromModel
 	return newBody
 }
 
+// CheckModelPricing 转发前检查模型是否可计费（未知模型策略为 reject 时拒绝无价格的模型）
+func (s *OpenAIGatewayService) CheckModelPricing(groupID *int64, model string) error {
+	return s.billingService.CheckModelPricing(groupID, model)
+}
+
 // OpenAIRecordUsageInput input for recording usage
 type OpenAIRecordUsageInput struct {
 	Result       *OpenAIForwardResult
@@ -1645,6 +1707,13 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	account := input.Account
 	subscription := input.Subscription
 
+	ctx, span := startRecordUsageSpan(ctx, account, result.Model, result.RequestID)
+	defer span.End()
+
+	if result.FirstTokenMs != nil {
+		metrics.ObserveFirstToken(account.Platform, result.Model, *result.FirstTokenMs)
+	}
+
 	// 计算实际的新输入token（减去缓存读取的token）
 	// 因为 input_tokens 包含了 cache_read_tokens，而缓存读取的token不应按输入价格计费
 	actualInputTokens := result.Usage.InputTokens - result.Usage.CacheReadInputTokens
@@ -1666,7 +1735,7 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		multiplier = apiKey.Group.RateMultiplier
 	}
 
-	cost, err := s.billingService.CalculateCost(result.Model, tokens, multiplier)
+	cost, err := s.billingService.CalculateCostForGroup(apiKey.GroupID, result.Model, tokens, multiplier)
 	if err != nil {
 		cost = &CostBreakdown{ActualCost: 0}
 	}
@@ -1716,35 +1785,61 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		usageLog.IPAddress = &input.IPAddress
 	}
 
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		usageLog.TraceID = &traceID
+	}
+
 	if apiKey.GroupID != nil {
 		usageLog.GroupID = apiKey.GroupID
 	}
 	if subscription != nil {
 		usageLog.SubscriptionID = &subscription.ID
 	}
+	if user.Organization != nil {
+		usageLog.OrganizationID = &user.Organization.OrganizationID
+	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	recordUsageSpanResult(span, usageLog, inserted, err)
+	shouldBill := inserted || err != nil
+
+	// Per-account budgets are tracked at the account rate
+	if shouldBill && s.accountBudgetService != nil {
+		s.accountBudgetService.RecordUsage(ctx, account, cost.TotalCost*accountRateMultiplier, int64(usageLog.TotalTokens()))
+	}
+
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
 		return nil
 	}
 
-	shouldBill := inserted || err != nil
-
 	// Deduct based on billing type
 	if isSubscriptionBilling {
 		if shouldBill && cost.TotalCost > 0 {
 			_ = s.userSubRepo.IncrementUsage(ctx, subscription.ID, cost.TotalCost)
-			s.billingCacheService.QueueUpdateSubscriptionUsage(user.ID, *apiKey.GroupID, cost.TotalCost)
+			s.billingCacheService.QueueUpdateSubscriptionUsage(subscriptionHolderID(user, subscription), *apiKey.GroupID, cost.TotalCost)
+			if usesSharedSubscription(user, subscription) {
+				recordOrgMemberUsage(ctx, s.orgRepo, s.billingCacheService, user.ID, cost.TotalCost)
+			}
+		}
+	} else if org := user.Organization; org != nil {
+		// Organization members draw from the org wallet
+		if shouldBill && cost.ActualCost > 0 {
+			recordOrganizationUsage(ctx, s.orgRepo, s.billingCacheService, org.OrganizationID, user.ID, cost.ActualCost, usageLog.RequestID)
 		}
 	} else {
 		if shouldBill && cost.ActualCost > 0 {
-			_ = s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost)
+			_ = s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost, BalanceChangeSource{Type: BalanceSourceUsage, ReferenceID: usageLog.RequestID})
 			s.billingCacheService.QueueDeductBalance(user.ID, cost.ActualCost)
 		}
 	}
 
+	// API Key spending counters (per-key total/daily/monthly caps)
+	if shouldBill && cost.ActualCost > 0 {
+		recordAPIKeyUsage(ctx, s.apiKeyRepo, s.billingCacheService, apiKey.ID, cost.ActualCost)
+	}
+
 	// Schedule batch update for account last_used_at
 	s.deferredService.ScheduleLastUsedUpdate(account.ID)
 
@@ -199,7 +199,10 @@ func (s *OpenAIOAuthService) RefreshToken(ctx context.Context, refreshToken stri
 }
 
 // RefreshAccountToken refreshes token for an OpenAI account
-func (s *OpenAIOAuthService) RefreshAccountToken(ctx context.Context, account *Account) (*OpenAITokenInfo, error) {
+func (s *OpenAIOAuthService) RefreshAccountToken(ctx context.Context, account *Account) (_ *OpenAITokenInfo, err error) {
+	ctx, endSpan := startTokenRefreshSpan(ctx, account)
+	defer func() { endSpan(err) }()
+
 	if !account.IsOpenAI() {
 		return nil, fmt.Errorf("account is not an OpenAI account")
 	}
@@ -22,6 +22,9 @@ const (
 	opsAlertEvaluatorLeaderLockKey   = "ops:alert:evaluator:leader"
 	opsAlertEvaluatorLeaderLockTTL   = 90 * time.Second
 	opsAlertEvaluatorSkipLogInterval = 1 * time.Minute
+
+	// Channel deliveries run asynchronously (retries/backoff must not stall evaluation).
+	opsAlertChannelNotifyTimeout = 2 * time.Minute
 )
 
 var opsAlertEvaluatorReleaseScript = redis.NewScript(`
@@ -32,9 +35,10 @@ return 0
 `)
 
 type OpsAlertEvaluatorService struct {
-	opsService   *OpsService
-	opsRepo      OpsRepository
-	emailService *EmailService
+	opsService           *OpsService
+	opsRepo              OpsRepository
+	emailService         *EmailService
+	balanceLedgerService *BalanceLedgerService
 
 	redisClient *redis.Client
 	cfg         *config.Config
@@ -65,18 +69,20 @@ func NewOpsAlertEvaluatorService(
 	opsService *OpsService,
 	opsRepo OpsRepository,
 	emailService *EmailService,
+	balanceLedgerService *BalanceLedgerService,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsAlertEvaluatorService {
 	return &OpsAlertEvaluatorService{
-		opsService:   opsService,
-		opsRepo:      opsRepo,
-		emailService: emailService,
-		redisClient:  redisClient,
-		cfg:          cfg,
-		instanceID:   uuid.NewString(),
-		ruleStates:   map[int64]*opsAlertRuleState{},
-		emailLimiter: newSlidingWindowLimiter(0, time.Hour),
+		opsService:           opsService,
+		opsRepo:              opsRepo,
+		emailService:         emailService,
+		balanceLedgerService: balanceLedgerService,
+		redisClient:          redisClient,
+		cfg:                  cfg,
+		instanceID:           uuid.NewString(),
+		ruleStates:           map[int64]*opsAlertRuleState{},
+		emailLimiter:         newSlidingWindowLimiter(0, time.Hour),
 	}
 }
 
@@ -196,6 +202,7 @@ func (s *OpsAlertEvaluatorService) evaluateOnce(interval time.Duration) {
 	eventsCreated := 0
 	eventsResolved := 0
 	emailsSent := 0
+	channelNotifications := 0
 
 	now := time.Now().UTC()
 	safeEnd := now.Truncate(time.Minute)
@@ -292,6 +299,9 @@ func (s *OpsAlertEvaluatorService) evaluateOnce(interval time.Duration) {
 				if s.maybeSendAlertEmail(ctx, runtimeCfg, rule, created) {
 					emailsSent++
 				}
+				if s.maybeNotifyAlertChannels(runtimeCfg, rule, created, OpsNotificationKindAlertFiring) {
+					channelNotifications++
+				}
 			}
 			continue
 		}
@@ -303,11 +313,16 @@ func (s *OpsAlertEvaluatorService) evaluateOnce(interval time.Duration) {
 				log.Printf("[OpsAlertEvaluator] resolve event failed (event=%d): %v", activeEvent.ID, err)
 			} else {
 				eventsResolved++
+				activeEvent.Status = OpsAlertStatusResolved
+				activeEvent.ResolvedAt = &resolvedAt
+				if s.maybeNotifyAlertChannels(runtimeCfg, rule, activeEvent, OpsNotificationKindAlertResolved) {
+					channelNotifications++
+				}
 			}
 		}
 	}
 
-	result := truncateString(fmt.Sprintf("rules=%d enabled=%d evaluated=%d created=%d resolved=%d emails_sent=%d", rulesTotal, rulesEnabled, rulesEvaluated, eventsCreated, eventsResolved, emailsSent), 2048)
+	result := truncateString(fmt.Sprintf("rules=%d enabled=%d evaluated=%d created=%d resolved=%d emails_sent=%d channel_notifications=%d", rulesTotal, rulesEnabled, rulesEvaluated, eventsCreated, eventsResolved, emailsSent, channelNotifications), 2048)
 	s.recordHeartbeatSuccess(runAt, time.Since(startedAt), result)
 }
 
@@ -506,6 +521,16 @@ func (s *OpsAlertEvaluatorService) computeRuleMetric(
 		return float64(countAccountsByCondition(availability.Accounts, func(acc *AccountAvailability) bool {
 			return acc.HasError && acc.TempUnschedulableUntil == nil
 		})), true
+	case "balance_ledger_drift_users":
+		// 对账：余额流水合计与 users.balance 不一致的用户数（全局指标，忽略 platform/group 过滤）
+		if s == nil || s.balanceLedgerService == nil {
+			return 0, false
+		}
+		count, ok := s.balanceLedgerService.DriftUserCount(ctx)
+		if !ok {
+			return 0, false
+		}
+		return float64(count), true
 	}
 
 	overview, err := s.opsRepo.GetDashboardOverview(ctx, &OpsDashboardFilter{
@@ -657,6 +682,31 @@ func (s *OpsAlertEvaluatorService) maybeSendAlertEmail(ctx context.Context, runt
 	return anySent
 }
 
+// maybeNotifyAlertChannels dispatches a firing/resolved notification to the rule's channels in the background.
+// Delivery results are recorded on the event by OpsService.NotifyAlertChannels.
+func (s *OpsAlertEvaluatorService) maybeNotifyAlertChannels(runtimeCfg *OpsAlertRuntimeSettings, rule *OpsAlertRule, event *OpsAlertEvent, kind string) bool {
+	if s == nil || s.opsService == nil || rule == nil || event == nil || event.ID <= 0 {
+		return false
+	}
+	if len(rule.NotifyChannelIDs) == 0 {
+		return false
+	}
+	if kind == OpsNotificationKindAlertFiring && runtimeCfg != nil && runtimeCfg.Silencing.Enabled {
+		if isOpsAlertSilenced(time.Now().UTC(), rule, event, runtimeCfg.Silencing) {
+			return false
+		}
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), opsAlertChannelNotifyTimeout)
+		defer cancel()
+		s.opsService.NotifyAlertChannels(ctx, rule, event, kind)
+	}()
+	return true
+}
+
 func buildOpsAlertEmailBody(rule *OpsAlertRule, event *OpsAlertEvent) string {
 	if rule == nil || event == nil {
 		return ""
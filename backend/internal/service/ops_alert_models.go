@@ -30,6 +30,8 @@ type OpsAlertRule struct {
 	CooldownMinutes  int `json:"cooldown_minutes"`
 
 	NotifyEmail bool `json:"notify_email"`
+	// NotifyChannelIDs selects ops_notification_channels to deliver firing/resolved notifications to.
+	NotifyChannelIDs []int64 `json:"notify_channel_ids"`
 
 	Filters map[string]any `json:"filters,omitempty"`
 
@@ -55,7 +57,10 @@ type OpsAlertEvent struct {
 	FiredAt    time.Time  `json:"fired_at"`
 	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
 
-	EmailSent bool      `json:"email_sent"`
+	EmailSent bool `json:"email_sent"`
+	// Notifications records per-channel delivery results (firing + resolved).
+	Notifications []OpsNotificationDelivery `json:"notifications,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -160,17 +160,20 @@ type opsCleanupDeletedCounts struct {
 	systemMetrics int64
 	hourlyPreagg  int64
 	dailyPreagg   int64
+
+	payloadCaptures int64
 }
 
 func (c opsCleanupDeletedCounts) String() string {
 	return fmt.Sprintf(
-		"error_logs=%d retry_attempts=%d alert_events=%d system_metrics=%d hourly_preagg=%d daily_preagg=%d",
+		"error_logs=%d retry_attempts=%d alert_events=%d system_metrics=%d hourly_preagg=%d daily_preagg=%d payload_captures=%d",
 		c.errorLogs,
 		c.retryAttempts,
 		c.alertEvents,
 		c.systemMetrics,
 		c.hourlyPreagg,
 		c.dailyPreagg,
+		c.payloadCaptures,
 	)
 }
 
@@ -232,6 +235,13 @@ func (s *OpsCleanupService) runCleanupOnce(ctx context.Context) (opsCleanupDelet
 		out.dailyPreagg = n
 	}
 
+	// Payload captures carry their own per-rule TTL (expires_at).
+	n, err := deleteOldRowsByID(ctx, s.db, "ops_payload_captures", "expires_at", now, batchSize, false)
+	if err != nil {
+		return out, err
+	}
+	out.payloadCaptures = n
+
 	return out, nil
 }
 
@@ -46,6 +46,7 @@ type OpsMetricsCollector struct {
 
 	accountRepo        AccountRepository
 	concurrencyService *ConcurrencyService
+	accountHealthCache AccountHealthCache
 
 	db          *sql.DB
 	redisClient *redis.Client
@@ -67,6 +68,7 @@ func NewOpsMetricsCollector(
 	settingRepo SettingRepository,
 	accountRepo AccountRepository,
 	concurrencyService *ConcurrencyService,
+	accountHealthCache AccountHealthCache,
 	db *sql.DB,
 	redisClient *redis.Client,
 	cfg *config.Config,
@@ -77,6 +79,7 @@ func NewOpsMetricsCollector(
 		cfg:                cfg,
 		accountRepo:        accountRepo,
 		concurrencyService: concurrencyService,
+		accountHealthCache: accountHealthCache,
 		db:                 db,
 		redisClient:        redisClient,
 		instanceID:         uuid.NewString(),
@@ -354,7 +357,117 @@ func (c *OpsMetricsCollector) collectAndPersist(ctx context.Context) error {
 		ConcurrencyQueueDepth: concurrencyQueueDepth,
 	}
 
-	return c.opsRepo.InsertSystemMetrics(ctx, input)
+	if err := c.opsRepo.InsertSystemMetrics(ctx, input); err != nil {
+		return err
+	}
+
+	// Account health feeds the health_weighted scheduler; best-effort so it never fails the collector run.
+	if err := c.collectAccountHealth(ctx, windowEnd); err != nil {
+		log.Printf("[OpsMetricsCollector] account health error: %v", err)
+	}
+	return nil
+}
+
+// collectAccountHealth aggregates per-account success/error/latency stats over accountHealthWindow
+// and publishes them to Redis for the scheduler and the admin account detail page.
+func (c *OpsMetricsCollector) collectAccountHealth(ctx context.Context, windowEnd time.Time) error {
+	if c.accountHealthCache == nil {
+		return nil
+	}
+	windowStart := windowEnd.Add(-accountHealthWindow)
+
+	statsByID := make(map[int64]*AccountHealthStats)
+	get := func(accountID int64) *AccountHealthStats {
+		st, ok := statsByID[accountID]
+		if !ok {
+			st = &AccountHealthStats{
+				AccountID:     accountID,
+				WindowMinutes: int(accountHealthWindow.Minutes()),
+				UpdatedAt:     windowEnd,
+			}
+			statsByID[accountID] = st
+		}
+		return st
+	}
+
+	usageQ := `
+SELECT
+  account_id,
+  COUNT(*) AS success_count,
+  percentile_cont(0.50) WITHIN GROUP (ORDER BY first_token_ms) AS ttft_p50,
+  percentile_cont(0.95) WITHIN GROUP (ORDER BY first_token_ms) AS ttft_p95
+FROM usage_logs
+WHERE created_at >= $1 AND created_at < $2
+GROUP BY account_id`
+
+	rows, err := c.db.QueryContext(ctx, usageQ, windowStart, windowEnd)
+	if err != nil {
+		return fmt.Errorf("query account usage: %w", err)
+	}
+	for rows.Next() {
+		var accountID, successCount int64
+		var p50, p95 sql.NullFloat64
+		if err := rows.Scan(&accountID, &successCount, &p50, &p95); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("scan account usage: %w", err)
+		}
+		st := get(accountID)
+		st.SuccessCount = successCount
+		st.TTFTP50Ms = floatToIntPtr(p50)
+		st.TTFTP95Ms = floatToIntPtr(p95)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Only provider-owned errors count against an account; client and platform errors are not its fault.
+	errorQ := `
+SELECT
+  account_id,
+  COUNT(*) AS error_count,
+  COUNT(*) FILTER (WHERE COALESCE(upstream_status_code, status_code, 0) = 429) AS upstream_429,
+  COUNT(*) FILTER (WHERE COALESCE(upstream_status_code, status_code, 0) = 529) AS upstream_529
+FROM ops_error_logs
+WHERE created_at >= $1 AND created_at < $2
+  AND account_id IS NOT NULL
+  AND error_owner = 'provider'
+  AND NOT is_business_limited
+GROUP BY account_id`
+
+	rows, err = c.db.QueryContext(ctx, errorQ, windowStart, windowEnd)
+	if err != nil {
+		return fmt.Errorf("query account errors: %w", err)
+	}
+	for rows.Next() {
+		var accountID, errorCount, c429, c529 int64
+		if err := rows.Scan(&accountID, &errorCount, &c429, &c529); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("scan account errors: %w", err)
+		}
+		st := get(accountID)
+		st.ErrorCount = errorCount
+		st.Upstream429Count = c429
+		st.Upstream529Count = c529
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(statsByID) == 0 {
+		return nil
+	}
+	stats := make([]*AccountHealthStats, 0, len(statsByID))
+	for _, st := range statsByID {
+		stats = append(stats, st)
+	}
+	// Keep entries alive across a few collector intervals; idle accounts simply expire back to neutral.
+	return c.accountHealthCache.SetAccountHealthStats(ctx, stats, 3*c.getInterval())
 }
 
 func (c *OpsMetricsCollector) collectConcurrencyQueueDepth(parentCtx context.Context) *int {
@@ -34,6 +34,7 @@ type OpsErrorLog struct {
 
 	ClientRequestID string `json:"client_request_id"`
 	RequestID       string `json:"request_id"`
+	TraceID         string `json:"trace_id"`
 	Message         string `json:"message"`
 
 	UserID      *int64 `json:"user_id"`
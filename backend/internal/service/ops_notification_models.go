@@ -0,0 +1,104 @@
+package service
+
+import "time"
+
+// Ops notification channel models.
+//
+// Channels deliver alert (firing/resolved) and scheduled report notifications to
+// webhook/chat tools in addition to the SMTP based email notifications.
+
+const (
+	OpsNotificationChannelWebhook  = "webhook"
+	OpsNotificationChannelSlack    = "slack"
+	OpsNotificationChannelDiscord  = "discord"
+	OpsNotificationChannelTelegram = "telegram"
+	OpsNotificationChannelDingTalk = "dingtalk"
+	OpsNotificationChannelFeishu   = "feishu"
+)
+
+var validOpsNotificationChannelTypes = []string{
+	OpsNotificationChannelWebhook,
+	OpsNotificationChannelSlack,
+	OpsNotificationChannelDiscord,
+	OpsNotificationChannelTelegram,
+	OpsNotificationChannelDingTalk,
+	OpsNotificationChannelFeishu,
+}
+
+// Notification kinds (also sent as X-Sub2API-Event for generic webhooks).
+const (
+	OpsNotificationKindAlertFiring   = "alert.firing"
+	OpsNotificationKindAlertResolved = "alert.resolved"
+	OpsNotificationKindReport        = "report"
+	OpsNotificationKindTest          = "test"
+)
+
+// Delivery statuses.
+const (
+	OpsNotificationStatusSent   = "sent"
+	OpsNotificationStatusFailed = "failed"
+)
+
+type OpsNotificationChannel struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+
+	Config OpsNotificationChannelConfig `json:"config"`
+
+	SendResolved bool `json:"send_resolved"`
+	SendReports  bool `json:"send_reports"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OpsNotificationChannelConfig holds type-specific settings.
+//
+// Secret and BotToken are write-only: API responses blank them and set the
+// corresponding *Configured flag; an empty value on update keeps the stored one.
+type OpsNotificationChannelConfig struct {
+	// URL is the webhook endpoint (webhook/slack/discord/dingtalk/feishu).
+	URL string `json:"url,omitempty"`
+	// Secret signs requests: HMAC-SHA256 for webhook, signed timestamp for dingtalk/feishu.
+	Secret string `json:"secret,omitempty"`
+	// Headers are extra request headers (webhook only).
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Telegram bot.
+	BotToken string `json:"bot_token,omitempty"`
+	ChatID   string `json:"chat_id,omitempty"`
+
+	SecretConfigured   bool `json:"secret_configured"`
+	BotTokenConfigured bool `json:"bot_token_configured"`
+}
+
+// OpsNotificationDelivery is the result of delivering one notification to one channel.
+type OpsNotificationDelivery struct {
+	ChannelID   int64     `json:"channel_id"`
+	ChannelName string    `json:"channel_name"`
+	ChannelType string    `json:"channel_type"`
+	Kind        string    `json:"kind"`
+	Status      string    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	Error       string    `json:"error,omitempty"`
+	SentAt      time.Time `json:"sent_at"`
+}
+
+// OpsNotificationMessage is a channel-agnostic notification.
+type OpsNotificationMessage struct {
+	Kind     string
+	Title    string
+	Text     string
+	Severity string
+
+	// Optional context (alerts only).
+	Rule  *OpsAlertRule
+	Event *OpsAlertEvent
+
+	// Optional context (reports only).
+	ReportType string
+
+	Timestamp time.Time
+}
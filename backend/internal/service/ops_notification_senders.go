@@ -0,0 +1,419 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	opsNotificationMaxAttempts  = 3
+	opsNotificationTimeout      = 10 * time.Second
+	opsNotificationMaxBodyBytes = 64 * 1024
+
+	opsDiscordMaxContentBytes  = 2000
+	opsTelegramMaxContentBytes = 4000
+	opsChatMaxContentBytes     = 8000
+
+	// Generic webhook signature headers.
+	opsWebhookEventHeader     = "X-Sub2API-Event"
+	opsWebhookTimestampHeader = "X-Sub2API-Timestamp"
+	opsWebhookSignatureHeader = "X-Sub2API-Signature"
+)
+
+// Overridable in unit tests.
+var (
+	opsTelegramAPIBaseURL       = "https://api.telegram.org"
+	opsNotificationRetryBackoff = func(attempt int) time.Duration { return time.Duration(attempt) * time.Second }
+)
+
+// errOpsNotificationPermanent marks delivery errors that should not be retried (4xx, API rejections).
+type errOpsNotificationPermanent struct{ err error }
+
+func (e *errOpsNotificationPermanent) Error() string { return e.err.Error() }
+func (e *errOpsNotificationPermanent) Unwrap() error { return e.err }
+
+// opsNotificationRequest is a prepared HTTP request; build is called once per attempt
+// so signed timestamps stay fresh across retries.
+type opsNotificationRequest struct {
+	build func(now time.Time) (*http.Request, error)
+	// check validates a 2xx response body (chat APIs report errors with HTTP 200).
+	check func(body []byte) error
+}
+
+// deliverOpsNotification sends msg to a single channel with retries (network errors, 429 and 5xx).
+func deliverOpsNotification(ctx context.Context, client *http.Client, channel *OpsNotificationChannel, msg *OpsNotificationMessage) OpsNotificationDelivery {
+	out := OpsNotificationDelivery{
+		ChannelID:   channel.ID,
+		ChannelName: channel.Name,
+		ChannelType: channel.Type,
+		Kind:        msg.Kind,
+		Status:      OpsNotificationStatusFailed,
+	}
+
+	req, err := buildOpsNotificationRequest(ctx, channel, msg)
+	if err != nil {
+		out.Error = truncateString(err.Error(), 512)
+		out.SentAt = time.Now().UTC()
+		return out
+	}
+
+	for attempt := 1; attempt <= opsNotificationMaxAttempts; attempt++ {
+		out.Attempts = attempt
+		err = doOpsNotificationRequest(client, req)
+		if err == nil {
+			break
+		}
+		var permanent *errOpsNotificationPermanent
+		if errors.As(err, &permanent) || attempt == opsNotificationMaxAttempts {
+			break
+		}
+		if !opsNotificationSleep(ctx, opsNotificationRetryBackoff(attempt)) {
+			err = ctx.Err()
+			break
+		}
+	}
+
+	out.SentAt = time.Now().UTC()
+	if err != nil {
+		out.Error = truncateString(err.Error(), 512)
+		return out
+	}
+	out.Status = OpsNotificationStatusSent
+	return out
+}
+
+func opsNotificationSleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func doOpsNotificationRequest(client *http.Client, req *opsNotificationRequest) error {
+	httpReq, err := req.build(time.Now())
+	if err != nil {
+		return &errOpsNotificationPermanent{err: err}
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		// Drop the URL from *url.Error: webhook URLs and Telegram endpoints embed access tokens.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("%s request failed: %w", strings.ToLower(urlErr.Op), urlErr.Err)
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, opsNotificationMaxBodyBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := fmt.Errorf("http %d: %s", resp.StatusCode, truncateString(strings.TrimSpace(string(body)), 256))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return err
+		}
+		return &errOpsNotificationPermanent{err: err}
+	}
+	if req.check != nil {
+		if err := req.check(body); err != nil {
+			return &errOpsNotificationPermanent{err: err}
+		}
+	}
+	return nil
+}
+
+func buildOpsNotificationRequest(ctx context.Context, channel *OpsNotificationChannel, msg *OpsNotificationMessage) (*opsNotificationRequest, error) {
+	cfg := channel.Config
+	switch channel.Type {
+	case OpsNotificationChannelWebhook:
+		body, err := json.Marshal(buildOpsWebhookPayload(msg))
+		if err != nil {
+			return nil, err
+		}
+		return &opsNotificationRequest{build: func(now time.Time) (*http.Request, error) {
+			req, err := newOpsJSONRequest(ctx, cfg.URL, body)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range cfg.Headers {
+				req.Header.Set(k, v)
+			}
+			req.Header.Set(opsWebhookEventHeader, msg.Kind)
+			if cfg.Secret != "" {
+				ts := strconv.FormatInt(now.Unix(), 10)
+				req.Header.Set(opsWebhookTimestampHeader, ts)
+				req.Header.Set(opsWebhookSignatureHeader, "sha256="+signOpsWebhookPayload(cfg.Secret, ts, body))
+			}
+			return req, nil
+		}}, nil
+
+	case OpsNotificationChannelSlack:
+		return newOpsStaticJSONRequest(ctx, cfg.URL, map[string]any{
+			"text": truncateString(fmt.Sprintf("*%s*\n%s", msg.Title, msg.Text), opsChatMaxContentBytes),
+		}, nil)
+
+	case OpsNotificationChannelDiscord:
+		return newOpsStaticJSONRequest(ctx, cfg.URL, map[string]any{
+			"content": truncateString(fmt.Sprintf("**%s**\n%s", msg.Title, msg.Text), opsDiscordMaxContentBytes),
+		}, nil)
+
+	case OpsNotificationChannelTelegram:
+		endpoint := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimRight(opsTelegramAPIBaseURL, "/"), cfg.BotToken)
+		return newOpsStaticJSONRequest(ctx, endpoint, map[string]any{
+			"chat_id":                  cfg.ChatID,
+			"text":                     truncateString(msg.Title+"\n\n"+msg.Text, opsTelegramMaxContentBytes),
+			"disable_web_page_preview": true,
+		}, func(body []byte) error {
+			var resp struct {
+				OK          bool   `json:"ok"`
+				Description string `json:"description"`
+			}
+			if err := json.Unmarshal(body, &resp); err != nil || !resp.OK {
+				return fmt.Errorf("telegram rejected message: %s", resp.Description)
+			}
+			return nil
+		})
+
+	case OpsNotificationChannelDingTalk:
+		body, err := json.Marshal(map[string]any{
+			"msgtype": "text",
+			"text":    map[string]any{"content": truncateString(msg.Title+"\n"+msg.Text, opsChatMaxContentBytes)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &opsNotificationRequest{
+			build: func(now time.Time) (*http.Request, error) {
+				endpoint := cfg.URL
+				if cfg.Secret != "" {
+					endpoint = signOpsDingTalkURL(endpoint, cfg.Secret, now)
+				}
+				return newOpsJSONRequest(ctx, endpoint, body)
+			},
+			check: func(body []byte) error {
+				var resp struct {
+					ErrCode int    `json:"errcode"`
+					ErrMsg  string `json:"errmsg"`
+				}
+				if err := json.Unmarshal(body, &resp); err == nil && resp.ErrCode != 0 {
+					return fmt.Errorf("dingtalk errcode %d: %s", resp.ErrCode, resp.ErrMsg)
+				}
+				return nil
+			},
+		}, nil
+
+	case OpsNotificationChannelFeishu:
+		text := truncateString(msg.Title+"\n"+msg.Text, opsChatMaxContentBytes)
+		return &opsNotificationRequest{
+			build: func(now time.Time) (*http.Request, error) {
+				payload := map[string]any{
+					"msg_type": "text",
+					"content":  map[string]any{"text": text},
+				}
+				if cfg.Secret != "" {
+					ts := strconv.FormatInt(now.Unix(), 10)
+					payload["timestamp"] = ts
+					payload["sign"] = signOpsFeishuPayload(cfg.Secret, ts)
+				}
+				body, err := json.Marshal(payload)
+				if err != nil {
+					return nil, err
+				}
+				return newOpsJSONRequest(ctx, cfg.URL, body)
+			},
+			check: func(body []byte) error {
+				var resp struct {
+					Code int    `json:"code"`
+					Msg  string `json:"msg"`
+				}
+				if err := json.Unmarshal(body, &resp); err == nil && resp.Code != 0 {
+					return fmt.Errorf("feishu code %d: %s", resp.Code, resp.Msg)
+				}
+				return nil
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported channel type: %s", channel.Type)
+	}
+}
+
+func newOpsStaticJSONRequest(ctx context.Context, endpoint string, payload any, check func([]byte) error) (*opsNotificationRequest, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &opsNotificationRequest{
+		build: func(time.Time) (*http.Request, error) { return newOpsJSONRequest(ctx, endpoint, body) },
+		check: check,
+	}, nil
+}
+
+func newOpsJSONRequest(ctx context.Context, endpoint string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sub2api-ops-notifier")
+	return req, nil
+}
+
+// signOpsWebhookPayload returns hex(HMAC-SHA256(secret, timestamp + "." + body)).
+// Receivers should recompute it and reject stale timestamps to prevent replays.
+func signOpsWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signOpsDingTalkURL appends DingTalk "加签" parameters: sign = base64(HMAC-SHA256(secret, ms + "\n" + secret)).
+func signOpsDingTalkURL(endpoint, secret string, now time.Time) string {
+	ts := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "\n" + secret))
+	sign := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	return endpoint + sep + "timestamp=" + ts + "&sign=" + url.QueryEscape(sign)
+}
+
+// signOpsFeishuPayload computes the Feishu custom bot signature: base64(HMAC-SHA256(key = ts + "\n" + secret, "")).
+func signOpsFeishuPayload(secret, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type opsWebhookPayload struct {
+	Kind       string           `json:"kind"`
+	Title      string           `json:"title"`
+	Text       string           `json:"text"`
+	Severity   string           `json:"severity,omitempty"`
+	Timestamp  time.Time        `json:"timestamp"`
+	Alert      *opsWebhookAlert `json:"alert,omitempty"`
+	ReportType string           `json:"report_type,omitempty"`
+}
+
+type opsWebhookAlert struct {
+	RuleID         int64          `json:"rule_id"`
+	RuleName       string         `json:"rule_name"`
+	EventID        int64          `json:"event_id"`
+	Status         string         `json:"status"`
+	MetricType     string         `json:"metric_type"`
+	Operator       string         `json:"operator"`
+	MetricValue    *float64       `json:"metric_value,omitempty"`
+	ThresholdValue *float64       `json:"threshold_value,omitempty"`
+	Dimensions     map[string]any `json:"dimensions,omitempty"`
+	FiredAt        time.Time      `json:"fired_at"`
+	ResolvedAt     *time.Time     `json:"resolved_at,omitempty"`
+}
+
+func buildOpsWebhookPayload(msg *OpsNotificationMessage) *opsWebhookPayload {
+	payload := &opsWebhookPayload{
+		Kind:       msg.Kind,
+		Title:      msg.Title,
+		Text:       msg.Text,
+		Severity:   msg.Severity,
+		Timestamp:  msg.Timestamp.UTC(),
+		ReportType: msg.ReportType,
+	}
+	if msg.Rule != nil && msg.Event != nil {
+		payload.Alert = &opsWebhookAlert{
+			RuleID:         msg.Rule.ID,
+			RuleName:       msg.Rule.Name,
+			EventID:        msg.Event.ID,
+			Status:         msg.Event.Status,
+			MetricType:     msg.Rule.MetricType,
+			Operator:       msg.Rule.Operator,
+			MetricValue:    msg.Event.MetricValue,
+			ThresholdValue: msg.Event.ThresholdValue,
+			Dimensions:     msg.Event.Dimensions,
+			FiredAt:        msg.Event.FiredAt.UTC(),
+			ResolvedAt:     msg.Event.ResolvedAt,
+		}
+	}
+	return payload
+}
+
+// buildOpsAlertNotificationText renders a plain-text alert body shared by all chat channels.
+func buildOpsAlertNotificationText(rule *OpsAlertRule, event *OpsAlertEvent) string {
+	if rule == nil || event == nil {
+		return ""
+	}
+	value := "-"
+	threshold := fmt.Sprintf("%.2f", rule.Threshold)
+	if event.MetricValue != nil {
+		value = fmt.Sprintf("%.2f", *event.MetricValue)
+	}
+	if event.ThresholdValue != nil {
+		threshold = fmt.Sprintf("%.2f", *event.ThresholdValue)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Rule: %s\n", strings.TrimSpace(rule.Name))
+	fmt.Fprintf(&b, "Severity: %s\n", strings.TrimSpace(rule.Severity))
+	fmt.Fprintf(&b, "Status: %s\n", strings.TrimSpace(event.Status))
+	fmt.Fprintf(&b, "Metric: %s %s %s (current %s)\n", strings.TrimSpace(rule.MetricType), strings.TrimSpace(rule.Operator), threshold, value)
+	fmt.Fprintf(&b, "Fired at: %s\n", event.FiredAt.UTC().Format(time.RFC3339))
+	if event.ResolvedAt != nil {
+		fmt.Fprintf(&b, "Resolved at: %s\n", event.ResolvedAt.UTC().Format(time.RFC3339))
+	}
+	if desc := strings.TrimSpace(event.Description); desc != "" {
+		b.WriteString(desc)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+var (
+	opsHTMLBlockEndRe = regexp.MustCompile(`(?i)<br\s*/?>|</(p|h[1-6]|li|tr|div|table|ul|ol)>`)
+	opsHTMLCellEndRe  = regexp.MustCompile(`(?i)</(td|th)>`)
+	opsHTMLListItemRe = regexp.MustCompile(`(?i)<li[^>]*>`)
+	opsHTMLTagRe      = regexp.MustCompile(`<[^>]*>`)
+)
+
+// opsHTMLToText converts the (simple) HTML produced by report builders into plain text for chat channels.
+func opsHTMLToText(s string) string {
+	s = opsHTMLListItemRe.ReplaceAllString(s, "- ")
+	s = opsHTMLCellEndRe.ReplaceAllString(s, " | ")
+	s = opsHTMLBlockEndRe.ReplaceAllString(s, "\n")
+	s = opsHTMLTagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		line = strings.TrimSpace(strings.TrimSuffix(line, "|"))
+		if line == "" {
+			continue
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
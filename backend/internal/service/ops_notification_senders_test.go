@@ -0,0 +1,256 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func withNoNotificationBackoff(t *testing.T) {
+	t.Helper()
+	prev := opsNotificationRetryBackoff
+	opsNotificationRetryBackoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { opsNotificationRetryBackoff = prev })
+}
+
+func testAlertMessage() *OpsNotificationMessage {
+	value := 12.5
+	return &OpsNotificationMessage{
+		Kind:      OpsNotificationKindAlertFiring,
+		Title:     "[Ops Alert][P1] error rate",
+		Text:      "Rule: error rate",
+		Severity:  "P1",
+		Rule:      &OpsAlertRule{ID: 7, Name: "error rate", MetricType: "error_rate", Operator: ">"},
+		Event:     &OpsAlertEvent{ID: 42, Status: OpsAlertStatusFiring, MetricValue: &value, FiredAt: time.Unix(1700000000, 0)},
+		Timestamp: time.Unix(1700000000, 0),
+	}
+}
+
+func TestDeliverOpsNotification_WebhookSignedWithRetry(t *testing.T) {
+	withNoNotificationBackoff(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+
+		ts := r.Header.Get(opsWebhookTimestampHeader)
+		mac := hmac.New(sha256.New, []byte("s3cret"))
+		mac.Write([]byte(ts + "." + string(body)))
+		require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(opsWebhookSignatureHeader))
+		require.Equal(t, OpsNotificationKindAlertFiring, r.Header.Get(opsWebhookEventHeader))
+		require.Equal(t, "bar", r.Header.Get("X-Foo"))
+
+		var payload opsWebhookPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+		require.NotNil(t, payload.Alert)
+		require.Equal(t, int64(42), payload.Alert.EventID)
+		require.Equal(t, int64(7), payload.Alert.RuleID)
+
+		if n == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	ch := &OpsNotificationChannel{ID: 1, Name: "hook", Type: OpsNotificationChannelWebhook, Config: OpsNotificationChannelConfig{
+		URL: srv.URL, Secret: "s3cret", Headers: map[string]string{"X-Foo": "bar"},
+	}}
+	d := deliverOpsNotification(context.Background(), srv.Client(), ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusSent, d.Status)
+	require.Equal(t, 2, d.Attempts)
+	require.Empty(t, d.Error)
+}
+
+func TestDeliverOpsNotification_ClientErrorNotRetried(t *testing.T) {
+	withNoNotificationBackoff(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("invalid_token"))
+	}))
+	defer srv.Close()
+
+	ch := &OpsNotificationChannel{ID: 2, Type: OpsNotificationChannelSlack, Config: OpsNotificationChannelConfig{URL: srv.URL}}
+	d := deliverOpsNotification(context.Background(), srv.Client(), ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusFailed, d.Status)
+	require.Equal(t, 1, d.Attempts)
+	require.Equal(t, int32(1), calls.Load())
+	require.Contains(t, d.Error, "http 403")
+}
+
+func TestDeliverOpsNotification_DingTalkSignAndErrCode(t *testing.T) {
+	withNoNotificationBackoff(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts := r.URL.Query().Get("timestamp")
+		mac := hmac.New(sha256.New, []byte("SECxyz"))
+		mac.Write([]byte(ts + "\n" + "SECxyz"))
+		require.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), r.URL.Query().Get("sign"))
+		require.Equal(t, "abc", r.URL.Query().Get("access_token"))
+		_, _ = w.Write([]byte(`{"errcode":310000,"errmsg":"keywords not in content"}`))
+	}))
+	defer srv.Close()
+
+	ch := &OpsNotificationChannel{ID: 3, Type: OpsNotificationChannelDingTalk, Config: OpsNotificationChannelConfig{
+		URL: srv.URL + "/robot/send?access_token=abc", Secret: "SECxyz",
+	}}
+	d := deliverOpsNotification(context.Background(), srv.Client(), ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusFailed, d.Status)
+	require.Equal(t, 1, d.Attempts)
+	require.Contains(t, d.Error, "310000")
+}
+
+func TestDeliverOpsNotification_FeishuSigned(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Timestamp string `json:"timestamp"`
+			Sign      string `json:"sign"`
+			MsgType   string `json:"msg_type"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Equal(t, "text", payload.MsgType)
+		mac := hmac.New(sha256.New, []byte(payload.Timestamp+"\n"+"fs"))
+		require.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), payload.Sign)
+		_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+	}))
+	defer srv.Close()
+
+	ch := &OpsNotificationChannel{ID: 4, Type: OpsNotificationChannelFeishu, Config: OpsNotificationChannelConfig{URL: srv.URL, Secret: "fs"}}
+	d := deliverOpsNotification(context.Background(), srv.Client(), ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusSent, d.Status)
+}
+
+func TestDeliverOpsNotification_Telegram(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/bot123:abc/sendMessage", r.URL.Path)
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Equal(t, "-100200", payload["chat_id"])
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	prev := opsTelegramAPIBaseURL
+	opsTelegramAPIBaseURL = srv.URL
+	t.Cleanup(func() { opsTelegramAPIBaseURL = prev })
+
+	ch := &OpsNotificationChannel{ID: 5, Type: OpsNotificationChannelTelegram, Config: OpsNotificationChannelConfig{BotToken: "123:abc", ChatID: "-100200"}}
+	d := deliverOpsNotification(context.Background(), srv.Client(), ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusSent, d.Status)
+}
+
+func TestDeliverOpsNotification_NetworkErrorHidesURL(t *testing.T) {
+	withNoNotificationBackoff(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := srv.URL + "/hook?token=topsecret"
+	srv.Close()
+
+	ch := &OpsNotificationChannel{ID: 6, Type: OpsNotificationChannelDiscord, Config: OpsNotificationChannelConfig{URL: endpoint}}
+	d := deliverOpsNotification(context.Background(), &http.Client{Timeout: time.Second}, ch, testAlertMessage())
+	require.Equal(t, OpsNotificationStatusFailed, d.Status)
+	require.Equal(t, opsNotificationMaxAttempts, d.Attempts)
+	require.NotContains(t, d.Error, "topsecret")
+}
+
+func TestOpsHTMLToText(t *testing.T) {
+	t.Parallel()
+
+	got := opsHTMLToText("<h2>Daily &amp; more</h2>\n<ul>\n  <li><b>Total</b>: 10</li>\n  <li>SLA: 99%</li>\n</ul><table><tr><td>a</td><td>b</td></tr></table>")
+	require.Equal(t, "Daily & more\n- Total: 10\n- SLA: 99%\na | b", got)
+}
+
+type notificationStubRepo struct {
+	OpsRepository
+	channels []*OpsNotificationChannel
+	recorded []OpsNotificationDelivery
+}
+
+func (r *notificationStubRepo) ListNotificationChannels(ctx context.Context) ([]*OpsNotificationChannel, error) {
+	return r.channels, nil
+}
+
+func (r *notificationStubRepo) UpdateAlertEventNotifications(ctx context.Context, eventID int64, deliveries []OpsNotificationDelivery) error {
+	r.recorded = deliveries
+	return nil
+}
+
+func TestNotifyAlertChannels_ResolvedOnlyToFiredChannels(t *testing.T) {
+	withNoNotificationBackoff(t)
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cfg := OpsNotificationChannelConfig{URL: srv.URL}
+	repo := &notificationStubRepo{channels: []*OpsNotificationChannel{
+		{ID: 1, Name: "fired", Type: OpsNotificationChannelSlack, Enabled: true, SendResolved: true, Config: cfg},
+		{ID: 2, Name: "not-fired", Type: OpsNotificationChannelSlack, Enabled: true, SendResolved: true, Config: cfg},
+		{ID: 3, Name: "no-resolved", Type: OpsNotificationChannelSlack, Enabled: true, SendResolved: false, Config: cfg},
+		{ID: 4, Name: "not-selected", Type: OpsNotificationChannelSlack, Enabled: true, SendResolved: true, Config: cfg},
+	}}
+	svc := &OpsService{opsRepo: repo}
+
+	rule := &OpsAlertRule{ID: 9, Name: "r", Severity: "P1", NotifyChannelIDs: []int64{1, 2, 3}}
+	resolvedAt := time.Now().UTC()
+	event := &OpsAlertEvent{ID: 100, Status: OpsAlertStatusResolved, ResolvedAt: &resolvedAt, Notifications: []OpsNotificationDelivery{
+		{ChannelID: 1, Kind: OpsNotificationKindAlertFiring, Status: OpsNotificationStatusSent},
+		{ChannelID: 2, Kind: OpsNotificationKindAlertFiring, Status: OpsNotificationStatusFailed},
+		{ChannelID: 3, Kind: OpsNotificationKindAlertFiring, Status: OpsNotificationStatusSent},
+	}}
+
+	deliveries := svc.NotifyAlertChannels(context.Background(), rule, event, OpsNotificationKindAlertResolved)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, int64(1), deliveries[0].ChannelID)
+	require.Equal(t, OpsNotificationKindAlertResolved, deliveries[0].Kind)
+	require.Equal(t, int32(1), hits.Load())
+	require.Len(t, repo.recorded, 4)
+	require.Equal(t, repo.recorded, event.Notifications)
+}
+
+func TestNormalizeNotificationChannel(t *testing.T) {
+	t.Parallel()
+
+	svc := &OpsService{}
+
+	ch := &OpsNotificationChannel{Name: " tg ", Type: "Telegram", Config: OpsNotificationChannelConfig{BotToken: "1:a", ChatID: "2", URL: "https://x"}}
+	require.NoError(t, svc.normalizeNotificationChannel(ch))
+	require.Equal(t, "tg", ch.Name)
+	require.Equal(t, OpsNotificationChannelTelegram, ch.Type)
+	require.Empty(t, ch.Config.URL)
+
+	require.Error(t, svc.normalizeNotificationChannel(&OpsNotificationChannel{Name: "x", Type: "telegram"}))
+	require.Error(t, svc.normalizeNotificationChannel(&OpsNotificationChannel{Name: "x", Type: "pager"}))
+	require.Error(t, svc.normalizeNotificationChannel(&OpsNotificationChannel{Name: "x", Type: "slack", Config: OpsNotificationChannelConfig{URL: "http://hooks.slack.com/x"}}))
+
+	slack := &OpsNotificationChannel{Name: "x", Type: "slack", Config: OpsNotificationChannelConfig{URL: "https://hooks.slack.com/services/T/B/C", Secret: "ignored"}}
+	require.NoError(t, svc.normalizeNotificationChannel(slack))
+	require.Empty(t, slack.Config.Secret)
+
+	masked := maskOpsNotificationChannel(&OpsNotificationChannel{Config: OpsNotificationChannelConfig{Secret: "s", BotToken: "t"}})
+	require.Empty(t, masked.Config.Secret)
+	require.Empty(t, masked.Config.BotToken)
+	require.True(t, masked.Config.SecretConfigured)
+	require.True(t, masked.Config.BotTokenConfigured)
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+)
+
+func (s *OpsService) ListNotificationChannels(ctx context.Context) ([]*OpsNotificationChannel, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return []*OpsNotificationChannel{}, nil
+	}
+	channels, err := s.opsRepo.ListNotificationChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*OpsNotificationChannel, 0, len(channels))
+	for _, ch := range channels {
+		out = append(out, maskOpsNotificationChannel(ch))
+	}
+	return out, nil
+}
+
+func (s *OpsService) CreateNotificationChannel(ctx context.Context, channel *OpsNotificationChannel) (*OpsNotificationChannel, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if channel == nil {
+		return nil, infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "invalid notification channel")
+	}
+	if err := s.normalizeNotificationChannel(channel); err != nil {
+		return nil, err
+	}
+
+	created, err := s.opsRepo.CreateNotificationChannel(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	return maskOpsNotificationChannel(created), nil
+}
+
+func (s *OpsService) UpdateNotificationChannel(ctx context.Context, channel *OpsNotificationChannel) (*OpsNotificationChannel, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if channel == nil || channel.ID <= 0 {
+		return nil, infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "invalid notification channel")
+	}
+
+	existing, err := s.opsRepo.GetNotificationChannelByID(ctx, channel.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, infraerrors.NotFound("OPS_NOTIFICATION_CHANNEL_NOT_FOUND", "notification channel not found")
+	}
+	// Secrets are write-only: keep the stored value when the update leaves them blank.
+	if strings.TrimSpace(channel.Type) == existing.Type {
+		if strings.TrimSpace(channel.Config.Secret) == "" {
+			channel.Config.Secret = existing.Config.Secret
+		}
+		if strings.TrimSpace(channel.Config.BotToken) == "" {
+			channel.Config.BotToken = existing.Config.BotToken
+		}
+	}
+	if err := s.normalizeNotificationChannel(channel); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.opsRepo.UpdateNotificationChannel(ctx, channel)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, infraerrors.NotFound("OPS_NOTIFICATION_CHANNEL_NOT_FOUND", "notification channel not found")
+		}
+		return nil, err
+	}
+	return maskOpsNotificationChannel(updated), nil
+}
+
+func (s *OpsService) DeleteNotificationChannel(ctx context.Context, id int64) error {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return err
+	}
+	if s.opsRepo == nil {
+		return infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if id <= 0 {
+		return infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL_ID", "invalid notification channel id")
+	}
+	if err := s.opsRepo.DeleteNotificationChannel(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infraerrors.NotFound("OPS_NOTIFICATION_CHANNEL_NOT_FOUND", "notification channel not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// TestNotificationChannel sends a test message synchronously and returns the delivery result.
+func (s *OpsService) TestNotificationChannel(ctx context.Context, id int64) (*OpsNotificationDelivery, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if id <= 0 {
+		return nil, infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL_ID", "invalid notification channel id")
+	}
+	channel, err := s.opsRepo.GetNotificationChannelByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, infraerrors.NotFound("OPS_NOTIFICATION_CHANNEL_NOT_FOUND", "notification channel not found")
+	}
+
+	now := time.Now().UTC()
+	deliveries := s.sendNotifications(ctx, []*OpsNotificationChannel{channel}, &OpsNotificationMessage{
+		Kind:      OpsNotificationKindTest,
+		Title:     "[Ops] Test notification",
+		Text:      fmt.Sprintf("This is a test notification for channel %q sent at %s.", channel.Name, now.Format(time.RFC3339)),
+		Timestamp: now,
+	})
+	return &deliveries[0], nil
+}
+
+// NotifyAlertChannels delivers a firing/resolved alert to the rule's channels and records the
+// delivery results on the event. Resolutions only go to channels that received the firing
+// notification and have send_resolved enabled.
+func (s *OpsService) NotifyAlertChannels(ctx context.Context, rule *OpsAlertRule, event *OpsAlertEvent, kind string) []OpsNotificationDelivery {
+	if s == nil || s.opsRepo == nil || rule == nil || event == nil || event.ID <= 0 {
+		return nil
+	}
+	if len(rule.NotifyChannelIDs) == 0 {
+		return nil
+	}
+
+	channels, err := s.opsRepo.ListNotificationChannels(ctx)
+	if err != nil {
+		log.Printf("[OpsNotification] list channels failed: %v", err)
+		return nil
+	}
+
+	selected := make(map[int64]struct{}, len(rule.NotifyChannelIDs))
+	for _, id := range rule.NotifyChannelIDs {
+		selected[id] = struct{}{}
+	}
+	firedTo := map[int64]struct{}{}
+	for _, d := range event.Notifications {
+		if d.Kind == OpsNotificationKindAlertFiring && d.Status == OpsNotificationStatusSent {
+			firedTo[d.ChannelID] = struct{}{}
+		}
+	}
+
+	targets := make([]*OpsNotificationChannel, 0, len(selected))
+	for _, ch := range channels {
+		if ch == nil || !ch.Enabled {
+			continue
+		}
+		if _, ok := selected[ch.ID]; !ok {
+			continue
+		}
+		if kind == OpsNotificationKindAlertResolved {
+			if _, ok := firedTo[ch.ID]; !ok || !ch.SendResolved {
+				continue
+			}
+		}
+		targets = append(targets, ch)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	label := "Ops Alert"
+	if kind == OpsNotificationKindAlertResolved {
+		label = "Ops Alert Resolved"
+	}
+	deliveries := s.sendNotifications(ctx, targets, &OpsNotificationMessage{
+		Kind:      kind,
+		Title:     fmt.Sprintf("[%s][%s] %s", label, strings.TrimSpace(rule.Severity), strings.TrimSpace(rule.Name)),
+		Text:      buildOpsAlertNotificationText(rule, event),
+		Severity:  strings.TrimSpace(rule.Severity),
+		Rule:      rule,
+		Event:     event,
+		Timestamp: time.Now().UTC(),
+	})
+
+	all := make([]OpsNotificationDelivery, 0, len(event.Notifications)+len(deliveries))
+	all = append(all, event.Notifications...)
+	all = append(all, deliveries...)
+	event.Notifications = all
+	if err := s.opsRepo.UpdateAlertEventNotifications(ctx, event.ID, all); err != nil {
+		log.Printf("[OpsNotification] record deliveries failed (event=%d): %v", event.ID, err)
+	}
+	return deliveries
+}
+
+// NotifyReportChannels delivers a scheduled report (HTML rendered as plain text) to channels with send_reports enabled.
+func (s *OpsService) NotifyReportChannels(ctx context.Context, reportType, title, contentHTML string) []OpsNotificationDelivery {
+	if s == nil || s.opsRepo == nil {
+		return nil
+	}
+	channels, err := s.opsRepo.ListNotificationChannels(ctx)
+	if err != nil {
+		log.Printf("[OpsNotification] list channels failed: %v", err)
+		return nil
+	}
+	targets := make([]*OpsNotificationChannel, 0, len(channels))
+	for _, ch := range channels {
+		if ch != nil && ch.Enabled && ch.SendReports {
+			targets = append(targets, ch)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	return s.sendNotifications(ctx, targets, &OpsNotificationMessage{
+		Kind:       OpsNotificationKindReport,
+		Title:      title,
+		Text:       opsHTMLToText(contentHTML),
+		ReportType: reportType,
+		Timestamp:  time.Now().UTC(),
+	})
+}
+
+func (s *OpsService) sendNotifications(ctx context.Context, channels []*OpsNotificationChannel, msg *OpsNotificationMessage) []OpsNotificationDelivery {
+	client := s.notificationHTTPClient()
+	out := make([]OpsNotificationDelivery, 0, len(channels))
+	for _, ch := range channels {
+		d := deliverOpsNotification(ctx, client, ch, msg)
+		if d.Status != OpsNotificationStatusSent {
+			log.Printf("[OpsNotification] deliver failed (channel=%d type=%s kind=%s attempts=%d): %s", ch.ID, ch.Type, msg.Kind, d.Attempts, d.Error)
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func (s *OpsService) notificationHTTPClient() *http.Client {
+	opts := httpclient.Options{Timeout: opsNotificationTimeout}
+	if s.cfg != nil {
+		opts.ValidateResolvedIP = s.cfg.Security.URLAllowlist.Enabled
+		opts.AllowPrivateHosts = s.cfg.Security.URLAllowlist.AllowPrivateHosts
+	}
+	client, err := httpclient.GetClient(opts)
+	if err != nil {
+		return &http.Client{Timeout: opsNotificationTimeout}
+	}
+	return client
+}
+
+func (s *OpsService) normalizeNotificationChannel(channel *OpsNotificationChannel) error {
+	channel.Name = strings.TrimSpace(channel.Name)
+	if channel.Name == "" || len(channel.Name) > 128 {
+		return infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "name is required (max 128 characters)")
+	}
+	channel.Type = strings.ToLower(strings.TrimSpace(channel.Type))
+
+	cfg := &channel.Config
+	cfg.URL = strings.TrimSpace(cfg.URL)
+	cfg.Secret = strings.TrimSpace(cfg.Secret)
+	cfg.BotToken = strings.TrimSpace(cfg.BotToken)
+	cfg.ChatID = strings.TrimSpace(cfg.ChatID)
+	cfg.SecretConfigured = false
+	cfg.BotTokenConfigured = false
+
+	switch channel.Type {
+	case OpsNotificationChannelTelegram:
+		if cfg.BotToken == "" || cfg.ChatID == "" {
+			return infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "bot_token and chat_id are required for telegram")
+		}
+		cfg.URL = ""
+		cfg.Secret = ""
+		cfg.Headers = nil
+	case OpsNotificationChannelWebhook, OpsNotificationChannelSlack, OpsNotificationChannelDiscord,
+		OpsNotificationChannelDingTalk, OpsNotificationChannelFeishu:
+		normalized, err := s.validateNotificationURL(cfg.URL)
+		if err != nil {
+			return infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "invalid url: "+err.Error())
+		}
+		cfg.URL = normalized
+		cfg.BotToken = ""
+		cfg.ChatID = ""
+		switch channel.Type {
+		case OpsNotificationChannelWebhook:
+			headers := make(map[string]string, len(cfg.Headers))
+			for k, v := range cfg.Headers {
+				if k = strings.TrimSpace(k); k != "" {
+					headers[k] = strings.TrimSpace(v)
+				}
+			}
+			cfg.Headers = headers
+		case OpsNotificationChannelSlack, OpsNotificationChannelDiscord:
+			cfg.Secret = ""
+			cfg.Headers = nil
+		default:
+			cfg.Headers = nil
+		}
+	default:
+		return infraerrors.BadRequest("INVALID_NOTIFICATION_CHANNEL", "type must be one of: "+strings.Join(validOpsNotificationChannelTypes, ", "))
+	}
+	return nil
+}
+
+func (s *OpsService) validateNotificationURL(raw string) (string, error) {
+	if s.cfg != nil && s.cfg.Security.URLAllowlist.Enabled {
+		return urlvalidator.ValidateHTTPSURL(raw, urlvalidator.ValidationOptions{
+			AllowPrivate: s.cfg.Security.URLAllowlist.AllowPrivateHosts,
+		})
+	}
+	allowInsecure := s.cfg != nil && s.cfg.Security.URLAllowlist.AllowInsecureHTTP
+	return urlvalidator.ValidateURLFormat(raw, allowInsecure)
+}
+
+// maskOpsNotificationChannel returns a copy safe for API responses (write-only secrets blanked).
+func maskOpsNotificationChannel(channel *OpsNotificationChannel) *OpsNotificationChannel {
+	if channel == nil {
+		return nil
+	}
+	out := *channel
+	out.Config.SecretConfigured = channel.Config.Secret != ""
+	out.Config.BotTokenConfigured = channel.Config.BotToken != ""
+	out.Config.Secret = ""
+	out.Config.BotToken = ""
+	return &out
+}
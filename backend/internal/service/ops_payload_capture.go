@@ -0,0 +1,561 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	mathrand "math/rand"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/util/logredact"
+)
+
+const opsPayloadCaptureRuleCacheTTL = 30 * time.Second
+
+// opsPayloadCaptureRedactKeys 在 logredact 默认键之外额外遮蔽的请求头/JSON 键
+var opsPayloadCaptureRedactKeys = []string{
+	"authorization",
+	"proxy-authorization",
+	"x-api-key",
+	"x-goog-api-key",
+	"api_key",
+	"apikey",
+	"cookie",
+	"set-cookie",
+	"secret",
+	"session_token",
+	"private_key",
+}
+
+type opsPayloadCaptureRuleSnapshot struct {
+	rules    []*OpsPayloadCaptureRule
+	loadedAt time.Time
+}
+
+func (s *OpsService) ListPayloadCaptureRules(ctx context.Context) ([]*OpsPayloadCaptureRule, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return []*OpsPayloadCaptureRule{}, nil
+	}
+	return s.opsRepo.ListPayloadCaptureRules(ctx)
+}
+
+func (s *OpsService) CreatePayloadCaptureRule(ctx context.Context, rule *OpsPayloadCaptureRule) (*OpsPayloadCaptureRule, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if rule == nil {
+		return nil, infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "invalid payload capture rule")
+	}
+	if err := normalizePayloadCaptureRule(rule, time.Now()); err != nil {
+		return nil, err
+	}
+
+	created, err := s.opsRepo.CreatePayloadCaptureRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidatePayloadCaptureRules()
+	return created, nil
+}
+
+func (s *OpsService) UpdatePayloadCaptureRule(ctx context.Context, rule *OpsPayloadCaptureRule) (*OpsPayloadCaptureRule, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if rule == nil || rule.ID <= 0 {
+		return nil, infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "invalid payload capture rule")
+	}
+	if err := normalizePayloadCaptureRule(rule, time.Now()); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.opsRepo.UpdatePayloadCaptureRule(ctx, rule)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, infraerrors.NotFound("OPS_PAYLOAD_CAPTURE_RULE_NOT_FOUND", "payload capture rule not found")
+		}
+		return nil, err
+	}
+	s.invalidatePayloadCaptureRules()
+	return updated, nil
+}
+
+func (s *OpsService) DeletePayloadCaptureRule(ctx context.Context, id int64) error {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return err
+	}
+	if s.opsRepo == nil {
+		return infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if id <= 0 {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE_ID", "invalid payload capture rule id")
+	}
+	if err := s.opsRepo.DeletePayloadCaptureRule(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infraerrors.NotFound("OPS_PAYLOAD_CAPTURE_RULE_NOT_FOUND", "payload capture rule not found")
+		}
+		return err
+	}
+	s.invalidatePayloadCaptureRules()
+	return nil
+}
+
+func normalizePayloadCaptureRule(rule *OpsPayloadCaptureRule, now time.Time) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" || len(rule.Name) > 128 {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "name is required (max 128 characters)")
+	}
+
+	rule.ScopeType = strings.ToLower(strings.TrimSpace(rule.ScopeType))
+	switch rule.ScopeType {
+	case OpsPayloadCaptureScopeUser, OpsPayloadCaptureScopeAPIKey, OpsPayloadCaptureScopeGroup:
+	default:
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "scope_type must be user, api_key or group")
+	}
+	if rule.ScopeID <= 0 {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "scope_id is required")
+	}
+
+	if rule.SampleRate <= 0 || rule.SampleRate > 1 {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "sample_rate must be in (0, 1]")
+	}
+
+	if rule.TTLHours == 0 {
+		rule.TTLHours = opsPayloadCaptureDefaultTTLHours
+	}
+	if rule.TTLHours < 1 || rule.TTLHours > opsPayloadCaptureMaxTTLHours {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", fmt.Sprintf("ttl_hours must be between 1 and %d", opsPayloadCaptureMaxTTLHours))
+	}
+
+	seen := make(map[string]struct{}, len(rule.RedactKeys))
+	keys := make([]string, 0, len(rule.RedactKeys))
+	for _, k := range rule.RedactKeys {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" {
+			continue
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		keys = append(keys, k)
+	}
+	if len(keys) > opsPayloadCaptureMaxRedactKeys {
+		return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", fmt.Sprintf("at most %d redact_keys are allowed", opsPayloadCaptureMaxRedactKeys))
+	}
+	rule.RedactKeys = keys
+
+	if rule.ExpiresAt != nil {
+		if !rule.ExpiresAt.After(now) {
+			return infraerrors.BadRequest("INVALID_PAYLOAD_CAPTURE_RULE", "expires_at must be in the future")
+		}
+		t := rule.ExpiresAt.UTC()
+		rule.ExpiresAt = &t
+	}
+	return nil
+}
+
+// HasPayloadCaptureRules reports whether any capture rule is currently active.
+// The gateway middleware uses it to skip response buffering entirely when capture is unused.
+func (s *OpsService) HasPayloadCaptureRules(ctx context.Context) bool {
+	if s == nil || s.opsRepo == nil {
+		return false
+	}
+	snapshot := s.payloadCaptureRuleSnapshot(ctx)
+	return snapshot != nil && len(snapshot.rules) > 0
+}
+
+// MatchPayloadCaptureRule returns the rule that should capture this request, or nil.
+// The most specific scope wins (api_key > user > group), then the sampling rate is applied.
+func (s *OpsService) MatchPayloadCaptureRule(ctx context.Context, userID int64, apiKeyID int64, groupID *int64) *OpsPayloadCaptureRule {
+	if s == nil || s.opsRepo == nil {
+		return nil
+	}
+	snapshot := s.payloadCaptureRuleSnapshot(ctx)
+	if snapshot == nil || len(snapshot.rules) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var matched *OpsPayloadCaptureRule
+	matchedRank := 0
+	for _, rule := range snapshot.rules {
+		if !rule.IsActive(now) {
+			continue
+		}
+		rank := 0
+		switch rule.ScopeType {
+		case OpsPayloadCaptureScopeAPIKey:
+			if rule.ScopeID == apiKeyID {
+				rank = 3
+			}
+		case OpsPayloadCaptureScopeUser:
+			if rule.ScopeID == userID {
+				rank = 2
+			}
+		case OpsPayloadCaptureScopeGroup:
+			if groupID != nil && rule.ScopeID == *groupID {
+				rank = 1
+			}
+		}
+		if rank > matchedRank {
+			matched, matchedRank = rule, rank
+		}
+	}
+	if matched == nil {
+		return nil
+	}
+	if matched.SampleRate < 1 {
+		sample := mathrand.Float64
+		if s.payloadCaptureSampler != nil {
+			sample = s.payloadCaptureSampler
+		}
+		if sample() >= matched.SampleRate {
+			return nil
+		}
+	}
+	if !s.IsMonitoringEnabled(ctx) {
+		return nil
+	}
+	return matched
+}
+
+// payloadCaptureRuleSnapshot 返回缓存的启用规则；首次同步加载，过期后后台刷新，避免网关热路径访问数据库
+func (s *OpsService) payloadCaptureRuleSnapshot(ctx context.Context) *opsPayloadCaptureRuleSnapshot {
+	snapshot := s.payloadCaptureRules.Load()
+	if snapshot == nil {
+		return s.reloadPayloadCaptureRules(ctx)
+	}
+	if time.Since(snapshot.loadedAt) > opsPayloadCaptureRuleCacheTTL && s.payloadCaptureRefreshing.CompareAndSwap(false, true) {
+		go func() {
+			defer s.payloadCaptureRefreshing.Store(false)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			s.reloadPayloadCaptureRules(ctx)
+		}()
+	}
+	return snapshot
+}
+
+func (s *OpsService) reloadPayloadCaptureRules(ctx context.Context) *opsPayloadCaptureRuleSnapshot {
+	rules, err := s.opsRepo.ListPayloadCaptureRules(ctx)
+	if err != nil {
+		// Keep the previous snapshot on transient errors; capture must never block gateway traffic.
+		log.Printf("[OpsPayloadCapture] load rules failed: %v", err)
+		if prev := s.payloadCaptureRules.Load(); prev != nil {
+			return prev
+		}
+		rules = nil
+	}
+
+	now := time.Now()
+	active := make([]*OpsPayloadCaptureRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.IsActive(now) {
+			active = append(active, rule)
+		}
+	}
+	snapshot := &opsPayloadCaptureRuleSnapshot{rules: active, loadedAt: now}
+	s.payloadCaptureRules.Store(snapshot)
+	return snapshot
+}
+
+func (s *OpsService) invalidatePayloadCaptureRules() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.reloadPayloadCaptureRules(ctx)
+}
+
+// RecordPayloadCapture redacts, compresses and persists a captured exchange.
+func (s *OpsService) RecordPayloadCapture(ctx context.Context, input *OpsPayloadCaptureInput) error {
+	if s == nil || s.opsRepo == nil || input == nil || input.Rule == nil {
+		return nil
+	}
+
+	row, err := buildPayloadCaptureRow(input)
+	if err != nil {
+		return err
+	}
+	_, err = s.opsRepo.InsertPayloadCapture(ctx, row)
+	return err
+}
+
+func buildPayloadCaptureRow(input *OpsPayloadCaptureInput) (*OpsInsertPayloadCaptureInput, error) {
+	rule := input.Rule
+	redactKeys := append(append([]string{}, opsPayloadCaptureRedactKeys...), rule.RedactKeys...)
+
+	createdAt := input.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	ruleID := rule.ID
+
+	row := &OpsInsertPayloadCaptureInput{
+		RuleID:          &ruleID,
+		RequestID:       truncateString(input.RequestID, 128),
+		ClientRequestID: truncateString(input.ClientRequestID, 128),
+		UserID:          input.UserID,
+		APIKeyID:        input.APIKeyID,
+		GroupID:         input.GroupID,
+		AccountID:       input.AccountID,
+		Platform:        truncateString(input.Platform, 32),
+		Model:           truncateString(input.Model, 200),
+		RequestPath:     truncateString(input.RequestPath, 256),
+		Stream:          input.Stream,
+		StatusCode:      input.StatusCode,
+		DurationMs:      input.DurationMs,
+		UserAgent:       truncateString(input.UserAgent, 512),
+		RequestBytes:    len(input.RequestBody),
+		ResponseBytes:   len(input.ResponseBody),
+		ExpiresAt:       createdAt.Add(time.Duration(rule.TTLHours) * time.Hour),
+		CreatedAt:       createdAt,
+	}
+
+	headersJSON, err := json.Marshal(logredact.RedactHeaders(input.RequestHeaders, redactKeys...))
+	if err != nil {
+		return nil, err
+	}
+	row.RequestHeadersJSON = string(headersJSON)
+
+	// 请求体：过大时仅保留占位信息（此类记录无法重放）
+	var requestBody string
+	if len(input.RequestBody) > OpsPayloadCaptureMaxBodyBytes {
+		row.RequestTruncated = true
+		requestBody = fmt.Sprintf(`{"payload_capture_truncated":true,"bytes":%d}`, len(input.RequestBody))
+	} else if len(input.RequestBody) > 0 {
+		requestBody = logredact.RedactText(logredact.RedactJSON(input.RequestBody, redactKeys...))
+	}
+
+	// 响应体：SSE 先重组为最终响应对象再脱敏，无法重组时保留原始文本
+	responseBody := input.ResponseBody
+	row.ResponseTruncated = input.ResponseTruncated
+	if isEventStreamCapture(input) {
+		if reassembled, ok := reassembleSSEResponse(responseBody); ok {
+			responseBody = reassembled
+			row.ResponseReassembled = true
+		}
+	}
+	var responseText string
+	if len(responseBody) > 0 {
+		if json.Valid(responseBody) {
+			responseText = logredact.RedactText(logredact.RedactJSON(responseBody, redactKeys...))
+		} else {
+			responseText = logredact.RedactText(string(responseBody))
+		}
+	}
+
+	if row.RequestBodyGzip, err = gzipPayload([]byte(requestBody)); err != nil {
+		return nil, err
+	}
+	if row.ResponseBodyGzip, err = gzipPayload([]byte(responseText)); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func isEventStreamCapture(input *OpsPayloadCaptureInput) bool {
+	if strings.Contains(strings.ToLower(input.ResponseContentType), "text/event-stream") {
+		return true
+	}
+	if !input.Stream {
+		return false
+	}
+	trimmed := bytes.TrimSpace(input.ResponseBody)
+	return bytes.HasPrefix(trimmed, []byte("event:")) || bytes.HasPrefix(trimmed, []byte("data:"))
+}
+
+func (s *OpsService) ListPayloadCaptures(ctx context.Context, filter *OpsPayloadCaptureFilter) (*OpsPayloadCaptureList, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return &OpsPayloadCaptureList{Items: []*OpsPayloadCapture{}, Total: 0, Page: 1, PageSize: 20}, nil
+	}
+	if filter == nil {
+		filter = &OpsPayloadCaptureFilter{}
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+	return s.opsRepo.ListPayloadCaptures(ctx, filter)
+}
+
+// GetPayloadCapture returns a capture with decompressed request/response bodies.
+func (s *OpsService) GetPayloadCapture(ctx context.Context, id int64) (*OpsPayloadCaptureDetail, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	detail, err := s.opsRepo.GetPayloadCaptureByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, infraerrors.NotFound("OPS_PAYLOAD_CAPTURE_NOT_FOUND", "payload capture not found")
+		}
+		return nil, infraerrors.InternalServer("OPS_PAYLOAD_CAPTURE_LOAD_FAILED", "Failed to load payload capture").WithCause(err)
+	}
+	if detail == nil {
+		return nil, infraerrors.NotFound("OPS_PAYLOAD_CAPTURE_NOT_FOUND", "payload capture not found")
+	}
+
+	reqBody, err := gunzipPayload(detail.RequestBodyGzip)
+	if err != nil {
+		return nil, infraerrors.InternalServer("OPS_PAYLOAD_CAPTURE_CORRUPTED", "Failed to decode captured request").WithCause(err)
+	}
+	respBody, err := gunzipPayload(detail.ResponseBodyGzip)
+	if err != nil {
+		return nil, infraerrors.InternalServer("OPS_PAYLOAD_CAPTURE_CORRUPTED", "Failed to decode captured response").WithCause(err)
+	}
+	detail.RequestBody = string(reqBody)
+	detail.ResponseBody = string(respBody)
+
+	detail.RequestHeaders = map[string]string{}
+	if strings.TrimSpace(detail.RequestHeadersJSON) != "" {
+		_ = json.Unmarshal([]byte(detail.RequestHeadersJSON), &detail.RequestHeaders)
+	}
+	return detail, nil
+}
+
+func (s *OpsService) DeletePayloadCapture(ctx context.Context, id int64) error {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return err
+	}
+	if s.opsRepo == nil {
+		return infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if err := s.opsRepo.DeletePayloadCapture(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infraerrors.NotFound("OPS_PAYLOAD_CAPTURE_NOT_FOUND", "payload capture not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// ReplayPayloadCapture re-runs a captured request through the ops retry machinery.
+// With accountID it is pinned to that account (which must belong to the capture's group);
+// otherwise an account is reselected from the capture's group with failover.
+func (s *OpsService) ReplayPayloadCapture(ctx context.Context, id int64, accountID *int64) (*OpsRetryResult, error) {
+	detail, err := s.GetPayloadCapture(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if detail.RequestTruncated {
+		return nil, infraerrors.BadRequest("OPS_PAYLOAD_CAPTURE_TRUNCATED", "Captured request body was truncated and cannot be replayed")
+	}
+	if strings.TrimSpace(detail.RequestBody) == "" || !json.Valid([]byte(detail.RequestBody)) {
+		return nil, infraerrors.BadRequest("OPS_RETRY_NO_REQUEST_BODY", "No replayable request body found")
+	}
+
+	mode := OpsRetryModeClient
+	var pinned *int64
+	if accountID != nil && *accountID > 0 {
+		mode = OpsRetryModeUpstream
+		pinned = accountID
+	} else if detail.GroupID == nil || *detail.GroupID <= 0 {
+		return nil, infraerrors.BadRequest("OPS_RETRY_PINNED_ACCOUNT_REQUIRED", "account_id is required when the capture has no group")
+	}
+
+	headersJSON, _ := json.Marshal(detail.RequestHeaders)
+	errorLog := &OpsErrorLogDetail{
+		OpsErrorLog: OpsErrorLog{
+			Platform:    detail.Platform,
+			Model:       detail.Model,
+			GroupID:     detail.GroupID,
+			AccountID:   detail.AccountID,
+			RequestPath: opsPayloadCaptureReplayPath(detail.RequestPath, detail.Platform),
+			Stream:      detail.Stream,
+		},
+		UserAgent:      detail.UserAgent,
+		RequestBody:    detail.RequestBody,
+		RequestHeaders: string(headersJSON),
+	}
+
+	startedAt := time.Now()
+	execCtx, cancel := context.WithTimeout(ctx, opsRetryTimeout)
+	defer cancel()
+	exec := s.executeRetry(execCtx, errorLog, mode, pinned)
+
+	finishedAt := time.Now()
+	result := &OpsRetryResult{
+		Mode:            mode,
+		Status:          opsRetryStatusFailed,
+		PinnedAccountID: pinned,
+		StartedAt:       startedAt,
+		FinishedAt:      finishedAt,
+		DurationMs:      finishedAt.Sub(startedAt).Milliseconds(),
+	}
+	if exec != nil {
+		result.Status = exec.status
+		result.UsedAccountID = exec.usedAccountID
+		result.HTTPStatusCode = exec.httpStatusCode
+		result.UpstreamRequestID = exec.upstreamRequestID
+		result.ResponsePreview = exec.responsePreview
+		result.ResponseTruncated = exec.responseTruncated
+		result.ErrorMessage = exec.errorMessage
+	}
+	if strings.TrimSpace(result.Status) == "" {
+		result.Status = opsRetryStatusFailed
+	}
+	return result, nil
+}
+
+// opsPayloadCaptureReplayPath maps chat-completions captures to the native endpoint:
+// their stored request body is the converted Messages/Responses payload.
+func opsPayloadCaptureReplayPath(path, platform string) string {
+	if !strings.Contains(strings.ToLower(path), "/chat/completions") {
+		return path
+	}
+	if platform == PlatformOpenAI {
+		return "/v1/responses"
+	}
+	return "/v1/messages"
+}
+
+func gzipPayload(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipPayload(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = zr.Close() }()
+	return io.ReadAll(zr)
+}
@@ -0,0 +1,183 @@
+package service
+
+import "time"
+
+// Ops payload capture models.
+//
+// Capture rules opt a user, API key or group into full request/response capture with a
+// sampling rate and retention TTL. Captured bodies are redacted and gzip-compressed; streamed
+// (SSE) responses are reassembled into the final response object before storage.
+
+const (
+	OpsPayloadCaptureScopeUser   = "user"
+	OpsPayloadCaptureScopeAPIKey = "api_key"
+	OpsPayloadCaptureScopeGroup  = "group"
+)
+
+const (
+	// OpsPayloadCaptureMaxBodyBytes caps the raw bytes buffered per request/response side.
+	OpsPayloadCaptureMaxBodyBytes = 2 * 1024 * 1024
+
+	opsPayloadCaptureDefaultTTLHours = 24
+	opsPayloadCaptureMaxTTLHours     = 30 * 24
+	opsPayloadCaptureMaxRedactKeys   = 50
+)
+
+type OpsPayloadCaptureRule struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	ScopeType string `json:"scope_type"`
+	ScopeID   int64  `json:"scope_id"`
+
+	SampleRate float64  `json:"sample_rate"`
+	TTLHours   int      `json:"ttl_hours"`
+	RedactKeys []string `json:"redact_keys"`
+
+	Enabled   bool       `json:"enabled"`
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	CaptureCount   int64      `json:"capture_count"`
+	LastCapturedAt *time.Time `json:"last_captured_at"`
+
+	CreatedBy *int64    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsActive reports whether the rule should still capture traffic at the given time.
+func (r *OpsPayloadCaptureRule) IsActive(now time.Time) bool {
+	if r == nil || !r.Enabled {
+		return false
+	}
+	return r.ExpiresAt == nil || now.Before(*r.ExpiresAt)
+}
+
+// OpsPayloadCaptureInput is the raw exchange collected by the gateway middleware.
+type OpsPayloadCaptureInput struct {
+	Rule *OpsPayloadCaptureRule
+
+	RequestID       string
+	ClientRequestID string
+
+	UserID    *int64
+	APIKeyID  *int64
+	GroupID   *int64
+	AccountID *int64
+
+	Platform    string
+	Model       string
+	RequestPath string
+	Stream      bool
+	StatusCode  int
+	DurationMs  int64
+	UserAgent   string
+
+	RequestHeaders      map[string][]string
+	RequestBody         []byte
+	ResponseBody        []byte
+	ResponseContentType string
+	ResponseTruncated   bool
+
+	CreatedAt time.Time
+}
+
+// OpsInsertPayloadCaptureInput is the redacted/compressed row persisted by the repository.
+type OpsInsertPayloadCaptureInput struct {
+	RuleID *int64
+
+	RequestID       string
+	ClientRequestID string
+
+	UserID    *int64
+	APIKeyID  *int64
+	GroupID   *int64
+	AccountID *int64
+
+	Platform    string
+	Model       string
+	RequestPath string
+	Stream      bool
+	StatusCode  int
+	DurationMs  int64
+	UserAgent   string
+
+	RequestHeadersJSON string
+
+	RequestBodyGzip     []byte
+	ResponseBodyGzip    []byte
+	RequestBytes        int
+	ResponseBytes       int
+	RequestTruncated    bool
+	ResponseTruncated   bool
+	ResponseReassembled bool
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type OpsPayloadCapture struct {
+	ID     int64  `json:"id"`
+	RuleID *int64 `json:"rule_id"`
+
+	RequestID       string `json:"request_id"`
+	ClientRequestID string `json:"client_request_id"`
+
+	UserID      *int64 `json:"user_id"`
+	UserEmail   string `json:"user_email"`
+	APIKeyID    *int64 `json:"api_key_id"`
+	GroupID     *int64 `json:"group_id"`
+	GroupName   string `json:"group_name"`
+	AccountID   *int64 `json:"account_id"`
+	AccountName string `json:"account_name"`
+
+	Platform    string `json:"platform"`
+	Model       string `json:"model"`
+	RequestPath string `json:"request_path"`
+	Stream      bool   `json:"stream"`
+	StatusCode  int    `json:"status_code"`
+	DurationMs  int64  `json:"duration_ms"`
+
+	RequestBytes        int  `json:"request_bytes"`
+	ResponseBytes       int  `json:"response_bytes"`
+	RequestTruncated    bool `json:"request_truncated"`
+	ResponseTruncated   bool `json:"response_truncated"`
+	ResponseReassembled bool `json:"response_reassembled"`
+
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OpsPayloadCaptureDetail struct {
+	OpsPayloadCapture
+
+	UserAgent      string            `json:"user_agent"`
+	RequestHeaders map[string]string `json:"request_headers"`
+	RequestBody    string            `json:"request_body"`
+	ResponseBody   string            `json:"response_body"`
+
+	RequestHeadersJSON string `json:"-"`
+	RequestBodyGzip    []byte `json:"-"`
+	ResponseBodyGzip   []byte `json:"-"`
+}
+
+type OpsPayloadCaptureFilter struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+
+	RuleID    *int64
+	UserID    *int64
+	APIKeyID  *int64
+	GroupID   *int64
+	AccountID *int64
+	Model     string
+
+	Page     int
+	PageSize int
+}
+
+type OpsPayloadCaptureList struct {
+	Items    []*OpsPayloadCapture `json:"items"`
+	Total    int64                `json:"total"`
+	Page     int                  `json:"page"`
+	PageSize int                  `json:"page_size"`
+}
@@ -0,0 +1,451 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// reassembleSSEResponse 将抓取到的 SSE 流重组为客户端最终看到的完整响应对象。
+//
+// 支持 Anthropic Messages、OpenAI Responses、Chat Completions 与 Gemini 流式格式；
+// 无法识别的格式返回各事件 data 组成的 JSON 数组。raw 不是 SSE 时返回 false。
+func reassembleSSEResponse(raw []byte) ([]byte, bool) {
+	events := parseSSEDataEvents(raw)
+	if len(events) == 0 {
+		return nil, false
+	}
+
+	var out any
+	switch detectSSEFormat(events) {
+	case "anthropic":
+		out = reassembleAnthropicSSE(events)
+	case "openai_responses":
+		out = reassembleOpenAIResponsesSSE(events)
+	case "chat_completions":
+		out = reassembleChatCompletionsSSE(events)
+	case "gemini":
+		out = reassembleGeminiSSE(events)
+	default:
+		arr := make([]any, 0, len(events))
+		for _, ev := range events {
+			arr = append(arr, ev)
+		}
+		out = arr
+	}
+
+	encoded, err := json.Marshal(out)
+	if err != nil {
+		return nil, false
+	}
+	return encoded, true
+}
+
+// parseSSEDataEvents 提取每个事件的 data 并解析为 JSON 对象，忽略 [DONE] 与非 JSON 数据
+func parseSSEDataEvents(raw []byte) []map[string]any {
+	var events []map[string]any
+	var data []string
+
+	flush := func() {
+		if len(data) == 0 {
+			return
+		}
+		payload := strings.TrimSpace(strings.Join(data, "\n"))
+		data = data[:0]
+		if payload == "" || payload == "[DONE]" {
+			return
+		}
+		var ev map[string]any
+		if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+			return
+		}
+		events = append(events, ev)
+	}
+
+	for _, line := range bytes.Split(raw, []byte("\n")) {
+		l := strings.TrimRight(string(line), "\r")
+		if l == "" {
+			flush()
+			continue
+		}
+		if strings.HasPrefix(l, "data:") {
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(l, "data:"), " "))
+		}
+	}
+	flush()
+	return events
+}
+
+func detectSSEFormat(events []map[string]any) string {
+	for _, ev := range events {
+		typ, _ := ev["type"].(string)
+		switch {
+		case typ == "message_start":
+			return "anthropic"
+		case strings.HasPrefix(typ, "response."):
+			return "openai_responses"
+		}
+		if obj, _ := ev["object"].(string); obj == "chat.completion.chunk" {
+			return "chat_completions"
+		}
+		if _, ok := unwrapGeminiSSEEvent(ev)["candidates"]; ok {
+			return "gemini"
+		}
+	}
+	return ""
+}
+
+func reassembleAnthropicSSE(events []map[string]any) map[string]any {
+	msg := map[string]any{}
+	var content []map[string]any
+	partialJSON := map[int]*strings.Builder{}
+
+	blockAt := func(idx int) map[string]any {
+		if idx < 0 || idx >= len(content) {
+			return nil
+		}
+		return content[idx]
+	}
+
+	for _, ev := range events {
+		typ, _ := ev["type"].(string)
+		switch typ {
+		case "message_start":
+			if m, ok := ev["message"].(map[string]any); ok {
+				for k, v := range m {
+					msg[k] = v
+				}
+			}
+		case "content_block_start":
+			idx := sseInt(ev["index"])
+			block := map[string]any{}
+			if b, ok := ev["content_block"].(map[string]any); ok {
+				for k, v := range b {
+					block[k] = v
+				}
+			}
+			for len(content) <= idx {
+				content = append(content, map[string]any{})
+			}
+			content[idx] = block
+		case "content_block_delta":
+			idx := sseInt(ev["index"])
+			block := blockAt(idx)
+			delta, _ := ev["delta"].(map[string]any)
+			if block == nil || delta == nil {
+				continue
+			}
+			switch delta["type"] {
+			case "text_delta":
+				block["text"] = sseString(block["text"]) + sseString(delta["text"])
+			case "thinking_delta":
+				block["thinking"] = sseString(block["thinking"]) + sseString(delta["thinking"])
+			case "signature_delta":
+				block["signature"] = delta["signature"]
+			case "input_json_delta":
+				sb := partialJSON[idx]
+				if sb == nil {
+					sb = &strings.Builder{}
+					partialJSON[idx] = sb
+				}
+				sb.WriteString(sseString(delta["partial_json"]))
+			case "citations_delta":
+				citations, _ := block["citations"].([]any)
+				block["citations"] = append(citations, delta["citation"])
+			}
+		case "content_block_stop":
+			idx := sseInt(ev["index"])
+			if sb := partialJSON[idx]; sb != nil {
+				if block := blockAt(idx); block != nil {
+					var input any
+					if err := json.Unmarshal([]byte(sb.String()), &input); err == nil {
+						block["input"] = input
+					} else {
+						block["input"] = sb.String()
+					}
+				}
+				delete(partialJSON, idx)
+			}
+		case "message_delta":
+			if delta, ok := ev["delta"].(map[string]any); ok {
+				for k, v := range delta {
+					msg[k] = v
+				}
+			}
+			if usage, ok := ev["usage"].(map[string]any); ok {
+				merged, _ := msg["usage"].(map[string]any)
+				if merged == nil {
+					merged = map[string]any{}
+				}
+				for k, v := range usage {
+					merged[k] = v
+				}
+				msg["usage"] = merged
+			}
+		case "error":
+			msg["error"] = ev["error"]
+		}
+	}
+
+	blocks := make([]any, 0, len(content))
+	for _, b := range content {
+		blocks = append(blocks, b)
+	}
+	msg["content"] = blocks
+	return msg
+}
+
+func reassembleOpenAIResponsesSSE(events []map[string]any) any {
+	var last map[string]any
+	for _, ev := range events {
+		resp, ok := ev["response"].(map[string]any)
+		if !ok {
+			continue
+		}
+		last = resp
+		switch ev["type"] {
+		case "response.completed", "response.incomplete", "response.failed":
+			return resp
+		}
+	}
+	if last != nil {
+		return last
+	}
+	return events[len(events)-1]
+}
+
+func reassembleChatCompletionsSSE(events []map[string]any) map[string]any {
+	out := map[string]any{"object": "chat.completion"}
+
+	type toolCall struct {
+		id, typ, name string
+		args          strings.Builder
+	}
+	type choice struct {
+		role         string
+		content      strings.Builder
+		finishReason any
+		toolCalls    map[int]*toolCall
+	}
+	choices := map[int]*choice{}
+
+	for _, ev := range events {
+		for _, key := range []string{"id", "created", "model", "system_fingerprint"} {
+			if v, ok := ev[key]; ok && v != nil {
+				out[key] = v
+			}
+		}
+		if usage, ok := ev["usage"].(map[string]any); ok {
+			out["usage"] = usage
+		}
+		arr, _ := ev["choices"].([]any)
+		for _, item := range arr {
+			c, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			idx := sseInt(c["index"])
+			ch := choices[idx]
+			if ch == nil {
+				ch = &choice{toolCalls: map[int]*toolCall{}}
+				choices[idx] = ch
+			}
+			if fr, ok := c["finish_reason"]; ok && fr != nil {
+				ch.finishReason = fr
+			}
+			delta, _ := c["delta"].(map[string]any)
+			if delta == nil {
+				continue
+			}
+			if role := sseString(delta["role"]); role != "" {
+				ch.role = role
+			}
+			ch.content.WriteString(sseString(delta["content"]))
+			calls, _ := delta["tool_calls"].([]any)
+			for _, rawCall := range calls {
+				call, ok := rawCall.(map[string]any)
+				if !ok {
+					continue
+				}
+				tIdx := sseInt(call["index"])
+				tc := ch.toolCalls[tIdx]
+				if tc == nil {
+					tc = &toolCall{}
+					ch.toolCalls[tIdx] = tc
+				}
+				if id := sseString(call["id"]); id != "" {
+					tc.id = id
+				}
+				if typ := sseString(call["type"]); typ != "" {
+					tc.typ = typ
+				}
+				if fn, ok := call["function"].(map[string]any); ok {
+					if name := sseString(fn["name"]); name != "" {
+						tc.name = name
+					}
+					tc.args.WriteString(sseString(fn["arguments"]))
+				}
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(choices))
+	for idx := range choices {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	outChoices := make([]any, 0, len(indexes))
+	for _, idx := range indexes {
+		ch := choices[idx]
+		role := ch.role
+		if role == "" {
+			role = "assistant"
+		}
+		message := map[string]any{"role": role, "content": ch.content.String()}
+		if len(ch.toolCalls) > 0 {
+			tIndexes := make([]int, 0, len(ch.toolCalls))
+			for tIdx := range ch.toolCalls {
+				tIndexes = append(tIndexes, tIdx)
+			}
+			sort.Ints(tIndexes)
+			calls := make([]any, 0, len(tIndexes))
+			for _, tIdx := range tIndexes {
+				tc := ch.toolCalls[tIdx]
+				calls = append(calls, map[string]any{
+					"id":       tc.id,
+					"type":     tc.typ,
+					"function": map[string]any{"name": tc.name, "arguments": tc.args.String()},
+				})
+			}
+			message["tool_calls"] = calls
+		}
+		outChoices = append(outChoices, map[string]any{
+			"index":         idx,
+			"message":       message,
+			"finish_reason": ch.finishReason,
+		})
+	}
+	out["choices"] = outChoices
+	return out
+}
+
+func reassembleGeminiSSE(events []map[string]any) map[string]any {
+	out := map[string]any{}
+	type candidate struct {
+		fields map[string]any
+		role   any
+		parts  []map[string]any
+	}
+	candidates := map[int]*candidate{}
+
+	for _, raw := range events {
+		ev := unwrapGeminiSSEEvent(raw)
+		for _, key := range []string{"usageMetadata", "modelVersion", "responseId", "promptFeedback"} {
+			if v, ok := ev[key]; ok && v != nil {
+				out[key] = v
+			}
+		}
+		arr, _ := ev["candidates"].([]any)
+		for i, item := range arr {
+			c, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			idx := i
+			if v, ok := c["index"]; ok {
+				idx = sseInt(v)
+			}
+			cand := candidates[idx]
+			if cand == nil {
+				cand = &candidate{fields: map[string]any{}}
+				candidates[idx] = cand
+			}
+			for k, v := range c {
+				if k != "content" && v != nil {
+					cand.fields[k] = v
+				}
+			}
+			content, _ := c["content"].(map[string]any)
+			if content == nil {
+				continue
+			}
+			if role, ok := content["role"]; ok {
+				cand.role = role
+			}
+			parts, _ := content["parts"].([]any)
+			for _, p := range parts {
+				part, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				// 连续的文本片段（thought 标记一致）合并为一个 part
+				if text, ok := part["text"].(string); ok && len(cand.parts) > 0 {
+					prev := cand.parts[len(cand.parts)-1]
+					if prevText, ok := prev["text"].(string); ok && prev["thought"] == part["thought"] {
+						prev["text"] = prevText + text
+						continue
+					}
+				}
+				copied := make(map[string]any, len(part))
+				for k, v := range part {
+					copied[k] = v
+				}
+				cand.parts = append(cand.parts, copied)
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(candidates))
+	for idx := range candidates {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	outCandidates := make([]any, 0, len(indexes))
+	for _, idx := range indexes {
+		cand := candidates[idx]
+		parts := make([]any, 0, len(cand.parts))
+		for _, p := range cand.parts {
+			parts = append(parts, p)
+		}
+		content := map[string]any{"parts": parts}
+		if cand.role != nil {
+			content["role"] = cand.role
+		}
+		cand.fields["content"] = content
+		outCandidates = append(outCandidates, cand.fields)
+	}
+	out["candidates"] = outCandidates
+	return out
+}
+
+// unwrapGeminiSSEEvent 兼容 Code Assist / Antigravity 的 {"response": {...}} 包装
+func unwrapGeminiSSEEvent(ev map[string]any) map[string]any {
+	if inner, ok := ev["response"].(map[string]any); ok {
+		if _, has := inner["candidates"]; has {
+			return inner
+		}
+	}
+	return ev
+}
+
+func sseInt(v any) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	default:
+		return 0
+	}
+}
+
+func sseString(v any) string {
+	s, _ := v.(string)
+	return s
+}
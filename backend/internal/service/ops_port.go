@@ -47,6 +47,24 @@ type OpsRepository interface {
 	CreateAlertEvent(ctx context.Context, event *OpsAlertEvent) (*OpsAlertEvent, error)
 	UpdateAlertEventStatus(ctx context.Context, eventID int64, status string, resolvedAt *time.Time) error
 	UpdateAlertEventEmailSent(ctx context.Context, eventID int64, emailSent bool) error
+	UpdateAlertEventNotifications(ctx context.Context, eventID int64, deliveries []OpsNotificationDelivery) error
+
+	// Notification channels (webhook/chat delivery for alerts and reports)
+	ListNotificationChannels(ctx context.Context) ([]*OpsNotificationChannel, error)
+	GetNotificationChannelByID(ctx context.Context, id int64) (*OpsNotificationChannel, error)
+	CreateNotificationChannel(ctx context.Context, input *OpsNotificationChannel) (*OpsNotificationChannel, error)
+	UpdateNotificationChannel(ctx context.Context, input *OpsNotificationChannel) (*OpsNotificationChannel, error)
+	DeleteNotificationChannel(ctx context.Context, id int64) error
+
+	// Payload capture (opt-in request/response capture for debugging and replay)
+	ListPayloadCaptureRules(ctx context.Context) ([]*OpsPayloadCaptureRule, error)
+	CreatePayloadCaptureRule(ctx context.Context, input *OpsPayloadCaptureRule) (*OpsPayloadCaptureRule, error)
+	UpdatePayloadCaptureRule(ctx context.Context, input *OpsPayloadCaptureRule) (*OpsPayloadCaptureRule, error)
+	DeletePayloadCaptureRule(ctx context.Context, id int64) error
+	InsertPayloadCapture(ctx context.Context, input *OpsInsertPayloadCaptureInput) (int64, error)
+	ListPayloadCaptures(ctx context.Context, filter *OpsPayloadCaptureFilter) (*OpsPayloadCaptureList, error)
+	GetPayloadCaptureByID(ctx context.Context, id int64) (*OpsPayloadCaptureDetail, error)
+	DeletePayloadCapture(ctx context.Context, id int64) error
 
 	// Alert silences
 	CreateAlertSilence(ctx context.Context, input *OpsAlertSilence) (*OpsAlertSilence, error)
@@ -62,6 +80,7 @@ type OpsRepository interface {
 type OpsInsertErrorLogInput struct {
 	RequestID       string
 	ClientRequestID string
+	TraceID         string
 
 	UserID    *int64
 	APIKeyID  *int64
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"log"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
@@ -36,6 +37,12 @@ type OpsService struct {
 	openAIGatewayService      *OpenAIGatewayService
 	geminiCompatService       *GeminiMessagesCompatService
 	antigravityGatewayService *AntigravityGatewayService
+
+	// payloadCaptureRules caches enabled capture rules for the gateway hot path.
+	payloadCaptureRules      atomic.Pointer[opsPayloadCaptureRuleSnapshot]
+	payloadCaptureRefreshing atomic.Bool
+	// payloadCaptureSampler is a unit-test hook for overriding sampling randomness.
+	payloadCaptureSampler func() float64
 }
 
 func NewOpsService(
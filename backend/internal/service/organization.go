@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// Organization 组织：持有共享余额与并发池，成员用户的 API Key 从组织钱包扣费
+//
+// 组织不单独持有订阅：成员没有自己的订阅时共享 owner 名下的订阅，所有成员的用量计入该订阅的
+// 日/周/月限额（即组织级订阅限额），成员月度花费上限同样约束共享订阅上的用量。
+type Organization struct {
+	ID          int64
+	Name        string
+	OwnerUserID int64
+	Balance     float64
+	Concurrency int // 组织并发池上限，0 表示不限制
+	Status      string
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// 列表查询时填充
+	MemberCount int
+	Owner       *User
+}
+
+func (o *Organization) IsActive() bool {
+	return o.Status == StatusActive
+}
+
+// OrganizationBalanceTransaction 组织钱包流水（只追加），来源类型与用户余额流水一致
+type OrganizationBalanceTransaction struct {
+	ID             int64
+	OrganizationID int64
+	Amount         float64
+	BalanceAfter   float64
+	SourceType     string
+	ReferenceID    string
+	ActorUserID    *int64 // 管理员调整为操作人，请求扣费为发起请求的成员
+	Notes          string
+	CreatedAt      time.Time
+}
+
+// OrganizationMember 组织成员，一个用户最多属于一个组织
+type OrganizationMember struct {
+	ID                 int64
+	OrganizationID     int64
+	UserID             int64
+	Role               string
+	MonthlyLimitUSD    *float64 // 成员月度花费上限，nil 表示不限制
+	MonthlyUsageUSD    float64
+	MonthlyWindowStart *time.Time
+	JoinedAt           time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	User *User
+}
+
+// HasMonthlyLimit 是否设置了成员月度花费上限
+func (m *OrganizationMember) HasMonthlyLimit() bool {
+	return m.MonthlyLimitUSD != nil && *m.MonthlyLimitUSD > 0
+}
+
+// CurrentMonthlyUsage 返回当前自然月的花费；窗口已过期视为 0
+func (m *OrganizationMember) CurrentMonthlyUsage(now time.Time) float64 {
+	if m.MonthlyWindowStart == nil || m.MonthlyWindowStart.Before(timezone.StartOfMonth(now)) {
+		return 0
+	}
+	return m.MonthlyUsageUSD
+}
+
+// CanManageMembers owner/admin 可以管理成员
+func (m *OrganizationMember) CanManageMembers() bool {
+	return m.Role == OrgRoleOwner || m.Role == OrgRoleAdmin
+}
+
+// OrganizationMembership 用户所属组织的计费视图，随 API Key 认证快照缓存
+type OrganizationMembership struct {
+	OrganizationID  int64
+	OwnerUserID     int64
+	Status          string // 组织状态
+	Concurrency     int    // 组织并发池上限
+	Role            string
+	MonthlyLimitUSD *float64
+}
+
+// IsActive 组织是否处于可用状态
+func (m *OrganizationMembership) IsActive() bool {
+	return m.Status == StatusActive
+}
+
+// OrganizationMemberUsage 组织内单个成员在统计区间内的用量
+type OrganizationMemberUsage struct {
+	UserID       int64   `json:"user_id"`
+	Email        string  `json:"email"`
+	Username     string  `json:"username"`
+	Role         string  `json:"role"`
+	Requests     int64   `json:"requests"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	CacheTokens  int64   `json:"cache_tokens"`
+	TotalCost    float64 `json:"total_cost"`
+	ActualCost   float64 `json:"actual_cost"`
+}
+
+// OrganizationUsageBreakdown 组织用量按成员拆分
+type OrganizationUsageBreakdown struct {
+	OrganizationID int64                     `json:"organization_id"`
+	StartTime      time.Time                 `json:"start_time"`
+	EndTime        time.Time                 `json:"end_time"`
+	Members        []OrganizationMemberUsage `json:"members"`
+	TotalRequests  int64                     `json:"total_requests"`
+	TotalCost      float64                   `json:"total_cost"`
+	ActualCost     float64                   `json:"actual_cost"`
+}
+
+// CreateOrganizationInput 创建组织输入
+type CreateOrganizationInput struct {
+	Name        string
+	OwnerUserID int64
+	Balance     float64
+	Concurrency int
+	Notes       string
+}
+
+// UpdateOrganizationInput 更新组织输入
+type UpdateOrganizationInput struct {
+	Name        *string
+	Concurrency *int
+	Status      *string
+	Notes       *string
+}
+
+// AddOrganizationMemberInput 添加成员输入（按用户 ID 或邮箱定位用户）
+type AddOrganizationMemberInput struct {
+	UserID          int64
+	Email           string
+	Role            string
+	MonthlyLimitUSD *float64
+}
+
+// UpdateOrganizationMemberInput 更新成员输入
+type UpdateOrganizationMemberInput struct {
+	Role *string
+	// MonthlyLimitUSD 为 nil 表示不修改；ClearMonthlyLimit 为 true 时移除上限
+	MonthlyLimitUSD   *float64
+	ClearMonthlyLimit bool
+}
+
+// OrganizationRepository 组织仓储接口
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *Organization) error
+	GetByID(ctx context.Context, id int64) (*Organization, error)
+	Update(ctx context.Context, org *Organization) error
+	Delete(ctx context.Context, id int64) error
+	ListWithFilters(ctx context.Context, params pagination.PaginationParams, status, search string) ([]Organization, *pagination.PaginationResult, error)
+
+	// UpdateBalance 增减组织余额（amount 可为负），并在同一语句内追加组织钱包流水
+	UpdateBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error
+	// DeductBalance 扣除组织余额，允许透支（与用户余额策略一致）
+	DeductBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error
+	// ListBalanceTransactions 按时间倒序列出组织钱包流水
+	ListBalanceTransactions(ctx context.Context, organizationID int64, params pagination.PaginationParams) ([]OrganizationBalanceTransaction, *pagination.PaginationResult, error)
+
+	AddMember(ctx context.Context, member *OrganizationMember) error
+	GetMemberByUserID(ctx context.Context, userID int64) (*OrganizationMember, error)
+	UpdateMember(ctx context.Context, member *OrganizationMember) error
+	RemoveMember(ctx context.Context, organizationID, userID int64) error
+	ListMembers(ctx context.Context, organizationID int64) ([]OrganizationMember, error)
+	ListMemberUserIDs(ctx context.Context, organizationID int64) ([]int64, error)
+	// IncrementMemberUsage 累加成员月度花费，月度窗口落后于 monthStart 时先归零
+	IncrementMemberUsage(ctx context.Context, userID int64, amount float64, monthStart time.Time) error
+
+	// GetMemberUsage 按成员汇总组织在 [startTime, endTime) 内的用量
+	GetMemberUsage(ctx context.Context, organizationID int64, startTime, endTime time.Time) ([]OrganizationMemberUsage, error)
+}
@@ -0,0 +1,541 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrOrganizationNotFound         = infraerrors.NotFound("ORGANIZATION_NOT_FOUND", "organization not found")
+	ErrOrganizationMemberNotFound   = infraerrors.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "organization member not found")
+	ErrNotInOrganization            = infraerrors.NotFound("NOT_IN_ORGANIZATION", "you are not a member of any organization")
+	ErrUserAlreadyInOrganization    = infraerrors.Conflict("USER_ALREADY_IN_ORGANIZATION", "user already belongs to an organization")
+	ErrOrganizationDisabled         = infraerrors.Forbidden("ORGANIZATION_DISABLED", "organization is disabled")
+	ErrOrganizationPermissionDenied = infraerrors.Forbidden("ORGANIZATION_PERMISSION_DENIED", "insufficient organization permissions")
+	ErrOrganizationOwnerImmutable   = infraerrors.BadRequest("ORGANIZATION_OWNER_IMMUTABLE", "the organization owner cannot be removed or demoted")
+	ErrOrganizationInvalidRole      = infraerrors.BadRequest("ORGANIZATION_INVALID_ROLE", "role must be admin or member")
+	ErrOrgMemberMonthlyLimitReached = infraerrors.TooManyRequests("ORG_MEMBER_MONTHLY_LIMIT_EXCEEDED", "organization member monthly spending limit exceeded")
+)
+
+// OrganizationService 组织服务：管理员维护组织与钱包，组织 owner/admin 自助管理成员
+type OrganizationService struct {
+	orgRepo              OrganizationRepository
+	userRepo             UserRepository
+	billingCacheService  *BillingCacheService
+	entClient            *dbent.Client
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+}
+
+// NewOrganizationService 创建组织服务实例
+func NewOrganizationService(
+	orgRepo OrganizationRepository,
+	userRepo UserRepository,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *OrganizationService {
+	return &OrganizationService{
+		orgRepo:              orgRepo,
+		userRepo:             userRepo,
+		billingCacheService:  billingCacheService,
+		entClient:            entClient,
+		authCacheInvalidator: authCacheInvalidator,
+	}
+}
+
+// ============================================
+// 管理员接口
+// ============================================
+
+// List 分页列出组织
+func (s *OrganizationService) List(ctx context.Context, params pagination.PaginationParams, status, search string) ([]Organization, *pagination.PaginationResult, error) {
+	return s.orgRepo.ListWithFilters(ctx, params, status, search)
+}
+
+// GetByID 获取组织
+func (s *OrganizationService) GetByID(ctx context.Context, id int64) (*Organization, error) {
+	return s.orgRepo.GetByID(ctx, id)
+}
+
+// Create 创建组织，并将 owner 加入为成员
+func (s *OrganizationService) Create(ctx context.Context, input *CreateOrganizationInput) (*Organization, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, infraerrors.BadRequest("ORGANIZATION_NAME_REQUIRED", "organization name is required")
+	}
+	if _, err := s.userRepo.GetByID(ctx, input.OwnerUserID); err != nil {
+		return nil, err
+	}
+	if err := s.ensureNotInOrganization(ctx, input.OwnerUserID); err != nil {
+		return nil, err
+	}
+
+	org := &Organization{
+		Name:        name,
+		OwnerUserID: input.OwnerUserID,
+		Balance:     input.Balance,
+		Concurrency: input.Concurrency,
+		Status:      StatusActive,
+		Notes:       input.Notes,
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	if err := s.orgRepo.Create(txCtx, org); err != nil {
+		return nil, fmt.Errorf("create organization: %w", err)
+	}
+	owner := &OrganizationMember{
+		OrganizationID: org.ID,
+		UserID:         input.OwnerUserID,
+		Role:           OrgRoleOwner,
+	}
+	if err := s.orgRepo.AddMember(txCtx, owner); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	s.invalidateMemberAuth(ctx, input.OwnerUserID)
+	org.MemberCount = 1
+	return org, nil
+}
+
+// Update 更新组织基本信息；并发池与状态变化需要刷新所有成员的认证缓存
+func (s *OrganizationService) Update(ctx context.Context, id int64, input *UpdateOrganizationInput) (*Organization, error) {
+	org, err := s.orgRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return nil, infraerrors.BadRequest("ORGANIZATION_NAME_REQUIRED", "organization name is required")
+		}
+		org.Name = name
+	}
+	if input.Concurrency != nil {
+		org.Concurrency = *input.Concurrency
+	}
+	if input.Status != nil {
+		org.Status = *input.Status
+	}
+	if input.Notes != nil {
+		org.Notes = *input.Notes
+	}
+
+	if err := s.orgRepo.Update(ctx, org); err != nil {
+		return nil, err
+	}
+	if input.Concurrency != nil || input.Status != nil {
+		s.invalidateOrganizationAuth(ctx, org.ID)
+	}
+	return org, nil
+}
+
+// Delete 删除组织并移除全部成员，成员恢复为个人计费
+func (s *OrganizationService) Delete(ctx context.Context, id int64) error {
+	if _, err := s.orgRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	memberIDs, err := s.orgRepo.ListMemberUserIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	for _, userID := range memberIDs {
+		if err := s.orgRepo.RemoveMember(txCtx, id, userID); err != nil {
+			return err
+		}
+	}
+	if err := s.orgRepo.Delete(txCtx, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	for _, userID := range memberIDs {
+		s.invalidateMemberAuth(ctx, userID)
+		s.invalidateMemberUsage(ctx, userID)
+	}
+	s.invalidateOrganizationBalance(id)
+	return nil
+}
+
+// AdjustBalance 调整组织余额，operation 为 set/add/subtract；调整按差额写入组织钱包流水
+func (s *OrganizationService) AdjustBalance(ctx context.Context, id int64, amount float64, operation, notes string, actorUserID int64) (*Organization, error) {
+	org, err := s.orgRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	newBalance := org.Balance
+	switch operation {
+	case "set":
+		newBalance = amount
+	case "add":
+		newBalance += amount
+	case "subtract":
+		newBalance -= amount
+	default:
+		return nil, infraerrors.BadRequest("INVALID_BALANCE_OPERATION", "operation must be set, add or subtract")
+	}
+	if newBalance < 0 {
+		return nil, fmt.Errorf("balance cannot be negative, current balance: %.2f, requested operation would result in: %.2f", org.Balance, newBalance)
+	}
+
+	if diff := newBalance - org.Balance; diff != 0 {
+		source := BalanceChangeSource{
+			Type:  BalanceSourceAdminAdjustment,
+			Notes: truncateString(notes, 500),
+		}
+		if actorUserID > 0 {
+			source.ActorUserID = &actorUserID
+		}
+		// 按差额原子更新，避免覆盖并发扣费
+		if err := s.orgRepo.UpdateBalance(ctx, id, diff, source); err != nil {
+			return nil, err
+		}
+		s.invalidateOrganizationBalance(id)
+	}
+	org.Balance = newBalance
+	return org, nil
+}
+
+// ListBalanceTransactions 列出组织钱包流水
+func (s *OrganizationService) ListBalanceTransactions(ctx context.Context, organizationID int64, params pagination.PaginationParams) ([]OrganizationBalanceTransaction, *pagination.PaginationResult, error) {
+	if _, err := s.orgRepo.GetByID(ctx, organizationID); err != nil {
+		return nil, nil, err
+	}
+	return s.orgRepo.ListBalanceTransactions(ctx, organizationID, params)
+}
+
+// ListMembers 列出组织成员
+func (s *OrganizationService) ListMembers(ctx context.Context, organizationID int64) ([]OrganizationMember, error) {
+	if _, err := s.orgRepo.GetByID(ctx, organizationID); err != nil {
+		return nil, err
+	}
+	members, err := s.orgRepo.ListMembers(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i := range members {
+		members[i].MonthlyUsageUSD = members[i].CurrentMonthlyUsage(now)
+	}
+	return members, nil
+}
+
+// AddMember 添加成员（仅可添加 admin/member，owner 在创建组织时确定）
+func (s *OrganizationService) AddMember(ctx context.Context, organizationID int64, input *AddOrganizationMemberInput) (*OrganizationMember, error) {
+	if _, err := s.orgRepo.GetByID(ctx, organizationID); err != nil {
+		return nil, err
+	}
+	role := input.Role
+	if role == "" {
+		role = OrgRoleMember
+	}
+	if !isAssignableOrgRole(role) {
+		return nil, ErrOrganizationInvalidRole
+	}
+
+	user, err := s.resolveUser(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureNotInOrganization(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	member := &OrganizationMember{
+		OrganizationID:  organizationID,
+		UserID:          user.ID,
+		Role:            role,
+		MonthlyLimitUSD: input.MonthlyLimitUSD,
+	}
+	if err := s.orgRepo.AddMember(ctx, member); err != nil {
+		return nil, err
+	}
+	member.User = user
+
+	s.invalidateMemberAuth(ctx, user.ID)
+	s.invalidateMemberUsage(ctx, user.ID)
+	return member, nil
+}
+
+// UpdateMember 修改成员角色或月度上限
+func (s *OrganizationService) UpdateMember(ctx context.Context, organizationID, userID int64, input *UpdateOrganizationMemberInput) (*OrganizationMember, error) {
+	member, err := s.getMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Role != nil && *input.Role != member.Role {
+		if member.Role == OrgRoleOwner {
+			return nil, ErrOrganizationOwnerImmutable
+		}
+		if !isAssignableOrgRole(*input.Role) {
+			return nil, ErrOrganizationInvalidRole
+		}
+		member.Role = *input.Role
+	}
+	if input.ClearMonthlyLimit {
+		member.MonthlyLimitUSD = nil
+	} else if input.MonthlyLimitUSD != nil {
+		limit := *input.MonthlyLimitUSD
+		member.MonthlyLimitUSD = &limit
+	}
+
+	if err := s.orgRepo.UpdateMember(ctx, member); err != nil {
+		return nil, err
+	}
+	s.invalidateMemberAuth(ctx, userID)
+	return member, nil
+}
+
+// RemoveMember 移除成员，owner 不可移除
+func (s *OrganizationService) RemoveMember(ctx context.Context, organizationID, userID int64) error {
+	member, err := s.getMember(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == OrgRoleOwner {
+		return ErrOrganizationOwnerImmutable
+	}
+	if err := s.orgRepo.RemoveMember(ctx, organizationID, userID); err != nil {
+		return err
+	}
+	s.invalidateMemberAuth(ctx, userID)
+	s.invalidateMemberUsage(ctx, userID)
+	return nil
+}
+
+// GetUsageBreakdown 按成员汇总组织在 [startTime, endTime) 内的用量
+func (s *OrganizationService) GetUsageBreakdown(ctx context.Context, organizationID int64, startTime, endTime time.Time) (*OrganizationUsageBreakdown, error) {
+	if _, err := s.orgRepo.GetByID(ctx, organizationID); err != nil {
+		return nil, err
+	}
+	members, err := s.orgRepo.GetMemberUsage(ctx, organizationID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("get organization usage: %w", err)
+	}
+	out := &OrganizationUsageBreakdown{
+		OrganizationID: organizationID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Members:        members,
+	}
+	for _, m := range members {
+		out.TotalRequests += m.Requests
+		out.TotalCost += m.TotalCost
+		out.ActualCost += m.ActualCost
+	}
+	return out, nil
+}
+
+// ============================================
+// 成员自助接口（按当前用户的成员身份与角色鉴权）
+// ============================================
+
+// GetMyOrganization 获取当前用户所属组织及其成员信息
+func (s *OrganizationService) GetMyOrganization(ctx context.Context, userID int64) (*Organization, *OrganizationMember, error) {
+	member, err := s.orgRepo.GetMemberByUserID(ctx, userID)
+	if err != nil {
+		if infraerrors.IsNotFound(err) {
+			return nil, nil, ErrNotInOrganization
+		}
+		return nil, nil, err
+	}
+	org, err := s.orgRepo.GetByID(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	member.MonthlyUsageUSD = member.CurrentMonthlyUsage(time.Now())
+	return org, member, nil
+}
+
+// ListMyMembers owner/admin 查看本组织成员
+func (s *OrganizationService) ListMyMembers(ctx context.Context, actorID int64) ([]OrganizationMember, error) {
+	actor, err := s.requireManager(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListMembers(ctx, actor.OrganizationID)
+}
+
+// AddMyMember owner/admin 添加成员；只有 owner 可以添加 admin
+func (s *OrganizationService) AddMyMember(ctx context.Context, actorID int64, input *AddOrganizationMemberInput) (*OrganizationMember, error) {
+	actor, err := s.requireManager(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Role == OrgRoleAdmin && actor.Role != OrgRoleOwner {
+		return nil, ErrOrganizationPermissionDenied
+	}
+	return s.AddMember(ctx, actor.OrganizationID, input)
+}
+
+// UpdateMyMember owner/admin 修改成员；admin 只能管理普通成员
+func (s *OrganizationService) UpdateMyMember(ctx context.Context, actorID, userID int64, input *UpdateOrganizationMemberInput) (*OrganizationMember, error) {
+	actor, target, err := s.requireManageTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Role != nil && *input.Role == OrgRoleAdmin && actor.Role != OrgRoleOwner {
+		return nil, ErrOrganizationPermissionDenied
+	}
+	return s.UpdateMember(ctx, actor.OrganizationID, target.UserID, input)
+}
+
+// RemoveMyMember owner/admin 移除成员；admin 只能移除普通成员
+func (s *OrganizationService) RemoveMyMember(ctx context.Context, actorID, userID int64) error {
+	actor, target, err := s.requireManageTarget(ctx, actorID, userID)
+	if err != nil {
+		return err
+	}
+	return s.RemoveMember(ctx, actor.OrganizationID, target.UserID)
+}
+
+// GetMyUsageBreakdown owner/admin 查看本组织按成员的用量
+func (s *OrganizationService) GetMyUsageBreakdown(ctx context.Context, actorID int64, startTime, endTime time.Time) (*OrganizationUsageBreakdown, error) {
+	actor, err := s.requireManager(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUsageBreakdown(ctx, actor.OrganizationID, startTime, endTime)
+}
+
+func (s *OrganizationService) requireManager(ctx context.Context, actorID int64) (*OrganizationMember, error) {
+	actor, err := s.orgRepo.GetMemberByUserID(ctx, actorID)
+	if err != nil {
+		if infraerrors.IsNotFound(err) {
+			return nil, ErrNotInOrganization
+		}
+		return nil, err
+	}
+	if !actor.CanManageMembers() {
+		return nil, ErrOrganizationPermissionDenied
+	}
+	return actor, nil
+}
+
+// requireManageTarget 校验 actor 可以管理 target：owner 可管理所有非 owner 成员，admin 只能管理 member
+func (s *OrganizationService) requireManageTarget(ctx context.Context, actorID, userID int64) (*OrganizationMember, *OrganizationMember, error) {
+	actor, err := s.requireManager(ctx, actorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := s.getMember(ctx, actor.OrganizationID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if target.Role == OrgRoleOwner {
+		return nil, nil, ErrOrganizationOwnerImmutable
+	}
+	if actor.Role != OrgRoleOwner && target.Role != OrgRoleMember {
+		return nil, nil, ErrOrganizationPermissionDenied
+	}
+	return actor, target, nil
+}
+
+// ============================================
+// 内部工具
+// ============================================
+
+func isAssignableOrgRole(role string) bool {
+	return role == OrgRoleAdmin || role == OrgRoleMember
+}
+
+func (s *OrganizationService) getMember(ctx context.Context, organizationID, userID int64) (*OrganizationMember, error) {
+	member, err := s.orgRepo.GetMemberByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.OrganizationID != organizationID {
+		return nil, ErrOrganizationMemberNotFound
+	}
+	return member, nil
+}
+
+func (s *OrganizationService) ensureNotInOrganization(ctx context.Context, userID int64) error {
+	_, err := s.orgRepo.GetMemberByUserID(ctx, userID)
+	if err == nil {
+		return ErrUserAlreadyInOrganization
+	}
+	if infraerrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *OrganizationService) resolveUser(ctx context.Context, input *AddOrganizationMemberInput) (*User, error) {
+	if input.UserID > 0 {
+		return s.userRepo.GetByID(ctx, input.UserID)
+	}
+	email := strings.TrimSpace(input.Email)
+	if email == "" {
+		return nil, infraerrors.BadRequest("ORGANIZATION_MEMBER_REQUIRED", "user_id or email is required")
+	}
+	return s.userRepo.GetByEmail(ctx, email)
+}
+
+// invalidateMemberAuth 成员身份写入 API Key 认证快照，变更后需要清除
+func (s *OrganizationService) invalidateMemberAuth(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+}
+
+func (s *OrganizationService) invalidateOrganizationAuth(ctx context.Context, organizationID int64) {
+	if s.authCacheInvalidator == nil {
+		return
+	}
+	userIDs, err := s.orgRepo.ListMemberUserIDs(ctx, organizationID)
+	if err != nil {
+		log.Printf("list organization members failed: org_id=%d err=%v", organizationID, err)
+		return
+	}
+	for _, userID := range userIDs {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+}
+
+func (s *OrganizationService) invalidateMemberUsage(ctx context.Context, userID int64) {
+	if s.billingCacheService != nil {
+		_ = s.billingCacheService.InvalidateOrgMemberUsage(ctx, userID)
+	}
+}
+
+func (s *OrganizationService) invalidateOrganizationBalance(organizationID int64) {
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.billingCacheService.InvalidateOrganizationBalance(cacheCtx, organizationID); err != nil {
+			log.Printf("invalidate organization balance cache failed: org_id=%d err=%v", organizationID, err)
+		}
+	}()
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 支付渠道
+const (
+	PaymentProviderCreem  = "creem"
+	PaymentProviderStripe = "stripe"
+	PaymentProviderEPay   = "epay"
+)
+
+// 充值订单状态
+// pending -> paid -> refunded；pending -> failed（过期/支付失败），failed 的订单仍可因迟到的成功回调变为 paid
+const (
+	PaymentStatusPending  = "pending"
+	PaymentStatusPaid     = "paid"
+	PaymentStatusFailed   = "failed"
+	PaymentStatusRefunded = "refunded"
+)
+
+// 单笔充值金额范围（支付币种，整数）
+const (
+	PaymentMinAmount = 1
+	PaymentMaxAmount = 10000
+)
+
+var (
+	ErrPaymentOrderNotFound    = infraerrors.NotFound("PAYMENT_ORDER_NOT_FOUND", "payment order not found")
+	ErrPaymentProviderNotFound = infraerrors.NotFound("PAYMENT_PROVIDER_NOT_FOUND", "payment provider not found")
+	ErrPaymentProviderDisabled = infraerrors.BadRequest("PAYMENT_PROVIDER_DISABLED", "payment provider is not enabled or not configured")
+	ErrPaymentInvalidAmount    = infraerrors.BadRequest("PAYMENT_INVALID_AMOUNT", "payment amount is out of range")
+	ErrPaymentInvalidMethod    = infraerrors.BadRequest("PAYMENT_INVALID_METHOD", "payment method is not supported by this provider")
+	ErrPaymentWebhookInvalid   = infraerrors.Unauthorized("PAYMENT_WEBHOOK_INVALID", "invalid payment webhook signature")
+	ErrPaymentOrderStatus      = infraerrors.Conflict("PAYMENT_ORDER_STATUS", "payment order status does not allow this operation")
+	ErrPaymentTxnConflict      = infraerrors.Conflict("PAYMENT_TXN_CONFLICT", "provider transaction id is already bound to another order")
+)
+
+// PaymentOrder 充值订单。金额以支付币种计，CreditAmount 为到账余额（美元）
+type PaymentOrder struct {
+	ID      int64
+	OrderNo string
+	UserID  int64
+	// Provider 支付渠道；Method 为渠道内的支付方式（如易支付的 alipay/wxpay）
+	Provider string
+	Method   string
+	Status   string
+	Amount   float64
+	Currency string
+	// RateMultiplier 下单时的充值倍率快照，CreditAmount = Amount * RateMultiplier
+	RateMultiplier float64
+	CreditAmount   float64
+	// ProviderSessionID 渠道侧的 checkout 会话 ID；ProviderTxnID 渠道侧的交易号，回调按它幂等
+	ProviderSessionID string
+	ProviderTxnID     string
+	CheckoutURL       string
+	FailureReason     string
+	Notes             string
+	PaidAt            *time.Time
+	RefundedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	// PlanID 非空表示订阅套餐订单：支付成功后开通/续期套餐，不入账余额（CreditAmount 为 0）
+	PlanID   *int64
+	PlanName string
+
+	User *User
+}
+
+// PaymentOrderFilter 充值订单查询条件
+type PaymentOrderFilter struct {
+	UserID   int64
+	Provider string
+	Status   string
+	// Search 匹配订单号、渠道交易号或用户邮箱
+	Search string
+}
+
+// PaymentOrderTransition 订单状态迁移时一并写入的字段；空值保持原值
+type PaymentOrderTransition struct {
+	Status        string
+	ProviderTxnID string
+	Amount        *float64
+	CreditAmount  *float64
+	FailureReason string
+	Notes         string
+}
+
+// PaymentOrderRepository 充值订单持久化
+type PaymentOrderRepository interface {
+	Create(ctx context.Context, order *PaymentOrder) error
+	GetByID(ctx context.Context, id int64) (*PaymentOrder, error)
+	GetByOrderNo(ctx context.Context, orderNo string) (*PaymentOrder, error)
+	GetByProviderTxnID(ctx context.Context, provider, txnID string) (*PaymentOrder, error)
+	SetCheckout(ctx context.Context, id int64, sessionID, checkoutURL string) error
+	// Transition 仅当订单当前状态属于 from 时迁移并返回更新后的订单；状态不符时返回 nil
+	Transition(ctx context.Context, id int64, from []string, t PaymentOrderTransition) (*PaymentOrder, error)
+	List(ctx context.Context, params pagination.PaginationParams, filter PaymentOrderFilter) ([]PaymentOrder, *pagination.PaginationResult, error)
+}
+
+// PaymentProviderInfo 渠道对外展示的配置
+type PaymentProviderInfo struct {
+	Name           string
+	Enabled        bool
+	Currency       string
+	RateMultiplier float64
+	// Methods 渠道内可选的支付方式，为空表示由渠道收银台选择
+	Methods []string
+}
+
+// PaymentCheckoutInput 创建 checkout 所需的上下文
+type PaymentCheckoutInput struct {
+	Email  string
+	Method string
+	// ReturnURL 支付完成后用户返回的页面；NotifyURL 渠道异步通知地址（需要在下单时提交的渠道使用）
+	ReturnURL string
+	CancelURL string
+	NotifyURL string
+}
+
+// PaymentCheckout 渠道返回的 checkout 会话
+type PaymentCheckout struct {
+	SessionID string
+	URL       string
+}
+
+// PaymentWebhookRequest 原始回调请求
+type PaymentWebhookRequest struct {
+	Body   []byte
+	Header http.Header
+	// Form 合并后的 query 与表单参数（易支付以参数形式回调）
+	Form url.Values
+}
+
+// PaymentWebhookEvent 渠道回调归一化后的事件；Status 为空表示无需处理
+type PaymentWebhookEvent struct {
+	Status        string
+	OrderNo       string
+	ProviderTxnID string
+	// Amount 实付金额（支付币种），0 表示回调未携带
+	Amount   float64
+	Currency string
+	Reason   string
+	// UserID/Email 仅用于升级前创建、没有订单号的 Creem checkout
+	UserID int64
+	Email  string
+}
+
+// PaymentProvider 支付渠道。新增渠道只需实现该接口并在 ProvidePaymentService 中注册
+type PaymentProvider interface {
+	Name() string
+	Info(ctx context.Context) PaymentProviderInfo
+	// CreateCheckout 为订单创建渠道 checkout 会话
+	CreateCheckout(ctx context.Context, order *PaymentOrder, in PaymentCheckoutInput) (*PaymentCheckout, error)
+	// VerifyWebhook 校验回调签名并解析为归一化事件；签名无效时返回 ErrPaymentWebhookInvalid
+	VerifyWebhook(ctx context.Context, req *PaymentWebhookRequest) (*PaymentWebhookEvent, error)
+	// CreditAmount 将支付金额换算为到账余额
+	CreditAmount(ctx context.Context, amount float64) float64
+}
+
+// PaymentWebhookAcker 需要特定回调应答的渠道（如易支付要求返回纯文本 success）
+type PaymentWebhookAcker interface {
+	WebhookAck() string
+}
@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// PaymentService 充值订单服务：统一各支付渠道的下单、回调入账与对账
+//
+// 回调处理以订单状态的条件迁移保证幂等：同一笔交易无论回调多少次，只有首次把订单从 pending/failed
+// 迁移为 paid 的那次会入账，入账与状态迁移在同一事务内完成。
+//
+// 订阅套餐订单（PlanID 非空）走同一套下单与回调流程，支付成功时改为开通/续期套餐订阅，不入账余额。
+type PaymentService struct {
+	orderRepo            PaymentOrderRepository
+	userRepo             UserRepository
+	planRepo             SubscriptionPlanRepository
+	subscriptionService  *SubscriptionService
+	billingCacheService  *BillingCacheService
+	entClient            *dbent.Client
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	providers            []PaymentProvider
+}
+
+// NewPaymentService 创建充值订单服务实例，providers 的顺序即前端展示顺序
+func NewPaymentService(
+	orderRepo PaymentOrderRepository,
+	userRepo UserRepository,
+	planRepo SubscriptionPlanRepository,
+	subscriptionService *SubscriptionService,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	providers []PaymentProvider,
+) *PaymentService {
+	return &PaymentService{
+		orderRepo:            orderRepo,
+		userRepo:             userRepo,
+		planRepo:             planRepo,
+		subscriptionService:  subscriptionService,
+		billingCacheService:  billingCacheService,
+		entClient:            entClient,
+		authCacheInvalidator: authCacheInvalidator,
+		providers:            providers,
+	}
+}
+
+// PaymentCheckoutRequest 用户发起充值
+type PaymentCheckoutRequest struct {
+	UserID   int64
+	Email    string
+	Provider string
+	Amount   int
+	Method   string
+	// BaseURL 站点对外地址，用于拼接返回页与异步通知地址
+	BaseURL string
+}
+
+// Provider 按名称获取支付渠道
+func (s *PaymentService) Provider(name string) (PaymentProvider, error) {
+	for _, p := range s.providers {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, ErrPaymentProviderNotFound
+}
+
+// EnabledProviders 返回已启用且配置完整的渠道
+func (s *PaymentService) EnabledProviders(ctx context.Context) []PaymentProviderInfo {
+	out := make([]PaymentProviderInfo, 0, len(s.providers))
+	for _, p := range s.providers {
+		if info := p.Info(ctx); info.Enabled {
+			out = append(out, info)
+		}
+	}
+	return out
+}
+
+// WebhookAck 渠道要求的回调应答内容；为空时使用默认 JSON 应答
+func (s *PaymentService) WebhookAck(name string) string {
+	p, err := s.Provider(name)
+	if err != nil {
+		return ""
+	}
+	if acker, ok := p.(PaymentWebhookAcker); ok {
+		return acker.WebhookAck()
+	}
+	return ""
+}
+
+// CreateCheckout 创建 pending 订单并向渠道申请 checkout 会话
+func (s *PaymentService) CreateCheckout(ctx context.Context, req PaymentCheckoutRequest) (*PaymentOrder, error) {
+	p, info, method, err := s.resolveCheckoutProvider(ctx, req.Provider, req.Method)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount < PaymentMinAmount || req.Amount > PaymentMaxAmount {
+		return nil, ErrPaymentInvalidAmount
+	}
+
+	amount := float64(req.Amount)
+	order := &PaymentOrder{
+		UserID:         req.UserID,
+		Provider:       p.Name(),
+		Method:         method,
+		Status:         PaymentStatusPending,
+		Amount:         amount,
+		Currency:       info.Currency,
+		RateMultiplier: info.RateMultiplier,
+		CreditAmount:   roundPaymentCredit(p.CreditAmount(ctx, amount)),
+	}
+	return s.startCheckout(ctx, p, order, req.Email, req.BaseURL, "/redeem?payment=cancelled")
+}
+
+// PaymentPlanCheckoutRequest 用户购买/续费订阅套餐
+type PaymentPlanCheckoutRequest struct {
+	UserID   int64
+	Email    string
+	PlanID   int64
+	Provider string
+	Method   string
+	BaseURL  string
+}
+
+// CreatePlanCheckout 为订阅套餐下单：套餐价格（美元）按渠道倍率换算为支付金额，向上取整到分
+func (s *PaymentService) CreatePlanCheckout(ctx context.Context, req PaymentPlanCheckoutRequest) (*PaymentOrder, error) {
+	plan, err := s.planRepo.GetByID(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.IsActive() {
+		return nil, ErrSubscriptionPlanUnavailable
+	}
+	p, info, method, err := s.resolveCheckoutProvider(ctx, req.Provider, req.Method)
+	if err != nil {
+		return nil, err
+	}
+	if info.RateMultiplier <= 0 {
+		return nil, ErrPaymentProviderDisabled
+	}
+	amount := math.Ceil(plan.Price/info.RateMultiplier*100-1e-6) / 100
+	if amount <= 0 || amount > PaymentMaxAmount {
+		return nil, ErrPaymentInvalidAmount
+	}
+
+	planID := plan.ID
+	order := &PaymentOrder{
+		UserID:         req.UserID,
+		Provider:       p.Name(),
+		Method:         method,
+		Status:         PaymentStatusPending,
+		Amount:         amount,
+		Currency:       info.Currency,
+		RateMultiplier: info.RateMultiplier,
+		PlanID:         &planID,
+		PlanName:       plan.Name,
+	}
+	return s.startCheckout(ctx, p, order, req.Email, req.BaseURL, "/subscriptions?payment=cancelled")
+}
+
+// resolveCheckoutProvider 校验渠道可用并确定支付方式（渠道未提供可选方式时为空）
+func (s *PaymentService) resolveCheckoutProvider(ctx context.Context, provider, method string) (PaymentProvider, PaymentProviderInfo, string, error) {
+	p, err := s.Provider(provider)
+	if err != nil {
+		return nil, PaymentProviderInfo{}, "", err
+	}
+	info := p.Info(ctx)
+	if !info.Enabled {
+		return nil, PaymentProviderInfo{}, "", ErrPaymentProviderDisabled
+	}
+	method = strings.TrimSpace(method)
+	if len(info.Methods) == 0 {
+		return p, info, "", nil
+	}
+	if method == "" {
+		method = info.Methods[0]
+	}
+	if !slices.Contains(info.Methods, method) {
+		return nil, PaymentProviderInfo{}, "", ErrPaymentInvalidMethod
+	}
+	return p, info, method, nil
+}
+
+// startCheckout 落单后创建渠道 checkout 会话；渠道下单失败时订单标记为 failed
+func (s *PaymentService) startCheckout(ctx context.Context, p PaymentProvider, order *PaymentOrder, email, baseURL, cancelPath string) (*PaymentOrder, error) {
+	orderNo, err := newPaymentOrderNo()
+	if err != nil {
+		return nil, fmt.Errorf("generate order no: %w", err)
+	}
+	order.OrderNo = orderNo
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("create payment order: %w", err)
+	}
+
+	baseURL = strings.TrimRight(baseURL, "/")
+	checkout, err := p.CreateCheckout(ctx, order, PaymentCheckoutInput{
+		Email:     email,
+		Method:    order.Method,
+		ReturnURL: baseURL + "/payment-orders?order_no=" + url.QueryEscape(orderNo),
+		CancelURL: baseURL + cancelPath,
+		NotifyURL: baseURL + "/api/v1/webhook/" + p.Name(),
+	})
+	if err != nil {
+		log.Printf("[Payment] Create checkout failed: provider=%s order_no=%s err=%v", p.Name(), orderNo, err)
+		if _, terr := s.orderRepo.Transition(ctx, order.ID, []string{PaymentStatusPending}, PaymentOrderTransition{
+			Status:        PaymentStatusFailed,
+			FailureReason: "create checkout failed",
+		}); terr != nil {
+			log.Printf("[Payment] Mark order failed error: order_no=%s err=%v", orderNo, terr)
+		}
+		return nil, err
+	}
+
+	if err := s.orderRepo.SetCheckout(ctx, order.ID, checkout.SessionID, checkout.URL); err != nil {
+		return nil, fmt.Errorf("save checkout session: %w", err)
+	}
+	order.ProviderSessionID = checkout.SessionID
+	order.CheckoutURL = checkout.URL
+	return order, nil
+}
+
+// HandleWebhook 校验并处理渠道回调。订单已处于目标状态时直接返回（重复回调）
+func (s *PaymentService) HandleWebhook(ctx context.Context, providerName string, req *PaymentWebhookRequest) error {
+	p, err := s.Provider(providerName)
+	if err != nil {
+		return err
+	}
+	event, err := p.VerifyWebhook(ctx, req)
+	if err != nil {
+		return err
+	}
+	if event == nil || event.Status == "" {
+		return nil
+	}
+
+	order, err := s.findWebhookOrder(ctx, p.Name(), event)
+	if err != nil {
+		return err
+	}
+	if order == nil && event.Status == PaymentStatusPaid && (event.UserID > 0 || event.Email != "") {
+		order, err = s.createWebhookOrder(ctx, p, event)
+		if err != nil {
+			return err
+		}
+	}
+	if order == nil {
+		// 可能是同一渠道账号下其他业务的交易，忽略以免渠道无限重试
+		log.Printf("[Payment] Webhook order not found: provider=%s order_no=%s txn=%s", p.Name(), event.OrderNo, event.ProviderTxnID)
+		return nil
+	}
+	if order.Status == event.Status {
+		log.Printf("[Payment] Duplicate webhook ignored: order_no=%s status=%s", order.OrderNo, order.Status)
+		return nil
+	}
+
+	switch event.Status {
+	case PaymentStatusPaid:
+		_, err = s.markPaid(ctx, order, event.ProviderTxnID, event.Amount, nil, "")
+	case PaymentStatusFailed:
+		_, err = s.orderRepo.Transition(ctx, order.ID, []string{PaymentStatusPending}, PaymentOrderTransition{
+			Status:        PaymentStatusFailed,
+			ProviderTxnID: event.ProviderTxnID,
+			FailureReason: event.Reason,
+		})
+	case PaymentStatusRefunded:
+		_, err = s.markRefunded(ctx, order, nil, event.Reason)
+	}
+	return err
+}
+
+// ListUserOrders 用户查看自己的充值记录
+func (s *PaymentService) ListUserOrders(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	return s.orderRepo.List(ctx, params, PaymentOrderFilter{UserID: userID, Status: status})
+}
+
+// GetUserOrder 用户按订单号查询（返回页轮询支付结果）
+func (s *PaymentService) GetUserOrder(ctx context.Context, userID int64, orderNo string) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, ErrPaymentOrderNotFound
+	}
+	return order, nil
+}
+
+// List 管理员分页查询订单
+func (s *PaymentService) List(ctx context.Context, params pagination.PaginationParams, filter PaymentOrderFilter) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	return s.orderRepo.List(ctx, params, filter)
+}
+
+// GetByID 管理员查看订单
+func (s *PaymentService) GetByID(ctx context.Context, id int64) (*PaymentOrder, error) {
+	return s.orderRepo.GetByID(ctx, id)
+}
+
+// MarkPaid 管理员对账：渠道已收款但回调丢失时手工入账
+func (s *PaymentService) MarkPaid(ctx context.Context, id int64, txnID, notes string, actorID int64) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != PaymentStatusPending && order.Status != PaymentStatusFailed {
+		return nil, ErrPaymentOrderStatus
+	}
+	updated, err := s.markPaid(ctx, order, strings.TrimSpace(txnID), 0, optionalActor(actorID), notes)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrPaymentOrderStatus
+	}
+	return updated, nil
+}
+
+// MarkRefunded 管理员登记退款（渠道侧退款需在渠道后台操作），扣回到账余额
+func (s *PaymentService) MarkRefunded(ctx context.Context, id int64, notes string, actorID int64) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != PaymentStatusPaid {
+		return nil, ErrPaymentOrderStatus
+	}
+	updated, err := s.markRefunded(ctx, order, optionalActor(actorID), notes)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrPaymentOrderStatus
+	}
+	return updated, nil
+}
+
+// findWebhookOrder 先按订单号，再按渠道交易号查找订单；找不到返回 nil
+func (s *PaymentService) findWebhookOrder(ctx context.Context, provider string, event *PaymentWebhookEvent) (*PaymentOrder, error) {
+	if event.OrderNo != "" {
+		order, err := s.orderRepo.GetByOrderNo(ctx, event.OrderNo)
+		if err == nil && order.Provider == provider {
+			return order, nil
+		}
+		if err != nil && !errors.Is(err, ErrPaymentOrderNotFound) {
+			return nil, fmt.Errorf("get payment order: %w", err)
+		}
+	}
+	if event.ProviderTxnID != "" {
+		order, err := s.orderRepo.GetByProviderTxnID(ctx, provider, event.ProviderTxnID)
+		if err == nil {
+			return order, nil
+		}
+		if !errors.Is(err, ErrPaymentOrderNotFound) {
+			return nil, fmt.Errorf("get payment order by txn: %w", err)
+		}
+	}
+	return nil, nil
+}
+
+// createWebhookOrder 为没有订单号的成功回调（升级前创建的 Creem checkout）补建订单，
+// 渠道交易号唯一，并发回调只会建出一笔
+func (s *PaymentService) createWebhookOrder(ctx context.Context, p PaymentProvider, event *PaymentWebhookEvent) (*PaymentOrder, error) {
+	userID := event.UserID
+	if userID == 0 {
+		user, err := s.userRepo.GetByEmail(ctx, event.Email)
+		if err != nil {
+			return nil, fmt.Errorf("find user by email %s: %w", event.Email, err)
+		}
+		userID = user.ID
+	}
+	orderNo, err := newPaymentOrderNo()
+	if err != nil {
+		return nil, fmt.Errorf("generate order no: %w", err)
+	}
+	info := p.Info(ctx)
+	order := &PaymentOrder{
+		OrderNo:        orderNo,
+		UserID:         userID,
+		Provider:       p.Name(),
+		Status:         PaymentStatusPending,
+		Amount:         event.Amount,
+		Currency:       event.Currency,
+		RateMultiplier: info.RateMultiplier,
+		CreditAmount:   roundPaymentCredit(p.CreditAmount(ctx, event.Amount)),
+		ProviderTxnID:  event.ProviderTxnID,
+		Notes:          "created from webhook without order number",
+	}
+	if order.Currency == "" {
+		order.Currency = info.Currency
+	}
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		if errors.Is(err, ErrPaymentTxnConflict) {
+			return s.orderRepo.GetByProviderTxnID(ctx, p.Name(), event.ProviderTxnID)
+		}
+		return nil, fmt.Errorf("create payment order: %w", err)
+	}
+	return order, nil
+}
+
+// markPaid 将订单迁移为 paid 并入账（套餐订单为开通/续期订阅）；订单已被其他回调处理时返回 nil
+func (s *PaymentService) markPaid(ctx context.Context, order *PaymentOrder, txnID string, paidAmount float64, actorID *int64, notes string) (*PaymentOrder, error) {
+	if order.PlanID != nil {
+		return s.markPlanPaid(ctx, order, txnID, paidAmount, notes)
+	}
+
+	t := PaymentOrderTransition{Status: PaymentStatusPaid, ProviderTxnID: txnID, Notes: notes}
+	credit := order.CreditAmount
+	// 实付金额与下单金额不一致（如 Creem 按产品定价收款）时，按实付金额和下单时的倍率入账
+	if paidAmount > 0 && math.Abs(paidAmount-order.Amount) >= 0.005 {
+		credit = roundPaymentCredit(paidAmount * order.RateMultiplier)
+		t.Amount = &paidAmount
+		t.CreditAmount = &credit
+	}
+
+	var updated *PaymentOrder
+	err := s.runInTx(ctx, func(txCtx context.Context) error {
+		var err error
+		updated, err = s.orderRepo.Transition(txCtx, order.ID, []string{PaymentStatusPending, PaymentStatusFailed}, t)
+		if err != nil || updated == nil {
+			return err
+		}
+		return s.userRepo.UpdateBalance(txCtx, order.UserID, credit, BalanceChangeSource{
+			Type:        BalanceSourcePaymentOrder,
+			ReferenceID: order.OrderNo,
+			ActorUserID: actorID,
+			Notes:       strings.TrimSpace(order.Provider + " " + txnID),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated != nil {
+		log.Printf("[Payment] Order paid: order_no=%s user_id=%d provider=%s credit=%.4f", order.OrderNo, order.UserID, order.Provider, credit)
+		s.invalidateBalanceCaches(ctx, order.UserID)
+	}
+	return updated, nil
+}
+
+// markPlanPaid 套餐订单支付成功：在同一事务内迁移订单状态并开通/续期套餐订阅。
+// 实付金额低于下单金额时不开通，订单仍记为 paid 并写明原因，由管理员核实后处理。
+func (s *PaymentService) markPlanPaid(ctx context.Context, order *PaymentOrder, txnID string, paidAmount float64, notes string) (*PaymentOrder, error) {
+	t := PaymentOrderTransition{Status: PaymentStatusPaid, ProviderTxnID: txnID, Notes: notes}
+	underpaid := paidAmount > 0 && order.Amount-paidAmount >= 0.005
+	if underpaid {
+		t.Amount = &paidAmount
+		t.Notes = strings.TrimSpace(notes + " underpaid: plan not applied")
+	}
+	plan, err := s.planRepo.GetByID(ctx, *order.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription plan %d: %w", *order.PlanID, err)
+	}
+
+	var updated *PaymentOrder
+	err = s.runInTx(ctx, func(txCtx context.Context) error {
+		var err error
+		updated, err = s.orderRepo.Transition(txCtx, order.ID, []string{PaymentStatusPending, PaymentStatusFailed}, t)
+		if err != nil || updated == nil || underpaid {
+			return err
+		}
+		_, err = s.subscriptionService.ApplyPlan(txCtx, order.UserID, plan, "payment order "+order.OrderNo)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated != nil {
+		if underpaid {
+			log.Printf("[Payment] Plan order underpaid: order_no=%s user_id=%d amount=%.2f paid=%.2f", order.OrderNo, order.UserID, order.Amount, paidAmount)
+		} else {
+			log.Printf("[Payment] Plan order paid: order_no=%s user_id=%d plan_id=%d group_id=%d", order.OrderNo, order.UserID, plan.ID, plan.GroupID)
+		}
+		s.subscriptionService.invalidateSubscriptionCache(order.UserID, plan.GroupID)
+	}
+	return updated, nil
+}
+
+// markRefunded 将 paid 订单迁移为 refunded 并扣回到账余额（余额允许为负）。
+// 套餐订单没有到账余额，退款只记录状态，已开通的订阅需管理员在订阅管理中调整。
+func (s *PaymentService) markRefunded(ctx context.Context, order *PaymentOrder, actorID *int64, notes string) (*PaymentOrder, error) {
+	var updated *PaymentOrder
+	err := s.runInTx(ctx, func(txCtx context.Context) error {
+		var err error
+		updated, err = s.orderRepo.Transition(txCtx, order.ID, []string{PaymentStatusPaid}, PaymentOrderTransition{
+			Status: PaymentStatusRefunded,
+			Notes:  notes,
+		})
+		if err != nil || updated == nil || updated.CreditAmount == 0 {
+			return err
+		}
+		return s.userRepo.UpdateBalance(txCtx, order.UserID, -updated.CreditAmount, BalanceChangeSource{
+			Type:        BalanceSourcePaymentRefund,
+			ReferenceID: order.OrderNo,
+			ActorUserID: actorID,
+			Notes:       notes,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated != nil {
+		log.Printf("[Payment] Order refunded: order_no=%s user_id=%d debit=%.4f", order.OrderNo, order.UserID, updated.CreditAmount)
+		s.invalidateBalanceCaches(ctx, order.UserID)
+	}
+	return updated, nil
+}
+
+// runInTx 在事务中执行 fn，使订单状态迁移与余额变动原子提交
+func (s *PaymentService) runInTx(ctx context.Context, fn func(txCtx context.Context) error) error {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(dbent.NewTxContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *PaymentService) invalidateBalanceCaches(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+	}()
+}
+
+// optionalActor 操作人为 0（如未绑定用户的管理员 API Key）时流水不记录操作人
+func optionalActor(actorID int64) *int64 {
+	if actorID <= 0 {
+		return nil
+	}
+	return &actorID
+}
+
+// newPaymentOrderNo 订单号：P + 时间 + 随机串，兼容易支付 out_trade_no 的长度与字符限制
+func newPaymentOrderNo() (string, error) {
+	suffix, err := randomHexString(4)
+	if err != nil {
+		return "", err
+	}
+	return "P" + time.Now().UTC().Format("20060102150405") + suffix, nil
+}
+
+// roundPaymentCredit 到账余额按余额列精度（8 位小数）取整
+func roundPaymentCredit(v float64) float64 {
+	return math.Round(v*1e8) / 1e8
+}
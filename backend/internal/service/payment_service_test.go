@@ -0,0 +1,420 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type paymentOrderRepoStub struct {
+	orders      map[int64]*PaymentOrder
+	nextID      int64
+	transitions []PaymentOrderTransition
+}
+
+func newPaymentOrderRepoStub(orders ...*PaymentOrder) *paymentOrderRepoStub {
+	s := &paymentOrderRepoStub{orders: map[int64]*PaymentOrder{}}
+	for _, o := range orders {
+		s.nextID++
+		o.ID = s.nextID
+		s.orders[o.ID] = o
+	}
+	return s
+}
+
+func (s *paymentOrderRepoStub) Create(ctx context.Context, order *PaymentOrder) error {
+	s.nextID++
+	order.ID = s.nextID
+	cp := *order
+	s.orders[order.ID] = &cp
+	return nil
+}
+
+func (s *paymentOrderRepoStub) GetByID(ctx context.Context, id int64) (*PaymentOrder, error) {
+	if o, ok := s.orders[id]; ok {
+		cp := *o
+		return &cp, nil
+	}
+	return nil, ErrPaymentOrderNotFound
+}
+
+func (s *paymentOrderRepoStub) GetByOrderNo(ctx context.Context, orderNo string) (*PaymentOrder, error) {
+	for _, o := range s.orders {
+		if o.OrderNo == orderNo {
+			cp := *o
+			return &cp, nil
+		}
+	}
+	return nil, ErrPaymentOrderNotFound
+}
+
+func (s *paymentOrderRepoStub) GetByProviderTxnID(ctx context.Context, provider, txnID string) (*PaymentOrder, error) {
+	for _, o := range s.orders {
+		if o.Provider == provider && o.ProviderTxnID == txnID {
+			cp := *o
+			return &cp, nil
+		}
+	}
+	return nil, ErrPaymentOrderNotFound
+}
+
+func (s *paymentOrderRepoStub) SetCheckout(ctx context.Context, id int64, sessionID, checkoutURL string) error {
+	o, ok := s.orders[id]
+	if !ok {
+		return ErrPaymentOrderNotFound
+	}
+	o.ProviderSessionID = sessionID
+	o.CheckoutURL = checkoutURL
+	return nil
+}
+
+func (s *paymentOrderRepoStub) Transition(ctx context.Context, id int64, from []string, t PaymentOrderTransition) (*PaymentOrder, error) {
+	s.transitions = append(s.transitions, t)
+	o, ok := s.orders[id]
+	if !ok {
+		return nil, nil
+	}
+	allowed := false
+	for _, st := range from {
+		if o.Status == st {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return nil, nil
+	}
+	o.Status = t.Status
+	if t.ProviderTxnID != "" {
+		o.ProviderTxnID = t.ProviderTxnID
+	}
+	if t.FailureReason != "" {
+		o.FailureReason = t.FailureReason
+	}
+	cp := *o
+	return &cp, nil
+}
+
+func (s *paymentOrderRepoStub) List(ctx context.Context, params pagination.PaginationParams, filter PaymentOrderFilter) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+type paymentProviderStub struct {
+	info        PaymentProviderInfo
+	event       *PaymentWebhookEvent
+	verifyErr   error
+	checkoutErr error
+	lastInput   PaymentCheckoutInput
+}
+
+func (p *paymentProviderStub) Name() string { return p.info.Name }
+
+func (p *paymentProviderStub) Info(ctx context.Context) PaymentProviderInfo { return p.info }
+
+func (p *paymentProviderStub) CreateCheckout(ctx context.Context, order *PaymentOrder, in PaymentCheckoutInput) (*PaymentCheckout, error) {
+	p.lastInput = in
+	if p.checkoutErr != nil {
+		return nil, p.checkoutErr
+	}
+	return &PaymentCheckout{SessionID: "cs_" + order.OrderNo, URL: "https://pay.example.com/" + order.OrderNo}, nil
+}
+
+func (p *paymentProviderStub) VerifyWebhook(ctx context.Context, req *PaymentWebhookRequest) (*PaymentWebhookEvent, error) {
+	return p.event, p.verifyErr
+}
+
+func (p *paymentProviderStub) CreditAmount(ctx context.Context, amount float64) float64 {
+	return amount * p.info.RateMultiplier
+}
+
+// paymentSettingRepoStub 在 settingRepoStub 基础上支持按 key 批量读取
+type paymentSettingRepoStub struct {
+	settingRepoStub
+}
+
+func (s *paymentSettingRepoStub) GetMultiple(ctx context.Context, keys []string) (map[string]string, error) {
+	out := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if v, ok := s.values[k]; ok {
+			out[k] = v
+		}
+	}
+	return out, nil
+}
+
+func newPaymentSettingService(values map[string]string) *SettingService {
+	return NewSettingService(&paymentSettingRepoStub{settingRepoStub{values: values}}, nil)
+}
+
+func newPaymentServiceForTest(repo *paymentOrderRepoStub, providers ...PaymentProvider) *PaymentService {
+	return NewPaymentService(repo, nil, nil, nil, nil, nil, nil, providers)
+}
+
+func TestPaymentService_CreateCheckout(t *testing.T) {
+	repo := newPaymentOrderRepoStub()
+	provider := &paymentProviderStub{info: PaymentProviderInfo{
+		Name: PaymentProviderEPay, Enabled: true, Currency: "CNY", RateMultiplier: 0.14, Methods: []string{"alipay", "wxpay"},
+	}}
+	svc := newPaymentServiceForTest(repo, provider)
+
+	order, err := svc.CreateCheckout(context.Background(), PaymentCheckoutRequest{
+		UserID: 7, Email: "u@example.com", Provider: PaymentProviderEPay, Amount: 50, BaseURL: "https://example.com/",
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentStatusPending, order.Status)
+	require.Equal(t, "alipay", order.Method)
+	require.Equal(t, "CNY", order.Currency)
+	require.InDelta(t, 7.0, order.CreditAmount, 1e-9)
+	require.Equal(t, "https://pay.example.com/"+order.OrderNo, order.CheckoutURL)
+	require.Equal(t, "https://example.com/payment-orders?order_no="+order.OrderNo, provider.lastInput.ReturnURL)
+	require.Equal(t, "https://example.com/api/v1/webhook/epay", provider.lastInput.NotifyURL)
+
+	stored, err := repo.GetByOrderNo(context.Background(), order.OrderNo)
+	require.NoError(t, err)
+	require.Equal(t, "cs_"+order.OrderNo, stored.ProviderSessionID)
+}
+
+func TestPaymentService_CreateCheckoutValidation(t *testing.T) {
+	provider := &paymentProviderStub{info: PaymentProviderInfo{Name: PaymentProviderEPay, Enabled: true, Methods: []string{"alipay"}}}
+	disabled := &paymentProviderStub{info: PaymentProviderInfo{Name: PaymentProviderStripe}}
+	svc := newPaymentServiceForTest(newPaymentOrderRepoStub(), provider, disabled)
+	ctx := context.Background()
+
+	_, err := svc.CreateCheckout(ctx, PaymentCheckoutRequest{UserID: 1, Provider: "paypal", Amount: 10})
+	require.ErrorIs(t, err, ErrPaymentProviderNotFound)
+
+	_, err = svc.CreateCheckout(ctx, PaymentCheckoutRequest{UserID: 1, Provider: PaymentProviderStripe, Amount: 10})
+	require.ErrorIs(t, err, ErrPaymentProviderDisabled)
+
+	_, err = svc.CreateCheckout(ctx, PaymentCheckoutRequest{UserID: 1, Provider: PaymentProviderEPay, Amount: PaymentMaxAmount + 1})
+	require.ErrorIs(t, err, ErrPaymentInvalidAmount)
+
+	_, err = svc.CreateCheckout(ctx, PaymentCheckoutRequest{UserID: 1, Provider: PaymentProviderEPay, Amount: 10, Method: "wxpay"})
+	require.ErrorIs(t, err, ErrPaymentInvalidMethod)
+}
+
+func TestPaymentService_CreateCheckoutProviderErrorMarksFailed(t *testing.T) {
+	repo := newPaymentOrderRepoStub()
+	provider := &paymentProviderStub{
+		info:        PaymentProviderInfo{Name: PaymentProviderStripe, Enabled: true, RateMultiplier: 1},
+		checkoutErr: errors.New("stripe down"),
+	}
+	svc := newPaymentServiceForTest(repo, provider)
+
+	_, err := svc.CreateCheckout(context.Background(), PaymentCheckoutRequest{UserID: 1, Provider: PaymentProviderStripe, Amount: 5})
+	require.Error(t, err)
+	require.Len(t, repo.orders, 1)
+	require.Equal(t, PaymentStatusFailed, repo.orders[1].Status)
+}
+
+func TestPaymentService_CreatePlanCheckout(t *testing.T) {
+	repo := newPaymentOrderRepoStub()
+	provider := &paymentProviderStub{info: PaymentProviderInfo{
+		Name: PaymentProviderEPay, Enabled: true, Currency: "CNY", RateMultiplier: 0.14, Methods: []string{"alipay"},
+	}}
+	svc := newPaymentServiceForTest(repo, provider)
+	svc.planRepo = newSubscriptionPlanRepoStub(
+		&SubscriptionPlan{ID: 5, Name: "Pro Monthly", GroupID: 3, ValidityDays: 30, Price: 10, Status: SubscriptionPlanStatusActive},
+		&SubscriptionPlan{ID: 6, Name: "Legacy", GroupID: 3, ValidityDays: 30, Price: 10, Status: SubscriptionPlanStatusDisabled},
+	)
+	ctx := context.Background()
+
+	order, err := svc.CreatePlanCheckout(ctx, PaymentPlanCheckoutRequest{
+		UserID: 7, Email: "u@example.com", PlanID: 5, Provider: PaymentProviderEPay, BaseURL: "https://example.com",
+	})
+	require.NoError(t, err)
+	require.InDelta(t, 71.43, order.Amount, 1e-9) // 10 / 0.14 向上取整到分
+	require.Zero(t, order.CreditAmount)
+	require.NotNil(t, order.PlanID)
+	require.Equal(t, int64(5), *order.PlanID)
+	require.Equal(t, "https://example.com/subscriptions?payment=cancelled", provider.lastInput.CancelURL)
+
+	_, err = svc.CreatePlanCheckout(ctx, PaymentPlanCheckoutRequest{UserID: 7, PlanID: 6, Provider: PaymentProviderEPay})
+	require.ErrorIs(t, err, ErrSubscriptionPlanUnavailable)
+
+	_, err = svc.CreatePlanCheckout(ctx, PaymentPlanCheckoutRequest{UserID: 7, PlanID: 99, Provider: PaymentProviderEPay})
+	require.ErrorIs(t, err, ErrSubscriptionPlanNotFound)
+}
+
+func TestPaymentService_HandleWebhookDuplicateIsNoop(t *testing.T) {
+	repo := newPaymentOrderRepoStub(&PaymentOrder{
+		OrderNo: "P1", UserID: 3, Provider: PaymentProviderStripe, Status: PaymentStatusPaid, ProviderTxnID: "pi_1",
+	})
+	provider := &paymentProviderStub{
+		info:  PaymentProviderInfo{Name: PaymentProviderStripe, Enabled: true},
+		event: &PaymentWebhookEvent{Status: PaymentStatusPaid, ProviderTxnID: "pi_1", Amount: 10},
+	}
+	// userRepo / entClient 为 nil：重复回调若尝试入账会直接 panic
+	svc := newPaymentServiceForTest(repo, provider)
+
+	require.NoError(t, svc.HandleWebhook(context.Background(), PaymentProviderStripe, &PaymentWebhookRequest{}))
+	require.Empty(t, repo.transitions)
+}
+
+func TestPaymentService_HandleWebhookFailedAndUnknown(t *testing.T) {
+	repo := newPaymentOrderRepoStub(&PaymentOrder{OrderNo: "P2", UserID: 3, Provider: PaymentProviderStripe, Status: PaymentStatusPending})
+	provider := &paymentProviderStub{info: PaymentProviderInfo{Name: PaymentProviderStripe, Enabled: true}}
+	svc := newPaymentServiceForTest(repo, provider)
+	ctx := context.Background()
+
+	// 订单号属于其他渠道或不存在：忽略
+	provider.event = &PaymentWebhookEvent{Status: PaymentStatusFailed, OrderNo: "P404"}
+	require.NoError(t, svc.HandleWebhook(ctx, PaymentProviderStripe, &PaymentWebhookRequest{}))
+	require.Equal(t, PaymentStatusPending, repo.orders[1].Status)
+
+	provider.event = &PaymentWebhookEvent{Status: PaymentStatusFailed, OrderNo: "P2", Reason: "checkout session expired"}
+	require.NoError(t, svc.HandleWebhook(ctx, PaymentProviderStripe, &PaymentWebhookRequest{}))
+	require.Equal(t, PaymentStatusFailed, repo.orders[1].Status)
+	require.Equal(t, "checkout session expired", repo.orders[1].FailureReason)
+
+	provider.verifyErr = ErrPaymentWebhookInvalid
+	require.ErrorIs(t, svc.HandleWebhook(ctx, PaymentProviderStripe, &PaymentWebhookRequest{}), ErrPaymentWebhookInvalid)
+	require.ErrorIs(t, svc.HandleWebhook(ctx, "paypal", &PaymentWebhookRequest{}), ErrPaymentProviderNotFound)
+}
+
+func TestPaymentService_GetUserOrderChecksOwner(t *testing.T) {
+	repo := newPaymentOrderRepoStub(&PaymentOrder{OrderNo: "P3", UserID: 3, Provider: PaymentProviderEPay})
+	svc := newPaymentServiceForTest(repo)
+
+	order, err := svc.GetUserOrder(context.Background(), 3, "P3")
+	require.NoError(t, err)
+	require.Equal(t, "P3", order.OrderNo)
+
+	_, err = svc.GetUserOrder(context.Background(), 4, "P3")
+	require.ErrorIs(t, err, ErrPaymentOrderNotFound)
+}
+
+func TestPaymentService_MarkRefundedRequiresPaid(t *testing.T) {
+	repo := newPaymentOrderRepoStub(&PaymentOrder{OrderNo: "P4", UserID: 3, Provider: PaymentProviderEPay, Status: PaymentStatusPending})
+	svc := newPaymentServiceForTest(repo)
+
+	_, err := svc.MarkRefunded(context.Background(), 1, "", 1)
+	require.ErrorIs(t, err, ErrPaymentOrderStatus)
+}
+
+func signStripePayload(secret string, ts int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10) + "."))
+	mac.Write(payload)
+	return "t=" + strconv.FormatInt(ts, 10) + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyStripeSignature(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	payload := []byte(`{"id":"evt_1"}`)
+	header := signStripePayload("whsec_test", now.Unix(), payload)
+
+	require.NoError(t, verifyStripeSignature("whsec_test", payload, header, now))
+	require.Error(t, verifyStripeSignature("whsec_other", payload, header, now))
+	require.Error(t, verifyStripeSignature("whsec_test", []byte(`{"id":"evt_2"}`), header, now))
+	require.Error(t, verifyStripeSignature("whsec_test", payload, header, now.Add(10*time.Minute)))
+	require.Error(t, verifyStripeSignature("whsec_test", payload, "v1=abc", now))
+	require.Error(t, verifyStripeSignature("", payload, header, now))
+}
+
+func TestStripeService_VerifyWebhookMapsEvents(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	svc := NewStripeService(newPaymentSettingService(map[string]string{
+		SettingKeyStripeEnabled:       "true",
+		SettingKeyStripeSecretKey:     "sk_test_1",
+		SettingKeyStripeWebhookSecret: "whsec_test",
+	}))
+	svc.now = func() time.Time { return now }
+
+	verify := func(payload string) *PaymentWebhookEvent {
+		header := http.Header{}
+		header.Set("Stripe-Signature", signStripePayload("whsec_test", now.Unix(), []byte(payload)))
+		event, err := svc.VerifyWebhook(context.Background(), &PaymentWebhookRequest{Body: []byte(payload), Header: header})
+		require.NoError(t, err)
+		return event
+	}
+
+	event := verify(`{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"id":"cs_1","payment_status":"paid","payment_intent":"pi_1","amount_total":1250,"currency":"usd","client_reference_id":"P1"}}}`)
+	require.Equal(t, PaymentStatusPaid, event.Status)
+	require.Equal(t, "P1", event.OrderNo)
+	require.Equal(t, "pi_1", event.ProviderTxnID)
+	require.InDelta(t, 12.5, event.Amount, 1e-9)
+
+	event = verify(`{"id":"evt_2","type":"checkout.session.completed","data":{"object":{"id":"cs_2","payment_status":"unpaid","client_reference_id":"P2"}}}`)
+	require.Empty(t, event.Status)
+
+	event = verify(`{"id":"evt_3","type":"checkout.session.expired","data":{"object":{"id":"cs_3","client_reference_id":"P3"}}}`)
+	require.Equal(t, PaymentStatusFailed, event.Status)
+
+	event = verify(`{"id":"evt_4","type":"charge.refunded","data":{"object":{"id":"ch_1","payment_intent":"pi_1","refunded":true,"amount_refunded":1250,"currency":"usd"}}}`)
+	require.Equal(t, PaymentStatusRefunded, event.Status)
+	require.Equal(t, "pi_1", event.ProviderTxnID)
+
+	event = verify(`{"id":"evt_5","type":"charge.refunded","data":{"object":{"id":"ch_1","payment_intent":"pi_1","refunded":false,"amount_refunded":100,"currency":"usd"}}}`)
+	require.Empty(t, event.Status)
+
+	_, err := svc.VerifyWebhook(context.Background(), &PaymentWebhookRequest{Body: []byte(`{}`), Header: http.Header{}})
+	require.ErrorIs(t, err, ErrPaymentWebhookInvalid)
+}
+
+func TestEPayService_CheckoutAndNotify(t *testing.T) {
+	svc := NewEPayService(newPaymentSettingService(map[string]string{
+		SettingKeyEPayEnabled:     "true",
+		SettingKeyEPayGatewayURL:  "https://pay.example.com/",
+		SettingKeyEPayMerchantID:  "1001",
+		SettingKeyEPayMerchantKey: "secret",
+		SettingKeyEPayPayTypes:    "alipay, wxpay",
+	}))
+	ctx := context.Background()
+
+	info := svc.Info(ctx)
+	require.True(t, info.Enabled)
+	require.Equal(t, []string{"alipay", "wxpay"}, info.Methods)
+	require.Equal(t, "CNY", info.Currency)
+
+	checkout, err := svc.CreateCheckout(ctx, &PaymentOrder{OrderNo: "P5", Amount: 30}, PaymentCheckoutInput{
+		Method: "wxpay", NotifyURL: "https://example.com/api/v1/webhook/epay", ReturnURL: "https://example.com/payment-orders?order_no=P5",
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(checkout.URL, "https://pay.example.com/submit.php?"))
+	parsed, err := url.Parse(checkout.URL)
+	require.NoError(t, err)
+	params := parsed.Query()
+	require.Equal(t, "30.00", params.Get("money"))
+	require.Equal(t, "wxpay", params.Get("type"))
+	require.Equal(t, epaySign(params, "secret"), params.Get("sign"))
+
+	_, err = svc.CreateCheckout(ctx, &PaymentOrder{OrderNo: "P6", Amount: 30}, PaymentCheckoutInput{Method: "qqpay"})
+	require.ErrorIs(t, err, ErrPaymentInvalidMethod)
+
+	notify := url.Values{
+		"pid": {"1001"}, "trade_no": {"T100"}, "out_trade_no": {"P5"}, "type": {"wxpay"},
+		"name": {"Balance top-up P5"}, "money": {"30.00"}, "trade_status": {"TRADE_SUCCESS"}, "sign_type": {"MD5"},
+	}
+	notify.Set("sign", epaySign(notify, "secret"))
+	event, err := svc.VerifyWebhook(ctx, &PaymentWebhookRequest{Form: notify})
+	require.NoError(t, err)
+	require.Equal(t, PaymentStatusPaid, event.Status)
+	require.Equal(t, "P5", event.OrderNo)
+	require.Equal(t, "T100", event.ProviderTxnID)
+	require.InDelta(t, 30.0, event.Amount, 1e-9)
+	require.Equal(t, "success", svc.WebhookAck())
+
+	notify.Set("money", "3000.00")
+	_, err = svc.VerifyWebhook(ctx, &PaymentWebhookRequest{Form: notify})
+	require.ErrorIs(t, err, ErrPaymentWebhookInvalid)
+}
+
+func TestEPaySign(t *testing.T) {
+	params := url.Values{"b": {"2"}, "a": {"1"}, "empty": {""}, "sign": {"x"}, "sign_type": {"MD5"}}
+	sum := md5.Sum([]byte("a=1&b=2key"))
+	require.Equal(t, hex.EncodeToString(sum[:]), epaySign(params, "key"))
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagestats"
+)
+
+// PromptCacheStats 提示缓存命中与节省统计
+type PromptCacheStats struct {
+	Accounts []usagestats.PromptCacheStat  `json:"accounts"`
+	Groups   []usagestats.PromptCacheStat  `json:"groups"`
+	Users    []usagestats.UserCacheSavings `json:"users"`
+}
+
+// PromptCacheStatsService 基于 usage_logs 的 cache_read_tokens / cache_creation_tokens 计算缓存命中率，
+// 并按计费规则估算缓存读取相对普通输入节省的费用
+type PromptCacheStatsService struct {
+	usageRepo      UsageLogRepository
+	billingService *BillingService
+}
+
+// NewPromptCacheStatsService 创建提示缓存统计服务
+func NewPromptCacheStatsService(usageRepo UsageLogRepository, billingService *BillingService) *PromptCacheStatsService {
+	return &PromptCacheStatsService{
+		usageRepo:      usageRepo,
+		billingService: billingService,
+	}
+}
+
+// GetStats 返回时间范围内按账号、分组统计的缓存命中情况，以及节省最多的前 userLimit 个用户
+func (s *PromptCacheStatsService) GetStats(ctx context.Context, startTime, endTime time.Time, groupID int64, userLimit int) (*PromptCacheStats, error) {
+	accounts, err := s.usageRepo.GetPromptCacheStatsByAccount(ctx, startTime, endTime, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("get account cache stats: %w", err)
+	}
+	groups, err := s.usageRepo.GetPromptCacheStatsByGroup(ctx, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("get group cache stats: %w", err)
+	}
+	reads, err := s.usageRepo.GetUserModelCacheReads(ctx, startTime, endTime, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get user cache reads: %w", err)
+	}
+
+	users := s.calculateSavings(reads)
+	if userLimit > 0 && len(users) > userLimit {
+		users = users[:userLimit]
+	}
+	return &PromptCacheStats{Accounts: accounts, Groups: groups, Users: users}, nil
+}
+
+// GetUserSavings 返回单个用户在时间范围内的缓存节省
+func (s *PromptCacheStatsService) GetUserSavings(ctx context.Context, userID int64, startTime, endTime time.Time) (*usagestats.UserCacheSavings, error) {
+	reads, err := s.usageRepo.GetUserModelCacheReads(ctx, startTime, endTime, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user cache reads: %w", err)
+	}
+	for _, savings := range s.calculateSavings(reads) {
+		if savings.UserID == userID {
+			return &savings, nil
+		}
+	}
+	return &usagestats.UserCacheSavings{UserID: userID}, nil
+}
+
+// calculateSavings 节省 = 将缓存读取 token 按普通输入计价的费用 - 实际的缓存读取费用。
+// 按 (用户, 模型, 倍率) 分组计价，保证与原请求的计费口径一致；按节省金额降序返回。
+func (s *PromptCacheStatsService) calculateSavings(reads []usagestats.UserModelCacheRead) []usagestats.UserCacheSavings {
+	byUser := make(map[int64]*usagestats.UserCacheSavings)
+	for _, r := range reads {
+		savings, ok := byUser[r.UserID]
+		if !ok {
+			savings = &usagestats.UserCacheSavings{UserID: r.UserID, Email: r.Email}
+			byUser[r.UserID] = savings
+		}
+		savings.CacheReadTokens += r.CacheReadTokens
+		savings.CacheHitRequests += r.Requests
+
+		if s.billingService == nil || r.CacheReadTokens <= 0 {
+			continue
+		}
+		uncached, err := s.billingService.CalculateCost(r.Model, UsageTokens{InputTokens: int(r.CacheReadTokens)}, r.RateMultiplier)
+		if err != nil {
+			continue
+		}
+		cached, err := s.billingService.CalculateCost(r.Model, UsageTokens{CacheReadTokens: int(r.CacheReadTokens)}, r.RateMultiplier)
+		if err != nil {
+			continue
+		}
+		savings.Savings += uncached.TotalCost - cached.TotalCost
+		savings.ActualSavings += uncached.ActualCost - cached.ActualCost
+	}
+
+	out := make([]usagestats.UserCacheSavings, 0, len(byUser))
+	for _, savings := range byUser {
+		out = append(out, *savings)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Savings != out[j].Savings {
+			return out[i].Savings > out[j].Savings
+		}
+		return out[i].UserID < out[j].UserID
+	})
+	return out
+}
@@ -0,0 +1,45 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagestats"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptCacheStatsService_CalculateSavings(t *testing.T) {
+	svc := NewPromptCacheStatsService(nil, NewBillingService(&config.Config{}, nil, nil))
+
+	reads := []usagestats.UserModelCacheRead{
+		{UserID: 1, Email: "a@example.com", Model: "claude-sonnet-4", RateMultiplier: 1, Requests: 2, CacheReadTokens: 1_000_000},
+		{UserID: 1, Email: "a@example.com", Model: "claude-sonnet-4", RateMultiplier: 2, Requests: 1, CacheReadTokens: 1_000_000},
+		{UserID: 2, Email: "b@example.com", Model: "claude-sonnet-4", RateMultiplier: 1, Requests: 1, CacheReadTokens: 100_000},
+	}
+
+	out := svc.calculateSavings(reads)
+	require.Len(t, out, 2)
+
+	// claude-sonnet-4: input $3/MTok, cache read $0.30/MTok -> $2.70 saved per MTok
+	require.Equal(t, int64(1), out[0].UserID)
+	require.Equal(t, int64(2_000_000), out[0].CacheReadTokens)
+	require.Equal(t, int64(3), out[0].CacheHitRequests)
+	require.InDelta(t, 5.4, out[0].Savings, 1e-9)
+	require.InDelta(t, 2.7+5.4, out[0].ActualSavings, 1e-9)
+
+	require.Equal(t, int64(2), out[1].UserID)
+	require.InDelta(t, 0.27, out[1].Savings, 1e-9)
+}
+
+func TestPromptCacheStatsService_CalculateSavingsWithoutBilling(t *testing.T) {
+	svc := NewPromptCacheStatsService(nil, nil)
+
+	out := svc.calculateSavings([]usagestats.UserModelCacheRead{
+		{UserID: 1, Model: "claude-sonnet-4", RateMultiplier: 1, Requests: 1, CacheReadTokens: 10},
+	})
+	require.Len(t, out, 1)
+	require.Equal(t, int64(10), out[0].CacheReadTokens)
+	require.Zero(t, out[0].Savings)
+}
@@ -14,8 +14,12 @@ type Proxy struct {
 	Username  string
 	Password  string
 	Status    string
+	PoolID    *int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// SubscriptionID 导入来源的代理订阅（手动创建的代理为 nil）
+	SubscriptionID *int64
 }
 
 func (p *Proxy) IsActive() bool {
@@ -40,6 +44,14 @@ type ProxyWithAccountCount struct {
 	CountryCode    string
 	Region         string
 	City           string
+
+	// 健康检查结果（来自代理池健康检查与转发时的代理连接错误）
+	HealthStatus        string
+	HealthMessage       string
+	ErrorRate           *float64
+	ConsecutiveFailures int
+	ForwardErrors       int
+	HealthCheckedAt     *time.Time
 }
 
 type ProxyAccountSummary struct {
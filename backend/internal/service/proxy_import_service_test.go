@@ -0,0 +1,182 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+// memProxyRepo is an in-memory ProxyRepository covering the calls made by the importer.
+type memProxyRepo struct {
+	ProxyRepository
+	nextID  int64
+	proxies map[int64]*Proxy
+}
+
+func newMemProxyRepo() *memProxyRepo {
+	return &memProxyRepo{proxies: map[int64]*Proxy{}}
+}
+
+func (r *memProxyRepo) Create(ctx context.Context, proxy *Proxy) error {
+	r.nextID++
+	proxy.ID = r.nextID
+	cp := *proxy
+	r.proxies[proxy.ID] = &cp
+	return nil
+}
+
+func (r *memProxyRepo) Update(ctx context.Context, proxy *Proxy) error {
+	cp := *proxy
+	r.proxies[proxy.ID] = &cp
+	return nil
+}
+
+func (r *memProxyRepo) ExistsByHostPortAuth(ctx context.Context, host string, port int, username, password string) (bool, error) {
+	for _, p := range r.proxies {
+		if p.Host == host && p.Port == port && p.Username == username && p.Password == password {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memProxySubscriptionRepo struct {
+	ProxySubscriptionRepository
+	proxies *memProxyRepo
+	sub     *ProxySubscription
+}
+
+func (r *memProxySubscriptionRepo) GetByID(ctx context.Context, id int64) (*ProxySubscription, error) {
+	if r.sub == nil || r.sub.ID != id {
+		return nil, ErrProxySubscriptionNotFound
+	}
+	cp := *r.sub
+	return &cp, nil
+}
+
+func (r *memProxySubscriptionRepo) UpdateSyncResult(ctx context.Context, id int64, syncedAt time.Time, result *ProxyImportResult, syncErr string) error {
+	r.sub.LastSyncedAt = &syncedAt
+	r.sub.LastError = syncErr
+	if result != nil {
+		r.sub.LastTotal = result.Total
+		r.sub.LastCreated = result.Created
+		r.sub.LastDisabled = result.Disabled
+	}
+	return nil
+}
+
+func (r *memProxySubscriptionRepo) ListProxies(ctx context.Context, id int64) ([]Proxy, error) {
+	var out []Proxy
+	for i := int64(1); i <= r.proxies.nextID; i++ {
+		if p, ok := r.proxies.proxies[i]; ok && p.SubscriptionID != nil && *p.SubscriptionID == id {
+			out = append(out, *p)
+		}
+	}
+	return out, nil
+}
+
+func proxyImportTestConfig() *config.Config {
+	cfg := &config.Config{ProxyImport: config.ProxyImportConfig{FetchTimeoutSeconds: 5, MaxSubscriptionBytes: 1 << 20, MaxProxies: 100}}
+	cfg.Security.URLAllowlist.AllowInsecureHTTP = true
+	return cfg
+}
+
+func TestProxyImportService_ImportDedupesExisting(t *testing.T) {
+	proxyRepo := newMemProxyRepo()
+	require.NoError(t, proxyRepo.Create(context.Background(), &Proxy{Name: "old", Protocol: "http", Host: "10.0.0.1", Port: 8080, Status: StatusActive}))
+
+	svc := NewProxyImportService(proxyRepo, nil, nil, proxyImportTestConfig())
+	result, err := svc.Import(context.Background(), ProxyImportInput{
+		Content:    "10.0.0.1:8080\nsocks5://u:p@10.0.0.2:1080#jp\nvmess://abc",
+		NamePrefix: "vendor",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Total)
+	require.Equal(t, 1, result.Created)
+	require.Equal(t, 1, result.Duplicated)
+	require.Len(t, result.Skipped, 1)
+	require.Len(t, result.ProxyIDs, 1)
+
+	created := proxyRepo.proxies[result.ProxyIDs[0]]
+	require.Equal(t, "vendor-jp", created.Name)
+	require.Equal(t, "socks5", created.Protocol)
+	require.Nil(t, created.SubscriptionID)
+
+	_, err = svc.Import(context.Background(), ProxyImportInput{Content: "vmess://abc"})
+	require.Error(t, err)
+}
+
+func TestProxyImportService_SyncSubscriptionReconciles(t *testing.T) {
+	var body atomic.Value
+	body.Store("http://10.0.0.1:8080\nhttp://10.0.0.2:8080")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body.Load().(string)))
+	}))
+	defer srv.Close()
+
+	proxyRepo := newMemProxyRepo()
+	// 手动创建的同地址代理不属于该订阅，应记为重复
+	require.NoError(t, proxyRepo.Create(context.Background(), &Proxy{Name: "manual", Protocol: "http", Host: "10.0.0.3", Port: 8080, Status: StatusActive}))
+
+	subRepo := &memProxySubscriptionRepo{
+		proxies: proxyRepo,
+		sub:     &ProxySubscription{ID: 5, Name: "airport", URL: srv.URL, Status: StatusActive},
+	}
+	svc := NewProxyImportService(proxyRepo, subRepo, nil, proxyImportTestConfig())
+	ctx := context.Background()
+
+	result, err := svc.SyncSubscription(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Created)
+	require.Equal(t, 2, subRepo.sub.LastCreated)
+	first, second := proxyRepo.proxies[result.ProxyIDs[0]], proxyRepo.proxies[result.ProxyIDs[1]]
+	require.Equal(t, "airport-10.0.0.1:8080", first.Name)
+	require.Equal(t, int64(5), *first.SubscriptionID)
+
+	// 10.0.0.1 从订阅中移除 -> 停用；10.0.0.3 已手动存在 -> 重复
+	body.Store("http://10.0.0.2:8080\nhttp://10.0.0.3:8080")
+	result, err = svc.SyncSubscription(ctx, 5)
+	require.NoError(t, err)
+	require.Zero(t, result.Created)
+	require.Equal(t, 1, result.Duplicated)
+	require.Equal(t, 1, result.Disabled)
+	require.Equal(t, proxyStatusInactive, proxyRepo.proxies[first.ID].Status)
+	require.Equal(t, StatusActive, proxyRepo.proxies[second.ID].Status)
+
+	// 重新出现 -> 恢复启用，不重复创建
+	body.Store("http://10.0.0.1:8080\nhttp://10.0.0.2:8080")
+	result, err = svc.SyncSubscription(ctx, 5)
+	require.NoError(t, err)
+	require.Zero(t, result.Created)
+	require.Equal(t, 1, result.Reactivated)
+	require.Equal(t, StatusActive, proxyRepo.proxies[first.ID].Status)
+	require.Len(t, proxyRepo.proxies, 3)
+
+	// 拉取失败时记录错误
+	body.Store("")
+	_, err = svc.SyncSubscription(ctx, 5)
+	require.Error(t, err)
+	require.NotEmpty(t, subRepo.sub.LastError)
+}
+
+func TestProxySubscription_IsDue(t *testing.T) {
+	now := time.Now()
+	sub := &ProxySubscription{Status: StatusActive, RefreshIntervalMinutes: 30}
+	require.True(t, sub.IsDue(now))
+
+	last := now.Add(-10 * time.Minute)
+	sub.LastSyncedAt = &last
+	require.False(t, sub.IsDue(now))
+	require.True(t, sub.IsDue(now.Add(20*time.Minute)))
+
+	sub.RefreshIntervalMinutes = 0
+	require.False(t, sub.IsDue(now.Add(time.Hour)))
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrProxyPoolNotFound  = infraerrors.NotFound("PROXY_POOL_NOT_FOUND", "proxy pool not found")
+	ErrProxyPoolInUse     = infraerrors.Conflict("PROXY_POOL_IN_USE", "proxy pool is in use by accounts")
+	ErrProxyPoolEmpty     = infraerrors.BadRequest("PROXY_POOL_EMPTY", "proxy pool has no active proxies")
+	ErrProxyPoolInactive  = infraerrors.BadRequest("PROXY_POOL_INACTIVE", "proxy pool is inactive")
+	ErrProxyPoolBadPolicy = infraerrors.BadRequest("PROXY_POOL_INVALID_POLICY", "invalid proxy pool selection policy")
+)
+
+// ProxyPool 代理池
+type ProxyPool struct {
+	ID          int64
+	Name        string
+	Description string
+	// Policy 选择策略，决定账号分配 / 切换到哪个池内代理
+	Policy    string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// 以下为列表展示用的统计信息
+	ProxyIDs     []int64
+	AccountCount int64
+	HealthyCount int
+}
+
+func (p *ProxyPool) IsActive() bool {
+	return p.Status == StatusActive
+}
+
+// IsValidProxyPoolPolicy 校验代理池选择策略
+func IsValidProxyPoolPolicy(policy string) bool {
+	switch policy {
+	case ProxyPoolPolicySticky, ProxyPoolPolicyRoundRobin, ProxyPoolPolicyLeastLatency, ProxyPoolPolicySameCountry:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProxyPoolAssignment 引用代理池的账号及其当前分配到的代理
+type ProxyPoolAssignment struct {
+	AccountID int64
+	ProxyID   *int64
+}
+
+type ProxyPoolRepository interface {
+	Create(ctx context.Context, pool *ProxyPool) error
+	GetByID(ctx context.Context, id int64) (*ProxyPool, error)
+	Update(ctx context.Context, pool *ProxyPool) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, params pagination.PaginationParams, status, search string) ([]ProxyPool, *pagination.PaginationResult, error)
+	ListActive(ctx context.Context) ([]ProxyPool, error)
+
+	// SetMembers 将代理池成员设置为 proxyIDs，原成员中未包含的代理移出代理池
+	SetMembers(ctx context.Context, poolID int64, proxyIDs []int64) error
+	// ListMembers 返回代理池内启用状态的代理
+	ListMembers(ctx context.Context, poolID int64) ([]Proxy, error)
+	CountAccounts(ctx context.Context, poolID int64) (int64, error)
+	// ListAssignments 返回引用该代理池的账号及其当前代理
+	ListAssignments(ctx context.Context, poolID int64) ([]ProxyPoolAssignment, error)
+	// ReassignAccountProxy 仅当账号仍引用该代理池且当前代理仍为 fromProxyID（nil 表示未分配）时切换到 toProxyID
+	ReassignAccountProxy(ctx context.Context, accountID, poolID int64, fromProxyID *int64, toProxyID int64) (bool, error)
+}
+
+// CreateProxyPoolInput 创建代理池
+type CreateProxyPoolInput struct {
+	Name        string
+	Description string
+	Policy      string
+	ProxyIDs    []int64
+}
+
+// UpdateProxyPoolInput 更新代理池（nil 表示不修改）
+type UpdateProxyPoolInput struct {
+	Name        *string
+	Description *string
+	Policy      *string
+	Status      *string
+	ProxyIDs    *[]int64
+}
+
+// ProxyHealth 代理健康状态
+//
+// Checks / Failures 统计窗口内的观测次数（后台探测 + 转发时的代理连接错误），用于计算错误率；
+// 连续失败达到阈值时标记为不健康，任意一次探测成功即恢复。
+type ProxyHealth struct {
+	Status              string    `json:"status"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	Checks              int       `json:"checks"`
+	Failures            int       `json:"failures"`
+	ForwardErrors       int       `json:"forward_errors"`
+	WindowStart         time.Time `json:"window_start"`
+	LastError           string    `json:"last_error,omitempty"`
+	CheckedAt           time.Time `json:"checked_at"`
+}
+
+// Usable 未知状态（尚未探测）视为可用
+func (h *ProxyHealth) Usable() bool {
+	return h == nil || h.Status != ProxyHealthUnhealthy
+}
+
+// ErrorRate 统计窗口内的失败比例
+func (h *ProxyHealth) ErrorRate() float64 {
+	if h == nil || h.Checks == 0 {
+		return 0
+	}
+	return float64(h.Failures) / float64(h.Checks)
+}
+
+// record 记录一次观测结果；forward 表示来自转发请求的代理连接错误
+func (h *ProxyHealth) record(success, forward bool, message string, now time.Time, window time.Duration, unhealthyThreshold int) {
+	if h.WindowStart.IsZero() || now.Sub(h.WindowStart) >= window {
+		h.WindowStart = now
+		h.Checks = 0
+		h.Failures = 0
+		h.ForwardErrors = 0
+	}
+	h.Checks++
+	h.CheckedAt = now
+	if success {
+		h.ConsecutiveFailures = 0
+		h.Status = ProxyHealthHealthy
+		h.LastError = ""
+		return
+	}
+
+	h.Failures++
+	if forward {
+		h.ForwardErrors++
+	}
+	h.ConsecutiveFailures++
+	h.LastError = message
+	if h.ConsecutiveFailures >= unhealthyThreshold {
+		h.Status = ProxyHealthUnhealthy
+	} else if h.Status == "" {
+		h.Status = ProxyHealthUnknown
+	}
+}
+
+type ProxyHealthCache interface {
+	GetProxyHealth(ctx context.Context, proxyIDs []int64) (map[int64]*ProxyHealth, error)
+	SetProxyHealth(ctx context.Context, proxyID int64, health *ProxyHealth) error
+}
@@ -0,0 +1,750 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const proxyPoolProbeTimeout = 30 * time.Second
+
+// ProxyPoolService manages proxy pools, probes pool members in the background and
+// moves accounts off unhealthy proxies.
+//
+// An account that references a pool keeps its currently assigned member in proxy_id,
+// so every upstream call path keeps using account.Proxy. Reassignment happens when the
+// account is bound to the pool, when a periodic probe marks its proxy unhealthy, and
+// when Forward fails with a proxy/connect error.
+type ProxyPoolService struct {
+	poolRepo     ProxyPoolRepository
+	proxyRepo    ProxyRepository
+	healthCache  ProxyHealthCache
+	latencyCache ProxyLatencyCache
+	prober       ProxyExitInfoProber
+	cfg          config.ProxyPoolConfig
+
+	// roundRobin holds a rotating counter per pool (pool id -> *atomic.Uint64)
+	roundRobin sync.Map
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewProxyPoolService(
+	poolRepo ProxyPoolRepository,
+	proxyRepo ProxyRepository,
+	healthCache ProxyHealthCache,
+	latencyCache ProxyLatencyCache,
+	prober ProxyExitInfoProber,
+	cfg *config.Config,
+) *ProxyPoolService {
+	s := &ProxyPoolService{
+		poolRepo:     poolRepo,
+		proxyRepo:    proxyRepo,
+		healthCache:  healthCache,
+		latencyCache: latencyCache,
+		prober:       prober,
+		stopCh:       make(chan struct{}),
+	}
+	if cfg != nil {
+		s.cfg = cfg.ProxyPool
+	}
+	return s
+}
+
+func (s *ProxyPoolService) Start() {
+	if s == nil || s.poolRepo == nil || s.prober == nil || !s.cfg.HealthCheckEnabled || s.cfg.HealthCheckIntervalSeconds <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		ticker := time.NewTicker(time.Duration(s.cfg.HealthCheckIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+
+		s.runHealthCheck(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				s.runHealthCheck(ctx)
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+	// Abort in-flight probes on shutdown instead of waiting for their timeout.
+	go func() {
+		<-s.stopCh
+		cancel()
+	}()
+}
+
+func (s *ProxyPoolService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+// List returns pools with member / account counts and the number of healthy members.
+func (s *ProxyPoolService) List(ctx context.Context, params pagination.PaginationParams, status, search string) ([]ProxyPool, *pagination.PaginationResult, error) {
+	pools, result, err := s.poolRepo.List(ctx, params, status, search)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.attachHealthyCount(ctx, pools)
+	return pools, result, nil
+}
+
+// ListActive returns all active pools, used for account binding selectors.
+func (s *ProxyPoolService) ListActive(ctx context.Context) ([]ProxyPool, error) {
+	pools, err := s.poolRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.attachHealthyCount(ctx, pools)
+	return pools, nil
+}
+
+func (s *ProxyPoolService) GetByID(ctx context.Context, id int64) (*ProxyPool, error) {
+	pool, err := s.poolRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pools := []ProxyPool{*pool}
+	s.attachHealthyCount(ctx, pools)
+	return &pools[0], nil
+}
+
+func (s *ProxyPoolService) Create(ctx context.Context, input *CreateProxyPoolInput) (*ProxyPool, error) {
+	policy := strings.TrimSpace(input.Policy)
+	if policy == "" {
+		policy = ProxyPoolPolicySticky
+	}
+	if !IsValidProxyPoolPolicy(policy) {
+		return nil, ErrProxyPoolBadPolicy
+	}
+	if err := s.validateProxyIDs(ctx, input.ProxyIDs); err != nil {
+		return nil, err
+	}
+
+	pool := &ProxyPool{
+		Name:        strings.TrimSpace(input.Name),
+		Description: strings.TrimSpace(input.Description),
+		Policy:      policy,
+		Status:      StatusActive,
+	}
+	if err := s.poolRepo.Create(ctx, pool); err != nil {
+		return nil, err
+	}
+	if err := s.poolRepo.SetMembers(ctx, pool.ID, input.ProxyIDs); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, pool.ID)
+}
+
+func (s *ProxyPoolService) Update(ctx context.Context, id int64, input *UpdateProxyPoolInput) (*ProxyPool, error) {
+	pool, err := s.poolRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if input.Name != nil {
+		pool.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		pool.Description = strings.TrimSpace(*input.Description)
+	}
+	if input.Policy != nil {
+		if !IsValidProxyPoolPolicy(*input.Policy) {
+			return nil, ErrProxyPoolBadPolicy
+		}
+		pool.Policy = *input.Policy
+	}
+	if input.Status != nil {
+		pool.Status = *input.Status
+	}
+	if input.ProxyIDs != nil {
+		if err := s.validateProxyIDs(ctx, *input.ProxyIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.poolRepo.Update(ctx, pool); err != nil {
+		return nil, err
+	}
+	if input.ProxyIDs != nil {
+		if err := s.poolRepo.SetMembers(ctx, pool.ID, *input.ProxyIDs); err != nil {
+			return nil, err
+		}
+		// Move accounts whose proxy was removed from the pool right away.
+		if pool.IsActive() {
+			if _, err := s.rebalance(ctx, pool); err != nil {
+				log.Printf("[ProxyPool] Rebalance pool %d after member change failed: %v", pool.ID, err)
+			}
+		}
+	}
+	return s.GetByID(ctx, pool.ID)
+}
+
+// Delete removes a pool that no account references; its proxies leave the pool.
+func (s *ProxyPoolService) Delete(ctx context.Context, id int64) error {
+	if _, err := s.poolRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	count, err := s.poolRepo.CountAccounts(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrProxyPoolInUse
+	}
+	if err := s.poolRepo.SetMembers(ctx, id, nil); err != nil {
+		return err
+	}
+	return s.poolRepo.Delete(ctx, id)
+}
+
+// AddMembers adds proxies to a pool, keeping its current members.
+func (s *ProxyPoolService) AddMembers(ctx context.Context, poolID int64, proxyIDs []int64) error {
+	if len(proxyIDs) == 0 {
+		return nil
+	}
+	pool, err := s.poolRepo.GetByID(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	members := append([]int64(nil), pool.ProxyIDs...)
+	for _, id := range proxyIDs {
+		if !containsInt64(members, id) {
+			members = append(members, id)
+		}
+	}
+	if len(members) == len(pool.ProxyIDs) {
+		return nil
+	}
+	return s.poolRepo.SetMembers(ctx, poolID, members)
+}
+
+// ProbeProxies probes the given proxies and records exit info, latency and health.
+func (s *ProxyPoolService) ProbeProxies(ctx context.Context, proxies []Proxy) {
+	if s == nil || s.prober == nil || len(proxies) == 0 {
+		return
+	}
+	byID := make(map[int64]Proxy, len(proxies))
+	for i := range proxies {
+		byID[proxies[i].ID] = proxies[i]
+	}
+	s.probeAll(ctx, byID)
+}
+
+func (s *ProxyPoolService) validateProxyIDs(ctx context.Context, ids []int64) error {
+	for _, id := range ids {
+		if _, err := s.proxyRepo.GetByID(ctx, id); err != nil {
+			return fmt.Errorf("get proxy %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// ValidatePool checks that a pool can be bound to accounts.
+func (s *ProxyPoolService) ValidatePool(ctx context.Context, poolID int64) error {
+	pool, err := s.poolRepo.GetByID(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	if !pool.IsActive() {
+		return ErrProxyPoolInactive
+	}
+	members, err := s.poolRepo.ListMembers(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return ErrProxyPoolEmpty
+	}
+	return nil
+}
+
+// AssignAccount picks a pool member for an account bound to a pool and persists it.
+// The current proxy is kept when it is still a usable member of the pool.
+func (s *ProxyPoolService) AssignAccount(ctx context.Context, account *Account) error {
+	if s == nil || account == nil || account.ProxyPoolID == nil {
+		return nil
+	}
+	pool, err := s.poolRepo.GetByID(ctx, *account.ProxyPoolID)
+	if err != nil {
+		return err
+	}
+	members, err := s.poolRepo.ListMembers(ctx, pool.ID)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return ErrProxyPoolEmpty
+	}
+	health, latencies := s.loadProxyState(ctx, members)
+
+	if account.ProxyID != nil {
+		for i := range members {
+			if members[i].ID == *account.ProxyID && health[members[i].ID].Usable() {
+				return nil
+			}
+		}
+	}
+
+	next := s.choose(pool, members, health, latencies, account.ID, account.ProxyID, nil)
+	if next == nil {
+		// Every member is unhealthy: binding to one of them beats leaving the account without a proxy.
+		next = s.choose(pool, members, nil, latencies, account.ID, account.ProxyID, nil)
+	}
+	if _, err := s.poolRepo.ReassignAccountProxy(ctx, account.ID, pool.ID, account.ProxyID, next.ID); err != nil {
+		return err
+	}
+	account.ProxyID = &next.ID
+	account.Proxy = next
+	return nil
+}
+
+// DoWithFailover sends an upstream request and, when it fails with a proxy/connect error
+// and the account references a proxy pool, retries through other healthy pool members.
+// rebuild must return a fresh request because the previous body has been consumed.
+// It returns the proxy URL used by the last attempt.
+func (s *ProxyPoolService) DoWithFailover(
+	ctx context.Context,
+	account *Account,
+	req *http.Request,
+	proxyURL string,
+	rebuild func() (*http.Request, error),
+	do func(req *http.Request, proxyURL string) (*http.Response, error),
+) (*http.Response, string, error) {
+	resp, err := do(req, proxyURL)
+	if s == nil || err == nil || account == nil || account.ProxyID == nil || !IsProxyConnectError(err) {
+		return resp, proxyURL, err
+	}
+
+	current := *account.ProxyID
+	tried := []int64{current}
+	for attempt := 0; ; attempt++ {
+		s.recordObservation(ctx, current, false, true, err.Error())
+		if account.ProxyPoolID == nil || attempt >= s.cfg.MaxFailoverAttempts {
+			break
+		}
+		next := s.failoverTarget(ctx, account, current, tried)
+		if next == nil {
+			break
+		}
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+		log.Printf("[ProxyPool] Failover account %d from proxy %d to %d: %v", account.ID, current, next.ID, err)
+
+		nextReq, buildErr := rebuild()
+		if buildErr != nil {
+			return nil, proxyURL, buildErr
+		}
+		current = next.ID
+		tried = append(tried, current)
+		proxyURL = next.URL()
+		resp, err = do(nextReq, proxyURL)
+		if !IsProxyConnectError(err) {
+			return resp, proxyURL, err
+		}
+	}
+	return resp, proxyURL, err
+}
+
+// failoverTarget picks another usable member and persists it as the account's proxy.
+func (s *ProxyPoolService) failoverTarget(ctx context.Context, account *Account, current int64, tried []int64) *Proxy {
+	pool, err := s.poolRepo.GetByID(ctx, *account.ProxyPoolID)
+	if err != nil || !pool.IsActive() {
+		return nil
+	}
+	members, err := s.poolRepo.ListMembers(ctx, pool.ID)
+	if err != nil {
+		log.Printf("[ProxyPool] Load members of pool %d failed: %v", pool.ID, err)
+		return nil
+	}
+	health, latencies := s.loadProxyState(ctx, members)
+	next := s.choose(pool, members, health, latencies, account.ID, &current, tried)
+	if next == nil {
+		return nil
+	}
+	// A concurrent request may have moved the account already; this request still uses next.
+	if _, err := s.poolRepo.ReassignAccountProxy(ctx, account.ID, pool.ID, &current, next.ID); err != nil {
+		log.Printf("[ProxyPool] Reassign account %d to proxy %d failed: %v", account.ID, next.ID, err)
+	}
+	return next
+}
+
+// AttachProxyHealth fills health fields for the admin proxy list.
+func (s *ProxyPoolService) AttachProxyHealth(ctx context.Context, proxies []ProxyWithAccountCount) {
+	if s == nil || s.healthCache == nil || len(proxies) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(proxies))
+	for i := range proxies {
+		ids = append(ids, proxies[i].ID)
+	}
+	health, err := s.healthCache.GetProxyHealth(ctx, ids)
+	if err != nil {
+		log.Printf("Warning: load proxy health cache failed: %v", err)
+		return
+	}
+	for i := range proxies {
+		h := health[proxies[i].ID]
+		if h == nil {
+			continue
+		}
+		rate := h.ErrorRate()
+		checkedAt := h.CheckedAt
+		proxies[i].HealthStatus = h.Status
+		proxies[i].HealthMessage = h.LastError
+		proxies[i].ErrorRate = &rate
+		proxies[i].ConsecutiveFailures = h.ConsecutiveFailures
+		proxies[i].ForwardErrors = h.ForwardErrors
+		proxies[i].HealthCheckedAt = &checkedAt
+	}
+}
+
+func (s *ProxyPoolService) attachHealthyCount(ctx context.Context, pools []ProxyPool) {
+	if s.healthCache == nil || len(pools) == 0 {
+		return
+	}
+	ids := make([]int64, 0)
+	for i := range pools {
+		ids = append(ids, pools[i].ProxyIDs...)
+	}
+	health, err := s.healthCache.GetProxyHealth(ctx, ids)
+	if err != nil {
+		log.Printf("Warning: load proxy health cache failed: %v", err)
+	}
+	for i := range pools {
+		pools[i].HealthyCount = 0
+		for _, id := range pools[i].ProxyIDs {
+			if health[id].Usable() {
+				pools[i].HealthyCount++
+			}
+		}
+	}
+}
+
+// runHealthCheck probes every member of active pools, then moves accounts off unhealthy members.
+func (s *ProxyPoolService) runHealthCheck(ctx context.Context) {
+	pools, err := s.poolRepo.ListActive(ctx)
+	if err != nil {
+		log.Printf("[ProxyPool] List pools failed: %v", err)
+		return
+	}
+
+	probed := make(map[int64]Proxy)
+	for i := range pools {
+		members, err := s.poolRepo.ListMembers(ctx, pools[i].ID)
+		if err != nil {
+			log.Printf("[ProxyPool] Load members of pool %d failed: %v", pools[i].ID, err)
+			continue
+		}
+		for _, p := range members {
+			probed[p.ID] = p
+		}
+	}
+	s.probeAll(ctx, probed)
+	if ctx.Err() != nil {
+		return
+	}
+
+	for i := range pools {
+		moved, err := s.rebalance(ctx, &pools[i])
+		if err != nil {
+			log.Printf("[ProxyPool] Rebalance pool %d failed: %v", pools[i].ID, err)
+			continue
+		}
+		if moved > 0 {
+			log.Printf("[ProxyPool] Moved %d accounts of pool %d to healthy proxies", moved, pools[i].ID)
+		}
+	}
+}
+
+func (s *ProxyPoolService) probeAll(ctx context.Context, proxies map[int64]Proxy) {
+	concurrency := s.cfg.ProbeConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for id := range proxies {
+		p := proxies[id]
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.probe(ctx, &p)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *ProxyPoolService) probe(ctx context.Context, p *Proxy) {
+	probeCtx, cancel := context.WithTimeout(ctx, proxyPoolProbeTimeout)
+	defer cancel()
+
+	exitInfo, latencyMs, err := s.prober.ProbeProxy(probeCtx, p.URL())
+	if ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+	if err != nil {
+		s.recordObservation(ctx, p.ID, false, false, err.Error())
+		s.saveLatency(ctx, p.ID, &ProxyLatencyInfo{Success: false, Message: err.Error(), UpdatedAt: now})
+		return
+	}
+	s.recordObservation(ctx, p.ID, true, false, "")
+	s.saveLatency(ctx, p.ID, &ProxyLatencyInfo{
+		Success:     true,
+		LatencyMs:   &latencyMs,
+		Message:     "Proxy is accessible",
+		IPAddress:   exitInfo.IP,
+		Country:     exitInfo.Country,
+		CountryCode: exitInfo.CountryCode,
+		Region:      exitInfo.Region,
+		City:        exitInfo.City,
+		UpdatedAt:   now,
+	})
+}
+
+// rebalance moves accounts of a pool that have no proxy, a proxy outside the pool,
+// or an unhealthy proxy to a usable member. Accounts stay put when no member is usable.
+func (s *ProxyPoolService) rebalance(ctx context.Context, pool *ProxyPool) (int, error) {
+	assignments, err := s.poolRepo.ListAssignments(ctx, pool.ID)
+	if err != nil || len(assignments) == 0 {
+		return 0, err
+	}
+	members, err := s.poolRepo.ListMembers(ctx, pool.ID)
+	if err != nil || len(members) == 0 {
+		return 0, err
+	}
+	health, latencies := s.loadProxyState(ctx, members)
+	memberIDs := make(map[int64]struct{}, len(members))
+	for i := range members {
+		memberIDs[members[i].ID] = struct{}{}
+	}
+
+	moved := 0
+	for _, a := range assignments {
+		if a.ProxyID != nil {
+			if _, ok := memberIDs[*a.ProxyID]; ok && health[*a.ProxyID].Usable() {
+				continue
+			}
+		}
+		next := s.choose(pool, members, health, latencies, a.AccountID, a.ProxyID, nil)
+		if next == nil {
+			continue
+		}
+		ok, err := s.poolRepo.ReassignAccountProxy(ctx, a.AccountID, pool.ID, a.ProxyID, next.ID)
+		if err != nil {
+			return moved, err
+		}
+		if ok {
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+// choose applies the pool policy to the usable members not in exclude.
+// A nil health map treats every member as usable.
+func (s *ProxyPoolService) choose(pool *ProxyPool, members []Proxy, health map[int64]*ProxyHealth, latencies map[int64]*ProxyLatencyInfo, accountID int64, currentProxyID *int64, exclude []int64) *Proxy {
+	var counter uint64
+	if pool.Policy == ProxyPoolPolicyRoundRobin {
+		v, _ := s.roundRobin.LoadOrStore(pool.ID, new(atomic.Uint64))
+		counter = v.(*atomic.Uint64).Add(1) - 1
+	}
+	return selectPoolProxy(pool.Policy, members, health, latencies, accountID, currentProxyID, exclude, counter)
+}
+
+// selectPoolProxy picks a member according to policy:
+//   - sticky: a stable member derived from the account id, so an account keeps landing on the same proxy
+//   - round_robin: the next member of the pool's rotation, spreading accounts evenly
+//   - least_latency: the member with the lowest probed latency
+//   - same_country: the lowest latency member in the exit country of the current proxy,
+//     falling back to least_latency when none is available
+func selectPoolProxy(policy string, members []Proxy, health map[int64]*ProxyHealth, latencies map[int64]*ProxyLatencyInfo, accountID int64, currentProxyID *int64, exclude []int64, counter uint64) *Proxy {
+	candidates := make([]Proxy, 0, len(members))
+	for i := range members {
+		if containsInt64(exclude, members[i].ID) {
+			continue
+		}
+		if health != nil && !health[members[i].ID].Usable() {
+			continue
+		}
+		candidates = append(candidates, members[i])
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].ID < candidates[j].ID })
+
+	switch policy {
+	case ProxyPoolPolicyRoundRobin:
+		return &candidates[counter%uint64(len(candidates))]
+	case ProxyPoolPolicyLeastLatency:
+		return leastLatencyProxy(candidates, latencies)
+	case ProxyPoolPolicySameCountry:
+		if currentProxyID != nil {
+			if info := latencies[*currentProxyID]; info != nil && info.CountryCode != "" {
+				sameCountry := make([]Proxy, 0, len(candidates))
+				for i := range candidates {
+					if other := latencies[candidates[i].ID]; other != nil && other.CountryCode == info.CountryCode {
+						sameCountry = append(sameCountry, candidates[i])
+					}
+				}
+				if len(sameCountry) > 0 {
+					return leastLatencyProxy(sameCountry, latencies)
+				}
+			}
+		}
+		return leastLatencyProxy(candidates, latencies)
+	default:
+		idx := accountID % int64(len(candidates))
+		if idx < 0 {
+			idx = -idx
+		}
+		return &candidates[idx]
+	}
+}
+
+// leastLatencyProxy returns the member with the lowest successful latency; members
+// without a measurement come last. candidates must be sorted by id.
+func leastLatencyProxy(candidates []Proxy, latencies map[int64]*ProxyLatencyInfo) *Proxy {
+	best := -1
+	var bestLatency int64
+	for i := range candidates {
+		info := latencies[candidates[i].ID]
+		if info == nil || !info.Success || info.LatencyMs == nil {
+			continue
+		}
+		if best < 0 || *info.LatencyMs < bestLatency {
+			best = i
+			bestLatency = *info.LatencyMs
+		}
+	}
+	if best < 0 {
+		return &candidates[0]
+	}
+	return &candidates[best]
+}
+
+func (s *ProxyPoolService) loadProxyState(ctx context.Context, proxies []Proxy) (map[int64]*ProxyHealth, map[int64]*ProxyLatencyInfo) {
+	ids := make([]int64, 0, len(proxies))
+	for i := range proxies {
+		ids = append(ids, proxies[i].ID)
+	}
+	health := map[int64]*ProxyHealth{}
+	if s.healthCache != nil {
+		if loaded, err := s.healthCache.GetProxyHealth(ctx, ids); err == nil {
+			health = loaded
+		} else {
+			log.Printf("Warning: load proxy health cache failed: %v", err)
+		}
+	}
+	latencies := map[int64]*ProxyLatencyInfo{}
+	if s.latencyCache != nil {
+		if loaded, err := s.latencyCache.GetProxyLatencies(ctx, ids); err == nil {
+			latencies = loaded
+		} else {
+			log.Printf("Warning: load proxy latency cache failed: %v", err)
+		}
+	}
+	return health, latencies
+}
+
+func (s *ProxyPoolService) recordObservation(ctx context.Context, proxyID int64, success, forward bool, message string) {
+	if s.healthCache == nil {
+		return
+	}
+	current, err := s.healthCache.GetProxyHealth(ctx, []int64{proxyID})
+	if err != nil {
+		log.Printf("Warning: load proxy health cache failed: %v", err)
+		return
+	}
+	h := current[proxyID]
+	if h == nil {
+		h = &ProxyHealth{}
+	}
+	h.record(success, forward, sanitizeUpstreamErrorMessage(message), time.Now(), s.errorWindow(), s.unhealthyThreshold())
+	if err := s.healthCache.SetProxyHealth(ctx, proxyID, h); err != nil {
+		log.Printf("Warning: store proxy health cache failed: %v", err)
+	}
+}
+
+func (s *ProxyPoolService) saveLatency(ctx context.Context, proxyID int64, info *ProxyLatencyInfo) {
+	if s.latencyCache == nil {
+		return
+	}
+	if err := s.latencyCache.SetProxyLatency(ctx, proxyID, info); err != nil {
+		log.Printf("Warning: store proxy latency cache failed: %v", err)
+	}
+}
+
+func (s *ProxyPoolService) errorWindow() time.Duration {
+	if s.cfg.ErrorWindowMinutes <= 0 {
+		return time.Hour
+	}
+	return time.Duration(s.cfg.ErrorWindowMinutes) * time.Minute
+}
+
+func (s *ProxyPoolService) unhealthyThreshold() int {
+	if s.cfg.UnhealthyThreshold <= 0 {
+		return 1
+	}
+	return s.cfg.UnhealthyThreshold
+}
+
+// IsProxyConnectError reports whether err happened while connecting to or through the
+// proxy itself rather than at the upstream API.
+func IsProxyConnectError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && (opErr.Op == "proxyconnect" || strings.HasPrefix(opErr.Op, "socks")) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{
+		"proxyconnect",
+		"socks connect",
+		"socks5 connect",
+		"connect to proxy",
+		"proxy connect failed",
+		"write connect request",
+		"read connect response",
+	} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
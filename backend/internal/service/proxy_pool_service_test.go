@@ -0,0 +1,221 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type proxyPoolRepoStub struct {
+	pool       *ProxyPool
+	members    []Proxy
+	reassigned map[int64]int64
+}
+
+func (s *proxyPoolRepoStub) Create(ctx context.Context, pool *ProxyPool) error { return nil }
+func (s *proxyPoolRepoStub) GetByID(ctx context.Context, id int64) (*ProxyPool, error) {
+	if s.pool == nil || s.pool.ID != id {
+		return nil, ErrProxyPoolNotFound
+	}
+	return s.pool, nil
+}
+func (s *proxyPoolRepoStub) Update(ctx context.Context, pool *ProxyPool) error { return nil }
+func (s *proxyPoolRepoStub) Delete(ctx context.Context, id int64) error        { return nil }
+func (s *proxyPoolRepoStub) List(ctx context.Context, params pagination.PaginationParams, status, search string) ([]ProxyPool, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+func (s *proxyPoolRepoStub) ListActive(ctx context.Context) ([]ProxyPool, error) { return nil, nil }
+func (s *proxyPoolRepoStub) SetMembers(ctx context.Context, poolID int64, proxyIDs []int64) error {
+	return nil
+}
+func (s *proxyPoolRepoStub) ListMembers(ctx context.Context, poolID int64) ([]Proxy, error) {
+	return s.members, nil
+}
+func (s *proxyPoolRepoStub) CountAccounts(ctx context.Context, poolID int64) (int64, error) {
+	return 0, nil
+}
+func (s *proxyPoolRepoStub) ListAssignments(ctx context.Context, poolID int64) ([]ProxyPoolAssignment, error) {
+	return nil, nil
+}
+func (s *proxyPoolRepoStub) ReassignAccountProxy(ctx context.Context, accountID, poolID int64, fromProxyID *int64, toProxyID int64) (bool, error) {
+	if s.reassigned == nil {
+		s.reassigned = map[int64]int64{}
+	}
+	s.reassigned[accountID] = toProxyID
+	return true, nil
+}
+
+type proxyHealthCacheStub struct {
+	health map[int64]*ProxyHealth
+}
+
+func (s *proxyHealthCacheStub) GetProxyHealth(ctx context.Context, proxyIDs []int64) (map[int64]*ProxyHealth, error) {
+	out := make(map[int64]*ProxyHealth, len(proxyIDs))
+	for _, id := range proxyIDs {
+		if h, ok := s.health[id]; ok {
+			cp := *h
+			out[id] = &cp
+		}
+	}
+	return out, nil
+}
+
+func (s *proxyHealthCacheStub) SetProxyHealth(ctx context.Context, proxyID int64, health *ProxyHealth) error {
+	if s.health == nil {
+		s.health = map[int64]*ProxyHealth{}
+	}
+	s.health[proxyID] = health
+	return nil
+}
+
+func poolTestProxies(ids ...int64) []Proxy {
+	out := make([]Proxy, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, Proxy{ID: id, Protocol: "http", Host: "10.0.0.1", Port: int(8000 + id), Status: StatusActive})
+	}
+	return out
+}
+
+func latencyInfo(ms int64, country string) *ProxyLatencyInfo {
+	return &ProxyLatencyInfo{Success: true, LatencyMs: &ms, CountryCode: country}
+}
+
+func TestSelectPoolProxy_Policies(t *testing.T) {
+	members := poolTestProxies(3, 1, 2)
+	latencies := map[int64]*ProxyLatencyInfo{
+		1: latencyInfo(300, "US"),
+		2: latencyInfo(100, "JP"),
+		3: latencyInfo(200, "US"),
+	}
+
+	// sticky：按账号 ID 稳定映射
+	require.Equal(t, int64(2), selectPoolProxy(ProxyPoolPolicySticky, members, nil, latencies, 4, nil, nil, 0).ID)
+	require.Equal(t, int64(2), selectPoolProxy(ProxyPoolPolicySticky, members, nil, latencies, 4, nil, nil, 7).ID)
+
+	// round_robin：按计数轮转
+	require.Equal(t, int64(1), selectPoolProxy(ProxyPoolPolicyRoundRobin, members, nil, latencies, 4, nil, nil, 0).ID)
+	require.Equal(t, int64(2), selectPoolProxy(ProxyPoolPolicyRoundRobin, members, nil, latencies, 4, nil, nil, 1).ID)
+	require.Equal(t, int64(3), selectPoolProxy(ProxyPoolPolicyRoundRobin, members, nil, latencies, 4, nil, nil, 5).ID)
+
+	require.Equal(t, int64(2), selectPoolProxy(ProxyPoolPolicyLeastLatency, members, nil, latencies, 4, nil, nil, 0).ID)
+
+	// same_country：优先同出口国家的最低延迟代理
+	current := int64(1)
+	require.Equal(t, int64(3), selectPoolProxy(ProxyPoolPolicySameCountry, members, nil, latencies, 4, &current, []int64{1}, 0).ID)
+	// 无当前代理时退化为最低延迟
+	require.Equal(t, int64(2), selectPoolProxy(ProxyPoolPolicySameCountry, members, nil, latencies, 4, nil, nil, 0).ID)
+}
+
+func TestSelectPoolProxy_SkipsUnhealthyAndExcluded(t *testing.T) {
+	members := poolTestProxies(1, 2, 3)
+	health := map[int64]*ProxyHealth{
+		1: {Status: ProxyHealthUnhealthy},
+		2: {Status: ProxyHealthHealthy},
+	}
+
+	got := selectPoolProxy(ProxyPoolPolicyLeastLatency, members, health, nil, 1, nil, []int64{2}, 0)
+	require.NotNil(t, got)
+	require.Equal(t, int64(3), got.ID)
+
+	require.Nil(t, selectPoolProxy(ProxyPoolPolicySticky, members, health, nil, 1, nil, []int64{2, 3}, 0))
+}
+
+func TestProxyHealthRecord(t *testing.T) {
+	now := time.Now()
+	h := &ProxyHealth{}
+
+	h.record(false, true, "dial failed", now, time.Hour, 2)
+	require.Equal(t, ProxyHealthUnknown, h.Status)
+	require.True(t, h.Usable())
+
+	h.record(false, false, "dial failed", now.Add(time.Second), time.Hour, 2)
+	require.Equal(t, ProxyHealthUnhealthy, h.Status)
+	require.False(t, h.Usable())
+	require.Equal(t, 2, h.Failures)
+	require.Equal(t, 1, h.ForwardErrors)
+	require.Equal(t, 1.0, h.ErrorRate())
+
+	h.record(true, false, "", now.Add(2*time.Second), time.Hour, 2)
+	require.Equal(t, ProxyHealthHealthy, h.Status)
+	require.Zero(t, h.ConsecutiveFailures)
+	require.InDelta(t, 2.0/3.0, h.ErrorRate(), 1e-9)
+
+	// 超出统计窗口后重新计数
+	h.record(true, false, "", now.Add(2*time.Hour), time.Hour, 2)
+	require.Equal(t, 1, h.Checks)
+	require.Zero(t, h.Failures)
+}
+
+func TestIsProxyConnectError(t *testing.T) {
+	require.True(t, IsProxyConnectError(&net.OpError{Op: "proxyconnect", Err: errors.New("connection refused")}))
+	require.True(t, IsProxyConnectError(errors.New("socks connect tcp 1.2.3.4:1080->api.anthropic.com:443: unknown error")))
+	require.False(t, IsProxyConnectError(errors.New("dial tcp: lookup api.anthropic.com: no such host")))
+	require.False(t, IsProxyConnectError(context.Canceled))
+	require.False(t, IsProxyConnectError(nil))
+}
+
+func TestDoWithFailover_SwitchesToNextPoolMember(t *testing.T) {
+	poolID := int64(9)
+	members := poolTestProxies(1, 2)
+	repo := &proxyPoolRepoStub{
+		pool:    &ProxyPool{ID: poolID, Policy: ProxyPoolPolicySticky, Status: StatusActive},
+		members: members,
+	}
+	cache := &proxyHealthCacheStub{}
+	cfg := &config.Config{ProxyPool: config.ProxyPoolConfig{UnhealthyThreshold: 3, ErrorWindowMinutes: 60, MaxFailoverAttempts: 2}}
+	svc := NewProxyPoolService(repo, nil, cache, nil, nil, cfg)
+
+	currentID := int64(1)
+	account := &Account{ID: 7, ProxyID: &currentID, ProxyPoolID: &poolID}
+	firstURL := members[0].URL()
+
+	var usedURLs []string
+	do := func(req *http.Request, proxyURL string) (*http.Response, error) {
+		usedURLs = append(usedURLs, proxyURL)
+		if proxyURL == firstURL {
+			return nil, &net.OpError{Op: "proxyconnect", Err: errors.New("connection refused")}
+		}
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}
+	rebuilds := 0
+	rebuild := func() (*http.Request, error) {
+		rebuilds++
+		return http.NewRequest(http.MethodPost, "https://example.com", nil)
+	}
+	req, err := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	require.NoError(t, err)
+
+	resp, usedURL, err := svc.DoWithFailover(context.Background(), account, req, firstURL, rebuild, do)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, members[1].URL(), usedURL)
+	require.Equal(t, []string{firstURL, members[1].URL()}, usedURLs)
+	require.Equal(t, 1, rebuilds)
+	require.Equal(t, int64(2), repo.reassigned[account.ID])
+	require.Equal(t, 1, cache.health[1].ForwardErrors)
+}
+
+func TestDoWithFailover_IgnoresNonPoolAndUpstreamErrors(t *testing.T) {
+	var svc *ProxyPoolService
+	upstreamErr := errors.New("upstream timeout")
+	calls := 0
+	do := func(req *http.Request, proxyURL string) (*http.Response, error) {
+		calls++
+		return nil, upstreamErr
+	}
+	req, err := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	require.NoError(t, err)
+
+	_, _, err = svc.DoWithFailover(context.Background(), &Account{ID: 1}, req, "", nil, do)
+	require.ErrorIs(t, err, upstreamErr)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrProxySubscriptionNotFound = infraerrors.NotFound("PROXY_SUBSCRIPTION_NOT_FOUND", "proxy subscription not found")
+	ErrProxyImportEmpty          = infraerrors.BadRequest("PROXY_IMPORT_EMPTY", "no importable http/https/socks5 proxies found")
+	ErrProxyImportTooMany        = infraerrors.BadRequest("PROXY_IMPORT_TOO_MANY", "too many proxies in one import")
+	ErrProxyImportNoSource       = infraerrors.BadRequest("PROXY_IMPORT_NO_SOURCE", "content or url is required")
+	ErrProxyImportBadInterval    = infraerrors.BadRequest("PROXY_IMPORT_INVALID_INTERVAL", "refresh interval must be 0 or at least 5 minutes")
+)
+
+// ProxySubscriptionMinRefreshMinutes 自动同步的最小间隔（分钟）
+const ProxySubscriptionMinRefreshMinutes = 5
+
+// proxyStatusInactive 代理 / 代理订阅的停用状态
+const proxyStatusInactive = "inactive"
+
+// ProxySubscription 代理订阅：定期从订阅地址同步代理
+type ProxySubscription struct {
+	ID   int64
+	Name string
+	URL  string
+	// RefreshIntervalMinutes 自动同步间隔，0 表示仅手动同步
+	RefreshIntervalMinutes int
+	// PoolID 导入的代理自动加入的代理池
+	PoolID    *int64
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// 最近一次同步结果
+	LastSyncedAt *time.Time
+	LastError    string
+	LastTotal    int
+	LastCreated  int
+	LastSkipped  int
+	LastDisabled int
+
+	// ProxyCount 由该订阅导入的代理数（列表展示用）
+	ProxyCount int64
+}
+
+func (s *ProxySubscription) IsActive() bool {
+	return s.Status == StatusActive
+}
+
+// IsDue 判断订阅是否到达自动同步时间
+func (s *ProxySubscription) IsDue(now time.Time) bool {
+	if !s.IsActive() || s.RefreshIntervalMinutes <= 0 {
+		return false
+	}
+	if s.LastSyncedAt == nil {
+		return true
+	}
+	return !now.Before(s.LastSyncedAt.Add(time.Duration(s.RefreshIntervalMinutes) * time.Minute))
+}
+
+type ProxySubscriptionRepository interface {
+	Create(ctx context.Context, sub *ProxySubscription) error
+	GetByID(ctx context.Context, id int64) (*ProxySubscription, error)
+	Update(ctx context.Context, sub *ProxySubscription) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context) ([]ProxySubscription, error)
+
+	// UpdateSyncResult 记录同步结果；syncErr 非空表示同步失败（保留上次的统计数据）
+	UpdateSyncResult(ctx context.Context, id int64, syncedAt time.Time, result *ProxyImportResult, syncErr string) error
+	// ListProxies 返回由该订阅导入的代理（包括已停用的）
+	ListProxies(ctx context.Context, id int64) ([]Proxy, error)
+}
+
+// ProxyImportInput 批量导入代理（Content 与 URL 二选一）
+type ProxyImportInput struct {
+	Content string
+	URL     string
+	// NamePrefix 为导入的代理名称添加前缀
+	NamePrefix string
+	// PoolID 导入的代理加入该代理池
+	PoolID *int64
+}
+
+// ProxyImportSkipped 未导入的条目及原因
+type ProxyImportSkipped struct {
+	Entry  string
+	Reason string
+}
+
+// ProxyImportResult 导入 / 同步结果
+type ProxyImportResult struct {
+	// Total 解析出的可导入代理数（已去重）
+	Total int
+	// Created 新建的代理数
+	Created int
+	// Duplicated 与已有代理重复而跳过的数量
+	Duplicated int
+	// Reactivated 订阅中重新出现而恢复启用的代理数
+	Reactivated int
+	// Disabled 订阅中已移除而停用的代理数
+	Disabled int
+	// Skipped 无法解析或协议不支持的条目
+	Skipped []ProxyImportSkipped
+	// ProxyIDs 新建的代理 ID
+	ProxyIDs []int64
+}
+
+type CreateProxySubscriptionInput struct {
+	Name                   string
+	URL                    string
+	RefreshIntervalMinutes int
+	PoolID                 *int64
+}
+
+// UpdateProxySubscriptionInput 更新代理订阅（nil 表示不修改；PoolID 为 0 表示不再加入代理池）
+type UpdateProxySubscriptionInput struct {
+	Name                   *string
+	URL                    *string
+	RefreshIntervalMinutes *int
+	PoolID                 *int64
+	Status                 *string
+}
@@ -278,7 +278,7 @@ func (s *RedeemService) Redeem(ctx context.Context, userID int64, code string) (
 	switch redeemCode.Type {
 	case RedeemTypeBalance:
 		// 增加用户余额
-		if err := s.userRepo.UpdateBalance(txCtx, userID, redeemCode.Value); err != nil {
+		if err := s.userRepo.UpdateBalance(txCtx, userID, redeemCode.Value, BalanceChangeSource{Type: BalanceSourceRedeemCode, ReferenceID: redeemCode.Code}); err != nil {
 			return nil, fmt.Errorf("update user balance: %w", err)
 		}
 
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// RequestRateLimits 每分钟请求数与 token 数限制（滑动窗口），0 表示不限制。
+// 用户、分组、API Key 均可配置，请求需同时满足所有已配置的维度。
+type RequestRateLimits struct {
+	RPM       int `json:"rpm_limit"`
+	InputTPM  int `json:"input_tpm_limit"`
+	OutputTPM int `json:"output_tpm_limit"`
+}
+
+// IsZero 是否未配置任何限制
+func (l RequestRateLimits) IsZero() bool {
+	return l.RPM <= 0 && l.InputTPM <= 0 && l.OutputTPM <= 0
+}
+
+// Validate 校验限制值不为负数
+func (l RequestRateLimits) Validate() error {
+	if l.RPM < 0 || l.InputTPM < 0 || l.OutputTPM < 0 {
+		return ErrInvalidRateLimit
+	}
+	return nil
+}
+
+// RequestRateLimitsUpdate 部分更新限制值，nil 表示不修改，0 表示取消限制
+type RequestRateLimitsUpdate struct {
+	RPM       *int
+	InputTPM  *int
+	OutputTPM *int
+}
+
+// IsEmpty 是否未提供任何字段
+func (u RequestRateLimitsUpdate) IsEmpty() bool {
+	return u.RPM == nil && u.InputTPM == nil && u.OutputTPM == nil
+}
+
+// Apply 将更新应用到现有限制上并校验结果
+func (u RequestRateLimitsUpdate) Apply(current RequestRateLimits) (RequestRateLimits, error) {
+	if u.RPM != nil {
+		current.RPM = *u.RPM
+	}
+	if u.InputTPM != nil {
+		current.InputTPM = *u.InputTPM
+	}
+	if u.OutputTPM != nil {
+		current.OutputTPM = *u.OutputTPM
+	}
+	return current, current.Validate()
+}
+
+// RateLimitScope 单个限流主体（用户/API Key/分组）及其限制
+type RateLimitScope struct {
+	Name   string // 主体名称，如 "user:1"、"key:2"、"group:3"
+	Limits RequestRateLimits
+}
+
+// RateLimitWindow 单个维度在当前窗口内的状态
+type RateLimitWindow struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration // 窗口内最早的记录过期所需时间
+}
+
+// RateLimitScopeState 单个主体在当前窗口内的状态
+type RateLimitScopeState struct {
+	Requests     RateLimitWindow
+	InputTokens  RateLimitWindow
+	OutputTokens RateLimitWindow
+}
+
+// RateLimitAcquireResult 限流检查结果
+type RateLimitAcquireResult struct {
+	Allowed bool
+	// 被拒绝时的主体、维度（requests/input_tokens/output_tokens）与建议重试时间
+	RejectedScope     string
+	RejectedDimension string
+	RetryAfter        time.Duration
+	// ReservedAt 预占输入 token 所在的秒级时间戳（Redis 服务器时间），结算时在该时间戳上修正预占
+	ReservedAt int64
+	// 各主体状态，与传入的 scopes 一一对应
+	States []RateLimitScopeState
+}
+
+// RequestRateLimitCache 请求/Token 速率限制缓存（Redis 滑动窗口）
+//
+// Key 格式: ratelimit:{rpm|itpm|otpm}:{scope}
+// 数据结构: Hash (field=秒级时间戳, value=该秒内的计数)，窗口外的字段在访问时清理
+type RequestRateLimitCache interface {
+	// Acquire 原子地检查所有主体的限制；全部通过时为每个主体的 RPM 计数 +1，
+	// 并为配置了输入 TPM 的主体预占 inputTokens（请求体预估值）。
+	// 输入 TPM 按 已用 + 预占 检查（窗口为空时放行，避免单个超大请求永远无法通过），输出 TPM 仅检查是否已用尽。
+	Acquire(ctx context.Context, scopes []RateLimitScope, inputTokens int, window time.Duration) (*RateLimitAcquireResult, error)
+
+	// SettleTokens 按实际用量结算：输入 TPM 在 reservedAt 上将预占 reservedInput 修正为 inputTokens
+	// （预占已滑出窗口时仅补记超出部分），输出 TPM 累加 outputTokens
+	SettleTokens(ctx context.Context, scopes []RateLimitScope, reservedAt int64, reservedInput, inputTokens, outputTokens int, window time.Duration) error
+}
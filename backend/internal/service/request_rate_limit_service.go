@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrInvalidRateLimit   = infraerrors.BadRequest("INVALID_RATE_LIMIT", "rate limit must not be negative")
+	ErrRequestRateLimited = infraerrors.TooManyRequests("REQUEST_RATE_LIMITED", "request rate limit exceeded")
+)
+
+const (
+	RateLimitDimensionRequests     = "requests"
+	RateLimitDimensionInputTokens  = "input_tokens"
+	RateLimitDimensionOutputTokens = "output_tokens"
+
+	defaultRequestLimitWindow = time.Minute
+	// rateLimitOpTimeout 异步补记 token 的超时时间
+	rateLimitOpTimeout = 3 * time.Second
+)
+
+// RateLimitReservation 一次请求的限流检查结果与输入 token 预占，请求结束时用于结算实际 token 用量
+type RateLimitReservation struct {
+	scopes        []RateLimitScope
+	reservedInput int
+	inputTokens   int
+	outputTokens  int
+	Result        *RateLimitAcquireResult
+	Window        time.Duration
+}
+
+// RecordUsage 记录上游返回的实际用量，供 Settle 结算；未记录时 Settle 释放输入预占
+func (r *RateLimitReservation) RecordUsage(inputTokens, outputTokens int) {
+	if r == nil {
+		return
+	}
+	r.inputTokens = inputTokens
+	r.outputTokens = outputTokens
+}
+
+// RejectMessage 返回被限流时面向客户端的错误信息
+func (r *RateLimitReservation) RejectMessage() string {
+	if r == nil || r.Result == nil || r.Result.Allowed {
+		return ""
+	}
+	subject := r.Result.RejectedScope
+	if idx := strings.IndexByte(subject, ':'); idx > 0 {
+		subject = subject[:idx]
+	}
+	if subject == "key" {
+		subject = "API key"
+	}
+	dimension := r.Result.RejectedDimension
+	switch dimension {
+	case RateLimitDimensionInputTokens:
+		dimension = "input tokens"
+	case RateLimitDimensionOutputTokens:
+		dimension = "output tokens"
+	}
+	return fmt.Sprintf("Rate limit exceeded: %s %s per %s, please retry after %ds",
+		subject, dimension, formatRateLimitWindow(r.Window), retryAfterSeconds(r.Result.RetryAfter))
+}
+
+// RequestRateLimitService 用户/API Key/分组级别的 RPM 与 TPM 限制（Redis 滑动窗口，多实例共享）
+type RequestRateLimitService struct {
+	cache   RequestRateLimitCache
+	enabled bool
+	window  time.Duration
+}
+
+// NewRequestRateLimitService 创建请求速率限制服务
+func NewRequestRateLimitService(cache RequestRateLimitCache, cfg *config.Config) *RequestRateLimitService {
+	svc := &RequestRateLimitService{cache: cache, window: defaultRequestLimitWindow}
+	if cfg != nil {
+		svc.enabled = cfg.RateLimit.RequestLimitEnabled
+		if cfg.RateLimit.RequestLimitWindowSeconds > 0 {
+			svc.window = time.Duration(cfg.RateLimit.RequestLimitWindowSeconds) * time.Second
+		}
+	}
+	return svc
+}
+
+// Acquire 检查 API Key 对应的用户、Key、分组的 RPM/TPM 限制，通过时占用一次请求计数，
+// 并按请求体预估的输入 token 预占输入 TPM。
+// 未配置任何限制时返回 (nil, nil)；被限流时返回 reservation（用于输出响应头）与 ErrRequestRateLimited。
+// 缓存故障时失败开放，允许请求继续。
+func (s *RequestRateLimitService) Acquire(ctx context.Context, apiKey *APIKey, body []byte) (*RateLimitReservation, error) {
+	if s == nil || !s.enabled || s.cache == nil || apiKey == nil {
+		return nil, nil
+	}
+	scopes := rateLimitScopesForAPIKey(apiKey)
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+	reserve := 0
+	for _, scope := range scopes {
+		if scope.Limits.InputTPM > 0 {
+			reserve = EstimateRequestInputTokens(body)
+			break
+		}
+	}
+	result, err := s.cache.Acquire(ctx, scopes, reserve, s.window)
+	if err != nil {
+		log.Printf("Warning: request rate limit check failed for api key %d: %v", apiKey.ID, err)
+		return nil, nil
+	}
+	reservation := &RateLimitReservation{scopes: scopes, Result: result, Window: s.window}
+	if !result.Allowed {
+		return reservation, ErrRequestRateLimited
+	}
+	reservation.reservedInput = reserve
+	return reservation, nil
+}
+
+// Settle 在请求结束后结算：将输入 token 预占修正为 RecordUsage 记录的实际值并补记输出 token。
+// 未记录用量（转发失败、响应缓存命中等）时释放预占。每个 reservation 只应结算一次。
+func (s *RequestRateLimitService) Settle(ctx context.Context, reservation *RateLimitReservation) {
+	if s == nil || s.cache == nil || reservation == nil {
+		return
+	}
+	inputTokens, outputTokens := reservation.inputTokens, reservation.outputTokens
+	if reservation.reservedInput <= 0 && inputTokens <= 0 && outputTokens <= 0 {
+		return
+	}
+	scopes := make([]RateLimitScope, 0, len(reservation.scopes))
+	for _, scope := range reservation.scopes {
+		if scope.Limits.InputTPM > 0 || scope.Limits.OutputTPM > 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return
+	}
+	opCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rateLimitOpTimeout)
+	defer cancel()
+	if err := s.cache.SettleTokens(opCtx, scopes, reservation.Result.ReservedAt, reservation.reservedInput, inputTokens, outputTokens, reservation.Window); err != nil {
+		log.Printf("Warning: request rate limit token settle failed: %v", err)
+	}
+}
+
+// rateLimitEstimateSkipKeys 估算输入 token 时跳过的字段（标识、枚举、图片/文件数据等非文本内容）
+var rateLimitEstimateSkipKeys = map[string]struct{}{
+	"model": {}, "role": {}, "type": {}, "id": {}, "tool_use_id": {}, "call_id": {},
+	"data": {}, "url": {}, "image_url": {}, "file_data": {}, "mime_type": {}, "media_type": {},
+	"signature": {}, "cache_control": {}, "previous_response_id": {},
+	"mimeType": {}, "fileUri": {}, "thoughtSignature": {},
+}
+
+// EstimateRequestInputTokens 按请求体中的文本内容粗略估算输入 token（Claude/OpenAI/Gemini 请求格式通用），
+// 用于限流预占，实际用量在结算时修正。请求体无法解析时返回 0。
+func EstimateRequestInputTokens(body []byte) int {
+	if len(body) == 0 {
+		return 0
+	}
+	var root any
+	if err := json.Unmarshal(body, &root); err != nil {
+		return 0
+	}
+	total := 0
+	var walk func(v any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case map[string]any:
+			for key, child := range t {
+				if _, skip := rateLimitEstimateSkipKeys[key]; skip {
+					continue
+				}
+				walk(child)
+			}
+		case []any:
+			for _, child := range t {
+				walk(child)
+			}
+		case string:
+			if !strings.HasPrefix(t, "data:") {
+				total += estimateTokensForText(t)
+			}
+		}
+	}
+	walk(root)
+	return total
+}
+
+// rateLimitScopesForAPIKey 收集已配置限制的主体：用户、API Key、分组
+func rateLimitScopesForAPIKey(apiKey *APIKey) []RateLimitScope {
+	scopes := make([]RateLimitScope, 0, 3)
+	if apiKey.User != nil && !apiKey.User.RateLimits.IsZero() {
+		scopes = append(scopes, RateLimitScope{Name: fmt.Sprintf("user:%d", apiKey.User.ID), Limits: apiKey.User.RateLimits})
+	}
+	if !apiKey.RateLimits.IsZero() {
+		scopes = append(scopes, RateLimitScope{Name: fmt.Sprintf("key:%d", apiKey.ID), Limits: apiKey.RateLimits})
+	}
+	if apiKey.Group != nil && !apiKey.Group.RateLimits.IsZero() {
+		scopes = append(scopes, RateLimitScope{Name: fmt.Sprintf("group:%d", apiKey.Group.ID), Limits: apiKey.Group.RateLimits})
+	}
+	return scopes
+}
+
+// MostRestrictive 返回所有主体中剩余额度最少的请求数与 token 窗口（用于 x-ratelimit-* 响应头）。
+// token 窗口同时考虑输入与输出维度；ok 为 false 表示该维度未配置限制。
+func (r *RateLimitReservation) MostRestrictive() (requests RateLimitWindow, requestsOK bool, tokens RateLimitWindow, tokensOK bool) {
+	if r == nil || r.Result == nil {
+		return
+	}
+	pick := func(cur RateLimitWindow, ok bool, cand RateLimitWindow) (RateLimitWindow, bool) {
+		if cand.Limit <= 0 {
+			return cur, ok
+		}
+		if !ok || cand.Remaining < cur.Remaining || (cand.Remaining == cur.Remaining && cand.Reset > cur.Reset) {
+			return cand, true
+		}
+		return cur, ok
+	}
+	for _, state := range r.Result.States {
+		requests, requestsOK = pick(requests, requestsOK, state.Requests)
+		tokens, tokensOK = pick(tokens, tokensOK, state.InputTokens)
+		tokens, tokensOK = pick(tokens, tokensOK, state.OutputTokens)
+	}
+	return
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	secs := int((d + time.Second - 1) / time.Second)
+	if secs < 1 {
+		secs = 1
+	}
+	return secs
+}
+
+// RetryAfterSeconds 返回被限流时建议的重试秒数（至少 1 秒）
+func (r *RateLimitReservation) RetryAfterSeconds() int {
+	if r == nil || r.Result == nil {
+		return 1
+	}
+	return retryAfterSeconds(r.Result.RetryAfter)
+}
+
+func formatRateLimitWindow(d time.Duration) string {
+	if d == time.Minute {
+		return "minute"
+	}
+	return d.String()
+}
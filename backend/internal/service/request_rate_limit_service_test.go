@@ -0,0 +1,185 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type requestRateLimitCacheStub struct {
+	acquireScopes  []RateLimitScope
+	acquireReserve int
+	acquireResult  *RateLimitAcquireResult
+	acquireErr     error
+
+	settleCalls      int
+	settleScopes     []RateLimitScope
+	settleReservedAt int64
+	settleReserved   int
+	settleInput      int
+	settleOutput     int
+}
+
+func (s *requestRateLimitCacheStub) Acquire(_ context.Context, scopes []RateLimitScope, inputTokens int, _ time.Duration) (*RateLimitAcquireResult, error) {
+	s.acquireScopes = scopes
+	s.acquireReserve = inputTokens
+	return s.acquireResult, s.acquireErr
+}
+
+func (s *requestRateLimitCacheStub) SettleTokens(_ context.Context, scopes []RateLimitScope, reservedAt int64, reservedInput, inputTokens, outputTokens int, _ time.Duration) error {
+	s.settleCalls++
+	s.settleScopes = scopes
+	s.settleReservedAt = reservedAt
+	s.settleReserved = reservedInput
+	s.settleInput = inputTokens
+	s.settleOutput = outputTokens
+	return nil
+}
+
+func newRequestRateLimitServiceForTest(cache RequestRateLimitCache) *RequestRateLimitService {
+	cfg := &config.Config{}
+	cfg.RateLimit.RequestLimitEnabled = true
+	cfg.RateLimit.RequestLimitWindowSeconds = 60
+	return NewRequestRateLimitService(cache, cfg)
+}
+
+func TestRequestRateLimitService_AcquireCollectsConfiguredScopes(t *testing.T) {
+	cache := &requestRateLimitCacheStub{acquireResult: &RateLimitAcquireResult{Allowed: true}}
+	svc := newRequestRateLimitServiceForTest(cache)
+
+	apiKey := &APIKey{
+		ID:         2,
+		RateLimits: RequestRateLimits{RPM: 10},
+		User:       &User{ID: 1},
+		Group:      &Group{ID: 3, RateLimits: RequestRateLimits{OutputTPM: 1000}},
+	}
+	reservation, err := svc.Acquire(context.Background(), apiKey, []byte(`{"messages":[{"role":"user","content":"hello world"}]}`))
+	require.NoError(t, err)
+	require.NotNil(t, reservation)
+	require.Equal(t, []RateLimitScope{
+		{Name: "key:2", Limits: RequestRateLimits{RPM: 10}},
+		{Name: "group:3", Limits: RequestRateLimits{OutputTPM: 1000}},
+	}, cache.acquireScopes)
+	// 未配置输入 TPM 时不预占
+	require.Zero(t, cache.acquireReserve)
+
+	// 只为配置了 TPM 的主体补记 token
+	reservation.RecordUsage(120, 80)
+	svc.Settle(context.Background(), reservation)
+	require.Equal(t, []RateLimitScope{{Name: "group:3", Limits: RequestRateLimits{OutputTPM: 1000}}}, cache.settleScopes)
+	require.Equal(t, 120, cache.settleInput)
+	require.Equal(t, 80, cache.settleOutput)
+}
+
+func TestRequestRateLimitService_ReservesInputTokensAtAcquire(t *testing.T) {
+	cache := &requestRateLimitCacheStub{acquireResult: &RateLimitAcquireResult{Allowed: true, ReservedAt: 1700000000}}
+	svc := newRequestRateLimitServiceForTest(cache)
+
+	apiKey := &APIKey{ID: 2, RateLimits: RequestRateLimits{InputTPM: 1000}}
+	body := []byte(`{"model":"claude-sonnet-4","system":"You are helpful.","messages":[{"role":"user","content":[{"type":"text","text":"Summarize this document please."}]}]}`)
+	reservation, err := svc.Acquire(context.Background(), apiKey, body)
+	require.NoError(t, err)
+	require.Equal(t, EstimateRequestInputTokens(body), cache.acquireReserve)
+	require.Positive(t, cache.acquireReserve)
+
+	// 结算时将预占修正为实际输入
+	reservation.RecordUsage(42, 7)
+	svc.Settle(context.Background(), reservation)
+	require.Equal(t, int64(1700000000), cache.settleReservedAt)
+	require.Equal(t, cache.acquireReserve, cache.settleReserved)
+	require.Equal(t, 42, cache.settleInput)
+	require.Equal(t, 7, cache.settleOutput)
+
+	// 未记录用量（转发失败）时释放预占
+	reservation, err = svc.Acquire(context.Background(), apiKey, body)
+	require.NoError(t, err)
+	svc.Settle(context.Background(), reservation)
+	require.Equal(t, 2, cache.settleCalls)
+	require.Equal(t, cache.acquireReserve, cache.settleReserved)
+	require.Zero(t, cache.settleInput)
+}
+
+func TestEstimateRequestInputTokens(t *testing.T) {
+	require.Zero(t, EstimateRequestInputTokens(nil))
+	require.Zero(t, EstimateRequestInputTokens([]byte("not json")))
+
+	// 只统计文本内容，跳过模型名、角色与图片数据
+	text := EstimateRequestInputTokens([]byte(`{"messages":[{"role":"user","content":"abcdefgh"}]}`))
+	require.Equal(t, 2, text)
+	withImage := EstimateRequestInputTokens([]byte(`{"model":"gpt-4o","messages":[{"role":"user","content":[{"type":"text","text":"abcdefgh"},{"type":"image_url","image_url":{"url":"data:image/png;base64,AAAA"}}]}]}`))
+	require.Equal(t, 2, withImage)
+	gemini := EstimateRequestInputTokens([]byte(`{"contents":[{"role":"user","parts":[{"text":"你好世界"},{"inlineData":{"mimeType":"image/png","data":"AAAA"}}]}]}`))
+	require.Equal(t, 4, gemini)
+}
+
+func TestRequestRateLimitService_AcquireWithoutLimits(t *testing.T) {
+	cache := &requestRateLimitCacheStub{}
+	svc := newRequestRateLimitServiceForTest(cache)
+
+	reservation, err := svc.Acquire(context.Background(), &APIKey{ID: 1, User: &User{ID: 1}}, nil)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+	require.Nil(t, cache.acquireScopes)
+}
+
+func TestRequestRateLimitService_AcquireFailsOpen(t *testing.T) {
+	cache := &requestRateLimitCacheStub{acquireErr: errors.New("redis down")}
+	svc := newRequestRateLimitServiceForTest(cache)
+
+	reservation, err := svc.Acquire(context.Background(), &APIKey{ID: 1, RateLimits: RequestRateLimits{RPM: 1}}, nil)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+}
+
+func TestRequestRateLimitService_Rejected(t *testing.T) {
+	cache := &requestRateLimitCacheStub{acquireResult: &RateLimitAcquireResult{
+		Allowed:           false,
+		RejectedScope:     "user:1",
+		RejectedDimension: RateLimitDimensionInputTokens,
+		RetryAfter:        1500 * time.Millisecond,
+		States: []RateLimitScopeState{{
+			Requests:    RateLimitWindow{Limit: 60, Remaining: 40, Reset: 30 * time.Second},
+			InputTokens: RateLimitWindow{Limit: 1000, Remaining: 0, Reset: 2 * time.Second},
+		}},
+	}}
+	svc := newRequestRateLimitServiceForTest(cache)
+
+	reservation, err := svc.Acquire(context.Background(), &APIKey{ID: 1, User: &User{ID: 1, RateLimits: RequestRateLimits{RPM: 60, InputTPM: 1000}}}, nil)
+	require.ErrorIs(t, err, ErrRequestRateLimited)
+	require.Equal(t, 2, reservation.RetryAfterSeconds())
+	require.Equal(t, "Rate limit exceeded: user input tokens per minute, please retry after 2s", reservation.RejectMessage())
+
+	requests, requestsOK, tokens, tokensOK := reservation.MostRestrictive()
+	require.True(t, requestsOK)
+	require.Equal(t, 40, requests.Remaining)
+	require.True(t, tokensOK)
+	require.Equal(t, 1000, tokens.Limit)
+	require.Equal(t, 0, tokens.Remaining)
+}
+
+func TestRequestRateLimitService_Disabled(t *testing.T) {
+	cache := &requestRateLimitCacheStub{}
+	svc := NewRequestRateLimitService(cache, &config.Config{})
+
+	reservation, err := svc.Acquire(context.Background(), &APIKey{ID: 1, RateLimits: RequestRateLimits{RPM: 1}}, nil)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+	require.Nil(t, cache.acquireScopes)
+}
+
+func TestRequestRateLimitsUpdateApply(t *testing.T) {
+	rpm, outputTPM := 0, 500
+	limits, err := RequestRateLimitsUpdate{RPM: &rpm, OutputTPM: &outputTPM}.Apply(RequestRateLimits{RPM: 10, InputTPM: 2000})
+	require.NoError(t, err)
+	require.Equal(t, RequestRateLimits{InputTPM: 2000, OutputTPM: 500}, limits)
+
+	negative := -1
+	_, err = RequestRateLimitsUpdate{InputTPM: &negative}.Apply(RequestRateLimits{})
+	require.ErrorIs(t, err, ErrInvalidRateLimit)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// ResponseCacheMaxTTLSeconds 分组 / API Key 可配置的最大缓存有效期（7 天）
+const ResponseCacheMaxTTLSeconds = 7 * 24 * 3600
+
+var (
+	ErrInvalidResponseCacheTTL       = infraerrors.BadRequest("INVALID_RESPONSE_CACHE_TTL", "response cache ttl must be between 0 and 604800 seconds")
+	ErrInvalidResponseCacheCostRatio = infraerrors.BadRequest("INVALID_RESPONSE_CACHE_COST_RATIO", "response cache cost ratio must be between 0 and 1")
+)
+
+// ResponseCacheSettings 响应缓存配置（分组与 API Key 共用）
+type ResponseCacheSettings struct {
+	Enabled    bool `json:"enabled"`
+	TTLSeconds int  `json:"ttl_seconds"` // 0 表示使用全局默认值
+}
+
+// Validate 校验有效期范围
+func (s ResponseCacheSettings) Validate() error {
+	if s.TTLSeconds < 0 || s.TTLSeconds > ResponseCacheMaxTTLSeconds {
+		return ErrInvalidResponseCacheTTL
+	}
+	return nil
+}
+
+// ResponseCacheSettingsUpdate 部分更新响应缓存配置，nil 表示不修改
+type ResponseCacheSettingsUpdate struct {
+	Enabled    *bool
+	TTLSeconds *int
+}
+
+// Apply 将更新应用到现有配置上并校验结果
+func (u ResponseCacheSettingsUpdate) Apply(current ResponseCacheSettings) (ResponseCacheSettings, error) {
+	if u.Enabled != nil {
+		current.Enabled = *u.Enabled
+	}
+	if u.TTLSeconds != nil {
+		current.TTLSeconds = *u.TTLSeconds
+	}
+	return current, current.Validate()
+}
+
+// validateResponseCacheCostRatio 命中计费比例需在 [0, 1] 之间
+func validateResponseCacheCostRatio(ratio float64) error {
+	if ratio < 0 || ratio > 1 {
+		return ErrInvalidResponseCacheCostRatio
+	}
+	return nil
+}
+
+// ResponseCacheEntry 缓存的上游响应（按原样回放，保留平台原生格式）
+type ResponseCacheEntry struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+	Stream      bool   `json:"stream"`
+
+	// 原始请求的计费信息，命中时据此写入 usage_logs
+	Platform  string      `json:"platform"`
+	Model     string      `json:"model"`
+	AccountID int64       `json:"account_id"`
+	Usage     ClaudeUsage `json:"usage"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// ResponseCacheStore 响应缓存存储（Redis 或 PostgreSQL）
+type ResponseCacheStore interface {
+	// Get 返回未过期的缓存条目，不存在时返回 nil, nil
+	Get(ctx context.Context, key string) (*ResponseCacheEntry, error)
+	Set(ctx context.Context, key string, entry *ResponseCacheEntry, ttl time.Duration) error
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// responseCacheKeyVersion 缓存 key 版本号，规范化规则变化时递增使旧缓存失效
+const responseCacheKeyVersion = "v1"
+
+// responseCacheVolatileFields 不影响模型输出、计算缓存 key 前移除的请求字段
+var responseCacheVolatileFields = []string{
+	"stream",
+	"stream_options",
+	"metadata",
+	"user",
+	"store",
+	"prompt_cache_key",
+	"safety_identifier",
+}
+
+// ResponseCachePolicy 单个 API Key 生效的响应缓存策略
+type ResponseCachePolicy struct {
+	// Scope 缓存共享范围："key:<id>"（仅该 Key）或 "group:<id>"（分组内所有 Key）
+	Scope     string
+	TTL       time.Duration
+	CostRatio float64
+}
+
+// ResponseCacheLookup 一次可缓存请求的查询信息
+type ResponseCacheLookup struct {
+	Key      string
+	Platform string
+	Model    string
+	Stream   bool
+	Policy   ResponseCachePolicy
+}
+
+// ResponseCacheHitInput 缓存命中后记录用量所需的信息
+type ResponseCacheHitInput struct {
+	Lookup       *ResponseCacheLookup
+	Entry        *ResponseCacheEntry
+	APIKey       *APIKey
+	Subscription *UserSubscription
+	UserAgent    string
+	IPAddress    string
+	Duration     time.Duration
+}
+
+// ResponseCacheService 确定性请求（temperature=0）的精确响应缓存。
+//
+// 缓存 key 为规范化请求体（模型、消息、工具等）的 sha256，按平台原生格式原样回放
+// 非流式 JSON 与流式 SSE；命中以 BillingTypeResponseCache 写入 usage_logs。
+type ResponseCacheService struct {
+	cfg            *config.Config
+	store          ResponseCacheStore
+	gatewayService *GatewayService
+	accountRepo    AccountRepository
+}
+
+func NewResponseCacheService(cfg *config.Config, store ResponseCacheStore, gatewayService *GatewayService, accountRepo AccountRepository) *ResponseCacheService {
+	return &ResponseCacheService{
+		cfg:            cfg,
+		store:          store,
+		gatewayService: gatewayService,
+		accountRepo:    accountRepo,
+	}
+}
+
+// Policy 解析 API Key 生效的缓存策略：Key 上启用时优先，否则使用分组配置。
+// 命中计费比例始终取自分组（未绑定分组时免费）。
+func (s *ResponseCacheService) Policy(apiKey *APIKey) (ResponseCachePolicy, bool) {
+	if s == nil || s.store == nil || s.cfg == nil || !s.cfg.RespCache.Enabled || apiKey == nil {
+		return ResponseCachePolicy{}, false
+	}
+
+	var policy ResponseCachePolicy
+	var ttlSeconds int
+	switch {
+	case apiKey.ResponseCache.Enabled:
+		policy.Scope = fmt.Sprintf("key:%d", apiKey.ID)
+		ttlSeconds = apiKey.ResponseCache.TTLSeconds
+		if ttlSeconds <= 0 && apiKey.Group != nil {
+			ttlSeconds = apiKey.Group.ResponseCache.TTLSeconds
+		}
+	case apiKey.Group != nil && apiKey.Group.ResponseCache.Enabled:
+		policy.Scope = fmt.Sprintf("group:%d", apiKey.Group.ID)
+		ttlSeconds = apiKey.Group.ResponseCache.TTLSeconds
+	default:
+		return ResponseCachePolicy{}, false
+	}
+	if ttlSeconds <= 0 {
+		ttlSeconds = s.cfg.RespCache.DefaultTTLSeconds
+	}
+	policy.TTL = time.Duration(ttlSeconds) * time.Second
+	if apiKey.Group != nil {
+		policy.CostRatio = apiKey.Group.ResponseCacheCostRatio
+	}
+	return policy, true
+}
+
+// MaxEntryBytes 单条缓存响应体的最大字节数
+func (s *ResponseCacheService) MaxEntryBytes() int {
+	if s == nil || s.cfg == nil {
+		return 0
+	}
+	return s.cfg.RespCache.MaxEntryBytes
+}
+
+// Prepare 判断请求是否可缓存并计算缓存 key。
+// model 为 API Key 别名解析后的模型（为空时取自请求体或 Gemini 原生路径）；
+// Gemini 原生接口的流式标记来自 path。
+func (s *ResponseCacheService) Prepare(apiKey *APIKey, platform, path, model string, body []byte) (*ResponseCacheLookup, bool) {
+	policy, ok := s.Policy(apiKey)
+	if !ok {
+		return nil, false
+	}
+	key, model, stream, ok := buildResponseCacheKey(policy.Scope, platform, path, model, body)
+	if !ok {
+		return nil, false
+	}
+	return &ResponseCacheLookup{
+		Key:      key,
+		Platform: platform,
+		Model:    model,
+		Stream:   stream,
+		Policy:   policy,
+	}, true
+}
+
+// Get 查询缓存，存储错误按未命中处理
+func (s *ResponseCacheService) Get(ctx context.Context, lookup *ResponseCacheLookup) *ResponseCacheEntry {
+	if s == nil || lookup == nil {
+		return nil
+	}
+	entry, err := s.store.Get(ctx, lookup.Key)
+	if err != nil {
+		log.Printf("[ResponseCache] get failed: key=%s err=%v", lookup.Key, err)
+		return nil
+	}
+	if entry == nil || entry.Stream != lookup.Stream || len(entry.Body) == 0 {
+		return nil
+	}
+	return entry
+}
+
+// Save 缓存上游成功的完整响应；非 200、超出大小、格式不符或流未正常结束的响应不缓存
+func (s *ResponseCacheService) Save(ctx context.Context, lookup *ResponseCacheLookup, accountID int64, statusCode int, contentType string, body []byte) error {
+	if s == nil || lookup == nil || accountID <= 0 || statusCode != 200 || len(body) == 0 {
+		return nil
+	}
+	if len(body) > s.cfg.RespCache.MaxEntryBytes {
+		return nil
+	}
+	mediaType := strings.ToLower(contentType)
+	if lookup.Stream {
+		if !strings.Contains(mediaType, "text/event-stream") || !responseCacheStreamCompleted(body) {
+			return nil
+		}
+	} else if !strings.Contains(mediaType, "application/json") || !json.Valid(body) {
+		return nil
+	}
+
+	usage, ok := extractResponseCacheUsage(body, lookup.Stream)
+	if !ok {
+		return nil
+	}
+
+	entry := &ResponseCacheEntry{
+		StatusCode:  statusCode,
+		ContentType: contentType,
+		Body:        body,
+		Stream:      lookup.Stream,
+		Platform:    lookup.Platform,
+		Model:       lookup.Model,
+		AccountID:   accountID,
+		Usage:       usage,
+		CreatedAt:   time.Now().UTC(),
+	}
+	return s.store.Set(ctx, lookup.Key, entry, lookup.Policy.TTL)
+}
+
+// RecordHit 以响应缓存计费类型写入 usage_logs，并按分组配置的比例扣费
+func (s *ResponseCacheService) RecordHit(ctx context.Context, input *ResponseCacheHitInput) error {
+	if s == nil || s.gatewayService == nil || input == nil || input.Entry == nil || input.APIKey == nil {
+		return nil
+	}
+	entry := input.Entry
+
+	account, err := s.accountRepo.GetByID(ctx, entry.AccountID)
+	if err != nil || account == nil {
+		return fmt.Errorf("load origin account %d: %w", entry.AccountID, err)
+	}
+
+	firstTokenMs := int(input.Duration.Milliseconds())
+	return s.gatewayService.RecordUsage(ctx, &RecordUsageInput{
+		Result: &ForwardResult{
+			RequestID:    "rc_" + uuid.NewString(),
+			Usage:        entry.Usage,
+			Model:        entry.Model,
+			Stream:       entry.Stream,
+			Duration:     input.Duration,
+			FirstTokenMs: &firstTokenMs,
+		},
+		APIKey:                 input.APIKey,
+		User:                   input.APIKey.User,
+		Account:                account,
+		Subscription:           input.Subscription,
+		UserAgent:              input.UserAgent,
+		IPAddress:              input.IPAddress,
+		ResponseCacheHit:       true,
+		ResponseCacheCostRatio: input.Lookup.Policy.CostRatio,
+	})
+}
+
+// buildResponseCacheKey 规范化请求体并计算缓存 key。
+// 仅 temperature 显式为 0 的请求视为确定性请求；Gemini 读取 generationConfig.temperature。
+func buildResponseCacheKey(scope, platform, path, resolvedModel string, body []byte) (key, model string, stream, ok bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var req map[string]any
+	if err := dec.Decode(&req); err != nil || req == nil {
+		return "", "", false, false
+	}
+
+	endpoint := path
+	geminiModel, geminiAction, isGemini := parseGeminiModelAction(path)
+	if isGemini {
+		model = geminiModel
+		stream = geminiAction == "streamGenerateContent"
+		genConfig, _ := req["generationConfig"].(map[string]any)
+		if !isZeroTemperature(genConfig["temperature"]) {
+			return "", "", false, false
+		}
+	} else {
+		model, _ = req["model"].(string)
+		stream, _ = req["stream"].(bool)
+		if !isZeroTemperature(req["temperature"]) {
+			return "", "", false, false
+		}
+		endpoint = responseCacheEndpoint(path)
+	}
+	if resolvedModel != "" {
+		model = resolvedModel
+	}
+	if model == "" {
+		return "", "", false, false
+	}
+
+	for _, field := range responseCacheVolatileFields {
+		delete(req, field)
+	}
+	// encoding/json 按 key 排序输出 map，得到与字段顺序无关的规范化表示
+	canonical, err := json.Marshal(req)
+	if err != nil {
+		return "", "", false, false
+	}
+
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s|%s|%s|%s|%t|", responseCacheKeyVersion, scope, platform, endpoint, stream)
+	_, _ = h.Write(canonical)
+	return hex.EncodeToString(h.Sum(nil)), model, stream, true
+}
+
+// parseGeminiModelAction 解析 Gemini 原生路径 .../models/{model}:{action}
+func parseGeminiModelAction(path string) (model, action string, ok bool) {
+	idx := strings.LastIndex(path, "/models/")
+	if idx < 0 {
+		return "", "", false
+	}
+	rest := path[idx+len("/models/"):]
+	colon := strings.LastIndex(rest, ":")
+	if colon <= 0 {
+		return "", "", false
+	}
+	return rest[:colon], rest[colon+1:], true
+}
+
+// responseCacheEndpoint 去掉路由前缀（/v1、/antigravity/v1 等），相同协议共享缓存
+func responseCacheEndpoint(path string) string {
+	for _, suffix := range []string{"/messages", "/responses", "/chat/completions"} {
+		if strings.HasSuffix(path, suffix) {
+			return suffix
+		}
+	}
+	return path
+}
+
+func isZeroTemperature(v any) bool {
+	switch t := v.(type) {
+	case json.Number:
+		f, err := t.Float64()
+		return err == nil && f == 0
+	case float64:
+		return t == 0
+	default:
+		return false
+	}
+}
+
+// responseCacheStreamCompleted 流式响应必须包含各协议的正常结束标记才会缓存
+func responseCacheStreamCompleted(body []byte) bool {
+	for _, marker := range [][]byte{
+		[]byte(`"type":"message_stop"`),
+		[]byte(`"type":"response.completed"`),
+		[]byte("data: [DONE]"),
+	} {
+		if bytes.Contains(body, marker) {
+			return true
+		}
+	}
+	return responseCacheGeminiStreamCompleted(body)
+}
+
+// responseCacheGeminiStreamCompleted Gemini 没有独立的结束事件，且 finishReason 可能出现在中间分片，
+// 因此要求最后一个事件是完整 JSON，并同时带有非空 finishReason 与 usageMetadata
+func responseCacheGeminiStreamCompleted(body []byte) bool {
+	body = bytes.TrimRight(body, "\r\n")
+	last := body
+	if i := bytes.LastIndexByte(body, '\n'); i >= 0 {
+		last = body[i+1:]
+	}
+	payload, ok := bytes.CutPrefix(bytes.TrimRight(last, "\r"), []byte("data:"))
+	if !ok {
+		return false
+	}
+	payload = bytes.TrimSpace(payload)
+	if !gjson.ValidBytes(payload) {
+		return false
+	}
+	root := gjson.ParseBytes(payload)
+	// Code Assist（Gemini CLI / Antigravity）分片包装在 response 字段中
+	if r := root.Get("response"); r.IsObject() {
+		root = r
+	}
+	if !root.Get("usageMetadata").Exists() {
+		return false
+	}
+	reason := root.Get("candidates.0.finishReason").String()
+	return reason != "" && reason != "FINISH_REASON_UNSPECIFIED"
+}
+
+// extractResponseCacheUsage 从完整响应（流式先重组）中提取 token 用量，
+// 输入 token 统一为不含缓存读取的口径（与 ClaudeUsage 一致）
+func extractResponseCacheUsage(body []byte, stream bool) (ClaudeUsage, bool) {
+	if stream {
+		reassembled, ok := reassembleSSEResponse(body)
+		if !ok {
+			return ClaudeUsage{}, false
+		}
+		body = reassembled
+	}
+	root := gjson.ParseBytes(body)
+	// Responses 流式事件与 Code Assist（Gemini CLI / Antigravity）非流式响应包装在 response 字段中
+	if r := root.Get("response"); r.IsObject() && (r.Get("usage").Exists() || r.Get("usageMetadata").Exists()) {
+		root = r
+	}
+
+	if meta := root.Get("usageMetadata"); meta.Exists() {
+		cached := int(meta.Get("cachedContentTokenCount").Int())
+		return ClaudeUsage{
+			InputTokens:          int(meta.Get("promptTokenCount").Int()) - cached,
+			OutputTokens:         int(meta.Get("candidatesTokenCount").Int() + meta.Get("thoughtsTokenCount").Int()),
+			CacheReadInputTokens: cached,
+		}, true
+	}
+
+	usage := root.Get("usage")
+	if !usage.Exists() {
+		return ClaudeUsage{}, false
+	}
+	switch {
+	case usage.Get("prompt_tokens").Exists():
+		// Chat Completions
+		cached := int(usage.Get("prompt_tokens_details.cached_tokens").Int())
+		return ClaudeUsage{
+			InputTokens:          int(usage.Get("prompt_tokens").Int()) - cached,
+			OutputTokens:         int(usage.Get("completion_tokens").Int()),
+			CacheReadInputTokens: cached,
+		}, true
+	case usage.Get("input_tokens_details").Exists():
+		// OpenAI Responses：input_tokens 包含缓存命中部分
+		cached := int(usage.Get("input_tokens_details.cached_tokens").Int())
+		return ClaudeUsage{
+			InputTokens:          int(usage.Get("input_tokens").Int()) - cached,
+			OutputTokens:         int(usage.Get("output_tokens").Int()),
+			CacheReadInputTokens: cached,
+		}, true
+	default:
+		// Anthropic Messages
+		return ClaudeUsage{
+			InputTokens:              int(usage.Get("input_tokens").Int()),
+			OutputTokens:             int(usage.Get("output_tokens").Int()),
+			CacheCreationInputTokens: int(usage.Get("cache_creation_input_tokens").Int()),
+			CacheReadInputTokens:     int(usage.Get("cache_read_input_tokens").Int()),
+		}, true
+	}
+}
@@ -0,0 +1,248 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type responseCacheStoreStub struct {
+	entries map[string]*ResponseCacheEntry
+	ttls    map[string]time.Duration
+}
+
+func newResponseCacheStoreStub() *responseCacheStoreStub {
+	return &responseCacheStoreStub{entries: map[string]*ResponseCacheEntry{}, ttls: map[string]time.Duration{}}
+}
+
+func (s *responseCacheStoreStub) Get(ctx context.Context, key string) (*ResponseCacheEntry, error) {
+	return s.entries[key], nil
+}
+
+func (s *responseCacheStoreStub) Set(ctx context.Context, key string, entry *ResponseCacheEntry, ttl time.Duration) error {
+	s.entries[key] = entry
+	s.ttls[key] = ttl
+	return nil
+}
+
+func newTestResponseCacheService(store ResponseCacheStore) *ResponseCacheService {
+	cfg := &config.Config{RespCache: config.ResponseCacheConfig{
+		Enabled:           true,
+		Backend:           config.ResponseCacheBackendRedis,
+		DefaultTTLSeconds: 600,
+		MaxEntryBytes:     1 << 20,
+	}}
+	return NewResponseCacheService(cfg, store, nil, nil)
+}
+
+func TestBuildResponseCacheKey_Canonicalization(t *testing.T) {
+	a := []byte(`{"model":"claude-sonnet-4","temperature":0,"messages":[{"role":"user","content":"hi"}],"metadata":{"user_id":"u1"}}`)
+	b := []byte(`{"messages":[{"role":"user","content":"hi"}],"temperature":0,"model":"claude-sonnet-4","metadata":{"user_id":"u2"}}`)
+
+	keyA, model, stream, ok := buildResponseCacheKey("group:1", PlatformAnthropic, "/v1/messages", "", a)
+	require.True(t, ok)
+	require.Equal(t, "claude-sonnet-4", model)
+	require.False(t, stream)
+
+	keyB, _, _, ok := buildResponseCacheKey("group:1", PlatformAnthropic, "/antigravity/v1/messages", "", b)
+	require.True(t, ok)
+	require.Equal(t, keyA, keyB, "field order, metadata and route prefix must not affect the key")
+
+	keyOtherScope, _, _, _ := buildResponseCacheKey("key:9", PlatformAnthropic, "/v1/messages", "", a)
+	require.NotEqual(t, keyA, keyOtherScope)
+
+	streamBody := []byte(`{"model":"claude-sonnet-4","temperature":0,"stream":true,"messages":[{"role":"user","content":"hi"}]}`)
+	keyStream, _, stream, ok := buildResponseCacheKey("group:1", PlatformAnthropic, "/v1/messages", "", streamBody)
+	require.True(t, ok)
+	require.True(t, stream)
+	require.NotEqual(t, keyA, keyStream, "streaming and non-streaming responses are cached separately")
+}
+
+func TestBuildResponseCacheKey_RequiresZeroTemperature(t *testing.T) {
+	for _, body := range []string{
+		`{"model":"gpt-4o","messages":[]}`,
+		`{"model":"gpt-4o","temperature":0.7,"messages":[]}`,
+		`{"model":"gpt-4o","temperature":"0","messages":[]}`,
+		`not json`,
+	} {
+		_, _, _, ok := buildResponseCacheKey("group:1", PlatformOpenAI, "/v1/responses", "", []byte(body))
+		require.False(t, ok, body)
+	}
+
+	_, _, _, ok := buildResponseCacheKey("group:1", PlatformOpenAI, "/v1/responses", "", []byte(`{"model":"gpt-4o","temperature":0.0,"input":"hi"}`))
+	require.True(t, ok)
+}
+
+func TestBuildResponseCacheKey_GeminiNativePath(t *testing.T) {
+	body := []byte(`{"contents":[{"role":"user","parts":[{"text":"hi"}]}],"generationConfig":{"temperature":0}}`)
+
+	key, model, stream, ok := buildResponseCacheKey("group:2", PlatformGemini, "/v1beta/models/gemini-2.5-flash:streamGenerateContent", "gemini-2.5-flash", body)
+	require.True(t, ok)
+	require.Equal(t, "gemini-2.5-flash", model)
+	require.True(t, stream)
+
+	other, _, stream, ok := buildResponseCacheKey("group:2", PlatformGemini, "/v1beta/models/gemini-2.5-flash:generateContent", "gemini-2.5-flash", body)
+	require.True(t, ok)
+	require.False(t, stream)
+	require.NotEqual(t, key, other)
+
+	_, _, _, ok = buildResponseCacheKey("group:2", PlatformGemini, "/v1beta/models/gemini-2.5-flash:generateContent", "", []byte(`{"contents":[]}`))
+	require.False(t, ok)
+}
+
+func TestResponseCacheService_Policy(t *testing.T) {
+	svc := newTestResponseCacheService(newResponseCacheStoreStub())
+	group := &Group{ID: 3, ResponseCache: ResponseCacheSettings{Enabled: true, TTLSeconds: 120}, ResponseCacheCostRatio: 0.1}
+
+	policy, ok := svc.Policy(&APIKey{ID: 7, Group: group})
+	require.True(t, ok)
+	require.Equal(t, "group:3", policy.Scope)
+	require.Equal(t, 120*time.Second, policy.TTL)
+	require.Equal(t, 0.1, policy.CostRatio)
+
+	// Key 上启用时优先，未配置 TTL 时回退到分组
+	policy, ok = svc.Policy(&APIKey{ID: 7, Group: group, ResponseCache: ResponseCacheSettings{Enabled: true}})
+	require.True(t, ok)
+	require.Equal(t, "key:7", policy.Scope)
+	require.Equal(t, 120*time.Second, policy.TTL)
+
+	// 均未配置 TTL 时使用全局默认值，未绑定分组时免费
+	policy, ok = svc.Policy(&APIKey{ID: 8, ResponseCache: ResponseCacheSettings{Enabled: true}})
+	require.True(t, ok)
+	require.Equal(t, 600*time.Second, policy.TTL)
+	require.Zero(t, policy.CostRatio)
+
+	_, ok = svc.Policy(&APIKey{ID: 9, Group: &Group{ID: 4}})
+	require.False(t, ok)
+
+	svc.cfg.RespCache.Enabled = false
+	_, ok = svc.Policy(&APIKey{ID: 7, Group: group})
+	require.False(t, ok)
+}
+
+func TestResponseCacheService_SaveAndGet(t *testing.T) {
+	store := newResponseCacheStoreStub()
+	svc := newTestResponseCacheService(store)
+	apiKey := &APIKey{ID: 1, Group: &Group{ID: 1, ResponseCache: ResponseCacheSettings{Enabled: true, TTLSeconds: 30}}}
+	ctx := context.Background()
+
+	body := []byte(`{"model":"claude-sonnet-4","temperature":0,"stream":true,"messages":[{"role":"user","content":"hi"}]}`)
+	lookup, ok := svc.Prepare(apiKey, PlatformAnthropic, "/v1/messages", "", body)
+	require.True(t, ok)
+
+	incomplete := strings.Join([]string{
+		`data: {"type":"message_start","message":{"id":"m","usage":{"input_tokens":5}}}`,
+		``,
+	}, "\n")
+	require.NoError(t, svc.Save(ctx, lookup, 11, 200, "text/event-stream", []byte(incomplete)))
+	require.Nil(t, svc.Get(ctx, lookup), "interrupted streams must not be cached")
+
+	complete := incomplete + "\n" + strings.Join([]string{
+		`data: {"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":3}}`,
+		``,
+		`data: {"type":"message_stop"}`,
+		``,
+	}, "\n")
+	require.NoError(t, svc.Save(ctx, lookup, 11, 500, "text/event-stream", []byte(complete)))
+	require.Nil(t, svc.Get(ctx, lookup), "error responses must not be cached")
+
+	require.NoError(t, svc.Save(ctx, lookup, 11, 200, "text/event-stream; charset=utf-8", []byte(complete)))
+	entry := svc.Get(ctx, lookup)
+	require.NotNil(t, entry)
+	require.Equal(t, int64(11), entry.AccountID)
+	require.Equal(t, "claude-sonnet-4", entry.Model)
+	require.Equal(t, ClaudeUsage{InputTokens: 5, OutputTokens: 3}, entry.Usage)
+	require.Equal(t, 30*time.Second, store.ttls[lookup.Key])
+
+	svc.cfg.RespCache.MaxEntryBytes = 10
+	other, _ := svc.Prepare(apiKey, PlatformAnthropic, "/v1/messages", "", []byte(`{"model":"claude-sonnet-4","temperature":0,"messages":[]}`))
+	require.NoError(t, svc.Save(ctx, other, 11, 200, "application/json", []byte(`{"usage":{"input_tokens":1}}`)))
+	require.Nil(t, svc.Get(ctx, other), "oversized responses must not be cached")
+}
+
+func TestResponseCacheService_SaveGeminiStreamRequiresFinalChunk(t *testing.T) {
+	store := newResponseCacheStoreStub()
+	svc := newTestResponseCacheService(store)
+	apiKey := &APIKey{ID: 1, Group: &Group{ID: 1, ResponseCache: ResponseCacheSettings{Enabled: true, TTLSeconds: 30}}}
+	ctx := context.Background()
+
+	body := []byte(`{"contents":[{"role":"user","parts":[{"text":"hi"}]}],"generationConfig":{"temperature":0}}`)
+	lookup, ok := svc.Prepare(apiKey, PlatformGemini, "/v1beta/models/gemini-2.5-flash:streamGenerateContent", "gemini-2.5-flash", body)
+	require.True(t, ok)
+	require.True(t, lookup.Stream)
+
+	// 中间分片带有 finishReason，但流在最终分片之前被截断
+	truncated := strings.Join([]string{
+		`data: {"candidates":[{"content":{"parts":[{"text":"a"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":1}}`,
+		``,
+		`data: {"candidates":[{"content":{"parts":[{"text":"b"}]}}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":2}}`,
+		``,
+		`data: {"candidates":[{"content":{"parts":[{"text":"c"`,
+	}, "\n")
+	require.NoError(t, svc.Save(ctx, lookup, 11, 200, "text/event-stream", []byte(truncated)))
+	require.Nil(t, svc.Get(ctx, lookup), "truncated Gemini streams must not be cached")
+
+	noUsage := strings.Join([]string{
+		`data: {"candidates":[{"content":{"parts":[{"text":"a"}]}}]}`,
+		``,
+		`data: {"candidates":[{"content":{"parts":[{"text":"b"}]},"finishReason":"STOP"}]}`,
+		``,
+	}, "\n")
+	require.NoError(t, svc.Save(ctx, lookup, 11, 200, "text/event-stream", []byte(noUsage)))
+	require.Nil(t, svc.Get(ctx, lookup), "final chunk must carry usageMetadata")
+
+	complete := strings.Join([]string{
+		`data: {"candidates":[{"content":{"parts":[{"text":"a"}]}}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":1}}`,
+		``,
+		`data: {"candidates":[{"content":{"parts":[{"text":"b"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":2}}`,
+		``,
+	}, "\n")
+	require.NoError(t, svc.Save(ctx, lookup, 11, 200, "text/event-stream", []byte(complete)))
+	entry := svc.Get(ctx, lookup)
+	require.NotNil(t, entry)
+	require.Equal(t, ClaudeUsage{InputTokens: 7, OutputTokens: 2}, entry.Usage)
+
+	// Code Assist（Gemini CLI / Antigravity）分片包装在 response 字段中
+	wrapped := `data: {"response":{"candidates":[{"content":{"parts":[{"text":"b"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":2}}}` + "\r\n\r\n"
+	require.True(t, responseCacheStreamCompleted([]byte(wrapped)))
+}
+
+func TestExtractResponseCacheUsage(t *testing.T) {
+	usage, ok := extractResponseCacheUsage([]byte(`{"usage":{"input_tokens":10,"output_tokens":2,"cache_read_input_tokens":4}}`), false)
+	require.True(t, ok)
+	require.Equal(t, ClaudeUsage{InputTokens: 10, OutputTokens: 2, CacheReadInputTokens: 4}, usage)
+
+	// OpenAI Responses 的 input_tokens 包含缓存命中部分
+	usage, ok = extractResponseCacheUsage([]byte(`{"usage":{"input_tokens":10,"output_tokens":2,"input_tokens_details":{"cached_tokens":6}}}`), false)
+	require.True(t, ok)
+	require.Equal(t, ClaudeUsage{InputTokens: 4, OutputTokens: 2, CacheReadInputTokens: 6}, usage)
+
+	usage, ok = extractResponseCacheUsage([]byte(`{"usage":{"prompt_tokens":8,"completion_tokens":1}}`), false)
+	require.True(t, ok)
+	require.Equal(t, ClaudeUsage{InputTokens: 8, OutputTokens: 1}, usage)
+
+	gemini := strings.Join([]string{
+		`data: {"candidates":[{"content":{"role":"model","parts":[{"text":"a"}]}}]}`,
+		``,
+		`data: {"candidates":[{"content":{"parts":[{"text":"b"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":7,"candidatesTokenCount":2,"thoughtsTokenCount":1}}`,
+		``,
+	}, "\n")
+	usage, ok = extractResponseCacheUsage([]byte(gemini), true)
+	require.True(t, ok)
+	require.Equal(t, ClaudeUsage{InputTokens: 7, OutputTokens: 3}, usage)
+
+	_, ok = extractResponseCacheUsage([]byte(`{"id":"x"}`), false)
+	require.False(t, ok)
+}
+
+func TestCostBreakdownScale(t *testing.T) {
+	cost := &CostBreakdown{InputCost: 1, OutputCost: 2, TotalCost: 3, ActualCost: 6}
+	require.Equal(t, &CostBreakdown{}, cost.Scale(0))
+	require.Equal(t, &CostBreakdown{InputCost: 0.5, OutputCost: 1, TotalCost: 1.5, ActualCost: 3}, cost.Scale(0.5))
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+var ErrSecretEncryptionDisabled = errors.New("secret encryption is disabled (secret_encryption.enabled)")
+
+// SecretRotationBatch is the outcome of re-encrypting one batch of rows.
+type SecretRotationBatch struct {
+	LastID  int64 // largest row id scanned in this batch
+	Scanned int
+	Updated int
+}
+
+// SecretRotationResult summarizes a full re-encryption pass.
+type SecretRotationResult struct {
+	Accounts int
+	Proxies  int
+}
+
+// SecretRotationRepository re-encrypts stored secrets with the active master key.
+type SecretRotationRepository interface {
+	// ReencryptAccounts migrates credentials of accounts with id > afterID that are
+	// plaintext or wrapped by a retired key. Rows changed concurrently are skipped.
+	ReencryptAccounts(ctx context.Context, afterID int64, limit int) (SecretRotationBatch, error)
+	// ReencryptProxies does the same for proxy passwords.
+	ReencryptProxies(ctx context.Context, afterID int64, limit int) (SecretRotationBatch, error)
+	// RequestSchedulerRebuild re-seals the scheduler snapshot with the active key.
+	RequestSchedulerRebuild(ctx context.Context) error
+}
+
+// SecretRotationService periodically migrates plaintext secrets and secrets wrapped by
+// retired master keys to the active key. The `reencrypt-secrets` subcommand runs one pass.
+type SecretRotationService struct {
+	repo      SecretRotationRepository
+	enabled   bool
+	interval  time.Duration
+	batchSize int
+	stopCh    chan struct{}
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewSecretRotationService(repo SecretRotationRepository, cfg *config.Config) *SecretRotationService {
+	return &SecretRotationService{
+		repo:      repo,
+		enabled:   cfg.SecretEnc.Enabled,
+		interval:  time.Duration(cfg.SecretEnc.ReencryptIntervalMinutes) * time.Minute,
+		batchSize: cfg.SecretEnc.ReencryptBatchSize,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (s *SecretRotationService) Start() {
+	if s == nil || s.repo == nil || !s.enabled || s.interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.runOnce(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				s.runOnce(ctx)
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+	// Abort an in-flight pass on shutdown instead of waiting for it to finish.
+	go func() {
+		<-s.stopCh
+		cancel()
+	}()
+}
+
+func (s *SecretRotationService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+// Run re-encrypts all accounts and proxies that are not yet under the active key.
+func (s *SecretRotationService) Run(ctx context.Context) (SecretRotationResult, error) {
+	var result SecretRotationResult
+	if !s.enabled {
+		return result, ErrSecretEncryptionDisabled
+	}
+
+	var err error
+	if result.Accounts, err = s.reencryptAll(ctx, s.repo.ReencryptAccounts); err != nil {
+		return result, err
+	}
+	if result.Proxies, err = s.reencryptAll(ctx, s.repo.ReencryptProxies); err != nil {
+		return result, err
+	}
+	if result.Accounts+result.Proxies > 0 {
+		if err := s.repo.RequestSchedulerRebuild(ctx); err != nil {
+			log.Printf("[SecretRotation] Request scheduler rebuild failed: %v", err)
+		}
+	}
+	return result, nil
+}
+
+func (s *SecretRotationService) reencryptAll(ctx context.Context, batchFn func(context.Context, int64, int) (SecretRotationBatch, error)) (int, error) {
+	var afterID int64
+	updated := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+		batch, err := batchFn(ctx, afterID, s.batchSize)
+		if err != nil {
+			return updated, err
+		}
+		updated += batch.Updated
+		if batch.Scanned < s.batchSize {
+			return updated, nil
+		}
+		afterID = batch.LastID
+	}
+}
+
+func (s *SecretRotationService) runOnce(ctx context.Context) {
+	result, err := s.Run(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Printf("[SecretRotation] Re-encrypt secrets failed: %v", err)
+		}
+		return
+	}
+	if result.Accounts+result.Proxies > 0 {
+		log.Printf("[SecretRotation] Re-encrypted %d account credentials and %d proxy passwords", result.Accounts, result.Proxies)
+	}
+}
@@ -0,0 +1,70 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type secretRotationRepoStub struct {
+	accountIDs   []int64
+	proxyIDs     []int64
+	afterIDs     []int64
+	rebuildCalls int
+}
+
+func (s *secretRotationRepoStub) batch(ids []int64, afterID int64, limit int) SecretRotationBatch {
+	s.afterIDs = append(s.afterIDs, afterID)
+	var batch SecretRotationBatch
+	for _, id := range ids {
+		if id <= afterID {
+			continue
+		}
+		if batch.Scanned == limit {
+			break
+		}
+		batch.Scanned++
+		batch.Updated++
+		batch.LastID = id
+	}
+	return batch
+}
+
+func (s *secretRotationRepoStub) ReencryptAccounts(ctx context.Context, afterID int64, limit int) (SecretRotationBatch, error) {
+	return s.batch(s.accountIDs, afterID, limit), nil
+}
+
+func (s *secretRotationRepoStub) ReencryptProxies(ctx context.Context, afterID int64, limit int) (SecretRotationBatch, error) {
+	return s.batch(s.proxyIDs, afterID, limit), nil
+}
+
+func (s *secretRotationRepoStub) RequestSchedulerRebuild(ctx context.Context) error {
+	s.rebuildCalls++
+	return nil
+}
+
+func TestSecretRotationService_RunPagesThroughAllRows(t *testing.T) {
+	repo := &secretRotationRepoStub{accountIDs: []int64{1, 2, 5, 9}, proxyIDs: []int64{3}}
+	cfg := &config.Config{SecretEnc: config.SecretEncryptionConfig{Enabled: true, ReencryptBatchSize: 2}}
+	svc := NewSecretRotationService(repo, cfg)
+
+	result, err := svc.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, SecretRotationResult{Accounts: 4, Proxies: 1}, result)
+	require.Equal(t, []int64{0, 2, 9, 0}, repo.afterIDs)
+	require.Equal(t, 1, repo.rebuildCalls)
+}
+
+func TestSecretRotationService_RunDisabled(t *testing.T) {
+	repo := &secretRotationRepoStub{accountIDs: []int64{1}}
+	svc := NewSecretRotationService(repo, &config.Config{SecretEnc: config.SecretEncryptionConfig{ReencryptBatchSize: 10}})
+
+	_, err := svc.Run(context.Background())
+	require.ErrorIs(t, err, ErrSecretEncryptionDisabled)
+	require.Empty(t, repo.afterIDs)
+	require.Zero(t, repo.rebuildCalls)
+}
@@ -0,0 +1,105 @@
+// Package servicetest 提供 service 层仓储接口的内存实现，供各包单元测试共享。
+package servicetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// AdminAPIKeyRepo 内存版 service.AdminAPIKeyRepository，按 Key 摘要存储记录
+type AdminAPIKeyRepo struct {
+	ByHash  map[string]*service.AdminAPIKey
+	Touched int
+}
+
+// NewAdminAPIKeyRepo 创建空的内存 Admin API Key 仓储
+func NewAdminAPIKeyRepo() *AdminAPIKeyRepo {
+	return &AdminAPIKeyRepo{ByHash: map[string]*service.AdminAPIKey{}}
+}
+
+// Add 以明文 Key 的摘要预置一条记录
+func (r *AdminAPIKeyRepo) Add(plaintext string, key *service.AdminAPIKey) {
+	r.ByHash[service.HashAdminAPIKey(plaintext)] = key
+}
+
+func (r *AdminAPIKeyRepo) Create(_ context.Context, key *service.AdminAPIKey, keyHash string) error {
+	key.ID = int64(len(r.ByHash) + 1)
+	cp := *key
+	r.ByHash[keyHash] = &cp
+	return nil
+}
+
+func (r *AdminAPIKeyRepo) GetByHash(_ context.Context, keyHash string) (*service.AdminAPIKey, error) {
+	if k, ok := r.ByHash[keyHash]; ok {
+		cp := *k
+		return &cp, nil
+	}
+	return nil, service.ErrAdminAPIKeyNotFound
+}
+
+func (r *AdminAPIKeyRepo) List(context.Context) ([]service.AdminAPIKey, error) {
+	out := make([]service.AdminAPIKey, 0, len(r.ByHash))
+	for _, k := range r.ByHash {
+		out = append(out, *k)
+	}
+	return out, nil
+}
+
+func (r *AdminAPIKeyRepo) Delete(_ context.Context, id int64) error {
+	for h, k := range r.ByHash {
+		if k.ID == id {
+			delete(r.ByHash, h)
+			return nil
+		}
+	}
+	return service.ErrAdminAPIKeyNotFound
+}
+
+func (r *AdminAPIKeyRepo) TouchLastUsed(_ context.Context, id int64, at time.Time) error {
+	r.Touched++
+	for _, k := range r.ByHash {
+		if k.ID == id {
+			k.LastUsedAt = &at
+		}
+	}
+	return nil
+}
+
+// UserRepo 内存版 service.UserRepository，仅实现按 ID 查询与首个超级管理员查询
+type UserRepo struct {
+	service.UserRepository
+	Users map[int64]*service.User
+}
+
+// NewUserRepo 以给定用户创建内存用户仓储
+func NewUserRepo(users ...*service.User) *UserRepo {
+	r := &UserRepo{Users: map[int64]*service.User{}}
+	for _, u := range users {
+		r.Users[u.ID] = u
+	}
+	return r
+}
+
+func (r *UserRepo) GetByID(_ context.Context, id int64) (*service.User, error) {
+	if u, ok := r.Users[id]; ok {
+		cp := *u
+		return &cp, nil
+	}
+	return nil, service.ErrUserNotFound
+}
+
+func (r *UserRepo) GetFirstAdmin(context.Context) (*service.User, error) {
+	var first *service.User
+	for _, u := range r.Users {
+		if u.Role == service.RoleAdmin && u.IsActive() && (first == nil || u.ID < first.ID) {
+			first = u
+		}
+	}
+	if first == nil {
+		return nil, service.ErrUserNotFound
+	}
+	cp := *first
+	return &cp, nil
+}
@@ -2,8 +2,6 @@ package service
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -253,6 +251,28 @@ func (s *SettingService) UpdateSettings(ctx context.Context, settings *SystemSet
 	updates[SettingKeyCreemRateMultiplier] = strconv.FormatFloat(settings.CreemRateMultiplier, 'f', 2, 64)
 	updates[SettingKeyCreemSuccessURL] = settings.CreemSuccessURL
 
+	// Stripe Checkout
+	updates[SettingKeyStripeEnabled] = strconv.FormatBool(settings.StripeEnabled)
+	if settings.StripeSecretKey != "" {
+		updates[SettingKeyStripeSecretKey] = settings.StripeSecretKey
+	}
+	if settings.StripeWebhookSecret != "" {
+		updates[SettingKeyStripeWebhookSecret] = settings.StripeWebhookSecret
+	}
+	updates[SettingKeyStripeCurrency] = strings.ToLower(strings.TrimSpace(settings.StripeCurrency))
+	updates[SettingKeyStripeRateMultiplier] = strconv.FormatFloat(settings.StripeRateMultiplier, 'f', 4, 64)
+
+	// EPay 易支付
+	updates[SettingKeyEPayEnabled] = strconv.FormatBool(settings.EPayEnabled)
+	updates[SettingKeyEPayGatewayURL] = strings.TrimRight(strings.TrimSpace(settings.EPayGatewayURL), "/")
+	updates[SettingKeyEPayMerchantID] = strings.TrimSpace(settings.EPayMerchantID)
+	if settings.EPayMerchantKey != "" {
+		updates[SettingKeyEPayMerchantKey] = settings.EPayMerchantKey
+	}
+	updates[SettingKeyEPayPayTypes] = settings.EPayPayTypes
+	updates[SettingKeyEPayCurrency] = strings.ToUpper(strings.TrimSpace(settings.EPayCurrency))
+	updates[SettingKeyEPayRateMultiplier] = strconv.FormatFloat(settings.EPayRateMultiplier, 'f', 4, 64)
+
 	err := s.settingRepo.SetMultiple(ctx, updates)
 	if err == nil && s.onUpdate != nil {
 		s.onUpdate() // Invalidate cache after settings update
@@ -326,6 +346,15 @@ func (s *SettingService) GetSiteName(ctx context.Context) string {
 	return value
 }
 
+// GetSiteLogo 获取站点Logo（data URL），未设置时返回空字符串
+func (s *SettingService) GetSiteLogo(ctx context.Context) string {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeySiteLogo)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
 // GetDefaultConcurrency 获取默认并发量
 func (s *SettingService) GetDefaultConcurrency(ctx context.Context) int {
 	value, err := s.settingRepo.GetValue(ctx, SettingKeyDefaultConcurrency)
@@ -523,6 +552,26 @@ func (s *SettingService) parseSettings(settings map[string]string) *SystemSettin
 		result.CreemRateMultiplier = 10.0 // 默认 1:10
 	}
 
+	// Stripe / 易支付设置
+	stripeCfg := parseStripeConfig(settings)
+	result.StripeEnabled = stripeCfg.Enabled
+	result.StripeSecretKey = stripeCfg.SecretKey
+	result.StripeSecretKeyConfigured = stripeCfg.SecretKey != ""
+	result.StripeWebhookSecret = stripeCfg.WebhookSecret
+	result.StripeWebhookSecretConfigured = stripeCfg.WebhookSecret != ""
+	result.StripeCurrency = stripeCfg.Currency
+	result.StripeRateMultiplier = stripeCfg.RateMultiplier
+
+	epayCfg := parseEPayConfig(settings)
+	result.EPayEnabled = epayCfg.Enabled
+	result.EPayGatewayURL = epayCfg.GatewayURL
+	result.EPayMerchantID = epayCfg.MerchantID
+	result.EPayMerchantKey = epayCfg.MerchantKey
+	result.EPayMerchantKeyConfigured = epayCfg.MerchantKey != ""
+	result.EPayPayTypes = strings.Join(epayCfg.PayTypes, ",")
+	result.EPayCurrency = epayCfg.Currency
+	result.EPayRateMultiplier = epayCfg.RateMultiplier
+
 	return result
 }
 
@@ -580,66 +629,6 @@ func (s *SettingService) GetIdentityPatchPrompt(ctx context.Context) string {
 	return value
 }
 
-// GenerateAdminAPIKey 生成新的管理员 API Key
-func (s *SettingService) GenerateAdminAPIKey(ctx context.Context) (string, error) {
-	// 生成 32 字节随机数 = 64 位十六进制字符
-	bytes := make([]byte, 32)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", fmt.Errorf("generate random bytes: %w", err)
-	}
-
-	key := AdminAPIKeyPrefix + hex.EncodeToString(bytes)
-
-	// 存储到 settings 表
-	if err := s.settingRepo.Set(ctx, SettingKeyAdminAPIKey, key); err != nil {
-		return "", fmt.Errorf("save admin api key: %w", err)
-	}
-
-	return key, nil
-}
-
-// GetAdminAPIKeyStatus 获取管理员 API Key 状态
-// 返回脱敏的 key、是否存在、错误
-func (s *SettingService) GetAdminAPIKeyStatus(ctx context.Context) (maskedKey string, exists bool, err error) {
-	key, err := s.settingRepo.GetValue(ctx, SettingKeyAdminAPIKey)
-	if err != nil {
-		if errors.Is(err, ErrSettingNotFound) {
-			return "", false, nil
-		}
-		return "", false, err
-	}
-	if key == "" {
-		return "", false, nil
-	}
-
-	// 脱敏：显示前 10 位和后 4 位
-	if len(key) > 14 {
-		maskedKey = key[:10] + "..." + key[len(key)-4:]
-	} else {
-		maskedKey = key
-	}
-
-	return maskedKey, true, nil
-}
-
-// GetAdminAPIKey 获取完整的管理员 API Key（仅供内部验证使用）
-// 如果未配置返回空字符串和 nil 错误，只有数据库错误时才返回 error
-func (s *SettingService) GetAdminAPIKey(ctx context.Context) (string, error) {
-	key, err := s.settingRepo.GetValue(ctx, SettingKeyAdminAPIKey)
-	if err != nil {
-		if errors.Is(err, ErrSettingNotFound) {
-			return "", nil // 未配置，返回空字符串
-		}
-		return "", err // 数据库错误
-	}
-	return key, nil
-}
-
-// DeleteAdminAPIKey 删除管理员 API Key
-func (s *SettingService) DeleteAdminAPIKey(ctx context.Context) error {
-	return s.settingRepo.Delete(ctx, SettingKeyAdminAPIKey)
-}
-
 // IsModelFallbackEnabled 检查是否启用模型兜底机制
 func (s *SettingService) IsModelFallbackEnabled(ctx context.Context) bool {
 	value, err := s.settingRepo.GetValue(ctx, SettingKeyEnableModelFallback)
@@ -852,6 +841,133 @@ func (s *SettingService) SetStreamTimeoutSettings(ctx context.Context, settings
 	return s.settingRepo.Set(ctx, SettingKeyStreamTimeoutSettings, string(data))
 }
 
+// GetInvoiceSettings 获取发票配置
+func (s *SettingService) GetInvoiceSettings(ctx context.Context) (*InvoiceSettings, error) {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyInvoiceSettings)
+	if err != nil {
+		if errors.Is(err, ErrSettingNotFound) {
+			return DefaultInvoiceSettings(), nil
+		}
+		return nil, fmt.Errorf("get invoice settings: %w", err)
+	}
+	if value == "" {
+		return DefaultInvoiceSettings(), nil
+	}
+
+	settings := DefaultInvoiceSettings()
+	if err := json.Unmarshal([]byte(value), settings); err != nil {
+		return DefaultInvoiceSettings(), nil
+	}
+	normalizeInvoiceSettings(settings)
+	return settings, nil
+}
+
+// SetInvoiceSettings 设置发票配置
+func (s *SettingService) SetInvoiceSettings(ctx context.Context, settings *InvoiceSettings) error {
+	if settings == nil {
+		return fmt.Errorf("settings cannot be nil")
+	}
+	settings.NumberPrefix = strings.TrimSpace(settings.NumberPrefix)
+	settings.Currency = strings.ToUpper(strings.TrimSpace(settings.Currency))
+	if len(settings.NumberPrefix) > 20 {
+		return fmt.Errorf("number_prefix must be at most 20 characters")
+	}
+	if settings.NextNumber < 1 {
+		return fmt.Errorf("next_number must be at least 1")
+	}
+	if settings.NumberPadding < 1 || settings.NumberPadding > 12 {
+		return fmt.Errorf("number_padding must be between 1-12")
+	}
+	if settings.TaxRate < 0 || settings.TaxRate > 100 {
+		return fmt.Errorf("tax_rate must be between 0-100")
+	}
+	if len(settings.Currency) != 3 {
+		return fmt.Errorf("currency must be a 3-letter code")
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("marshal invoice settings: %w", err)
+	}
+	return s.settingRepo.Set(ctx, SettingKeyInvoiceSettings, string(data))
+}
+
+// normalizeInvoiceSettings 修正存储中的非法值
+func normalizeInvoiceSettings(settings *InvoiceSettings) {
+	defaults := DefaultInvoiceSettings()
+	if settings.NextNumber < 1 {
+		settings.NextNumber = defaults.NextNumber
+	}
+	if settings.NumberPadding < 1 || settings.NumberPadding > 12 {
+		settings.NumberPadding = defaults.NumberPadding
+	}
+	if settings.TaxRate < 0 || settings.TaxRate > 100 {
+		settings.TaxRate = 0
+	}
+	if len(settings.Currency) != 3 {
+		settings.Currency = defaults.Currency
+	}
+	if strings.TrimSpace(settings.TaxLabel) == "" {
+		settings.TaxLabel = defaults.TaxLabel
+	}
+}
+
+// GetUnknownModelPricingSettings 获取未知模型计费策略
+func (s *SettingService) GetUnknownModelPricingSettings(ctx context.Context) (*UnknownModelPricingSettings, error) {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyUnknownModelPricing)
+	if err != nil {
+		if errors.Is(err, ErrSettingNotFound) {
+			return DefaultUnknownModelPricingSettings(), nil
+		}
+		return nil, fmt.Errorf("get unknown model pricing settings: %w", err)
+	}
+	if value == "" {
+		return DefaultUnknownModelPricingSettings(), nil
+	}
+
+	var settings UnknownModelPricingSettings
+	if err := json.Unmarshal([]byte(value), &settings); err != nil {
+		return DefaultUnknownModelPricingSettings(), nil
+	}
+	if validateUnknownModelPricingSettings(&settings) != nil {
+		return DefaultUnknownModelPricingSettings(), nil
+	}
+	return &settings, nil
+}
+
+// SetUnknownModelPricingSettings 设置未知模型计费策略
+func (s *SettingService) SetUnknownModelPricingSettings(ctx context.Context, settings *UnknownModelPricingSettings) error {
+	if settings == nil {
+		return fmt.Errorf("settings cannot be nil")
+	}
+	if err := validateUnknownModelPricingSettings(settings); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("marshal unknown model pricing settings: %w", err)
+	}
+	return s.settingRepo.Set(ctx, SettingKeyUnknownModelPricing, string(data))
+}
+
+func validateUnknownModelPricingSettings(settings *UnknownModelPricingSettings) error {
+	switch settings.Policy {
+	case UnknownModelPricingFallback, UnknownModelPricingReject:
+		// valid
+	case UnknownModelPricingDefaultPrice:
+		if settings.InputPrice < 0 || settings.OutputPrice < 0 || settings.CacheWritePrice < 0 || settings.CacheReadPrice < 0 {
+			return fmt.Errorf("prices must not be negative")
+		}
+		if settings.InputPrice == 0 && settings.OutputPrice == 0 {
+			return fmt.Errorf("default_price policy requires input_price or output_price")
+		}
+	default:
+		return fmt.Errorf("invalid policy: %s", settings.Policy)
+	}
+	return nil
+}
+
 // CreemConfig Creem 支付配置
 type CreemConfig struct {
 	Enabled        bool
@@ -895,6 +1011,103 @@ func (s *SettingService) GetCreemConfig(ctx context.Context) (*CreemConfig, erro
 	return cfg, nil
 }
 
+// StripeConfig Stripe Checkout 配置
+type StripeConfig struct {
+	Enabled        bool
+	SecretKey      string
+	WebhookSecret  string
+	Currency       string
+	RateMultiplier float64
+}
+
+// GetStripeConfig 获取 Stripe 支付配置
+func (s *SettingService) GetStripeConfig(ctx context.Context) (*StripeConfig, error) {
+	settings, err := s.settingRepo.GetMultiple(ctx, []string{
+		SettingKeyStripeEnabled,
+		SettingKeyStripeSecretKey,
+		SettingKeyStripeWebhookSecret,
+		SettingKeyStripeCurrency,
+		SettingKeyStripeRateMultiplier,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get stripe settings: %w", err)
+	}
+	return parseStripeConfig(settings), nil
+}
+
+func parseStripeConfig(settings map[string]string) *StripeConfig {
+	cfg := &StripeConfig{
+		Enabled:       settings[SettingKeyStripeEnabled] == "true",
+		SecretKey:     settings[SettingKeyStripeSecretKey],
+		WebhookSecret: settings[SettingKeyStripeWebhookSecret],
+		Currency:      strings.ToLower(strings.TrimSpace(settings[SettingKeyStripeCurrency])),
+	}
+	if cfg.Currency == "" {
+		cfg.Currency = "usd"
+	}
+	if multiplier, err := strconv.ParseFloat(settings[SettingKeyStripeRateMultiplier], 64); err == nil && multiplier > 0 {
+		cfg.RateMultiplier = multiplier
+	} else {
+		cfg.RateMultiplier = 1.0
+	}
+	return cfg
+}
+
+// EPayConfig 易支付配置
+type EPayConfig struct {
+	Enabled        bool
+	GatewayURL     string
+	MerchantID     string
+	MerchantKey    string
+	PayTypes       []string
+	Currency       string
+	RateMultiplier float64
+}
+
+// GetEPayConfig 获取易支付配置
+func (s *SettingService) GetEPayConfig(ctx context.Context) (*EPayConfig, error) {
+	settings, err := s.settingRepo.GetMultiple(ctx, []string{
+		SettingKeyEPayEnabled,
+		SettingKeyEPayGatewayURL,
+		SettingKeyEPayMerchantID,
+		SettingKeyEPayMerchantKey,
+		SettingKeyEPayPayTypes,
+		SettingKeyEPayCurrency,
+		SettingKeyEPayRateMultiplier,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get epay settings: %w", err)
+	}
+	return parseEPayConfig(settings), nil
+}
+
+func parseEPayConfig(settings map[string]string) *EPayConfig {
+	cfg := &EPayConfig{
+		Enabled:     settings[SettingKeyEPayEnabled] == "true",
+		GatewayURL:  strings.TrimRight(strings.TrimSpace(settings[SettingKeyEPayGatewayURL]), "/"),
+		MerchantID:  strings.TrimSpace(settings[SettingKeyEPayMerchantID]),
+		MerchantKey: settings[SettingKeyEPayMerchantKey],
+		Currency:    strings.ToUpper(strings.TrimSpace(settings[SettingKeyEPayCurrency])),
+	}
+	for _, t := range strings.Split(settings[SettingKeyEPayPayTypes], ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			cfg.PayTypes = append(cfg.PayTypes, t)
+		}
+	}
+	if len(cfg.PayTypes) == 0 {
+		cfg.PayTypes = []string{"alipay", "wxpay"}
+	}
+	if cfg.Currency == "" {
+		cfg.Currency = "CNY"
+	}
+	if multiplier, err := strconv.ParseFloat(settings[SettingKeyEPayRateMultiplier], 64); err == nil && multiplier > 0 {
+		cfg.RateMultiplier = multiplier
+	} else {
+		cfg.RateMultiplier = 1.0
+	}
+	return cfg
+}
+
 // IsCreemEnabled 检查是否启用 Creem 支付
 func (s *SettingService) IsCreemEnabled(ctx context.Context) bool {
 	value, err := s.settingRepo.GetValue(ctx, SettingKeyCreemEnabled)
@@ -58,14 +58,33 @@ type SystemSettings struct {
 	OpsMetricsIntervalSeconds    int
 
 	// Creem 支付集成
-	CreemEnabled                  bool
-	CreemAPIKey                   string
-	CreemAPIKeyConfigured         bool
-	CreemWebhookSecret            string
-	CreemWebhookSecretConfigured  bool
-	CreemProductID                string
-	CreemRateMultiplier           float64
-	CreemSuccessURL               string
+	CreemEnabled                 bool
+	CreemAPIKey                  string
+	CreemAPIKeyConfigured        bool
+	CreemWebhookSecret           string
+	CreemWebhookSecretConfigured bool
+	CreemProductID               string
+	CreemRateMultiplier          float64
+	CreemSuccessURL              string
+
+	// Stripe Checkout
+	StripeEnabled                 bool
+	StripeSecretKey               string
+	StripeSecretKeyConfigured     bool
+	StripeWebhookSecret           string
+	StripeWebhookSecretConfigured bool
+	StripeCurrency                string
+	StripeRateMultiplier          float64
+
+	// EPay 易支付
+	EPayEnabled               bool
+	EPayGatewayURL            string
+	EPayMerchantID            string
+	EPayMerchantKey           string
+	EPayMerchantKeyConfigured bool
+	EPayPayTypes              string
+	EPayCurrency              string
+	EPayRateMultiplier        float64
 }
 
 type PublicSettings struct {
@@ -119,3 +138,62 @@ func DefaultStreamTimeoutSettings() *StreamTimeoutSettings {
 		ThresholdWindowMinutes: 10,
 	}
 }
+
+// InvoiceSettings 发票配置：编号规则、税率与开票方公司信息
+type InvoiceSettings struct {
+	// AutoGenerate 每月初自动为上个月开具发票
+	AutoGenerate bool `json:"auto_generate"`
+	// AutoEmail 自动开具后通过邮件队列发送给收票人
+	AutoEmail bool `json:"auto_email"`
+	// NumberPrefix 发票编号前缀，编号 = 前缀 + 补零序号，例如 INV-000001
+	NumberPrefix string `json:"number_prefix"`
+	// NextNumber 下一张发票的最小序号（已使用的序号更大时以已用序号 +1 为准）
+	NextNumber int64 `json:"next_number"`
+	// NumberPadding 序号补零位数
+	NumberPadding int    `json:"number_padding"`
+	Currency      string `json:"currency"`
+	// TaxRate 税率百分比（0-100）
+	TaxRate        float64 `json:"tax_rate"`
+	TaxLabel       string  `json:"tax_label"`
+	CompanyName    string  `json:"company_name"`
+	CompanyAddress string  `json:"company_address"`
+	CompanyTaxID   string  `json:"company_tax_id"`
+	CompanyEmail   string  `json:"company_email"`
+	FooterNote     string  `json:"footer_note"`
+}
+
+// UnknownModelPricingSettings 未知模型计费策略：模型既没有价格覆盖也不在 LiteLLM 价格表中时的处理方式
+type UnknownModelPricingSettings struct {
+	// Policy 处理方式: "fallback" | "default_price" | "reject"
+	Policy string `json:"policy"`
+	// 以下价格仅在 default_price 策略下使用，单位 USD / 百万 token
+	InputPrice      float64 `json:"input_price"`
+	OutputPrice     float64 `json:"output_price"`
+	CacheWritePrice float64 `json:"cache_write_price"`
+	CacheReadPrice  float64 `json:"cache_read_price"`
+}
+
+// UnknownModelPricingPolicy 未知模型计费策略常量
+const (
+	UnknownModelPricingFallback     = "fallback"      // 按模型系列匹配硬编码回退价格（默认 Sonnet）
+	UnknownModelPricingDefaultPrice = "default_price" // 使用配置的默认价格
+	UnknownModelPricingReject       = "reject"        // 转发前拒绝请求
+)
+
+// DefaultUnknownModelPricingSettings 返回默认的未知模型计费策略（保持原有的回退行为）
+func DefaultUnknownModelPricingSettings() *UnknownModelPricingSettings {
+	return &UnknownModelPricingSettings{
+		Policy: UnknownModelPricingFallback,
+	}
+}
+
+// DefaultInvoiceSettings 返回默认的发票配置
+func DefaultInvoiceSettings() *InvoiceSettings {
+	return &InvoiceSettings{
+		NumberPrefix:  "INV-",
+		NextNumber:    1,
+		NumberPadding: 6,
+		Currency:      "USD",
+		TaxLabel:      "Tax",
+	}
+}
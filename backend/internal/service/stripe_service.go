@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stripeAPIBase Stripe API 地址
+const stripeAPIBase = "https://api.stripe.com/v1"
+
+// stripeSignatureTolerance Stripe-Signature 时间戳允许的偏差，超出视为重放
+const stripeSignatureTolerance = 5 * time.Minute
+
+// stripeZeroDecimalCurrencies 无小数位的币种，金额不乘 100
+var stripeZeroDecimalCurrencies = map[string]bool{
+	"bif": true, "clp": true, "djf": true, "gnf": true, "jpy": true, "kmf": true, "krw": true, "mga": true,
+	"pyg": true, "rwf": true, "ugx": true, "vnd": true, "vuv": true, "xaf": true, "xof": true, "xpf": true,
+}
+
+// StripeService Stripe Checkout 支付渠道
+type StripeService struct {
+	settingService *SettingService
+	apiBase        string
+	now            func() time.Time
+}
+
+var _ PaymentProvider = (*StripeService)(nil)
+
+// NewStripeService 创建 Stripe 支付服务实例
+func NewStripeService(settingService *SettingService) *StripeService {
+	return &StripeService{
+		settingService: settingService,
+		apiBase:        stripeAPIBase,
+		now:            time.Now,
+	}
+}
+
+// Name 渠道名称
+func (s *StripeService) Name() string {
+	return PaymentProviderStripe
+}
+
+// Info 渠道展示配置
+func (s *StripeService) Info(ctx context.Context) PaymentProviderInfo {
+	cfg, err := s.settingService.GetStripeConfig(ctx)
+	if err != nil {
+		return PaymentProviderInfo{Name: PaymentProviderStripe}
+	}
+	return PaymentProviderInfo{
+		Name:           PaymentProviderStripe,
+		Enabled:        cfg.Enabled && cfg.SecretKey != "" && cfg.WebhookSecret != "",
+		Currency:       strings.ToUpper(cfg.Currency),
+		RateMultiplier: cfg.RateMultiplier,
+	}
+}
+
+// CreditAmount 支付金额乘以充值倍率
+func (s *StripeService) CreditAmount(ctx context.Context, amount float64) float64 {
+	cfg, err := s.settingService.GetStripeConfig(ctx)
+	if err != nil {
+		return 0
+	}
+	return amount * cfg.RateMultiplier
+}
+
+// stripeCheckoutSession Checkout Session 中用到的字段
+type stripeCheckoutSession struct {
+	ID                string            `json:"id"`
+	URL               string            `json:"url"`
+	PaymentStatus     string            `json:"payment_status"`
+	PaymentIntent     string            `json:"payment_intent"`
+	AmountTotal       int64             `json:"amount_total"`
+	Currency          string            `json:"currency"`
+	ClientReferenceID string            `json:"client_reference_id"`
+	Metadata          map[string]string `json:"metadata"`
+}
+
+// stripeCharge charge.refunded 事件中用到的字段
+type stripeCharge struct {
+	ID             string `json:"id"`
+	PaymentIntent  string `json:"payment_intent"`
+	Refunded       bool   `json:"refunded"`
+	AmountRefunded int64  `json:"amount_refunded"`
+	Currency       string `json:"currency"`
+}
+
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// CreateCheckout 创建一次性付款的 Checkout Session，client_reference_id 为订单号
+func (s *StripeService) CreateCheckout(ctx context.Context, order *PaymentOrder, in PaymentCheckoutInput) (*PaymentCheckout, error) {
+	cfg, err := s.settingService.GetStripeConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get stripe config: %w", err)
+	}
+	if !cfg.Enabled || cfg.SecretKey == "" || cfg.WebhookSecret == "" {
+		return nil, ErrPaymentProviderDisabled
+	}
+
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("success_url", in.ReturnURL)
+	form.Set("cancel_url", in.CancelURL)
+	form.Set("client_reference_id", order.OrderNo)
+	if in.Email != "" {
+		form.Set("customer_email", in.Email)
+	}
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", cfg.Currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(stripeMinorUnits(order.Amount, cfg.Currency), 10))
+	form.Set("line_items[0][price_data][product_data][name]", "Balance top-up "+order.OrderNo)
+	form.Set("metadata[order_no]", order.OrderNo)
+	form.Set("metadata[user_id]", strconv.FormatInt(order.UserID, 10))
+	form.Set("payment_intent_data[metadata][order_no]", order.OrderNo)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.apiBase+"/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+cfg.SecretKey)
+	// 同一订单重复提交时 Stripe 返回同一会话
+	req.Header.Set("Idempotency-Key", order.OrderNo)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("stripe api error: status=%d body=%s", resp.StatusCode, string(body))
+	}
+
+	var session stripeCheckoutSession
+	if err := json.Unmarshal(body, &session); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+	return &PaymentCheckout{SessionID: session.ID, URL: session.URL}, nil
+}
+
+// VerifyWebhook 校验 Stripe-Signature 并把 Checkout/Charge 事件映射为订单状态
+func (s *StripeService) VerifyWebhook(ctx context.Context, req *PaymentWebhookRequest) (*PaymentWebhookEvent, error) {
+	cfg, err := s.settingService.GetStripeConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get stripe config: %w", err)
+	}
+	if err := verifyStripeSignature(cfg.WebhookSecret, req.Body, req.Header.Get("Stripe-Signature"), s.now()); err != nil {
+		log.Printf("[Stripe] Invalid webhook signature: %v", err)
+		return nil, ErrPaymentWebhookInvalid
+	}
+
+	var evt stripeEvent
+	if err := json.Unmarshal(req.Body, &evt); err != nil {
+		return nil, fmt.Errorf("unmarshal webhook: %w", err)
+	}
+	log.Printf("[Stripe] Webhook received: id=%s type=%s", evt.ID, evt.Type)
+
+	switch evt.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded",
+		"checkout.session.async_payment_failed", "checkout.session.expired":
+		var session stripeCheckoutSession
+		if err := json.Unmarshal(evt.Data.Object, &session); err != nil {
+			return nil, fmt.Errorf("unmarshal checkout session: %w", err)
+		}
+		event := &PaymentWebhookEvent{
+			OrderNo:       session.ClientReferenceID,
+			ProviderTxnID: session.PaymentIntent,
+			Amount:        stripeMajorUnits(session.AmountTotal, session.Currency),
+			Currency:      strings.ToUpper(session.Currency),
+		}
+		if event.OrderNo == "" {
+			event.OrderNo = session.Metadata["order_no"]
+		}
+		switch evt.Type {
+		case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+			// 异步支付方式（如银行转账）completed 时尚未到账，等待 async_payment_succeeded
+			if session.PaymentStatus == "paid" {
+				event.Status = PaymentStatusPaid
+			}
+		case "checkout.session.async_payment_failed":
+			event.Status = PaymentStatusFailed
+			event.Reason = "async payment failed"
+		case "checkout.session.expired":
+			event.Status = PaymentStatusFailed
+			event.Reason = "checkout session expired"
+		}
+		return event, nil
+
+	case "charge.refunded":
+		var charge stripeCharge
+		if err := json.Unmarshal(evt.Data.Object, &charge); err != nil {
+			return nil, fmt.Errorf("unmarshal charge: %w", err)
+		}
+		// 部分退款不改变订单状态，由管理员人工处理
+		if !charge.Refunded || charge.PaymentIntent == "" {
+			return &PaymentWebhookEvent{}, nil
+		}
+		return &PaymentWebhookEvent{
+			Status:        PaymentStatusRefunded,
+			ProviderTxnID: charge.PaymentIntent,
+			Amount:        stripeMajorUnits(charge.AmountRefunded, charge.Currency),
+			Currency:      strings.ToUpper(charge.Currency),
+			Reason:        "refunded in stripe",
+		}, nil
+	}
+	return &PaymentWebhookEvent{}, nil
+}
+
+// verifyStripeSignature 校验 "t=<ts>,v1=<sig>[,v1=<sig>]"：HMAC-SHA256(secret, "<ts>.<payload>")
+func verifyStripeSignature(secret string, payload []byte, header string, now time.Time) error {
+	if secret == "" {
+		return fmt.Errorf("webhook secret not configured")
+	}
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return fmt.Errorf("malformed signature header")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	if diff := now.Sub(time.Unix(ts, 0)); diff > stripeSignatureTolerance || diff < -stripeSignatureTolerance {
+		return fmt.Errorf("timestamp outside tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(expected), []byte(sig)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no matching signature")
+}
+
+func stripeMinorUnits(amount float64, currency string) int64 {
+	if stripeZeroDecimalCurrencies[strings.ToLower(currency)] {
+		return int64(math.Round(amount))
+	}
+	return int64(math.Round(amount * 100))
+}
+
+func stripeMajorUnits(amount int64, currency string) float64 {
+	if stripeZeroDecimalCurrencies[strings.ToLower(currency)] {
+		return float64(amount)
+	}
+	return float64(amount) / 100.0
+}
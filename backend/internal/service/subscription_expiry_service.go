@@ -7,18 +7,21 @@ import (
 	"time"
 )
 
-// SubscriptionExpiryService periodically updates expired subscription status.
+// SubscriptionExpiryService periodically updates expired subscription status
+// and processes plan auto-renewals / expiry notices before that.
 type SubscriptionExpiryService struct {
 	userSubRepo UserSubscriptionRepository
+	planService *SubscriptionPlanService
 	interval    time.Duration
 	stopCh      chan struct{}
 	stopOnce    sync.Once
 	wg          sync.WaitGroup
 }
 
-func NewSubscriptionExpiryService(userSubRepo UserSubscriptionRepository, interval time.Duration) *SubscriptionExpiryService {
+func NewSubscriptionExpiryService(userSubRepo UserSubscriptionRepository, planService *SubscriptionPlanService, interval time.Duration) *SubscriptionExpiryService {
 	return &SubscriptionExpiryService{
 		userSubRepo: userSubRepo,
+		planService: planService,
 		interval:    interval,
 		stopCh:      make(chan struct{}),
 	}
@@ -57,6 +60,8 @@ func (s *SubscriptionExpiryService) Stop() {
 }
 
 func (s *SubscriptionExpiryService) runOnce() {
+	s.processRenewals()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -69,3 +74,21 @@ func (s *SubscriptionExpiryService) runOnce() {
 		log.Printf("[SubscriptionExpiry] Updated %d expired subscriptions", updated)
 	}
 }
+
+// processRenewals 在标记过期之前处理即将到期的套餐订阅（自动续费与到期提醒）
+func (s *SubscriptionExpiryService) processRenewals() {
+	if s.planService == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	processed, err := s.planService.ProcessRenewals(ctx)
+	if err != nil {
+		log.Printf("[SubscriptionExpiry] Process renewals failed: %v", err)
+		return
+	}
+	if processed > 0 {
+		log.Printf("[SubscriptionExpiry] Processed %d renewal-due subscriptions", processed)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrSubscriptionPlanNotFound    = infraerrors.NotFound("SUBSCRIPTION_PLAN_NOT_FOUND", "subscription plan not found")
+	ErrSubscriptionPlanUnavailable = infraerrors.BadRequest("SUBSCRIPTION_PLAN_UNAVAILABLE", "subscription plan is not available for purchase")
+	ErrSubscriptionPlanInvalid     = infraerrors.BadRequest("SUBSCRIPTION_PLAN_INVALID", "invalid subscription plan")
+	ErrSubscriptionNotRenewable    = infraerrors.BadRequest("SUBSCRIPTION_NOT_RENEWABLE", "subscription was not purchased from a plan and cannot auto-renew")
+)
+
+// SubscriptionPlan 可自助购买的订阅套餐
+type SubscriptionPlan struct {
+	ID           int64
+	Name         string
+	Description  string
+	GroupID      int64
+	ValidityDays int
+	// Price 套餐价格（美元），下单时按支付渠道倍率换算为支付金额，自动续费时直接从余额扣除
+	Price float64
+	// 限额为 nil 表示沿用分组配置
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	Status          string
+	SortOrder       int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Group *Group
+}
+
+// IsActive 套餐是否可购买
+func (p *SubscriptionPlan) IsActive() bool {
+	return p.Status == SubscriptionPlanStatusActive
+}
+
+// CreateSubscriptionPlanInput 创建套餐输入
+type CreateSubscriptionPlanInput struct {
+	Name            string
+	Description     string
+	GroupID         int64
+	ValidityDays    int
+	Price           float64
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	Status          string
+	SortOrder       int
+}
+
+// UpdateSubscriptionPlanInput 更新套餐输入，nil 表示不修改；限额传 0 或负数表示恢复为沿用分组配置
+type UpdateSubscriptionPlanInput struct {
+	Name            *string
+	Description     *string
+	GroupID         *int64
+	ValidityDays    *int
+	Price           *float64
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	Status          *string
+	SortOrder       *int
+}
+
+// SubscriptionPlanRepository 订阅套餐仓储接口
+type SubscriptionPlanRepository interface {
+	Create(ctx context.Context, plan *SubscriptionPlan) error
+	GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error)
+	Update(ctx context.Context, plan *SubscriptionPlan) error
+	Delete(ctx context.Context, id int64) error
+	// List 管理端分页列表，status 为空表示不过滤
+	List(ctx context.Context, params pagination.PaginationParams, status string) ([]SubscriptionPlan, *pagination.PaginationResult, error)
+	// ListActive 用户端可购买的套餐，按 sort_order、id 升序
+	ListActive(ctx context.Context) ([]SubscriptionPlan, error)
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	// subscriptionRenewalLead 到期前多久开始处理自动续费/到期提醒
+	subscriptionRenewalLead = 24 * time.Hour
+	// subscriptionRenewalBatch 每轮最多处理的订阅数
+	subscriptionRenewalBatch = 100
+)
+
+// subscriptionNoticeMailer 到期提醒邮件投递接口（便于测试替换）
+type subscriptionNoticeMailer interface {
+	EnqueueNotice(email, subject, body string) error
+}
+
+// SubscriptionPlanService 订阅套餐：管理端维护套餐，用户端购买（经 PaymentService 下单）与自动续费
+//
+// 自动续费由 SubscriptionExpiryService 周期性调用 ProcessRenewals：在到期前 subscriptionRenewalLead 内，
+// 开启自动续费的订阅从余额扣除套餐价格并续期，余额不足或未开启自动续费时发送提醒邮件。
+// 每个到期周期只处理一次（见 UserSubscriptionRepository.ClaimRenewalNotice）。
+type SubscriptionPlanService struct {
+	planRepo             SubscriptionPlanRepository
+	groupRepo            GroupRepository
+	userSubRepo          UserSubscriptionRepository
+	userRepo             UserRepository
+	subscriptionService  *SubscriptionService
+	settingService       *SettingService
+	billingCacheService  *BillingCacheService
+	entClient            *dbent.Client
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	mailer               subscriptionNoticeMailer
+	now                  func() time.Time
+}
+
+// NewSubscriptionPlanService 创建订阅套餐服务实例
+func NewSubscriptionPlanService(
+	planRepo SubscriptionPlanRepository,
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	userRepo UserRepository,
+	subscriptionService *SubscriptionService,
+	settingService *SettingService,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	emailQueueService *EmailQueueService,
+) *SubscriptionPlanService {
+	svc := &SubscriptionPlanService{
+		planRepo:             planRepo,
+		groupRepo:            groupRepo,
+		userSubRepo:          userSubRepo,
+		userRepo:             userRepo,
+		subscriptionService:  subscriptionService,
+		settingService:       settingService,
+		billingCacheService:  billingCacheService,
+		entClient:            entClient,
+		authCacheInvalidator: authCacheInvalidator,
+		now:                  time.Now,
+	}
+	if emailQueueService != nil {
+		svc.mailer = emailQueueService
+	}
+	return svc
+}
+
+// Create 创建套餐
+func (s *SubscriptionPlanService) Create(ctx context.Context, input *CreateSubscriptionPlanInput) (*SubscriptionPlan, error) {
+	plan := &SubscriptionPlan{
+		Name:            strings.TrimSpace(input.Name),
+		Description:     strings.TrimSpace(input.Description),
+		GroupID:         input.GroupID,
+		ValidityDays:    input.ValidityDays,
+		Price:           input.Price,
+		DailyLimitUSD:   normalizeLimit(input.DailyLimitUSD),
+		WeeklyLimitUSD:  normalizeLimit(input.WeeklyLimitUSD),
+		MonthlyLimitUSD: normalizeLimit(input.MonthlyLimitUSD),
+		Status:          input.Status,
+		SortOrder:       input.SortOrder,
+	}
+	if plan.Status == "" {
+		plan.Status = SubscriptionPlanStatusActive
+	}
+	if err := s.validatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+	if err := s.planRepo.Create(ctx, plan); err != nil {
+		return nil, fmt.Errorf("create subscription plan: %w", err)
+	}
+	return plan, nil
+}
+
+// Update 更新套餐；已售出的订阅保留购买时的限额快照，下次续费时才按新配置生效
+func (s *SubscriptionPlanService) Update(ctx context.Context, id int64, input *UpdateSubscriptionPlanInput) (*SubscriptionPlan, error) {
+	plan, err := s.planRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if input.Name != nil {
+		plan.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		plan.Description = strings.TrimSpace(*input.Description)
+	}
+	if input.GroupID != nil {
+		plan.GroupID = *input.GroupID
+	}
+	if input.ValidityDays != nil {
+		plan.ValidityDays = *input.ValidityDays
+	}
+	if input.Price != nil {
+		plan.Price = *input.Price
+	}
+	if input.DailyLimitUSD != nil {
+		plan.DailyLimitUSD = normalizeLimit(input.DailyLimitUSD)
+	}
+	if input.WeeklyLimitUSD != nil {
+		plan.WeeklyLimitUSD = normalizeLimit(input.WeeklyLimitUSD)
+	}
+	if input.MonthlyLimitUSD != nil {
+		plan.MonthlyLimitUSD = normalizeLimit(input.MonthlyLimitUSD)
+	}
+	if input.Status != nil {
+		plan.Status = *input.Status
+	}
+	if input.SortOrder != nil {
+		plan.SortOrder = *input.SortOrder
+	}
+	if err := s.validatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+	if err := s.planRepo.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Delete 删除套餐（软删除）；已开启自动续费的订阅到期时会收到续费失败提醒
+func (s *SubscriptionPlanService) Delete(ctx context.Context, id int64) error {
+	return s.planRepo.Delete(ctx, id)
+}
+
+func (s *SubscriptionPlanService) GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error) {
+	return s.planRepo.GetByID(ctx, id)
+}
+
+func (s *SubscriptionPlanService) List(ctx context.Context, params pagination.PaginationParams, status string) ([]SubscriptionPlan, *pagination.PaginationResult, error) {
+	return s.planRepo.List(ctx, params, status)
+}
+
+// ListActive 用户端可购买的套餐
+func (s *SubscriptionPlanService) ListActive(ctx context.Context) ([]SubscriptionPlan, error) {
+	return s.planRepo.ListActive(ctx)
+}
+
+func (s *SubscriptionPlanService) validatePlan(ctx context.Context, plan *SubscriptionPlan) error {
+	if plan.Name == "" {
+		return ErrSubscriptionPlanInvalid.WithMetadata(map[string]string{"field": "name"})
+	}
+	if plan.ValidityDays <= 0 || plan.ValidityDays > MaxValidityDays {
+		return ErrSubscriptionPlanInvalid.WithMetadata(map[string]string{"field": "validity_days"})
+	}
+	if plan.Price <= 0 {
+		return ErrSubscriptionPlanInvalid.WithMetadata(map[string]string{"field": "price"})
+	}
+	if plan.Status != SubscriptionPlanStatusActive && plan.Status != SubscriptionPlanStatusDisabled {
+		return ErrSubscriptionPlanInvalid.WithMetadata(map[string]string{"field": "status"})
+	}
+	group, err := s.groupRepo.GetByID(ctx, plan.GroupID)
+	if err != nil {
+		return err
+	}
+	if !group.IsSubscriptionType() {
+		return ErrGroupNotSubscriptionType
+	}
+	return nil
+}
+
+// SetAutoRenew 用户开启/关闭自己订阅的自动续费；只有通过套餐购买的订阅可以开启
+func (s *SubscriptionPlanService) SetAutoRenew(ctx context.Context, userID, subscriptionID int64, enabled bool) (*UserSubscription, error) {
+	sub, err := s.userSubRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.UserID != userID {
+		return nil, ErrSubscriptionNotFound
+	}
+	if enabled && sub.PlanID == nil {
+		return nil, ErrSubscriptionNotRenewable
+	}
+	if err := s.userSubRepo.SetAutoRenew(ctx, sub.ID, enabled); err != nil {
+		return nil, err
+	}
+	sub.AutoRenew = enabled
+	return sub, nil
+}
+
+// ProcessRenewals 处理即将到期的套餐订阅，返回本轮处理的订阅数
+func (s *SubscriptionPlanService) ProcessRenewals(ctx context.Context) (int, error) {
+	subs, err := s.userSubRepo.ListRenewalDue(ctx, s.now().Add(subscriptionRenewalLead), subscriptionRenewalBatch)
+	if err != nil {
+		return 0, fmt.Errorf("list renewal due subscriptions: %w", err)
+	}
+	processed := 0
+	for i := range subs {
+		if ctx.Err() != nil {
+			break
+		}
+		handled, err := s.processRenewal(ctx, &subs[i])
+		if err != nil {
+			log.Printf("[SubscriptionRenewal] Process subscription failed: sub_id=%d err=%v", subs[i].ID, err)
+			continue
+		}
+		if handled {
+			processed++
+		}
+	}
+	return processed, nil
+}
+
+// subscriptionNotice 续费处理结果，对应一封提醒邮件
+type subscriptionNotice int
+
+const (
+	subscriptionNoticeExpiring subscriptionNotice = iota
+	subscriptionNoticeRenewed
+	subscriptionNoticeInsufficientBalance
+	subscriptionNoticePlanUnavailable
+)
+
+// processRenewal 处理单个订阅，返回是否认领到本周期的处理
+func (s *SubscriptionPlanService) processRenewal(ctx context.Context, sub *UserSubscription) (bool, error) {
+	var plan *SubscriptionPlan
+	if sub.PlanID != nil {
+		p, err := s.planRepo.GetByID(ctx, *sub.PlanID)
+		if err != nil && !infraerrors.IsNotFound(err) {
+			return false, err
+		}
+		plan = p
+	}
+
+	var (
+		claimed bool
+		notice  subscriptionNotice
+		renewed *UserSubscription
+	)
+	err := s.runInTx(ctx, func(txCtx context.Context) error {
+		var err error
+		claimed, err = s.userSubRepo.ClaimRenewalNotice(txCtx, sub.ID, sub.ExpiresAt)
+		if err != nil || !claimed {
+			// 未认领到说明其他实例已处理，或订阅在此期间已被续期
+			return err
+		}
+		switch {
+		case !sub.AutoRenew:
+			notice = subscriptionNoticeExpiring
+			return nil
+		case plan == nil || !plan.IsActive():
+			notice = subscriptionNoticePlanUnavailable
+			return nil
+		}
+
+		user, err := s.userRepo.GetByID(txCtx, sub.UserID)
+		if err != nil {
+			return err
+		}
+		if user.Balance < plan.Price {
+			notice = subscriptionNoticeInsufficientBalance
+			return nil
+		}
+		if err := s.userRepo.DeductBalance(txCtx, sub.UserID, plan.Price, BalanceChangeSource{
+			Type:        BalanceSourceSubscriptionRenewal,
+			ReferenceID: strconv.FormatInt(sub.ID, 10),
+			Notes:       plan.Name,
+		}); err != nil {
+			return err
+		}
+		renewed, err = s.subscriptionService.ApplyPlan(txCtx, sub.UserID, plan, "auto renewal: "+plan.Name)
+		if err != nil {
+			return err
+		}
+		notice = subscriptionNoticeRenewed
+		return nil
+	})
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if notice == subscriptionNoticeRenewed {
+		log.Printf("[SubscriptionRenewal] Renewed: sub_id=%d user_id=%d plan_id=%d price=%.4f expires_at=%s",
+			sub.ID, sub.UserID, plan.ID, plan.Price, renewed.ExpiresAt.Format(time.RFC3339))
+		s.invalidateRenewalCaches(ctx, sub.UserID, sub.GroupID)
+	}
+	s.sendNotice(ctx, sub, plan, renewed, notice)
+	return true, nil
+}
+
+func (s *SubscriptionPlanService) invalidateRenewalCaches(ctx context.Context, userID, groupID int64) {
+	s.subscriptionService.invalidateSubscriptionCache(userID, groupID)
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+	}()
+}
+
+// sendNotice 发送到期/续费提醒邮件；未配置邮件或用户没有邮箱时只记录日志
+func (s *SubscriptionPlanService) sendNotice(ctx context.Context, sub *UserSubscription, plan *SubscriptionPlan, renewed *UserSubscription, notice subscriptionNotice) {
+	if s.mailer == nil || sub.User == nil || sub.User.Email == "" || strings.HasSuffix(sub.User.Email, LinuxDoConnectSyntheticEmailDomain) {
+		return
+	}
+	siteName := "Sub2API"
+	if s.settingService != nil {
+		siteName = s.settingService.GetSiteName(ctx)
+	}
+	name := ""
+	if plan != nil {
+		name = plan.Name
+	} else if sub.Group != nil {
+		name = sub.Group.Name
+	}
+
+	var subject, message string
+	switch notice {
+	case subscriptionNoticeRenewed:
+		subject = "订阅已自动续费"
+		message = fmt.Sprintf("您的订阅「%s」已自动续费，余额扣除 $%.2f，新的到期时间为 %s。",
+			html.EscapeString(name), plan.Price, renewed.ExpiresAt.Format("2006-01-02 15:04 MST"))
+	case subscriptionNoticeInsufficientBalance:
+		subject = "订阅自动续费失败"
+		message = fmt.Sprintf("您的订阅「%s」将于 %s 到期，自动续费需要 $%.2f，当前余额不足。请在到期前充值或手动续费。",
+			html.EscapeString(name), sub.ExpiresAt.Format("2006-01-02 15:04 MST"), plan.Price)
+	case subscriptionNoticePlanUnavailable:
+		subject = "订阅自动续费失败"
+		message = fmt.Sprintf("您的订阅「%s」将于 %s 到期，对应套餐已下架，无法自动续费。请选择其他套餐。",
+			html.EscapeString(name), sub.ExpiresAt.Format("2006-01-02 15:04 MST"))
+	default:
+		subject = "订阅即将到期"
+		message = fmt.Sprintf("您的订阅「%s」将于 %s 到期。如需继续使用，请在到期前续费，或开启自动续费。",
+			html.EscapeString(name), sub.ExpiresAt.Format("2006-01-02 15:04 MST"))
+	}
+	subject = fmt.Sprintf("[%s] %s", siteName, subject)
+	if err := s.mailer.EnqueueNotice(sub.User.Email, subject, buildSubscriptionNoticeBody(siteName, message)); err != nil {
+		log.Printf("[SubscriptionRenewal] Enqueue notice failed: sub_id=%d err=%v", sub.ID, err)
+	}
+}
+
+func buildSubscriptionNoticeBody(siteName, message string) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5; margin: 0; padding: 20px; }
+        .container { max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; overflow: hidden; box-shadow: 0 2px 8px rgba(0,0,0,0.1); }
+        .header { background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 30px; text-align: center; }
+        .header h1 { margin: 0; font-size: 24px; }
+        .content { padding: 40px 30px; color: #333; font-size: 15px; line-height: 1.6; }
+        .footer { background-color: #f8f9fa; padding: 20px; text-align: center; color: #999; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>%s</h1>
+        </div>
+        <div class="content">
+            <p>%s</p>
+        </div>
+        <div class="footer">
+            <p>这是一封自动发送的邮件，请勿回复。</p>
+        </div>
+    </div>
+</body>
+</html>
+`, html.EscapeString(siteName), message)
+}
+
+// runInTx 在事务中执行 fn，使余额扣费与订阅续期原子提交
+func (s *SubscriptionPlanService) runInTx(ctx context.Context, fn func(txCtx context.Context) error) error {
+	if s.entClient == nil {
+		return fn(ctx)
+	}
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(dbent.NewTxContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type subscriptionPlanRepoStub struct {
+	plans   map[int64]*SubscriptionPlan
+	nextID  int64
+	updated []*SubscriptionPlan
+}
+
+func newSubscriptionPlanRepoStub(plans ...*SubscriptionPlan) *subscriptionPlanRepoStub {
+	s := &subscriptionPlanRepoStub{plans: map[int64]*SubscriptionPlan{}, nextID: 100}
+	for _, p := range plans {
+		s.plans[p.ID] = p
+	}
+	return s
+}
+
+func (s *subscriptionPlanRepoStub) Create(ctx context.Context, plan *SubscriptionPlan) error {
+	s.nextID++
+	plan.ID = s.nextID
+	cp := *plan
+	s.plans[plan.ID] = &cp
+	return nil
+}
+
+func (s *subscriptionPlanRepoStub) GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error) {
+	if p, ok := s.plans[id]; ok {
+		cp := *p
+		return &cp, nil
+	}
+	return nil, ErrSubscriptionPlanNotFound
+}
+
+func (s *subscriptionPlanRepoStub) Update(ctx context.Context, plan *SubscriptionPlan) error {
+	cp := *plan
+	s.plans[plan.ID] = &cp
+	s.updated = append(s.updated, &cp)
+	return nil
+}
+
+func (s *subscriptionPlanRepoStub) Delete(ctx context.Context, id int64) error {
+	panic("unexpected Delete call")
+}
+
+func (s *subscriptionPlanRepoStub) List(ctx context.Context, params pagination.PaginationParams, status string) ([]SubscriptionPlan, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+func (s *subscriptionPlanRepoStub) ListActive(ctx context.Context) ([]SubscriptionPlan, error) {
+	panic("unexpected ListActive call")
+}
+
+// planGroupRepoStub 按 ID 返回固定分组
+type planGroupRepoStub struct {
+	*groupRepoStub
+	groups map[int64]*Group
+}
+
+func (s *planGroupRepoStub) GetByID(ctx context.Context, id int64) (*Group, error) {
+	if g, ok := s.groups[id]; ok {
+		return g, nil
+	}
+	return nil, ErrGroupNotFound
+}
+
+// renewalSubRepoStub 内存中的单用户订阅仓储，只实现续费流程用到的方法
+type renewalSubRepoStub struct {
+	subs      map[int64]*UserSubscription
+	due       []UserSubscription
+	claimed   map[int64]time.Time
+	autoRenew map[int64]bool
+}
+
+func newRenewalSubRepoStub(subs ...*UserSubscription) *renewalSubRepoStub {
+	s := &renewalSubRepoStub{
+		subs:      map[int64]*UserSubscription{},
+		claimed:   map[int64]time.Time{},
+		autoRenew: map[int64]bool{},
+	}
+	for _, sub := range subs {
+		s.subs[sub.ID] = sub
+		s.due = append(s.due, *sub)
+	}
+	return s
+}
+
+func (s *renewalSubRepoStub) Create(ctx context.Context, sub *UserSubscription) error {
+	panic("unexpected Create call")
+}
+
+func (s *renewalSubRepoStub) GetByID(ctx context.Context, id int64) (*UserSubscription, error) {
+	if sub, ok := s.subs[id]; ok {
+		cp := *sub
+		return &cp, nil
+	}
+	return nil, ErrSubscriptionNotFound
+}
+
+func (s *renewalSubRepoStub) GetByUserIDAndGroupID(ctx context.Context, userID, groupID int64) (*UserSubscription, error) {
+	for _, sub := range s.subs {
+		if sub.UserID == userID && sub.GroupID == groupID {
+			cp := *sub
+			return &cp, nil
+		}
+	}
+	return nil, ErrSubscriptionNotFound
+}
+
+func (s *renewalSubRepoStub) GetActiveByUserIDAndGroupID(ctx context.Context, userID, groupID int64) (*UserSubscription, error) {
+	panic("unexpected GetActiveByUserIDAndGroupID call")
+}
+
+func (s *renewalSubRepoStub) Update(ctx context.Context, sub *UserSubscription) error {
+	panic("unexpected Update call")
+}
+
+func (s *renewalSubRepoStub) Delete(ctx context.Context, id int64) error {
+	panic("unexpected Delete call")
+}
+
+func (s *renewalSubRepoStub) ListByUserID(ctx context.Context, userID int64) ([]UserSubscription, error) {
+	panic("unexpected ListByUserID call")
+}
+
+func (s *renewalSubRepoStub) ListActiveByUserID(ctx context.Context, userID int64) ([]UserSubscription, error) {
+	panic("unexpected ListActiveByUserID call")
+}
+
+func (s *renewalSubRepoStub) ListByGroupID(ctx context.Context, groupID int64, params pagination.PaginationParams) ([]UserSubscription, *pagination.PaginationResult, error) {
+	panic("unexpected ListByGroupID call")
+}
+
+func (s *renewalSubRepoStub) List(ctx context.Context, params pagination.PaginationParams, userID, groupID *int64, status, sortBy, sortOrder string) ([]UserSubscription, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+func (s *renewalSubRepoStub) ExistsByUserIDAndGroupID(ctx context.Context, userID, groupID int64) (bool, error) {
+	panic("unexpected ExistsByUserIDAndGroupID call")
+}
+
+func (s *renewalSubRepoStub) ExtendExpiry(ctx context.Context, subscriptionID int64, newExpiresAt time.Time) error {
+	s.subs[subscriptionID].ExpiresAt = newExpiresAt
+	return nil
+}
+
+func (s *renewalSubRepoStub) UpdateStatus(ctx context.Context, subscriptionID int64, status string) error {
+	s.subs[subscriptionID].Status = status
+	return nil
+}
+
+func (s *renewalSubRepoStub) UpdateNotes(ctx context.Context, subscriptionID int64, notes string) error {
+	s.subs[subscriptionID].Notes = notes
+	return nil
+}
+
+func (s *renewalSubRepoStub) ActivateWindows(ctx context.Context, id int64, start time.Time) error {
+	panic("unexpected ActivateWindows call")
+}
+
+func (s *renewalSubRepoStub) ResetDailyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
+	panic("unexpected ResetDailyUsage call")
+}
+
+func (s *renewalSubRepoStub) ResetWeeklyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
+	panic("unexpected ResetWeeklyUsage call")
+}
+
+func (s *renewalSubRepoStub) ResetMonthlyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
+	panic("unexpected ResetMonthlyUsage call")
+}
+
+func (s *renewalSubRepoStub) IncrementUsage(ctx context.Context, id int64, costUSD float64) error {
+	panic("unexpected IncrementUsage call")
+}
+
+func (s *renewalSubRepoStub) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
+	panic("unexpected BatchUpdateExpiredStatus call")
+}
+
+func (s *renewalSubRepoStub) ApplyPlan(ctx context.Context, id int64, plan *SubscriptionPlan) error {
+	planID := plan.ID
+	sub := s.subs[id]
+	sub.PlanID = &planID
+	sub.DailyLimitUSD = plan.DailyLimitUSD
+	sub.WeeklyLimitUSD = plan.WeeklyLimitUSD
+	sub.MonthlyLimitUSD = plan.MonthlyLimitUSD
+	return nil
+}
+
+func (s *renewalSubRepoStub) SetAutoRenew(ctx context.Context, id int64, autoRenew bool) error {
+	s.autoRenew[id] = autoRenew
+	return nil
+}
+
+func (s *renewalSubRepoStub) ListRenewalDue(ctx context.Context, before time.Time, limit int) ([]UserSubscription, error) {
+	return s.due, nil
+}
+
+func (s *renewalSubRepoStub) ClaimRenewalNotice(ctx context.Context, id int64, expiresAt time.Time) (bool, error) {
+	if prev, ok := s.claimed[id]; ok && prev.Equal(expiresAt) {
+		return false, nil
+	}
+	s.claimed[id] = expiresAt
+	return true, nil
+}
+
+// renewalUserRepoStub 记录余额扣费
+type renewalUserRepoStub struct {
+	*userRepoStub
+	deducted []BalanceChangeSource
+}
+
+func (s *renewalUserRepoStub) DeductBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error {
+	s.user.Balance -= amount
+	s.deducted = append(s.deducted, source)
+	return nil
+}
+
+type subscriptionNoticeMailerStub struct {
+	to       []string
+	subjects []string
+}
+
+func (m *subscriptionNoticeMailerStub) EnqueueNotice(email, subject, body string) error {
+	m.to = append(m.to, email)
+	m.subjects = append(m.subjects, subject)
+	return nil
+}
+
+type subscriptionPlanFixture struct {
+	svc      *SubscriptionPlanService
+	plans    *subscriptionPlanRepoStub
+	subs     *renewalSubRepoStub
+	users    *renewalUserRepoStub
+	mailer   *subscriptionNoticeMailerStub
+	now      time.Time
+	expireAt time.Time
+}
+
+func newSubscriptionPlanFixture(balance float64, autoRenew bool) *subscriptionPlanFixture {
+	// 续期基于真实时间计算（AssignOrExtendSubscription 使用 time.Now），到期时间需在未来
+	now := time.Now()
+	expireAt := now.Add(6 * time.Hour)
+	daily := 5.0
+	planID := int64(5)
+	plans := newSubscriptionPlanRepoStub(&SubscriptionPlan{
+		ID: planID, Name: "Pro Monthly", GroupID: 3, ValidityDays: 30, Price: 10,
+		DailyLimitUSD: &daily, Status: SubscriptionPlanStatusActive,
+	})
+	subs := newRenewalSubRepoStub(&UserSubscription{
+		ID: 1, UserID: 7, GroupID: 3, PlanID: &planID, AutoRenew: autoRenew,
+		Status: SubscriptionStatusActive, ExpiresAt: expireAt,
+		User: &User{ID: 7, Email: "u@example.com"},
+	})
+	groups := &planGroupRepoStub{groups: map[int64]*Group{
+		3: {ID: 3, Name: "Claude Pro", SubscriptionType: SubscriptionTypeSubscription},
+		4: {ID: 4, Name: "Standard", SubscriptionType: SubscriptionTypeStandard},
+	}}
+	users := &renewalUserRepoStub{userRepoStub: &userRepoStub{user: &User{ID: 7, Balance: balance}}}
+	mailer := &subscriptionNoticeMailerStub{}
+
+	svc := NewSubscriptionPlanService(plans, groups, subs, users, NewSubscriptionService(groups, subs, nil), nil, nil, nil, nil, nil)
+	svc.mailer = mailer
+	svc.now = func() time.Time { return now }
+	return &subscriptionPlanFixture{svc: svc, plans: plans, subs: subs, users: users, mailer: mailer, now: now, expireAt: expireAt}
+}
+
+func TestSubscriptionPlanService_CreateValidation(t *testing.T) {
+	f := newSubscriptionPlanFixture(0, false)
+	ctx := context.Background()
+
+	_, err := f.svc.Create(ctx, &CreateSubscriptionPlanInput{Name: "Pro", GroupID: 3, ValidityDays: 30})
+	require.ErrorIs(t, err, ErrSubscriptionPlanInvalid)
+
+	_, err = f.svc.Create(ctx, &CreateSubscriptionPlanInput{Name: "Pro", GroupID: 3, ValidityDays: 0, Price: 10})
+	require.ErrorIs(t, err, ErrSubscriptionPlanInvalid)
+
+	_, err = f.svc.Create(ctx, &CreateSubscriptionPlanInput{Name: "Pro", GroupID: 4, ValidityDays: 30, Price: 10})
+	require.ErrorIs(t, err, ErrGroupNotSubscriptionType)
+
+	zero := 0.0
+	plan, err := f.svc.Create(ctx, &CreateSubscriptionPlanInput{Name: " Pro ", GroupID: 3, ValidityDays: 30, Price: 10, DailyLimitUSD: &zero})
+	require.NoError(t, err)
+	require.Equal(t, "Pro", plan.Name)
+	require.Equal(t, SubscriptionPlanStatusActive, plan.Status)
+	require.Nil(t, plan.DailyLimitUSD)
+}
+
+func TestSubscriptionPlanService_UpdateClearsLimit(t *testing.T) {
+	f := newSubscriptionPlanFixture(0, false)
+
+	zero := 0.0
+	disabled := SubscriptionPlanStatusDisabled
+	plan, err := f.svc.Update(context.Background(), 5, &UpdateSubscriptionPlanInput{DailyLimitUSD: &zero, Status: &disabled})
+	require.NoError(t, err)
+	require.Nil(t, plan.DailyLimitUSD)
+	require.False(t, plan.IsActive())
+	require.Len(t, f.plans.updated, 1)
+}
+
+func TestSubscriptionPlanService_SetAutoRenew(t *testing.T) {
+	f := newSubscriptionPlanFixture(0, false)
+	f.subs.subs[2] = &UserSubscription{ID: 2, UserID: 7, GroupID: 9}
+	ctx := context.Background()
+
+	_, err := f.svc.SetAutoRenew(ctx, 8, 1, true)
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+
+	_, err = f.svc.SetAutoRenew(ctx, 7, 2, true)
+	require.ErrorIs(t, err, ErrSubscriptionNotRenewable)
+
+	sub, err := f.svc.SetAutoRenew(ctx, 7, 1, true)
+	require.NoError(t, err)
+	require.True(t, sub.AutoRenew)
+	require.True(t, f.subs.autoRenew[1])
+
+	// 非套餐订阅允许关闭
+	_, err = f.svc.SetAutoRenew(ctx, 7, 2, false)
+	require.NoError(t, err)
+}
+
+func TestSubscriptionPlanService_ProcessRenewalsRenews(t *testing.T) {
+	f := newSubscriptionPlanFixture(25, true)
+
+	processed, err := f.svc.ProcessRenewals(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, processed)
+
+	require.InDelta(t, 15.0, f.users.user.Balance, 1e-9)
+	require.Len(t, f.users.deducted, 1)
+	require.Equal(t, BalanceSourceSubscriptionRenewal, f.users.deducted[0].Type)
+	require.Equal(t, "1", f.users.deducted[0].ReferenceID)
+
+	sub := f.subs.subs[1]
+	require.True(t, sub.ExpiresAt.Equal(f.expireAt.AddDate(0, 0, 30)))
+	require.NotNil(t, sub.DailyLimitUSD)
+	require.InDelta(t, 5.0, *sub.DailyLimitUSD, 1e-9)
+
+	require.Equal(t, []string{"u@example.com"}, f.mailer.to)
+	require.Contains(t, f.mailer.subjects[0], "已自动续费")
+
+	// 同一到期周期不会重复处理
+	processed, err = f.svc.ProcessRenewals(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, processed)
+	require.Len(t, f.users.deducted, 1)
+	require.Len(t, f.mailer.to, 1)
+}
+
+func TestSubscriptionPlanService_ProcessRenewalsInsufficientBalance(t *testing.T) {
+	f := newSubscriptionPlanFixture(3, true)
+
+	processed, err := f.svc.ProcessRenewals(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, processed)
+	require.Empty(t, f.users.deducted)
+	require.True(t, f.subs.subs[1].ExpiresAt.Equal(f.expireAt))
+	require.Len(t, f.mailer.subjects, 1)
+	require.Contains(t, f.mailer.subjects[0], "自动续费失败")
+	require.True(t, f.subs.claimed[1].Equal(f.expireAt))
+}
+
+func TestSubscriptionPlanService_ProcessRenewalsNoticeOnly(t *testing.T) {
+	f := newSubscriptionPlanFixture(100, false)
+
+	_, err := f.svc.ProcessRenewals(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, f.users.deducted)
+	require.Len(t, f.mailer.subjects, 1)
+	require.True(t, strings.HasSuffix(f.mailer.subjects[0], "订阅即将到期"))
+}
+
+func TestSubscriptionPlanService_ProcessRenewalsPlanDisabled(t *testing.T) {
+	f := newSubscriptionPlanFixture(100, true)
+	f.plans.plans[5].Status = SubscriptionPlanStatusDisabled
+
+	_, err := f.svc.ProcessRenewals(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, f.users.deducted)
+	require.Contains(t, f.mailer.subjects[0], "自动续费失败")
+}
+
+func TestUserSubscription_EffectiveGroup(t *testing.T) {
+	groupDaily, groupWeekly := 10.0, 50.0
+	group := &Group{ID: 3, DailyLimitUSD: &groupDaily, WeeklyLimitUSD: &groupWeekly}
+	daily := 2.0
+	sub := &UserSubscription{DailyLimitUSD: &daily}
+
+	eff := sub.EffectiveGroup(group)
+	require.InDelta(t, 2.0, *eff.DailyLimitUSD, 1e-9)
+	require.InDelta(t, 50.0, *eff.WeeklyLimitUSD, 1e-9)
+	require.Nil(t, eff.MonthlyLimitUSD)
+	// 原分组不被修改
+	require.InDelta(t, 10.0, *group.DailyLimitUSD, 1e-9)
+	require.Same(t, group, (&UserSubscription{}).EffectiveGroup(group))
+}
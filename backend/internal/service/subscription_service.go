@@ -200,6 +200,41 @@ func (s *SubscriptionService) AssignOrExtendSubscription(ctx context.Context, in
 	return sub, false, nil // false 表示是新建
 }
 
+// ApplyPlan 开通或续期套餐对应分组的订阅（规则同 AssignOrExtendSubscription），并把套餐限额快照到订阅上。
+// 在事务上下文中调用时与调用方的其他写入（订单状态、余额扣费）一起提交。
+func (s *SubscriptionService) ApplyPlan(ctx context.Context, userID int64, plan *SubscriptionPlan, notes string) (*UserSubscription, error) {
+	sub, _, err := s.AssignOrExtendSubscription(ctx, &AssignSubscriptionInput{
+		UserID:       userID,
+		GroupID:      plan.GroupID,
+		ValidityDays: plan.ValidityDays,
+		Notes:        notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userSubRepo.ApplyPlan(ctx, sub.ID, plan); err != nil {
+		return nil, fmt.Errorf("apply subscription plan: %w", err)
+	}
+	planID := plan.ID
+	sub.PlanID = &planID
+	sub.DailyLimitUSD = plan.DailyLimitUSD
+	sub.WeeklyLimitUSD = plan.WeeklyLimitUSD
+	sub.MonthlyLimitUSD = plan.MonthlyLimitUSD
+	return sub, nil
+}
+
+// invalidateSubscriptionCache 异步失效订阅缓存；事务内的变更应在提交后调用
+func (s *SubscriptionService) invalidateSubscriptionCache(userID, groupID int64) {
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateSubscription(cacheCtx, userID, groupID)
+	}()
+}
+
 // createSubscription 创建新订阅（内部方法）
 func (s *SubscriptionService) createSubscription(ctx context.Context, input *AssignSubscriptionInput) (*UserSubscription, error) {
 	validityDays := input.ValidityDays
@@ -389,6 +424,21 @@ func (s *SubscriptionService) GetActiveSubscription(ctx context.Context, userID,
 	return sub, nil
 }
 
+// ResolveActiveSubscription 获取 API Key 请求可用的订阅：优先使用用户自己的订阅，
+// 组织成员没有自己的订阅时使用组织 owner 名下的订阅。组织不单独持有订阅，owner 的订阅即组织的共享订阅，
+// 其日/周/月限额由全体成员共同消耗，成员月度花费上限另行约束（见 BillingCacheService.CheckBillingEligibility）。
+func (s *SubscriptionService) ResolveActiveSubscription(ctx context.Context, user *User, groupID int64) (*UserSubscription, error) {
+	sub, err := s.GetActiveSubscription(ctx, user.ID, groupID)
+	if err == nil {
+		return sub, nil
+	}
+	org := user.Organization
+	if org == nil || !org.IsActive() || org.OwnerUserID == user.ID {
+		return nil, err
+	}
+	return s.GetActiveSubscription(ctx, org.OwnerUserID, groupID)
+}
+
 // ListUserSubscriptions 获取用户的所有订阅
 func (s *SubscriptionService) ListUserSubscriptions(ctx context.Context, userID int64) ([]UserSubscription, error) {
 	subs, err := s.userSubRepo.ListByUserID(ctx, userID)
@@ -585,6 +635,7 @@ func (s *SubscriptionService) GetSubscriptionProgress(ctx context.Context, subsc
 			return nil, err
 		}
 	}
+	group = sub.EffectiveGroup(group)
 
 	progress := &SubscriptionProgress{
 		ID:            sub.ID,
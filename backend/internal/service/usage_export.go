@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	UsageExportStatusPending   = "pending"
+	UsageExportStatusRunning   = "running"
+	UsageExportStatusSucceeded = "succeeded"
+	UsageExportStatusFailed    = "failed"
+	UsageExportStatusCanceled  = "canceled"
+)
+
+const (
+	UsageExportFormatCSV   = "csv"
+	UsageExportFormatJSONL = "jsonl"
+)
+
+const (
+	// UsageExportDestinationLocal 导出文件保存在本地目录，通过管理端下载
+	UsageExportDestinationLocal = "local"
+	// UsageExportDestinationS3 导出完成后上传到 S3 兼容对象存储（本地文件同样保留可下载）
+	UsageExportDestinationS3 = "s3"
+)
+
+var ErrUsageExportTaskNotFound = infraerrors.NotFound("USAGE_EXPORT_TASK_NOT_FOUND", "usage export task not found")
+
+// UsageExportFilters 定义导出条件，与 /admin/usage 列表的过滤条件一致
+// 时间范围为左闭右开区间 [StartTime, EndTime)，JSON 序列化用于存储任务参数
+type UsageExportFilters struct {
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	UserID      *int64    `json:"user_id,omitempty"`
+	APIKeyID    *int64    `json:"api_key_id,omitempty"`
+	AccountID   *int64    `json:"account_id,omitempty"`
+	GroupID     *int64    `json:"group_id,omitempty"`
+	Model       *string   `json:"model,omitempty"`
+	Stream      *bool     `json:"stream,omitempty"`
+	BillingType *int8     `json:"billing_type,omitempty"`
+}
+
+// UsageExportCursor 导出游标，指向最后一条已写出的记录
+// 导出按 (created_at, id) 升序遍历，断点续跑时从游标之后继续
+type UsageExportCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+}
+
+// UsageExportTask 表示使用记录后台导出任务
+type UsageExportTask struct {
+	ID          int64
+	Status      string
+	Format      string
+	Destination string
+	Filters     UsageExportFilters
+	// CreatedBy 手动创建的管理员；定时任务为 nil
+	CreatedBy *int64
+	// ScheduledFor 定时任务对应的日期（UTC），手动任务为 nil
+	ScheduledFor *time.Time
+
+	RowsExported int64
+	Cursor       *UsageExportCursor
+	// FileSize 与 Cursor 对应的已落盘字节数，续跑时先截断到该长度
+	FileSize  int64
+	ObjectKey *string
+	ErrorMsg  *string
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// IsFinished 任务是否已结束（不会再被执行器处理）
+func (t *UsageExportTask) IsFinished() bool {
+	switch t.Status {
+	case UsageExportStatusSucceeded, UsageExportStatusFailed, UsageExportStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// UsageExportRepository 定义导出任务与导出数据读取的持久层接口
+type UsageExportRepository interface {
+	CreateTask(ctx context.Context, task *UsageExportTask) error
+	// CreateScheduledTask 幂等创建定时任务：同一 ScheduledFor 只会创建一次，返回是否新建
+	CreateScheduledTask(ctx context.Context, task *UsageExportTask) (bool, error)
+	ListTasks(ctx context.Context, params pagination.PaginationParams) ([]UsageExportTask, *pagination.PaginationResult, error)
+	// GetTask 查询任务；不存在时返回 ErrUsageExportTaskNotFound
+	GetTask(ctx context.Context, taskID int64) (*UsageExportTask, error)
+	// ClaimNextPendingTask 抢占下一条可执行任务，running 超过 staleRunningAfterSeconds 的任务允许重新抢占续跑
+	ClaimNextPendingTask(ctx context.Context, staleRunningAfterSeconds int64) (*UsageExportTask, error)
+	// UpdateTaskProgress 记录断点（游标、行数、已落盘字节数）
+	UpdateTaskProgress(ctx context.Context, taskID int64, rowsExported int64, cursor UsageExportCursor, fileSize int64) error
+	MarkTaskSucceeded(ctx context.Context, taskID int64, rowsExported int64, fileSize int64, objectKey *string) error
+	MarkTaskFailed(ctx context.Context, taskID int64, errorMsg string) error
+	// CancelTask 将任务标记为 canceled（仅允许 pending/running）
+	CancelTask(ctx context.Context, taskID int64) (bool, error)
+	// DeleteTask 删除已结束的任务记录
+	DeleteTask(ctx context.Context, taskID int64) (bool, error)
+
+	// ListUsageLogsForExport 在 [windowStart, windowEnd) 内按 (created_at, id) 升序读取 after 之后的记录
+	// 调用方按月切分时间窗口，使查询只命中单个 usage_logs 月分区
+	ListUsageLogsForExport(ctx context.Context, filters UsageExportFilters, windowStart, windowEnd time.Time, after *UsageExportCursor, limit int) ([]UsageLog, error)
+}
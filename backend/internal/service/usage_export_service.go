@@ -0,0 +1,706 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/objectstore"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	usageExportWorkerName = "usage_export_worker"
+)
+
+var (
+	ErrUsageExportDisabled    = infraerrors.New(http.StatusServiceUnavailable, "USAGE_EXPORT_DISABLED", "usage export is disabled")
+	ErrUsageExportS3Disabled  = infraerrors.BadRequest("USAGE_EXPORT_S3_DISABLED", "object storage is not configured")
+	ErrUsageExportNotReady    = infraerrors.New(http.StatusConflict, "USAGE_EXPORT_NOT_READY", "usage export is not finished yet")
+	ErrUsageExportFileMissing = infraerrors.New(http.StatusGone, "USAGE_EXPORT_FILE_MISSING", "usage export file no longer exists")
+	ErrUsageExportConflict    = infraerrors.New(http.StatusConflict, "USAGE_EXPORT_STATUS_CONFLICT", "usage export task cannot be changed in current status")
+)
+
+// usageExportUploader 对象存储上传接口（便于测试替换）
+type usageExportUploader interface {
+	PutObject(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error
+}
+
+// UsageExportService 负责使用记录的流式导出与后台导出任务
+//
+// 后台任务按 (created_at, id) 升序分批写入本地文件，每批落盘后记录游标与文件长度；
+// 进程退出或超时后，执行器会重新抢占 running 任务，截断到最后一次记录的长度并从游标续跑。
+type UsageExportService struct {
+	repo        UsageExportRepository
+	timingWheel *TimingWheelService
+	cfg         *config.Config
+	uploader    usageExportUploader
+	now         func() time.Time
+
+	running     int32
+	lastNightly atomic.Value // time.Time，最近一次已确认创建的定时导出日期
+	startOnce   sync.Once
+	stopOnce    sync.Once
+
+	workerCtx    context.Context
+	workerCancel context.CancelFunc
+}
+
+func NewUsageExportService(repo UsageExportRepository, timingWheel *TimingWheelService, cfg *config.Config) *UsageExportService {
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	svc := &UsageExportService{
+		repo:         repo,
+		timingWheel:  timingWheel,
+		cfg:          cfg,
+		now:          time.Now,
+		workerCtx:    workerCtx,
+		workerCancel: workerCancel,
+	}
+	if cfg != nil && cfg.UsageExport.S3.Enabled {
+		s3 := cfg.UsageExport.S3
+		client, err := objectstore.New(objectstore.Config{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+			UsePathStyle:    s3.UsePathStyle,
+		})
+		if err != nil {
+			log.Printf("[UsageExport] object storage disabled: %v", err)
+		} else {
+			svc.uploader = client
+		}
+	}
+	return svc
+}
+
+func (s *UsageExportService) Start() {
+	if s == nil {
+		return
+	}
+	if s.cfg != nil && !s.cfg.UsageExport.Enabled {
+		log.Printf("[UsageExport] not started (disabled)")
+		return
+	}
+	if s.repo == nil || s.timingWheel == nil {
+		log.Printf("[UsageExport] not started (missing deps)")
+		return
+	}
+
+	interval := s.workerInterval()
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(usageExportWorkerName, interval, s.runOnce)
+		log.Printf("[UsageExport] started (interval=%s batch_size=%d dir=%s s3=%t nightly=%t)", interval, s.batchSize(), s.exportDir(), s.uploader != nil, s.nightlyEnabled())
+	})
+}
+
+func (s *UsageExportService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.workerCancel != nil {
+			s.workerCancel()
+		}
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(usageExportWorkerName)
+		}
+		log.Printf("[UsageExport] stopped")
+	})
+}
+
+// ValidateStreamFilters 校验直接流式下载的条件（时间范围不能超过 max_sync_range_days）
+func (s *UsageExportService) ValidateStreamFilters(filters UsageExportFilters) error {
+	return validateUsageExportRange(filters, s.maxSyncRangeDays(), true)
+}
+
+// Stream 按 (created_at, id) 升序遍历符合条件的使用记录
+func (s *UsageExportService) Stream(ctx context.Context, filters UsageExportFilters, fn func(*UsageLog) error) error {
+	sanitizeUsageExportFilters(&filters)
+	if err := s.ValidateStreamFilters(filters); err != nil {
+		return err
+	}
+	return s.scan(ctx, filters, nil, func(batch []UsageLog) error {
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteStream 将符合条件的记录写入 w，每 500 行刷新一次，返回已写出的行数
+// admin=false 时省略仅管理员可见的列
+func (s *UsageExportService) WriteStream(ctx context.Context, w io.Writer, filters UsageExportFilters, format string, admin bool) (int64, error) {
+	writer := NewUsageExportWriter(w, format, admin)
+	if err := writer.WriteHeader(); err != nil {
+		return 0, err
+	}
+	var rows int64
+	err := s.Stream(ctx, filters, func(l *UsageLog) error {
+		if err := writer.Write(l); err != nil {
+			return err
+		}
+		rows++
+		if rows%500 == 0 {
+			return writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, writer.Flush()
+}
+
+// UsageExportFileName 直接下载时的文件名（日期为区间首尾两天）
+func UsageExportFileName(filters UsageExportFilters, format string) string {
+	lastDay := filters.EndTime.Add(-time.Nanosecond)
+	return fmt.Sprintf("usage_%s_%s.%s", filters.StartTime.Format("20060102"), lastDay.Format("20060102"), format)
+}
+
+func (s *UsageExportService) ListTasks(ctx context.Context, params pagination.PaginationParams) ([]UsageExportTask, *pagination.PaginationResult, error) {
+	if s == nil || s.repo == nil {
+		return nil, nil, fmt.Errorf("usage export service not ready")
+	}
+	return s.repo.ListTasks(ctx, params)
+}
+
+// CreateTask 创建后台导出任务
+func (s *UsageExportService) CreateTask(ctx context.Context, filters UsageExportFilters, format, destination string, createdBy int64) (*UsageExportTask, error) {
+	if s == nil || s.repo == nil {
+		return nil, fmt.Errorf("usage export service not ready")
+	}
+	if s.cfg != nil && !s.cfg.UsageExport.Enabled {
+		return nil, ErrUsageExportDisabled
+	}
+	normalized, ok := NormalizeUsageExportFormat(format)
+	if !ok {
+		return nil, infraerrors.BadRequest("USAGE_EXPORT_INVALID_FORMAT", "format must be csv or jsonl")
+	}
+	switch destination {
+	case "", UsageExportDestinationLocal:
+		destination = UsageExportDestinationLocal
+	case UsageExportDestinationS3:
+		if s.uploader == nil {
+			return nil, ErrUsageExportS3Disabled
+		}
+	default:
+		return nil, infraerrors.BadRequest("USAGE_EXPORT_INVALID_DESTINATION", "destination must be local or s3")
+	}
+
+	sanitizeUsageExportFilters(&filters)
+	if err := validateUsageExportRange(filters, s.maxRangeDays(), false); err != nil {
+		return nil, err
+	}
+
+	task := &UsageExportTask{
+		Status:      UsageExportStatusPending,
+		Format:      normalized,
+		Destination: destination,
+		Filters:     filters,
+	}
+	if createdBy > 0 {
+		task.CreatedBy = &createdBy
+	}
+	if err := s.repo.CreateTask(ctx, task); err != nil {
+		return nil, fmt.Errorf("create usage export task: %w", err)
+	}
+	log.Printf("[UsageExport] create_task: task=%d operator=%d format=%s destination=%s %s", task.ID, createdBy, task.Format, task.Destination, describeUsageExportFilters(filters))
+	go s.runOnce()
+	return task, nil
+}
+
+// CancelTask 取消未完成的任务
+func (s *UsageExportService) CancelTask(ctx context.Context, taskID int64) error {
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.IsFinished() {
+		return ErrUsageExportConflict
+	}
+	ok, err := s.repo.CancelTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUsageExportConflict
+	}
+	log.Printf("[UsageExport] cancel_task: task=%d", taskID)
+	return nil
+}
+
+// DeleteTask 删除已结束的任务及其本地文件
+func (s *UsageExportService) DeleteTask(ctx context.Context, taskID int64) error {
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if !task.IsFinished() {
+		return ErrUsageExportConflict
+	}
+	if err := os.Remove(s.taskFilePath(task)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove export file: %w", err)
+	}
+	ok, err := s.repo.DeleteTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUsageExportTaskNotFound
+	}
+	return nil
+}
+
+// OpenTaskFile 打开已完成任务的导出文件，调用方负责关闭
+func (s *UsageExportService) OpenTaskFile(ctx context.Context, taskID int64) (*UsageExportTask, *os.File, error) {
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if task.Status != UsageExportStatusSucceeded {
+		return nil, nil, ErrUsageExportNotReady
+	}
+	f, err := os.Open(s.taskFilePath(task))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, ErrUsageExportFileMissing
+		}
+		return nil, nil, err
+	}
+	return task, f, nil
+}
+
+// DownloadName 导出文件的下载/对象名
+func (t *UsageExportTask) DownloadName() string {
+	if t.ScheduledFor != nil {
+		return fmt.Sprintf("usage_%s.%s", t.ScheduledFor.UTC().Format("2006-01-02"), t.Format)
+	}
+	lastDay := t.Filters.EndTime.Add(-time.Nanosecond)
+	return fmt.Sprintf("usage_export_%d_%s_%s.%s", t.ID, t.Filters.StartTime.UTC().Format("20060102"), lastDay.UTC().Format("20060102"), t.Format)
+}
+
+func (s *UsageExportService) runOnce() {
+	svc := s
+	if svc == nil {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&svc.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&svc.running, 0)
+
+	parent := context.Background()
+	if svc.workerCtx != nil {
+		parent = svc.workerCtx
+	}
+	ctx, cancel := context.WithTimeout(parent, svc.taskTimeout())
+	defer cancel()
+
+	svc.scheduleNightly(ctx)
+
+	task, err := svc.repo.ClaimNextPendingTask(ctx, int64(svc.taskTimeout().Seconds()))
+	if err != nil {
+		log.Printf("[UsageExport] claim pending task failed: %v", err)
+		return
+	}
+	if task == nil {
+		return
+	}
+	log.Printf("[UsageExport] task claimed: task=%d rows=%d resume=%t %s", task.ID, task.RowsExported, task.Cursor != nil, describeUsageExportFilters(task.Filters))
+	svc.executeTask(ctx, task)
+}
+
+// scheduleNightly 到达配置的小时后，为前一天（UTC）创建一次导出到对象存储的任务
+func (s *UsageExportService) scheduleNightly(ctx context.Context) {
+	if !s.nightlyEnabled() || s.uploader == nil {
+		return
+	}
+	now := s.now().UTC()
+	if now.Hour() < s.cfg.UsageExport.Nightly.HourUTC {
+		return
+	}
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	if last, ok := s.lastNightly.Load().(time.Time); ok && last.Equal(day) {
+		return
+	}
+
+	format, ok := NormalizeUsageExportFormat(s.cfg.UsageExport.Nightly.Format)
+	if !ok {
+		format = UsageExportFormatCSV
+	}
+	task := &UsageExportTask{
+		Status:       UsageExportStatusPending,
+		Format:       format,
+		Destination:  UsageExportDestinationS3,
+		Filters:      UsageExportFilters{StartTime: day, EndTime: day.AddDate(0, 0, 1)},
+		ScheduledFor: &day,
+	}
+	created, err := s.repo.CreateScheduledTask(ctx, task)
+	if err != nil {
+		log.Printf("[UsageExport] schedule nightly export failed: day=%s err=%v", day.Format("2006-01-02"), err)
+		return
+	}
+	s.lastNightly.Store(day)
+	if created {
+		log.Printf("[UsageExport] nightly export scheduled: task=%d day=%s", task.ID, day.Format("2006-01-02"))
+	}
+}
+
+func (s *UsageExportService) executeTask(ctx context.Context, task *UsageExportTask) {
+	start := time.Now()
+	path := s.taskFilePath(task)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o640)
+	if err != nil {
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	rows, cursor, err := prepareUsageExportFile(f, task)
+	if err != nil {
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+
+	writer := NewUsageExportWriter(f, task.Format, true)
+	if cursor == nil {
+		if err := writer.WriteHeader(); err != nil {
+			s.markTaskFailed(task.ID, err)
+			return
+		}
+	}
+
+	canceled := false
+	err = s.scan(ctx, task.Filters, cursor, func(batch []UsageLog) error {
+		if s.isTaskCanceled(task.ID) {
+			canceled = true
+			return errUsageExportStop
+		}
+		for i := range batch {
+			if err := writer.Write(&batch[i]); err != nil {
+				return err
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if err := f.Sync(); err != nil {
+			return err
+		}
+		size, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		rows += int64(len(batch))
+		last := batch[len(batch)-1]
+		cursor = &UsageExportCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+
+		updateCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err := s.repo.UpdateTaskProgress(updateCtx, task.ID, rows, *cursor, size); err != nil {
+			log.Printf("[UsageExport] task progress update failed: task=%d rows=%d err=%v", task.ID, rows, err)
+		}
+		return nil
+	})
+	if canceled {
+		log.Printf("[UsageExport] task canceled: task=%d rows=%d", task.ID, rows)
+		return
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			// 保持 running 状态，后续通过 stale reclaim 从游标续跑
+			log.Printf("[UsageExport] task interrupted: task=%d rows=%d err=%v", task.ID, rows, err)
+			return
+		}
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+	if err := writer.Flush(); err != nil {
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		s.markTaskFailed(task.ID, err)
+		return
+	}
+
+	var objectKey *string
+	if task.Destination == UsageExportDestinationS3 {
+		key, err := s.upload(ctx, task, f, size)
+		if err != nil {
+			s.markTaskFailed(task.ID, err)
+			return
+		}
+		objectKey = &key
+	}
+
+	updateCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.repo.MarkTaskSucceeded(updateCtx, task.ID, rows, size, objectKey); err != nil {
+		log.Printf("[UsageExport] update task succeeded failed: task=%d err=%v", task.ID, err)
+		return
+	}
+	log.Printf("[UsageExport] task succeeded: task=%d rows=%d bytes=%d duration=%s", task.ID, rows, size, time.Since(start))
+}
+
+var errUsageExportStop = errors.New("usage export stopped")
+
+// prepareUsageExportFile 根据任务断点恢复文件状态，返回已导出行数与续跑游标
+// 文件比记录的长度短（被删除或损坏）时从头开始导出
+func prepareUsageExportFile(f *os.File, task *UsageExportTask) (int64, *UsageExportCursor, error) {
+	restart := func() (int64, *UsageExportCursor, error) {
+		if err := f.Truncate(0); err != nil {
+			return 0, nil, err
+		}
+		_, err := f.Seek(0, io.SeekStart)
+		return 0, nil, err
+	}
+	if task.Cursor == nil || task.FileSize <= 0 {
+		return restart()
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return 0, nil, err
+	}
+	if info.Size() < task.FileSize {
+		log.Printf("[UsageExport] task file shorter than checkpoint, restarting: task=%d size=%d checkpoint=%d", task.ID, info.Size(), task.FileSize)
+		return restart()
+	}
+	// 丢弃最后一次断点之后写入但未记录的数据
+	if err := f.Truncate(task.FileSize); err != nil {
+		return 0, nil, err
+	}
+	if _, err := f.Seek(task.FileSize, io.SeekStart); err != nil {
+		return 0, nil, err
+	}
+	cursor := *task.Cursor
+	return task.RowsExported, &cursor, nil
+}
+
+func (s *UsageExportService) upload(ctx context.Context, task *UsageExportTask, f *os.File, size int64) (string, error) {
+	if s.uploader == nil {
+		return "", ErrUsageExportS3Disabled
+	}
+	key := s.objectKey(task)
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	if err := s.uploader.PutObject(ctx, key, f, size, UsageExportContentType(task.Format)); err != nil {
+		return "", err
+	}
+	log.Printf("[UsageExport] task uploaded: task=%d key=%s bytes=%d", task.ID, key, size)
+	return key, nil
+}
+
+func (s *UsageExportService) objectKey(task *UsageExportTask) string {
+	prefix := ""
+	if s.cfg != nil {
+		prefix = strings.TrimLeft(s.cfg.UsageExport.S3.Prefix, "/")
+		if prefix != "" && !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+	}
+	day := task.Filters.StartTime.UTC()
+	return fmt.Sprintf("%susage/%04d/%02d/%s", prefix, day.Year(), int(day.Month()), task.DownloadName())
+}
+
+// scan 按月窗口分批读取，after 非空时从游标之后继续
+func (s *UsageExportService) scan(ctx context.Context, filters UsageExportFilters, after *UsageExportCursor, fn func([]UsageLog) error) error {
+	batchSize := s.batchSize()
+	for _, window := range usageExportWindows(filters.StartTime, filters.EndTime) {
+		if after != nil && !after.CreatedAt.Before(window[1]) {
+			continue
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			batch, err := s.repo.ListUsageLogsForExport(ctx, filters, window[0], window[1], after, batchSize)
+			if err != nil {
+				return err
+			}
+			if len(batch) == 0 {
+				break
+			}
+			if err := fn(batch); err != nil {
+				return err
+			}
+			last := batch[len(batch)-1]
+			after = &UsageExportCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+			if len(batch) < batchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// usageExportWindows 将 [start, end) 按自然月（UTC）切分，与 usage_logs 的月分区边界对齐
+func usageExportWindows(start, end time.Time) [][2]time.Time {
+	start, end = start.UTC(), end.UTC()
+	var windows [][2]time.Time
+	for cur := start; cur.Before(end); {
+		next := time.Date(cur.Year(), cur.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+		if next.After(end) {
+			next = end
+		}
+		windows = append(windows, [2]time.Time{cur, next})
+		cur = next
+	}
+	return windows
+}
+
+func (s *UsageExportService) isTaskCanceled(taskID int64) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return errors.Is(err, ErrUsageExportTaskNotFound)
+	}
+	return task.Status == UsageExportStatusCanceled
+}
+
+func (s *UsageExportService) markTaskFailed(taskID int64, err error) {
+	msg := strings.TrimSpace(err.Error())
+	if len(msg) > 500 {
+		msg = msg[:500]
+	}
+	log.Printf("[UsageExport] task failed: task=%d err=%s", taskID, msg)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if updateErr := s.repo.MarkTaskFailed(ctx, taskID, msg); updateErr != nil {
+		log.Printf("[UsageExport] update task failed failed: task=%d err=%v", taskID, updateErr)
+	}
+}
+
+func (s *UsageExportService) taskFilePath(task *UsageExportTask) string {
+	return filepath.Join(s.exportDir(), fmt.Sprintf("usage_export_%d.%s", task.ID, task.Format))
+}
+
+func validateUsageExportRange(filters UsageExportFilters, maxDays int, sync bool) error {
+	if filters.StartTime.IsZero() || filters.EndTime.IsZero() {
+		return infraerrors.BadRequest("USAGE_EXPORT_MISSING_RANGE", "start_date and end_date are required")
+	}
+	if !filters.EndTime.After(filters.StartTime) {
+		return infraerrors.BadRequest("USAGE_EXPORT_INVALID_RANGE", "end_date must be after start_date")
+	}
+	if maxDays > 0 && filters.EndTime.Sub(filters.StartTime) > time.Duration(maxDays)*24*time.Hour {
+		if sync {
+			return infraerrors.BadRequest("USAGE_EXPORT_RANGE_TOO_LARGE", fmt.Sprintf("date range exceeds %d days, create a background export instead", maxDays))
+		}
+		return infraerrors.BadRequest("USAGE_EXPORT_RANGE_TOO_LARGE", fmt.Sprintf("date range exceeds %d days", maxDays))
+	}
+	return nil
+}
+
+func sanitizeUsageExportFilters(filters *UsageExportFilters) {
+	if filters.UserID != nil && *filters.UserID <= 0 {
+		filters.UserID = nil
+	}
+	if filters.APIKeyID != nil && *filters.APIKeyID <= 0 {
+		filters.APIKeyID = nil
+	}
+	if filters.AccountID != nil && *filters.AccountID <= 0 {
+		filters.AccountID = nil
+	}
+	if filters.GroupID != nil && *filters.GroupID <= 0 {
+		filters.GroupID = nil
+	}
+	if filters.Model != nil {
+		model := strings.TrimSpace(*filters.Model)
+		if model == "" {
+			filters.Model = nil
+		} else {
+			filters.Model = &model
+		}
+	}
+}
+
+func describeUsageExportFilters(filters UsageExportFilters) string {
+	parts := []string{
+		"start=" + filters.StartTime.UTC().Format(time.RFC3339),
+		"end=" + filters.EndTime.UTC().Format(time.RFC3339),
+	}
+	if filters.UserID != nil {
+		parts = append(parts, fmt.Sprintf("user_id=%d", *filters.UserID))
+	}
+	if filters.APIKeyID != nil {
+		parts = append(parts, fmt.Sprintf("api_key_id=%d", *filters.APIKeyID))
+	}
+	if filters.AccountID != nil {
+		parts = append(parts, fmt.Sprintf("account_id=%d", *filters.AccountID))
+	}
+	if filters.GroupID != nil {
+		parts = append(parts, fmt.Sprintf("group_id=%d", *filters.GroupID))
+	}
+	if filters.Model != nil {
+		parts = append(parts, "model="+*filters.Model)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (s *UsageExportService) nightlyEnabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.UsageExport.Nightly.Enabled
+}
+
+func (s *UsageExportService) exportDir() string {
+	if s == nil || s.cfg == nil || strings.TrimSpace(s.cfg.UsageExport.Dir) == "" {
+		return "./data/exports"
+	}
+	return s.cfg.UsageExport.Dir
+}
+
+func (s *UsageExportService) maxSyncRangeDays() int {
+	if s == nil || s.cfg == nil {
+		return 31
+	}
+	return s.cfg.UsageExport.MaxSyncRangeDays
+}
+
+func (s *UsageExportService) maxRangeDays() int {
+	if s == nil || s.cfg == nil || s.cfg.UsageExport.MaxRangeDays <= 0 {
+		return 366
+	}
+	return s.cfg.UsageExport.MaxRangeDays
+}
+
+func (s *UsageExportService) batchSize() int {
+	if s == nil || s.cfg == nil || s.cfg.UsageExport.BatchSize <= 0 {
+		return 2000
+	}
+	return s.cfg.UsageExport.BatchSize
+}
+
+func (s *UsageExportService) workerInterval() time.Duration {
+	if s == nil || s.cfg == nil || s.cfg.UsageExport.WorkerIntervalSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(s.cfg.UsageExport.WorkerIntervalSeconds) * time.Second
+}
+
+func (s *UsageExportService) taskTimeout() time.Duration {
+	if s == nil || s.cfg == nil || s.cfg.UsageExport.TaskTimeoutSeconds <= 0 {
+		return time.Hour
+	}
+	return time.Duration(s.cfg.UsageExport.TaskTimeoutSeconds) * time.Second
+}
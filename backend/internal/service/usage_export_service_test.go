@@ -0,0 +1,451 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type exportRepoStub struct {
+	mu        sync.Mutex
+	tasks     map[int64]*UsageExportTask
+	logs      []UsageLog
+	nextID    int64
+	listCalls int
+	// onList 在每次读取批次后调用，用于模拟执行中断
+	onList func(call int)
+}
+
+func newExportRepoStub(logs []UsageLog) *exportRepoStub {
+	sorted := append([]UsageLog(nil), logs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+	return &exportRepoStub{tasks: map[int64]*UsageExportTask{}, logs: sorted}
+}
+
+func (s *exportRepoStub) CreateTask(ctx context.Context, task *UsageExportTask) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	task.ID = s.nextID
+	cp := *task
+	s.tasks[task.ID] = &cp
+	return nil
+}
+
+func (s *exportRepoStub) CreateScheduledTask(ctx context.Context, task *UsageExportTask) (bool, error) {
+	s.mu.Lock()
+	for _, t := range s.tasks {
+		if t.ScheduledFor != nil && t.ScheduledFor.Equal(*task.ScheduledFor) {
+			s.mu.Unlock()
+			return false, nil
+		}
+	}
+	s.mu.Unlock()
+	return true, s.CreateTask(ctx, task)
+}
+
+func (s *exportRepoStub) ListTasks(ctx context.Context, params pagination.PaginationParams) ([]UsageExportTask, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (s *exportRepoStub) GetTask(ctx context.Context, taskID int64) (*UsageExportTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[taskID]
+	if !ok {
+		return nil, ErrUsageExportTaskNotFound
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (s *exportRepoStub) ClaimNextPendingTask(ctx context.Context, staleRunningAfterSeconds int64) (*UsageExportTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id := int64(1); id <= s.nextID; id++ {
+		t, ok := s.tasks[id]
+		if ok && (t.Status == UsageExportStatusPending || t.Status == UsageExportStatusRunning) {
+			t.Status = UsageExportStatusRunning
+			cp := *t
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *exportRepoStub) UpdateTaskProgress(ctx context.Context, taskID int64, rowsExported int64, cursor UsageExportCursor, fileSize int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.tasks[taskID]
+	t.RowsExported = rowsExported
+	t.Cursor = &cursor
+	t.FileSize = fileSize
+	return nil
+}
+
+func (s *exportRepoStub) MarkTaskSucceeded(ctx context.Context, taskID int64, rowsExported int64, fileSize int64, objectKey *string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.tasks[taskID]
+	t.Status = UsageExportStatusSucceeded
+	t.RowsExported = rowsExported
+	t.FileSize = fileSize
+	t.ObjectKey = objectKey
+	return nil
+}
+
+func (s *exportRepoStub) MarkTaskFailed(ctx context.Context, taskID int64, errorMsg string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.tasks[taskID]
+	t.Status = UsageExportStatusFailed
+	t.ErrorMsg = &errorMsg
+	return nil
+}
+
+func (s *exportRepoStub) CancelTask(ctx context.Context, taskID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[taskID]
+	if !ok || t.IsFinished() {
+		return false, nil
+	}
+	t.Status = UsageExportStatusCanceled
+	return true, nil
+}
+
+func (s *exportRepoStub) DeleteTask(ctx context.Context, taskID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[taskID]; !ok {
+		return false, nil
+	}
+	delete(s.tasks, taskID)
+	return true, nil
+}
+
+func (s *exportRepoStub) ListUsageLogsForExport(ctx context.Context, filters UsageExportFilters, windowStart, windowEnd time.Time, after *UsageExportCursor, limit int) ([]UsageLog, error) {
+	s.mu.Lock()
+	out := make([]UsageLog, 0, limit)
+	for _, l := range s.logs {
+		if l.CreatedAt.Before(windowStart) || !l.CreatedAt.Before(windowEnd) {
+			continue
+		}
+		if filters.UserID != nil && l.UserID != *filters.UserID {
+			continue
+		}
+		if after != nil && (l.CreatedAt.Before(after.CreatedAt) || (l.CreatedAt.Equal(after.CreatedAt) && l.ID <= after.ID)) {
+			continue
+		}
+		out = append(out, l)
+		if len(out) == limit {
+			break
+		}
+	}
+	s.listCalls++
+	call := s.listCalls
+	onList := s.onList
+	s.mu.Unlock()
+	if onList != nil {
+		onList(call)
+	}
+	return out, nil
+}
+
+type uploaderStub struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (u *uploaderStub) PutObject(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.objects == nil {
+		u.objects = map[string][]byte{}
+	}
+	u.objects[key] = data
+	return nil
+}
+
+func exportTestLogs(start time.Time, count int, step time.Duration) []UsageLog {
+	logs := make([]UsageLog, 0, count)
+	for i := 0; i < count; i++ {
+		logs = append(logs, UsageLog{
+			ID:        int64(i + 1),
+			UserID:    int64(1 + i%2),
+			APIKeyID:  10,
+			AccountID: 20,
+			RequestID: "req-" + strconv.Itoa(i),
+			Model:     "claude-sonnet-4",
+			CreatedAt: start.Add(time.Duration(i) * step),
+			IPAddress: strPtrForExport("10.0.0.1"),
+			UserAgent: strPtrForExport("=cmd|' /C calc'!A0"),
+		})
+	}
+	return logs
+}
+
+func strPtrForExport(s string) *string { return &s }
+
+func newExportTestService(t *testing.T, repo UsageExportRepository, batchSize int) *UsageExportService {
+	t.Helper()
+	cfg := &config.Config{UsageExport: config.UsageExportConfig{
+		Enabled:          true,
+		Dir:              t.TempDir(),
+		MaxSyncRangeDays: 7,
+		MaxRangeDays:     366,
+		BatchSize:        batchSize,
+	}}
+	return NewUsageExportService(repo, nil, cfg)
+}
+
+func TestUsageExportWindowsAlignWithMonths(t *testing.T) {
+	start := time.Date(2026, 1, 20, 8, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	windows := usageExportWindows(start, end)
+	require.Equal(t, [][2]time.Time{
+		{start, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), end},
+	}, windows)
+	require.Empty(t, usageExportWindows(end, start))
+}
+
+func TestUsageExportWriterOmitsAdminColumnsForUsers(t *testing.T) {
+	logs := exportTestLogs(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 1, time.Minute)
+
+	var buf bytes.Buffer
+	w := NewUsageExportWriter(&buf, UsageExportFormatCSV, false)
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.Write(&logs[0]))
+	require.NoError(t, w.Flush())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.NotContains(t, records[0], "ip_address")
+	require.NotContains(t, records[0], "account_id")
+	require.Contains(t, records[0], "api_key_id")
+
+	uaIdx := -1
+	for i, name := range records[0] {
+		if name == "user_agent" {
+			uaIdx = i
+		}
+	}
+	require.GreaterOrEqual(t, uaIdx, 0)
+	require.True(t, strings.HasPrefix(records[1][uaIdx], "'="), "formula-like cell should be escaped")
+}
+
+func TestUsageExportWriterJSONLKeepsColumnOrder(t *testing.T) {
+	logs := exportTestLogs(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 1, time.Minute)
+
+	var buf bytes.Buffer
+	w := NewUsageExportWriter(&buf, UsageExportFormatJSONL, true)
+	require.NoError(t, w.WriteHeader())
+	require.NoError(t, w.Write(&logs[0]))
+	require.NoError(t, w.Flush())
+
+	line := strings.TrimSpace(buf.String())
+	require.True(t, strings.HasPrefix(line, `{"id":1,"created_at":"2026-01-01T00:00:00Z"`), line)
+	require.Contains(t, line, `"ip_address":"10.0.0.1"`)
+	require.Equal(t, 1, strings.Count(buf.String(), "\n"))
+}
+
+func TestUsageExportStreamRejectsLargeRange(t *testing.T) {
+	svc := newExportTestService(t, newExportRepoStub(nil), 10)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := svc.ValidateStreamFilters(UsageExportFilters{StartTime: start, EndTime: start.AddDate(0, 0, 8)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "background export")
+
+	require.NoError(t, svc.ValidateStreamFilters(UsageExportFilters{StartTime: start, EndTime: start.AddDate(0, 0, 7)}))
+	require.Error(t, svc.ValidateStreamFilters(UsageExportFilters{StartTime: start}))
+}
+
+func TestUsageExportStreamCrossesMonthBoundary(t *testing.T) {
+	start := time.Date(2026, 1, 31, 22, 0, 0, 0, time.UTC)
+	repo := newExportRepoStub(exportTestLogs(start, 10, 30*time.Minute))
+	svc := newExportTestService(t, repo, 3)
+
+	var ids []int64
+	err := svc.Stream(context.Background(), UsageExportFilters{StartTime: start, EndTime: start.Add(24 * time.Hour)}, func(l *UsageLog) error {
+		ids = append(ids, l.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+}
+
+func TestUsageExportTaskResumesFromCursor(t *testing.T) {
+	start := time.Date(2026, 1, 31, 20, 0, 0, 0, time.UTC)
+	filters := UsageExportFilters{StartTime: start, EndTime: start.Add(24 * time.Hour)}
+	logs := exportTestLogs(start, 25, 20*time.Minute)
+
+	// 基准：一次性完成的导出
+	fullRepo := newExportRepoStub(logs)
+	fullSvc := newExportTestService(t, fullRepo, 4)
+	fullTask := &UsageExportTask{Status: UsageExportStatusPending, Format: UsageExportFormatCSV, Filters: filters}
+	require.NoError(t, fullRepo.CreateTask(context.Background(), fullTask))
+	fullSvc.runOnce()
+	require.Equal(t, UsageExportStatusSucceeded, fullRepo.tasks[fullTask.ID].Status)
+	expected, err := os.ReadFile(fullSvc.taskFilePath(fullTask))
+	require.NoError(t, err)
+
+	// 第三个批次读取后中断
+	repo := newExportRepoStub(logs)
+	svc := newExportTestService(t, repo, 4)
+	task := &UsageExportTask{Status: UsageExportStatusPending, Format: UsageExportFormatCSV, Filters: filters}
+	require.NoError(t, repo.CreateTask(context.Background(), task))
+	repo.onList = func(call int) {
+		if call == 3 {
+			svc.workerCancel()
+		}
+	}
+	svc.runOnce()
+
+	interrupted := repo.tasks[task.ID]
+	require.Equal(t, UsageExportStatusRunning, interrupted.Status)
+	require.NotNil(t, interrupted.Cursor)
+	require.Equal(t, int64(12), interrupted.RowsExported)
+
+	// 模拟断点之后写入但未记录的半截数据
+	path := svc.taskFilePath(task)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o640)
+	require.NoError(t, err)
+	_, err = f.WriteString("partial,row")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	resumed := newExportTestService(t, repo, 4)
+	resumed.cfg.UsageExport.Dir = svc.cfg.UsageExport.Dir
+	repo.onList = nil
+	resumed.runOnce()
+
+	done := repo.tasks[task.ID]
+	require.Equal(t, UsageExportStatusSucceeded, done.Status)
+	require.Equal(t, int64(25), done.RowsExported)
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(got))
+	require.Equal(t, int64(len(got)), done.FileSize)
+}
+
+func TestUsageExportTaskRestartsWhenFileMissing(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	repo := newExportRepoStub(exportTestLogs(start, 5, time.Minute))
+	svc := newExportTestService(t, repo, 2)
+	task := &UsageExportTask{
+		Status:       UsageExportStatusRunning,
+		Format:       UsageExportFormatJSONL,
+		Filters:      UsageExportFilters{StartTime: start, EndTime: start.Add(time.Hour)},
+		RowsExported: 2,
+		Cursor:       &UsageExportCursor{CreatedAt: start.Add(time.Minute), ID: 2},
+		FileSize:     100,
+	}
+	require.NoError(t, repo.CreateTask(context.Background(), task))
+
+	svc.runOnce()
+
+	done := repo.tasks[task.ID]
+	require.Equal(t, UsageExportStatusSucceeded, done.Status)
+	require.Equal(t, int64(5), done.RowsExported)
+	got, err := os.ReadFile(filepath.Join(svc.exportDir(), "usage_export_1.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, 5, strings.Count(string(got), "\n"))
+}
+
+func TestUsageExportTaskStopsWhenCanceled(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	repo := newExportRepoStub(exportTestLogs(start, 10, time.Minute))
+	svc := newExportTestService(t, repo, 2)
+	task := &UsageExportTask{Status: UsageExportStatusPending, Format: UsageExportFormatCSV, Filters: UsageExportFilters{StartTime: start, EndTime: start.Add(time.Hour)}}
+	require.NoError(t, repo.CreateTask(context.Background(), task))
+	repo.onList = func(call int) {
+		if call == 2 {
+			_, _ = repo.CancelTask(context.Background(), task.ID)
+		}
+	}
+
+	svc.runOnce()
+
+	done := repo.tasks[task.ID]
+	require.Equal(t, UsageExportStatusCanceled, done.Status)
+	require.Equal(t, int64(2), done.RowsExported)
+}
+
+func TestUsageExportNightlyUploadsPreviousDay(t *testing.T) {
+	day := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	logs := append(exportTestLogs(day.Add(-time.Hour), 3, time.Hour), exportTestLogs(day.Add(25*time.Hour), 1, time.Minute)...)
+	for i := range logs {
+		logs[i].ID = int64(i + 1)
+	}
+	repo := newExportRepoStub(logs)
+	svc := newExportTestService(t, repo, 100)
+	svc.cfg.UsageExport.Nightly = config.UsageExportNightlyConfig{Enabled: true, HourUTC: 1, Format: "csv"}
+	svc.cfg.UsageExport.S3.Prefix = "finance"
+	uploader := &uploaderStub{}
+	svc.uploader = uploader
+
+	// 未到配置的小时不调度
+	svc.now = func() time.Time { return day.Add(24*time.Hour + 30*time.Minute) }
+	svc.runOnce()
+	require.Empty(t, repo.tasks)
+
+	svc.now = func() time.Time { return day.Add(24*time.Hour + 2*time.Hour) }
+	svc.runOnce()
+	svc.runOnce()
+	require.Len(t, repo.tasks, 1)
+
+	task := repo.tasks[1]
+	require.Equal(t, UsageExportStatusSucceeded, task.Status)
+	require.Equal(t, int64(2), task.RowsExported)
+	require.NotNil(t, task.ObjectKey)
+	require.Equal(t, "finance/usage/2026/03/usage_2026-03-14.csv", *task.ObjectKey)
+	body, ok := uploader.objects[*task.ObjectKey]
+	require.True(t, ok)
+	require.Equal(t, 3, strings.Count(string(body), "\n"))
+
+	// 进程重启后同一天不会重复创建
+	svc.lastNightly.Store(time.Time{})
+	svc.runOnce()
+	require.Len(t, repo.tasks, 1)
+}
+
+func TestUsageExportCreateTaskRequiresObjectStorageForS3(t *testing.T) {
+	svc := newExportTestService(t, newExportRepoStub(nil), 10)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := svc.CreateTask(context.Background(), UsageExportFilters{StartTime: start, EndTime: start.AddDate(0, 1, 0)}, "csv", UsageExportDestinationS3, 1)
+	require.ErrorIs(t, err, ErrUsageExportS3Disabled)
+
+	_, err = svc.CreateTask(context.Background(), UsageExportFilters{StartTime: start, EndTime: start.AddDate(2, 0, 0)}, "csv", "", 1)
+	require.Error(t, err)
+
+	_, err = svc.CreateTask(context.Background(), UsageExportFilters{StartTime: start, EndTime: start.AddDate(0, 1, 0)}, "xlsx", "", 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// usageExportColumn 导出列定义；adminOnly 列不会出现在用户自助导出中
+type usageExportColumn struct {
+	name      string
+	adminOnly bool
+	value     func(l *UsageLog) any
+}
+
+var usageExportColumns = []usageExportColumn{
+	{name: "id", value: func(l *UsageLog) any { return l.ID }},
+	{name: "created_at", value: func(l *UsageLog) any { return l.CreatedAt.UTC().Format(time.RFC3339Nano) }},
+	{name: "request_id", value: func(l *UsageLog) any { return l.RequestID }},
+	{name: "user_id", adminOnly: true, value: func(l *UsageLog) any { return l.UserID }},
+	{name: "user_email", adminOnly: true, value: func(l *UsageLog) any {
+		if l.User != nil {
+			return l.User.Email
+		}
+		return ""
+	}},
+	{name: "organization_id", adminOnly: true, value: func(l *UsageLog) any { return l.OrganizationID }},
+	{name: "api_key_id", value: func(l *UsageLog) any { return l.APIKeyID }},
+	{name: "api_key_name", value: func(l *UsageLog) any {
+		if l.APIKey != nil {
+			return l.APIKey.Name
+		}
+		return ""
+	}},
+	{name: "account_id", adminOnly: true, value: func(l *UsageLog) any { return l.AccountID }},
+	{name: "account_name", adminOnly: true, value: func(l *UsageLog) any {
+		if l.Account != nil {
+			return l.Account.Name
+		}
+		return ""
+	}},
+	{name: "group_id", value: func(l *UsageLog) any { return l.GroupID }},
+	{name: "group_name", value: func(l *UsageLog) any {
+		if l.Group != nil {
+			return l.Group.Name
+		}
+		return ""
+	}},
+	{name: "model", value: func(l *UsageLog) any { return l.Model }},
+	{name: "billing_type", value: func(l *UsageLog) any { return l.BillingType }},
+	{name: "stream", value: func(l *UsageLog) any { return l.Stream }},
+	{name: "input_tokens", value: func(l *UsageLog) any { return l.InputTokens }},
+	{name: "output_tokens", value: func(l *UsageLog) any { return l.OutputTokens }},
+	{name: "cache_creation_tokens", value: func(l *UsageLog) any { return l.CacheCreationTokens }},
+	{name: "cache_read_tokens", value: func(l *UsageLog) any { return l.CacheReadTokens }},
+	{name: "image_count", value: func(l *UsageLog) any { return l.ImageCount }},
+	{name: "input_cost", value: func(l *UsageLog) any { return l.InputCost }},
+	{name: "output_cost", value: func(l *UsageLog) any { return l.OutputCost }},
+	{name: "cache_creation_cost", value: func(l *UsageLog) any { return l.CacheCreationCost }},
+	{name: "cache_read_cost", value: func(l *UsageLog) any { return l.CacheReadCost }},
+	{name: "total_cost", value: func(l *UsageLog) any { return l.TotalCost }},
+	{name: "actual_cost", value: func(l *UsageLog) any { return l.ActualCost }},
+	{name: "rate_multiplier", value: func(l *UsageLog) any { return l.RateMultiplier }},
+	{name: "account_rate_multiplier", adminOnly: true, value: func(l *UsageLog) any { return l.AccountRateMultiplier }},
+	{name: "duration_ms", value: func(l *UsageLog) any { return l.DurationMs }},
+	{name: "first_token_ms", value: func(l *UsageLog) any { return l.FirstTokenMs }},
+	{name: "ip_address", adminOnly: true, value: func(l *UsageLog) any { return l.IPAddress }},
+	{name: "user_agent", value: func(l *UsageLog) any { return l.UserAgent }},
+}
+
+// UsageExportWriter 将使用记录逐行写出为 CSV 或 JSONL
+type UsageExportWriter struct {
+	format  string
+	columns []usageExportColumn
+	buf     *bufio.Writer
+	csv     *csv.Writer
+}
+
+// UsageExportContentType 返回导出格式对应的 Content-Type
+func UsageExportContentType(format string) string {
+	if format == UsageExportFormatJSONL {
+		return "application/x-ndjson; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// NormalizeUsageExportFormat 校验导出格式，空值默认为 csv
+func NormalizeUsageExportFormat(format string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", UsageExportFormatCSV:
+		return UsageExportFormatCSV, true
+	case UsageExportFormatJSONL, "ndjson":
+		return UsageExportFormatJSONL, true
+	}
+	return "", false
+}
+
+// NewUsageExportWriter 创建导出写入器；admin=false 时省略账号、IP 等仅管理员可见的列
+func NewUsageExportWriter(w io.Writer, format string, admin bool) *UsageExportWriter {
+	columns := make([]usageExportColumn, 0, len(usageExportColumns))
+	for _, col := range usageExportColumns {
+		if col.adminOnly && !admin {
+			continue
+		}
+		columns = append(columns, col)
+	}
+	buf := bufio.NewWriterSize(w, 64*1024)
+	ew := &UsageExportWriter{format: format, columns: columns, buf: buf}
+	if format != UsageExportFormatJSONL {
+		ew.csv = csv.NewWriter(buf)
+	}
+	return ew
+}
+
+// WriteHeader 写出 CSV 表头；JSONL 无表头
+func (w *UsageExportWriter) WriteHeader() error {
+	if w.csv == nil {
+		return nil
+	}
+	names := make([]string, len(w.columns))
+	for i, col := range w.columns {
+		names[i] = col.name
+	}
+	return w.csv.Write(names)
+}
+
+// Write 写出一条记录
+func (w *UsageExportWriter) Write(l *UsageLog) error {
+	if w.csv != nil {
+		record := make([]string, len(w.columns))
+		for i, col := range w.columns {
+			record[i] = usageExportCSVCell(col.value(l))
+		}
+		return w.csv.Write(record)
+	}
+
+	// 手动拼接以保持列顺序与 CSV 一致
+	if err := w.buf.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, col := range w.columns {
+		if i > 0 {
+			_ = w.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(col.name)
+		val, err := json.Marshal(col.value(l))
+		if err != nil {
+			return err
+		}
+		_, _ = w.buf.Write(key)
+		_ = w.buf.WriteByte(':')
+		_, _ = w.buf.Write(val)
+	}
+	_, err := w.buf.WriteString("}\n")
+	return err
+}
+
+// Flush 将缓冲内容写入底层 io.Writer
+func (w *UsageExportWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return w.buf.Flush()
+}
+
+func usageExportCSVCell(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return csvSafeText(val)
+	case *string:
+		if val == nil {
+			return ""
+		}
+		return csvSafeText(*val)
+	case *int64:
+		if val == nil {
+			return ""
+		}
+		return strconv.FormatInt(*val, 10)
+	case *int:
+		if val == nil {
+			return ""
+		}
+		return strconv.Itoa(*val)
+	case *float64:
+		if val == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*val, 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// csvSafeText 防止表格软件把客户端可控的字段（如 User-Agent）当作公式执行
+func csvSafeText(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + s
+	}
+	return s
+}
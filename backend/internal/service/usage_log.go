@@ -3,8 +3,9 @@ package service
 import "time"
 
 const (
-	BillingTypeBalance      int8 = 0 // 钱包余额
-	BillingTypeSubscription int8 = 1 // 订阅套餐
+	BillingTypeBalance       int8 = 0 // 钱包余额
+	BillingTypeSubscription  int8 = 1 // 订阅套餐
+	BillingTypeResponseCache int8 = 2 // 响应缓存命中（未请求上游，按分组配置比例计费）
 )
 
 type UsageLog struct {
@@ -17,6 +18,8 @@ type UsageLog struct {
 
 	GroupID        *int64
 	SubscriptionID *int64
+	// OrganizationID 组织成员请求时记录所属组织，用于组织内按成员统计
+	OrganizationID *int64
 
 	InputTokens         int
 	OutputTokens        int
@@ -42,6 +45,8 @@ type UsageLog struct {
 	FirstTokenMs *int
 	UserAgent    *string
 	IPAddress    *string
+	// TraceID OpenTelemetry trace ID（未开启追踪或未采样时为 nil）
+	TraceID *string
 
 	// 图片生成字段
 	ImageCount int
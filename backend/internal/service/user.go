@@ -18,6 +18,7 @@ type User struct {
 	Status        string
 	AllowedGroups []int64
 	TokenVersion  int64 // Incremented on password change to invalidate existing tokens
+	RateLimits    RequestRateLimits
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 
@@ -28,12 +29,20 @@ type User struct {
 
 	APIKeys       []APIKey
 	Subscriptions []UserSubscription
+
+	// Organization 所属组织的计费视图（仅 API Key 认证路径填充）；非 nil 时余额模式从组织钱包扣费
+	Organization *OrganizationMembership
 }
 
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
 }
 
+// IsStaff 是否为任一管理端角色（超级管理员、客服、财务、运维）
+func (u *User) IsStaff() bool {
+	return IsAdminRole(u.Role)
+}
+
 func (u *User) IsActive() bool {
 	return u.Status == StatusActive
 }
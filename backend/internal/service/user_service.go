@@ -27,14 +27,16 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetFirstAdmin(ctx context.Context) (*User, error)
+	// Update 更新用户资料；余额只能通过 UpdateBalance / DeductBalance 变更（需写入账本）
 	Update(ctx context.Context, user *User) error
 	Delete(ctx context.Context, id int64) error
 
 	List(ctx context.Context, params pagination.PaginationParams) ([]User, *pagination.PaginationResult, error)
 	ListWithFilters(ctx context.Context, params pagination.PaginationParams, filters UserListFilters) ([]User, *pagination.PaginationResult, error)
 
-	UpdateBalance(ctx context.Context, id int64, amount float64) error
-	DeductBalance(ctx context.Context, id int64, amount float64) error
+	// UpdateBalance / DeductBalance 在同一语句内更新余额并追加一条余额流水
+	UpdateBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error
+	DeductBalance(ctx context.Context, id int64, amount float64, source BalanceChangeSource) error
 	UpdateConcurrency(ctx context.Context, id int64, amount int) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	RemoveGroupFromAllowedGroups(ctx context.Context, groupID int64) (int64, error)
@@ -177,7 +179,7 @@ func (s *UserService) List(ctx context.Context, params pagination.PaginationPara
 
 // UpdateBalance 更新用户余额（管理员功能）
 func (s *UserService) UpdateBalance(ctx context.Context, userID int64, amount float64) error {
-	if err := s.userRepo.UpdateBalance(ctx, userID, amount); err != nil {
+	if err := s.userRepo.UpdateBalance(ctx, userID, amount, BalanceChangeSource{Type: BalanceSourceAdminAdjustment}); err != nil {
 		return fmt.Errorf("update balance: %w", err)
 	}
 	if s.authCacheInvalidator != nil {
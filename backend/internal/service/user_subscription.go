@@ -23,6 +23,16 @@ type UserSubscription struct {
 	AssignedAt time.Time
 	Notes      string
 
+	// PlanID 最近一次购买/续费的套餐；AutoRenew 到期前自动从余额扣费续订该套餐
+	PlanID    *int64
+	AutoRenew bool
+	// 套餐限额快照，非 nil 时覆盖分组的对应限额
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	// RenewalNoticeFor 已完成到期处理（自动续费或提醒）的 ExpiresAt
+	RenewalNoticeFor *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -95,7 +105,27 @@ func (s *UserSubscription) MonthlyResetTime() *time.Time {
 	return &t
 }
 
+// EffectiveGroup 返回应用订阅自身限额（套餐快照）后的分组；没有覆盖时原样返回 group。
+// 限额相关的判断与展示都应基于该分组，而不是直接读取 group 的限额。
+func (s *UserSubscription) EffectiveGroup(group *Group) *Group {
+	if group == nil || (s.DailyLimitUSD == nil && s.WeeklyLimitUSD == nil && s.MonthlyLimitUSD == nil) {
+		return group
+	}
+	g := *group
+	if s.DailyLimitUSD != nil {
+		g.DailyLimitUSD = s.DailyLimitUSD
+	}
+	if s.WeeklyLimitUSD != nil {
+		g.WeeklyLimitUSD = s.WeeklyLimitUSD
+	}
+	if s.MonthlyLimitUSD != nil {
+		g.MonthlyLimitUSD = s.MonthlyLimitUSD
+	}
+	return &g
+}
+
 func (s *UserSubscription) CheckDailyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasDailyLimit() {
 		return true
 	}
@@ -103,6 +133,7 @@ func (s *UserSubscription) CheckDailyLimit(group *Group, additionalCost float64)
 }
 
 func (s *UserSubscription) CheckWeeklyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasWeeklyLimit() {
 		return true
 	}
@@ -110,6 +141,7 @@ func (s *UserSubscription) CheckWeeklyLimit(group *Group, additionalCost float64
 }
 
 func (s *UserSubscription) CheckMonthlyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasMonthlyLimit() {
 		return true
 	}
@@ -32,4 +32,13 @@ type UserSubscriptionRepository interface {
 	IncrementUsage(ctx context.Context, id int64, costUSD float64) error
 
 	BatchUpdateExpiredStatus(ctx context.Context) (int64, error)
+
+	// ApplyPlan 记录订阅来源套餐并快照套餐限额（套餐限额为 nil 时清除覆盖，沿用分组限额）
+	ApplyPlan(ctx context.Context, id int64, plan *SubscriptionPlan) error
+	SetAutoRenew(ctx context.Context, id int64, autoRenew bool) error
+	// ListRenewalDue 返回来自套餐、在 before 之前到期且本周期尚未做到期处理的有效订阅（含用户信息）
+	ListRenewalDue(ctx context.Context, before time.Time, limit int) ([]UserSubscription, error)
+	// ClaimRenewalNotice 认领 expiresAt 这一周期的到期处理：仅当订阅仍在该时间到期且本周期未处理时记录并返回 true，
+	// 多实例并发处理同一订阅时只有一个能认领成功
+	ClaimRenewalNotice(ctx context.Context, id int64, expiresAt time.Time) (bool, error)
 }
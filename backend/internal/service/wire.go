@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"database/sql"
+	"log"
 	"time"
 
+	dbent "github.com/Wei-Shaw/sub2api/ent"
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
@@ -26,6 +28,18 @@ func ProvidePricingService(cfg *config.Config, remoteClient PricingRemoteClient)
 	return svc, nil
 }
 
+// ProvideModelPriceService creates ModelPriceService and loads the initial price snapshot
+func ProvideModelPriceService(repo ModelPriceRepository, groupRepo GroupRepository, settingService *SettingService) *ModelPriceService {
+	svc := NewModelPriceService(repo, groupRepo, settingService)
+	ctx, cancel := context.WithTimeout(context.Background(), modelPriceLoadTimeout)
+	defer cancel()
+	if err := svc.Reload(ctx); err != nil {
+		// 价格覆盖加载失败不阻塞启动，计费路径会在后台重试
+		log.Printf("[Service] Warning: Model price snapshot load failed: %v", err)
+	}
+	return svc
+}
+
 // ProvideUpdateService creates UpdateService with BuildInfo
 func ProvideUpdateService(cache UpdateCache, githubClient GitHubReleaseClient, buildInfo BuildInfo) *UpdateService {
 	return NewUpdateService(cache, githubClient, buildInfo.Version, buildInfo.BuildType)
@@ -65,6 +79,51 @@ func ProvideUsageCleanupService(repo UsageCleanupRepository, timingWheel *Timing
 	return svc
 }
 
+// ProvideUsageExportService 创建并启动使用记录导出任务服务
+func ProvideUsageExportService(repo UsageExportRepository, timingWheel *TimingWheelService, cfg *config.Config) *UsageExportService {
+	svc := NewUsageExportService(repo, timingWheel, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideInvoiceService 创建并启动发票服务（每月初按配置自动开具上月发票）
+func ProvideInvoiceService(
+	repo InvoiceRepository,
+	userRepo UserRepository,
+	orgRepo OrganizationRepository,
+	settingService *SettingService,
+	emailQueueService *EmailQueueService,
+	timingWheel *TimingWheelService,
+	cfg *config.Config,
+) *InvoiceService {
+	if cfg != nil {
+		ConfigureInvoicePDFFont(cfg.Invoice.PDFFontPath)
+	}
+	svc := NewInvoiceService(repo, userRepo, orgRepo, settingService, emailQueueService, timingWheel)
+	svc.Start()
+	return svc
+}
+
+// ProvidePaymentService 创建充值订单服务并注册支付渠道（顺序即前端展示顺序）
+func ProvidePaymentService(
+	orderRepo PaymentOrderRepository,
+	userRepo UserRepository,
+	planRepo SubscriptionPlanRepository,
+	subscriptionService *SubscriptionService,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	creemService *CreemService,
+	stripeService *StripeService,
+	epayService *EPayService,
+) *PaymentService {
+	return NewPaymentService(orderRepo, userRepo, planRepo, subscriptionService, billingCacheService, entClient, authCacheInvalidator, []PaymentProvider{
+		creemService,
+		stripeService,
+		epayService,
+	})
+}
+
 // ProvideAccountExpiryService creates and starts AccountExpiryService.
 func ProvideAccountExpiryService(accountRepo AccountRepository) *AccountExpiryService {
 	svc := NewAccountExpiryService(accountRepo, time.Minute)
@@ -72,9 +131,42 @@ func ProvideAccountExpiryService(accountRepo AccountRepository) *AccountExpirySe
 	return svc
 }
 
+// ProvideSecretRotationService creates and starts SecretRotationService.
+func ProvideSecretRotationService(repo SecretRotationRepository, cfg *config.Config) *SecretRotationService {
+	svc := NewSecretRotationService(repo, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideProxyPoolService creates ProxyPoolService and starts background health checks.
+func ProvideProxyPoolService(
+	poolRepo ProxyPoolRepository,
+	proxyRepo ProxyRepository,
+	healthCache ProxyHealthCache,
+	latencyCache ProxyLatencyCache,
+	prober ProxyExitInfoProber,
+	cfg *config.Config,
+) *ProxyPoolService {
+	svc := NewProxyPoolService(poolRepo, proxyRepo, healthCache, latencyCache, prober, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideProxyImportService creates ProxyImportService and starts periodic subscription refresh.
+func ProvideProxyImportService(
+	proxyRepo ProxyRepository,
+	subRepo ProxySubscriptionRepository,
+	poolService *ProxyPoolService,
+	cfg *config.Config,
+) *ProxyImportService {
+	svc := NewProxyImportService(proxyRepo, subRepo, poolService, cfg)
+	svc.Start()
+	return svc
+}
+
 // ProvideSubscriptionExpiryService creates and starts SubscriptionExpiryService.
-func ProvideSubscriptionExpiryService(userSubRepo UserSubscriptionRepository) *SubscriptionExpiryService {
-	svc := NewSubscriptionExpiryService(userSubRepo, time.Minute)
+func ProvideSubscriptionExpiryService(userSubRepo UserSubscriptionRepository, planService *SubscriptionPlanService) *SubscriptionExpiryService {
+	svc := NewSubscriptionExpiryService(userSubRepo, planService, time.Minute)
 	svc.Start()
 	return svc
 }
@@ -142,11 +234,12 @@ func ProvideOpsMetricsCollector(
 	settingRepo SettingRepository,
 	accountRepo AccountRepository,
 	concurrencyService *ConcurrencyService,
+	accountHealthCache AccountHealthCache,
 	db *sql.DB,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsMetricsCollector {
-	collector := NewOpsMetricsCollector(opsRepo, settingRepo, accountRepo, concurrencyService, db, redisClient, cfg)
+	collector := NewOpsMetricsCollector(opsRepo, settingRepo, accountRepo, concurrencyService, accountHealthCache, db, redisClient, cfg)
 	collector.Start()
 	return collector
 }
@@ -169,10 +262,11 @@ func ProvideOpsAlertEvaluatorService(
 	opsService *OpsService,
 	opsRepo OpsRepository,
 	emailService *EmailService,
+	balanceLedgerService *BalanceLedgerService,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsAlertEvaluatorService {
-	svc := NewOpsAlertEvaluatorService(opsService, opsRepo, emailService, redisClient, cfg)
+	svc := NewOpsAlertEvaluatorService(opsService, opsRepo, emailService, balanceLedgerService, redisClient, cfg)
 	svc.Start()
 	return svc
 }
@@ -215,17 +309,28 @@ var ProviderSet = wire.NewSet(
 	NewAuthService,
 	NewUserService,
 	NewAPIKeyService,
+	NewResponseCacheService,
 	ProvideAPIKeyAuthCacheInvalidator,
 	NewGroupService,
 	NewAccountService,
 	NewProxyService,
 	NewRedeemService,
 	NewPromoService,
+	NewAuditLogService,
+	NewBalanceLedgerService,
+	NewAdminAPIKeyService,
+	NewOrganizationService,
 	NewUsageService,
 	NewDashboardService,
+	NewPromptCacheStatsService,
+	NewAccountHealthService,
+	NewAccountBudgetService,
 	ProvidePricingService,
+	ProvideModelPriceService,
 	NewBillingService,
 	NewBillingCacheService,
+	NewRequestRateLimitService,
+	NewGatewayMetricsService,
 	NewAdminService,
 	NewGatewayService,
 	NewOpenAIGatewayService,
@@ -260,17 +365,27 @@ var ProviderSet = wire.NewSet(
 	ProvideSchedulerSnapshotService,
 	NewIdentityService,
 	NewCRSSyncService,
+	NewAccountBundleService,
 	ProvideUpdateService,
 	ProvideTokenRefreshService,
 	ProvideAccountExpiryService,
+	ProvideSecretRotationService,
+	ProvideProxyPoolService,
+	ProvideProxyImportService,
 	ProvideSubscriptionExpiryService,
+	NewSubscriptionPlanService,
 	ProvideTimingWheelService,
 	ProvideDashboardAggregationService,
 	ProvideUsageCleanupService,
+	ProvideUsageExportService,
+	ProvideInvoiceService,
 	ProvideDeferredService,
 	NewAntigravityQuotaFetcher,
 	NewUserAttributeService,
 	NewUsageCache,
 	NewCreemService,
+	NewStripeService,
+	NewEPayService,
+	ProvidePaymentService,
 	NewTotpService,
 )
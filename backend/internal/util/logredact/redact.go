@@ -2,6 +2,8 @@ package logredact
 
 import (
 	"encoding/json"
+	"net/http"
+	"regexp"
 	"strings"
 )
 
@@ -98,3 +100,43 @@ func isSensitiveKey(key string, keys map[string]struct{}) bool {
 func normalizeKey(key string) string {
 	return strings.ToLower(strings.TrimSpace(key))
 }
+
+// textSecretPatterns 匹配非结构化文本中常见的凭证格式
+var textSecretPatterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]{8,}`), "${1}***"},
+	{regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{16,}`), "sk-***"},
+	{regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{20,}`), "AIza***"},
+	{regexp.MustCompile(`(?i)([?&](?:key|api_key|access_token|refresh_token|client_secret)=)[^&"\s]+`), "${1}***"},
+}
+
+// RedactText 遮蔽文本（含已序列化的 JSON）中出现的 API Key、Bearer Token 等凭证片段
+func RedactText(s string) string {
+	if s == "" {
+		return s
+	}
+	for _, p := range textSecretPatterns {
+		s = p.re.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+// RedactHeaders 将请求头转换为小写键的 map，敏感头（默认键 + extraKeys）的值替换为 ***
+func RedactHeaders(header http.Header, extraKeys ...string) map[string]string {
+	out := make(map[string]string, len(header))
+	keys := buildKeySet(extraKeys)
+	for k, values := range header {
+		key := normalizeKey(k)
+		if key == "" {
+			continue
+		}
+		if isSensitiveKey(key, keys) {
+			out[key] = "***"
+			continue
+		}
+		out[key] = RedactText(strings.Join(values, ", "))
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package logredact
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactJSONDefaultAndExtraKeys(t *testing.T) {
+	out := RedactJSON([]byte(`{"password":"p","nested":{"x-api-key":"k","max_tokens":10}}`), "x-api-key")
+	if strings.Contains(out, `"p"`) || strings.Contains(out, `"k"`) {
+		t.Fatalf("expected secrets to be redacted, got %s", out)
+	}
+	if !strings.Contains(out, `"max_tokens":10`) {
+		t.Fatalf("expected non-sensitive keys to be kept, got %s", out)
+	}
+	if got := RedactJSON([]byte("not json")); got != "<non-json payload redacted>" {
+		t.Fatalf("unexpected non-json result: %s", got)
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	in := "Authorization: Bearer abcdefghijklmnop key sk-ant-REDACTED url https://x/v1?key=AIzaSyabcdefghijklmnopqrstuv&alt=sse"
+	out := RedactText(in)
+	for _, secret := range []string{"abcdefghijklmnop", "sk-ant-api03", "AIzaSyabcdefghijklmnopqrstuv"} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("expected %q to be redacted, got %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "alt=sse") {
+		t.Fatalf("expected other query params to be kept, got %s", out)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer secret-token-value")
+	h.Set("X-Api-Key", "sk-ant-REDACTED")
+	h.Set("Anthropic-Version", "2023-06-01")
+
+	out := RedactHeaders(h, "authorization", "x-api-key")
+	if out["authorization"] != "***" || out["x-api-key"] != "***" {
+		t.Fatalf("expected auth headers to be masked, got %v", out)
+	}
+	if out["anthropic-version"] != "2023-06-01" {
+		t.Fatalf("expected other headers to be kept, got %v", out)
+	}
+}
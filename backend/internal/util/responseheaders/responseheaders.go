@@ -89,9 +89,17 @@ func FilterHeaders(src http.Header, cfg config.ResponseHeaderConfig) http.Header
 	return filtered
 }
 
+// isGatewayRateLimitHeader 限流相关头部：网关自身已设置时（用户/Key/分组 RPM/TPM 限制）优先于上游
+func isGatewayRateLimitHeader(lower string) bool {
+	return strings.HasPrefix(lower, "x-ratelimit-") || lower == "retry-after"
+}
+
 func WriteFilteredHeaders(dst http.Header, src http.Header, cfg config.ResponseHeaderConfig) {
 	filtered := FilterHeaders(src, cfg)
 	for key, values := range filtered {
+		if isGatewayRateLimitHeader(strings.ToLower(key)) && dst.Get(key) != "" {
+			continue
+		}
 		for _, value := range values {
 			dst.Add(key, value)
 		}
@@ -65,3 +65,25 @@ func TestFilterHeadersEnabledUsesAllowlist(t *testing.T) {
 		t.Fatalf("expected X-Blocked removed, got %q", filtered.Get("X-Blocked"))
 	}
 }
+
+func TestWriteFilteredHeadersKeepsGatewayRateLimitHeaders(t *testing.T) {
+	src := http.Header{}
+	src.Add("X-Ratelimit-Remaining-Requests", "999")
+	src.Add("X-Ratelimit-Limit-Tokens", "100000")
+	src.Add("Retry-After", "30")
+
+	dst := http.Header{}
+	dst.Set("X-Ratelimit-Remaining-Requests", "4")
+	dst.Set("Retry-After", "2")
+
+	WriteFilteredHeaders(dst, src, config.ResponseHeaderConfig{})
+	if got := dst.Values("X-Ratelimit-Remaining-Requests"); len(got) != 1 || got[0] != "4" {
+		t.Fatalf("expected gateway remaining-requests kept, got %v", got)
+	}
+	if got := dst.Values("Retry-After"); len(got) != 1 || got[0] != "2" {
+		t.Fatalf("expected gateway retry-after kept, got %v", got)
+	}
+	if dst.Get("X-Ratelimit-Limit-Tokens") != "100000" {
+		t.Fatalf("expected upstream limit-tokens passed through, got %q", dst.Get("X-Ratelimit-Limit-Tokens"))
+	}
+}
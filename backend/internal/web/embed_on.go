@@ -37,10 +37,13 @@ type FrontendServer struct {
 	baseHTML   []byte
 	cache      *HTMLCache
 	settings   PublicSettingsProvider
+	// extraAPIPaths 额外交给后端处理的精确路径（如可配置的 /metrics）
+	extraAPIPaths []string
 }
 
-// NewFrontendServer creates a new frontend server with settings injection
-func NewFrontendServer(settingsProvider PublicSettingsProvider) (*FrontendServer, error) {
+// NewFrontendServer creates a new frontend server with settings injection.
+// extraAPIPaths are exact paths that must fall through to backend routes.
+func NewFrontendServer(settingsProvider PublicSettingsProvider, extraAPIPaths ...string) (*FrontendServer, error) {
 	distFS, err := fs.Sub(frontendFS, "dist")
 	if err != nil {
 		return nil, err
@@ -62,11 +65,12 @@ func NewFrontendServer(settingsProvider PublicSettingsProvider) (*FrontendServer
 	cache.SetBaseHTML(baseHTML)
 
 	return &FrontendServer{
-		distFS:     distFS,
-		fileServer: http.FileServer(http.FS(distFS)),
-		baseHTML:   baseHTML,
-		cache:      cache,
-		settings:   settingsProvider,
+		distFS:        distFS,
+		fileServer:    http.FileServer(http.FS(distFS)),
+		baseHTML:      baseHTML,
+		cache:         cache,
+		settings:      settingsProvider,
+		extraAPIPaths: extraAPIPaths,
 	}, nil
 }
 
@@ -83,13 +87,7 @@ func (s *FrontendServer) Middleware() gin.HandlerFunc {
 		path := c.Request.URL.Path
 
 		// Skip API routes
-		if strings.HasPrefix(path, "/api/") ||
-			strings.HasPrefix(path, "/v1/") ||
-			strings.HasPrefix(path, "/v1beta/") ||
-			strings.HasPrefix(path, "/antigravity/") ||
-			strings.HasPrefix(path, "/setup/") ||
-			path == "/health" ||
-			path == "/responses" {
+		if isAPIPath(path, s.extraAPIPaths) {
 			c.Next()
 			return
 		}
@@ -194,9 +192,29 @@ func replaceNoncePlaceholder(html []byte, nonce string) []byte {
 	return bytes.ReplaceAll(html, []byte(NonceHTMLPlaceholder), []byte(nonce))
 }
 
+// isAPIPath reports whether the path belongs to backend routes rather than the SPA
+func isAPIPath(path string, extraAPIPaths []string) bool {
+	if strings.HasPrefix(path, "/api/") ||
+		strings.HasPrefix(path, "/v1/") ||
+		strings.HasPrefix(path, "/v1beta/") ||
+		strings.HasPrefix(path, "/antigravity/") ||
+		strings.HasPrefix(path, "/setup/") ||
+		path == "/health" ||
+		path == "/responses" ||
+		path == "/chat/completions" {
+		return true
+	}
+	for _, p := range extraAPIPaths {
+		if path == p {
+			return true
+		}
+	}
+	return false
+}
+
 // ServeEmbeddedFrontend returns a middleware for serving embedded frontend
 // This is the legacy function for backward compatibility when no settings provider is available
-func ServeEmbeddedFrontend() gin.HandlerFunc {
+func ServeEmbeddedFrontend(extraAPIPaths ...string) gin.HandlerFunc {
 	distFS, err := fs.Sub(frontendFS, "dist")
 	if err != nil {
 		panic("failed to get dist subdirectory: " + err.Error())
@@ -206,13 +224,7 @@ func ServeEmbeddedFrontend() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		if strings.HasPrefix(path, "/api/") ||
-			strings.HasPrefix(path, "/v1/") ||
-			strings.HasPrefix(path, "/v1beta/") ||
-			strings.HasPrefix(path, "/antigravity/") ||
-			strings.HasPrefix(path, "/setup/") ||
-			path == "/health" ||
-			path == "/responses" {
+		if isAPIPath(path, extraAPIPaths) {
 			c.Next()
 			return
 		}
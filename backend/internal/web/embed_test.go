@@ -366,6 +366,7 @@ func TestFrontendServer_Middleware(t *testing.T) {
 			"/setup/init",
 			"/health",
 			"/responses",
+			"/chat/completions",
 		}
 
 		for _, path := range apiPaths {
@@ -440,6 +441,28 @@ func TestFrontendServer_Middleware(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Contains(t, w.Header().Get("Content-Type"), "image/png")
 	})
+
+	t.Run("skips_extra_api_paths", func(t *testing.T) {
+		provider := &mockSettingsProvider{
+			settings: map[string]string{"test": "value"},
+		}
+
+		server, err := NewFrontendServer(provider, "/metrics")
+		require.NoError(t, err)
+
+		router := gin.New()
+		router.Use(server.Middleware())
+		router.GET("/metrics", func(c *gin.Context) {
+			c.String(http.StatusOK, "metrics")
+		})
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "metrics", w.Body.String())
+	})
 }
 
 func TestNewFrontendServer(t *testing.T) {
@@ -0,0 +1,23 @@
+-- Add spending caps and expiry to api_keys table
+-- quota_usd: hard total cap (USD, actual cost), NULL means unlimited
+-- daily_limit_usd / monthly_limit_usd: rolling window caps, NULL means unlimited
+-- expires_at: key stops working after this time, NULL means never expires
+-- *_usage_usd / *_window_start: usage counters maintained by the gateway
+
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS quota_usd DECIMAL(20,8) DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_limit_usd DECIMAL(20,8) DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS monthly_limit_usd DECIMAL(20,8) DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ DEFAULT NULL;
+
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS quota_used_usd DECIMAL(20,10) NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_usage_usd DECIMAL(20,10) NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS monthly_usage_usd DECIMAL(20,10) NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_window_start TIMESTAMPTZ DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS monthly_window_start TIMESTAMPTZ DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_expires_at ON api_keys(expires_at);
+
+COMMENT ON COLUMN api_keys.quota_usd IS 'Total spending cap in USD (actual cost), NULL = unlimited';
+COMMENT ON COLUMN api_keys.daily_limit_usd IS 'Daily spending cap in USD, NULL = unlimited';
+COMMENT ON COLUMN api_keys.monthly_limit_usd IS 'Monthly spending cap in USD, NULL = unlimited';
+COMMENT ON COLUMN api_keys.expires_at IS 'Key expiration time, NULL = never expires';
@@ -0,0 +1,9 @@
+-- Add model restriction fields to api_keys table
+-- allowed_models: JSON array of allowed model patterns (supports * wildcard, NULL = all models)
+-- model_aliases: JSON object mapping client-facing aliases to real model names
+
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS allowed_models JSONB DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS model_aliases JSONB DEFAULT NULL;
+
+COMMENT ON COLUMN api_keys.allowed_models IS 'JSON array of allowed model patterns, e.g. ["claude-*-haiku*", "gpt-5*"]';
+COMMENT ON COLUMN api_keys.model_aliases IS 'JSON object of model aliases, e.g. {"fast": "claude-haiku-4-5"}';
@@ -0,0 +1,21 @@
+-- Add per-minute request/token rate limits (sliding window, enforced in Redis)
+-- rpm_limit: max requests per minute, 0 = unlimited
+-- input_tpm_limit: max input tokens per minute, 0 = unlimited
+-- output_tpm_limit: max output tokens per minute, 0 = unlimited
+-- Limits can be set on users, groups and api_keys; every configured scope is enforced.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS rpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS input_tpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS output_tpm_limit INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS rpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS input_tpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS output_tpm_limit INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS rpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS input_tpm_limit INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS output_tpm_limit INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN users.rpm_limit IS 'Requests per minute limit, 0 = unlimited';
+COMMENT ON COLUMN groups.rpm_limit IS 'Requests per minute limit for all keys in the group, 0 = unlimited';
+COMMENT ON COLUMN api_keys.rpm_limit IS 'Requests per minute limit, 0 = unlimited';
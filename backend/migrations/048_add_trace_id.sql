@@ -0,0 +1,7 @@
+-- Add OpenTelemetry trace ID to usage and error logs for cross-referencing with the tracing backend
+-- (32-char hex, NULL when tracing is disabled or the request was not sampled)
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS trace_id VARCHAR(32);
+ALTER TABLE ops_error_logs ADD COLUMN IF NOT EXISTS trace_id VARCHAR(32);
+
+CREATE INDEX IF NOT EXISTS idx_usage_logs_trace_id ON usage_logs(trace_id) WHERE trace_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_ops_error_logs_trace_id ON ops_error_logs(trace_id) WHERE trace_id IS NOT NULL;
@@ -0,0 +1,29 @@
+-- Ops notification channels: deliver alert/report notifications to webhook/chat tools
+-- (generic signed webhook, Slack, Discord, Telegram, DingTalk, Feishu) in addition to email.
+
+CREATE TABLE IF NOT EXISTS ops_notification_channels (
+    id BIGSERIAL PRIMARY KEY,
+
+    name VARCHAR(128) NOT NULL,
+    channel_type VARCHAR(32) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+
+    -- Type-specific settings (url/secret/bot_token/chat_id/headers)
+    config JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    -- Whether to also notify when an alert resolves / deliver scheduled reports
+    send_resolved BOOLEAN NOT NULL DEFAULT true,
+    send_reports BOOLEAN NOT NULL DEFAULT false,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ops_notification_channels_name_unique
+    ON ops_notification_channels (name);
+
+-- Per-rule channel selection (array of ops_notification_channels.id)
+ALTER TABLE ops_alert_rules ADD COLUMN IF NOT EXISTS notify_channel_ids JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- Per-event delivery results (array of {channel_id, channel_name, channel_type, kind, status, attempts, error, sent_at})
+ALTER TABLE ops_alert_events ADD COLUMN IF NOT EXISTS notification_status JSONB;
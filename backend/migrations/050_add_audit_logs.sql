@@ -0,0 +1,30 @@
+-- 050_add_audit_logs.sql
+-- 管理员操作审计日志（只追加）
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    actor_user_id BIGINT,
+    actor_type VARCHAR(20) NOT NULL DEFAULT '',
+    action VARCHAR(255) NOT NULL,
+    target_type VARCHAR(64) NOT NULL DEFAULT '',
+    target_id VARCHAR(64) NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    status_code INT NOT NULL DEFAULT 0,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at
+    ON audit_logs(created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_user_id_created_at
+    ON audit_logs(actor_user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target
+    ON audit_logs(target_type, target_id);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action
+    ON audit_logs(action);
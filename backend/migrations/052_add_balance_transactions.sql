@@ -0,0 +1,53 @@
+-- 052_add_balance_transactions.sql
+-- 用户余额流水账本（只追加）：记录每一次余额变动的来源、关联单据与变动后余额
+
+CREATE TABLE IF NOT EXISTS balance_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    amount DECIMAL(20,8) NOT NULL,
+    balance_after DECIMAL(20,8) NOT NULL,
+    source_type VARCHAR(32) NOT NULL,
+    reference_id VARCHAR(128) NOT NULL DEFAULT '',
+    actor_user_id BIGINT,
+    notes VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_user_id_created_at
+    ON balance_transactions(user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_source
+    ON balance_transactions(source_type, reference_id);
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_created_at
+    ON balance_transactions(created_at DESC);
+
+-- 账本不可修改：拒绝 UPDATE / DELETE
+CREATE OR REPLACE FUNCTION balance_transactions_reject_mutation() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'balance_transactions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_balance_transactions_append_only ON balance_transactions;
+CREATE TRIGGER trg_balance_transactions_append_only
+    BEFORE UPDATE OR DELETE ON balance_transactions
+    FOR EACH ROW EXECUTE FUNCTION balance_transactions_reject_mutation();
+
+-- 期初余额：为已有余额的用户写入一条 opening_balance，使账本合计与 users.balance 对齐
+INSERT INTO balance_transactions (user_id, amount, balance_after, source_type, notes, created_at)
+SELECT u.id, u.balance, u.balance, 'opening_balance', 'ledger introduced', NOW()
+FROM users u
+WHERE u.balance <> 0
+  AND NOT EXISTS (SELECT 1 FROM balance_transactions bt WHERE bt.user_id = u.id);
+
+-- 默认对账告警规则：存在账本合计与余额不一致的用户时触发
+INSERT INTO ops_alert_rules (
+    name, description, enabled, metric_type, operator, threshold,
+    window_minutes, sustained_minutes, severity, notify_email, cooldown_minutes,
+    created_at, updated_at
+) VALUES (
+    '余额账本不一致',
+    '当存在余额流水合计与用户余额不一致的用户时触发告警（对账）',
+    true, 'balance_ledger_drift_users', '>', 0, 60, 1, 'P1', true, 60, NOW(), NOW()
+) ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,28 @@
+-- 053_add_admin_api_keys.sql
+-- 管理端 API Key：由单个全局密钥改为多个具名、带权限范围与过期时间的 Key，仅保存 SHA-256 摘要
+
+CREATE TABLE IF NOT EXISTS admin_api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    key_prefix VARCHAR(20) NOT NULL DEFAULT '',
+    scopes JSONB NOT NULL DEFAULT '[]'::jsonb,
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_by BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_api_keys_expires_at
+    ON admin_api_keys(expires_at);
+
+-- 迁移旧的全局管理员 API Key：保留全部权限、永不过期，原 Key 继续可用
+INSERT INTO admin_api_keys (name, key_hash, key_prefix, scopes)
+SELECT 'legacy', encode(sha256(convert_to(value, 'UTF8')), 'hex'), left(value, 14), '["*"]'::jsonb
+FROM settings
+WHERE key = 'admin_api_key' AND value <> ''
+ON CONFLICT (key_hash) DO NOTHING;
+
+DELETE FROM settings WHERE key = 'admin_api_key';
+
+COMMENT ON COLUMN users.role IS 'user / admin(超级管理员) / support / finance / ops';
@@ -0,0 +1,34 @@
+-- 054_add_usage_export_tasks.sql
+-- 使用记录后台导出任务表
+-- cursor_created_at/cursor_id 与 file_size 构成断点：续跑时文件截断到 file_size，并从 (created_at, id) 之后继续读取
+
+CREATE TABLE IF NOT EXISTS usage_export_tasks (
+    id BIGSERIAL PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    destination VARCHAR(10) NOT NULL DEFAULT 'local',
+    filters JSONB NOT NULL,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    scheduled_for DATE,
+    rows_exported BIGINT NOT NULL DEFAULT 0,
+    cursor_created_at TIMESTAMPTZ,
+    cursor_id BIGINT,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    object_key TEXT,
+    error_message TEXT,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 每日定时导出按日期幂等，多实例同时调度也只会创建一次
+CREATE UNIQUE INDEX IF NOT EXISTS idx_usage_export_tasks_scheduled_for
+    ON usage_export_tasks(scheduled_for)
+    WHERE scheduled_for IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_usage_export_tasks_status_created_at
+    ON usage_export_tasks(status, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_usage_export_tasks_created_at
+    ON usage_export_tasks(created_at DESC);
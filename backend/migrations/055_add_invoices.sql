@@ -0,0 +1,49 @@
+-- 055_add_invoices.sql
+-- 月度发票表：明细、开票方与收票方均为开具时的快照（JSONB），PDF/HTML 由快照按需渲染
+-- 同一主体同一账期只能有一张未作废的发票；作废后可重新开具（使用新序号）
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_number VARCHAR(64) NOT NULL,
+    sequence BIGINT NOT NULL,
+    subject_type VARCHAR(20) NOT NULL,
+    subject_id BIGINT NOT NULL,
+    recipient_user_id BIGINT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    line_items JSONB NOT NULL DEFAULT '[]',
+    top_ups JSONB NOT NULL DEFAULT '[]',
+    subtotal DECIMAL(20,2) NOT NULL DEFAULT 0,
+    tax_rate DECIMAL(7,4) NOT NULL DEFAULT 0,
+    tax_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+    total DECIMAL(20,2) NOT NULL DEFAULT 0,
+    top_up_total DECIMAL(20,2) NOT NULL DEFAULT 0,
+    issuer JSONB NOT NULL DEFAULT '{}',
+    bill_to JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'issued',
+    emailed_at TIMESTAMPTZ,
+    email_error TEXT,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_number ON invoices(invoice_number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_sequence ON invoices(sequence);
+
+-- 自动开票按 (主体, 账期) 幂等，多实例同时运行也只会开具一次
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_subject_period_active
+    ON invoices(subject_type, subject_id, period_start)
+    WHERE status <> 'void';
+
+CREATE INDEX IF NOT EXISTS idx_invoices_recipient_period
+    ON invoices(recipient_user_id, period_start DESC);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_period_start
+    ON invoices(period_start DESC);
+
+-- 订阅兑换码按兑换时间汇总订阅费用
+CREATE INDEX IF NOT EXISTS idx_redeem_codes_used_by_used_at
+    ON redeem_codes(used_by, used_at)
+    WHERE used_by IS NOT NULL;
@@ -0,0 +1,38 @@
+-- 056_add_payment_orders.sql
+-- 充值订单：各支付渠道（Creem / Stripe / 易支付）统一落单，状态 pending/paid/failed/refunded
+-- 回调按 (provider, provider_txn_id) 幂等；入账流水 reference 为订单号
+
+CREATE TABLE IF NOT EXISTS payment_orders (
+    id BIGSERIAL PRIMARY KEY,
+    order_no VARCHAR(64) NOT NULL,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(20) NOT NULL,
+    method VARCHAR(32) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    amount DECIMAL(20,2) NOT NULL,
+    currency VARCHAR(8) NOT NULL DEFAULT 'USD',
+    rate_multiplier DECIMAL(20,8) NOT NULL DEFAULT 1,
+    credit_amount DECIMAL(20,8) NOT NULL,
+    provider_session_id VARCHAR(255) NOT NULL DEFAULT '',
+    provider_txn_id VARCHAR(255) NOT NULL DEFAULT '',
+    checkout_url TEXT NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    paid_at TIMESTAMPTZ,
+    refunded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_orders_order_no ON payment_orders(order_no);
+
+-- 同一渠道交易号只能绑定一笔订单，重复回调不会重复入账
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_orders_provider_txn
+    ON payment_orders(provider, provider_txn_id)
+    WHERE provider_txn_id <> '';
+
+CREATE INDEX IF NOT EXISTS idx_payment_orders_user_created
+    ON payment_orders(user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_orders_status_created
+    ON payment_orders(status, created_at DESC);
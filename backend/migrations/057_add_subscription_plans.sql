@@ -0,0 +1,40 @@
+-- 057_add_subscription_plans.sql
+-- 订阅套餐：管理员定义分组、有效天数、价格与日/周/月限额，用户通过支付渠道自助购买或续费
+-- user_subscriptions 记录来源套餐、自动续费开关与限额快照；payment_orders.plan_id 非空表示套餐订单（不入账余额）
+
+CREATE TABLE IF NOT EXISTS subscription_plans (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    group_id BIGINT NOT NULL,
+    validity_days INTEGER NOT NULL DEFAULT 30,
+    price DECIMAL(20,8) NOT NULL,
+    daily_limit_usd DECIMAL(20,8),
+    weekly_limit_usd DECIMAL(20,8),
+    monthly_limit_usd DECIMAL(20,8),
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_plans_group_id ON subscription_plans(group_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_plans_status ON subscription_plans(status);
+CREATE INDEX IF NOT EXISTS idx_subscription_plans_deleted_at ON subscription_plans(deleted_at);
+
+ALTER TABLE user_subscriptions
+    ADD COLUMN IF NOT EXISTS plan_id BIGINT,
+    ADD COLUMN IF NOT EXISTS auto_renew BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS daily_limit_usd DECIMAL(20,8),
+    ADD COLUMN IF NOT EXISTS weekly_limit_usd DECIMAL(20,8),
+    ADD COLUMN IF NOT EXISTS monthly_limit_usd DECIMAL(20,8),
+    ADD COLUMN IF NOT EXISTS renewal_notice_for TIMESTAMPTZ;
+
+-- 到期处理只扫描来自套餐的有效订阅
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_plan_expires
+    ON user_subscriptions(expires_at)
+    WHERE plan_id IS NOT NULL AND deleted_at IS NULL;
+
+ALTER TABLE payment_orders
+    ADD COLUMN IF NOT EXISTS plan_id BIGINT;
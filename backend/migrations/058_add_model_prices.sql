@@ -0,0 +1,23 @@
+-- 058_add_model_prices.sql
+-- 模型价格覆盖：管理员按全局或分组维护模型的输入/输出/缓存写入/缓存读取单价（USD / 百万 token）与长上下文阶梯
+-- 每行是一个生效时间版本，计费时取 effective_from 不晚于请求时间的最新版本；已生效版本不可修改
+
+CREATE TABLE IF NOT EXISTS model_prices (
+    id BIGSERIAL PRIMARY KEY,
+    group_id BIGINT,
+    model VARCHAR(200) NOT NULL,
+    input_price DECIMAL(20,8) NOT NULL,
+    output_price DECIMAL(20,8) NOT NULL,
+    cache_write_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    cache_read_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    long_context_threshold INTEGER NOT NULL DEFAULT 0,
+    long_context_input_price DECIMAL(20,8),
+    long_context_output_price DECIMAL(20,8),
+    effective_from TIMESTAMPTZ NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_model_prices_group_model_effective ON model_prices(group_id, model, effective_from);
+CREATE INDEX IF NOT EXISTS idx_model_prices_model ON model_prices(model);
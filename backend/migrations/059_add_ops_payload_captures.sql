@@ -0,0 +1,76 @@
+-- Ops payload capture: opt-in capture of full request/response payloads (per user, API key or group)
+-- for debugging and replay. Bodies are redacted and gzip-compressed before storage.
+
+CREATE TABLE IF NOT EXISTS ops_payload_capture_rules (
+    id BIGSERIAL PRIMARY KEY,
+
+    name VARCHAR(128) NOT NULL,
+
+    -- user | api_key | group
+    scope_type VARCHAR(16) NOT NULL,
+    scope_id BIGINT NOT NULL,
+
+    -- Fraction of matching requests to capture (0, 1]
+    sample_rate DOUBLE PRECISION NOT NULL DEFAULT 1,
+    -- How long captured exchanges are kept
+    ttl_hours INT NOT NULL DEFAULT 24,
+    -- Extra JSON/header keys to redact in addition to the built-in list
+    redact_keys JSONB NOT NULL DEFAULT '[]'::jsonb,
+
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    -- Rule stops capturing after this time (NULL = until disabled)
+    expires_at TIMESTAMPTZ,
+
+    capture_count BIGINT NOT NULL DEFAULT 0,
+    last_captured_at TIMESTAMPTZ,
+
+    created_by BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ops_payload_capture_rules_scope
+    ON ops_payload_capture_rules (scope_type, scope_id);
+
+CREATE TABLE IF NOT EXISTS ops_payload_captures (
+    id BIGSERIAL PRIMARY KEY,
+    rule_id BIGINT REFERENCES ops_payload_capture_rules(id) ON DELETE SET NULL,
+
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    client_request_id VARCHAR(128) NOT NULL DEFAULT '',
+
+    user_id BIGINT,
+    api_key_id BIGINT,
+    group_id BIGINT,
+    account_id BIGINT,
+
+    platform VARCHAR(32) NOT NULL DEFAULT '',
+    model VARCHAR(200) NOT NULL DEFAULT '',
+    request_path VARCHAR(256) NOT NULL DEFAULT '',
+    stream BOOLEAN NOT NULL DEFAULT false,
+    status_code INT NOT NULL DEFAULT 0,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+
+    -- Redacted request headers ({"name": "value"})
+    request_headers JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    -- gzip-compressed, redacted bodies; streamed (SSE) responses are stored reassembled
+    request_body BYTEA,
+    response_body BYTEA,
+    request_bytes INT NOT NULL DEFAULT 0,
+    response_bytes INT NOT NULL DEFAULT 0,
+    request_truncated BOOLEAN NOT NULL DEFAULT false,
+    response_truncated BOOLEAN NOT NULL DEFAULT false,
+    response_reassembled BOOLEAN NOT NULL DEFAULT false,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ops_payload_captures_created_at
+    ON ops_payload_captures (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_ops_payload_captures_rule_id
+    ON ops_payload_captures (rule_id);
+CREATE INDEX IF NOT EXISTS idx_ops_payload_captures_expires_at
+    ON ops_payload_captures (expires_at);
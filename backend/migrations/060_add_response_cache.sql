@@ -0,0 +1,24 @@
+-- Opt-in exact response cache for deterministic requests (temperature = 0)
+-- response_cache_enabled: cache identical requests for the group / api key
+-- response_cache_ttl_seconds: entry lifetime, 0 = use the global default
+-- response_cache_cost_ratio (groups only): fraction of the original cost billed on a hit, 0 = free
+-- API key settings take precedence over group settings when enabled on the key.
+
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS response_cache_enabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS response_cache_ttl_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS response_cache_cost_ratio DECIMAL(10,4) NOT NULL DEFAULT 0;
+
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS response_cache_enabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS response_cache_ttl_seconds INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN groups.response_cache_cost_ratio IS 'Fraction of the original cost billed on a response cache hit, 0 = free';
+
+-- Postgres storage backend for cached responses (used when response_cache.backend = postgres)
+CREATE TABLE IF NOT EXISTS response_cache_entries (
+    cache_key VARCHAR(64) PRIMARY KEY,
+    payload BYTEA NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_response_cache_entries_expires_at ON response_cache_entries (expires_at);
@@ -0,0 +1,5 @@
+-- Encryption at rest: proxies.password may hold an envelope ciphertext,
+-- which is longer than the original VARCHAR(100). The plaintext length limit
+-- is now enforced by the API layer.
+
+ALTER TABLE proxies ALTER COLUMN password TYPE TEXT;
@@ -0,0 +1,25 @@
+-- 062_add_proxy_pools.sql
+-- 代理池：将多个代理编组，账号引用代理池后按选择策略（sticky / round_robin / least_latency / same_country）分配代理
+-- accounts.proxy_id 保存当前分配到的池内代理；代理不健康或转发时连接失败会自动切换到池内其他代理
+
+CREATE TABLE IF NOT EXISTS proxy_pools (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    policy VARCHAR(20) NOT NULL DEFAULT 'sticky',
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_proxy_pools_status ON proxy_pools(status);
+CREATE INDEX IF NOT EXISTS idx_proxy_pools_deleted_at ON proxy_pools(deleted_at);
+
+-- 代理所属代理池（一个代理最多属于一个代理池）
+ALTER TABLE proxies ADD COLUMN IF NOT EXISTS pool_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_proxies_pool_id ON proxies(pool_id);
+
+-- 账号引用的代理池（非空时 proxy_id 由代理池管理）
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS proxy_pool_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_accounts_proxy_pool_id ON accounts(proxy_pool_id);
@@ -0,0 +1,28 @@
+-- 063_add_proxy_subscriptions.sql
+-- 代理订阅：从 Clash / V2Ray 订阅地址批量导入 http / https / socks5 代理，并按间隔自动同步
+-- 订阅中移除的代理会被停用（不删除），重新出现时恢复启用
+
+CREATE TABLE IF NOT EXISTS proxy_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    url TEXT NOT NULL,
+    refresh_interval_minutes INT NOT NULL DEFAULT 0,
+    pool_id BIGINT,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    last_synced_at TIMESTAMPTZ,
+    last_error TEXT NOT NULL DEFAULT '',
+    last_total INT NOT NULL DEFAULT 0,
+    last_created INT NOT NULL DEFAULT 0,
+    last_skipped INT NOT NULL DEFAULT 0,
+    last_disabled INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_proxy_subscriptions_status ON proxy_subscriptions(status);
+CREATE INDEX IF NOT EXISTS idx_proxy_subscriptions_deleted_at ON proxy_subscriptions(deleted_at);
+
+-- 代理的导入来源订阅（手动创建的代理为 NULL）
+ALTER TABLE proxies ADD COLUMN IF NOT EXISTS subscription_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_proxies_subscription_id ON proxies(subscription_id);
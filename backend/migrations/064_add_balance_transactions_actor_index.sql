@@ -0,0 +1,6 @@
+-- 064_add_balance_transactions_actor_index.sql
+-- 按操作人统计近 24 小时的管理员余额调整总额（客服累计调整额度）
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_actor_created_at
+    ON balance_transactions(actor_user_id, created_at DESC)
+    WHERE actor_user_id IS NOT NULL;
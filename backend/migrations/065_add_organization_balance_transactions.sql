@@ -0,0 +1,33 @@
+-- 065_add_organization_balance_transactions.sql
+-- 组织钱包流水账本（只追加）：请求扣费与管理员调整随 organizations.balance 的更新一起写入
+
+CREATE TABLE IF NOT EXISTS organization_balance_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    amount DECIMAL(20,8) NOT NULL,
+    balance_after DECIMAL(20,8) NOT NULL,
+    source_type VARCHAR(32) NOT NULL,
+    reference_id VARCHAR(128) NOT NULL DEFAULT '',
+    actor_user_id BIGINT,
+    notes VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_org_balance_transactions_org_id
+    ON organization_balance_transactions(organization_id, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_org_balance_transactions_source
+    ON organization_balance_transactions(source_type, reference_id);
+
+-- 账本不可修改：拒绝 UPDATE / DELETE（复用用户余额账本的触发函数）
+DROP TRIGGER IF EXISTS trg_org_balance_transactions_append_only ON organization_balance_transactions;
+CREATE TRIGGER trg_org_balance_transactions_append_only
+    BEFORE UPDATE OR DELETE ON organization_balance_transactions
+    FOR EACH ROW EXECUTE FUNCTION balance_transactions_reject_mutation();
+
+-- 期初余额：为已有余额的组织写入一条 opening_balance，使账本合计与 organizations.balance 对齐
+INSERT INTO organization_balance_transactions (organization_id, amount, balance_after, source_type, notes, created_at)
+SELECT o.id, o.balance, o.balance, 'opening_balance', 'ledger introduced', NOW()
+FROM organizations o
+WHERE o.balance <> 0
+  AND NOT EXISTS (SELECT 1 FROM organization_balance_transactions t WHERE t.organization_id = o.id);
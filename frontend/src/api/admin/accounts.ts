@@ -13,7 +13,12 @@ import type {
   WindowStats,
   ClaudeModel,
   AccountUsageStatsResponse,
-  TempUnschedulableStatus
+  AccountHealthReport,
+  TempUnschedulableStatus,
+  AccountBundleEnvelope,
+  AccountBundleImportResult,
+  ImportAccountBundleRequest,
+  SyncFromInstanceRequest
 } from '@/types'
 
 /**
@@ -139,6 +144,16 @@ export async function getStats(id: number, days: number = 30): Promise<AccountUs
   return data
 }
 
+/**
+ * Get account health score used by health-weighted scheduling
+ * @param id - Account ID
+ * @returns Health score with per-factor breakdown
+ */
+export async function getHealth(id: number): Promise<AccountHealthReport> {
+  const { data } = await apiClient.get<AccountHealthReport>(`/admin/accounts/${id}/health`)
+  return data
+}
+
 /**
  * Clear account error
  * @param id - Account ID
@@ -347,6 +362,40 @@ export async function syncFromCrs(params: {
   return data
 }
 
+/**
+ * Export accounts (with their groups and proxies) as a password-encrypted bundle
+ * @param params - Password and optional account/group IDs (all accounts when empty)
+ * @returns Encrypted bundle envelope
+ */
+export async function exportBundle(params: {
+  password: string
+  account_ids?: number[]
+  group_ids?: number[]
+}): Promise<AccountBundleEnvelope> {
+  const { data } = await apiClient.post<AccountBundleEnvelope>('/admin/accounts/bundle/export', params)
+  return data
+}
+
+/**
+ * Import an encrypted account bundle (dry_run returns the diff without writing)
+ * @param params - Bundle, password, conflict policy and dry-run flag
+ * @returns Import result with per-item actions
+ */
+export async function importBundle(params: ImportAccountBundleRequest): Promise<AccountBundleImportResult> {
+  const { data } = await apiClient.post<AccountBundleImportResult>('/admin/accounts/bundle/import', params)
+  return data
+}
+
+/**
+ * Pull accounts from another sub2api instance through its admin API
+ * @param params - Remote base URL, admin API key, bundle password and import options
+ * @returns Import result with per-item actions
+ */
+export async function syncFromInstance(params: SyncFromInstanceRequest): Promise<AccountBundleImportResult> {
+  const { data } = await apiClient.post<AccountBundleImportResult>('/admin/accounts/sync/instance', params)
+  return data
+}
+
 export const accountsAPI = {
   list,
   getById,
@@ -357,6 +406,7 @@ export const accountsAPI = {
   testAccount,
   refreshCredentials,
   getStats,
+  getHealth,
   clearError,
   getUsage,
   getTodayStats,
@@ -370,7 +420,10 @@ export const accountsAPI = {
   batchCreate,
   batchUpdateCredentials,
   bulkUpdate,
-  syncFromCrs
+  syncFromCrs,
+  exportBundle,
+  importBundle,
+  syncFromInstance
 }
 
 export default accountsAPI
@@ -0,0 +1,58 @@
+/**
+ * Admin API Keys API endpoints
+ * Named, scoped keys for external system integration
+ */
+
+import { apiClient } from '../client'
+import type {
+  AdminApiKey,
+  AdminRolesResponse,
+  CreateAdminApiKeyRequest,
+  CreateAdminApiKeyResponse
+} from '@/types'
+
+/**
+ * List all admin API keys
+ * @returns Key metadata (plaintext keys are never returned)
+ */
+export async function list(): Promise<AdminApiKey[]> {
+  const { data } = await apiClient.get<AdminApiKey[]>('/admin/admin-api-keys')
+  return data
+}
+
+/**
+ * Create a new admin API key
+ * @param payload - Name, scopes and optional expiry
+ * @returns Created key including the plaintext key (only shown once)
+ */
+export async function create(payload: CreateAdminApiKeyRequest): Promise<CreateAdminApiKeyResponse> {
+  const { data } = await apiClient.post<CreateAdminApiKeyResponse>('/admin/admin-api-keys', payload)
+  return data
+}
+
+/**
+ * Revoke an admin API key
+ * @param id - Key ID
+ * @returns Success message
+ */
+export async function revoke(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/admin-api-keys/${id}`)
+  return data
+}
+
+/**
+ * Get admin roles and the permission catalog
+ */
+export async function getRoles(): Promise<AdminRolesResponse> {
+  const { data } = await apiClient.get<AdminRolesResponse>('/admin/roles')
+  return data
+}
+
+const adminApiKeysAPI = {
+  list,
+  create,
+  revoke,
+  getRoles
+}
+
+export default adminApiKeysAPI
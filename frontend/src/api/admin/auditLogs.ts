@@ -0,0 +1,36 @@
+/**
+ * Admin Audit Logs API endpoints
+ */
+
+import { apiClient } from '../client'
+import type { AuditLog, AuditLogFilters, BasePaginationResponse } from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: AuditLogFilters
+): Promise<BasePaginationResponse<AuditLog>> {
+  const { data } = await apiClient.get<BasePaginationResponse<AuditLog>>('/admin/audit-logs', {
+    params: { page, page_size: pageSize, ...filters }
+  })
+  return data
+}
+
+/**
+ * Export audit logs matching the filters to CSV
+ * @returns CSV data as blob
+ */
+export async function exportCSV(filters?: AuditLogFilters): Promise<Blob> {
+  const response = await apiClient.get('/admin/audit-logs/export', {
+    params: filters,
+    responseType: 'blob'
+  })
+  return response.data
+}
+
+const auditLogsAPI = {
+  list,
+  exportCSV
+}
+
+export default auditLogsAPI
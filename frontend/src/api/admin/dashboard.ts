@@ -9,7 +9,9 @@ import type {
   TrendDataPoint,
   ModelStat,
   ApiKeyUsageTrendPoint,
-  UserUsageTrendPoint
+  UserUsageTrendPoint,
+  PromptCacheStat,
+  UserCacheSavings
 } from '@/types'
 
 /**
@@ -98,6 +100,35 @@ export async function getModelStats(params?: ModelStatsParams): Promise<ModelSta
   return data
 }
 
+export interface PromptCacheStatsParams {
+  start_date?: string
+  end_date?: string
+  group_id?: number
+  limit?: number
+}
+
+export interface PromptCacheStatsResponse {
+  accounts: PromptCacheStat[]
+  groups: PromptCacheStat[]
+  users: UserCacheSavings[]
+  start_date: string
+  end_date: string
+}
+
+/**
+ * Get prompt cache hit rates per account/group and cache savings per user
+ * @param params - Query parameters for filtering
+ * @returns Prompt cache statistics
+ */
+export async function getPromptCacheStats(
+  params?: PromptCacheStatsParams
+): Promise<PromptCacheStatsResponse> {
+  const { data } = await apiClient.get<PromptCacheStatsResponse>('/admin/dashboard/prompt-cache', {
+    params
+  })
+  return data
+}
+
 export interface ApiKeyTrendParams extends TrendParams {
   limit?: number
 }
@@ -200,6 +231,7 @@ export const dashboardAPI = {
   getRealtimeMetrics,
   getUsageTrend,
   getModelStats,
+  getPromptCacheStats,
   getApiKeyUsageTrend,
   getUserUsageTrend,
   getBatchUsersUsage,
@@ -18,6 +18,14 @@ import geminiAPI from './gemini'
 import antigravityAPI from './antigravity'
 import userAttributesAPI from './userAttributes'
 import opsAPI from './ops'
+import auditLogsAPI from './auditLogs'
+import organizationsAPI from './organizations'
+import adminApiKeysAPI from './adminApiKeys'
+import invoicesAPI from './invoices'
+import paymentOrdersAPI from './paymentOrders'
+import subscriptionPlansAPI from './subscriptionPlans'
+import modelPricesAPI from './modelPrices'
+import proxyPoolsAPI from './proxyPools'
 
 /**
  * Unified admin API object for convenient access
@@ -37,7 +45,15 @@ export const adminAPI = {
   gemini: geminiAPI,
   antigravity: antigravityAPI,
   userAttributes: userAttributesAPI,
-  ops: opsAPI
+  ops: opsAPI,
+  auditLogs: auditLogsAPI,
+  organizations: organizationsAPI,
+  adminApiKeys: adminApiKeysAPI,
+  invoices: invoicesAPI,
+  paymentOrders: paymentOrdersAPI,
+  subscriptionPlans: subscriptionPlansAPI,
+  modelPrices: modelPricesAPI,
+  proxyPools: proxyPoolsAPI
 }
 
 export {
@@ -55,7 +71,15 @@ export {
   geminiAPI,
   antigravityAPI,
   userAttributesAPI,
-  opsAPI
+  opsAPI,
+  auditLogsAPI,
+  organizationsAPI,
+  adminApiKeysAPI,
+  invoicesAPI,
+  paymentOrdersAPI,
+  subscriptionPlansAPI,
+  modelPricesAPI,
+  proxyPoolsAPI
 }
 
 export default adminAPI
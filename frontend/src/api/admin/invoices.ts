@@ -0,0 +1,87 @@
+/**
+ * Admin Invoices API endpoints
+ * Issue, regenerate, void and email monthly invoices
+ */
+
+import { apiClient } from '../client'
+import type {
+  Invoice,
+  InvoiceFilters,
+  GenerateInvoicesRequest,
+  BasePaginationResponse
+} from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: InvoiceFilters
+): Promise<BasePaginationResponse<Invoice>> {
+  const { data } = await apiClient.get<BasePaginationResponse<Invoice>>('/admin/invoices', {
+    params: { page, page_size: pageSize, ...filters }
+  })
+  return data
+}
+
+export async function getById(id: number): Promise<Invoice> {
+  const { data } = await apiClient.get<Invoice>(`/admin/invoices/${id}`)
+  return data
+}
+
+/**
+ * Issue invoices for a past month.
+ * With subject_type/subject_id the invoice is returned directly;
+ * without them all billable users and organizations are invoiced in the background.
+ */
+export async function generate(
+  request: GenerateInvoicesRequest
+): Promise<Invoice | { period: string; started: boolean }> {
+  const { data } = await apiClient.post<Invoice | { period: string; started: boolean }>(
+    '/admin/invoices/generate',
+    request
+  )
+  return data
+}
+
+/**
+ * Rebuild an invoice from current data, keeping its number
+ */
+export async function regenerate(id: number): Promise<Invoice> {
+  const { data } = await apiClient.post<Invoice>(`/admin/invoices/${id}/regenerate`)
+  return data
+}
+
+export async function voidInvoice(id: number): Promise<Invoice> {
+  const { data } = await apiClient.post<Invoice>(`/admin/invoices/${id}/void`)
+  return data
+}
+
+/**
+ * Queue the invoice email (HTML body with PDF attachment) to the recipient
+ */
+export async function sendEmail(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.post<{ message: string }>(`/admin/invoices/${id}/send`)
+  return data
+}
+
+/**
+ * Download an invoice rendered as PDF or HTML
+ * @returns File data as blob
+ */
+export async function download(id: number, format: 'pdf' | 'html'): Promise<Blob> {
+  const response = await apiClient.get(`/admin/invoices/${id}/${format}`, {
+    responseType: 'blob'
+  })
+  return response.data
+}
+
+export const invoicesAPI = {
+  list,
+  getById,
+  generate,
+  regenerate,
+  voidInvoice,
+  sendEmail,
+  download
+}
+
+export default invoicesAPI
@@ -0,0 +1,88 @@
+/**
+ * Admin Model Prices API endpoints
+ * Manage effective-dated price overrides (global or per group) and the unknown model policy
+ */
+
+import { apiClient } from '../client'
+import type {
+  ModelPrice,
+  ModelPriceRequest,
+  ModelPricingPreview,
+  UnknownModelPricingSettings,
+  BasePaginationResponse
+} from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: { scope?: string; group_id?: number; model?: string }
+): Promise<BasePaginationResponse<ModelPrice>> {
+  const { data } = await apiClient.get<BasePaginationResponse<ModelPrice>>('/admin/model-prices', {
+    params: { page, page_size: pageSize, ...filters }
+  })
+  return data
+}
+
+export async function getById(id: number): Promise<ModelPrice> {
+  const { data } = await apiClient.get<ModelPrice>(`/admin/model-prices/${id}`)
+  return data
+}
+
+/**
+ * Create a price version; leave effective_from empty to apply immediately
+ */
+export async function create(request: ModelPriceRequest): Promise<ModelPrice> {
+  const { data } = await apiClient.post<ModelPrice>('/admin/model-prices', request)
+  return data
+}
+
+/**
+ * Update a scheduled price version (versions already in effect are immutable)
+ */
+export async function update(id: number, request: ModelPriceRequest): Promise<ModelPrice> {
+  const { data } = await apiClient.put<ModelPrice>(`/admin/model-prices/${id}`, request)
+  return data
+}
+
+export async function deletePrice(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/model-prices/${id}`)
+  return data
+}
+
+/**
+ * Resolve the price currently used to bill a model (optionally for a group)
+ */
+export async function preview(model: string, groupId?: number | null): Promise<ModelPricingPreview> {
+  const { data } = await apiClient.get<ModelPricingPreview>('/admin/model-prices/preview', {
+    params: { model, group_id: groupId || undefined }
+  })
+  return data
+}
+
+export async function getUnknownPolicy(): Promise<UnknownModelPricingSettings> {
+  const { data } = await apiClient.get<UnknownModelPricingSettings>('/admin/model-prices/unknown-policy')
+  return data
+}
+
+export async function updateUnknownPolicy(
+  settings: UnknownModelPricingSettings
+): Promise<UnknownModelPricingSettings> {
+  const { data } = await apiClient.put<UnknownModelPricingSettings>(
+    '/admin/model-prices/unknown-policy',
+    settings
+  )
+  return data
+}
+
+export const modelPricesAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deletePrice,
+  preview,
+  getUnknownPolicy,
+  updateUnknownPolicy
+}
+
+export default modelPricesAPI
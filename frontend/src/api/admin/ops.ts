@@ -678,6 +678,7 @@ export type MetricType =
   | 'account_error_count'
   | 'account_error_ratio'
   | 'overload_account_count'
+  | 'balance_ledger_drift_users'
 export type Operator = '>' | '>=' | '<' | '<=' | '==' | '!='
 
 export interface AlertRule {
@@ -693,6 +694,7 @@ export interface AlertRule {
   severity: OpsSeverity
   cooldown_minutes: number
   notify_email: boolean
+  notify_channel_ids?: number[]
   filters?: Record<string, any>
   created_at?: string
   updated_at?: string
@@ -712,9 +714,46 @@ export interface AlertEvent {
   fired_at: string
   resolved_at?: string | null
   email_sent: boolean
+  notifications?: NotificationDelivery[]
   created_at: string
 }
 
+export type NotificationChannelType = 'webhook' | 'slack' | 'discord' | 'telegram' | 'dingtalk' | 'feishu'
+
+export interface NotificationChannelConfig {
+  url?: string
+  // Write-only: responses blank these and set *_configured; leave empty on update to keep the stored value.
+  secret?: string
+  headers?: Record<string, string>
+  bot_token?: string
+  chat_id?: string
+  secret_configured?: boolean
+  bot_token_configured?: boolean
+}
+
+export interface NotificationChannel {
+  id?: number
+  name: string
+  type: NotificationChannelType
+  enabled: boolean
+  config: NotificationChannelConfig
+  send_resolved: boolean
+  send_reports: boolean
+  created_at?: string
+  updated_at?: string
+}
+
+export interface NotificationDelivery {
+  channel_id: number
+  channel_name: string
+  channel_type: NotificationChannelType | string
+  kind: 'alert.firing' | 'alert.resolved' | 'report' | 'test' | string
+  status: 'sent' | 'failed' | string
+  attempts: number
+  error?: string
+  sent_at: string
+}
+
 export interface EmailNotificationConfig {
   alert: {
     enabled: boolean
@@ -740,6 +779,73 @@ export interface EmailNotificationConfig {
   }
 }
 
+export type PayloadCaptureScopeType = 'user' | 'api_key' | 'group'
+
+export interface PayloadCaptureRule {
+  id?: number
+  name: string
+  scope_type: PayloadCaptureScopeType
+  scope_id: number
+  sample_rate: number
+  ttl_hours: number
+  redact_keys: string[]
+  enabled: boolean
+  expires_at?: string | null
+  capture_count?: number
+  last_captured_at?: string | null
+  created_by?: number | null
+  created_at?: string
+  updated_at?: string
+}
+
+export interface PayloadCapture {
+  id: number
+  rule_id?: number | null
+  request_id: string
+  client_request_id: string
+  user_id?: number | null
+  user_email: string
+  api_key_id?: number | null
+  group_id?: number | null
+  group_name: string
+  account_id?: number | null
+  account_name: string
+  platform: string
+  model: string
+  request_path: string
+  stream: boolean
+  status_code: number
+  duration_ms: number
+  request_bytes: number
+  response_bytes: number
+  request_truncated: boolean
+  response_truncated: boolean
+  response_reassembled: boolean
+  expires_at: string
+  created_at: string
+}
+
+export interface PayloadCaptureDetail extends PayloadCapture {
+  user_agent: string
+  request_headers: Record<string, string>
+  request_body: string
+  response_body: string
+}
+
+export interface PayloadCaptureQueryParams {
+  page?: number
+  page_size?: number
+  time_range?: '5m' | '30m' | '1h' | '6h' | '24h' | '7d' | '30d'
+  start_time?: string
+  end_time?: string
+  rule_id?: number | null
+  user_id?: number | null
+  api_key_id?: number | null
+  group_id?: number | null
+  account_id?: number | null
+  model?: string
+}
+
 export interface OpsMetricThresholds {
   sla_percent_min?: number | null                 // SLA低于此值变红
   ttft_p99_ms_max?: number | null                 // TTFT P99高于此值变红
@@ -817,6 +923,7 @@ export interface OpsErrorLog {
 
   client_request_id: string
   request_id: string
+  trace_id?: string
   message: string
 
   user_id?: number | null
@@ -1115,6 +1222,30 @@ export async function createAlertSilence(payload: {
 }
 
 // Email notification config
+export async function listNotificationChannels(): Promise<NotificationChannel[]> {
+  const { data } = await apiClient.get<NotificationChannel[]>('/admin/ops/notification-channels')
+  return data
+}
+
+export async function createNotificationChannel(channel: NotificationChannel): Promise<NotificationChannel> {
+  const { data } = await apiClient.post<NotificationChannel>('/admin/ops/notification-channels', channel)
+  return data
+}
+
+export async function updateNotificationChannel(id: number, channel: NotificationChannel): Promise<NotificationChannel> {
+  const { data } = await apiClient.put<NotificationChannel>(`/admin/ops/notification-channels/${id}`, channel)
+  return data
+}
+
+export async function deleteNotificationChannel(id: number): Promise<void> {
+  await apiClient.delete(`/admin/ops/notification-channels/${id}`)
+}
+
+export async function testNotificationChannel(id: number): Promise<NotificationDelivery> {
+  const { data } = await apiClient.post<NotificationDelivery>(`/admin/ops/notification-channels/${id}/test`)
+  return data
+}
+
 export async function getEmailNotificationConfig(): Promise<EmailNotificationConfig> {
   const { data } = await apiClient.get<EmailNotificationConfig>('/admin/ops/email-notification/config')
   return data
@@ -1125,6 +1256,47 @@ export async function updateEmailNotificationConfig(config: EmailNotificationCon
   return data
 }
 
+// Payload capture
+export async function listPayloadCaptureRules(): Promise<PayloadCaptureRule[]> {
+  const { data } = await apiClient.get<PayloadCaptureRule[]>('/admin/ops/payload-capture-rules')
+  return data
+}
+
+export async function createPayloadCaptureRule(rule: PayloadCaptureRule): Promise<PayloadCaptureRule> {
+  const { data } = await apiClient.post<PayloadCaptureRule>('/admin/ops/payload-capture-rules', rule)
+  return data
+}
+
+export async function updatePayloadCaptureRule(id: number, rule: PayloadCaptureRule): Promise<PayloadCaptureRule> {
+  const { data } = await apiClient.put<PayloadCaptureRule>(`/admin/ops/payload-capture-rules/${id}`, rule)
+  return data
+}
+
+export async function deletePayloadCaptureRule(id: number): Promise<void> {
+  await apiClient.delete(`/admin/ops/payload-capture-rules/${id}`)
+}
+
+export async function listPayloadCaptures(params: PayloadCaptureQueryParams): Promise<PaginatedResponse<PayloadCapture>> {
+  const { data } = await apiClient.get<PaginatedResponse<PayloadCapture>>('/admin/ops/payload-captures', { params })
+  return data
+}
+
+export async function getPayloadCapture(id: number): Promise<PayloadCaptureDetail> {
+  const { data } = await apiClient.get<PayloadCaptureDetail>(`/admin/ops/payload-captures/${id}`)
+  return data
+}
+
+export async function deletePayloadCapture(id: number): Promise<void> {
+  await apiClient.delete(`/admin/ops/payload-captures/${id}`)
+}
+
+export async function replayPayloadCapture(id: number, accountId?: number | null): Promise<OpsRetryResult> {
+  const { data } = await apiClient.post<OpsRetryResult>(`/admin/ops/payload-captures/${id}/replay`, {
+    account_id: accountId ?? null
+  })
+  return data
+}
+
 // Runtime settings (DB-backed)
 export async function getAlertRuntimeSettings(): Promise<OpsAlertRuntimeSettings> {
   const { data } = await apiClient.get<OpsAlertRuntimeSettings>('/admin/ops/runtime/alert')
@@ -1197,8 +1369,21 @@ export const opsAPI = {
   getAlertEvent,
   updateAlertEventStatus,
   createAlertSilence,
+  listNotificationChannels,
+  createNotificationChannel,
+  updateNotificationChannel,
+  deleteNotificationChannel,
+  testNotificationChannel,
   getEmailNotificationConfig,
   updateEmailNotificationConfig,
+  listPayloadCaptureRules,
+  createPayloadCaptureRule,
+  updatePayloadCaptureRule,
+  deletePayloadCaptureRule,
+  listPayloadCaptures,
+  getPayloadCapture,
+  deletePayloadCapture,
+  replayPayloadCapture,
   getAlertRuntimeSettings,
   updateAlertRuntimeSettings,
   getAdvancedSettings,
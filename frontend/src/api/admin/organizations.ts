@@ -0,0 +1,147 @@
+/**
+ * Admin Organizations API endpoints
+ */
+
+import { apiClient } from '../client'
+import type {
+  Organization,
+  OrganizationMember,
+  OrganizationMemberRequest,
+  OrganizationUsageBreakdown,
+  OrganizationBalanceTransaction,
+  CreateOrganizationRequest,
+  UpdateOrganizationRequest,
+  BasePaginationResponse
+} from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: {
+    status?: string
+    search?: string
+  }
+): Promise<BasePaginationResponse<Organization>> {
+  const { data } = await apiClient.get<BasePaginationResponse<Organization>>('/admin/organizations', {
+    params: { page, page_size: pageSize, ...filters }
+  })
+  return data
+}
+
+export async function getById(id: number): Promise<Organization> {
+  const { data } = await apiClient.get<Organization>(`/admin/organizations/${id}`)
+  return data
+}
+
+export async function create(request: CreateOrganizationRequest): Promise<Organization> {
+  const { data } = await apiClient.post<Organization>('/admin/organizations', request)
+  return data
+}
+
+export async function update(id: number, request: UpdateOrganizationRequest): Promise<Organization> {
+  const { data } = await apiClient.put<Organization>(`/admin/organizations/${id}`, request)
+  return data
+}
+
+export async function deleteOrganization(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/organizations/${id}`)
+  return data
+}
+
+/**
+ * Adjust the shared organization wallet
+ */
+export async function adjustBalance(
+  id: number,
+  amount: number,
+  operation: 'set' | 'add' | 'subtract',
+  notes?: string
+): Promise<Organization> {
+  const { data } = await apiClient.post<Organization>(`/admin/organizations/${id}/balance`, {
+    amount,
+    operation,
+    notes
+  })
+  return data
+}
+
+/**
+ * List the organization wallet ledger (newest first)
+ */
+export async function listTransactions(
+  id: number,
+  page: number = 1,
+  pageSize: number = 20
+): Promise<BasePaginationResponse<OrganizationBalanceTransaction>> {
+  const { data } = await apiClient.get<BasePaginationResponse<OrganizationBalanceTransaction>>(
+    `/admin/organizations/${id}/transactions`,
+    { params: { page, page_size: pageSize } }
+  )
+  return data
+}
+
+export async function listMembers(id: number): Promise<OrganizationMember[]> {
+  const { data } = await apiClient.get<OrganizationMember[]>(`/admin/organizations/${id}/members`)
+  return data
+}
+
+export async function addMember(
+  id: number,
+  request: OrganizationMemberRequest
+): Promise<OrganizationMember> {
+  const { data } = await apiClient.post<OrganizationMember>(
+    `/admin/organizations/${id}/members`,
+    request
+  )
+  return data
+}
+
+export async function updateMember(
+  id: number,
+  userId: number,
+  request: OrganizationMemberRequest
+): Promise<OrganizationMember> {
+  const { data } = await apiClient.put<OrganizationMember>(
+    `/admin/organizations/${id}/members/${userId}`,
+    request
+  )
+  return data
+}
+
+export async function removeMember(id: number, userId: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(
+    `/admin/organizations/${id}/members/${userId}`
+  )
+  return data
+}
+
+/**
+ * Per-member usage breakdown (defaults to the current month)
+ */
+export async function getUsage(
+  id: number,
+  params?: { start_date?: string; end_date?: string; timezone?: string }
+): Promise<OrganizationUsageBreakdown> {
+  const { data } = await apiClient.get<OrganizationUsageBreakdown>(
+    `/admin/organizations/${id}/usage`,
+    { params }
+  )
+  return data
+}
+
+const organizationsAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deleteOrganization,
+  adjustBalance,
+  listTransactions,
+  listMembers,
+  addMember,
+  updateMember,
+  removeMember,
+  getUsage
+}
+
+export default organizationsAPI
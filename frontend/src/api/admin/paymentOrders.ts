@@ -0,0 +1,57 @@
+/**
+ * Admin Payment Orders API endpoints
+ * Reconcile top-up orders: manual crediting of lost webhooks and refunds
+ */
+
+import { apiClient } from '../client'
+import type { AdminPaymentOrder, PaymentOrderFilters, BasePaginationResponse } from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: PaymentOrderFilters
+): Promise<BasePaginationResponse<AdminPaymentOrder>> {
+  const { data } = await apiClient.get<BasePaginationResponse<AdminPaymentOrder>>(
+    '/admin/payment-orders',
+    { params: { page, page_size: pageSize, ...filters } }
+  )
+  return data
+}
+
+export async function getById(id: number): Promise<AdminPaymentOrder> {
+  const { data } = await apiClient.get<AdminPaymentOrder>(`/admin/payment-orders/${id}`)
+  return data
+}
+
+/**
+ * Credit a pending/failed order after confirming the payment in the provider dashboard
+ */
+export async function markPaid(
+  id: number,
+  request: { provider_txn_id?: string; notes?: string }
+): Promise<AdminPaymentOrder> {
+  const { data } = await apiClient.post<AdminPaymentOrder>(
+    `/admin/payment-orders/${id}/mark-paid`,
+    request
+  )
+  return data
+}
+
+/**
+ * Record a refund issued in the provider dashboard and debit the credited balance
+ */
+export async function refund(id: number, notes?: string): Promise<AdminPaymentOrder> {
+  const { data } = await apiClient.post<AdminPaymentOrder>(`/admin/payment-orders/${id}/refund`, {
+    notes
+  })
+  return data
+}
+
+export const paymentOrdersAPI = {
+  list,
+  getById,
+  markPaid,
+  refund
+}
+
+export default paymentOrdersAPI
@@ -9,6 +9,11 @@ import type {
   ProxyAccountSummary,
   CreateProxyRequest,
   UpdateProxyRequest,
+  ProxySubscription,
+  CreateProxySubscriptionRequest,
+  UpdateProxySubscriptionRequest,
+  ImportProxiesRequest,
+  ProxyImportResult,
   PaginatedResponse
 } from '@/types'
 
@@ -208,6 +213,76 @@ export async function batchDelete(ids: number[]): Promise<{
   return data
 }
 
+/**
+ * Import proxies from a pasted list or subscription URL
+ * Supports Clash YAML, base64 V2Ray subscriptions and one proxy per line
+ * @param request - Content or URL to import
+ * @returns Import result (created / duplicated / skipped entries)
+ */
+export async function importProxies(request: ImportProxiesRequest): Promise<ProxyImportResult> {
+  const { data } = await apiClient.post<ProxyImportResult>('/admin/proxies/import', request)
+  return data
+}
+
+/**
+ * List proxy subscriptions
+ * @returns All proxy subscriptions with last sync results
+ */
+export async function listSubscriptions(): Promise<ProxySubscription[]> {
+  const { data } = await apiClient.get<ProxySubscription[]>('/admin/proxy-subscriptions')
+  return data
+}
+
+/**
+ * Create proxy subscription and run its first sync
+ * @param request - Subscription data
+ * @returns Created subscription and first sync result (null when the sync failed)
+ */
+export async function createSubscription(request: CreateProxySubscriptionRequest): Promise<{
+  subscription: ProxySubscription
+  result: ProxyImportResult | null
+}> {
+  const { data } = await apiClient.post<{
+    subscription: ProxySubscription
+    result: ProxyImportResult | null
+  }>('/admin/proxy-subscriptions', request)
+  return data
+}
+
+/**
+ * Update proxy subscription
+ * @param id - Subscription ID
+ * @param updates - Fields to update
+ * @returns Updated subscription
+ */
+export async function updateSubscription(
+  id: number,
+  updates: UpdateProxySubscriptionRequest
+): Promise<ProxySubscription> {
+  const { data } = await apiClient.put<ProxySubscription>(`/admin/proxy-subscriptions/${id}`, updates)
+  return data
+}
+
+/**
+ * Delete proxy subscription (imported proxies are kept)
+ * @param id - Subscription ID
+ * @returns Success confirmation
+ */
+export async function deleteSubscription(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/proxy-subscriptions/${id}`)
+  return data
+}
+
+/**
+ * Sync proxy subscription now
+ * @param id - Subscription ID
+ * @returns Sync result
+ */
+export async function syncSubscription(id: number): Promise<ProxyImportResult> {
+  const { data } = await apiClient.post<ProxyImportResult>(`/admin/proxy-subscriptions/${id}/sync`)
+  return data
+}
+
 export const proxiesAPI = {
   list,
   getAll,
@@ -221,7 +296,13 @@ export const proxiesAPI = {
   getStats,
   getProxyAccounts,
   batchCreate,
-  batchDelete
+  batchDelete,
+  importProxies,
+  listSubscriptions,
+  createSubscription,
+  updateSubscription,
+  deleteSubscription,
+  syncSubscription
 }
 
 export default proxiesAPI
@@ -0,0 +1,65 @@
+/**
+ * Admin Proxy Pools API endpoints
+ * Manage proxy pools that accounts can reference instead of a single proxy
+ */
+
+import { apiClient } from '../client'
+import type {
+  ProxyPool,
+  CreateProxyPoolRequest,
+  UpdateProxyPoolRequest,
+  BasePaginationResponse
+} from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: { status?: string; search?: string }
+): Promise<BasePaginationResponse<ProxyPool>> {
+  const { data } = await apiClient.get<BasePaginationResponse<ProxyPool>>('/admin/proxy-pools', {
+    params: { page, page_size: pageSize, ...filters }
+  })
+  return data
+}
+
+/**
+ * Get all active pools (for account forms)
+ */
+export async function getAll(): Promise<ProxyPool[]> {
+  const { data } = await apiClient.get<ProxyPool[]>('/admin/proxy-pools/all')
+  return data
+}
+
+export async function getById(id: number): Promise<ProxyPool> {
+  const { data } = await apiClient.get<ProxyPool>(`/admin/proxy-pools/${id}`)
+  return data
+}
+
+export async function create(request: CreateProxyPoolRequest): Promise<ProxyPool> {
+  const { data } = await apiClient.post<ProxyPool>('/admin/proxy-pools', request)
+  return data
+}
+
+/**
+ * Update a pool; accounts on removed or unhealthy members are moved to other members
+ */
+export async function update(id: number, request: UpdateProxyPoolRequest): Promise<ProxyPool> {
+  const { data } = await apiClient.put<ProxyPool>(`/admin/proxy-pools/${id}`, request)
+  return data
+}
+
+export async function deletePool(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/proxy-pools/${id}`)
+  return data
+}
+
+export const proxyPoolsAPI = {
+  list,
+  getAll,
+  getById,
+  create,
+  update,
+  delete: deletePool
+}
+
+export default proxyPoolsAPI
@@ -4,6 +4,7 @@
  */
 
 import { apiClient } from '../client'
+import type { InvoiceSettings } from '@/types'
 
 /**
  * System settings interface
@@ -71,6 +72,22 @@ export interface SystemSettings {
   creem_product_id: string
   creem_rate_multiplier: number
   creem_success_url: string
+
+  // Stripe Payment Integration
+  stripe_enabled: boolean
+  stripe_secret_key_configured: boolean
+  stripe_webhook_secret_configured: boolean
+  stripe_currency: string
+  stripe_rate_multiplier: number
+
+  // EPay Payment Integration
+  epay_enabled: boolean
+  epay_gateway_url: string
+  epay_merchant_id: string
+  epay_merchant_key_configured: boolean
+  epay_pay_types: string // comma separated, e.g. alipay,wxpay
+  epay_currency: string
+  epay_rate_multiplier: number
 }
 
 export interface UpdateSettingsRequest {
@@ -121,6 +138,20 @@ export interface UpdateSettingsRequest {
   creem_product_id?: string
   creem_rate_multiplier?: number
   creem_success_url?: string
+  // Stripe Payment Integration
+  stripe_enabled?: boolean
+  stripe_secret_key?: string
+  stripe_webhook_secret?: string
+  stripe_currency?: string
+  stripe_rate_multiplier?: number
+  // EPay Payment Integration
+  epay_enabled?: boolean
+  epay_gateway_url?: string
+  epay_merchant_id?: string
+  epay_merchant_key?: string
+  epay_pay_types?: string
+  epay_currency?: string
+  epay_rate_multiplier?: number
 }
 
 /**
@@ -190,41 +221,6 @@ export async function sendTestEmail(request: SendTestEmailRequest): Promise<{ me
   return data
 }
 
-/**
- * Admin API Key status response
- */
-export interface AdminApiKeyStatus {
-  exists: boolean
-  masked_key: string
-}
-
-/**
- * Get admin API key status
- * @returns Status indicating if key exists and masked version
- */
-export async function getAdminApiKey(): Promise<AdminApiKeyStatus> {
-  const { data } = await apiClient.get<AdminApiKeyStatus>('/admin/settings/admin-api-key')
-  return data
-}
-
-/**
- * Regenerate admin API key
- * @returns The new full API key (only shown once)
- */
-export async function regenerateAdminApiKey(): Promise<{ key: string }> {
-  const { data } = await apiClient.post<{ key: string }>('/admin/settings/admin-api-key/regenerate')
-  return data
-}
-
-/**
- * Delete admin API key
- * @returns Success message
- */
-export async function deleteAdminApiKey(): Promise<{ message: string }> {
-  const { data } = await apiClient.delete<{ message: string }>('/admin/settings/admin-api-key')
-  return data
-}
-
 /**
  * Stream timeout settings interface
  */
@@ -260,16 +256,33 @@ export async function updateStreamTimeoutSettings(
   return data
 }
 
+/**
+ * Get invoice numbering, tax and company settings
+ */
+export async function getInvoiceSettings(): Promise<InvoiceSettings> {
+  const { data } = await apiClient.get<InvoiceSettings>('/admin/settings/invoice')
+  return data
+}
+
+/**
+ * Update invoice settings
+ * @param settings - Invoice settings to update
+ * @returns Updated settings
+ */
+export async function updateInvoiceSettings(settings: InvoiceSettings): Promise<InvoiceSettings> {
+  const { data } = await apiClient.put<InvoiceSettings>('/admin/settings/invoice', settings)
+  return data
+}
+
 export const settingsAPI = {
   getSettings,
   updateSettings,
   testSmtpConnection,
   sendTestEmail,
-  getAdminApiKey,
-  regenerateAdminApiKey,
-  deleteAdminApiKey,
   getStreamTimeoutSettings,
-  updateStreamTimeoutSettings
+  updateStreamTimeoutSettings,
+  getInvoiceSettings,
+  updateInvoiceSettings
 }
 
 export default settingsAPI
@@ -0,0 +1,60 @@
+/**
+ * Admin Subscription Plans API endpoints
+ * Manage purchasable plans bound to subscription groups
+ */
+
+import { apiClient } from '../client'
+import type {
+  SubscriptionPlan,
+  CreateSubscriptionPlanRequest,
+  UpdateSubscriptionPlanRequest,
+  BasePaginationResponse
+} from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: { status?: string }
+): Promise<BasePaginationResponse<SubscriptionPlan>> {
+  const { data } = await apiClient.get<BasePaginationResponse<SubscriptionPlan>>(
+    '/admin/subscription-plans',
+    { params: { page, page_size: pageSize, ...filters } }
+  )
+  return data
+}
+
+export async function getById(id: number): Promise<SubscriptionPlan> {
+  const { data } = await apiClient.get<SubscriptionPlan>(`/admin/subscription-plans/${id}`)
+  return data
+}
+
+export async function create(request: CreateSubscriptionPlanRequest): Promise<SubscriptionPlan> {
+  const { data } = await apiClient.post<SubscriptionPlan>('/admin/subscription-plans', request)
+  return data
+}
+
+/**
+ * Update a plan; changes apply to existing subscriptions on their next renewal
+ */
+export async function update(
+  id: number,
+  request: UpdateSubscriptionPlanRequest
+): Promise<SubscriptionPlan> {
+  const { data } = await apiClient.put<SubscriptionPlan>(`/admin/subscription-plans/${id}`, request)
+  return data
+}
+
+export async function deletePlan(id: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/subscription-plans/${id}`)
+  return data
+}
+
+export const subscriptionPlansAPI = {
+  list,
+  getById,
+  create,
+  update,
+  delete: deletePlan
+}
+
+export default subscriptionPlansAPI
@@ -71,6 +71,32 @@ export interface CreateUsageCleanupTaskRequest {
   timezone?: string
 }
 
+export type UsageExportFormat = 'csv' | 'jsonl'
+
+export interface UsageExportTask {
+  id: number
+  status: string
+  format: UsageExportFormat
+  destination: 'local' | 's3'
+  filters: UsageCleanupFilters
+  created_by?: number | null
+  scheduled_for?: string | null
+  rows_exported: number
+  file_size: number
+  file_name: string
+  object_key?: string | null
+  error_message?: string | null
+  started_at?: string | null
+  finished_at?: string | null
+  created_at: string
+  updated_at: string
+}
+
+export interface CreateUsageExportTaskRequest extends CreateUsageCleanupTaskRequest {
+  format: UsageExportFormat
+  destination?: 'local' | 's3'
+}
+
 export interface AdminUsageQueryParams extends UsageQueryParams {
   user_id?: number
 }
@@ -185,6 +211,74 @@ export async function cancelCleanupTask(taskId: number): Promise<{ id: number; s
   return data
 }
 
+/**
+ * Stream usage logs matching the filters as CSV/JSONL (admin only)
+ * Ranges longer than the server's sync limit must use an export task instead
+ * @returns File data as blob
+ */
+export async function exportFile(
+  params: AdminUsageQueryParams & { format: UsageExportFormat; timezone?: string },
+  options?: { signal?: AbortSignal }
+): Promise<Blob> {
+  const response = await apiClient.get('/admin/usage/export', {
+    params,
+    responseType: 'blob',
+    signal: options?.signal
+  })
+  return response.data
+}
+
+/**
+ * List background usage export tasks (admin only)
+ */
+export async function listExportTasks(
+  params: { page?: number; page_size?: number },
+  options?: { signal?: AbortSignal }
+): Promise<PaginatedResponse<UsageExportTask>> {
+  const { data } = await apiClient.get<PaginatedResponse<UsageExportTask>>('/admin/usage/export-tasks', {
+    params,
+    signal: options?.signal
+  })
+  return data
+}
+
+/**
+ * Create a background usage export task (admin only)
+ */
+export async function createExportTask(payload: CreateUsageExportTaskRequest): Promise<UsageExportTask> {
+  const { data } = await apiClient.post<UsageExportTask>('/admin/usage/export-tasks', payload)
+  return data
+}
+
+/**
+ * Cancel a pending or running export task (admin only)
+ */
+export async function cancelExportTask(taskId: number): Promise<{ id: number; status: string }> {
+  const { data } = await apiClient.post<{ id: number; status: string }>(
+    `/admin/usage/export-tasks/${taskId}/cancel`
+  )
+  return data
+}
+
+/**
+ * Delete a finished export task and its file (admin only)
+ */
+export async function deleteExportTask(taskId: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/admin/usage/export-tasks/${taskId}`)
+  return data
+}
+
+/**
+ * Download the file of a succeeded export task (admin only)
+ * @returns File data as blob
+ */
+export async function downloadExportTask(taskId: number): Promise<Blob> {
+  const response = await apiClient.get(`/admin/usage/export-tasks/${taskId}/download`, {
+    responseType: 'blob'
+  })
+  return response.data
+}
+
 export const adminUsageAPI = {
   list,
   getStats,
@@ -192,7 +286,13 @@ export const adminUsageAPI = {
   searchApiKeys,
   listCleanupTasks,
   createCleanupTask,
-  cancelCleanupTask
+  cancelCleanupTask,
+  exportFile,
+  listExportTasks,
+  createExportTask,
+  cancelExportTask,
+  deleteExportTask,
+  downloadExportTask
 }
 
 export default adminUsageAPI
@@ -4,7 +4,15 @@
  */
 
 import { apiClient } from '../client'
-import type { AdminUser, UpdateUserRequest, PaginatedResponse } from '@/types'
+import type {
+  AdminUser,
+  UpdateUserRequest,
+  PaginatedResponse,
+  BalanceTransaction,
+  BalanceTransactionFilters,
+  BasePaginationResponse,
+  UserRole
+} from '@/types'
 
 /**
  * List all users with pagination
@@ -19,7 +27,7 @@ export async function list(
   pageSize: number = 20,
   filters?: {
     status?: 'active' | 'disabled'
-    role?: 'admin' | 'user'
+    role?: UserRole
     search?: string
     attributes?: Record<number, string>  // attributeId -> value
   },
@@ -72,6 +80,9 @@ export async function create(userData: {
   balance?: number
   concurrency?: number
   allowed_groups?: number[] | null
+  rpm_limit?: number
+  input_tpm_limit?: number
+  output_tpm_limit?: number
 }): Promise<AdminUser> {
   const { data } = await apiClient.post<AdminUser>('/admin/users', userData)
   return data
@@ -174,6 +185,36 @@ export async function getUserUsageStats(
   return data
 }
 
+/**
+ * List a user's balance transactions
+ * @param id - User ID
+ */
+export async function listTransactions(
+  id: number,
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: BalanceTransactionFilters
+): Promise<BasePaginationResponse<BalanceTransaction>> {
+  const { data } = await apiClient.get<BasePaginationResponse<BalanceTransaction>>(
+    `/admin/users/${id}/transactions`,
+    { params: { page, page_size: pageSize, ...filters } }
+  )
+  return data
+}
+
+/**
+ * Export a user's balance statement to CSV
+ * @param id - User ID
+ * @returns CSV data as blob
+ */
+export async function exportStatement(id: number, filters?: BalanceTransactionFilters): Promise<Blob> {
+  const response = await apiClient.get(`/admin/users/${id}/transactions/export`, {
+    params: filters,
+    responseType: 'blob'
+  })
+  return response.data
+}
+
 export const usersAPI = {
   list,
   getById,
@@ -184,7 +225,9 @@ export const usersAPI = {
   updateConcurrency,
   toggleStatus,
   getUserApiKeys,
-  getUserUsageStats
+  getUserUsageStats,
+  listTransactions,
+  exportStatement
 }
 
 export default usersAPI
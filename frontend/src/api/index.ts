@@ -16,6 +16,9 @@ export { userAPI } from './user'
 export { redeemAPI, type RedeemHistoryItem } from './redeem'
 export { userGroupsAPI } from './groups'
 export { totpAPI } from './totp'
+export { organizationAPI } from './organization'
+export { invoicesAPI } from './invoices'
+export { paymentAPI } from './payment'
 
 // Admin APIs
 export { adminAPI } from './admin'
@@ -0,0 +1,41 @@
+/**
+ * User Invoices API
+ * Monthly invoices addressed to the current user (organization invoices go to the owner)
+ */
+
+import { apiClient } from './client'
+import type { Invoice, BasePaginationResponse } from '@/types'
+
+export async function list(
+  page: number = 1,
+  pageSize: number = 20
+): Promise<BasePaginationResponse<Invoice>> {
+  const { data } = await apiClient.get<BasePaginationResponse<Invoice>>('/invoices', {
+    params: { page, page_size: pageSize }
+  })
+  return data
+}
+
+export async function getById(id: number): Promise<Invoice> {
+  const { data } = await apiClient.get<Invoice>(`/invoices/${id}`)
+  return data
+}
+
+/**
+ * Download an invoice rendered as PDF or HTML
+ * @returns File data as blob
+ */
+export async function download(id: number, format: 'pdf' | 'html'): Promise<Blob> {
+  const response = await apiClient.get(`/invoices/${id}/${format}`, {
+    responseType: 'blob'
+  })
+  return response.data
+}
+
+export const invoicesAPI = {
+  list,
+  getById,
+  download
+}
+
+export default invoicesAPI
@@ -4,7 +4,16 @@
  */
 
 import { apiClient } from './client'
-import type { ApiKey, CreateApiKeyRequest, UpdateApiKeyRequest, PaginatedResponse } from '@/types'
+import type {
+  ApiKey,
+  ApiKeyLimits,
+  ApiKeyModelRules,
+  CreateApiKeyRequest,
+  UpdateApiKeyRequest,
+  RequestRateLimits,
+  ResponseCacheSettings,
+  PaginatedResponse
+} from '@/types'
 
 /**
  * List all API keys for current user
@@ -44,6 +53,7 @@ export async function getById(id: number): Promise<ApiKey> {
  * @param customKey - Optional custom key value
  * @param ipWhitelist - Optional IP whitelist
  * @param ipBlacklist - Optional IP blacklist
+ * @param options - Optional spending caps, expiry, model rules, RPM/TPM limits and response cache
  * @returns Created API key
  */
 export async function create(
@@ -51,9 +61,13 @@ export async function create(
   groupId?: number | null,
   customKey?: string,
   ipWhitelist?: string[],
-  ipBlacklist?: string[]
+  ipBlacklist?: string[],
+  options?: ApiKeyLimits &
+    ApiKeyModelRules &
+    Partial<RequestRateLimits> &
+    Partial<ResponseCacheSettings>
 ): Promise<ApiKey> {
-  const payload: CreateApiKeyRequest = { name }
+  const payload: CreateApiKeyRequest = { name, ...options }
   if (groupId !== undefined) {
     payload.group_id = groupId
   }
@@ -0,0 +1,69 @@
+/**
+ * User Organization API
+ * Self-service endpoints for organization owners and admins
+ */
+
+import { apiClient } from './client'
+import type {
+  MyOrganization,
+  OrganizationMember,
+  OrganizationMemberRequest,
+  OrganizationUsageBreakdown
+} from '@/types'
+
+/**
+ * Get the organization the current user belongs to
+ */
+export async function getMine(): Promise<MyOrganization> {
+  const { data } = await apiClient.get<MyOrganization>('/organization')
+  return data
+}
+
+export async function listMembers(): Promise<OrganizationMember[]> {
+  const { data } = await apiClient.get<OrganizationMember[]>('/organization/members')
+  return data
+}
+
+/**
+ * Add an existing user to the organization by email
+ */
+export async function addMember(request: OrganizationMemberRequest): Promise<OrganizationMember> {
+  const { data } = await apiClient.post<OrganizationMember>('/organization/members', request)
+  return data
+}
+
+export async function updateMember(
+  userId: number,
+  request: OrganizationMemberRequest
+): Promise<OrganizationMember> {
+  const { data } = await apiClient.put<OrganizationMember>(`/organization/members/${userId}`, request)
+  return data
+}
+
+export async function removeMember(userId: number): Promise<{ message: string }> {
+  const { data } = await apiClient.delete<{ message: string }>(`/organization/members/${userId}`)
+  return data
+}
+
+/**
+ * Per-member usage breakdown (defaults to the current month)
+ */
+export async function getUsage(params?: {
+  start_date?: string
+  end_date?: string
+  timezone?: string
+}): Promise<OrganizationUsageBreakdown> {
+  const { data } = await apiClient.get<OrganizationUsageBreakdown>('/organization/usage', { params })
+  return data
+}
+
+export const organizationAPI = {
+  getMine,
+  listMembers,
+  addMember,
+  updateMember,
+  removeMember,
+  getUsage
+}
+
+export default organizationAPI
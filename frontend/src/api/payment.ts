@@ -0,0 +1,57 @@
+/**
+ * Payment API
+ * Online top-up through the enabled payment providers
+ */
+
+import { apiClient } from './client'
+import type {
+  PaymentProvider,
+  PaymentOrder,
+  CreatePaymentCheckoutRequest,
+  PaymentCheckoutResponse,
+  BasePaginationResponse
+} from '@/types'
+
+/**
+ * Get enabled payment providers (public)
+ */
+export async function getProviders(): Promise<PaymentProvider[]> {
+  const { data } = await apiClient.get<PaymentProvider[]>('/payment/providers')
+  return data
+}
+
+/**
+ * Create a pending top-up order and its provider checkout
+ * @returns Order number and the URL to redirect the user to
+ */
+export async function createCheckout(
+  request: CreatePaymentCheckoutRequest
+): Promise<PaymentCheckoutResponse> {
+  const { data } = await apiClient.post<PaymentCheckoutResponse>('/payment/checkout', request)
+  return data
+}
+
+export async function listOrders(
+  page: number = 1,
+  pageSize: number = 20,
+  status?: string
+): Promise<BasePaginationResponse<PaymentOrder>> {
+  const { data } = await apiClient.get<BasePaginationResponse<PaymentOrder>>('/payment/orders', {
+    params: { page, page_size: pageSize, status: status || undefined }
+  })
+  return data
+}
+
+export async function getOrder(orderNo: string): Promise<PaymentOrder> {
+  const { data } = await apiClient.get<PaymentOrder>(`/payment/orders/${encodeURIComponent(orderNo)}`)
+  return data
+}
+
+export const paymentAPI = {
+  getProviders,
+  createCheckout,
+  listOrders,
+  getOrder
+}
+
+export default paymentAPI
@@ -4,7 +4,13 @@
  */
 
 import { apiClient } from './client'
-import type { UserSubscription, SubscriptionProgress } from '@/types'
+import type {
+  UserSubscription,
+  SubscriptionProgress,
+  SubscriptionPlan,
+  CreatePlanCheckoutRequest,
+  PaymentCheckoutResponse
+} from '@/types'
 
 /**
  * Subscription summary for user dashboard
@@ -67,10 +73,50 @@ export async function getSubscriptionProgress(
   return response.data
 }
 
+/**
+ * Get purchasable subscription plans
+ */
+export async function getPlans(): Promise<SubscriptionPlan[]> {
+  const response = await apiClient.get<SubscriptionPlan[]>('/subscription-plans')
+  return response.data
+}
+
+/**
+ * Create a pending plan order and its provider checkout
+ * @returns Order number and the URL to redirect the user to
+ */
+export async function createPlanCheckout(
+  planId: number,
+  request: CreatePlanCheckoutRequest
+): Promise<PaymentCheckoutResponse> {
+  const response = await apiClient.post<PaymentCheckoutResponse>(
+    `/subscription-plans/${planId}/checkout`,
+    request
+  )
+  return response.data
+}
+
+/**
+ * Enable/disable auto-renewal (plan price is debited from balance before expiry)
+ */
+export async function setAutoRenew(
+  subscriptionId: number,
+  autoRenew: boolean
+): Promise<UserSubscription> {
+  const response = await apiClient.put<UserSubscription>(
+    `/subscriptions/${subscriptionId}/auto-renew`,
+    { auto_renew: autoRenew }
+  )
+  return response.data
+}
+
 export default {
   getMySubscriptions,
   getActiveSubscriptions,
   getSubscriptionsProgress,
   getSubscriptionSummary,
-  getSubscriptionProgress
+  getSubscriptionProgress,
+  getPlans,
+  createPlanCheckout,
+  setAutoRenew
 }
@@ -101,6 +101,21 @@ export async function query(
   return data
 }
 
+/**
+ * Export the current user's usage logs matching the filters (streamed by the server)
+ * @param params - Same filters as query(), plus output format
+ * @returns File data as blob
+ */
+export async function exportFile(
+  params: Omit<UsageQueryParams, 'page' | 'page_size'> & { format?: 'csv' | 'jsonl' }
+): Promise<Blob> {
+  const response = await apiClient.get('/usage/export', {
+    params,
+    responseType: 'blob'
+  })
+  return response.data
+}
+
 /**
  * Get usage statistics for a specific period
  * @param period - Time period ('today', 'week', 'month', 'year')
@@ -223,6 +238,30 @@ export async function getDashboardModels(params?: {
   return data
 }
 
+export interface CacheSavingsResponse {
+  cache_read_tokens: number
+  cache_hit_requests: number
+  savings: number // 标准计费节省
+  actual_savings: number // 实际节省
+  start_date: string
+  end_date: string
+}
+
+/**
+ * Get prompt cache savings for current user
+ * @param params - Query parameters for filtering
+ * @returns Cost saved by prompt cache reads in the date range
+ */
+export async function getDashboardCacheSavings(params?: {
+  start_date?: string
+  end_date?: string
+}): Promise<CacheSavingsResponse> {
+  const { data } = await apiClient.get<CacheSavingsResponse>('/usage/dashboard/cache-savings', {
+    params
+  })
+  return data
+}
+
 export interface BatchApiKeyUsageStats {
   api_key_id: number
   today_actual_cost: number
@@ -264,10 +303,12 @@ export const usageAPI = {
   getStatsByDateRange,
   getByDateRange,
   getById,
+  exportFile,
   // Dashboard
   getDashboardStats,
   getDashboardTrend,
   getDashboardModels,
+  getDashboardCacheSavings,
   getDashboardApiKeysUsage
 }
 
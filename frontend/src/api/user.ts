@@ -4,7 +4,13 @@
  */
 
 import { apiClient } from './client'
-import type { User, ChangePasswordRequest } from '@/types'
+import type {
+  User,
+  ChangePasswordRequest,
+  BalanceTransaction,
+  BalanceTransactionFilters,
+  BasePaginationResponse
+} from '@/types'
 
 /**
  * Get current user profile
@@ -45,10 +51,26 @@ export async function changePassword(
   return data
 }
 
+/**
+ * List current user's balance transactions (newest first)
+ */
+export async function listTransactions(
+  page: number = 1,
+  pageSize: number = 20,
+  filters?: BalanceTransactionFilters
+): Promise<BasePaginationResponse<BalanceTransaction>> {
+  const { data } = await apiClient.get<BasePaginationResponse<BalanceTransaction>>(
+    '/user/transactions',
+    { params: { page, page_size: pageSize, ...filters } }
+  )
+  return data
+}
+
 export const userAPI = {
   getProfile,
   updateProfile,
-  changePassword
+  changePassword,
+  listTransactions
 }
 
 export default userAPI
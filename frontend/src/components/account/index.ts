@@ -11,3 +11,4 @@ export { default as AccountTestModal } from './AccountTestModal.vue'
 export { default as AccountTodayStatsCell } from './AccountTodayStatsCell.vue'
 export { default as TempUnschedStatusModal } from './TempUnschedStatusModal.vue'
 export { default as SyncFromCrsModal } from './SyncFromCrsModal.vue'
+export { default as AccountBundleModal } from './AccountBundleModal.vue'
@@ -167,6 +167,20 @@ export default {
     now: 'Now',
     unknown: 'Unknown',
     minutes: 'min',
+    rateLimits: {
+      title: 'Rate Limits',
+      rpm: 'Requests / min',
+      inputTpm: 'Input tokens / min',
+      outputTpm: 'Output tokens / min',
+      unlimited: 'Unlimited',
+      hint: 'Sliding one-minute window. Leave empty or 0 for no limit.'
+    },
+    responseCache: {
+      title: 'Response Cache',
+      ttlSeconds: 'TTL (seconds)',
+      ttlDefault: 'Default',
+      hint: 'Replays identical deterministic requests (temperature = 0) from cache. Leave TTL empty to use the default.'
+    },
     time: {
       never: 'Never',
       justNow: 'Just now',
@@ -192,11 +206,17 @@ export default {
     users: 'Users',
     groups: 'Groups',
     subscriptions: 'Subscriptions',
+    subscriptionPlans: 'Subscription Plans',
+    modelPrices: 'Model Prices',
     accounts: 'Accounts',
     proxies: 'Proxies',
+    proxyPools: 'Proxy Pools',
     redeemCodes: 'Redeem Codes',
     ops: 'Ops',
     promoCodes: 'Promo Codes',
+    auditLogs: 'Audit Logs',
+    organizations: 'Organizations',
+    invoices: 'Invoices',
     settings: 'Settings',
     myAccount: 'My Account',
     lightMode: 'Light Mode',
@@ -206,6 +226,10 @@ export default {
     logout: 'Logout',
     github: 'GitHub',
     mySubscriptions: 'My Subscriptions',
+    myOrganization: 'My Organization',
+    balanceHistory: 'Balance History',
+    myInvoices: 'My Invoices',
+    paymentOrders: 'Top-up Orders',
     docs: 'Docs'
   },
 
@@ -326,6 +350,10 @@ export default {
     hour: 'Hour',
     modelDistribution: 'Model Distribution',
     tokenUsageTrend: 'Token Usage Trend',
+    cacheSavings: {
+      title: 'Prompt Cache Savings',
+      hint: '{requests} cache-hit requests, {tokens} tokens read from cache'
+    },
     noDataAvailable: 'No data available',
     model: 'Model',
     requests: 'Requests',
@@ -450,6 +478,24 @@ export default {
     ipBlacklistPlaceholder: '1.2.3.4\n5.6.0.0/16',
     ipBlacklistHint: 'One IP or CIDR per line. These IPs will be blocked from using this key.',
     ipRestrictionEnabled: 'IP restriction enabled',
+    spendingLimits: 'Limits & Expiry',
+    quotaUsd: 'Total Cap (USD)',
+    dailyLimitUsd: 'Daily Cap (USD)',
+    monthlyLimitUsd: 'Monthly Cap (USD)',
+    limitPlaceholder: 'Unlimited',
+    expiresAt: 'Expires At',
+    spendingLimitsHint: 'Leave empty or 0 for no limit. Caps are based on the actual cost charged to this key.',
+    keyExpired: 'Expired',
+    expiresOn: 'Expires {date}',
+    month: 'Month',
+    modelRestriction: 'Model Restriction',
+    modelRestrictionEnabled: 'Model restriction enabled',
+    allowedModels: 'Allowed Models',
+    allowedModelsPlaceholder: 'claude-*-haiku*\ngpt-5*',
+    allowedModelsHint: 'One model per line, * matches any characters. Leave empty to allow all models.',
+    modelAliases: 'Model Aliases',
+    modelAliasesPlaceholder: 'fast=claude-haiku-4-5\nsmart=claude-sonnet-4-5',
+    modelAliasesHint: 'One alias=model per line. Clients can request the alias name instead of the real model.',
     ccSwitchNotInstalled: 'CC-Switch is not installed or the protocol handler is not registered. Please install CC-Switch first or manually copy the API key.',
     ccsClientSelect: {
       title: 'Select Client',
@@ -562,16 +608,21 @@ export default {
     onlineRechargeDesc: 'Instantly top up your balance with credit or debit card',
     rechargeNow: 'Recharge Now',
     rechargeFeature1: 'Supports Visa, MasterCard, UnionPay and more',
-    rechargeFeature2: 'Pay $1 and get $10 balance',
+    rechargeFeature2: 'Pay {pay} and get {credit} balance',
     rechargeFeature3: 'Instant credit, secure payment',
     selectAmount: 'Select Amount',
-    customAmount: 'Custom amount (1-100)',
+    customAmount: 'Custom amount ({min}-{max})',
     youWillGet: 'You will get',
     payAmount: 'Pay amount',
     proceedToPayment: 'Proceed to Payment',
     processingPayment: 'Processing...',
-    securePaymentByCreem: 'Secure payment by Creem',
-    paymentFailed: 'Failed to create payment, please try again'
+    securePaymentBy: 'Secure payment by {provider}',
+    paymentFailed: 'Failed to create payment, please try again',
+    paymentCancelled: 'Payment was cancelled, no charge was made',
+    paymentProvider: 'Payment Provider',
+    paymentMethod: 'Payment Method',
+    noPaymentProviders: 'Online recharge is not available at the moment',
+    viewPaymentOrders: 'Top-up orders'
   },
 
   // Profile
@@ -729,6 +780,22 @@ export default {
       modelDistribution: 'Model Distribution',
       tokenUsageTrend: 'Token Usage Trend',
       userUsageTrend: 'User Usage Trend (Top 12)',
+      promptCache: {
+        title: 'Prompt Cache',
+        tabs: {
+          accounts: 'By Account',
+          groups: 'By Group',
+          users: 'Savings by User'
+        },
+        account: 'Account',
+        group: 'Group',
+        user: 'User',
+        ungrouped: 'Ungrouped',
+        hitRequests: 'Hit Requests',
+        cacheCreation: 'Cache Write',
+        cacheRead: 'Cache Read',
+        hitRate: 'Hit Rate'
+      },
       model: 'Model',
       requests: 'Requests',
       tokens: 'Tokens',
@@ -826,6 +893,10 @@ export default {
       failedToUpdateAllowedGroups: 'Failed to update allowed groups',
       deposit: 'Deposit',
       withdraw: 'Withdraw',
+      balanceHistory: 'Balance History',
+      exportStatement: 'Export Statement',
+      exportingStatement: 'Exporting...',
+      failedToExportStatement: 'Failed to export balance statement',
       depositAmount: 'Deposit Amount',
       withdrawAmount: 'Withdraw Amount',
       withdrawAll: 'All',
@@ -847,9 +918,13 @@ export default {
       failedToWithdraw: 'Failed to withdraw',
       useDepositWithdrawButtons: 'Please use deposit/withdraw buttons to adjust balance',
       roles: {
-        admin: 'Admin',
+        admin: 'Super Admin',
+        support: 'Support',
+        finance: 'Finance',
+        ops: 'Ops',
         user: 'User'
       },
+      roleHint: 'Staff roles get access to the admin console limited to their permissions',
       // Settings Dropdowns
       filterSettings: 'Filter Settings',
       columnSettings: 'Column Settings',
@@ -976,6 +1051,10 @@ export default {
       failedToUpdate: 'Failed to update group',
       failedToDelete: 'Failed to delete group',
       nameRequired: 'Please enter group name',
+      rateLimitsHint: 'Shared by all API keys in this group (sliding one-minute window). Leave empty or 0 for no limit.',
+      responseCacheHint: 'Cache entries are shared by all API keys in this group. Keys with their own cache setting use a private cache.',
+      responseCacheCostRatio: 'Hit cost ratio',
+      responseCacheCostRatioHint: 'Fraction of the normal cost billed on a cache hit (0 = free, 1 = full price).',
       platforms: {
         all: 'All Platforms',
         anthropic: 'Anthropic',
@@ -1141,6 +1220,70 @@ export default {
       syncCompletedWithErrors:
         'Sync completed with errors: failed {failed} (created {created}, updated {updated})',
       syncFailed: 'Sync failed',
+      bundle: {
+        button: 'Export / Import',
+        title: 'Export / Import Accounts',
+        tabs: {
+          export: 'Export',
+          import: 'Import File',
+          instance: 'Sync from Instance'
+        },
+        exportDesc:
+          'Export accounts together with their groups, model routing and proxies into a password-encrypted file. Credentials are included, keep the file and password safe.',
+        importDesc:
+          'Import a bundle exported from another instance. Use Preview to see what would change before applying it.',
+        instanceDesc:
+          'Pull accounts directly from another sub2api instance using its admin API key. The bundle password is only used to encrypt this transfer.',
+        onlySelected: 'Only export the {count} selected accounts (otherwise all accounts)',
+        file: 'Bundle File',
+        fileInfo: 'Exported by {source} at {time}',
+        invalidFile: 'Not a valid account bundle file',
+        baseUrl: 'Instance URL',
+        adminApiKey: 'Admin API Key',
+        password: 'Bundle Password',
+        passwordHint: 'At least 8 characters',
+        confirmPassword: 'Confirm Password',
+        conflictPolicy: 'On Conflict',
+        policies: {
+          skip: 'Skip existing',
+          overwrite: 'Overwrite existing',
+          rename: 'Import as new (rename)'
+        },
+        policyHint: {
+          skip: 'Accounts and groups that already exist are left untouched.',
+          overwrite:
+            'Existing accounts and groups are updated; account status and proxy pool assignments are kept.',
+          rename: 'Conflicting entries are created again with an "(imported)" suffix.'
+        },
+        preview: 'Preview',
+        previewTitle: 'Preview (nothing has been written)',
+        resultTitle: 'Import Result',
+        summary:
+          'created {created}, updated {updated}, skipped {skipped}, renamed {renamed}, reused {reused}, failed {failed}',
+        kinds: {
+          accounts: 'Accounts',
+          groups: 'Groups',
+          proxies: 'Proxies'
+        },
+        actions: {
+          create: 'create',
+          update: 'update',
+          skip: 'skip',
+          rename: 'create renamed',
+          reuse: 'reuse',
+          failed: 'failed'
+        },
+        submit: {
+          export: 'Export & Download',
+          import: 'Import',
+          instance: 'Sync Now'
+        },
+        processing: 'Processing...',
+        exported: 'Bundle exported',
+        completed: 'Import completed',
+        completedWithErrors: 'Import completed with {failed} failures',
+        failed: 'Operation failed'
+      },
       editAccount: 'Edit Account',
       deleteAccount: 'Delete Account',
       searchAccounts: 'Search accounts...',
@@ -1405,9 +1548,32 @@ export default {
           hint: 'When enabled, fixes the session ID in metadata.user_id for 15 minutes, making upstream think requests come from the same session'
         }
       },
+      // Per-account budgets (all platforms)
+      budget: {
+        title: 'Budgets',
+        hint: 'Limit account cost (at the account billing rate) or tokens per day, week (from Monday) or calendar month. Leave empty for no limit',
+        periods: {
+          daily: 'Daily',
+          weekly: 'Weekly',
+          monthly: 'Monthly'
+        },
+        short: {
+          daily: 'Day',
+          weekly: 'Week',
+          monthly: 'Month'
+        },
+        costPlaceholder: 'Cost limit',
+        tokensPlaceholder: 'Token limit',
+        pauseHint: 'When a budget is used up the account stops being scheduled and resumes automatically when that period resets',
+        exhausted: 'Budget used up, scheduling paused until {time}',
+        normal: 'Resets at {time}'
+      },
       expired: 'Expired',
       proxy: 'Proxy',
       noProxy: 'No Proxy',
+      proxyPool: 'Proxy Pool',
+      noProxyPool: 'No pool (use a single proxy)',
+      proxyPoolHint: 'With a pool, the proxy is assigned by the pool policy and switched automatically when it becomes unhealthy',
       concurrency: 'Concurrency',
       priority: 'Priority',
       priorityHint: 'Lower value accounts are used first',
@@ -1768,6 +1934,30 @@ export default {
         usageTrend: '30-Day Cost & Request Trend',
         noData: 'No usage data available for this account'
       },
+      health: {
+        title: 'Scheduling Health',
+        score: 'Health score',
+        weight: 'Scheduling weight',
+        window: 'Last {minutes} minutes',
+        noData: 'No recent traffic; the account is scheduled at full weight',
+        notApplied: 'Not enough data',
+        modeLoadAware:
+          'Health-weighted scheduling is off (selection_mode: load_aware); this score is informational only',
+        modeHealthWeighted:
+          'Within the same priority, accounts are picked at random in proportion to their weight, so degraded accounts receive less traffic',
+        factors: {
+          success_rate: 'Success rate',
+          latency: 'First-token latency',
+          throttle: '429/529 frequency',
+          window_cost: 'Remaining window cost'
+        },
+        explain: {
+          success_rate: '{success} succeeded, {errors} upstream errors',
+          latency: 'p50 {p50} · p95 {p95}',
+          throttle: '{count429} × 429, {count529} × 529',
+          window_cost: '${cost} of ${limit} used in the current 5h window'
+        }
+      },
       usageWindow: {
         statsTitle: '5-Hour Window Usage Statistics',
         statsTitleDaily: 'Daily Usage Statistics',
@@ -1816,12 +2006,20 @@ export default {
         status: 'Status',
         accounts: 'Accounts',
         latency: 'Latency',
+        health: 'Health',
         actions: 'Actions'
       },
       testConnection: 'Test Connection',
       batchTest: 'Test All Proxies',
       testFailed: 'Failed',
       latencyFailed: 'Connection failed',
+      health: {
+        unknown: 'Unknown',
+        healthy: 'Healthy',
+        unhealthy: 'Unhealthy',
+        errorRate: 'Error rate {rate}%',
+        forwardErrors: '{count} forward errors'
+      },
       batchTestEmpty: 'No proxies available for testing',
       batchTestDone: 'Batch test completed for {count} proxies',
       batchTestFailed: 'Batch test failed',
@@ -1870,6 +2068,52 @@ export default {
       batchImportSuccess: 'Successfully imported {created} proxies, skipped {skipped} duplicates',
       batchImportAllSkipped: 'All {skipped} proxies already exist, skipped import',
       failedToImport: 'Failed to batch import',
+      importFromSubscription: 'Import / Subscriptions',
+      importer: {
+        title: 'Import Proxies',
+        tabPaste: 'Paste List',
+        tabUrl: 'From URL',
+        tabSubscriptions: 'Subscriptions',
+        content: 'Proxy list',
+        contentPlaceholder:
+          "Paste a Clash YAML config, a base64 V2Ray subscription, or one proxy per line:\nsocks5://user:pass{'@'}1.2.3.4:1080#tokyo\n1.2.3.4:8080:user:pass",
+        url: 'Subscription URL',
+        formatHint:
+          'Supports Clash YAML, base64 V2Ray subscriptions and http / https / socks5 share links. vmess, trojan, ss and other protocols are reported as skipped.',
+        namePrefix: 'Name prefix (optional)',
+        pool: 'Add to proxy pool',
+        noPool: 'Do not add to a pool',
+        saveAsSubscription: 'Save as subscription and keep it in sync',
+        subscriptionName: 'Subscription name',
+        refreshInterval: 'Refresh interval (minutes)',
+        refreshIntervalHint: '0 = sync manually only; minimum 5 minutes',
+        import: 'Import',
+        resultTotal: '{count} parsed',
+        resultCreated: '{count} created',
+        resultDuplicated: '{count} already existed',
+        resultReactivated: '{count} re-enabled',
+        resultDisabled: '{count} disabled (removed from subscription)',
+        resultSkipped: '{count} skipped',
+        importSuccess: 'Imported {created} proxies ({duplicated} already existed); exit info is being probed in the background',
+        nothingNew: 'No new proxies imported ({duplicated} already existed)',
+        noSubscriptions: 'No subscriptions yet. Import from a URL and save it as a subscription.',
+        proxyCount: '{count} proxies',
+        everyMinutes: 'every {minutes} min',
+        manualOnly: 'manual sync',
+        lastSynced: 'synced {time}',
+        neverSynced: 'never synced',
+        lastResult: 'Last sync: {total} parsed, {created} created, {skipped} skipped, {disabled} disabled',
+        syncNow: 'Sync now',
+        pause: 'Pause auto sync',
+        resume: 'Resume auto sync',
+        syncSuccess: 'Sync finished: {created} created, {disabled} disabled',
+        deleteConfirm: 'Delete subscription "{name}"? Imported proxies are kept.',
+        deleted: 'Subscription deleted',
+        failedToLoadSubscriptions: 'Failed to load subscriptions',
+        failedToSync: 'Failed to sync subscription',
+        failedToSave: 'Failed to update subscription',
+        failedToDelete: 'Failed to delete subscription'
+      },
       // Other messages
       creating: 'Creating...',
       updating: 'Updating...',
@@ -1965,6 +2209,370 @@ export default {
       }
     },
 
+    // Audit Logs
+    auditLogs: {
+      title: 'Audit Logs',
+      description: 'Append-only record of every change made through the admin console and admin API key',
+      searchAction: 'Search action...',
+      actorUserId: 'Actor user ID',
+      allActorTypes: 'All actors',
+      allTargetTypes: 'All targets',
+      actorTypes: {
+        jwt: 'Console login',
+        admin_api_key: 'Admin API key'
+      },
+      columns: {
+        time: 'Time',
+        actor: 'Actor',
+        action: 'Action',
+        target: 'Target',
+        status: 'Status',
+        ip: 'IP',
+        actions: 'Actions'
+      },
+      viewChanges: 'View changes',
+      changesTitle: 'Change Details',
+      before: 'Before',
+      after: 'After',
+      exportCsv: 'Export CSV',
+      exporting: 'Exporting...',
+      failedToLoad: 'Failed to load audit logs',
+      failedToExport: 'Failed to export audit logs'
+    },
+
+    // Invoices
+    invoices: {
+      title: 'Invoices',
+      description: 'Issue, email and manage monthly invoices for users and organizations',
+      generate: 'Generate Invoices',
+      generating: 'Generating...',
+      generated: 'Invoice issued',
+      generationStarted: 'Invoice generation started in the background',
+      failedToGenerate: 'Failed to generate invoices',
+      regenerate: 'Regenerate',
+      regenerated: 'Invoice regenerated',
+      failedToRegenerate: 'Failed to regenerate invoice',
+      void: 'Void',
+      voided: 'Invoice voided',
+      voidConfirm: 'Void invoice {number}? A new invoice with a new number can then be issued for the same period.',
+      failedToVoid: 'Failed to void invoice',
+      sendEmail: 'Send Email',
+      emailQueued: 'Invoice email queued to {email}',
+      emailFailed: 'Send failed',
+      failedToSend: 'Failed to send invoice email',
+      empty: 'No invoices',
+      emptyDesc: 'Generate invoices for a closed month or enable automatic generation',
+      filterPeriod: 'Billing period',
+      allSubjects: 'All Subjects',
+      allStatuses: 'All Statuses',
+      allBillableSubjects: 'All users and organizations with activity',
+      allSubjectsHint: 'Runs in the background; subjects that already have an invoice are skipped',
+      period: 'Billing Period',
+      periodHint: 'Only months that have already ended can be invoiced',
+      subjectType: 'Subject',
+      subjectId: 'User / Organization ID',
+      sendEmailAfter: 'Email invoices to recipients',
+      subjectTypes: {
+        user: 'User',
+        organization: 'Organization'
+      },
+      statuses: {
+        issued: 'Issued',
+        void: 'Void'
+      },
+      columns: {
+        status: 'Status',
+        emailedAt: 'Emailed'
+      },
+      settings: 'Invoice Settings',
+      failedToLoadSettings: 'Failed to load invoice settings',
+      failedToSaveSettings: 'Failed to save invoice settings',
+      settingsSaved: 'Invoice settings saved',
+      autoGenerate: 'Auto generate',
+      autoGenerateHint: 'Issue invoices for the previous month at the start of each month',
+      autoEmail: 'Auto email',
+      autoEmailHint: 'Email automatically generated invoices to recipients',
+      numberPrefix: 'Number prefix',
+      nextNumber: 'Next number',
+      numberPadding: 'Digits',
+      numberPreview: 'Next invoice number: {number}',
+      currency: 'Currency (ISO code)',
+      taxLabel: 'Tax label',
+      taxRate: 'Tax rate (%)',
+      companyName: 'Company name',
+      companyAddress: 'Company address',
+      companyTaxId: 'Tax ID',
+      companyEmail: 'Billing email',
+      footerNote: 'Footer note',
+      brandingHint: 'Site name and logo are taken from the site settings. Changes apply to newly issued or regenerated invoices.'
+    },
+
+    // Top-up orders
+    paymentOrders: {
+      title: 'Top-up Orders',
+      description: 'Reconcile online recharge orders against the payment providers',
+      searchPlaceholder: 'Search order no., transaction ID or email...',
+      allProviders: 'All Providers',
+      allStatuses: 'All Statuses',
+      empty: 'No top-up orders',
+      emptyDesc: 'Orders are created when users start an online recharge',
+      providerTxnId: 'Provider transaction ID',
+      providerTxnIdHint: 'Payment or trade ID shown in the provider dashboard; used to deduplicate later webhooks',
+      notes: 'Notes',
+      paidAt: 'Paid {time}',
+      markPaid: 'Mark as Paid',
+      markPaidHint: 'Credit {amount} to {email} for order {orderNo}. Only do this after confirming the payment in the provider dashboard.',
+      markedPaid: 'Order marked as paid and balance credited',
+      refund: 'Record Refund',
+      refundHint: 'Deduct {amount} from {email} for order {orderNo}. Issue the refund itself in the provider dashboard.',
+      refunded: 'Refund recorded and balance deducted',
+      actionFailed: 'Operation failed',
+      planLabel: 'Plan: {plan}',
+      markPaidPlanHint: 'Activate or renew plan {plan} for {email} (order {orderNo}). Only do this after confirming the payment in the provider dashboard.',
+      refundPlanHint: 'Record a refund for plan order {orderNo} of {email}. The subscription is not revoked; adjust it in Subscriptions if needed.',
+      columns: {
+        user: 'User'
+      }
+    },
+
+    // Proxy pools
+    proxyPools: {
+      title: 'Proxy Pools',
+      description: 'Group proxies into pools; accounts bound to a pool get a proxy by policy and fail over automatically',
+      create: 'Create Pool',
+      edit: 'Edit Pool',
+      delete: 'Delete Pool',
+      deleteConfirm: "Delete proxy pool '{name}'? Pools still referenced by accounts cannot be deleted.",
+      searchPlaceholder: 'Search pools...',
+      allStatus: 'All Status',
+      name: 'Name',
+      descriptionLabel: 'Description',
+      policy: 'Selection Policy',
+      status: 'Status',
+      members: 'Member Proxies',
+      membersHint: 'A proxy belongs to at most one pool; selecting a proxy from another pool moves it here',
+      selectedCount: '{count} selected',
+      noProxies: 'No proxies yet',
+      inOtherPool: 'In another pool',
+      healthyOfTotal: '{healthy} / {total} healthy',
+      policies: {
+        sticky: 'Sticky',
+        round_robin: 'Round Robin',
+        least_latency: 'Least Latency',
+        same_country: 'Same Country'
+      },
+      policyHints: {
+        sticky: 'Each account stays on a stable proxy derived from its ID',
+        round_robin: 'Accounts are spread evenly across members as they are assigned',
+        least_latency: 'Accounts use the member with the lowest probed latency',
+        same_country: 'On failover, prefer a member with the same exit country as the current proxy'
+      },
+      columns: {
+        name: 'Name',
+        policy: 'Policy',
+        members: 'Members',
+        accounts: 'Accounts',
+        status: 'Status',
+        actions: 'Actions'
+      },
+      empty: 'No proxy pools',
+      emptyDesc: 'Create a pool to rotate accounts across several proxies',
+      created: 'Proxy pool created',
+      updated: 'Proxy pool updated',
+      deleted: 'Proxy pool deleted',
+      failedToLoad: 'Failed to load proxy pools',
+      failedToSave: 'Failed to save proxy pool',
+      failedToDelete: 'Failed to delete proxy pool'
+    },
+
+    // Subscription plans
+    subscriptionPlans: {
+      title: 'Subscription Plans',
+      description: 'Plans users can buy online or auto-renew from their balance',
+      create: 'Create Plan',
+      edit: 'Edit Plan',
+      delete: 'Delete Plan',
+      deleteConfirm: 'Delete plan "{name}"? Existing subscriptions stay active but will no longer auto-renew.',
+      allStatus: 'All Status',
+      statusActive: 'On Sale',
+      statusDisabled: 'Disabled',
+      name: 'Name',
+      descriptionLabel: 'Description',
+      group: 'Subscription Group',
+      selectGroup: 'Select a subscription group',
+      groupHint: 'Buying the plan grants or extends a subscription to this group',
+      price: 'Price (USD)',
+      validityDays: 'Validity (days)',
+      days: '{days} days',
+      limits: 'Usage Limits (USD)',
+      limitsHint: 'Daily / weekly / monthly. Leave empty or 0 to use the group limits.',
+      dailyShort: 'Daily',
+      weeklyShort: 'Weekly',
+      monthlyShort: 'Monthly',
+      fromGroup: 'group',
+      status: 'Status',
+      sortOrder: 'Sort Order',
+      empty: 'No subscription plans',
+      emptyDesc: 'Create a plan to let users buy subscriptions online',
+      created: 'Plan created',
+      updated: 'Plan updated',
+      deleted: 'Plan deleted',
+      failedToLoad: 'Failed to load subscription plans',
+      failedToSave: 'Failed to save plan',
+      failedToDelete: 'Failed to delete plan',
+      columns: {
+        name: 'Plan',
+        group: 'Group',
+        price: 'Price',
+        validity: 'Validity',
+        limits: 'Limits',
+        status: 'Status',
+        sortOrder: 'Sort',
+        actions: 'Actions'
+      }
+    },
+
+    // Model Prices
+    modelPrices: {
+      title: 'Model Prices',
+      description: 'Override model prices globally or per group, schedule price changes and control billing of unknown models',
+      create: 'New Price',
+      edit: 'Edit Scheduled Price',
+      newVersion: 'New version from this price',
+      delete: 'Delete Price',
+      deleteConfirm: "Delete the price for '{model}'? Billing falls back to the previous version or the default price list.",
+      preview: 'Price Preview',
+      resolve: 'Resolve',
+      unknownPolicy: 'Unknown Model Policy',
+      unknownPolicyHint: 'Controls how requests are billed when a model has no override and is not in the LiteLLM price list.',
+      policy: 'Policy',
+      policies: {
+        fallback: 'Fallback to model family price',
+        default_price: 'Use a default price',
+        reject: 'Reject the request'
+      },
+      policyHints: {
+        fallback: 'Bill with the built-in price of the closest model family (current behavior).',
+        default_price: 'Bill every unknown model with the prices below.',
+        reject: 'Return 403 for models without a known price so they are never billed at a guessed rate.'
+      },
+      searchModel: 'Search model...',
+      allScopes: 'All Scopes',
+      global: 'Global',
+      groupScope: 'Group',
+      scope: 'Scope',
+      model: 'Model',
+      modelHint: 'Exact model name, or a prefix ending with * (e.g. claude-sonnet-4*). Exact matches win over prefixes; group prices win over global ones.',
+      prices: 'Prices (USD / million tokens)',
+      pricesHint: 'Input, output, cache write and cache read prices per million tokens.',
+      inputShort: 'Input',
+      outputShort: 'Output',
+      cacheWriteShort: 'Cache Write',
+      cacheReadShort: 'Cache Read',
+      longContext: 'Long Context Tier',
+      longContextThreshold: 'Threshold (tokens)',
+      longContextHint: 'When the prompt (including cache tokens) exceeds the threshold, the whole request is billed at these prices.',
+      effectiveFrom: 'Effective From',
+      effectiveFromHint: 'Leave empty to apply immediately. Prices already in effect cannot be edited; create a new version instead.',
+      notes: 'Notes',
+      scheduled: 'Scheduled',
+      source: 'Source',
+      sources: {
+        override: 'Price override',
+        litellm: 'LiteLLM price list',
+        fallback: 'Model family fallback',
+        default_price: 'Unknown model default price'
+      },
+      empty: 'No price overrides',
+      emptyDesc: 'All models are billed with the LiteLLM price list.',
+      created: 'Price created',
+      updated: 'Price updated',
+      deleted: 'Price deleted',
+      policySaved: 'Unknown model policy saved',
+      failedToLoad: 'Failed to load model prices',
+      failedToSave: 'Failed to save model price',
+      failedToDelete: 'Failed to delete model price',
+      previewFailed: 'Failed to resolve price',
+      columns: {
+        model: 'Model',
+        scope: 'Scope',
+        prices: 'Input / Output',
+        cache: 'Cache',
+        longContext: 'Long Context',
+        effectiveFrom: 'Effective From',
+        actions: 'Actions'
+      }
+    },
+
+    // Organizations
+    organizations: {
+      title: 'Organization Management',
+      description: "Team accounts with a shared wallet, the owner's subscriptions shared by all members, pooled concurrency and per-member spending limits",
+      create: 'Create Organization',
+      edit: 'Edit Organization',
+      delete: 'Delete Organization',
+      deleteConfirm: 'Delete organization "{name}"? All members will be detached and fall back to their personal balance.',
+      searchPlaceholder: 'Search organizations...',
+      allStatus: 'All Status',
+      statusActive: 'Active',
+      statusDisabled: 'Disabled',
+      name: 'Name',
+      ownerUserId: 'Owner User ID',
+      ownerHint: 'The owner must not already belong to another organization',
+      initialBalance: 'Initial Wallet Balance',
+      concurrency: 'Shared Concurrency',
+      zeroUnlimited: '0 = unlimited',
+      status: 'Status',
+      notes: 'Notes',
+      members: 'Members',
+      membersTitle: 'Members of {name}',
+      usage: 'Usage',
+      usageTitle: 'Usage of {name}',
+      adjustBalance: 'Adjust Wallet',
+      currentBalance: 'Current balance',
+      operation: 'Operation',
+      amount: 'Amount',
+      operations: {
+        add: 'Add',
+        subtract: 'Subtract',
+        set: 'Set to'
+      },
+      memberEmail: 'Member email',
+      role: 'Role',
+      monthlyLimit: 'Monthly limit (USD)',
+      monthlyUsage: 'This month',
+      unlimited: 'Unlimited',
+      addMember: 'Add Member',
+      removeMember: 'Remove member',
+      removeMemberConfirm: 'Remove {email} from this organization?',
+      columns: {
+        name: 'Name',
+        owner: 'Owner',
+        balance: 'Wallet',
+        concurrency: 'Concurrency',
+        members: 'Members',
+        member: 'Member',
+        status: 'Status',
+        createdAt: 'Created',
+        actions: 'Actions'
+      },
+      created: 'Organization created',
+      updated: 'Organization updated',
+      deleted: 'Organization deleted',
+      balanceAdjusted: 'Wallet balance updated',
+      memberAdded: 'Member added',
+      memberUpdated: 'Member updated',
+      memberRemoved: 'Member removed',
+      failedToLoad: 'Failed to load organizations',
+      failedToCreate: 'Failed to create organization',
+      failedToUpdate: 'Failed to update organization',
+      failedToDelete: 'Failed to delete organization',
+      failedToAdjustBalance: 'Failed to adjust wallet balance',
+      failedToLoadMembers: 'Failed to load members',
+      failedToUpdateMember: 'Failed to update member',
+      failedToLoadUsage: 'Failed to load usage'
+    },
+
     // Promo Codes
     promo: {
       title: 'Promo Code Management',
@@ -2055,6 +2663,7 @@ export default {
       allBillingTypes: 'All Billing Types',
       billingTypeBalance: 'Balance',
       billingTypeSubscription: 'Subscription',
+      billingTypeResponseCache: 'Response Cache',
       ipAddress: 'IP',
       cleanup: {
         button: 'Cleanup',
@@ -2087,6 +2696,29 @@ export default {
           failed: 'Failed',
           canceled: 'Canceled'
         }
+      },
+      export: {
+        button: 'Export CSV/JSONL',
+        title: 'Export Usage Records',
+        format: 'Format',
+        destination: 'Destination',
+        destinationLocal: 'Server file (download when finished)',
+        destinationS3: 'Object storage (S3)',
+        destinationHint: 'Object storage is only available when configured on the server',
+        hint: 'Download now streams the file directly and is limited to short date ranges. For large ranges create a background export; it resumes automatically if interrupted.',
+        downloadNow: 'Download Now',
+        downloading: 'Downloading...',
+        downloadFailed: 'Export failed. For large date ranges, create a background export instead.',
+        createTask: 'Create Background Export',
+        taskCreated: 'Export task created',
+        taskFailed: 'Failed to create export task',
+        deleteFailed: 'Failed to delete export task',
+        recentTasks: 'Export Tasks',
+        noTasks: 'No export tasks yet',
+        nightly: 'Nightly',
+        download: 'Download',
+        rows: 'Rows',
+        size: 'Size'
       }
     },
 
@@ -2302,7 +2934,7 @@ export default {
           internal: 'Internal'
         },
         total: 'Total:',
-        searchPlaceholder: 'Search request_id / client_request_id / message',
+        searchPlaceholder: 'Search request_id / client_request_id / trace_id / message',
       },
       // Error Detail Modal
       errorDetail: {
@@ -2350,6 +2982,7 @@ export default {
         },
         loading: 'Loading…',
         requestId: 'Request ID',
+        traceId: 'Trace ID',
         time: 'Time',
         phase: 'Phase',
         status: 'Status',
@@ -2446,7 +3079,7 @@ export default {
       },
       alertEvents: {
         title: 'Alert Events',
-        description: 'Recent alert firing/resolution records (email-only)',
+        description: 'Recent alert firing/resolution records',
         loading: 'Loading...',
         empty: 'No alert events',
         loadFailed: 'Failed to load alert events',
@@ -2475,7 +3108,9 @@ export default {
           historyTitle: 'History',
           historyHint: 'Recent events with same rule + dimensions',
           historyLoading: 'Loading history...',
-          historyEmpty: 'No history'
+          historyEmpty: 'No history',
+          notifications: 'Channel Deliveries',
+          notificationsEmpty: 'No channel deliveries'
         },
         table: {
           time: 'Time',
@@ -2494,7 +3129,7 @@ export default {
       },
       alertRules: {
         title: 'Alert Rules',
-        description: 'Create and manage threshold-based system alerts (email-only)',
+        description: 'Create and manage threshold-based system alerts',
         loading: 'Loading...',
         empty: 'No alert rules',
         loadFailed: 'Failed to load alert rules',
@@ -2528,7 +3163,8 @@ export default {
           accountRateLimitedCount: 'Rate-limited Accounts',
           accountErrorCount: 'Error Accounts (excluding temporarily unschedulable)',
           accountErrorRatio: 'Error Account Ratio (%)',
-          overloadAccountCount: 'Overloaded Accounts'
+          overloadAccountCount: 'Overloaded Accounts',
+          balanceLedgerDriftUsers: 'Balance Ledger Drift (users)'
         },
         metricDescriptions: {
           successRate: 'Percentage of successful requests in the window (0-100).',
@@ -2545,12 +3181,14 @@ export default {
           accountRateLimitedCount: 'Number of rate-limited accounts within the window.',
           accountErrorCount: 'Number of error accounts within the window (excluding temporarily unschedulable).',
           accountErrorRatio: 'Error account ratio within the window (0-100).',
-          overloadAccountCount: 'Number of overloaded accounts within the window.'
+          overloadAccountCount: 'Number of overloaded accounts within the window.',
+          balanceLedgerDriftUsers: 'Number of users whose ledger total differs from their balance (reconciled every 10 minutes, ignores platform/group filters).'
         },
         hints: {
           recommended: 'Recommended: operator {operator}, threshold {threshold}{unit}',
           groupRequired: 'This is a group-level metric; selecting a group (group_id) is required.',
-          groupOptional: 'Optional: limit the rule to a specific group via group_id.'
+          groupOptional: 'Optional: limit the rule to a specific group via group_id.',
+          noChannels: 'No notification channels yet. Add one under Notification Channels below.'
         },
         table: {
           name: 'Name',
@@ -2573,7 +3211,8 @@ export default {
           sustained: 'Sustained (samples)',
           cooldown: 'Cooldown (minutes)',
           enabled: 'Enabled',
-          notifyEmail: 'Send email notifications'
+          notifyEmail: 'Send email notifications',
+          notifyChannels: 'Notification channels'
         },
         validation: {
           title: 'Please fix the following issues',
@@ -2588,6 +3227,174 @@ export default {
           cooldownRange: 'Cooldown must be between 0 and 1440 minutes'
         }
       },
+      payloadCapture: {
+        title: 'Payload Capture',
+        manage: 'Payload Capture',
+        scopes: {
+          api_key: 'API Key',
+          user: 'User',
+          group: 'Group'
+        },
+        rules: {
+          title: 'Capture Rules',
+          description: 'Opt a user, API key or group into full request/response capture for debugging. Captures are redacted and expire automatically.',
+          loading: 'Loading...',
+          empty: 'No capture rules',
+          create: 'Create Rule',
+          createTitle: 'Create Capture Rule',
+          editTitle: 'Edit Capture Rule',
+          privacyHint: 'Captured traffic may contain end-user content. Credentials and common secrets are masked, but keep sampling and retention as low as possible.',
+          expiresAt: 'Stops at {time}',
+          expired: 'Expired',
+          ttlValue: 'keep {hours}h',
+          table: {
+            name: 'Name',
+            scope: 'Scope',
+            sampling: 'Sampling / TTL',
+            captures: 'Captures',
+            status: 'Status',
+            actions: 'Actions'
+          },
+          form: {
+            name: 'Name',
+            scopeType: 'Scope',
+            scopeId: 'Scope ID',
+            sampleRate: 'Sample Rate',
+            ttlHours: 'Retention (hours)',
+            expiresAt: 'Stop Capturing At',
+            redactKeys: 'Extra Redacted Keys',
+            enabled: 'Enabled'
+          },
+          hints: {
+            sampleRate: 'Fraction of matching requests to capture, between 0 and 1 (1 = every request).',
+            ttlHours: 'Captures are deleted after this many hours (max 720).',
+            expiresAt: 'Optional. The rule stops matching after this time.',
+            redactKeys: 'One per line. JSON fields and headers with these names are masked in addition to the built-in list.'
+          },
+          validation: {
+            nameRequired: 'Name is required',
+            scopeIdRequired: 'Scope ID must be a positive integer',
+            sampleRateRange: 'Sample rate must be greater than 0 and at most 1',
+            ttlRange: 'Retention must be between 1 and 720 hours'
+          },
+          saveSuccess: 'Capture rule saved',
+          saveFailed: 'Failed to save capture rule',
+          loadFailed: 'Failed to load capture rules',
+          deleteSuccess: 'Capture rule deleted',
+          deleteFailed: 'Failed to delete capture rule',
+          deleteConfirmTitle: 'Delete Capture Rule',
+          deleteConfirmMessage: 'Delete this capture rule? Existing captures are kept until they expire.'
+        },
+        captures: {
+          title: 'Captured Requests',
+          description: 'Redacted request/response pairs. Streamed responses are reassembled into the final response.',
+          loading: 'Loading...',
+          empty: 'No captured requests',
+          view: 'View',
+          truncated: 'Truncated',
+          reassembled: 'Reassembled from stream',
+          filters: {
+            ruleId: 'Rule ID',
+            model: 'Model'
+          },
+          table: {
+            time: 'Time',
+            request: 'Request',
+            user: 'User',
+            account: 'Account',
+            status: 'Status',
+            size: 'Req / Resp',
+            actions: 'Actions'
+          },
+          fields: {
+            requestId: 'Request ID',
+            status: 'Status',
+            expiresAt: 'Expires At',
+            userAgent: 'User Agent',
+            requestHeaders: 'Request Headers',
+            requestBody: 'Request Body',
+            responseBody: 'Response Body'
+          },
+          detailTitle: 'Captured Request',
+          detailFailed: 'Failed to load capture',
+          replayTitle: 'Replay',
+          replayHint: 'Send the captured request again. Leave the account empty to reselect an account from the original group.',
+          replayAccountId: 'Account ID (optional)',
+          replay: 'Replay',
+          replaying: 'Replaying...',
+          replaySuccess: 'Replay succeeded',
+          replayFailed: 'Replay failed',
+          usedAccount: 'account #{id}',
+          loadFailed: 'Failed to load captures',
+          deleteSuccess: 'Capture deleted',
+          deleteFailed: 'Failed to delete capture',
+          deleteConfirmTitle: 'Delete Capture',
+          deleteConfirmMessage: 'Delete this captured request?'
+        }
+      },
+      notificationChannels: {
+        title: 'Notification Channels',
+        description: 'Webhook and chat channels that alert rules and scheduled reports can deliver to.',
+        loading: 'Loading...',
+        empty: 'No notification channels',
+        loadFailed: 'Failed to load notification channels',
+        saveFailed: 'Failed to save notification channel',
+        saveSuccess: 'Notification channel saved',
+        deleteFailed: 'Failed to delete notification channel',
+        deleteSuccess: 'Notification channel deleted',
+        create: 'Add Channel',
+        createTitle: 'Add Notification Channel',
+        editTitle: 'Edit Notification Channel',
+        deleteConfirmTitle: 'Delete this channel?',
+        deleteConfirmMessage: 'Alert rules using this channel will stop notifying it. Continue?',
+        test: 'Test',
+        testing: 'Sending...',
+        testSuccess: 'Test message sent',
+        testFailed: 'Test message failed: {error}',
+        types: {
+          webhook: 'Webhook',
+          slack: 'Slack',
+          discord: 'Discord',
+          telegram: 'Telegram',
+          dingtalk: 'DingTalk',
+          feishu: 'Feishu / Lark'
+        },
+        table: {
+          name: 'Name',
+          type: 'Type',
+          target: 'Target',
+          enabled: 'Enabled',
+          actions: 'Actions'
+        },
+        form: {
+          name: 'Name',
+          type: 'Type',
+          url: 'URL',
+          botToken: 'Bot Token',
+          chatId: 'Chat ID',
+          secret: 'Signing Secret',
+          headers: 'Extra Headers (one "Key: Value" per line)',
+          enabled: 'Enabled',
+          sendResolved: 'Send resolution notifications',
+          sendReports: 'Send scheduled reports',
+          keepUnchanged: 'Configured; leave blank to keep unchanged'
+        },
+        hints: {
+          webhook: 'Receives a JSON POST; failed deliveries are retried up to 3 times.',
+          slack: 'Slack incoming webhook URL.',
+          discord: 'Discord channel webhook URL.',
+          dingtalk: 'DingTalk custom robot webhook URL.',
+          feishu: 'Feishu / Lark custom bot webhook URL.',
+          webhookSecret: 'Optional: the body is signed with HMAC-SHA256 into the X-Sub2API-Signature header.',
+          botSecret: 'Optional: required if the robot has signature verification enabled.'
+        },
+        validation: {
+          nameRequired: 'Name is required',
+          urlRequired: 'URL is required',
+          botTokenRequired: 'Bot token is required',
+          chatIdRequired: 'Chat ID is required'
+        }
+      },
       runtime: {
         title: 'Ops Runtime Settings',
         description: 'Stored in database; changes take effect without editing config files.',
@@ -2817,6 +3624,36 @@ export default {
       }
     },
 
+    // Admin roles & permissions
+    roles: {
+      admin: 'Super Admin',
+      support: 'Support',
+      finance: 'Finance',
+      ops: 'Ops',
+      user: 'User',
+      permissions: {
+        all: 'All permissions',
+        dashboard_view: 'View dashboard',
+        users_view: 'View users',
+        users_manage: 'Manage users',
+        roles_manage: 'Manage admin roles',
+        balance_adjust: 'Adjust balance (limited)',
+        balance_adjust_unlimited: 'Adjust balance (unlimited)',
+        usage_view: 'View usage',
+        usage_manage: 'Manage usage cleanup',
+        billing_manage: 'Redeem/promo codes, subscriptions & ledgers',
+        groups_view: 'View groups',
+        groups_manage: 'Manage groups',
+        accounts_manage: 'Manage accounts',
+        proxies_manage: 'Manage proxies',
+        ops_manage: 'Ops dashboard & retries',
+        settings_manage: 'System settings',
+        system_manage: 'System maintenance',
+        audit_view: 'View audit log',
+        admin_keys_manage: 'Manage admin API keys'
+      }
+    },
+
     // Settings
     settings: {
       title: 'System Settings',
@@ -2888,7 +3725,49 @@ export default {
         rateMultiplierHint: 'Balance user receives per $1 paid (e.g., 10 means $1 = $10 balance)',
         successUrl: 'Success Redirect URL',
         successUrlPlaceholder: 'https://your-domain.com/redeem?payment=success',
-        successUrlHint: 'URL to redirect users after successful payment'
+        successUrlHint: 'URL to redirect users after successful payment (leave empty to show the top-up order page)'
+      },
+      stripe: {
+        title: 'Stripe Payment Integration',
+        description: 'Accept card payments through Stripe Checkout',
+        enable: 'Enable Stripe Payment',
+        enableHint: 'Show Stripe as a provider on the recharge page',
+        secretKey: 'Secret Key',
+        secretKeyPlaceholder: 'sk_live_xxx...',
+        secretKeyConfiguredPlaceholder: '********',
+        secretKeyHint: 'From Stripe Dashboard > Developers > API keys (test keys start with sk_test_)',
+        webhookSecret: 'Webhook Signing Secret',
+        webhookSecretPlaceholder: 'whsec_xxx...',
+        webhookSecretConfiguredPlaceholder: '********',
+        webhookSecretHint: 'Signing secret of the webhook endpoint, used to verify Stripe-Signature',
+        currency: 'Currency',
+        currencyHint: 'Three-letter ISO currency code users pay in (e.g. usd, eur)',
+        rateMultiplier: 'Rate Multiplier',
+        rateMultiplierHint: 'USD balance credited per 1 unit paid',
+        webhookUrlHint: 'Webhook endpoint (subscribe to checkout.session.* and charge.refunded):'
+      },
+      epay: {
+        title: 'EPay Payment Integration',
+        description: 'Accept Alipay / WeChat Pay through an EPay-compatible aggregator gateway',
+        enable: 'Enable EPay',
+        enableHint: 'Show EPay as a provider on the recharge page',
+        gatewayUrl: 'Gateway URL',
+        gatewayUrlPlaceholder: 'https://pay.example.com',
+        gatewayUrlHint: 'Base URL of the gateway; users are redirected to its /submit.php page',
+        merchantId: 'Merchant ID (pid)',
+        merchantIdPlaceholder: '1001',
+        merchantIdHint: 'Merchant ID assigned by the gateway',
+        merchantKey: 'Merchant Key',
+        merchantKeyPlaceholder: 'Merchant key',
+        merchantKeyConfiguredPlaceholder: '********',
+        merchantKeyHint: 'Used to sign orders and verify notifications (MD5)',
+        payTypes: 'Payment Methods',
+        payTypesHint: 'Comma separated method codes supported by the gateway, e.g. alipay,wxpay',
+        currency: 'Currency',
+        currencyHint: 'Currency the gateway charges in',
+        rateMultiplier: 'Rate Multiplier',
+        rateMultiplierHint: 'USD balance credited per 1 unit paid (e.g. 0.14 means 1 CNY = $0.14)',
+        webhookUrlHint: 'Notify URL (sent automatically with each order):'
       },
       defaults: {
         title: 'Default User Settings',
@@ -2980,26 +3859,30 @@ export default {
         metricsIntervalHint: 'How often to collect system/request metrics (60-3600 seconds)'
       },
       adminApiKey: {
-        title: 'Admin API Key',
-        description: 'Global API key for external system integration with full admin access',
-        notConfigured: 'Admin API key not configured',
-        configured: 'Admin API key is active',
-        currentKey: 'Current Key',
-        regenerate: 'Regenerate',
-        regenerating: 'Regenerating...',
-        delete: 'Delete',
-        deleting: 'Deleting...',
+        title: 'Admin API Keys',
+        description: 'Named API keys for external system integration, each limited to selected permissions',
+        notConfigured: 'No admin API keys yet',
+        name: 'Name',
+        namePlaceholder: 'e.g. billing-sync',
+        scopes: 'Permissions',
+        expiresAt: 'Expires',
+        expiresAtHint: 'Leave empty for a key that never expires',
+        neverExpires: 'Never',
+        lastUsedAt: 'Last used',
+        neverUsed: 'Never',
+        expired: 'Expired',
+        revoke: 'Revoke',
         create: 'Create Key',
         creating: 'Creating...',
-        regenerateConfirm: 'Are you sure? The current key will be immediately invalidated.',
-        deleteConfirm:
-          'Are you sure you want to delete the admin API key? External integrations will stop working.',
+        revokeConfirm:
+          'Revoke admin API key "{name}"? Integrations using it will stop working immediately.',
         keyGenerated: 'New admin API key generated',
-        keyDeleted: 'Admin API key deleted',
+        keyDeleted: 'Admin API key revoked',
         copyKey: 'Copy Key',
         keyCopied: 'Key copied to clipboard',
         keyWarning: 'This key will only be shown once. Please copy it now.',
-        securityWarning: 'Warning: This key provides full admin access. Keep it secure.',
+        securityWarning:
+          'Warning: Admin API keys act with the permissions you grant them. Grant only what is needed and keep them secure.',
         usage: 'Usage: Add to request header - x-api-key: <your-admin-api-key>'
       },
       streamTimeout: {
@@ -3101,7 +3984,180 @@ export default {
     expiresOn: 'Expires on {date}',
     resetIn: 'Resets in {time}',
     windowNotActive: 'Awaiting first use',
-    usageOf: '{used} of {limit}'
+    usageOf: '{used} of {limit}',
+    autoRenew: 'Auto-renew',
+    autoRenewHint: 'Pays the plan price from your balance 24 hours before expiry',
+    autoRenewEnabled: 'Auto-renew enabled',
+    autoRenewDisabled: 'Auto-renew disabled',
+    autoRenewFailed: 'Failed to update auto-renew',
+    plans: {
+      title: 'Subscription Plans',
+      description: 'Buy a plan to start or extend a subscription',
+      validity: '{days} days',
+      payAmount: 'Pay {amount}',
+      buy: 'Buy',
+      renew: 'Renew'
+    }
+  },
+
+  // Balance ledger (per-transaction history)
+  balanceLedger: {
+    title: 'Balance History',
+    description: 'Every credit and debit to your balance',
+    empty: 'No transactions',
+    allSources: 'All Sources',
+    failedToLoad: 'Failed to load balance history',
+    actor: 'By admin #{id}',
+    columns: {
+      time: 'Time',
+      source: 'Source',
+      amount: 'Amount',
+      balanceAfter: 'Balance After',
+      reference: 'Reference'
+    },
+    sources: {
+      usage: 'API Usage',
+      admin_adjustment: 'Admin Adjustment',
+      redeem_code: 'Redeem Code',
+      promo_code: 'Promo Code',
+      creem_checkout: 'Online Recharge',
+      payment_order: 'Online Recharge',
+      payment_refund: 'Recharge Refund',
+      initial: 'Initial Balance',
+      opening_balance: 'Opening Balance',
+      subscription_renewal: 'Subscription Renewal'
+    }
+  },
+
+  // Top-up orders (user)
+  paymentOrders: {
+    title: 'Top-up Orders',
+    description: 'Online recharge orders and their payment status',
+    topUp: 'Top Up',
+    empty: 'No top-up orders yet',
+    emptyDesc: 'Orders appear here after you start an online recharge',
+    failedToLoad: 'Failed to load top-up orders',
+    continuePayment: 'Continue payment',
+    pendingHint: 'The payment has not been confirmed yet. It is credited automatically once the provider notifies us; refresh later to check.',
+    returnOrder: 'Order {orderNo} · {amount} balance',
+    returnPlanOrder: 'Order {orderNo} · plan {plan}',
+    returnStatus: {
+      pending: 'Waiting for payment confirmation...',
+      paid: 'Payment received, your balance has been credited',
+      failed: 'Payment failed or expired',
+      refunded: 'This order has been refunded',
+      planPaid: 'Payment received, your subscription has been activated'
+    },
+    statuses: {
+      pending: 'Pending',
+      paid: 'Paid',
+      failed: 'Failed',
+      refunded: 'Refunded'
+    },
+    providers: {
+      creem: 'Creem',
+      stripe: 'Stripe',
+      epay: 'EPay'
+    },
+    methods: {
+      alipay: 'Alipay',
+      wxpay: 'WeChat Pay',
+      qqpay: 'QQ Pay'
+    },
+    columns: {
+      orderNo: 'Order No.',
+      provider: 'Provider',
+      amount: 'Paid',
+      credit: 'Credited',
+      status: 'Status',
+      createdAt: 'Created',
+      actions: 'Actions'
+    }
+  },
+
+  // Invoices (user)
+  invoices: {
+    title: 'Invoices',
+    description: 'Monthly invoices for your usage and subscriptions',
+    empty: 'No invoices yet',
+    emptyDesc: 'Invoices are issued after each month closes',
+    failedToLoad: 'Failed to load invoices',
+    failedToDownload: 'Failed to download invoice',
+    personal: 'Personal',
+    view: 'View',
+    downloadPdf: 'Download PDF',
+    downloadHtml: 'Download HTML',
+    detailTitle: 'Invoice {number}',
+    billTo: 'Bill to',
+    period: 'Billing period',
+    issuedAt: 'Issued {date}',
+    noCharges: 'No charges in this period',
+    subscriptionCovered: 'Covered by subscription',
+    subtotal: 'Subtotal',
+    tax: 'Tax',
+    total: 'Total',
+    topUps: 'Top-ups in this period',
+    topUpsHint: 'Listed for reference, not included in the total',
+    columns: {
+      number: 'Invoice No.',
+      period: 'Period',
+      billedTo: 'Billed To',
+      total: 'Total',
+      issuedAt: 'Issued',
+      actions: 'Actions',
+      description: 'Description',
+      requests: 'Requests',
+      tokens: 'Tokens',
+      amount: 'Amount'
+    }
+  },
+
+  // Organization (member self-service)
+  organization: {
+    title: 'My Organization',
+    description: 'Shared wallet, members and usage of your team',
+    notInOrganization: 'Not in an Organization',
+    notInOrganizationDesc: 'You are not a member of any organization. Ask an organization owner or the administrator to add you.',
+    yourRole: 'Your role',
+    statusActive: 'Active',
+    statusDisabled: 'Disabled',
+    walletBalance: 'Shared wallet',
+    sharedConcurrency: 'Shared concurrency',
+    myMonthlySpend: 'My spend this month',
+    unlimited: 'Unlimited',
+    members: 'Members',
+    member: 'Member',
+    memberEmail: 'Member email',
+    role: 'Role',
+    monthlyLimit: 'Monthly limit (USD)',
+    monthlyUsage: 'This month',
+    addMember: 'Add Member',
+    removeMember: 'Remove member',
+    removeMemberConfirm: 'Remove {email} from the organization?',
+    memberAdded: 'Member added',
+    memberUpdated: 'Member updated',
+    memberRemoved: 'Member removed',
+    failedToLoad: 'Failed to load organization',
+    failedToLoadUsage: 'Failed to load usage',
+    failedToUpdateMember: 'Failed to update member',
+    roles: {
+      owner: 'Owner',
+      admin: 'Admin',
+      member: 'Member'
+    },
+    usage: {
+      title: 'Usage by Member',
+      member: 'Member',
+      requests: 'Requests',
+      inputTokens: 'Input',
+      outputTokens: 'Output',
+      cacheTokens: 'Cache',
+      actualCost: 'Cost',
+      standardCost: 'Standard cost',
+      totalRequests: 'Total requests',
+      formerMember: 'Former member',
+      empty: 'No usage in this period'
+    }
   },
 
   // Onboarding Tour
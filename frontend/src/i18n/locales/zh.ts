@@ -164,6 +164,20 @@ export default {
     now: '现在',
     unknown: '未知',
     minutes: '分钟',
+    rateLimits: {
+      title: '速率限制',
+      rpm: '每分钟请求数',
+      inputTpm: '每分钟输入 Token',
+      outputTpm: '每分钟输出 Token',
+      unlimited: '不限制',
+      hint: '按一分钟滑动窗口统计，留空或填 0 表示不限制。'
+    },
+    responseCache: {
+      title: '响应缓存',
+      ttlSeconds: '缓存时长（秒）',
+      ttlDefault: '默认',
+      hint: '对完全相同的确定性请求（temperature = 0）直接返回缓存响应，缓存时长留空则使用默认值。'
+    },
     time: {
       never: '从未',
       justNow: '刚刚',
@@ -189,11 +203,17 @@ export default {
     users: '用户管理',
     groups: '分组管理',
     subscriptions: '订阅管理',
+    subscriptionPlans: '订阅套餐',
+    modelPrices: '模型价格',
     accounts: '账号管理',
     proxies: 'IP管理',
+    proxyPools: '代理池',
     redeemCodes: '兑换码',
     ops: '运维监控',
     promoCodes: '优惠码',
+    auditLogs: '审计日志',
+    organizations: '组织管理',
+    invoices: '发票管理',
     settings: '系统设置',
     myAccount: '我的账户',
     lightMode: '浅色模式',
@@ -203,6 +223,10 @@ export default {
     logout: '退出登录',
     github: 'GitHub',
     mySubscriptions: '我的订阅',
+    myOrganization: '我的组织',
+    balanceHistory: '余额明细',
+    myInvoices: '我的发票',
+    paymentOrders: '充值订单',
     docs: '文档'
   },
 
@@ -323,6 +347,10 @@ export default {
     hour: '按小时',
     modelDistribution: '模型分布',
     tokenUsageTrend: 'Token 使用趋势',
+    cacheSavings: {
+      title: '提示缓存节省',
+      hint: '{requests} 次缓存命中请求，从缓存读取 {tokens} Token'
+    },
     noDataAvailable: '暂无数据',
     model: '模型',
     requests: '请求',
@@ -446,6 +474,24 @@ export default {
     ipBlacklistPlaceholder: '1.2.3.4\n5.6.0.0/16',
     ipBlacklistHint: '每行一个 IP 或 CIDR，这些 IP 将被禁止使用此密钥',
     ipRestrictionEnabled: '已配置 IP 限制',
+    spendingLimits: '限额与有效期',
+    quotaUsd: '总上限 (USD)',
+    dailyLimitUsd: '每日上限 (USD)',
+    monthlyLimitUsd: '每月上限 (USD)',
+    limitPlaceholder: '不限制',
+    expiresAt: '过期时间',
+    spendingLimitsHint: '留空或填 0 表示不限制。上限按该密钥实际扣费金额计算。',
+    keyExpired: '已过期',
+    expiresOn: '{date} 过期',
+    month: '本月',
+    modelRestriction: '模型限制',
+    modelRestrictionEnabled: '已配置模型限制',
+    allowedModels: '允许的模型',
+    allowedModelsPlaceholder: 'claude-*-haiku*\ngpt-5*',
+    allowedModelsHint: '每行一个模型，* 匹配任意字符。留空表示允许所有模型',
+    modelAliases: '模型别名',
+    modelAliasesPlaceholder: 'fast=claude-haiku-4-5\nsmart=claude-sonnet-4-5',
+    modelAliasesHint: '每行一个 别名=模型，客户端可使用别名代替真实模型名请求',
     ccSwitchNotInstalled: 'CC-Switch 未安装或协议处理程序未注册。请先安装 CC-Switch 或手动复制 API 密钥。',
     ccsClientSelect: {
       title: '选择客户端',
@@ -558,16 +604,21 @@ export default {
     onlineRechargeDesc: '使用信用卡或借记卡即时充值余额',
     rechargeNow: '立即充值',
     rechargeFeature1: '支持 Visa、MasterCard、银联等主流卡',
-    rechargeFeature2: '支付 $1 即可获得 $10 余额',
+    rechargeFeature2: '支付 {pay} 即可获得 {credit} 余额',
     rechargeFeature3: '即时到账，安全可靠',
     selectAmount: '选择充值金额',
-    customAmount: '自定义金额 (1-100)',
+    customAmount: '自定义金额 ({min}-{max})',
     youWillGet: '您将获得',
     payAmount: '支付金额',
     proceedToPayment: '前往支付',
     processingPayment: '处理中...',
-    securePaymentByCreem: '安全支付由 Creem 提供',
-    paymentFailed: '支付创建失败，请重试'
+    securePaymentBy: '安全支付由 {provider} 提供',
+    paymentFailed: '支付创建失败，请重试',
+    paymentCancelled: '支付已取消，未产生扣款',
+    paymentProvider: '支付渠道',
+    paymentMethod: '支付方式',
+    noPaymentProviders: '暂未开放在线充值',
+    viewPaymentOrders: '充值订单'
   },
 
   // Profile
@@ -749,7 +800,23 @@ export default {
       configureAiAccounts: '配置 AI 平台账号',
       systemSettings: '系统设置',
       configureSystem: '配置系统设置',
-      failedToLoad: '加载仪表盘数据失败'
+      failedToLoad: '加载仪表盘数据失败',
+      promptCache: {
+        title: '提示缓存',
+        tabs: {
+          accounts: '按账号',
+          groups: '按分组',
+          users: '用户节省'
+        },
+        account: '账号',
+        group: '分组',
+        user: '用户',
+        ungrouped: '未分组',
+        hitRequests: '命中请求',
+        cacheCreation: '缓存写入',
+        cacheRead: '缓存读取',
+        hitRate: '命中率'
+      }
     },
 
     // Users Management
@@ -830,9 +897,13 @@ export default {
       failedToLoadApiKeys: '加载用户 API 密钥失败',
       deleteConfirm: "确定要删除用户 '{email}' 吗？此操作无法撤销。",
       roles: {
-        admin: '管理员',
+        admin: '超级管理员',
+        support: '客服',
+        finance: '财务',
+        ops: '运维',
         user: '用户'
       },
+      roleHint: '员工角色可进入管理后台，但只能访问其权限范围内的功能',
       form: {
         emailLabel: '邮箱',
         emailPlaceholder: '请输入邮箱',
@@ -880,6 +951,10 @@ export default {
       failedToUpdateAllowedGroups: '更新允许分组失败',
       deposit: '充值',
       withdraw: '退款',
+      balanceHistory: '余额明细',
+      exportStatement: '导出对账单',
+      exportingStatement: '导出中...',
+      failedToExportStatement: '导出对账单失败',
       depositAmount: '充值金额',
       withdrawAmount: '退款金额',
       withdrawAll: '全部',
@@ -1063,6 +1138,10 @@ export default {
       failedToCreate: '创建分组失败',
       failedToUpdate: '更新分组失败',
       nameRequired: '请输入分组名称',
+      rateLimitsHint: '分组内所有 API Key 共享（一分钟滑动窗口），留空或填 0 表示不限制。',
+      responseCacheHint: '分组内所有 API Key 共享缓存；单独启用了缓存的 API Key 使用独立缓存。',
+      responseCacheCostRatio: '命中计费比例',
+      responseCacheCostRatioHint: '缓存命中时按正常费用的该比例计费（0 为免费，1 为全价）。',
       subscription: {
         title: '订阅设置',
         type: '计费类型',
@@ -1214,6 +1293,67 @@ export default {
       syncCompleted: '同步完成：创建 {created}，更新 {updated}',
       syncCompletedWithErrors: '同步完成但有错误：失败 {failed}（创建 {created}，更新 {updated}）',
       syncFailed: '同步失败',
+      bundle: {
+        button: '导出 / 导入',
+        title: '导出 / 导入账号',
+        tabs: {
+          export: '导出',
+          import: '导入文件',
+          instance: '从实例同步'
+        },
+        exportDesc:
+          '将账号连同所属分组、模型路由和代理导出为口令加密的文件。文件包含凭据，请妥善保管文件与口令。',
+        importDesc: '导入其他实例导出的迁移包。可先点击“预览”查看将要发生的变更，再执行导入。',
+        instanceDesc:
+          '使用另一个 sub2api 实例的管理员 API Key 直接拉取账号，迁移包口令仅用于本次传输加密。',
+        onlySelected: '仅导出已选中的 {count} 个账号（否则导出全部账号）',
+        file: '迁移包文件',
+        fileInfo: '来源 {source}，导出于 {time}',
+        invalidFile: '不是有效的账号迁移包文件',
+        baseUrl: '实例地址',
+        adminApiKey: '管理员 API Key',
+        password: '迁移包口令',
+        passwordHint: '至少 8 个字符',
+        confirmPassword: '确认口令',
+        conflictPolicy: '冲突处理',
+        policies: {
+          skip: '跳过已存在',
+          overwrite: '覆盖已存在',
+          rename: '作为新条目导入（重命名）'
+        },
+        policyHint: {
+          skip: '已存在的账号和分组保持不变。',
+          overwrite: '更新已存在的账号和分组；账号状态与代理池分配保持不变。',
+          rename: '冲突条目以“(imported)”后缀重新创建。'
+        },
+        preview: '预览',
+        previewTitle: '预览（尚未写入）',
+        resultTitle: '导入结果',
+        summary: '创建 {created}，更新 {updated}，跳过 {skipped}，重命名 {renamed}，复用 {reused}，失败 {failed}',
+        kinds: {
+          accounts: '账号',
+          groups: '分组',
+          proxies: '代理'
+        },
+        actions: {
+          create: '创建',
+          update: '更新',
+          skip: '跳过',
+          rename: '重命名创建',
+          reuse: '复用',
+          failed: '失败'
+        },
+        submit: {
+          export: '导出并下载',
+          import: '导入',
+          instance: '立即同步'
+        },
+        processing: '处理中...',
+        exported: '迁移包已导出',
+        completed: '导入完成',
+        completedWithErrors: '导入完成，{failed} 项失败',
+        failed: '操作失败'
+      },
       editAccount: '编辑账号',
       deleteAccount: '删除账号',
       deleteConfirmMessage: "确定要删除账号 '{name}' 吗？",
@@ -1537,9 +1677,32 @@ export default {
           hint: '启用后将在 15 分钟内固定 metadata.user_id 中的 session ID，使上游认为请求来自同一会话'
         }
       },
+      // 账号预算（所有平台）
+      budget: {
+        title: '预算',
+        hint: '按自然日、自然周（周一起）或自然月限制账号费用（按账号计费倍率）或 token 用量，留空表示不限制',
+        periods: {
+          daily: '每日',
+          weekly: '每周',
+          monthly: '每月'
+        },
+        short: {
+          daily: '日',
+          weekly: '周',
+          monthly: '月'
+        },
+        costPlaceholder: '费用上限',
+        tokensPlaceholder: 'Token 上限',
+        pauseHint: '预算用尽后账号将暂停调度，并在对应周期重置时自动恢复',
+        exhausted: '预算已用尽，暂停调度至 {time}',
+        normal: '重置时间 {time}'
+      },
       expired: '已过期',
       proxy: '代理',
       noProxy: '无代理',
+      proxyPool: '代理池',
+      noProxyPool: '不使用代理池（单个代理）',
+      proxyPoolHint: '绑定代理池后由代理池按选择策略分配代理，代理不健康时自动切换',
       concurrency: '并发数',
       priority: '优先级',
       priorityHint: '优先级越小的账号优先使用',
@@ -1851,6 +2014,28 @@ export default {
       viewStats: '查看统计',
       usageStatistics: '使用统计',
       last30DaysUsage: '近30天使用统计（日均基于实际使用天数）',
+      health: {
+        title: '调度健康度',
+        score: '健康分',
+        weight: '调度权重',
+        window: '最近 {minutes} 分钟',
+        noData: '近期无流量，账号按满权重参与调度',
+        notApplied: '数据不足',
+        modeLoadAware: '未启用健康度加权调度（selection_mode: load_aware），健康分仅供参考',
+        modeHealthWeighted: '同优先级账号按权重加权随机选择，亚健康账号获得的流量更少',
+        factors: {
+          success_rate: '成功率',
+          latency: '首字延迟',
+          throttle: '429/529 频率',
+          window_cost: '窗口剩余费用'
+        },
+        explain: {
+          success_rate: '成功 {success} 次，上游错误 {errors} 次',
+          latency: 'p50 {p50} · p95 {p95}',
+          throttle: '429 {count429} 次，529 {count529} 次',
+          window_cost: '当前 5h 窗口已用 ${cost} / ${limit}'
+        }
+      },
       stats: {
         totalCost: '30天总费用',
         accumulatedCost: '累计成本',
@@ -1900,6 +2085,7 @@ export default {
         status: '状态',
         accounts: '账号数',
         latency: '延迟',
+        health: '健康状态',
         actions: '操作',
         nameLabel: '名称',
         namePlaceholder: '请输入代理名称',
@@ -1946,6 +2132,13 @@ export default {
       batchTest: '批量测试',
       testFailed: '失败',
       latencyFailed: '链接失败',
+      health: {
+        unknown: '未知',
+        healthy: '健康',
+        unhealthy: '不健康',
+        errorRate: '错误率 {rate}%',
+        forwardErrors: '转发错误 {count} 次'
+      },
       batchTestEmpty: '暂无可测试的代理',
       batchTestDone: '批量测试完成，共测试 {count} 个代理',
       batchTestFailed: '批量测试失败',
@@ -1977,6 +2170,52 @@ export default {
       batchImportSuccess: '成功导入 {created} 个代理，跳过 {skipped} 个重复',
       batchImportAllSkipped: '全部 {skipped} 个代理已存在，跳过导入',
       failedToImport: '批量导入失败',
+      importFromSubscription: '导入 / 订阅',
+      importer: {
+        title: '导入代理',
+        tabPaste: '粘贴列表',
+        tabUrl: '从链接导入',
+        tabSubscriptions: '订阅管理',
+        content: '代理列表',
+        contentPlaceholder:
+          "粘贴 Clash YAML 配置、Base64 编码的 V2Ray 订阅，或每行一个代理：\nsocks5://user:pass{'@'}1.2.3.4:1080#tokyo\n1.2.3.4:8080:user:pass",
+        url: '订阅链接',
+        formatHint:
+          '支持 Clash YAML、Base64 V2Ray 订阅以及 http / https / socks5 分享链接；vmess、trojan、ss 等协议会被跳过并列出原因。',
+        namePrefix: '名称前缀（可选）',
+        pool: '加入代理池',
+        noPool: '不加入代理池',
+        saveAsSubscription: '保存为订阅并自动同步',
+        subscriptionName: '订阅名称',
+        refreshInterval: '同步间隔（分钟）',
+        refreshIntervalHint: '0 表示仅手动同步；最小 5 分钟',
+        import: '导入',
+        resultTotal: '解析 {count} 个',
+        resultCreated: '新建 {count} 个',
+        resultDuplicated: '已存在 {count} 个',
+        resultReactivated: '恢复启用 {count} 个',
+        resultDisabled: '停用 {count} 个（已从订阅移除）',
+        resultSkipped: '跳过 {count} 个',
+        importSuccess: '成功导入 {created} 个代理（{duplicated} 个已存在），正在后台探测出口信息',
+        nothingNew: '没有新的代理（{duplicated} 个已存在）',
+        noSubscriptions: '暂无订阅，可在“从链接导入”中保存为订阅',
+        proxyCount: '{count} 个代理',
+        everyMinutes: '每 {minutes} 分钟',
+        manualOnly: '手动同步',
+        lastSynced: '同步于 {time}',
+        neverSynced: '尚未同步',
+        lastResult: '上次同步：解析 {total} 个，新建 {created} 个，跳过 {skipped} 个，停用 {disabled} 个',
+        syncNow: '立即同步',
+        pause: '暂停自动同步',
+        resume: '恢复自动同步',
+        syncSuccess: '同步完成：新建 {created} 个，停用 {disabled} 个',
+        deleteConfirm: '确定删除订阅“{name}”吗？已导入的代理会保留。',
+        deleted: '订阅已删除',
+        failedToLoadSubscriptions: '加载订阅失败',
+        failedToSync: '同步订阅失败',
+        failedToSave: '更新订阅失败',
+        failedToDelete: '删除订阅失败'
+      },
       // Other messages
       saving: '保存中...',
       testing: '测试中...',
@@ -2112,6 +2351,370 @@ export default {
       failedToDelete: '删除兑换码失败'
     },
 
+    // Audit Logs
+    auditLogs: {
+      title: '审计日志',
+      description: '记录通过管理后台与管理员 API Key 进行的所有变更操作（只追加）',
+      searchAction: '搜索操作...',
+      actorUserId: '操作者用户 ID',
+      allActorTypes: '全部操作者',
+      allTargetTypes: '全部对象',
+      actorTypes: {
+        jwt: '后台登录',
+        admin_api_key: '管理员 API Key'
+      },
+      columns: {
+        time: '时间',
+        actor: '操作者',
+        action: '操作',
+        target: '对象',
+        status: '状态',
+        ip: 'IP',
+        actions: '操作'
+      },
+      viewChanges: '查看变更',
+      changesTitle: '变更详情',
+      before: '变更前',
+      after: '变更后',
+      exportCsv: '导出 CSV',
+      exporting: '导出中...',
+      failedToLoad: '加载审计日志失败',
+      failedToExport: '导出审计日志失败'
+    },
+
+    // 发票管理
+    invoices: {
+      title: '发票管理',
+      description: '为用户与组织开具、发送和管理月度发票',
+      generate: '开具发票',
+      generating: '开具中...',
+      generated: '发票已开具',
+      generationStarted: '已在后台开始开具发票',
+      failedToGenerate: '开具发票失败',
+      regenerate: '重新生成',
+      regenerated: '发票已重新生成',
+      failedToRegenerate: '重新生成发票失败',
+      void: '作废',
+      voided: '发票已作废',
+      voidConfirm: '确定作废发票 {number} 吗？作废后可为同一账期重新开具新编号的发票。',
+      failedToVoid: '作废发票失败',
+      sendEmail: '发送邮件',
+      emailQueued: '发票邮件已加入发送队列：{email}',
+      emailFailed: '发送失败',
+      failedToSend: '发送发票邮件失败',
+      empty: '暂无发票',
+      emptyDesc: '为已结束的月份开具发票，或开启自动开票',
+      filterPeriod: '账期',
+      allSubjects: '全部主体',
+      allStatuses: '全部状态',
+      allBillableSubjects: '账期内有业务的全部用户与组织',
+      allSubjectsHint: '在后台执行，已开具发票的主体会被跳过',
+      period: '账期',
+      periodHint: '只能为已结束的月份开具发票',
+      subjectType: '开票主体',
+      subjectId: '用户 / 组织 ID',
+      sendEmailAfter: '开具后发送邮件给收票人',
+      subjectTypes: {
+        user: '用户',
+        organization: '组织'
+      },
+      statuses: {
+        issued: '已开具',
+        void: '已作废'
+      },
+      columns: {
+        status: '状态',
+        emailedAt: '邮件发送'
+      },
+      settings: '发票设置',
+      failedToLoadSettings: '加载发票设置失败',
+      failedToSaveSettings: '保存发票设置失败',
+      settingsSaved: '发票设置已保存',
+      autoGenerate: '自动开票',
+      autoGenerateHint: '每月初自动为上一个月开具发票',
+      autoEmail: '自动发送邮件',
+      autoEmailHint: '自动开具的发票通过邮件发送给收票人',
+      numberPrefix: '编号前缀',
+      nextNumber: '下一个编号',
+      numberPadding: '位数',
+      numberPreview: '下一张发票编号：{number}',
+      currency: '币种（ISO 代码）',
+      taxLabel: '税项名称',
+      taxRate: '税率（%）',
+      companyName: '公司名称',
+      companyAddress: '公司地址',
+      companyTaxId: '税号',
+      companyEmail: '账单邮箱',
+      footerNote: '页脚备注',
+      brandingHint: '站点名称与 Logo 取自站点设置。修改仅影响之后开具或重新生成的发票。'
+    },
+
+    // 充值订单
+    paymentOrders: {
+      title: '充值订单',
+      description: '核对在线充值订单与支付渠道的收款记录',
+      searchPlaceholder: '搜索订单号、交易号或邮箱...',
+      allProviders: '全部渠道',
+      allStatuses: '全部状态',
+      empty: '暂无充值订单',
+      emptyDesc: '用户发起在线充值后会生成订单',
+      providerTxnId: '渠道交易号',
+      providerTxnIdHint: '渠道后台显示的支付/交易号，用于识别之后到达的重复回调',
+      notes: '备注',
+      paidAt: '支付于 {time}',
+      markPaid: '标记已支付',
+      markPaidHint: '为 {email} 的订单 {orderNo} 入账 {amount}。请先在渠道后台确认已收款。',
+      markedPaid: '订单已标记为已支付并完成入账',
+      refund: '登记退款',
+      refundHint: '从 {email} 扣回订单 {orderNo} 的 {amount}。实际退款需在渠道后台操作。',
+      refunded: '退款已登记并扣回余额',
+      actionFailed: '操作失败',
+      planLabel: '套餐：{plan}',
+      markPaidPlanHint: '为 {email} 开通或续期套餐 {plan}（订单 {orderNo}）。请先在渠道后台确认已收款。',
+      refundPlanHint: '登记 {email} 的套餐订单 {orderNo} 退款。已开通的订阅不会自动撤销，如需调整请到订阅管理操作。',
+      columns: {
+        user: '用户'
+      }
+    },
+
+    // 代理池
+    proxyPools: {
+      title: '代理池',
+      description: '将多个代理编组，绑定代理池的账号按策略分配代理并自动故障切换',
+      create: '创建代理池',
+      edit: '编辑代理池',
+      delete: '删除代理池',
+      deleteConfirm: "确定要删除代理池 '{name}' 吗？仍被账号引用的代理池无法删除。",
+      searchPlaceholder: '搜索代理池...',
+      allStatus: '全部状态',
+      name: '名称',
+      descriptionLabel: '描述',
+      policy: '选择策略',
+      status: '状态',
+      members: '成员代理',
+      membersHint: '一个代理最多属于一个代理池，勾选其他代理池的代理会将其移入当前代理池',
+      selectedCount: '已选 {count} 个',
+      noProxies: '暂无代理',
+      inOtherPool: '已属于其他代理池',
+      healthyOfTotal: '{healthy} / {total} 健康',
+      policies: {
+        sticky: '固定分配',
+        round_robin: '轮询',
+        least_latency: '最低延迟',
+        same_country: '同国家优先'
+      },
+      policyHints: {
+        sticky: '按账号 ID 固定分配到某个代理',
+        round_robin: '分配时依次轮换，使账号均匀分布在各代理上',
+        least_latency: '分配到探测延迟最低的代理',
+        same_country: '故障切换时优先选择与当前代理出口国家相同的代理'
+      },
+      columns: {
+        name: '名称',
+        policy: '策略',
+        members: '成员',
+        accounts: '账号数',
+        status: '状态',
+        actions: '操作'
+      },
+      empty: '暂无代理池',
+      emptyDesc: '创建代理池以在多个代理间轮换账号',
+      created: '代理池已创建',
+      updated: '代理池已更新',
+      deleted: '代理池已删除',
+      failedToLoad: '加载代理池失败',
+      failedToSave: '保存代理池失败',
+      failedToDelete: '删除代理池失败'
+    },
+
+    // 订阅套餐
+    subscriptionPlans: {
+      title: '订阅套餐',
+      description: '用户可在线购买、或到期前从余额自动续费的订阅套餐',
+      create: '创建套餐',
+      edit: '编辑套餐',
+      delete: '删除套餐',
+      deleteConfirm: '确定删除套餐「{name}」吗？已有订阅保持有效，但不再自动续费。',
+      allStatus: '全部状态',
+      statusActive: '在售',
+      statusDisabled: '已下架',
+      name: '名称',
+      descriptionLabel: '描述',
+      group: '订阅分组',
+      selectGroup: '请选择订阅分组',
+      groupHint: '购买套餐后开通或续期该分组的订阅',
+      price: '价格（美元）',
+      validityDays: '有效期（天）',
+      days: '{days} 天',
+      limits: '用量限额（美元）',
+      limitsHint: '日 / 周 / 月限额，留空或填 0 表示沿用分组限额',
+      dailyShort: '日',
+      weeklyShort: '周',
+      monthlyShort: '月',
+      fromGroup: '分组',
+      status: '状态',
+      sortOrder: '排序',
+      empty: '暂无订阅套餐',
+      emptyDesc: '创建套餐后用户即可在线购买订阅',
+      created: '套餐已创建',
+      updated: '套餐已更新',
+      deleted: '套餐已删除',
+      failedToLoad: '加载订阅套餐失败',
+      failedToSave: '保存套餐失败',
+      failedToDelete: '删除套餐失败',
+      columns: {
+        name: '套餐',
+        group: '分组',
+        price: '价格',
+        validity: '有效期',
+        limits: '限额',
+        status: '状态',
+        sortOrder: '排序',
+        actions: '操作'
+      }
+    },
+
+    // 模型价格
+    modelPrices: {
+      title: '模型价格',
+      description: '按全局或分组覆盖模型价格、预约调价，并控制未知模型的计费方式',
+      create: '新建价格',
+      edit: '编辑预约价格',
+      newVersion: '基于此价格新建版本',
+      delete: '删除价格',
+      deleteConfirm: "确定删除 '{model}' 的价格吗？计费将回退到上一版本或默认价格表。",
+      preview: '价格预览',
+      resolve: '解析',
+      unknownPolicy: '未知模型策略',
+      unknownPolicyHint: '当模型既没有价格覆盖、也不在 LiteLLM 价格表中时的计费方式。',
+      policy: '策略',
+      policies: {
+        fallback: '回退到同系列模型价格',
+        default_price: '使用默认价格',
+        reject: '拒绝请求'
+      },
+      policyHints: {
+        fallback: '按最接近的模型系列内置价格计费（现有行为）。',
+        default_price: '所有未知模型均按下方价格计费。',
+        reject: '对没有已知价格的模型返回 403，避免按猜测的价格计费。'
+      },
+      searchModel: '搜索模型...',
+      allScopes: '全部范围',
+      global: '全局',
+      groupScope: '分组',
+      scope: '范围',
+      model: '模型',
+      modelHint: '精确模型名，或以 * 结尾的前缀（如 claude-sonnet-4*）。精确匹配优先于前缀，分组价格优先于全局价格。',
+      prices: '价格（美元 / 百万 tokens）',
+      pricesHint: '依次为每百万 tokens 的输入、输出、缓存写入、缓存读取价格。',
+      inputShort: '输入',
+      outputShort: '输出',
+      cacheWriteShort: '缓存写入',
+      cacheReadShort: '缓存读取',
+      longContext: '长上下文档位',
+      longContextThreshold: '阈值（tokens）',
+      longContextHint: '当提示词（含缓存 tokens）超过阈值时，整个请求按该档位价格计费。',
+      effectiveFrom: '生效时间',
+      effectiveFromHint: '留空表示立即生效。已生效的价格不可修改，请新建版本。',
+      notes: '备注',
+      scheduled: '待生效',
+      source: '来源',
+      sources: {
+        override: '价格覆盖',
+        litellm: 'LiteLLM 价格表',
+        fallback: '模型系列回退',
+        default_price: '未知模型默认价格'
+      },
+      empty: '暂无价格覆盖',
+      emptyDesc: '所有模型均按 LiteLLM 价格表计费。',
+      created: '价格已创建',
+      updated: '价格已更新',
+      deleted: '价格已删除',
+      policySaved: '未知模型策略已保存',
+      failedToLoad: '加载模型价格失败',
+      failedToSave: '保存模型价格失败',
+      failedToDelete: '删除模型价格失败',
+      previewFailed: '解析价格失败',
+      columns: {
+        model: '模型',
+        scope: '范围',
+        prices: '输入 / 输出',
+        cache: '缓存',
+        longContext: '长上下文',
+        effectiveFrom: '生效时间',
+        actions: '操作'
+      }
+    },
+
+    // 组织管理
+    organizations: {
+      title: '组织管理',
+      description: '团队账户：共享钱包、成员共享 owner 名下的订阅、共享并发池，并可为成员设置月度花费上限',
+      create: '创建组织',
+      edit: '编辑组织',
+      delete: '删除组织',
+      deleteConfirm: '确定删除组织「{name}」吗？所有成员将被移出并恢复使用个人余额。',
+      searchPlaceholder: '搜索组织...',
+      allStatus: '全部状态',
+      statusActive: '启用',
+      statusDisabled: '禁用',
+      name: '名称',
+      ownerUserId: '所有者用户 ID',
+      ownerHint: '所有者不能已属于其他组织',
+      initialBalance: '初始钱包余额',
+      concurrency: '共享并发数',
+      zeroUnlimited: '0 表示不限制',
+      status: '状态',
+      notes: '备注',
+      members: '成员',
+      membersTitle: '{name} 的成员',
+      usage: '用量',
+      usageTitle: '{name} 的用量',
+      adjustBalance: '调整钱包',
+      currentBalance: '当前余额',
+      operation: '操作',
+      amount: '金额',
+      operations: {
+        add: '增加',
+        subtract: '扣减',
+        set: '设置为'
+      },
+      memberEmail: '成员邮箱',
+      role: '角色',
+      monthlyLimit: '月度上限（USD）',
+      monthlyUsage: '本月花费',
+      unlimited: '不限制',
+      addMember: '添加成员',
+      removeMember: '移除成员',
+      removeMemberConfirm: '确定将 {email} 移出该组织吗？',
+      columns: {
+        name: '名称',
+        owner: '所有者',
+        balance: '钱包',
+        concurrency: '并发',
+        members: '成员数',
+        member: '成员',
+        status: '状态',
+        createdAt: '创建时间',
+        actions: '操作'
+      },
+      created: '组织已创建',
+      updated: '组织已更新',
+      deleted: '组织已删除',
+      balanceAdjusted: '钱包余额已更新',
+      memberAdded: '成员已添加',
+      memberUpdated: '成员已更新',
+      memberRemoved: '成员已移除',
+      failedToLoad: '加载组织失败',
+      failedToCreate: '创建组织失败',
+      failedToUpdate: '更新组织失败',
+      failedToDelete: '删除组织失败',
+      failedToAdjustBalance: '调整钱包余额失败',
+      failedToLoadMembers: '加载成员失败',
+      failedToUpdateMember: '更新成员失败',
+      failedToLoadUsage: '加载用量失败'
+    },
+
     // Promo Codes
     promo: {
       title: '优惠码管理',
@@ -2202,6 +2805,7 @@ export default {
       allBillingTypes: '全部计费类型',
       billingTypeBalance: '钱包余额',
       billingTypeSubscription: '订阅套餐',
+      billingTypeResponseCache: '响应缓存',
       ipAddress: 'IP',
       cleanup: {
         button: '清理',
@@ -2234,6 +2838,29 @@ export default {
           failed: '失败',
           canceled: '已取消'
         }
+      },
+      export: {
+        button: '导出 CSV/JSONL',
+        title: '导出使用记录',
+        format: '格式',
+        destination: '导出位置',
+        destinationLocal: '服务器文件（完成后下载）',
+        destinationS3: '对象存储（S3）',
+        destinationHint: '仅在服务端配置对象存储后可用',
+        hint: '立即下载会直接流式输出文件，仅适用于较短的时间范围；大范围请创建后台导出任务，中断后会自动续跑。',
+        downloadNow: '立即下载',
+        downloading: '下载中...',
+        downloadFailed: '导出失败，时间范围较大时请创建后台导出任务',
+        createTask: '创建后台导出',
+        taskCreated: '导出任务已创建',
+        taskFailed: '创建导出任务失败',
+        deleteFailed: '删除导出任务失败',
+        recentTasks: '导出任务',
+        noTasks: '暂无导出任务',
+        nightly: '每日定时',
+        download: '下载',
+        rows: '行数',
+        size: '大小'
       }
     },
 
@@ -2455,7 +3082,7 @@ export default {
           internal: '内部'
         },
         total: '总计：',
-        searchPlaceholder: '搜索 request_id / client_request_id / message',
+        searchPlaceholder: '搜索 request_id / client_request_id / trace_id / message',
       },
       // Error Detail Modal
       errorDetail: {
@@ -2503,6 +3130,7 @@ export default {
         },
         loading: '加载中…',
         requestId: '请求 ID',
+        traceId: '链路追踪 ID',
         time: '时间',
         phase: '阶段',
         status: '状态码',
@@ -2599,7 +3227,7 @@ export default {
       },
       alertEvents: {
         title: '告警事件',
-        description: '最近的告警触发/恢复记录（仅邮件通知）',
+        description: '最近的告警触发/恢复记录',
         loading: '加载中...',
         empty: '暂无告警事件',
         loadFailed: '加载告警事件失败',
@@ -2628,7 +3256,9 @@ export default {
           historyTitle: '历史记录',
           historyHint: '同一规则 + 相同维度的最近事件',
           historyLoading: '加载历史中...',
-          historyEmpty: '暂无历史记录'
+          historyEmpty: '暂无历史记录',
+          notifications: '渠道投递',
+          notificationsEmpty: '暂无渠道投递记录'
         },
         table: {
           time: '时间',
@@ -2647,7 +3277,7 @@ export default {
       },
       alertRules: {
         title: '告警规则',
-        description: '创建与管理系统阈值告警（仅邮件通知）',
+        description: '创建与管理系统阈值告警',
         loading: '加载中...',
         empty: '暂无告警规则',
         loadFailed: '加载告警规则失败',
@@ -2681,7 +3311,8 @@ export default {
           accountRateLimitedCount: '限流账号数',
           accountErrorCount: '错误账号数（不含临时不可调度）',
           accountErrorRatio: '错误账号比例 (%)',
-          overloadAccountCount: '过载账号数'
+          overloadAccountCount: '过载账号数',
+          balanceLedgerDriftUsers: '余额账本不一致用户数'
         },
         metricDescriptions: {
           successRate: '统计窗口内成功请求占比（0~100）。',
@@ -2698,12 +3329,14 @@ export default {
           accountRateLimitedCount: '统计窗口内被限流的账号数量。',
           accountErrorCount: '统计窗口内产生错误的账号数量（不含临时不可调度）。',
           accountErrorRatio: '统计窗口内错误账号占比（0~100）。',
-          overloadAccountCount: '统计窗口内过载账号数量。'
+          overloadAccountCount: '统计窗口内过载账号数量。',
+          balanceLedgerDriftUsers: '余额流水合计与用户余额不一致的用户数量（每 10 分钟对账一次，忽略平台/分组过滤）。'
         },
         hints: {
           recommended: '推荐：运算符 {operator}，阈值 {threshold}{unit}',
           groupRequired: '该指标为分组级别指标，必须选择分组（group_id）。',
-          groupOptional: '可选：通过 group_id 将规则限定到某个分组。'
+          groupOptional: '可选：通过 group_id 将规则限定到某个分组。',
+          noChannels: '暂无通知渠道，可在下方「通知渠道」中添加。'
         },
         table: {
           name: '名称',
@@ -2726,7 +3359,8 @@ export default {
           sustained: '连续样本数（每分钟）',
           cooldown: '冷却期（分钟）',
           enabled: '启用',
-          notifyEmail: '发送邮件通知'
+          notifyEmail: '发送邮件通知',
+          notifyChannels: '通知渠道'
         },
         validation: {
           title: '请先修正以下问题',
@@ -2741,6 +3375,174 @@ export default {
           cooldownRange: '冷却期必须在 0 到 1440 分钟之间'
         }
       },
+      payloadCapture: {
+        title: '请求抓取',
+        manage: '请求抓取',
+        scopes: {
+          api_key: 'API 密钥',
+          user: '用户',
+          group: '分组'
+        },
+        rules: {
+          title: '抓取规则',
+          description: '为指定用户、API 密钥或分组开启完整请求/响应抓取以便排查问题。抓取内容会脱敏并自动过期。',
+          loading: '加载中...',
+          empty: '暂无抓取规则',
+          create: '新建规则',
+          createTitle: '新建抓取规则',
+          editTitle: '编辑抓取规则',
+          privacyHint: '抓取内容可能包含终端用户数据。凭证和常见密钥会被遮蔽，但请尽量降低采样率并缩短保留时间。',
+          expiresAt: '{time} 停止',
+          expired: '已过期',
+          ttlValue: '保留 {hours} 小时',
+          table: {
+            name: '名称',
+            scope: '范围',
+            sampling: '采样 / 保留',
+            captures: '抓取数',
+            status: '状态',
+            actions: '操作'
+          },
+          form: {
+            name: '名称',
+            scopeType: '范围',
+            scopeId: '范围 ID',
+            sampleRate: '采样率',
+            ttlHours: '保留时长（小时）',
+            expiresAt: '停止抓取时间',
+            redactKeys: '额外脱敏字段',
+            enabled: '启用'
+          },
+          hints: {
+            sampleRate: '匹配请求中被抓取的比例，取值 0~1（1 表示全部抓取）。',
+            ttlHours: '抓取记录在该时长后自动删除（最多 720 小时）。',
+            expiresAt: '可选，超过该时间后规则不再生效。',
+            redactKeys: '每行一个。除内置列表外，同名的 JSON 字段和请求头也会被遮蔽。'
+          },
+          validation: {
+            nameRequired: '名称不能为空',
+            scopeIdRequired: '范围 ID 必须为正整数',
+            sampleRateRange: '采样率必须大于 0 且不超过 1',
+            ttlRange: '保留时长必须在 1~720 小时之间'
+          },
+          saveSuccess: '抓取规则已保存',
+          saveFailed: '保存抓取规则失败',
+          loadFailed: '加载抓取规则失败',
+          deleteSuccess: '抓取规则已删除',
+          deleteFailed: '删除抓取规则失败',
+          deleteConfirmTitle: '删除抓取规则',
+          deleteConfirmMessage: '确定删除该抓取规则吗？已有抓取记录会保留到过期。'
+        },
+        captures: {
+          title: '抓取记录',
+          description: '已脱敏的请求/响应。流式响应会被重组为最终响应对象。',
+          loading: '加载中...',
+          empty: '暂无抓取记录',
+          view: '查看',
+          truncated: '已截断',
+          reassembled: '由流式响应重组',
+          filters: {
+            ruleId: '规则 ID',
+            model: '模型'
+          },
+          table: {
+            time: '时间',
+            request: '请求',
+            user: '用户',
+            account: '账号',
+            status: '状态',
+            size: '请求 / 响应',
+            actions: '操作'
+          },
+          fields: {
+            requestId: '请求 ID',
+            status: '状态',
+            expiresAt: '过期时间',
+            userAgent: 'User Agent',
+            requestHeaders: '请求头',
+            requestBody: '请求体',
+            responseBody: '响应体'
+          },
+          detailTitle: '抓取详情',
+          detailFailed: '加载抓取详情失败',
+          replayTitle: '重放',
+          replayHint: '重新发送抓取到的请求。账号留空时将从原分组重新选择账号。',
+          replayAccountId: '账号 ID（可选）',
+          replay: '重放',
+          replaying: '重放中...',
+          replaySuccess: '重放成功',
+          replayFailed: '重放失败',
+          usedAccount: '账号 #{id}',
+          loadFailed: '加载抓取记录失败',
+          deleteSuccess: '抓取记录已删除',
+          deleteFailed: '删除抓取记录失败',
+          deleteConfirmTitle: '删除抓取记录',
+          deleteConfirmMessage: '确定删除该抓取记录吗？'
+        }
+      },
+      notificationChannels: {
+        title: '通知渠道',
+        description: '告警规则与定时报告可投递的 Webhook 及聊天渠道。',
+        loading: '加载中...',
+        empty: '暂无通知渠道',
+        loadFailed: '加载通知渠道失败',
+        saveFailed: '保存通知渠道失败',
+        saveSuccess: '通知渠道已保存',
+        deleteFailed: '删除通知渠道失败',
+        deleteSuccess: '通知渠道已删除',
+        create: '添加渠道',
+        createTitle: '添加通知渠道',
+        editTitle: '编辑通知渠道',
+        deleteConfirmTitle: '确定删除该渠道？',
+        deleteConfirmMessage: '使用该渠道的告警规则将不再向其发送通知，是否继续？',
+        test: '测试',
+        testing: '发送中...',
+        testSuccess: '测试消息已发送',
+        testFailed: '测试消息发送失败：{error}',
+        types: {
+          webhook: 'Webhook',
+          slack: 'Slack',
+          discord: 'Discord',
+          telegram: 'Telegram',
+          dingtalk: '钉钉',
+          feishu: '飞书'
+        },
+        table: {
+          name: '名称',
+          type: '类型',
+          target: '目标',
+          enabled: '启用',
+          actions: '操作'
+        },
+        form: {
+          name: '名称',
+          type: '类型',
+          url: 'URL',
+          botToken: 'Bot Token',
+          chatId: 'Chat ID',
+          secret: '签名密钥',
+          headers: '附加请求头（每行一个 "Key: Value"）',
+          enabled: '启用',
+          sendResolved: '发送恢复通知',
+          sendReports: '发送定时报告',
+          keepUnchanged: '已配置，留空则保持不变'
+        },
+        hints: {
+          webhook: '以 JSON POST 推送，投递失败最多重试 3 次。',
+          slack: 'Slack Incoming Webhook 地址。',
+          discord: 'Discord 频道 Webhook 地址。',
+          dingtalk: '钉钉自定义机器人 Webhook 地址。',
+          feishu: '飞书自定义机器人 Webhook 地址。',
+          webhookSecret: '可选：使用 HMAC-SHA256 对请求体签名，写入 X-Sub2API-Signature 请求头。',
+          botSecret: '可选：机器人开启签名校验时必填。'
+        },
+        validation: {
+          nameRequired: '名称不能为空',
+          urlRequired: 'URL 不能为空',
+          botTokenRequired: 'Bot Token 不能为空',
+          chatIdRequired: 'Chat ID 不能为空'
+        }
+      },
       runtime: {
         title: '运维监控运行设置',
         description: '配置存储在数据库中，无需修改 config 文件即可生效。',
@@ -2970,6 +3772,36 @@ export default {
       }
     },
 
+    // Admin roles & permissions
+    roles: {
+      admin: '超级管理员',
+      support: '客服',
+      finance: '财务',
+      ops: '运维',
+      user: '用户',
+      permissions: {
+        all: '全部权限',
+        dashboard_view: '查看仪表盘',
+        users_view: '查看用户',
+        users_manage: '管理用户',
+        roles_manage: '管理管理员角色',
+        balance_adjust: '调整余额（限额）',
+        balance_adjust_unlimited: '调整余额（不限额）',
+        usage_view: '查看使用记录',
+        usage_manage: '管理使用记录清理',
+        billing_manage: '兑换码/优惠码、订阅与账本',
+        groups_view: '查看分组',
+        groups_manage: '管理分组',
+        accounts_manage: '管理账号',
+        proxies_manage: '管理代理',
+        ops_manage: '运维监控与重试',
+        settings_manage: '系统设置',
+        system_manage: '系统维护',
+        audit_view: '查看审计日志',
+        admin_keys_manage: '管理管理员 API Key'
+      }
+    },
+
     // Settings
     settings: {
       title: '系统设置',
@@ -3040,7 +3872,49 @@ export default {
         rateMultiplierHint: '用户支付 $1 获得的余额（例如 10 表示充 $1 得 $10）',
         successUrl: '支付成功跳转 URL',
         successUrlPlaceholder: 'https://your-domain.com/redeem?payment=success',
-        successUrlHint: '用户支付成功后跳转的页面地址'
+        successUrlHint: '用户支付成功后跳转的页面地址（留空则跳转到充值订单页）'
+      },
+      stripe: {
+        title: 'Stripe 支付集成',
+        description: '通过 Stripe Checkout 接收银行卡付款',
+        enable: '启用 Stripe 支付',
+        enableHint: '在充值页面显示 Stripe 渠道',
+        secretKey: 'Secret Key',
+        secretKeyPlaceholder: 'sk_live_xxx...',
+        secretKeyConfiguredPlaceholder: '********',
+        secretKeyHint: '在 Stripe 后台 Developers > API keys 获取（测试密钥以 sk_test_ 开头）',
+        webhookSecret: 'Webhook 签名密钥',
+        webhookSecretPlaceholder: 'whsec_xxx...',
+        webhookSecretConfiguredPlaceholder: '********',
+        webhookSecretHint: 'Webhook 端点的签名密钥，用于校验 Stripe-Signature',
+        currency: '币种',
+        currencyHint: '用户支付使用的三位 ISO 币种代码（如 usd、eur）',
+        rateMultiplier: '充值倍率',
+        rateMultiplierHint: '每支付 1 单位币种到账的美元余额',
+        webhookUrlHint: 'Webhook 地址（订阅 checkout.session.* 与 charge.refunded 事件）：'
+      },
+      epay: {
+        title: '易支付集成',
+        description: '通过兼容易支付协议的聚合网关接收支付宝 / 微信支付',
+        enable: '启用易支付',
+        enableHint: '在充值页面显示易支付渠道',
+        gatewayUrl: '网关地址',
+        gatewayUrlPlaceholder: 'https://pay.example.com',
+        gatewayUrlHint: '网关根地址，用户将跳转到其 /submit.php 页面',
+        merchantId: '商户 ID (pid)',
+        merchantIdPlaceholder: '1001',
+        merchantIdHint: '网关分配的商户号',
+        merchantKey: '商户密钥',
+        merchantKeyPlaceholder: '商户密钥',
+        merchantKeyConfiguredPlaceholder: '********',
+        merchantKeyHint: '用于下单签名及校验异步通知（MD5）',
+        payTypes: '支付方式',
+        payTypesHint: '网关支持的支付方式代码，逗号分隔，如 alipay,wxpay',
+        currency: '币种',
+        currencyHint: '网关收款使用的币种',
+        rateMultiplier: '充值倍率',
+        rateMultiplierHint: '每支付 1 单位币种到账的美元余额（如 0.14 表示 1 元 = $0.14）',
+        webhookUrlHint: '异步通知地址（下单时自动提交）：'
       },
       defaults: {
         title: '用户默认设置',
@@ -3132,24 +4006,27 @@ export default {
       },
       adminApiKey: {
         title: '管理员 API Key',
-        description: '用于外部系统集成的全局 API Key，拥有完整的管理员权限',
-        notConfigured: '尚未配置管理员 API Key',
-        configured: '管理员 API Key 已启用',
-        currentKey: '当前密钥',
-        regenerate: '重新生成',
-        regenerating: '生成中...',
-        delete: '删除',
-        deleting: '删除中...',
+        description: '用于外部系统集成的命名 API Key，每个 Key 仅拥有所选权限',
+        notConfigured: '暂无管理员 API Key',
+        name: '名称',
+        namePlaceholder: '例如 billing-sync',
+        scopes: '权限',
+        expiresAt: '过期时间',
+        expiresAtHint: '留空表示永不过期',
+        neverExpires: '永不过期',
+        lastUsedAt: '最近使用',
+        neverUsed: '从未使用',
+        expired: '已过期',
+        revoke: '吊销',
         create: '创建密钥',
         creating: '创建中...',
-        regenerateConfirm: '确定要重新生成吗？当前密钥将立即失效。',
-        deleteConfirm: '确定要删除管理员 API Key 吗？外部集成将停止工作。',
+        revokeConfirm: '确定要吊销管理员 API Key「{name}」吗？使用该 Key 的集成将立即失效。',
         keyGenerated: '新的管理员 API Key 已生成',
-        keyDeleted: '管理员 API Key 已删除',
+        keyDeleted: '管理员 API Key 已吊销',
         copyKey: '复制密钥',
         keyCopied: '密钥已复制到剪贴板',
         keyWarning: '此密钥仅显示一次，请立即复制保存。',
-        securityWarning: '警告：此密钥拥有完整的管理员权限，请妥善保管。',
+        securityWarning: '警告：管理员 API Key 拥有所授予的全部权限，请仅授予必要权限并妥善保管。',
         usage: '使用方法：在请求头中添加 x-api-key: <your-admin-api-key>'
       },
       streamTimeout: {
@@ -3250,7 +4127,180 @@ export default {
     expiresOn: '{date} 到期',
     resetIn: '{time} 后重置',
     windowNotActive: '等待首次使用',
-    usageOf: '已用 {used} / {limit}'
+    usageOf: '已用 {used} / {limit}',
+    autoRenew: '自动续费',
+    autoRenewHint: '到期前 24 小时从余额扣除套餐价格续期',
+    autoRenewEnabled: '已开启自动续费',
+    autoRenewDisabled: '已关闭自动续费',
+    autoRenewFailed: '设置自动续费失败',
+    plans: {
+      title: '订阅套餐',
+      description: '购买套餐以开通或续期订阅',
+      validity: '{days} 天',
+      payAmount: '需支付 {amount}',
+      buy: '购买',
+      renew: '续费'
+    }
+  },
+
+  // 余额明细（账本）
+  balanceLedger: {
+    title: '余额明细',
+    description: '余额的每一笔入账与扣费',
+    empty: '暂无记录',
+    allSources: '全部来源',
+    failedToLoad: '加载余额明细失败',
+    actor: '管理员 #{id}',
+    columns: {
+      time: '时间',
+      source: '来源',
+      amount: '金额',
+      balanceAfter: '变动后余额',
+      reference: '关联单据'
+    },
+    sources: {
+      usage: 'API 调用扣费',
+      admin_adjustment: '管理员调整',
+      redeem_code: '兑换码',
+      promo_code: '优惠码',
+      creem_checkout: '在线充值',
+      payment_order: '在线充值',
+      payment_refund: '充值退款',
+      initial: '初始余额',
+      opening_balance: '期初余额',
+      subscription_renewal: '订阅自动续费'
+    }
+  },
+
+  // 充值订单（用户）
+  paymentOrders: {
+    title: '充值订单',
+    description: '在线充值订单及支付状态',
+    topUp: '去充值',
+    empty: '暂无充值订单',
+    emptyDesc: '发起在线充值后订单会显示在这里',
+    failedToLoad: '加载充值订单失败',
+    continuePayment: '继续支付',
+    pendingHint: '暂未收到支付确认。渠道通知到达后会自动入账，请稍后刷新查看。',
+    returnOrder: '订单 {orderNo} · 到账 {amount}',
+    returnPlanOrder: '订单 {orderNo} · 套餐 {plan}',
+    returnStatus: {
+      pending: '正在等待支付确认...',
+      paid: '支付成功，余额已到账',
+      failed: '支付失败或已过期',
+      refunded: '该订单已退款',
+      planPaid: '支付成功，订阅已开通'
+    },
+    statuses: {
+      pending: '待支付',
+      paid: '已支付',
+      failed: '失败',
+      refunded: '已退款'
+    },
+    providers: {
+      creem: 'Creem',
+      stripe: 'Stripe',
+      epay: '易支付'
+    },
+    methods: {
+      alipay: '支付宝',
+      wxpay: '微信支付',
+      qqpay: 'QQ 钱包'
+    },
+    columns: {
+      orderNo: '订单号',
+      provider: '支付渠道',
+      amount: '支付金额',
+      credit: '到账余额',
+      status: '状态',
+      createdAt: '创建时间',
+      actions: '操作'
+    }
+  },
+
+  // 发票（用户）
+  invoices: {
+    title: '我的发票',
+    description: '按月开具的用量与订阅发票',
+    empty: '暂无发票',
+    emptyDesc: '每月结束后开具上月发票',
+    failedToLoad: '加载发票失败',
+    failedToDownload: '下载发票失败',
+    personal: '个人',
+    view: '查看',
+    downloadPdf: '下载 PDF',
+    downloadHtml: '下载 HTML',
+    detailTitle: '发票 {number}',
+    billTo: '收票方',
+    period: '账期',
+    issuedAt: '开具于 {date}',
+    noCharges: '本账期无费用',
+    subscriptionCovered: '由订阅额度抵扣',
+    subtotal: '小计',
+    tax: '税费',
+    total: '合计',
+    topUps: '本账期充值',
+    topUpsHint: '仅供参考，不计入发票合计',
+    columns: {
+      number: '发票编号',
+      period: '账期',
+      billedTo: '收票方',
+      total: '合计',
+      issuedAt: '开具时间',
+      actions: '操作',
+      description: '项目',
+      requests: '请求数',
+      tokens: 'Token 数',
+      amount: '金额'
+    }
+  },
+
+  // 组织（成员自助）
+  organization: {
+    title: '我的组织',
+    description: '团队共享钱包、成员与用量',
+    notInOrganization: '未加入组织',
+    notInOrganizationDesc: '您尚未加入任何组织。请联系组织所有者或管理员将您添加为成员。',
+    yourRole: '您的角色',
+    statusActive: '启用',
+    statusDisabled: '禁用',
+    walletBalance: '共享钱包',
+    sharedConcurrency: '共享并发',
+    myMonthlySpend: '本月我的花费',
+    unlimited: '不限制',
+    members: '成员',
+    member: '成员',
+    memberEmail: '成员邮箱',
+    role: '角色',
+    monthlyLimit: '月度上限（USD）',
+    monthlyUsage: '本月花费',
+    addMember: '添加成员',
+    removeMember: '移除成员',
+    removeMemberConfirm: '确定将 {email} 移出组织吗？',
+    memberAdded: '成员已添加',
+    memberUpdated: '成员已更新',
+    memberRemoved: '成员已移除',
+    failedToLoad: '加载组织失败',
+    failedToLoadUsage: '加载用量失败',
+    failedToUpdateMember: '更新成员失败',
+    roles: {
+      owner: '所有者',
+      admin: '管理员',
+      member: '成员'
+    },
+    usage: {
+      title: '成员用量',
+      member: '成员',
+      requests: '请求数',
+      inputTokens: '输入',
+      outputTokens: '输出',
+      cacheTokens: '缓存',
+      actualCost: '费用',
+      standardCost: '标准费用',
+      totalRequests: '总请求数',
+      formerMember: '已离开的成员',
+      empty: '该时间段内暂无用量'
+    }
   },
 
   // Onboarding Tour
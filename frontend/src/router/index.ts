@@ -175,6 +175,54 @@ const routes: RouteRecordRaw[] = [
       descriptionKey: 'userSubscriptions.description'
     }
   },
+  {
+    path: '/transactions',
+    name: 'Transactions',
+    component: () => import('@/views/user/TransactionsView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: false,
+      title: 'Balance History',
+      titleKey: 'balanceLedger.title',
+      descriptionKey: 'balanceLedger.description'
+    }
+  },
+  {
+    path: '/invoices',
+    name: 'Invoices',
+    component: () => import('@/views/user/InvoicesView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: false,
+      title: 'My Invoices',
+      titleKey: 'invoices.title',
+      descriptionKey: 'invoices.description'
+    }
+  },
+  {
+    path: '/payment-orders',
+    name: 'PaymentOrders',
+    component: () => import('@/views/user/PaymentOrdersView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: false,
+      title: 'Top-up Orders',
+      titleKey: 'paymentOrders.title',
+      descriptionKey: 'paymentOrders.description'
+    }
+  },
+  {
+    path: '/organization',
+    name: 'Organization',
+    component: () => import('@/views/user/OrganizationView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: false,
+      title: 'My Organization',
+      titleKey: 'organization.title',
+      descriptionKey: 'organization.description'
+    }
+  },
 
   // ==================== Admin Routes ====================
   {
@@ -188,6 +236,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'dashboard:view',
       title: 'Admin Dashboard',
       titleKey: 'admin.dashboard.title',
       descriptionKey: 'admin.dashboard.description'
@@ -200,6 +249,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'ops:manage',
       title: 'Ops Monitoring',
       titleKey: 'admin.ops.title',
       descriptionKey: 'admin.ops.description'
@@ -212,6 +262,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'users:view',
       title: 'User Management',
       titleKey: 'admin.users.title',
       descriptionKey: 'admin.users.description'
@@ -224,6 +275,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'groups:view',
       title: 'Group Management',
       titleKey: 'admin.groups.title',
       descriptionKey: 'admin.groups.description'
@@ -236,11 +288,77 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'billing:manage',
       title: 'Subscription Management',
       titleKey: 'admin.subscriptions.title',
       descriptionKey: 'admin.subscriptions.description'
     }
   },
+  {
+    path: '/admin/organizations',
+    name: 'AdminOrganizations',
+    component: () => import('@/views/admin/OrganizationsView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'billing:manage',
+      title: 'Organization Management',
+      titleKey: 'admin.organizations.title',
+      descriptionKey: 'admin.organizations.description'
+    }
+  },
+  {
+    path: '/admin/invoices',
+    name: 'AdminInvoices',
+    component: () => import('@/views/admin/InvoicesView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'billing:manage',
+      title: 'Invoice Management',
+      titleKey: 'admin.invoices.title',
+      descriptionKey: 'admin.invoices.description'
+    }
+  },
+  {
+    path: '/admin/subscription-plans',
+    name: 'AdminSubscriptionPlans',
+    component: () => import('@/views/admin/SubscriptionPlansView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'billing:manage',
+      title: 'Subscription Plans',
+      titleKey: 'admin.subscriptionPlans.title',
+      descriptionKey: 'admin.subscriptionPlans.description'
+    }
+  },
+  {
+    path: '/admin/model-prices',
+    name: 'AdminModelPrices',
+    component: () => import('@/views/admin/ModelPricesView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'billing:manage',
+      title: 'Model Prices',
+      titleKey: 'admin.modelPrices.title',
+      descriptionKey: 'admin.modelPrices.description'
+    }
+  },
+  {
+    path: '/admin/payment-orders',
+    name: 'AdminPaymentOrders',
+    component: () => import('@/views/admin/PaymentOrdersView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'billing:manage',
+      title: 'Top-up Orders',
+      titleKey: 'admin.paymentOrders.title',
+      descriptionKey: 'admin.paymentOrders.description'
+    }
+  },
   {
     path: '/admin/accounts',
     name: 'AdminAccounts',
@@ -248,6 +366,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'accounts:manage',
       title: 'Account Management',
       titleKey: 'admin.accounts.title',
       descriptionKey: 'admin.accounts.description'
@@ -260,11 +379,25 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'proxies:manage',
       title: 'Proxy Management',
       titleKey: 'admin.proxies.title',
       descriptionKey: 'admin.proxies.description'
     }
   },
+  {
+    path: '/admin/proxy-pools',
+    name: 'AdminProxyPools',
+    component: () => import('@/views/admin/ProxyPoolsView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'proxies:manage',
+      title: 'Proxy Pools',
+      titleKey: 'admin.proxyPools.title',
+      descriptionKey: 'admin.proxyPools.description'
+    }
+  },
   {
     path: '/admin/redeem',
     name: 'AdminRedeem',
@@ -272,6 +405,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'billing:manage',
       title: 'Redeem Code Management',
       titleKey: 'admin.redeem.title',
       descriptionKey: 'admin.redeem.description'
@@ -284,11 +418,25 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'billing:manage',
       title: 'Promo Code Management',
       titleKey: 'admin.promo.title',
       descriptionKey: 'admin.promo.description'
     }
   },
+  {
+    path: '/admin/audit-logs',
+    name: 'AdminAuditLogs',
+    component: () => import('@/views/admin/AuditLogsView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: true,
+      permission: 'audit:view',
+      title: 'Audit Logs',
+      titleKey: 'admin.auditLogs.title',
+      descriptionKey: 'admin.auditLogs.description'
+    }
+  },
   {
     path: '/admin/settings',
     name: 'AdminSettings',
@@ -296,6 +444,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'settings:manage',
       title: 'System Settings',
       titleKey: 'admin.settings.title',
       descriptionKey: 'admin.settings.description'
@@ -308,6 +457,7 @@ const routes: RouteRecordRaw[] = [
     meta: {
       requiresAuth: true,
       requiresAdmin: true,
+      permission: 'usage:view',
       title: 'Usage Records',
       titleKey: 'admin.usage.title',
       descriptionKey: 'admin.usage.description'
@@ -405,14 +555,27 @@ router.beforeEach((to, _from, next) => {
     return
   }
 
+  // Check admin permission (support / finance / ops roles only see part of the admin area)
+  if (to.meta.permission && !authStore.hasPermission(to.meta.permission)) {
+    next(to.path !== '/admin/dashboard' && authStore.hasPermission('dashboard:view') ? '/admin/dashboard' : '/dashboard')
+    return
+  }
+
   // 简易模式下限制访问某些页面
   if (authStore.isSimpleMode) {
     const restrictedPaths = [
       '/admin/groups',
       '/admin/subscriptions',
+      '/admin/organizations',
+      '/admin/invoices',
+      '/admin/payment-orders',
       '/admin/redeem',
       '/subscriptions',
-      '/redeem'
+      '/organization',
+      '/redeem',
+      '/transactions',
+      '/invoices',
+      '/payment-orders'
     ]
 
     if (restrictedPaths.some((path) => to.path.startsWith(path))) {
@@ -19,6 +19,11 @@ declare module 'vue-router' {
      */
     requiresAdmin?: boolean
 
+    /**
+     * Admin permission required for this route (e.g. 'users:view'), checked after requiresAdmin
+     */
+    permission?: string
+
     /**
      * Page title for this route
      */
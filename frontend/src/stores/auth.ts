@@ -11,6 +11,7 @@ import type { User, LoginRequest, RegisterRequest, AuthResponse } from '@/types'
 const AUTH_TOKEN_KEY = 'auth_token'
 const AUTH_USER_KEY = 'auth_user'
 const AUTO_REFRESH_INTERVAL = 60 * 1000 // 60 seconds
+const ADMIN_ROLES: string[] = ['admin', 'support', 'finance', 'ops']
 
 export const useAuthStore = defineStore('auth', () => {
   // ==================== State ====================
@@ -26,10 +27,24 @@ export const useAuthStore = defineStore('auth', () => {
     return !!token.value && !!user.value
   })
 
+  // Any admin-area role (super admin, support, finance, ops)
   const isAdmin = computed(() => {
-    return user.value?.role === 'admin'
+    return !!user.value && ADMIN_ROLES.includes(user.value.role)
   })
 
+  const isSuperAdmin = computed(() => user.value?.role === 'admin')
+
+  /**
+   * Check an admin permission (e.g. 'users:view').
+   * Permissions come from /auth/me; super admins hold everything even before the first refresh.
+   */
+  function hasPermission(permission: string): boolean {
+    if (!user.value) return false
+    if (user.value.role === 'admin') return true
+    const perms = user.value.permissions || []
+    return perms.includes('*') || perms.includes(permission)
+  }
+
   const isSimpleMode = computed(() => runMode.value === 'simple')
 
   // ==================== Actions ====================
@@ -106,6 +121,7 @@ export const useAuthStore = defineStore('auth', () => {
 
       // Set auth state from the response
       setAuthFromResponse(response)
+      await loadStaffPermissions()
 
       return response
     } catch (error) {
@@ -126,6 +142,7 @@ export const useAuthStore = defineStore('auth', () => {
     try {
       const response = await authAPI.login2FA({ temp_token: tempToken, totp_code: totpCode })
       setAuthFromResponse(response)
+      await loadStaffPermissions()
       return user.value!
     } catch (error) {
       clearAuth()
@@ -156,6 +173,19 @@ export const useAuthStore = defineStore('auth', () => {
     startAutoRefresh()
   }
 
+  /**
+   * Login responses don't carry admin permissions; fetch them from /auth/me for staff roles
+   * Internal helper function
+   */
+  async function loadStaffPermissions(): Promise<void> {
+    if (!isAdmin.value || isSuperAdmin.value) return
+    try {
+      await refreshUser()
+    } catch (error) {
+      console.error('Failed to load admin permissions:', error)
+    }
+  }
+
   /**
    * User registration
    * @param userData - Registration data (username, email, password)
@@ -281,6 +311,7 @@ export const useAuthStore = defineStore('auth', () => {
     // Computed
     isAuthenticated,
     isAdmin,
+    isSuperAdmin,
     isSimpleMode,
 
     // Actions
@@ -290,6 +321,7 @@ export const useAuthStore = defineStore('auth', () => {
     setToken,
     logout,
     checkAuth,
-    refreshUser
+    refreshUser,
+    hasPermission
   }
 })
@@ -24,16 +24,27 @@ export interface FetchOptions {
 
 // ==================== User & Auth Types ====================
 
-export interface User {
+// RPM / TPM limits shared by users, groups and API keys (0 = unlimited)
+export interface RequestRateLimits {
+  rpm_limit: number
+  input_tpm_limit: number
+  output_tpm_limit: number
+}
+
+// 'admin' is the super admin; support / finance / ops see a subset of the admin area
+export type UserRole = 'admin' | 'support' | 'finance' | 'ops' | 'user'
+
+export interface User extends RequestRateLimits {
   id: number
   username: string
   email: string
-  role: 'admin' | 'user' // User role for authorization
+  role: UserRole // User role for authorization
   balance: number // User balance for API usage
   concurrency: number // Allowed concurrent requests
   status: 'active' | 'disabled' // Account status
   allowed_groups: number[] | null // Allowed group IDs (null = all non-exclusive groups)
   subscriptions?: UserSubscription[] // User's active subscriptions
+  permissions?: string[] // Admin permissions (only returned by /auth/me for admin-area roles)
   created_at: string
   updated_at: string
 }
@@ -257,7 +268,13 @@ export type GroupPlatform = 'anthropic' | 'openai' | 'gemini' | 'antigravity'
 
 export type SubscriptionType = 'standard' | 'subscription'
 
-export interface Group {
+// 确定性请求（temperature=0）的精确响应缓存配置，TTL 为 0 表示使用上级或全局默认值
+export interface ResponseCacheSettings {
+  response_cache_enabled: boolean
+  response_cache_ttl_seconds: number
+}
+
+export interface Group extends RequestRateLimits, ResponseCacheSettings {
   id: number
   name: string
   description: string | null
@@ -276,6 +293,8 @@ export interface Group {
   // Claude Code 客户端限制
   claude_code_only: boolean
   fallback_group_id: number | null
+  // 响应缓存命中时按正常费用的该比例计费（0 = 免费）
+  response_cache_cost_ratio: number
   created_at: string
   updated_at: string
 }
@@ -289,7 +308,7 @@ export interface AdminGroup extends Group {
   account_count?: number
 }
 
-export interface ApiKey {
+export interface ApiKey extends RequestRateLimits, ResponseCacheSettings {
   id: number
   user_id: number
   key: string
@@ -298,12 +317,34 @@ export interface ApiKey {
   status: 'active' | 'inactive'
   ip_whitelist: string[]
   ip_blacklist: string[]
+  allowed_models: string[] | null
+  model_aliases: Record<string, string> | null
+  quota_usd: number | null
+  daily_limit_usd: number | null
+  monthly_limit_usd: number | null
+  expires_at: string | null
+  quota_used_usd: number
+  daily_usage_usd: number
+  monthly_usage_usd: number
   created_at: string
   updated_at: string
   group?: Group
 }
 
-export interface CreateApiKeyRequest {
+export interface ApiKeyLimits {
+  quota_usd?: number // 0 clears the cap on update
+  daily_limit_usd?: number
+  monthly_limit_usd?: number
+  expires_at?: string // RFC3339; empty string clears on update
+}
+
+export interface ApiKeyModelRules {
+  allowed_models?: string[] // glob patterns; empty array clears on update
+  model_aliases?: Record<string, string> // alias -> model; empty object clears on update
+}
+
+export interface CreateApiKeyRequest
+  extends ApiKeyLimits, ApiKeyModelRules, Partial<RequestRateLimits>, Partial<ResponseCacheSettings> {
   name: string
   group_id?: number | null
   custom_key?: string // Optional custom API Key
@@ -311,7 +352,8 @@ export interface CreateApiKeyRequest {
   ip_blacklist?: string[]
 }
 
-export interface UpdateApiKeyRequest {
+export interface UpdateApiKeyRequest
+  extends ApiKeyLimits, ApiKeyModelRules, Partial<RequestRateLimits>, Partial<ResponseCacheSettings> {
   name?: string
   group_id?: number | null
   status?: 'active' | 'inactive'
@@ -319,7 +361,8 @@ export interface UpdateApiKeyRequest {
   ip_blacklist?: string[]
 }
 
-export interface CreateGroupRequest {
+export interface CreateGroupRequest
+  extends Partial<RequestRateLimits>, Partial<ResponseCacheSettings> {
   name: string
   description?: string | null
   platform?: GroupPlatform
@@ -334,9 +377,11 @@ export interface CreateGroupRequest {
   image_price_4k?: number | null
   claude_code_only?: boolean
   fallback_group_id?: number | null
+  response_cache_cost_ratio?: number
 }
 
-export interface UpdateGroupRequest {
+export interface UpdateGroupRequest
+  extends Partial<RequestRateLimits>, Partial<ResponseCacheSettings> {
   name?: string
   description?: string | null
   platform?: GroupPlatform
@@ -352,6 +397,7 @@ export interface UpdateGroupRequest {
   image_price_4k?: number | null
   claude_code_only?: boolean
   fallback_group_id?: number | null
+  response_cache_cost_ratio?: number
 }
 
 // ==================== Account & Proxy Types ====================
@@ -387,10 +433,100 @@ export interface Proxy {
   country_code?: string
   region?: string
   city?: string
+  pool_id?: number | null // Proxy pool this proxy belongs to
+  subscription_id?: number | null // Proxy subscription this proxy was imported from
+  health_status?: ProxyHealthStatus
+  health_message?: string
+  error_rate?: number // Failure ratio within the health statistics window (0-1)
+  consecutive_failures?: number
+  forward_errors?: number // Proxy connect errors seen while forwarding requests
+  health_checked_at?: string
+  created_at: string
+  updated_at: string
+}
+
+export type ProxyHealthStatus = 'unknown' | 'healthy' | 'unhealthy'
+
+export type ProxyPoolPolicy = 'sticky' | 'round_robin' | 'least_latency' | 'same_country'
+
+export interface ProxyPool {
+  id: number
+  name: string
+  description: string
+  policy: ProxyPoolPolicy
+  status: 'active' | 'inactive'
+  proxy_ids: number[]
+  account_count: number
+  healthy_count: number
+  created_at: string
+  updated_at: string
+}
+
+export interface CreateProxyPoolRequest {
+  name: string
+  description?: string
+  policy?: ProxyPoolPolicy
+  proxy_ids?: number[]
+}
+
+export interface UpdateProxyPoolRequest {
+  name?: string
+  description?: string
+  policy?: ProxyPoolPolicy
+  status?: 'active' | 'inactive'
+  proxy_ids?: number[]
+}
+
+export interface ProxySubscription {
+  id: number
+  name: string
+  url: string
+  refresh_interval_minutes: number // 0 = manual sync only
+  pool_id: number | null
+  status: 'active' | 'inactive'
+  proxy_count: number
+  last_synced_at: string | null
+  last_error: string
+  last_total: number
+  last_created: number
+  last_skipped: number
+  last_disabled: number
   created_at: string
   updated_at: string
 }
 
+export interface CreateProxySubscriptionRequest {
+  name: string
+  url: string
+  refresh_interval_minutes?: number
+  pool_id?: number | null
+}
+
+export interface UpdateProxySubscriptionRequest {
+  name?: string
+  url?: string
+  refresh_interval_minutes?: number
+  pool_id?: number // 0 = stop adding imported proxies to a pool
+  status?: 'active' | 'inactive'
+}
+
+export interface ImportProxiesRequest {
+  content?: string
+  url?: string
+  name_prefix?: string
+  pool_id?: number | null
+}
+
+export interface ProxyImportResult {
+  total: number
+  created: number
+  duplicated: number
+  reactivated: number
+  disabled: number
+  skipped: Array<{ entry: string; reason: string }>
+  proxy_ids: number[]
+}
+
 export interface ProxyAccountSummary {
   id: number
   name: string
@@ -456,6 +592,7 @@ export interface Account {
   credentials?: Record<string, unknown>
   extra?: CodexUsageSnapshot & Record<string, unknown> // Extra fields including Codex usage
   proxy_id: number | null
+  proxy_pool_id?: number | null // When set, proxy_id is the pool member currently assigned
   concurrency: number
   current_concurrency?: number // Real-time concurrency count from Redis
   priority: number
@@ -502,6 +639,25 @@ export interface Account {
   // 运行时状态（仅当启用对应限制时返回）
   current_window_cost?: number | null // 当前窗口费用
   active_sessions?: number | null // 当前活跃会话数
+  budget?: AccountBudgetStatus | null // 预算进度（仅配置了预算时返回）
+}
+
+export type AccountBudgetPeriod = 'daily' | 'weekly' | 'monthly'
+
+export interface AccountBudgetPeriodStatus {
+  period: AccountBudgetPeriod
+  cost_used: number
+  cost_limit?: number
+  tokens_used: number
+  tokens_limit?: number
+  reset_at: string
+  exhausted: boolean
+}
+
+export interface AccountBudgetStatus {
+  periods: AccountBudgetPeriodStatus[]
+  exhausted: boolean
+  resume_at?: string
 }
 
 // Account Usage types
@@ -573,6 +729,7 @@ export interface CreateAccountRequest {
   credentials: Record<string, unknown>
   extra?: Record<string, unknown>
   proxy_id?: number | null
+  proxy_pool_id?: number | null // Takes precedence over proxy_id
   concurrency?: number
   priority?: number
   rate_multiplier?: number // Account billing multiplier (>=0, 0 means free)
@@ -589,6 +746,7 @@ export interface UpdateAccountRequest {
   credentials?: Record<string, unknown>
   extra?: Record<string, unknown>
   proxy_id?: number | null
+  proxy_pool_id?: number | null // 0 unbinds the pool
   concurrency?: number
   priority?: number
   rate_multiplier?: number // Account billing multiplier (>=0, 0 means free)
@@ -600,6 +758,68 @@ export interface UpdateAccountRequest {
   confirm_mixed_channel_risk?: boolean
 }
 
+// Encrypted account bundle (export/import and instance-to-instance sync)
+export interface AccountBundleEnvelope {
+  format: string
+  version: number
+  source: string
+  exported_at: string
+  kdf: string
+  scrypt_n: number
+  scrypt_r: number
+  scrypt_p: number
+  salt: string
+  nonce: string
+  ciphertext: string
+}
+
+export type AccountBundleConflictPolicy = 'skip' | 'overwrite' | 'rename'
+
+export interface AccountBundleImportCounts {
+  created: number
+  updated: number
+  skipped: number
+  renamed: number
+  reused: number
+  failed: number
+}
+
+export interface AccountBundleImportItem {
+  kind: 'account' | 'group' | 'proxy'
+  source_id: number
+  name: string
+  action: 'create' | 'update' | 'skip' | 'rename' | 'reuse' | 'failed'
+  target_id?: number
+  changes?: string[]
+  error?: string
+}
+
+export interface AccountBundleImportResult {
+  dry_run: boolean
+  source: string
+  exported_at: string
+  accounts: AccountBundleImportCounts
+  groups: AccountBundleImportCounts
+  proxies: AccountBundleImportCounts
+  items: AccountBundleImportItem[]
+}
+
+export interface ImportAccountBundleRequest {
+  bundle: AccountBundleEnvelope
+  password: string
+  conflict_policy?: AccountBundleConflictPolicy
+  dry_run?: boolean
+}
+
+export interface SyncFromInstanceRequest {
+  base_url: string
+  admin_api_key: string
+  password: string
+  account_ids?: number[]
+  conflict_policy?: AccountBundleConflictPolicy
+  dry_run?: boolean
+}
+
 export interface CreateProxyRequest {
   name: string
   protocol: ProxyProtocol
@@ -661,6 +881,9 @@ export interface UsageLog {
   // User-Agent
   user_agent: string | null
 
+  // 链路追踪 ID（与响应头 X-Trace-Id 一致）
+  trace_id?: string | null
+
   created_at: string
 
   user?: User
@@ -827,6 +1050,26 @@ export interface ModelStat {
   actual_cost: number // 实际扣除
 }
 
+export interface PromptCacheStat {
+  id: number
+  name: string
+  requests: number
+  cache_hit_requests: number
+  input_tokens: number
+  cache_creation_tokens: number
+  cache_read_tokens: number
+  hit_rate: number // cache_read / (input + cache_creation + cache_read)
+}
+
+export interface UserCacheSavings {
+  user_id: number
+  email: string
+  cache_read_tokens: number
+  cache_hit_requests: number
+  savings: number // 标准计费节省
+  actual_savings: number // 实际节省
+}
+
 export interface UserUsageTrendPoint {
   date: string
   user_id: number
@@ -847,12 +1090,12 @@ export interface ApiKeyUsageTrendPoint {
 
 // ==================== Admin User Management ====================
 
-export interface UpdateUserRequest {
+export interface UpdateUserRequest extends Partial<RequestRateLimits> {
   email?: string
   password?: string
   username?: string
   notes?: string
-  role?: 'admin' | 'user'
+  role?: UserRole
   balance?: number
   concurrency?: number
   status?: 'active' | 'disabled'
@@ -877,6 +1120,12 @@ export interface UserSubscription {
   daily_window_start: string | null
   weekly_window_start: string | null
   monthly_window_start: string | null
+  // Plan subscriptions: source plan, auto-renew flag and limit snapshot (null = group limit)
+  plan_id: number | null
+  auto_renew: boolean
+  daily_limit_usd: number | null
+  weekly_limit_usd: number | null
+  monthly_limit_usd: number | null
   created_at: string
   updated_at: string
   expires_at: string | null
@@ -884,6 +1133,106 @@ export interface UserSubscription {
   group?: Group
 }
 
+export type SubscriptionPlanStatus = 'active' | 'disabled'
+
+export interface SubscriptionPlan {
+  id: number
+  name: string
+  description: string
+  group_id: number
+  validity_days: number
+  price: number // USD; converted with the provider rate at checkout, debited from balance on auto-renew
+  daily_limit_usd: number | null // null = group limit
+  weekly_limit_usd: number | null
+  monthly_limit_usd: number | null
+  status: SubscriptionPlanStatus
+  sort_order: number
+  created_at: string
+  updated_at: string
+  group?: Group
+}
+
+export interface CreateSubscriptionPlanRequest {
+  name: string
+  description?: string
+  group_id: number
+  validity_days: number
+  price: number
+  daily_limit_usd?: number | null // 0 or empty = group limit
+  weekly_limit_usd?: number | null
+  monthly_limit_usd?: number | null
+  status?: SubscriptionPlanStatus
+  sort_order?: number
+}
+
+export type UpdateSubscriptionPlanRequest = Partial<CreateSubscriptionPlanRequest>
+
+export interface CreatePlanCheckoutRequest {
+  provider: PaymentProviderName
+  method?: string
+}
+
+// ==================== Model Price Types ====================
+
+/** Admin-managed price version; prices are USD per million tokens, group_id null = global */
+export interface ModelPrice {
+  id: number
+  group_id: number | null
+  model: string
+  input_price: number
+  output_price: number
+  cache_write_price: number
+  cache_read_price: number
+  long_context_threshold: number
+  long_context_input_price: number | null
+  long_context_output_price: number | null
+  effective_from: string
+  scheduled: boolean
+  notes: string
+  created_at: string
+  updated_at: string
+  group?: Group
+}
+
+export interface ModelPriceRequest {
+  group_id?: number | null
+  model: string
+  input_price: number
+  output_price: number
+  cache_write_price: number
+  cache_read_price: number
+  long_context_threshold?: number
+  long_context_input_price?: number | null
+  long_context_output_price?: number | null
+  effective_from?: string | null
+  notes?: string
+}
+
+export type ModelPricingSource = 'override' | 'litellm' | 'fallback' | 'default_price'
+
+export interface ModelPricingPreview {
+  model: string
+  source: ModelPricingSource
+  price_id?: number
+  input_price: number
+  output_price: number
+  cache_write_price: number
+  cache_read_price: number
+  long_context_threshold: number
+  long_context_input_price: number
+  long_context_output_price: number
+}
+
+export type UnknownModelPricingPolicy = 'fallback' | 'default_price' | 'reject'
+
+export interface UnknownModelPricingSettings {
+  policy: UnknownModelPricingPolicy
+  input_price: number
+  output_price: number
+  cache_write_price: number
+  cache_read_price: number
+}
+
 export interface SubscriptionProgress {
   subscription_id: number
   daily: {
@@ -994,6 +1343,37 @@ export interface AccountUsageStatsResponse {
   models: ModelStat[]
 }
 
+export type AccountHealthFactorKey = 'success_rate' | 'latency' | 'throttle' | 'window_cost'
+
+export interface AccountHealthFactor {
+  key: AccountHealthFactorKey
+  score: number // 0-100
+  applied: boolean // false when there is not enough data or the factor does not apply
+}
+
+export interface AccountHealthStats {
+  account_id: number
+  window_minutes: number
+  success_count: number
+  error_count: number
+  upstream_429_count: number
+  upstream_529_count: number
+  ttft_p50_ms?: number
+  ttft_p95_ms?: number
+  updated_at: string
+}
+
+export interface AccountHealthReport {
+  account_id: number
+  score: number // 0-100
+  weight: number // scheduling weight 0-1
+  factors: AccountHealthFactor[]
+  stats?: AccountHealthStats
+  window_cost?: number
+  window_cost_limit?: number
+  scheduling_mode: 'load_aware' | 'health_weighted' | string
+}
+
 // ==================== User Attribute Types ====================
 
 export type UserAttributeType = 'text' | 'textarea' | 'number' | 'email' | 'url' | 'date' | 'select' | 'multi_select'
@@ -1109,6 +1489,336 @@ export interface UpdatePromoCodeRequest {
   notes?: string
 }
 
+// ==================== Organization Types ====================
+
+export type OrganizationRole = 'owner' | 'admin' | 'member'
+
+export interface Organization {
+  id: number
+  name: string
+  owner_user_id: number
+  balance: number
+  concurrency: number // 0 = unlimited
+  status: 'active' | 'disabled'
+  notes: string
+  member_count: number
+  created_at: string
+  updated_at: string
+  owner?: User
+}
+
+export interface OrganizationMember {
+  id: number
+  organization_id: number
+  user_id: number
+  role: OrganizationRole
+  monthly_limit_usd: number | null
+  monthly_usage_usd: number
+  joined_at: string
+  user?: Pick<User, 'id' | 'email' | 'username'>
+}
+
+export interface OrganizationMemberUsage {
+  user_id: number
+  email: string
+  username: string
+  role: OrganizationRole | ''
+  requests: number
+  input_tokens: number
+  output_tokens: number
+  cache_tokens: number
+  total_cost: number
+  actual_cost: number
+}
+
+export interface OrganizationUsageBreakdown {
+  organization_id: number
+  start_time: string
+  end_time: string
+  members: OrganizationMemberUsage[]
+  total_requests: number
+  total_cost: number
+  actual_cost: number
+}
+
+export interface MyOrganization {
+  organization: Organization
+  membership: OrganizationMember
+}
+
+export interface CreateOrganizationRequest {
+  name: string
+  owner_user_id: number
+  balance?: number
+  concurrency?: number
+  notes?: string
+}
+
+export interface UpdateOrganizationRequest {
+  name?: string
+  concurrency?: number
+  status?: 'active' | 'disabled'
+  notes?: string
+}
+
+export interface OrganizationMemberRequest {
+  user_id?: number
+  email?: string
+  role?: Exclude<OrganizationRole, 'owner'>
+  monthly_limit_usd?: number // <= 0 clears the limit on update
+}
+
+// ==================== Balance Ledger Types ====================
+
+export type BalanceTransactionSource =
+  | 'usage'
+  | 'admin_adjustment'
+  | 'redeem_code'
+  | 'promo_code'
+  | 'creem_checkout'
+  | 'payment_order'
+  | 'payment_refund'
+  | 'initial'
+  | 'opening_balance'
+  | 'subscription_renewal'
+
+export interface BalanceTransaction {
+  id: number
+  user_id: number
+  amount: number // positive = credit, negative = debit
+  balance_after: number
+  source_type: BalanceTransactionSource
+  reference_id: string
+  actor_user_id?: number | null // admin view only
+  notes?: string // admin view only
+  created_at: string
+}
+
+export interface OrganizationBalanceTransaction {
+  id: number
+  organization_id: number
+  amount: number // positive = credit, negative = debit
+  balance_after: number
+  source_type: BalanceTransactionSource
+  reference_id: string
+  actor_user_id?: number | null // admin adjuster, or the member who made the request for usage
+  notes?: string
+  created_at: string
+}
+
+export interface BalanceTransactionFilters {
+  source_type?: string
+  start_date?: string
+  end_date?: string
+}
+
+// ==================== Payment Types ====================
+
+export type PaymentProviderName = 'creem' | 'stripe' | 'epay'
+export type PaymentOrderStatus = 'pending' | 'paid' | 'failed' | 'refunded'
+
+export interface PaymentProvider {
+  name: PaymentProviderName
+  currency: string
+  rate_multiplier: number // credited USD per unit of payment currency
+  methods: string[] // empty = chosen on the provider's checkout page
+}
+
+export interface PaymentOrder {
+  id: number
+  order_no: string
+  user_id: number
+  provider: PaymentProviderName
+  method: string
+  status: PaymentOrderStatus
+  amount: number // in payment currency
+  currency: string
+  rate_multiplier: number
+  credit_amount: number // USD credited to balance
+  plan_id: number | null // set for subscription plan purchases (no balance credit)
+  plan_name?: string
+  provider_txn_id: string
+  checkout_url: string
+  failure_reason: string
+  paid_at: string | null
+  refunded_at: string | null
+  created_at: string
+  updated_at: string
+}
+
+export interface AdminPaymentOrder extends PaymentOrder {
+  provider_session_id: string
+  notes: string
+  user?: User
+}
+
+export interface PaymentOrderFilters {
+  user_id?: number
+  provider?: string
+  status?: string
+  search?: string
+}
+
+export interface CreatePaymentCheckoutRequest {
+  provider: PaymentProviderName
+  amount: number
+  method?: string
+}
+
+export interface PaymentCheckoutResponse {
+  order_no: string
+  checkout_url: string
+}
+
+// ==================== Invoice Types ====================
+
+export type InvoiceSubjectType = 'user' | 'organization'
+export type InvoiceStatus = 'issued' | 'void'
+
+export interface InvoiceLineItem {
+  kind: 'usage' | 'subscription'
+  description: string
+  model?: string
+  group_id?: number
+  group_name?: string
+  subscription_covered: boolean // usage covered by subscription quota, amount is 0
+  requests: number
+  input_tokens: number
+  output_tokens: number
+  cache_creation_tokens: number
+  cache_read_tokens: number
+  validity_days?: number
+  cost: number
+  amount: number
+}
+
+export interface InvoiceTopUp {
+  source: string
+  reference: string
+  amount: number
+  paid_at: string
+}
+
+export interface Invoice {
+  id: number
+  number: string
+  subject_type: InvoiceSubjectType
+  subject_id: number
+  recipient_user_id: number
+  period: string // YYYY-MM
+  period_start: string
+  period_end: string
+  currency: string
+  items: InvoiceLineItem[]
+  top_ups: InvoiceTopUp[]
+  subtotal: number
+  tax_label: string
+  tax_rate: number // percent
+  tax_amount: number
+  total: number
+  top_up_total: number // informational, not part of the total
+  bill_to_name: string
+  bill_to_email: string
+  status: InvoiceStatus
+  emailed_at?: string
+  email_error?: string
+  created_by?: number
+  created_at: string
+  updated_at: string
+}
+
+export interface InvoiceFilters {
+  subject_type?: string
+  subject_id?: number
+  status?: string
+  period?: string
+}
+
+export interface GenerateInvoicesRequest {
+  period: string
+  subject_type?: InvoiceSubjectType // empty = all billable subjects, in background
+  subject_id?: number
+  send_email?: boolean
+}
+
+export interface InvoiceSettings {
+  auto_generate: boolean
+  auto_email: boolean
+  number_prefix: string
+  next_number: number
+  number_padding: number
+  currency: string
+  tax_rate: number
+  tax_label: string
+  company_name: string
+  company_address: string
+  company_tax_id: string
+  company_email: string
+  footer_note: string
+}
+
+// ==================== Audit Log Types ====================
+
+export interface AuditLog {
+  id: number
+  actor_user_id: number | null
+  actor_type: 'jwt' | 'admin_api_key' | ''
+  action: string
+  target_type: string
+  target_id: string
+  before?: Record<string, unknown>
+  after?: Record<string, unknown>
+  status_code: number
+  ip_address: string
+  user_agent: string
+  request_id?: string
+  created_at: string
+}
+
+export interface AuditLogFilters {
+  actor_user_id?: number
+  actor_type?: string
+  action?: string
+  target_type?: string
+  target_id?: string
+  start_date?: string
+  end_date?: string
+}
+
+// ==================== Admin API Key Types ====================
+
+export interface AdminApiKey {
+  id: number
+  name: string
+  key_prefix: string
+  scopes: string[]
+  expires_at: string | null
+  last_used_at: string | null
+  created_by: number | null
+  expired: boolean
+  created_at: string
+}
+
+export interface CreateAdminApiKeyRequest {
+  name: string
+  scopes: string[]
+  expires_at?: number | null // Unix timestamp in seconds, null = never expires
+}
+
+export interface CreateAdminApiKeyResponse extends AdminApiKey {
+  key: string
+}
+
+export interface AdminRoleInfo {
+  role: UserRole
+  permissions: string[]
+}
+
+export interface AdminRolesResponse {
+  roles: AdminRoleInfo[]
+  permissions: string[]
+}
+
 // ==================== TOTP (2FA) Types ====================
 
 export interface TotpStatus {
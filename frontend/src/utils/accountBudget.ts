@@ -0,0 +1,74 @@
+/**
+ * 账号预算配置（存储在 account.extra 中，空值表示不限制）
+ */
+export interface AccountBudgetForm {
+  budget_daily_cost_usd: number | null
+  budget_weekly_cost_usd: number | null
+  budget_monthly_cost_usd: number | null
+  budget_daily_tokens: number | null
+  budget_weekly_tokens: number | null
+  budget_monthly_tokens: number | null
+}
+
+export const ACCOUNT_BUDGET_KEYS = [
+  'budget_daily_cost_usd',
+  'budget_weekly_cost_usd',
+  'budget_monthly_cost_usd',
+  'budget_daily_tokens',
+  'budget_weekly_tokens',
+  'budget_monthly_tokens'
+] as const
+
+export function emptyAccountBudget(): AccountBudgetForm {
+  return {
+    budget_daily_cost_usd: null,
+    budget_weekly_cost_usd: null,
+    budget_monthly_cost_usd: null,
+    budget_daily_tokens: null,
+    budget_weekly_tokens: null,
+    budget_monthly_tokens: null
+  }
+}
+
+/**
+ * 从 account.extra 读取预算配置
+ */
+export function accountBudgetFromExtra(extra?: Record<string, unknown> | null): AccountBudgetForm {
+  const form = emptyAccountBudget()
+  for (const key of ACCOUNT_BUDGET_KEYS) {
+    const value = Number(extra?.[key])
+    form[key] = Number.isFinite(value) && value > 0 ? value : null
+  }
+  return form
+}
+
+/**
+ * 将预算配置合并到 extra 中（返回新对象），未设置或 <=0 的项会被移除
+ */
+export function applyAccountBudgetToExtra(
+  extra: Record<string, unknown> | null | undefined,
+  budget: AccountBudgetForm
+): Record<string, unknown> {
+  const next: Record<string, unknown> = { ...(extra || {}) }
+  for (const key of ACCOUNT_BUDGET_KEYS) {
+    const value = budget[key]
+    if (value != null && value > 0) {
+      next[key] = key.endsWith('_tokens') ? Math.floor(value) : value
+    } else {
+      delete next[key]
+    }
+  }
+  return next
+}
+
+/**
+ * 预算配置相对 extra 是否有变化
+ */
+export function accountBudgetChanged(
+  extra: Record<string, unknown> | null | undefined,
+  budget: AccountBudgetForm
+): boolean {
+  const current = accountBudgetFromExtra(extra)
+  const next = accountBudgetFromExtra(applyAccountBudgetToExtra(extra, budget))
+  return ACCOUNT_BUDGET_KEYS.some((key) => current[key] !== next[key])
+}
@@ -12,6 +12,13 @@ export type {
   MetricType,
   Operator,
   EmailNotificationConfig,
+  NotificationChannel,
+  NotificationChannelType,
+  NotificationDelivery,
+  PayloadCaptureRule,
+  PayloadCaptureScopeType,
+  PayloadCapture,
+  PayloadCaptureDetail,
   OpsDistributedLockSettings,
   OpsAlertRuntimeSettings,
   OpsMetricThresholds,